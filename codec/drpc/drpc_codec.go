@@ -10,18 +10,41 @@ type vtprotoMessage interface {
 	UnmarshalVT([]byte) error
 }
 
+type vtprotoAppendMessage interface {
+	MarshalAppendVT([]byte) ([]byte, error)
+}
+
 func Marshal(msg interface{}) ([]byte, error) {
 	return msg.(vtprotoMessage).MarshalVT()
 }
 
+// MarshalAppend appends the encoding of msg to buf and returns the extended buffer,
+// so that callers can reuse their buffers across messages.
+func MarshalAppend(buf []byte, msg interface{}) ([]byte, error) {
+	if vt, ok := msg.(vtprotoAppendMessage); ok {
+		return vt.MarshalAppendVT(buf)
+	}
+	b, err := msg.(vtprotoMessage).MarshalVT()
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, b...), nil
+}
+
 func Unmarshal(buf []byte, msg interface{}) error {
 	return msg.(vtprotoMessage).UnmarshalVT(buf)
 }
 
 func JSONMarshal(msg interface{}) ([]byte, error) {
+	if vt, ok := msg.(interface{ MarshalJSONVT() ([]byte, error) }); ok {
+		return vt.MarshalJSONVT()
+	}
 	return protojson.Marshal(msg.(proto.Message))
 }
 
 func JSONUnmarshal(buf []byte, msg interface{}) error {
+	if vt, ok := msg.(interface{ UnmarshalJSONVT([]byte) error }); ok {
+		return vt.UnmarshalJSONVT(buf)
+	}
 	return protojson.Unmarshal(buf, msg.(proto.Message))
 }
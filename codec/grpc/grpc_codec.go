@@ -5,6 +5,11 @@ import "fmt"
 // Name is the name registered for the proto compressor.
 const Name = "proto"
 
+// Codec implements the encoding.Codec interface of GRPC with the generated vtproto
+// methods. It doesn't marshal into the buffers of the vtproto pool: an
+// encoding.Codec has no way to learn when GRPC is done with the slice returned by
+// Marshal, so a pooled buffer could never be freed safely. Use the codec of the
+// github.com/planetscale/vtprotobuf/codec/grpcv2 module for pooled buffers.
 type Codec struct{}
 
 type vtprotoMessage interface {
@@ -12,6 +17,12 @@ type vtprotoMessage interface {
 	UnmarshalVT([]byte) error
 }
 
+type vtprotoAppendMessage interface {
+	MarshalAppendVT([]byte) ([]byte, error)
+}
+
+// Marshal returns the encoding of v in a newly allocated slice, which GRPC may
+// keep for as long as it needs.
 func (Codec) Marshal(v interface{}) ([]byte, error) {
 	vt, ok := v.(vtprotoMessage)
 	if !ok {
@@ -20,6 +31,23 @@ func (Codec) Marshal(v interface{}) ([]byte, error) {
 	return vt.MarshalVT()
 }
 
+// MarshalAppend appends the encoding of v to buf and returns the extended buffer,
+// so that callers can reuse their buffers across messages.
+func (Codec) MarshalAppend(buf []byte, v interface{}) ([]byte, error) {
+	if vt, ok := v.(vtprotoAppendMessage); ok {
+		return vt.MarshalAppendVT(buf)
+	}
+	vt, ok := v.(vtprotoMessage)
+	if !ok {
+		return nil, fmt.Errorf("failed to marshal, message is %T (missing vtprotobuf helpers)", v)
+	}
+	b, err := vt.MarshalVT()
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, b...), nil
+}
+
 func (Codec) Unmarshal(data []byte, v interface{}) error {
 	vt, ok := v.(vtprotoMessage)
 	if !ok {
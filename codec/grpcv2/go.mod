@@ -0,0 +1,25 @@
+module github.com/planetscale/vtprotobuf/codec/grpcv2
+
+go 1.21
+
+require (
+	github.com/planetscale/vtprotobuf v0.7.0
+	github.com/stretchr/testify v1.7.1
+	google.golang.org/grpc v1.66.2
+	google.golang.org/protobuf v1.34.2
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/net v0.26.0 // indirect
+	golang.org/x/sys v0.21.0 // indirect
+	golang.org/x/text v0.16.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240604185151-ef581f913117 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
+)
+
+// Use the root module of this repository while developing. The replace is
+// ignored by the modules depending on this one, which get the version of the
+// root module required above: it must be a released version.
+replace github.com/planetscale/vtprotobuf => ../..
@@ -0,0 +1,105 @@
+// Package grpcv2 provides a codec for the encoding.CodecV2 interface of GRPC. It is
+// a separate module, so that using the other packages of vtprotobuf doesn't
+// require GRPC 1.66 or later.
+package grpcv2
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/mem"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+// Name is the name registered for the proto codec.
+const Name = "proto"
+
+// Codec implements the encoding.CodecV2 interface of GRPC, which marshals
+// messages into reference-counted buffers that GRPC releases once it has sent
+// them, and unmarshals them from the buffers GRPC has read them into. It must be
+// registered with encoding.RegisterCodecV2.
+type Codec struct{}
+
+var _ encoding.CodecV2 = Codec{}
+
+type vtprotoMessage interface {
+	UnmarshalVT([]byte) error
+}
+
+type vtprotoSizedMessage interface {
+	SizeVT() int
+	MarshalToSizedBufferVT([]byte) (int, error)
+}
+
+// bufferPool is the mem.BufferPool of the buffers of the vtproto package.
+type bufferPool struct{}
+
+func (bufferPool) Get(length int) *[]byte {
+	return vtproto.GetBuffer(length)
+}
+
+func (bufferPool) Put(buf *[]byte) {
+	vtproto.PutBuffer(buf)
+}
+
+// Marshal encodes v with SizeVT and MarshalToSizedBufferVT. Like the proto codec of
+// GRPC, messages smaller than the pooling threshold of the mem package are
+// encoded into a new slice, and larger ones into a buffer taken from the pool of
+// the vtproto package, which is returned to it when GRPC frees the buffer.
+func (Codec) Marshal(v any) (mem.BufferSlice, error) {
+	vt, ok := v.(vtprotoSizedMessage)
+	if !ok {
+		return nil, fmt.Errorf("failed to marshal, message is %T (missing vtprotobuf helpers)", v)
+	}
+	size := vt.SizeVT()
+	if mem.IsBelowBufferPoolingThreshold(size) {
+		buf := make([]byte, size)
+		if err := marshalSized(vt, buf); err != nil {
+			return nil, err
+		}
+		return mem.BufferSlice{mem.SliceBuffer(buf)}, nil
+	}
+	pool := bufferPool{}
+	buf := pool.Get(size)
+	if err := marshalSized(vt, *buf); err != nil {
+		pool.Put(buf)
+		return nil, err
+	}
+	return mem.BufferSlice{mem.NewBuffer(buf, pool)}, nil
+}
+
+func marshalSized(vt vtprotoSizedMessage, buf []byte) error {
+	n, err := vt.MarshalToSizedBufferVT(buf)
+	if err != nil {
+		return err
+	}
+	if n != len(buf) {
+		return vtproto.ErrSizeMismatch
+	}
+	return nil
+}
+
+// Unmarshal decodes data into v with UnmarshalVT. If the message was read into a
+// single buffer, it is decoded in place; otherwise, the buffers are first copied
+// into one taken from the pool of the vtproto package. As UnmarshalVT copies the
+// strings and bytes fields, v does not reference data once Unmarshal returns.
+func (Codec) Unmarshal(data mem.BufferSlice, v any) error {
+	vt, ok := v.(vtprotoMessage)
+	if !ok {
+		return fmt.Errorf("failed to unmarshal, message is %T (missing vtprotobuf helpers)", v)
+	}
+	switch len(data) {
+	case 0:
+		return vt.UnmarshalVT(nil)
+	case 1:
+		return vt.UnmarshalVT(data[0].ReadOnlyData())
+	}
+	buf := data.MaterializeToBuffer(bufferPool{})
+	defer buf.Free()
+	return vt.UnmarshalVT(buf.ReadOnlyData())
+}
+
+func (Codec) Name() string {
+	return Name
+}
@@ -0,0 +1,100 @@
+package grpcv2
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/mem"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/planetscale/vtprotobuf/testproto/methods"
+)
+
+func newMessage(bytesLen int) *methods.MethodsMessage {
+	msg := &methods.MethodsMessage{
+		Int32Value:  -1,
+		StringValue: "string",
+		Children:    []*methods.MethodsChild{{Id: 1}, {Tags: []string{"a", "b"}}},
+		ChildMap:    map[string]*methods.MethodsChild{"a": {Id: 2}},
+		Wrapped:     wrapperspb.String("wrapped"),
+		Choice:      &methods.MethodsMessage_OneofString{OneofString: "oneof"},
+		BytesValue:  make([]byte, bytesLen),
+	}
+	for i := range msg.BytesValue {
+		msg.BytesValue[i] = byte(i)
+	}
+	return msg
+}
+
+// echoServiceDesc describes a service whose Echo method returns its request.
+var echoServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vtproto.test.Echo",
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{{
+		MethodName: "Echo",
+		Handler: func(_ interface{}, _ context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+			in := &methods.MethodsMessage{}
+			if err := dec(in); err != nil {
+				return nil, err
+			}
+			return in, nil
+		},
+	}},
+}
+
+func TestCodecEcho(t *testing.T) {
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.ForceServerCodecV2(Codec{}))
+	srv.RegisterService(&echoServiceDesc, struct{}{})
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodecV2(Codec{})),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// the small messages are not pooled, and the large one spans several frames
+	for _, msg := range []*methods.MethodsMessage{{}, {Int32Value: 42}, newMessage(10), newMessage(100_000)} {
+		out := &methods.MethodsMessage{}
+		require.NoError(t, conn.Invoke(context.Background(), "/vtproto.test.Echo/Echo", msg, out))
+		require.True(t, proto.Equal(msg, out))
+	}
+}
+
+func TestCodec(t *testing.T) {
+	msg := newMessage(10_000)
+
+	data, err := Codec{}.Marshal(msg)
+	require.NoError(t, err)
+	expected, err := msg.MarshalVT()
+	require.NoError(t, err)
+	require.Equal(t, len(expected), data.Len())
+
+	decoded := &methods.MethodsMessage{}
+	require.NoError(t, Codec{}.Unmarshal(data, decoded))
+	require.True(t, proto.Equal(msg, decoded))
+
+	// a message split across several buffers
+	b := data.Materialize()
+	data.Free()
+	fragmented := mem.BufferSlice{mem.SliceBuffer(b[:7]), mem.SliceBuffer(b[7:5000]), mem.SliceBuffer(b[5000:])}
+	decoded = &methods.MethodsMessage{}
+	require.NoError(t, Codec{}.Unmarshal(fragmented, decoded))
+	require.True(t, proto.Equal(msg, decoded))
+
+	_, err = Codec{}.Marshal(struct{}{})
+	require.Error(t, err)
+	require.Error(t, Codec{}.Unmarshal(nil, struct{}{}))
+}
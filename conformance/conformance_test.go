@@ -23,6 +23,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	pb "github.com/planetscale/vtprotobuf/conformance/internal/conformance"
+	"github.com/planetscale/vtprotobuf/vtproto"
 )
 
 func init() {
@@ -82,6 +83,27 @@ func conformanceUnmarshal(b []byte, msg proto.Message) error {
 	return nil
 }
 
+func conformanceUnmarshalJSON(b []byte, msg proto.Message, discardUnknown bool) error {
+	expected := proto.Clone(msg)
+	expectedErr := protojson.UnmarshalOptions{DiscardUnknown: discardUnknown}.Unmarshal(b, expected)
+	err := vtproto.JSONUnmarshalOptions{DiscardUnknown: discardUnknown}.Unmarshal(b, msg)
+
+	if (expectedErr == nil) != (err == nil) {
+		fmt.Fprintf(marshalDifflog, "UNMARSHAL JSON\n")
+		fmt.Fprintf(marshalDifflog, "expected error: %v\n", expectedErr)
+		fmt.Fprintf(marshalDifflog, "got error: %v\n", err)
+		fmt.Fprintf(marshalDifflog, "raw: %s\n\n", b)
+		fmt.Fprintf(marshalDifflog, "==============\n\n")
+	} else if err == nil && !proto.Equal(expected, msg) {
+		fmt.Fprintf(marshalDifflog, "UNMARSHAL JSON\n")
+		fmt.Fprintf(marshalDifflog, "expected:\n%s\n\n", prototext.Format(expected))
+		fmt.Fprintf(marshalDifflog, "got:\n%s\n\n", prototext.Format(msg))
+		fmt.Fprintf(marshalDifflog, "raw: %s\n\n", b)
+		fmt.Fprintf(marshalDifflog, "==============\n\n")
+	}
+	return err
+}
+
 func conformanceMarshal(msg proto.Message) ([]byte, error) {
 	var expected, got []byte
 	var err error
@@ -229,9 +251,8 @@ func handle(req *pb.ConformanceRequest) (res *pb.ConformanceResponse) {
 	case *pb.ConformanceRequest_ProtobufPayload:
 		err = conformanceUnmarshal(p.ProtobufPayload, msg)
 	case *pb.ConformanceRequest_JsonPayload:
-		err = protojson.UnmarshalOptions{
-			DiscardUnknown: req.TestCategory == pb.TestCategory_JSON_IGNORE_UNKNOWN_PARSING_TEST,
-		}.Unmarshal([]byte(p.JsonPayload), msg)
+		err = conformanceUnmarshalJSON([]byte(p.JsonPayload), msg,
+			req.TestCategory == pb.TestCategory_JSON_IGNORE_UNKNOWN_PARSING_TEST)
 	case *pb.ConformanceRequest_TextPayload:
 		err = prototext.Unmarshal([]byte(p.TextPayload), msg)
 	default:
@@ -125,3 +125,43 @@ func TestCloneVT_UnknownFields(t *testing.T) {
 
 	assert.Truef(t, proto.Equal(cloned, protoCloned), "expected %T to be equal:\ncloned = %+v\nprotoCloned = %+v\n", cloned, cloned, protoCloned)
 }
+
+func TestCopyToVT(t *testing.T) {
+	msg := &TestAllTypesProto3{
+		OptionalInt32:          42,
+		OptionalBytes:          []byte("blop"),
+		OptionalNestedMessage:  &TestAllTypesProto3_NestedMessage{A: 1},
+		RepeatedString:         []string{"a", "b"},
+		RepeatedNestedMessage:  []*TestAllTypesProto3_NestedMessage{{A: 2}, {A: 3}},
+		MapStringNestedMessage: map[string]*TestAllTypesProto3_NestedMessage{"a": {A: 4}},
+		OneofField:             &TestAllTypesProto3_OneofNestedMessage{OneofNestedMessage: &TestAllTypesProto3_NestedMessage{A: 5}},
+		OptionalStringWrapper:  wrapperspb.String("blip"),
+		RepeatedValue:          []*structpb.Value{structpb.NewNumberValue(42)},
+	}
+
+	for _, dst := range []*TestAllTypesProto3{
+		{},
+		{
+			OptionalInt64:          1,
+			OptionalBytes:          []byte("something longer"),
+			OptionalNestedMessage:  &TestAllTypesProto3_NestedMessage{A: 10, Corecursive: &TestAllTypesProto3{}},
+			RepeatedString:         []string{"x", "y", "z"},
+			RepeatedNestedMessage:  []*TestAllTypesProto3_NestedMessage{{A: 20}},
+			MapStringNestedMessage: map[string]*TestAllTypesProto3_NestedMessage{"a": {A: 40}, "b": {A: 41}},
+			OneofField:             &TestAllTypesProto3_OneofNestedMessage{OneofNestedMessage: &TestAllTypesProto3_NestedMessage{A: 50}},
+			OptionalStringWrapper:  wrapperspb.String("blop"),
+			RepeatedValue:          []*structpb.Value{structpb.NewStringValue("a"), structpb.NewNullValue()},
+		},
+		{OneofField: &TestAllTypesProto3_OneofString{OneofString: "blip"}},
+	} {
+		t.Run(fmt.Sprintf("%+v", dst), func(t *testing.T) {
+			orig := proto.Clone(msg).(*TestAllTypesProto3)
+			orig.CopyToVT(dst)
+			require.Truef(t, proto.Equal(msg, dst), "copying %T returned modified message:\nmsg = %+v\ncopy = %+v\n", msg, msg, dst)
+
+			MutateFields(dst)
+			require.False(t, dst.EqualVT(msg), "copied message unchanged after mutation")
+			require.True(t, orig.EqualVT(msg), "mutating copied %T mutated original:\nmsg = %+v\nafter copy = %+v\n", msg, msg, orig)
+		})
+	}
+}
@@ -5,10 +5,18 @@
 package conformance
 
 import (
+	errors "errors"
 	fmt "fmt"
+	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
+	vtproto "github.com/planetscale/vtprotobuf/vtproto"
+	protowire "google.golang.org/protobuf/encoding/protowire"
 	proto "google.golang.org/protobuf/proto"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	hash "hash"
 	io "io"
+	strconv "strconv"
+	utf8 "unicode/utf8"
+	unsafe "unsafe"
 )
 
 const (
@@ -39,6 +47,15 @@ func (m *FailureSet) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *FailureSet) CopyToVT(dst *FailureSet) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Failure = append(dst.Failure[:0], m.Failure...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *ConformanceRequest) CloneVT() *ConformanceRequest {
 	if m == nil {
 		return (*ConformanceRequest)(nil)
@@ -66,6 +83,55 @@ func (m *ConformanceRequest) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *ConformanceRequest) CopyToVT(dst *ConformanceRequest) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	switch c := m.Payload.(type) {
+	case *ConformanceRequest_ProtobufPayload:
+		if d, ok := dst.Payload.(*ConformanceRequest_ProtobufPayload); ok {
+			d.ProtobufPayload = append(d.ProtobufPayload[:0], c.ProtobufPayload...)
+		} else {
+			dst.Payload = c.CloneVT()
+		}
+	case *ConformanceRequest_JsonPayload:
+		if d, ok := dst.Payload.(*ConformanceRequest_JsonPayload); ok {
+			d.JsonPayload = c.JsonPayload
+		} else {
+			dst.Payload = c.CloneVT()
+		}
+	case *ConformanceRequest_JspbPayload:
+		if d, ok := dst.Payload.(*ConformanceRequest_JspbPayload); ok {
+			d.JspbPayload = c.JspbPayload
+		} else {
+			dst.Payload = c.CloneVT()
+		}
+	case *ConformanceRequest_TextPayload:
+		if d, ok := dst.Payload.(*ConformanceRequest_TextPayload); ok {
+			d.TextPayload = c.TextPayload
+		} else {
+			dst.Payload = c.CloneVT()
+		}
+	default:
+		dst.Payload = nil
+	}
+	dst.RequestedOutputFormat = m.RequestedOutputFormat
+	dst.MessageType = m.MessageType
+	dst.TestCategory = m.TestCategory
+	if m.JspbEncodingOptions == nil {
+		dst.JspbEncodingOptions = nil
+	} else {
+		if dst.JspbEncodingOptions == nil {
+			dst.JspbEncodingOptions = m.JspbEncodingOptions.CloneVT()
+		} else {
+			m.JspbEncodingOptions.CopyToVT(dst.JspbEncodingOptions)
+		}
+	}
+	dst.PrintUnknownFields = m.PrintUnknownFields
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *ConformanceRequest_ProtobufPayload) CloneVT() isConformanceRequest_Payload {
 	if m == nil {
 		return (*ConformanceRequest_ProtobufPayload)(nil)
@@ -130,6 +196,66 @@ func (m *ConformanceResponse) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *ConformanceResponse) CopyToVT(dst *ConformanceResponse) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	switch c := m.Result.(type) {
+	case *ConformanceResponse_ParseError:
+		if d, ok := dst.Result.(*ConformanceResponse_ParseError); ok {
+			d.ParseError = c.ParseError
+		} else {
+			dst.Result = c.CloneVT()
+		}
+	case *ConformanceResponse_SerializeError:
+		if d, ok := dst.Result.(*ConformanceResponse_SerializeError); ok {
+			d.SerializeError = c.SerializeError
+		} else {
+			dst.Result = c.CloneVT()
+		}
+	case *ConformanceResponse_RuntimeError:
+		if d, ok := dst.Result.(*ConformanceResponse_RuntimeError); ok {
+			d.RuntimeError = c.RuntimeError
+		} else {
+			dst.Result = c.CloneVT()
+		}
+	case *ConformanceResponse_ProtobufPayload:
+		if d, ok := dst.Result.(*ConformanceResponse_ProtobufPayload); ok {
+			d.ProtobufPayload = append(d.ProtobufPayload[:0], c.ProtobufPayload...)
+		} else {
+			dst.Result = c.CloneVT()
+		}
+	case *ConformanceResponse_JsonPayload:
+		if d, ok := dst.Result.(*ConformanceResponse_JsonPayload); ok {
+			d.JsonPayload = c.JsonPayload
+		} else {
+			dst.Result = c.CloneVT()
+		}
+	case *ConformanceResponse_Skipped:
+		if d, ok := dst.Result.(*ConformanceResponse_Skipped); ok {
+			d.Skipped = c.Skipped
+		} else {
+			dst.Result = c.CloneVT()
+		}
+	case *ConformanceResponse_JspbPayload:
+		if d, ok := dst.Result.(*ConformanceResponse_JspbPayload); ok {
+			d.JspbPayload = c.JspbPayload
+		} else {
+			dst.Result = c.CloneVT()
+		}
+	case *ConformanceResponse_TextPayload:
+		if d, ok := dst.Result.(*ConformanceResponse_TextPayload); ok {
+			d.TextPayload = c.TextPayload
+		} else {
+			dst.Result = c.CloneVT()
+		}
+	default:
+		dst.Result = nil
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *ConformanceResponse_ParseError) CloneVT() isConformanceResponse_Result {
 	if m == nil {
 		return (*ConformanceResponse_ParseError)(nil)
@@ -231,7 +357,247 @@ func (m *JspbEncodingConfig) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *JspbEncodingConfig) CopyToVT(dst *JspbEncodingConfig) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.UseJspbArrayAnyFormat = m.UseJspbArrayAnyFormat
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (this *FailureSet) DiffVT(that *FailureSet) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	for i := 0; i < len(this.Failure) && i < len(that.Failure); i++ {
+		if this.Failure[i] != that.Failure[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "failure[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.Failure[i], New: that.Failure[i]})
+		}
+	}
+	for i := len(that.Failure); i < len(this.Failure); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "failure[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.Failure[i]})
+	}
+	for i := len(this.Failure); i < len(that.Failure); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "failure[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.Failure[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *ConformanceRequest) DiffVT(that *ConformanceRequest) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if x, ok := this.Payload.(*ConformanceRequest_ProtobufPayload); ok {
+		if y, ok := that.Payload.(*ConformanceRequest_ProtobufPayload); ok {
+			if string(x.ProtobufPayload) != string(y.ProtobufPayload) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "protobuf_payload", Kind: vtproto.FieldChanged, Old: x.ProtobufPayload, New: y.ProtobufPayload})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "protobuf_payload", Kind: vtproto.FieldRemoved, Old: x.ProtobufPayload})
+		}
+	} else if y, ok := that.Payload.(*ConformanceRequest_ProtobufPayload); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "protobuf_payload", Kind: vtproto.FieldAdded, New: y.ProtobufPayload})
+	}
+	if x, ok := this.Payload.(*ConformanceRequest_JsonPayload); ok {
+		if y, ok := that.Payload.(*ConformanceRequest_JsonPayload); ok {
+			if x.JsonPayload != y.JsonPayload {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "json_payload", Kind: vtproto.FieldChanged, Old: x.JsonPayload, New: y.JsonPayload})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "json_payload", Kind: vtproto.FieldRemoved, Old: x.JsonPayload})
+		}
+	} else if y, ok := that.Payload.(*ConformanceRequest_JsonPayload); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "json_payload", Kind: vtproto.FieldAdded, New: y.JsonPayload})
+	}
+	if x, ok := this.Payload.(*ConformanceRequest_JspbPayload); ok {
+		if y, ok := that.Payload.(*ConformanceRequest_JspbPayload); ok {
+			if x.JspbPayload != y.JspbPayload {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "jspb_payload", Kind: vtproto.FieldChanged, Old: x.JspbPayload, New: y.JspbPayload})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "jspb_payload", Kind: vtproto.FieldRemoved, Old: x.JspbPayload})
+		}
+	} else if y, ok := that.Payload.(*ConformanceRequest_JspbPayload); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "jspb_payload", Kind: vtproto.FieldAdded, New: y.JspbPayload})
+	}
+	if x, ok := this.Payload.(*ConformanceRequest_TextPayload); ok {
+		if y, ok := that.Payload.(*ConformanceRequest_TextPayload); ok {
+			if x.TextPayload != y.TextPayload {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "text_payload", Kind: vtproto.FieldChanged, Old: x.TextPayload, New: y.TextPayload})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "text_payload", Kind: vtproto.FieldRemoved, Old: x.TextPayload})
+		}
+	} else if y, ok := that.Payload.(*ConformanceRequest_TextPayload); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "text_payload", Kind: vtproto.FieldAdded, New: y.TextPayload})
+	}
+	if this.RequestedOutputFormat != that.RequestedOutputFormat {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "requested_output_format", Kind: vtproto.FieldChanged, Old: this.RequestedOutputFormat, New: that.RequestedOutputFormat})
+	}
+	if this.MessageType != that.MessageType {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "message_type", Kind: vtproto.FieldChanged, Old: this.MessageType, New: that.MessageType})
+	}
+	if this.TestCategory != that.TestCategory {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "test_category", Kind: vtproto.FieldChanged, Old: this.TestCategory, New: that.TestCategory})
+	}
+	if p, q := this.JspbEncodingOptions, that.JspbEncodingOptions; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "jspb_encoding_options", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "jspb_encoding_options", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if nested := p.DiffVT(q); len(nested) > 0 {
+			diffs = protohelpers.AppendDiffs(diffs, "jspb_encoding_options", nested)
+		}
+	}
+	if this.PrintUnknownFields != that.PrintUnknownFields {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "print_unknown_fields", Kind: vtproto.FieldChanged, Old: this.PrintUnknownFields, New: that.PrintUnknownFields})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *ConformanceResponse) DiffVT(that *ConformanceResponse) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if x, ok := this.Result.(*ConformanceResponse_ParseError); ok {
+		if y, ok := that.Result.(*ConformanceResponse_ParseError); ok {
+			if x.ParseError != y.ParseError {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "parse_error", Kind: vtproto.FieldChanged, Old: x.ParseError, New: y.ParseError})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "parse_error", Kind: vtproto.FieldRemoved, Old: x.ParseError})
+		}
+	} else if y, ok := that.Result.(*ConformanceResponse_ParseError); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "parse_error", Kind: vtproto.FieldAdded, New: y.ParseError})
+	}
+	if x, ok := this.Result.(*ConformanceResponse_SerializeError); ok {
+		if y, ok := that.Result.(*ConformanceResponse_SerializeError); ok {
+			if x.SerializeError != y.SerializeError {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "serialize_error", Kind: vtproto.FieldChanged, Old: x.SerializeError, New: y.SerializeError})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "serialize_error", Kind: vtproto.FieldRemoved, Old: x.SerializeError})
+		}
+	} else if y, ok := that.Result.(*ConformanceResponse_SerializeError); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "serialize_error", Kind: vtproto.FieldAdded, New: y.SerializeError})
+	}
+	if x, ok := this.Result.(*ConformanceResponse_RuntimeError); ok {
+		if y, ok := that.Result.(*ConformanceResponse_RuntimeError); ok {
+			if x.RuntimeError != y.RuntimeError {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "runtime_error", Kind: vtproto.FieldChanged, Old: x.RuntimeError, New: y.RuntimeError})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "runtime_error", Kind: vtproto.FieldRemoved, Old: x.RuntimeError})
+		}
+	} else if y, ok := that.Result.(*ConformanceResponse_RuntimeError); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "runtime_error", Kind: vtproto.FieldAdded, New: y.RuntimeError})
+	}
+	if x, ok := this.Result.(*ConformanceResponse_ProtobufPayload); ok {
+		if y, ok := that.Result.(*ConformanceResponse_ProtobufPayload); ok {
+			if string(x.ProtobufPayload) != string(y.ProtobufPayload) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "protobuf_payload", Kind: vtproto.FieldChanged, Old: x.ProtobufPayload, New: y.ProtobufPayload})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "protobuf_payload", Kind: vtproto.FieldRemoved, Old: x.ProtobufPayload})
+		}
+	} else if y, ok := that.Result.(*ConformanceResponse_ProtobufPayload); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "protobuf_payload", Kind: vtproto.FieldAdded, New: y.ProtobufPayload})
+	}
+	if x, ok := this.Result.(*ConformanceResponse_JsonPayload); ok {
+		if y, ok := that.Result.(*ConformanceResponse_JsonPayload); ok {
+			if x.JsonPayload != y.JsonPayload {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "json_payload", Kind: vtproto.FieldChanged, Old: x.JsonPayload, New: y.JsonPayload})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "json_payload", Kind: vtproto.FieldRemoved, Old: x.JsonPayload})
+		}
+	} else if y, ok := that.Result.(*ConformanceResponse_JsonPayload); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "json_payload", Kind: vtproto.FieldAdded, New: y.JsonPayload})
+	}
+	if x, ok := this.Result.(*ConformanceResponse_Skipped); ok {
+		if y, ok := that.Result.(*ConformanceResponse_Skipped); ok {
+			if x.Skipped != y.Skipped {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "skipped", Kind: vtproto.FieldChanged, Old: x.Skipped, New: y.Skipped})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "skipped", Kind: vtproto.FieldRemoved, Old: x.Skipped})
+		}
+	} else if y, ok := that.Result.(*ConformanceResponse_Skipped); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "skipped", Kind: vtproto.FieldAdded, New: y.Skipped})
+	}
+	if x, ok := this.Result.(*ConformanceResponse_JspbPayload); ok {
+		if y, ok := that.Result.(*ConformanceResponse_JspbPayload); ok {
+			if x.JspbPayload != y.JspbPayload {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "jspb_payload", Kind: vtproto.FieldChanged, Old: x.JspbPayload, New: y.JspbPayload})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "jspb_payload", Kind: vtproto.FieldRemoved, Old: x.JspbPayload})
+		}
+	} else if y, ok := that.Result.(*ConformanceResponse_JspbPayload); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "jspb_payload", Kind: vtproto.FieldAdded, New: y.JspbPayload})
+	}
+	if x, ok := this.Result.(*ConformanceResponse_TextPayload); ok {
+		if y, ok := that.Result.(*ConformanceResponse_TextPayload); ok {
+			if x.TextPayload != y.TextPayload {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "text_payload", Kind: vtproto.FieldChanged, Old: x.TextPayload, New: y.TextPayload})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "text_payload", Kind: vtproto.FieldRemoved, Old: x.TextPayload})
+		}
+	} else if y, ok := that.Result.(*ConformanceResponse_TextPayload); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "text_payload", Kind: vtproto.FieldAdded, New: y.TextPayload})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *JspbEncodingConfig) DiffVT(that *JspbEncodingConfig) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if this.UseJspbArrayAnyFormat != that.UseJspbArrayAnyFormat {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "use_jspb_array_any_format", Kind: vtproto.FieldChanged, Old: this.UseJspbArrayAnyFormat, New: that.UseJspbArrayAnyFormat})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
 func (this *FailureSet) EqualVT(that *FailureSet) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *FailureSet) EqualVTWithOptions(that *FailureSet, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
@@ -246,10 +612,22 @@ func (this *FailureSet) EqualVT(that *FailureSet) bool {
 			return false
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *FailureSet) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*FailureSet)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
 }
 
 func (this *ConformanceRequest) EqualVT(that *ConformanceRequest) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *ConformanceRequest) EqualVTWithOptions(that *ConformanceRequest, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
@@ -262,8 +640,8 @@ func (this *ConformanceRequest) EqualVT(that *ConformanceRequest) bool {
 			return false
 		}
 		if !this.Payload.(interface {
-			EqualVT(isConformanceRequest_Payload) bool
-		}).EqualVT(that.Payload) {
+			EqualVTWithOptions(isConformanceRequest_Payload, vtproto.EqualOptions) bool
+		}).EqualVTWithOptions(that.Payload, opts) {
 			return false
 		}
 	}
@@ -276,16 +654,28 @@ func (this *ConformanceRequest) EqualVT(that *ConformanceRequest) bool {
 	if this.TestCategory != that.TestCategory {
 		return false
 	}
-	if !this.JspbEncodingOptions.EqualVT(that.JspbEncodingOptions) {
+	if !this.JspbEncodingOptions.EqualVTWithOptions(that.JspbEncodingOptions, opts) {
 		return false
 	}
 	if this.PrintUnknownFields != that.PrintUnknownFields {
 		return false
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *ConformanceRequest) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*ConformanceRequest)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
 }
 
 func (this *ConformanceRequest_ProtobufPayload) EqualVT(thatIface isConformanceRequest_Payload) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *ConformanceRequest_ProtobufPayload) EqualVTWithOptions(thatIface isConformanceRequest_Payload, opts vtproto.EqualOptions) bool {
 	that, ok := thatIface.(*ConformanceRequest_ProtobufPayload)
 	if !ok {
 		return false
@@ -303,6 +693,10 @@ func (this *ConformanceRequest_ProtobufPayload) EqualVT(thatIface isConformanceR
 }
 
 func (this *ConformanceRequest_JsonPayload) EqualVT(thatIface isConformanceRequest_Payload) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *ConformanceRequest_JsonPayload) EqualVTWithOptions(thatIface isConformanceRequest_Payload, opts vtproto.EqualOptions) bool {
 	that, ok := thatIface.(*ConformanceRequest_JsonPayload)
 	if !ok {
 		return false
@@ -320,6 +714,10 @@ func (this *ConformanceRequest_JsonPayload) EqualVT(thatIface isConformanceReque
 }
 
 func (this *ConformanceRequest_JspbPayload) EqualVT(thatIface isConformanceRequest_Payload) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *ConformanceRequest_JspbPayload) EqualVTWithOptions(thatIface isConformanceRequest_Payload, opts vtproto.EqualOptions) bool {
 	that, ok := thatIface.(*ConformanceRequest_JspbPayload)
 	if !ok {
 		return false
@@ -337,6 +735,10 @@ func (this *ConformanceRequest_JspbPayload) EqualVT(thatIface isConformanceReque
 }
 
 func (this *ConformanceRequest_TextPayload) EqualVT(thatIface isConformanceRequest_Payload) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *ConformanceRequest_TextPayload) EqualVTWithOptions(thatIface isConformanceRequest_Payload, opts vtproto.EqualOptions) bool {
 	that, ok := thatIface.(*ConformanceRequest_TextPayload)
 	if !ok {
 		return false
@@ -354,6 +756,10 @@ func (this *ConformanceRequest_TextPayload) EqualVT(thatIface isConformanceReque
 }
 
 func (this *ConformanceResponse) EqualVT(that *ConformanceResponse) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *ConformanceResponse) EqualVTWithOptions(that *ConformanceResponse, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
@@ -366,15 +772,27 @@ func (this *ConformanceResponse) EqualVT(that *ConformanceResponse) bool {
 			return false
 		}
 		if !this.Result.(interface {
-			EqualVT(isConformanceResponse_Result) bool
-		}).EqualVT(that.Result) {
+			EqualVTWithOptions(isConformanceResponse_Result, vtproto.EqualOptions) bool
+		}).EqualVTWithOptions(that.Result, opts) {
 			return false
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *ConformanceResponse) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*ConformanceResponse)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
 }
 
 func (this *ConformanceResponse_ParseError) EqualVT(thatIface isConformanceResponse_Result) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *ConformanceResponse_ParseError) EqualVTWithOptions(thatIface isConformanceResponse_Result, opts vtproto.EqualOptions) bool {
 	that, ok := thatIface.(*ConformanceResponse_ParseError)
 	if !ok {
 		return false
@@ -392,6 +810,10 @@ func (this *ConformanceResponse_ParseError) EqualVT(thatIface isConformanceRespo
 }
 
 func (this *ConformanceResponse_RuntimeError) EqualVT(thatIface isConformanceResponse_Result) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *ConformanceResponse_RuntimeError) EqualVTWithOptions(thatIface isConformanceResponse_Result, opts vtproto.EqualOptions) bool {
 	that, ok := thatIface.(*ConformanceResponse_RuntimeError)
 	if !ok {
 		return false
@@ -409,6 +831,10 @@ func (this *ConformanceResponse_RuntimeError) EqualVT(thatIface isConformanceRes
 }
 
 func (this *ConformanceResponse_ProtobufPayload) EqualVT(thatIface isConformanceResponse_Result) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *ConformanceResponse_ProtobufPayload) EqualVTWithOptions(thatIface isConformanceResponse_Result, opts vtproto.EqualOptions) bool {
 	that, ok := thatIface.(*ConformanceResponse_ProtobufPayload)
 	if !ok {
 		return false
@@ -426,6 +852,10 @@ func (this *ConformanceResponse_ProtobufPayload) EqualVT(thatIface isConformance
 }
 
 func (this *ConformanceResponse_JsonPayload) EqualVT(thatIface isConformanceResponse_Result) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *ConformanceResponse_JsonPayload) EqualVTWithOptions(thatIface isConformanceResponse_Result, opts vtproto.EqualOptions) bool {
 	that, ok := thatIface.(*ConformanceResponse_JsonPayload)
 	if !ok {
 		return false
@@ -443,6 +873,10 @@ func (this *ConformanceResponse_JsonPayload) EqualVT(thatIface isConformanceResp
 }
 
 func (this *ConformanceResponse_Skipped) EqualVT(thatIface isConformanceResponse_Result) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *ConformanceResponse_Skipped) EqualVTWithOptions(thatIface isConformanceResponse_Result, opts vtproto.EqualOptions) bool {
 	that, ok := thatIface.(*ConformanceResponse_Skipped)
 	if !ok {
 		return false
@@ -460,6 +894,10 @@ func (this *ConformanceResponse_Skipped) EqualVT(thatIface isConformanceResponse
 }
 
 func (this *ConformanceResponse_SerializeError) EqualVT(thatIface isConformanceResponse_Result) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *ConformanceResponse_SerializeError) EqualVTWithOptions(thatIface isConformanceResponse_Result, opts vtproto.EqualOptions) bool {
 	that, ok := thatIface.(*ConformanceResponse_SerializeError)
 	if !ok {
 		return false
@@ -477,6 +915,10 @@ func (this *ConformanceResponse_SerializeError) EqualVT(thatIface isConformanceR
 }
 
 func (this *ConformanceResponse_JspbPayload) EqualVT(thatIface isConformanceResponse_Result) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *ConformanceResponse_JspbPayload) EqualVTWithOptions(thatIface isConformanceResponse_Result, opts vtproto.EqualOptions) bool {
 	that, ok := thatIface.(*ConformanceResponse_JspbPayload)
 	if !ok {
 		return false
@@ -494,6 +936,10 @@ func (this *ConformanceResponse_JspbPayload) EqualVT(thatIface isConformanceResp
 }
 
 func (this *ConformanceResponse_TextPayload) EqualVT(thatIface isConformanceResponse_Result) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *ConformanceResponse_TextPayload) EqualVTWithOptions(thatIface isConformanceResponse_Result, opts vtproto.EqualOptions) bool {
 	that, ok := thatIface.(*ConformanceResponse_TextPayload)
 	if !ok {
 		return false
@@ -511,6 +957,10 @@ func (this *ConformanceResponse_TextPayload) EqualVT(thatIface isConformanceResp
 }
 
 func (this *JspbEncodingConfig) EqualVT(that *JspbEncodingConfig) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *JspbEncodingConfig) EqualVTWithOptions(that *JspbEncodingConfig, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
@@ -519,359 +969,828 @@ func (this *JspbEncodingConfig) EqualVT(that *JspbEncodingConfig) bool {
 	if this.UseJspbArrayAnyFormat != that.UseJspbArrayAnyFormat {
 		return false
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *FailureSet) MarshalVT() (dAtA []byte, err error) {
+func (this *JspbEncodingConfig) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*JspbEncodingConfig)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (m *FailureSet) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *FailureSet) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return nil, nil
+		return
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Failure) > 0 {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(len(m.Failure)))
+		for _, v := range m.Failure {
+			protohelpers.HashString(h, s, v)
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	return dAtA[:n], nil
 }
 
-func (m *FailureSet) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *ConformanceRequest) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
 }
 
-func (m *FailureSet) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *ConformanceRequest) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return 0, nil
+		return
+	}
+	switch c := m.Payload.(type) {
+	case *ConformanceRequest_ProtobufPayload:
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashBytes(h, s, c.ProtobufPayload)
+	case *ConformanceRequest_JsonPayload:
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashString(h, s, c.JsonPayload)
+	case *ConformanceRequest_JspbPayload:
+		protohelpers.HashUint64(h, s, 7)
+		protohelpers.HashString(h, s, c.JspbPayload)
+	case *ConformanceRequest_TextPayload:
+		protohelpers.HashUint64(h, s, 8)
+		protohelpers.HashString(h, s, c.TextPayload)
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if m.RequestedOutputFormat != 0 {
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(m.RequestedOutputFormat))
 	}
-	if len(m.Failure) > 0 {
-		for iNdEx := len(m.Failure) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Failure[iNdEx])
-			copy(dAtA[i:], m.Failure[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.Failure[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.MessageType != "" {
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashString(h, s, m.MessageType)
+	}
+	if m.TestCategory != 0 {
+		protohelpers.HashUint64(h, s, 5)
+		protohelpers.HashUint64(h, s, uint64(m.TestCategory))
+	}
+	if m.JspbEncodingOptions != nil {
+		protohelpers.HashUint64(h, s, 6)
+		m.JspbEncodingOptions.hashVT(h, s)
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.PrintUnknownFields {
+		protohelpers.HashUint64(h, s, 9)
+		protohelpers.HashBool(h, s, m.PrintUnknownFields)
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *ConformanceRequest) MarshalVT() (dAtA []byte, err error) {
+func (m *ConformanceResponse) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *ConformanceResponse) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return nil, nil
+		return
+	}
+	switch c := m.Result.(type) {
+	case *ConformanceResponse_ParseError:
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashString(h, s, c.ParseError)
+	case *ConformanceResponse_SerializeError:
+		protohelpers.HashUint64(h, s, 6)
+		protohelpers.HashString(h, s, c.SerializeError)
+	case *ConformanceResponse_RuntimeError:
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashString(h, s, c.RuntimeError)
+	case *ConformanceResponse_ProtobufPayload:
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashBytes(h, s, c.ProtobufPayload)
+	case *ConformanceResponse_JsonPayload:
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashString(h, s, c.JsonPayload)
+	case *ConformanceResponse_Skipped:
+		protohelpers.HashUint64(h, s, 5)
+		protohelpers.HashString(h, s, c.Skipped)
+	case *ConformanceResponse_JspbPayload:
+		protohelpers.HashUint64(h, s, 7)
+		protohelpers.HashString(h, s, c.JspbPayload)
+	case *ConformanceResponse_TextPayload:
+		protohelpers.HashUint64(h, s, 8)
+		protohelpers.HashString(h, s, c.TextPayload)
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	return dAtA[:n], nil
 }
 
-func (m *ConformanceRequest) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *JspbEncodingConfig) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
 }
 
-func (m *ConformanceRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *JspbEncodingConfig) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return 0, nil
+		return
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if m.UseJspbArrayAnyFormat {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashBool(h, s, m.UseJspbArrayAnyFormat)
 	}
-	if vtmsg, ok := m.Payload.(interface {
-		MarshalToSizedBufferVT([]byte) (int, error)
-	}); ok {
-		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	if m.PrintUnknownFields {
-		i--
-		if m.PrintUnknownFields {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.JspbEncodingOptions != nil {
-		size, err := m.JspbEncodingOptions.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.TestCategory != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.TestCategory))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.MessageType) > 0 {
-		i -= len(m.MessageType)
-		copy(dAtA[i:], m.MessageType)
-		i = encodeVarint(dAtA, i, uint64(len(m.MessageType)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.RequestedOutputFormat != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.RequestedOutputFormat))
-		i--
-		dAtA[i] = 0x18
-	}
-	return len(dAtA) - i, nil
 }
 
-func (m *ConformanceRequest_ProtobufPayload) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *FailureSet) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
 }
 
-func (m *ConformanceRequest_ProtobufPayload) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.ProtobufPayload)
-	copy(dAtA[i:], m.ProtobufPayload)
-	i = encodeVarint(dAtA, i, uint64(len(m.ProtobufPayload)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-func (m *ConformanceRequest_JsonPayload) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *FailureSet) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &FailureSet{}
+	}
+	w.ObjectStart()
+	if len(m.Failure) > 0 {
+		w.Field("failure", "failure")
+		w.ArrayStart()
+		for _, v := range m.Failure {
+			if err := w.String(v); err != nil {
+				return errors.New("proto: field conformance.FailureSet.failure contains invalid UTF-8")
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("failure", "failure")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+	return nil
 }
 
-func (m *ConformanceRequest_JsonPayload) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.JsonPayload)
-	copy(dAtA[i:], m.JsonPayload)
-	i = encodeVarint(dAtA, i, uint64(len(m.JsonPayload)))
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
-}
-func (m *ConformanceRequest_JspbPayload) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *FailureSet) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
 }
 
-func (m *ConformanceRequest_JspbPayload) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.JspbPayload)
-	copy(dAtA[i:], m.JspbPayload)
-	i = encodeVarint(dAtA, i, uint64(len(m.JspbPayload)))
-	i--
-	dAtA[i] = 0x3a
-	return len(dAtA) - i, nil
-}
-func (m *ConformanceRequest_TextPayload) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *FailureSet) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [1]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "failure":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadString()
+				if err != nil {
+					return err
+				}
+				m.Failure = append(m.Failure, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
 }
 
-func (m *ConformanceRequest_TextPayload) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.TextPayload)
-	copy(dAtA[i:], m.TextPayload)
-	i = encodeVarint(dAtA, i, uint64(len(m.TextPayload)))
-	i--
-	dAtA[i] = 0x42
-	return len(dAtA) - i, nil
+func (m *ConformanceRequest) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
 }
-func (m *ConformanceResponse) MarshalVT() (dAtA []byte, err error) {
+
+func (m *ConformanceRequest) MarshalJSONToVT(w *vtproto.JSONWriter) error {
 	if m == nil {
-		return nil, nil
+		// a nil message is written like an empty one
+		m = &ConformanceRequest{}
+	}
+	w.ObjectStart()
+	if c, ok := m.Payload.(*ConformanceRequest_ProtobufPayload); ok {
+		w.Field("protobufPayload", "protobuf_payload")
+		w.Base64(c.ProtobufPayload)
+	}
+	if c, ok := m.Payload.(*ConformanceRequest_JsonPayload); ok {
+		w.Field("jsonPayload", "json_payload")
+		if err := w.String(c.JsonPayload); err != nil {
+			return errors.New("proto: field conformance.ConformanceRequest.json_payload contains invalid UTF-8")
+		}
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if c, ok := m.Payload.(*ConformanceRequest_JspbPayload); ok {
+		w.Field("jspbPayload", "jspb_payload")
+		if err := w.String(c.JspbPayload); err != nil {
+			return errors.New("proto: field conformance.ConformanceRequest.jspb_payload contains invalid UTF-8")
+		}
+	}
+	if c, ok := m.Payload.(*ConformanceRequest_TextPayload); ok {
+		w.Field("textPayload", "text_payload")
+		if err := w.String(c.TextPayload); err != nil {
+			return errors.New("proto: field conformance.ConformanceRequest.text_payload contains invalid UTF-8")
+		}
+	}
+	if m.RequestedOutputFormat != 0 {
+		w.Field("requestedOutputFormat", "requested_output_format")
+		w.Enum(int32(m.RequestedOutputFormat), WireFormat_name)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("requestedOutputFormat", "requested_output_format")
+		w.Enum(int32(m.GetRequestedOutputFormat()), WireFormat_name)
+	}
+	if m.MessageType != "" {
+		w.Field("messageType", "message_type")
+		if err := w.String(m.MessageType); err != nil {
+			return errors.New("proto: field conformance.ConformanceRequest.message_type contains invalid UTF-8")
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("messageType", "message_type")
+		if err := w.String(m.GetMessageType()); err != nil {
+			return errors.New("proto: field conformance.ConformanceRequest.message_type contains invalid UTF-8")
+		}
+	}
+	if m.TestCategory != 0 {
+		w.Field("testCategory", "test_category")
+		w.Enum(int32(m.TestCategory), TestCategory_name)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("testCategory", "test_category")
+		w.Enum(int32(m.GetTestCategory()), TestCategory_name)
+	}
+	if m.JspbEncodingOptions != nil {
+		w.Field("jspbEncodingOptions", "jspb_encoding_options")
+		if err := m.JspbEncodingOptions.MarshalJSONToVT(w); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("jspbEncodingOptions", "jspb_encoding_options")
+		w.Null()
 	}
-	return dAtA[:n], nil
+	if m.PrintUnknownFields {
+		w.Field("printUnknownFields", "print_unknown_fields")
+		w.Bool(m.PrintUnknownFields)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("printUnknownFields", "print_unknown_fields")
+		w.Bool(m.GetPrintUnknownFields())
+	}
+	w.ObjectEnd()
+	return nil
 }
 
-func (m *ConformanceResponse) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *ConformanceRequest) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
 }
 
-func (m *ConformanceResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (m *ConformanceRequest) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
 	}
-	if vtmsg, ok := m.Result.(interface {
-		MarshalToSizedBufferVT([]byte) (int, error)
-	}); ok {
-		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+	var seen [10]bool
+	for {
+		name, ok, err := r.ReadName()
 		if err != nil {
-			return 0, err
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "protobufPayload", "protobuf_payload":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[9] {
+				return r.Errorf("oneof conformance.ConformanceRequest.payload is already set")
+			}
+			seen[9] = true
+			v, err := r.ReadBytes()
+			if err != nil {
+				return err
+			}
+			m.Payload = &ConformanceRequest_ProtobufPayload{ProtobufPayload: v}
+		case "jsonPayload", "json_payload":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[9] {
+				return r.Errorf("oneof conformance.ConformanceRequest.payload is already set")
+			}
+			seen[9] = true
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.Payload = &ConformanceRequest_JsonPayload{JsonPayload: v}
+		case "jspbPayload", "jspb_payload":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[9] {
+				return r.Errorf("oneof conformance.ConformanceRequest.payload is already set")
+			}
+			seen[9] = true
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.Payload = &ConformanceRequest_JspbPayload{JspbPayload: v}
+		case "textPayload", "text_payload":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[9] {
+				return r.Errorf("oneof conformance.ConformanceRequest.payload is already set")
+			}
+			seen[9] = true
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.Payload = &ConformanceRequest_TextPayload{TextPayload: v}
+		case "requestedOutputFormat", "requested_output_format":
+			if seen[4] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[4] = true
+			if r.SkipNull() {
+				continue
+			}
+			e, ok, err := r.ReadEnum(WireFormat_value)
+			if err != nil {
+				return err
+			}
+			if ok {
+				v := WireFormat(e)
+				m.RequestedOutputFormat = v
+			}
+		case "messageType", "message_type":
+			if seen[5] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[5] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.MessageType = v
+		case "testCategory", "test_category":
+			if seen[6] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[6] = true
+			if r.SkipNull() {
+				continue
+			}
+			e, ok, err := r.ReadEnum(TestCategory_value)
+			if err != nil {
+				return err
+			}
+			if ok {
+				v := TestCategory(e)
+				m.TestCategory = v
+			}
+		case "jspbEncodingOptions", "jspb_encoding_options":
+			if seen[7] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[7] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(JspbEncodingConfig)
+			if err := v.UnmarshalJSONFromVT(r); err != nil {
+				return err
+			}
+			m.JspbEncodingOptions = v
+		case "printUnknownFields", "print_unknown_fields":
+			if seen[8] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[8] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadBool()
+			if err != nil {
+				return err
+			}
+			m.PrintUnknownFields = v
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
 		}
-		i -= size
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ConformanceResponse_ParseError) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *ConformanceResponse_ParseError) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.ParseError)
-	copy(dAtA[i:], m.ParseError)
-	i = encodeVarint(dAtA, i, uint64(len(m.ParseError)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-func (m *ConformanceResponse_RuntimeError) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *ConformanceResponse) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
 }
 
-func (m *ConformanceResponse_RuntimeError) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.RuntimeError)
-	copy(dAtA[i:], m.RuntimeError)
-	i = encodeVarint(dAtA, i, uint64(len(m.RuntimeError)))
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
-}
-func (m *ConformanceResponse_ProtobufPayload) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *ConformanceResponse) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &ConformanceResponse{}
+	}
+	w.ObjectStart()
+	if c, ok := m.Result.(*ConformanceResponse_ParseError); ok {
+		w.Field("parseError", "parse_error")
+		if err := w.String(c.ParseError); err != nil {
+			return errors.New("proto: field conformance.ConformanceResponse.parse_error contains invalid UTF-8")
+		}
+	}
+	if c, ok := m.Result.(*ConformanceResponse_SerializeError); ok {
+		w.Field("serializeError", "serialize_error")
+		if err := w.String(c.SerializeError); err != nil {
+			return errors.New("proto: field conformance.ConformanceResponse.serialize_error contains invalid UTF-8")
+		}
+	}
+	if c, ok := m.Result.(*ConformanceResponse_RuntimeError); ok {
+		w.Field("runtimeError", "runtime_error")
+		if err := w.String(c.RuntimeError); err != nil {
+			return errors.New("proto: field conformance.ConformanceResponse.runtime_error contains invalid UTF-8")
+		}
+	}
+	if c, ok := m.Result.(*ConformanceResponse_ProtobufPayload); ok {
+		w.Field("protobufPayload", "protobuf_payload")
+		w.Base64(c.ProtobufPayload)
+	}
+	if c, ok := m.Result.(*ConformanceResponse_JsonPayload); ok {
+		w.Field("jsonPayload", "json_payload")
+		if err := w.String(c.JsonPayload); err != nil {
+			return errors.New("proto: field conformance.ConformanceResponse.json_payload contains invalid UTF-8")
+		}
+	}
+	if c, ok := m.Result.(*ConformanceResponse_Skipped); ok {
+		w.Field("skipped", "skipped")
+		if err := w.String(c.Skipped); err != nil {
+			return errors.New("proto: field conformance.ConformanceResponse.skipped contains invalid UTF-8")
+		}
+	}
+	if c, ok := m.Result.(*ConformanceResponse_JspbPayload); ok {
+		w.Field("jspbPayload", "jspb_payload")
+		if err := w.String(c.JspbPayload); err != nil {
+			return errors.New("proto: field conformance.ConformanceResponse.jspb_payload contains invalid UTF-8")
+		}
+	}
+	if c, ok := m.Result.(*ConformanceResponse_TextPayload); ok {
+		w.Field("textPayload", "text_payload")
+		if err := w.String(c.TextPayload); err != nil {
+			return errors.New("proto: field conformance.ConformanceResponse.text_payload contains invalid UTF-8")
+		}
+	}
+	w.ObjectEnd()
+	return nil
 }
 
-func (m *ConformanceResponse_ProtobufPayload) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.ProtobufPayload)
-	copy(dAtA[i:], m.ProtobufPayload)
-	i = encodeVarint(dAtA, i, uint64(len(m.ProtobufPayload)))
-	i--
-	dAtA[i] = 0x1a
-	return len(dAtA) - i, nil
-}
-func (m *ConformanceResponse_JsonPayload) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *ConformanceResponse) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
 }
 
-func (m *ConformanceResponse_JsonPayload) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.JsonPayload)
-	copy(dAtA[i:], m.JsonPayload)
-	i = encodeVarint(dAtA, i, uint64(len(m.JsonPayload)))
-	i--
-	dAtA[i] = 0x22
-	return len(dAtA) - i, nil
+func (m *ConformanceResponse) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [9]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "parseError", "parse_error":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[8] {
+				return r.Errorf("oneof conformance.ConformanceResponse.result is already set")
+			}
+			seen[8] = true
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.Result = &ConformanceResponse_ParseError{ParseError: v}
+		case "serializeError", "serialize_error":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[8] {
+				return r.Errorf("oneof conformance.ConformanceResponse.result is already set")
+			}
+			seen[8] = true
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.Result = &ConformanceResponse_SerializeError{SerializeError: v}
+		case "runtimeError", "runtime_error":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[8] {
+				return r.Errorf("oneof conformance.ConformanceResponse.result is already set")
+			}
+			seen[8] = true
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.Result = &ConformanceResponse_RuntimeError{RuntimeError: v}
+		case "protobufPayload", "protobuf_payload":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[8] {
+				return r.Errorf("oneof conformance.ConformanceResponse.result is already set")
+			}
+			seen[8] = true
+			v, err := r.ReadBytes()
+			if err != nil {
+				return err
+			}
+			m.Result = &ConformanceResponse_ProtobufPayload{ProtobufPayload: v}
+		case "jsonPayload", "json_payload":
+			if seen[4] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[4] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[8] {
+				return r.Errorf("oneof conformance.ConformanceResponse.result is already set")
+			}
+			seen[8] = true
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.Result = &ConformanceResponse_JsonPayload{JsonPayload: v}
+		case "skipped":
+			if seen[5] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[5] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[8] {
+				return r.Errorf("oneof conformance.ConformanceResponse.result is already set")
+			}
+			seen[8] = true
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.Result = &ConformanceResponse_Skipped{Skipped: v}
+		case "jspbPayload", "jspb_payload":
+			if seen[6] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[6] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[8] {
+				return r.Errorf("oneof conformance.ConformanceResponse.result is already set")
+			}
+			seen[8] = true
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.Result = &ConformanceResponse_JspbPayload{JspbPayload: v}
+		case "textPayload", "text_payload":
+			if seen[7] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[7] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[8] {
+				return r.Errorf("oneof conformance.ConformanceResponse.result is already set")
+			}
+			seen[8] = true
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.Result = &ConformanceResponse_TextPayload{TextPayload: v}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
 }
-func (m *ConformanceResponse_Skipped) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+
+func (m *JspbEncodingConfig) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
 }
 
-func (m *ConformanceResponse_Skipped) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.Skipped)
-	copy(dAtA[i:], m.Skipped)
-	i = encodeVarint(dAtA, i, uint64(len(m.Skipped)))
-	i--
-	dAtA[i] = 0x2a
-	return len(dAtA) - i, nil
+func (m *JspbEncodingConfig) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &JspbEncodingConfig{}
+	}
+	w.ObjectStart()
+	if m.UseJspbArrayAnyFormat {
+		w.Field("useJspbArrayAnyFormat", "use_jspb_array_any_format")
+		w.Bool(m.UseJspbArrayAnyFormat)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("useJspbArrayAnyFormat", "use_jspb_array_any_format")
+		w.Bool(m.GetUseJspbArrayAnyFormat())
+	}
+	w.ObjectEnd()
+	return nil
 }
-func (m *ConformanceResponse_SerializeError) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+
+func (m *JspbEncodingConfig) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
 }
 
-func (m *ConformanceResponse_SerializeError) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.SerializeError)
-	copy(dAtA[i:], m.SerializeError)
-	i = encodeVarint(dAtA, i, uint64(len(m.SerializeError)))
-	i--
-	dAtA[i] = 0x32
-	return len(dAtA) - i, nil
+func (m *JspbEncodingConfig) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [1]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "useJspbArrayAnyFormat", "use_jspb_array_any_format":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadBool()
+			if err != nil {
+				return err
+			}
+			m.UseJspbArrayAnyFormat = v
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
 }
-func (m *ConformanceResponse_JspbPayload) MarshalToVT(dAtA []byte) (int, error) {
+
+func (m *FailureSet) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
 }
 
-func (m *ConformanceResponse_JspbPayload) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.JspbPayload)
-	copy(dAtA[i:], m.JspbPayload)
-	i = encodeVarint(dAtA, i, uint64(len(m.JspbPayload)))
-	i--
-	dAtA[i] = 0x3a
-	return len(dAtA) - i, nil
-}
-func (m *ConformanceResponse_TextPayload) MarshalToVT(dAtA []byte) (int, error) {
+func (m *FailureSet) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
 }
 
-func (m *ConformanceResponse_TextPayload) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.TextPayload)
-	copy(dAtA[i:], m.TextPayload)
-	i = encodeVarint(dAtA, i, uint64(len(m.TextPayload)))
-	i--
-	dAtA[i] = 0x42
-	return len(dAtA) - i, nil
-}
-func (m *JspbEncodingConfig) MarshalVT() (dAtA []byte, err error) {
+func (m *FailureSet) MarshalAppendVT(dAtA []byte) ([]byte, error) {
 	if m == nil {
-		return nil, nil
+		return dAtA, nil
 	}
+	l := len(dAtA)
 	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
 	if err != nil {
-		return nil, err
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
 	}
-	return dAtA[:n], nil
+	return dAtA, nil
 }
 
-func (m *JspbEncodingConfig) MarshalToVT(dAtA []byte) (int, error) {
+func (m *FailureSet) MarshalDelimitedVT() (dAtA []byte, err error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
 }
 
-func (m *JspbEncodingConfig) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *FailureSet) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -881,218 +1800,2343 @@ func (m *JspbEncodingConfig) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	_ = l
 	if m.unknownFields != nil {
 		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.UseJspbArrayAnyFormat {
-		i--
-		if m.UseJspbArrayAnyFormat {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Failure) > 0 {
+		for iNdEx := len(m.Failure) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Failure[iNdEx])
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], m.Failure[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.Failure[iNdEx])))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *FailureSet) SizeVT() (n int) {
+func (m *ConformanceRequest) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
+		return nil, nil
 	}
-	var l int
-	_ = l
-	if len(m.Failure) > 0 {
-		for _, s := range m.Failure {
-			l = len(s)
-			n += 1 + l + sov(uint64(l))
-		}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
 }
 
-func (m *ConformanceRequest) SizeVT() (n int) {
-	if m == nil {
-		return 0
+func (m *ConformanceRequest) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
 	}
-	var l int
-	_ = l
-	if vtmsg, ok := m.Payload.(interface{ SizeVT() int }); ok {
-		n += vtmsg.SizeVT()
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
 	}
-	if m.RequestedOutputFormat != 0 {
-		n += 1 + sov(uint64(m.RequestedOutputFormat))
+	if n != size {
+		return 0, ErrSizeMismatch
 	}
-	l = len(m.MessageType)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	return n, nil
+}
+
+func (m *ConformanceRequest) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
 	}
-	if m.TestCategory != 0 {
-		n += 1 + sov(uint64(m.TestCategory))
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
 	}
-	if m.JspbEncodingOptions != nil {
-		l = m.JspbEncodingOptions.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
 	}
-	if m.PrintUnknownFields {
-		n += 2
+	return dAtA, nil
+}
+
+func (m *ConformanceRequest) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
 }
 
-func (m *ConformanceRequest_ProtobufPayload) SizeVT() (n int) {
+func (m *ConformanceRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ProtobufPayload)
-	n += 1 + l + sov(uint64(l))
-	return n
-}
-func (m *ConformanceRequest_JsonPayload) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if vtmsg, ok := m.Payload.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if m.PrintUnknownFields {
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		if m.PrintUnknownFields {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x48
+	}
+	if m.JspbEncodingOptions != nil {
+		size, err := m.JspbEncodingOptions.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x32
+	}
+	if m.TestCategory != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.TestCategory))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x28
+	}
+	if len(m.MessageType) > 0 {
+		i -= len(m.MessageType)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.MessageType)
+		i = encodeVarint(dAtA, i, uint64(len(m.MessageType)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x22
+	}
+	if m.RequestedOutputFormat != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.RequestedOutputFormat))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x18
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConformanceRequest_ProtobufPayload) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *ConformanceRequest_ProtobufPayload) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ConformanceRequest_ProtobufPayload) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.ProtobufPayload)
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	copy(dAtA[i:], m.ProtobufPayload)
+	i = encodeVarint(dAtA, i, uint64(len(m.ProtobufPayload)))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i--
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceRequest_JsonPayload) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *ConformanceRequest_JsonPayload) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ConformanceRequest_JsonPayload) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.JsonPayload)
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	copy(dAtA[i:], m.JsonPayload)
+	i = encodeVarint(dAtA, i, uint64(len(m.JsonPayload)))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i--
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceRequest_JspbPayload) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *ConformanceRequest_JspbPayload) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ConformanceRequest_JspbPayload) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.JspbPayload)
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	copy(dAtA[i:], m.JspbPayload)
+	i = encodeVarint(dAtA, i, uint64(len(m.JspbPayload)))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i--
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0x3a
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceRequest_TextPayload) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *ConformanceRequest_TextPayload) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ConformanceRequest_TextPayload) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.TextPayload)
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	copy(dAtA[i:], m.TextPayload)
+	i = encodeVarint(dAtA, i, uint64(len(m.TextPayload)))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i--
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0x42
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceResponse) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ConformanceResponse) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *ConformanceResponse) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ConformanceResponse) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ConformanceResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if vtmsg, ok := m.Result.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConformanceResponse_ParseError) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *ConformanceResponse_ParseError) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ConformanceResponse_ParseError) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.ParseError)
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	copy(dAtA[i:], m.ParseError)
+	i = encodeVarint(dAtA, i, uint64(len(m.ParseError)))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i--
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceResponse_RuntimeError) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *ConformanceResponse_RuntimeError) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ConformanceResponse_RuntimeError) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.RuntimeError)
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	copy(dAtA[i:], m.RuntimeError)
+	i = encodeVarint(dAtA, i, uint64(len(m.RuntimeError)))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i--
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceResponse_ProtobufPayload) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *ConformanceResponse_ProtobufPayload) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ConformanceResponse_ProtobufPayload) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.ProtobufPayload)
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	copy(dAtA[i:], m.ProtobufPayload)
+	i = encodeVarint(dAtA, i, uint64(len(m.ProtobufPayload)))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i--
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceResponse_JsonPayload) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *ConformanceResponse_JsonPayload) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ConformanceResponse_JsonPayload) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.JsonPayload)
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	copy(dAtA[i:], m.JsonPayload)
+	i = encodeVarint(dAtA, i, uint64(len(m.JsonPayload)))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i--
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceResponse_Skipped) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *ConformanceResponse_Skipped) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ConformanceResponse_Skipped) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Skipped)
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	copy(dAtA[i:], m.Skipped)
+	i = encodeVarint(dAtA, i, uint64(len(m.Skipped)))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i--
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceResponse_SerializeError) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *ConformanceResponse_SerializeError) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ConformanceResponse_SerializeError) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.SerializeError)
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	copy(dAtA[i:], m.SerializeError)
+	i = encodeVarint(dAtA, i, uint64(len(m.SerializeError)))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i--
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0x32
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceResponse_JspbPayload) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *ConformanceResponse_JspbPayload) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ConformanceResponse_JspbPayload) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.JspbPayload)
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	copy(dAtA[i:], m.JspbPayload)
+	i = encodeVarint(dAtA, i, uint64(len(m.JspbPayload)))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i--
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0x3a
+	return len(dAtA) - i, nil
+}
+func (m *ConformanceResponse_TextPayload) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *ConformanceResponse_TextPayload) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ConformanceResponse_TextPayload) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.TextPayload)
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	copy(dAtA[i:], m.TextPayload)
+	i = encodeVarint(dAtA, i, uint64(len(m.TextPayload)))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i--
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0x42
+	return len(dAtA) - i, nil
+}
+func (m *JspbEncodingConfig) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *JspbEncodingConfig) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *JspbEncodingConfig) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *JspbEncodingConfig) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *JspbEncodingConfig) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.UseJspbArrayAnyFormat {
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		if m.UseJspbArrayAnyFormat {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailureSet) MarshalVTPooled() (*vtproto.Buffer, error) {
+	size := m.SizeVT()
+	buf := vtproto.NewBuffer(size)
+	n, err := m.MarshalToSizedBufferVT(buf.Bytes())
+	if err == nil && n != size {
+		err = ErrSizeMismatch
+	}
+	if err != nil {
+		buf.Free()
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (m *ConformanceRequest) MarshalVTPooled() (*vtproto.Buffer, error) {
+	size := m.SizeVT()
+	buf := vtproto.NewBuffer(size)
+	n, err := m.MarshalToSizedBufferVT(buf.Bytes())
+	if err == nil && n != size {
+		err = ErrSizeMismatch
+	}
+	if err != nil {
+		buf.Free()
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (m *ConformanceResponse) MarshalVTPooled() (*vtproto.Buffer, error) {
+	size := m.SizeVT()
+	buf := vtproto.NewBuffer(size)
+	n, err := m.MarshalToSizedBufferVT(buf.Bytes())
+	if err == nil && n != size {
+		err = ErrSizeMismatch
+	}
+	if err != nil {
+		buf.Free()
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (m *JspbEncodingConfig) MarshalVTPooled() (*vtproto.Buffer, error) {
+	size := m.SizeVT()
+	buf := vtproto.NewBuffer(size)
+	n, err := m.MarshalToSizedBufferVT(buf.Bytes())
+	if err == nil && n != size {
+		err = ErrSizeMismatch
+	}
+	if err != nil {
+		buf.Free()
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (m *FailureSet) MergeVT(src *FailureSet) {
+	if m == nil || src == nil {
+		return
+	}
+	m.Failure = append(m.Failure, src.Failure...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *FailureSet) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*FailureSet); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *ConformanceRequest) MergeVT(src *ConformanceRequest) {
+	if m == nil || src == nil {
+		return
+	}
+	switch srcOneof := src.Payload.(type) {
+	case *ConformanceRequest_ProtobufPayload:
+		m.Payload = &ConformanceRequest_ProtobufPayload{ProtobufPayload: append([]byte{}, srcOneof.ProtobufPayload...)}
+	case *ConformanceRequest_JsonPayload:
+		m.Payload = &ConformanceRequest_JsonPayload{JsonPayload: srcOneof.JsonPayload}
+	case *ConformanceRequest_JspbPayload:
+		m.Payload = &ConformanceRequest_JspbPayload{JspbPayload: srcOneof.JspbPayload}
+	case *ConformanceRequest_TextPayload:
+		m.Payload = &ConformanceRequest_TextPayload{TextPayload: srcOneof.TextPayload}
+	}
+	if src.RequestedOutputFormat != 0 {
+		m.RequestedOutputFormat = src.RequestedOutputFormat
+	}
+	if src.MessageType != "" {
+		m.MessageType = src.MessageType
+	}
+	if src.TestCategory != 0 {
+		m.TestCategory = src.TestCategory
+	}
+	if src.JspbEncodingOptions != nil {
+		if m.JspbEncodingOptions == nil {
+			m.JspbEncodingOptions = &JspbEncodingConfig{}
+		}
+		m.JspbEncodingOptions.MergeVT(src.JspbEncodingOptions)
+	}
+	if src.PrintUnknownFields {
+		m.PrintUnknownFields = true
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *ConformanceRequest) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*ConformanceRequest); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *ConformanceResponse) MergeVT(src *ConformanceResponse) {
+	if m == nil || src == nil {
+		return
+	}
+	switch srcOneof := src.Result.(type) {
+	case *ConformanceResponse_ParseError:
+		m.Result = &ConformanceResponse_ParseError{ParseError: srcOneof.ParseError}
+	case *ConformanceResponse_SerializeError:
+		m.Result = &ConformanceResponse_SerializeError{SerializeError: srcOneof.SerializeError}
+	case *ConformanceResponse_RuntimeError:
+		m.Result = &ConformanceResponse_RuntimeError{RuntimeError: srcOneof.RuntimeError}
+	case *ConformanceResponse_ProtobufPayload:
+		m.Result = &ConformanceResponse_ProtobufPayload{ProtobufPayload: append([]byte{}, srcOneof.ProtobufPayload...)}
+	case *ConformanceResponse_JsonPayload:
+		m.Result = &ConformanceResponse_JsonPayload{JsonPayload: srcOneof.JsonPayload}
+	case *ConformanceResponse_Skipped:
+		m.Result = &ConformanceResponse_Skipped{Skipped: srcOneof.Skipped}
+	case *ConformanceResponse_JspbPayload:
+		m.Result = &ConformanceResponse_JspbPayload{JspbPayload: srcOneof.JspbPayload}
+	case *ConformanceResponse_TextPayload:
+		m.Result = &ConformanceResponse_TextPayload{TextPayload: srcOneof.TextPayload}
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *ConformanceResponse) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*ConformanceResponse); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *JspbEncodingConfig) MergeVT(src *JspbEncodingConfig) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.UseJspbArrayAnyFormat {
+		m.UseJspbArrayAnyFormat = true
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *JspbEncodingConfig) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*JspbEncodingConfig); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *FailureSet) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Failure) > 0 {
+		for _, s := range m.Failure {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *ConformanceRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if vtmsg, ok := m.Payload.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	if m.RequestedOutputFormat != 0 {
+		n += 1 + sov(uint64(m.RequestedOutputFormat))
+	}
+	l = len(m.MessageType)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.TestCategory != 0 {
+		n += 1 + sov(uint64(m.TestCategory))
+	}
+	if m.JspbEncodingOptions != nil {
+		l = m.JspbEncodingOptions.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.PrintUnknownFields {
+		n += 2
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *ConformanceRequest_ProtobufPayload) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProtobufPayload)
+	n += 1 + l + sov(uint64(l))
+	return n
+}
+func (m *ConformanceRequest_JsonPayload) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JsonPayload)
+	n += 1 + l + sov(uint64(l))
+	return n
+}
+func (m *ConformanceRequest_JspbPayload) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JspbPayload)
+	n += 1 + l + sov(uint64(l))
+	return n
+}
+func (m *ConformanceRequest_TextPayload) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TextPayload)
+	n += 1 + l + sov(uint64(l))
+	return n
+}
+func (m *ConformanceResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if vtmsg, ok := m.Result.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *ConformanceResponse_ParseError) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ParseError)
+	n += 1 + l + sov(uint64(l))
+	return n
+}
+func (m *ConformanceResponse_RuntimeError) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RuntimeError)
+	n += 1 + l + sov(uint64(l))
+	return n
+}
+func (m *ConformanceResponse_ProtobufPayload) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProtobufPayload)
+	n += 1 + l + sov(uint64(l))
+	return n
+}
+func (m *ConformanceResponse_JsonPayload) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JsonPayload)
+	n += 1 + l + sov(uint64(l))
+	return n
+}
+func (m *ConformanceResponse_Skipped) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Skipped)
+	n += 1 + l + sov(uint64(l))
+	return n
+}
+func (m *ConformanceResponse_SerializeError) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SerializeError)
+	n += 1 + l + sov(uint64(l))
+	return n
+}
+func (m *ConformanceResponse_JspbPayload) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JspbPayload)
+	n += 1 + l + sov(uint64(l))
+	return n
+}
+func (m *ConformanceResponse_TextPayload) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TextPayload)
+	n += 1 + l + sov(uint64(l))
+	return n
+}
+func (m *JspbEncodingConfig) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UseJspbArrayAnyFormat {
+		n += 2
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *FailureSet) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *FailureSet) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *FailureSet) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *FailureSet) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*FailureSet)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailureSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailureSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.Failure = append(m.Failure, string(string(dAtA[iNdEx:postIndex])))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConformanceRequest) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *ConformanceRequest) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *ConformanceRequest) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *ConformanceRequest) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*ConformanceRequest)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConformanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtobufPayload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Payload = &ConformanceRequest_ProtobufPayload{ProtobufPayload: v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonPayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.Payload = &ConformanceRequest_JsonPayload{JsonPayload: string(string(dAtA[iNdEx:postIndex]))}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedOutputFormat", wireType)
+			}
+			m.RequestedOutputFormat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedOutputFormat |= WireFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.MessageType = string(string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TestCategory", wireType)
+			}
+			m.TestCategory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TestCategory |= TestCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JspbEncodingOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JspbEncodingOptions == nil {
+				m.JspbEncodingOptions = &JspbEncodingConfig{}
+			}
+			if err := m.JspbEncodingOptions.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JspbPayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.Payload = &ConformanceRequest_JspbPayload{JspbPayload: string(string(dAtA[iNdEx:postIndex]))}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TextPayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.Payload = &ConformanceRequest_TextPayload{TextPayload: string(string(dAtA[iNdEx:postIndex]))}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrintUnknownFields", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PrintUnknownFields = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConformanceResponse) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *ConformanceResponse) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *ConformanceResponse) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *ConformanceResponse) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*ConformanceResponse)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConformanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParseError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.Result = &ConformanceResponse_ParseError{ParseError: string(string(dAtA[iNdEx:postIndex]))}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.Result = &ConformanceResponse_RuntimeError{RuntimeError: string(string(dAtA[iNdEx:postIndex]))}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtobufPayload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Result = &ConformanceResponse_ProtobufPayload{ProtobufPayload: v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonPayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.Result = &ConformanceResponse_JsonPayload{JsonPayload: string(string(dAtA[iNdEx:postIndex]))}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.Result = &ConformanceResponse_Skipped{Skipped: string(string(dAtA[iNdEx:postIndex]))}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SerializeError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.Result = &ConformanceResponse_SerializeError{SerializeError: string(string(dAtA[iNdEx:postIndex]))}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JspbPayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.Result = &ConformanceResponse_JspbPayload{JspbPayload: string(string(dAtA[iNdEx:postIndex]))}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TextPayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.Result = &ConformanceResponse_TextPayload{TextPayload: string(string(dAtA[iNdEx:postIndex]))}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.JsonPayload)
-	n += 1 + l + sov(uint64(l))
-	return n
-}
-func (m *ConformanceRequest_JspbPayload) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = len(m.JspbPayload)
-	n += 1 + l + sov(uint64(l))
-	return n
+	return nil
 }
-func (m *ConformanceRequest_TextPayload) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TextPayload)
-	n += 1 + l + sov(uint64(l))
-	return n
+func (m *JspbEncodingConfig) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
 }
-func (m *ConformanceResponse) SizeVT() (n int) {
-	if m == nil {
-		return 0
+
+func (m *JspbEncodingConfig) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
 	}
-	var l int
-	_ = l
-	if vtmsg, ok := m.Result.(interface{ SizeVT() int }); ok {
-		n += vtmsg.SizeVT()
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
 	}
-	n += len(m.unknownFields)
-	return n
+	return m.unmarshalVT(dAtA, opts, depth)
 }
 
-func (m *ConformanceResponse_ParseError) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ParseError)
-	n += 1 + l + sov(uint64(l))
-	return n
-}
-func (m *ConformanceResponse_RuntimeError) SizeVT() (n int) {
-	if m == nil {
-		return 0
+func (m *JspbEncodingConfig) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
 	}
-	var l int
-	_ = l
-	l = len(m.RuntimeError)
-	n += 1 + l + sov(uint64(l))
-	return n
-}
-func (m *ConformanceResponse_ProtobufPayload) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = len(m.ProtobufPayload)
-	n += 1 + l + sov(uint64(l))
-	return n
-}
-func (m *ConformanceResponse_JsonPayload) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
 	}
-	var l int
-	_ = l
-	l = len(m.JsonPayload)
-	n += 1 + l + sov(uint64(l))
-	return n
+	return end, nil
 }
-func (m *ConformanceResponse_Skipped) SizeVT() (n int) {
-	if m == nil {
-		return 0
+
+func (m *JspbEncodingConfig) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
 	}
-	var l int
-	_ = l
-	l = len(m.Skipped)
-	n += 1 + l + sov(uint64(l))
-	return n
-}
-func (m *ConformanceResponse_SerializeError) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*JspbEncodingConfig)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JspbEncodingConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JspbEncodingConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseJspbArrayAnyFormat", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseJspbArrayAnyFormat = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.SerializeError)
-	n += 1 + l + sov(uint64(l))
-	return n
-}
-func (m *ConformanceResponse_JspbPayload) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = len(m.JspbPayload)
-	n += 1 + l + sov(uint64(l))
-	return n
+	return nil
 }
-func (m *ConformanceResponse_TextPayload) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TextPayload)
-	n += 1 + l + sov(uint64(l))
-	return n
+func (m *FailureSet) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
 }
-func (m *JspbEncodingConfig) SizeVT() (n int) {
-	if m == nil {
-		return 0
+
+func (m *FailureSet) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
 	}
-	var l int
-	_ = l
-	if m.UseJspbArrayAnyFormat {
-		n += 2
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
 	}
-	n += len(m.unknownFields)
-	return n
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
 }
 
-func (m *FailureSet) UnmarshalVT(dAtA []byte) error {
+func (m *FailureSet) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*FailureSet)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -1147,7 +4191,14 @@ func (m *FailureSet) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Failure = append(m.Failure, string(dAtA[iNdEx:postIndex]))
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.Failure = append(m.Failure, string(stringValue))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1161,21 +4212,49 @@ func (m *FailureSet) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ConformanceRequest) UnmarshalVT(dAtA []byte) error {
+func (m *ConformanceRequest) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *ConformanceRequest) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *ConformanceRequest) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*ConformanceRequest)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -1229,9 +4308,7 @@ func (m *ConformanceRequest) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Payload = &ConformanceRequest_ProtobufPayload{ProtobufPayload: v}
+			m.Payload = &ConformanceRequest_ProtobufPayload{ProtobufPayload: dAtA[iNdEx:postIndex:postIndex]}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1263,7 +4340,14 @@ func (m *ConformanceRequest) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = &ConformanceRequest_JsonPayload{JsonPayload: string(dAtA[iNdEx:postIndex])}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.Payload = &ConformanceRequest_JsonPayload{JsonPayload: string(stringValue)}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
@@ -1314,7 +4398,14 @@ func (m *ConformanceRequest) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MessageType = string(dAtA[iNdEx:postIndex])
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.MessageType = string(stringValue)
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
@@ -1367,7 +4458,7 @@ func (m *ConformanceRequest) UnmarshalVT(dAtA []byte) error {
 			if m.JspbEncodingOptions == nil {
 				m.JspbEncodingOptions = &JspbEncodingConfig{}
 			}
-			if err := m.JspbEncodingOptions.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JspbEncodingOptions.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1401,7 +4492,14 @@ func (m *ConformanceRequest) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = &ConformanceRequest_JspbPayload{JspbPayload: string(dAtA[iNdEx:postIndex])}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.Payload = &ConformanceRequest_JspbPayload{JspbPayload: string(stringValue)}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
@@ -1433,7 +4531,14 @@ func (m *ConformanceRequest) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = &ConformanceRequest_TextPayload{TextPayload: string(dAtA[iNdEx:postIndex])}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.Payload = &ConformanceRequest_TextPayload{TextPayload: string(stringValue)}
 			iNdEx = postIndex
 		case 9:
 			if wireType != 0 {
@@ -1467,21 +4572,49 @@ func (m *ConformanceRequest) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ConformanceResponse) UnmarshalVT(dAtA []byte) error {
+func (m *ConformanceResponse) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *ConformanceResponse) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *ConformanceResponse) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*ConformanceResponse)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -1536,7 +4669,14 @@ func (m *ConformanceResponse) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = &ConformanceResponse_ParseError{ParseError: string(dAtA[iNdEx:postIndex])}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.Result = &ConformanceResponse_ParseError{ParseError: string(stringValue)}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1568,7 +4708,14 @@ func (m *ConformanceResponse) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = &ConformanceResponse_RuntimeError{RuntimeError: string(dAtA[iNdEx:postIndex])}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.Result = &ConformanceResponse_RuntimeError{RuntimeError: string(stringValue)}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -1599,9 +4746,7 @@ func (m *ConformanceResponse) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Result = &ConformanceResponse_ProtobufPayload{ProtobufPayload: v}
+			m.Result = &ConformanceResponse_ProtobufPayload{ProtobufPayload: dAtA[iNdEx:postIndex:postIndex]}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -1633,7 +4778,14 @@ func (m *ConformanceResponse) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = &ConformanceResponse_JsonPayload{JsonPayload: string(dAtA[iNdEx:postIndex])}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.Result = &ConformanceResponse_JsonPayload{JsonPayload: string(stringValue)}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
@@ -1665,7 +4817,14 @@ func (m *ConformanceResponse) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = &ConformanceResponse_Skipped{Skipped: string(dAtA[iNdEx:postIndex])}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.Result = &ConformanceResponse_Skipped{Skipped: string(stringValue)}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
@@ -1697,7 +4856,14 @@ func (m *ConformanceResponse) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = &ConformanceResponse_SerializeError{SerializeError: string(dAtA[iNdEx:postIndex])}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.Result = &ConformanceResponse_SerializeError{SerializeError: string(stringValue)}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
@@ -1729,7 +4895,14 @@ func (m *ConformanceResponse) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = &ConformanceResponse_JspbPayload{JspbPayload: string(dAtA[iNdEx:postIndex])}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.Result = &ConformanceResponse_JspbPayload{JspbPayload: string(stringValue)}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
@@ -1761,7 +4934,14 @@ func (m *ConformanceResponse) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Result = &ConformanceResponse_TextPayload{TextPayload: string(dAtA[iNdEx:postIndex])}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.Result = &ConformanceResponse_TextPayload{TextPayload: string(stringValue)}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1775,21 +4955,49 @@ func (m *ConformanceResponse) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *JspbEncodingConfig) UnmarshalVT(dAtA []byte) error {
+func (m *JspbEncodingConfig) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *JspbEncodingConfig) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *JspbEncodingConfig) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*JspbEncodingConfig)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -1846,10 +5054,13 @@ func (m *JspbEncodingConfig) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -0,0 +1,123 @@
+package conformance
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+func delimitedMessages() []*TestAllTypesProto3 {
+	full := testHashMessage()
+	MutateFields(full)
+	return []*TestAllTypesProto3{testHashMessage(), {}, full, {OptionalInt32: 42}}
+}
+
+func TestDelimitedWriter(t *testing.T) {
+	msgs := delimitedMessages()
+
+	var b bytes.Buffer
+	w := vtproto.NewDelimitedWriter(&b)
+	for _, msg := range msgs {
+		require.NoError(t, w.WriteMsg(msg))
+	}
+	// messages without the generated methods are supported too
+	require.NoError(t, w.WriteMsg(wrapperspb.String("hello")))
+
+	r := bufio.NewReader(&b)
+	for _, msg := range msgs {
+		decoded := &TestAllTypesProto3{}
+		require.NoError(t, protodelim.UnmarshalFrom(r, decoded))
+		require.True(t, proto.Equal(msg, decoded))
+	}
+	str := &wrapperspb.StringValue{}
+	require.NoError(t, protodelim.UnmarshalFrom(r, str))
+	require.Equal(t, "hello", str.Value)
+	require.Equal(t, io.EOF, protodelim.UnmarshalFrom(r, str))
+}
+
+func TestDelimitedReader(t *testing.T) {
+	msgs := delimitedMessages()
+
+	var b bytes.Buffer
+	for _, msg := range msgs {
+		_, err := protodelim.MarshalTo(&b, msg)
+		require.NoError(t, err)
+	}
+	_, err := protodelim.MarshalTo(&b, wrapperspb.String("hello"))
+	require.NoError(t, err)
+
+	r := vtproto.NewDelimitedReader(&b)
+	decoded := &TestAllTypesProto3{}
+	for _, msg := range msgs {
+		// the message is reset before reading each record
+		require.NoError(t, r.ReadMsg(decoded))
+		require.True(t, proto.Equal(msg, decoded))
+	}
+	str := &wrapperspb.StringValue{}
+	require.NoError(t, r.ReadMsg(str))
+	require.Equal(t, "hello", str.Value)
+	require.Equal(t, io.EOF, r.ReadMsg(str))
+}
+
+func TestDelimitedReaderErrors(t *testing.T) {
+	msg := testHashMessage()
+	encoded, err := msg.MarshalDelimitedVT()
+	require.NoError(t, err)
+	size := msg.SizeVT()
+
+	for l := 1; l < len(encoded); l++ {
+		r := vtproto.NewDelimitedReader(bytes.NewReader(encoded[:l]))
+		require.Equal(t, io.ErrUnexpectedEOF, r.ReadMsg(&TestAllTypesProto3{}), "length %d", l)
+	}
+
+	r := vtproto.NewDelimitedReader(bytes.NewReader(encoded))
+	r.MaxSize = size - 1
+	var tooLarge *protodelim.SizeTooLargeError
+	require.True(t, errors.As(r.ReadMsg(&TestAllTypesProto3{}), &tooLarge))
+	require.Equal(t, uint64(size), tooLarge.Size)
+
+	r = vtproto.NewDelimitedReader(bytes.NewReader(encoded))
+	r.MaxSize = size
+	require.NoError(t, r.ReadMsg(&TestAllTypesProto3{}))
+}
+
+func TestMarshalDelimitedVT(t *testing.T) {
+	var b []byte
+	for _, msg := range delimitedMessages() {
+		encoded, err := msg.MarshalDelimitedVT()
+		require.NoError(t, err)
+		b = append(b, encoded...)
+	}
+	var msg *TestAllTypesProto3
+	encoded, err := msg.MarshalDelimitedVT()
+	require.NoError(t, err)
+	require.Equal(t, []byte{0}, encoded)
+	b = append(b, encoded...)
+
+	for _, msg := range append(delimitedMessages(), &TestAllTypesProto3{}) {
+		expected, err := protodelim.MarshalTo(io.Discard, msg)
+		require.NoError(t, err)
+
+		decoded := &TestAllTypesProto3{}
+		n, err := decoded.UnmarshalDelimitedVT(b)
+		require.NoError(t, err)
+		require.Equal(t, expected, n)
+		require.True(t, proto.Equal(msg, decoded))
+		b = b[n:]
+	}
+	require.Empty(t, b)
+
+	_, err = (&TestAllTypesProto3{}).UnmarshalDelimitedVT(nil)
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+	_, err = (&TestAllTypesProto3{}).UnmarshalDelimitedVT([]byte{3, 0x08})
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+}
@@ -0,0 +1,95 @@
+package conformance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+func TestDiffVTEqual(t *testing.T) {
+	for _, msg := range []*TestAllTypesProto3{
+		{},
+		testHashMessage(),
+		{RepeatedNestedMessage: []*TestAllTypesProto3_NestedMessage{nil}},
+		{MapStringNestedMessage: map[string]*TestAllTypesProto3_NestedMessage{"": nil}},
+	} {
+		clone := proto.Clone(msg).(*TestAllTypesProto3)
+		require.True(t, msg.EqualVT(clone))
+		require.Empty(t, msg.DiffVT(clone))
+
+		MutateFields(clone)
+		require.False(t, msg.EqualVT(clone))
+		require.NotEmpty(t, msg.DiffVT(clone))
+	}
+
+	var a, b *TestAllTypesProto3
+	require.Empty(t, a.DiffVT(b))
+}
+
+func TestDiffVT3(t *testing.T) {
+	old := testHashMessage()
+	new := testHashMessage()
+	new.OptionalInt32 = 43
+	new.OptionalNestedMessage.A = 2
+	new.RepeatedDouble = new.RepeatedDouble[:2]
+	new.RepeatedNestedMessage = append(new.RepeatedNestedMessage, &TestAllTypesProto3_NestedMessage{A: 5})
+	delete(new.MapStringString, "a")
+	new.MapStringString["b"] = "c"
+	new.MapStringString["c"] = "e"
+	new.MapStringNestedMessage["x"].Corecursive.OptionalInt64 = 6
+	new.OneofField = &TestAllTypesProto3_OneofUint32{OneofUint32: 7}
+	new.unknownFields = protowire.AppendTag(nil, 1337, protowire.VarintType)
+
+	require.Equal(t, []vtproto.FieldDiff{
+		{Path: "optional_int32", Kind: vtproto.FieldChanged, Old: int32(42), New: int32(43)},
+		{Path: "optional_nested_message.a", Kind: vtproto.FieldChanged, Old: int32(1), New: int32(2)},
+		{Path: "repeated_double[2]", Kind: vtproto.FieldRemoved, Old: float64(3)},
+		{Path: "repeated_nested_message[2]", Kind: vtproto.FieldAdded, New: &TestAllTypesProto3_NestedMessage{A: 5}},
+		{Path: `map_string_string["a"]`, Kind: vtproto.FieldRemoved, Old: "b"},
+		{Path: `map_string_string["b"]`, Kind: vtproto.FieldAdded, New: "c"},
+		{Path: `map_string_string["c"]`, Kind: vtproto.FieldChanged, Old: "d", New: "e"},
+		{Path: `map_string_nested_message["x"].corecursive.optional_int64`, Kind: vtproto.FieldChanged, Old: int64(5), New: int64(6)},
+		{Path: "oneof_uint32", Kind: vtproto.FieldAdded, New: uint32(7)},
+		{Path: "oneof_string", Kind: vtproto.FieldRemoved, Old: "oneof"},
+		{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: []byte(nil), New: new.unknownFields},
+	}, old.DiffVT(new))
+
+	// messages without DiffVT are compared with proto.Equal
+	new = testHashMessage()
+	new.OptionalStringWrapper = wrapperspb.String("changed")
+	diffs := old.DiffVT(new)
+	require.Len(t, diffs, 1)
+	require.Equal(t, "optional_string_wrapper", diffs[0].Path)
+	require.Equal(t, vtproto.FieldChanged, diffs[0].Kind)
+	require.True(t, proto.Equal(old.OptionalStringWrapper, diffs[0].Old.(proto.Message)))
+	require.True(t, proto.Equal(new.OptionalStringWrapper, diffs[0].New.(proto.Message)))
+}
+
+func TestDiffVT2(t *testing.T) {
+	old := &TestAllTypesProto2{
+		OptionalInt32:         proto.Int32(1),
+		OptionalBytes:         []byte{},
+		OptionalNestedMessage: &TestAllTypesProto2_NestedMessage{},
+		MapInt32Int32:         map[int32]int32{1: 1, 2: 2, 10: 10},
+	}
+	new := &TestAllTypesProto2{
+		OptionalString: proto.String(""),
+		MapInt32Int32:  map[int32]int32{1: 1, 2: 3, 10: 11},
+	}
+
+	require.Equal(t, []vtproto.FieldDiff{
+		{Path: "optional_int32", Kind: vtproto.FieldRemoved, Old: int32(1)},
+		{Path: "optional_string", Kind: vtproto.FieldAdded, New: ""},
+		{Path: "optional_bytes", Kind: vtproto.FieldRemoved, Old: []byte{}},
+		{Path: "optional_nested_message", Kind: vtproto.FieldRemoved, Old: &TestAllTypesProto2_NestedMessage{}},
+		{Path: "map_int32_int32[2]", Kind: vtproto.FieldChanged, Old: int32(2), New: int32(3)},
+		{Path: "map_int32_int32[10]", Kind: vtproto.FieldChanged, Old: int32(10), New: int32(11)},
+	}, old.DiffVT(new))
+
+	require.Equal(t, []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: new}}, (*TestAllTypesProto2)(nil).DiffVT(new))
+}
@@ -2,12 +2,15 @@ package conformance
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/planetscale/vtprotobuf/testproto/proto3opt"
+	"github.com/planetscale/vtprotobuf/vtproto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -367,3 +370,81 @@ func TestEqualVT_NilVsEmpty(t *testing.T) {
 		})
 	}
 }
+
+func TestEqualVTWithOptions(t *testing.T) {
+	nan := math.NaN()
+	unknown := protowire.AppendTag(nil, 1337, protowire.VarintType)
+	unknown = protowire.AppendVarint(unknown, 1)
+
+	for _, tc := range []struct {
+		name string
+		a, b *TestAllTypesProto3
+		opts vtproto.EqualOptions
+	}{
+		{
+			name: "unknown fields",
+			a:    &TestAllTypesProto3{OptionalInt32: 1, unknownFields: unknown},
+			b:    &TestAllTypesProto3{OptionalInt32: 1},
+			opts: vtproto.EqualOptions{IgnoreUnknown: true},
+		},
+		{
+			name: "nested unknown fields",
+			a:    &TestAllTypesProto3{OptionalNestedMessage: &TestAllTypesProto3_NestedMessage{A: 1, unknownFields: unknown}},
+			b:    &TestAllTypesProto3{OptionalNestedMessage: &TestAllTypesProto3_NestedMessage{A: 1}},
+			opts: vtproto.EqualOptions{IgnoreUnknown: true},
+		},
+		{
+			name: "NaN",
+			a:    &TestAllTypesProto3{OptionalDouble: nan, OptionalFloat: float32(nan), RepeatedDouble: []float64{1, nan}},
+			b:    &TestAllTypesProto3{OptionalDouble: nan, OptionalFloat: float32(nan), RepeatedDouble: []float64{1, nan}},
+			opts: vtproto.EqualOptions{NaNEqual: true},
+		},
+		{
+			name: "NaN in map",
+			a:    &TestAllTypesProto3{MapStringNestedMessage: map[string]*TestAllTypesProto3_NestedMessage{"a": {Corecursive: &TestAllTypesProto3{MapInt32Double: map[int32]float64{1: nan}}}}},
+			b:    &TestAllTypesProto3{MapStringNestedMessage: map[string]*TestAllTypesProto3_NestedMessage{"a": {Corecursive: &TestAllTypesProto3{MapInt32Double: map[int32]float64{1: nan}}}}},
+			opts: vtproto.EqualOptions{NaNEqual: true},
+		},
+		{
+			name: "NaN in oneof",
+			a:    &TestAllTypesProto3{OneofField: &TestAllTypesProto3_OneofDouble{OneofDouble: nan}},
+			b:    &TestAllTypesProto3{OneofField: &TestAllTypesProto3_OneofDouble{OneofDouble: nan}},
+			opts: vtproto.EqualOptions{NaNEqual: true},
+		},
+		{
+			name: "tolerance",
+			a:    &TestAllTypesProto3{OptionalDouble: 1, OptionalFloat: 1, RepeatedFloat: []float32{2}},
+			b:    &TestAllTypesProto3{OptionalDouble: 1.0001, OptionalFloat: 0.9999, RepeatedFloat: []float32{2.0001}},
+			opts: vtproto.EqualOptions{FloatTolerance: 0.001},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.False(t, tc.a.EqualVT(tc.b))
+			require.False(t, tc.a.EqualVTWithOptions(tc.b, vtproto.EqualOptions{}))
+			require.True(t, tc.a.EqualVTWithOptions(tc.b, tc.opts))
+			require.True(t, tc.b.EqualVTWithOptions(tc.a, tc.opts))
+		})
+	}
+
+	a := &TestAllTypesProto3{OptionalDouble: 1, OptionalFloat: 1}
+	b := &TestAllTypesProto3{OptionalDouble: 1.1, OptionalFloat: 1}
+	require.False(t, a.EqualVTWithOptions(b, vtproto.EqualOptions{FloatTolerance: 0.001}))
+	require.False(t, (&TestAllTypesProto3{OptionalDouble: nan}).EqualVTWithOptions(&TestAllTypesProto3{OptionalDouble: 1}, vtproto.EqualOptions{NaNEqual: true, FloatTolerance: math.Inf(1)}))
+}
+
+func TestEqualMessageVT(t *testing.T) {
+	a := &TestAllTypesProto3{OptionalInt32: 1, OptionalNestedMessage: &TestAllTypesProto3_NestedMessage{A: 2}}
+	b := proto.Clone(a)
+
+	var msg proto.Message = a
+	require.True(t, msg.(interface{ EqualMessageVT(proto.Message) bool }).EqualMessageVT(b))
+	require.False(t, a.EqualMessageVT(&TestAllTypesProto3{}))
+	require.False(t, a.EqualMessageVT(&TestAllTypesProto2{OptionalInt32: proto.Int32(1)}))
+	require.False(t, a.EqualMessageVT(nil))
+
+	require.True(t, vtproto.Equal(a, b))
+	require.False(t, vtproto.Equal(a, &TestAllTypesProto2{}))
+	require.True(t, vtproto.Equal(wrapperspb.String("a"), wrapperspb.String("a")))
+	require.False(t, vtproto.Equal(wrapperspb.String("a"), wrapperspb.String("b")))
+	require.False(t, vtproto.Equal(wrapperspb.String("a"), a))
+}
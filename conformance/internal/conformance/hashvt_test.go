@@ -0,0 +1,143 @@
+package conformance
+
+import (
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/planetscale/vtprotobuf/protohelpers"
+)
+
+func hashVT(m interface{ HashVT(hash.Hash64) }) uint64 {
+	h := fnv.New64a()
+	m.HashVT(h)
+	return h.Sum64()
+}
+
+func testHashMessage() *TestAllTypesProto3 {
+	return &TestAllTypesProto3{
+		OptionalInt32:          42,
+		OptionalString:         "blip",
+		OptionalBytes:          []byte("blop"),
+		OptionalNestedMessage:  &TestAllTypesProto3_NestedMessage{A: 1},
+		RepeatedDouble:         []float64{1, 2, 3},
+		RepeatedNestedMessage:  []*TestAllTypesProto3_NestedMessage{{A: 2}, {}},
+		MapStringString:        map[string]string{"a": "b", "c": "d", "e": "f", "g": "h"},
+		MapInt32Int32:          map[int32]int32{1: 3, 2: 4, 3: 0},
+		OneofField:             &TestAllTypesProto3_OneofString{OneofString: "oneof"},
+		OptionalStringWrapper:  wrapperspb.String("wrapped"),
+		OptionalValue:          structpb.NewStringValue("value"),
+		RepeatedValue:          []*structpb.Value{structpb.NewNumberValue(1), structpb.NewBoolValue(true)},
+		MapStringNestedMessage: map[string]*TestAllTypesProto3_NestedMessage{"x": {Corecursive: &TestAllTypesProto3{OptionalInt64: 5}}},
+	}
+}
+
+func TestHashVTEqual(t *testing.T) {
+	msgs := []*TestAllTypesProto3{
+		{},
+		testHashMessage(),
+		{OptionalFloat: float32(math.Copysign(0, -1))},
+		{RepeatedNestedMessage: []*TestAllTypesProto3_NestedMessage{nil}},
+		{MapStringNestedMessage: map[string]*TestAllTypesProto3_NestedMessage{"": nil}},
+	}
+	equal := []*TestAllTypesProto3{
+		{},
+		testHashMessage(),
+		{},
+		{RepeatedNestedMessage: []*TestAllTypesProto3_NestedMessage{{}}},
+		{MapStringNestedMessage: map[string]*TestAllTypesProto3_NestedMessage{"": {}}},
+	}
+
+	for i, msg := range msgs {
+		t.Run(fmt.Sprintf("%+v", msg), func(t *testing.T) {
+			require.True(t, msg.EqualVT(equal[i]))
+			require.Equal(t, hashVT(msg), hashVT(equal[i]))
+
+			// maps are ranged over in a different order every time
+			for j := 0; j < 10; j++ {
+				require.Equal(t, hashVT(msg), hashVT(msg.CloneVT()))
+			}
+		})
+	}
+}
+
+func TestHashVTDifferent(t *testing.T) {
+	base := testHashMessage()
+	hashes := map[uint64]string{hashVT(base): "base"}
+	for name, mutate := range map[string]func(m *TestAllTypesProto3){
+		"scalar":       func(m *TestAllTypesProto3) { m.OptionalInt32++ },
+		"list":         func(m *TestAllTypesProto3) { m.RepeatedDouble = m.RepeatedDouble[1:] },
+		"list order":   func(m *TestAllTypesProto3) { m.RepeatedDouble[0], m.RepeatedDouble[1] = 2, 1 },
+		"map value":    func(m *TestAllTypesProto3) { m.MapStringString["a"] = "c" },
+		"map key":      func(m *TestAllTypesProto3) { delete(m.MapStringString, "a"); m.MapStringString["b"] = "b" },
+		"nested":       func(m *TestAllTypesProto3) { m.OptionalNestedMessage.A++ },
+		"nested nil":   func(m *TestAllTypesProto3) { m.OptionalNestedMessage = nil },
+		"nested empty": func(m *TestAllTypesProto3) { m.OptionalNestedMessage = &TestAllTypesProto3_NestedMessage{} },
+		"oneof member": func(m *TestAllTypesProto3) {
+			m.OneofField = &TestAllTypesProto3_OneofBytes{OneofBytes: []byte("oneof")}
+		},
+		"oneof unset":   func(m *TestAllTypesProto3) { m.OneofField = nil },
+		"wrapper":       func(m *TestAllTypesProto3) { m.OptionalStringWrapper.Value = "changed" },
+		"value":         func(m *TestAllTypesProto3) { m.RepeatedValue[1] = structpb.NewBoolValue(false) },
+		"unknown field": func(m *TestAllTypesProto3) { m.unknownFields = protowire.AppendTag(nil, 1337, protowire.VarintType) },
+	} {
+		m := testHashMessage()
+		mutate(m)
+		require.False(t, base.EqualVT(m), name)
+
+		h := hashVT(m)
+		require.NotContains(t, hashes, h, "%s hashes like %s", name, hashes[h])
+		hashes[h] = name
+	}
+}
+
+func TestHashVTReflection(t *testing.T) {
+	ha, hb := fnv.New64a(), fnv.New64a()
+	protohelpers.HashMessage(ha, wrapperspb.String("wrapped"))
+	protohelpers.HashMessage(hb, proto.Clone(wrapperspb.String("wrapped")))
+	require.Equal(t, ha.Sum64(), hb.Sum64())
+
+	hb.Reset()
+	protohelpers.HashMessage(hb, wrapperspb.String("changed"))
+	require.NotEqual(t, ha.Sum64(), hb.Sum64())
+
+	for i := 0; i < 10; i++ {
+		ha.Reset()
+		hb.Reset()
+		protohelpers.HashMessage(ha, structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+			"a": structpb.NewNumberValue(1), "b": structpb.NewListValue(&structpb.ListValue{}), "c": structpb.NewNullValue(),
+		}}))
+		protohelpers.HashMessage(hb, structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+			"c": structpb.NewNullValue(), "b": structpb.NewListValue(&structpb.ListValue{}), "a": structpb.NewNumberValue(1),
+		}}))
+		require.Equal(t, ha.Sum64(), hb.Sum64())
+	}
+}
+
+func TestHashVTAllocs(t *testing.T) {
+	h := fnv.New64a()
+	msg := &TestAllTypesProto3{
+		OptionalInt32:         42,
+		OptionalString:        "blip",
+		OptionalBytes:         []byte("blop"),
+		OptionalNestedMessage: &TestAllTypesProto3_NestedMessage{A: 1},
+		RepeatedString:        []string{"a", "b", "c"},
+		RepeatedDouble:        []float64{1, 2, 3},
+		OneofField:            &TestAllTypesProto3_OneofString{OneofString: "oneof"},
+	}
+	// the scratch buffer shared by all the fields
+	require.Equal(t, 1.0, testing.AllocsPerRun(100, func() { msg.HashVT(h) }))
+
+	msg.MapStringString = map[string]string{"a": "b", "c": "d"}
+	msg.MapInt32Int32 = map[int32]int32{1: 3, 2: 4}
+	// and the hash of the map entries, shared by all the maps
+	require.Equal(t, 2.0, testing.AllocsPerRun(100, func() { msg.HashVT(h) }))
+}
@@ -0,0 +1,477 @@
+package conformance
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/planetscale/vtprotobuf/testproto/editions"
+	"github.com/planetscale/vtprotobuf/testproto/proto3opt"
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+var jsonOptions = []vtproto.JSONMarshalOptions{
+	{},
+	{EmitUnpopulated: true},
+	{UseProtoNames: true},
+	{EmitUnpopulated: true, UseProtoNames: true},
+}
+
+// requireSameJSON checks that msg is marshalled exactly like protojson does, once the
+// whitespace protojson randomly adds is removed.
+func requireSameJSON(t *testing.T, msg proto.Message) {
+	t.Helper()
+	for _, opts := range jsonOptions {
+		expected, err := protojson.MarshalOptions{
+			UseProtoNames:   opts.UseProtoNames,
+			EmitUnpopulated: opts.EmitUnpopulated,
+		}.Marshal(msg)
+		require.NoError(t, err)
+		var compact bytes.Buffer
+		require.NoError(t, json.Compact(&compact, expected))
+
+		got, err := opts.Marshal(msg)
+		require.NoError(t, err)
+		require.Equal(t, compact.String(), string(got), "%+v", opts)
+	}
+}
+
+func TestMarshalJSONVT3(t *testing.T) {
+	any, err := anypb.New(&TestAllTypesProto3{OptionalInt32: 1})
+	require.NoError(t, err)
+
+	full := testHashMessage()
+	MutateFields(full)
+	// a Value without kind cannot be marshalled
+	full.RepeatedValue = nil
+	full.MapStringNestedMessage["blip blop"].Corecursive.RepeatedValue = nil
+
+	for i, msg := range []*TestAllTypesProto3{
+		nil,
+		{},
+		testHashMessage(),
+		full,
+		{
+			OptionalInt64:           math.MinInt64,
+			OptionalUint64:          math.MaxUint64,
+			OptionalUint32:          math.MaxUint32,
+			OptionalSint32:          math.MinInt32,
+			OptionalString:          "\"quoted\"\n\t\\ \u0001 \u007f é 😀 <>&",
+			OptionalBytes:           []byte{0, 1, 2, 0xfe, 0xff},
+			OptionalNestedEnum:      TestAllTypesProto3_NEG,
+			OptionalForeignEnum:     ForeignEnum(42),
+			RepeatedNestedEnum:      []TestAllTypesProto3_NestedEnum{TestAllTypesProto3_FOO, 1337},
+			RepeatedFloat:           []float32{float32(math.Inf(1)), float32(math.NaN()), 1e-7, 1e21, 0.1, -3.5, 1e20},
+			RepeatedDouble:          []float64{math.Inf(-1), 1e-7, 1e-6, 1e21, 123456789.123, 5e-324, math.MaxFloat64},
+			MapBoolBool:             map[bool]bool{true: false, false: true},
+			MapInt64Int64:           map[int64]int64{-10: 1, 3: 2, -2: 3},
+			MapUint32Uint32:         map[uint32]uint32{10: 1, 3: 2},
+			MapStringNestedEnum:     map[string]TestAllTypesProto3_NestedEnum{"z": TestAllTypesProto3_BAZ, "a": 7},
+			MapStringForeignMessage: map[string]*ForeignMessage{"b": {C: 1}, "a": nil},
+			OneofField:              &TestAllTypesProto3_OneofNullValue{},
+			OptionalTimestamp:       &timestamppb.Timestamp{Seconds: 1700000000, Nanos: 120000000},
+			OptionalDuration:        &durationpb.Duration{Seconds: -1, Nanos: -500},
+			RepeatedTimestamp:       []*timestamppb.Timestamp{{}, {Seconds: -62135596800}, {Nanos: 1000}, {Nanos: 1}},
+			RepeatedDuration:        []*durationpb.Duration{{}, {Seconds: 3}, {Nanos: 1000000}},
+			OptionalFieldMask:       &fieldmaskpb.FieldMask{Paths: []string{"foo_bar", "baz"}},
+			OptionalStruct: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"null":   structpb.NewNullValue(),
+				"list":   structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewNumberValue(1.5), structpb.NewStringValue("x")}}),
+				"struct": structpb.NewStructValue(&structpb.Struct{}),
+			}},
+			OptionalAny:           any,
+			OptionalInt64Wrapper:  wrapperspb.Int64(-5),
+			OptionalUint64Wrapper: wrapperspb.UInt64(5),
+			OptionalFloatWrapper:  wrapperspb.Float(float32(math.NaN())),
+			OptionalBytesWrapper:  wrapperspb.Bytes([]byte("x")),
+			OptionalBoolWrapper:   wrapperspb.Bool(false),
+			RepeatedListValue:     []*structpb.ListValue{{}},
+			OptionalNullValue:     structpb.NullValue_NULL_VALUE,
+		},
+		{OptionalFloat: float32(math.Copysign(0, -1)), OptionalDouble: math.Copysign(0, -1)},
+		{OneofField: &TestAllTypesProto3_OneofNestedMessage{}},
+		{OneofField: &TestAllTypesProto3_OneofBytes{}},
+		{RepeatedNestedMessage: []*TestAllTypesProto3_NestedMessage{nil, {}}},
+		{OptionalNestedMessage: &TestAllTypesProto3_NestedMessage{Corecursive: &TestAllTypesProto3{}}},
+	} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			requireSameJSON(t, msg)
+		})
+	}
+}
+
+func TestMarshalJSONVT2(t *testing.T) {
+	ext := &TestAllTypesProto2{OptionalInt32: proto.Int32(1)}
+	proto.SetExtension(ext, E_ExtensionInt32, int32(42))
+
+	for i, msg := range []*TestAllTypesProto2{
+		{},
+		{
+			OptionalInt32:   proto.Int32(0),
+			OptionalString:  proto.String(""),
+			OptionalBytes:   []byte{},
+			DefaultInt32:    proto.Int32(-1),
+			RepeatedBool:    []bool{true, false},
+			Data:            &TestAllTypesProto2_Data{GroupInt32: proto.Int32(3)},
+			MapStringString: map[string]string{"b": "", "a": "x"},
+			OneofField:      &TestAllTypesProto2_OneofEnum{OneofEnum: TestAllTypesProto2_BAZ},
+		},
+		ext,
+	} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			requireSameJSON(t, msg)
+		})
+	}
+}
+
+func TestMarshalJSONVTPresence(t *testing.T) {
+	// unset proto3 optional fields are skipped even with EmitUnpopulated
+	got, err := vtproto.JSONMarshalOptions{EmitUnpopulated: true}.Marshal(&proto3opt.OptionalFieldInProto3{})
+	require.NoError(t, err)
+	require.Equal(t, "{}", string(got))
+
+	for i, msg := range []proto.Message{
+		&proto3opt.OptionalFieldInProto3{},
+		&proto3opt.OptionalFieldInProto3{OptionalInt32: proto.Int32(0), OptionalString: proto.String(""), OptionalBytes: []byte{}},
+		&editions.Editions{},
+		&editions.Editions{ExplicitInt32: proto.Int32(0), ExplicitString: proto.String(""), ImplicitInt32: 1},
+		&editions.Editions{Choice: &editions.Editions_OneofInt32{}},
+	} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			requireSameJSON(t, msg)
+		})
+	}
+}
+
+func TestMarshalJSONVTErrors(t *testing.T) {
+	for _, msg := range []*TestAllTypesProto3{
+		{OptionalString: "\xff"},
+		{MapStringString: map[string]string{"\xff": ""}},
+		{OptionalTimestamp: &timestamppb.Timestamp{Seconds: math.MaxInt64}},
+		{OptionalDuration: &durationpb.Duration{Seconds: 1, Nanos: -1}},
+		{OptionalValue: &structpb.Value{}},
+		{OptionalValue: structpb.NewNumberValue(math.NaN())},
+	} {
+		t.Run(fmt.Sprintf("%+v", msg), func(t *testing.T) {
+			_, err := protojson.Marshal(msg)
+			require.Error(t, err)
+			_, err = msg.MarshalJSONVT()
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestMarshalJSONVTMessage(t *testing.T) {
+	// messages without MarshalJSONToVT are marshalled with protojson
+	msg := &fieldmaskpb.FieldMask{Paths: []string{"a_b"}}
+	expected, err := protojson.Marshal(msg)
+	require.NoError(t, err)
+	got, err := vtproto.JSONMarshalOptions{}.Marshal(msg)
+	require.NoError(t, err)
+	require.JSONEq(t, string(expected), string(got))
+
+	got, err = testHashMessage().MarshalJSONVT()
+	require.NoError(t, err)
+	var decoded TestAllTypesProto3
+	require.NoError(t, protojson.Unmarshal(got, &decoded))
+	require.True(t, testHashMessage().EqualVT(&decoded))
+}
+
+// requireSameUnmarshalJSON checks that the JSON input is accepted by UnmarshalJSONVT
+// if and only if protojson accepts it, and that both produce the same message.
+func requireSameUnmarshalJSON(t *testing.T, input string, discardUnknown bool, newMsg func() proto.Message) {
+	t.Helper()
+	expected := newMsg()
+	expectedErr := protojson.UnmarshalOptions{DiscardUnknown: discardUnknown}.Unmarshal([]byte(input), expected)
+	got := newMsg()
+	err := vtproto.JSONUnmarshalOptions{DiscardUnknown: discardUnknown}.Unmarshal([]byte(input), got)
+	if expectedErr != nil {
+		require.Error(t, err, "protojson: %v", expectedErr)
+		return
+	}
+	require.NoError(t, err)
+	require.True(t, proto.Equal(expected, got), "expected %v\ngot %v", expected, got)
+}
+
+func TestUnmarshalJSONVT3(t *testing.T) {
+	newMsg := func() proto.Message { return &TestAllTypesProto3{} }
+
+	full := testHashMessage()
+	MutateFields(full)
+	full.RepeatedValue = nil
+	full.MapStringNestedMessage["blip blop"].Corecursive.RepeatedValue = nil
+
+	var inputs []string
+	for _, msg := range []*TestAllTypesProto3{testHashMessage(), full} {
+		for _, opts := range jsonOptions {
+			b, err := opts.Marshal(msg)
+			require.NoError(t, err)
+			inputs = append(inputs, string(b))
+		}
+	}
+	inputs = append(inputs,
+		``,
+		`{}`,
+		` { } `,
+		`{}{}`,
+		`{} x`,
+		`[]`,
+		`null`,
+		`{"optionalInt32": 1,}`,
+		`{"optionalInt32": 1 "optionalInt64": 2}`,
+		`{,"optionalInt32": 1}`,
+		`{"optionalInt32" 1}`,
+		`{"optionalInt32": 1`,
+		`{'optionalInt32': 1}`,
+		// names
+		`{"optionalInt32": 1, "optional_int64": "2"}`,
+		`{"optional_int32": 1, "optionalInt32": 2}`,
+		`{"optionalInt32": 1, "optionalInt32": null}`,
+		`{"fieldname1": 1, "fieldName2": 2, "FieldName3": 3, "field_name4": 4, "FIELDNAME5": 5, "fieldName12": 12, "FieldName13": 13}`,
+		`{"field0name5": 1, "field_0_name6": 2, "fieldName7": 3, "FieldName8": 4, "fieldName9": 5, "FieldName10": 6, "FIELDNAME11": 7}`,
+		`{"__field_name7": 1, "FieldName14": 2, "field_name15": 3, "field__name16": 4, "fieldName17": 5, "fieldName18__": 6}`,
+		`{"Field_name9": 1, "FIELD_NAME11": 2, "Field_Name10": 3, "fieldName14": 4, "fieldName15": 5, "FieldName18": 6}`,
+		`{"unknown": 1}`,
+		`{"unknown": {"a": [1, {"b": null}, "c\"", true]}, "optionalInt32": 1}`,
+		`{"unknown": {"a": }}`,
+		`{"unknown": [1 2]}`,
+		`{"[protobuf_test_messages.proto2.extension_int32]": 1}`,
+		// null
+		`{"optionalInt32": null, "optionalString": null, "optionalNestedMessage": null, "repeatedInt32": null, "mapStringString": null}`,
+		`{"optionalNestedEnum": null, "optionalTimestamp": null, "optionalBoolWrapper": null}`,
+		`{"optionalValue": null}`,
+		`{"optionalNullValue": null}`,
+		`{"optionalNullValue": "NULL_VALUE"}`,
+		`{"optionalNullValue": 0}`,
+		`{"repeatedValue": null}`,
+		`{"repeatedValue": [null, 1, "a", true, {}, []]}`,
+		`{"repeatedNullValue": [null]}`,
+		`{"repeatedInt32": [1, null]}`,
+		`{"repeatedNestedMessage": [{}, null]}`,
+		`{"mapStringNestedMessage": {"a": null}}`,
+		`{"mapStringValue": {"a": null}}`,
+		`{"optionalInt32": nul}`,
+		`{"optionalInt32": nullx}`,
+		// numbers
+		`{"optionalInt32": "1"}`,
+		`{"optionalInt32": " 1"}`,
+		`{"optionalInt32": "1 "}`,
+		`{"optionalInt32": 1.0}`,
+		`{"optionalInt32": "1.0"}`,
+		`{"optionalInt32": 1e2}`,
+		`{"optionalInt32": 1E-0}`,
+		`{"optionalInt32": 1.5}`,
+		`{"optionalInt32": 100e-2}`,
+		`{"optionalInt32": 0.1e1}`,
+		`{"optionalInt32": -0}`,
+		`{"optionalInt32": 01}`,
+		`{"optionalInt32": +1}`,
+		`{"optionalInt32": 1.}`,
+		`{"optionalInt32": .1}`,
+		`{"optionalInt32": 1e}`,
+		`{"optionalInt32": 1e+}`,
+		`{"optionalInt32": 2147483647}`,
+		`{"optionalInt32": 2147483648}`,
+		`{"optionalInt32": -2147483649}`,
+		`{"optionalInt32": 0x10}`,
+		`{"optionalInt32": true}`,
+		`{"optionalInt64": "9223372036854775807"}`,
+		`{"optionalInt64": 9223372036854775808}`,
+		`{"optionalInt64": -9223372036854775808}`,
+		`{"optionalUint32": -1}`,
+		`{"optionalUint32": 4294967295}`,
+		`{"optionalUint64": "18446744073709551615"}`,
+		`{"optionalUint64": 1.8446744073709551615e19}`,
+		`{"optionalSint32": -5, "optionalFixed32": 5, "optionalFixed64": "5", "optionalSfixed32": "-5", "optionalSfixed64": -5}`,
+		`{"optionalFloat": 1.5, "optionalDouble": "2.5"}`,
+		`{"optionalFloat": "NaN", "optionalDouble": "-Infinity"}`,
+		`{"optionalFloat": "Infinity", "optionalDouble": "1e308"}`,
+		`{"optionalFloat": 3.4e39}`,
+		`{"optionalDouble": 1e309}`,
+		`{"optionalDouble": "nan"}`,
+		`{"optionalDouble": -0}`,
+		`{"optionalDouble": " 1"}`,
+		// other scalars
+		`{"optionalBool": true, "optionalString": "é😀\"\\\/\b\f\n\r\t"}`,
+		`{"optionalBool": "true"}`,
+		`{"optionalBool": 1}`,
+		`{"optionalBool": tru}`,
+		`{"optionalString": 1}`,
+		`{"optionalString": "\ud83d"}`,
+		`{"optionalString": "\x"}`,
+		"{\"optionalString\": \"\xff\"}",
+		"{\"optionalString\": \"\t\"}",
+		`{"optionalBytes": "AAEC/w=="}`,
+		`{"optionalBytes": "AAEC/w"}`,
+		`{"optionalBytes": "AAEC_w"}`,
+		`{"optionalBytes": "AAEC_w=="}`,
+		`{"optionalBytes": ""}`,
+		`{"optionalBytes": "!"}`,
+		`{"optionalBytes": "A"}`,
+		// enums
+		`{"optionalNestedEnum": "BAR", "optionalForeignEnum": 2, "repeatedNestedEnum": ["FOO", 1, -1, 1337]}`,
+		`{"optionalNestedEnum": "bar"}`,
+		`{"optionalNestedEnum": "UNKNOWN"}`,
+		`{"repeatedNestedEnum": ["UNKNOWN", "BAZ"]}`,
+		`{"mapStringNestedEnum": {"a": "UNKNOWN", "b": "BAZ"}}`,
+		`{"oneofEnum": "UNKNOWN", "oneofUint32": 1}`,
+		`{"optionalNestedEnum": 1.0}`,
+		`{"optionalNestedEnum": "1"}`,
+		`{"optionalNestedEnum": true}`,
+		`{"optionalAliasedEnum": "ALIAS_BAZ", "repeatedForeignEnum": ["FOREIGN_BAR"]}`,
+		`{"optionalAliasedEnum": "qux"}`,
+		// oneofs
+		`{"oneofUint32": 1}`,
+		`{"oneofUint32": 1, "oneofString": "x"}`,
+		`{"oneofUint32": null, "oneofString": "x"}`,
+		`{"oneofNestedMessage": {"a": 1}}`,
+		`{"oneofNullValue": null}`,
+		`{"oneofNullValue": null, "oneofBool": true}`,
+		`{"oneof_uint32": 1, "oneofUint32": 1}`,
+		// messages, lists and maps
+		`{"optionalNestedMessage": {"a": 1, "corecursive": {"optionalInt32": 2}}}`,
+		`{"optionalNestedMessage": 1}`,
+		`{"optionalNestedMessage": []}`,
+		`{"recursiveMessage": {"recursiveMessage": {"optionalInt32": 3}}}`,
+		`{"repeatedInt32": [1, "2", 3.0], "repeatedString": [], "repeatedNestedMessage": [{"a": 1}, {}]}`,
+		`{"repeatedInt32": [1,]}`,
+		`{"repeatedInt32": [,1]}`,
+		`{"repeatedInt32": 1}`,
+		`{"repeatedInt32": {}}`,
+		`{"mapInt32Int32": {"1": 2, "-3": "4"}, "mapBoolBool": {"true": false}, "mapStringString": {}}`,
+		`{"mapInt64Int64": {"1": 2}, "mapUint32Uint32": {"1": 2}, "mapUint64Uint64": {"18446744073709551615": 2}}`,
+		`{"mapSint32Sint32": {"1": 2}, "mapFixed32Fixed32": {"1": 2}, "mapSfixed64Sfixed64": {"-1": 2}}`,
+		`{"mapInt32Int32": {"1": 2, "1": 3}}`,
+		`{"mapInt32Int32": {"01": 2}}`,
+		`{"mapInt32Int32": {"1.0": 2}}`,
+		`{"mapInt32Int32": {" 1": 2}}`,
+		`{"mapInt32Int32": {"2147483648": 2}}`,
+		`{"mapUint32Uint32": {"-1": 2}}`,
+		`{"mapBoolBool": {"True": false}}`,
+		`{"mapBoolBool": {"1": false}}`,
+		`{"mapStringString": {"a": null}}`,
+		`{"mapStringString": {"a": "b",}}`,
+		`{"mapStringNestedMessage": {"a": {"a": 1}}}`,
+		`{"mapStringBytes": {"a": "AA=="}, "mapStringFloat": {"a": "NaN"}, "mapStringDouble": {"b": 1}}`,
+		// well-known types
+		`{"optionalTimestamp": "1970-01-01T00:00:00Z"}`,
+		`{"optionalTimestamp": "2023-11-14T22:13:20.123456789+01:00"}`,
+		`{"optionalTimestamp": "2023-11-14T22:13:20.1234567891Z"}`,
+		`{"optionalTimestamp": "0001-01-01T00:00:00Z"}`,
+		`{"optionalTimestamp": "0000-12-31T23:59:59Z"}`,
+		`{"optionalTimestamp": "9999-12-31T23:59:59.999999999Z"}`,
+		`{"optionalTimestamp": "2023-11-14 22:13:20Z"}`,
+		`{"optionalTimestamp": "2023-11-14T22:13:20"}`,
+		`{"optionalTimestamp": 1}`,
+		`{"optionalDuration": "1s"}`,
+		`{"optionalDuration": "-1.5s"}`,
+		`{"optionalDuration": "0.000000001s"}`,
+		`{"optionalDuration": ".5s"}`,
+		`{"optionalDuration": "1.s"}`,
+		`{"optionalDuration": "1.0000000001s"}`,
+		`{"optionalDuration": "01s"}`,
+		`{"optionalDuration": "+1s"}`,
+		`{"optionalDuration": "1"}`,
+		`{"optionalDuration": "s"}`,
+		`{"optionalDuration": "-s"}`,
+		`{"optionalDuration": "315576000000s"}`,
+		`{"optionalDuration": "315576000001s"}`,
+		`{"optionalDuration": "-315576000000.999999999s"}`,
+		`{"optionalDuration": "1e2s"}`,
+		`{"optionalDuration": " 1s"}`,
+		`{"optionalBoolWrapper": false, "optionalInt32Wrapper": "1", "optionalInt64Wrapper": 1, "optionalUint32Wrapper": 1, "optionalUint64Wrapper": "1"}`,
+		`{"optionalFloatWrapper": "NaN", "optionalDoubleWrapper": 1, "optionalStringWrapper": "", "optionalBytesWrapper": ""}`,
+		`{"optionalBoolWrapper": {"value": true}}`,
+		`{"repeatedInt32Wrapper": [1, null]}`,
+		`{"repeatedInt32Wrapper": [1, "2"], "repeatedStringWrapper": ["a"]}`,
+		`{"optionalStruct": {"a": null, "b": [1, {"c": "d"}], "e": true, "f": 1.5}}`,
+		`{"optionalStruct": {"a": 1, "a": 2}}`,
+		`{"optionalStruct": []}`,
+		`{"optionalStruct": null}`,
+		`{"optionalValue": {"a": [null]}}`,
+		`{"optionalValue": []}`,
+		`{"optionalValue": 1e400}`,
+		`{"optionalValue": -}`,
+		`{"repeatedListValue": [[1, "a"], []]}`,
+		`{"repeatedStruct": [{}, {"a": {}}]}`,
+		`{"optionalEmpty": {}}`,
+		`{"optionalEmpty": {"a": 1}}`,
+		`{"optionalEmpty": []}`,
+		`{"optionalFieldMask": "fooBar,baz"}`,
+		`{"optionalFieldMask": "foo_bar"}`,
+		`{"optionalFieldMask": 1}`,
+		`{"optionalAny": {"@type": "type.googleapis.com/protobuf_test_messages.proto3.TestAllTypesProto3", "optionalInt32": 1}}`,
+		`{"optionalAny": {"@type": "type.googleapis.com/google.protobuf.Duration", "value": "1s"}}`,
+		`{"optionalAny": {"@type": "type.googleapis.com/protobuf_test_messages.proto3.TestAllTypesProto3", "unknown": 1}}`,
+		`{"optionalAny": {}}`,
+		`{"optionalAny": {"optionalInt32": 1}}`,
+	)
+
+	for i, input := range inputs {
+		for _, discardUnknown := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%d/%v", i, discardUnknown), func(t *testing.T) {
+				requireSameUnmarshalJSON(t, input, discardUnknown, newMsg)
+			})
+		}
+	}
+}
+
+func TestUnmarshalJSONVT2(t *testing.T) {
+	newMsg := func() proto.Message { return &TestAllTypesProto2{} }
+
+	for i, input := range []string{
+		`{}`,
+		`{"optionalInt32": 0, "optionalString": "", "optionalBytes": "", "optionalNestedEnum": "FOO"}`,
+		`{"defaultInt32": null}`,
+		`{"data": {"groupInt32": 1, "groupUint32": 2}}`,
+		`{"Data": {"group_int32": 1}}`,
+		`{"data": {}, "Data": {}}`,
+		`{"oneofEnum": "BAZ"}`,
+		`{"[protobuf_test_messages.proto2.extension_int32]": 1}`,
+		`{"[protobuf_test_messages.proto2.extension_int32]": "1", "optionalInt32": 2}`,
+		`{"[protobuf_test_messages.proto2.extension_int32]": 1, "[protobuf_test_messages.proto2.extension_int32]": 2}`,
+		`{"[protobuf_test_messages.proto2.extension_int32]": null}`,
+		`{"[protobuf_test_messages.proto2.extension_int32]": "x"}`,
+		`{"[protobuf_test_messages.proto2.unknown]": 1}`,
+		`{"[protobuf_test_messages.proto2.TestAllTypesProto2.MessageSetCorrectExtension1.message_set_extension]": {}}`,
+		`{"optionalInt32": 1, "extensionInt32": 1}`,
+	} {
+		for _, discardUnknown := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%d/%v", i, discardUnknown), func(t *testing.T) {
+				requireSameUnmarshalJSON(t, input, discardUnknown, newMsg)
+			})
+		}
+	}
+}
+
+func TestUnmarshalJSONVTRecursionLimit(t *testing.T) {
+	nested := func(depth int) []byte {
+		var b bytes.Buffer
+		for i := 0; i < depth; i++ {
+			b.WriteString(`{"recursiveMessage":`)
+		}
+		b.WriteString(`{}`)
+		for i := 0; i < depth; i++ {
+			b.WriteString(`}`)
+		}
+		return b.Bytes()
+	}
+	opts := vtproto.JSONUnmarshalOptions{RecursionLimit: 5}
+	require.NoError(t, opts.Unmarshal(nested(4), &TestAllTypesProto3{}))
+	require.Error(t, opts.Unmarshal(nested(5), &TestAllTypesProto3{}))
+	require.NoError(t, (&TestAllTypesProto3{}).UnmarshalJSONVT(nested(5000)))
+	require.Error(t, (&TestAllTypesProto3{}).UnmarshalJSONVT(nested(20000)))
+}
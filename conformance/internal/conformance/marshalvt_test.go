@@ -0,0 +1,161 @@
+package conformance
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	grpccodec "github.com/planetscale/vtprotobuf/codec/grpc"
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+// requireAppended checks that got is prefix followed by the encoding of msg. The
+// encoding is compared by decoding it, as map entries are not sorted.
+func requireAppended(t *testing.T, prefix []byte, msg *TestAllTypesProto3, got []byte) {
+	t.Helper()
+	require.Equal(t, string(prefix), string(got[:len(prefix)]))
+	require.Equal(t, msg.SizeVT(), len(got)-len(prefix))
+	decoded := &TestAllTypesProto3{}
+	require.NoError(t, decoded.UnmarshalVT(got[len(prefix):]))
+	require.True(t, proto.Equal(msg, decoded))
+}
+
+func TestMarshalAppendVT(t *testing.T) {
+	full := testHashMessage()
+	MutateFields(full)
+
+	for i, msg := range []*TestAllTypesProto3{{}, testHashMessage(), full} {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			got, err := msg.MarshalAppendVT(nil)
+			require.NoError(t, err)
+			requireAppended(t, nil, msg, got)
+
+			prefix := []byte("prefix")
+			got, err = msg.MarshalAppendVT(prefix)
+			require.NoError(t, err)
+			requireAppended(t, []byte("prefix"), msg, got)
+
+			// a buffer with enough capacity is not reallocated
+			size := msg.SizeVT()
+			buf := make([]byte, 3, 3+size)
+			got, err = msg.MarshalAppendVT(buf)
+			require.NoError(t, err)
+			requireAppended(t, make([]byte, 3), msg, got)
+			require.Same(t, &buf[0], &got[0])
+
+			got, err = grpccodec.Codec{}.MarshalAppend(prefix, msg)
+			require.NoError(t, err)
+			requireAppended(t, []byte("prefix"), msg, got)
+		})
+	}
+}
+
+func TestMarshalAppendVTNil(t *testing.T) {
+	var msg *TestAllTypesProto3
+	got, err := msg.MarshalAppendVT([]byte("prefix"))
+	require.NoError(t, err)
+	require.Equal(t, "prefix", string(got))
+}
+
+func TestMarshalAppendVTOneof(t *testing.T) {
+	oneof := &TestAllTypesProto3_OneofNestedMessage{OneofNestedMessage: &TestAllTypesProto3_NestedMessage{A: 1}}
+	expected, err := proto.Marshal(&TestAllTypesProto3{OneofField: oneof})
+	require.NoError(t, err)
+
+	got, err := oneof.MarshalAppendVT([]byte{1})
+	require.NoError(t, err)
+	require.Equal(t, append([]byte{1}, expected...), got)
+}
+
+func TestMarshalShortBuffer(t *testing.T) {
+	msg := testHashMessage()
+	MutateFields(msg)
+	size := msg.SizeVT()
+
+	for _, l := range []int{0, 1, size / 2, size - 1} {
+		n, err := msg.MarshalToVT(make([]byte, l))
+		require.ErrorIs(t, err, ErrBufferTooSmall)
+		require.Zero(t, n)
+
+		n, err = msg.MarshalToSizedBufferVT(make([]byte, l))
+		require.ErrorIs(t, err, ErrBufferTooSmall)
+		require.Zero(t, n)
+	}
+
+	buf := make([]byte, size+3)
+	n, err := msg.MarshalToVT(buf)
+	require.NoError(t, err)
+	require.Equal(t, size, n)
+	decoded := &TestAllTypesProto3{}
+	require.NoError(t, decoded.UnmarshalVT(buf[:n]))
+	require.True(t, proto.Equal(msg, decoded))
+}
+
+func TestMarshalShortBufferOneof(t *testing.T) {
+	oneof := &TestAllTypesProto3_OneofNestedMessage{OneofNestedMessage: &TestAllTypesProto3_NestedMessage{A: 1}}
+	size := oneof.SizeVT()
+
+	_, err := oneof.MarshalToVT(make([]byte, size-1))
+	require.ErrorIs(t, err, ErrBufferTooSmall)
+	_, err = oneof.MarshalToSizedBufferVT(make([]byte, size-1))
+	require.ErrorIs(t, err, ErrBufferTooSmall)
+
+	// the nested message does not fit in the buffer of its parent
+	msg := &TestAllTypesProto3{OneofField: oneof}
+	_, err = msg.MarshalToSizedBufferVT(make([]byte, msg.SizeVT()-1))
+	require.ErrorIs(t, err, ErrBufferTooSmall)
+}
+
+func TestMarshalVTPooled(t *testing.T) {
+	for i, msg := range delimitedMessages() {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			buf, err := msg.MarshalVTPooled()
+			require.NoError(t, err)
+			require.Equal(t, msg.SizeVT(), buf.Len())
+			decoded := &TestAllTypesProto3{}
+			require.NoError(t, decoded.UnmarshalVT(buf.Bytes()))
+			require.True(t, proto.Equal(msg, decoded))
+			buf.Free()
+		})
+	}
+
+	var msg *TestAllTypesProto3
+	buf, err := msg.MarshalVTPooled()
+	require.NoError(t, err)
+	require.Empty(t, buf.Bytes())
+	buf.Free()
+
+	// freeing a Buffer twice doesn't put its array back in the pool twice
+	buf, err = testHashMessage().MarshalVTPooled()
+	require.NoError(t, err)
+	buf.Free()
+	require.Nil(t, buf.Bytes())
+	buf.Free()
+
+	var nilBuf *vtproto.Buffer
+	nilBuf.Free()
+}
+
+func TestBufferPool(t *testing.T) {
+	for _, tc := range []struct{ length, cap int }{
+		{0, 64},
+		{1, 64},
+		{64, 64},
+		{65, 128},
+		{1000, 1024},
+		{1 << 24, 1 << 24},
+		{1<<24 + 1, 1<<24 + 1},
+	} {
+		buf := vtproto.GetBuffer(tc.length)
+		require.Len(t, *buf, tc.length)
+		require.Equal(t, tc.cap, cap(*buf), "length %d", tc.length)
+		vtproto.PutBuffer(buf)
+	}
+
+	// buffers that were not allocated by the pool are dropped
+	foreign := make([]byte, 100)
+	vtproto.PutBuffer(&foreign)
+	require.Equal(t, 128, cap(*vtproto.GetBuffer(100)))
+}
@@ -6,12 +6,21 @@ package conformance
 
 import (
 	binary "encoding/binary"
+	errors "errors"
 	fmt "fmt"
+	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
+	vtproto "github.com/planetscale/vtprotobuf/vtproto"
+	protowire "google.golang.org/protobuf/encoding/protowire"
 	proto "google.golang.org/protobuf/proto"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	hash "hash"
+	fnv "hash/fnv"
 	io "io"
 	math "math"
 	bits "math/bits"
+	sort "sort"
+	strconv "strconv"
+	unsafe "unsafe"
 )
 
 const (
@@ -43,6 +52,31 @@ func (m *TestAllTypesProto2_NestedMessage) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *TestAllTypesProto2_NestedMessage) CopyToVT(dst *TestAllTypesProto2_NestedMessage) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.A == nil {
+		dst.A = nil
+	} else {
+		if dst.A == nil {
+			dst.A = new(int32)
+		}
+		*dst.A = *m.A
+	}
+	if m.Corecursive == nil {
+		dst.Corecursive = nil
+	} else {
+		if dst.Corecursive == nil {
+			dst.Corecursive = m.Corecursive.CloneVT()
+		} else {
+			m.Corecursive.CopyToVT(dst.Corecursive)
+		}
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *TestAllTypesProto2_Data) CloneVT() *TestAllTypesProto2_Data {
 	if m == nil {
 		return (*TestAllTypesProto2_Data)(nil)
@@ -67,6 +101,30 @@ func (m *TestAllTypesProto2_Data) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *TestAllTypesProto2_Data) CopyToVT(dst *TestAllTypesProto2_Data) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.GroupInt32 == nil {
+		dst.GroupInt32 = nil
+	} else {
+		if dst.GroupInt32 == nil {
+			dst.GroupInt32 = new(int32)
+		}
+		*dst.GroupInt32 = *m.GroupInt32
+	}
+	if m.GroupUint32 == nil {
+		dst.GroupUint32 = nil
+	} else {
+		if dst.GroupUint32 == nil {
+			dst.GroupUint32 = new(uint32)
+		}
+		*dst.GroupUint32 = *m.GroupUint32
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *TestAllTypesProto2_MessageSetCorrect) CloneVT() *TestAllTypesProto2_MessageSetCorrect {
 	if m == nil {
 		return (*TestAllTypesProto2_MessageSetCorrect)(nil)
@@ -83,6 +141,14 @@ func (m *TestAllTypesProto2_MessageSetCorrect) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *TestAllTypesProto2_MessageSetCorrect) CopyToVT(dst *TestAllTypesProto2_MessageSetCorrect) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *TestAllTypesProto2_MessageSetCorrectExtension1) CloneVT() *TestAllTypesProto2_MessageSetCorrectExtension1 {
 	if m == nil {
 		return (*TestAllTypesProto2_MessageSetCorrectExtension1)(nil)
@@ -103,6 +169,22 @@ func (m *TestAllTypesProto2_MessageSetCorrectExtension1) CloneGenericVT() proto.
 	return m.CloneVT()
 }
 
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) CopyToVT(dst *TestAllTypesProto2_MessageSetCorrectExtension1) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.Str == nil {
+		dst.Str = nil
+	} else {
+		if dst.Str == nil {
+			dst.Str = new(string)
+		}
+		*dst.Str = *m.Str
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *TestAllTypesProto2_MessageSetCorrectExtension2) CloneVT() *TestAllTypesProto2_MessageSetCorrectExtension2 {
 	if m == nil {
 		return (*TestAllTypesProto2_MessageSetCorrectExtension2)(nil)
@@ -123,6 +205,22 @@ func (m *TestAllTypesProto2_MessageSetCorrectExtension2) CloneGenericVT() proto.
 	return m.CloneVT()
 }
 
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) CopyToVT(dst *TestAllTypesProto2_MessageSetCorrectExtension2) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.I == nil {
+		dst.I = nil
+	} else {
+		if dst.I == nil {
+			dst.I = new(int32)
+		}
+		*dst.I = *m.I
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *TestAllTypesProto2) CloneVT() *TestAllTypesProto2 {
 	if m == nil {
 		return (*TestAllTypesProto2)(nil)
@@ -736,6 +834,15 @@ func (m *TestAllTypesProto2) CloneVT() *TestAllTypesProto2 {
 		tmpVal := *rhs
 		r.FieldName18__ = &tmpVal
 	}
+	for num, x := range m.extensionFields {
+		switch num {
+		case 120:
+			rhs := proto.GetExtension(m, E_ExtensionInt32).(int32)
+			proto.SetExtension(r, E_ExtensionInt32, rhs)
+		default:
+			r.ProtoReflect().Set(x.Type().TypeDescriptor(), protohelpers.CloneExtension(x.Type(), x.Value()))
+		}
+	}
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
@@ -747,4342 +854,24470 @@ func (m *TestAllTypesProto2) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
-func (m *TestAllTypesProto2_OneofUint32) CloneVT() isTestAllTypesProto2_OneofField {
+func (m *TestAllTypesProto2) CopyToVT(dst *TestAllTypesProto2) {
 	if m == nil {
-		return (*TestAllTypesProto2_OneofUint32)(nil)
+		dst.Reset()
+		return
 	}
-	r := &TestAllTypesProto2_OneofUint32{
-		OneofUint32: m.OneofUint32,
+	if m.OptionalInt32 == nil {
+		dst.OptionalInt32 = nil
+	} else {
+		if dst.OptionalInt32 == nil {
+			dst.OptionalInt32 = new(int32)
+		}
+		*dst.OptionalInt32 = *m.OptionalInt32
 	}
-	return r
-}
-
-func (m *TestAllTypesProto2_OneofNestedMessage) CloneVT() isTestAllTypesProto2_OneofField {
-	if m == nil {
-		return (*TestAllTypesProto2_OneofNestedMessage)(nil)
+	if m.OptionalInt64 == nil {
+		dst.OptionalInt64 = nil
+	} else {
+		if dst.OptionalInt64 == nil {
+			dst.OptionalInt64 = new(int64)
+		}
+		*dst.OptionalInt64 = *m.OptionalInt64
 	}
-	r := &TestAllTypesProto2_OneofNestedMessage{
-		OneofNestedMessage: m.OneofNestedMessage.CloneVT(),
+	if m.OptionalUint32 == nil {
+		dst.OptionalUint32 = nil
+	} else {
+		if dst.OptionalUint32 == nil {
+			dst.OptionalUint32 = new(uint32)
+		}
+		*dst.OptionalUint32 = *m.OptionalUint32
 	}
-	return r
-}
-
-func (m *TestAllTypesProto2_OneofString) CloneVT() isTestAllTypesProto2_OneofField {
-	if m == nil {
-		return (*TestAllTypesProto2_OneofString)(nil)
+	if m.OptionalUint64 == nil {
+		dst.OptionalUint64 = nil
+	} else {
+		if dst.OptionalUint64 == nil {
+			dst.OptionalUint64 = new(uint64)
+		}
+		*dst.OptionalUint64 = *m.OptionalUint64
 	}
-	r := &TestAllTypesProto2_OneofString{
-		OneofString: m.OneofString,
+	if m.OptionalSint32 == nil {
+		dst.OptionalSint32 = nil
+	} else {
+		if dst.OptionalSint32 == nil {
+			dst.OptionalSint32 = new(int32)
+		}
+		*dst.OptionalSint32 = *m.OptionalSint32
 	}
-	return r
-}
-
-func (m *TestAllTypesProto2_OneofBytes) CloneVT() isTestAllTypesProto2_OneofField {
-	if m == nil {
-		return (*TestAllTypesProto2_OneofBytes)(nil)
+	if m.OptionalSint64 == nil {
+		dst.OptionalSint64 = nil
+	} else {
+		if dst.OptionalSint64 == nil {
+			dst.OptionalSint64 = new(int64)
+		}
+		*dst.OptionalSint64 = *m.OptionalSint64
 	}
-	r := &TestAllTypesProto2_OneofBytes{}
-	if rhs := m.OneofBytes; rhs != nil {
-		tmpBytes := make([]byte, len(rhs))
-		copy(tmpBytes, rhs)
-		r.OneofBytes = tmpBytes
+	if m.OptionalFixed32 == nil {
+		dst.OptionalFixed32 = nil
+	} else {
+		if dst.OptionalFixed32 == nil {
+			dst.OptionalFixed32 = new(uint32)
+		}
+		*dst.OptionalFixed32 = *m.OptionalFixed32
 	}
-	return r
-}
-
-func (m *TestAllTypesProto2_OneofBool) CloneVT() isTestAllTypesProto2_OneofField {
-	if m == nil {
-		return (*TestAllTypesProto2_OneofBool)(nil)
+	if m.OptionalFixed64 == nil {
+		dst.OptionalFixed64 = nil
+	} else {
+		if dst.OptionalFixed64 == nil {
+			dst.OptionalFixed64 = new(uint64)
+		}
+		*dst.OptionalFixed64 = *m.OptionalFixed64
 	}
-	r := &TestAllTypesProto2_OneofBool{
-		OneofBool: m.OneofBool,
+	if m.OptionalSfixed32 == nil {
+		dst.OptionalSfixed32 = nil
+	} else {
+		if dst.OptionalSfixed32 == nil {
+			dst.OptionalSfixed32 = new(int32)
+		}
+		*dst.OptionalSfixed32 = *m.OptionalSfixed32
 	}
-	return r
-}
-
-func (m *TestAllTypesProto2_OneofUint64) CloneVT() isTestAllTypesProto2_OneofField {
-	if m == nil {
-		return (*TestAllTypesProto2_OneofUint64)(nil)
+	if m.OptionalSfixed64 == nil {
+		dst.OptionalSfixed64 = nil
+	} else {
+		if dst.OptionalSfixed64 == nil {
+			dst.OptionalSfixed64 = new(int64)
+		}
+		*dst.OptionalSfixed64 = *m.OptionalSfixed64
 	}
-	r := &TestAllTypesProto2_OneofUint64{
-		OneofUint64: m.OneofUint64,
+	if m.OptionalFloat == nil {
+		dst.OptionalFloat = nil
+	} else {
+		if dst.OptionalFloat == nil {
+			dst.OptionalFloat = new(float32)
+		}
+		*dst.OptionalFloat = *m.OptionalFloat
 	}
-	return r
-}
-
-func (m *TestAllTypesProto2_OneofFloat) CloneVT() isTestAllTypesProto2_OneofField {
-	if m == nil {
-		return (*TestAllTypesProto2_OneofFloat)(nil)
+	if m.OptionalDouble == nil {
+		dst.OptionalDouble = nil
+	} else {
+		if dst.OptionalDouble == nil {
+			dst.OptionalDouble = new(float64)
+		}
+		*dst.OptionalDouble = *m.OptionalDouble
 	}
-	r := &TestAllTypesProto2_OneofFloat{
-		OneofFloat: m.OneofFloat,
+	if m.OptionalBool == nil {
+		dst.OptionalBool = nil
+	} else {
+		if dst.OptionalBool == nil {
+			dst.OptionalBool = new(bool)
+		}
+		*dst.OptionalBool = *m.OptionalBool
 	}
-	return r
-}
-
-func (m *TestAllTypesProto2_OneofDouble) CloneVT() isTestAllTypesProto2_OneofField {
-	if m == nil {
-		return (*TestAllTypesProto2_OneofDouble)(nil)
+	if m.OptionalString == nil {
+		dst.OptionalString = nil
+	} else {
+		if dst.OptionalString == nil {
+			dst.OptionalString = new(string)
+		}
+		*dst.OptionalString = *m.OptionalString
 	}
-	r := &TestAllTypesProto2_OneofDouble{
-		OneofDouble: m.OneofDouble,
+	if m.OptionalBytes == nil {
+		dst.OptionalBytes = nil
+	} else if dst.OptionalBytes == nil {
+		dst.OptionalBytes = append([]byte{}, m.OptionalBytes...)
+	} else {
+		dst.OptionalBytes = append(dst.OptionalBytes[:0], m.OptionalBytes...)
 	}
-	return r
-}
-
-func (m *TestAllTypesProto2_OneofEnum) CloneVT() isTestAllTypesProto2_OneofField {
-	if m == nil {
-		return (*TestAllTypesProto2_OneofEnum)(nil)
+	if m.OptionalNestedMessage == nil {
+		dst.OptionalNestedMessage = nil
+	} else {
+		if dst.OptionalNestedMessage == nil {
+			dst.OptionalNestedMessage = m.OptionalNestedMessage.CloneVT()
+		} else {
+			m.OptionalNestedMessage.CopyToVT(dst.OptionalNestedMessage)
+		}
 	}
-	r := &TestAllTypesProto2_OneofEnum{
-		OneofEnum: m.OneofEnum,
+	if m.OptionalForeignMessage == nil {
+		dst.OptionalForeignMessage = nil
+	} else {
+		if dst.OptionalForeignMessage == nil {
+			dst.OptionalForeignMessage = m.OptionalForeignMessage.CloneVT()
+		} else {
+			m.OptionalForeignMessage.CopyToVT(dst.OptionalForeignMessage)
+		}
 	}
-	return r
-}
-
-func (m *ForeignMessageProto2) CloneVT() *ForeignMessageProto2 {
-	if m == nil {
-		return (*ForeignMessageProto2)(nil)
+	if m.OptionalNestedEnum == nil {
+		dst.OptionalNestedEnum = nil
+	} else {
+		if dst.OptionalNestedEnum == nil {
+			dst.OptionalNestedEnum = new(TestAllTypesProto2_NestedEnum)
+		}
+		*dst.OptionalNestedEnum = *m.OptionalNestedEnum
 	}
-	r := &ForeignMessageProto2{}
-	if rhs := m.C; rhs != nil {
-		tmpVal := *rhs
-		r.C = &tmpVal
+	if m.OptionalForeignEnum == nil {
+		dst.OptionalForeignEnum = nil
+	} else {
+		if dst.OptionalForeignEnum == nil {
+			dst.OptionalForeignEnum = new(ForeignEnumProto2)
+		}
+		*dst.OptionalForeignEnum = *m.OptionalForeignEnum
 	}
-	if len(m.unknownFields) > 0 {
-		r.unknownFields = make([]byte, len(m.unknownFields))
-		copy(r.unknownFields, m.unknownFields)
+	if m.OptionalStringPiece == nil {
+		dst.OptionalStringPiece = nil
+	} else {
+		if dst.OptionalStringPiece == nil {
+			dst.OptionalStringPiece = new(string)
+		}
+		*dst.OptionalStringPiece = *m.OptionalStringPiece
 	}
-	return r
-}
-
-func (m *ForeignMessageProto2) CloneGenericVT() proto.Message {
-	return m.CloneVT()
-}
-
-func (m *UnknownToTestAllTypes_OptionalGroup) CloneVT() *UnknownToTestAllTypes_OptionalGroup {
-	if m == nil {
-		return (*UnknownToTestAllTypes_OptionalGroup)(nil)
+	if m.OptionalCord == nil {
+		dst.OptionalCord = nil
+	} else {
+		if dst.OptionalCord == nil {
+			dst.OptionalCord = new(string)
+		}
+		*dst.OptionalCord = *m.OptionalCord
 	}
-	r := &UnknownToTestAllTypes_OptionalGroup{}
-	if rhs := m.A; rhs != nil {
-		tmpVal := *rhs
-		r.A = &tmpVal
+	if m.RecursiveMessage == nil {
+		dst.RecursiveMessage = nil
+	} else {
+		if dst.RecursiveMessage == nil {
+			dst.RecursiveMessage = m.RecursiveMessage.CloneVT()
+		} else {
+			m.RecursiveMessage.CopyToVT(dst.RecursiveMessage)
+		}
+	}
+	dst.RepeatedInt32 = append(dst.RepeatedInt32[:0], m.RepeatedInt32...)
+	dst.RepeatedInt64 = append(dst.RepeatedInt64[:0], m.RepeatedInt64...)
+	dst.RepeatedUint32 = append(dst.RepeatedUint32[:0], m.RepeatedUint32...)
+	dst.RepeatedUint64 = append(dst.RepeatedUint64[:0], m.RepeatedUint64...)
+	dst.RepeatedSint32 = append(dst.RepeatedSint32[:0], m.RepeatedSint32...)
+	dst.RepeatedSint64 = append(dst.RepeatedSint64[:0], m.RepeatedSint64...)
+	dst.RepeatedFixed32 = append(dst.RepeatedFixed32[:0], m.RepeatedFixed32...)
+	dst.RepeatedFixed64 = append(dst.RepeatedFixed64[:0], m.RepeatedFixed64...)
+	dst.RepeatedSfixed32 = append(dst.RepeatedSfixed32[:0], m.RepeatedSfixed32...)
+	dst.RepeatedSfixed64 = append(dst.RepeatedSfixed64[:0], m.RepeatedSfixed64...)
+	dst.RepeatedFloat = append(dst.RepeatedFloat[:0], m.RepeatedFloat...)
+	dst.RepeatedDouble = append(dst.RepeatedDouble[:0], m.RepeatedDouble...)
+	dst.RepeatedBool = append(dst.RepeatedBool[:0], m.RepeatedBool...)
+	dst.RepeatedString = append(dst.RepeatedString[:0], m.RepeatedString...)
+	if len(m.RepeatedBytes) > cap(dst.RepeatedBytes) {
+		tmpContainer := make([][]byte, len(m.RepeatedBytes))
+		copy(tmpContainer, dst.RepeatedBytes[:cap(dst.RepeatedBytes)])
+		dst.RepeatedBytes = tmpContainer
+	} else {
+		dst.RepeatedBytes = dst.RepeatedBytes[:len(m.RepeatedBytes)]
 	}
-	if len(m.unknownFields) > 0 {
-		r.unknownFields = make([]byte, len(m.unknownFields))
-		copy(r.unknownFields, m.unknownFields)
+	for k, v := range m.RepeatedBytes {
+		dst.RepeatedBytes[k] = append(dst.RepeatedBytes[k][:0], v...)
 	}
-	return r
-}
-
-func (m *UnknownToTestAllTypes_OptionalGroup) CloneGenericVT() proto.Message {
-	return m.CloneVT()
-}
-
-func (m *UnknownToTestAllTypes) CloneVT() *UnknownToTestAllTypes {
-	if m == nil {
-		return (*UnknownToTestAllTypes)(nil)
+	if len(m.RepeatedNestedMessage) > cap(dst.RepeatedNestedMessage) {
+		tmpContainer := make([]*TestAllTypesProto2_NestedMessage, len(m.RepeatedNestedMessage))
+		copy(tmpContainer, dst.RepeatedNestedMessage[:cap(dst.RepeatedNestedMessage)])
+		dst.RepeatedNestedMessage = tmpContainer
+	} else {
+		dst.RepeatedNestedMessage = dst.RepeatedNestedMessage[:len(m.RepeatedNestedMessage)]
 	}
-	r := &UnknownToTestAllTypes{
-		NestedMessage: m.NestedMessage.CloneVT(),
-		Optionalgroup: m.Optionalgroup.CloneVT(),
+	for k, v := range m.RepeatedNestedMessage {
+		if dst.RepeatedNestedMessage[k] == nil {
+			dst.RepeatedNestedMessage[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.RepeatedNestedMessage[k])
+		}
 	}
-	if rhs := m.OptionalInt32; rhs != nil {
-		tmpVal := *rhs
-		r.OptionalInt32 = &tmpVal
+	if len(m.RepeatedForeignMessage) > cap(dst.RepeatedForeignMessage) {
+		tmpContainer := make([]*ForeignMessageProto2, len(m.RepeatedForeignMessage))
+		copy(tmpContainer, dst.RepeatedForeignMessage[:cap(dst.RepeatedForeignMessage)])
+		dst.RepeatedForeignMessage = tmpContainer
+	} else {
+		dst.RepeatedForeignMessage = dst.RepeatedForeignMessage[:len(m.RepeatedForeignMessage)]
 	}
-	if rhs := m.OptionalString; rhs != nil {
-		tmpVal := *rhs
-		r.OptionalString = &tmpVal
+	for k, v := range m.RepeatedForeignMessage {
+		if dst.RepeatedForeignMessage[k] == nil {
+			dst.RepeatedForeignMessage[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.RepeatedForeignMessage[k])
+		}
 	}
-	if rhs := m.OptionalBool; rhs != nil {
-		tmpVal := *rhs
-		r.OptionalBool = &tmpVal
+	dst.RepeatedNestedEnum = append(dst.RepeatedNestedEnum[:0], m.RepeatedNestedEnum...)
+	dst.RepeatedForeignEnum = append(dst.RepeatedForeignEnum[:0], m.RepeatedForeignEnum...)
+	dst.RepeatedStringPiece = append(dst.RepeatedStringPiece[:0], m.RepeatedStringPiece...)
+	dst.RepeatedCord = append(dst.RepeatedCord[:0], m.RepeatedCord...)
+	dst.PackedInt32 = append(dst.PackedInt32[:0], m.PackedInt32...)
+	dst.PackedInt64 = append(dst.PackedInt64[:0], m.PackedInt64...)
+	dst.PackedUint32 = append(dst.PackedUint32[:0], m.PackedUint32...)
+	dst.PackedUint64 = append(dst.PackedUint64[:0], m.PackedUint64...)
+	dst.PackedSint32 = append(dst.PackedSint32[:0], m.PackedSint32...)
+	dst.PackedSint64 = append(dst.PackedSint64[:0], m.PackedSint64...)
+	dst.PackedFixed32 = append(dst.PackedFixed32[:0], m.PackedFixed32...)
+	dst.PackedFixed64 = append(dst.PackedFixed64[:0], m.PackedFixed64...)
+	dst.PackedSfixed32 = append(dst.PackedSfixed32[:0], m.PackedSfixed32...)
+	dst.PackedSfixed64 = append(dst.PackedSfixed64[:0], m.PackedSfixed64...)
+	dst.PackedFloat = append(dst.PackedFloat[:0], m.PackedFloat...)
+	dst.PackedDouble = append(dst.PackedDouble[:0], m.PackedDouble...)
+	dst.PackedBool = append(dst.PackedBool[:0], m.PackedBool...)
+	dst.PackedNestedEnum = append(dst.PackedNestedEnum[:0], m.PackedNestedEnum...)
+	dst.UnpackedInt32 = append(dst.UnpackedInt32[:0], m.UnpackedInt32...)
+	dst.UnpackedInt64 = append(dst.UnpackedInt64[:0], m.UnpackedInt64...)
+	dst.UnpackedUint32 = append(dst.UnpackedUint32[:0], m.UnpackedUint32...)
+	dst.UnpackedUint64 = append(dst.UnpackedUint64[:0], m.UnpackedUint64...)
+	dst.UnpackedSint32 = append(dst.UnpackedSint32[:0], m.UnpackedSint32...)
+	dst.UnpackedSint64 = append(dst.UnpackedSint64[:0], m.UnpackedSint64...)
+	dst.UnpackedFixed32 = append(dst.UnpackedFixed32[:0], m.UnpackedFixed32...)
+	dst.UnpackedFixed64 = append(dst.UnpackedFixed64[:0], m.UnpackedFixed64...)
+	dst.UnpackedSfixed32 = append(dst.UnpackedSfixed32[:0], m.UnpackedSfixed32...)
+	dst.UnpackedSfixed64 = append(dst.UnpackedSfixed64[:0], m.UnpackedSfixed64...)
+	dst.UnpackedFloat = append(dst.UnpackedFloat[:0], m.UnpackedFloat...)
+	dst.UnpackedDouble = append(dst.UnpackedDouble[:0], m.UnpackedDouble...)
+	dst.UnpackedBool = append(dst.UnpackedBool[:0], m.UnpackedBool...)
+	dst.UnpackedNestedEnum = append(dst.UnpackedNestedEnum[:0], m.UnpackedNestedEnum...)
+	if dst.MapInt32Int32 == nil && len(m.MapInt32Int32) > 0 {
+		dst.MapInt32Int32 = make(map[int32]int32, len(m.MapInt32Int32))
 	}
-	if rhs := m.RepeatedInt32; rhs != nil {
-		tmpContainer := make([]int32, len(rhs))
-		copy(tmpContainer, rhs)
-		r.RepeatedInt32 = tmpContainer
+	for k := range dst.MapInt32Int32 {
+		if _, ok := m.MapInt32Int32[k]; !ok {
+			delete(dst.MapInt32Int32, k)
+		}
 	}
-	if len(m.unknownFields) > 0 {
-		r.unknownFields = make([]byte, len(m.unknownFields))
-		copy(r.unknownFields, m.unknownFields)
+	for k, v := range m.MapInt32Int32 {
+		dst.MapInt32Int32[k] = v
 	}
-	return r
-}
-
-func (m *UnknownToTestAllTypes) CloneGenericVT() proto.Message {
-	return m.CloneVT()
-}
-
-func (m *NullHypothesisProto2) CloneVT() *NullHypothesisProto2 {
-	if m == nil {
-		return (*NullHypothesisProto2)(nil)
+	if dst.MapInt64Int64 == nil && len(m.MapInt64Int64) > 0 {
+		dst.MapInt64Int64 = make(map[int64]int64, len(m.MapInt64Int64))
 	}
-	r := &NullHypothesisProto2{}
-	if len(m.unknownFields) > 0 {
-		r.unknownFields = make([]byte, len(m.unknownFields))
-		copy(r.unknownFields, m.unknownFields)
+	for k := range dst.MapInt64Int64 {
+		if _, ok := m.MapInt64Int64[k]; !ok {
+			delete(dst.MapInt64Int64, k)
+		}
 	}
-	return r
-}
-
-func (m *NullHypothesisProto2) CloneGenericVT() proto.Message {
-	return m.CloneVT()
-}
-
-func (m *EnumOnlyProto2) CloneVT() *EnumOnlyProto2 {
-	if m == nil {
-		return (*EnumOnlyProto2)(nil)
+	for k, v := range m.MapInt64Int64 {
+		dst.MapInt64Int64[k] = v
 	}
-	r := &EnumOnlyProto2{}
-	if len(m.unknownFields) > 0 {
-		r.unknownFields = make([]byte, len(m.unknownFields))
-		copy(r.unknownFields, m.unknownFields)
+	if dst.MapUint32Uint32 == nil && len(m.MapUint32Uint32) > 0 {
+		dst.MapUint32Uint32 = make(map[uint32]uint32, len(m.MapUint32Uint32))
 	}
-	return r
-}
-
-func (m *EnumOnlyProto2) CloneGenericVT() proto.Message {
-	return m.CloneVT()
-}
-
-func (m *OneStringProto2) CloneVT() *OneStringProto2 {
-	if m == nil {
-		return (*OneStringProto2)(nil)
+	for k := range dst.MapUint32Uint32 {
+		if _, ok := m.MapUint32Uint32[k]; !ok {
+			delete(dst.MapUint32Uint32, k)
+		}
 	}
-	r := &OneStringProto2{}
-	if rhs := m.Data; rhs != nil {
-		tmpVal := *rhs
-		r.Data = &tmpVal
+	for k, v := range m.MapUint32Uint32 {
+		dst.MapUint32Uint32[k] = v
 	}
-	if len(m.unknownFields) > 0 {
-		r.unknownFields = make([]byte, len(m.unknownFields))
-		copy(r.unknownFields, m.unknownFields)
+	if dst.MapUint64Uint64 == nil && len(m.MapUint64Uint64) > 0 {
+		dst.MapUint64Uint64 = make(map[uint64]uint64, len(m.MapUint64Uint64))
 	}
-	return r
-}
-
-func (m *OneStringProto2) CloneGenericVT() proto.Message {
-	return m.CloneVT()
-}
-
-func (this *TestAllTypesProto2_NestedMessage) EqualVT(that *TestAllTypesProto2_NestedMessage) bool {
-	if this == nil {
-		return that == nil
-	} else if that == nil {
-		return false
+	for k := range dst.MapUint64Uint64 {
+		if _, ok := m.MapUint64Uint64[k]; !ok {
+			delete(dst.MapUint64Uint64, k)
+		}
 	}
-	if p, q := this.A, that.A; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for k, v := range m.MapUint64Uint64 {
+		dst.MapUint64Uint64[k] = v
 	}
-	if !this.Corecursive.EqualVT(that.Corecursive) {
-		return false
+	if dst.MapSint32Sint32 == nil && len(m.MapSint32Sint32) > 0 {
+		dst.MapSint32Sint32 = make(map[int32]int32, len(m.MapSint32Sint32))
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
-}
-
-func (this *TestAllTypesProto2_Data) EqualVT(that *TestAllTypesProto2_Data) bool {
-	if this == nil {
-		return that == nil
-	} else if that == nil {
-		return false
+	for k := range dst.MapSint32Sint32 {
+		if _, ok := m.MapSint32Sint32[k]; !ok {
+			delete(dst.MapSint32Sint32, k)
+		}
 	}
-	if p, q := this.GroupInt32, that.GroupInt32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for k, v := range m.MapSint32Sint32 {
+		dst.MapSint32Sint32[k] = v
 	}
-	if p, q := this.GroupUint32, that.GroupUint32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	if dst.MapSint64Sint64 == nil && len(m.MapSint64Sint64) > 0 {
+		dst.MapSint64Sint64 = make(map[int64]int64, len(m.MapSint64Sint64))
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
-}
-
-func (this *TestAllTypesProto2_MessageSetCorrect) EqualVT(that *TestAllTypesProto2_MessageSetCorrect) bool {
-	if this == nil {
-		return that == nil
-	} else if that == nil {
-		return false
+	for k := range dst.MapSint64Sint64 {
+		if _, ok := m.MapSint64Sint64[k]; !ok {
+			delete(dst.MapSint64Sint64, k)
+		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
-}
-
-func (this *TestAllTypesProto2_MessageSetCorrectExtension1) EqualVT(that *TestAllTypesProto2_MessageSetCorrectExtension1) bool {
-	if this == nil {
-		return that == nil
-	} else if that == nil {
-		return false
+	for k, v := range m.MapSint64Sint64 {
+		dst.MapSint64Sint64[k] = v
 	}
-	if p, q := this.Str, that.Str; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	if dst.MapFixed32Fixed32 == nil && len(m.MapFixed32Fixed32) > 0 {
+		dst.MapFixed32Fixed32 = make(map[uint32]uint32, len(m.MapFixed32Fixed32))
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
-}
-
-func (this *TestAllTypesProto2_MessageSetCorrectExtension2) EqualVT(that *TestAllTypesProto2_MessageSetCorrectExtension2) bool {
-	if this == nil {
-		return that == nil
-	} else if that == nil {
-		return false
+	for k := range dst.MapFixed32Fixed32 {
+		if _, ok := m.MapFixed32Fixed32[k]; !ok {
+			delete(dst.MapFixed32Fixed32, k)
+		}
 	}
-	if p, q := this.I, that.I; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for k, v := range m.MapFixed32Fixed32 {
+		dst.MapFixed32Fixed32[k] = v
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
-}
-
-func (this *TestAllTypesProto2) EqualVT(that *TestAllTypesProto2) bool {
-	if this == nil {
-		return that == nil
-	} else if that == nil {
-		return false
+	if dst.MapFixed64Fixed64 == nil && len(m.MapFixed64Fixed64) > 0 {
+		dst.MapFixed64Fixed64 = make(map[uint64]uint64, len(m.MapFixed64Fixed64))
 	}
-	if this.OneofField == nil && that.OneofField != nil {
-		return false
-	} else if this.OneofField != nil {
-		if that.OneofField == nil {
-			return false
-		}
-		if !this.OneofField.(interface {
-			EqualVT(isTestAllTypesProto2_OneofField) bool
-		}).EqualVT(that.OneofField) {
-			return false
+	for k := range dst.MapFixed64Fixed64 {
+		if _, ok := m.MapFixed64Fixed64[k]; !ok {
+			delete(dst.MapFixed64Fixed64, k)
 		}
 	}
-	if p, q := this.OptionalInt32, that.OptionalInt32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for k, v := range m.MapFixed64Fixed64 {
+		dst.MapFixed64Fixed64[k] = v
 	}
-	if p, q := this.OptionalInt64, that.OptionalInt64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	if dst.MapSfixed32Sfixed32 == nil && len(m.MapSfixed32Sfixed32) > 0 {
+		dst.MapSfixed32Sfixed32 = make(map[int32]int32, len(m.MapSfixed32Sfixed32))
 	}
-	if p, q := this.OptionalUint32, that.OptionalUint32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for k := range dst.MapSfixed32Sfixed32 {
+		if _, ok := m.MapSfixed32Sfixed32[k]; !ok {
+			delete(dst.MapSfixed32Sfixed32, k)
+		}
 	}
-	if p, q := this.OptionalUint64, that.OptionalUint64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for k, v := range m.MapSfixed32Sfixed32 {
+		dst.MapSfixed32Sfixed32[k] = v
 	}
-	if p, q := this.OptionalSint32, that.OptionalSint32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	if dst.MapSfixed64Sfixed64 == nil && len(m.MapSfixed64Sfixed64) > 0 {
+		dst.MapSfixed64Sfixed64 = make(map[int64]int64, len(m.MapSfixed64Sfixed64))
 	}
-	if p, q := this.OptionalSint64, that.OptionalSint64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for k := range dst.MapSfixed64Sfixed64 {
+		if _, ok := m.MapSfixed64Sfixed64[k]; !ok {
+			delete(dst.MapSfixed64Sfixed64, k)
+		}
 	}
-	if p, q := this.OptionalFixed32, that.OptionalFixed32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for k, v := range m.MapSfixed64Sfixed64 {
+		dst.MapSfixed64Sfixed64[k] = v
 	}
-	if p, q := this.OptionalFixed64, that.OptionalFixed64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	if dst.MapInt32Float == nil && len(m.MapInt32Float) > 0 {
+		dst.MapInt32Float = make(map[int32]float32, len(m.MapInt32Float))
 	}
-	if p, q := this.OptionalSfixed32, that.OptionalSfixed32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for k := range dst.MapInt32Float {
+		if _, ok := m.MapInt32Float[k]; !ok {
+			delete(dst.MapInt32Float, k)
+		}
 	}
-	if p, q := this.OptionalSfixed64, that.OptionalSfixed64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for k, v := range m.MapInt32Float {
+		dst.MapInt32Float[k] = v
 	}
-	if p, q := this.OptionalFloat, that.OptionalFloat; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	if dst.MapInt32Double == nil && len(m.MapInt32Double) > 0 {
+		dst.MapInt32Double = make(map[int32]float64, len(m.MapInt32Double))
 	}
-	if p, q := this.OptionalDouble, that.OptionalDouble; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for k := range dst.MapInt32Double {
+		if _, ok := m.MapInt32Double[k]; !ok {
+			delete(dst.MapInt32Double, k)
+		}
 	}
-	if p, q := this.OptionalBool, that.OptionalBool; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for k, v := range m.MapInt32Double {
+		dst.MapInt32Double[k] = v
 	}
-	if p, q := this.OptionalString, that.OptionalString; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	if dst.MapBoolBool == nil && len(m.MapBoolBool) > 0 {
+		dst.MapBoolBool = make(map[bool]bool, len(m.MapBoolBool))
 	}
-	if p, q := this.OptionalBytes, that.OptionalBytes; (p == nil && q != nil) || (p != nil && q == nil) || string(p) != string(q) {
-		return false
+	for k := range dst.MapBoolBool {
+		if _, ok := m.MapBoolBool[k]; !ok {
+			delete(dst.MapBoolBool, k)
+		}
 	}
-	if !this.OptionalNestedMessage.EqualVT(that.OptionalNestedMessage) {
-		return false
+	for k, v := range m.MapBoolBool {
+		dst.MapBoolBool[k] = v
 	}
-	if !this.OptionalForeignMessage.EqualVT(that.OptionalForeignMessage) {
-		return false
+	if dst.MapStringString == nil && len(m.MapStringString) > 0 {
+		dst.MapStringString = make(map[string]string, len(m.MapStringString))
 	}
-	if p, q := this.OptionalNestedEnum, that.OptionalNestedEnum; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for k := range dst.MapStringString {
+		if _, ok := m.MapStringString[k]; !ok {
+			delete(dst.MapStringString, k)
+		}
 	}
-	if p, q := this.OptionalForeignEnum, that.OptionalForeignEnum; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for k, v := range m.MapStringString {
+		dst.MapStringString[k] = v
 	}
-	if p, q := this.OptionalStringPiece, that.OptionalStringPiece; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	if dst.MapStringBytes == nil && len(m.MapStringBytes) > 0 {
+		dst.MapStringBytes = make(map[string][]byte, len(m.MapStringBytes))
 	}
-	if p, q := this.OptionalCord, that.OptionalCord; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for k := range dst.MapStringBytes {
+		if _, ok := m.MapStringBytes[k]; !ok {
+			delete(dst.MapStringBytes, k)
+		}
 	}
-	if !this.RecursiveMessage.EqualVT(that.RecursiveMessage) {
-		return false
+	for k, v := range m.MapStringBytes {
+		dst.MapStringBytes[k] = append(dst.MapStringBytes[k][:0], v...)
 	}
-	if len(this.RepeatedInt32) != len(that.RepeatedInt32) {
-		return false
+	if dst.MapStringNestedMessage == nil && len(m.MapStringNestedMessage) > 0 {
+		dst.MapStringNestedMessage = make(map[string]*TestAllTypesProto2_NestedMessage, len(m.MapStringNestedMessage))
 	}
-	for i, vx := range this.RepeatedInt32 {
-		vy := that.RepeatedInt32[i]
-		if vx != vy {
-			return false
+	for k := range dst.MapStringNestedMessage {
+		if _, ok := m.MapStringNestedMessage[k]; !ok {
+			delete(dst.MapStringNestedMessage, k)
 		}
 	}
-	if len(this.RepeatedInt64) != len(that.RepeatedInt64) {
-		return false
-	}
-	for i, vx := range this.RepeatedInt64 {
-		vy := that.RepeatedInt64[i]
-		if vx != vy {
-			return false
+	for k, v := range m.MapStringNestedMessage {
+		if dst.MapStringNestedMessage[k] == nil {
+			dst.MapStringNestedMessage[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.MapStringNestedMessage[k])
 		}
 	}
-	if len(this.RepeatedUint32) != len(that.RepeatedUint32) {
-		return false
+	if dst.MapStringForeignMessage == nil && len(m.MapStringForeignMessage) > 0 {
+		dst.MapStringForeignMessage = make(map[string]*ForeignMessageProto2, len(m.MapStringForeignMessage))
 	}
-	for i, vx := range this.RepeatedUint32 {
-		vy := that.RepeatedUint32[i]
-		if vx != vy {
-			return false
+	for k := range dst.MapStringForeignMessage {
+		if _, ok := m.MapStringForeignMessage[k]; !ok {
+			delete(dst.MapStringForeignMessage, k)
 		}
 	}
-	if len(this.RepeatedUint64) != len(that.RepeatedUint64) {
-		return false
-	}
-	for i, vx := range this.RepeatedUint64 {
-		vy := that.RepeatedUint64[i]
-		if vx != vy {
-			return false
+	for k, v := range m.MapStringForeignMessage {
+		if dst.MapStringForeignMessage[k] == nil {
+			dst.MapStringForeignMessage[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.MapStringForeignMessage[k])
 		}
 	}
-	if len(this.RepeatedSint32) != len(that.RepeatedSint32) {
-		return false
+	if dst.MapStringNestedEnum == nil && len(m.MapStringNestedEnum) > 0 {
+		dst.MapStringNestedEnum = make(map[string]TestAllTypesProto2_NestedEnum, len(m.MapStringNestedEnum))
 	}
-	for i, vx := range this.RepeatedSint32 {
-		vy := that.RepeatedSint32[i]
-		if vx != vy {
-			return false
+	for k := range dst.MapStringNestedEnum {
+		if _, ok := m.MapStringNestedEnum[k]; !ok {
+			delete(dst.MapStringNestedEnum, k)
 		}
 	}
-	if len(this.RepeatedSint64) != len(that.RepeatedSint64) {
-		return false
+	for k, v := range m.MapStringNestedEnum {
+		dst.MapStringNestedEnum[k] = v
 	}
-	for i, vx := range this.RepeatedSint64 {
-		vy := that.RepeatedSint64[i]
-		if vx != vy {
-			return false
+	if dst.MapStringForeignEnum == nil && len(m.MapStringForeignEnum) > 0 {
+		dst.MapStringForeignEnum = make(map[string]ForeignEnumProto2, len(m.MapStringForeignEnum))
+	}
+	for k := range dst.MapStringForeignEnum {
+		if _, ok := m.MapStringForeignEnum[k]; !ok {
+			delete(dst.MapStringForeignEnum, k)
 		}
 	}
-	if len(this.RepeatedFixed32) != len(that.RepeatedFixed32) {
-		return false
+	for k, v := range m.MapStringForeignEnum {
+		dst.MapStringForeignEnum[k] = v
 	}
-	for i, vx := range this.RepeatedFixed32 {
-		vy := that.RepeatedFixed32[i]
-		if vx != vy {
-			return false
+	switch c := m.OneofField.(type) {
+	case *TestAllTypesProto2_OneofUint32:
+		if d, ok := dst.OneofField.(*TestAllTypesProto2_OneofUint32); ok {
+			d.OneofUint32 = c.OneofUint32
+		} else {
+			dst.OneofField = c.CloneVT()
 		}
+	case *TestAllTypesProto2_OneofNestedMessage:
+		if d, ok := dst.OneofField.(*TestAllTypesProto2_OneofNestedMessage); ok {
+			if d.OneofNestedMessage == nil {
+				d.OneofNestedMessage = c.OneofNestedMessage.CloneVT()
+			} else {
+				c.OneofNestedMessage.CopyToVT(d.OneofNestedMessage)
+			}
+		} else {
+			dst.OneofField = c.CloneVT()
+		}
+	case *TestAllTypesProto2_OneofString:
+		if d, ok := dst.OneofField.(*TestAllTypesProto2_OneofString); ok {
+			d.OneofString = c.OneofString
+		} else {
+			dst.OneofField = c.CloneVT()
+		}
+	case *TestAllTypesProto2_OneofBytes:
+		if d, ok := dst.OneofField.(*TestAllTypesProto2_OneofBytes); ok {
+			d.OneofBytes = append(d.OneofBytes[:0], c.OneofBytes...)
+		} else {
+			dst.OneofField = c.CloneVT()
+		}
+	case *TestAllTypesProto2_OneofBool:
+		if d, ok := dst.OneofField.(*TestAllTypesProto2_OneofBool); ok {
+			d.OneofBool = c.OneofBool
+		} else {
+			dst.OneofField = c.CloneVT()
+		}
+	case *TestAllTypesProto2_OneofUint64:
+		if d, ok := dst.OneofField.(*TestAllTypesProto2_OneofUint64); ok {
+			d.OneofUint64 = c.OneofUint64
+		} else {
+			dst.OneofField = c.CloneVT()
+		}
+	case *TestAllTypesProto2_OneofFloat:
+		if d, ok := dst.OneofField.(*TestAllTypesProto2_OneofFloat); ok {
+			d.OneofFloat = c.OneofFloat
+		} else {
+			dst.OneofField = c.CloneVT()
+		}
+	case *TestAllTypesProto2_OneofDouble:
+		if d, ok := dst.OneofField.(*TestAllTypesProto2_OneofDouble); ok {
+			d.OneofDouble = c.OneofDouble
+		} else {
+			dst.OneofField = c.CloneVT()
+		}
+	case *TestAllTypesProto2_OneofEnum:
+		if d, ok := dst.OneofField.(*TestAllTypesProto2_OneofEnum); ok {
+			d.OneofEnum = c.OneofEnum
+		} else {
+			dst.OneofField = c.CloneVT()
+		}
+	default:
+		dst.OneofField = nil
 	}
-	if len(this.RepeatedFixed64) != len(that.RepeatedFixed64) {
-		return false
-	}
-	for i, vx := range this.RepeatedFixed64 {
-		vy := that.RepeatedFixed64[i]
-		if vx != vy {
-			return false
+	if m.Data == nil {
+		dst.Data = nil
+	} else {
+		if dst.Data == nil {
+			dst.Data = m.Data.CloneVT()
+		} else {
+			m.Data.CopyToVT(dst.Data)
 		}
 	}
-	if len(this.RepeatedSfixed32) != len(that.RepeatedSfixed32) {
-		return false
+	if m.DefaultInt32 == nil {
+		dst.DefaultInt32 = nil
+	} else {
+		if dst.DefaultInt32 == nil {
+			dst.DefaultInt32 = new(int32)
+		}
+		*dst.DefaultInt32 = *m.DefaultInt32
 	}
-	for i, vx := range this.RepeatedSfixed32 {
-		vy := that.RepeatedSfixed32[i]
-		if vx != vy {
-			return false
+	if m.DefaultInt64 == nil {
+		dst.DefaultInt64 = nil
+	} else {
+		if dst.DefaultInt64 == nil {
+			dst.DefaultInt64 = new(int64)
 		}
+		*dst.DefaultInt64 = *m.DefaultInt64
 	}
-	if len(this.RepeatedSfixed64) != len(that.RepeatedSfixed64) {
-		return false
+	if m.DefaultUint32 == nil {
+		dst.DefaultUint32 = nil
+	} else {
+		if dst.DefaultUint32 == nil {
+			dst.DefaultUint32 = new(uint32)
+		}
+		*dst.DefaultUint32 = *m.DefaultUint32
 	}
-	for i, vx := range this.RepeatedSfixed64 {
-		vy := that.RepeatedSfixed64[i]
-		if vx != vy {
-			return false
+	if m.DefaultUint64 == nil {
+		dst.DefaultUint64 = nil
+	} else {
+		if dst.DefaultUint64 == nil {
+			dst.DefaultUint64 = new(uint64)
 		}
+		*dst.DefaultUint64 = *m.DefaultUint64
 	}
-	if len(this.RepeatedFloat) != len(that.RepeatedFloat) {
-		return false
+	if m.DefaultSint32 == nil {
+		dst.DefaultSint32 = nil
+	} else {
+		if dst.DefaultSint32 == nil {
+			dst.DefaultSint32 = new(int32)
+		}
+		*dst.DefaultSint32 = *m.DefaultSint32
 	}
-	for i, vx := range this.RepeatedFloat {
-		vy := that.RepeatedFloat[i]
-		if vx != vy {
-			return false
+	if m.DefaultSint64 == nil {
+		dst.DefaultSint64 = nil
+	} else {
+		if dst.DefaultSint64 == nil {
+			dst.DefaultSint64 = new(int64)
 		}
+		*dst.DefaultSint64 = *m.DefaultSint64
 	}
-	if len(this.RepeatedDouble) != len(that.RepeatedDouble) {
-		return false
+	if m.DefaultFixed32 == nil {
+		dst.DefaultFixed32 = nil
+	} else {
+		if dst.DefaultFixed32 == nil {
+			dst.DefaultFixed32 = new(uint32)
+		}
+		*dst.DefaultFixed32 = *m.DefaultFixed32
 	}
-	for i, vx := range this.RepeatedDouble {
-		vy := that.RepeatedDouble[i]
-		if vx != vy {
-			return false
+	if m.DefaultFixed64 == nil {
+		dst.DefaultFixed64 = nil
+	} else {
+		if dst.DefaultFixed64 == nil {
+			dst.DefaultFixed64 = new(uint64)
 		}
+		*dst.DefaultFixed64 = *m.DefaultFixed64
 	}
-	if len(this.RepeatedBool) != len(that.RepeatedBool) {
-		return false
+	if m.DefaultSfixed32 == nil {
+		dst.DefaultSfixed32 = nil
+	} else {
+		if dst.DefaultSfixed32 == nil {
+			dst.DefaultSfixed32 = new(int32)
+		}
+		*dst.DefaultSfixed32 = *m.DefaultSfixed32
 	}
-	for i, vx := range this.RepeatedBool {
-		vy := that.RepeatedBool[i]
-		if vx != vy {
-			return false
+	if m.DefaultSfixed64 == nil {
+		dst.DefaultSfixed64 = nil
+	} else {
+		if dst.DefaultSfixed64 == nil {
+			dst.DefaultSfixed64 = new(int64)
 		}
+		*dst.DefaultSfixed64 = *m.DefaultSfixed64
 	}
-	if len(this.RepeatedString) != len(that.RepeatedString) {
-		return false
+	if m.DefaultFloat == nil {
+		dst.DefaultFloat = nil
+	} else {
+		if dst.DefaultFloat == nil {
+			dst.DefaultFloat = new(float32)
+		}
+		*dst.DefaultFloat = *m.DefaultFloat
 	}
-	for i, vx := range this.RepeatedString {
-		vy := that.RepeatedString[i]
-		if vx != vy {
-			return false
+	if m.DefaultDouble == nil {
+		dst.DefaultDouble = nil
+	} else {
+		if dst.DefaultDouble == nil {
+			dst.DefaultDouble = new(float64)
 		}
+		*dst.DefaultDouble = *m.DefaultDouble
 	}
-	if len(this.RepeatedBytes) != len(that.RepeatedBytes) {
-		return false
-	}
-	for i, vx := range this.RepeatedBytes {
-		vy := that.RepeatedBytes[i]
-		if string(vx) != string(vy) {
-			return false
+	if m.DefaultBool == nil {
+		dst.DefaultBool = nil
+	} else {
+		if dst.DefaultBool == nil {
+			dst.DefaultBool = new(bool)
 		}
+		*dst.DefaultBool = *m.DefaultBool
 	}
-	if len(this.RepeatedNestedMessage) != len(that.RepeatedNestedMessage) {
-		return false
-	}
-	for i, vx := range this.RepeatedNestedMessage {
-		vy := that.RepeatedNestedMessage[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &TestAllTypesProto2_NestedMessage{}
-			}
-			if q == nil {
-				q = &TestAllTypesProto2_NestedMessage{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
+	if m.DefaultString == nil {
+		dst.DefaultString = nil
+	} else {
+		if dst.DefaultString == nil {
+			dst.DefaultString = new(string)
 		}
+		*dst.DefaultString = *m.DefaultString
 	}
-	if len(this.RepeatedForeignMessage) != len(that.RepeatedForeignMessage) {
-		return false
+	if m.DefaultBytes == nil {
+		dst.DefaultBytes = nil
+	} else if dst.DefaultBytes == nil {
+		dst.DefaultBytes = append([]byte{}, m.DefaultBytes...)
+	} else {
+		dst.DefaultBytes = append(dst.DefaultBytes[:0], m.DefaultBytes...)
 	}
-	for i, vx := range this.RepeatedForeignMessage {
-		vy := that.RepeatedForeignMessage[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &ForeignMessageProto2{}
-			}
-			if q == nil {
-				q = &ForeignMessageProto2{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
+	if m.Fieldname1 == nil {
+		dst.Fieldname1 = nil
+	} else {
+		if dst.Fieldname1 == nil {
+			dst.Fieldname1 = new(int32)
 		}
+		*dst.Fieldname1 = *m.Fieldname1
 	}
-	if len(this.RepeatedNestedEnum) != len(that.RepeatedNestedEnum) {
-		return false
-	}
-	for i, vx := range this.RepeatedNestedEnum {
-		vy := that.RepeatedNestedEnum[i]
-		if vx != vy {
-			return false
+	if m.FieldName2 == nil {
+		dst.FieldName2 = nil
+	} else {
+		if dst.FieldName2 == nil {
+			dst.FieldName2 = new(int32)
 		}
+		*dst.FieldName2 = *m.FieldName2
 	}
-	if len(this.RepeatedForeignEnum) != len(that.RepeatedForeignEnum) {
-		return false
-	}
-	for i, vx := range this.RepeatedForeignEnum {
-		vy := that.RepeatedForeignEnum[i]
-		if vx != vy {
-			return false
+	if m.XFieldName3 == nil {
+		dst.XFieldName3 = nil
+	} else {
+		if dst.XFieldName3 == nil {
+			dst.XFieldName3 = new(int32)
 		}
+		*dst.XFieldName3 = *m.XFieldName3
 	}
-	if len(this.RepeatedStringPiece) != len(that.RepeatedStringPiece) {
-		return false
-	}
-	for i, vx := range this.RepeatedStringPiece {
-		vy := that.RepeatedStringPiece[i]
-		if vx != vy {
-			return false
+	if m.Field_Name4_ == nil {
+		dst.Field_Name4_ = nil
+	} else {
+		if dst.Field_Name4_ == nil {
+			dst.Field_Name4_ = new(int32)
 		}
+		*dst.Field_Name4_ = *m.Field_Name4_
 	}
-	if len(this.RepeatedCord) != len(that.RepeatedCord) {
-		return false
+	if m.Field0Name5 == nil {
+		dst.Field0Name5 = nil
+	} else {
+		if dst.Field0Name5 == nil {
+			dst.Field0Name5 = new(int32)
+		}
+		*dst.Field0Name5 = *m.Field0Name5
 	}
-	for i, vx := range this.RepeatedCord {
-		vy := that.RepeatedCord[i]
-		if vx != vy {
-			return false
+	if m.Field_0Name6 == nil {
+		dst.Field_0Name6 = nil
+	} else {
+		if dst.Field_0Name6 == nil {
+			dst.Field_0Name6 = new(int32)
 		}
+		*dst.Field_0Name6 = *m.Field_0Name6
 	}
-	if len(this.MapInt32Int32) != len(that.MapInt32Int32) {
-		return false
+	if m.FieldName7 == nil {
+		dst.FieldName7 = nil
+	} else {
+		if dst.FieldName7 == nil {
+			dst.FieldName7 = new(int32)
+		}
+		*dst.FieldName7 = *m.FieldName7
 	}
-	for i, vx := range this.MapInt32Int32 {
-		vy, ok := that.MapInt32Int32[i]
-		if !ok {
-			return false
+	if m.FieldName8 == nil {
+		dst.FieldName8 = nil
+	} else {
+		if dst.FieldName8 == nil {
+			dst.FieldName8 = new(int32)
 		}
-		if vx != vy {
-			return false
+		*dst.FieldName8 = *m.FieldName8
+	}
+	if m.Field_Name9 == nil {
+		dst.Field_Name9 = nil
+	} else {
+		if dst.Field_Name9 == nil {
+			dst.Field_Name9 = new(int32)
 		}
+		*dst.Field_Name9 = *m.Field_Name9
 	}
-	if len(this.MapInt64Int64) != len(that.MapInt64Int64) {
-		return false
+	if m.Field_Name10 == nil {
+		dst.Field_Name10 = nil
+	} else {
+		if dst.Field_Name10 == nil {
+			dst.Field_Name10 = new(int32)
+		}
+		*dst.Field_Name10 = *m.Field_Name10
 	}
-	for i, vx := range this.MapInt64Int64 {
-		vy, ok := that.MapInt64Int64[i]
-		if !ok {
-			return false
+	if m.FIELD_NAME11 == nil {
+		dst.FIELD_NAME11 = nil
+	} else {
+		if dst.FIELD_NAME11 == nil {
+			dst.FIELD_NAME11 = new(int32)
 		}
-		if vx != vy {
-			return false
+		*dst.FIELD_NAME11 = *m.FIELD_NAME11
+	}
+	if m.FIELDName12 == nil {
+		dst.FIELDName12 = nil
+	} else {
+		if dst.FIELDName12 == nil {
+			dst.FIELDName12 = new(int32)
 		}
+		*dst.FIELDName12 = *m.FIELDName12
 	}
-	if len(this.MapUint32Uint32) != len(that.MapUint32Uint32) {
-		return false
+	if m.XFieldName13 == nil {
+		dst.XFieldName13 = nil
+	} else {
+		if dst.XFieldName13 == nil {
+			dst.XFieldName13 = new(int32)
+		}
+		*dst.XFieldName13 = *m.XFieldName13
 	}
-	for i, vx := range this.MapUint32Uint32 {
-		vy, ok := that.MapUint32Uint32[i]
-		if !ok {
-			return false
+	if m.X_FieldName14 == nil {
+		dst.X_FieldName14 = nil
+	} else {
+		if dst.X_FieldName14 == nil {
+			dst.X_FieldName14 = new(int32)
 		}
-		if vx != vy {
-			return false
+		*dst.X_FieldName14 = *m.X_FieldName14
+	}
+	if m.Field_Name15 == nil {
+		dst.Field_Name15 = nil
+	} else {
+		if dst.Field_Name15 == nil {
+			dst.Field_Name15 = new(int32)
 		}
+		*dst.Field_Name15 = *m.Field_Name15
 	}
-	if len(this.MapUint64Uint64) != len(that.MapUint64Uint64) {
-		return false
+	if m.Field__Name16 == nil {
+		dst.Field__Name16 = nil
+	} else {
+		if dst.Field__Name16 == nil {
+			dst.Field__Name16 = new(int32)
+		}
+		*dst.Field__Name16 = *m.Field__Name16
 	}
-	for i, vx := range this.MapUint64Uint64 {
-		vy, ok := that.MapUint64Uint64[i]
-		if !ok {
-			return false
+	if m.FieldName17__ == nil {
+		dst.FieldName17__ = nil
+	} else {
+		if dst.FieldName17__ == nil {
+			dst.FieldName17__ = new(int32)
 		}
-		if vx != vy {
-			return false
+		*dst.FieldName17__ = *m.FieldName17__
+	}
+	if m.FieldName18__ == nil {
+		dst.FieldName18__ = nil
+	} else {
+		if dst.FieldName18__ == nil {
+			dst.FieldName18__ = new(int32)
 		}
+		*dst.FieldName18__ = *m.FieldName18__
 	}
-	if len(this.MapSint32Sint32) != len(that.MapSint32Sint32) {
-		return false
+	for num := range dst.extensionFields {
+		delete(dst.extensionFields, num)
 	}
-	for i, vx := range this.MapSint32Sint32 {
-		vy, ok := that.MapSint32Sint32[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
+	for num, x := range m.extensionFields {
+		switch num {
+		case 120:
+			rhs := proto.GetExtension(m, E_ExtensionInt32).(int32)
+			proto.SetExtension(dst, E_ExtensionInt32, rhs)
+		default:
+			dst.ProtoReflect().Set(x.Type().TypeDescriptor(), protohelpers.CloneExtension(x.Type(), x.Value()))
 		}
 	}
-	if len(this.MapSint64Sint64) != len(that.MapSint64Sint64) {
-		return false
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *TestAllTypesProto2_OneofUint32) CloneVT() isTestAllTypesProto2_OneofField {
+	if m == nil {
+		return (*TestAllTypesProto2_OneofUint32)(nil)
 	}
-	for i, vx := range this.MapSint64Sint64 {
-		vy, ok := that.MapSint64Sint64[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
-		}
+	r := &TestAllTypesProto2_OneofUint32{
+		OneofUint32: m.OneofUint32,
 	}
-	if len(this.MapFixed32Fixed32) != len(that.MapFixed32Fixed32) {
-		return false
+	return r
+}
+
+func (m *TestAllTypesProto2_OneofNestedMessage) CloneVT() isTestAllTypesProto2_OneofField {
+	if m == nil {
+		return (*TestAllTypesProto2_OneofNestedMessage)(nil)
 	}
-	for i, vx := range this.MapFixed32Fixed32 {
-		vy, ok := that.MapFixed32Fixed32[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
-		}
+	r := &TestAllTypesProto2_OneofNestedMessage{
+		OneofNestedMessage: m.OneofNestedMessage.CloneVT(),
 	}
-	if len(this.MapFixed64Fixed64) != len(that.MapFixed64Fixed64) {
-		return false
+	return r
+}
+
+func (m *TestAllTypesProto2_OneofString) CloneVT() isTestAllTypesProto2_OneofField {
+	if m == nil {
+		return (*TestAllTypesProto2_OneofString)(nil)
 	}
-	for i, vx := range this.MapFixed64Fixed64 {
-		vy, ok := that.MapFixed64Fixed64[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
-		}
+	r := &TestAllTypesProto2_OneofString{
+		OneofString: m.OneofString,
 	}
-	if len(this.MapSfixed32Sfixed32) != len(that.MapSfixed32Sfixed32) {
-		return false
+	return r
+}
+
+func (m *TestAllTypesProto2_OneofBytes) CloneVT() isTestAllTypesProto2_OneofField {
+	if m == nil {
+		return (*TestAllTypesProto2_OneofBytes)(nil)
 	}
-	for i, vx := range this.MapSfixed32Sfixed32 {
-		vy, ok := that.MapSfixed32Sfixed32[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
-		}
+	r := &TestAllTypesProto2_OneofBytes{}
+	if rhs := m.OneofBytes; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.OneofBytes = tmpBytes
 	}
-	if len(this.MapSfixed64Sfixed64) != len(that.MapSfixed64Sfixed64) {
-		return false
+	return r
+}
+
+func (m *TestAllTypesProto2_OneofBool) CloneVT() isTestAllTypesProto2_OneofField {
+	if m == nil {
+		return (*TestAllTypesProto2_OneofBool)(nil)
 	}
-	for i, vx := range this.MapSfixed64Sfixed64 {
-		vy, ok := that.MapSfixed64Sfixed64[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
-		}
+	r := &TestAllTypesProto2_OneofBool{
+		OneofBool: m.OneofBool,
 	}
-	if len(this.MapInt32Float) != len(that.MapInt32Float) {
-		return false
+	return r
+}
+
+func (m *TestAllTypesProto2_OneofUint64) CloneVT() isTestAllTypesProto2_OneofField {
+	if m == nil {
+		return (*TestAllTypesProto2_OneofUint64)(nil)
 	}
-	for i, vx := range this.MapInt32Float {
-		vy, ok := that.MapInt32Float[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
-		}
+	r := &TestAllTypesProto2_OneofUint64{
+		OneofUint64: m.OneofUint64,
 	}
-	if len(this.MapInt32Double) != len(that.MapInt32Double) {
-		return false
+	return r
+}
+
+func (m *TestAllTypesProto2_OneofFloat) CloneVT() isTestAllTypesProto2_OneofField {
+	if m == nil {
+		return (*TestAllTypesProto2_OneofFloat)(nil)
 	}
-	for i, vx := range this.MapInt32Double {
-		vy, ok := that.MapInt32Double[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.MapBoolBool) != len(that.MapBoolBool) {
-		return false
+	r := &TestAllTypesProto2_OneofFloat{
+		OneofFloat: m.OneofFloat,
 	}
-	for i, vx := range this.MapBoolBool {
-		vy, ok := that.MapBoolBool[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
-		}
+	return r
+}
+
+func (m *TestAllTypesProto2_OneofDouble) CloneVT() isTestAllTypesProto2_OneofField {
+	if m == nil {
+		return (*TestAllTypesProto2_OneofDouble)(nil)
 	}
-	if len(this.MapStringString) != len(that.MapStringString) {
-		return false
+	r := &TestAllTypesProto2_OneofDouble{
+		OneofDouble: m.OneofDouble,
 	}
-	for i, vx := range this.MapStringString {
-		vy, ok := that.MapStringString[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
-		}
+	return r
+}
+
+func (m *TestAllTypesProto2_OneofEnum) CloneVT() isTestAllTypesProto2_OneofField {
+	if m == nil {
+		return (*TestAllTypesProto2_OneofEnum)(nil)
 	}
-	if len(this.MapStringBytes) != len(that.MapStringBytes) {
-		return false
+	r := &TestAllTypesProto2_OneofEnum{
+		OneofEnum: m.OneofEnum,
 	}
-	for i, vx := range this.MapStringBytes {
-		vy, ok := that.MapStringBytes[i]
-		if !ok {
-			return false
-		}
-		if string(vx) != string(vy) {
-			return false
-		}
+	return r
+}
+
+func (m *ForeignMessageProto2) CloneVT() *ForeignMessageProto2 {
+	if m == nil {
+		return (*ForeignMessageProto2)(nil)
 	}
-	if len(this.MapStringNestedMessage) != len(that.MapStringNestedMessage) {
-		return false
+	r := &ForeignMessageProto2{}
+	if rhs := m.C; rhs != nil {
+		tmpVal := *rhs
+		r.C = &tmpVal
 	}
-	for i, vx := range this.MapStringNestedMessage {
-		vy, ok := that.MapStringNestedMessage[i]
-		if !ok {
-			return false
-		}
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &TestAllTypesProto2_NestedMessage{}
-			}
-			if q == nil {
-				q = &TestAllTypesProto2_NestedMessage{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
-		}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
 	}
-	if len(this.MapStringForeignMessage) != len(that.MapStringForeignMessage) {
-		return false
+	return r
+}
+
+func (m *ForeignMessageProto2) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *ForeignMessageProto2) CopyToVT(dst *ForeignMessageProto2) {
+	if m == nil {
+		dst.Reset()
+		return
 	}
-	for i, vx := range this.MapStringForeignMessage {
-		vy, ok := that.MapStringForeignMessage[i]
-		if !ok {
-			return false
-		}
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &ForeignMessageProto2{}
-			}
-			if q == nil {
-				q = &ForeignMessageProto2{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
+	if m.C == nil {
+		dst.C = nil
+	} else {
+		if dst.C == nil {
+			dst.C = new(int32)
 		}
+		*dst.C = *m.C
 	}
-	if len(this.MapStringNestedEnum) != len(that.MapStringNestedEnum) {
-		return false
-	}
-	for i, vx := range this.MapStringNestedEnum {
-		vy, ok := that.MapStringNestedEnum[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
-		}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) CloneVT() *UnknownToTestAllTypes_OptionalGroup {
+	if m == nil {
+		return (*UnknownToTestAllTypes_OptionalGroup)(nil)
 	}
-	if len(this.MapStringForeignEnum) != len(that.MapStringForeignEnum) {
-		return false
+	r := &UnknownToTestAllTypes_OptionalGroup{}
+	if rhs := m.A; rhs != nil {
+		tmpVal := *rhs
+		r.A = &tmpVal
 	}
-	for i, vx := range this.MapStringForeignEnum {
-		vy, ok := that.MapStringForeignEnum[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
-		}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
 	}
-	if len(this.PackedInt32) != len(that.PackedInt32) {
-		return false
+	return r
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) CopyToVT(dst *UnknownToTestAllTypes_OptionalGroup) {
+	if m == nil {
+		dst.Reset()
+		return
 	}
-	for i, vx := range this.PackedInt32 {
-		vy := that.PackedInt32[i]
-		if vx != vy {
-			return false
+	if m.A == nil {
+		dst.A = nil
+	} else {
+		if dst.A == nil {
+			dst.A = new(int32)
 		}
+		*dst.A = *m.A
 	}
-	if len(this.PackedInt64) != len(that.PackedInt64) {
-		return false
-	}
-	for i, vx := range this.PackedInt64 {
-		vy := that.PackedInt64[i]
-		if vx != vy {
-			return false
-		}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *UnknownToTestAllTypes) CloneVT() *UnknownToTestAllTypes {
+	if m == nil {
+		return (*UnknownToTestAllTypes)(nil)
 	}
-	if len(this.PackedUint32) != len(that.PackedUint32) {
-		return false
+	r := &UnknownToTestAllTypes{
+		NestedMessage: m.NestedMessage.CloneVT(),
+		Optionalgroup: m.Optionalgroup.CloneVT(),
 	}
-	for i, vx := range this.PackedUint32 {
-		vy := that.PackedUint32[i]
-		if vx != vy {
-			return false
-		}
+	if rhs := m.OptionalInt32; rhs != nil {
+		tmpVal := *rhs
+		r.OptionalInt32 = &tmpVal
 	}
-	if len(this.PackedUint64) != len(that.PackedUint64) {
-		return false
+	if rhs := m.OptionalString; rhs != nil {
+		tmpVal := *rhs
+		r.OptionalString = &tmpVal
 	}
-	for i, vx := range this.PackedUint64 {
-		vy := that.PackedUint64[i]
-		if vx != vy {
-			return false
-		}
+	if rhs := m.OptionalBool; rhs != nil {
+		tmpVal := *rhs
+		r.OptionalBool = &tmpVal
 	}
-	if len(this.PackedSint32) != len(that.PackedSint32) {
-		return false
+	if rhs := m.RepeatedInt32; rhs != nil {
+		tmpContainer := make([]int32, len(rhs))
+		copy(tmpContainer, rhs)
+		r.RepeatedInt32 = tmpContainer
 	}
-	for i, vx := range this.PackedSint32 {
-		vy := that.PackedSint32[i]
-		if vx != vy {
-			return false
-		}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
 	}
-	if len(this.PackedSint64) != len(that.PackedSint64) {
-		return false
+	return r
+}
+
+func (m *UnknownToTestAllTypes) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *UnknownToTestAllTypes) CopyToVT(dst *UnknownToTestAllTypes) {
+	if m == nil {
+		dst.Reset()
+		return
 	}
-	for i, vx := range this.PackedSint64 {
-		vy := that.PackedSint64[i]
-		if vx != vy {
-			return false
+	if m.OptionalInt32 == nil {
+		dst.OptionalInt32 = nil
+	} else {
+		if dst.OptionalInt32 == nil {
+			dst.OptionalInt32 = new(int32)
 		}
+		*dst.OptionalInt32 = *m.OptionalInt32
 	}
-	if len(this.PackedFixed32) != len(that.PackedFixed32) {
-		return false
-	}
-	for i, vx := range this.PackedFixed32 {
-		vy := that.PackedFixed32[i]
-		if vx != vy {
-			return false
+	if m.OptionalString == nil {
+		dst.OptionalString = nil
+	} else {
+		if dst.OptionalString == nil {
+			dst.OptionalString = new(string)
 		}
+		*dst.OptionalString = *m.OptionalString
 	}
-	if len(this.PackedFixed64) != len(that.PackedFixed64) {
-		return false
-	}
-	for i, vx := range this.PackedFixed64 {
-		vy := that.PackedFixed64[i]
-		if vx != vy {
-			return false
+	if m.NestedMessage == nil {
+		dst.NestedMessage = nil
+	} else {
+		if dst.NestedMessage == nil {
+			dst.NestedMessage = m.NestedMessage.CloneVT()
+		} else {
+			m.NestedMessage.CopyToVT(dst.NestedMessage)
 		}
 	}
-	if len(this.PackedSfixed32) != len(that.PackedSfixed32) {
-		return false
+	if m.Optionalgroup == nil {
+		dst.Optionalgroup = nil
+	} else {
+		if dst.Optionalgroup == nil {
+			dst.Optionalgroup = m.Optionalgroup.CloneVT()
+		} else {
+			m.Optionalgroup.CopyToVT(dst.Optionalgroup)
+		}
 	}
-	for i, vx := range this.PackedSfixed32 {
-		vy := that.PackedSfixed32[i]
-		if vx != vy {
-			return false
+	if m.OptionalBool == nil {
+		dst.OptionalBool = nil
+	} else {
+		if dst.OptionalBool == nil {
+			dst.OptionalBool = new(bool)
 		}
+		*dst.OptionalBool = *m.OptionalBool
 	}
-	if len(this.PackedSfixed64) != len(that.PackedSfixed64) {
-		return false
+	dst.RepeatedInt32 = append(dst.RepeatedInt32[:0], m.RepeatedInt32...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *NullHypothesisProto2) CloneVT() *NullHypothesisProto2 {
+	if m == nil {
+		return (*NullHypothesisProto2)(nil)
 	}
-	for i, vx := range this.PackedSfixed64 {
-		vy := that.PackedSfixed64[i]
-		if vx != vy {
-			return false
-		}
+	r := &NullHypothesisProto2{}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
 	}
-	if len(this.PackedFloat) != len(that.PackedFloat) {
-		return false
+	return r
+}
+
+func (m *NullHypothesisProto2) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *NullHypothesisProto2) CopyToVT(dst *NullHypothesisProto2) {
+	if m == nil {
+		dst.Reset()
+		return
 	}
-	for i, vx := range this.PackedFloat {
-		vy := that.PackedFloat[i]
-		if vx != vy {
-			return false
-		}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *EnumOnlyProto2) CloneVT() *EnumOnlyProto2 {
+	if m == nil {
+		return (*EnumOnlyProto2)(nil)
 	}
-	if len(this.PackedDouble) != len(that.PackedDouble) {
-		return false
+	r := &EnumOnlyProto2{}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
 	}
-	for i, vx := range this.PackedDouble {
-		vy := that.PackedDouble[i]
-		if vx != vy {
-			return false
-		}
+	return r
+}
+
+func (m *EnumOnlyProto2) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *EnumOnlyProto2) CopyToVT(dst *EnumOnlyProto2) {
+	if m == nil {
+		dst.Reset()
+		return
 	}
-	if len(this.PackedBool) != len(that.PackedBool) {
-		return false
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *OneStringProto2) CloneVT() *OneStringProto2 {
+	if m == nil {
+		return (*OneStringProto2)(nil)
 	}
-	for i, vx := range this.PackedBool {
-		vy := that.PackedBool[i]
-		if vx != vy {
-			return false
-		}
+	r := &OneStringProto2{}
+	if rhs := m.Data; rhs != nil {
+		tmpVal := *rhs
+		r.Data = &tmpVal
 	}
-	if len(this.PackedNestedEnum) != len(that.PackedNestedEnum) {
-		return false
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
 	}
-	for i, vx := range this.PackedNestedEnum {
-		vy := that.PackedNestedEnum[i]
-		if vx != vy {
-			return false
+	return r
+}
+
+func (m *OneStringProto2) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *OneStringProto2) CopyToVT(dst *OneStringProto2) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.Data == nil {
+		dst.Data = nil
+	} else {
+		if dst.Data == nil {
+			dst.Data = new(string)
 		}
+		*dst.Data = *m.Data
 	}
-	if len(this.UnpackedInt32) != len(that.UnpackedInt32) {
-		return false
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (this *TestAllTypesProto2_NestedMessage) DiffVT(that *TestAllTypesProto2_NestedMessage) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
 	}
-	for i, vx := range this.UnpackedInt32 {
-		vy := that.UnpackedInt32[i]
-		if vx != vy {
-			return false
+	var diffs []vtproto.FieldDiff
+	if p, q := this.A, that.A; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "a", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "a", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "a", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.Corecursive, that.Corecursive; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "corecursive", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "corecursive", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if nested := p.DiffVT(q); len(nested) > 0 {
+			diffs = protohelpers.AppendDiffs(diffs, "corecursive", nested)
 		}
 	}
-	if len(this.UnpackedInt64) != len(that.UnpackedInt64) {
-		return false
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
 	}
-	for i, vx := range this.UnpackedInt64 {
-		vy := that.UnpackedInt64[i]
-		if vx != vy {
-			return false
-		}
+	return diffs
+}
+
+func (this *TestAllTypesProto2_Data) DiffVT(that *TestAllTypesProto2_Data) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.GroupInt32, that.GroupInt32; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "group_int32", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "group_int32", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "group_int32", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.GroupUint32, that.GroupUint32; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "group_uint32", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "group_uint32", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "group_uint32", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *TestAllTypesProto2_MessageSetCorrect) DiffVT(that *TestAllTypesProto2_MessageSetCorrect) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
 	}
-	if len(this.UnpackedUint32) != len(that.UnpackedUint32) {
-		return false
+	var diffs []vtproto.FieldDiff
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
 	}
-	for i, vx := range this.UnpackedUint32 {
-		vy := that.UnpackedUint32[i]
-		if vx != vy {
-			return false
-		}
+	return diffs
+}
+
+func (this *TestAllTypesProto2_MessageSetCorrectExtension1) DiffVT(that *TestAllTypesProto2_MessageSetCorrectExtension1) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
 	}
-	if len(this.UnpackedUint64) != len(that.UnpackedUint64) {
-		return false
+	var diffs []vtproto.FieldDiff
+	if p, q := this.Str, that.Str; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "str", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "str", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "str", Kind: vtproto.FieldChanged, Old: *p, New: *q})
 	}
-	for i, vx := range this.UnpackedUint64 {
-		vy := that.UnpackedUint64[i]
-		if vx != vy {
-			return false
-		}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
 	}
-	if len(this.UnpackedSint32) != len(that.UnpackedSint32) {
-		return false
+	return diffs
+}
+
+func (this *TestAllTypesProto2_MessageSetCorrectExtension2) DiffVT(that *TestAllTypesProto2_MessageSetCorrectExtension2) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
 	}
-	for i, vx := range this.UnpackedSint32 {
-		vy := that.UnpackedSint32[i]
-		if vx != vy {
-			return false
-		}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.I, that.I; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "i", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "i", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "i", Kind: vtproto.FieldChanged, Old: *p, New: *q})
 	}
-	if len(this.UnpackedSint64) != len(that.UnpackedSint64) {
-		return false
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
 	}
-	for i, vx := range this.UnpackedSint64 {
-		vy := that.UnpackedSint64[i]
-		if vx != vy {
-			return false
-		}
+	return diffs
+}
+
+func (this *TestAllTypesProto2) DiffVT(that *TestAllTypesProto2) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.OptionalInt32, that.OptionalInt32; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int32", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int32", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int32", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalInt64, that.OptionalInt64; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int64", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int64", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int64", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalUint32, that.OptionalUint32; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_uint32", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_uint32", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_uint32", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalUint64, that.OptionalUint64; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_uint64", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_uint64", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_uint64", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalSint32, that.OptionalSint32; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sint32", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sint32", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sint32", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalSint64, that.OptionalSint64; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sint64", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sint64", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sint64", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalFixed32, that.OptionalFixed32; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_fixed32", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_fixed32", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_fixed32", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalFixed64, that.OptionalFixed64; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_fixed64", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_fixed64", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_fixed64", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalSfixed32, that.OptionalSfixed32; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sfixed32", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sfixed32", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sfixed32", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalSfixed64, that.OptionalSfixed64; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sfixed64", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sfixed64", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sfixed64", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalFloat, that.OptionalFloat; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_float", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_float", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_float", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalDouble, that.OptionalDouble; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_double", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_double", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_double", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalBool, that.OptionalBool; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bool", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bool", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bool", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalString, that.OptionalString; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_string", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_string", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_string", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalBytes, that.OptionalBytes; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bytes", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bytes", Kind: vtproto.FieldRemoved, Old: p})
+	} else if string(p) != string(q) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bytes", Kind: vtproto.FieldChanged, Old: p, New: q})
+	}
+	if p, q := this.OptionalNestedMessage, that.OptionalNestedMessage; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_nested_message", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_nested_message", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if nested := p.DiffVT(q); len(nested) > 0 {
+			diffs = protohelpers.AppendDiffs(diffs, "optional_nested_message", nested)
+		}
+	}
+	if p, q := this.OptionalForeignMessage, that.OptionalForeignMessage; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_foreign_message", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_foreign_message", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if nested := p.DiffVT(q); len(nested) > 0 {
+			diffs = protohelpers.AppendDiffs(diffs, "optional_foreign_message", nested)
+		}
+	}
+	if p, q := this.OptionalNestedEnum, that.OptionalNestedEnum; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_nested_enum", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_nested_enum", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_nested_enum", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalForeignEnum, that.OptionalForeignEnum; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_foreign_enum", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_foreign_enum", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_foreign_enum", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalStringPiece, that.OptionalStringPiece; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_string_piece", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_string_piece", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_string_piece", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalCord, that.OptionalCord; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_cord", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_cord", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_cord", Kind: vtproto.FieldChanged, Old: *p, New: *q})
 	}
-	if len(this.UnpackedFixed32) != len(that.UnpackedFixed32) {
-		return false
+	if p, q := this.RecursiveMessage, that.RecursiveMessage; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "recursive_message", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "recursive_message", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if nested := p.DiffVT(q); len(nested) > 0 {
+			diffs = protohelpers.AppendDiffs(diffs, "recursive_message", nested)
+		}
 	}
-	for i, vx := range this.UnpackedFixed32 {
-		vy := that.UnpackedFixed32[i]
-		if vx != vy {
-			return false
+	for i := 0; i < len(this.RepeatedInt32) && i < len(that.RepeatedInt32); i++ {
+		if this.RepeatedInt32[i] != that.RepeatedInt32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedInt32[i], New: that.RepeatedInt32[i]})
 		}
 	}
-	if len(this.UnpackedFixed64) != len(that.UnpackedFixed64) {
-		return false
+	for i := len(that.RepeatedInt32); i < len(this.RepeatedInt32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedInt32[i]})
 	}
-	for i, vx := range this.UnpackedFixed64 {
-		vy := that.UnpackedFixed64[i]
-		if vx != vy {
-			return false
+	for i := len(this.RepeatedInt32); i < len(that.RepeatedInt32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedInt32[i]})
+	}
+	for i := 0; i < len(this.RepeatedInt64) && i < len(that.RepeatedInt64); i++ {
+		if this.RepeatedInt64[i] != that.RepeatedInt64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedInt64[i], New: that.RepeatedInt64[i]})
 		}
 	}
-	if len(this.UnpackedSfixed32) != len(that.UnpackedSfixed32) {
-		return false
+	for i := len(that.RepeatedInt64); i < len(this.RepeatedInt64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedInt64[i]})
 	}
-	for i, vx := range this.UnpackedSfixed32 {
-		vy := that.UnpackedSfixed32[i]
-		if vx != vy {
-			return false
+	for i := len(this.RepeatedInt64); i < len(that.RepeatedInt64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedInt64[i]})
+	}
+	for i := 0; i < len(this.RepeatedUint32) && i < len(that.RepeatedUint32); i++ {
+		if this.RepeatedUint32[i] != that.RepeatedUint32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_uint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedUint32[i], New: that.RepeatedUint32[i]})
 		}
 	}
-	if len(this.UnpackedSfixed64) != len(that.UnpackedSfixed64) {
-		return false
+	for i := len(that.RepeatedUint32); i < len(this.RepeatedUint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_uint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedUint32[i]})
 	}
-	for i, vx := range this.UnpackedSfixed64 {
-		vy := that.UnpackedSfixed64[i]
-		if vx != vy {
-			return false
+	for i := len(this.RepeatedUint32); i < len(that.RepeatedUint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_uint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedUint32[i]})
+	}
+	for i := 0; i < len(this.RepeatedUint64) && i < len(that.RepeatedUint64); i++ {
+		if this.RepeatedUint64[i] != that.RepeatedUint64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_uint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedUint64[i], New: that.RepeatedUint64[i]})
 		}
 	}
-	if len(this.UnpackedFloat) != len(that.UnpackedFloat) {
-		return false
+	for i := len(that.RepeatedUint64); i < len(this.RepeatedUint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_uint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedUint64[i]})
 	}
-	for i, vx := range this.UnpackedFloat {
-		vy := that.UnpackedFloat[i]
-		if vx != vy {
-			return false
+	for i := len(this.RepeatedUint64); i < len(that.RepeatedUint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_uint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedUint64[i]})
+	}
+	for i := 0; i < len(this.RepeatedSint32) && i < len(that.RepeatedSint32); i++ {
+		if this.RepeatedSint32[i] != that.RepeatedSint32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedSint32[i], New: that.RepeatedSint32[i]})
 		}
 	}
-	if len(this.UnpackedDouble) != len(that.UnpackedDouble) {
-		return false
+	for i := len(that.RepeatedSint32); i < len(this.RepeatedSint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedSint32[i]})
 	}
-	for i, vx := range this.UnpackedDouble {
-		vy := that.UnpackedDouble[i]
-		if vx != vy {
-			return false
+	for i := len(this.RepeatedSint32); i < len(that.RepeatedSint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedSint32[i]})
+	}
+	for i := 0; i < len(this.RepeatedSint64) && i < len(that.RepeatedSint64); i++ {
+		if this.RepeatedSint64[i] != that.RepeatedSint64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedSint64[i], New: that.RepeatedSint64[i]})
 		}
 	}
-	if len(this.UnpackedBool) != len(that.UnpackedBool) {
-		return false
+	for i := len(that.RepeatedSint64); i < len(this.RepeatedSint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedSint64[i]})
 	}
-	for i, vx := range this.UnpackedBool {
-		vy := that.UnpackedBool[i]
-		if vx != vy {
-			return false
+	for i := len(this.RepeatedSint64); i < len(that.RepeatedSint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedSint64[i]})
+	}
+	for i := 0; i < len(this.RepeatedFixed32) && i < len(that.RepeatedFixed32); i++ {
+		if this.RepeatedFixed32[i] != that.RepeatedFixed32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_fixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedFixed32[i], New: that.RepeatedFixed32[i]})
 		}
 	}
-	if len(this.UnpackedNestedEnum) != len(that.UnpackedNestedEnum) {
-		return false
+	for i := len(that.RepeatedFixed32); i < len(this.RepeatedFixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_fixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedFixed32[i]})
 	}
-	for i, vx := range this.UnpackedNestedEnum {
-		vy := that.UnpackedNestedEnum[i]
-		if vx != vy {
-			return false
+	for i := len(this.RepeatedFixed32); i < len(that.RepeatedFixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_fixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedFixed32[i]})
+	}
+	for i := 0; i < len(this.RepeatedFixed64) && i < len(that.RepeatedFixed64); i++ {
+		if this.RepeatedFixed64[i] != that.RepeatedFixed64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_fixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedFixed64[i], New: that.RepeatedFixed64[i]})
 		}
 	}
-	if !this.Data.EqualVT(that.Data) {
-		return false
+	for i := len(that.RepeatedFixed64); i < len(this.RepeatedFixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_fixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedFixed64[i]})
 	}
-	if p, q := this.DefaultInt32, that.DefaultInt32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(this.RepeatedFixed64); i < len(that.RepeatedFixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_fixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedFixed64[i]})
 	}
-	if p, q := this.DefaultInt64, that.DefaultInt64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := 0; i < len(this.RepeatedSfixed32) && i < len(that.RepeatedSfixed32); i++ {
+		if this.RepeatedSfixed32[i] != that.RepeatedSfixed32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sfixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedSfixed32[i], New: that.RepeatedSfixed32[i]})
+		}
 	}
-	if p, q := this.DefaultUint32, that.DefaultUint32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(that.RepeatedSfixed32); i < len(this.RepeatedSfixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sfixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedSfixed32[i]})
 	}
-	if p, q := this.DefaultUint64, that.DefaultUint64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(this.RepeatedSfixed32); i < len(that.RepeatedSfixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sfixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedSfixed32[i]})
 	}
-	if p, q := this.DefaultSint32, that.DefaultSint32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := 0; i < len(this.RepeatedSfixed64) && i < len(that.RepeatedSfixed64); i++ {
+		if this.RepeatedSfixed64[i] != that.RepeatedSfixed64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sfixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedSfixed64[i], New: that.RepeatedSfixed64[i]})
+		}
 	}
-	if p, q := this.DefaultSint64, that.DefaultSint64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(that.RepeatedSfixed64); i < len(this.RepeatedSfixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sfixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedSfixed64[i]})
 	}
-	if p, q := this.DefaultFixed32, that.DefaultFixed32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(this.RepeatedSfixed64); i < len(that.RepeatedSfixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sfixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedSfixed64[i]})
 	}
-	if p, q := this.DefaultFixed64, that.DefaultFixed64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := 0; i < len(this.RepeatedFloat) && i < len(that.RepeatedFloat); i++ {
+		if this.RepeatedFloat[i] != that.RepeatedFloat[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_float[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedFloat[i], New: that.RepeatedFloat[i]})
+		}
 	}
-	if p, q := this.DefaultSfixed32, that.DefaultSfixed32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(that.RepeatedFloat); i < len(this.RepeatedFloat); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_float[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedFloat[i]})
 	}
-	if p, q := this.DefaultSfixed64, that.DefaultSfixed64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(this.RepeatedFloat); i < len(that.RepeatedFloat); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_float[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedFloat[i]})
 	}
-	if p, q := this.DefaultFloat, that.DefaultFloat; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := 0; i < len(this.RepeatedDouble) && i < len(that.RepeatedDouble); i++ {
+		if this.RepeatedDouble[i] != that.RepeatedDouble[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_double[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedDouble[i], New: that.RepeatedDouble[i]})
+		}
 	}
-	if p, q := this.DefaultDouble, that.DefaultDouble; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(that.RepeatedDouble); i < len(this.RepeatedDouble); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_double[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedDouble[i]})
 	}
-	if p, q := this.DefaultBool, that.DefaultBool; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(this.RepeatedDouble); i < len(that.RepeatedDouble); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_double[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedDouble[i]})
 	}
-	if p, q := this.DefaultString, that.DefaultString; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := 0; i < len(this.RepeatedBool) && i < len(that.RepeatedBool); i++ {
+		if this.RepeatedBool[i] != that.RepeatedBool[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_bool[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedBool[i], New: that.RepeatedBool[i]})
+		}
 	}
-	if p, q := this.DefaultBytes, that.DefaultBytes; (p == nil && q != nil) || (p != nil && q == nil) || string(p) != string(q) {
-		return false
+	for i := len(that.RepeatedBool); i < len(this.RepeatedBool); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_bool[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedBool[i]})
 	}
-	if p, q := this.Fieldname1, that.Fieldname1; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(this.RepeatedBool); i < len(that.RepeatedBool); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_bool[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedBool[i]})
 	}
-	if p, q := this.FieldName2, that.FieldName2; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := 0; i < len(this.RepeatedString) && i < len(that.RepeatedString); i++ {
+		if this.RepeatedString[i] != that.RepeatedString[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_string[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedString[i], New: that.RepeatedString[i]})
+		}
 	}
-	if p, q := this.XFieldName3, that.XFieldName3; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(that.RepeatedString); i < len(this.RepeatedString); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_string[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedString[i]})
 	}
-	if p, q := this.Field_Name4_, that.Field_Name4_; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(this.RepeatedString); i < len(that.RepeatedString); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_string[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedString[i]})
 	}
-	if p, q := this.Field0Name5, that.Field0Name5; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := 0; i < len(this.RepeatedBytes) && i < len(that.RepeatedBytes); i++ {
+		if string(this.RepeatedBytes[i]) != string(that.RepeatedBytes[i]) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_bytes[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedBytes[i], New: that.RepeatedBytes[i]})
+		}
 	}
-	if p, q := this.Field_0Name6, that.Field_0Name6; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(that.RepeatedBytes); i < len(this.RepeatedBytes); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_bytes[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedBytes[i]})
 	}
-	if p, q := this.FieldName7, that.FieldName7; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(this.RepeatedBytes); i < len(that.RepeatedBytes); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_bytes[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedBytes[i]})
 	}
-	if p, q := this.FieldName8, that.FieldName8; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := 0; i < len(this.RepeatedNestedMessage) && i < len(that.RepeatedNestedMessage); i++ {
+		if p, q := this.RepeatedNestedMessage[i], that.RepeatedNestedMessage[i]; p != q {
+			if p == nil {
+				p = &TestAllTypesProto2_NestedMessage{}
+			}
+			if q == nil {
+				q = &TestAllTypesProto2_NestedMessage{}
+			}
+			if nested := p.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "repeated_nested_message["+strconv.Itoa(i)+"]", nested)
+			}
+		}
 	}
-	if p, q := this.Field_Name9, that.Field_Name9; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(that.RepeatedNestedMessage); i < len(this.RepeatedNestedMessage); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_nested_message[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedNestedMessage[i]})
 	}
-	if p, q := this.Field_Name10, that.Field_Name10; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(this.RepeatedNestedMessage); i < len(that.RepeatedNestedMessage); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_nested_message[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedNestedMessage[i]})
 	}
-	if p, q := this.FIELD_NAME11, that.FIELD_NAME11; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := 0; i < len(this.RepeatedForeignMessage) && i < len(that.RepeatedForeignMessage); i++ {
+		if p, q := this.RepeatedForeignMessage[i], that.RepeatedForeignMessage[i]; p != q {
+			if p == nil {
+				p = &ForeignMessageProto2{}
+			}
+			if q == nil {
+				q = &ForeignMessageProto2{}
+			}
+			if nested := p.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "repeated_foreign_message["+strconv.Itoa(i)+"]", nested)
+			}
+		}
 	}
-	if p, q := this.FIELDName12, that.FIELDName12; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(that.RepeatedForeignMessage); i < len(this.RepeatedForeignMessage); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_foreign_message[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedForeignMessage[i]})
 	}
-	if p, q := this.XFieldName13, that.XFieldName13; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(this.RepeatedForeignMessage); i < len(that.RepeatedForeignMessage); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_foreign_message[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedForeignMessage[i]})
 	}
-	if p, q := this.X_FieldName14, that.X_FieldName14; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := 0; i < len(this.RepeatedNestedEnum) && i < len(that.RepeatedNestedEnum); i++ {
+		if this.RepeatedNestedEnum[i] != that.RepeatedNestedEnum[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_nested_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedNestedEnum[i], New: that.RepeatedNestedEnum[i]})
+		}
 	}
-	if p, q := this.Field_Name15, that.Field_Name15; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(that.RepeatedNestedEnum); i < len(this.RepeatedNestedEnum); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_nested_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedNestedEnum[i]})
 	}
-	if p, q := this.Field__Name16, that.Field__Name16; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(this.RepeatedNestedEnum); i < len(that.RepeatedNestedEnum); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_nested_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedNestedEnum[i]})
 	}
-	if p, q := this.FieldName17__, that.FieldName17__; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := 0; i < len(this.RepeatedForeignEnum) && i < len(that.RepeatedForeignEnum); i++ {
+		if this.RepeatedForeignEnum[i] != that.RepeatedForeignEnum[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_foreign_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedForeignEnum[i], New: that.RepeatedForeignEnum[i]})
+		}
 	}
-	if p, q := this.FieldName18__, that.FieldName18__; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(that.RepeatedForeignEnum); i < len(this.RepeatedForeignEnum); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_foreign_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedForeignEnum[i]})
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
-}
-
-func (this *TestAllTypesProto2_OneofUint32) EqualVT(thatIface isTestAllTypesProto2_OneofField) bool {
-	that, ok := thatIface.(*TestAllTypesProto2_OneofUint32)
-	if !ok {
-		return false
+	for i := len(this.RepeatedForeignEnum); i < len(that.RepeatedForeignEnum); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_foreign_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedForeignEnum[i]})
 	}
-	if this == that {
-		return true
+	for i := 0; i < len(this.RepeatedStringPiece) && i < len(that.RepeatedStringPiece); i++ {
+		if this.RepeatedStringPiece[i] != that.RepeatedStringPiece[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_string_piece[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedStringPiece[i], New: that.RepeatedStringPiece[i]})
+		}
 	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	for i := len(that.RepeatedStringPiece); i < len(this.RepeatedStringPiece); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_string_piece[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedStringPiece[i]})
 	}
-	if this.OneofUint32 != that.OneofUint32 {
-		return false
+	for i := len(this.RepeatedStringPiece); i < len(that.RepeatedStringPiece); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_string_piece[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedStringPiece[i]})
 	}
-	return true
-}
-
-func (this *TestAllTypesProto2_OneofNestedMessage) EqualVT(thatIface isTestAllTypesProto2_OneofField) bool {
-	that, ok := thatIface.(*TestAllTypesProto2_OneofNestedMessage)
-	if !ok {
-		return false
+	for i := 0; i < len(this.RepeatedCord) && i < len(that.RepeatedCord); i++ {
+		if this.RepeatedCord[i] != that.RepeatedCord[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_cord[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedCord[i], New: that.RepeatedCord[i]})
+		}
 	}
-	if this == that {
-		return true
+	for i := len(that.RepeatedCord); i < len(this.RepeatedCord); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_cord[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedCord[i]})
 	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	for i := len(this.RepeatedCord); i < len(that.RepeatedCord); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_cord[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedCord[i]})
 	}
-	if p, q := this.OneofNestedMessage, that.OneofNestedMessage; p != q {
-		if p == nil {
-			p = &TestAllTypesProto2_NestedMessage{}
+	if len(this.MapInt32Int32) > 0 || len(that.MapInt32Int32) > 0 {
+		keys := make([]int32, 0, len(this.MapInt32Int32)+len(that.MapInt32Int32))
+		for k := range this.MapInt32Int32 {
+			keys = append(keys, k)
 		}
-		if q == nil {
-			q = &TestAllTypesProto2_NestedMessage{}
+		for k := range that.MapInt32Int32 {
+			if _, ok := this.MapInt32Int32[k]; !ok {
+				keys = append(keys, k)
+			}
 		}
-		if !p.EqualVT(q) {
-			return false
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapInt32Int32[k]
+			vy, yok := that.MapInt32Int32[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_int32_int32[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_int32_int32[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_int32_int32[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
 		}
 	}
-	return true
-}
-
-func (this *TestAllTypesProto2_OneofString) EqualVT(thatIface isTestAllTypesProto2_OneofField) bool {
-	that, ok := thatIface.(*TestAllTypesProto2_OneofString)
-	if !ok {
-		return false
-	}
-	if this == that {
-		return true
-	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	if len(this.MapInt64Int64) > 0 || len(that.MapInt64Int64) > 0 {
+		keys := make([]int64, 0, len(this.MapInt64Int64)+len(that.MapInt64Int64))
+		for k := range this.MapInt64Int64 {
+			keys = append(keys, k)
+		}
+		for k := range that.MapInt64Int64 {
+			if _, ok := this.MapInt64Int64[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapInt64Int64[k]
+			vy, yok := that.MapInt64Int64[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_int64_int64[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_int64_int64[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_int64_int64[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
 	}
-	if this.OneofString != that.OneofString {
-		return false
+	if len(this.MapUint32Uint32) > 0 || len(that.MapUint32Uint32) > 0 {
+		keys := make([]uint32, 0, len(this.MapUint32Uint32)+len(that.MapUint32Uint32))
+		for k := range this.MapUint32Uint32 {
+			keys = append(keys, k)
+		}
+		for k := range that.MapUint32Uint32 {
+			if _, ok := this.MapUint32Uint32[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapUint32Uint32[k]
+			vy, yok := that.MapUint32Uint32[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_uint32_uint32[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_uint32_uint32[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_uint32_uint32[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
 	}
-	return true
-}
-
-func (this *TestAllTypesProto2_OneofBytes) EqualVT(thatIface isTestAllTypesProto2_OneofField) bool {
-	that, ok := thatIface.(*TestAllTypesProto2_OneofBytes)
-	if !ok {
-		return false
+	if len(this.MapUint64Uint64) > 0 || len(that.MapUint64Uint64) > 0 {
+		keys := make([]uint64, 0, len(this.MapUint64Uint64)+len(that.MapUint64Uint64))
+		for k := range this.MapUint64Uint64 {
+			keys = append(keys, k)
+		}
+		for k := range that.MapUint64Uint64 {
+			if _, ok := this.MapUint64Uint64[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapUint64Uint64[k]
+			vy, yok := that.MapUint64Uint64[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_uint64_uint64[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_uint64_uint64[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_uint64_uint64[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
 	}
-	if this == that {
-		return true
+	if len(this.MapSint32Sint32) > 0 || len(that.MapSint32Sint32) > 0 {
+		keys := make([]int32, 0, len(this.MapSint32Sint32)+len(that.MapSint32Sint32))
+		for k := range this.MapSint32Sint32 {
+			keys = append(keys, k)
+		}
+		for k := range that.MapSint32Sint32 {
+			if _, ok := this.MapSint32Sint32[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapSint32Sint32[k]
+			vy, yok := that.MapSint32Sint32[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_sint32_sint32[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_sint32_sint32[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_sint32_sint32[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
 	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	if len(this.MapSint64Sint64) > 0 || len(that.MapSint64Sint64) > 0 {
+		keys := make([]int64, 0, len(this.MapSint64Sint64)+len(that.MapSint64Sint64))
+		for k := range this.MapSint64Sint64 {
+			keys = append(keys, k)
+		}
+		for k := range that.MapSint64Sint64 {
+			if _, ok := this.MapSint64Sint64[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapSint64Sint64[k]
+			vy, yok := that.MapSint64Sint64[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_sint64_sint64[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_sint64_sint64[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_sint64_sint64[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
 	}
-	if string(this.OneofBytes) != string(that.OneofBytes) {
-		return false
+	if len(this.MapFixed32Fixed32) > 0 || len(that.MapFixed32Fixed32) > 0 {
+		keys := make([]uint32, 0, len(this.MapFixed32Fixed32)+len(that.MapFixed32Fixed32))
+		for k := range this.MapFixed32Fixed32 {
+			keys = append(keys, k)
+		}
+		for k := range that.MapFixed32Fixed32 {
+			if _, ok := this.MapFixed32Fixed32[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapFixed32Fixed32[k]
+			vy, yok := that.MapFixed32Fixed32[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_fixed32_fixed32[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_fixed32_fixed32[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_fixed32_fixed32[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
 	}
-	return true
-}
-
-func (this *TestAllTypesProto2_OneofBool) EqualVT(thatIface isTestAllTypesProto2_OneofField) bool {
-	that, ok := thatIface.(*TestAllTypesProto2_OneofBool)
-	if !ok {
-		return false
+	if len(this.MapFixed64Fixed64) > 0 || len(that.MapFixed64Fixed64) > 0 {
+		keys := make([]uint64, 0, len(this.MapFixed64Fixed64)+len(that.MapFixed64Fixed64))
+		for k := range this.MapFixed64Fixed64 {
+			keys = append(keys, k)
+		}
+		for k := range that.MapFixed64Fixed64 {
+			if _, ok := this.MapFixed64Fixed64[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapFixed64Fixed64[k]
+			vy, yok := that.MapFixed64Fixed64[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_fixed64_fixed64[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_fixed64_fixed64[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_fixed64_fixed64[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
 	}
-	if this == that {
-		return true
+	if len(this.MapSfixed32Sfixed32) > 0 || len(that.MapSfixed32Sfixed32) > 0 {
+		keys := make([]int32, 0, len(this.MapSfixed32Sfixed32)+len(that.MapSfixed32Sfixed32))
+		for k := range this.MapSfixed32Sfixed32 {
+			keys = append(keys, k)
+		}
+		for k := range that.MapSfixed32Sfixed32 {
+			if _, ok := this.MapSfixed32Sfixed32[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapSfixed32Sfixed32[k]
+			vy, yok := that.MapSfixed32Sfixed32[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_sfixed32_sfixed32[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_sfixed32_sfixed32[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_sfixed32_sfixed32[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
 	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	if len(this.MapSfixed64Sfixed64) > 0 || len(that.MapSfixed64Sfixed64) > 0 {
+		keys := make([]int64, 0, len(this.MapSfixed64Sfixed64)+len(that.MapSfixed64Sfixed64))
+		for k := range this.MapSfixed64Sfixed64 {
+			keys = append(keys, k)
+		}
+		for k := range that.MapSfixed64Sfixed64 {
+			if _, ok := this.MapSfixed64Sfixed64[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapSfixed64Sfixed64[k]
+			vy, yok := that.MapSfixed64Sfixed64[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_sfixed64_sfixed64[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_sfixed64_sfixed64[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_sfixed64_sfixed64[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
 	}
-	if this.OneofBool != that.OneofBool {
-		return false
+	if len(this.MapInt32Float) > 0 || len(that.MapInt32Float) > 0 {
+		keys := make([]int32, 0, len(this.MapInt32Float)+len(that.MapInt32Float))
+		for k := range this.MapInt32Float {
+			keys = append(keys, k)
+		}
+		for k := range that.MapInt32Float {
+			if _, ok := this.MapInt32Float[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapInt32Float[k]
+			vy, yok := that.MapInt32Float[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_int32_float[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_int32_float[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_int32_float[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
 	}
-	return true
-}
-
-func (this *TestAllTypesProto2_OneofUint64) EqualVT(thatIface isTestAllTypesProto2_OneofField) bool {
-	that, ok := thatIface.(*TestAllTypesProto2_OneofUint64)
-	if !ok {
-		return false
+	if len(this.MapInt32Double) > 0 || len(that.MapInt32Double) > 0 {
+		keys := make([]int32, 0, len(this.MapInt32Double)+len(that.MapInt32Double))
+		for k := range this.MapInt32Double {
+			keys = append(keys, k)
+		}
+		for k := range that.MapInt32Double {
+			if _, ok := this.MapInt32Double[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapInt32Double[k]
+			vy, yok := that.MapInt32Double[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_int32_double[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_int32_double[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_int32_double[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
 	}
-	if this == that {
-		return true
+	if len(this.MapBoolBool) > 0 || len(that.MapBoolBool) > 0 {
+		keys := make([]bool, 0, len(this.MapBoolBool)+len(that.MapBoolBool))
+		for k := range this.MapBoolBool {
+			keys = append(keys, k)
+		}
+		for k := range that.MapBoolBool {
+			if _, ok := this.MapBoolBool[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return !keys[i] && keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapBoolBool[k]
+			vy, yok := that.MapBoolBool[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_bool_bool[" + strconv.FormatBool(k) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_bool_bool[" + strconv.FormatBool(k) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_bool_bool[" + strconv.FormatBool(k) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
 	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	if len(this.MapStringString) > 0 || len(that.MapStringString) > 0 {
+		keys := make([]string, 0, len(this.MapStringString)+len(that.MapStringString))
+		for k := range this.MapStringString {
+			keys = append(keys, k)
+		}
+		for k := range that.MapStringString {
+			if _, ok := this.MapStringString[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapStringString[k]
+			vy, yok := that.MapStringString[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_string[" + strconv.Quote(k) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_string[" + strconv.Quote(k) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_string[" + strconv.Quote(k) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
 	}
-	if this.OneofUint64 != that.OneofUint64 {
-		return false
+	if len(this.MapStringBytes) > 0 || len(that.MapStringBytes) > 0 {
+		keys := make([]string, 0, len(this.MapStringBytes)+len(that.MapStringBytes))
+		for k := range this.MapStringBytes {
+			keys = append(keys, k)
+		}
+		for k := range that.MapStringBytes {
+			if _, ok := this.MapStringBytes[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapStringBytes[k]
+			vy, yok := that.MapStringBytes[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_bytes[" + strconv.Quote(k) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_bytes[" + strconv.Quote(k) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if string(vx) != string(vy) {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_bytes[" + strconv.Quote(k) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
 	}
-	return true
-}
-
-func (this *TestAllTypesProto2_OneofFloat) EqualVT(thatIface isTestAllTypesProto2_OneofField) bool {
-	that, ok := thatIface.(*TestAllTypesProto2_OneofFloat)
-	if !ok {
-		return false
+	if len(this.MapStringNestedMessage) > 0 || len(that.MapStringNestedMessage) > 0 {
+		keys := make([]string, 0, len(this.MapStringNestedMessage)+len(that.MapStringNestedMessage))
+		for k := range this.MapStringNestedMessage {
+			keys = append(keys, k)
+		}
+		for k := range that.MapStringNestedMessage {
+			if _, ok := this.MapStringNestedMessage[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapStringNestedMessage[k]
+			vy, yok := that.MapStringNestedMessage[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_nested_message[" + strconv.Quote(k) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_nested_message[" + strconv.Quote(k) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if p, q := vx, vy; p != q {
+					if p == nil {
+						p = &TestAllTypesProto2_NestedMessage{}
+					}
+					if q == nil {
+						q = &TestAllTypesProto2_NestedMessage{}
+					}
+					if nested := p.DiffVT(q); len(nested) > 0 {
+						diffs = protohelpers.AppendDiffs(diffs, "map_string_nested_message["+strconv.Quote(k)+"]", nested)
+					}
+				}
+			}
+		}
 	}
-	if this == that {
-		return true
+	if len(this.MapStringForeignMessage) > 0 || len(that.MapStringForeignMessage) > 0 {
+		keys := make([]string, 0, len(this.MapStringForeignMessage)+len(that.MapStringForeignMessage))
+		for k := range this.MapStringForeignMessage {
+			keys = append(keys, k)
+		}
+		for k := range that.MapStringForeignMessage {
+			if _, ok := this.MapStringForeignMessage[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapStringForeignMessage[k]
+			vy, yok := that.MapStringForeignMessage[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_foreign_message[" + strconv.Quote(k) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_foreign_message[" + strconv.Quote(k) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if p, q := vx, vy; p != q {
+					if p == nil {
+						p = &ForeignMessageProto2{}
+					}
+					if q == nil {
+						q = &ForeignMessageProto2{}
+					}
+					if nested := p.DiffVT(q); len(nested) > 0 {
+						diffs = protohelpers.AppendDiffs(diffs, "map_string_foreign_message["+strconv.Quote(k)+"]", nested)
+					}
+				}
+			}
+		}
 	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	if len(this.MapStringNestedEnum) > 0 || len(that.MapStringNestedEnum) > 0 {
+		keys := make([]string, 0, len(this.MapStringNestedEnum)+len(that.MapStringNestedEnum))
+		for k := range this.MapStringNestedEnum {
+			keys = append(keys, k)
+		}
+		for k := range that.MapStringNestedEnum {
+			if _, ok := this.MapStringNestedEnum[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapStringNestedEnum[k]
+			vy, yok := that.MapStringNestedEnum[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_nested_enum[" + strconv.Quote(k) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_nested_enum[" + strconv.Quote(k) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_nested_enum[" + strconv.Quote(k) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
 	}
-	if this.OneofFloat != that.OneofFloat {
-		return false
+	if len(this.MapStringForeignEnum) > 0 || len(that.MapStringForeignEnum) > 0 {
+		keys := make([]string, 0, len(this.MapStringForeignEnum)+len(that.MapStringForeignEnum))
+		for k := range this.MapStringForeignEnum {
+			keys = append(keys, k)
+		}
+		for k := range that.MapStringForeignEnum {
+			if _, ok := this.MapStringForeignEnum[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapStringForeignEnum[k]
+			vy, yok := that.MapStringForeignEnum[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_foreign_enum[" + strconv.Quote(k) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_foreign_enum[" + strconv.Quote(k) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_foreign_enum[" + strconv.Quote(k) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
 	}
-	return true
+	for i := 0; i < len(this.PackedInt32) && i < len(that.PackedInt32); i++ {
+		if this.PackedInt32[i] != that.PackedInt32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedInt32[i], New: that.PackedInt32[i]})
+		}
+	}
+	for i := len(that.PackedInt32); i < len(this.PackedInt32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedInt32[i]})
+	}
+	for i := len(this.PackedInt32); i < len(that.PackedInt32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedInt32[i]})
+	}
+	for i := 0; i < len(this.PackedInt64) && i < len(that.PackedInt64); i++ {
+		if this.PackedInt64[i] != that.PackedInt64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_int64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedInt64[i], New: that.PackedInt64[i]})
+		}
+	}
+	for i := len(that.PackedInt64); i < len(this.PackedInt64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_int64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedInt64[i]})
+	}
+	for i := len(this.PackedInt64); i < len(that.PackedInt64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_int64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedInt64[i]})
+	}
+	for i := 0; i < len(this.PackedUint32) && i < len(that.PackedUint32); i++ {
+		if this.PackedUint32[i] != that.PackedUint32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_uint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedUint32[i], New: that.PackedUint32[i]})
+		}
+	}
+	for i := len(that.PackedUint32); i < len(this.PackedUint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_uint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedUint32[i]})
+	}
+	for i := len(this.PackedUint32); i < len(that.PackedUint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_uint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedUint32[i]})
+	}
+	for i := 0; i < len(this.PackedUint64) && i < len(that.PackedUint64); i++ {
+		if this.PackedUint64[i] != that.PackedUint64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_uint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedUint64[i], New: that.PackedUint64[i]})
+		}
+	}
+	for i := len(that.PackedUint64); i < len(this.PackedUint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_uint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedUint64[i]})
+	}
+	for i := len(this.PackedUint64); i < len(that.PackedUint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_uint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedUint64[i]})
+	}
+	for i := 0; i < len(this.PackedSint32) && i < len(that.PackedSint32); i++ {
+		if this.PackedSint32[i] != that.PackedSint32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedSint32[i], New: that.PackedSint32[i]})
+		}
+	}
+	for i := len(that.PackedSint32); i < len(this.PackedSint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedSint32[i]})
+	}
+	for i := len(this.PackedSint32); i < len(that.PackedSint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedSint32[i]})
+	}
+	for i := 0; i < len(this.PackedSint64) && i < len(that.PackedSint64); i++ {
+		if this.PackedSint64[i] != that.PackedSint64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedSint64[i], New: that.PackedSint64[i]})
+		}
+	}
+	for i := len(that.PackedSint64); i < len(this.PackedSint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedSint64[i]})
+	}
+	for i := len(this.PackedSint64); i < len(that.PackedSint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedSint64[i]})
+	}
+	for i := 0; i < len(this.PackedFixed32) && i < len(that.PackedFixed32); i++ {
+		if this.PackedFixed32[i] != that.PackedFixed32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_fixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedFixed32[i], New: that.PackedFixed32[i]})
+		}
+	}
+	for i := len(that.PackedFixed32); i < len(this.PackedFixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_fixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedFixed32[i]})
+	}
+	for i := len(this.PackedFixed32); i < len(that.PackedFixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_fixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedFixed32[i]})
+	}
+	for i := 0; i < len(this.PackedFixed64) && i < len(that.PackedFixed64); i++ {
+		if this.PackedFixed64[i] != that.PackedFixed64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_fixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedFixed64[i], New: that.PackedFixed64[i]})
+		}
+	}
+	for i := len(that.PackedFixed64); i < len(this.PackedFixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_fixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedFixed64[i]})
+	}
+	for i := len(this.PackedFixed64); i < len(that.PackedFixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_fixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedFixed64[i]})
+	}
+	for i := 0; i < len(this.PackedSfixed32) && i < len(that.PackedSfixed32); i++ {
+		if this.PackedSfixed32[i] != that.PackedSfixed32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sfixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedSfixed32[i], New: that.PackedSfixed32[i]})
+		}
+	}
+	for i := len(that.PackedSfixed32); i < len(this.PackedSfixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sfixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedSfixed32[i]})
+	}
+	for i := len(this.PackedSfixed32); i < len(that.PackedSfixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sfixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedSfixed32[i]})
+	}
+	for i := 0; i < len(this.PackedSfixed64) && i < len(that.PackedSfixed64); i++ {
+		if this.PackedSfixed64[i] != that.PackedSfixed64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sfixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedSfixed64[i], New: that.PackedSfixed64[i]})
+		}
+	}
+	for i := len(that.PackedSfixed64); i < len(this.PackedSfixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sfixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedSfixed64[i]})
+	}
+	for i := len(this.PackedSfixed64); i < len(that.PackedSfixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sfixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedSfixed64[i]})
+	}
+	for i := 0; i < len(this.PackedFloat) && i < len(that.PackedFloat); i++ {
+		if this.PackedFloat[i] != that.PackedFloat[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_float[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedFloat[i], New: that.PackedFloat[i]})
+		}
+	}
+	for i := len(that.PackedFloat); i < len(this.PackedFloat); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_float[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedFloat[i]})
+	}
+	for i := len(this.PackedFloat); i < len(that.PackedFloat); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_float[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedFloat[i]})
+	}
+	for i := 0; i < len(this.PackedDouble) && i < len(that.PackedDouble); i++ {
+		if this.PackedDouble[i] != that.PackedDouble[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_double[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedDouble[i], New: that.PackedDouble[i]})
+		}
+	}
+	for i := len(that.PackedDouble); i < len(this.PackedDouble); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_double[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedDouble[i]})
+	}
+	for i := len(this.PackedDouble); i < len(that.PackedDouble); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_double[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedDouble[i]})
+	}
+	for i := 0; i < len(this.PackedBool) && i < len(that.PackedBool); i++ {
+		if this.PackedBool[i] != that.PackedBool[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_bool[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedBool[i], New: that.PackedBool[i]})
+		}
+	}
+	for i := len(that.PackedBool); i < len(this.PackedBool); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_bool[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedBool[i]})
+	}
+	for i := len(this.PackedBool); i < len(that.PackedBool); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_bool[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedBool[i]})
+	}
+	for i := 0; i < len(this.PackedNestedEnum) && i < len(that.PackedNestedEnum); i++ {
+		if this.PackedNestedEnum[i] != that.PackedNestedEnum[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_nested_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedNestedEnum[i], New: that.PackedNestedEnum[i]})
+		}
+	}
+	for i := len(that.PackedNestedEnum); i < len(this.PackedNestedEnum); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_nested_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedNestedEnum[i]})
+	}
+	for i := len(this.PackedNestedEnum); i < len(that.PackedNestedEnum); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_nested_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedNestedEnum[i]})
+	}
+	for i := 0; i < len(this.UnpackedInt32) && i < len(that.UnpackedInt32); i++ {
+		if this.UnpackedInt32[i] != that.UnpackedInt32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedInt32[i], New: that.UnpackedInt32[i]})
+		}
+	}
+	for i := len(that.UnpackedInt32); i < len(this.UnpackedInt32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedInt32[i]})
+	}
+	for i := len(this.UnpackedInt32); i < len(that.UnpackedInt32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedInt32[i]})
+	}
+	for i := 0; i < len(this.UnpackedInt64) && i < len(that.UnpackedInt64); i++ {
+		if this.UnpackedInt64[i] != that.UnpackedInt64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_int64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedInt64[i], New: that.UnpackedInt64[i]})
+		}
+	}
+	for i := len(that.UnpackedInt64); i < len(this.UnpackedInt64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_int64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedInt64[i]})
+	}
+	for i := len(this.UnpackedInt64); i < len(that.UnpackedInt64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_int64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedInt64[i]})
+	}
+	for i := 0; i < len(this.UnpackedUint32) && i < len(that.UnpackedUint32); i++ {
+		if this.UnpackedUint32[i] != that.UnpackedUint32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_uint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedUint32[i], New: that.UnpackedUint32[i]})
+		}
+	}
+	for i := len(that.UnpackedUint32); i < len(this.UnpackedUint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_uint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedUint32[i]})
+	}
+	for i := len(this.UnpackedUint32); i < len(that.UnpackedUint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_uint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedUint32[i]})
+	}
+	for i := 0; i < len(this.UnpackedUint64) && i < len(that.UnpackedUint64); i++ {
+		if this.UnpackedUint64[i] != that.UnpackedUint64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_uint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedUint64[i], New: that.UnpackedUint64[i]})
+		}
+	}
+	for i := len(that.UnpackedUint64); i < len(this.UnpackedUint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_uint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedUint64[i]})
+	}
+	for i := len(this.UnpackedUint64); i < len(that.UnpackedUint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_uint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedUint64[i]})
+	}
+	for i := 0; i < len(this.UnpackedSint32) && i < len(that.UnpackedSint32); i++ {
+		if this.UnpackedSint32[i] != that.UnpackedSint32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedSint32[i], New: that.UnpackedSint32[i]})
+		}
+	}
+	for i := len(that.UnpackedSint32); i < len(this.UnpackedSint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedSint32[i]})
+	}
+	for i := len(this.UnpackedSint32); i < len(that.UnpackedSint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedSint32[i]})
+	}
+	for i := 0; i < len(this.UnpackedSint64) && i < len(that.UnpackedSint64); i++ {
+		if this.UnpackedSint64[i] != that.UnpackedSint64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedSint64[i], New: that.UnpackedSint64[i]})
+		}
+	}
+	for i := len(that.UnpackedSint64); i < len(this.UnpackedSint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedSint64[i]})
+	}
+	for i := len(this.UnpackedSint64); i < len(that.UnpackedSint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedSint64[i]})
+	}
+	for i := 0; i < len(this.UnpackedFixed32) && i < len(that.UnpackedFixed32); i++ {
+		if this.UnpackedFixed32[i] != that.UnpackedFixed32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_fixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedFixed32[i], New: that.UnpackedFixed32[i]})
+		}
+	}
+	for i := len(that.UnpackedFixed32); i < len(this.UnpackedFixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_fixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedFixed32[i]})
+	}
+	for i := len(this.UnpackedFixed32); i < len(that.UnpackedFixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_fixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedFixed32[i]})
+	}
+	for i := 0; i < len(this.UnpackedFixed64) && i < len(that.UnpackedFixed64); i++ {
+		if this.UnpackedFixed64[i] != that.UnpackedFixed64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_fixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedFixed64[i], New: that.UnpackedFixed64[i]})
+		}
+	}
+	for i := len(that.UnpackedFixed64); i < len(this.UnpackedFixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_fixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedFixed64[i]})
+	}
+	for i := len(this.UnpackedFixed64); i < len(that.UnpackedFixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_fixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedFixed64[i]})
+	}
+	for i := 0; i < len(this.UnpackedSfixed32) && i < len(that.UnpackedSfixed32); i++ {
+		if this.UnpackedSfixed32[i] != that.UnpackedSfixed32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sfixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedSfixed32[i], New: that.UnpackedSfixed32[i]})
+		}
+	}
+	for i := len(that.UnpackedSfixed32); i < len(this.UnpackedSfixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sfixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedSfixed32[i]})
+	}
+	for i := len(this.UnpackedSfixed32); i < len(that.UnpackedSfixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sfixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedSfixed32[i]})
+	}
+	for i := 0; i < len(this.UnpackedSfixed64) && i < len(that.UnpackedSfixed64); i++ {
+		if this.UnpackedSfixed64[i] != that.UnpackedSfixed64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sfixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedSfixed64[i], New: that.UnpackedSfixed64[i]})
+		}
+	}
+	for i := len(that.UnpackedSfixed64); i < len(this.UnpackedSfixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sfixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedSfixed64[i]})
+	}
+	for i := len(this.UnpackedSfixed64); i < len(that.UnpackedSfixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sfixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedSfixed64[i]})
+	}
+	for i := 0; i < len(this.UnpackedFloat) && i < len(that.UnpackedFloat); i++ {
+		if this.UnpackedFloat[i] != that.UnpackedFloat[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_float[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedFloat[i], New: that.UnpackedFloat[i]})
+		}
+	}
+	for i := len(that.UnpackedFloat); i < len(this.UnpackedFloat); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_float[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedFloat[i]})
+	}
+	for i := len(this.UnpackedFloat); i < len(that.UnpackedFloat); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_float[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedFloat[i]})
+	}
+	for i := 0; i < len(this.UnpackedDouble) && i < len(that.UnpackedDouble); i++ {
+		if this.UnpackedDouble[i] != that.UnpackedDouble[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_double[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedDouble[i], New: that.UnpackedDouble[i]})
+		}
+	}
+	for i := len(that.UnpackedDouble); i < len(this.UnpackedDouble); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_double[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedDouble[i]})
+	}
+	for i := len(this.UnpackedDouble); i < len(that.UnpackedDouble); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_double[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedDouble[i]})
+	}
+	for i := 0; i < len(this.UnpackedBool) && i < len(that.UnpackedBool); i++ {
+		if this.UnpackedBool[i] != that.UnpackedBool[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_bool[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedBool[i], New: that.UnpackedBool[i]})
+		}
+	}
+	for i := len(that.UnpackedBool); i < len(this.UnpackedBool); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_bool[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedBool[i]})
+	}
+	for i := len(this.UnpackedBool); i < len(that.UnpackedBool); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_bool[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedBool[i]})
+	}
+	for i := 0; i < len(this.UnpackedNestedEnum) && i < len(that.UnpackedNestedEnum); i++ {
+		if this.UnpackedNestedEnum[i] != that.UnpackedNestedEnum[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_nested_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedNestedEnum[i], New: that.UnpackedNestedEnum[i]})
+		}
+	}
+	for i := len(that.UnpackedNestedEnum); i < len(this.UnpackedNestedEnum); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_nested_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedNestedEnum[i]})
+	}
+	for i := len(this.UnpackedNestedEnum); i < len(that.UnpackedNestedEnum); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_nested_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedNestedEnum[i]})
+	}
+	if x, ok := this.OneofField.(*TestAllTypesProto2_OneofUint32); ok {
+		if y, ok := that.OneofField.(*TestAllTypesProto2_OneofUint32); ok {
+			if x.OneofUint32 != y.OneofUint32 {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_uint32", Kind: vtproto.FieldChanged, Old: x.OneofUint32, New: y.OneofUint32})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_uint32", Kind: vtproto.FieldRemoved, Old: x.OneofUint32})
+		}
+	} else if y, ok := that.OneofField.(*TestAllTypesProto2_OneofUint32); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_uint32", Kind: vtproto.FieldAdded, New: y.OneofUint32})
+	}
+	if x, ok := this.OneofField.(*TestAllTypesProto2_OneofNestedMessage); ok {
+		if y, ok := that.OneofField.(*TestAllTypesProto2_OneofNestedMessage); ok {
+			if p, q := x.OneofNestedMessage, y.OneofNestedMessage; p != q {
+				if p == nil {
+					p = &TestAllTypesProto2_NestedMessage{}
+				}
+				if q == nil {
+					q = &TestAllTypesProto2_NestedMessage{}
+				}
+				if nested := p.DiffVT(q); len(nested) > 0 {
+					diffs = protohelpers.AppendDiffs(diffs, "oneof_nested_message", nested)
+				}
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_nested_message", Kind: vtproto.FieldRemoved, Old: x.OneofNestedMessage})
+		}
+	} else if y, ok := that.OneofField.(*TestAllTypesProto2_OneofNestedMessage); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_nested_message", Kind: vtproto.FieldAdded, New: y.OneofNestedMessage})
+	}
+	if x, ok := this.OneofField.(*TestAllTypesProto2_OneofString); ok {
+		if y, ok := that.OneofField.(*TestAllTypesProto2_OneofString); ok {
+			if x.OneofString != y.OneofString {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_string", Kind: vtproto.FieldChanged, Old: x.OneofString, New: y.OneofString})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_string", Kind: vtproto.FieldRemoved, Old: x.OneofString})
+		}
+	} else if y, ok := that.OneofField.(*TestAllTypesProto2_OneofString); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_string", Kind: vtproto.FieldAdded, New: y.OneofString})
+	}
+	if x, ok := this.OneofField.(*TestAllTypesProto2_OneofBytes); ok {
+		if y, ok := that.OneofField.(*TestAllTypesProto2_OneofBytes); ok {
+			if string(x.OneofBytes) != string(y.OneofBytes) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_bytes", Kind: vtproto.FieldChanged, Old: x.OneofBytes, New: y.OneofBytes})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_bytes", Kind: vtproto.FieldRemoved, Old: x.OneofBytes})
+		}
+	} else if y, ok := that.OneofField.(*TestAllTypesProto2_OneofBytes); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_bytes", Kind: vtproto.FieldAdded, New: y.OneofBytes})
+	}
+	if x, ok := this.OneofField.(*TestAllTypesProto2_OneofBool); ok {
+		if y, ok := that.OneofField.(*TestAllTypesProto2_OneofBool); ok {
+			if x.OneofBool != y.OneofBool {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_bool", Kind: vtproto.FieldChanged, Old: x.OneofBool, New: y.OneofBool})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_bool", Kind: vtproto.FieldRemoved, Old: x.OneofBool})
+		}
+	} else if y, ok := that.OneofField.(*TestAllTypesProto2_OneofBool); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_bool", Kind: vtproto.FieldAdded, New: y.OneofBool})
+	}
+	if x, ok := this.OneofField.(*TestAllTypesProto2_OneofUint64); ok {
+		if y, ok := that.OneofField.(*TestAllTypesProto2_OneofUint64); ok {
+			if x.OneofUint64 != y.OneofUint64 {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_uint64", Kind: vtproto.FieldChanged, Old: x.OneofUint64, New: y.OneofUint64})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_uint64", Kind: vtproto.FieldRemoved, Old: x.OneofUint64})
+		}
+	} else if y, ok := that.OneofField.(*TestAllTypesProto2_OneofUint64); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_uint64", Kind: vtproto.FieldAdded, New: y.OneofUint64})
+	}
+	if x, ok := this.OneofField.(*TestAllTypesProto2_OneofFloat); ok {
+		if y, ok := that.OneofField.(*TestAllTypesProto2_OneofFloat); ok {
+			if x.OneofFloat != y.OneofFloat {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_float", Kind: vtproto.FieldChanged, Old: x.OneofFloat, New: y.OneofFloat})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_float", Kind: vtproto.FieldRemoved, Old: x.OneofFloat})
+		}
+	} else if y, ok := that.OneofField.(*TestAllTypesProto2_OneofFloat); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_float", Kind: vtproto.FieldAdded, New: y.OneofFloat})
+	}
+	if x, ok := this.OneofField.(*TestAllTypesProto2_OneofDouble); ok {
+		if y, ok := that.OneofField.(*TestAllTypesProto2_OneofDouble); ok {
+			if x.OneofDouble != y.OneofDouble {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_double", Kind: vtproto.FieldChanged, Old: x.OneofDouble, New: y.OneofDouble})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_double", Kind: vtproto.FieldRemoved, Old: x.OneofDouble})
+		}
+	} else if y, ok := that.OneofField.(*TestAllTypesProto2_OneofDouble); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_double", Kind: vtproto.FieldAdded, New: y.OneofDouble})
+	}
+	if x, ok := this.OneofField.(*TestAllTypesProto2_OneofEnum); ok {
+		if y, ok := that.OneofField.(*TestAllTypesProto2_OneofEnum); ok {
+			if x.OneofEnum != y.OneofEnum {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_enum", Kind: vtproto.FieldChanged, Old: x.OneofEnum, New: y.OneofEnum})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_enum", Kind: vtproto.FieldRemoved, Old: x.OneofEnum})
+		}
+	} else if y, ok := that.OneofField.(*TestAllTypesProto2_OneofEnum); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_enum", Kind: vtproto.FieldAdded, New: y.OneofEnum})
+	}
+	if p, q := this.Data, that.Data; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "data", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "data", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if nested := p.DiffVT(q); len(nested) > 0 {
+			diffs = protohelpers.AppendDiffs(diffs, "data", nested)
+		}
+	}
+	if p, q := this.DefaultInt32, that.DefaultInt32; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_int32", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_int32", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_int32", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.DefaultInt64, that.DefaultInt64; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_int64", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_int64", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_int64", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.DefaultUint32, that.DefaultUint32; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_uint32", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_uint32", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_uint32", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.DefaultUint64, that.DefaultUint64; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_uint64", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_uint64", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_uint64", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.DefaultSint32, that.DefaultSint32; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_sint32", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_sint32", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_sint32", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.DefaultSint64, that.DefaultSint64; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_sint64", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_sint64", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_sint64", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.DefaultFixed32, that.DefaultFixed32; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_fixed32", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_fixed32", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_fixed32", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.DefaultFixed64, that.DefaultFixed64; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_fixed64", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_fixed64", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_fixed64", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.DefaultSfixed32, that.DefaultSfixed32; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_sfixed32", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_sfixed32", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_sfixed32", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.DefaultSfixed64, that.DefaultSfixed64; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_sfixed64", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_sfixed64", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_sfixed64", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.DefaultFloat, that.DefaultFloat; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_float", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_float", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_float", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.DefaultDouble, that.DefaultDouble; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_double", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_double", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_double", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.DefaultBool, that.DefaultBool; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_bool", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_bool", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_bool", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.DefaultString, that.DefaultString; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_string", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_string", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_string", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.DefaultBytes, that.DefaultBytes; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_bytes", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_bytes", Kind: vtproto.FieldRemoved, Old: p})
+	} else if string(p) != string(q) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "default_bytes", Kind: vtproto.FieldChanged, Old: p, New: q})
+	}
+	if p, q := this.Fieldname1, that.Fieldname1; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "fieldname1", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "fieldname1", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "fieldname1", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.FieldName2, that.FieldName2; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field_name2", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field_name2", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field_name2", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.XFieldName3, that.XFieldName3; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "_field_name3", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "_field_name3", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "_field_name3", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.Field_Name4_, that.Field_Name4_; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field__name4_", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field__name4_", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field__name4_", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.Field0Name5, that.Field0Name5; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field0name5", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field0name5", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field0name5", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.Field_0Name6, that.Field_0Name6; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field_0_name6", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field_0_name6", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field_0_name6", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.FieldName7, that.FieldName7; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "fieldName7", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "fieldName7", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "fieldName7", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.FieldName8, that.FieldName8; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "FieldName8", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "FieldName8", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "FieldName8", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.Field_Name9, that.Field_Name9; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field_Name9", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field_Name9", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field_Name9", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.Field_Name10, that.Field_Name10; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "Field_Name10", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "Field_Name10", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "Field_Name10", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.FIELD_NAME11, that.FIELD_NAME11; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "FIELD_NAME11", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "FIELD_NAME11", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "FIELD_NAME11", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.FIELDName12, that.FIELDName12; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "FIELD_name12", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "FIELD_name12", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "FIELD_name12", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.XFieldName13, that.XFieldName13; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "__field_name13", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "__field_name13", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "__field_name13", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.X_FieldName14, that.X_FieldName14; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "__Field_name14", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "__Field_name14", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "__Field_name14", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.Field_Name15, that.Field_Name15; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field__name15", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field__name15", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field__name15", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.Field__Name16, that.Field__Name16; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field__Name16", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field__Name16", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field__Name16", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.FieldName17__, that.FieldName17__; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field_name17__", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field_name17__", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field_name17__", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.FieldName18__, that.FieldName18__; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "Field_name18__", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "Field_name18__", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "Field_name18__", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	diffs = protohelpers.DiffExtensions(diffs, this.extensionFields, that.extensionFields)
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
 }
 
-func (this *TestAllTypesProto2_OneofDouble) EqualVT(thatIface isTestAllTypesProto2_OneofField) bool {
-	that, ok := thatIface.(*TestAllTypesProto2_OneofDouble)
-	if !ok {
-		return false
-	}
-	if this == that {
-		return true
+func (this *ForeignMessageProto2) DiffVT(that *ForeignMessageProto2) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
 	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	var diffs []vtproto.FieldDiff
+	if p, q := this.C, that.C; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "c", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "c", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "c", Kind: vtproto.FieldChanged, Old: *p, New: *q})
 	}
-	if this.OneofDouble != that.OneofDouble {
-		return false
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
 	}
-	return true
+	return diffs
 }
 
-func (this *TestAllTypesProto2_OneofEnum) EqualVT(thatIface isTestAllTypesProto2_OneofField) bool {
-	that, ok := thatIface.(*TestAllTypesProto2_OneofEnum)
-	if !ok {
-		return false
+func (this *UnknownToTestAllTypes_OptionalGroup) DiffVT(that *UnknownToTestAllTypes_OptionalGroup) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
 	}
-	if this == that {
-		return true
+	var diffs []vtproto.FieldDiff
+	if p, q := this.A, that.A; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "a", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "a", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "a", Kind: vtproto.FieldChanged, Old: *p, New: *q})
 	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
 	}
-	if this.OneofEnum != that.OneofEnum {
-		return false
+	return diffs
+}
+
+func (this *UnknownToTestAllTypes) DiffVT(that *UnknownToTestAllTypes) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.OptionalInt32, that.OptionalInt32; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int32", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int32", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int32", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalString, that.OptionalString; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_string", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_string", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_string", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.NestedMessage, that.NestedMessage; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "nested_message", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "nested_message", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if nested := p.DiffVT(q); len(nested) > 0 {
+			diffs = protohelpers.AppendDiffs(diffs, "nested_message", nested)
+		}
+	}
+	if p, q := this.Optionalgroup, that.Optionalgroup; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optionalgroup", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optionalgroup", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if nested := p.DiffVT(q); len(nested) > 0 {
+			diffs = protohelpers.AppendDiffs(diffs, "optionalgroup", nested)
+		}
+	}
+	if p, q := this.OptionalBool, that.OptionalBool; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bool", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bool", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bool", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	for i := 0; i < len(this.RepeatedInt32) && i < len(that.RepeatedInt32); i++ {
+		if this.RepeatedInt32[i] != that.RepeatedInt32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedInt32[i], New: that.RepeatedInt32[i]})
+		}
+	}
+	for i := len(that.RepeatedInt32); i < len(this.RepeatedInt32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedInt32[i]})
+	}
+	for i := len(this.RepeatedInt32); i < len(that.RepeatedInt32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedInt32[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *NullHypothesisProto2) DiffVT(that *NullHypothesisProto2) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
 	}
-	return true
+	var diffs []vtproto.FieldDiff
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
 }
 
-func (this *ForeignMessageProto2) EqualVT(that *ForeignMessageProto2) bool {
-	if this == nil {
-		return that == nil
+func (this *EnumOnlyProto2) DiffVT(that *EnumOnlyProto2) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
 	} else if that == nil {
-		return false
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
 	}
-	if p, q := this.C, that.C; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	var diffs []vtproto.FieldDiff
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return diffs
 }
 
-func (this *UnknownToTestAllTypes_OptionalGroup) EqualVT(that *UnknownToTestAllTypes_OptionalGroup) bool {
-	if this == nil {
-		return that == nil
+func (this *OneStringProto2) DiffVT(that *OneStringProto2) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
 	} else if that == nil {
-		return false
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
 	}
-	if p, q := this.A, that.A; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	var diffs []vtproto.FieldDiff
+	if p, q := this.Data, that.Data; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "data", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "data", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "data", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return diffs
 }
 
-func (this *UnknownToTestAllTypes) EqualVT(that *UnknownToTestAllTypes) bool {
+func (this *TestAllTypesProto2_NestedMessage) EqualVT(that *TestAllTypesProto2_NestedMessage) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *TestAllTypesProto2_NestedMessage) EqualVTWithOptions(that *TestAllTypesProto2_NestedMessage, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
 		return false
 	}
-	if p, q := this.OptionalInt32, that.OptionalInt32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if p, q := this.A, that.A; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if p, q := this.OptionalString, that.OptionalString; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if !this.Corecursive.EqualVTWithOptions(that.Corecursive, opts) {
 		return false
 	}
-	if !this.NestedMessage.EqualVT(that.NestedMessage) {
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *TestAllTypesProto2_NestedMessage) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*TestAllTypesProto2_NestedMessage)
+	if !ok {
 		return false
 	}
-	if !this.Optionalgroup.EqualVT(that.Optionalgroup) {
+	return this.EqualVT(that)
+}
+
+func (this *TestAllTypesProto2_Data) EqualVT(that *TestAllTypesProto2_Data) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *TestAllTypesProto2_Data) EqualVTWithOptions(that *TestAllTypesProto2_Data, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
 		return false
 	}
-	if p, q := this.OptionalBool, that.OptionalBool; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if p, q := this.GroupInt32, that.GroupInt32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if len(this.RepeatedInt32) != len(that.RepeatedInt32) {
+	if p, q := this.GroupUint32, that.GroupUint32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	for i, vx := range this.RepeatedInt32 {
-		vy := that.RepeatedInt32[i]
-		if vx != vy {
-			return false
-		}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *TestAllTypesProto2_Data) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*TestAllTypesProto2_Data)
+	if !ok {
+		return false
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return this.EqualVT(that)
 }
 
-func (this *NullHypothesisProto2) EqualVT(that *NullHypothesisProto2) bool {
+func (this *TestAllTypesProto2_MessageSetCorrect) EqualVT(that *TestAllTypesProto2_MessageSetCorrect) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *TestAllTypesProto2_MessageSetCorrect) EqualVTWithOptions(that *TestAllTypesProto2_MessageSetCorrect, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
 		return false
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *EnumOnlyProto2) EqualVT(that *EnumOnlyProto2) bool {
-	if this == nil {
-		return that == nil
-	} else if that == nil {
+func (this *TestAllTypesProto2_MessageSetCorrect) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*TestAllTypesProto2_MessageSetCorrect)
+	if !ok {
 		return false
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return this.EqualVT(that)
 }
 
-func (this *OneStringProto2) EqualVT(that *OneStringProto2) bool {
+func (this *TestAllTypesProto2_MessageSetCorrectExtension1) EqualVT(that *TestAllTypesProto2_MessageSetCorrectExtension1) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *TestAllTypesProto2_MessageSetCorrectExtension1) EqualVTWithOptions(that *TestAllTypesProto2_MessageSetCorrectExtension1, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
 		return false
 	}
-	if p, q := this.Data, that.Data; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if p, q := this.Str, that.Str; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *TestAllTypesProto2_NestedMessage) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *TestAllTypesProto2_MessageSetCorrectExtension1) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*TestAllTypesProto2_MessageSetCorrectExtension1)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
+	return this.EqualVT(that)
 }
 
-func (m *TestAllTypesProto2_NestedMessage) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *TestAllTypesProto2_MessageSetCorrectExtension2) EqualVT(that *TestAllTypesProto2_MessageSetCorrectExtension2) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
 }
 
-func (m *TestAllTypesProto2_NestedMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
-	}
-	if m.Corecursive != nil {
-		size, err := m.Corecursive.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0x12
+func (this *TestAllTypesProto2_MessageSetCorrectExtension2) EqualVTWithOptions(that *TestAllTypesProto2_MessageSetCorrectExtension2, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
 	}
-	if m.A != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.A))
-		i--
-		dAtA[i] = 0x8
+	if p, q := this.I, that.I; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *TestAllTypesProto2_Data) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *TestAllTypesProto2_MessageSetCorrectExtension2) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*TestAllTypesProto2_MessageSetCorrectExtension2)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
+	return this.EqualVT(that)
 }
 
-func (m *TestAllTypesProto2_Data) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *TestAllTypesProto2) EqualVT(that *TestAllTypesProto2) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
 }
 
-func (m *TestAllTypesProto2_Data) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *TestAllTypesProto2) EqualVTWithOptions(that *TestAllTypesProto2, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if this.OneofField == nil && that.OneofField != nil {
+		return false
+	} else if this.OneofField != nil {
+		if that.OneofField == nil {
+			return false
+		}
+		if !this.OneofField.(interface {
+			EqualVTWithOptions(isTestAllTypesProto2_OneofField, vtproto.EqualOptions) bool
+		}).EqualVTWithOptions(that.OneofField, opts) {
+			return false
+		}
 	}
-	if m.GroupUint32 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.GroupUint32))
-		i--
-		dAtA[i] = 0xc
-		i--
-		dAtA[i] = 0xd8
+	if p, q := this.OptionalInt32, that.OptionalInt32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.GroupInt32 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.GroupInt32))
-		i--
-		dAtA[i] = 0xc
-		i--
-		dAtA[i] = 0xd0
+	if p, q := this.OptionalInt64, that.OptionalInt64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TestAllTypesProto2_MessageSetCorrect) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if p, q := this.OptionalUint32, that.OptionalUint32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if p, q := this.OptionalUint64, that.OptionalUint64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TestAllTypesProto2_MessageSetCorrect) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *TestAllTypesProto2_MessageSetCorrect) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+	if p, q := this.OptionalSint32, that.OptionalSint32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if p, q := this.OptionalSint64, that.OptionalSint64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TestAllTypesProto2_MessageSetCorrectExtension1) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if p, q := this.OptionalFixed32, that.OptionalFixed32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if p, q := this.OptionalFixed64, that.OptionalFixed64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TestAllTypesProto2_MessageSetCorrectExtension1) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *TestAllTypesProto2_MessageSetCorrectExtension1) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+	if p, q := this.OptionalSfixed32, that.OptionalSfixed32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if p, q := this.OptionalSfixed64, that.OptionalSfixed64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.Str != nil {
-		i -= len(*m.Str)
-		copy(dAtA[i:], *m.Str)
-		i = encodeVarint(dAtA, i, uint64(len(*m.Str)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xca
+	if p, q := this.OptionalFloat, that.OptionalFloat; (p == nil && q != nil) || (p != nil && (q == nil || !opts.EqualFloat32(*p, *q))) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TestAllTypesProto2_MessageSetCorrectExtension2) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if p, q := this.OptionalDouble, that.OptionalDouble; (p == nil && q != nil) || (p != nil && (q == nil || !opts.EqualFloat64(*p, *q))) {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if p, q := this.OptionalBool, that.OptionalBool; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TestAllTypesProto2_MessageSetCorrectExtension2) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *TestAllTypesProto2_MessageSetCorrectExtension2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+	if p, q := this.OptionalString, that.OptionalString; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if p, q := this.OptionalBytes, that.OptionalBytes; (p == nil && q != nil) || (p != nil && q == nil) || string(p) != string(q) {
+		return false
 	}
-	if m.I != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.I))
-		i--
-		dAtA[i] = 0x48
+	if !this.OptionalNestedMessage.EqualVTWithOptions(that.OptionalNestedMessage, opts) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TestAllTypesProto2) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if !this.OptionalForeignMessage.EqualVTWithOptions(that.OptionalForeignMessage, opts) {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if p, q := this.OptionalNestedEnum, that.OptionalNestedEnum; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TestAllTypesProto2) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+	if p, q := this.OptionalForeignEnum, that.OptionalForeignEnum; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if p, q := this.OptionalStringPiece, that.OptionalStringPiece; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if vtmsg, ok := m.OneofField.(interface {
-		MarshalToSizedBufferVT([]byte) (int, error)
-	}); ok {
-		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
+	if p, q := this.OptionalCord, that.OptionalCord; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.FieldName18__ != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.FieldName18__))
-		i--
-		dAtA[i] = 0x1a
-		i--
-		dAtA[i] = 0x90
+	if !this.RecursiveMessage.EqualVTWithOptions(that.RecursiveMessage, opts) {
+		return false
 	}
-	if m.FieldName17__ != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.FieldName17__))
-		i--
-		dAtA[i] = 0x1a
-		i--
-		dAtA[i] = 0x88
+	if len(this.RepeatedInt32) != len(that.RepeatedInt32) {
+		return false
 	}
-	if m.Field__Name16 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.Field__Name16))
-		i--
-		dAtA[i] = 0x1a
-		i--
-		dAtA[i] = 0x80
+	for i, vx := range this.RepeatedInt32 {
+		vy := that.RepeatedInt32[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.Field_Name15 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.Field_Name15))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xf8
+	if len(this.RepeatedInt64) != len(that.RepeatedInt64) {
+		return false
 	}
-	if m.X_FieldName14 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.X_FieldName14))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xf0
+	for i, vx := range this.RepeatedInt64 {
+		vy := that.RepeatedInt64[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.XFieldName13 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.XFieldName13))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xe8
+	if len(this.RepeatedUint32) != len(that.RepeatedUint32) {
+		return false
 	}
-	if m.FIELDName12 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.FIELDName12))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xe0
+	for i, vx := range this.RepeatedUint32 {
+		vy := that.RepeatedUint32[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.FIELD_NAME11 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.FIELD_NAME11))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xd8
+	if len(this.RepeatedUint64) != len(that.RepeatedUint64) {
+		return false
 	}
-	if m.Field_Name10 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.Field_Name10))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xd0
+	for i, vx := range this.RepeatedUint64 {
+		vy := that.RepeatedUint64[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.Field_Name9 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.Field_Name9))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xc8
+	if len(this.RepeatedSint32) != len(that.RepeatedSint32) {
+		return false
 	}
-	if m.FieldName8 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.FieldName8))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xc0
+	for i, vx := range this.RepeatedSint32 {
+		vy := that.RepeatedSint32[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.FieldName7 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.FieldName7))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xb8
+	if len(this.RepeatedSint64) != len(that.RepeatedSint64) {
+		return false
 	}
-	if m.Field_0Name6 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.Field_0Name6))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xb0
+	for i, vx := range this.RepeatedSint64 {
+		vy := that.RepeatedSint64[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.Field0Name5 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.Field0Name5))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xa8
+	if len(this.RepeatedFixed32) != len(that.RepeatedFixed32) {
+		return false
 	}
-	if m.Field_Name4_ != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.Field_Name4_))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xa0
+	for i, vx := range this.RepeatedFixed32 {
+		vy := that.RepeatedFixed32[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.XFieldName3 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.XFieldName3))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0x98
+	if len(this.RepeatedFixed64) != len(that.RepeatedFixed64) {
+		return false
 	}
-	if m.FieldName2 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.FieldName2))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0x90
+	for i, vx := range this.RepeatedFixed64 {
+		vy := that.RepeatedFixed64[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.Fieldname1 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.Fieldname1))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0x88
+	if len(this.RepeatedSfixed32) != len(that.RepeatedSfixed32) {
+		return false
 	}
-	if m.DefaultBytes != nil {
-		i -= len(m.DefaultBytes)
-		copy(dAtA[i:], m.DefaultBytes)
-		i = encodeVarint(dAtA, i, uint64(len(m.DefaultBytes)))
-		i--
-		dAtA[i] = 0xf
-		i--
-		dAtA[i] = 0xfa
+	for i, vx := range this.RepeatedSfixed32 {
+		vy := that.RepeatedSfixed32[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.DefaultString != nil {
-		i -= len(*m.DefaultString)
-		copy(dAtA[i:], *m.DefaultString)
-		i = encodeVarint(dAtA, i, uint64(len(*m.DefaultString)))
-		i--
-		dAtA[i] = 0xf
-		i--
-		dAtA[i] = 0xf2
+	if len(this.RepeatedSfixed64) != len(that.RepeatedSfixed64) {
+		return false
 	}
-	if m.DefaultBool != nil {
-		i--
-		if *m.DefaultBool {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	for i, vx := range this.RepeatedSfixed64 {
+		vy := that.RepeatedSfixed64[i]
+		if vx != vy {
+			return false
 		}
-		i--
-		dAtA[i] = 0xf
-		i--
-		dAtA[i] = 0xe8
-	}
-	if m.DefaultDouble != nil {
-		i -= 8
-		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.DefaultDouble))))
-		i--
-		dAtA[i] = 0xf
-		i--
-		dAtA[i] = 0xe1
 	}
-	if m.DefaultFloat != nil {
-		i -= 4
-		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(*m.DefaultFloat))))
-		i--
-		dAtA[i] = 0xf
-		i--
-		dAtA[i] = 0xdd
+	if len(this.RepeatedFloat) != len(that.RepeatedFloat) {
+		return false
 	}
-	if m.DefaultSfixed64 != nil {
-		i -= 8
-		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.DefaultSfixed64))
-		i--
-		dAtA[i] = 0xf
-		i--
-		dAtA[i] = 0xd1
+	for i, vx := range this.RepeatedFloat {
+		vy := that.RepeatedFloat[i]
+		if !opts.EqualFloat32(vx, vy) {
+			return false
+		}
 	}
-	if m.DefaultSfixed32 != nil {
-		i -= 4
-		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.DefaultSfixed32))
-		i--
-		dAtA[i] = 0xf
-		i--
-		dAtA[i] = 0xcd
+	if len(this.RepeatedDouble) != len(that.RepeatedDouble) {
+		return false
 	}
-	if m.DefaultFixed64 != nil {
-		i -= 8
-		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.DefaultFixed64))
-		i--
-		dAtA[i] = 0xf
-		i--
-		dAtA[i] = 0xc1
+	for i, vx := range this.RepeatedDouble {
+		vy := that.RepeatedDouble[i]
+		if !opts.EqualFloat64(vx, vy) {
+			return false
+		}
 	}
-	if m.DefaultFixed32 != nil {
-		i -= 4
-		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.DefaultFixed32))
-		i--
-		dAtA[i] = 0xf
-		i--
-		dAtA[i] = 0xbd
+	if len(this.RepeatedBool) != len(that.RepeatedBool) {
+		return false
 	}
-	if m.DefaultSint64 != nil {
-		i = encodeVarint(dAtA, i, uint64((uint64(*m.DefaultSint64)<<1)^uint64((*m.DefaultSint64>>63))))
-		i--
-		dAtA[i] = 0xf
-		i--
-		dAtA[i] = 0xb0
+	for i, vx := range this.RepeatedBool {
+		vy := that.RepeatedBool[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.DefaultSint32 != nil {
-		i = encodeVarint(dAtA, i, uint64((uint32(*m.DefaultSint32)<<1)^uint32((*m.DefaultSint32>>31))))
-		i--
-		dAtA[i] = 0xf
-		i--
-		dAtA[i] = 0xa8
+	if len(this.RepeatedString) != len(that.RepeatedString) {
+		return false
 	}
-	if m.DefaultUint64 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.DefaultUint64))
-		i--
-		dAtA[i] = 0xf
-		i--
-		dAtA[i] = 0xa0
+	for i, vx := range this.RepeatedString {
+		vy := that.RepeatedString[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.DefaultUint32 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.DefaultUint32))
-		i--
-		dAtA[i] = 0xf
-		i--
-		dAtA[i] = 0x98
+	if len(this.RepeatedBytes) != len(that.RepeatedBytes) {
+		return false
 	}
-	if m.DefaultInt64 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.DefaultInt64))
-		i--
-		dAtA[i] = 0xf
-		i--
-		dAtA[i] = 0x90
+	for i, vx := range this.RepeatedBytes {
+		vy := that.RepeatedBytes[i]
+		if string(vx) != string(vy) {
+			return false
+		}
 	}
-	if m.DefaultInt32 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.DefaultInt32))
-		i--
-		dAtA[i] = 0xf
-		i--
-		dAtA[i] = 0x88
+	if len(this.RepeatedNestedMessage) != len(that.RepeatedNestedMessage) {
+		return false
 	}
-	if m.Data != nil {
-		i--
-		dAtA[i] = 0xc
-		i--
-		dAtA[i] = 0xcc
-		size, err := m.Data.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+	for i, vx := range this.RepeatedNestedMessage {
+		vy := that.RepeatedNestedMessage[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &TestAllTypesProto2_NestedMessage{}
+			}
+			if q == nil {
+				q = &TestAllTypesProto2_NestedMessage{}
+			}
+			if !p.EqualVTWithOptions(q, opts) {
+				return false
+			}
 		}
-		i -= size
-		i--
-		dAtA[i] = 0xc
-		i--
-		dAtA[i] = 0xcb
 	}
-	if len(m.UnpackedNestedEnum) > 0 {
-		for iNdEx := len(m.UnpackedNestedEnum) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.UnpackedNestedEnum[iNdEx]))
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xb0
-		}
+	if len(this.RepeatedForeignMessage) != len(that.RepeatedForeignMessage) {
+		return false
 	}
-	if len(m.UnpackedBool) > 0 {
-		for iNdEx := len(m.UnpackedBool) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.UnpackedBool[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+	for i, vx := range this.RepeatedForeignMessage {
+		vy := that.RepeatedForeignMessage[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &ForeignMessageProto2{}
+			}
+			if q == nil {
+				q = &ForeignMessageProto2{}
+			}
+			if !p.EqualVTWithOptions(q, opts) {
+				return false
 			}
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xa8
 		}
 	}
-	if len(m.UnpackedDouble) > 0 {
-		for iNdEx := len(m.UnpackedDouble) - 1; iNdEx >= 0; iNdEx-- {
-			f1 := math.Float64bits(float64(m.UnpackedDouble[iNdEx]))
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f1))
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xa1
-		}
+	if len(this.RepeatedNestedEnum) != len(that.RepeatedNestedEnum) {
+		return false
 	}
-	if len(m.UnpackedFloat) > 0 {
-		for iNdEx := len(m.UnpackedFloat) - 1; iNdEx >= 0; iNdEx-- {
-			f2 := math.Float32bits(float32(m.UnpackedFloat[iNdEx]))
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f2))
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0x9d
+	for i, vx := range this.RepeatedNestedEnum {
+		vy := that.RepeatedNestedEnum[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.UnpackedSfixed64) > 0 {
-		for iNdEx := len(m.UnpackedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.UnpackedSfixed64[iNdEx]))
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0x91
-		}
+	if len(this.RepeatedForeignEnum) != len(that.RepeatedForeignEnum) {
+		return false
 	}
-	if len(m.UnpackedSfixed32) > 0 {
-		for iNdEx := len(m.UnpackedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.UnpackedSfixed32[iNdEx]))
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0x8d
+	for i, vx := range this.RepeatedForeignEnum {
+		vy := that.RepeatedForeignEnum[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.UnpackedFixed64) > 0 {
-		for iNdEx := len(m.UnpackedFixed64) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.UnpackedFixed64[iNdEx]))
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0x81
-		}
+	if len(this.RepeatedStringPiece) != len(that.RepeatedStringPiece) {
+		return false
 	}
-	if len(m.UnpackedFixed32) > 0 {
-		for iNdEx := len(m.UnpackedFixed32) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.UnpackedFixed32[iNdEx]))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xfd
+	for i, vx := range this.RepeatedStringPiece {
+		vy := that.RepeatedStringPiece[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.UnpackedSint64) > 0 {
-		for iNdEx := len(m.UnpackedSint64) - 1; iNdEx >= 0; iNdEx-- {
-			x3 := (uint64(m.UnpackedSint64[iNdEx]) << 1) ^ uint64((m.UnpackedSint64[iNdEx] >> 63))
-			i = encodeVarint(dAtA, i, uint64(x3))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xf0
-		}
+	if len(this.RepeatedCord) != len(that.RepeatedCord) {
+		return false
 	}
-	if len(m.UnpackedSint32) > 0 {
-		for iNdEx := len(m.UnpackedSint32) - 1; iNdEx >= 0; iNdEx-- {
-			x4 := (uint32(m.UnpackedSint32[iNdEx]) << 1) ^ uint32((m.UnpackedSint32[iNdEx] >> 31))
-			i = encodeVarint(dAtA, i, uint64(x4))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xe8
+	for i, vx := range this.RepeatedCord {
+		vy := that.RepeatedCord[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.UnpackedUint64) > 0 {
-		for iNdEx := len(m.UnpackedUint64) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.UnpackedUint64[iNdEx]))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xe0
-		}
+	if len(this.MapInt32Int32) != len(that.MapInt32Int32) {
+		return false
 	}
-	if len(m.UnpackedUint32) > 0 {
-		for iNdEx := len(m.UnpackedUint32) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.UnpackedUint32[iNdEx]))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xd8
+	for i, vx := range this.MapInt32Int32 {
+		vy, ok := that.MapInt32Int32[i]
+		if !ok {
+			return false
 		}
-	}
-	if len(m.UnpackedInt64) > 0 {
-		for iNdEx := len(m.UnpackedInt64) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.UnpackedInt64[iNdEx]))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xd0
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.UnpackedInt32) > 0 {
-		for iNdEx := len(m.UnpackedInt32) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.UnpackedInt32[iNdEx]))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xc8
-		}
+	if len(this.MapInt64Int64) != len(that.MapInt64Int64) {
+		return false
 	}
-	if len(m.PackedNestedEnum) > 0 {
-		var pksize6 int
-		for _, num := range m.PackedNestedEnum {
-			pksize6 += sov(uint64(num))
+	for i, vx := range this.MapInt64Int64 {
+		vy, ok := that.MapInt64Int64[i]
+		if !ok {
+			return false
 		}
-		i -= pksize6
-		j5 := i
-		for _, num1 := range m.PackedNestedEnum {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA[j5] = uint8(num)
-			j5++
+		if vx != vy {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize6))
-		i--
-		dAtA[i] = 0x5
-		i--
-		dAtA[i] = 0xc2
 	}
-	if len(m.PackedBool) > 0 {
-		for iNdEx := len(m.PackedBool) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.PackedBool[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-		}
-		i = encodeVarint(dAtA, i, uint64(len(m.PackedBool)))
-		i--
-		dAtA[i] = 0x5
-		i--
-		dAtA[i] = 0xba
+	if len(this.MapUint32Uint32) != len(that.MapUint32Uint32) {
+		return false
 	}
-	if len(m.PackedDouble) > 0 {
-		for iNdEx := len(m.PackedDouble) - 1; iNdEx >= 0; iNdEx-- {
-			f7 := math.Float64bits(float64(m.PackedDouble[iNdEx]))
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f7))
+	for i, vx := range this.MapUint32Uint32 {
+		vy, ok := that.MapUint32Uint32[i]
+		if !ok {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(len(m.PackedDouble)*8))
-		i--
-		dAtA[i] = 0x5
-		i--
-		dAtA[i] = 0xb2
-	}
-	if len(m.PackedFloat) > 0 {
-		for iNdEx := len(m.PackedFloat) - 1; iNdEx >= 0; iNdEx-- {
-			f8 := math.Float32bits(float32(m.PackedFloat[iNdEx]))
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f8))
+		if vx != vy {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(len(m.PackedFloat)*4))
-		i--
-		dAtA[i] = 0x5
-		i--
-		dAtA[i] = 0xaa
 	}
-	if len(m.PackedSfixed64) > 0 {
-		for iNdEx := len(m.PackedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.PackedSfixed64[iNdEx]))
-		}
-		i = encodeVarint(dAtA, i, uint64(len(m.PackedSfixed64)*8))
-		i--
-		dAtA[i] = 0x5
-		i--
-		dAtA[i] = 0xa2
+	if len(this.MapUint64Uint64) != len(that.MapUint64Uint64) {
+		return false
 	}
-	if len(m.PackedSfixed32) > 0 {
-		for iNdEx := len(m.PackedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.PackedSfixed32[iNdEx]))
+	for i, vx := range this.MapUint64Uint64 {
+		vy, ok := that.MapUint64Uint64[i]
+		if !ok {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(len(m.PackedSfixed32)*4))
-		i--
-		dAtA[i] = 0x5
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.PackedFixed64) > 0 {
-		for iNdEx := len(m.PackedFixed64) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.PackedFixed64[iNdEx]))
+		if vx != vy {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(len(m.PackedFixed64)*8))
-		i--
-		dAtA[i] = 0x5
-		i--
-		dAtA[i] = 0x92
 	}
-	if len(m.PackedFixed32) > 0 {
-		for iNdEx := len(m.PackedFixed32) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.PackedFixed32[iNdEx]))
+	if len(this.MapSint32Sint32) != len(that.MapSint32Sint32) {
+		return false
+	}
+	for i, vx := range this.MapSint32Sint32 {
+		vy, ok := that.MapSint32Sint32[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(len(m.PackedFixed32)*4))
-		i--
-		dAtA[i] = 0x5
-		i--
-		dAtA[i] = 0x8a
 	}
-	if len(m.PackedSint64) > 0 {
-		var pksize10 int
-		for _, num := range m.PackedSint64 {
-			pksize10 += soz(uint64(num))
+	if len(this.MapSint64Sint64) != len(that.MapSint64Sint64) {
+		return false
+	}
+	for i, vx := range this.MapSint64Sint64 {
+		vy, ok := that.MapSint64Sint64[i]
+		if !ok {
+			return false
 		}
-		i -= pksize10
-		j9 := i
-		for _, num := range m.PackedSint64 {
-			x11 := (uint64(num) << 1) ^ uint64((num >> 63))
-			for x11 >= 1<<7 {
-				dAtA[j9] = uint8(uint64(x11)&0x7f | 0x80)
-				j9++
-				x11 >>= 7
-			}
-			dAtA[j9] = uint8(x11)
-			j9++
+		if vx != vy {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize10))
-		i--
-		dAtA[i] = 0x5
-		i--
-		dAtA[i] = 0x82
 	}
-	if len(m.PackedSint32) > 0 {
-		var pksize13 int
-		for _, num := range m.PackedSint32 {
-			pksize13 += soz(uint64(num))
+	if len(this.MapFixed32Fixed32) != len(that.MapFixed32Fixed32) {
+		return false
+	}
+	for i, vx := range this.MapFixed32Fixed32 {
+		vy, ok := that.MapFixed32Fixed32[i]
+		if !ok {
+			return false
 		}
-		i -= pksize13
-		j12 := i
-		for _, num := range m.PackedSint32 {
-			x14 := (uint32(num) << 1) ^ uint32((num >> 31))
-			for x14 >= 1<<7 {
-				dAtA[j12] = uint8(uint64(x14)&0x7f | 0x80)
-				j12++
-				x14 >>= 7
-			}
-			dAtA[j12] = uint8(x14)
-			j12++
+		if vx != vy {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize13))
-		i--
-		dAtA[i] = 0x4
-		i--
-		dAtA[i] = 0xfa
 	}
-	if len(m.PackedUint64) > 0 {
-		var pksize16 int
-		for _, num := range m.PackedUint64 {
-			pksize16 += sov(uint64(num))
+	if len(this.MapFixed64Fixed64) != len(that.MapFixed64Fixed64) {
+		return false
+	}
+	for i, vx := range this.MapFixed64Fixed64 {
+		vy, ok := that.MapFixed64Fixed64[i]
+		if !ok {
+			return false
 		}
-		i -= pksize16
-		j15 := i
-		for _, num := range m.PackedUint64 {
-			for num >= 1<<7 {
-				dAtA[j15] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j15++
-			}
-			dAtA[j15] = uint8(num)
-			j15++
+		if vx != vy {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize16))
-		i--
-		dAtA[i] = 0x4
-		i--
-		dAtA[i] = 0xf2
 	}
-	if len(m.PackedUint32) > 0 {
-		var pksize18 int
-		for _, num := range m.PackedUint32 {
-			pksize18 += sov(uint64(num))
+	if len(this.MapSfixed32Sfixed32) != len(that.MapSfixed32Sfixed32) {
+		return false
+	}
+	for i, vx := range this.MapSfixed32Sfixed32 {
+		vy, ok := that.MapSfixed32Sfixed32[i]
+		if !ok {
+			return false
 		}
-		i -= pksize18
-		j17 := i
-		for _, num := range m.PackedUint32 {
-			for num >= 1<<7 {
-				dAtA[j17] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j17++
-			}
-			dAtA[j17] = uint8(num)
-			j17++
+		if vx != vy {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize18))
-		i--
-		dAtA[i] = 0x4
-		i--
-		dAtA[i] = 0xea
 	}
-	if len(m.PackedInt64) > 0 {
-		var pksize20 int
-		for _, num := range m.PackedInt64 {
-			pksize20 += sov(uint64(num))
+	if len(this.MapSfixed64Sfixed64) != len(that.MapSfixed64Sfixed64) {
+		return false
+	}
+	for i, vx := range this.MapSfixed64Sfixed64 {
+		vy, ok := that.MapSfixed64Sfixed64[i]
+		if !ok {
+			return false
 		}
-		i -= pksize20
-		j19 := i
-		for _, num1 := range m.PackedInt64 {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA[j19] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j19++
-			}
-			dAtA[j19] = uint8(num)
-			j19++
+		if vx != vy {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize20))
-		i--
-		dAtA[i] = 0x4
-		i--
-		dAtA[i] = 0xe2
 	}
-	if len(m.PackedInt32) > 0 {
-		var pksize22 int
-		for _, num := range m.PackedInt32 {
-			pksize22 += sov(uint64(num))
+	if len(this.MapInt32Float) != len(that.MapInt32Float) {
+		return false
+	}
+	for i, vx := range this.MapInt32Float {
+		vy, ok := that.MapInt32Float[i]
+		if !ok {
+			return false
 		}
-		i -= pksize22
-		j21 := i
-		for _, num1 := range m.PackedInt32 {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA[j21] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j21++
-			}
-			dAtA[j21] = uint8(num)
-			j21++
+		if !opts.EqualFloat32(vx, vy) {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize22))
-		i--
-		dAtA[i] = 0x4
-		i--
-		dAtA[i] = 0xda
 	}
-	if len(m.MapStringForeignEnum) > 0 {
-		for k := range m.MapStringForeignEnum {
-			v := m.MapStringForeignEnum[k]
-			baseI := i
-			i = encodeVarint(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarint(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xd2
+	if len(this.MapInt32Double) != len(that.MapInt32Double) {
+		return false
+	}
+	for i, vx := range this.MapInt32Double {
+		vy, ok := that.MapInt32Double[i]
+		if !ok {
+			return false
+		}
+		if !opts.EqualFloat64(vx, vy) {
+			return false
 		}
 	}
-	if len(m.MapStringNestedEnum) > 0 {
-		for k := range m.MapStringNestedEnum {
-			v := m.MapStringNestedEnum[k]
-			baseI := i
-			i = encodeVarint(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarint(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xca
-		}
+	if len(this.MapBoolBool) != len(that.MapBoolBool) {
+		return false
 	}
-	if len(m.MapStringForeignMessage) > 0 {
-		for k := range m.MapStringForeignMessage {
-			v := m.MapStringForeignMessage[k]
-			baseI := i
-			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarint(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xc2
+	for i, vx := range this.MapBoolBool {
+		vy, ok := that.MapBoolBool[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapStringNestedMessage) > 0 {
-		for k := range m.MapStringNestedMessage {
-			v := m.MapStringNestedMessage[k]
-			baseI := i
-			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarint(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xba
+	if len(this.MapStringString) != len(that.MapStringString) {
+		return false
+	}
+	for i, vx := range this.MapStringString {
+		vy, ok := that.MapStringString[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapStringBytes) > 0 {
-		for k := range m.MapStringBytes {
-			v := m.MapStringBytes[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarint(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarint(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xb2
+	if len(this.MapStringBytes) != len(that.MapStringBytes) {
+		return false
+	}
+	for i, vx := range this.MapStringBytes {
+		vy, ok := that.MapStringBytes[i]
+		if !ok {
+			return false
+		}
+		if string(vx) != string(vy) {
+			return false
 		}
 	}
-	if len(m.MapStringString) > 0 {
-		for k := range m.MapStringString {
-			v := m.MapStringString[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarint(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarint(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xaa
+	if len(this.MapStringNestedMessage) != len(that.MapStringNestedMessage) {
+		return false
+	}
+	for i, vx := range this.MapStringNestedMessage {
+		vy, ok := that.MapStringNestedMessage[i]
+		if !ok {
+			return false
+		}
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &TestAllTypesProto2_NestedMessage{}
+			}
+			if q == nil {
+				q = &TestAllTypesProto2_NestedMessage{}
+			}
+			if !p.EqualVTWithOptions(q, opts) {
+				return false
+			}
 		}
 	}
-	if len(m.MapBoolBool) > 0 {
-		for k := range m.MapBoolBool {
-			v := m.MapBoolBool[k]
-			baseI := i
-			i--
-			if v {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+	if len(this.MapStringForeignMessage) != len(that.MapStringForeignMessage) {
+		return false
+	}
+	for i, vx := range this.MapStringForeignMessage {
+		vy, ok := that.MapStringForeignMessage[i]
+		if !ok {
+			return false
+		}
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &ForeignMessageProto2{}
 			}
-			i--
-			dAtA[i] = 0x10
-			i--
-			if k {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+			if q == nil {
+				q = &ForeignMessageProto2{}
+			}
+			if !p.EqualVTWithOptions(q, opts) {
+				return false
 			}
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.MapInt32Double) > 0 {
-		for k := range m.MapInt32Double {
-			v := m.MapInt32Double[k]
-			baseI := i
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
-			i--
-			dAtA[i] = 0x11
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0x9a
+	if len(this.MapStringNestedEnum) != len(that.MapStringNestedEnum) {
+		return false
+	}
+	for i, vx := range this.MapStringNestedEnum {
+		vy, ok := that.MapStringNestedEnum[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapInt32Float) > 0 {
-		for k := range m.MapInt32Float {
-			v := m.MapInt32Float[k]
-			baseI := i
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
-			i--
-			dAtA[i] = 0x15
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0x92
+	if len(this.MapStringForeignEnum) != len(that.MapStringForeignEnum) {
+		return false
+	}
+	for i, vx := range this.MapStringForeignEnum {
+		vy, ok := that.MapStringForeignEnum[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapSfixed64Sfixed64) > 0 {
-		for k := range m.MapSfixed64Sfixed64 {
-			v := m.MapSfixed64Sfixed64[k]
-			baseI := i
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
-			i--
-			dAtA[i] = 0x11
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
-			i--
-			dAtA[i] = 0x9
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0x8a
+	if len(this.PackedInt32) != len(that.PackedInt32) {
+		return false
+	}
+	for i, vx := range this.PackedInt32 {
+		vy := that.PackedInt32[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapSfixed32Sfixed32) > 0 {
-		for k := range m.MapSfixed32Sfixed32 {
-			v := m.MapSfixed32Sfixed32[k]
-			baseI := i
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
-			i--
-			dAtA[i] = 0x15
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
-			i--
-			dAtA[i] = 0xd
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0x82
-		}
+	if len(this.PackedInt64) != len(that.PackedInt64) {
+		return false
 	}
-	if len(m.MapFixed64Fixed64) > 0 {
-		for k := range m.MapFixed64Fixed64 {
-			v := m.MapFixed64Fixed64[k]
-			baseI := i
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
-			i--
-			dAtA[i] = 0x11
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
-			i--
-			dAtA[i] = 0x9
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xfa
+	for i, vx := range this.PackedInt64 {
+		vy := that.PackedInt64[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapFixed32Fixed32) > 0 {
-		for k := range m.MapFixed32Fixed32 {
-			v := m.MapFixed32Fixed32[k]
-			baseI := i
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
-			i--
-			dAtA[i] = 0x15
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
-			i--
-			dAtA[i] = 0xd
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xf2
+	if len(this.PackedUint32) != len(that.PackedUint32) {
+		return false
+	}
+	for i, vx := range this.PackedUint32 {
+		vy := that.PackedUint32[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapSint64Sint64) > 0 {
-		for k := range m.MapSint64Sint64 {
-			v := m.MapSint64Sint64[k]
-			baseI := i
-			i = encodeVarint(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarint(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xea
+	if len(this.PackedUint64) != len(that.PackedUint64) {
+		return false
+	}
+	for i, vx := range this.PackedUint64 {
+		vy := that.PackedUint64[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapSint32Sint32) > 0 {
-		for k := range m.MapSint32Sint32 {
-			v := m.MapSint32Sint32[k]
-			baseI := i
-			i = encodeVarint(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarint(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xe2
+	if len(this.PackedSint32) != len(that.PackedSint32) {
+		return false
+	}
+	for i, vx := range this.PackedSint32 {
+		vy := that.PackedSint32[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapUint64Uint64) > 0 {
-		for k := range m.MapUint64Uint64 {
-			v := m.MapUint64Uint64[k]
-			baseI := i
-			i = encodeVarint(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xda
+	if len(this.PackedSint64) != len(that.PackedSint64) {
+		return false
+	}
+	for i, vx := range this.PackedSint64 {
+		vy := that.PackedSint64[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapUint32Uint32) > 0 {
-		for k := range m.MapUint32Uint32 {
-			v := m.MapUint32Uint32[k]
-			baseI := i
-			i = encodeVarint(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xd2
+	if len(this.PackedFixed32) != len(that.PackedFixed32) {
+		return false
+	}
+	for i, vx := range this.PackedFixed32 {
+		vy := that.PackedFixed32[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapInt64Int64) > 0 {
-		for k := range m.MapInt64Int64 {
-			v := m.MapInt64Int64[k]
-			baseI := i
-			i = encodeVarint(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xca
+	if len(this.PackedFixed64) != len(that.PackedFixed64) {
+		return false
+	}
+	for i, vx := range this.PackedFixed64 {
+		vy := that.PackedFixed64[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapInt32Int32) > 0 {
-		for k := range m.MapInt32Int32 {
-			v := m.MapInt32Int32[k]
-			baseI := i
-			i = encodeVarint(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xc2
+	if len(this.PackedSfixed32) != len(that.PackedSfixed32) {
+		return false
+	}
+	for i, vx := range this.PackedSfixed32 {
+		vy := that.PackedSfixed32[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.RepeatedCord) > 0 {
-		for iNdEx := len(m.RepeatedCord) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RepeatedCord[iNdEx])
-			copy(dAtA[i:], m.RepeatedCord[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.RepeatedCord[iNdEx])))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xba
+	if len(this.PackedSfixed64) != len(that.PackedSfixed64) {
+		return false
+	}
+	for i, vx := range this.PackedSfixed64 {
+		vy := that.PackedSfixed64[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.RepeatedStringPiece) > 0 {
-		for iNdEx := len(m.RepeatedStringPiece) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RepeatedStringPiece[iNdEx])
-			copy(dAtA[i:], m.RepeatedStringPiece[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.RepeatedStringPiece[iNdEx])))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xb2
+	if len(this.PackedFloat) != len(that.PackedFloat) {
+		return false
+	}
+	for i, vx := range this.PackedFloat {
+		vy := that.PackedFloat[i]
+		if !opts.EqualFloat32(vx, vy) {
+			return false
 		}
 	}
-	if len(m.RepeatedForeignEnum) > 0 {
-		for iNdEx := len(m.RepeatedForeignEnum) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.RepeatedForeignEnum[iNdEx]))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xa0
+	if len(this.PackedDouble) != len(that.PackedDouble) {
+		return false
+	}
+	for i, vx := range this.PackedDouble {
+		vy := that.PackedDouble[i]
+		if !opts.EqualFloat64(vx, vy) {
+			return false
 		}
 	}
-	if len(m.RepeatedNestedEnum) > 0 {
-		for iNdEx := len(m.RepeatedNestedEnum) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.RepeatedNestedEnum[iNdEx]))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0x98
+	if len(this.PackedBool) != len(that.PackedBool) {
+		return false
+	}
+	for i, vx := range this.PackedBool {
+		vy := that.PackedBool[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.RepeatedForeignMessage) > 0 {
-		for iNdEx := len(m.RepeatedForeignMessage) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.RepeatedForeignMessage[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0x8a
+	if len(this.PackedNestedEnum) != len(that.PackedNestedEnum) {
+		return false
+	}
+	for i, vx := range this.PackedNestedEnum {
+		vy := that.PackedNestedEnum[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.RepeatedNestedMessage) > 0 {
-		for iNdEx := len(m.RepeatedNestedMessage) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.RepeatedNestedMessage[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0x82
-		}
+	if len(this.UnpackedInt32) != len(that.UnpackedInt32) {
+		return false
 	}
-	if len(m.RepeatedBytes) > 0 {
-		for iNdEx := len(m.RepeatedBytes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RepeatedBytes[iNdEx])
-			copy(dAtA[i:], m.RepeatedBytes[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.RepeatedBytes[iNdEx])))
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xea
+	for i, vx := range this.UnpackedInt32 {
+		vy := that.UnpackedInt32[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.RepeatedString) > 0 {
-		for iNdEx := len(m.RepeatedString) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RepeatedString[iNdEx])
-			copy(dAtA[i:], m.RepeatedString[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.RepeatedString[iNdEx])))
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xe2
-		}
+	if len(this.UnpackedInt64) != len(that.UnpackedInt64) {
+		return false
 	}
-	if len(m.RepeatedBool) > 0 {
-		for iNdEx := len(m.RepeatedBool) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.RepeatedBool[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xd8
+	for i, vx := range this.UnpackedInt64 {
+		vy := that.UnpackedInt64[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.RepeatedDouble) > 0 {
-		for iNdEx := len(m.RepeatedDouble) - 1; iNdEx >= 0; iNdEx-- {
-			f23 := math.Float64bits(float64(m.RepeatedDouble[iNdEx]))
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f23))
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xd1
-		}
+	if len(this.UnpackedUint32) != len(that.UnpackedUint32) {
+		return false
 	}
-	if len(m.RepeatedFloat) > 0 {
-		for iNdEx := len(m.RepeatedFloat) - 1; iNdEx >= 0; iNdEx-- {
-			f24 := math.Float32bits(float32(m.RepeatedFloat[iNdEx]))
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f24))
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xcd
+	for i, vx := range this.UnpackedUint32 {
+		vy := that.UnpackedUint32[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.RepeatedSfixed64) > 0 {
-		for iNdEx := len(m.RepeatedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.RepeatedSfixed64[iNdEx]))
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xc1
+	if len(this.UnpackedUint64) != len(that.UnpackedUint64) {
+		return false
+	}
+	for i, vx := range this.UnpackedUint64 {
+		vy := that.UnpackedUint64[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.RepeatedSfixed32) > 0 {
-		for iNdEx := len(m.RepeatedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.RepeatedSfixed32[iNdEx]))
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xbd
+	if len(this.UnpackedSint32) != len(that.UnpackedSint32) {
+		return false
+	}
+	for i, vx := range this.UnpackedSint32 {
+		vy := that.UnpackedSint32[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.RepeatedFixed64) > 0 {
-		for iNdEx := len(m.RepeatedFixed64) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.RepeatedFixed64[iNdEx]))
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xb1
+	if len(this.UnpackedSint64) != len(that.UnpackedSint64) {
+		return false
+	}
+	for i, vx := range this.UnpackedSint64 {
+		vy := that.UnpackedSint64[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.RepeatedFixed32) > 0 {
-		for iNdEx := len(m.RepeatedFixed32) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.RepeatedFixed32[iNdEx]))
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xad
+	if len(this.UnpackedFixed32) != len(that.UnpackedFixed32) {
+		return false
+	}
+	for i, vx := range this.UnpackedFixed32 {
+		vy := that.UnpackedFixed32[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.RepeatedSint64) > 0 {
-		for iNdEx := len(m.RepeatedSint64) - 1; iNdEx >= 0; iNdEx-- {
-			x25 := (uint64(m.RepeatedSint64[iNdEx]) << 1) ^ uint64((m.RepeatedSint64[iNdEx] >> 63))
-			i = encodeVarint(dAtA, i, uint64(x25))
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xa0
+	if len(this.UnpackedFixed64) != len(that.UnpackedFixed64) {
+		return false
+	}
+	for i, vx := range this.UnpackedFixed64 {
+		vy := that.UnpackedFixed64[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.RepeatedSint32) > 0 {
-		for iNdEx := len(m.RepeatedSint32) - 1; iNdEx >= 0; iNdEx-- {
-			x26 := (uint32(m.RepeatedSint32[iNdEx]) << 1) ^ uint32((m.RepeatedSint32[iNdEx] >> 31))
-			i = encodeVarint(dAtA, i, uint64(x26))
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0x98
+	if len(this.UnpackedSfixed32) != len(that.UnpackedSfixed32) {
+		return false
+	}
+	for i, vx := range this.UnpackedSfixed32 {
+		vy := that.UnpackedSfixed32[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.RepeatedUint64) > 0 {
-		for iNdEx := len(m.RepeatedUint64) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.RepeatedUint64[iNdEx]))
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0x90
+	if len(this.UnpackedSfixed64) != len(that.UnpackedSfixed64) {
+		return false
+	}
+	for i, vx := range this.UnpackedSfixed64 {
+		vy := that.UnpackedSfixed64[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.RepeatedUint32) > 0 {
-		for iNdEx := len(m.RepeatedUint32) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.RepeatedUint32[iNdEx]))
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0x88
+	if len(this.UnpackedFloat) != len(that.UnpackedFloat) {
+		return false
+	}
+	for i, vx := range this.UnpackedFloat {
+		vy := that.UnpackedFloat[i]
+		if !opts.EqualFloat32(vx, vy) {
+			return false
 		}
 	}
-	if len(m.RepeatedInt64) > 0 {
-		for iNdEx := len(m.RepeatedInt64) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.RepeatedInt64[iNdEx]))
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0x80
+	if len(this.UnpackedDouble) != len(that.UnpackedDouble) {
+		return false
+	}
+	for i, vx := range this.UnpackedDouble {
+		vy := that.UnpackedDouble[i]
+		if !opts.EqualFloat64(vx, vy) {
+			return false
 		}
 	}
-	if len(m.RepeatedInt32) > 0 {
-		for iNdEx := len(m.RepeatedInt32) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.RepeatedInt32[iNdEx]))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xf8
+	if len(this.UnpackedBool) != len(that.UnpackedBool) {
+		return false
+	}
+	for i, vx := range this.UnpackedBool {
+		vy := that.UnpackedBool[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if m.RecursiveMessage != nil {
-		size, err := m.RecursiveMessage.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(this.UnpackedNestedEnum) != len(that.UnpackedNestedEnum) {
+		return false
+	}
+	for i, vx := range this.UnpackedNestedEnum {
+		vy := that.UnpackedNestedEnum[i]
+		if vx != vy {
+			return false
 		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xda
 	}
-	if m.OptionalCord != nil {
-		i -= len(*m.OptionalCord)
-		copy(dAtA[i:], *m.OptionalCord)
-		i = encodeVarint(dAtA, i, uint64(len(*m.OptionalCord)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xca
+	if !this.Data.EqualVTWithOptions(that.Data, opts) {
+		return false
 	}
-	if m.OptionalStringPiece != nil {
-		i -= len(*m.OptionalStringPiece)
-		copy(dAtA[i:], *m.OptionalStringPiece)
-		i = encodeVarint(dAtA, i, uint64(len(*m.OptionalStringPiece)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc2
+	if p, q := this.DefaultInt32, that.DefaultInt32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.OptionalForeignEnum != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.OptionalForeignEnum))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb0
+	if p, q := this.DefaultInt64, that.DefaultInt64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.OptionalNestedEnum != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.OptionalNestedEnum))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa8
+	if p, q := this.DefaultUint32, that.DefaultUint32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.OptionalForeignMessage != nil {
-		size, err := m.OptionalForeignMessage.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x9a
+	if p, q := this.DefaultUint64, that.DefaultUint64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.OptionalNestedMessage != nil {
-		size, err := m.OptionalNestedMessage.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
+	if p, q := this.DefaultSint32, that.DefaultSint32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.OptionalBytes != nil {
-		i -= len(m.OptionalBytes)
-		copy(dAtA[i:], m.OptionalBytes)
-		i = encodeVarint(dAtA, i, uint64(len(m.OptionalBytes)))
-		i--
-		dAtA[i] = 0x7a
+	if p, q := this.DefaultSint64, that.DefaultSint64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.OptionalString != nil {
-		i -= len(*m.OptionalString)
-		copy(dAtA[i:], *m.OptionalString)
-		i = encodeVarint(dAtA, i, uint64(len(*m.OptionalString)))
-		i--
-		dAtA[i] = 0x72
+	if p, q := this.DefaultFixed32, that.DefaultFixed32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.OptionalBool != nil {
-		i--
-		if *m.OptionalBool {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
+	if p, q := this.DefaultFixed64, that.DefaultFixed64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.OptionalDouble != nil {
-		i -= 8
-		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.OptionalDouble))))
-		i--
-		dAtA[i] = 0x61
+	if p, q := this.DefaultSfixed32, that.DefaultSfixed32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.OptionalFloat != nil {
-		i -= 4
-		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(*m.OptionalFloat))))
-		i--
-		dAtA[i] = 0x5d
+	if p, q := this.DefaultSfixed64, that.DefaultSfixed64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.OptionalSfixed64 != nil {
-		i -= 8
-		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.OptionalSfixed64))
-		i--
-		dAtA[i] = 0x51
+	if p, q := this.DefaultFloat, that.DefaultFloat; (p == nil && q != nil) || (p != nil && (q == nil || !opts.EqualFloat32(*p, *q))) {
+		return false
 	}
-	if m.OptionalSfixed32 != nil {
-		i -= 4
-		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.OptionalSfixed32))
-		i--
-		dAtA[i] = 0x4d
+	if p, q := this.DefaultDouble, that.DefaultDouble; (p == nil && q != nil) || (p != nil && (q == nil || !opts.EqualFloat64(*p, *q))) {
+		return false
 	}
-	if m.OptionalFixed64 != nil {
-		i -= 8
-		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.OptionalFixed64))
-		i--
-		dAtA[i] = 0x41
+	if p, q := this.DefaultBool, that.DefaultBool; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.OptionalFixed32 != nil {
-		i -= 4
-		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.OptionalFixed32))
-		i--
-		dAtA[i] = 0x3d
+	if p, q := this.DefaultString, that.DefaultString; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.OptionalSint64 != nil {
-		i = encodeVarint(dAtA, i, uint64((uint64(*m.OptionalSint64)<<1)^uint64((*m.OptionalSint64>>63))))
-		i--
-		dAtA[i] = 0x30
+	if p, q := this.DefaultBytes, that.DefaultBytes; (p == nil && q != nil) || (p != nil && q == nil) || string(p) != string(q) {
+		return false
 	}
-	if m.OptionalSint32 != nil {
-		i = encodeVarint(dAtA, i, uint64((uint32(*m.OptionalSint32)<<1)^uint32((*m.OptionalSint32>>31))))
-		i--
-		dAtA[i] = 0x28
+	if p, q := this.Fieldname1, that.Fieldname1; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.OptionalUint64 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.OptionalUint64))
-		i--
-		dAtA[i] = 0x20
+	if p, q := this.FieldName2, that.FieldName2; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.OptionalUint32 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.OptionalUint32))
-		i--
-		dAtA[i] = 0x18
+	if p, q := this.XFieldName3, that.XFieldName3; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.OptionalInt64 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.OptionalInt64))
-		i--
-		dAtA[i] = 0x10
+	if p, q := this.Field_Name4_, that.Field_Name4_; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.OptionalInt32 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.OptionalInt32))
-		i--
-		dAtA[i] = 0x8
+	if p, q := this.Field0Name5, that.Field0Name5; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if p, q := this.Field_0Name6, that.Field_0Name6; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := this.FieldName7, that.FieldName7; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := this.FieldName8, that.FieldName8; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := this.Field_Name9, that.Field_Name9; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := this.Field_Name10, that.Field_Name10; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := this.FIELD_NAME11, that.FIELD_NAME11; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := this.FIELDName12, that.FIELDName12; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := this.XFieldName13, that.XFieldName13; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := this.X_FieldName14, that.X_FieldName14; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := this.Field_Name15, that.Field_Name15; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := this.Field__Name16, that.Field__Name16; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := this.FieldName17__, that.FieldName17__; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := this.FieldName18__, that.FieldName18__; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if len(this.extensionFields) != len(that.extensionFields) {
+		return false
+	}
+	for num, x := range this.extensionFields {
+		y, ok := that.extensionFields[num]
+		if !ok {
+			return false
+		}
+		switch num {
+		case 120:
+			ex, ey := proto.GetExtension(this, E_ExtensionInt32).(int32), proto.GetExtension(that, E_ExtensionInt32).(int32)
+			if ex != ey {
+				return false
+			}
+		default:
+			if x.Type() != y.Type() || !protohelpers.EqualExtensionWithOptions(x.Type(), x.Value(), y.Value(), opts) {
+				return false
+			}
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *TestAllTypesProto2_OneofUint32) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *TestAllTypesProto2) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*TestAllTypesProto2)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
 }
 
-func (m *TestAllTypesProto2_OneofUint32) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarint(dAtA, i, uint64(m.OneofUint32))
-	i--
-	dAtA[i] = 0x6
-	i--
-	dAtA[i] = 0xf8
-	return len(dAtA) - i, nil
+func (this *TestAllTypesProto2_OneofUint32) EqualVT(thatIface isTestAllTypesProto2_OneofField) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
 }
-func (m *TestAllTypesProto2_OneofNestedMessage) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+
+func (this *TestAllTypesProto2_OneofUint32) EqualVTWithOptions(thatIface isTestAllTypesProto2_OneofField, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*TestAllTypesProto2_OneofUint32)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.OneofUint32 != that.OneofUint32 {
+		return false
+	}
+	return true
 }
 
-func (m *TestAllTypesProto2_OneofNestedMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.OneofNestedMessage != nil {
-		size, err := m.OneofNestedMessage.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+func (this *TestAllTypesProto2_OneofNestedMessage) EqualVT(thatIface isTestAllTypesProto2_OneofField) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *TestAllTypesProto2_OneofNestedMessage) EqualVTWithOptions(thatIface isTestAllTypesProto2_OneofField, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*TestAllTypesProto2_OneofNestedMessage)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if p, q := this.OneofNestedMessage, that.OneofNestedMessage; p != q {
+		if p == nil {
+			p = &TestAllTypesProto2_NestedMessage{}
+		}
+		if q == nil {
+			q = &TestAllTypesProto2_NestedMessage{}
+		}
+		if !p.EqualVTWithOptions(q, opts) {
+			return false
 		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0x7
-		i--
-		dAtA[i] = 0x82
 	}
-	return len(dAtA) - i, nil
-}
-func (m *TestAllTypesProto2_OneofString) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	return true
 }
 
-func (m *TestAllTypesProto2_OneofString) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.OneofString)
-	copy(dAtA[i:], m.OneofString)
-	i = encodeVarint(dAtA, i, uint64(len(m.OneofString)))
-	i--
-	dAtA[i] = 0x7
-	i--
-	dAtA[i] = 0x8a
-	return len(dAtA) - i, nil
-}
-func (m *TestAllTypesProto2_OneofBytes) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *TestAllTypesProto2_OneofString) EqualVT(thatIface isTestAllTypesProto2_OneofField) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
 }
 
-func (m *TestAllTypesProto2_OneofBytes) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.OneofBytes)
-	copy(dAtA[i:], m.OneofBytes)
-	i = encodeVarint(dAtA, i, uint64(len(m.OneofBytes)))
-	i--
-	dAtA[i] = 0x7
-	i--
-	dAtA[i] = 0x92
-	return len(dAtA) - i, nil
+func (this *TestAllTypesProto2_OneofString) EqualVTWithOptions(thatIface isTestAllTypesProto2_OneofField, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*TestAllTypesProto2_OneofString)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.OneofString != that.OneofString {
+		return false
+	}
+	return true
 }
-func (m *TestAllTypesProto2_OneofBool) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+
+func (this *TestAllTypesProto2_OneofBytes) EqualVT(thatIface isTestAllTypesProto2_OneofField) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
 }
 
-func (m *TestAllTypesProto2_OneofBool) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i--
-	if m.OneofBool {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+func (this *TestAllTypesProto2_OneofBytes) EqualVTWithOptions(thatIface isTestAllTypesProto2_OneofField, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*TestAllTypesProto2_OneofBytes)
+	if !ok {
+		return false
 	}
-	i--
-	dAtA[i] = 0x7
-	i--
-	dAtA[i] = 0x98
-	return len(dAtA) - i, nil
-}
-func (m *TestAllTypesProto2_OneofUint64) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if string(this.OneofBytes) != string(that.OneofBytes) {
+		return false
+	}
+	return true
 }
 
-func (m *TestAllTypesProto2_OneofUint64) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarint(dAtA, i, uint64(m.OneofUint64))
-	i--
-	dAtA[i] = 0x7
-	i--
-	dAtA[i] = 0xa0
-	return len(dAtA) - i, nil
-}
-func (m *TestAllTypesProto2_OneofFloat) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *TestAllTypesProto2_OneofBool) EqualVT(thatIface isTestAllTypesProto2_OneofField) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
 }
 
-func (m *TestAllTypesProto2_OneofFloat) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= 4
-	binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OneofFloat))))
-	i--
-	dAtA[i] = 0x7
-	i--
-	dAtA[i] = 0xad
-	return len(dAtA) - i, nil
-}
-func (m *TestAllTypesProto2_OneofDouble) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *TestAllTypesProto2_OneofBool) EqualVTWithOptions(thatIface isTestAllTypesProto2_OneofField, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*TestAllTypesProto2_OneofBool)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.OneofBool != that.OneofBool {
+		return false
+	}
+	return true
 }
 
-func (m *TestAllTypesProto2_OneofDouble) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= 8
-	binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.OneofDouble))))
-	i--
-	dAtA[i] = 0x7
-	i--
-	dAtA[i] = 0xb1
-	return len(dAtA) - i, nil
-}
-func (m *TestAllTypesProto2_OneofEnum) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *TestAllTypesProto2_OneofUint64) EqualVT(thatIface isTestAllTypesProto2_OneofField) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
 }
 
-func (m *TestAllTypesProto2_OneofEnum) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarint(dAtA, i, uint64(m.OneofEnum))
-	i--
-	dAtA[i] = 0x7
-	i--
-	dAtA[i] = 0xb8
-	return len(dAtA) - i, nil
-}
-func (m *ForeignMessageProto2) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+func (this *TestAllTypesProto2_OneofUint64) EqualVTWithOptions(thatIface isTestAllTypesProto2_OneofField, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*TestAllTypesProto2_OneofUint64)
+	if !ok {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.OneofUint64 != that.OneofUint64 {
+		return false
+	}
+	return true
 }
 
-func (m *ForeignMessageProto2) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *TestAllTypesProto2_OneofFloat) EqualVT(thatIface isTestAllTypesProto2_OneofField) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
 }
 
-func (m *ForeignMessageProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *TestAllTypesProto2_OneofFloat) EqualVTWithOptions(thatIface isTestAllTypesProto2_OneofField, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*TestAllTypesProto2_OneofFloat)
+	if !ok {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if this == that {
+		return true
 	}
-	if m.C != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.C))
-		i--
-		dAtA[i] = 0x8
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if !opts.EqualFloat32(this.OneofFloat, that.OneofFloat) {
+		return false
+	}
+	return true
 }
 
-func (m *UnknownToTestAllTypes_OptionalGroup) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+func (this *TestAllTypesProto2_OneofDouble) EqualVT(thatIface isTestAllTypesProto2_OneofField) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *TestAllTypesProto2_OneofDouble) EqualVTWithOptions(thatIface isTestAllTypesProto2_OneofField, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*TestAllTypesProto2_OneofDouble)
+	if !ok {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this == that {
+		return true
 	}
-	return dAtA[:n], nil
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if !opts.EqualFloat64(this.OneofDouble, that.OneofDouble) {
+		return false
+	}
+	return true
 }
 
-func (m *UnknownToTestAllTypes_OptionalGroup) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *TestAllTypesProto2_OneofEnum) EqualVT(thatIface isTestAllTypesProto2_OneofField) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
 }
 
-func (m *UnknownToTestAllTypes_OptionalGroup) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *TestAllTypesProto2_OneofEnum) EqualVTWithOptions(thatIface isTestAllTypesProto2_OneofField, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*TestAllTypesProto2_OneofEnum)
+	if !ok {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if this == that {
+		return true
 	}
-	if m.A != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.A))
-		i--
-		dAtA[i] = 0x8
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.OneofEnum != that.OneofEnum {
+		return false
+	}
+	return true
 }
 
-func (m *UnknownToTestAllTypes) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+func (this *ForeignMessageProto2) EqualVT(that *ForeignMessageProto2) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *ForeignMessageProto2) EqualVTWithOptions(that *ForeignMessageProto2, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if p, q := this.C, that.C; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	return dAtA[:n], nil
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *UnknownToTestAllTypes) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *ForeignMessageProto2) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*ForeignMessageProto2)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
 }
 
-func (m *UnknownToTestAllTypes) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *UnknownToTestAllTypes_OptionalGroup) EqualVT(that *UnknownToTestAllTypes_OptionalGroup) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *UnknownToTestAllTypes_OptionalGroup) EqualVTWithOptions(that *UnknownToTestAllTypes_OptionalGroup, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if p, q := this.A, that.A; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if len(m.RepeatedInt32) > 0 {
-		for iNdEx := len(m.RepeatedInt32) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.RepeatedInt32[iNdEx]))
-			i--
-			dAtA[i] = 0x3f
-			i--
-			dAtA[i] = 0x98
-		}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *UnknownToTestAllTypes_OptionalGroup) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*UnknownToTestAllTypes_OptionalGroup)
+	if !ok {
+		return false
 	}
-	if m.OptionalBool != nil {
-		i--
-		if *m.OptionalBool {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x3e
-		i--
-		dAtA[i] = 0xf0
+	return this.EqualVT(that)
+}
+
+func (this *UnknownToTestAllTypes) EqualVT(that *UnknownToTestAllTypes) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *UnknownToTestAllTypes) EqualVTWithOptions(that *UnknownToTestAllTypes, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
 	}
-	if m.Optionalgroup != nil {
-		i--
-		dAtA[i] = 0x3e
-		i--
-		dAtA[i] = 0xe4
-		size, err := m.Optionalgroup.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i--
-		dAtA[i] = 0x3e
-		i--
-		dAtA[i] = 0xe3
+	if p, q := this.OptionalInt32, that.OptionalInt32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.NestedMessage != nil {
-		size, err := m.NestedMessage.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0x3e
-		i--
-		dAtA[i] = 0xda
+	if p, q := this.OptionalString, that.OptionalString; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.OptionalString != nil {
-		i -= len(*m.OptionalString)
-		copy(dAtA[i:], *m.OptionalString)
-		i = encodeVarint(dAtA, i, uint64(len(*m.OptionalString)))
-		i--
-		dAtA[i] = 0x3e
-		i--
-		dAtA[i] = 0xd2
+	if !this.NestedMessage.EqualVTWithOptions(that.NestedMessage, opts) {
+		return false
 	}
-	if m.OptionalInt32 != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.OptionalInt32))
-		i--
-		dAtA[i] = 0x3e
-		i--
-		dAtA[i] = 0xc8
+	if !this.Optionalgroup.EqualVTWithOptions(that.Optionalgroup, opts) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if p, q := this.OptionalBool, that.OptionalBool; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if len(this.RepeatedInt32) != len(that.RepeatedInt32) {
+		return false
+	}
+	for i, vx := range this.RepeatedInt32 {
+		vy := that.RepeatedInt32[i]
+		if vx != vy {
+			return false
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *NullHypothesisProto2) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *UnknownToTestAllTypes) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*UnknownToTestAllTypes)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
+	return this.EqualVT(that)
 }
 
-func (m *NullHypothesisProto2) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *NullHypothesisProto2) EqualVT(that *NullHypothesisProto2) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
 }
 
-func (m *NullHypothesisProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+func (this *NullHypothesisProto2) EqualVTWithOptions(that *NullHypothesisProto2, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *EnumOnlyProto2) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *NullHypothesisProto2) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*NullHypothesisProto2)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
+	return this.EqualVT(that)
 }
 
-func (m *EnumOnlyProto2) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *EnumOnlyProto2) EqualVT(that *EnumOnlyProto2) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
 }
 
-func (m *EnumOnlyProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+func (this *EnumOnlyProto2) EqualVTWithOptions(that *EnumOnlyProto2, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *OneStringProto2) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *EnumOnlyProto2) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*EnumOnlyProto2)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
+	return this.EqualVT(that)
 }
 
-func (m *OneStringProto2) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *OneStringProto2) EqualVT(that *OneStringProto2) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
 }
 
-func (m *OneStringProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+func (this *OneStringProto2) EqualVTWithOptions(that *OneStringProto2, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
 	}
-	if m.Data != nil {
-		i -= len(*m.Data)
-		copy(dAtA[i:], *m.Data)
-		i = encodeVarint(dAtA, i, uint64(len(*m.Data)))
-		i--
-		dAtA[i] = 0xa
+	if p, q := this.Data, that.Data; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func encodeVarint(dAtA []byte, offset int, v uint64) int {
-	offset -= sov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (this *OneStringProto2) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*OneStringProto2)
+	if !ok {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return this.EqualVT(that)
 }
-func (m *TestAllTypesProto2_NestedMessage) SizeVT() (n int) {
+
+func (m *TestAllTypesProto2_NestedMessage) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *TestAllTypesProto2_NestedMessage) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return 0
+		return
 	}
-	var l int
-	_ = l
 	if m.A != nil {
-		n += 1 + sov(uint64(*m.A))
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(*m.A))
 	}
 	if m.Corecursive != nil {
-		l = m.Corecursive.SizeVT()
-		n += 1 + l + sov(uint64(l))
+		protohelpers.HashUint64(h, s, 2)
+		m.Corecursive.hashVT(h, s)
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	n += len(m.unknownFields)
-	return n
 }
 
-func (m *TestAllTypesProto2_Data) SizeVT() (n int) {
+func (m *TestAllTypesProto2_Data) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *TestAllTypesProto2_Data) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return 0
+		return
 	}
-	var l int
-	_ = l
 	if m.GroupInt32 != nil {
-		n += 2 + sov(uint64(*m.GroupInt32))
+		protohelpers.HashUint64(h, s, 202)
+		protohelpers.HashUint64(h, s, uint64(*m.GroupInt32))
 	}
 	if m.GroupUint32 != nil {
-		n += 2 + sov(uint64(*m.GroupUint32))
+		protohelpers.HashUint64(h, s, 203)
+		protohelpers.HashUint64(h, s, uint64(*m.GroupUint32))
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	n += len(m.unknownFields)
-	return n
 }
 
-func (m *TestAllTypesProto2_MessageSetCorrect) SizeVT() (n int) {
+func (m *TestAllTypesProto2_MessageSetCorrect) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return 0
+		return
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	var l int
-	_ = l
-	n += len(m.unknownFields)
-	return n
 }
 
-func (m *TestAllTypesProto2_MessageSetCorrectExtension1) SizeVT() (n int) {
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return 0
+		return
 	}
-	var l int
-	_ = l
 	if m.Str != nil {
-		l = len(*m.Str)
-		n += 2 + l + sov(uint64(l))
+		protohelpers.HashUint64(h, s, 25)
+		protohelpers.HashString(h, s, *m.Str)
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	n += len(m.unknownFields)
-	return n
 }
 
-func (m *TestAllTypesProto2_MessageSetCorrectExtension2) SizeVT() (n int) {
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return 0
+		return
 	}
-	var l int
-	_ = l
 	if m.I != nil {
-		n += 1 + sov(uint64(*m.I))
+		protohelpers.HashUint64(h, s, 9)
+		protohelpers.HashUint64(h, s, uint64(*m.I))
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	n += len(m.unknownFields)
-	return n
 }
 
-func (m *TestAllTypesProto2) SizeVT() (n int) {
+func (m *TestAllTypesProto2) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *TestAllTypesProto2) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return 0
+		return
 	}
-	var l int
-	_ = l
+	var entry hash.Hash64
 	if m.OptionalInt32 != nil {
-		n += 1 + sov(uint64(*m.OptionalInt32))
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalInt32))
 	}
 	if m.OptionalInt64 != nil {
-		n += 1 + sov(uint64(*m.OptionalInt64))
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalInt64))
 	}
 	if m.OptionalUint32 != nil {
-		n += 1 + sov(uint64(*m.OptionalUint32))
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalUint32))
 	}
 	if m.OptionalUint64 != nil {
-		n += 1 + sov(uint64(*m.OptionalUint64))
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalUint64))
 	}
 	if m.OptionalSint32 != nil {
-		n += 1 + soz(uint64(*m.OptionalSint32))
+		protohelpers.HashUint64(h, s, 5)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalSint32))
 	}
 	if m.OptionalSint64 != nil {
-		n += 1 + soz(uint64(*m.OptionalSint64))
+		protohelpers.HashUint64(h, s, 6)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalSint64))
 	}
 	if m.OptionalFixed32 != nil {
-		n += 5
+		protohelpers.HashUint64(h, s, 7)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalFixed32))
 	}
 	if m.OptionalFixed64 != nil {
-		n += 9
+		protohelpers.HashUint64(h, s, 8)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalFixed64))
 	}
 	if m.OptionalSfixed32 != nil {
-		n += 5
+		protohelpers.HashUint64(h, s, 9)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalSfixed32))
 	}
 	if m.OptionalSfixed64 != nil {
-		n += 9
+		protohelpers.HashUint64(h, s, 10)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalSfixed64))
 	}
 	if m.OptionalFloat != nil {
-		n += 5
+		protohelpers.HashUint64(h, s, 11)
+		protohelpers.HashFloat32(h, s, *m.OptionalFloat)
 	}
 	if m.OptionalDouble != nil {
-		n += 9
+		protohelpers.HashUint64(h, s, 12)
+		protohelpers.HashFloat64(h, s, *m.OptionalDouble)
 	}
 	if m.OptionalBool != nil {
-		n += 2
+		protohelpers.HashUint64(h, s, 13)
+		protohelpers.HashBool(h, s, *m.OptionalBool)
 	}
 	if m.OptionalString != nil {
-		l = len(*m.OptionalString)
-		n += 1 + l + sov(uint64(l))
+		protohelpers.HashUint64(h, s, 14)
+		protohelpers.HashString(h, s, *m.OptionalString)
 	}
 	if m.OptionalBytes != nil {
-		l = len(m.OptionalBytes)
-		n += 1 + l + sov(uint64(l))
+		protohelpers.HashUint64(h, s, 15)
+		protohelpers.HashBytes(h, s, m.OptionalBytes)
 	}
 	if m.OptionalNestedMessage != nil {
-		l = m.OptionalNestedMessage.SizeVT()
-		n += 2 + l + sov(uint64(l))
+		protohelpers.HashUint64(h, s, 18)
+		m.OptionalNestedMessage.hashVT(h, s)
+		protohelpers.HashUint64(h, s, 0)
 	}
 	if m.OptionalForeignMessage != nil {
-		l = m.OptionalForeignMessage.SizeVT()
-		n += 2 + l + sov(uint64(l))
+		protohelpers.HashUint64(h, s, 19)
+		m.OptionalForeignMessage.hashVT(h, s)
+		protohelpers.HashUint64(h, s, 0)
 	}
 	if m.OptionalNestedEnum != nil {
-		n += 2 + sov(uint64(*m.OptionalNestedEnum))
+		protohelpers.HashUint64(h, s, 21)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalNestedEnum))
 	}
 	if m.OptionalForeignEnum != nil {
-		n += 2 + sov(uint64(*m.OptionalForeignEnum))
+		protohelpers.HashUint64(h, s, 22)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalForeignEnum))
 	}
 	if m.OptionalStringPiece != nil {
-		l = len(*m.OptionalStringPiece)
-		n += 2 + l + sov(uint64(l))
+		protohelpers.HashUint64(h, s, 24)
+		protohelpers.HashString(h, s, *m.OptionalStringPiece)
 	}
 	if m.OptionalCord != nil {
-		l = len(*m.OptionalCord)
-		n += 2 + l + sov(uint64(l))
+		protohelpers.HashUint64(h, s, 25)
+		protohelpers.HashString(h, s, *m.OptionalCord)
 	}
 	if m.RecursiveMessage != nil {
-		l = m.RecursiveMessage.SizeVT()
-		n += 2 + l + sov(uint64(l))
+		protohelpers.HashUint64(h, s, 27)
+		m.RecursiveMessage.hashVT(h, s)
+		protohelpers.HashUint64(h, s, 0)
 	}
 	if len(m.RepeatedInt32) > 0 {
-		for _, e := range m.RepeatedInt32 {
-			n += 2 + sov(uint64(e))
+		protohelpers.HashUint64(h, s, 31)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedInt32)))
+		for _, v := range m.RepeatedInt32 {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
 	}
 	if len(m.RepeatedInt64) > 0 {
-		for _, e := range m.RepeatedInt64 {
-			n += 2 + sov(uint64(e))
+		protohelpers.HashUint64(h, s, 32)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedInt64)))
+		for _, v := range m.RepeatedInt64 {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
 	}
 	if len(m.RepeatedUint32) > 0 {
-		for _, e := range m.RepeatedUint32 {
-			n += 2 + sov(uint64(e))
+		protohelpers.HashUint64(h, s, 33)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedUint32)))
+		for _, v := range m.RepeatedUint32 {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
 	}
 	if len(m.RepeatedUint64) > 0 {
-		for _, e := range m.RepeatedUint64 {
-			n += 2 + sov(uint64(e))
+		protohelpers.HashUint64(h, s, 34)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedUint64)))
+		for _, v := range m.RepeatedUint64 {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
 	}
 	if len(m.RepeatedSint32) > 0 {
-		for _, e := range m.RepeatedSint32 {
-			n += 2 + soz(uint64(e))
+		protohelpers.HashUint64(h, s, 35)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedSint32)))
+		for _, v := range m.RepeatedSint32 {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
 	}
 	if len(m.RepeatedSint64) > 0 {
-		for _, e := range m.RepeatedSint64 {
-			n += 2 + soz(uint64(e))
+		protohelpers.HashUint64(h, s, 36)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedSint64)))
+		for _, v := range m.RepeatedSint64 {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
 	}
 	if len(m.RepeatedFixed32) > 0 {
-		n += 6 * len(m.RepeatedFixed32)
+		protohelpers.HashUint64(h, s, 37)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedFixed32)))
+		for _, v := range m.RepeatedFixed32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
 	}
 	if len(m.RepeatedFixed64) > 0 {
-		n += 10 * len(m.RepeatedFixed64)
+		protohelpers.HashUint64(h, s, 38)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedFixed64)))
+		for _, v := range m.RepeatedFixed64 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
 	}
 	if len(m.RepeatedSfixed32) > 0 {
-		n += 6 * len(m.RepeatedSfixed32)
+		protohelpers.HashUint64(h, s, 39)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedSfixed32)))
+		for _, v := range m.RepeatedSfixed32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
 	}
 	if len(m.RepeatedSfixed64) > 0 {
-		n += 10 * len(m.RepeatedSfixed64)
+		protohelpers.HashUint64(h, s, 40)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedSfixed64)))
+		for _, v := range m.RepeatedSfixed64 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
 	}
 	if len(m.RepeatedFloat) > 0 {
-		n += 6 * len(m.RepeatedFloat)
+		protohelpers.HashUint64(h, s, 41)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedFloat)))
+		for _, v := range m.RepeatedFloat {
+			protohelpers.HashFloat32(h, s, v)
+		}
 	}
 	if len(m.RepeatedDouble) > 0 {
-		n += 10 * len(m.RepeatedDouble)
+		protohelpers.HashUint64(h, s, 42)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedDouble)))
+		for _, v := range m.RepeatedDouble {
+			protohelpers.HashFloat64(h, s, v)
+		}
 	}
 	if len(m.RepeatedBool) > 0 {
-		n += 3 * len(m.RepeatedBool)
+		protohelpers.HashUint64(h, s, 43)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedBool)))
+		for _, v := range m.RepeatedBool {
+			protohelpers.HashBool(h, s, v)
+		}
 	}
 	if len(m.RepeatedString) > 0 {
-		for _, s := range m.RepeatedString {
-			l = len(s)
-			n += 2 + l + sov(uint64(l))
+		protohelpers.HashUint64(h, s, 44)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedString)))
+		for _, v := range m.RepeatedString {
+			protohelpers.HashString(h, s, v)
 		}
 	}
 	if len(m.RepeatedBytes) > 0 {
-		for _, b := range m.RepeatedBytes {
-			l = len(b)
-			n += 2 + l + sov(uint64(l))
+		protohelpers.HashUint64(h, s, 45)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedBytes)))
+		for _, v := range m.RepeatedBytes {
+			protohelpers.HashBytes(h, s, v)
 		}
 	}
 	if len(m.RepeatedNestedMessage) > 0 {
-		for _, e := range m.RepeatedNestedMessage {
-			l = e.SizeVT()
-			n += 2 + l + sov(uint64(l))
+		protohelpers.HashUint64(h, s, 48)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedNestedMessage)))
+		for _, v := range m.RepeatedNestedMessage {
+			v.hashVT(h, s)
+			protohelpers.HashUint64(h, s, 0)
 		}
 	}
 	if len(m.RepeatedForeignMessage) > 0 {
-		for _, e := range m.RepeatedForeignMessage {
-			l = e.SizeVT()
-			n += 2 + l + sov(uint64(l))
+		protohelpers.HashUint64(h, s, 49)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedForeignMessage)))
+		for _, v := range m.RepeatedForeignMessage {
+			v.hashVT(h, s)
+			protohelpers.HashUint64(h, s, 0)
 		}
 	}
 	if len(m.RepeatedNestedEnum) > 0 {
-		for _, e := range m.RepeatedNestedEnum {
-			n += 2 + sov(uint64(e))
+		protohelpers.HashUint64(h, s, 51)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedNestedEnum)))
+		for _, v := range m.RepeatedNestedEnum {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
 	}
 	if len(m.RepeatedForeignEnum) > 0 {
-		for _, e := range m.RepeatedForeignEnum {
-			n += 2 + sov(uint64(e))
+		protohelpers.HashUint64(h, s, 52)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedForeignEnum)))
+		for _, v := range m.RepeatedForeignEnum {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
 	}
 	if len(m.RepeatedStringPiece) > 0 {
-		for _, s := range m.RepeatedStringPiece {
-			l = len(s)
-			n += 2 + l + sov(uint64(l))
+		protohelpers.HashUint64(h, s, 54)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedStringPiece)))
+		for _, v := range m.RepeatedStringPiece {
+			protohelpers.HashString(h, s, v)
 		}
 	}
 	if len(m.RepeatedCord) > 0 {
-		for _, s := range m.RepeatedCord {
-			l = len(s)
-			n += 2 + l + sov(uint64(l))
+		protohelpers.HashUint64(h, s, 55)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedCord)))
+		for _, v := range m.RepeatedCord {
+			protohelpers.HashString(h, s, v)
 		}
 	}
 	if len(m.MapInt32Int32) > 0 {
+		protohelpers.HashUint64(h, s, 56)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapInt32Int32)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
 		for k, v := range m.MapInt32Int32 {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sov(uint64(k)) + 1 + sov(uint64(v))
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
 		}
+		protohelpers.HashUint64(h, s, sum)
 	}
 	if len(m.MapInt64Int64) > 0 {
+		protohelpers.HashUint64(h, s, 57)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapInt64Int64)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
 		for k, v := range m.MapInt64Int64 {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sov(uint64(k)) + 1 + sov(uint64(v))
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
 		}
+		protohelpers.HashUint64(h, s, sum)
 	}
 	if len(m.MapUint32Uint32) > 0 {
+		protohelpers.HashUint64(h, s, 58)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapUint32Uint32)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
 		for k, v := range m.MapUint32Uint32 {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sov(uint64(k)) + 1 + sov(uint64(v))
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
 		}
+		protohelpers.HashUint64(h, s, sum)
 	}
 	if len(m.MapUint64Uint64) > 0 {
+		protohelpers.HashUint64(h, s, 59)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapUint64Uint64)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
 		for k, v := range m.MapUint64Uint64 {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sov(uint64(k)) + 1 + sov(uint64(v))
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
 		}
+		protohelpers.HashUint64(h, s, sum)
 	}
 	if len(m.MapSint32Sint32) > 0 {
+		protohelpers.HashUint64(h, s, 60)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapSint32Sint32)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
 		for k, v := range m.MapSint32Sint32 {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + soz(uint64(k)) + 1 + soz(uint64(v))
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
 		}
+		protohelpers.HashUint64(h, s, sum)
 	}
 	if len(m.MapSint64Sint64) > 0 {
+		protohelpers.HashUint64(h, s, 61)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapSint64Sint64)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
 		for k, v := range m.MapSint64Sint64 {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + soz(uint64(k)) + 1 + soz(uint64(v))
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
 		}
+		protohelpers.HashUint64(h, s, sum)
 	}
 	if len(m.MapFixed32Fixed32) > 0 {
+		protohelpers.HashUint64(h, s, 62)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapFixed32Fixed32)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
 		for k, v := range m.MapFixed32Fixed32 {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + 4 + 1 + 4
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
 		}
+		protohelpers.HashUint64(h, s, sum)
 	}
 	if len(m.MapFixed64Fixed64) > 0 {
+		protohelpers.HashUint64(h, s, 63)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapFixed64Fixed64)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
 		for k, v := range m.MapFixed64Fixed64 {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + 8 + 1 + 8
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
 		}
+		protohelpers.HashUint64(h, s, sum)
 	}
 	if len(m.MapSfixed32Sfixed32) > 0 {
+		protohelpers.HashUint64(h, s, 64)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapSfixed32Sfixed32)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
 		for k, v := range m.MapSfixed32Sfixed32 {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + 4 + 1 + 4
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
 		}
+		protohelpers.HashUint64(h, s, sum)
 	}
 	if len(m.MapSfixed64Sfixed64) > 0 {
+		protohelpers.HashUint64(h, s, 65)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapSfixed64Sfixed64)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
 		for k, v := range m.MapSfixed64Sfixed64 {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + 8 + 1 + 8
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
 		}
+		protohelpers.HashUint64(h, s, sum)
 	}
 	if len(m.MapInt32Float) > 0 {
+		protohelpers.HashUint64(h, s, 66)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapInt32Float)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
 		for k, v := range m.MapInt32Float {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sov(uint64(k)) + 1 + 4
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashFloat32(entry, s, v)
+			sum += entry.Sum64()
 		}
+		protohelpers.HashUint64(h, s, sum)
 	}
 	if len(m.MapInt32Double) > 0 {
+		protohelpers.HashUint64(h, s, 67)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapInt32Double)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
 		for k, v := range m.MapInt32Double {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sov(uint64(k)) + 1 + 8
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashFloat64(entry, s, v)
+			sum += entry.Sum64()
 		}
+		protohelpers.HashUint64(h, s, sum)
 	}
 	if len(m.MapBoolBool) > 0 {
+		protohelpers.HashUint64(h, s, 68)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapBoolBool)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
 		for k, v := range m.MapBoolBool {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + 1 + 1 + 1
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+			entry.Reset()
+			protohelpers.HashBool(entry, s, k)
+			protohelpers.HashBool(entry, s, v)
+			sum += entry.Sum64()
 		}
+		protohelpers.HashUint64(h, s, sum)
 	}
 	if len(m.MapStringString) > 0 {
+		protohelpers.HashUint64(h, s, 69)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapStringString)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
 		for k, v := range m.MapStringString {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + len(v) + sov(uint64(len(v)))
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+			entry.Reset()
+			protohelpers.HashString(entry, s, k)
+			protohelpers.HashString(entry, s, v)
+			sum += entry.Sum64()
 		}
+		protohelpers.HashUint64(h, s, sum)
 	}
 	if len(m.MapStringBytes) > 0 {
+		protohelpers.HashUint64(h, s, 70)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapStringBytes)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
 		for k, v := range m.MapStringBytes {
-			_ = k
-			_ = v
-			l = 1 + len(v) + sov(uint64(len(v)))
-			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + l
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+			entry.Reset()
+			protohelpers.HashString(entry, s, k)
+			protohelpers.HashBytes(entry, s, v)
+			sum += entry.Sum64()
 		}
+		protohelpers.HashUint64(h, s, sum)
 	}
 	if len(m.MapStringNestedMessage) > 0 {
+		protohelpers.HashUint64(h, s, 71)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapStringNestedMessage)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
 		for k, v := range m.MapStringNestedMessage {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.SizeVT()
-			}
-			l += 1 + sov(uint64(l))
-			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + l
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+			entry.Reset()
+			protohelpers.HashString(entry, s, k)
+			v.hashVT(entry, s)
+			protohelpers.HashUint64(entry, s, 0)
+			sum += entry.Sum64()
 		}
+		protohelpers.HashUint64(h, s, sum)
 	}
 	if len(m.MapStringForeignMessage) > 0 {
+		protohelpers.HashUint64(h, s, 72)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapStringForeignMessage)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
 		for k, v := range m.MapStringForeignMessage {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.SizeVT()
-			}
-			l += 1 + sov(uint64(l))
-			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + l
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+			entry.Reset()
+			protohelpers.HashString(entry, s, k)
+			v.hashVT(entry, s)
+			protohelpers.HashUint64(entry, s, 0)
+			sum += entry.Sum64()
 		}
+		protohelpers.HashUint64(h, s, sum)
 	}
 	if len(m.MapStringNestedEnum) > 0 {
+		protohelpers.HashUint64(h, s, 73)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapStringNestedEnum)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
 		for k, v := range m.MapStringNestedEnum {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + sov(uint64(v))
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+			entry.Reset()
+			protohelpers.HashString(entry, s, k)
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
 		}
+		protohelpers.HashUint64(h, s, sum)
 	}
 	if len(m.MapStringForeignEnum) > 0 {
+		protohelpers.HashUint64(h, s, 74)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapStringForeignEnum)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
 		for k, v := range m.MapStringForeignEnum {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + sov(uint64(v))
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+			entry.Reset()
+			protohelpers.HashString(entry, s, k)
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
 		}
+		protohelpers.HashUint64(h, s, sum)
 	}
 	if len(m.PackedInt32) > 0 {
-		l = 0
-		for _, e := range m.PackedInt32 {
-			l += sov(uint64(e))
+		protohelpers.HashUint64(h, s, 75)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedInt32)))
+		for _, v := range m.PackedInt32 {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
-		n += 2 + sov(uint64(l)) + l
 	}
 	if len(m.PackedInt64) > 0 {
-		l = 0
-		for _, e := range m.PackedInt64 {
-			l += sov(uint64(e))
+		protohelpers.HashUint64(h, s, 76)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedInt64)))
+		for _, v := range m.PackedInt64 {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
-		n += 2 + sov(uint64(l)) + l
 	}
 	if len(m.PackedUint32) > 0 {
-		l = 0
-		for _, e := range m.PackedUint32 {
-			l += sov(uint64(e))
+		protohelpers.HashUint64(h, s, 77)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedUint32)))
+		for _, v := range m.PackedUint32 {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
-		n += 2 + sov(uint64(l)) + l
 	}
 	if len(m.PackedUint64) > 0 {
-		l = 0
-		for _, e := range m.PackedUint64 {
-			l += sov(uint64(e))
+		protohelpers.HashUint64(h, s, 78)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedUint64)))
+		for _, v := range m.PackedUint64 {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
-		n += 2 + sov(uint64(l)) + l
 	}
 	if len(m.PackedSint32) > 0 {
-		l = 0
-		for _, e := range m.PackedSint32 {
-			l += soz(uint64(e))
+		protohelpers.HashUint64(h, s, 79)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedSint32)))
+		for _, v := range m.PackedSint32 {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
-		n += 2 + sov(uint64(l)) + l
 	}
 	if len(m.PackedSint64) > 0 {
-		l = 0
-		for _, e := range m.PackedSint64 {
-			l += soz(uint64(e))
+		protohelpers.HashUint64(h, s, 80)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedSint64)))
+		for _, v := range m.PackedSint64 {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
-		n += 2 + sov(uint64(l)) + l
 	}
 	if len(m.PackedFixed32) > 0 {
-		n += 2 + sov(uint64(len(m.PackedFixed32)*4)) + len(m.PackedFixed32)*4
+		protohelpers.HashUint64(h, s, 81)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedFixed32)))
+		for _, v := range m.PackedFixed32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
 	}
 	if len(m.PackedFixed64) > 0 {
-		n += 2 + sov(uint64(len(m.PackedFixed64)*8)) + len(m.PackedFixed64)*8
+		protohelpers.HashUint64(h, s, 82)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedFixed64)))
+		for _, v := range m.PackedFixed64 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
 	}
 	if len(m.PackedSfixed32) > 0 {
-		n += 2 + sov(uint64(len(m.PackedSfixed32)*4)) + len(m.PackedSfixed32)*4
+		protohelpers.HashUint64(h, s, 83)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedSfixed32)))
+		for _, v := range m.PackedSfixed32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
 	}
 	if len(m.PackedSfixed64) > 0 {
-		n += 2 + sov(uint64(len(m.PackedSfixed64)*8)) + len(m.PackedSfixed64)*8
+		protohelpers.HashUint64(h, s, 84)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedSfixed64)))
+		for _, v := range m.PackedSfixed64 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
 	}
 	if len(m.PackedFloat) > 0 {
-		n += 2 + sov(uint64(len(m.PackedFloat)*4)) + len(m.PackedFloat)*4
+		protohelpers.HashUint64(h, s, 85)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedFloat)))
+		for _, v := range m.PackedFloat {
+			protohelpers.HashFloat32(h, s, v)
+		}
 	}
 	if len(m.PackedDouble) > 0 {
-		n += 2 + sov(uint64(len(m.PackedDouble)*8)) + len(m.PackedDouble)*8
+		protohelpers.HashUint64(h, s, 86)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedDouble)))
+		for _, v := range m.PackedDouble {
+			protohelpers.HashFloat64(h, s, v)
+		}
 	}
 	if len(m.PackedBool) > 0 {
-		n += 2 + sov(uint64(len(m.PackedBool))) + len(m.PackedBool)*1
+		protohelpers.HashUint64(h, s, 87)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedBool)))
+		for _, v := range m.PackedBool {
+			protohelpers.HashBool(h, s, v)
+		}
 	}
 	if len(m.PackedNestedEnum) > 0 {
-		l = 0
-		for _, e := range m.PackedNestedEnum {
-			l += sov(uint64(e))
+		protohelpers.HashUint64(h, s, 88)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedNestedEnum)))
+		for _, v := range m.PackedNestedEnum {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
-		n += 2 + sov(uint64(l)) + l
 	}
 	if len(m.UnpackedInt32) > 0 {
-		for _, e := range m.UnpackedInt32 {
-			n += 2 + sov(uint64(e))
+		protohelpers.HashUint64(h, s, 89)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedInt32)))
+		for _, v := range m.UnpackedInt32 {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
 	}
 	if len(m.UnpackedInt64) > 0 {
-		for _, e := range m.UnpackedInt64 {
-			n += 2 + sov(uint64(e))
+		protohelpers.HashUint64(h, s, 90)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedInt64)))
+		for _, v := range m.UnpackedInt64 {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
 	}
 	if len(m.UnpackedUint32) > 0 {
-		for _, e := range m.UnpackedUint32 {
-			n += 2 + sov(uint64(e))
+		protohelpers.HashUint64(h, s, 91)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedUint32)))
+		for _, v := range m.UnpackedUint32 {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
 	}
 	if len(m.UnpackedUint64) > 0 {
-		for _, e := range m.UnpackedUint64 {
-			n += 2 + sov(uint64(e))
+		protohelpers.HashUint64(h, s, 92)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedUint64)))
+		for _, v := range m.UnpackedUint64 {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
 	}
 	if len(m.UnpackedSint32) > 0 {
-		for _, e := range m.UnpackedSint32 {
-			n += 2 + soz(uint64(e))
+		protohelpers.HashUint64(h, s, 93)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedSint32)))
+		for _, v := range m.UnpackedSint32 {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
 	}
 	if len(m.UnpackedSint64) > 0 {
-		for _, e := range m.UnpackedSint64 {
-			n += 2 + soz(uint64(e))
+		protohelpers.HashUint64(h, s, 94)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedSint64)))
+		for _, v := range m.UnpackedSint64 {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
 	}
 	if len(m.UnpackedFixed32) > 0 {
-		n += 6 * len(m.UnpackedFixed32)
+		protohelpers.HashUint64(h, s, 95)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedFixed32)))
+		for _, v := range m.UnpackedFixed32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
 	}
 	if len(m.UnpackedFixed64) > 0 {
-		n += 10 * len(m.UnpackedFixed64)
+		protohelpers.HashUint64(h, s, 96)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedFixed64)))
+		for _, v := range m.UnpackedFixed64 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
 	}
 	if len(m.UnpackedSfixed32) > 0 {
-		n += 6 * len(m.UnpackedSfixed32)
+		protohelpers.HashUint64(h, s, 97)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedSfixed32)))
+		for _, v := range m.UnpackedSfixed32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
 	}
 	if len(m.UnpackedSfixed64) > 0 {
-		n += 10 * len(m.UnpackedSfixed64)
+		protohelpers.HashUint64(h, s, 98)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedSfixed64)))
+		for _, v := range m.UnpackedSfixed64 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
 	}
 	if len(m.UnpackedFloat) > 0 {
-		n += 6 * len(m.UnpackedFloat)
+		protohelpers.HashUint64(h, s, 99)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedFloat)))
+		for _, v := range m.UnpackedFloat {
+			protohelpers.HashFloat32(h, s, v)
+		}
 	}
 	if len(m.UnpackedDouble) > 0 {
-		n += 10 * len(m.UnpackedDouble)
+		protohelpers.HashUint64(h, s, 100)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedDouble)))
+		for _, v := range m.UnpackedDouble {
+			protohelpers.HashFloat64(h, s, v)
+		}
 	}
 	if len(m.UnpackedBool) > 0 {
-		n += 3 * len(m.UnpackedBool)
-	}
-	if len(m.UnpackedNestedEnum) > 0 {
-		for _, e := range m.UnpackedNestedEnum {
-			n += 2 + sov(uint64(e))
+		protohelpers.HashUint64(h, s, 101)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedBool)))
+		for _, v := range m.UnpackedBool {
+			protohelpers.HashBool(h, s, v)
 		}
 	}
-	if vtmsg, ok := m.OneofField.(interface{ SizeVT() int }); ok {
-		n += vtmsg.SizeVT()
+	if len(m.UnpackedNestedEnum) > 0 {
+		protohelpers.HashUint64(h, s, 102)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedNestedEnum)))
+		for _, v := range m.UnpackedNestedEnum {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	switch c := m.OneofField.(type) {
+	case *TestAllTypesProto2_OneofUint32:
+		protohelpers.HashUint64(h, s, 111)
+		protohelpers.HashUint64(h, s, uint64(c.OneofUint32))
+	case *TestAllTypesProto2_OneofNestedMessage:
+		protohelpers.HashUint64(h, s, 112)
+		c.OneofNestedMessage.hashVT(h, s)
+		protohelpers.HashUint64(h, s, 0)
+	case *TestAllTypesProto2_OneofString:
+		protohelpers.HashUint64(h, s, 113)
+		protohelpers.HashString(h, s, c.OneofString)
+	case *TestAllTypesProto2_OneofBytes:
+		protohelpers.HashUint64(h, s, 114)
+		protohelpers.HashBytes(h, s, c.OneofBytes)
+	case *TestAllTypesProto2_OneofBool:
+		protohelpers.HashUint64(h, s, 115)
+		protohelpers.HashBool(h, s, c.OneofBool)
+	case *TestAllTypesProto2_OneofUint64:
+		protohelpers.HashUint64(h, s, 116)
+		protohelpers.HashUint64(h, s, uint64(c.OneofUint64))
+	case *TestAllTypesProto2_OneofFloat:
+		protohelpers.HashUint64(h, s, 117)
+		protohelpers.HashFloat32(h, s, c.OneofFloat)
+	case *TestAllTypesProto2_OneofDouble:
+		protohelpers.HashUint64(h, s, 118)
+		protohelpers.HashFloat64(h, s, c.OneofDouble)
+	case *TestAllTypesProto2_OneofEnum:
+		protohelpers.HashUint64(h, s, 119)
+		protohelpers.HashUint64(h, s, uint64(c.OneofEnum))
 	}
 	if m.Data != nil {
-		l = m.Data.SizeVT()
-		n += l + 4
+		protohelpers.HashUint64(h, s, 201)
+		m.Data.hashVT(h, s)
+		protohelpers.HashUint64(h, s, 0)
 	}
 	if m.DefaultInt32 != nil {
-		n += 2 + sov(uint64(*m.DefaultInt32))
+		protohelpers.HashUint64(h, s, 241)
+		protohelpers.HashUint64(h, s, uint64(*m.DefaultInt32))
 	}
 	if m.DefaultInt64 != nil {
-		n += 2 + sov(uint64(*m.DefaultInt64))
+		protohelpers.HashUint64(h, s, 242)
+		protohelpers.HashUint64(h, s, uint64(*m.DefaultInt64))
 	}
 	if m.DefaultUint32 != nil {
-		n += 2 + sov(uint64(*m.DefaultUint32))
+		protohelpers.HashUint64(h, s, 243)
+		protohelpers.HashUint64(h, s, uint64(*m.DefaultUint32))
 	}
 	if m.DefaultUint64 != nil {
-		n += 2 + sov(uint64(*m.DefaultUint64))
+		protohelpers.HashUint64(h, s, 244)
+		protohelpers.HashUint64(h, s, uint64(*m.DefaultUint64))
 	}
 	if m.DefaultSint32 != nil {
-		n += 2 + soz(uint64(*m.DefaultSint32))
+		protohelpers.HashUint64(h, s, 245)
+		protohelpers.HashUint64(h, s, uint64(*m.DefaultSint32))
 	}
 	if m.DefaultSint64 != nil {
-		n += 2 + soz(uint64(*m.DefaultSint64))
+		protohelpers.HashUint64(h, s, 246)
+		protohelpers.HashUint64(h, s, uint64(*m.DefaultSint64))
 	}
 	if m.DefaultFixed32 != nil {
-		n += 6
+		protohelpers.HashUint64(h, s, 247)
+		protohelpers.HashUint64(h, s, uint64(*m.DefaultFixed32))
 	}
 	if m.DefaultFixed64 != nil {
-		n += 10
+		protohelpers.HashUint64(h, s, 248)
+		protohelpers.HashUint64(h, s, uint64(*m.DefaultFixed64))
 	}
 	if m.DefaultSfixed32 != nil {
-		n += 6
+		protohelpers.HashUint64(h, s, 249)
+		protohelpers.HashUint64(h, s, uint64(*m.DefaultSfixed32))
 	}
 	if m.DefaultSfixed64 != nil {
-		n += 10
+		protohelpers.HashUint64(h, s, 250)
+		protohelpers.HashUint64(h, s, uint64(*m.DefaultSfixed64))
 	}
 	if m.DefaultFloat != nil {
-		n += 6
+		protohelpers.HashUint64(h, s, 251)
+		protohelpers.HashFloat32(h, s, *m.DefaultFloat)
 	}
 	if m.DefaultDouble != nil {
-		n += 10
+		protohelpers.HashUint64(h, s, 252)
+		protohelpers.HashFloat64(h, s, *m.DefaultDouble)
 	}
 	if m.DefaultBool != nil {
-		n += 3
+		protohelpers.HashUint64(h, s, 253)
+		protohelpers.HashBool(h, s, *m.DefaultBool)
 	}
 	if m.DefaultString != nil {
-		l = len(*m.DefaultString)
-		n += 2 + l + sov(uint64(l))
+		protohelpers.HashUint64(h, s, 254)
+		protohelpers.HashString(h, s, *m.DefaultString)
 	}
 	if m.DefaultBytes != nil {
-		l = len(m.DefaultBytes)
-		n += 2 + l + sov(uint64(l))
+		protohelpers.HashUint64(h, s, 255)
+		protohelpers.HashBytes(h, s, m.DefaultBytes)
 	}
 	if m.Fieldname1 != nil {
-		n += 2 + sov(uint64(*m.Fieldname1))
+		protohelpers.HashUint64(h, s, 401)
+		protohelpers.HashUint64(h, s, uint64(*m.Fieldname1))
 	}
 	if m.FieldName2 != nil {
-		n += 2 + sov(uint64(*m.FieldName2))
+		protohelpers.HashUint64(h, s, 402)
+		protohelpers.HashUint64(h, s, uint64(*m.FieldName2))
 	}
 	if m.XFieldName3 != nil {
-		n += 2 + sov(uint64(*m.XFieldName3))
+		protohelpers.HashUint64(h, s, 403)
+		protohelpers.HashUint64(h, s, uint64(*m.XFieldName3))
 	}
 	if m.Field_Name4_ != nil {
-		n += 2 + sov(uint64(*m.Field_Name4_))
+		protohelpers.HashUint64(h, s, 404)
+		protohelpers.HashUint64(h, s, uint64(*m.Field_Name4_))
 	}
 	if m.Field0Name5 != nil {
-		n += 2 + sov(uint64(*m.Field0Name5))
+		protohelpers.HashUint64(h, s, 405)
+		protohelpers.HashUint64(h, s, uint64(*m.Field0Name5))
 	}
 	if m.Field_0Name6 != nil {
-		n += 2 + sov(uint64(*m.Field_0Name6))
+		protohelpers.HashUint64(h, s, 406)
+		protohelpers.HashUint64(h, s, uint64(*m.Field_0Name6))
 	}
 	if m.FieldName7 != nil {
-		n += 2 + sov(uint64(*m.FieldName7))
+		protohelpers.HashUint64(h, s, 407)
+		protohelpers.HashUint64(h, s, uint64(*m.FieldName7))
 	}
 	if m.FieldName8 != nil {
-		n += 2 + sov(uint64(*m.FieldName8))
+		protohelpers.HashUint64(h, s, 408)
+		protohelpers.HashUint64(h, s, uint64(*m.FieldName8))
 	}
 	if m.Field_Name9 != nil {
-		n += 2 + sov(uint64(*m.Field_Name9))
+		protohelpers.HashUint64(h, s, 409)
+		protohelpers.HashUint64(h, s, uint64(*m.Field_Name9))
 	}
 	if m.Field_Name10 != nil {
-		n += 2 + sov(uint64(*m.Field_Name10))
+		protohelpers.HashUint64(h, s, 410)
+		protohelpers.HashUint64(h, s, uint64(*m.Field_Name10))
 	}
 	if m.FIELD_NAME11 != nil {
-		n += 2 + sov(uint64(*m.FIELD_NAME11))
+		protohelpers.HashUint64(h, s, 411)
+		protohelpers.HashUint64(h, s, uint64(*m.FIELD_NAME11))
 	}
 	if m.FIELDName12 != nil {
-		n += 2 + sov(uint64(*m.FIELDName12))
+		protohelpers.HashUint64(h, s, 412)
+		protohelpers.HashUint64(h, s, uint64(*m.FIELDName12))
 	}
 	if m.XFieldName13 != nil {
-		n += 2 + sov(uint64(*m.XFieldName13))
+		protohelpers.HashUint64(h, s, 413)
+		protohelpers.HashUint64(h, s, uint64(*m.XFieldName13))
 	}
 	if m.X_FieldName14 != nil {
-		n += 2 + sov(uint64(*m.X_FieldName14))
+		protohelpers.HashUint64(h, s, 414)
+		protohelpers.HashUint64(h, s, uint64(*m.X_FieldName14))
 	}
 	if m.Field_Name15 != nil {
-		n += 2 + sov(uint64(*m.Field_Name15))
+		protohelpers.HashUint64(h, s, 415)
+		protohelpers.HashUint64(h, s, uint64(*m.Field_Name15))
+	}
+	if m.Field__Name16 != nil {
+		protohelpers.HashUint64(h, s, 416)
+		protohelpers.HashUint64(h, s, uint64(*m.Field__Name16))
+	}
+	if m.FieldName17__ != nil {
+		protohelpers.HashUint64(h, s, 417)
+		protohelpers.HashUint64(h, s, uint64(*m.FieldName17__))
+	}
+	if m.FieldName18__ != nil {
+		protohelpers.HashUint64(h, s, 418)
+		protohelpers.HashUint64(h, s, uint64(*m.FieldName18__))
+	}
+	if len(m.extensionFields) > 0 {
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for num, x := range m.extensionFields {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(num))
+			switch num {
+			case 120:
+				v := proto.GetExtension(m, E_ExtensionInt32).(int32)
+				protohelpers.HashUint64(entry, s, uint64(v))
+			default:
+				protohelpers.HashExtension(entry, x.Type(), x.Value())
+			}
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *ForeignMessageProto2) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *ForeignMessageProto2) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	if m.C != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(*m.C))
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	if m.A != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(*m.A))
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *UnknownToTestAllTypes) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *UnknownToTestAllTypes) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	if m.OptionalInt32 != nil {
+		protohelpers.HashUint64(h, s, 1001)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalInt32))
+	}
+	if m.OptionalString != nil {
+		protohelpers.HashUint64(h, s, 1002)
+		protohelpers.HashString(h, s, *m.OptionalString)
+	}
+	if m.NestedMessage != nil {
+		protohelpers.HashUint64(h, s, 1003)
+		m.NestedMessage.hashVT(h, s)
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.Optionalgroup != nil {
+		protohelpers.HashUint64(h, s, 1004)
+		m.Optionalgroup.hashVT(h, s)
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.OptionalBool != nil {
+		protohelpers.HashUint64(h, s, 1006)
+		protohelpers.HashBool(h, s, *m.OptionalBool)
+	}
+	if len(m.RepeatedInt32) > 0 {
+		protohelpers.HashUint64(h, s, 1011)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedInt32)))
+		for _, v := range m.RepeatedInt32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *NullHypothesisProto2) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *NullHypothesisProto2) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *EnumOnlyProto2) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *EnumOnlyProto2) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *OneStringProto2) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *OneStringProto2) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	if m.Data != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashString(h, s, *m.Data)
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *TestAllTypesProto2_NestedMessage) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *TestAllTypesProto2_NestedMessage) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &TestAllTypesProto2_NestedMessage{}
+	}
+	w.ObjectStart()
+	if m.A != nil {
+		w.Field("a", "a")
+		w.Int32(*m.A)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("a", "a")
+		w.Null()
+	}
+	if m.Corecursive != nil {
+		w.Field("corecursive", "corecursive")
+		if err := m.Corecursive.MarshalJSONToVT(w); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("corecursive", "corecursive")
+		w.Null()
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *TestAllTypesProto2_NestedMessage) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *TestAllTypesProto2_NestedMessage) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [2]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "a":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.A = &v
+		case "corecursive":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(TestAllTypesProto2)
+			if err := v.UnmarshalJSONFromVT(r); err != nil {
+				return err
+			}
+			m.Corecursive = v
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *TestAllTypesProto2_Data) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *TestAllTypesProto2_Data) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &TestAllTypesProto2_Data{}
+	}
+	w.ObjectStart()
+	if m.GroupInt32 != nil {
+		w.Field("groupInt32", "group_int32")
+		w.Int32(*m.GroupInt32)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("groupInt32", "group_int32")
+		w.Null()
+	}
+	if m.GroupUint32 != nil {
+		w.Field("groupUint32", "group_uint32")
+		w.Uint32(*m.GroupUint32)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("groupUint32", "group_uint32")
+		w.Null()
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *TestAllTypesProto2_Data) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *TestAllTypesProto2_Data) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [2]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "groupInt32", "group_int32":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.GroupInt32 = &v
+		case "groupUint32", "group_uint32":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint32()
+			if err != nil {
+				return err
+			}
+			m.GroupUint32 = &v
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &TestAllTypesProto2_MessageSetCorrect{}
+	}
+	w.ObjectStart()
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &TestAllTypesProto2_MessageSetCorrectExtension1{}
+	}
+	w.ObjectStart()
+	if m.Str != nil {
+		w.Field("str", "str")
+		if err := w.String(*m.Str); err != nil {
+			return errors.New("proto: field protobuf_test_messages.proto2.TestAllTypesProto2.MessageSetCorrectExtension1.str contains invalid UTF-8")
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("str", "str")
+		w.Null()
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [1]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "str":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.Str = &v
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &TestAllTypesProto2_MessageSetCorrectExtension2{}
+	}
+	w.ObjectStart()
+	if m.I != nil {
+		w.Field("i", "i")
+		w.Int32(*m.I)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("i", "i")
+		w.Null()
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [1]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "i":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.I = &v
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *TestAllTypesProto2) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *TestAllTypesProto2) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &TestAllTypesProto2{}
+	}
+	w.ObjectStart()
+	if m.OptionalInt32 != nil {
+		w.Field("optionalInt32", "optional_int32")
+		w.Int32(*m.OptionalInt32)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalInt32", "optional_int32")
+		w.Null()
+	}
+	if m.OptionalInt64 != nil {
+		w.Field("optionalInt64", "optional_int64")
+		w.Int64(*m.OptionalInt64)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalInt64", "optional_int64")
+		w.Null()
+	}
+	if m.OptionalUint32 != nil {
+		w.Field("optionalUint32", "optional_uint32")
+		w.Uint32(*m.OptionalUint32)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalUint32", "optional_uint32")
+		w.Null()
+	}
+	if m.OptionalUint64 != nil {
+		w.Field("optionalUint64", "optional_uint64")
+		w.Uint64(*m.OptionalUint64)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalUint64", "optional_uint64")
+		w.Null()
+	}
+	if m.OptionalSint32 != nil {
+		w.Field("optionalSint32", "optional_sint32")
+		w.Int32(*m.OptionalSint32)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalSint32", "optional_sint32")
+		w.Null()
+	}
+	if m.OptionalSint64 != nil {
+		w.Field("optionalSint64", "optional_sint64")
+		w.Int64(*m.OptionalSint64)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalSint64", "optional_sint64")
+		w.Null()
+	}
+	if m.OptionalFixed32 != nil {
+		w.Field("optionalFixed32", "optional_fixed32")
+		w.Uint32(*m.OptionalFixed32)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalFixed32", "optional_fixed32")
+		w.Null()
+	}
+	if m.OptionalFixed64 != nil {
+		w.Field("optionalFixed64", "optional_fixed64")
+		w.Uint64(*m.OptionalFixed64)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalFixed64", "optional_fixed64")
+		w.Null()
+	}
+	if m.OptionalSfixed32 != nil {
+		w.Field("optionalSfixed32", "optional_sfixed32")
+		w.Int32(*m.OptionalSfixed32)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalSfixed32", "optional_sfixed32")
+		w.Null()
+	}
+	if m.OptionalSfixed64 != nil {
+		w.Field("optionalSfixed64", "optional_sfixed64")
+		w.Int64(*m.OptionalSfixed64)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalSfixed64", "optional_sfixed64")
+		w.Null()
+	}
+	if m.OptionalFloat != nil {
+		w.Field("optionalFloat", "optional_float")
+		w.Float32(*m.OptionalFloat)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalFloat", "optional_float")
+		w.Null()
+	}
+	if m.OptionalDouble != nil {
+		w.Field("optionalDouble", "optional_double")
+		w.Float64(*m.OptionalDouble)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalDouble", "optional_double")
+		w.Null()
+	}
+	if m.OptionalBool != nil {
+		w.Field("optionalBool", "optional_bool")
+		w.Bool(*m.OptionalBool)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalBool", "optional_bool")
+		w.Null()
+	}
+	if m.OptionalString != nil {
+		w.Field("optionalString", "optional_string")
+		if err := w.String(*m.OptionalString); err != nil {
+			return errors.New("proto: field protobuf_test_messages.proto2.TestAllTypesProto2.optional_string contains invalid UTF-8")
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalString", "optional_string")
+		w.Null()
+	}
+	if m.OptionalBytes != nil {
+		w.Field("optionalBytes", "optional_bytes")
+		w.Base64(m.OptionalBytes)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalBytes", "optional_bytes")
+		w.Null()
+	}
+	if m.OptionalNestedMessage != nil {
+		w.Field("optionalNestedMessage", "optional_nested_message")
+		if err := m.OptionalNestedMessage.MarshalJSONToVT(w); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalNestedMessage", "optional_nested_message")
+		w.Null()
+	}
+	if m.OptionalForeignMessage != nil {
+		w.Field("optionalForeignMessage", "optional_foreign_message")
+		if err := m.OptionalForeignMessage.MarshalJSONToVT(w); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalForeignMessage", "optional_foreign_message")
+		w.Null()
+	}
+	if m.OptionalNestedEnum != nil {
+		w.Field("optionalNestedEnum", "optional_nested_enum")
+		w.Enum(int32(*m.OptionalNestedEnum), TestAllTypesProto2_NestedEnum_name)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalNestedEnum", "optional_nested_enum")
+		w.Null()
+	}
+	if m.OptionalForeignEnum != nil {
+		w.Field("optionalForeignEnum", "optional_foreign_enum")
+		w.Enum(int32(*m.OptionalForeignEnum), ForeignEnumProto2_name)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalForeignEnum", "optional_foreign_enum")
+		w.Null()
+	}
+	if m.OptionalStringPiece != nil {
+		w.Field("optionalStringPiece", "optional_string_piece")
+		if err := w.String(*m.OptionalStringPiece); err != nil {
+			return errors.New("proto: field protobuf_test_messages.proto2.TestAllTypesProto2.optional_string_piece contains invalid UTF-8")
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalStringPiece", "optional_string_piece")
+		w.Null()
+	}
+	if m.OptionalCord != nil {
+		w.Field("optionalCord", "optional_cord")
+		if err := w.String(*m.OptionalCord); err != nil {
+			return errors.New("proto: field protobuf_test_messages.proto2.TestAllTypesProto2.optional_cord contains invalid UTF-8")
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalCord", "optional_cord")
+		w.Null()
+	}
+	if m.RecursiveMessage != nil {
+		w.Field("recursiveMessage", "recursive_message")
+		if err := m.RecursiveMessage.MarshalJSONToVT(w); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("recursiveMessage", "recursive_message")
+		w.Null()
+	}
+	if len(m.RepeatedInt32) > 0 {
+		w.Field("repeatedInt32", "repeated_int32")
+		w.ArrayStart()
+		for _, v := range m.RepeatedInt32 {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedInt32", "repeated_int32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedInt64) > 0 {
+		w.Field("repeatedInt64", "repeated_int64")
+		w.ArrayStart()
+		for _, v := range m.RepeatedInt64 {
+			w.Int64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedInt64", "repeated_int64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedUint32) > 0 {
+		w.Field("repeatedUint32", "repeated_uint32")
+		w.ArrayStart()
+		for _, v := range m.RepeatedUint32 {
+			w.Uint32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedUint32", "repeated_uint32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedUint64) > 0 {
+		w.Field("repeatedUint64", "repeated_uint64")
+		w.ArrayStart()
+		for _, v := range m.RepeatedUint64 {
+			w.Uint64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedUint64", "repeated_uint64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedSint32) > 0 {
+		w.Field("repeatedSint32", "repeated_sint32")
+		w.ArrayStart()
+		for _, v := range m.RepeatedSint32 {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedSint32", "repeated_sint32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedSint64) > 0 {
+		w.Field("repeatedSint64", "repeated_sint64")
+		w.ArrayStart()
+		for _, v := range m.RepeatedSint64 {
+			w.Int64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedSint64", "repeated_sint64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedFixed32) > 0 {
+		w.Field("repeatedFixed32", "repeated_fixed32")
+		w.ArrayStart()
+		for _, v := range m.RepeatedFixed32 {
+			w.Uint32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedFixed32", "repeated_fixed32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedFixed64) > 0 {
+		w.Field("repeatedFixed64", "repeated_fixed64")
+		w.ArrayStart()
+		for _, v := range m.RepeatedFixed64 {
+			w.Uint64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedFixed64", "repeated_fixed64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedSfixed32) > 0 {
+		w.Field("repeatedSfixed32", "repeated_sfixed32")
+		w.ArrayStart()
+		for _, v := range m.RepeatedSfixed32 {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedSfixed32", "repeated_sfixed32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedSfixed64) > 0 {
+		w.Field("repeatedSfixed64", "repeated_sfixed64")
+		w.ArrayStart()
+		for _, v := range m.RepeatedSfixed64 {
+			w.Int64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedSfixed64", "repeated_sfixed64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedFloat) > 0 {
+		w.Field("repeatedFloat", "repeated_float")
+		w.ArrayStart()
+		for _, v := range m.RepeatedFloat {
+			w.Float32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedFloat", "repeated_float")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedDouble) > 0 {
+		w.Field("repeatedDouble", "repeated_double")
+		w.ArrayStart()
+		for _, v := range m.RepeatedDouble {
+			w.Float64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedDouble", "repeated_double")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedBool) > 0 {
+		w.Field("repeatedBool", "repeated_bool")
+		w.ArrayStart()
+		for _, v := range m.RepeatedBool {
+			w.Bool(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedBool", "repeated_bool")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedString) > 0 {
+		w.Field("repeatedString", "repeated_string")
+		w.ArrayStart()
+		for _, v := range m.RepeatedString {
+			if err := w.String(v); err != nil {
+				return errors.New("proto: field protobuf_test_messages.proto2.TestAllTypesProto2.repeated_string contains invalid UTF-8")
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedString", "repeated_string")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedBytes) > 0 {
+		w.Field("repeatedBytes", "repeated_bytes")
+		w.ArrayStart()
+		for _, v := range m.RepeatedBytes {
+			w.Base64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedBytes", "repeated_bytes")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedNestedMessage) > 0 {
+		w.Field("repeatedNestedMessage", "repeated_nested_message")
+		w.ArrayStart()
+		for _, v := range m.RepeatedNestedMessage {
+			if err := v.MarshalJSONToVT(w); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedNestedMessage", "repeated_nested_message")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedForeignMessage) > 0 {
+		w.Field("repeatedForeignMessage", "repeated_foreign_message")
+		w.ArrayStart()
+		for _, v := range m.RepeatedForeignMessage {
+			if err := v.MarshalJSONToVT(w); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedForeignMessage", "repeated_foreign_message")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedNestedEnum) > 0 {
+		w.Field("repeatedNestedEnum", "repeated_nested_enum")
+		w.ArrayStart()
+		for _, v := range m.RepeatedNestedEnum {
+			w.Enum(int32(v), TestAllTypesProto2_NestedEnum_name)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedNestedEnum", "repeated_nested_enum")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedForeignEnum) > 0 {
+		w.Field("repeatedForeignEnum", "repeated_foreign_enum")
+		w.ArrayStart()
+		for _, v := range m.RepeatedForeignEnum {
+			w.Enum(int32(v), ForeignEnumProto2_name)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedForeignEnum", "repeated_foreign_enum")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedStringPiece) > 0 {
+		w.Field("repeatedStringPiece", "repeated_string_piece")
+		w.ArrayStart()
+		for _, v := range m.RepeatedStringPiece {
+			if err := w.String(v); err != nil {
+				return errors.New("proto: field protobuf_test_messages.proto2.TestAllTypesProto2.repeated_string_piece contains invalid UTF-8")
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedStringPiece", "repeated_string_piece")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedCord) > 0 {
+		w.Field("repeatedCord", "repeated_cord")
+		w.ArrayStart()
+		for _, v := range m.RepeatedCord {
+			if err := w.String(v); err != nil {
+				return errors.New("proto: field protobuf_test_messages.proto2.TestAllTypesProto2.repeated_cord contains invalid UTF-8")
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedCord", "repeated_cord")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedInt32) > 0 {
+		w.Field("packedInt32", "packed_int32")
+		w.ArrayStart()
+		for _, v := range m.PackedInt32 {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedInt32", "packed_int32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedInt64) > 0 {
+		w.Field("packedInt64", "packed_int64")
+		w.ArrayStart()
+		for _, v := range m.PackedInt64 {
+			w.Int64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedInt64", "packed_int64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedUint32) > 0 {
+		w.Field("packedUint32", "packed_uint32")
+		w.ArrayStart()
+		for _, v := range m.PackedUint32 {
+			w.Uint32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedUint32", "packed_uint32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedUint64) > 0 {
+		w.Field("packedUint64", "packed_uint64")
+		w.ArrayStart()
+		for _, v := range m.PackedUint64 {
+			w.Uint64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedUint64", "packed_uint64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedSint32) > 0 {
+		w.Field("packedSint32", "packed_sint32")
+		w.ArrayStart()
+		for _, v := range m.PackedSint32 {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedSint32", "packed_sint32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedSint64) > 0 {
+		w.Field("packedSint64", "packed_sint64")
+		w.ArrayStart()
+		for _, v := range m.PackedSint64 {
+			w.Int64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedSint64", "packed_sint64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedFixed32) > 0 {
+		w.Field("packedFixed32", "packed_fixed32")
+		w.ArrayStart()
+		for _, v := range m.PackedFixed32 {
+			w.Uint32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedFixed32", "packed_fixed32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedFixed64) > 0 {
+		w.Field("packedFixed64", "packed_fixed64")
+		w.ArrayStart()
+		for _, v := range m.PackedFixed64 {
+			w.Uint64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedFixed64", "packed_fixed64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedSfixed32) > 0 {
+		w.Field("packedSfixed32", "packed_sfixed32")
+		w.ArrayStart()
+		for _, v := range m.PackedSfixed32 {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedSfixed32", "packed_sfixed32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedSfixed64) > 0 {
+		w.Field("packedSfixed64", "packed_sfixed64")
+		w.ArrayStart()
+		for _, v := range m.PackedSfixed64 {
+			w.Int64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedSfixed64", "packed_sfixed64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedFloat) > 0 {
+		w.Field("packedFloat", "packed_float")
+		w.ArrayStart()
+		for _, v := range m.PackedFloat {
+			w.Float32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedFloat", "packed_float")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedDouble) > 0 {
+		w.Field("packedDouble", "packed_double")
+		w.ArrayStart()
+		for _, v := range m.PackedDouble {
+			w.Float64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedDouble", "packed_double")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedBool) > 0 {
+		w.Field("packedBool", "packed_bool")
+		w.ArrayStart()
+		for _, v := range m.PackedBool {
+			w.Bool(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedBool", "packed_bool")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedNestedEnum) > 0 {
+		w.Field("packedNestedEnum", "packed_nested_enum")
+		w.ArrayStart()
+		for _, v := range m.PackedNestedEnum {
+			w.Enum(int32(v), TestAllTypesProto2_NestedEnum_name)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedNestedEnum", "packed_nested_enum")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedInt32) > 0 {
+		w.Field("unpackedInt32", "unpacked_int32")
+		w.ArrayStart()
+		for _, v := range m.UnpackedInt32 {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedInt32", "unpacked_int32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedInt64) > 0 {
+		w.Field("unpackedInt64", "unpacked_int64")
+		w.ArrayStart()
+		for _, v := range m.UnpackedInt64 {
+			w.Int64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedInt64", "unpacked_int64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedUint32) > 0 {
+		w.Field("unpackedUint32", "unpacked_uint32")
+		w.ArrayStart()
+		for _, v := range m.UnpackedUint32 {
+			w.Uint32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedUint32", "unpacked_uint32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedUint64) > 0 {
+		w.Field("unpackedUint64", "unpacked_uint64")
+		w.ArrayStart()
+		for _, v := range m.UnpackedUint64 {
+			w.Uint64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedUint64", "unpacked_uint64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedSint32) > 0 {
+		w.Field("unpackedSint32", "unpacked_sint32")
+		w.ArrayStart()
+		for _, v := range m.UnpackedSint32 {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedSint32", "unpacked_sint32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedSint64) > 0 {
+		w.Field("unpackedSint64", "unpacked_sint64")
+		w.ArrayStart()
+		for _, v := range m.UnpackedSint64 {
+			w.Int64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedSint64", "unpacked_sint64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedFixed32) > 0 {
+		w.Field("unpackedFixed32", "unpacked_fixed32")
+		w.ArrayStart()
+		for _, v := range m.UnpackedFixed32 {
+			w.Uint32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedFixed32", "unpacked_fixed32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedFixed64) > 0 {
+		w.Field("unpackedFixed64", "unpacked_fixed64")
+		w.ArrayStart()
+		for _, v := range m.UnpackedFixed64 {
+			w.Uint64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedFixed64", "unpacked_fixed64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedSfixed32) > 0 {
+		w.Field("unpackedSfixed32", "unpacked_sfixed32")
+		w.ArrayStart()
+		for _, v := range m.UnpackedSfixed32 {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedSfixed32", "unpacked_sfixed32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedSfixed64) > 0 {
+		w.Field("unpackedSfixed64", "unpacked_sfixed64")
+		w.ArrayStart()
+		for _, v := range m.UnpackedSfixed64 {
+			w.Int64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedSfixed64", "unpacked_sfixed64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedFloat) > 0 {
+		w.Field("unpackedFloat", "unpacked_float")
+		w.ArrayStart()
+		for _, v := range m.UnpackedFloat {
+			w.Float32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedFloat", "unpacked_float")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedDouble) > 0 {
+		w.Field("unpackedDouble", "unpacked_double")
+		w.ArrayStart()
+		for _, v := range m.UnpackedDouble {
+			w.Float64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedDouble", "unpacked_double")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedBool) > 0 {
+		w.Field("unpackedBool", "unpacked_bool")
+		w.ArrayStart()
+		for _, v := range m.UnpackedBool {
+			w.Bool(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedBool", "unpacked_bool")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedNestedEnum) > 0 {
+		w.Field("unpackedNestedEnum", "unpacked_nested_enum")
+		w.ArrayStart()
+		for _, v := range m.UnpackedNestedEnum {
+			w.Enum(int32(v), TestAllTypesProto2_NestedEnum_name)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedNestedEnum", "unpacked_nested_enum")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.MapInt32Int32) > 0 {
+		w.Field("mapInt32Int32", "map_int32_int32")
+		keys := make([]int32, 0, len(m.MapInt32Int32))
+		for k := range m.MapInt32Int32 {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			w.Int32(m.MapInt32Int32[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapInt32Int32", "map_int32_int32")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapInt64Int64) > 0 {
+		w.Field("mapInt64Int64", "map_int64_int64")
+		keys := make([]int64, 0, len(m.MapInt64Int64))
+		for k := range m.MapInt64Int64 {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			w.Int64(m.MapInt64Int64[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapInt64Int64", "map_int64_int64")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapUint32Uint32) > 0 {
+		w.Field("mapUint32Uint32", "map_uint32_uint32")
+		keys := make([]uint32, 0, len(m.MapUint32Uint32))
+		for k := range m.MapUint32Uint32 {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatUint(uint64(k), 10))
+			w.Uint32(m.MapUint32Uint32[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapUint32Uint32", "map_uint32_uint32")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapUint64Uint64) > 0 {
+		w.Field("mapUint64Uint64", "map_uint64_uint64")
+		keys := make([]uint64, 0, len(m.MapUint64Uint64))
+		for k := range m.MapUint64Uint64 {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatUint(uint64(k), 10))
+			w.Uint64(m.MapUint64Uint64[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapUint64Uint64", "map_uint64_uint64")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapSint32Sint32) > 0 {
+		w.Field("mapSint32Sint32", "map_sint32_sint32")
+		keys := make([]int32, 0, len(m.MapSint32Sint32))
+		for k := range m.MapSint32Sint32 {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			w.Int32(m.MapSint32Sint32[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapSint32Sint32", "map_sint32_sint32")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapSint64Sint64) > 0 {
+		w.Field("mapSint64Sint64", "map_sint64_sint64")
+		keys := make([]int64, 0, len(m.MapSint64Sint64))
+		for k := range m.MapSint64Sint64 {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			w.Int64(m.MapSint64Sint64[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapSint64Sint64", "map_sint64_sint64")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapFixed32Fixed32) > 0 {
+		w.Field("mapFixed32Fixed32", "map_fixed32_fixed32")
+		keys := make([]uint32, 0, len(m.MapFixed32Fixed32))
+		for k := range m.MapFixed32Fixed32 {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatUint(uint64(k), 10))
+			w.Uint32(m.MapFixed32Fixed32[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapFixed32Fixed32", "map_fixed32_fixed32")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapFixed64Fixed64) > 0 {
+		w.Field("mapFixed64Fixed64", "map_fixed64_fixed64")
+		keys := make([]uint64, 0, len(m.MapFixed64Fixed64))
+		for k := range m.MapFixed64Fixed64 {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatUint(uint64(k), 10))
+			w.Uint64(m.MapFixed64Fixed64[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapFixed64Fixed64", "map_fixed64_fixed64")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapSfixed32Sfixed32) > 0 {
+		w.Field("mapSfixed32Sfixed32", "map_sfixed32_sfixed32")
+		keys := make([]int32, 0, len(m.MapSfixed32Sfixed32))
+		for k := range m.MapSfixed32Sfixed32 {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			w.Int32(m.MapSfixed32Sfixed32[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapSfixed32Sfixed32", "map_sfixed32_sfixed32")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapSfixed64Sfixed64) > 0 {
+		w.Field("mapSfixed64Sfixed64", "map_sfixed64_sfixed64")
+		keys := make([]int64, 0, len(m.MapSfixed64Sfixed64))
+		for k := range m.MapSfixed64Sfixed64 {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			w.Int64(m.MapSfixed64Sfixed64[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapSfixed64Sfixed64", "map_sfixed64_sfixed64")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapInt32Float) > 0 {
+		w.Field("mapInt32Float", "map_int32_float")
+		keys := make([]int32, 0, len(m.MapInt32Float))
+		for k := range m.MapInt32Float {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			w.Float32(m.MapInt32Float[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapInt32Float", "map_int32_float")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapInt32Double) > 0 {
+		w.Field("mapInt32Double", "map_int32_double")
+		keys := make([]int32, 0, len(m.MapInt32Double))
+		for k := range m.MapInt32Double {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			w.Float64(m.MapInt32Double[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapInt32Double", "map_int32_double")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapBoolBool) > 0 {
+		w.Field("mapBoolBool", "map_bool_bool")
+		keys := make([]bool, 0, len(m.MapBoolBool))
+		for k := range m.MapBoolBool {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return !keys[i] && keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatBool(k))
+			w.Bool(m.MapBoolBool[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapBoolBool", "map_bool_bool")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapStringString) > 0 {
+		w.Field("mapStringString", "map_string_string")
+		keys := make([]string, 0, len(m.MapStringString))
+		for k := range m.MapStringString {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		w.ObjectStart()
+		for _, k := range keys {
+			if err := w.Name(k); err != nil {
+				return errors.New("proto: field protobuf_test_messages.proto2.TestAllTypesProto2.MapStringStringEntry.key contains invalid UTF-8")
+			}
+			if err := w.String(m.MapStringString[k]); err != nil {
+				return errors.New("proto: field protobuf_test_messages.proto2.TestAllTypesProto2.MapStringStringEntry.value contains invalid UTF-8")
+			}
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapStringString", "map_string_string")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapStringBytes) > 0 {
+		w.Field("mapStringBytes", "map_string_bytes")
+		keys := make([]string, 0, len(m.MapStringBytes))
+		for k := range m.MapStringBytes {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		w.ObjectStart()
+		for _, k := range keys {
+			if err := w.Name(k); err != nil {
+				return errors.New("proto: field protobuf_test_messages.proto2.TestAllTypesProto2.MapStringBytesEntry.key contains invalid UTF-8")
+			}
+			w.Base64(m.MapStringBytes[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapStringBytes", "map_string_bytes")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapStringNestedMessage) > 0 {
+		w.Field("mapStringNestedMessage", "map_string_nested_message")
+		keys := make([]string, 0, len(m.MapStringNestedMessage))
+		for k := range m.MapStringNestedMessage {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		w.ObjectStart()
+		for _, k := range keys {
+			if err := w.Name(k); err != nil {
+				return errors.New("proto: field protobuf_test_messages.proto2.TestAllTypesProto2.MapStringNestedMessageEntry.key contains invalid UTF-8")
+			}
+			if err := m.MapStringNestedMessage[k].MarshalJSONToVT(w); err != nil {
+				return err
+			}
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapStringNestedMessage", "map_string_nested_message")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapStringForeignMessage) > 0 {
+		w.Field("mapStringForeignMessage", "map_string_foreign_message")
+		keys := make([]string, 0, len(m.MapStringForeignMessage))
+		for k := range m.MapStringForeignMessage {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		w.ObjectStart()
+		for _, k := range keys {
+			if err := w.Name(k); err != nil {
+				return errors.New("proto: field protobuf_test_messages.proto2.TestAllTypesProto2.MapStringForeignMessageEntry.key contains invalid UTF-8")
+			}
+			if err := m.MapStringForeignMessage[k].MarshalJSONToVT(w); err != nil {
+				return err
+			}
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapStringForeignMessage", "map_string_foreign_message")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapStringNestedEnum) > 0 {
+		w.Field("mapStringNestedEnum", "map_string_nested_enum")
+		keys := make([]string, 0, len(m.MapStringNestedEnum))
+		for k := range m.MapStringNestedEnum {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		w.ObjectStart()
+		for _, k := range keys {
+			if err := w.Name(k); err != nil {
+				return errors.New("proto: field protobuf_test_messages.proto2.TestAllTypesProto2.MapStringNestedEnumEntry.key contains invalid UTF-8")
+			}
+			w.Enum(int32(m.MapStringNestedEnum[k]), TestAllTypesProto2_NestedEnum_name)
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapStringNestedEnum", "map_string_nested_enum")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapStringForeignEnum) > 0 {
+		w.Field("mapStringForeignEnum", "map_string_foreign_enum")
+		keys := make([]string, 0, len(m.MapStringForeignEnum))
+		for k := range m.MapStringForeignEnum {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		w.ObjectStart()
+		for _, k := range keys {
+			if err := w.Name(k); err != nil {
+				return errors.New("proto: field protobuf_test_messages.proto2.TestAllTypesProto2.MapStringForeignEnumEntry.key contains invalid UTF-8")
+			}
+			w.Enum(int32(m.MapStringForeignEnum[k]), ForeignEnumProto2_name)
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapStringForeignEnum", "map_string_foreign_enum")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if c, ok := m.OneofField.(*TestAllTypesProto2_OneofUint32); ok {
+		w.Field("oneofUint32", "oneof_uint32")
+		w.Uint32(c.OneofUint32)
+	}
+	if c, ok := m.OneofField.(*TestAllTypesProto2_OneofNestedMessage); ok {
+		w.Field("oneofNestedMessage", "oneof_nested_message")
+		if err := c.OneofNestedMessage.MarshalJSONToVT(w); err != nil {
+			return err
+		}
+	}
+	if c, ok := m.OneofField.(*TestAllTypesProto2_OneofString); ok {
+		w.Field("oneofString", "oneof_string")
+		if err := w.String(c.OneofString); err != nil {
+			return errors.New("proto: field protobuf_test_messages.proto2.TestAllTypesProto2.oneof_string contains invalid UTF-8")
+		}
+	}
+	if c, ok := m.OneofField.(*TestAllTypesProto2_OneofBytes); ok {
+		w.Field("oneofBytes", "oneof_bytes")
+		w.Base64(c.OneofBytes)
+	}
+	if c, ok := m.OneofField.(*TestAllTypesProto2_OneofBool); ok {
+		w.Field("oneofBool", "oneof_bool")
+		w.Bool(c.OneofBool)
+	}
+	if c, ok := m.OneofField.(*TestAllTypesProto2_OneofUint64); ok {
+		w.Field("oneofUint64", "oneof_uint64")
+		w.Uint64(c.OneofUint64)
+	}
+	if c, ok := m.OneofField.(*TestAllTypesProto2_OneofFloat); ok {
+		w.Field("oneofFloat", "oneof_float")
+		w.Float32(c.OneofFloat)
+	}
+	if c, ok := m.OneofField.(*TestAllTypesProto2_OneofDouble); ok {
+		w.Field("oneofDouble", "oneof_double")
+		w.Float64(c.OneofDouble)
+	}
+	if c, ok := m.OneofField.(*TestAllTypesProto2_OneofEnum); ok {
+		w.Field("oneofEnum", "oneof_enum")
+		w.Enum(int32(c.OneofEnum), TestAllTypesProto2_NestedEnum_name)
+	}
+	if m.Data != nil {
+		w.Field("data", "Data")
+		if err := m.Data.MarshalJSONToVT(w); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("data", "Data")
+		w.Null()
+	}
+	if m.DefaultInt32 != nil {
+		w.Field("defaultInt32", "default_int32")
+		w.Int32(*m.DefaultInt32)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("defaultInt32", "default_int32")
+		w.Null()
+	}
+	if m.DefaultInt64 != nil {
+		w.Field("defaultInt64", "default_int64")
+		w.Int64(*m.DefaultInt64)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("defaultInt64", "default_int64")
+		w.Null()
+	}
+	if m.DefaultUint32 != nil {
+		w.Field("defaultUint32", "default_uint32")
+		w.Uint32(*m.DefaultUint32)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("defaultUint32", "default_uint32")
+		w.Null()
+	}
+	if m.DefaultUint64 != nil {
+		w.Field("defaultUint64", "default_uint64")
+		w.Uint64(*m.DefaultUint64)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("defaultUint64", "default_uint64")
+		w.Null()
+	}
+	if m.DefaultSint32 != nil {
+		w.Field("defaultSint32", "default_sint32")
+		w.Int32(*m.DefaultSint32)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("defaultSint32", "default_sint32")
+		w.Null()
+	}
+	if m.DefaultSint64 != nil {
+		w.Field("defaultSint64", "default_sint64")
+		w.Int64(*m.DefaultSint64)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("defaultSint64", "default_sint64")
+		w.Null()
+	}
+	if m.DefaultFixed32 != nil {
+		w.Field("defaultFixed32", "default_fixed32")
+		w.Uint32(*m.DefaultFixed32)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("defaultFixed32", "default_fixed32")
+		w.Null()
+	}
+	if m.DefaultFixed64 != nil {
+		w.Field("defaultFixed64", "default_fixed64")
+		w.Uint64(*m.DefaultFixed64)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("defaultFixed64", "default_fixed64")
+		w.Null()
+	}
+	if m.DefaultSfixed32 != nil {
+		w.Field("defaultSfixed32", "default_sfixed32")
+		w.Int32(*m.DefaultSfixed32)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("defaultSfixed32", "default_sfixed32")
+		w.Null()
+	}
+	if m.DefaultSfixed64 != nil {
+		w.Field("defaultSfixed64", "default_sfixed64")
+		w.Int64(*m.DefaultSfixed64)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("defaultSfixed64", "default_sfixed64")
+		w.Null()
+	}
+	if m.DefaultFloat != nil {
+		w.Field("defaultFloat", "default_float")
+		w.Float32(*m.DefaultFloat)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("defaultFloat", "default_float")
+		w.Null()
+	}
+	if m.DefaultDouble != nil {
+		w.Field("defaultDouble", "default_double")
+		w.Float64(*m.DefaultDouble)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("defaultDouble", "default_double")
+		w.Null()
+	}
+	if m.DefaultBool != nil {
+		w.Field("defaultBool", "default_bool")
+		w.Bool(*m.DefaultBool)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("defaultBool", "default_bool")
+		w.Null()
+	}
+	if m.DefaultString != nil {
+		w.Field("defaultString", "default_string")
+		if err := w.String(*m.DefaultString); err != nil {
+			return errors.New("proto: field protobuf_test_messages.proto2.TestAllTypesProto2.default_string contains invalid UTF-8")
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("defaultString", "default_string")
+		w.Null()
+	}
+	if m.DefaultBytes != nil {
+		w.Field("defaultBytes", "default_bytes")
+		w.Base64(m.DefaultBytes)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("defaultBytes", "default_bytes")
+		w.Null()
+	}
+	if m.Fieldname1 != nil {
+		w.Field("fieldname1", "fieldname1")
+		w.Int32(*m.Fieldname1)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("fieldname1", "fieldname1")
+		w.Null()
+	}
+	if m.FieldName2 != nil {
+		w.Field("fieldName2", "field_name2")
+		w.Int32(*m.FieldName2)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("fieldName2", "field_name2")
+		w.Null()
+	}
+	if m.XFieldName3 != nil {
+		w.Field("FieldName3", "_field_name3")
+		w.Int32(*m.XFieldName3)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("FieldName3", "_field_name3")
+		w.Null()
+	}
+	if m.Field_Name4_ != nil {
+		w.Field("fieldName4", "field__name4_")
+		w.Int32(*m.Field_Name4_)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("fieldName4", "field__name4_")
+		w.Null()
+	}
+	if m.Field0Name5 != nil {
+		w.Field("field0name5", "field0name5")
+		w.Int32(*m.Field0Name5)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("field0name5", "field0name5")
+		w.Null()
+	}
+	if m.Field_0Name6 != nil {
+		w.Field("field0Name6", "field_0_name6")
+		w.Int32(*m.Field_0Name6)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("field0Name6", "field_0_name6")
+		w.Null()
+	}
+	if m.FieldName7 != nil {
+		w.Field("fieldName7", "fieldName7")
+		w.Int32(*m.FieldName7)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("fieldName7", "fieldName7")
+		w.Null()
+	}
+	if m.FieldName8 != nil {
+		w.Field("FieldName8", "FieldName8")
+		w.Int32(*m.FieldName8)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("FieldName8", "FieldName8")
+		w.Null()
+	}
+	if m.Field_Name9 != nil {
+		w.Field("fieldName9", "field_Name9")
+		w.Int32(*m.Field_Name9)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("fieldName9", "field_Name9")
+		w.Null()
+	}
+	if m.Field_Name10 != nil {
+		w.Field("FieldName10", "Field_Name10")
+		w.Int32(*m.Field_Name10)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("FieldName10", "Field_Name10")
+		w.Null()
+	}
+	if m.FIELD_NAME11 != nil {
+		w.Field("FIELDNAME11", "FIELD_NAME11")
+		w.Int32(*m.FIELD_NAME11)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("FIELDNAME11", "FIELD_NAME11")
+		w.Null()
+	}
+	if m.FIELDName12 != nil {
+		w.Field("FIELDName12", "FIELD_name12")
+		w.Int32(*m.FIELDName12)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("FIELDName12", "FIELD_name12")
+		w.Null()
+	}
+	if m.XFieldName13 != nil {
+		w.Field("FieldName13", "__field_name13")
+		w.Int32(*m.XFieldName13)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("FieldName13", "__field_name13")
+		w.Null()
+	}
+	if m.X_FieldName14 != nil {
+		w.Field("FieldName14", "__Field_name14")
+		w.Int32(*m.X_FieldName14)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("FieldName14", "__Field_name14")
+		w.Null()
+	}
+	if m.Field_Name15 != nil {
+		w.Field("fieldName15", "field__name15")
+		w.Int32(*m.Field_Name15)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("fieldName15", "field__name15")
+		w.Null()
+	}
+	if m.Field__Name16 != nil {
+		w.Field("fieldName16", "field__Name16")
+		w.Int32(*m.Field__Name16)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("fieldName16", "field__Name16")
+		w.Null()
+	}
+	if m.FieldName17__ != nil {
+		w.Field("fieldName17", "field_name17__")
+		w.Int32(*m.FieldName17__)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("fieldName17", "field_name17__")
+		w.Null()
+	}
+	if m.FieldName18__ != nil {
+		w.Field("FieldName18", "Field_name18__")
+		w.Int32(*m.FieldName18__)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("FieldName18", "Field_name18__")
+		w.Null()
+	}
+	if err := protohelpers.MarshalJSONExtensions(w, m.extensionFields); err != nil {
+		return err
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *TestAllTypesProto2) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *TestAllTypesProto2) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [134]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "optionalInt32", "optional_int32":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.OptionalInt32 = &v
+		case "optionalInt64", "optional_int64":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt64()
+			if err != nil {
+				return err
+			}
+			m.OptionalInt64 = &v
+		case "optionalUint32", "optional_uint32":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint32()
+			if err != nil {
+				return err
+			}
+			m.OptionalUint32 = &v
+		case "optionalUint64", "optional_uint64":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint64()
+			if err != nil {
+				return err
+			}
+			m.OptionalUint64 = &v
+		case "optionalSint32", "optional_sint32":
+			if seen[4] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[4] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.OptionalSint32 = &v
+		case "optionalSint64", "optional_sint64":
+			if seen[5] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[5] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt64()
+			if err != nil {
+				return err
+			}
+			m.OptionalSint64 = &v
+		case "optionalFixed32", "optional_fixed32":
+			if seen[6] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[6] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint32()
+			if err != nil {
+				return err
+			}
+			m.OptionalFixed32 = &v
+		case "optionalFixed64", "optional_fixed64":
+			if seen[7] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[7] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint64()
+			if err != nil {
+				return err
+			}
+			m.OptionalFixed64 = &v
+		case "optionalSfixed32", "optional_sfixed32":
+			if seen[8] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[8] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.OptionalSfixed32 = &v
+		case "optionalSfixed64", "optional_sfixed64":
+			if seen[9] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[9] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt64()
+			if err != nil {
+				return err
+			}
+			m.OptionalSfixed64 = &v
+		case "optionalFloat", "optional_float":
+			if seen[10] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[10] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadFloat32()
+			if err != nil {
+				return err
+			}
+			m.OptionalFloat = &v
+		case "optionalDouble", "optional_double":
+			if seen[11] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[11] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadFloat64()
+			if err != nil {
+				return err
+			}
+			m.OptionalDouble = &v
+		case "optionalBool", "optional_bool":
+			if seen[12] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[12] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadBool()
+			if err != nil {
+				return err
+			}
+			m.OptionalBool = &v
+		case "optionalString", "optional_string":
+			if seen[13] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[13] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.OptionalString = &v
+		case "optionalBytes", "optional_bytes":
+			if seen[14] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[14] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadBytes()
+			if err != nil {
+				return err
+			}
+			m.OptionalBytes = v
+		case "optionalNestedMessage", "optional_nested_message":
+			if seen[15] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[15] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(TestAllTypesProto2_NestedMessage)
+			if err := v.UnmarshalJSONFromVT(r); err != nil {
+				return err
+			}
+			m.OptionalNestedMessage = v
+		case "optionalForeignMessage", "optional_foreign_message":
+			if seen[16] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[16] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(ForeignMessageProto2)
+			if err := v.UnmarshalJSONFromVT(r); err != nil {
+				return err
+			}
+			m.OptionalForeignMessage = v
+		case "optionalNestedEnum", "optional_nested_enum":
+			if seen[17] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[17] = true
+			if r.SkipNull() {
+				continue
+			}
+			e, ok, err := r.ReadEnum(TestAllTypesProto2_NestedEnum_value)
+			if err != nil {
+				return err
+			}
+			if ok {
+				v := TestAllTypesProto2_NestedEnum(e)
+				m.OptionalNestedEnum = &v
+			}
+		case "optionalForeignEnum", "optional_foreign_enum":
+			if seen[18] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[18] = true
+			if r.SkipNull() {
+				continue
+			}
+			e, ok, err := r.ReadEnum(ForeignEnumProto2_value)
+			if err != nil {
+				return err
+			}
+			if ok {
+				v := ForeignEnumProto2(e)
+				m.OptionalForeignEnum = &v
+			}
+		case "optionalStringPiece", "optional_string_piece":
+			if seen[19] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[19] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.OptionalStringPiece = &v
+		case "optionalCord", "optional_cord":
+			if seen[20] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[20] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.OptionalCord = &v
+		case "recursiveMessage", "recursive_message":
+			if seen[21] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[21] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(TestAllTypesProto2)
+			if err := v.UnmarshalJSONFromVT(r); err != nil {
+				return err
+			}
+			m.RecursiveMessage = v
+		case "repeatedInt32", "repeated_int32":
+			if seen[22] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[22] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.RepeatedInt32 = append(m.RepeatedInt32, v)
+			}
+		case "repeatedInt64", "repeated_int64":
+			if seen[23] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[23] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.RepeatedInt64 = append(m.RepeatedInt64, v)
+			}
+		case "repeatedUint32", "repeated_uint32":
+			if seen[24] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[24] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint32()
+				if err != nil {
+					return err
+				}
+				m.RepeatedUint32 = append(m.RepeatedUint32, v)
+			}
+		case "repeatedUint64", "repeated_uint64":
+			if seen[25] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[25] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint64()
+				if err != nil {
+					return err
+				}
+				m.RepeatedUint64 = append(m.RepeatedUint64, v)
+			}
+		case "repeatedSint32", "repeated_sint32":
+			if seen[26] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[26] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.RepeatedSint32 = append(m.RepeatedSint32, v)
+			}
+		case "repeatedSint64", "repeated_sint64":
+			if seen[27] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[27] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.RepeatedSint64 = append(m.RepeatedSint64, v)
+			}
+		case "repeatedFixed32", "repeated_fixed32":
+			if seen[28] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[28] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint32()
+				if err != nil {
+					return err
+				}
+				m.RepeatedFixed32 = append(m.RepeatedFixed32, v)
+			}
+		case "repeatedFixed64", "repeated_fixed64":
+			if seen[29] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[29] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint64()
+				if err != nil {
+					return err
+				}
+				m.RepeatedFixed64 = append(m.RepeatedFixed64, v)
+			}
+		case "repeatedSfixed32", "repeated_sfixed32":
+			if seen[30] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[30] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.RepeatedSfixed32 = append(m.RepeatedSfixed32, v)
+			}
+		case "repeatedSfixed64", "repeated_sfixed64":
+			if seen[31] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[31] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.RepeatedSfixed64 = append(m.RepeatedSfixed64, v)
+			}
+		case "repeatedFloat", "repeated_float":
+			if seen[32] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[32] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadFloat32()
+				if err != nil {
+					return err
+				}
+				m.RepeatedFloat = append(m.RepeatedFloat, v)
+			}
+		case "repeatedDouble", "repeated_double":
+			if seen[33] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[33] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadFloat64()
+				if err != nil {
+					return err
+				}
+				m.RepeatedDouble = append(m.RepeatedDouble, v)
+			}
+		case "repeatedBool", "repeated_bool":
+			if seen[34] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[34] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadBool()
+				if err != nil {
+					return err
+				}
+				m.RepeatedBool = append(m.RepeatedBool, v)
+			}
+		case "repeatedString", "repeated_string":
+			if seen[35] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[35] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadString()
+				if err != nil {
+					return err
+				}
+				m.RepeatedString = append(m.RepeatedString, v)
+			}
+		case "repeatedBytes", "repeated_bytes":
+			if seen[36] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[36] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadBytes()
+				if err != nil {
+					return err
+				}
+				m.RepeatedBytes = append(m.RepeatedBytes, v)
+			}
+		case "repeatedNestedMessage", "repeated_nested_message":
+			if seen[37] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[37] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(TestAllTypesProto2_NestedMessage)
+				if err := v.UnmarshalJSONFromVT(r); err != nil {
+					return err
+				}
+				m.RepeatedNestedMessage = append(m.RepeatedNestedMessage, v)
+			}
+		case "repeatedForeignMessage", "repeated_foreign_message":
+			if seen[38] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[38] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(ForeignMessageProto2)
+				if err := v.UnmarshalJSONFromVT(r); err != nil {
+					return err
+				}
+				m.RepeatedForeignMessage = append(m.RepeatedForeignMessage, v)
+			}
+		case "repeatedNestedEnum", "repeated_nested_enum":
+			if seen[39] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[39] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				e, ok, err := r.ReadEnum(TestAllTypesProto2_NestedEnum_value)
+				if err != nil {
+					return err
+				}
+				if ok {
+					v := TestAllTypesProto2_NestedEnum(e)
+					m.RepeatedNestedEnum = append(m.RepeatedNestedEnum, v)
+				}
+			}
+		case "repeatedForeignEnum", "repeated_foreign_enum":
+			if seen[40] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[40] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				e, ok, err := r.ReadEnum(ForeignEnumProto2_value)
+				if err != nil {
+					return err
+				}
+				if ok {
+					v := ForeignEnumProto2(e)
+					m.RepeatedForeignEnum = append(m.RepeatedForeignEnum, v)
+				}
+			}
+		case "repeatedStringPiece", "repeated_string_piece":
+			if seen[41] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[41] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadString()
+				if err != nil {
+					return err
+				}
+				m.RepeatedStringPiece = append(m.RepeatedStringPiece, v)
+			}
+		case "repeatedCord", "repeated_cord":
+			if seen[42] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[42] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadString()
+				if err != nil {
+					return err
+				}
+				m.RepeatedCord = append(m.RepeatedCord, v)
+			}
+		case "packedInt32", "packed_int32":
+			if seen[43] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[43] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.PackedInt32 = append(m.PackedInt32, v)
+			}
+		case "packedInt64", "packed_int64":
+			if seen[44] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[44] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.PackedInt64 = append(m.PackedInt64, v)
+			}
+		case "packedUint32", "packed_uint32":
+			if seen[45] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[45] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint32()
+				if err != nil {
+					return err
+				}
+				m.PackedUint32 = append(m.PackedUint32, v)
+			}
+		case "packedUint64", "packed_uint64":
+			if seen[46] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[46] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint64()
+				if err != nil {
+					return err
+				}
+				m.PackedUint64 = append(m.PackedUint64, v)
+			}
+		case "packedSint32", "packed_sint32":
+			if seen[47] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[47] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.PackedSint32 = append(m.PackedSint32, v)
+			}
+		case "packedSint64", "packed_sint64":
+			if seen[48] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[48] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.PackedSint64 = append(m.PackedSint64, v)
+			}
+		case "packedFixed32", "packed_fixed32":
+			if seen[49] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[49] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint32()
+				if err != nil {
+					return err
+				}
+				m.PackedFixed32 = append(m.PackedFixed32, v)
+			}
+		case "packedFixed64", "packed_fixed64":
+			if seen[50] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[50] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint64()
+				if err != nil {
+					return err
+				}
+				m.PackedFixed64 = append(m.PackedFixed64, v)
+			}
+		case "packedSfixed32", "packed_sfixed32":
+			if seen[51] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[51] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.PackedSfixed32 = append(m.PackedSfixed32, v)
+			}
+		case "packedSfixed64", "packed_sfixed64":
+			if seen[52] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[52] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.PackedSfixed64 = append(m.PackedSfixed64, v)
+			}
+		case "packedFloat", "packed_float":
+			if seen[53] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[53] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadFloat32()
+				if err != nil {
+					return err
+				}
+				m.PackedFloat = append(m.PackedFloat, v)
+			}
+		case "packedDouble", "packed_double":
+			if seen[54] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[54] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadFloat64()
+				if err != nil {
+					return err
+				}
+				m.PackedDouble = append(m.PackedDouble, v)
+			}
+		case "packedBool", "packed_bool":
+			if seen[55] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[55] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadBool()
+				if err != nil {
+					return err
+				}
+				m.PackedBool = append(m.PackedBool, v)
+			}
+		case "packedNestedEnum", "packed_nested_enum":
+			if seen[56] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[56] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				e, ok, err := r.ReadEnum(TestAllTypesProto2_NestedEnum_value)
+				if err != nil {
+					return err
+				}
+				if ok {
+					v := TestAllTypesProto2_NestedEnum(e)
+					m.PackedNestedEnum = append(m.PackedNestedEnum, v)
+				}
+			}
+		case "unpackedInt32", "unpacked_int32":
+			if seen[57] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[57] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.UnpackedInt32 = append(m.UnpackedInt32, v)
+			}
+		case "unpackedInt64", "unpacked_int64":
+			if seen[58] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[58] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.UnpackedInt64 = append(m.UnpackedInt64, v)
+			}
+		case "unpackedUint32", "unpacked_uint32":
+			if seen[59] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[59] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint32()
+				if err != nil {
+					return err
+				}
+				m.UnpackedUint32 = append(m.UnpackedUint32, v)
+			}
+		case "unpackedUint64", "unpacked_uint64":
+			if seen[60] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[60] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint64()
+				if err != nil {
+					return err
+				}
+				m.UnpackedUint64 = append(m.UnpackedUint64, v)
+			}
+		case "unpackedSint32", "unpacked_sint32":
+			if seen[61] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[61] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.UnpackedSint32 = append(m.UnpackedSint32, v)
+			}
+		case "unpackedSint64", "unpacked_sint64":
+			if seen[62] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[62] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.UnpackedSint64 = append(m.UnpackedSint64, v)
+			}
+		case "unpackedFixed32", "unpacked_fixed32":
+			if seen[63] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[63] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint32()
+				if err != nil {
+					return err
+				}
+				m.UnpackedFixed32 = append(m.UnpackedFixed32, v)
+			}
+		case "unpackedFixed64", "unpacked_fixed64":
+			if seen[64] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[64] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint64()
+				if err != nil {
+					return err
+				}
+				m.UnpackedFixed64 = append(m.UnpackedFixed64, v)
+			}
+		case "unpackedSfixed32", "unpacked_sfixed32":
+			if seen[65] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[65] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.UnpackedSfixed32 = append(m.UnpackedSfixed32, v)
+			}
+		case "unpackedSfixed64", "unpacked_sfixed64":
+			if seen[66] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[66] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.UnpackedSfixed64 = append(m.UnpackedSfixed64, v)
+			}
+		case "unpackedFloat", "unpacked_float":
+			if seen[67] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[67] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadFloat32()
+				if err != nil {
+					return err
+				}
+				m.UnpackedFloat = append(m.UnpackedFloat, v)
+			}
+		case "unpackedDouble", "unpacked_double":
+			if seen[68] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[68] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadFloat64()
+				if err != nil {
+					return err
+				}
+				m.UnpackedDouble = append(m.UnpackedDouble, v)
+			}
+		case "unpackedBool", "unpacked_bool":
+			if seen[69] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[69] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadBool()
+				if err != nil {
+					return err
+				}
+				m.UnpackedBool = append(m.UnpackedBool, v)
+			}
+		case "unpackedNestedEnum", "unpacked_nested_enum":
+			if seen[70] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[70] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				e, ok, err := r.ReadEnum(TestAllTypesProto2_NestedEnum_value)
+				if err != nil {
+					return err
+				}
+				if ok {
+					v := TestAllTypesProto2_NestedEnum(e)
+					m.UnpackedNestedEnum = append(m.UnpackedNestedEnum, v)
+				}
+			}
+		case "mapInt32Int32", "map_int32_int32":
+			if seen[71] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[71] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapInt32Int32 == nil {
+				m.MapInt32Int32 = make(map[int32]int32)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int32Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapInt32Int32[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.MapInt32Int32[k] = v
+			}
+		case "mapInt64Int64", "map_int64_int64":
+			if seen[72] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[72] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapInt64Int64 == nil {
+				m.MapInt64Int64 = make(map[int64]int64)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int64Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapInt64Int64[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.MapInt64Int64[k] = v
+			}
+		case "mapUint32Uint32", "map_uint32_uint32":
+			if seen[73] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[73] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapUint32Uint32 == nil {
+				m.MapUint32Uint32 = make(map[uint32]uint32)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Uint32Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapUint32Uint32[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadUint32()
+				if err != nil {
+					return err
+				}
+				m.MapUint32Uint32[k] = v
+			}
+		case "mapUint64Uint64", "map_uint64_uint64":
+			if seen[74] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[74] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapUint64Uint64 == nil {
+				m.MapUint64Uint64 = make(map[uint64]uint64)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Uint64Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapUint64Uint64[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadUint64()
+				if err != nil {
+					return err
+				}
+				m.MapUint64Uint64[k] = v
+			}
+		case "mapSint32Sint32", "map_sint32_sint32":
+			if seen[75] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[75] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapSint32Sint32 == nil {
+				m.MapSint32Sint32 = make(map[int32]int32)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int32Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapSint32Sint32[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.MapSint32Sint32[k] = v
+			}
+		case "mapSint64Sint64", "map_sint64_sint64":
+			if seen[76] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[76] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapSint64Sint64 == nil {
+				m.MapSint64Sint64 = make(map[int64]int64)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int64Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapSint64Sint64[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.MapSint64Sint64[k] = v
+			}
+		case "mapFixed32Fixed32", "map_fixed32_fixed32":
+			if seen[77] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[77] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapFixed32Fixed32 == nil {
+				m.MapFixed32Fixed32 = make(map[uint32]uint32)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Uint32Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapFixed32Fixed32[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadUint32()
+				if err != nil {
+					return err
+				}
+				m.MapFixed32Fixed32[k] = v
+			}
+		case "mapFixed64Fixed64", "map_fixed64_fixed64":
+			if seen[78] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[78] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapFixed64Fixed64 == nil {
+				m.MapFixed64Fixed64 = make(map[uint64]uint64)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Uint64Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapFixed64Fixed64[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadUint64()
+				if err != nil {
+					return err
+				}
+				m.MapFixed64Fixed64[k] = v
+			}
+		case "mapSfixed32Sfixed32", "map_sfixed32_sfixed32":
+			if seen[79] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[79] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapSfixed32Sfixed32 == nil {
+				m.MapSfixed32Sfixed32 = make(map[int32]int32)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int32Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapSfixed32Sfixed32[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.MapSfixed32Sfixed32[k] = v
+			}
+		case "mapSfixed64Sfixed64", "map_sfixed64_sfixed64":
+			if seen[80] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[80] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapSfixed64Sfixed64 == nil {
+				m.MapSfixed64Sfixed64 = make(map[int64]int64)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int64Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapSfixed64Sfixed64[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.MapSfixed64Sfixed64[k] = v
+			}
+		case "mapInt32Float", "map_int32_float":
+			if seen[81] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[81] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapInt32Float == nil {
+				m.MapInt32Float = make(map[int32]float32)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int32Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapInt32Float[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadFloat32()
+				if err != nil {
+					return err
+				}
+				m.MapInt32Float[k] = v
+			}
+		case "mapInt32Double", "map_int32_double":
+			if seen[82] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[82] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapInt32Double == nil {
+				m.MapInt32Double = make(map[int32]float64)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int32Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapInt32Double[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadFloat64()
+				if err != nil {
+					return err
+				}
+				m.MapInt32Double[k] = v
+			}
+		case "mapBoolBool", "map_bool_bool":
+			if seen[83] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[83] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapBoolBool == nil {
+				m.MapBoolBool = make(map[bool]bool)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.BoolKey(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapBoolBool[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadBool()
+				if err != nil {
+					return err
+				}
+				m.MapBoolBool[k] = v
+			}
+		case "mapStringString", "map_string_string":
+			if seen[84] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[84] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapStringString == nil {
+				m.MapStringString = make(map[string]string)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k := name
+				if _, ok := m.MapStringString[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadString()
+				if err != nil {
+					return err
+				}
+				m.MapStringString[k] = v
+			}
+		case "mapStringBytes", "map_string_bytes":
+			if seen[85] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[85] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapStringBytes == nil {
+				m.MapStringBytes = make(map[string][]byte)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k := name
+				if _, ok := m.MapStringBytes[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadBytes()
+				if err != nil {
+					return err
+				}
+				m.MapStringBytes[k] = v
+			}
+		case "mapStringNestedMessage", "map_string_nested_message":
+			if seen[86] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[86] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapStringNestedMessage == nil {
+				m.MapStringNestedMessage = make(map[string]*TestAllTypesProto2_NestedMessage)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k := name
+				if _, ok := m.MapStringNestedMessage[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v := new(TestAllTypesProto2_NestedMessage)
+				if err := v.UnmarshalJSONFromVT(r); err != nil {
+					return err
+				}
+				m.MapStringNestedMessage[k] = v
+			}
+		case "mapStringForeignMessage", "map_string_foreign_message":
+			if seen[87] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[87] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapStringForeignMessage == nil {
+				m.MapStringForeignMessage = make(map[string]*ForeignMessageProto2)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k := name
+				if _, ok := m.MapStringForeignMessage[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v := new(ForeignMessageProto2)
+				if err := v.UnmarshalJSONFromVT(r); err != nil {
+					return err
+				}
+				m.MapStringForeignMessage[k] = v
+			}
+		case "mapStringNestedEnum", "map_string_nested_enum":
+			if seen[88] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[88] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapStringNestedEnum == nil {
+				m.MapStringNestedEnum = make(map[string]TestAllTypesProto2_NestedEnum)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k := name
+				if _, ok := m.MapStringNestedEnum[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				e, ok, err := r.ReadEnum(TestAllTypesProto2_NestedEnum_value)
+				if err != nil {
+					return err
+				}
+				if ok {
+					v := TestAllTypesProto2_NestedEnum(e)
+					m.MapStringNestedEnum[k] = v
+				}
+			}
+		case "mapStringForeignEnum", "map_string_foreign_enum":
+			if seen[89] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[89] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapStringForeignEnum == nil {
+				m.MapStringForeignEnum = make(map[string]ForeignEnumProto2)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k := name
+				if _, ok := m.MapStringForeignEnum[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				e, ok, err := r.ReadEnum(ForeignEnumProto2_value)
+				if err != nil {
+					return err
+				}
+				if ok {
+					v := ForeignEnumProto2(e)
+					m.MapStringForeignEnum[k] = v
+				}
+			}
+		case "oneofUint32", "oneof_uint32":
+			if seen[90] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[90] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[133] {
+				return r.Errorf("oneof protobuf_test_messages.proto2.TestAllTypesProto2.oneof_field is already set")
+			}
+			seen[133] = true
+			v, err := r.ReadUint32()
+			if err != nil {
+				return err
+			}
+			m.OneofField = &TestAllTypesProto2_OneofUint32{OneofUint32: v}
+		case "oneofNestedMessage", "oneof_nested_message":
+			if seen[91] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[91] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[133] {
+				return r.Errorf("oneof protobuf_test_messages.proto2.TestAllTypesProto2.oneof_field is already set")
+			}
+			seen[133] = true
+			v := new(TestAllTypesProto2_NestedMessage)
+			if err := v.UnmarshalJSONFromVT(r); err != nil {
+				return err
+			}
+			m.OneofField = &TestAllTypesProto2_OneofNestedMessage{OneofNestedMessage: v}
+		case "oneofString", "oneof_string":
+			if seen[92] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[92] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[133] {
+				return r.Errorf("oneof protobuf_test_messages.proto2.TestAllTypesProto2.oneof_field is already set")
+			}
+			seen[133] = true
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.OneofField = &TestAllTypesProto2_OneofString{OneofString: v}
+		case "oneofBytes", "oneof_bytes":
+			if seen[93] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[93] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[133] {
+				return r.Errorf("oneof protobuf_test_messages.proto2.TestAllTypesProto2.oneof_field is already set")
+			}
+			seen[133] = true
+			v, err := r.ReadBytes()
+			if err != nil {
+				return err
+			}
+			m.OneofField = &TestAllTypesProto2_OneofBytes{OneofBytes: v}
+		case "oneofBool", "oneof_bool":
+			if seen[94] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[94] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[133] {
+				return r.Errorf("oneof protobuf_test_messages.proto2.TestAllTypesProto2.oneof_field is already set")
+			}
+			seen[133] = true
+			v, err := r.ReadBool()
+			if err != nil {
+				return err
+			}
+			m.OneofField = &TestAllTypesProto2_OneofBool{OneofBool: v}
+		case "oneofUint64", "oneof_uint64":
+			if seen[95] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[95] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[133] {
+				return r.Errorf("oneof protobuf_test_messages.proto2.TestAllTypesProto2.oneof_field is already set")
+			}
+			seen[133] = true
+			v, err := r.ReadUint64()
+			if err != nil {
+				return err
+			}
+			m.OneofField = &TestAllTypesProto2_OneofUint64{OneofUint64: v}
+		case "oneofFloat", "oneof_float":
+			if seen[96] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[96] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[133] {
+				return r.Errorf("oneof protobuf_test_messages.proto2.TestAllTypesProto2.oneof_field is already set")
+			}
+			seen[133] = true
+			v, err := r.ReadFloat32()
+			if err != nil {
+				return err
+			}
+			m.OneofField = &TestAllTypesProto2_OneofFloat{OneofFloat: v}
+		case "oneofDouble", "oneof_double":
+			if seen[97] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[97] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[133] {
+				return r.Errorf("oneof protobuf_test_messages.proto2.TestAllTypesProto2.oneof_field is already set")
+			}
+			seen[133] = true
+			v, err := r.ReadFloat64()
+			if err != nil {
+				return err
+			}
+			m.OneofField = &TestAllTypesProto2_OneofDouble{OneofDouble: v}
+		case "oneofEnum", "oneof_enum":
+			if seen[98] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[98] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[133] {
+				return r.Errorf("oneof protobuf_test_messages.proto2.TestAllTypesProto2.oneof_field is already set")
+			}
+			seen[133] = true
+			e, ok, err := r.ReadEnum(TestAllTypesProto2_NestedEnum_value)
+			if err != nil {
+				return err
+			}
+			if ok {
+				v := TestAllTypesProto2_NestedEnum(e)
+				m.OneofField = &TestAllTypesProto2_OneofEnum{OneofEnum: v}
+			}
+		case "data", "Data":
+			if seen[99] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[99] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(TestAllTypesProto2_Data)
+			if err := v.UnmarshalJSONFromVT(r); err != nil {
+				return err
+			}
+			m.Data = v
+		case "defaultInt32", "default_int32":
+			if seen[100] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[100] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.DefaultInt32 = &v
+		case "defaultInt64", "default_int64":
+			if seen[101] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[101] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt64()
+			if err != nil {
+				return err
+			}
+			m.DefaultInt64 = &v
+		case "defaultUint32", "default_uint32":
+			if seen[102] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[102] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint32()
+			if err != nil {
+				return err
+			}
+			m.DefaultUint32 = &v
+		case "defaultUint64", "default_uint64":
+			if seen[103] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[103] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint64()
+			if err != nil {
+				return err
+			}
+			m.DefaultUint64 = &v
+		case "defaultSint32", "default_sint32":
+			if seen[104] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[104] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.DefaultSint32 = &v
+		case "defaultSint64", "default_sint64":
+			if seen[105] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[105] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt64()
+			if err != nil {
+				return err
+			}
+			m.DefaultSint64 = &v
+		case "defaultFixed32", "default_fixed32":
+			if seen[106] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[106] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint32()
+			if err != nil {
+				return err
+			}
+			m.DefaultFixed32 = &v
+		case "defaultFixed64", "default_fixed64":
+			if seen[107] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[107] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint64()
+			if err != nil {
+				return err
+			}
+			m.DefaultFixed64 = &v
+		case "defaultSfixed32", "default_sfixed32":
+			if seen[108] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[108] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.DefaultSfixed32 = &v
+		case "defaultSfixed64", "default_sfixed64":
+			if seen[109] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[109] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt64()
+			if err != nil {
+				return err
+			}
+			m.DefaultSfixed64 = &v
+		case "defaultFloat", "default_float":
+			if seen[110] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[110] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadFloat32()
+			if err != nil {
+				return err
+			}
+			m.DefaultFloat = &v
+		case "defaultDouble", "default_double":
+			if seen[111] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[111] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadFloat64()
+			if err != nil {
+				return err
+			}
+			m.DefaultDouble = &v
+		case "defaultBool", "default_bool":
+			if seen[112] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[112] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadBool()
+			if err != nil {
+				return err
+			}
+			m.DefaultBool = &v
+		case "defaultString", "default_string":
+			if seen[113] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[113] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.DefaultString = &v
+		case "defaultBytes", "default_bytes":
+			if seen[114] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[114] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadBytes()
+			if err != nil {
+				return err
+			}
+			m.DefaultBytes = v
+		case "fieldname1":
+			if seen[115] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[115] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Fieldname1 = &v
+		case "fieldName2", "field_name2":
+			if seen[116] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[116] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.FieldName2 = &v
+		case "FieldName3", "_field_name3":
+			if seen[117] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[117] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.XFieldName3 = &v
+		case "fieldName4", "field__name4_":
+			if seen[118] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[118] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Field_Name4_ = &v
+		case "field0name5":
+			if seen[119] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[119] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Field0Name5 = &v
+		case "field0Name6", "field_0_name6":
+			if seen[120] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[120] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Field_0Name6 = &v
+		case "fieldName7":
+			if seen[121] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[121] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.FieldName7 = &v
+		case "FieldName8":
+			if seen[122] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[122] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.FieldName8 = &v
+		case "fieldName9", "field_Name9":
+			if seen[123] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[123] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Field_Name9 = &v
+		case "FieldName10", "Field_Name10":
+			if seen[124] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[124] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Field_Name10 = &v
+		case "FIELDNAME11", "FIELD_NAME11":
+			if seen[125] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[125] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.FIELD_NAME11 = &v
+		case "FIELDName12", "FIELD_name12":
+			if seen[126] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[126] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.FIELDName12 = &v
+		case "FieldName13", "__field_name13":
+			if seen[127] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[127] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.XFieldName13 = &v
+		case "FieldName14", "__Field_name14":
+			if seen[128] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[128] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.X_FieldName14 = &v
+		case "fieldName15", "field__name15":
+			if seen[129] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[129] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Field_Name15 = &v
+		case "fieldName16", "field__Name16":
+			if seen[130] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[130] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Field__Name16 = &v
+		case "fieldName17", "field_name17__":
+			if seen[131] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[131] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.FieldName17__ = &v
+		case "FieldName18", "Field_name18__":
+			if seen[132] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[132] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.FieldName18__ = &v
+		default:
+			if err := protohelpers.UnmarshalJSONExtension(r, m, name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *ForeignMessageProto2) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *ForeignMessageProto2) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &ForeignMessageProto2{}
+	}
+	w.ObjectStart()
+	if m.C != nil {
+		w.Field("c", "c")
+		w.Int32(*m.C)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("c", "c")
+		w.Null()
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *ForeignMessageProto2) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *ForeignMessageProto2) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [1]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "c":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.C = &v
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &UnknownToTestAllTypes_OptionalGroup{}
+	}
+	w.ObjectStart()
+	if m.A != nil {
+		w.Field("a", "a")
+		w.Int32(*m.A)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("a", "a")
+		w.Null()
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [1]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "a":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.A = &v
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *UnknownToTestAllTypes) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *UnknownToTestAllTypes) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &UnknownToTestAllTypes{}
+	}
+	w.ObjectStart()
+	if m.OptionalInt32 != nil {
+		w.Field("optionalInt32", "optional_int32")
+		w.Int32(*m.OptionalInt32)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalInt32", "optional_int32")
+		w.Null()
+	}
+	if m.OptionalString != nil {
+		w.Field("optionalString", "optional_string")
+		if err := w.String(*m.OptionalString); err != nil {
+			return errors.New("proto: field protobuf_test_messages.proto2.UnknownToTestAllTypes.optional_string contains invalid UTF-8")
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalString", "optional_string")
+		w.Null()
+	}
+	if m.NestedMessage != nil {
+		w.Field("nestedMessage", "nested_message")
+		if err := m.NestedMessage.MarshalJSONToVT(w); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("nestedMessage", "nested_message")
+		w.Null()
+	}
+	if m.Optionalgroup != nil {
+		w.Field("optionalgroup", "OptionalGroup")
+		if err := m.Optionalgroup.MarshalJSONToVT(w); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalgroup", "OptionalGroup")
+		w.Null()
+	}
+	if m.OptionalBool != nil {
+		w.Field("optionalBool", "optional_bool")
+		w.Bool(*m.OptionalBool)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalBool", "optional_bool")
+		w.Null()
+	}
+	if len(m.RepeatedInt32) > 0 {
+		w.Field("repeatedInt32", "repeated_int32")
+		w.ArrayStart()
+		for _, v := range m.RepeatedInt32 {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedInt32", "repeated_int32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *UnknownToTestAllTypes) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *UnknownToTestAllTypes) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [6]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "optionalInt32", "optional_int32":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.OptionalInt32 = &v
+		case "optionalString", "optional_string":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.OptionalString = &v
+		case "nestedMessage", "nested_message":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(ForeignMessageProto2)
+			if err := v.UnmarshalJSONFromVT(r); err != nil {
+				return err
+			}
+			m.NestedMessage = v
+		case "optionalgroup", "OptionalGroup":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(UnknownToTestAllTypes_OptionalGroup)
+			if err := v.UnmarshalJSONFromVT(r); err != nil {
+				return err
+			}
+			m.Optionalgroup = v
+		case "optionalBool", "optional_bool":
+			if seen[4] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[4] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadBool()
+			if err != nil {
+				return err
+			}
+			m.OptionalBool = &v
+		case "repeatedInt32", "repeated_int32":
+			if seen[5] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[5] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.RepeatedInt32 = append(m.RepeatedInt32, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *NullHypothesisProto2) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *NullHypothesisProto2) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &NullHypothesisProto2{}
+	}
+	w.ObjectStart()
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *NullHypothesisProto2) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *NullHypothesisProto2) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *EnumOnlyProto2) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *EnumOnlyProto2) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &EnumOnlyProto2{}
+	}
+	w.ObjectStart()
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *EnumOnlyProto2) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *EnumOnlyProto2) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *OneStringProto2) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *OneStringProto2) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &OneStringProto2{}
+	}
+	w.ObjectStart()
+	if m.Data != nil {
+		w.Field("data", "data")
+		if err := w.String(*m.Data); err != nil {
+			return errors.New("proto: field protobuf_test_messages.proto2.OneStringProto2.data contains invalid UTF-8")
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("data", "data")
+		w.Null()
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *OneStringProto2) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *OneStringProto2) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [1]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "data":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.Data = &v
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *TestAllTypesProto2_NestedMessage) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_NestedMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto2_NestedMessage) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_NestedMessage) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_NestedMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Corecursive != nil {
+		size, err := m.Corecursive.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x12
+	}
+	if m.A != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.A))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestAllTypesProto2_Data) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_Data) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto2_Data) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_Data) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_Data) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.GroupUint32 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.GroupUint32))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xd8
+		dAtA[i+1] = 0xc
+	}
+	if m.GroupInt32 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.GroupInt32))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xd0
+		dAtA[i+1] = 0xc
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Str != nil {
+		i -= len(*m.Str)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], *m.Str)
+		i = encodeVarint(dAtA, i, uint64(len(*m.Str)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xca
+		dAtA[i+1] = 0x1
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.I != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.I))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x48
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestAllTypesProto2) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto2) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if vtmsg, ok := m.OneofField.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if m.FieldName18__ != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.FieldName18__))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x90
+		dAtA[i+1] = 0x1a
+	}
+	if m.FieldName17__ != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.FieldName17__))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x88
+		dAtA[i+1] = 0x1a
+	}
+	if m.Field__Name16 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.Field__Name16))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x80
+		dAtA[i+1] = 0x1a
+	}
+	if m.Field_Name15 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.Field_Name15))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xf8
+		dAtA[i+1] = 0x19
+	}
+	if m.X_FieldName14 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.X_FieldName14))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xf0
+		dAtA[i+1] = 0x19
+	}
+	if m.XFieldName13 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.XFieldName13))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xe8
+		dAtA[i+1] = 0x19
+	}
+	if m.FIELDName12 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.FIELDName12))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xe0
+		dAtA[i+1] = 0x19
+	}
+	if m.FIELD_NAME11 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.FIELD_NAME11))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xd8
+		dAtA[i+1] = 0x19
+	}
+	if m.Field_Name10 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.Field_Name10))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xd0
+		dAtA[i+1] = 0x19
+	}
+	if m.Field_Name9 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.Field_Name9))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xc8
+		dAtA[i+1] = 0x19
+	}
+	if m.FieldName8 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.FieldName8))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xc0
+		dAtA[i+1] = 0x19
+	}
+	if m.FieldName7 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.FieldName7))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xb8
+		dAtA[i+1] = 0x19
+	}
+	if m.Field_0Name6 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.Field_0Name6))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xb0
+		dAtA[i+1] = 0x19
+	}
+	if m.Field0Name5 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.Field0Name5))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xa8
+		dAtA[i+1] = 0x19
+	}
+	if m.Field_Name4_ != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.Field_Name4_))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xa0
+		dAtA[i+1] = 0x19
+	}
+	if m.XFieldName3 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.XFieldName3))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x98
+		dAtA[i+1] = 0x19
+	}
+	if m.FieldName2 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.FieldName2))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x90
+		dAtA[i+1] = 0x19
+	}
+	if m.Fieldname1 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.Fieldname1))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x88
+		dAtA[i+1] = 0x19
+	}
+	if m.DefaultBytes != nil {
+		i -= len(m.DefaultBytes)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.DefaultBytes)
+		i = encodeVarint(dAtA, i, uint64(len(m.DefaultBytes)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xfa
+		dAtA[i+1] = 0xf
+	}
+	if m.DefaultString != nil {
+		i -= len(*m.DefaultString)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], *m.DefaultString)
+		i = encodeVarint(dAtA, i, uint64(len(*m.DefaultString)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xf2
+		dAtA[i+1] = 0xf
+	}
+	if m.DefaultBool != nil {
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		if *m.DefaultBool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xe8
+		dAtA[i+1] = 0xf
+	}
+	if m.DefaultDouble != nil {
+		i -= 8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.DefaultDouble))))
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xe1
+		dAtA[i+1] = 0xf
+	}
+	if m.DefaultFloat != nil {
+		i -= 4
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(*m.DefaultFloat))))
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xdd
+		dAtA[i+1] = 0xf
+	}
+	if m.DefaultSfixed64 != nil {
+		i -= 8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.DefaultSfixed64))
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xd1
+		dAtA[i+1] = 0xf
+	}
+	if m.DefaultSfixed32 != nil {
+		i -= 4
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.DefaultSfixed32))
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xcd
+		dAtA[i+1] = 0xf
+	}
+	if m.DefaultFixed64 != nil {
+		i -= 8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.DefaultFixed64))
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xc1
+		dAtA[i+1] = 0xf
+	}
+	if m.DefaultFixed32 != nil {
+		i -= 4
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.DefaultFixed32))
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xbd
+		dAtA[i+1] = 0xf
+	}
+	if m.DefaultSint64 != nil {
+		i = encodeVarint(dAtA, i, uint64((uint64(*m.DefaultSint64)<<1)^uint64((*m.DefaultSint64>>63))))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xb0
+		dAtA[i+1] = 0xf
+	}
+	if m.DefaultSint32 != nil {
+		i = encodeVarint(dAtA, i, uint64((uint32(*m.DefaultSint32)<<1)^uint32((*m.DefaultSint32>>31))))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xa8
+		dAtA[i+1] = 0xf
+	}
+	if m.DefaultUint64 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.DefaultUint64))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xa0
+		dAtA[i+1] = 0xf
+	}
+	if m.DefaultUint32 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.DefaultUint32))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x98
+		dAtA[i+1] = 0xf
+	}
+	if m.DefaultInt64 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.DefaultInt64))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x90
+		dAtA[i+1] = 0xf
+	}
+	if m.DefaultInt32 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.DefaultInt32))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x88
+		dAtA[i+1] = 0xf
+	}
+	if m.Data != nil {
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xcc
+		dAtA[i+1] = 0xc
+		size, err := m.Data.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xcb
+		dAtA[i+1] = 0xc
+	}
+	if len(m.UnpackedNestedEnum) > 0 {
+		for iNdEx := len(m.UnpackedNestedEnum) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.UnpackedNestedEnum[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xb0
+			dAtA[i+1] = 0x6
+		}
+	}
+	if len(m.UnpackedBool) > 0 {
+		for iNdEx := len(m.UnpackedBool) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			if m.UnpackedBool[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa8
+			dAtA[i+1] = 0x6
+		}
+	}
+	if len(m.UnpackedDouble) > 0 {
+		for iNdEx := len(m.UnpackedDouble) - 1; iNdEx >= 0; iNdEx-- {
+			f1 := math.Float64bits(float64(m.UnpackedDouble[iNdEx]))
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f1))
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa1
+			dAtA[i+1] = 0x6
+		}
+	}
+	if len(m.UnpackedFloat) > 0 {
+		for iNdEx := len(m.UnpackedFloat) - 1; iNdEx >= 0; iNdEx-- {
+			f2 := math.Float32bits(float32(m.UnpackedFloat[iNdEx]))
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f2))
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x9d
+			dAtA[i+1] = 0x6
+		}
+	}
+	if len(m.UnpackedSfixed64) > 0 {
+		for iNdEx := len(m.UnpackedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.UnpackedSfixed64[iNdEx]))
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x91
+			dAtA[i+1] = 0x6
+		}
+	}
+	if len(m.UnpackedSfixed32) > 0 {
+		for iNdEx := len(m.UnpackedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.UnpackedSfixed32[iNdEx]))
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8d
+			dAtA[i+1] = 0x6
+		}
+	}
+	if len(m.UnpackedFixed64) > 0 {
+		for iNdEx := len(m.UnpackedFixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.UnpackedFixed64[iNdEx]))
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x81
+			dAtA[i+1] = 0x6
+		}
+	}
+	if len(m.UnpackedFixed32) > 0 {
+		for iNdEx := len(m.UnpackedFixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.UnpackedFixed32[iNdEx]))
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xfd
+			dAtA[i+1] = 0x5
+		}
+	}
+	if len(m.UnpackedSint64) > 0 {
+		for iNdEx := len(m.UnpackedSint64) - 1; iNdEx >= 0; iNdEx-- {
+			x3 := (uint64(m.UnpackedSint64[iNdEx]) << 1) ^ uint64((m.UnpackedSint64[iNdEx] >> 63))
+			i = encodeVarint(dAtA, i, uint64(x3))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xf0
+			dAtA[i+1] = 0x5
+		}
+	}
+	if len(m.UnpackedSint32) > 0 {
+		for iNdEx := len(m.UnpackedSint32) - 1; iNdEx >= 0; iNdEx-- {
+			x4 := (uint32(m.UnpackedSint32[iNdEx]) << 1) ^ uint32((m.UnpackedSint32[iNdEx] >> 31))
+			i = encodeVarint(dAtA, i, uint64(x4))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xe8
+			dAtA[i+1] = 0x5
+		}
+	}
+	if len(m.UnpackedUint64) > 0 {
+		for iNdEx := len(m.UnpackedUint64) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.UnpackedUint64[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xe0
+			dAtA[i+1] = 0x5
+		}
+	}
+	if len(m.UnpackedUint32) > 0 {
+		for iNdEx := len(m.UnpackedUint32) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.UnpackedUint32[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xd8
+			dAtA[i+1] = 0x5
+		}
+	}
+	if len(m.UnpackedInt64) > 0 {
+		for iNdEx := len(m.UnpackedInt64) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.UnpackedInt64[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xd0
+			dAtA[i+1] = 0x5
+		}
+	}
+	if len(m.UnpackedInt32) > 0 {
+		for iNdEx := len(m.UnpackedInt32) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.UnpackedInt32[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xc8
+			dAtA[i+1] = 0x5
+		}
+	}
+	if len(m.PackedNestedEnum) > 0 {
+		var pksize6 int
+		for _, num := range m.PackedNestedEnum {
+			pksize6 += sov(uint64(num))
+		}
+		i -= pksize6
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j5 := i
+		for _, num1 := range m.PackedNestedEnum {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA[j5] = uint8(num)
+			j5++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize6))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xc2
+		dAtA[i+1] = 0x5
+	}
+	if len(m.PackedBool) > 0 {
+		for iNdEx := len(m.PackedBool) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			if m.PackedBool[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedBool)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xba
+		dAtA[i+1] = 0x5
+	}
+	if len(m.PackedDouble) > 0 {
+		for iNdEx := len(m.PackedDouble) - 1; iNdEx >= 0; iNdEx-- {
+			f7 := math.Float64bits(float64(m.PackedDouble[iNdEx]))
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f7))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedDouble)*8))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xb2
+		dAtA[i+1] = 0x5
+	}
+	if len(m.PackedFloat) > 0 {
+		for iNdEx := len(m.PackedFloat) - 1; iNdEx >= 0; iNdEx-- {
+			f8 := math.Float32bits(float32(m.PackedFloat[iNdEx]))
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f8))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedFloat)*4))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xaa
+		dAtA[i+1] = 0x5
+	}
+	if len(m.PackedSfixed64) > 0 {
+		for iNdEx := len(m.PackedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.PackedSfixed64[iNdEx]))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedSfixed64)*8))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xa2
+		dAtA[i+1] = 0x5
+	}
+	if len(m.PackedSfixed32) > 0 {
+		for iNdEx := len(m.PackedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.PackedSfixed32[iNdEx]))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedSfixed32)*4))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x9a
+		dAtA[i+1] = 0x5
+	}
+	if len(m.PackedFixed64) > 0 {
+		for iNdEx := len(m.PackedFixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.PackedFixed64[iNdEx]))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedFixed64)*8))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x92
+		dAtA[i+1] = 0x5
+	}
+	if len(m.PackedFixed32) > 0 {
+		for iNdEx := len(m.PackedFixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.PackedFixed32[iNdEx]))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedFixed32)*4))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8a
+		dAtA[i+1] = 0x5
+	}
+	if len(m.PackedSint64) > 0 {
+		var pksize10 int
+		for _, num := range m.PackedSint64 {
+			pksize10 += soz(uint64(num))
+		}
+		i -= pksize10
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j9 := i
+		for _, num := range m.PackedSint64 {
+			x11 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x11 >= 1<<7 {
+				dAtA[j9] = uint8(uint64(x11)&0x7f | 0x80)
+				j9++
+				x11 >>= 7
+			}
+			dAtA[j9] = uint8(x11)
+			j9++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize10))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x82
+		dAtA[i+1] = 0x5
+	}
+	if len(m.PackedSint32) > 0 {
+		var pksize13 int
+		for _, num := range m.PackedSint32 {
+			pksize13 += soz(uint64(num))
+		}
+		i -= pksize13
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j12 := i
+		for _, num := range m.PackedSint32 {
+			x14 := (uint32(num) << 1) ^ uint32((num >> 31))
+			for x14 >= 1<<7 {
+				dAtA[j12] = uint8(uint64(x14)&0x7f | 0x80)
+				j12++
+				x14 >>= 7
+			}
+			dAtA[j12] = uint8(x14)
+			j12++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize13))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xfa
+		dAtA[i+1] = 0x4
+	}
+	if len(m.PackedUint64) > 0 {
+		var pksize16 int
+		for _, num := range m.PackedUint64 {
+			pksize16 += sov(uint64(num))
+		}
+		i -= pksize16
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j15 := i
+		for _, num := range m.PackedUint64 {
+			for num >= 1<<7 {
+				dAtA[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA[j15] = uint8(num)
+			j15++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize16))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xf2
+		dAtA[i+1] = 0x4
+	}
+	if len(m.PackedUint32) > 0 {
+		var pksize18 int
+		for _, num := range m.PackedUint32 {
+			pksize18 += sov(uint64(num))
+		}
+		i -= pksize18
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j17 := i
+		for _, num := range m.PackedUint32 {
+			for num >= 1<<7 {
+				dAtA[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA[j17] = uint8(num)
+			j17++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize18))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xea
+		dAtA[i+1] = 0x4
+	}
+	if len(m.PackedInt64) > 0 {
+		var pksize20 int
+		for _, num := range m.PackedInt64 {
+			pksize20 += sov(uint64(num))
+		}
+		i -= pksize20
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j19 := i
+		for _, num1 := range m.PackedInt64 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA[j19] = uint8(num)
+			j19++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize20))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xe2
+		dAtA[i+1] = 0x4
+	}
+	if len(m.PackedInt32) > 0 {
+		var pksize22 int
+		for _, num := range m.PackedInt32 {
+			pksize22 += sov(uint64(num))
+		}
+		i -= pksize22
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j21 := i
+		for _, num1 := range m.PackedInt32 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j21] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j21++
+			}
+			dAtA[j21] = uint8(num)
+			j21++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize22))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xda
+		dAtA[i+1] = 0x4
+	}
+	if len(m.MapStringForeignEnum) > 0 {
+		for k := range m.MapStringForeignEnum {
+			v := m.MapStringForeignEnum[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i -= len(k)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xd2
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapStringNestedEnum) > 0 {
+		for k := range m.MapStringNestedEnum {
+			v := m.MapStringNestedEnum[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i -= len(k)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xca
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapStringForeignMessage) > 0 {
+		for k := range m.MapStringForeignMessage {
+			v := m.MapStringForeignMessage[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+			i -= len(k)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xc2
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapStringNestedMessage) > 0 {
+		for k := range m.MapStringNestedMessage {
+			v := m.MapStringNestedMessage[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+			i -= len(k)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xba
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapStringBytes) > 0 {
+		for k := range m.MapStringBytes {
+			v := m.MapStringBytes[k]
+			baseI := i
+			i -= len(v)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+			i -= len(k)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xb2
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapStringString) > 0 {
+		for k := range m.MapStringString {
+			v := m.MapStringString[k]
+			baseI := i
+			i -= len(v)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+			i -= len(k)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xaa
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapBoolBool) > 0 {
+		for k := range m.MapBoolBool {
+			v := m.MapBoolBool[k]
+			baseI := i
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			if k {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa2
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapInt32Double) > 0 {
+		for k := range m.MapInt32Double {
+			v := m.MapInt32Double[k]
+			baseI := i
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x11
+			i = encodeVarint(dAtA, i, uint64(k))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x9a
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapInt32Float) > 0 {
+		for k := range m.MapInt32Float {
+			v := m.MapInt32Float[k]
+			baseI := i
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x15
+			i = encodeVarint(dAtA, i, uint64(k))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x92
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapSfixed64Sfixed64) > 0 {
+		for k := range m.MapSfixed64Sfixed64 {
+			v := m.MapSfixed64Sfixed64[k]
+			baseI := i
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x11
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x9
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8a
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapSfixed32Sfixed32) > 0 {
+		for k := range m.MapSfixed32Sfixed32 {
+			v := m.MapSfixed32Sfixed32[k]
+			baseI := i
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x15
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xd
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x82
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapFixed64Fixed64) > 0 {
+		for k := range m.MapFixed64Fixed64 {
+			v := m.MapFixed64Fixed64[k]
+			baseI := i
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x11
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x9
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xfa
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.MapFixed32Fixed32) > 0 {
+		for k := range m.MapFixed32Fixed32 {
+			v := m.MapFixed32Fixed32[k]
+			baseI := i
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x15
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xd
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xf2
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.MapSint64Sint64) > 0 {
+		for k := range m.MapSint64Sint64 {
+			v := m.MapSint64Sint64[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i = encodeVarint(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xea
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.MapSint32Sint32) > 0 {
+		for k := range m.MapSint32Sint32 {
+			v := m.MapSint32Sint32[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i = encodeVarint(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xe2
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.MapUint64Uint64) > 0 {
+		for k := range m.MapUint64Uint64 {
+			v := m.MapUint64Uint64[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i = encodeVarint(dAtA, i, uint64(k))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xda
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.MapUint32Uint32) > 0 {
+		for k := range m.MapUint32Uint32 {
+			v := m.MapUint32Uint32[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i = encodeVarint(dAtA, i, uint64(k))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xd2
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.MapInt64Int64) > 0 {
+		for k := range m.MapInt64Int64 {
+			v := m.MapInt64Int64[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i = encodeVarint(dAtA, i, uint64(k))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xca
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.MapInt32Int32) > 0 {
+		for k := range m.MapInt32Int32 {
+			v := m.MapInt32Int32[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i = encodeVarint(dAtA, i, uint64(k))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xc2
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.RepeatedCord) > 0 {
+		for iNdEx := len(m.RepeatedCord) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RepeatedCord[iNdEx])
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], m.RepeatedCord[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.RepeatedCord[iNdEx])))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xba
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.RepeatedStringPiece) > 0 {
+		for iNdEx := len(m.RepeatedStringPiece) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RepeatedStringPiece[iNdEx])
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], m.RepeatedStringPiece[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.RepeatedStringPiece[iNdEx])))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xb2
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.RepeatedForeignEnum) > 0 {
+		for iNdEx := len(m.RepeatedForeignEnum) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.RepeatedForeignEnum[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa0
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.RepeatedNestedEnum) > 0 {
+		for iNdEx := len(m.RepeatedNestedEnum) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.RepeatedNestedEnum[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x98
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.RepeatedForeignMessage) > 0 {
+		for iNdEx := len(m.RepeatedForeignMessage) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.RepeatedForeignMessage[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8a
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.RepeatedNestedMessage) > 0 {
+		for iNdEx := len(m.RepeatedNestedMessage) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.RepeatedNestedMessage[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x82
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.RepeatedBytes) > 0 {
+		for iNdEx := len(m.RepeatedBytes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RepeatedBytes[iNdEx])
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], m.RepeatedBytes[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.RepeatedBytes[iNdEx])))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xea
+			dAtA[i+1] = 0x2
+		}
+	}
+	if len(m.RepeatedString) > 0 {
+		for iNdEx := len(m.RepeatedString) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RepeatedString[iNdEx])
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], m.RepeatedString[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.RepeatedString[iNdEx])))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xe2
+			dAtA[i+1] = 0x2
+		}
+	}
+	if len(m.RepeatedBool) > 0 {
+		for iNdEx := len(m.RepeatedBool) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			if m.RepeatedBool[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xd8
+			dAtA[i+1] = 0x2
+		}
+	}
+	if len(m.RepeatedDouble) > 0 {
+		for iNdEx := len(m.RepeatedDouble) - 1; iNdEx >= 0; iNdEx-- {
+			f23 := math.Float64bits(float64(m.RepeatedDouble[iNdEx]))
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f23))
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xd1
+			dAtA[i+1] = 0x2
+		}
+	}
+	if len(m.RepeatedFloat) > 0 {
+		for iNdEx := len(m.RepeatedFloat) - 1; iNdEx >= 0; iNdEx-- {
+			f24 := math.Float32bits(float32(m.RepeatedFloat[iNdEx]))
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f24))
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xcd
+			dAtA[i+1] = 0x2
+		}
+	}
+	if len(m.RepeatedSfixed64) > 0 {
+		for iNdEx := len(m.RepeatedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.RepeatedSfixed64[iNdEx]))
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xc1
+			dAtA[i+1] = 0x2
+		}
+	}
+	if len(m.RepeatedSfixed32) > 0 {
+		for iNdEx := len(m.RepeatedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.RepeatedSfixed32[iNdEx]))
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xbd
+			dAtA[i+1] = 0x2
+		}
+	}
+	if len(m.RepeatedFixed64) > 0 {
+		for iNdEx := len(m.RepeatedFixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.RepeatedFixed64[iNdEx]))
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xb1
+			dAtA[i+1] = 0x2
+		}
+	}
+	if len(m.RepeatedFixed32) > 0 {
+		for iNdEx := len(m.RepeatedFixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.RepeatedFixed32[iNdEx]))
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xad
+			dAtA[i+1] = 0x2
+		}
+	}
+	if len(m.RepeatedSint64) > 0 {
+		for iNdEx := len(m.RepeatedSint64) - 1; iNdEx >= 0; iNdEx-- {
+			x25 := (uint64(m.RepeatedSint64[iNdEx]) << 1) ^ uint64((m.RepeatedSint64[iNdEx] >> 63))
+			i = encodeVarint(dAtA, i, uint64(x25))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa0
+			dAtA[i+1] = 0x2
+		}
+	}
+	if len(m.RepeatedSint32) > 0 {
+		for iNdEx := len(m.RepeatedSint32) - 1; iNdEx >= 0; iNdEx-- {
+			x26 := (uint32(m.RepeatedSint32[iNdEx]) << 1) ^ uint32((m.RepeatedSint32[iNdEx] >> 31))
+			i = encodeVarint(dAtA, i, uint64(x26))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x98
+			dAtA[i+1] = 0x2
+		}
+	}
+	if len(m.RepeatedUint64) > 0 {
+		for iNdEx := len(m.RepeatedUint64) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.RepeatedUint64[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x90
+			dAtA[i+1] = 0x2
+		}
+	}
+	if len(m.RepeatedUint32) > 0 {
+		for iNdEx := len(m.RepeatedUint32) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.RepeatedUint32[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x88
+			dAtA[i+1] = 0x2
+		}
+	}
+	if len(m.RepeatedInt64) > 0 {
+		for iNdEx := len(m.RepeatedInt64) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.RepeatedInt64[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x80
+			dAtA[i+1] = 0x2
+		}
+	}
+	if len(m.RepeatedInt32) > 0 {
+		for iNdEx := len(m.RepeatedInt32) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.RepeatedInt32[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xf8
+			dAtA[i+1] = 0x1
+		}
+	}
+	if m.RecursiveMessage != nil {
+		size, err := m.RecursiveMessage.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xda
+		dAtA[i+1] = 0x1
+	}
+	if m.OptionalCord != nil {
+		i -= len(*m.OptionalCord)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], *m.OptionalCord)
+		i = encodeVarint(dAtA, i, uint64(len(*m.OptionalCord)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xca
+		dAtA[i+1] = 0x1
+	}
+	if m.OptionalStringPiece != nil {
+		i -= len(*m.OptionalStringPiece)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], *m.OptionalStringPiece)
+		i = encodeVarint(dAtA, i, uint64(len(*m.OptionalStringPiece)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xc2
+		dAtA[i+1] = 0x1
+	}
+	if m.OptionalForeignEnum != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.OptionalForeignEnum))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xb0
+		dAtA[i+1] = 0x1
+	}
+	if m.OptionalNestedEnum != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.OptionalNestedEnum))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xa8
+		dAtA[i+1] = 0x1
+	}
+	if m.OptionalForeignMessage != nil {
+		size, err := m.OptionalForeignMessage.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x9a
+		dAtA[i+1] = 0x1
+	}
+	if m.OptionalNestedMessage != nil {
+		size, err := m.OptionalNestedMessage.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x92
+		dAtA[i+1] = 0x1
+	}
+	if m.OptionalBytes != nil {
+		i -= len(m.OptionalBytes)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.OptionalBytes)
+		i = encodeVarint(dAtA, i, uint64(len(m.OptionalBytes)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x7a
+	}
+	if m.OptionalString != nil {
+		i -= len(*m.OptionalString)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], *m.OptionalString)
+		i = encodeVarint(dAtA, i, uint64(len(*m.OptionalString)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x72
+	}
+	if m.OptionalBool != nil {
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		if *m.OptionalBool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x68
+	}
+	if m.OptionalDouble != nil {
+		i -= 8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.OptionalDouble))))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x61
+	}
+	if m.OptionalFloat != nil {
+		i -= 4
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(*m.OptionalFloat))))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x5d
+	}
+	if m.OptionalSfixed64 != nil {
+		i -= 8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.OptionalSfixed64))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x51
+	}
+	if m.OptionalSfixed32 != nil {
+		i -= 4
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.OptionalSfixed32))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x4d
+	}
+	if m.OptionalFixed64 != nil {
+		i -= 8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.OptionalFixed64))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x41
+	}
+	if m.OptionalFixed32 != nil {
+		i -= 4
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.OptionalFixed32))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x3d
+	}
+	if m.OptionalSint64 != nil {
+		i = encodeVarint(dAtA, i, uint64((uint64(*m.OptionalSint64)<<1)^uint64((*m.OptionalSint64>>63))))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x30
+	}
+	if m.OptionalSint32 != nil {
+		i = encodeVarint(dAtA, i, uint64((uint32(*m.OptionalSint32)<<1)^uint32((*m.OptionalSint32>>31))))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x28
+	}
+	if m.OptionalUint64 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.OptionalUint64))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x20
+	}
+	if m.OptionalUint32 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.OptionalUint32))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x18
+	}
+	if m.OptionalInt64 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.OptionalInt64))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x10
+	}
+	if m.OptionalInt32 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.OptionalInt32))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	if len(m.extensionFields) > 0 {
+		extensionNums := make([]int32, 0, len(m.extensionFields))
+		for num := range m.extensionFields {
+			extensionNums = append(extensionNums, num)
+		}
+		sort.Slice(extensionNums, func(i, j int) bool {
+			return extensionNums[i] > extensionNums[j]
+		})
+		for _, num := range extensionNums {
+			switch num {
+			case 120:
+				v := proto.GetExtension(m, E_ExtensionInt32).(int32)
+				i = encodeVarint(dAtA, i, uint64(v))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				i -= 2
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				dAtA[i] = 0xc0
+				dAtA[i+1] = 0x7
+			default:
+				x := m.extensionFields[num]
+				i -= protohelpers.SizeOfExtension(x.Type(), x.Value())
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				if _, err := protohelpers.AppendExtension(dAtA[:i], x.Type(), x.Value()); err != nil {
+					return 0, err
+				}
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestAllTypesProto2_OneofUint32) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto2_OneofUint32) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_OneofUint32) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarint(dAtA, i, uint64(m.OneofUint32))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i -= 2
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0xf8
+	dAtA[i+1] = 0x6
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto2_OneofNestedMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto2_OneofNestedMessage) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_OneofNestedMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OneofNestedMessage != nil {
+		size, err := m.OneofNestedMessage.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x82
+		dAtA[i+1] = 0x7
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto2_OneofString) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto2_OneofString) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_OneofString) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.OneofString)
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	copy(dAtA[i:], m.OneofString)
+	i = encodeVarint(dAtA, i, uint64(len(m.OneofString)))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i -= 2
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0x8a
+	dAtA[i+1] = 0x7
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto2_OneofBytes) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto2_OneofBytes) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_OneofBytes) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.OneofBytes)
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	copy(dAtA[i:], m.OneofBytes)
+	i = encodeVarint(dAtA, i, uint64(len(m.OneofBytes)))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i -= 2
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0x92
+	dAtA[i+1] = 0x7
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto2_OneofBool) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto2_OneofBool) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_OneofBool) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i--
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	if m.OneofBool {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i -= 2
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0x98
+	dAtA[i+1] = 0x7
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto2_OneofUint64) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto2_OneofUint64) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_OneofUint64) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarint(dAtA, i, uint64(m.OneofUint64))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i -= 2
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0xa0
+	dAtA[i+1] = 0x7
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto2_OneofFloat) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto2_OneofFloat) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_OneofFloat) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 4
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OneofFloat))))
+	i -= 2
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0xad
+	dAtA[i+1] = 0x7
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto2_OneofDouble) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto2_OneofDouble) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_OneofDouble) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 8
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.OneofDouble))))
+	i -= 2
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0xb1
+	dAtA[i+1] = 0x7
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto2_OneofEnum) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto2_OneofEnum) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto2_OneofEnum) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarint(dAtA, i, uint64(m.OneofEnum))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i -= 2
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0xb8
+	dAtA[i+1] = 0x7
+	return len(dAtA) - i, nil
+}
+func (m *ForeignMessageProto2) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ForeignMessageProto2) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *ForeignMessageProto2) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ForeignMessageProto2) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ForeignMessageProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.C != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.C))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.A != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.A))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnknownToTestAllTypes) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *UnknownToTestAllTypes) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *UnknownToTestAllTypes) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *UnknownToTestAllTypes) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *UnknownToTestAllTypes) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.RepeatedInt32) > 0 {
+		for iNdEx := len(m.RepeatedInt32) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.RepeatedInt32[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x98
+			dAtA[i+1] = 0x3f
+		}
+	}
+	if m.OptionalBool != nil {
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		if *m.OptionalBool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xf0
+		dAtA[i+1] = 0x3e
+	}
+	if m.Optionalgroup != nil {
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xe4
+		dAtA[i+1] = 0x3e
+		size, err := m.Optionalgroup.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xe3
+		dAtA[i+1] = 0x3e
+	}
+	if m.NestedMessage != nil {
+		size, err := m.NestedMessage.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xda
+		dAtA[i+1] = 0x3e
+	}
+	if m.OptionalString != nil {
+		i -= len(*m.OptionalString)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], *m.OptionalString)
+		i = encodeVarint(dAtA, i, uint64(len(*m.OptionalString)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xd2
+		dAtA[i+1] = 0x3e
+	}
+	if m.OptionalInt32 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.OptionalInt32))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xc8
+		dAtA[i+1] = 0x3e
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NullHypothesisProto2) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *NullHypothesisProto2) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *NullHypothesisProto2) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *NullHypothesisProto2) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *NullHypothesisProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnumOnlyProto2) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *EnumOnlyProto2) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *EnumOnlyProto2) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *EnumOnlyProto2) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *EnumOnlyProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OneStringProto2) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *OneStringProto2) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *OneStringProto2) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *OneStringProto2) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *OneStringProto2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Data != nil {
+		i -= len(*m.Data)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], *m.Data)
+		i = encodeVarint(dAtA, i, uint64(len(*m.Data)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+var (
+	ErrBufferTooSmall = fmt.Errorf("proto: buffer too small to marshal the message")
+	ErrSizeMismatch   = fmt.Errorf("proto: message size changed while marshaling")
+)
+
+func encodeVarint(dAtA []byte, offset int, v uint64) int {
+	offset -= sov(v)
+	if offset < 0 {
+		return offset
+	}
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TestAllTypesProto2_NestedMessage) MarshalVTPooled() (*vtproto.Buffer, error) {
+	size := m.SizeVT()
+	buf := vtproto.NewBuffer(size)
+	n, err := m.MarshalToSizedBufferVT(buf.Bytes())
+	if err == nil && n != size {
+		err = ErrSizeMismatch
+	}
+	if err != nil {
+		buf.Free()
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (m *TestAllTypesProto2_Data) MarshalVTPooled() (*vtproto.Buffer, error) {
+	size := m.SizeVT()
+	buf := vtproto.NewBuffer(size)
+	n, err := m.MarshalToSizedBufferVT(buf.Bytes())
+	if err == nil && n != size {
+		err = ErrSizeMismatch
+	}
+	if err != nil {
+		buf.Free()
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) MarshalVTPooled() (*vtproto.Buffer, error) {
+	size := m.SizeVT()
+	buf := vtproto.NewBuffer(size)
+	n, err := m.MarshalToSizedBufferVT(buf.Bytes())
+	if err == nil && n != size {
+		err = ErrSizeMismatch
+	}
+	if err != nil {
+		buf.Free()
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) MarshalVTPooled() (*vtproto.Buffer, error) {
+	size := m.SizeVT()
+	buf := vtproto.NewBuffer(size)
+	n, err := m.MarshalToSizedBufferVT(buf.Bytes())
+	if err == nil && n != size {
+		err = ErrSizeMismatch
+	}
+	if err != nil {
+		buf.Free()
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) MarshalVTPooled() (*vtproto.Buffer, error) {
+	size := m.SizeVT()
+	buf := vtproto.NewBuffer(size)
+	n, err := m.MarshalToSizedBufferVT(buf.Bytes())
+	if err == nil && n != size {
+		err = ErrSizeMismatch
+	}
+	if err != nil {
+		buf.Free()
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (m *TestAllTypesProto2) MarshalVTPooled() (*vtproto.Buffer, error) {
+	size := m.SizeVT()
+	buf := vtproto.NewBuffer(size)
+	n, err := m.MarshalToSizedBufferVT(buf.Bytes())
+	if err == nil && n != size {
+		err = ErrSizeMismatch
+	}
+	if err != nil {
+		buf.Free()
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (m *ForeignMessageProto2) MarshalVTPooled() (*vtproto.Buffer, error) {
+	size := m.SizeVT()
+	buf := vtproto.NewBuffer(size)
+	n, err := m.MarshalToSizedBufferVT(buf.Bytes())
+	if err == nil && n != size {
+		err = ErrSizeMismatch
+	}
+	if err != nil {
+		buf.Free()
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) MarshalVTPooled() (*vtproto.Buffer, error) {
+	size := m.SizeVT()
+	buf := vtproto.NewBuffer(size)
+	n, err := m.MarshalToSizedBufferVT(buf.Bytes())
+	if err == nil && n != size {
+		err = ErrSizeMismatch
+	}
+	if err != nil {
+		buf.Free()
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (m *UnknownToTestAllTypes) MarshalVTPooled() (*vtproto.Buffer, error) {
+	size := m.SizeVT()
+	buf := vtproto.NewBuffer(size)
+	n, err := m.MarshalToSizedBufferVT(buf.Bytes())
+	if err == nil && n != size {
+		err = ErrSizeMismatch
+	}
+	if err != nil {
+		buf.Free()
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (m *NullHypothesisProto2) MarshalVTPooled() (*vtproto.Buffer, error) {
+	size := m.SizeVT()
+	buf := vtproto.NewBuffer(size)
+	n, err := m.MarshalToSizedBufferVT(buf.Bytes())
+	if err == nil && n != size {
+		err = ErrSizeMismatch
+	}
+	if err != nil {
+		buf.Free()
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (m *EnumOnlyProto2) MarshalVTPooled() (*vtproto.Buffer, error) {
+	size := m.SizeVT()
+	buf := vtproto.NewBuffer(size)
+	n, err := m.MarshalToSizedBufferVT(buf.Bytes())
+	if err == nil && n != size {
+		err = ErrSizeMismatch
+	}
+	if err != nil {
+		buf.Free()
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (m *OneStringProto2) MarshalVTPooled() (*vtproto.Buffer, error) {
+	size := m.SizeVT()
+	buf := vtproto.NewBuffer(size)
+	n, err := m.MarshalToSizedBufferVT(buf.Bytes())
+	if err == nil && n != size {
+		err = ErrSizeMismatch
+	}
+	if err != nil {
+		buf.Free()
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (m *TestAllTypesProto2_NestedMessage) MergeVT(src *TestAllTypesProto2_NestedMessage) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.A != nil {
+		tmpVal := *src.A
+		m.A = &tmpVal
+	}
+	if src.Corecursive != nil {
+		if m.Corecursive == nil {
+			m.Corecursive = &TestAllTypesProto2{}
+		}
+		m.Corecursive.MergeVT(src.Corecursive)
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *TestAllTypesProto2_NestedMessage) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*TestAllTypesProto2_NestedMessage); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *TestAllTypesProto2_Data) MergeVT(src *TestAllTypesProto2_Data) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.GroupInt32 != nil {
+		tmpVal := *src.GroupInt32
+		m.GroupInt32 = &tmpVal
+	}
+	if src.GroupUint32 != nil {
+		tmpVal := *src.GroupUint32
+		m.GroupUint32 = &tmpVal
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *TestAllTypesProto2_Data) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*TestAllTypesProto2_Data); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) MergeVT(src *TestAllTypesProto2_MessageSetCorrect) {
+	if m == nil || src == nil {
+		return
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*TestAllTypesProto2_MessageSetCorrect); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) MergeVT(src *TestAllTypesProto2_MessageSetCorrectExtension1) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Str != nil {
+		tmpVal := *src.Str
+		m.Str = &tmpVal
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*TestAllTypesProto2_MessageSetCorrectExtension1); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) MergeVT(src *TestAllTypesProto2_MessageSetCorrectExtension2) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.I != nil {
+		tmpVal := *src.I
+		m.I = &tmpVal
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*TestAllTypesProto2_MessageSetCorrectExtension2); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *TestAllTypesProto2) MergeVT(src *TestAllTypesProto2) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.OptionalInt32 != nil {
+		tmpVal := *src.OptionalInt32
+		m.OptionalInt32 = &tmpVal
+	}
+	if src.OptionalInt64 != nil {
+		tmpVal := *src.OptionalInt64
+		m.OptionalInt64 = &tmpVal
+	}
+	if src.OptionalUint32 != nil {
+		tmpVal := *src.OptionalUint32
+		m.OptionalUint32 = &tmpVal
+	}
+	if src.OptionalUint64 != nil {
+		tmpVal := *src.OptionalUint64
+		m.OptionalUint64 = &tmpVal
+	}
+	if src.OptionalSint32 != nil {
+		tmpVal := *src.OptionalSint32
+		m.OptionalSint32 = &tmpVal
+	}
+	if src.OptionalSint64 != nil {
+		tmpVal := *src.OptionalSint64
+		m.OptionalSint64 = &tmpVal
+	}
+	if src.OptionalFixed32 != nil {
+		tmpVal := *src.OptionalFixed32
+		m.OptionalFixed32 = &tmpVal
+	}
+	if src.OptionalFixed64 != nil {
+		tmpVal := *src.OptionalFixed64
+		m.OptionalFixed64 = &tmpVal
+	}
+	if src.OptionalSfixed32 != nil {
+		tmpVal := *src.OptionalSfixed32
+		m.OptionalSfixed32 = &tmpVal
+	}
+	if src.OptionalSfixed64 != nil {
+		tmpVal := *src.OptionalSfixed64
+		m.OptionalSfixed64 = &tmpVal
+	}
+	if src.OptionalFloat != nil {
+		tmpVal := *src.OptionalFloat
+		m.OptionalFloat = &tmpVal
+	}
+	if src.OptionalDouble != nil {
+		tmpVal := *src.OptionalDouble
+		m.OptionalDouble = &tmpVal
+	}
+	if src.OptionalBool != nil {
+		tmpVal := *src.OptionalBool
+		m.OptionalBool = &tmpVal
+	}
+	if src.OptionalString != nil {
+		tmpVal := *src.OptionalString
+		m.OptionalString = &tmpVal
+	}
+	if src.OptionalBytes != nil {
+		m.OptionalBytes = append([]byte{}, src.OptionalBytes...)
+	}
+	if src.OptionalNestedMessage != nil {
+		if m.OptionalNestedMessage == nil {
+			m.OptionalNestedMessage = &TestAllTypesProto2_NestedMessage{}
+		}
+		m.OptionalNestedMessage.MergeVT(src.OptionalNestedMessage)
+	}
+	if src.OptionalForeignMessage != nil {
+		if m.OptionalForeignMessage == nil {
+			m.OptionalForeignMessage = &ForeignMessageProto2{}
+		}
+		m.OptionalForeignMessage.MergeVT(src.OptionalForeignMessage)
+	}
+	if src.OptionalNestedEnum != nil {
+		tmpVal := *src.OptionalNestedEnum
+		m.OptionalNestedEnum = &tmpVal
+	}
+	if src.OptionalForeignEnum != nil {
+		tmpVal := *src.OptionalForeignEnum
+		m.OptionalForeignEnum = &tmpVal
+	}
+	if src.OptionalStringPiece != nil {
+		tmpVal := *src.OptionalStringPiece
+		m.OptionalStringPiece = &tmpVal
+	}
+	if src.OptionalCord != nil {
+		tmpVal := *src.OptionalCord
+		m.OptionalCord = &tmpVal
+	}
+	if src.RecursiveMessage != nil {
+		if m.RecursiveMessage == nil {
+			m.RecursiveMessage = &TestAllTypesProto2{}
+		}
+		m.RecursiveMessage.MergeVT(src.RecursiveMessage)
+	}
+	m.RepeatedInt32 = append(m.RepeatedInt32, src.RepeatedInt32...)
+	m.RepeatedInt64 = append(m.RepeatedInt64, src.RepeatedInt64...)
+	m.RepeatedUint32 = append(m.RepeatedUint32, src.RepeatedUint32...)
+	m.RepeatedUint64 = append(m.RepeatedUint64, src.RepeatedUint64...)
+	m.RepeatedSint32 = append(m.RepeatedSint32, src.RepeatedSint32...)
+	m.RepeatedSint64 = append(m.RepeatedSint64, src.RepeatedSint64...)
+	m.RepeatedFixed32 = append(m.RepeatedFixed32, src.RepeatedFixed32...)
+	m.RepeatedFixed64 = append(m.RepeatedFixed64, src.RepeatedFixed64...)
+	m.RepeatedSfixed32 = append(m.RepeatedSfixed32, src.RepeatedSfixed32...)
+	m.RepeatedSfixed64 = append(m.RepeatedSfixed64, src.RepeatedSfixed64...)
+	m.RepeatedFloat = append(m.RepeatedFloat, src.RepeatedFloat...)
+	m.RepeatedDouble = append(m.RepeatedDouble, src.RepeatedDouble...)
+	m.RepeatedBool = append(m.RepeatedBool, src.RepeatedBool...)
+	m.RepeatedString = append(m.RepeatedString, src.RepeatedString...)
+	for _, v := range src.RepeatedBytes {
+		m.RepeatedBytes = append(m.RepeatedBytes, append([]byte{}, v...))
+	}
+	for _, v := range src.RepeatedNestedMessage {
+		tmpMsg := &TestAllTypesProto2_NestedMessage{}
+		tmpMsg.MergeVT(v)
+		m.RepeatedNestedMessage = append(m.RepeatedNestedMessage, tmpMsg)
+	}
+	for _, v := range src.RepeatedForeignMessage {
+		tmpMsg := &ForeignMessageProto2{}
+		tmpMsg.MergeVT(v)
+		m.RepeatedForeignMessage = append(m.RepeatedForeignMessage, tmpMsg)
+	}
+	m.RepeatedNestedEnum = append(m.RepeatedNestedEnum, src.RepeatedNestedEnum...)
+	m.RepeatedForeignEnum = append(m.RepeatedForeignEnum, src.RepeatedForeignEnum...)
+	m.RepeatedStringPiece = append(m.RepeatedStringPiece, src.RepeatedStringPiece...)
+	m.RepeatedCord = append(m.RepeatedCord, src.RepeatedCord...)
+	if len(src.MapInt32Int32) > 0 {
+		if m.MapInt32Int32 == nil {
+			m.MapInt32Int32 = make(map[int32]int32, len(src.MapInt32Int32))
+		}
+		for k, v := range src.MapInt32Int32 {
+			m.MapInt32Int32[k] = v
+		}
+	}
+	if len(src.MapInt64Int64) > 0 {
+		if m.MapInt64Int64 == nil {
+			m.MapInt64Int64 = make(map[int64]int64, len(src.MapInt64Int64))
+		}
+		for k, v := range src.MapInt64Int64 {
+			m.MapInt64Int64[k] = v
+		}
+	}
+	if len(src.MapUint32Uint32) > 0 {
+		if m.MapUint32Uint32 == nil {
+			m.MapUint32Uint32 = make(map[uint32]uint32, len(src.MapUint32Uint32))
+		}
+		for k, v := range src.MapUint32Uint32 {
+			m.MapUint32Uint32[k] = v
+		}
+	}
+	if len(src.MapUint64Uint64) > 0 {
+		if m.MapUint64Uint64 == nil {
+			m.MapUint64Uint64 = make(map[uint64]uint64, len(src.MapUint64Uint64))
+		}
+		for k, v := range src.MapUint64Uint64 {
+			m.MapUint64Uint64[k] = v
+		}
+	}
+	if len(src.MapSint32Sint32) > 0 {
+		if m.MapSint32Sint32 == nil {
+			m.MapSint32Sint32 = make(map[int32]int32, len(src.MapSint32Sint32))
+		}
+		for k, v := range src.MapSint32Sint32 {
+			m.MapSint32Sint32[k] = v
+		}
+	}
+	if len(src.MapSint64Sint64) > 0 {
+		if m.MapSint64Sint64 == nil {
+			m.MapSint64Sint64 = make(map[int64]int64, len(src.MapSint64Sint64))
+		}
+		for k, v := range src.MapSint64Sint64 {
+			m.MapSint64Sint64[k] = v
+		}
+	}
+	if len(src.MapFixed32Fixed32) > 0 {
+		if m.MapFixed32Fixed32 == nil {
+			m.MapFixed32Fixed32 = make(map[uint32]uint32, len(src.MapFixed32Fixed32))
+		}
+		for k, v := range src.MapFixed32Fixed32 {
+			m.MapFixed32Fixed32[k] = v
+		}
+	}
+	if len(src.MapFixed64Fixed64) > 0 {
+		if m.MapFixed64Fixed64 == nil {
+			m.MapFixed64Fixed64 = make(map[uint64]uint64, len(src.MapFixed64Fixed64))
+		}
+		for k, v := range src.MapFixed64Fixed64 {
+			m.MapFixed64Fixed64[k] = v
+		}
+	}
+	if len(src.MapSfixed32Sfixed32) > 0 {
+		if m.MapSfixed32Sfixed32 == nil {
+			m.MapSfixed32Sfixed32 = make(map[int32]int32, len(src.MapSfixed32Sfixed32))
+		}
+		for k, v := range src.MapSfixed32Sfixed32 {
+			m.MapSfixed32Sfixed32[k] = v
+		}
+	}
+	if len(src.MapSfixed64Sfixed64) > 0 {
+		if m.MapSfixed64Sfixed64 == nil {
+			m.MapSfixed64Sfixed64 = make(map[int64]int64, len(src.MapSfixed64Sfixed64))
+		}
+		for k, v := range src.MapSfixed64Sfixed64 {
+			m.MapSfixed64Sfixed64[k] = v
+		}
+	}
+	if len(src.MapInt32Float) > 0 {
+		if m.MapInt32Float == nil {
+			m.MapInt32Float = make(map[int32]float32, len(src.MapInt32Float))
+		}
+		for k, v := range src.MapInt32Float {
+			m.MapInt32Float[k] = v
+		}
+	}
+	if len(src.MapInt32Double) > 0 {
+		if m.MapInt32Double == nil {
+			m.MapInt32Double = make(map[int32]float64, len(src.MapInt32Double))
+		}
+		for k, v := range src.MapInt32Double {
+			m.MapInt32Double[k] = v
+		}
+	}
+	if len(src.MapBoolBool) > 0 {
+		if m.MapBoolBool == nil {
+			m.MapBoolBool = make(map[bool]bool, len(src.MapBoolBool))
+		}
+		for k, v := range src.MapBoolBool {
+			m.MapBoolBool[k] = v
+		}
+	}
+	if len(src.MapStringString) > 0 {
+		if m.MapStringString == nil {
+			m.MapStringString = make(map[string]string, len(src.MapStringString))
+		}
+		for k, v := range src.MapStringString {
+			m.MapStringString[k] = v
+		}
+	}
+	if len(src.MapStringBytes) > 0 {
+		if m.MapStringBytes == nil {
+			m.MapStringBytes = make(map[string][]byte, len(src.MapStringBytes))
+		}
+		for k, v := range src.MapStringBytes {
+			m.MapStringBytes[k] = append([]byte{}, v...)
+		}
+	}
+	if len(src.MapStringNestedMessage) > 0 {
+		if m.MapStringNestedMessage == nil {
+			m.MapStringNestedMessage = make(map[string]*TestAllTypesProto2_NestedMessage, len(src.MapStringNestedMessage))
+		}
+		for k, v := range src.MapStringNestedMessage {
+			tmpMsg := &TestAllTypesProto2_NestedMessage{}
+			tmpMsg.MergeVT(v)
+			m.MapStringNestedMessage[k] = tmpMsg
+		}
+	}
+	if len(src.MapStringForeignMessage) > 0 {
+		if m.MapStringForeignMessage == nil {
+			m.MapStringForeignMessage = make(map[string]*ForeignMessageProto2, len(src.MapStringForeignMessage))
+		}
+		for k, v := range src.MapStringForeignMessage {
+			tmpMsg := &ForeignMessageProto2{}
+			tmpMsg.MergeVT(v)
+			m.MapStringForeignMessage[k] = tmpMsg
+		}
+	}
+	if len(src.MapStringNestedEnum) > 0 {
+		if m.MapStringNestedEnum == nil {
+			m.MapStringNestedEnum = make(map[string]TestAllTypesProto2_NestedEnum, len(src.MapStringNestedEnum))
+		}
+		for k, v := range src.MapStringNestedEnum {
+			m.MapStringNestedEnum[k] = v
+		}
+	}
+	if len(src.MapStringForeignEnum) > 0 {
+		if m.MapStringForeignEnum == nil {
+			m.MapStringForeignEnum = make(map[string]ForeignEnumProto2, len(src.MapStringForeignEnum))
+		}
+		for k, v := range src.MapStringForeignEnum {
+			m.MapStringForeignEnum[k] = v
+		}
+	}
+	m.PackedInt32 = append(m.PackedInt32, src.PackedInt32...)
+	m.PackedInt64 = append(m.PackedInt64, src.PackedInt64...)
+	m.PackedUint32 = append(m.PackedUint32, src.PackedUint32...)
+	m.PackedUint64 = append(m.PackedUint64, src.PackedUint64...)
+	m.PackedSint32 = append(m.PackedSint32, src.PackedSint32...)
+	m.PackedSint64 = append(m.PackedSint64, src.PackedSint64...)
+	m.PackedFixed32 = append(m.PackedFixed32, src.PackedFixed32...)
+	m.PackedFixed64 = append(m.PackedFixed64, src.PackedFixed64...)
+	m.PackedSfixed32 = append(m.PackedSfixed32, src.PackedSfixed32...)
+	m.PackedSfixed64 = append(m.PackedSfixed64, src.PackedSfixed64...)
+	m.PackedFloat = append(m.PackedFloat, src.PackedFloat...)
+	m.PackedDouble = append(m.PackedDouble, src.PackedDouble...)
+	m.PackedBool = append(m.PackedBool, src.PackedBool...)
+	m.PackedNestedEnum = append(m.PackedNestedEnum, src.PackedNestedEnum...)
+	m.UnpackedInt32 = append(m.UnpackedInt32, src.UnpackedInt32...)
+	m.UnpackedInt64 = append(m.UnpackedInt64, src.UnpackedInt64...)
+	m.UnpackedUint32 = append(m.UnpackedUint32, src.UnpackedUint32...)
+	m.UnpackedUint64 = append(m.UnpackedUint64, src.UnpackedUint64...)
+	m.UnpackedSint32 = append(m.UnpackedSint32, src.UnpackedSint32...)
+	m.UnpackedSint64 = append(m.UnpackedSint64, src.UnpackedSint64...)
+	m.UnpackedFixed32 = append(m.UnpackedFixed32, src.UnpackedFixed32...)
+	m.UnpackedFixed64 = append(m.UnpackedFixed64, src.UnpackedFixed64...)
+	m.UnpackedSfixed32 = append(m.UnpackedSfixed32, src.UnpackedSfixed32...)
+	m.UnpackedSfixed64 = append(m.UnpackedSfixed64, src.UnpackedSfixed64...)
+	m.UnpackedFloat = append(m.UnpackedFloat, src.UnpackedFloat...)
+	m.UnpackedDouble = append(m.UnpackedDouble, src.UnpackedDouble...)
+	m.UnpackedBool = append(m.UnpackedBool, src.UnpackedBool...)
+	m.UnpackedNestedEnum = append(m.UnpackedNestedEnum, src.UnpackedNestedEnum...)
+	switch srcOneof := src.OneofField.(type) {
+	case *TestAllTypesProto2_OneofUint32:
+		m.OneofField = &TestAllTypesProto2_OneofUint32{OneofUint32: srcOneof.OneofUint32}
+	case *TestAllTypesProto2_OneofNestedMessage:
+		if dstOneof, ok := m.OneofField.(*TestAllTypesProto2_OneofNestedMessage); ok && dstOneof.OneofNestedMessage != nil {
+			if srcOneof.OneofNestedMessage != nil {
+				dstOneof.OneofNestedMessage.MergeVT(srcOneof.OneofNestedMessage)
+			}
+		} else {
+			tmpMsg := &TestAllTypesProto2_NestedMessage{}
+			tmpMsg.MergeVT(srcOneof.OneofNestedMessage)
+			m.OneofField = &TestAllTypesProto2_OneofNestedMessage{OneofNestedMessage: tmpMsg}
+		}
+	case *TestAllTypesProto2_OneofString:
+		m.OneofField = &TestAllTypesProto2_OneofString{OneofString: srcOneof.OneofString}
+	case *TestAllTypesProto2_OneofBytes:
+		m.OneofField = &TestAllTypesProto2_OneofBytes{OneofBytes: append([]byte{}, srcOneof.OneofBytes...)}
+	case *TestAllTypesProto2_OneofBool:
+		m.OneofField = &TestAllTypesProto2_OneofBool{OneofBool: srcOneof.OneofBool}
+	case *TestAllTypesProto2_OneofUint64:
+		m.OneofField = &TestAllTypesProto2_OneofUint64{OneofUint64: srcOneof.OneofUint64}
+	case *TestAllTypesProto2_OneofFloat:
+		m.OneofField = &TestAllTypesProto2_OneofFloat{OneofFloat: srcOneof.OneofFloat}
+	case *TestAllTypesProto2_OneofDouble:
+		m.OneofField = &TestAllTypesProto2_OneofDouble{OneofDouble: srcOneof.OneofDouble}
+	case *TestAllTypesProto2_OneofEnum:
+		m.OneofField = &TestAllTypesProto2_OneofEnum{OneofEnum: srcOneof.OneofEnum}
+	}
+	if src.Data != nil {
+		if m.Data == nil {
+			m.Data = &TestAllTypesProto2_Data{}
+		}
+		m.Data.MergeVT(src.Data)
+	}
+	if src.DefaultInt32 != nil {
+		tmpVal := *src.DefaultInt32
+		m.DefaultInt32 = &tmpVal
+	}
+	if src.DefaultInt64 != nil {
+		tmpVal := *src.DefaultInt64
+		m.DefaultInt64 = &tmpVal
+	}
+	if src.DefaultUint32 != nil {
+		tmpVal := *src.DefaultUint32
+		m.DefaultUint32 = &tmpVal
+	}
+	if src.DefaultUint64 != nil {
+		tmpVal := *src.DefaultUint64
+		m.DefaultUint64 = &tmpVal
+	}
+	if src.DefaultSint32 != nil {
+		tmpVal := *src.DefaultSint32
+		m.DefaultSint32 = &tmpVal
+	}
+	if src.DefaultSint64 != nil {
+		tmpVal := *src.DefaultSint64
+		m.DefaultSint64 = &tmpVal
+	}
+	if src.DefaultFixed32 != nil {
+		tmpVal := *src.DefaultFixed32
+		m.DefaultFixed32 = &tmpVal
+	}
+	if src.DefaultFixed64 != nil {
+		tmpVal := *src.DefaultFixed64
+		m.DefaultFixed64 = &tmpVal
+	}
+	if src.DefaultSfixed32 != nil {
+		tmpVal := *src.DefaultSfixed32
+		m.DefaultSfixed32 = &tmpVal
+	}
+	if src.DefaultSfixed64 != nil {
+		tmpVal := *src.DefaultSfixed64
+		m.DefaultSfixed64 = &tmpVal
+	}
+	if src.DefaultFloat != nil {
+		tmpVal := *src.DefaultFloat
+		m.DefaultFloat = &tmpVal
+	}
+	if src.DefaultDouble != nil {
+		tmpVal := *src.DefaultDouble
+		m.DefaultDouble = &tmpVal
+	}
+	if src.DefaultBool != nil {
+		tmpVal := *src.DefaultBool
+		m.DefaultBool = &tmpVal
+	}
+	if src.DefaultString != nil {
+		tmpVal := *src.DefaultString
+		m.DefaultString = &tmpVal
+	}
+	if src.DefaultBytes != nil {
+		m.DefaultBytes = append([]byte{}, src.DefaultBytes...)
+	}
+	if src.Fieldname1 != nil {
+		tmpVal := *src.Fieldname1
+		m.Fieldname1 = &tmpVal
+	}
+	if src.FieldName2 != nil {
+		tmpVal := *src.FieldName2
+		m.FieldName2 = &tmpVal
+	}
+	if src.XFieldName3 != nil {
+		tmpVal := *src.XFieldName3
+		m.XFieldName3 = &tmpVal
+	}
+	if src.Field_Name4_ != nil {
+		tmpVal := *src.Field_Name4_
+		m.Field_Name4_ = &tmpVal
+	}
+	if src.Field0Name5 != nil {
+		tmpVal := *src.Field0Name5
+		m.Field0Name5 = &tmpVal
+	}
+	if src.Field_0Name6 != nil {
+		tmpVal := *src.Field_0Name6
+		m.Field_0Name6 = &tmpVal
+	}
+	if src.FieldName7 != nil {
+		tmpVal := *src.FieldName7
+		m.FieldName7 = &tmpVal
+	}
+	if src.FieldName8 != nil {
+		tmpVal := *src.FieldName8
+		m.FieldName8 = &tmpVal
+	}
+	if src.Field_Name9 != nil {
+		tmpVal := *src.Field_Name9
+		m.Field_Name9 = &tmpVal
+	}
+	if src.Field_Name10 != nil {
+		tmpVal := *src.Field_Name10
+		m.Field_Name10 = &tmpVal
+	}
+	if src.FIELD_NAME11 != nil {
+		tmpVal := *src.FIELD_NAME11
+		m.FIELD_NAME11 = &tmpVal
+	}
+	if src.FIELDName12 != nil {
+		tmpVal := *src.FIELDName12
+		m.FIELDName12 = &tmpVal
+	}
+	if src.XFieldName13 != nil {
+		tmpVal := *src.XFieldName13
+		m.XFieldName13 = &tmpVal
+	}
+	if src.X_FieldName14 != nil {
+		tmpVal := *src.X_FieldName14
+		m.X_FieldName14 = &tmpVal
+	}
+	if src.Field_Name15 != nil {
+		tmpVal := *src.Field_Name15
+		m.Field_Name15 = &tmpVal
+	}
+	if src.Field__Name16 != nil {
+		tmpVal := *src.Field__Name16
+		m.Field__Name16 = &tmpVal
+	}
+	if src.FieldName17__ != nil {
+		tmpVal := *src.FieldName17__
+		m.FieldName17__ = &tmpVal
+	}
+	if src.FieldName18__ != nil {
+		tmpVal := *src.FieldName18__
+		m.FieldName18__ = &tmpVal
+	}
+	for num, x := range src.extensionFields {
+		switch num {
+		case 120:
+			rhs := proto.GetExtension(src, E_ExtensionInt32).(int32)
+			proto.SetExtension(m, E_ExtensionInt32, rhs)
+		default:
+			protohelpers.MergeExtension(m.ProtoReflect(), x.Type(), x.Value())
+		}
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *TestAllTypesProto2) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*TestAllTypesProto2); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *ForeignMessageProto2) MergeVT(src *ForeignMessageProto2) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.C != nil {
+		tmpVal := *src.C
+		m.C = &tmpVal
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *ForeignMessageProto2) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*ForeignMessageProto2); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) MergeVT(src *UnknownToTestAllTypes_OptionalGroup) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.A != nil {
+		tmpVal := *src.A
+		m.A = &tmpVal
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*UnknownToTestAllTypes_OptionalGroup); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *UnknownToTestAllTypes) MergeVT(src *UnknownToTestAllTypes) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.OptionalInt32 != nil {
+		tmpVal := *src.OptionalInt32
+		m.OptionalInt32 = &tmpVal
+	}
+	if src.OptionalString != nil {
+		tmpVal := *src.OptionalString
+		m.OptionalString = &tmpVal
+	}
+	if src.NestedMessage != nil {
+		if m.NestedMessage == nil {
+			m.NestedMessage = &ForeignMessageProto2{}
+		}
+		m.NestedMessage.MergeVT(src.NestedMessage)
+	}
+	if src.Optionalgroup != nil {
+		if m.Optionalgroup == nil {
+			m.Optionalgroup = &UnknownToTestAllTypes_OptionalGroup{}
+		}
+		m.Optionalgroup.MergeVT(src.Optionalgroup)
+	}
+	if src.OptionalBool != nil {
+		tmpVal := *src.OptionalBool
+		m.OptionalBool = &tmpVal
+	}
+	m.RepeatedInt32 = append(m.RepeatedInt32, src.RepeatedInt32...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *UnknownToTestAllTypes) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*UnknownToTestAllTypes); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *NullHypothesisProto2) MergeVT(src *NullHypothesisProto2) {
+	if m == nil || src == nil {
+		return
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *NullHypothesisProto2) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*NullHypothesisProto2); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *EnumOnlyProto2) MergeVT(src *EnumOnlyProto2) {
+	if m == nil || src == nil {
+		return
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *EnumOnlyProto2) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*EnumOnlyProto2); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *OneStringProto2) MergeVT(src *OneStringProto2) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Data != nil {
+		tmpVal := *src.Data
+		m.Data = &tmpVal
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *OneStringProto2) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*OneStringProto2); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *TestAllTypesProto2_NestedMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.A != nil {
+		n += 1 + sov(uint64(*m.A))
+	}
+	if m.Corecursive != nil {
+		l = m.Corecursive.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *TestAllTypesProto2_Data) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupInt32 != nil {
+		n += 2 + sov(uint64(*m.GroupInt32))
+	}
+	if m.GroupUint32 != nil {
+		n += 2 + sov(uint64(*m.GroupUint32))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Str != nil {
+		l = len(*m.Str)
+		n += 2 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.I != nil {
+		n += 1 + sov(uint64(*m.I))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *TestAllTypesProto2) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OptionalInt32 != nil {
+		n += 1 + sov(uint64(*m.OptionalInt32))
+	}
+	if m.OptionalInt64 != nil {
+		n += 1 + sov(uint64(*m.OptionalInt64))
+	}
+	if m.OptionalUint32 != nil {
+		n += 1 + sov(uint64(*m.OptionalUint32))
+	}
+	if m.OptionalUint64 != nil {
+		n += 1 + sov(uint64(*m.OptionalUint64))
+	}
+	if m.OptionalSint32 != nil {
+		n += 1 + soz(uint64(*m.OptionalSint32))
+	}
+	if m.OptionalSint64 != nil {
+		n += 1 + soz(uint64(*m.OptionalSint64))
+	}
+	if m.OptionalFixed32 != nil {
+		n += 5
+	}
+	if m.OptionalFixed64 != nil {
+		n += 9
+	}
+	if m.OptionalSfixed32 != nil {
+		n += 5
+	}
+	if m.OptionalSfixed64 != nil {
+		n += 9
+	}
+	if m.OptionalFloat != nil {
+		n += 5
+	}
+	if m.OptionalDouble != nil {
+		n += 9
+	}
+	if m.OptionalBool != nil {
+		n += 2
+	}
+	if m.OptionalString != nil {
+		l = len(*m.OptionalString)
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.OptionalBytes != nil {
+		l = len(m.OptionalBytes)
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.OptionalNestedMessage != nil {
+		l = m.OptionalNestedMessage.SizeVT()
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.OptionalForeignMessage != nil {
+		l = m.OptionalForeignMessage.SizeVT()
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.OptionalNestedEnum != nil {
+		n += 2 + sov(uint64(*m.OptionalNestedEnum))
+	}
+	if m.OptionalForeignEnum != nil {
+		n += 2 + sov(uint64(*m.OptionalForeignEnum))
+	}
+	if m.OptionalStringPiece != nil {
+		l = len(*m.OptionalStringPiece)
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.OptionalCord != nil {
+		l = len(*m.OptionalCord)
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.RecursiveMessage != nil {
+		l = m.RecursiveMessage.SizeVT()
+		n += 2 + l + sov(uint64(l))
+	}
+	if len(m.RepeatedInt32) > 0 {
+		for _, e := range m.RepeatedInt32 {
+			n += 2 + sov(uint64(e))
+		}
+	}
+	if len(m.RepeatedInt64) > 0 {
+		for _, e := range m.RepeatedInt64 {
+			n += 2 + sov(uint64(e))
+		}
+	}
+	if len(m.RepeatedUint32) > 0 {
+		for _, e := range m.RepeatedUint32 {
+			n += 2 + sov(uint64(e))
+		}
+	}
+	if len(m.RepeatedUint64) > 0 {
+		for _, e := range m.RepeatedUint64 {
+			n += 2 + sov(uint64(e))
+		}
+	}
+	if len(m.RepeatedSint32) > 0 {
+		for _, e := range m.RepeatedSint32 {
+			n += 2 + soz(uint64(e))
+		}
+	}
+	if len(m.RepeatedSint64) > 0 {
+		for _, e := range m.RepeatedSint64 {
+			n += 2 + soz(uint64(e))
+		}
+	}
+	if len(m.RepeatedFixed32) > 0 {
+		n += 6 * len(m.RepeatedFixed32)
+	}
+	if len(m.RepeatedFixed64) > 0 {
+		n += 10 * len(m.RepeatedFixed64)
+	}
+	if len(m.RepeatedSfixed32) > 0 {
+		n += 6 * len(m.RepeatedSfixed32)
+	}
+	if len(m.RepeatedSfixed64) > 0 {
+		n += 10 * len(m.RepeatedSfixed64)
+	}
+	if len(m.RepeatedFloat) > 0 {
+		n += 6 * len(m.RepeatedFloat)
+	}
+	if len(m.RepeatedDouble) > 0 {
+		n += 10 * len(m.RepeatedDouble)
+	}
+	if len(m.RepeatedBool) > 0 {
+		n += 3 * len(m.RepeatedBool)
+	}
+	if len(m.RepeatedString) > 0 {
+		for _, s := range m.RepeatedString {
+			l = len(s)
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedBytes) > 0 {
+		for _, b := range m.RepeatedBytes {
+			l = len(b)
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedNestedMessage) > 0 {
+		for _, e := range m.RepeatedNestedMessage {
+			l = e.SizeVT()
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedForeignMessage) > 0 {
+		for _, e := range m.RepeatedForeignMessage {
+			l = e.SizeVT()
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedNestedEnum) > 0 {
+		for _, e := range m.RepeatedNestedEnum {
+			n += 2 + sov(uint64(e))
+		}
+	}
+	if len(m.RepeatedForeignEnum) > 0 {
+		for _, e := range m.RepeatedForeignEnum {
+			n += 2 + sov(uint64(e))
+		}
+	}
+	if len(m.RepeatedStringPiece) > 0 {
+		for _, s := range m.RepeatedStringPiece {
+			l = len(s)
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedCord) > 0 {
+		for _, s := range m.RepeatedCord {
+			l = len(s)
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.MapInt32Int32) > 0 {
+		for k, v := range m.MapInt32Int32 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sov(uint64(k)) + 1 + sov(uint64(v))
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapInt64Int64) > 0 {
+		for k, v := range m.MapInt64Int64 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sov(uint64(k)) + 1 + sov(uint64(v))
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapUint32Uint32) > 0 {
+		for k, v := range m.MapUint32Uint32 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sov(uint64(k)) + 1 + sov(uint64(v))
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapUint64Uint64) > 0 {
+		for k, v := range m.MapUint64Uint64 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sov(uint64(k)) + 1 + sov(uint64(v))
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapSint32Sint32) > 0 {
+		for k, v := range m.MapSint32Sint32 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + soz(uint64(k)) + 1 + soz(uint64(v))
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapSint64Sint64) > 0 {
+		for k, v := range m.MapSint64Sint64 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + soz(uint64(k)) + 1 + soz(uint64(v))
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapFixed32Fixed32) > 0 {
+		for k, v := range m.MapFixed32Fixed32 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + 4
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapFixed64Fixed64) > 0 {
+		for k, v := range m.MapFixed64Fixed64 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + 8
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapSfixed32Sfixed32) > 0 {
+		for k, v := range m.MapSfixed32Sfixed32 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + 4
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapSfixed64Sfixed64) > 0 {
+		for k, v := range m.MapSfixed64Sfixed64 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + 8
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapInt32Float) > 0 {
+		for k, v := range m.MapInt32Float {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sov(uint64(k)) + 1 + 4
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapInt32Double) > 0 {
+		for k, v := range m.MapInt32Double {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sov(uint64(k)) + 1 + 8
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapBoolBool) > 0 {
+		for k, v := range m.MapBoolBool {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 1 + 1 + 1
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapStringString) > 0 {
+		for k, v := range m.MapStringString {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + len(v) + sov(uint64(len(v)))
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapStringBytes) > 0 {
+		for k, v := range m.MapStringBytes {
+			_ = k
+			_ = v
+			l = 1 + len(v) + sov(uint64(len(v)))
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapStringNestedMessage) > 0 {
+		for k, v := range m.MapStringNestedMessage {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.SizeVT()
+			}
+			l += 1 + sov(uint64(l))
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapStringForeignMessage) > 0 {
+		for k, v := range m.MapStringForeignMessage {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.SizeVT()
+			}
+			l += 1 + sov(uint64(l))
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapStringNestedEnum) > 0 {
+		for k, v := range m.MapStringNestedEnum {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + sov(uint64(v))
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapStringForeignEnum) > 0 {
+		for k, v := range m.MapStringForeignEnum {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + sov(uint64(v))
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.PackedInt32) > 0 {
+		l = 0
+		for _, e := range m.PackedInt32 {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.PackedInt64) > 0 {
+		l = 0
+		for _, e := range m.PackedInt64 {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.PackedUint32) > 0 {
+		l = 0
+		for _, e := range m.PackedUint32 {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.PackedUint64) > 0 {
+		l = 0
+		for _, e := range m.PackedUint64 {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.PackedSint32) > 0 {
+		l = 0
+		for _, e := range m.PackedSint32 {
+			l += soz(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.PackedSint64) > 0 {
+		l = 0
+		for _, e := range m.PackedSint64 {
+			l += soz(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.PackedFixed32) > 0 {
+		n += 2 + sov(uint64(len(m.PackedFixed32)*4)) + len(m.PackedFixed32)*4
+	}
+	if len(m.PackedFixed64) > 0 {
+		n += 2 + sov(uint64(len(m.PackedFixed64)*8)) + len(m.PackedFixed64)*8
+	}
+	if len(m.PackedSfixed32) > 0 {
+		n += 2 + sov(uint64(len(m.PackedSfixed32)*4)) + len(m.PackedSfixed32)*4
+	}
+	if len(m.PackedSfixed64) > 0 {
+		n += 2 + sov(uint64(len(m.PackedSfixed64)*8)) + len(m.PackedSfixed64)*8
+	}
+	if len(m.PackedFloat) > 0 {
+		n += 2 + sov(uint64(len(m.PackedFloat)*4)) + len(m.PackedFloat)*4
+	}
+	if len(m.PackedDouble) > 0 {
+		n += 2 + sov(uint64(len(m.PackedDouble)*8)) + len(m.PackedDouble)*8
+	}
+	if len(m.PackedBool) > 0 {
+		n += 2 + sov(uint64(len(m.PackedBool))) + len(m.PackedBool)*1
+	}
+	if len(m.PackedNestedEnum) > 0 {
+		l = 0
+		for _, e := range m.PackedNestedEnum {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.UnpackedInt32) > 0 {
+		for _, e := range m.UnpackedInt32 {
+			n += 2 + sov(uint64(e))
+		}
+	}
+	if len(m.UnpackedInt64) > 0 {
+		for _, e := range m.UnpackedInt64 {
+			n += 2 + sov(uint64(e))
+		}
+	}
+	if len(m.UnpackedUint32) > 0 {
+		for _, e := range m.UnpackedUint32 {
+			n += 2 + sov(uint64(e))
+		}
+	}
+	if len(m.UnpackedUint64) > 0 {
+		for _, e := range m.UnpackedUint64 {
+			n += 2 + sov(uint64(e))
+		}
+	}
+	if len(m.UnpackedSint32) > 0 {
+		for _, e := range m.UnpackedSint32 {
+			n += 2 + soz(uint64(e))
+		}
+	}
+	if len(m.UnpackedSint64) > 0 {
+		for _, e := range m.UnpackedSint64 {
+			n += 2 + soz(uint64(e))
+		}
+	}
+	if len(m.UnpackedFixed32) > 0 {
+		n += 6 * len(m.UnpackedFixed32)
+	}
+	if len(m.UnpackedFixed64) > 0 {
+		n += 10 * len(m.UnpackedFixed64)
+	}
+	if len(m.UnpackedSfixed32) > 0 {
+		n += 6 * len(m.UnpackedSfixed32)
+	}
+	if len(m.UnpackedSfixed64) > 0 {
+		n += 10 * len(m.UnpackedSfixed64)
+	}
+	if len(m.UnpackedFloat) > 0 {
+		n += 6 * len(m.UnpackedFloat)
+	}
+	if len(m.UnpackedDouble) > 0 {
+		n += 10 * len(m.UnpackedDouble)
+	}
+	if len(m.UnpackedBool) > 0 {
+		n += 3 * len(m.UnpackedBool)
+	}
+	if len(m.UnpackedNestedEnum) > 0 {
+		for _, e := range m.UnpackedNestedEnum {
+			n += 2 + sov(uint64(e))
+		}
+	}
+	if vtmsg, ok := m.OneofField.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	if m.Data != nil {
+		l = m.Data.SizeVT()
+		n += l + 4
+	}
+	if m.DefaultInt32 != nil {
+		n += 2 + sov(uint64(*m.DefaultInt32))
+	}
+	if m.DefaultInt64 != nil {
+		n += 2 + sov(uint64(*m.DefaultInt64))
+	}
+	if m.DefaultUint32 != nil {
+		n += 2 + sov(uint64(*m.DefaultUint32))
+	}
+	if m.DefaultUint64 != nil {
+		n += 2 + sov(uint64(*m.DefaultUint64))
+	}
+	if m.DefaultSint32 != nil {
+		n += 2 + soz(uint64(*m.DefaultSint32))
+	}
+	if m.DefaultSint64 != nil {
+		n += 2 + soz(uint64(*m.DefaultSint64))
+	}
+	if m.DefaultFixed32 != nil {
+		n += 6
+	}
+	if m.DefaultFixed64 != nil {
+		n += 10
+	}
+	if m.DefaultSfixed32 != nil {
+		n += 6
+	}
+	if m.DefaultSfixed64 != nil {
+		n += 10
+	}
+	if m.DefaultFloat != nil {
+		n += 6
+	}
+	if m.DefaultDouble != nil {
+		n += 10
+	}
+	if m.DefaultBool != nil {
+		n += 3
+	}
+	if m.DefaultString != nil {
+		l = len(*m.DefaultString)
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.DefaultBytes != nil {
+		l = len(m.DefaultBytes)
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.Fieldname1 != nil {
+		n += 2 + sov(uint64(*m.Fieldname1))
+	}
+	if m.FieldName2 != nil {
+		n += 2 + sov(uint64(*m.FieldName2))
+	}
+	if m.XFieldName3 != nil {
+		n += 2 + sov(uint64(*m.XFieldName3))
+	}
+	if m.Field_Name4_ != nil {
+		n += 2 + sov(uint64(*m.Field_Name4_))
+	}
+	if m.Field0Name5 != nil {
+		n += 2 + sov(uint64(*m.Field0Name5))
+	}
+	if m.Field_0Name6 != nil {
+		n += 2 + sov(uint64(*m.Field_0Name6))
+	}
+	if m.FieldName7 != nil {
+		n += 2 + sov(uint64(*m.FieldName7))
+	}
+	if m.FieldName8 != nil {
+		n += 2 + sov(uint64(*m.FieldName8))
+	}
+	if m.Field_Name9 != nil {
+		n += 2 + sov(uint64(*m.Field_Name9))
+	}
+	if m.Field_Name10 != nil {
+		n += 2 + sov(uint64(*m.Field_Name10))
+	}
+	if m.FIELD_NAME11 != nil {
+		n += 2 + sov(uint64(*m.FIELD_NAME11))
+	}
+	if m.FIELDName12 != nil {
+		n += 2 + sov(uint64(*m.FIELDName12))
+	}
+	if m.XFieldName13 != nil {
+		n += 2 + sov(uint64(*m.XFieldName13))
+	}
+	if m.X_FieldName14 != nil {
+		n += 2 + sov(uint64(*m.X_FieldName14))
+	}
+	if m.Field_Name15 != nil {
+		n += 2 + sov(uint64(*m.Field_Name15))
+	}
+	if m.Field__Name16 != nil {
+		n += 2 + sov(uint64(*m.Field__Name16))
+	}
+	if m.FieldName17__ != nil {
+		n += 2 + sov(uint64(*m.FieldName17__))
+	}
+	if m.FieldName18__ != nil {
+		n += 2 + sov(uint64(*m.FieldName18__))
+	}
+	for num, x := range m.extensionFields {
+		switch num {
+		case 120:
+			v := proto.GetExtension(m, E_ExtensionInt32).(int32)
+			n += 2 + sov(uint64(v))
+		default:
+			n += protohelpers.SizeOfExtension(x.Type(), x.Value())
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *TestAllTypesProto2_OneofUint32) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2 + sov(uint64(m.OneofUint32))
+	return n
+}
+func (m *TestAllTypesProto2_OneofNestedMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OneofNestedMessage != nil {
+		l = m.OneofNestedMessage.SizeVT()
+		n += 2 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *TestAllTypesProto2_OneofString) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OneofString)
+	n += 2 + l + sov(uint64(l))
+	return n
+}
+func (m *TestAllTypesProto2_OneofBytes) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OneofBytes)
+	n += 2 + l + sov(uint64(l))
+	return n
+}
+func (m *TestAllTypesProto2_OneofBool) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 3
+	return n
+}
+func (m *TestAllTypesProto2_OneofUint64) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2 + sov(uint64(m.OneofUint64))
+	return n
+}
+func (m *TestAllTypesProto2_OneofFloat) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 6
+	return n
+}
+func (m *TestAllTypesProto2_OneofDouble) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 10
+	return n
+}
+func (m *TestAllTypesProto2_OneofEnum) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2 + sov(uint64(m.OneofEnum))
+	return n
+}
+func (m *ForeignMessageProto2) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.C != nil {
+		n += 1 + sov(uint64(*m.C))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.A != nil {
+		n += 1 + sov(uint64(*m.A))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *UnknownToTestAllTypes) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OptionalInt32 != nil {
+		n += 2 + sov(uint64(*m.OptionalInt32))
+	}
+	if m.OptionalString != nil {
+		l = len(*m.OptionalString)
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.NestedMessage != nil {
+		l = m.NestedMessage.SizeVT()
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.Optionalgroup != nil {
+		l = m.Optionalgroup.SizeVT()
+		n += l + 4
+	}
+	if m.OptionalBool != nil {
+		n += 3
+	}
+	if len(m.RepeatedInt32) > 0 {
+		for _, e := range m.RepeatedInt32 {
+			n += 2 + sov(uint64(e))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *NullHypothesisProto2) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *EnumOnlyProto2) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *OneStringProto2) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = len(*m.Data)
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func sov(x uint64) (n int) {
+	return (bits.Len64(x|1) + 6) / 7
+}
+func soz(x uint64) (n int) {
+	return sov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TestAllTypesProto2_NestedMessage) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *TestAllTypesProto2_NestedMessage) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *TestAllTypesProto2_NestedMessage) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *TestAllTypesProto2_NestedMessage) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*TestAllTypesProto2_NestedMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TestAllTypesProto2_NestedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TestAllTypesProto2_NestedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.A = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corecursive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Corecursive == nil {
+				m.Corecursive = &TestAllTypesProto2{}
+			}
+			if err := m.Corecursive.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TestAllTypesProto2_Data) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *TestAllTypesProto2_Data) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *TestAllTypesProto2_Data) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *TestAllTypesProto2_Data) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*TestAllTypesProto2_Data)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TestAllTypesProto2_Data: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TestAllTypesProto2_Data: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 202:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupInt32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GroupInt32 = &v
+		case 203:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupUint32", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GroupUint32 = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TestAllTypesProto2_MessageSetCorrect) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*TestAllTypesProto2_MessageSetCorrect)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TestAllTypesProto2_MessageSetCorrect: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TestAllTypesProto2_MessageSetCorrect: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*TestAllTypesProto2_MessageSetCorrectExtension1)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TestAllTypesProto2_MessageSetCorrectExtension1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TestAllTypesProto2_MessageSetCorrectExtension1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Str", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(string(dAtA[iNdEx:postIndex]))
+			m.Str = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*TestAllTypesProto2_MessageSetCorrectExtension2)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TestAllTypesProto2_MessageSetCorrectExtension2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TestAllTypesProto2_MessageSetCorrectExtension2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field I", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.I = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *TestAllTypesProto2) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *TestAllTypesProto2) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *TestAllTypesProto2) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*TestAllTypesProto2)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TestAllTypesProto2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TestAllTypesProto2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalInt32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalInt32 = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalInt64", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalInt64 = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalUint32", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalUint32 = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalUint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalUint64 = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSint32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.OptionalSint32 = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			v2 := int64(v)
+			m.OptionalSint64 = &v2
+		case 7:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalFixed32", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.OptionalFixed32 = &v
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalFixed64", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.OptionalFixed64 = &v
+		case 9:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSfixed32", wireType)
+			}
+			var v int32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.OptionalSfixed32 = &v
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSfixed64", wireType)
+			}
+			var v int64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.OptionalSfixed64 = &v
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalFloat", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			v2 := float32(math.Float32frombits(v))
+			m.OptionalFloat = &v2
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalDouble", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.OptionalDouble = &v2
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalBool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.OptionalBool = &b
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(string(dAtA[iNdEx:postIndex]))
+			m.OptionalString = &s
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionalBytes = append(m.OptionalBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.OptionalBytes == nil {
+				m.OptionalBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalNestedMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalNestedMessage == nil {
+				m.OptionalNestedMessage = &TestAllTypesProto2_NestedMessage{}
+			}
+			if err := m.OptionalNestedMessage.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalForeignMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalForeignMessage == nil {
+				m.OptionalForeignMessage = &ForeignMessageProto2{}
+			}
+			if err := m.OptionalForeignMessage.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalNestedEnum", wireType)
+			}
+			var v TestAllTypesProto2_NestedEnum
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= TestAllTypesProto2_NestedEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalNestedEnum = &v
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalForeignEnum", wireType)
+			}
+			var v ForeignEnumProto2
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= ForeignEnumProto2(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalForeignEnum = &v
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalStringPiece", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(string(dAtA[iNdEx:postIndex]))
+			m.OptionalStringPiece = &s
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalCord", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(string(dAtA[iNdEx:postIndex]))
+			m.OptionalCord = &s
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecursiveMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RecursiveMessage == nil {
+				m.RecursiveMessage = &TestAllTypesProto2{}
+			}
+			if err := m.RecursiveMessage.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedInt32 = append(m.RepeatedInt32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedInt32) == 0 {
+					m.RepeatedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedInt32 = append(m.RepeatedInt32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt32", wireType)
+			}
+		case 32:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedInt64 = append(m.RepeatedInt64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedInt64) == 0 {
+					m.RepeatedInt64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedInt64 = append(m.RepeatedInt64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt64", wireType)
+			}
+		case 33:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedUint32 = append(m.RepeatedUint32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedUint32) == 0 {
+					m.RepeatedUint32 = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedUint32 = append(m.RepeatedUint32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint32", wireType)
+			}
+		case 34:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedUint64 = append(m.RepeatedUint64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedUint64) == 0 {
+					m.RepeatedUint64 = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedUint64 = append(m.RepeatedUint64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint64", wireType)
+			}
+		case 35:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				m.RepeatedSint32 = append(m.RepeatedSint32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedSint32) == 0 {
+					m.RepeatedSint32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+					m.RepeatedSint32 = append(m.RepeatedSint32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedSint32", wireType)
+			}
+		case 36:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				m.RepeatedSint64 = append(m.RepeatedSint64, int64(v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedSint64) == 0 {
+					m.RepeatedSint64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					m.RepeatedSint64 = append(m.RepeatedSint64, int64(v))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedSint64", wireType)
+			}
+		case 37:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.RepeatedFixed32 = append(m.RepeatedFixed32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.RepeatedFixed32) == 0 {
+					m.RepeatedFixed32 = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.RepeatedFixed32 = append(m.RepeatedFixed32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedFixed32", wireType)
+			}
+		case 38:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.RepeatedFixed64 = append(m.RepeatedFixed64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.RepeatedFixed64) == 0 {
+					m.RepeatedFixed64 = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.RepeatedFixed64 = append(m.RepeatedFixed64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedFixed64", wireType)
+			}
+		case 39:
+			if wireType == 5 {
+				var v int32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.RepeatedSfixed32 = append(m.RepeatedSfixed32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.RepeatedSfixed32) == 0 {
+					m.RepeatedSfixed32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.RepeatedSfixed32 = append(m.RepeatedSfixed32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedSfixed32", wireType)
+			}
+		case 40:
+			if wireType == 1 {
+				var v int64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.RepeatedSfixed64 = append(m.RepeatedSfixed64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.RepeatedSfixed64) == 0 {
+					m.RepeatedSfixed64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.RepeatedSfixed64 = append(m.RepeatedSfixed64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedSfixed64", wireType)
+			}
+		case 41:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				m.RepeatedFloat = append(m.RepeatedFloat, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.RepeatedFloat) == 0 {
+					m.RepeatedFloat = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					v2 := float32(math.Float32frombits(v))
+					m.RepeatedFloat = append(m.RepeatedFloat, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedFloat", wireType)
+			}
+		case 42:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.RepeatedDouble = append(m.RepeatedDouble, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.RepeatedDouble) == 0 {
+					m.RepeatedDouble = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.RepeatedDouble = append(m.RepeatedDouble, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedDouble", wireType)
+			}
+		case 43:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedBool = append(m.RepeatedBool, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.RepeatedBool) == 0 {
+					m.RepeatedBool = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedBool = append(m.RepeatedBool, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedBool", wireType)
+			}
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedString = append(m.RepeatedString, string(string(dAtA[iNdEx:postIndex])))
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedBytes = append(m.RepeatedBytes, make([]byte, postIndex-iNdEx))
+			copy(m.RepeatedBytes[len(m.RepeatedBytes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedNestedMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedNestedMessage = append(m.RepeatedNestedMessage, &TestAllTypesProto2_NestedMessage{})
+			if err := m.RepeatedNestedMessage[len(m.RepeatedNestedMessage)-1].unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedForeignMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedForeignMessage = append(m.RepeatedForeignMessage, &ForeignMessageProto2{})
+			if err := m.RepeatedForeignMessage[len(m.RepeatedForeignMessage)-1].unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 51:
+			if wireType == 0 {
+				var v TestAllTypesProto2_NestedEnum
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= TestAllTypesProto2_NestedEnum(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedNestedEnum = append(m.RepeatedNestedEnum, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.RepeatedNestedEnum) == 0 {
+					m.RepeatedNestedEnum = make([]TestAllTypesProto2_NestedEnum, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v TestAllTypesProto2_NestedEnum
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= TestAllTypesProto2_NestedEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedNestedEnum = append(m.RepeatedNestedEnum, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedNestedEnum", wireType)
+			}
+		case 52:
+			if wireType == 0 {
+				var v ForeignEnumProto2
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ForeignEnumProto2(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedForeignEnum = append(m.RepeatedForeignEnum, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.RepeatedForeignEnum) == 0 {
+					m.RepeatedForeignEnum = make([]ForeignEnumProto2, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ForeignEnumProto2
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ForeignEnumProto2(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedForeignEnum = append(m.RepeatedForeignEnum, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedForeignEnum", wireType)
+			}
+		case 54:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedStringPiece", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedStringPiece = append(m.RepeatedStringPiece, string(string(dAtA[iNdEx:postIndex])))
+			iNdEx = postIndex
+		case 55:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedCord", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedCord = append(m.RepeatedCord, string(string(dAtA[iNdEx:postIndex])))
+			iNdEx = postIndex
+		case 56:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapInt32Int32", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapInt32Int32 == nil {
+				m.MapInt32Int32 = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapInt32Int32[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 57:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapInt64Int64", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapInt64Int64 == nil {
+				m.MapInt64Int64 = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapInt64Int64[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapUint32Uint32", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapUint32Uint32 == nil {
+				m.MapUint32Uint32 = make(map[uint32]uint32)
+			}
+			var mapkey uint32
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapUint32Uint32[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapUint64Uint64", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapUint64Uint64 == nil {
+				m.MapUint64Uint64 = make(map[uint64]uint64)
+			}
+			var mapkey uint64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapUint64Uint64[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 60:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapSint32Sint32", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapSint32Sint32 == nil {
+				m.MapSint32Sint32 = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapvaluetemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = int32((uint32(mapvaluetemp) >> 1) ^ uint32(((mapvaluetemp&1)<<31)>>31))
+					mapvalue = int32(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapSint32Sint32[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 61:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapSint64Sint64", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapSint64Sint64 == nil {
+				m.MapSint64Sint64 = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = (mapvaluetemp >> 1) ^ uint64((int64(mapvaluetemp&1)<<63)>>63)
+					mapvalue = int64(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapSint64Sint64[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 62:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapFixed32Fixed32", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapFixed32Fixed32 == nil {
+				m.MapFixed32Fixed32 = make(map[uint32]uint32)
+			}
+			var mapkey uint32
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapFixed32Fixed32[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 63:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapFixed64Fixed64", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapFixed64Fixed64 == nil {
+				m.MapFixed64Fixed64 = make(map[uint64]uint64)
+			}
+			var mapkey uint64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapFixed64Fixed64[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 64:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapSfixed32Sfixed32", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapSfixed32Sfixed32 == nil {
+				m.MapSfixed32Sfixed32 = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapSfixed32Sfixed32[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 65:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapSfixed64Sfixed64", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapSfixed64Sfixed64 == nil {
+				m.MapSfixed64Sfixed64 = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapSfixed64Sfixed64[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 66:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapInt32Float", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapInt32Float == nil {
+				m.MapInt32Float = make(map[int32]float32)
+			}
+			var mapkey int32
+			var mapvalue float32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					mapvalue = math.Float32frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapInt32Float[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 67:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapInt32Double", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapInt32Double == nil {
+				m.MapInt32Double = make(map[int32]float64)
+			}
+			var mapkey int32
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapInt32Double[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 68:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapBoolBool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapBoolBool == nil {
+				m.MapBoolBool = make(map[bool]bool)
+			}
+			var mapkey bool
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkey = bool(mapkeytemp != 0)
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapBoolBool[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 69:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapStringString", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapStringString == nil {
+				m.MapStringString = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapStringString[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 70:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapStringBytes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapStringBytes == nil {
+				m.MapStringBytes = make(map[string][]byte)
+			}
+			var mapkey string
+			var mapvalue []byte
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLength
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapStringBytes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 71:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapStringNestedMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapStringNestedMessage == nil {
+				m.MapStringNestedMessage = make(map[string]*TestAllTypesProto2_NestedMessage)
+			}
+			var mapkey string
+			var mapvalue *TestAllTypesProto2_NestedMessage
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &TestAllTypesProto2_NestedMessage{}
+					if err := mapvalue.unmarshalVT(dAtA[iNdEx:postmsgIndex], opts, depth); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapStringNestedMessage[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 72:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapStringForeignMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapStringForeignMessage == nil {
+				m.MapStringForeignMessage = make(map[string]*ForeignMessageProto2)
+			}
+			var mapkey string
+			var mapvalue *ForeignMessageProto2
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ForeignMessageProto2{}
+					if err := mapvalue.unmarshalVT(dAtA[iNdEx:postmsgIndex], opts, depth); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapStringForeignMessage[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 73:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapStringNestedEnum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapStringNestedEnum == nil {
+				m.MapStringNestedEnum = make(map[string]TestAllTypesProto2_NestedEnum)
+			}
+			var mapkey string
+			var mapvalue TestAllTypesProto2_NestedEnum
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= TestAllTypesProto2_NestedEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapStringNestedEnum[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 74:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapStringForeignEnum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapStringForeignEnum == nil {
+				m.MapStringForeignEnum = make(map[string]ForeignEnumProto2)
+			}
+			var mapkey string
+			var mapvalue ForeignEnumProto2
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= ForeignEnumProto2(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapStringForeignEnum[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 75:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedInt32 = append(m.PackedInt32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedInt32) == 0 {
+					m.PackedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PackedInt32 = append(m.PackedInt32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedInt32", wireType)
+			}
+		case 76:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedInt64 = append(m.PackedInt64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedInt64) == 0 {
+					m.PackedInt64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PackedInt64 = append(m.PackedInt64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedInt64", wireType)
+			}
+		case 77:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedUint32 = append(m.PackedUint32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedUint32) == 0 {
+					m.PackedUint32 = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PackedUint32 = append(m.PackedUint32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedUint32", wireType)
+			}
+		case 78:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedUint64 = append(m.PackedUint64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedUint64) == 0 {
+					m.PackedUint64 = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PackedUint64 = append(m.PackedUint64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedUint64", wireType)
+			}
+		case 79:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				m.PackedSint32 = append(m.PackedSint32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedSint32) == 0 {
+					m.PackedSint32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+					m.PackedSint32 = append(m.PackedSint32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedSint32", wireType)
+			}
+		case 80:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				m.PackedSint64 = append(m.PackedSint64, int64(v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedSint64) == 0 {
+					m.PackedSint64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					m.PackedSint64 = append(m.PackedSint64, int64(v))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedSint64", wireType)
+			}
+		case 81:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.PackedFixed32 = append(m.PackedFixed32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.PackedFixed32) == 0 {
+					m.PackedFixed32 = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.PackedFixed32 = append(m.PackedFixed32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedFixed32", wireType)
+			}
+		case 82:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.PackedFixed64 = append(m.PackedFixed64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.PackedFixed64) == 0 {
+					m.PackedFixed64 = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.PackedFixed64 = append(m.PackedFixed64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedFixed64", wireType)
+			}
+		case 83:
+			if wireType == 5 {
+				var v int32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.PackedSfixed32 = append(m.PackedSfixed32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.PackedSfixed32) == 0 {
+					m.PackedSfixed32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.PackedSfixed32 = append(m.PackedSfixed32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedSfixed32", wireType)
+			}
+		case 84:
+			if wireType == 1 {
+				var v int64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.PackedSfixed64 = append(m.PackedSfixed64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.PackedSfixed64) == 0 {
+					m.PackedSfixed64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.PackedSfixed64 = append(m.PackedSfixed64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedSfixed64", wireType)
+			}
+		case 85:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				m.PackedFloat = append(m.PackedFloat, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.PackedFloat) == 0 {
+					m.PackedFloat = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					v2 := float32(math.Float32frombits(v))
+					m.PackedFloat = append(m.PackedFloat, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedFloat", wireType)
+			}
+		case 86:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.PackedDouble = append(m.PackedDouble, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.PackedDouble) == 0 {
+					m.PackedDouble = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.PackedDouble = append(m.PackedDouble, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedDouble", wireType)
+			}
+		case 87:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedBool = append(m.PackedBool, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.PackedBool) == 0 {
+					m.PackedBool = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PackedBool = append(m.PackedBool, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedBool", wireType)
+			}
+		case 88:
+			if wireType == 0 {
+				var v TestAllTypesProto2_NestedEnum
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= TestAllTypesProto2_NestedEnum(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedNestedEnum = append(m.PackedNestedEnum, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.PackedNestedEnum) == 0 {
+					m.PackedNestedEnum = make([]TestAllTypesProto2_NestedEnum, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v TestAllTypesProto2_NestedEnum
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= TestAllTypesProto2_NestedEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PackedNestedEnum = append(m.PackedNestedEnum, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedNestedEnum", wireType)
+			}
+		case 89:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnpackedInt32 = append(m.UnpackedInt32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnpackedInt32) == 0 {
+					m.UnpackedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnpackedInt32 = append(m.UnpackedInt32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedInt32", wireType)
+			}
+		case 90:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnpackedInt64 = append(m.UnpackedInt64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnpackedInt64) == 0 {
+					m.UnpackedInt64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnpackedInt64 = append(m.UnpackedInt64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedInt64", wireType)
+			}
+		case 91:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnpackedUint32 = append(m.UnpackedUint32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnpackedUint32) == 0 {
+					m.UnpackedUint32 = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnpackedUint32 = append(m.UnpackedUint32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedUint32", wireType)
+			}
+		case 92:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnpackedUint64 = append(m.UnpackedUint64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnpackedUint64) == 0 {
+					m.UnpackedUint64 = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnpackedUint64 = append(m.UnpackedUint64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedUint64", wireType)
+			}
+		case 93:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				m.UnpackedSint32 = append(m.UnpackedSint32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnpackedSint32) == 0 {
+					m.UnpackedSint32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+					m.UnpackedSint32 = append(m.UnpackedSint32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedSint32", wireType)
+			}
+		case 94:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				m.UnpackedSint64 = append(m.UnpackedSint64, int64(v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnpackedSint64) == 0 {
+					m.UnpackedSint64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					m.UnpackedSint64 = append(m.UnpackedSint64, int64(v))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedSint64", wireType)
+			}
+		case 95:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.UnpackedFixed32 = append(m.UnpackedFixed32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.UnpackedFixed32) == 0 {
+					m.UnpackedFixed32 = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.UnpackedFixed32 = append(m.UnpackedFixed32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedFixed32", wireType)
+			}
+		case 96:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.UnpackedFixed64 = append(m.UnpackedFixed64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.UnpackedFixed64) == 0 {
+					m.UnpackedFixed64 = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.UnpackedFixed64 = append(m.UnpackedFixed64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedFixed64", wireType)
+			}
+		case 97:
+			if wireType == 5 {
+				var v int32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.UnpackedSfixed32 = append(m.UnpackedSfixed32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.UnpackedSfixed32) == 0 {
+					m.UnpackedSfixed32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.UnpackedSfixed32 = append(m.UnpackedSfixed32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedSfixed32", wireType)
+			}
+		case 98:
+			if wireType == 1 {
+				var v int64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.UnpackedSfixed64 = append(m.UnpackedSfixed64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.UnpackedSfixed64) == 0 {
+					m.UnpackedSfixed64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.UnpackedSfixed64 = append(m.UnpackedSfixed64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedSfixed64", wireType)
+			}
+		case 99:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				m.UnpackedFloat = append(m.UnpackedFloat, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.UnpackedFloat) == 0 {
+					m.UnpackedFloat = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					v2 := float32(math.Float32frombits(v))
+					m.UnpackedFloat = append(m.UnpackedFloat, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedFloat", wireType)
+			}
+		case 100:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.UnpackedDouble = append(m.UnpackedDouble, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.UnpackedDouble) == 0 {
+					m.UnpackedDouble = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.UnpackedDouble = append(m.UnpackedDouble, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedDouble", wireType)
+			}
+		case 101:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnpackedBool = append(m.UnpackedBool, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.UnpackedBool) == 0 {
+					m.UnpackedBool = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnpackedBool = append(m.UnpackedBool, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedBool", wireType)
+			}
+		case 102:
+			if wireType == 0 {
+				var v TestAllTypesProto2_NestedEnum
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= TestAllTypesProto2_NestedEnum(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnpackedNestedEnum = append(m.UnpackedNestedEnum, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.UnpackedNestedEnum) == 0 {
+					m.UnpackedNestedEnum = make([]TestAllTypesProto2_NestedEnum, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v TestAllTypesProto2_NestedEnum
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= TestAllTypesProto2_NestedEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnpackedNestedEnum = append(m.UnpackedNestedEnum, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedNestedEnum", wireType)
+			}
+		case 111:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofUint32", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OneofField = &TestAllTypesProto2_OneofUint32{OneofUint32: v}
+		case 112:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofNestedMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.OneofField.(*TestAllTypesProto2_OneofNestedMessage); ok {
+				if err := oneof.OneofNestedMessage.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+					return err
+				}
+			} else {
+				v := &TestAllTypesProto2_NestedMessage{}
+				if err := v.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+					return err
+				}
+				m.OneofField = &TestAllTypesProto2_OneofNestedMessage{OneofNestedMessage: v}
+			}
+			iNdEx = postIndex
+		case 113:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OneofField = &TestAllTypesProto2_OneofString{OneofString: string(string(dAtA[iNdEx:postIndex]))}
+			iNdEx = postIndex
+		case 114:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.OneofField = &TestAllTypesProto2_OneofBytes{OneofBytes: v}
+			iNdEx = postIndex
+		case 115:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofBool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.OneofField = &TestAllTypesProto2_OneofBool{OneofBool: b}
+		case 116:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofUint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OneofField = &TestAllTypesProto2_OneofUint64{OneofUint64: v}
+		case 117:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofFloat", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.OneofField = &TestAllTypesProto2_OneofFloat{OneofFloat: float32(math.Float32frombits(v))}
+		case 118:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofDouble", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.OneofField = &TestAllTypesProto2_OneofDouble{OneofDouble: float64(math.Float64frombits(v))}
+		case 119:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofEnum", wireType)
+			}
+			var v TestAllTypesProto2_NestedEnum
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= TestAllTypesProto2_NestedEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OneofField = &TestAllTypesProto2_OneofEnum{OneofEnum: v}
+		case 201:
+			if wireType != 3 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			groupStart := iNdEx
+			var groupEnd int
+			for {
+				groupEnd = iNdEx
+				var groupFieldWire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					groupFieldWire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if groupFieldWire&0x7 == 4 {
+					if int32(groupFieldWire>>3) != 201 {
+						return fmt.Errorf("proto: mismatched end group for field Data")
+					}
+					break
+				}
+				iNdEx = groupEnd
+				skippy, err := skip(dAtA[iNdEx:])
+				if err != nil {
+					return err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx += skippy
+			}
+			if m.Data == nil {
+				m.Data = &TestAllTypesProto2_Data{}
+			}
+			if err := m.Data.unmarshalVT(dAtA[groupStart:groupEnd], opts, depth); err != nil {
+				return err
+			}
+		case 241:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultInt32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DefaultInt32 = &v
+		case 242:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultInt64", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DefaultInt64 = &v
+		case 243:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultUint32", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DefaultUint32 = &v
+		case 244:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultUint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DefaultUint64 = &v
+		case 245:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSint32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.DefaultSint32 = &v
+		case 246:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			v2 := int64(v)
+			m.DefaultSint64 = &v2
+		case 247:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFixed32", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.DefaultFixed32 = &v
+		case 248:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFixed64", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DefaultFixed64 = &v
+		case 249:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSfixed32", wireType)
+			}
+			var v int32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.DefaultSfixed32 = &v
+		case 250:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultSfixed64", wireType)
+			}
+			var v int64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DefaultSfixed64 = &v
+		case 251:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFloat", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			v2 := float32(math.Float32frombits(v))
+			m.DefaultFloat = &v2
+		case 252:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultDouble", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.DefaultDouble = &v2
+		case 253:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultBool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.DefaultBool = &b
+		case 254:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(string(dAtA[iNdEx:postIndex]))
+			m.DefaultString = &s
+			iNdEx = postIndex
+		case 255:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultBytes = append(m.DefaultBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.DefaultBytes == nil {
+				m.DefaultBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 401:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fieldname1", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Fieldname1 = &v
+		case 402:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldName2", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FieldName2 = &v
+		case 403:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XFieldName3", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.XFieldName3 = &v
+		case 404:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field_Name4_", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Field_Name4_ = &v
+		case 405:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field0Name5", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Field0Name5 = &v
+		case 406:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field_0Name6", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Field_0Name6 = &v
+		case 407:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldName7", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FieldName7 = &v
+		case 408:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldName8", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FieldName8 = &v
+		case 409:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field_Name9", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Field_Name9 = &v
+		case 410:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field_Name10", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Field_Name10 = &v
+		case 411:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FIELD_NAME11", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FIELD_NAME11 = &v
+		case 412:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FIELDName12", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FIELDName12 = &v
+		case 413:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XFieldName13", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.XFieldName13 = &v
+		case 414:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X_FieldName14", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.X_FieldName14 = &v
+		case 415:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field_Name15", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Field_Name15 = &v
+		case 416:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field__Name16", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Field__Name16 = &v
+		case 417:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldName17__", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FieldName17__ = &v
+		case 418:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldName18__", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FieldName18__ = &v
+		case 120:
+			var ext int32
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionInt32", wireType)
+			}
+			ext = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				ext |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			proto.SetExtension(m, E_ExtensionInt32, ext)
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if (fieldNum >= 120) && (fieldNum < 201) {
+				found, err := protohelpers.UnmarshalExtension(m.ProtoReflect(), dAtA[iNdEx:iNdEx+skippy], depth)
+				if err != nil {
+					return err
+				}
+				if !found && !opts.DiscardUnknown {
+					m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			} else {
+				if !opts.DiscardUnknown {
+					m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForeignMessageProto2) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *ForeignMessageProto2) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *ForeignMessageProto2) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *ForeignMessageProto2) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*ForeignMessageProto2)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForeignMessageProto2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForeignMessageProto2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.C = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnknownToTestAllTypes_OptionalGroup) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*UnknownToTestAllTypes_OptionalGroup)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnknownToTestAllTypes_OptionalGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnknownToTestAllTypes_OptionalGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.A = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnknownToTestAllTypes) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *UnknownToTestAllTypes) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *UnknownToTestAllTypes) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *UnknownToTestAllTypes) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*UnknownToTestAllTypes)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnknownToTestAllTypes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnknownToTestAllTypes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1001:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalInt32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalInt32 = &v
+		case 1002:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(string(dAtA[iNdEx:postIndex]))
+			m.OptionalString = &s
+			iNdEx = postIndex
+		case 1003:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NestedMessage == nil {
+				m.NestedMessage = &ForeignMessageProto2{}
+			}
+			if err := m.NestedMessage.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 1004:
+			if wireType != 3 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Optionalgroup", wireType)
+			}
+			groupStart := iNdEx
+			var groupEnd int
+			for {
+				groupEnd = iNdEx
+				var groupFieldWire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					groupFieldWire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if groupFieldWire&0x7 == 4 {
+					if int32(groupFieldWire>>3) != 1004 {
+						return fmt.Errorf("proto: mismatched end group for field Optionalgroup")
+					}
+					break
+				}
+				iNdEx = groupEnd
+				skippy, err := skip(dAtA[iNdEx:])
+				if err != nil {
+					return err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx += skippy
+			}
+			if m.Optionalgroup == nil {
+				m.Optionalgroup = &UnknownToTestAllTypes_OptionalGroup{}
+			}
+			if err := m.Optionalgroup.unmarshalVT(dAtA[groupStart:groupEnd], opts, depth); err != nil {
+				return err
+			}
+		case 1006:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalBool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.OptionalBool = &b
+		case 1011:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedInt32 = append(m.RepeatedInt32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedInt32) == 0 {
+					m.RepeatedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedInt32 = append(m.RepeatedInt32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt32", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
 	}
-	if m.Field__Name16 != nil {
-		n += 2 + sov(uint64(*m.Field__Name16))
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if m.FieldName17__ != nil {
-		n += 2 + sov(uint64(*m.FieldName17__))
+	return nil
+}
+func (m *NullHypothesisProto2) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *NullHypothesisProto2) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
 	}
-	if m.FieldName18__ != nil {
-		n += 2 + sov(uint64(*m.FieldName18__))
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
 	}
-	n += len(m.unknownFields)
-	return n
+	return m.unmarshalVT(dAtA, opts, depth)
 }
 
-func (m *TestAllTypesProto2_OneofUint32) SizeVT() (n int) {
-	if m == nil {
-		return 0
+func (m *NullHypothesisProto2) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
 	}
-	var l int
-	_ = l
-	n += 2 + sov(uint64(m.OneofUint32))
-	return n
-}
-func (m *TestAllTypesProto2_OneofNestedMessage) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if m.OneofNestedMessage != nil {
-		l = m.OneofNestedMessage.SizeVT()
-		n += 2 + l + sov(uint64(l))
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
 	}
-	return n
+	return end, nil
 }
-func (m *TestAllTypesProto2_OneofString) SizeVT() (n int) {
-	if m == nil {
-		return 0
+
+func (m *NullHypothesisProto2) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
 	}
-	var l int
-	_ = l
-	l = len(m.OneofString)
-	n += 2 + l + sov(uint64(l))
-	return n
-}
-func (m *TestAllTypesProto2_OneofBytes) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*NullHypothesisProto2)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NullHypothesisProto2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NullHypothesisProto2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.OneofBytes)
-	n += 2 + l + sov(uint64(l))
-	return n
-}
-func (m *TestAllTypesProto2_OneofBool) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	n += 3
-	return n
+	return nil
 }
-func (m *TestAllTypesProto2_OneofUint64) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 2 + sov(uint64(m.OneofUint64))
-	return n
+func (m *EnumOnlyProto2) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
 }
-func (m *TestAllTypesProto2_OneofFloat) SizeVT() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EnumOnlyProto2) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
 	}
-	var l int
-	_ = l
-	n += 6
-	return n
-}
-func (m *TestAllTypesProto2_OneofDouble) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
 	}
-	var l int
-	_ = l
-	n += 10
-	return n
+	return m.unmarshalVT(dAtA, opts, depth)
 }
-func (m *TestAllTypesProto2_OneofEnum) SizeVT() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EnumOnlyProto2) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
 	}
-	var l int
-	_ = l
-	n += 2 + sov(uint64(m.OneofEnum))
-	return n
-}
-func (m *ForeignMessageProto2) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if m.C != nil {
-		n += 1 + sov(uint64(*m.C))
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return end, nil
 }
 
-func (m *UnknownToTestAllTypes_OptionalGroup) SizeVT() (n int) {
-	if m == nil {
-		return 0
+func (m *EnumOnlyProto2) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
 	}
-	var l int
-	_ = l
-	if m.A != nil {
-		n += 1 + sov(uint64(*m.A))
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*EnumOnlyProto2)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnumOnlyProto2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnumOnlyProto2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
 	}
-	n += len(m.unknownFields)
-	return n
-}
+	preIndex = iNdEx
 
-func (m *UnknownToTestAllTypes) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if m.OptionalInt32 != nil {
-		n += 2 + sov(uint64(*m.OptionalInt32))
+	return nil
+}
+func (m *OneStringProto2) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *OneStringProto2) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
 	}
-	if m.OptionalString != nil {
-		l = len(*m.OptionalString)
-		n += 2 + l + sov(uint64(l))
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
 	}
-	if m.NestedMessage != nil {
-		l = m.NestedMessage.SizeVT()
-		n += 2 + l + sov(uint64(l))
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *OneStringProto2) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
 	}
-	if m.Optionalgroup != nil {
-		l = m.Optionalgroup.SizeVT()
-		n += l + 4
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
 	}
-	if m.OptionalBool != nil {
-		n += 3
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
 	}
-	if len(m.RepeatedInt32) > 0 {
-		for _, e := range m.RepeatedInt32 {
-			n += 2 + sov(uint64(e))
+	return end, nil
+}
+
+func (m *OneStringProto2) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*OneStringProto2)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OneStringProto2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OneStringProto2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(string(dAtA[iNdEx:postIndex]))
+			m.Data = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
 		}
 	}
-	n += len(m.unknownFields)
-	return n
-}
+	preIndex = iNdEx
 
-func (m *NullHypothesisProto2) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	n += len(m.unknownFields)
-	return n
+	return nil
 }
-
-func (m *EnumOnlyProto2) SizeVT() (n int) {
-	if m == nil {
-		return 0
+func skip(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLength
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroup
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLength
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
 	}
-	var l int
-	_ = l
-	n += len(m.unknownFields)
-	return n
+	return 0, io.ErrUnexpectedEOF
 }
 
-func (m *OneStringProto2) SizeVT() (n int) {
-	if m == nil {
-		return 0
+var (
+	ErrInvalidLength          = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflow            = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroup   = fmt.Errorf("proto: unexpected end of group")
+	ErrInvalidUTF8            = fmt.Errorf("proto: string field contains invalid UTF-8")
+	ErrRecursionLimitExceeded = fmt.Errorf("proto: exceeded maximum recursion depth")
+)
+
+func (m *TestAllTypesProto2_NestedMessage) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *TestAllTypesProto2_NestedMessage) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
 	}
-	var l int
-	_ = l
-	if m.Data != nil {
-		l = len(*m.Data)
-		n += 1 + l + sov(uint64(l))
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
 	}
-	n += len(m.unknownFields)
-	return n
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
 }
 
-func sov(x uint64) (n int) {
-	return (bits.Len64(x|1) + 6) / 7
-}
-func soz(x uint64) (n int) {
-	return sov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *TestAllTypesProto2_NestedMessage) UnmarshalVT(dAtA []byte) error {
+func (m *TestAllTypesProto2_NestedMessage) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*TestAllTypesProto2_NestedMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -5159,7 +25394,7 @@ func (m *TestAllTypesProto2_NestedMessage) UnmarshalVT(dAtA []byte) error {
 			if m.Corecursive == nil {
 				m.Corecursive = &TestAllTypesProto2{}
 			}
-			if err := m.Corecursive.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Corecursive.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5175,21 +25410,49 @@ func (m *TestAllTypesProto2_NestedMessage) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *TestAllTypesProto2_Data) UnmarshalVT(dAtA []byte) error {
+func (m *TestAllTypesProto2_Data) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *TestAllTypesProto2_Data) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *TestAllTypesProto2_Data) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*TestAllTypesProto2_Data)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -5266,21 +25529,49 @@ func (m *TestAllTypesProto2_Data) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *TestAllTypesProto2_MessageSetCorrect) UnmarshalVT(dAtA []byte) error {
+func (m *TestAllTypesProto2_MessageSetCorrect) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrect) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*TestAllTypesProto2_MessageSetCorrect)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -5317,29 +25608,49 @@ func (m *TestAllTypesProto2_MessageSetCorrect) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if (fieldNum >= 4) && (fieldNum < 2147483647) {
-				err = proto.UnmarshalOptions{AllowPartial: true}.Unmarshal(dAtA[iNdEx:iNdEx+skippy], m)
-				if err != nil {
-					return err
-				}
-				iNdEx += skippy
-			} else {
+			if !opts.DiscardUnknown {
 				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				iNdEx += skippy
 			}
+			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
 	}
-	return nil
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
 }
-func (m *TestAllTypesProto2_MessageSetCorrectExtension1) UnmarshalVT(dAtA []byte) error {
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension1) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*TestAllTypesProto2_MessageSetCorrectExtension1)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -5394,7 +25705,11 @@ func (m *TestAllTypesProto2_MessageSetCorrectExtension1) UnmarshalVT(dAtA []byte
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			s := string(stringValue)
 			m.Str = &s
 			iNdEx = postIndex
 		default:
@@ -5409,21 +25724,49 @@ func (m *TestAllTypesProto2_MessageSetCorrectExtension1) UnmarshalVT(dAtA []byte
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *TestAllTypesProto2_MessageSetCorrectExtension2) UnmarshalVT(dAtA []byte) error {
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *TestAllTypesProto2_MessageSetCorrectExtension2) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*TestAllTypesProto2_MessageSetCorrectExtension2)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -5480,21 +25823,49 @@ func (m *TestAllTypesProto2_MessageSetCorrectExtension2) UnmarshalVT(dAtA []byte
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
+func (m *TestAllTypesProto2) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *TestAllTypesProto2) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *TestAllTypesProto2) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*TestAllTypesProto2)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -5761,7 +26132,11 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			s := string(stringValue)
 			m.OptionalString = &s
 			iNdEx = postIndex
 		case 15:
@@ -5793,10 +26168,7 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptionalBytes = append(m.OptionalBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.OptionalBytes == nil {
-				m.OptionalBytes = []byte{}
-			}
+			m.OptionalBytes = dAtA[iNdEx:postIndex:postIndex]
 			iNdEx = postIndex
 		case 18:
 			if wireType != 2 {
@@ -5830,7 +26202,7 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 			if m.OptionalNestedMessage == nil {
 				m.OptionalNestedMessage = &TestAllTypesProto2_NestedMessage{}
 			}
-			if err := m.OptionalNestedMessage.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OptionalNestedMessage.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5866,7 +26238,7 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 			if m.OptionalForeignMessage == nil {
 				m.OptionalForeignMessage = &ForeignMessageProto2{}
 			}
-			if err := m.OptionalForeignMessage.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OptionalForeignMessage.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5940,7 +26312,11 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			s := string(stringValue)
 			m.OptionalStringPiece = &s
 			iNdEx = postIndex
 		case 25:
@@ -5973,7 +26349,11 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			s := string(stringValue)
 			m.OptionalCord = &s
 			iNdEx = postIndex
 		case 27:
@@ -6008,7 +26388,7 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 			if m.RecursiveMessage == nil {
 				m.RecursiveMessage = &TestAllTypesProto2{}
 			}
-			if err := m.RecursiveMessage.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecursiveMessage.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6888,7 +27268,11 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepeatedString = append(m.RepeatedString, string(dAtA[iNdEx:postIndex]))
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.RepeatedString = append(m.RepeatedString, string(stringValue))
 			iNdEx = postIndex
 		case 45:
 			if wireType != 2 {
@@ -6919,8 +27303,7 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepeatedBytes = append(m.RepeatedBytes, make([]byte, postIndex-iNdEx))
-			copy(m.RepeatedBytes[len(m.RepeatedBytes)-1], dAtA[iNdEx:postIndex])
+			m.RepeatedBytes = append(m.RepeatedBytes, dAtA[iNdEx:postIndex:postIndex])
 			iNdEx = postIndex
 		case 48:
 			if wireType != 2 {
@@ -6952,7 +27335,7 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.RepeatedNestedMessage = append(m.RepeatedNestedMessage, &TestAllTypesProto2_NestedMessage{})
-			if err := m.RepeatedNestedMessage[len(m.RepeatedNestedMessage)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RepeatedNestedMessage[len(m.RepeatedNestedMessage)-1].unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6986,7 +27369,7 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.RepeatedForeignMessage = append(m.RepeatedForeignMessage, &ForeignMessageProto2{})
-			if err := m.RepeatedForeignMessage[len(m.RepeatedForeignMessage)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RepeatedForeignMessage[len(m.RepeatedForeignMessage)-1].unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7158,7 +27541,11 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepeatedStringPiece = append(m.RepeatedStringPiece, string(dAtA[iNdEx:postIndex]))
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.RepeatedStringPiece = append(m.RepeatedStringPiece, string(stringValue))
 			iNdEx = postIndex
 		case 55:
 			if wireType != 2 {
@@ -7190,7 +27577,11 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepeatedCord = append(m.RepeatedCord, string(dAtA[iNdEx:postIndex]))
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.RepeatedCord = append(m.RepeatedCord, string(stringValue))
 			iNdEx = postIndex
 		case 56:
 			if wireType != 2 {
@@ -8488,7 +28879,9 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if intStringLenmapkey > 0 {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var stringLenmapvalue uint64
@@ -8517,7 +28910,9 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					if intStringLenmapvalue > 0 {
+						mapvalue = unsafe.String(&dAtA[iNdEx], intStringLenmapvalue)
+					}
 					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
@@ -8615,7 +29010,9 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if intStringLenmapkey > 0 {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var mapbyteLen uint64
@@ -8644,8 +29041,7 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 					if postbytesIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = make([]byte, mapbyteLen)
-					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					mapvalue = dAtA[iNdEx:postbytesIndex:postbytesIndex]
 					iNdEx = postbytesIndex
 				} else {
 					iNdEx = entryPreIndex
@@ -8743,7 +29139,9 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if intStringLenmapkey > 0 {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var mapmsglen int
@@ -8772,7 +29170,7 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 						return io.ErrUnexpectedEOF
 					}
 					mapvalue = &TestAllTypesProto2_NestedMessage{}
-					if err := mapvalue.UnmarshalVT(dAtA[iNdEx:postmsgIndex]); err != nil {
+					if err := mapvalue.unmarshalVTUnsafe(dAtA[iNdEx:postmsgIndex], opts, depth); err != nil {
 						return err
 					}
 					iNdEx = postmsgIndex
@@ -8872,7 +29270,9 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if intStringLenmapkey > 0 {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var mapmsglen int
@@ -8901,7 +29301,7 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 						return io.ErrUnexpectedEOF
 					}
 					mapvalue = &ForeignMessageProto2{}
-					if err := mapvalue.UnmarshalVT(dAtA[iNdEx:postmsgIndex]); err != nil {
+					if err := mapvalue.unmarshalVTUnsafe(dAtA[iNdEx:postmsgIndex], opts, depth); err != nil {
 						return err
 					}
 					iNdEx = postmsgIndex
@@ -9001,7 +29401,9 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if intStringLenmapkey > 0 {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					for shift := uint(0); ; shift += 7 {
@@ -9114,7 +29516,9 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if intStringLenmapkey > 0 {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					for shift := uint(0); ; shift += 7 {
@@ -11028,12 +31432,12 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if oneof, ok := m.OneofField.(*TestAllTypesProto2_OneofNestedMessage); ok {
-				if err := oneof.OneofNestedMessage.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := oneof.OneofNestedMessage.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
 					return err
 				}
 			} else {
 				v := &TestAllTypesProto2_NestedMessage{}
-				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := v.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
 					return err
 				}
 				m.OneofField = &TestAllTypesProto2_OneofNestedMessage{OneofNestedMessage: v}
@@ -11069,7 +31473,11 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OneofField = &TestAllTypesProto2_OneofString{OneofString: string(dAtA[iNdEx:postIndex])}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.OneofField = &TestAllTypesProto2_OneofString{OneofString: string(stringValue)}
 			iNdEx = postIndex
 		case 114:
 			if wireType != 2 {
@@ -11100,9 +31508,7 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.OneofField = &TestAllTypesProto2_OneofBytes{OneofBytes: v}
+			m.OneofField = &TestAllTypesProto2_OneofBytes{OneofBytes: dAtA[iNdEx:postIndex:postIndex]}
 			iNdEx = postIndex
 		case 115:
 			if wireType != 0 {
@@ -11192,8 +31598,9 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			groupStart := iNdEx
+			var groupEnd int
 			for {
-				maybeGroupEnd := iNdEx
+				groupEnd = iNdEx
 				var groupFieldWire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
@@ -11209,13 +31616,13 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 						break
 					}
 				}
-				groupWireType := int(wire & 0x7)
-				if groupWireType == 4 {
-					if err := m.Data.UnmarshalVT(dAtA[groupStart:maybeGroupEnd]); err != nil {
-						return err
+				if groupFieldWire&0x7 == 4 {
+					if int32(groupFieldWire>>3) != 201 {
+						return fmt.Errorf("proto: mismatched end group for field Data")
 					}
 					break
 				}
+				iNdEx = groupEnd
 				skippy, err := skip(dAtA[iNdEx:])
 				if err != nil {
 					return err
@@ -11223,8 +31630,17 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return ErrInvalidLength
 				}
+				if (iNdEx + skippy) > l {
+					return io.ErrUnexpectedEOF
+				}
 				iNdEx += skippy
 			}
+			if m.Data == nil {
+				m.Data = &TestAllTypesProto2_Data{}
+			}
+			if err := m.Data.unmarshalVTUnsafe(dAtA[groupStart:groupEnd], opts, depth); err != nil {
+				return err
+			}
 		case 241:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DefaultInt32", wireType)
@@ -11467,7 +31883,11 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			s := string(stringValue)
 			m.DefaultString = &s
 			iNdEx = postIndex
 		case 255:
@@ -11499,10 +31919,7 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DefaultBytes = append(m.DefaultBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.DefaultBytes == nil {
-				m.DefaultBytes = []byte{}
-			}
+			m.DefaultBytes = dAtA[iNdEx:postIndex:postIndex]
 			iNdEx = postIndex
 		case 401:
 			if wireType != 0 {
@@ -11864,6 +32281,27 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				}
 			}
 			m.FieldName18__ = &v
+		case 120:
+			var ext int32
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionInt32", wireType)
+			}
+			ext = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				ext |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			proto.SetExtension(m, E_ExtensionInt32, ext)
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -11877,28 +32315,59 @@ func (m *TestAllTypesProto2) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if (fieldNum >= 120) && (fieldNum < 201) {
-				err = proto.UnmarshalOptions{AllowPartial: true}.Unmarshal(dAtA[iNdEx:iNdEx+skippy], m)
+				found, err := protohelpers.UnmarshalExtension(m.ProtoReflect(), dAtA[iNdEx:iNdEx+skippy], depth)
 				if err != nil {
 					return err
 				}
+				if !found && !opts.DiscardUnknown {
+					m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
 				iNdEx += skippy
 			} else {
-				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !opts.DiscardUnknown {
+					m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
 				iNdEx += skippy
 			}
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ForeignMessageProto2) UnmarshalVT(dAtA []byte) error {
+func (m *ForeignMessageProto2) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *ForeignMessageProto2) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *ForeignMessageProto2) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*ForeignMessageProto2)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -11955,21 +32424,49 @@ func (m *ForeignMessageProto2) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *UnknownToTestAllTypes_OptionalGroup) UnmarshalVT(dAtA []byte) error {
+func (m *UnknownToTestAllTypes_OptionalGroup) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *UnknownToTestAllTypes_OptionalGroup) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*UnknownToTestAllTypes_OptionalGroup)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -12026,21 +32523,49 @@ func (m *UnknownToTestAllTypes_OptionalGroup) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *UnknownToTestAllTypes) UnmarshalVT(dAtA []byte) error {
+func (m *UnknownToTestAllTypes) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *UnknownToTestAllTypes) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *UnknownToTestAllTypes) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*UnknownToTestAllTypes)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -12115,7 +32640,11 @@ func (m *UnknownToTestAllTypes) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			s := string(stringValue)
 			m.OptionalString = &s
 			iNdEx = postIndex
 		case 1003:
@@ -12150,7 +32679,7 @@ func (m *UnknownToTestAllTypes) UnmarshalVT(dAtA []byte) error {
 			if m.NestedMessage == nil {
 				m.NestedMessage = &ForeignMessageProto2{}
 			}
-			if err := m.NestedMessage.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NestedMessage.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12159,8 +32688,9 @@ func (m *UnknownToTestAllTypes) UnmarshalVT(dAtA []byte) error {
 				return fmt.Errorf("proto: wrong wireType = %d for field Optionalgroup", wireType)
 			}
 			groupStart := iNdEx
+			var groupEnd int
 			for {
-				maybeGroupEnd := iNdEx
+				groupEnd = iNdEx
 				var groupFieldWire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
@@ -12176,13 +32706,13 @@ func (m *UnknownToTestAllTypes) UnmarshalVT(dAtA []byte) error {
 						break
 					}
 				}
-				groupWireType := int(wire & 0x7)
-				if groupWireType == 4 {
-					if err := m.Optionalgroup.UnmarshalVT(dAtA[groupStart:maybeGroupEnd]); err != nil {
-						return err
+				if groupFieldWire&0x7 == 4 {
+					if int32(groupFieldWire>>3) != 1004 {
+						return fmt.Errorf("proto: mismatched end group for field Optionalgroup")
 					}
 					break
 				}
+				iNdEx = groupEnd
 				skippy, err := skip(dAtA[iNdEx:])
 				if err != nil {
 					return err
@@ -12190,8 +32720,17 @@ func (m *UnknownToTestAllTypes) UnmarshalVT(dAtA []byte) error {
 				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return ErrInvalidLength
 				}
+				if (iNdEx + skippy) > l {
+					return io.ErrUnexpectedEOF
+				}
 				iNdEx += skippy
 			}
+			if m.Optionalgroup == nil {
+				m.Optionalgroup = &UnknownToTestAllTypes_OptionalGroup{}
+			}
+			if err := m.Optionalgroup.unmarshalVTUnsafe(dAtA[groupStart:groupEnd], opts, depth); err != nil {
+				return err
+			}
 		case 1006:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OptionalBool", wireType)
@@ -12301,21 +32840,49 @@ func (m *UnknownToTestAllTypes) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *NullHypothesisProto2) UnmarshalVT(dAtA []byte) error {
+func (m *NullHypothesisProto2) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *NullHypothesisProto2) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *NullHypothesisProto2) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*NullHypothesisProto2)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -12352,21 +32919,49 @@ func (m *NullHypothesisProto2) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *EnumOnlyProto2) UnmarshalVT(dAtA []byte) error {
+func (m *EnumOnlyProto2) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *EnumOnlyProto2) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *EnumOnlyProto2) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*EnumOnlyProto2)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -12403,21 +32998,49 @@ func (m *EnumOnlyProto2) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *OneStringProto2) UnmarshalVT(dAtA []byte) error {
+func (m *OneStringProto2) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *OneStringProto2) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *OneStringProto2) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*OneStringProto2)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -12472,7 +33095,11 @@ func (m *OneStringProto2) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			s := string(stringValue)
 			m.Data = &s
 			iNdEx = postIndex
 		default:
@@ -12487,97 +33114,16 @@ func (m *OneStringProto2) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func skip(dAtA []byte) (n int, err error) {
-	l := len(dAtA)
-	iNdEx := 0
-	depth := 0
-	for iNdEx < l {
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return 0, ErrIntOverflow
-			}
-			if iNdEx >= l {
-				return 0, io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		wireType := int(wire & 0x7)
-		switch wireType {
-		case 0:
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return 0, ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
-				}
-				iNdEx++
-				if dAtA[iNdEx-1] < 0x80 {
-					break
-				}
-			}
-		case 1:
-			iNdEx += 8
-		case 2:
-			var length int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return 0, ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				length |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if length < 0 {
-				return 0, ErrInvalidLength
-			}
-			iNdEx += length
-		case 3:
-			depth++
-		case 4:
-			if depth == 0 {
-				return 0, ErrUnexpectedEndOfGroup
-			}
-			depth--
-		case 5:
-			iNdEx += 4
-		default:
-			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
-		}
-		if iNdEx < 0 {
-			return 0, ErrInvalidLength
-		}
-		if depth == 0 {
-			return iNdEx, nil
-		}
-	}
-	return 0, io.ErrUnexpectedEOF
-}
-
-var (
-	ErrInvalidLength        = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflow          = fmt.Errorf("proto: integer overflow")
-	ErrUnexpectedEndOfGroup = fmt.Errorf("proto: unexpected end of group")
-)
@@ -6,7 +6,11 @@ package conformance
 
 import (
 	binary "encoding/binary"
+	errors "errors"
 	fmt "fmt"
+	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
+	vtproto "github.com/planetscale/vtprotobuf/vtproto"
+	protowire "google.golang.org/protobuf/encoding/protowire"
 	proto "google.golang.org/protobuf/proto"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
@@ -15,8 +19,14 @@ import (
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	hash "hash"
+	fnv "hash/fnv"
 	io "io"
 	math "math"
+	sort "sort"
+	strconv "strconv"
+	utf8 "unicode/utf8"
+	unsafe "unsafe"
 )
 
 const (
@@ -45,6 +55,24 @@ func (m *TestAllTypesProto3_NestedMessage) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *TestAllTypesProto3_NestedMessage) CopyToVT(dst *TestAllTypesProto3_NestedMessage) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.A = m.A
+	if m.Corecursive == nil {
+		dst.Corecursive = nil
+	} else {
+		if dst.Corecursive == nil {
+			dst.Corecursive = m.Corecursive.CloneVT()
+		} else {
+			m.Corecursive.CopyToVT(dst.Corecursive)
+		}
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *TestAllTypesProto3) CloneVT() *TestAllTypesProto3 {
 	if m == nil {
 		return (*TestAllTypesProto3)(nil)
@@ -798,5276 +826,26195 @@ func (m *TestAllTypesProto3) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
-func (m *TestAllTypesProto3_OneofUint32) CloneVT() isTestAllTypesProto3_OneofField {
+func (m *TestAllTypesProto3) CopyToVT(dst *TestAllTypesProto3) {
 	if m == nil {
-		return (*TestAllTypesProto3_OneofUint32)(nil)
-	}
-	r := &TestAllTypesProto3_OneofUint32{
-		OneofUint32: m.OneofUint32,
+		dst.Reset()
+		return
+	}
+	dst.OptionalInt32 = m.OptionalInt32
+	dst.OptionalInt64 = m.OptionalInt64
+	dst.OptionalUint32 = m.OptionalUint32
+	dst.OptionalUint64 = m.OptionalUint64
+	dst.OptionalSint32 = m.OptionalSint32
+	dst.OptionalSint64 = m.OptionalSint64
+	dst.OptionalFixed32 = m.OptionalFixed32
+	dst.OptionalFixed64 = m.OptionalFixed64
+	dst.OptionalSfixed32 = m.OptionalSfixed32
+	dst.OptionalSfixed64 = m.OptionalSfixed64
+	dst.OptionalFloat = m.OptionalFloat
+	dst.OptionalDouble = m.OptionalDouble
+	dst.OptionalBool = m.OptionalBool
+	dst.OptionalString = m.OptionalString
+	dst.OptionalBytes = append(dst.OptionalBytes[:0], m.OptionalBytes...)
+	if m.OptionalNestedMessage == nil {
+		dst.OptionalNestedMessage = nil
+	} else {
+		if dst.OptionalNestedMessage == nil {
+			dst.OptionalNestedMessage = m.OptionalNestedMessage.CloneVT()
+		} else {
+			m.OptionalNestedMessage.CopyToVT(dst.OptionalNestedMessage)
+		}
 	}
-	return r
-}
-
-func (m *TestAllTypesProto3_OneofNestedMessage) CloneVT() isTestAllTypesProto3_OneofField {
-	if m == nil {
-		return (*TestAllTypesProto3_OneofNestedMessage)(nil)
+	if m.OptionalForeignMessage == nil {
+		dst.OptionalForeignMessage = nil
+	} else {
+		if dst.OptionalForeignMessage == nil {
+			dst.OptionalForeignMessage = m.OptionalForeignMessage.CloneVT()
+		} else {
+			m.OptionalForeignMessage.CopyToVT(dst.OptionalForeignMessage)
+		}
 	}
-	r := &TestAllTypesProto3_OneofNestedMessage{
-		OneofNestedMessage: m.OneofNestedMessage.CloneVT(),
+	dst.OptionalNestedEnum = m.OptionalNestedEnum
+	dst.OptionalForeignEnum = m.OptionalForeignEnum
+	dst.OptionalAliasedEnum = m.OptionalAliasedEnum
+	dst.OptionalStringPiece = m.OptionalStringPiece
+	dst.OptionalCord = m.OptionalCord
+	if m.RecursiveMessage == nil {
+		dst.RecursiveMessage = nil
+	} else {
+		if dst.RecursiveMessage == nil {
+			dst.RecursiveMessage = m.RecursiveMessage.CloneVT()
+		} else {
+			m.RecursiveMessage.CopyToVT(dst.RecursiveMessage)
+		}
+	}
+	dst.RepeatedInt32 = append(dst.RepeatedInt32[:0], m.RepeatedInt32...)
+	dst.RepeatedInt64 = append(dst.RepeatedInt64[:0], m.RepeatedInt64...)
+	dst.RepeatedUint32 = append(dst.RepeatedUint32[:0], m.RepeatedUint32...)
+	dst.RepeatedUint64 = append(dst.RepeatedUint64[:0], m.RepeatedUint64...)
+	dst.RepeatedSint32 = append(dst.RepeatedSint32[:0], m.RepeatedSint32...)
+	dst.RepeatedSint64 = append(dst.RepeatedSint64[:0], m.RepeatedSint64...)
+	dst.RepeatedFixed32 = append(dst.RepeatedFixed32[:0], m.RepeatedFixed32...)
+	dst.RepeatedFixed64 = append(dst.RepeatedFixed64[:0], m.RepeatedFixed64...)
+	dst.RepeatedSfixed32 = append(dst.RepeatedSfixed32[:0], m.RepeatedSfixed32...)
+	dst.RepeatedSfixed64 = append(dst.RepeatedSfixed64[:0], m.RepeatedSfixed64...)
+	dst.RepeatedFloat = append(dst.RepeatedFloat[:0], m.RepeatedFloat...)
+	dst.RepeatedDouble = append(dst.RepeatedDouble[:0], m.RepeatedDouble...)
+	dst.RepeatedBool = append(dst.RepeatedBool[:0], m.RepeatedBool...)
+	dst.RepeatedString = append(dst.RepeatedString[:0], m.RepeatedString...)
+	if len(m.RepeatedBytes) > cap(dst.RepeatedBytes) {
+		tmpContainer := make([][]byte, len(m.RepeatedBytes))
+		copy(tmpContainer, dst.RepeatedBytes[:cap(dst.RepeatedBytes)])
+		dst.RepeatedBytes = tmpContainer
+	} else {
+		dst.RepeatedBytes = dst.RepeatedBytes[:len(m.RepeatedBytes)]
 	}
-	return r
-}
-
-func (m *TestAllTypesProto3_OneofString) CloneVT() isTestAllTypesProto3_OneofField {
-	if m == nil {
-		return (*TestAllTypesProto3_OneofString)(nil)
+	for k, v := range m.RepeatedBytes {
+		dst.RepeatedBytes[k] = append(dst.RepeatedBytes[k][:0], v...)
 	}
-	r := &TestAllTypesProto3_OneofString{
-		OneofString: m.OneofString,
+	if len(m.RepeatedNestedMessage) > cap(dst.RepeatedNestedMessage) {
+		tmpContainer := make([]*TestAllTypesProto3_NestedMessage, len(m.RepeatedNestedMessage))
+		copy(tmpContainer, dst.RepeatedNestedMessage[:cap(dst.RepeatedNestedMessage)])
+		dst.RepeatedNestedMessage = tmpContainer
+	} else {
+		dst.RepeatedNestedMessage = dst.RepeatedNestedMessage[:len(m.RepeatedNestedMessage)]
 	}
-	return r
-}
-
-func (m *TestAllTypesProto3_OneofBytes) CloneVT() isTestAllTypesProto3_OneofField {
-	if m == nil {
-		return (*TestAllTypesProto3_OneofBytes)(nil)
+	for k, v := range m.RepeatedNestedMessage {
+		if dst.RepeatedNestedMessage[k] == nil {
+			dst.RepeatedNestedMessage[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.RepeatedNestedMessage[k])
+		}
 	}
-	r := &TestAllTypesProto3_OneofBytes{}
-	if rhs := m.OneofBytes; rhs != nil {
-		tmpBytes := make([]byte, len(rhs))
-		copy(tmpBytes, rhs)
-		r.OneofBytes = tmpBytes
+	if len(m.RepeatedForeignMessage) > cap(dst.RepeatedForeignMessage) {
+		tmpContainer := make([]*ForeignMessage, len(m.RepeatedForeignMessage))
+		copy(tmpContainer, dst.RepeatedForeignMessage[:cap(dst.RepeatedForeignMessage)])
+		dst.RepeatedForeignMessage = tmpContainer
+	} else {
+		dst.RepeatedForeignMessage = dst.RepeatedForeignMessage[:len(m.RepeatedForeignMessage)]
 	}
-	return r
-}
-
-func (m *TestAllTypesProto3_OneofBool) CloneVT() isTestAllTypesProto3_OneofField {
-	if m == nil {
-		return (*TestAllTypesProto3_OneofBool)(nil)
+	for k, v := range m.RepeatedForeignMessage {
+		if dst.RepeatedForeignMessage[k] == nil {
+			dst.RepeatedForeignMessage[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.RepeatedForeignMessage[k])
+		}
 	}
-	r := &TestAllTypesProto3_OneofBool{
-		OneofBool: m.OneofBool,
+	dst.RepeatedNestedEnum = append(dst.RepeatedNestedEnum[:0], m.RepeatedNestedEnum...)
+	dst.RepeatedForeignEnum = append(dst.RepeatedForeignEnum[:0], m.RepeatedForeignEnum...)
+	dst.RepeatedStringPiece = append(dst.RepeatedStringPiece[:0], m.RepeatedStringPiece...)
+	dst.RepeatedCord = append(dst.RepeatedCord[:0], m.RepeatedCord...)
+	dst.PackedInt32 = append(dst.PackedInt32[:0], m.PackedInt32...)
+	dst.PackedInt64 = append(dst.PackedInt64[:0], m.PackedInt64...)
+	dst.PackedUint32 = append(dst.PackedUint32[:0], m.PackedUint32...)
+	dst.PackedUint64 = append(dst.PackedUint64[:0], m.PackedUint64...)
+	dst.PackedSint32 = append(dst.PackedSint32[:0], m.PackedSint32...)
+	dst.PackedSint64 = append(dst.PackedSint64[:0], m.PackedSint64...)
+	dst.PackedFixed32 = append(dst.PackedFixed32[:0], m.PackedFixed32...)
+	dst.PackedFixed64 = append(dst.PackedFixed64[:0], m.PackedFixed64...)
+	dst.PackedSfixed32 = append(dst.PackedSfixed32[:0], m.PackedSfixed32...)
+	dst.PackedSfixed64 = append(dst.PackedSfixed64[:0], m.PackedSfixed64...)
+	dst.PackedFloat = append(dst.PackedFloat[:0], m.PackedFloat...)
+	dst.PackedDouble = append(dst.PackedDouble[:0], m.PackedDouble...)
+	dst.PackedBool = append(dst.PackedBool[:0], m.PackedBool...)
+	dst.PackedNestedEnum = append(dst.PackedNestedEnum[:0], m.PackedNestedEnum...)
+	dst.UnpackedInt32 = append(dst.UnpackedInt32[:0], m.UnpackedInt32...)
+	dst.UnpackedInt64 = append(dst.UnpackedInt64[:0], m.UnpackedInt64...)
+	dst.UnpackedUint32 = append(dst.UnpackedUint32[:0], m.UnpackedUint32...)
+	dst.UnpackedUint64 = append(dst.UnpackedUint64[:0], m.UnpackedUint64...)
+	dst.UnpackedSint32 = append(dst.UnpackedSint32[:0], m.UnpackedSint32...)
+	dst.UnpackedSint64 = append(dst.UnpackedSint64[:0], m.UnpackedSint64...)
+	dst.UnpackedFixed32 = append(dst.UnpackedFixed32[:0], m.UnpackedFixed32...)
+	dst.UnpackedFixed64 = append(dst.UnpackedFixed64[:0], m.UnpackedFixed64...)
+	dst.UnpackedSfixed32 = append(dst.UnpackedSfixed32[:0], m.UnpackedSfixed32...)
+	dst.UnpackedSfixed64 = append(dst.UnpackedSfixed64[:0], m.UnpackedSfixed64...)
+	dst.UnpackedFloat = append(dst.UnpackedFloat[:0], m.UnpackedFloat...)
+	dst.UnpackedDouble = append(dst.UnpackedDouble[:0], m.UnpackedDouble...)
+	dst.UnpackedBool = append(dst.UnpackedBool[:0], m.UnpackedBool...)
+	dst.UnpackedNestedEnum = append(dst.UnpackedNestedEnum[:0], m.UnpackedNestedEnum...)
+	if dst.MapInt32Int32 == nil && len(m.MapInt32Int32) > 0 {
+		dst.MapInt32Int32 = make(map[int32]int32, len(m.MapInt32Int32))
 	}
-	return r
-}
-
-func (m *TestAllTypesProto3_OneofUint64) CloneVT() isTestAllTypesProto3_OneofField {
-	if m == nil {
-		return (*TestAllTypesProto3_OneofUint64)(nil)
+	for k := range dst.MapInt32Int32 {
+		if _, ok := m.MapInt32Int32[k]; !ok {
+			delete(dst.MapInt32Int32, k)
+		}
 	}
-	r := &TestAllTypesProto3_OneofUint64{
-		OneofUint64: m.OneofUint64,
+	for k, v := range m.MapInt32Int32 {
+		dst.MapInt32Int32[k] = v
 	}
-	return r
-}
-
-func (m *TestAllTypesProto3_OneofFloat) CloneVT() isTestAllTypesProto3_OneofField {
-	if m == nil {
-		return (*TestAllTypesProto3_OneofFloat)(nil)
+	if dst.MapInt64Int64 == nil && len(m.MapInt64Int64) > 0 {
+		dst.MapInt64Int64 = make(map[int64]int64, len(m.MapInt64Int64))
 	}
-	r := &TestAllTypesProto3_OneofFloat{
-		OneofFloat: m.OneofFloat,
+	for k := range dst.MapInt64Int64 {
+		if _, ok := m.MapInt64Int64[k]; !ok {
+			delete(dst.MapInt64Int64, k)
+		}
 	}
-	return r
-}
-
-func (m *TestAllTypesProto3_OneofDouble) CloneVT() isTestAllTypesProto3_OneofField {
-	if m == nil {
-		return (*TestAllTypesProto3_OneofDouble)(nil)
+	for k, v := range m.MapInt64Int64 {
+		dst.MapInt64Int64[k] = v
 	}
-	r := &TestAllTypesProto3_OneofDouble{
-		OneofDouble: m.OneofDouble,
+	if dst.MapUint32Uint32 == nil && len(m.MapUint32Uint32) > 0 {
+		dst.MapUint32Uint32 = make(map[uint32]uint32, len(m.MapUint32Uint32))
 	}
-	return r
-}
-
-func (m *TestAllTypesProto3_OneofEnum) CloneVT() isTestAllTypesProto3_OneofField {
-	if m == nil {
-		return (*TestAllTypesProto3_OneofEnum)(nil)
+	for k := range dst.MapUint32Uint32 {
+		if _, ok := m.MapUint32Uint32[k]; !ok {
+			delete(dst.MapUint32Uint32, k)
+		}
 	}
-	r := &TestAllTypesProto3_OneofEnum{
-		OneofEnum: m.OneofEnum,
+	for k, v := range m.MapUint32Uint32 {
+		dst.MapUint32Uint32[k] = v
 	}
-	return r
-}
-
-func (m *TestAllTypesProto3_OneofNullValue) CloneVT() isTestAllTypesProto3_OneofField {
-	if m == nil {
-		return (*TestAllTypesProto3_OneofNullValue)(nil)
+	if dst.MapUint64Uint64 == nil && len(m.MapUint64Uint64) > 0 {
+		dst.MapUint64Uint64 = make(map[uint64]uint64, len(m.MapUint64Uint64))
 	}
-	r := &TestAllTypesProto3_OneofNullValue{
-		OneofNullValue: m.OneofNullValue,
+	for k := range dst.MapUint64Uint64 {
+		if _, ok := m.MapUint64Uint64[k]; !ok {
+			delete(dst.MapUint64Uint64, k)
+		}
 	}
-	return r
-}
-
-func (m *ForeignMessage) CloneVT() *ForeignMessage {
-	if m == nil {
-		return (*ForeignMessage)(nil)
+	for k, v := range m.MapUint64Uint64 {
+		dst.MapUint64Uint64[k] = v
 	}
-	r := &ForeignMessage{
-		C: m.C,
+	if dst.MapSint32Sint32 == nil && len(m.MapSint32Sint32) > 0 {
+		dst.MapSint32Sint32 = make(map[int32]int32, len(m.MapSint32Sint32))
 	}
-	if len(m.unknownFields) > 0 {
-		r.unknownFields = make([]byte, len(m.unknownFields))
-		copy(r.unknownFields, m.unknownFields)
+	for k := range dst.MapSint32Sint32 {
+		if _, ok := m.MapSint32Sint32[k]; !ok {
+			delete(dst.MapSint32Sint32, k)
+		}
 	}
-	return r
-}
-
-func (m *ForeignMessage) CloneGenericVT() proto.Message {
-	return m.CloneVT()
-}
-
-func (m *NullHypothesisProto3) CloneVT() *NullHypothesisProto3 {
-	if m == nil {
-		return (*NullHypothesisProto3)(nil)
+	for k, v := range m.MapSint32Sint32 {
+		dst.MapSint32Sint32[k] = v
 	}
-	r := &NullHypothesisProto3{}
-	if len(m.unknownFields) > 0 {
-		r.unknownFields = make([]byte, len(m.unknownFields))
-		copy(r.unknownFields, m.unknownFields)
+	if dst.MapSint64Sint64 == nil && len(m.MapSint64Sint64) > 0 {
+		dst.MapSint64Sint64 = make(map[int64]int64, len(m.MapSint64Sint64))
 	}
-	return r
-}
-
-func (m *NullHypothesisProto3) CloneGenericVT() proto.Message {
-	return m.CloneVT()
-}
-
-func (m *EnumOnlyProto3) CloneVT() *EnumOnlyProto3 {
-	if m == nil {
-		return (*EnumOnlyProto3)(nil)
+	for k := range dst.MapSint64Sint64 {
+		if _, ok := m.MapSint64Sint64[k]; !ok {
+			delete(dst.MapSint64Sint64, k)
+		}
 	}
-	r := &EnumOnlyProto3{}
-	if len(m.unknownFields) > 0 {
-		r.unknownFields = make([]byte, len(m.unknownFields))
-		copy(r.unknownFields, m.unknownFields)
+	for k, v := range m.MapSint64Sint64 {
+		dst.MapSint64Sint64[k] = v
 	}
-	return r
-}
-
-func (m *EnumOnlyProto3) CloneGenericVT() proto.Message {
-	return m.CloneVT()
-}
-
-func (this *TestAllTypesProto3_NestedMessage) EqualVT(that *TestAllTypesProto3_NestedMessage) bool {
-	if this == nil {
-		return that == nil
-	} else if that == nil {
-		return false
+	if dst.MapFixed32Fixed32 == nil && len(m.MapFixed32Fixed32) > 0 {
+		dst.MapFixed32Fixed32 = make(map[uint32]uint32, len(m.MapFixed32Fixed32))
 	}
-	if this.A != that.A {
-		return false
+	for k := range dst.MapFixed32Fixed32 {
+		if _, ok := m.MapFixed32Fixed32[k]; !ok {
+			delete(dst.MapFixed32Fixed32, k)
+		}
 	}
-	if !this.Corecursive.EqualVT(that.Corecursive) {
-		return false
+	for k, v := range m.MapFixed32Fixed32 {
+		dst.MapFixed32Fixed32[k] = v
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
-}
-
-func (this *TestAllTypesProto3) EqualVT(that *TestAllTypesProto3) bool {
-	if this == nil {
-		return that == nil
-	} else if that == nil {
-		return false
+	if dst.MapFixed64Fixed64 == nil && len(m.MapFixed64Fixed64) > 0 {
+		dst.MapFixed64Fixed64 = make(map[uint64]uint64, len(m.MapFixed64Fixed64))
 	}
-	if this.OneofField == nil && that.OneofField != nil {
-		return false
-	} else if this.OneofField != nil {
-		if that.OneofField == nil {
-			return false
+	for k := range dst.MapFixed64Fixed64 {
+		if _, ok := m.MapFixed64Fixed64[k]; !ok {
+			delete(dst.MapFixed64Fixed64, k)
 		}
-		if !this.OneofField.(interface {
-			EqualVT(isTestAllTypesProto3_OneofField) bool
-		}).EqualVT(that.OneofField) {
-			return false
+	}
+	for k, v := range m.MapFixed64Fixed64 {
+		dst.MapFixed64Fixed64[k] = v
+	}
+	if dst.MapSfixed32Sfixed32 == nil && len(m.MapSfixed32Sfixed32) > 0 {
+		dst.MapSfixed32Sfixed32 = make(map[int32]int32, len(m.MapSfixed32Sfixed32))
+	}
+	for k := range dst.MapSfixed32Sfixed32 {
+		if _, ok := m.MapSfixed32Sfixed32[k]; !ok {
+			delete(dst.MapSfixed32Sfixed32, k)
 		}
 	}
-	if this.OptionalInt32 != that.OptionalInt32 {
-		return false
+	for k, v := range m.MapSfixed32Sfixed32 {
+		dst.MapSfixed32Sfixed32[k] = v
 	}
-	if this.OptionalInt64 != that.OptionalInt64 {
-		return false
+	if dst.MapSfixed64Sfixed64 == nil && len(m.MapSfixed64Sfixed64) > 0 {
+		dst.MapSfixed64Sfixed64 = make(map[int64]int64, len(m.MapSfixed64Sfixed64))
 	}
-	if this.OptionalUint32 != that.OptionalUint32 {
-		return false
+	for k := range dst.MapSfixed64Sfixed64 {
+		if _, ok := m.MapSfixed64Sfixed64[k]; !ok {
+			delete(dst.MapSfixed64Sfixed64, k)
+		}
 	}
-	if this.OptionalUint64 != that.OptionalUint64 {
-		return false
+	for k, v := range m.MapSfixed64Sfixed64 {
+		dst.MapSfixed64Sfixed64[k] = v
 	}
-	if this.OptionalSint32 != that.OptionalSint32 {
-		return false
+	if dst.MapInt32Float == nil && len(m.MapInt32Float) > 0 {
+		dst.MapInt32Float = make(map[int32]float32, len(m.MapInt32Float))
 	}
-	if this.OptionalSint64 != that.OptionalSint64 {
-		return false
+	for k := range dst.MapInt32Float {
+		if _, ok := m.MapInt32Float[k]; !ok {
+			delete(dst.MapInt32Float, k)
+		}
 	}
-	if this.OptionalFixed32 != that.OptionalFixed32 {
-		return false
+	for k, v := range m.MapInt32Float {
+		dst.MapInt32Float[k] = v
 	}
-	if this.OptionalFixed64 != that.OptionalFixed64 {
-		return false
+	if dst.MapInt32Double == nil && len(m.MapInt32Double) > 0 {
+		dst.MapInt32Double = make(map[int32]float64, len(m.MapInt32Double))
 	}
-	if this.OptionalSfixed32 != that.OptionalSfixed32 {
-		return false
+	for k := range dst.MapInt32Double {
+		if _, ok := m.MapInt32Double[k]; !ok {
+			delete(dst.MapInt32Double, k)
+		}
 	}
-	if this.OptionalSfixed64 != that.OptionalSfixed64 {
-		return false
+	for k, v := range m.MapInt32Double {
+		dst.MapInt32Double[k] = v
 	}
-	if this.OptionalFloat != that.OptionalFloat {
-		return false
+	if dst.MapBoolBool == nil && len(m.MapBoolBool) > 0 {
+		dst.MapBoolBool = make(map[bool]bool, len(m.MapBoolBool))
 	}
-	if this.OptionalDouble != that.OptionalDouble {
-		return false
+	for k := range dst.MapBoolBool {
+		if _, ok := m.MapBoolBool[k]; !ok {
+			delete(dst.MapBoolBool, k)
+		}
 	}
-	if this.OptionalBool != that.OptionalBool {
-		return false
+	for k, v := range m.MapBoolBool {
+		dst.MapBoolBool[k] = v
 	}
-	if this.OptionalString != that.OptionalString {
-		return false
+	if dst.MapStringString == nil && len(m.MapStringString) > 0 {
+		dst.MapStringString = make(map[string]string, len(m.MapStringString))
 	}
-	if string(this.OptionalBytes) != string(that.OptionalBytes) {
-		return false
+	for k := range dst.MapStringString {
+		if _, ok := m.MapStringString[k]; !ok {
+			delete(dst.MapStringString, k)
+		}
 	}
-	if !this.OptionalNestedMessage.EqualVT(that.OptionalNestedMessage) {
-		return false
+	for k, v := range m.MapStringString {
+		dst.MapStringString[k] = v
 	}
-	if !this.OptionalForeignMessage.EqualVT(that.OptionalForeignMessage) {
-		return false
+	if dst.MapStringBytes == nil && len(m.MapStringBytes) > 0 {
+		dst.MapStringBytes = make(map[string][]byte, len(m.MapStringBytes))
 	}
-	if this.OptionalNestedEnum != that.OptionalNestedEnum {
-		return false
+	for k := range dst.MapStringBytes {
+		if _, ok := m.MapStringBytes[k]; !ok {
+			delete(dst.MapStringBytes, k)
+		}
 	}
-	if this.OptionalForeignEnum != that.OptionalForeignEnum {
-		return false
+	for k, v := range m.MapStringBytes {
+		dst.MapStringBytes[k] = append(dst.MapStringBytes[k][:0], v...)
 	}
-	if this.OptionalAliasedEnum != that.OptionalAliasedEnum {
-		return false
+	if dst.MapStringNestedMessage == nil && len(m.MapStringNestedMessage) > 0 {
+		dst.MapStringNestedMessage = make(map[string]*TestAllTypesProto3_NestedMessage, len(m.MapStringNestedMessage))
 	}
-	if this.OptionalStringPiece != that.OptionalStringPiece {
-		return false
+	for k := range dst.MapStringNestedMessage {
+		if _, ok := m.MapStringNestedMessage[k]; !ok {
+			delete(dst.MapStringNestedMessage, k)
+		}
 	}
-	if this.OptionalCord != that.OptionalCord {
-		return false
+	for k, v := range m.MapStringNestedMessage {
+		if dst.MapStringNestedMessage[k] == nil {
+			dst.MapStringNestedMessage[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.MapStringNestedMessage[k])
+		}
 	}
-	if !this.RecursiveMessage.EqualVT(that.RecursiveMessage) {
-		return false
+	if dst.MapStringForeignMessage == nil && len(m.MapStringForeignMessage) > 0 {
+		dst.MapStringForeignMessage = make(map[string]*ForeignMessage, len(m.MapStringForeignMessage))
 	}
-	if len(this.RepeatedInt32) != len(that.RepeatedInt32) {
-		return false
+	for k := range dst.MapStringForeignMessage {
+		if _, ok := m.MapStringForeignMessage[k]; !ok {
+			delete(dst.MapStringForeignMessage, k)
+		}
 	}
-	for i, vx := range this.RepeatedInt32 {
-		vy := that.RepeatedInt32[i]
-		if vx != vy {
-			return false
+	for k, v := range m.MapStringForeignMessage {
+		if dst.MapStringForeignMessage[k] == nil {
+			dst.MapStringForeignMessage[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.MapStringForeignMessage[k])
 		}
 	}
-	if len(this.RepeatedInt64) != len(that.RepeatedInt64) {
-		return false
+	if dst.MapStringNestedEnum == nil && len(m.MapStringNestedEnum) > 0 {
+		dst.MapStringNestedEnum = make(map[string]TestAllTypesProto3_NestedEnum, len(m.MapStringNestedEnum))
 	}
-	for i, vx := range this.RepeatedInt64 {
-		vy := that.RepeatedInt64[i]
-		if vx != vy {
-			return false
+	for k := range dst.MapStringNestedEnum {
+		if _, ok := m.MapStringNestedEnum[k]; !ok {
+			delete(dst.MapStringNestedEnum, k)
 		}
 	}
-	if len(this.RepeatedUint32) != len(that.RepeatedUint32) {
-		return false
+	for k, v := range m.MapStringNestedEnum {
+		dst.MapStringNestedEnum[k] = v
 	}
-	for i, vx := range this.RepeatedUint32 {
-		vy := that.RepeatedUint32[i]
-		if vx != vy {
-			return false
+	if dst.MapStringForeignEnum == nil && len(m.MapStringForeignEnum) > 0 {
+		dst.MapStringForeignEnum = make(map[string]ForeignEnum, len(m.MapStringForeignEnum))
+	}
+	for k := range dst.MapStringForeignEnum {
+		if _, ok := m.MapStringForeignEnum[k]; !ok {
+			delete(dst.MapStringForeignEnum, k)
 		}
 	}
-	if len(this.RepeatedUint64) != len(that.RepeatedUint64) {
-		return false
+	for k, v := range m.MapStringForeignEnum {
+		dst.MapStringForeignEnum[k] = v
 	}
-	for i, vx := range this.RepeatedUint64 {
-		vy := that.RepeatedUint64[i]
-		if vx != vy {
-			return false
+	switch c := m.OneofField.(type) {
+	case *TestAllTypesProto3_OneofUint32:
+		if d, ok := dst.OneofField.(*TestAllTypesProto3_OneofUint32); ok {
+			d.OneofUint32 = c.OneofUint32
+		} else {
+			dst.OneofField = c.CloneVT()
 		}
+	case *TestAllTypesProto3_OneofNestedMessage:
+		if d, ok := dst.OneofField.(*TestAllTypesProto3_OneofNestedMessage); ok {
+			if d.OneofNestedMessage == nil {
+				d.OneofNestedMessage = c.OneofNestedMessage.CloneVT()
+			} else {
+				c.OneofNestedMessage.CopyToVT(d.OneofNestedMessage)
+			}
+		} else {
+			dst.OneofField = c.CloneVT()
+		}
+	case *TestAllTypesProto3_OneofString:
+		if d, ok := dst.OneofField.(*TestAllTypesProto3_OneofString); ok {
+			d.OneofString = c.OneofString
+		} else {
+			dst.OneofField = c.CloneVT()
+		}
+	case *TestAllTypesProto3_OneofBytes:
+		if d, ok := dst.OneofField.(*TestAllTypesProto3_OneofBytes); ok {
+			d.OneofBytes = append(d.OneofBytes[:0], c.OneofBytes...)
+		} else {
+			dst.OneofField = c.CloneVT()
+		}
+	case *TestAllTypesProto3_OneofBool:
+		if d, ok := dst.OneofField.(*TestAllTypesProto3_OneofBool); ok {
+			d.OneofBool = c.OneofBool
+		} else {
+			dst.OneofField = c.CloneVT()
+		}
+	case *TestAllTypesProto3_OneofUint64:
+		if d, ok := dst.OneofField.(*TestAllTypesProto3_OneofUint64); ok {
+			d.OneofUint64 = c.OneofUint64
+		} else {
+			dst.OneofField = c.CloneVT()
+		}
+	case *TestAllTypesProto3_OneofFloat:
+		if d, ok := dst.OneofField.(*TestAllTypesProto3_OneofFloat); ok {
+			d.OneofFloat = c.OneofFloat
+		} else {
+			dst.OneofField = c.CloneVT()
+		}
+	case *TestAllTypesProto3_OneofDouble:
+		if d, ok := dst.OneofField.(*TestAllTypesProto3_OneofDouble); ok {
+			d.OneofDouble = c.OneofDouble
+		} else {
+			dst.OneofField = c.CloneVT()
+		}
+	case *TestAllTypesProto3_OneofEnum:
+		if d, ok := dst.OneofField.(*TestAllTypesProto3_OneofEnum); ok {
+			d.OneofEnum = c.OneofEnum
+		} else {
+			dst.OneofField = c.CloneVT()
+		}
+	case *TestAllTypesProto3_OneofNullValue:
+		if d, ok := dst.OneofField.(*TestAllTypesProto3_OneofNullValue); ok {
+			d.OneofNullValue = c.OneofNullValue
+		} else {
+			dst.OneofField = c.CloneVT()
+		}
+	default:
+		dst.OneofField = nil
 	}
-	if len(this.RepeatedSint32) != len(that.RepeatedSint32) {
-		return false
-	}
-	for i, vx := range this.RepeatedSint32 {
-		vy := that.RepeatedSint32[i]
-		if vx != vy {
-			return false
+	if m.OptionalBoolWrapper == nil {
+		dst.OptionalBoolWrapper = nil
+	} else {
+		if dst.OptionalBoolWrapper == nil {
+			if vtpb, ok := interface{}(m.OptionalBoolWrapper).(interface{ CloneVT() *wrapperspb.BoolValue }); ok {
+				dst.OptionalBoolWrapper = vtpb.CloneVT()
+			} else {
+				dst.OptionalBoolWrapper = proto.Clone(m.OptionalBoolWrapper).(*wrapperspb.BoolValue)
+			}
+		} else {
+			if vtpb, ok := interface{}(m.OptionalBoolWrapper).(interface{ CopyToVT(*wrapperspb.BoolValue) }); ok {
+				vtpb.CopyToVT(dst.OptionalBoolWrapper)
+			} else {
+				proto.Reset(dst.OptionalBoolWrapper)
+				proto.Merge(dst.OptionalBoolWrapper, m.OptionalBoolWrapper)
+			}
 		}
 	}
-	if len(this.RepeatedSint64) != len(that.RepeatedSint64) {
-		return false
+	if m.OptionalInt32Wrapper == nil {
+		dst.OptionalInt32Wrapper = nil
+	} else {
+		if dst.OptionalInt32Wrapper == nil {
+			if vtpb, ok := interface{}(m.OptionalInt32Wrapper).(interface{ CloneVT() *wrapperspb.Int32Value }); ok {
+				dst.OptionalInt32Wrapper = vtpb.CloneVT()
+			} else {
+				dst.OptionalInt32Wrapper = proto.Clone(m.OptionalInt32Wrapper).(*wrapperspb.Int32Value)
+			}
+		} else {
+			if vtpb, ok := interface{}(m.OptionalInt32Wrapper).(interface{ CopyToVT(*wrapperspb.Int32Value) }); ok {
+				vtpb.CopyToVT(dst.OptionalInt32Wrapper)
+			} else {
+				proto.Reset(dst.OptionalInt32Wrapper)
+				proto.Merge(dst.OptionalInt32Wrapper, m.OptionalInt32Wrapper)
+			}
+		}
 	}
-	for i, vx := range this.RepeatedSint64 {
-		vy := that.RepeatedSint64[i]
-		if vx != vy {
-			return false
+	if m.OptionalInt64Wrapper == nil {
+		dst.OptionalInt64Wrapper = nil
+	} else {
+		if dst.OptionalInt64Wrapper == nil {
+			if vtpb, ok := interface{}(m.OptionalInt64Wrapper).(interface{ CloneVT() *wrapperspb.Int64Value }); ok {
+				dst.OptionalInt64Wrapper = vtpb.CloneVT()
+			} else {
+				dst.OptionalInt64Wrapper = proto.Clone(m.OptionalInt64Wrapper).(*wrapperspb.Int64Value)
+			}
+		} else {
+			if vtpb, ok := interface{}(m.OptionalInt64Wrapper).(interface{ CopyToVT(*wrapperspb.Int64Value) }); ok {
+				vtpb.CopyToVT(dst.OptionalInt64Wrapper)
+			} else {
+				proto.Reset(dst.OptionalInt64Wrapper)
+				proto.Merge(dst.OptionalInt64Wrapper, m.OptionalInt64Wrapper)
+			}
 		}
 	}
-	if len(this.RepeatedFixed32) != len(that.RepeatedFixed32) {
-		return false
+	if m.OptionalUint32Wrapper == nil {
+		dst.OptionalUint32Wrapper = nil
+	} else {
+		if dst.OptionalUint32Wrapper == nil {
+			if vtpb, ok := interface{}(m.OptionalUint32Wrapper).(interface {
+				CloneVT() *wrapperspb.UInt32Value
+			}); ok {
+				dst.OptionalUint32Wrapper = vtpb.CloneVT()
+			} else {
+				dst.OptionalUint32Wrapper = proto.Clone(m.OptionalUint32Wrapper).(*wrapperspb.UInt32Value)
+			}
+		} else {
+			if vtpb, ok := interface{}(m.OptionalUint32Wrapper).(interface{ CopyToVT(*wrapperspb.UInt32Value) }); ok {
+				vtpb.CopyToVT(dst.OptionalUint32Wrapper)
+			} else {
+				proto.Reset(dst.OptionalUint32Wrapper)
+				proto.Merge(dst.OptionalUint32Wrapper, m.OptionalUint32Wrapper)
+			}
+		}
 	}
-	for i, vx := range this.RepeatedFixed32 {
-		vy := that.RepeatedFixed32[i]
-		if vx != vy {
-			return false
+	if m.OptionalUint64Wrapper == nil {
+		dst.OptionalUint64Wrapper = nil
+	} else {
+		if dst.OptionalUint64Wrapper == nil {
+			if vtpb, ok := interface{}(m.OptionalUint64Wrapper).(interface {
+				CloneVT() *wrapperspb.UInt64Value
+			}); ok {
+				dst.OptionalUint64Wrapper = vtpb.CloneVT()
+			} else {
+				dst.OptionalUint64Wrapper = proto.Clone(m.OptionalUint64Wrapper).(*wrapperspb.UInt64Value)
+			}
+		} else {
+			if vtpb, ok := interface{}(m.OptionalUint64Wrapper).(interface{ CopyToVT(*wrapperspb.UInt64Value) }); ok {
+				vtpb.CopyToVT(dst.OptionalUint64Wrapper)
+			} else {
+				proto.Reset(dst.OptionalUint64Wrapper)
+				proto.Merge(dst.OptionalUint64Wrapper, m.OptionalUint64Wrapper)
+			}
 		}
 	}
-	if len(this.RepeatedFixed64) != len(that.RepeatedFixed64) {
-		return false
+	if m.OptionalFloatWrapper == nil {
+		dst.OptionalFloatWrapper = nil
+	} else {
+		if dst.OptionalFloatWrapper == nil {
+			if vtpb, ok := interface{}(m.OptionalFloatWrapper).(interface{ CloneVT() *wrapperspb.FloatValue }); ok {
+				dst.OptionalFloatWrapper = vtpb.CloneVT()
+			} else {
+				dst.OptionalFloatWrapper = proto.Clone(m.OptionalFloatWrapper).(*wrapperspb.FloatValue)
+			}
+		} else {
+			if vtpb, ok := interface{}(m.OptionalFloatWrapper).(interface{ CopyToVT(*wrapperspb.FloatValue) }); ok {
+				vtpb.CopyToVT(dst.OptionalFloatWrapper)
+			} else {
+				proto.Reset(dst.OptionalFloatWrapper)
+				proto.Merge(dst.OptionalFloatWrapper, m.OptionalFloatWrapper)
+			}
+		}
 	}
-	for i, vx := range this.RepeatedFixed64 {
-		vy := that.RepeatedFixed64[i]
-		if vx != vy {
-			return false
+	if m.OptionalDoubleWrapper == nil {
+		dst.OptionalDoubleWrapper = nil
+	} else {
+		if dst.OptionalDoubleWrapper == nil {
+			if vtpb, ok := interface{}(m.OptionalDoubleWrapper).(interface {
+				CloneVT() *wrapperspb.DoubleValue
+			}); ok {
+				dst.OptionalDoubleWrapper = vtpb.CloneVT()
+			} else {
+				dst.OptionalDoubleWrapper = proto.Clone(m.OptionalDoubleWrapper).(*wrapperspb.DoubleValue)
+			}
+		} else {
+			if vtpb, ok := interface{}(m.OptionalDoubleWrapper).(interface{ CopyToVT(*wrapperspb.DoubleValue) }); ok {
+				vtpb.CopyToVT(dst.OptionalDoubleWrapper)
+			} else {
+				proto.Reset(dst.OptionalDoubleWrapper)
+				proto.Merge(dst.OptionalDoubleWrapper, m.OptionalDoubleWrapper)
+			}
 		}
 	}
-	if len(this.RepeatedSfixed32) != len(that.RepeatedSfixed32) {
-		return false
+	if m.OptionalStringWrapper == nil {
+		dst.OptionalStringWrapper = nil
+	} else {
+		if dst.OptionalStringWrapper == nil {
+			if vtpb, ok := interface{}(m.OptionalStringWrapper).(interface {
+				CloneVT() *wrapperspb.StringValue
+			}); ok {
+				dst.OptionalStringWrapper = vtpb.CloneVT()
+			} else {
+				dst.OptionalStringWrapper = proto.Clone(m.OptionalStringWrapper).(*wrapperspb.StringValue)
+			}
+		} else {
+			if vtpb, ok := interface{}(m.OptionalStringWrapper).(interface{ CopyToVT(*wrapperspb.StringValue) }); ok {
+				vtpb.CopyToVT(dst.OptionalStringWrapper)
+			} else {
+				proto.Reset(dst.OptionalStringWrapper)
+				proto.Merge(dst.OptionalStringWrapper, m.OptionalStringWrapper)
+			}
+		}
 	}
-	for i, vx := range this.RepeatedSfixed32 {
-		vy := that.RepeatedSfixed32[i]
-		if vx != vy {
-			return false
+	if m.OptionalBytesWrapper == nil {
+		dst.OptionalBytesWrapper = nil
+	} else {
+		if dst.OptionalBytesWrapper == nil {
+			if vtpb, ok := interface{}(m.OptionalBytesWrapper).(interface{ CloneVT() *wrapperspb.BytesValue }); ok {
+				dst.OptionalBytesWrapper = vtpb.CloneVT()
+			} else {
+				dst.OptionalBytesWrapper = proto.Clone(m.OptionalBytesWrapper).(*wrapperspb.BytesValue)
+			}
+		} else {
+			if vtpb, ok := interface{}(m.OptionalBytesWrapper).(interface{ CopyToVT(*wrapperspb.BytesValue) }); ok {
+				vtpb.CopyToVT(dst.OptionalBytesWrapper)
+			} else {
+				proto.Reset(dst.OptionalBytesWrapper)
+				proto.Merge(dst.OptionalBytesWrapper, m.OptionalBytesWrapper)
+			}
 		}
 	}
-	if len(this.RepeatedSfixed64) != len(that.RepeatedSfixed64) {
-		return false
+	if len(m.RepeatedBoolWrapper) > cap(dst.RepeatedBoolWrapper) {
+		tmpContainer := make([]*wrapperspb.BoolValue, len(m.RepeatedBoolWrapper))
+		copy(tmpContainer, dst.RepeatedBoolWrapper[:cap(dst.RepeatedBoolWrapper)])
+		dst.RepeatedBoolWrapper = tmpContainer
+	} else {
+		dst.RepeatedBoolWrapper = dst.RepeatedBoolWrapper[:len(m.RepeatedBoolWrapper)]
 	}
-	for i, vx := range this.RepeatedSfixed64 {
-		vy := that.RepeatedSfixed64[i]
-		if vx != vy {
-			return false
+	for k, v := range m.RepeatedBoolWrapper {
+		if dst.RepeatedBoolWrapper[k] == nil {
+			if vtpb, ok := interface{}(v).(interface{ CloneVT() *wrapperspb.BoolValue }); ok {
+				dst.RepeatedBoolWrapper[k] = vtpb.CloneVT()
+			} else {
+				dst.RepeatedBoolWrapper[k] = proto.Clone(v).(*wrapperspb.BoolValue)
+			}
+		} else {
+			if vtpb, ok := interface{}(v).(interface{ CopyToVT(*wrapperspb.BoolValue) }); ok {
+				vtpb.CopyToVT(dst.RepeatedBoolWrapper[k])
+			} else {
+				proto.Reset(dst.RepeatedBoolWrapper[k])
+				proto.Merge(dst.RepeatedBoolWrapper[k], v)
+			}
 		}
 	}
-	if len(this.RepeatedFloat) != len(that.RepeatedFloat) {
-		return false
+	if len(m.RepeatedInt32Wrapper) > cap(dst.RepeatedInt32Wrapper) {
+		tmpContainer := make([]*wrapperspb.Int32Value, len(m.RepeatedInt32Wrapper))
+		copy(tmpContainer, dst.RepeatedInt32Wrapper[:cap(dst.RepeatedInt32Wrapper)])
+		dst.RepeatedInt32Wrapper = tmpContainer
+	} else {
+		dst.RepeatedInt32Wrapper = dst.RepeatedInt32Wrapper[:len(m.RepeatedInt32Wrapper)]
 	}
-	for i, vx := range this.RepeatedFloat {
-		vy := that.RepeatedFloat[i]
-		if vx != vy {
-			return false
+	for k, v := range m.RepeatedInt32Wrapper {
+		if dst.RepeatedInt32Wrapper[k] == nil {
+			if vtpb, ok := interface{}(v).(interface{ CloneVT() *wrapperspb.Int32Value }); ok {
+				dst.RepeatedInt32Wrapper[k] = vtpb.CloneVT()
+			} else {
+				dst.RepeatedInt32Wrapper[k] = proto.Clone(v).(*wrapperspb.Int32Value)
+			}
+		} else {
+			if vtpb, ok := interface{}(v).(interface{ CopyToVT(*wrapperspb.Int32Value) }); ok {
+				vtpb.CopyToVT(dst.RepeatedInt32Wrapper[k])
+			} else {
+				proto.Reset(dst.RepeatedInt32Wrapper[k])
+				proto.Merge(dst.RepeatedInt32Wrapper[k], v)
+			}
 		}
 	}
-	if len(this.RepeatedDouble) != len(that.RepeatedDouble) {
-		return false
+	if len(m.RepeatedInt64Wrapper) > cap(dst.RepeatedInt64Wrapper) {
+		tmpContainer := make([]*wrapperspb.Int64Value, len(m.RepeatedInt64Wrapper))
+		copy(tmpContainer, dst.RepeatedInt64Wrapper[:cap(dst.RepeatedInt64Wrapper)])
+		dst.RepeatedInt64Wrapper = tmpContainer
+	} else {
+		dst.RepeatedInt64Wrapper = dst.RepeatedInt64Wrapper[:len(m.RepeatedInt64Wrapper)]
 	}
-	for i, vx := range this.RepeatedDouble {
-		vy := that.RepeatedDouble[i]
-		if vx != vy {
-			return false
+	for k, v := range m.RepeatedInt64Wrapper {
+		if dst.RepeatedInt64Wrapper[k] == nil {
+			if vtpb, ok := interface{}(v).(interface{ CloneVT() *wrapperspb.Int64Value }); ok {
+				dst.RepeatedInt64Wrapper[k] = vtpb.CloneVT()
+			} else {
+				dst.RepeatedInt64Wrapper[k] = proto.Clone(v).(*wrapperspb.Int64Value)
+			}
+		} else {
+			if vtpb, ok := interface{}(v).(interface{ CopyToVT(*wrapperspb.Int64Value) }); ok {
+				vtpb.CopyToVT(dst.RepeatedInt64Wrapper[k])
+			} else {
+				proto.Reset(dst.RepeatedInt64Wrapper[k])
+				proto.Merge(dst.RepeatedInt64Wrapper[k], v)
+			}
 		}
 	}
-	if len(this.RepeatedBool) != len(that.RepeatedBool) {
-		return false
+	if len(m.RepeatedUint32Wrapper) > cap(dst.RepeatedUint32Wrapper) {
+		tmpContainer := make([]*wrapperspb.UInt32Value, len(m.RepeatedUint32Wrapper))
+		copy(tmpContainer, dst.RepeatedUint32Wrapper[:cap(dst.RepeatedUint32Wrapper)])
+		dst.RepeatedUint32Wrapper = tmpContainer
+	} else {
+		dst.RepeatedUint32Wrapper = dst.RepeatedUint32Wrapper[:len(m.RepeatedUint32Wrapper)]
 	}
-	for i, vx := range this.RepeatedBool {
-		vy := that.RepeatedBool[i]
-		if vx != vy {
-			return false
+	for k, v := range m.RepeatedUint32Wrapper {
+		if dst.RepeatedUint32Wrapper[k] == nil {
+			if vtpb, ok := interface{}(v).(interface {
+				CloneVT() *wrapperspb.UInt32Value
+			}); ok {
+				dst.RepeatedUint32Wrapper[k] = vtpb.CloneVT()
+			} else {
+				dst.RepeatedUint32Wrapper[k] = proto.Clone(v).(*wrapperspb.UInt32Value)
+			}
+		} else {
+			if vtpb, ok := interface{}(v).(interface{ CopyToVT(*wrapperspb.UInt32Value) }); ok {
+				vtpb.CopyToVT(dst.RepeatedUint32Wrapper[k])
+			} else {
+				proto.Reset(dst.RepeatedUint32Wrapper[k])
+				proto.Merge(dst.RepeatedUint32Wrapper[k], v)
+			}
 		}
 	}
-	if len(this.RepeatedString) != len(that.RepeatedString) {
-		return false
+	if len(m.RepeatedUint64Wrapper) > cap(dst.RepeatedUint64Wrapper) {
+		tmpContainer := make([]*wrapperspb.UInt64Value, len(m.RepeatedUint64Wrapper))
+		copy(tmpContainer, dst.RepeatedUint64Wrapper[:cap(dst.RepeatedUint64Wrapper)])
+		dst.RepeatedUint64Wrapper = tmpContainer
+	} else {
+		dst.RepeatedUint64Wrapper = dst.RepeatedUint64Wrapper[:len(m.RepeatedUint64Wrapper)]
 	}
-	for i, vx := range this.RepeatedString {
-		vy := that.RepeatedString[i]
-		if vx != vy {
-			return false
+	for k, v := range m.RepeatedUint64Wrapper {
+		if dst.RepeatedUint64Wrapper[k] == nil {
+			if vtpb, ok := interface{}(v).(interface {
+				CloneVT() *wrapperspb.UInt64Value
+			}); ok {
+				dst.RepeatedUint64Wrapper[k] = vtpb.CloneVT()
+			} else {
+				dst.RepeatedUint64Wrapper[k] = proto.Clone(v).(*wrapperspb.UInt64Value)
+			}
+		} else {
+			if vtpb, ok := interface{}(v).(interface{ CopyToVT(*wrapperspb.UInt64Value) }); ok {
+				vtpb.CopyToVT(dst.RepeatedUint64Wrapper[k])
+			} else {
+				proto.Reset(dst.RepeatedUint64Wrapper[k])
+				proto.Merge(dst.RepeatedUint64Wrapper[k], v)
+			}
 		}
 	}
-	if len(this.RepeatedBytes) != len(that.RepeatedBytes) {
-		return false
+	if len(m.RepeatedFloatWrapper) > cap(dst.RepeatedFloatWrapper) {
+		tmpContainer := make([]*wrapperspb.FloatValue, len(m.RepeatedFloatWrapper))
+		copy(tmpContainer, dst.RepeatedFloatWrapper[:cap(dst.RepeatedFloatWrapper)])
+		dst.RepeatedFloatWrapper = tmpContainer
+	} else {
+		dst.RepeatedFloatWrapper = dst.RepeatedFloatWrapper[:len(m.RepeatedFloatWrapper)]
 	}
-	for i, vx := range this.RepeatedBytes {
-		vy := that.RepeatedBytes[i]
-		if string(vx) != string(vy) {
-			return false
+	for k, v := range m.RepeatedFloatWrapper {
+		if dst.RepeatedFloatWrapper[k] == nil {
+			if vtpb, ok := interface{}(v).(interface{ CloneVT() *wrapperspb.FloatValue }); ok {
+				dst.RepeatedFloatWrapper[k] = vtpb.CloneVT()
+			} else {
+				dst.RepeatedFloatWrapper[k] = proto.Clone(v).(*wrapperspb.FloatValue)
+			}
+		} else {
+			if vtpb, ok := interface{}(v).(interface{ CopyToVT(*wrapperspb.FloatValue) }); ok {
+				vtpb.CopyToVT(dst.RepeatedFloatWrapper[k])
+			} else {
+				proto.Reset(dst.RepeatedFloatWrapper[k])
+				proto.Merge(dst.RepeatedFloatWrapper[k], v)
+			}
 		}
 	}
-	if len(this.RepeatedNestedMessage) != len(that.RepeatedNestedMessage) {
-		return false
+	if len(m.RepeatedDoubleWrapper) > cap(dst.RepeatedDoubleWrapper) {
+		tmpContainer := make([]*wrapperspb.DoubleValue, len(m.RepeatedDoubleWrapper))
+		copy(tmpContainer, dst.RepeatedDoubleWrapper[:cap(dst.RepeatedDoubleWrapper)])
+		dst.RepeatedDoubleWrapper = tmpContainer
+	} else {
+		dst.RepeatedDoubleWrapper = dst.RepeatedDoubleWrapper[:len(m.RepeatedDoubleWrapper)]
 	}
-	for i, vx := range this.RepeatedNestedMessage {
-		vy := that.RepeatedNestedMessage[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &TestAllTypesProto3_NestedMessage{}
-			}
-			if q == nil {
-				q = &TestAllTypesProto3_NestedMessage{}
+	for k, v := range m.RepeatedDoubleWrapper {
+		if dst.RepeatedDoubleWrapper[k] == nil {
+			if vtpb, ok := interface{}(v).(interface {
+				CloneVT() *wrapperspb.DoubleValue
+			}); ok {
+				dst.RepeatedDoubleWrapper[k] = vtpb.CloneVT()
+			} else {
+				dst.RepeatedDoubleWrapper[k] = proto.Clone(v).(*wrapperspb.DoubleValue)
 			}
-			if !p.EqualVT(q) {
-				return false
+		} else {
+			if vtpb, ok := interface{}(v).(interface{ CopyToVT(*wrapperspb.DoubleValue) }); ok {
+				vtpb.CopyToVT(dst.RepeatedDoubleWrapper[k])
+			} else {
+				proto.Reset(dst.RepeatedDoubleWrapper[k])
+				proto.Merge(dst.RepeatedDoubleWrapper[k], v)
 			}
 		}
 	}
-	if len(this.RepeatedForeignMessage) != len(that.RepeatedForeignMessage) {
-		return false
+	if len(m.RepeatedStringWrapper) > cap(dst.RepeatedStringWrapper) {
+		tmpContainer := make([]*wrapperspb.StringValue, len(m.RepeatedStringWrapper))
+		copy(tmpContainer, dst.RepeatedStringWrapper[:cap(dst.RepeatedStringWrapper)])
+		dst.RepeatedStringWrapper = tmpContainer
+	} else {
+		dst.RepeatedStringWrapper = dst.RepeatedStringWrapper[:len(m.RepeatedStringWrapper)]
 	}
-	for i, vx := range this.RepeatedForeignMessage {
-		vy := that.RepeatedForeignMessage[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &ForeignMessage{}
-			}
-			if q == nil {
-				q = &ForeignMessage{}
+	for k, v := range m.RepeatedStringWrapper {
+		if dst.RepeatedStringWrapper[k] == nil {
+			if vtpb, ok := interface{}(v).(interface {
+				CloneVT() *wrapperspb.StringValue
+			}); ok {
+				dst.RepeatedStringWrapper[k] = vtpb.CloneVT()
+			} else {
+				dst.RepeatedStringWrapper[k] = proto.Clone(v).(*wrapperspb.StringValue)
 			}
-			if !p.EqualVT(q) {
-				return false
+		} else {
+			if vtpb, ok := interface{}(v).(interface{ CopyToVT(*wrapperspb.StringValue) }); ok {
+				vtpb.CopyToVT(dst.RepeatedStringWrapper[k])
+			} else {
+				proto.Reset(dst.RepeatedStringWrapper[k])
+				proto.Merge(dst.RepeatedStringWrapper[k], v)
 			}
 		}
 	}
-	if len(this.RepeatedNestedEnum) != len(that.RepeatedNestedEnum) {
-		return false
+	if len(m.RepeatedBytesWrapper) > cap(dst.RepeatedBytesWrapper) {
+		tmpContainer := make([]*wrapperspb.BytesValue, len(m.RepeatedBytesWrapper))
+		copy(tmpContainer, dst.RepeatedBytesWrapper[:cap(dst.RepeatedBytesWrapper)])
+		dst.RepeatedBytesWrapper = tmpContainer
+	} else {
+		dst.RepeatedBytesWrapper = dst.RepeatedBytesWrapper[:len(m.RepeatedBytesWrapper)]
 	}
-	for i, vx := range this.RepeatedNestedEnum {
-		vy := that.RepeatedNestedEnum[i]
-		if vx != vy {
-			return false
+	for k, v := range m.RepeatedBytesWrapper {
+		if dst.RepeatedBytesWrapper[k] == nil {
+			if vtpb, ok := interface{}(v).(interface{ CloneVT() *wrapperspb.BytesValue }); ok {
+				dst.RepeatedBytesWrapper[k] = vtpb.CloneVT()
+			} else {
+				dst.RepeatedBytesWrapper[k] = proto.Clone(v).(*wrapperspb.BytesValue)
+			}
+		} else {
+			if vtpb, ok := interface{}(v).(interface{ CopyToVT(*wrapperspb.BytesValue) }); ok {
+				vtpb.CopyToVT(dst.RepeatedBytesWrapper[k])
+			} else {
+				proto.Reset(dst.RepeatedBytesWrapper[k])
+				proto.Merge(dst.RepeatedBytesWrapper[k], v)
+			}
 		}
 	}
-	if len(this.RepeatedForeignEnum) != len(that.RepeatedForeignEnum) {
-		return false
-	}
-	for i, vx := range this.RepeatedForeignEnum {
-		vy := that.RepeatedForeignEnum[i]
-		if vx != vy {
-			return false
+	if m.OptionalDuration == nil {
+		dst.OptionalDuration = nil
+	} else {
+		if dst.OptionalDuration == nil {
+			if vtpb, ok := interface{}(m.OptionalDuration).(interface{ CloneVT() *durationpb.Duration }); ok {
+				dst.OptionalDuration = vtpb.CloneVT()
+			} else {
+				dst.OptionalDuration = proto.Clone(m.OptionalDuration).(*durationpb.Duration)
+			}
+		} else {
+			if vtpb, ok := interface{}(m.OptionalDuration).(interface{ CopyToVT(*durationpb.Duration) }); ok {
+				vtpb.CopyToVT(dst.OptionalDuration)
+			} else {
+				proto.Reset(dst.OptionalDuration)
+				proto.Merge(dst.OptionalDuration, m.OptionalDuration)
+			}
 		}
 	}
-	if len(this.RepeatedStringPiece) != len(that.RepeatedStringPiece) {
-		return false
-	}
-	for i, vx := range this.RepeatedStringPiece {
-		vy := that.RepeatedStringPiece[i]
-		if vx != vy {
-			return false
+	if m.OptionalTimestamp == nil {
+		dst.OptionalTimestamp = nil
+	} else {
+		if dst.OptionalTimestamp == nil {
+			if vtpb, ok := interface{}(m.OptionalTimestamp).(interface{ CloneVT() *timestamppb.Timestamp }); ok {
+				dst.OptionalTimestamp = vtpb.CloneVT()
+			} else {
+				dst.OptionalTimestamp = proto.Clone(m.OptionalTimestamp).(*timestamppb.Timestamp)
+			}
+		} else {
+			if vtpb, ok := interface{}(m.OptionalTimestamp).(interface{ CopyToVT(*timestamppb.Timestamp) }); ok {
+				vtpb.CopyToVT(dst.OptionalTimestamp)
+			} else {
+				proto.Reset(dst.OptionalTimestamp)
+				proto.Merge(dst.OptionalTimestamp, m.OptionalTimestamp)
+			}
 		}
 	}
-	if len(this.RepeatedCord) != len(that.RepeatedCord) {
-		return false
-	}
-	for i, vx := range this.RepeatedCord {
-		vy := that.RepeatedCord[i]
-		if vx != vy {
-			return false
+	if m.OptionalFieldMask == nil {
+		dst.OptionalFieldMask = nil
+	} else {
+		if dst.OptionalFieldMask == nil {
+			if vtpb, ok := interface{}(m.OptionalFieldMask).(interface{ CloneVT() *fieldmaskpb.FieldMask }); ok {
+				dst.OptionalFieldMask = vtpb.CloneVT()
+			} else {
+				dst.OptionalFieldMask = proto.Clone(m.OptionalFieldMask).(*fieldmaskpb.FieldMask)
+			}
+		} else {
+			if vtpb, ok := interface{}(m.OptionalFieldMask).(interface{ CopyToVT(*fieldmaskpb.FieldMask) }); ok {
+				vtpb.CopyToVT(dst.OptionalFieldMask)
+			} else {
+				proto.Reset(dst.OptionalFieldMask)
+				proto.Merge(dst.OptionalFieldMask, m.OptionalFieldMask)
+			}
 		}
 	}
-	if len(this.MapInt32Int32) != len(that.MapInt32Int32) {
-		return false
-	}
-	for i, vx := range this.MapInt32Int32 {
-		vy, ok := that.MapInt32Int32[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
+	if m.OptionalStruct == nil {
+		dst.OptionalStruct = nil
+	} else {
+		if dst.OptionalStruct == nil {
+			if vtpb, ok := interface{}(m.OptionalStruct).(interface{ CloneVT() *structpb.Struct }); ok {
+				dst.OptionalStruct = vtpb.CloneVT()
+			} else {
+				dst.OptionalStruct = proto.Clone(m.OptionalStruct).(*structpb.Struct)
+			}
+		} else {
+			if vtpb, ok := interface{}(m.OptionalStruct).(interface{ CopyToVT(*structpb.Struct) }); ok {
+				vtpb.CopyToVT(dst.OptionalStruct)
+			} else {
+				proto.Reset(dst.OptionalStruct)
+				proto.Merge(dst.OptionalStruct, m.OptionalStruct)
+			}
 		}
 	}
-	if len(this.MapInt64Int64) != len(that.MapInt64Int64) {
-		return false
-	}
-	for i, vx := range this.MapInt64Int64 {
-		vy, ok := that.MapInt64Int64[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
+	if m.OptionalAny == nil {
+		dst.OptionalAny = nil
+	} else {
+		if dst.OptionalAny == nil {
+			if vtpb, ok := interface{}(m.OptionalAny).(interface{ CloneVT() *anypb.Any }); ok {
+				dst.OptionalAny = vtpb.CloneVT()
+			} else {
+				dst.OptionalAny = proto.Clone(m.OptionalAny).(*anypb.Any)
+			}
+		} else {
+			if vtpb, ok := interface{}(m.OptionalAny).(interface{ CopyToVT(*anypb.Any) }); ok {
+				vtpb.CopyToVT(dst.OptionalAny)
+			} else {
+				proto.Reset(dst.OptionalAny)
+				proto.Merge(dst.OptionalAny, m.OptionalAny)
+			}
 		}
 	}
-	if len(this.MapUint32Uint32) != len(that.MapUint32Uint32) {
-		return false
-	}
-	for i, vx := range this.MapUint32Uint32 {
-		vy, ok := that.MapUint32Uint32[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
+	if m.OptionalValue == nil {
+		dst.OptionalValue = nil
+	} else {
+		if dst.OptionalValue == nil {
+			if vtpb, ok := interface{}(m.OptionalValue).(interface{ CloneVT() *structpb.Value }); ok {
+				dst.OptionalValue = vtpb.CloneVT()
+			} else {
+				dst.OptionalValue = proto.Clone(m.OptionalValue).(*structpb.Value)
+			}
+		} else {
+			if vtpb, ok := interface{}(m.OptionalValue).(interface{ CopyToVT(*structpb.Value) }); ok {
+				vtpb.CopyToVT(dst.OptionalValue)
+			} else {
+				proto.Reset(dst.OptionalValue)
+				proto.Merge(dst.OptionalValue, m.OptionalValue)
+			}
 		}
 	}
-	if len(this.MapUint64Uint64) != len(that.MapUint64Uint64) {
-		return false
+	dst.OptionalNullValue = m.OptionalNullValue
+	if len(m.RepeatedDuration) > cap(dst.RepeatedDuration) {
+		tmpContainer := make([]*durationpb.Duration, len(m.RepeatedDuration))
+		copy(tmpContainer, dst.RepeatedDuration[:cap(dst.RepeatedDuration)])
+		dst.RepeatedDuration = tmpContainer
+	} else {
+		dst.RepeatedDuration = dst.RepeatedDuration[:len(m.RepeatedDuration)]
 	}
-	for i, vx := range this.MapUint64Uint64 {
-		vy, ok := that.MapUint64Uint64[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
+	for k, v := range m.RepeatedDuration {
+		if dst.RepeatedDuration[k] == nil {
+			if vtpb, ok := interface{}(v).(interface{ CloneVT() *durationpb.Duration }); ok {
+				dst.RepeatedDuration[k] = vtpb.CloneVT()
+			} else {
+				dst.RepeatedDuration[k] = proto.Clone(v).(*durationpb.Duration)
+			}
+		} else {
+			if vtpb, ok := interface{}(v).(interface{ CopyToVT(*durationpb.Duration) }); ok {
+				vtpb.CopyToVT(dst.RepeatedDuration[k])
+			} else {
+				proto.Reset(dst.RepeatedDuration[k])
+				proto.Merge(dst.RepeatedDuration[k], v)
+			}
 		}
 	}
-	if len(this.MapSint32Sint32) != len(that.MapSint32Sint32) {
-		return false
+	if len(m.RepeatedTimestamp) > cap(dst.RepeatedTimestamp) {
+		tmpContainer := make([]*timestamppb.Timestamp, len(m.RepeatedTimestamp))
+		copy(tmpContainer, dst.RepeatedTimestamp[:cap(dst.RepeatedTimestamp)])
+		dst.RepeatedTimestamp = tmpContainer
+	} else {
+		dst.RepeatedTimestamp = dst.RepeatedTimestamp[:len(m.RepeatedTimestamp)]
 	}
-	for i, vx := range this.MapSint32Sint32 {
-		vy, ok := that.MapSint32Sint32[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
+	for k, v := range m.RepeatedTimestamp {
+		if dst.RepeatedTimestamp[k] == nil {
+			if vtpb, ok := interface{}(v).(interface{ CloneVT() *timestamppb.Timestamp }); ok {
+				dst.RepeatedTimestamp[k] = vtpb.CloneVT()
+			} else {
+				dst.RepeatedTimestamp[k] = proto.Clone(v).(*timestamppb.Timestamp)
+			}
+		} else {
+			if vtpb, ok := interface{}(v).(interface{ CopyToVT(*timestamppb.Timestamp) }); ok {
+				vtpb.CopyToVT(dst.RepeatedTimestamp[k])
+			} else {
+				proto.Reset(dst.RepeatedTimestamp[k])
+				proto.Merge(dst.RepeatedTimestamp[k], v)
+			}
 		}
 	}
-	if len(this.MapSint64Sint64) != len(that.MapSint64Sint64) {
-		return false
+	if len(m.RepeatedFieldmask) > cap(dst.RepeatedFieldmask) {
+		tmpContainer := make([]*fieldmaskpb.FieldMask, len(m.RepeatedFieldmask))
+		copy(tmpContainer, dst.RepeatedFieldmask[:cap(dst.RepeatedFieldmask)])
+		dst.RepeatedFieldmask = tmpContainer
+	} else {
+		dst.RepeatedFieldmask = dst.RepeatedFieldmask[:len(m.RepeatedFieldmask)]
 	}
-	for i, vx := range this.MapSint64Sint64 {
-		vy, ok := that.MapSint64Sint64[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
+	for k, v := range m.RepeatedFieldmask {
+		if dst.RepeatedFieldmask[k] == nil {
+			if vtpb, ok := interface{}(v).(interface{ CloneVT() *fieldmaskpb.FieldMask }); ok {
+				dst.RepeatedFieldmask[k] = vtpb.CloneVT()
+			} else {
+				dst.RepeatedFieldmask[k] = proto.Clone(v).(*fieldmaskpb.FieldMask)
+			}
+		} else {
+			if vtpb, ok := interface{}(v).(interface{ CopyToVT(*fieldmaskpb.FieldMask) }); ok {
+				vtpb.CopyToVT(dst.RepeatedFieldmask[k])
+			} else {
+				proto.Reset(dst.RepeatedFieldmask[k])
+				proto.Merge(dst.RepeatedFieldmask[k], v)
+			}
 		}
 	}
-	if len(this.MapFixed32Fixed32) != len(that.MapFixed32Fixed32) {
-		return false
+	if len(m.RepeatedStruct) > cap(dst.RepeatedStruct) {
+		tmpContainer := make([]*structpb.Struct, len(m.RepeatedStruct))
+		copy(tmpContainer, dst.RepeatedStruct[:cap(dst.RepeatedStruct)])
+		dst.RepeatedStruct = tmpContainer
+	} else {
+		dst.RepeatedStruct = dst.RepeatedStruct[:len(m.RepeatedStruct)]
 	}
-	for i, vx := range this.MapFixed32Fixed32 {
-		vy, ok := that.MapFixed32Fixed32[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
+	for k, v := range m.RepeatedStruct {
+		if dst.RepeatedStruct[k] == nil {
+			if vtpb, ok := interface{}(v).(interface{ CloneVT() *structpb.Struct }); ok {
+				dst.RepeatedStruct[k] = vtpb.CloneVT()
+			} else {
+				dst.RepeatedStruct[k] = proto.Clone(v).(*structpb.Struct)
+			}
+		} else {
+			if vtpb, ok := interface{}(v).(interface{ CopyToVT(*structpb.Struct) }); ok {
+				vtpb.CopyToVT(dst.RepeatedStruct[k])
+			} else {
+				proto.Reset(dst.RepeatedStruct[k])
+				proto.Merge(dst.RepeatedStruct[k], v)
+			}
 		}
 	}
-	if len(this.MapFixed64Fixed64) != len(that.MapFixed64Fixed64) {
-		return false
+	if len(m.RepeatedAny) > cap(dst.RepeatedAny) {
+		tmpContainer := make([]*anypb.Any, len(m.RepeatedAny))
+		copy(tmpContainer, dst.RepeatedAny[:cap(dst.RepeatedAny)])
+		dst.RepeatedAny = tmpContainer
+	} else {
+		dst.RepeatedAny = dst.RepeatedAny[:len(m.RepeatedAny)]
 	}
-	for i, vx := range this.MapFixed64Fixed64 {
-		vy, ok := that.MapFixed64Fixed64[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
+	for k, v := range m.RepeatedAny {
+		if dst.RepeatedAny[k] == nil {
+			if vtpb, ok := interface{}(v).(interface{ CloneVT() *anypb.Any }); ok {
+				dst.RepeatedAny[k] = vtpb.CloneVT()
+			} else {
+				dst.RepeatedAny[k] = proto.Clone(v).(*anypb.Any)
+			}
+		} else {
+			if vtpb, ok := interface{}(v).(interface{ CopyToVT(*anypb.Any) }); ok {
+				vtpb.CopyToVT(dst.RepeatedAny[k])
+			} else {
+				proto.Reset(dst.RepeatedAny[k])
+				proto.Merge(dst.RepeatedAny[k], v)
+			}
 		}
 	}
-	if len(this.MapSfixed32Sfixed32) != len(that.MapSfixed32Sfixed32) {
-		return false
+	if len(m.RepeatedValue) > cap(dst.RepeatedValue) {
+		tmpContainer := make([]*structpb.Value, len(m.RepeatedValue))
+		copy(tmpContainer, dst.RepeatedValue[:cap(dst.RepeatedValue)])
+		dst.RepeatedValue = tmpContainer
+	} else {
+		dst.RepeatedValue = dst.RepeatedValue[:len(m.RepeatedValue)]
 	}
-	for i, vx := range this.MapSfixed32Sfixed32 {
-		vy, ok := that.MapSfixed32Sfixed32[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
+	for k, v := range m.RepeatedValue {
+		if dst.RepeatedValue[k] == nil {
+			if vtpb, ok := interface{}(v).(interface{ CloneVT() *structpb.Value }); ok {
+				dst.RepeatedValue[k] = vtpb.CloneVT()
+			} else {
+				dst.RepeatedValue[k] = proto.Clone(v).(*structpb.Value)
+			}
+		} else {
+			if vtpb, ok := interface{}(v).(interface{ CopyToVT(*structpb.Value) }); ok {
+				vtpb.CopyToVT(dst.RepeatedValue[k])
+			} else {
+				proto.Reset(dst.RepeatedValue[k])
+				proto.Merge(dst.RepeatedValue[k], v)
+			}
 		}
 	}
-	if len(this.MapSfixed64Sfixed64) != len(that.MapSfixed64Sfixed64) {
-		return false
+	if len(m.RepeatedListValue) > cap(dst.RepeatedListValue) {
+		tmpContainer := make([]*structpb.ListValue, len(m.RepeatedListValue))
+		copy(tmpContainer, dst.RepeatedListValue[:cap(dst.RepeatedListValue)])
+		dst.RepeatedListValue = tmpContainer
+	} else {
+		dst.RepeatedListValue = dst.RepeatedListValue[:len(m.RepeatedListValue)]
 	}
-	for i, vx := range this.MapSfixed64Sfixed64 {
-		vy, ok := that.MapSfixed64Sfixed64[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
-		}
+	for k, v := range m.RepeatedListValue {
+		if dst.RepeatedListValue[k] == nil {
+			if vtpb, ok := interface{}(v).(interface{ CloneVT() *structpb.ListValue }); ok {
+				dst.RepeatedListValue[k] = vtpb.CloneVT()
+			} else {
+				dst.RepeatedListValue[k] = proto.Clone(v).(*structpb.ListValue)
+			}
+		} else {
+			if vtpb, ok := interface{}(v).(interface{ CopyToVT(*structpb.ListValue) }); ok {
+				vtpb.CopyToVT(dst.RepeatedListValue[k])
+			} else {
+				proto.Reset(dst.RepeatedListValue[k])
+				proto.Merge(dst.RepeatedListValue[k], v)
+			}
+		}
+	}
+	dst.Fieldname1 = m.Fieldname1
+	dst.FieldName2 = m.FieldName2
+	dst.XFieldName3 = m.XFieldName3
+	dst.Field_Name4_ = m.Field_Name4_
+	dst.Field0Name5 = m.Field0Name5
+	dst.Field_0Name6 = m.Field_0Name6
+	dst.FieldName7 = m.FieldName7
+	dst.FieldName8 = m.FieldName8
+	dst.Field_Name9 = m.Field_Name9
+	dst.Field_Name10 = m.Field_Name10
+	dst.FIELD_NAME11 = m.FIELD_NAME11
+	dst.FIELDName12 = m.FIELDName12
+	dst.XFieldName13 = m.XFieldName13
+	dst.X_FieldName14 = m.X_FieldName14
+	dst.Field_Name15 = m.Field_Name15
+	dst.Field__Name16 = m.Field__Name16
+	dst.FieldName17__ = m.FieldName17__
+	dst.FieldName18__ = m.FieldName18__
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *TestAllTypesProto3_OneofUint32) CloneVT() isTestAllTypesProto3_OneofField {
+	if m == nil {
+		return (*TestAllTypesProto3_OneofUint32)(nil)
 	}
-	if len(this.MapInt32Float) != len(that.MapInt32Float) {
-		return false
+	r := &TestAllTypesProto3_OneofUint32{
+		OneofUint32: m.OneofUint32,
 	}
-	for i, vx := range this.MapInt32Float {
-		vy, ok := that.MapInt32Float[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
-		}
+	return r
+}
+
+func (m *TestAllTypesProto3_OneofNestedMessage) CloneVT() isTestAllTypesProto3_OneofField {
+	if m == nil {
+		return (*TestAllTypesProto3_OneofNestedMessage)(nil)
 	}
-	if len(this.MapInt32Double) != len(that.MapInt32Double) {
-		return false
+	r := &TestAllTypesProto3_OneofNestedMessage{
+		OneofNestedMessage: m.OneofNestedMessage.CloneVT(),
 	}
-	for i, vx := range this.MapInt32Double {
-		vy, ok := that.MapInt32Double[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
-		}
+	return r
+}
+
+func (m *TestAllTypesProto3_OneofString) CloneVT() isTestAllTypesProto3_OneofField {
+	if m == nil {
+		return (*TestAllTypesProto3_OneofString)(nil)
 	}
-	if len(this.MapBoolBool) != len(that.MapBoolBool) {
-		return false
+	r := &TestAllTypesProto3_OneofString{
+		OneofString: m.OneofString,
 	}
-	for i, vx := range this.MapBoolBool {
-		vy, ok := that.MapBoolBool[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
-		}
+	return r
+}
+
+func (m *TestAllTypesProto3_OneofBytes) CloneVT() isTestAllTypesProto3_OneofField {
+	if m == nil {
+		return (*TestAllTypesProto3_OneofBytes)(nil)
 	}
-	if len(this.MapStringString) != len(that.MapStringString) {
-		return false
+	r := &TestAllTypesProto3_OneofBytes{}
+	if rhs := m.OneofBytes; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.OneofBytes = tmpBytes
 	}
-	for i, vx := range this.MapStringString {
-		vy, ok := that.MapStringString[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
-		}
+	return r
+}
+
+func (m *TestAllTypesProto3_OneofBool) CloneVT() isTestAllTypesProto3_OneofField {
+	if m == nil {
+		return (*TestAllTypesProto3_OneofBool)(nil)
 	}
-	if len(this.MapStringBytes) != len(that.MapStringBytes) {
-		return false
+	r := &TestAllTypesProto3_OneofBool{
+		OneofBool: m.OneofBool,
 	}
-	for i, vx := range this.MapStringBytes {
-		vy, ok := that.MapStringBytes[i]
-		if !ok {
-			return false
-		}
-		if string(vx) != string(vy) {
-			return false
-		}
+	return r
+}
+
+func (m *TestAllTypesProto3_OneofUint64) CloneVT() isTestAllTypesProto3_OneofField {
+	if m == nil {
+		return (*TestAllTypesProto3_OneofUint64)(nil)
 	}
-	if len(this.MapStringNestedMessage) != len(that.MapStringNestedMessage) {
-		return false
+	r := &TestAllTypesProto3_OneofUint64{
+		OneofUint64: m.OneofUint64,
 	}
-	for i, vx := range this.MapStringNestedMessage {
-		vy, ok := that.MapStringNestedMessage[i]
-		if !ok {
-			return false
-		}
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &TestAllTypesProto3_NestedMessage{}
-			}
-			if q == nil {
-				q = &TestAllTypesProto3_NestedMessage{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
-		}
+	return r
+}
+
+func (m *TestAllTypesProto3_OneofFloat) CloneVT() isTestAllTypesProto3_OneofField {
+	if m == nil {
+		return (*TestAllTypesProto3_OneofFloat)(nil)
 	}
-	if len(this.MapStringForeignMessage) != len(that.MapStringForeignMessage) {
-		return false
+	r := &TestAllTypesProto3_OneofFloat{
+		OneofFloat: m.OneofFloat,
 	}
-	for i, vx := range this.MapStringForeignMessage {
-		vy, ok := that.MapStringForeignMessage[i]
-		if !ok {
-			return false
-		}
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &ForeignMessage{}
-			}
-			if q == nil {
-				q = &ForeignMessage{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
-		}
+	return r
+}
+
+func (m *TestAllTypesProto3_OneofDouble) CloneVT() isTestAllTypesProto3_OneofField {
+	if m == nil {
+		return (*TestAllTypesProto3_OneofDouble)(nil)
 	}
-	if len(this.MapStringNestedEnum) != len(that.MapStringNestedEnum) {
-		return false
+	r := &TestAllTypesProto3_OneofDouble{
+		OneofDouble: m.OneofDouble,
 	}
-	for i, vx := range this.MapStringNestedEnum {
-		vy, ok := that.MapStringNestedEnum[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
-		}
+	return r
+}
+
+func (m *TestAllTypesProto3_OneofEnum) CloneVT() isTestAllTypesProto3_OneofField {
+	if m == nil {
+		return (*TestAllTypesProto3_OneofEnum)(nil)
 	}
-	if len(this.MapStringForeignEnum) != len(that.MapStringForeignEnum) {
-		return false
+	r := &TestAllTypesProto3_OneofEnum{
+		OneofEnum: m.OneofEnum,
 	}
-	for i, vx := range this.MapStringForeignEnum {
-		vy, ok := that.MapStringForeignEnum[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
-		}
+	return r
+}
+
+func (m *TestAllTypesProto3_OneofNullValue) CloneVT() isTestAllTypesProto3_OneofField {
+	if m == nil {
+		return (*TestAllTypesProto3_OneofNullValue)(nil)
 	}
-	if len(this.PackedInt32) != len(that.PackedInt32) {
-		return false
+	r := &TestAllTypesProto3_OneofNullValue{
+		OneofNullValue: m.OneofNullValue,
 	}
-	for i, vx := range this.PackedInt32 {
-		vy := that.PackedInt32[i]
-		if vx != vy {
-			return false
-		}
+	return r
+}
+
+func (m *ForeignMessage) CloneVT() *ForeignMessage {
+	if m == nil {
+		return (*ForeignMessage)(nil)
 	}
-	if len(this.PackedInt64) != len(that.PackedInt64) {
-		return false
+	r := &ForeignMessage{
+		C: m.C,
 	}
-	for i, vx := range this.PackedInt64 {
-		vy := that.PackedInt64[i]
-		if vx != vy {
-			return false
-		}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
 	}
-	if len(this.PackedUint32) != len(that.PackedUint32) {
-		return false
+	return r
+}
+
+func (m *ForeignMessage) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *ForeignMessage) CopyToVT(dst *ForeignMessage) {
+	if m == nil {
+		dst.Reset()
+		return
 	}
-	for i, vx := range this.PackedUint32 {
-		vy := that.PackedUint32[i]
-		if vx != vy {
-			return false
-		}
+	dst.C = m.C
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *NullHypothesisProto3) CloneVT() *NullHypothesisProto3 {
+	if m == nil {
+		return (*NullHypothesisProto3)(nil)
 	}
-	if len(this.PackedUint64) != len(that.PackedUint64) {
-		return false
+	r := &NullHypothesisProto3{}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
 	}
-	for i, vx := range this.PackedUint64 {
-		vy := that.PackedUint64[i]
-		if vx != vy {
-			return false
-		}
+	return r
+}
+
+func (m *NullHypothesisProto3) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *NullHypothesisProto3) CopyToVT(dst *NullHypothesisProto3) {
+	if m == nil {
+		dst.Reset()
+		return
 	}
-	if len(this.PackedSint32) != len(that.PackedSint32) {
-		return false
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *EnumOnlyProto3) CloneVT() *EnumOnlyProto3 {
+	if m == nil {
+		return (*EnumOnlyProto3)(nil)
 	}
-	for i, vx := range this.PackedSint32 {
-		vy := that.PackedSint32[i]
-		if vx != vy {
-			return false
-		}
+	r := &EnumOnlyProto3{}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
 	}
-	if len(this.PackedSint64) != len(that.PackedSint64) {
-		return false
+	return r
+}
+
+func (m *EnumOnlyProto3) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *EnumOnlyProto3) CopyToVT(dst *EnumOnlyProto3) {
+	if m == nil {
+		dst.Reset()
+		return
 	}
-	for i, vx := range this.PackedSint64 {
-		vy := that.PackedSint64[i]
-		if vx != vy {
-			return false
-		}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (this *TestAllTypesProto3_NestedMessage) DiffVT(that *TestAllTypesProto3_NestedMessage) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
 	}
-	if len(this.PackedFixed32) != len(that.PackedFixed32) {
-		return false
+	var diffs []vtproto.FieldDiff
+	if this.A != that.A {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "a", Kind: vtproto.FieldChanged, Old: this.A, New: that.A})
 	}
-	for i, vx := range this.PackedFixed32 {
-		vy := that.PackedFixed32[i]
-		if vx != vy {
-			return false
+	if p, q := this.Corecursive, that.Corecursive; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "corecursive", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "corecursive", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if nested := p.DiffVT(q); len(nested) > 0 {
+			diffs = protohelpers.AppendDiffs(diffs, "corecursive", nested)
 		}
 	}
-	if len(this.PackedFixed64) != len(that.PackedFixed64) {
-		return false
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
 	}
-	for i, vx := range this.PackedFixed64 {
-		vy := that.PackedFixed64[i]
-		if vx != vy {
-			return false
-		}
+	return diffs
+}
+
+func (this *TestAllTypesProto3) DiffVT(that *TestAllTypesProto3) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
 	}
-	if len(this.PackedSfixed32) != len(that.PackedSfixed32) {
-		return false
+	var diffs []vtproto.FieldDiff
+	if this.OptionalInt32 != that.OptionalInt32 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int32", Kind: vtproto.FieldChanged, Old: this.OptionalInt32, New: that.OptionalInt32})
 	}
-	for i, vx := range this.PackedSfixed32 {
-		vy := that.PackedSfixed32[i]
-		if vx != vy {
-			return false
-		}
+	if this.OptionalInt64 != that.OptionalInt64 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int64", Kind: vtproto.FieldChanged, Old: this.OptionalInt64, New: that.OptionalInt64})
 	}
-	if len(this.PackedSfixed64) != len(that.PackedSfixed64) {
-		return false
+	if this.OptionalUint32 != that.OptionalUint32 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_uint32", Kind: vtproto.FieldChanged, Old: this.OptionalUint32, New: that.OptionalUint32})
 	}
-	for i, vx := range this.PackedSfixed64 {
-		vy := that.PackedSfixed64[i]
-		if vx != vy {
-			return false
-		}
+	if this.OptionalUint64 != that.OptionalUint64 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_uint64", Kind: vtproto.FieldChanged, Old: this.OptionalUint64, New: that.OptionalUint64})
 	}
-	if len(this.PackedFloat) != len(that.PackedFloat) {
-		return false
+	if this.OptionalSint32 != that.OptionalSint32 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sint32", Kind: vtproto.FieldChanged, Old: this.OptionalSint32, New: that.OptionalSint32})
 	}
-	for i, vx := range this.PackedFloat {
-		vy := that.PackedFloat[i]
-		if vx != vy {
-			return false
-		}
+	if this.OptionalSint64 != that.OptionalSint64 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sint64", Kind: vtproto.FieldChanged, Old: this.OptionalSint64, New: that.OptionalSint64})
 	}
-	if len(this.PackedDouble) != len(that.PackedDouble) {
-		return false
+	if this.OptionalFixed32 != that.OptionalFixed32 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_fixed32", Kind: vtproto.FieldChanged, Old: this.OptionalFixed32, New: that.OptionalFixed32})
 	}
-	for i, vx := range this.PackedDouble {
-		vy := that.PackedDouble[i]
-		if vx != vy {
-			return false
-		}
+	if this.OptionalFixed64 != that.OptionalFixed64 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_fixed64", Kind: vtproto.FieldChanged, Old: this.OptionalFixed64, New: that.OptionalFixed64})
 	}
-	if len(this.PackedBool) != len(that.PackedBool) {
-		return false
+	if this.OptionalSfixed32 != that.OptionalSfixed32 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sfixed32", Kind: vtproto.FieldChanged, Old: this.OptionalSfixed32, New: that.OptionalSfixed32})
 	}
-	for i, vx := range this.PackedBool {
-		vy := that.PackedBool[i]
-		if vx != vy {
-			return false
-		}
+	if this.OptionalSfixed64 != that.OptionalSfixed64 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sfixed64", Kind: vtproto.FieldChanged, Old: this.OptionalSfixed64, New: that.OptionalSfixed64})
 	}
-	if len(this.PackedNestedEnum) != len(that.PackedNestedEnum) {
-		return false
+	if this.OptionalFloat != that.OptionalFloat {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_float", Kind: vtproto.FieldChanged, Old: this.OptionalFloat, New: that.OptionalFloat})
 	}
-	for i, vx := range this.PackedNestedEnum {
-		vy := that.PackedNestedEnum[i]
-		if vx != vy {
-			return false
-		}
+	if this.OptionalDouble != that.OptionalDouble {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_double", Kind: vtproto.FieldChanged, Old: this.OptionalDouble, New: that.OptionalDouble})
 	}
-	if len(this.UnpackedInt32) != len(that.UnpackedInt32) {
-		return false
+	if this.OptionalBool != that.OptionalBool {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bool", Kind: vtproto.FieldChanged, Old: this.OptionalBool, New: that.OptionalBool})
 	}
-	for i, vx := range this.UnpackedInt32 {
-		vy := that.UnpackedInt32[i]
-		if vx != vy {
-			return false
-		}
+	if this.OptionalString != that.OptionalString {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_string", Kind: vtproto.FieldChanged, Old: this.OptionalString, New: that.OptionalString})
 	}
-	if len(this.UnpackedInt64) != len(that.UnpackedInt64) {
-		return false
+	if string(this.OptionalBytes) != string(that.OptionalBytes) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bytes", Kind: vtproto.FieldChanged, Old: this.OptionalBytes, New: that.OptionalBytes})
 	}
-	for i, vx := range this.UnpackedInt64 {
-		vy := that.UnpackedInt64[i]
-		if vx != vy {
-			return false
+	if p, q := this.OptionalNestedMessage, that.OptionalNestedMessage; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_nested_message", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_nested_message", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if nested := p.DiffVT(q); len(nested) > 0 {
+			diffs = protohelpers.AppendDiffs(diffs, "optional_nested_message", nested)
 		}
 	}
-	if len(this.UnpackedUint32) != len(that.UnpackedUint32) {
-		return false
-	}
-	for i, vx := range this.UnpackedUint32 {
-		vy := that.UnpackedUint32[i]
-		if vx != vy {
-			return false
+	if p, q := this.OptionalForeignMessage, that.OptionalForeignMessage; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_foreign_message", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_foreign_message", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if nested := p.DiffVT(q); len(nested) > 0 {
+			diffs = protohelpers.AppendDiffs(diffs, "optional_foreign_message", nested)
 		}
 	}
-	if len(this.UnpackedUint64) != len(that.UnpackedUint64) {
-		return false
+	if this.OptionalNestedEnum != that.OptionalNestedEnum {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_nested_enum", Kind: vtproto.FieldChanged, Old: this.OptionalNestedEnum, New: that.OptionalNestedEnum})
 	}
-	for i, vx := range this.UnpackedUint64 {
-		vy := that.UnpackedUint64[i]
-		if vx != vy {
-			return false
-		}
+	if this.OptionalForeignEnum != that.OptionalForeignEnum {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_foreign_enum", Kind: vtproto.FieldChanged, Old: this.OptionalForeignEnum, New: that.OptionalForeignEnum})
 	}
-	if len(this.UnpackedSint32) != len(that.UnpackedSint32) {
-		return false
+	if this.OptionalAliasedEnum != that.OptionalAliasedEnum {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_aliased_enum", Kind: vtproto.FieldChanged, Old: this.OptionalAliasedEnum, New: that.OptionalAliasedEnum})
 	}
-	for i, vx := range this.UnpackedSint32 {
-		vy := that.UnpackedSint32[i]
-		if vx != vy {
-			return false
-		}
+	if this.OptionalStringPiece != that.OptionalStringPiece {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_string_piece", Kind: vtproto.FieldChanged, Old: this.OptionalStringPiece, New: that.OptionalStringPiece})
 	}
-	if len(this.UnpackedSint64) != len(that.UnpackedSint64) {
-		return false
+	if this.OptionalCord != that.OptionalCord {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_cord", Kind: vtproto.FieldChanged, Old: this.OptionalCord, New: that.OptionalCord})
 	}
-	for i, vx := range this.UnpackedSint64 {
-		vy := that.UnpackedSint64[i]
-		if vx != vy {
-			return false
+	if p, q := this.RecursiveMessage, that.RecursiveMessage; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "recursive_message", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "recursive_message", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if nested := p.DiffVT(q); len(nested) > 0 {
+			diffs = protohelpers.AppendDiffs(diffs, "recursive_message", nested)
 		}
 	}
-	if len(this.UnpackedFixed32) != len(that.UnpackedFixed32) {
-		return false
-	}
-	for i, vx := range this.UnpackedFixed32 {
-		vy := that.UnpackedFixed32[i]
-		if vx != vy {
-			return false
+	for i := 0; i < len(this.RepeatedInt32) && i < len(that.RepeatedInt32); i++ {
+		if this.RepeatedInt32[i] != that.RepeatedInt32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedInt32[i], New: that.RepeatedInt32[i]})
 		}
 	}
-	if len(this.UnpackedFixed64) != len(that.UnpackedFixed64) {
-		return false
+	for i := len(that.RepeatedInt32); i < len(this.RepeatedInt32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedInt32[i]})
 	}
-	for i, vx := range this.UnpackedFixed64 {
-		vy := that.UnpackedFixed64[i]
-		if vx != vy {
-			return false
+	for i := len(this.RepeatedInt32); i < len(that.RepeatedInt32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedInt32[i]})
+	}
+	for i := 0; i < len(this.RepeatedInt64) && i < len(that.RepeatedInt64); i++ {
+		if this.RepeatedInt64[i] != that.RepeatedInt64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedInt64[i], New: that.RepeatedInt64[i]})
 		}
 	}
-	if len(this.UnpackedSfixed32) != len(that.UnpackedSfixed32) {
-		return false
+	for i := len(that.RepeatedInt64); i < len(this.RepeatedInt64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedInt64[i]})
 	}
-	for i, vx := range this.UnpackedSfixed32 {
-		vy := that.UnpackedSfixed32[i]
-		if vx != vy {
-			return false
+	for i := len(this.RepeatedInt64); i < len(that.RepeatedInt64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedInt64[i]})
+	}
+	for i := 0; i < len(this.RepeatedUint32) && i < len(that.RepeatedUint32); i++ {
+		if this.RepeatedUint32[i] != that.RepeatedUint32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_uint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedUint32[i], New: that.RepeatedUint32[i]})
 		}
 	}
-	if len(this.UnpackedSfixed64) != len(that.UnpackedSfixed64) {
-		return false
+	for i := len(that.RepeatedUint32); i < len(this.RepeatedUint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_uint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedUint32[i]})
 	}
-	for i, vx := range this.UnpackedSfixed64 {
-		vy := that.UnpackedSfixed64[i]
-		if vx != vy {
-			return false
+	for i := len(this.RepeatedUint32); i < len(that.RepeatedUint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_uint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedUint32[i]})
+	}
+	for i := 0; i < len(this.RepeatedUint64) && i < len(that.RepeatedUint64); i++ {
+		if this.RepeatedUint64[i] != that.RepeatedUint64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_uint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedUint64[i], New: that.RepeatedUint64[i]})
 		}
 	}
-	if len(this.UnpackedFloat) != len(that.UnpackedFloat) {
-		return false
+	for i := len(that.RepeatedUint64); i < len(this.RepeatedUint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_uint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedUint64[i]})
 	}
-	for i, vx := range this.UnpackedFloat {
-		vy := that.UnpackedFloat[i]
-		if vx != vy {
-			return false
+	for i := len(this.RepeatedUint64); i < len(that.RepeatedUint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_uint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedUint64[i]})
+	}
+	for i := 0; i < len(this.RepeatedSint32) && i < len(that.RepeatedSint32); i++ {
+		if this.RepeatedSint32[i] != that.RepeatedSint32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedSint32[i], New: that.RepeatedSint32[i]})
 		}
 	}
-	if len(this.UnpackedDouble) != len(that.UnpackedDouble) {
-		return false
+	for i := len(that.RepeatedSint32); i < len(this.RepeatedSint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedSint32[i]})
 	}
-	for i, vx := range this.UnpackedDouble {
-		vy := that.UnpackedDouble[i]
-		if vx != vy {
-			return false
+	for i := len(this.RepeatedSint32); i < len(that.RepeatedSint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedSint32[i]})
+	}
+	for i := 0; i < len(this.RepeatedSint64) && i < len(that.RepeatedSint64); i++ {
+		if this.RepeatedSint64[i] != that.RepeatedSint64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedSint64[i], New: that.RepeatedSint64[i]})
 		}
 	}
-	if len(this.UnpackedBool) != len(that.UnpackedBool) {
-		return false
+	for i := len(that.RepeatedSint64); i < len(this.RepeatedSint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedSint64[i]})
 	}
-	for i, vx := range this.UnpackedBool {
-		vy := that.UnpackedBool[i]
-		if vx != vy {
-			return false
+	for i := len(this.RepeatedSint64); i < len(that.RepeatedSint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedSint64[i]})
+	}
+	for i := 0; i < len(this.RepeatedFixed32) && i < len(that.RepeatedFixed32); i++ {
+		if this.RepeatedFixed32[i] != that.RepeatedFixed32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_fixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedFixed32[i], New: that.RepeatedFixed32[i]})
 		}
 	}
-	if len(this.UnpackedNestedEnum) != len(that.UnpackedNestedEnum) {
-		return false
+	for i := len(that.RepeatedFixed32); i < len(this.RepeatedFixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_fixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedFixed32[i]})
 	}
-	for i, vx := range this.UnpackedNestedEnum {
-		vy := that.UnpackedNestedEnum[i]
-		if vx != vy {
-			return false
-		}
+	for i := len(this.RepeatedFixed32); i < len(that.RepeatedFixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_fixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedFixed32[i]})
 	}
-	if equal, ok := interface{}(this.OptionalBoolWrapper).(interface {
-		EqualVT(*wrapperspb.BoolValue) bool
-	}); ok {
-		if !equal.EqualVT(that.OptionalBoolWrapper) {
-			return false
+	for i := 0; i < len(this.RepeatedFixed64) && i < len(that.RepeatedFixed64); i++ {
+		if this.RepeatedFixed64[i] != that.RepeatedFixed64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_fixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedFixed64[i], New: that.RepeatedFixed64[i]})
 		}
-	} else if !proto.Equal(this.OptionalBoolWrapper, that.OptionalBoolWrapper) {
-		return false
 	}
-	if equal, ok := interface{}(this.OptionalInt32Wrapper).(interface {
-		EqualVT(*wrapperspb.Int32Value) bool
-	}); ok {
-		if !equal.EqualVT(that.OptionalInt32Wrapper) {
-			return false
-		}
-	} else if !proto.Equal(this.OptionalInt32Wrapper, that.OptionalInt32Wrapper) {
-		return false
+	for i := len(that.RepeatedFixed64); i < len(this.RepeatedFixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_fixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedFixed64[i]})
 	}
-	if equal, ok := interface{}(this.OptionalInt64Wrapper).(interface {
-		EqualVT(*wrapperspb.Int64Value) bool
-	}); ok {
-		if !equal.EqualVT(that.OptionalInt64Wrapper) {
-			return false
+	for i := len(this.RepeatedFixed64); i < len(that.RepeatedFixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_fixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedFixed64[i]})
+	}
+	for i := 0; i < len(this.RepeatedSfixed32) && i < len(that.RepeatedSfixed32); i++ {
+		if this.RepeatedSfixed32[i] != that.RepeatedSfixed32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sfixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedSfixed32[i], New: that.RepeatedSfixed32[i]})
 		}
-	} else if !proto.Equal(this.OptionalInt64Wrapper, that.OptionalInt64Wrapper) {
-		return false
 	}
-	if equal, ok := interface{}(this.OptionalUint32Wrapper).(interface {
-		EqualVT(*wrapperspb.UInt32Value) bool
-	}); ok {
-		if !equal.EqualVT(that.OptionalUint32Wrapper) {
-			return false
+	for i := len(that.RepeatedSfixed32); i < len(this.RepeatedSfixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sfixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedSfixed32[i]})
+	}
+	for i := len(this.RepeatedSfixed32); i < len(that.RepeatedSfixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sfixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedSfixed32[i]})
+	}
+	for i := 0; i < len(this.RepeatedSfixed64) && i < len(that.RepeatedSfixed64); i++ {
+		if this.RepeatedSfixed64[i] != that.RepeatedSfixed64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sfixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedSfixed64[i], New: that.RepeatedSfixed64[i]})
 		}
-	} else if !proto.Equal(this.OptionalUint32Wrapper, that.OptionalUint32Wrapper) {
-		return false
 	}
-	if equal, ok := interface{}(this.OptionalUint64Wrapper).(interface {
-		EqualVT(*wrapperspb.UInt64Value) bool
-	}); ok {
-		if !equal.EqualVT(that.OptionalUint64Wrapper) {
-			return false
+	for i := len(that.RepeatedSfixed64); i < len(this.RepeatedSfixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sfixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedSfixed64[i]})
+	}
+	for i := len(this.RepeatedSfixed64); i < len(that.RepeatedSfixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_sfixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedSfixed64[i]})
+	}
+	for i := 0; i < len(this.RepeatedFloat) && i < len(that.RepeatedFloat); i++ {
+		if this.RepeatedFloat[i] != that.RepeatedFloat[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_float[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedFloat[i], New: that.RepeatedFloat[i]})
 		}
-	} else if !proto.Equal(this.OptionalUint64Wrapper, that.OptionalUint64Wrapper) {
-		return false
 	}
-	if equal, ok := interface{}(this.OptionalFloatWrapper).(interface {
-		EqualVT(*wrapperspb.FloatValue) bool
-	}); ok {
-		if !equal.EqualVT(that.OptionalFloatWrapper) {
-			return false
+	for i := len(that.RepeatedFloat); i < len(this.RepeatedFloat); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_float[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedFloat[i]})
+	}
+	for i := len(this.RepeatedFloat); i < len(that.RepeatedFloat); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_float[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedFloat[i]})
+	}
+	for i := 0; i < len(this.RepeatedDouble) && i < len(that.RepeatedDouble); i++ {
+		if this.RepeatedDouble[i] != that.RepeatedDouble[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_double[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedDouble[i], New: that.RepeatedDouble[i]})
 		}
-	} else if !proto.Equal(this.OptionalFloatWrapper, that.OptionalFloatWrapper) {
-		return false
 	}
-	if equal, ok := interface{}(this.OptionalDoubleWrapper).(interface {
-		EqualVT(*wrapperspb.DoubleValue) bool
-	}); ok {
-		if !equal.EqualVT(that.OptionalDoubleWrapper) {
-			return false
+	for i := len(that.RepeatedDouble); i < len(this.RepeatedDouble); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_double[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedDouble[i]})
+	}
+	for i := len(this.RepeatedDouble); i < len(that.RepeatedDouble); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_double[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedDouble[i]})
+	}
+	for i := 0; i < len(this.RepeatedBool) && i < len(that.RepeatedBool); i++ {
+		if this.RepeatedBool[i] != that.RepeatedBool[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_bool[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedBool[i], New: that.RepeatedBool[i]})
 		}
-	} else if !proto.Equal(this.OptionalDoubleWrapper, that.OptionalDoubleWrapper) {
-		return false
 	}
-	if equal, ok := interface{}(this.OptionalStringWrapper).(interface {
-		EqualVT(*wrapperspb.StringValue) bool
-	}); ok {
-		if !equal.EqualVT(that.OptionalStringWrapper) {
-			return false
+	for i := len(that.RepeatedBool); i < len(this.RepeatedBool); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_bool[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedBool[i]})
+	}
+	for i := len(this.RepeatedBool); i < len(that.RepeatedBool); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_bool[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedBool[i]})
+	}
+	for i := 0; i < len(this.RepeatedString) && i < len(that.RepeatedString); i++ {
+		if this.RepeatedString[i] != that.RepeatedString[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_string[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedString[i], New: that.RepeatedString[i]})
 		}
-	} else if !proto.Equal(this.OptionalStringWrapper, that.OptionalStringWrapper) {
-		return false
 	}
-	if equal, ok := interface{}(this.OptionalBytesWrapper).(interface {
-		EqualVT(*wrapperspb.BytesValue) bool
-	}); ok {
-		if !equal.EqualVT(that.OptionalBytesWrapper) {
-			return false
+	for i := len(that.RepeatedString); i < len(this.RepeatedString); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_string[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedString[i]})
+	}
+	for i := len(this.RepeatedString); i < len(that.RepeatedString); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_string[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedString[i]})
+	}
+	for i := 0; i < len(this.RepeatedBytes) && i < len(that.RepeatedBytes); i++ {
+		if string(this.RepeatedBytes[i]) != string(that.RepeatedBytes[i]) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_bytes[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedBytes[i], New: that.RepeatedBytes[i]})
 		}
-	} else if !proto.Equal(this.OptionalBytesWrapper, that.OptionalBytesWrapper) {
-		return false
 	}
-	if len(this.RepeatedBoolWrapper) != len(that.RepeatedBoolWrapper) {
-		return false
+	for i := len(that.RepeatedBytes); i < len(this.RepeatedBytes); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_bytes[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedBytes[i]})
 	}
-	for i, vx := range this.RepeatedBoolWrapper {
-		vy := that.RepeatedBoolWrapper[i]
-		if p, q := vx, vy; p != q {
+	for i := len(this.RepeatedBytes); i < len(that.RepeatedBytes); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_bytes[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedBytes[i]})
+	}
+	for i := 0; i < len(this.RepeatedNestedMessage) && i < len(that.RepeatedNestedMessage); i++ {
+		if p, q := this.RepeatedNestedMessage[i], that.RepeatedNestedMessage[i]; p != q {
 			if p == nil {
-				p = &wrapperspb.BoolValue{}
+				p = &TestAllTypesProto3_NestedMessage{}
 			}
 			if q == nil {
-				q = &wrapperspb.BoolValue{}
+				q = &TestAllTypesProto3_NestedMessage{}
 			}
-			if equal, ok := interface{}(p).(interface {
-				EqualVT(*wrapperspb.BoolValue) bool
-			}); ok {
-				if !equal.EqualVT(q) {
-					return false
-				}
-			} else if !proto.Equal(p, q) {
-				return false
+			if nested := p.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "repeated_nested_message["+strconv.Itoa(i)+"]", nested)
 			}
 		}
 	}
-	if len(this.RepeatedInt32Wrapper) != len(that.RepeatedInt32Wrapper) {
-		return false
+	for i := len(that.RepeatedNestedMessage); i < len(this.RepeatedNestedMessage); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_nested_message[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedNestedMessage[i]})
 	}
-	for i, vx := range this.RepeatedInt32Wrapper {
-		vy := that.RepeatedInt32Wrapper[i]
-		if p, q := vx, vy; p != q {
+	for i := len(this.RepeatedNestedMessage); i < len(that.RepeatedNestedMessage); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_nested_message[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedNestedMessage[i]})
+	}
+	for i := 0; i < len(this.RepeatedForeignMessage) && i < len(that.RepeatedForeignMessage); i++ {
+		if p, q := this.RepeatedForeignMessage[i], that.RepeatedForeignMessage[i]; p != q {
 			if p == nil {
-				p = &wrapperspb.Int32Value{}
+				p = &ForeignMessage{}
 			}
 			if q == nil {
-				q = &wrapperspb.Int32Value{}
+				q = &ForeignMessage{}
 			}
-			if equal, ok := interface{}(p).(interface {
-				EqualVT(*wrapperspb.Int32Value) bool
-			}); ok {
-				if !equal.EqualVT(q) {
-					return false
-				}
-			} else if !proto.Equal(p, q) {
-				return false
+			if nested := p.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "repeated_foreign_message["+strconv.Itoa(i)+"]", nested)
 			}
 		}
 	}
-	if len(this.RepeatedInt64Wrapper) != len(that.RepeatedInt64Wrapper) {
-		return false
+	for i := len(that.RepeatedForeignMessage); i < len(this.RepeatedForeignMessage); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_foreign_message[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedForeignMessage[i]})
 	}
-	for i, vx := range this.RepeatedInt64Wrapper {
-		vy := that.RepeatedInt64Wrapper[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &wrapperspb.Int64Value{}
-			}
-			if q == nil {
-				q = &wrapperspb.Int64Value{}
+	for i := len(this.RepeatedForeignMessage); i < len(that.RepeatedForeignMessage); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_foreign_message[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedForeignMessage[i]})
+	}
+	for i := 0; i < len(this.RepeatedNestedEnum) && i < len(that.RepeatedNestedEnum); i++ {
+		if this.RepeatedNestedEnum[i] != that.RepeatedNestedEnum[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_nested_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedNestedEnum[i], New: that.RepeatedNestedEnum[i]})
+		}
+	}
+	for i := len(that.RepeatedNestedEnum); i < len(this.RepeatedNestedEnum); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_nested_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedNestedEnum[i]})
+	}
+	for i := len(this.RepeatedNestedEnum); i < len(that.RepeatedNestedEnum); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_nested_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedNestedEnum[i]})
+	}
+	for i := 0; i < len(this.RepeatedForeignEnum) && i < len(that.RepeatedForeignEnum); i++ {
+		if this.RepeatedForeignEnum[i] != that.RepeatedForeignEnum[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_foreign_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedForeignEnum[i], New: that.RepeatedForeignEnum[i]})
+		}
+	}
+	for i := len(that.RepeatedForeignEnum); i < len(this.RepeatedForeignEnum); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_foreign_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedForeignEnum[i]})
+	}
+	for i := len(this.RepeatedForeignEnum); i < len(that.RepeatedForeignEnum); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_foreign_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedForeignEnum[i]})
+	}
+	for i := 0; i < len(this.RepeatedStringPiece) && i < len(that.RepeatedStringPiece); i++ {
+		if this.RepeatedStringPiece[i] != that.RepeatedStringPiece[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_string_piece[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedStringPiece[i], New: that.RepeatedStringPiece[i]})
+		}
+	}
+	for i := len(that.RepeatedStringPiece); i < len(this.RepeatedStringPiece); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_string_piece[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedStringPiece[i]})
+	}
+	for i := len(this.RepeatedStringPiece); i < len(that.RepeatedStringPiece); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_string_piece[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedStringPiece[i]})
+	}
+	for i := 0; i < len(this.RepeatedCord) && i < len(that.RepeatedCord); i++ {
+		if this.RepeatedCord[i] != that.RepeatedCord[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_cord[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedCord[i], New: that.RepeatedCord[i]})
+		}
+	}
+	for i := len(that.RepeatedCord); i < len(this.RepeatedCord); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_cord[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedCord[i]})
+	}
+	for i := len(this.RepeatedCord); i < len(that.RepeatedCord); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_cord[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedCord[i]})
+	}
+	if len(this.MapInt32Int32) > 0 || len(that.MapInt32Int32) > 0 {
+		keys := make([]int32, 0, len(this.MapInt32Int32)+len(that.MapInt32Int32))
+		for k := range this.MapInt32Int32 {
+			keys = append(keys, k)
+		}
+		for k := range that.MapInt32Int32 {
+			if _, ok := this.MapInt32Int32[k]; !ok {
+				keys = append(keys, k)
 			}
-			if equal, ok := interface{}(p).(interface {
-				EqualVT(*wrapperspb.Int64Value) bool
-			}); ok {
-				if !equal.EqualVT(q) {
-					return false
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapInt32Int32[k]
+			vy, yok := that.MapInt32Int32[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_int32_int32[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_int32_int32[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_int32_int32[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
 				}
-			} else if !proto.Equal(p, q) {
-				return false
 			}
 		}
 	}
-	if len(this.RepeatedUint32Wrapper) != len(that.RepeatedUint32Wrapper) {
-		return false
-	}
-	for i, vx := range this.RepeatedUint32Wrapper {
-		vy := that.RepeatedUint32Wrapper[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &wrapperspb.UInt32Value{}
-			}
-			if q == nil {
-				q = &wrapperspb.UInt32Value{}
+	if len(this.MapInt64Int64) > 0 || len(that.MapInt64Int64) > 0 {
+		keys := make([]int64, 0, len(this.MapInt64Int64)+len(that.MapInt64Int64))
+		for k := range this.MapInt64Int64 {
+			keys = append(keys, k)
+		}
+		for k := range that.MapInt64Int64 {
+			if _, ok := this.MapInt64Int64[k]; !ok {
+				keys = append(keys, k)
 			}
-			if equal, ok := interface{}(p).(interface {
-				EqualVT(*wrapperspb.UInt32Value) bool
-			}); ok {
-				if !equal.EqualVT(q) {
-					return false
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapInt64Int64[k]
+			vy, yok := that.MapInt64Int64[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_int64_int64[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_int64_int64[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_int64_int64[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
 				}
-			} else if !proto.Equal(p, q) {
-				return false
 			}
 		}
 	}
-	if len(this.RepeatedUint64Wrapper) != len(that.RepeatedUint64Wrapper) {
-		return false
-	}
-	for i, vx := range this.RepeatedUint64Wrapper {
-		vy := that.RepeatedUint64Wrapper[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &wrapperspb.UInt64Value{}
-			}
-			if q == nil {
-				q = &wrapperspb.UInt64Value{}
+	if len(this.MapUint32Uint32) > 0 || len(that.MapUint32Uint32) > 0 {
+		keys := make([]uint32, 0, len(this.MapUint32Uint32)+len(that.MapUint32Uint32))
+		for k := range this.MapUint32Uint32 {
+			keys = append(keys, k)
+		}
+		for k := range that.MapUint32Uint32 {
+			if _, ok := this.MapUint32Uint32[k]; !ok {
+				keys = append(keys, k)
 			}
-			if equal, ok := interface{}(p).(interface {
-				EqualVT(*wrapperspb.UInt64Value) bool
-			}); ok {
-				if !equal.EqualVT(q) {
-					return false
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapUint32Uint32[k]
+			vy, yok := that.MapUint32Uint32[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_uint32_uint32[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_uint32_uint32[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_uint32_uint32[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
 				}
-			} else if !proto.Equal(p, q) {
-				return false
 			}
 		}
 	}
-	if len(this.RepeatedFloatWrapper) != len(that.RepeatedFloatWrapper) {
-		return false
-	}
-	for i, vx := range this.RepeatedFloatWrapper {
-		vy := that.RepeatedFloatWrapper[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &wrapperspb.FloatValue{}
-			}
-			if q == nil {
-				q = &wrapperspb.FloatValue{}
+	if len(this.MapUint64Uint64) > 0 || len(that.MapUint64Uint64) > 0 {
+		keys := make([]uint64, 0, len(this.MapUint64Uint64)+len(that.MapUint64Uint64))
+		for k := range this.MapUint64Uint64 {
+			keys = append(keys, k)
+		}
+		for k := range that.MapUint64Uint64 {
+			if _, ok := this.MapUint64Uint64[k]; !ok {
+				keys = append(keys, k)
 			}
-			if equal, ok := interface{}(p).(interface {
-				EqualVT(*wrapperspb.FloatValue) bool
-			}); ok {
-				if !equal.EqualVT(q) {
-					return false
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapUint64Uint64[k]
+			vy, yok := that.MapUint64Uint64[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_uint64_uint64[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_uint64_uint64[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_uint64_uint64[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
 				}
-			} else if !proto.Equal(p, q) {
-				return false
 			}
 		}
 	}
-	if len(this.RepeatedDoubleWrapper) != len(that.RepeatedDoubleWrapper) {
-		return false
-	}
-	for i, vx := range this.RepeatedDoubleWrapper {
-		vy := that.RepeatedDoubleWrapper[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &wrapperspb.DoubleValue{}
-			}
-			if q == nil {
-				q = &wrapperspb.DoubleValue{}
+	if len(this.MapSint32Sint32) > 0 || len(that.MapSint32Sint32) > 0 {
+		keys := make([]int32, 0, len(this.MapSint32Sint32)+len(that.MapSint32Sint32))
+		for k := range this.MapSint32Sint32 {
+			keys = append(keys, k)
+		}
+		for k := range that.MapSint32Sint32 {
+			if _, ok := this.MapSint32Sint32[k]; !ok {
+				keys = append(keys, k)
 			}
-			if equal, ok := interface{}(p).(interface {
-				EqualVT(*wrapperspb.DoubleValue) bool
-			}); ok {
-				if !equal.EqualVT(q) {
-					return false
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapSint32Sint32[k]
+			vy, yok := that.MapSint32Sint32[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_sint32_sint32[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_sint32_sint32[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_sint32_sint32[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
 				}
-			} else if !proto.Equal(p, q) {
-				return false
 			}
 		}
 	}
-	if len(this.RepeatedStringWrapper) != len(that.RepeatedStringWrapper) {
-		return false
-	}
-	for i, vx := range this.RepeatedStringWrapper {
-		vy := that.RepeatedStringWrapper[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &wrapperspb.StringValue{}
-			}
-			if q == nil {
-				q = &wrapperspb.StringValue{}
+	if len(this.MapSint64Sint64) > 0 || len(that.MapSint64Sint64) > 0 {
+		keys := make([]int64, 0, len(this.MapSint64Sint64)+len(that.MapSint64Sint64))
+		for k := range this.MapSint64Sint64 {
+			keys = append(keys, k)
+		}
+		for k := range that.MapSint64Sint64 {
+			if _, ok := this.MapSint64Sint64[k]; !ok {
+				keys = append(keys, k)
 			}
-			if equal, ok := interface{}(p).(interface {
-				EqualVT(*wrapperspb.StringValue) bool
-			}); ok {
-				if !equal.EqualVT(q) {
-					return false
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapSint64Sint64[k]
+			vy, yok := that.MapSint64Sint64[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_sint64_sint64[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_sint64_sint64[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_sint64_sint64[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
 				}
-			} else if !proto.Equal(p, q) {
-				return false
 			}
 		}
 	}
-	if len(this.RepeatedBytesWrapper) != len(that.RepeatedBytesWrapper) {
-		return false
-	}
-	for i, vx := range this.RepeatedBytesWrapper {
-		vy := that.RepeatedBytesWrapper[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &wrapperspb.BytesValue{}
-			}
-			if q == nil {
-				q = &wrapperspb.BytesValue{}
+	if len(this.MapFixed32Fixed32) > 0 || len(that.MapFixed32Fixed32) > 0 {
+		keys := make([]uint32, 0, len(this.MapFixed32Fixed32)+len(that.MapFixed32Fixed32))
+		for k := range this.MapFixed32Fixed32 {
+			keys = append(keys, k)
+		}
+		for k := range that.MapFixed32Fixed32 {
+			if _, ok := this.MapFixed32Fixed32[k]; !ok {
+				keys = append(keys, k)
 			}
-			if equal, ok := interface{}(p).(interface {
-				EqualVT(*wrapperspb.BytesValue) bool
-			}); ok {
-				if !equal.EqualVT(q) {
-					return false
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapFixed32Fixed32[k]
+			vy, yok := that.MapFixed32Fixed32[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_fixed32_fixed32[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_fixed32_fixed32[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_fixed32_fixed32[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
 				}
-			} else if !proto.Equal(p, q) {
-				return false
 			}
 		}
 	}
-	if equal, ok := interface{}(this.OptionalDuration).(interface {
-		EqualVT(*durationpb.Duration) bool
-	}); ok {
-		if !equal.EqualVT(that.OptionalDuration) {
-			return false
+	if len(this.MapFixed64Fixed64) > 0 || len(that.MapFixed64Fixed64) > 0 {
+		keys := make([]uint64, 0, len(this.MapFixed64Fixed64)+len(that.MapFixed64Fixed64))
+		for k := range this.MapFixed64Fixed64 {
+			keys = append(keys, k)
 		}
-	} else if !proto.Equal(this.OptionalDuration, that.OptionalDuration) {
-		return false
-	}
-	if equal, ok := interface{}(this.OptionalTimestamp).(interface {
-		EqualVT(*timestamppb.Timestamp) bool
-	}); ok {
-		if !equal.EqualVT(that.OptionalTimestamp) {
-			return false
+		for k := range that.MapFixed64Fixed64 {
+			if _, ok := this.MapFixed64Fixed64[k]; !ok {
+				keys = append(keys, k)
+			}
 		}
-	} else if !proto.Equal(this.OptionalTimestamp, that.OptionalTimestamp) {
-		return false
-	}
-	if equal, ok := interface{}(this.OptionalFieldMask).(interface {
-		EqualVT(*fieldmaskpb.FieldMask) bool
-	}); ok {
-		if !equal.EqualVT(that.OptionalFieldMask) {
-			return false
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapFixed64Fixed64[k]
+			vy, yok := that.MapFixed64Fixed64[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_fixed64_fixed64[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_fixed64_fixed64[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_fixed64_fixed64[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
 		}
-	} else if !proto.Equal(this.OptionalFieldMask, that.OptionalFieldMask) {
-		return false
 	}
-	if equal, ok := interface{}(this.OptionalStruct).(interface{ EqualVT(*structpb.Struct) bool }); ok {
-		if !equal.EqualVT(that.OptionalStruct) {
-			return false
+	if len(this.MapSfixed32Sfixed32) > 0 || len(that.MapSfixed32Sfixed32) > 0 {
+		keys := make([]int32, 0, len(this.MapSfixed32Sfixed32)+len(that.MapSfixed32Sfixed32))
+		for k := range this.MapSfixed32Sfixed32 {
+			keys = append(keys, k)
 		}
-	} else if !proto.Equal(this.OptionalStruct, that.OptionalStruct) {
-		return false
-	}
-	if equal, ok := interface{}(this.OptionalAny).(interface{ EqualVT(*anypb.Any) bool }); ok {
-		if !equal.EqualVT(that.OptionalAny) {
-			return false
+		for k := range that.MapSfixed32Sfixed32 {
+			if _, ok := this.MapSfixed32Sfixed32[k]; !ok {
+				keys = append(keys, k)
+			}
 		}
-	} else if !proto.Equal(this.OptionalAny, that.OptionalAny) {
-		return false
-	}
-	if equal, ok := interface{}(this.OptionalValue).(interface{ EqualVT(*structpb.Value) bool }); ok {
-		if !equal.EqualVT(that.OptionalValue) {
-			return false
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapSfixed32Sfixed32[k]
+			vy, yok := that.MapSfixed32Sfixed32[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_sfixed32_sfixed32[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_sfixed32_sfixed32[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_sfixed32_sfixed32[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
 		}
-	} else if !proto.Equal(this.OptionalValue, that.OptionalValue) {
-		return false
 	}
-	if this.OptionalNullValue != that.OptionalNullValue {
-		return false
-	}
-	if len(this.RepeatedDuration) != len(that.RepeatedDuration) {
-		return false
-	}
-	for i, vx := range this.RepeatedDuration {
-		vy := that.RepeatedDuration[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &durationpb.Duration{}
-			}
-			if q == nil {
-				q = &durationpb.Duration{}
+	if len(this.MapSfixed64Sfixed64) > 0 || len(that.MapSfixed64Sfixed64) > 0 {
+		keys := make([]int64, 0, len(this.MapSfixed64Sfixed64)+len(that.MapSfixed64Sfixed64))
+		for k := range this.MapSfixed64Sfixed64 {
+			keys = append(keys, k)
+		}
+		for k := range that.MapSfixed64Sfixed64 {
+			if _, ok := this.MapSfixed64Sfixed64[k]; !ok {
+				keys = append(keys, k)
 			}
-			if equal, ok := interface{}(p).(interface {
-				EqualVT(*durationpb.Duration) bool
-			}); ok {
-				if !equal.EqualVT(q) {
-					return false
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapSfixed64Sfixed64[k]
+			vy, yok := that.MapSfixed64Sfixed64[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_sfixed64_sfixed64[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_sfixed64_sfixed64[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_sfixed64_sfixed64[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
 				}
-			} else if !proto.Equal(p, q) {
-				return false
 			}
 		}
 	}
-	if len(this.RepeatedTimestamp) != len(that.RepeatedTimestamp) {
-		return false
-	}
-	for i, vx := range this.RepeatedTimestamp {
-		vy := that.RepeatedTimestamp[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &timestamppb.Timestamp{}
-			}
-			if q == nil {
-				q = &timestamppb.Timestamp{}
+	if len(this.MapInt32Float) > 0 || len(that.MapInt32Float) > 0 {
+		keys := make([]int32, 0, len(this.MapInt32Float)+len(that.MapInt32Float))
+		for k := range this.MapInt32Float {
+			keys = append(keys, k)
+		}
+		for k := range that.MapInt32Float {
+			if _, ok := this.MapInt32Float[k]; !ok {
+				keys = append(keys, k)
 			}
-			if equal, ok := interface{}(p).(interface {
-				EqualVT(*timestamppb.Timestamp) bool
-			}); ok {
-				if !equal.EqualVT(q) {
-					return false
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapInt32Float[k]
+			vy, yok := that.MapInt32Float[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_int32_float[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_int32_float[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_int32_float[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
 				}
-			} else if !proto.Equal(p, q) {
-				return false
 			}
 		}
 	}
-	if len(this.RepeatedFieldmask) != len(that.RepeatedFieldmask) {
-		return false
-	}
-	for i, vx := range this.RepeatedFieldmask {
-		vy := that.RepeatedFieldmask[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &fieldmaskpb.FieldMask{}
-			}
-			if q == nil {
-				q = &fieldmaskpb.FieldMask{}
+	if len(this.MapInt32Double) > 0 || len(that.MapInt32Double) > 0 {
+		keys := make([]int32, 0, len(this.MapInt32Double)+len(that.MapInt32Double))
+		for k := range this.MapInt32Double {
+			keys = append(keys, k)
+		}
+		for k := range that.MapInt32Double {
+			if _, ok := this.MapInt32Double[k]; !ok {
+				keys = append(keys, k)
 			}
-			if equal, ok := interface{}(p).(interface {
-				EqualVT(*fieldmaskpb.FieldMask) bool
-			}); ok {
-				if !equal.EqualVT(q) {
-					return false
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapInt32Double[k]
+			vy, yok := that.MapInt32Double[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_int32_double[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_int32_double[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_int32_double[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
 				}
-			} else if !proto.Equal(p, q) {
-				return false
 			}
 		}
 	}
-	if len(this.RepeatedAny) != len(that.RepeatedAny) {
-		return false
-	}
-	for i, vx := range this.RepeatedAny {
-		vy := that.RepeatedAny[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &anypb.Any{}
-			}
-			if q == nil {
-				q = &anypb.Any{}
+	if len(this.MapBoolBool) > 0 || len(that.MapBoolBool) > 0 {
+		keys := make([]bool, 0, len(this.MapBoolBool)+len(that.MapBoolBool))
+		for k := range this.MapBoolBool {
+			keys = append(keys, k)
+		}
+		for k := range that.MapBoolBool {
+			if _, ok := this.MapBoolBool[k]; !ok {
+				keys = append(keys, k)
 			}
-			if equal, ok := interface{}(p).(interface{ EqualVT(*anypb.Any) bool }); ok {
-				if !equal.EqualVT(q) {
-					return false
+		}
+		sort.Slice(keys, func(i, j int) bool { return !keys[i] && keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapBoolBool[k]
+			vy, yok := that.MapBoolBool[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_bool_bool[" + strconv.FormatBool(k) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_bool_bool[" + strconv.FormatBool(k) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_bool_bool[" + strconv.FormatBool(k) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
 				}
-			} else if !proto.Equal(p, q) {
-				return false
 			}
 		}
 	}
-	if len(this.RepeatedValue) != len(that.RepeatedValue) {
-		return false
-	}
-	for i, vx := range this.RepeatedValue {
-		vy := that.RepeatedValue[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &structpb.Value{}
-			}
-			if q == nil {
-				q = &structpb.Value{}
+	if len(this.MapStringString) > 0 || len(that.MapStringString) > 0 {
+		keys := make([]string, 0, len(this.MapStringString)+len(that.MapStringString))
+		for k := range this.MapStringString {
+			keys = append(keys, k)
+		}
+		for k := range that.MapStringString {
+			if _, ok := this.MapStringString[k]; !ok {
+				keys = append(keys, k)
 			}
-			if equal, ok := interface{}(p).(interface{ EqualVT(*structpb.Value) bool }); ok {
-				if !equal.EqualVT(q) {
-					return false
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapStringString[k]
+			vy, yok := that.MapStringString[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_string[" + strconv.Quote(k) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_string[" + strconv.Quote(k) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_string[" + strconv.Quote(k) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
 				}
-			} else if !proto.Equal(p, q) {
-				return false
 			}
 		}
 	}
-	if len(this.RepeatedListValue) != len(that.RepeatedListValue) {
-		return false
-	}
-	for i, vx := range this.RepeatedListValue {
-		vy := that.RepeatedListValue[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &structpb.ListValue{}
+	if len(this.MapStringBytes) > 0 || len(that.MapStringBytes) > 0 {
+		keys := make([]string, 0, len(this.MapStringBytes)+len(that.MapStringBytes))
+		for k := range this.MapStringBytes {
+			keys = append(keys, k)
+		}
+		for k := range that.MapStringBytes {
+			if _, ok := this.MapStringBytes[k]; !ok {
+				keys = append(keys, k)
 			}
-			if q == nil {
-				q = &structpb.ListValue{}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapStringBytes[k]
+			vy, yok := that.MapStringBytes[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_bytes[" + strconv.Quote(k) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_bytes[" + strconv.Quote(k) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if string(vx) != string(vy) {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_bytes[" + strconv.Quote(k) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
 			}
-			if equal, ok := interface{}(p).(interface {
-				EqualVT(*structpb.ListValue) bool
-			}); ok {
-				if !equal.EqualVT(q) {
-					return false
+		}
+	}
+	if len(this.MapStringNestedMessage) > 0 || len(that.MapStringNestedMessage) > 0 {
+		keys := make([]string, 0, len(this.MapStringNestedMessage)+len(that.MapStringNestedMessage))
+		for k := range this.MapStringNestedMessage {
+			keys = append(keys, k)
+		}
+		for k := range that.MapStringNestedMessage {
+			if _, ok := this.MapStringNestedMessage[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapStringNestedMessage[k]
+			vy, yok := that.MapStringNestedMessage[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_nested_message[" + strconv.Quote(k) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_nested_message[" + strconv.Quote(k) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if p, q := vx, vy; p != q {
+					if p == nil {
+						p = &TestAllTypesProto3_NestedMessage{}
+					}
+					if q == nil {
+						q = &TestAllTypesProto3_NestedMessage{}
+					}
+					if nested := p.DiffVT(q); len(nested) > 0 {
+						diffs = protohelpers.AppendDiffs(diffs, "map_string_nested_message["+strconv.Quote(k)+"]", nested)
+					}
 				}
-			} else if !proto.Equal(p, q) {
-				return false
 			}
 		}
 	}
-	if len(this.RepeatedStruct) != len(that.RepeatedStruct) {
-		return false
+	if len(this.MapStringForeignMessage) > 0 || len(that.MapStringForeignMessage) > 0 {
+		keys := make([]string, 0, len(this.MapStringForeignMessage)+len(that.MapStringForeignMessage))
+		for k := range this.MapStringForeignMessage {
+			keys = append(keys, k)
+		}
+		for k := range that.MapStringForeignMessage {
+			if _, ok := this.MapStringForeignMessage[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapStringForeignMessage[k]
+			vy, yok := that.MapStringForeignMessage[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_foreign_message[" + strconv.Quote(k) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_foreign_message[" + strconv.Quote(k) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if p, q := vx, vy; p != q {
+					if p == nil {
+						p = &ForeignMessage{}
+					}
+					if q == nil {
+						q = &ForeignMessage{}
+					}
+					if nested := p.DiffVT(q); len(nested) > 0 {
+						diffs = protohelpers.AppendDiffs(diffs, "map_string_foreign_message["+strconv.Quote(k)+"]", nested)
+					}
+				}
+			}
+		}
 	}
-	for i, vx := range this.RepeatedStruct {
-		vy := that.RepeatedStruct[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &structpb.Struct{}
+	if len(this.MapStringNestedEnum) > 0 || len(that.MapStringNestedEnum) > 0 {
+		keys := make([]string, 0, len(this.MapStringNestedEnum)+len(that.MapStringNestedEnum))
+		for k := range this.MapStringNestedEnum {
+			keys = append(keys, k)
+		}
+		for k := range that.MapStringNestedEnum {
+			if _, ok := this.MapStringNestedEnum[k]; !ok {
+				keys = append(keys, k)
 			}
-			if q == nil {
-				q = &structpb.Struct{}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapStringNestedEnum[k]
+			vy, yok := that.MapStringNestedEnum[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_nested_enum[" + strconv.Quote(k) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_nested_enum[" + strconv.Quote(k) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_nested_enum[" + strconv.Quote(k) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
 			}
-			if equal, ok := interface{}(p).(interface{ EqualVT(*structpb.Struct) bool }); ok {
-				if !equal.EqualVT(q) {
-					return false
+		}
+	}
+	if len(this.MapStringForeignEnum) > 0 || len(that.MapStringForeignEnum) > 0 {
+		keys := make([]string, 0, len(this.MapStringForeignEnum)+len(that.MapStringForeignEnum))
+		for k := range this.MapStringForeignEnum {
+			keys = append(keys, k)
+		}
+		for k := range that.MapStringForeignEnum {
+			if _, ok := this.MapStringForeignEnum[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.MapStringForeignEnum[k]
+			vy, yok := that.MapStringForeignEnum[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_foreign_enum[" + strconv.Quote(k) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_foreign_enum[" + strconv.Quote(k) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "map_string_foreign_enum[" + strconv.Quote(k) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
 				}
-			} else if !proto.Equal(p, q) {
-				return false
 			}
 		}
 	}
-	if this.Fieldname1 != that.Fieldname1 {
-		return false
+	for i := 0; i < len(this.PackedInt32) && i < len(that.PackedInt32); i++ {
+		if this.PackedInt32[i] != that.PackedInt32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedInt32[i], New: that.PackedInt32[i]})
+		}
 	}
-	if this.FieldName2 != that.FieldName2 {
-		return false
+	for i := len(that.PackedInt32); i < len(this.PackedInt32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedInt32[i]})
 	}
-	if this.XFieldName3 != that.XFieldName3 {
-		return false
+	for i := len(this.PackedInt32); i < len(that.PackedInt32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedInt32[i]})
 	}
-	if this.Field_Name4_ != that.Field_Name4_ {
-		return false
+	for i := 0; i < len(this.PackedInt64) && i < len(that.PackedInt64); i++ {
+		if this.PackedInt64[i] != that.PackedInt64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_int64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedInt64[i], New: that.PackedInt64[i]})
+		}
 	}
-	if this.Field0Name5 != that.Field0Name5 {
-		return false
+	for i := len(that.PackedInt64); i < len(this.PackedInt64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_int64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedInt64[i]})
 	}
-	if this.Field_0Name6 != that.Field_0Name6 {
-		return false
+	for i := len(this.PackedInt64); i < len(that.PackedInt64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_int64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedInt64[i]})
 	}
-	if this.FieldName7 != that.FieldName7 {
-		return false
+	for i := 0; i < len(this.PackedUint32) && i < len(that.PackedUint32); i++ {
+		if this.PackedUint32[i] != that.PackedUint32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_uint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedUint32[i], New: that.PackedUint32[i]})
+		}
 	}
-	if this.FieldName8 != that.FieldName8 {
-		return false
+	for i := len(that.PackedUint32); i < len(this.PackedUint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_uint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedUint32[i]})
 	}
-	if this.Field_Name9 != that.Field_Name9 {
-		return false
+	for i := len(this.PackedUint32); i < len(that.PackedUint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_uint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedUint32[i]})
 	}
-	if this.Field_Name10 != that.Field_Name10 {
-		return false
+	for i := 0; i < len(this.PackedUint64) && i < len(that.PackedUint64); i++ {
+		if this.PackedUint64[i] != that.PackedUint64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_uint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedUint64[i], New: that.PackedUint64[i]})
+		}
 	}
-	if this.FIELD_NAME11 != that.FIELD_NAME11 {
-		return false
+	for i := len(that.PackedUint64); i < len(this.PackedUint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_uint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedUint64[i]})
 	}
-	if this.FIELDName12 != that.FIELDName12 {
-		return false
+	for i := len(this.PackedUint64); i < len(that.PackedUint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_uint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedUint64[i]})
 	}
-	if this.XFieldName13 != that.XFieldName13 {
-		return false
+	for i := 0; i < len(this.PackedSint32) && i < len(that.PackedSint32); i++ {
+		if this.PackedSint32[i] != that.PackedSint32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedSint32[i], New: that.PackedSint32[i]})
+		}
 	}
-	if this.X_FieldName14 != that.X_FieldName14 {
-		return false
+	for i := len(that.PackedSint32); i < len(this.PackedSint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedSint32[i]})
 	}
-	if this.Field_Name15 != that.Field_Name15 {
-		return false
+	for i := len(this.PackedSint32); i < len(that.PackedSint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedSint32[i]})
 	}
-	if this.Field__Name16 != that.Field__Name16 {
-		return false
+	for i := 0; i < len(this.PackedSint64) && i < len(that.PackedSint64); i++ {
+		if this.PackedSint64[i] != that.PackedSint64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedSint64[i], New: that.PackedSint64[i]})
+		}
 	}
-	if this.FieldName17__ != that.FieldName17__ {
-		return false
+	for i := len(that.PackedSint64); i < len(this.PackedSint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedSint64[i]})
 	}
-	if this.FieldName18__ != that.FieldName18__ {
-		return false
+	for i := len(this.PackedSint64); i < len(that.PackedSint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedSint64[i]})
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
-}
-
-func (this *TestAllTypesProto3_OneofUint32) EqualVT(thatIface isTestAllTypesProto3_OneofField) bool {
-	that, ok := thatIface.(*TestAllTypesProto3_OneofUint32)
-	if !ok {
-		return false
+	for i := 0; i < len(this.PackedFixed32) && i < len(that.PackedFixed32); i++ {
+		if this.PackedFixed32[i] != that.PackedFixed32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_fixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedFixed32[i], New: that.PackedFixed32[i]})
+		}
 	}
-	if this == that {
-		return true
+	for i := len(that.PackedFixed32); i < len(this.PackedFixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_fixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedFixed32[i]})
 	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	for i := len(this.PackedFixed32); i < len(that.PackedFixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_fixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedFixed32[i]})
 	}
-	if this.OneofUint32 != that.OneofUint32 {
-		return false
+	for i := 0; i < len(this.PackedFixed64) && i < len(that.PackedFixed64); i++ {
+		if this.PackedFixed64[i] != that.PackedFixed64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_fixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedFixed64[i], New: that.PackedFixed64[i]})
+		}
 	}
-	return true
-}
-
-func (this *TestAllTypesProto3_OneofNestedMessage) EqualVT(thatIface isTestAllTypesProto3_OneofField) bool {
-	that, ok := thatIface.(*TestAllTypesProto3_OneofNestedMessage)
-	if !ok {
-		return false
+	for i := len(that.PackedFixed64); i < len(this.PackedFixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_fixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedFixed64[i]})
 	}
-	if this == that {
-		return true
+	for i := len(this.PackedFixed64); i < len(that.PackedFixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_fixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedFixed64[i]})
 	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	for i := 0; i < len(this.PackedSfixed32) && i < len(that.PackedSfixed32); i++ {
+		if this.PackedSfixed32[i] != that.PackedSfixed32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sfixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedSfixed32[i], New: that.PackedSfixed32[i]})
+		}
 	}
-	if p, q := this.OneofNestedMessage, that.OneofNestedMessage; p != q {
-		if p == nil {
-			p = &TestAllTypesProto3_NestedMessage{}
+	for i := len(that.PackedSfixed32); i < len(this.PackedSfixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sfixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedSfixed32[i]})
+	}
+	for i := len(this.PackedSfixed32); i < len(that.PackedSfixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sfixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedSfixed32[i]})
+	}
+	for i := 0; i < len(this.PackedSfixed64) && i < len(that.PackedSfixed64); i++ {
+		if this.PackedSfixed64[i] != that.PackedSfixed64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sfixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedSfixed64[i], New: that.PackedSfixed64[i]})
 		}
-		if q == nil {
-			q = &TestAllTypesProto3_NestedMessage{}
+	}
+	for i := len(that.PackedSfixed64); i < len(this.PackedSfixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sfixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedSfixed64[i]})
+	}
+	for i := len(this.PackedSfixed64); i < len(that.PackedSfixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_sfixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedSfixed64[i]})
+	}
+	for i := 0; i < len(this.PackedFloat) && i < len(that.PackedFloat); i++ {
+		if this.PackedFloat[i] != that.PackedFloat[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_float[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedFloat[i], New: that.PackedFloat[i]})
 		}
-		if !p.EqualVT(q) {
-			return false
+	}
+	for i := len(that.PackedFloat); i < len(this.PackedFloat); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_float[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedFloat[i]})
+	}
+	for i := len(this.PackedFloat); i < len(that.PackedFloat); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_float[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedFloat[i]})
+	}
+	for i := 0; i < len(this.PackedDouble) && i < len(that.PackedDouble); i++ {
+		if this.PackedDouble[i] != that.PackedDouble[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_double[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedDouble[i], New: that.PackedDouble[i]})
 		}
 	}
-	return true
-}
-
-func (this *TestAllTypesProto3_OneofString) EqualVT(thatIface isTestAllTypesProto3_OneofField) bool {
-	that, ok := thatIface.(*TestAllTypesProto3_OneofString)
-	if !ok {
-		return false
+	for i := len(that.PackedDouble); i < len(this.PackedDouble); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_double[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedDouble[i]})
 	}
-	if this == that {
-		return true
+	for i := len(this.PackedDouble); i < len(that.PackedDouble); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_double[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedDouble[i]})
 	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	for i := 0; i < len(this.PackedBool) && i < len(that.PackedBool); i++ {
+		if this.PackedBool[i] != that.PackedBool[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_bool[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedBool[i], New: that.PackedBool[i]})
+		}
 	}
-	if this.OneofString != that.OneofString {
-		return false
+	for i := len(that.PackedBool); i < len(this.PackedBool); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_bool[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedBool[i]})
 	}
-	return true
-}
-
-func (this *TestAllTypesProto3_OneofBytes) EqualVT(thatIface isTestAllTypesProto3_OneofField) bool {
-	that, ok := thatIface.(*TestAllTypesProto3_OneofBytes)
-	if !ok {
-		return false
+	for i := len(this.PackedBool); i < len(that.PackedBool); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_bool[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedBool[i]})
 	}
-	if this == that {
-		return true
+	for i := 0; i < len(this.PackedNestedEnum) && i < len(that.PackedNestedEnum); i++ {
+		if this.PackedNestedEnum[i] != that.PackedNestedEnum[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_nested_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedNestedEnum[i], New: that.PackedNestedEnum[i]})
+		}
 	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	for i := len(that.PackedNestedEnum); i < len(this.PackedNestedEnum); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_nested_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedNestedEnum[i]})
 	}
-	if string(this.OneofBytes) != string(that.OneofBytes) {
-		return false
+	for i := len(this.PackedNestedEnum); i < len(that.PackedNestedEnum); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_nested_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedNestedEnum[i]})
 	}
-	return true
-}
-
-func (this *TestAllTypesProto3_OneofBool) EqualVT(thatIface isTestAllTypesProto3_OneofField) bool {
-	that, ok := thatIface.(*TestAllTypesProto3_OneofBool)
-	if !ok {
-		return false
+	for i := 0; i < len(this.UnpackedInt32) && i < len(that.UnpackedInt32); i++ {
+		if this.UnpackedInt32[i] != that.UnpackedInt32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedInt32[i], New: that.UnpackedInt32[i]})
+		}
 	}
-	if this == that {
-		return true
+	for i := len(that.UnpackedInt32); i < len(this.UnpackedInt32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedInt32[i]})
 	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	for i := len(this.UnpackedInt32); i < len(that.UnpackedInt32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedInt32[i]})
 	}
-	if this.OneofBool != that.OneofBool {
-		return false
+	for i := 0; i < len(this.UnpackedInt64) && i < len(that.UnpackedInt64); i++ {
+		if this.UnpackedInt64[i] != that.UnpackedInt64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_int64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedInt64[i], New: that.UnpackedInt64[i]})
+		}
 	}
-	return true
-}
-
-func (this *TestAllTypesProto3_OneofUint64) EqualVT(thatIface isTestAllTypesProto3_OneofField) bool {
-	that, ok := thatIface.(*TestAllTypesProto3_OneofUint64)
-	if !ok {
-		return false
+	for i := len(that.UnpackedInt64); i < len(this.UnpackedInt64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_int64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedInt64[i]})
 	}
-	if this == that {
-		return true
+	for i := len(this.UnpackedInt64); i < len(that.UnpackedInt64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_int64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedInt64[i]})
 	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	for i := 0; i < len(this.UnpackedUint32) && i < len(that.UnpackedUint32); i++ {
+		if this.UnpackedUint32[i] != that.UnpackedUint32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_uint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedUint32[i], New: that.UnpackedUint32[i]})
+		}
 	}
-	if this.OneofUint64 != that.OneofUint64 {
-		return false
+	for i := len(that.UnpackedUint32); i < len(this.UnpackedUint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_uint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedUint32[i]})
 	}
-	return true
-}
-
-func (this *TestAllTypesProto3_OneofFloat) EqualVT(thatIface isTestAllTypesProto3_OneofField) bool {
-	that, ok := thatIface.(*TestAllTypesProto3_OneofFloat)
-	if !ok {
-		return false
+	for i := len(this.UnpackedUint32); i < len(that.UnpackedUint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_uint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedUint32[i]})
 	}
-	if this == that {
-		return true
+	for i := 0; i < len(this.UnpackedUint64) && i < len(that.UnpackedUint64); i++ {
+		if this.UnpackedUint64[i] != that.UnpackedUint64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_uint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedUint64[i], New: that.UnpackedUint64[i]})
+		}
 	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	for i := len(that.UnpackedUint64); i < len(this.UnpackedUint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_uint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedUint64[i]})
 	}
-	if this.OneofFloat != that.OneofFloat {
-		return false
+	for i := len(this.UnpackedUint64); i < len(that.UnpackedUint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_uint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedUint64[i]})
 	}
-	return true
-}
-
-func (this *TestAllTypesProto3_OneofDouble) EqualVT(thatIface isTestAllTypesProto3_OneofField) bool {
-	that, ok := thatIface.(*TestAllTypesProto3_OneofDouble)
-	if !ok {
-		return false
+	for i := 0; i < len(this.UnpackedSint32) && i < len(that.UnpackedSint32); i++ {
+		if this.UnpackedSint32[i] != that.UnpackedSint32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedSint32[i], New: that.UnpackedSint32[i]})
+		}
 	}
-	if this == that {
-		return true
+	for i := len(that.UnpackedSint32); i < len(this.UnpackedSint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedSint32[i]})
 	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	for i := len(this.UnpackedSint32); i < len(that.UnpackedSint32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sint32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedSint32[i]})
 	}
-	if this.OneofDouble != that.OneofDouble {
-		return false
+	for i := 0; i < len(this.UnpackedSint64) && i < len(that.UnpackedSint64); i++ {
+		if this.UnpackedSint64[i] != that.UnpackedSint64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedSint64[i], New: that.UnpackedSint64[i]})
+		}
 	}
-	return true
-}
-
-func (this *TestAllTypesProto3_OneofEnum) EqualVT(thatIface isTestAllTypesProto3_OneofField) bool {
-	that, ok := thatIface.(*TestAllTypesProto3_OneofEnum)
-	if !ok {
-		return false
+	for i := len(that.UnpackedSint64); i < len(this.UnpackedSint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedSint64[i]})
 	}
-	if this == that {
-		return true
+	for i := len(this.UnpackedSint64); i < len(that.UnpackedSint64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sint64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedSint64[i]})
 	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	for i := 0; i < len(this.UnpackedFixed32) && i < len(that.UnpackedFixed32); i++ {
+		if this.UnpackedFixed32[i] != that.UnpackedFixed32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_fixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedFixed32[i], New: that.UnpackedFixed32[i]})
+		}
 	}
-	if this.OneofEnum != that.OneofEnum {
-		return false
+	for i := len(that.UnpackedFixed32); i < len(this.UnpackedFixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_fixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedFixed32[i]})
 	}
-	return true
-}
-
-func (this *TestAllTypesProto3_OneofNullValue) EqualVT(thatIface isTestAllTypesProto3_OneofField) bool {
-	that, ok := thatIface.(*TestAllTypesProto3_OneofNullValue)
-	if !ok {
-		return false
+	for i := len(this.UnpackedFixed32); i < len(that.UnpackedFixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_fixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedFixed32[i]})
 	}
-	if this == that {
-		return true
+	for i := 0; i < len(this.UnpackedFixed64) && i < len(that.UnpackedFixed64); i++ {
+		if this.UnpackedFixed64[i] != that.UnpackedFixed64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_fixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedFixed64[i], New: that.UnpackedFixed64[i]})
+		}
 	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	for i := len(that.UnpackedFixed64); i < len(this.UnpackedFixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_fixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedFixed64[i]})
 	}
-	if this.OneofNullValue != that.OneofNullValue {
-		return false
+	for i := len(this.UnpackedFixed64); i < len(that.UnpackedFixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_fixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedFixed64[i]})
 	}
-	return true
-}
-
-func (this *ForeignMessage) EqualVT(that *ForeignMessage) bool {
-	if this == nil {
-		return that == nil
-	} else if that == nil {
-		return false
+	for i := 0; i < len(this.UnpackedSfixed32) && i < len(that.UnpackedSfixed32); i++ {
+		if this.UnpackedSfixed32[i] != that.UnpackedSfixed32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sfixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedSfixed32[i], New: that.UnpackedSfixed32[i]})
+		}
 	}
-	if this.C != that.C {
-		return false
+	for i := len(that.UnpackedSfixed32); i < len(this.UnpackedSfixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sfixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedSfixed32[i]})
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
-}
-
-func (this *NullHypothesisProto3) EqualVT(that *NullHypothesisProto3) bool {
-	if this == nil {
-		return that == nil
-	} else if that == nil {
-		return false
+	for i := len(this.UnpackedSfixed32); i < len(that.UnpackedSfixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sfixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedSfixed32[i]})
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
-}
-
-func (this *EnumOnlyProto3) EqualVT(that *EnumOnlyProto3) bool {
-	if this == nil {
-		return that == nil
-	} else if that == nil {
-		return false
+	for i := 0; i < len(this.UnpackedSfixed64) && i < len(that.UnpackedSfixed64); i++ {
+		if this.UnpackedSfixed64[i] != that.UnpackedSfixed64[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sfixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedSfixed64[i], New: that.UnpackedSfixed64[i]})
+		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
-}
-
-func (m *TestAllTypesProto3_NestedMessage) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	for i := len(that.UnpackedSfixed64); i < len(this.UnpackedSfixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sfixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedSfixed64[i]})
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	for i := len(this.UnpackedSfixed64); i < len(that.UnpackedSfixed64); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_sfixed64[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedSfixed64[i]})
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TestAllTypesProto3_NestedMessage) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *TestAllTypesProto3_NestedMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+	for i := 0; i < len(this.UnpackedFloat) && i < len(that.UnpackedFloat); i++ {
+		if this.UnpackedFloat[i] != that.UnpackedFloat[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_float[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedFloat[i], New: that.UnpackedFloat[i]})
+		}
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	for i := len(that.UnpackedFloat); i < len(this.UnpackedFloat); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_float[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedFloat[i]})
 	}
-	if m.Corecursive != nil {
-		size, err := m.Corecursive.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+	for i := len(this.UnpackedFloat); i < len(that.UnpackedFloat); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_float[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedFloat[i]})
+	}
+	for i := 0; i < len(this.UnpackedDouble) && i < len(that.UnpackedDouble); i++ {
+		if this.UnpackedDouble[i] != that.UnpackedDouble[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_double[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedDouble[i], New: that.UnpackedDouble[i]})
 		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.A != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.A))
-		i--
-		dAtA[i] = 0x8
+	for i := len(that.UnpackedDouble); i < len(this.UnpackedDouble); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_double[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedDouble[i]})
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TestAllTypesProto3) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	for i := len(this.UnpackedDouble); i < len(that.UnpackedDouble); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_double[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedDouble[i]})
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	for i := 0; i < len(this.UnpackedBool) && i < len(that.UnpackedBool); i++ {
+		if this.UnpackedBool[i] != that.UnpackedBool[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_bool[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedBool[i], New: that.UnpackedBool[i]})
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TestAllTypesProto3) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+	for i := len(that.UnpackedBool); i < len(this.UnpackedBool); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_bool[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedBool[i]})
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	for i := len(this.UnpackedBool); i < len(that.UnpackedBool); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_bool[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedBool[i]})
 	}
-	if vtmsg, ok := m.OneofField.(interface {
-		MarshalToSizedBufferVT([]byte) (int, error)
-	}); ok {
-		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+	for i := 0; i < len(this.UnpackedNestedEnum) && i < len(that.UnpackedNestedEnum); i++ {
+		if this.UnpackedNestedEnum[i] != that.UnpackedNestedEnum[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_nested_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.UnpackedNestedEnum[i], New: that.UnpackedNestedEnum[i]})
 		}
-		i -= size
 	}
-	if m.FieldName18__ != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.FieldName18__))
-		i--
-		dAtA[i] = 0x1a
-		i--
-		dAtA[i] = 0x90
+	for i := len(that.UnpackedNestedEnum); i < len(this.UnpackedNestedEnum); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_nested_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.UnpackedNestedEnum[i]})
 	}
-	if m.FieldName17__ != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.FieldName17__))
-		i--
-		dAtA[i] = 0x1a
-		i--
-		dAtA[i] = 0x88
+	for i := len(this.UnpackedNestedEnum); i < len(that.UnpackedNestedEnum); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "unpacked_nested_enum[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.UnpackedNestedEnum[i]})
 	}
-	if m.Field__Name16 != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Field__Name16))
-		i--
-		dAtA[i] = 0x1a
-		i--
-		dAtA[i] = 0x80
+	if x, ok := this.OneofField.(*TestAllTypesProto3_OneofUint32); ok {
+		if y, ok := that.OneofField.(*TestAllTypesProto3_OneofUint32); ok {
+			if x.OneofUint32 != y.OneofUint32 {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_uint32", Kind: vtproto.FieldChanged, Old: x.OneofUint32, New: y.OneofUint32})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_uint32", Kind: vtproto.FieldRemoved, Old: x.OneofUint32})
+		}
+	} else if y, ok := that.OneofField.(*TestAllTypesProto3_OneofUint32); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_uint32", Kind: vtproto.FieldAdded, New: y.OneofUint32})
 	}
-	if m.Field_Name15 != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Field_Name15))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xf8
+	if x, ok := this.OneofField.(*TestAllTypesProto3_OneofNestedMessage); ok {
+		if y, ok := that.OneofField.(*TestAllTypesProto3_OneofNestedMessage); ok {
+			if p, q := x.OneofNestedMessage, y.OneofNestedMessage; p != q {
+				if p == nil {
+					p = &TestAllTypesProto3_NestedMessage{}
+				}
+				if q == nil {
+					q = &TestAllTypesProto3_NestedMessage{}
+				}
+				if nested := p.DiffVT(q); len(nested) > 0 {
+					diffs = protohelpers.AppendDiffs(diffs, "oneof_nested_message", nested)
+				}
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_nested_message", Kind: vtproto.FieldRemoved, Old: x.OneofNestedMessage})
+		}
+	} else if y, ok := that.OneofField.(*TestAllTypesProto3_OneofNestedMessage); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_nested_message", Kind: vtproto.FieldAdded, New: y.OneofNestedMessage})
 	}
-	if m.X_FieldName14 != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.X_FieldName14))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xf0
+	if x, ok := this.OneofField.(*TestAllTypesProto3_OneofString); ok {
+		if y, ok := that.OneofField.(*TestAllTypesProto3_OneofString); ok {
+			if x.OneofString != y.OneofString {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_string", Kind: vtproto.FieldChanged, Old: x.OneofString, New: y.OneofString})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_string", Kind: vtproto.FieldRemoved, Old: x.OneofString})
+		}
+	} else if y, ok := that.OneofField.(*TestAllTypesProto3_OneofString); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_string", Kind: vtproto.FieldAdded, New: y.OneofString})
 	}
-	if m.XFieldName13 != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.XFieldName13))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xe8
+	if x, ok := this.OneofField.(*TestAllTypesProto3_OneofBytes); ok {
+		if y, ok := that.OneofField.(*TestAllTypesProto3_OneofBytes); ok {
+			if string(x.OneofBytes) != string(y.OneofBytes) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_bytes", Kind: vtproto.FieldChanged, Old: x.OneofBytes, New: y.OneofBytes})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_bytes", Kind: vtproto.FieldRemoved, Old: x.OneofBytes})
+		}
+	} else if y, ok := that.OneofField.(*TestAllTypesProto3_OneofBytes); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_bytes", Kind: vtproto.FieldAdded, New: y.OneofBytes})
 	}
-	if m.FIELDName12 != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.FIELDName12))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xe0
+	if x, ok := this.OneofField.(*TestAllTypesProto3_OneofBool); ok {
+		if y, ok := that.OneofField.(*TestAllTypesProto3_OneofBool); ok {
+			if x.OneofBool != y.OneofBool {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_bool", Kind: vtproto.FieldChanged, Old: x.OneofBool, New: y.OneofBool})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_bool", Kind: vtproto.FieldRemoved, Old: x.OneofBool})
+		}
+	} else if y, ok := that.OneofField.(*TestAllTypesProto3_OneofBool); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_bool", Kind: vtproto.FieldAdded, New: y.OneofBool})
 	}
-	if m.FIELD_NAME11 != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.FIELD_NAME11))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xd8
+	if x, ok := this.OneofField.(*TestAllTypesProto3_OneofUint64); ok {
+		if y, ok := that.OneofField.(*TestAllTypesProto3_OneofUint64); ok {
+			if x.OneofUint64 != y.OneofUint64 {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_uint64", Kind: vtproto.FieldChanged, Old: x.OneofUint64, New: y.OneofUint64})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_uint64", Kind: vtproto.FieldRemoved, Old: x.OneofUint64})
+		}
+	} else if y, ok := that.OneofField.(*TestAllTypesProto3_OneofUint64); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_uint64", Kind: vtproto.FieldAdded, New: y.OneofUint64})
 	}
-	if m.Field_Name10 != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Field_Name10))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xd0
+	if x, ok := this.OneofField.(*TestAllTypesProto3_OneofFloat); ok {
+		if y, ok := that.OneofField.(*TestAllTypesProto3_OneofFloat); ok {
+			if x.OneofFloat != y.OneofFloat {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_float", Kind: vtproto.FieldChanged, Old: x.OneofFloat, New: y.OneofFloat})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_float", Kind: vtproto.FieldRemoved, Old: x.OneofFloat})
+		}
+	} else if y, ok := that.OneofField.(*TestAllTypesProto3_OneofFloat); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_float", Kind: vtproto.FieldAdded, New: y.OneofFloat})
 	}
-	if m.Field_Name9 != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Field_Name9))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xc8
+	if x, ok := this.OneofField.(*TestAllTypesProto3_OneofDouble); ok {
+		if y, ok := that.OneofField.(*TestAllTypesProto3_OneofDouble); ok {
+			if x.OneofDouble != y.OneofDouble {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_double", Kind: vtproto.FieldChanged, Old: x.OneofDouble, New: y.OneofDouble})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_double", Kind: vtproto.FieldRemoved, Old: x.OneofDouble})
+		}
+	} else if y, ok := that.OneofField.(*TestAllTypesProto3_OneofDouble); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_double", Kind: vtproto.FieldAdded, New: y.OneofDouble})
 	}
-	if m.FieldName8 != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.FieldName8))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xc0
+	if x, ok := this.OneofField.(*TestAllTypesProto3_OneofEnum); ok {
+		if y, ok := that.OneofField.(*TestAllTypesProto3_OneofEnum); ok {
+			if x.OneofEnum != y.OneofEnum {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_enum", Kind: vtproto.FieldChanged, Old: x.OneofEnum, New: y.OneofEnum})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_enum", Kind: vtproto.FieldRemoved, Old: x.OneofEnum})
+		}
+	} else if y, ok := that.OneofField.(*TestAllTypesProto3_OneofEnum); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_enum", Kind: vtproto.FieldAdded, New: y.OneofEnum})
 	}
-	if m.FieldName7 != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.FieldName7))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xb8
+	if x, ok := this.OneofField.(*TestAllTypesProto3_OneofNullValue); ok {
+		if y, ok := that.OneofField.(*TestAllTypesProto3_OneofNullValue); ok {
+			if x.OneofNullValue != y.OneofNullValue {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_null_value", Kind: vtproto.FieldChanged, Old: x.OneofNullValue, New: y.OneofNullValue})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_null_value", Kind: vtproto.FieldRemoved, Old: x.OneofNullValue})
+		}
+	} else if y, ok := that.OneofField.(*TestAllTypesProto3_OneofNullValue); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_null_value", Kind: vtproto.FieldAdded, New: y.OneofNullValue})
+	}
+	if p, q := this.OptionalBoolWrapper, that.OptionalBoolWrapper; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bool_wrapper", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bool_wrapper", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if vtpb, ok := interface{}(p).(interface {
+			DiffVT(*wrapperspb.BoolValue) []vtproto.FieldDiff
+		}); ok {
+			if nested := vtpb.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "optional_bool_wrapper", nested)
+			}
+		} else if !proto.Equal(p, q) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bool_wrapper", Kind: vtproto.FieldChanged, Old: p, New: q})
+		}
 	}
-	if m.Field_0Name6 != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Field_0Name6))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xb0
+	if p, q := this.OptionalInt32Wrapper, that.OptionalInt32Wrapper; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int32_wrapper", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int32_wrapper", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if vtpb, ok := interface{}(p).(interface {
+			DiffVT(*wrapperspb.Int32Value) []vtproto.FieldDiff
+		}); ok {
+			if nested := vtpb.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "optional_int32_wrapper", nested)
+			}
+		} else if !proto.Equal(p, q) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int32_wrapper", Kind: vtproto.FieldChanged, Old: p, New: q})
+		}
 	}
-	if m.Field0Name5 != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Field0Name5))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xa8
+	if p, q := this.OptionalInt64Wrapper, that.OptionalInt64Wrapper; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int64_wrapper", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int64_wrapper", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if vtpb, ok := interface{}(p).(interface {
+			DiffVT(*wrapperspb.Int64Value) []vtproto.FieldDiff
+		}); ok {
+			if nested := vtpb.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "optional_int64_wrapper", nested)
+			}
+		} else if !proto.Equal(p, q) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int64_wrapper", Kind: vtproto.FieldChanged, Old: p, New: q})
+		}
 	}
-	if m.Field_Name4_ != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Field_Name4_))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0xa0
+	if p, q := this.OptionalUint32Wrapper, that.OptionalUint32Wrapper; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_uint32_wrapper", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_uint32_wrapper", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if vtpb, ok := interface{}(p).(interface {
+			DiffVT(*wrapperspb.UInt32Value) []vtproto.FieldDiff
+		}); ok {
+			if nested := vtpb.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "optional_uint32_wrapper", nested)
+			}
+		} else if !proto.Equal(p, q) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "optional_uint32_wrapper", Kind: vtproto.FieldChanged, Old: p, New: q})
+		}
 	}
-	if m.XFieldName3 != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.XFieldName3))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0x98
+	if p, q := this.OptionalUint64Wrapper, that.OptionalUint64Wrapper; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_uint64_wrapper", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_uint64_wrapper", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if vtpb, ok := interface{}(p).(interface {
+			DiffVT(*wrapperspb.UInt64Value) []vtproto.FieldDiff
+		}); ok {
+			if nested := vtpb.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "optional_uint64_wrapper", nested)
+			}
+		} else if !proto.Equal(p, q) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "optional_uint64_wrapper", Kind: vtproto.FieldChanged, Old: p, New: q})
+		}
 	}
-	if m.FieldName2 != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.FieldName2))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0x90
+	if p, q := this.OptionalFloatWrapper, that.OptionalFloatWrapper; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_float_wrapper", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_float_wrapper", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if vtpb, ok := interface{}(p).(interface {
+			DiffVT(*wrapperspb.FloatValue) []vtproto.FieldDiff
+		}); ok {
+			if nested := vtpb.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "optional_float_wrapper", nested)
+			}
+		} else if !proto.Equal(p, q) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "optional_float_wrapper", Kind: vtproto.FieldChanged, Old: p, New: q})
+		}
 	}
-	if m.Fieldname1 != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Fieldname1))
-		i--
-		dAtA[i] = 0x19
-		i--
-		dAtA[i] = 0x88
+	if p, q := this.OptionalDoubleWrapper, that.OptionalDoubleWrapper; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_double_wrapper", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_double_wrapper", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if vtpb, ok := interface{}(p).(interface {
+			DiffVT(*wrapperspb.DoubleValue) []vtproto.FieldDiff
+		}); ok {
+			if nested := vtpb.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "optional_double_wrapper", nested)
+			}
+		} else if !proto.Equal(p, q) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "optional_double_wrapper", Kind: vtproto.FieldChanged, Old: p, New: q})
+		}
 	}
-	if len(m.RepeatedStruct) > 0 {
-		for iNdEx := len(m.RepeatedStruct) - 1; iNdEx >= 0; iNdEx-- {
-			if vtmsg, ok := interface{}(m.RepeatedStruct[iNdEx]).(interface {
-				MarshalToSizedBufferVT([]byte) (int, error)
+	if p, q := this.OptionalStringWrapper, that.OptionalStringWrapper; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_string_wrapper", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_string_wrapper", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if vtpb, ok := interface{}(p).(interface {
+			DiffVT(*wrapperspb.StringValue) []vtproto.FieldDiff
+		}); ok {
+			if nested := vtpb.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "optional_string_wrapper", nested)
+			}
+		} else if !proto.Equal(p, q) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "optional_string_wrapper", Kind: vtproto.FieldChanged, Old: p, New: q})
+		}
+	}
+	if p, q := this.OptionalBytesWrapper, that.OptionalBytesWrapper; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bytes_wrapper", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bytes_wrapper", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if vtpb, ok := interface{}(p).(interface {
+			DiffVT(*wrapperspb.BytesValue) []vtproto.FieldDiff
+		}); ok {
+			if nested := vtpb.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "optional_bytes_wrapper", nested)
+			}
+		} else if !proto.Equal(p, q) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bytes_wrapper", Kind: vtproto.FieldChanged, Old: p, New: q})
+		}
+	}
+	for i := 0; i < len(this.RepeatedBoolWrapper) && i < len(that.RepeatedBoolWrapper); i++ {
+		if p, q := this.RepeatedBoolWrapper[i], that.RepeatedBoolWrapper[i]; p != q {
+			if p == nil {
+				p = &wrapperspb.BoolValue{}
+			}
+			if q == nil {
+				q = &wrapperspb.BoolValue{}
+			}
+			if vtpb, ok := interface{}(p).(interface {
+				DiffVT(*wrapperspb.BoolValue) []vtproto.FieldDiff
 			}); ok {
-				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-				if err != nil {
-					return 0, err
+				if nested := vtpb.DiffVT(q); len(nested) > 0 {
+					diffs = protohelpers.AppendDiffs(diffs, "repeated_bool_wrapper["+strconv.Itoa(i)+"]", nested)
 				}
-				i -= size
-				i = encodeVarint(dAtA, i, uint64(size))
-			} else {
-				encoded, err := proto.Marshal(m.RepeatedStruct[iNdEx])
-				if err != nil {
-					return 0, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			} else if !proto.Equal(p, q) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_bool_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: p, New: q})
 			}
-			i--
-			dAtA[i] = 0x14
-			i--
-			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.RepeatedListValue) > 0 {
-		for iNdEx := len(m.RepeatedListValue) - 1; iNdEx >= 0; iNdEx-- {
-			if vtmsg, ok := interface{}(m.RepeatedListValue[iNdEx]).(interface {
-				MarshalToSizedBufferVT([]byte) (int, error)
+	for i := len(that.RepeatedBoolWrapper); i < len(this.RepeatedBoolWrapper); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_bool_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedBoolWrapper[i]})
+	}
+	for i := len(this.RepeatedBoolWrapper); i < len(that.RepeatedBoolWrapper); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_bool_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedBoolWrapper[i]})
+	}
+	for i := 0; i < len(this.RepeatedInt32Wrapper) && i < len(that.RepeatedInt32Wrapper); i++ {
+		if p, q := this.RepeatedInt32Wrapper[i], that.RepeatedInt32Wrapper[i]; p != q {
+			if p == nil {
+				p = &wrapperspb.Int32Value{}
+			}
+			if q == nil {
+				q = &wrapperspb.Int32Value{}
+			}
+			if vtpb, ok := interface{}(p).(interface {
+				DiffVT(*wrapperspb.Int32Value) []vtproto.FieldDiff
 			}); ok {
-				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarint(dAtA, i, uint64(size))
-			} else {
-				encoded, err := proto.Marshal(m.RepeatedListValue[iNdEx])
-				if err != nil {
-					return 0, err
+				if nested := vtpb.DiffVT(q); len(nested) > 0 {
+					diffs = protohelpers.AppendDiffs(diffs, "repeated_int32_wrapper["+strconv.Itoa(i)+"]", nested)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			} else if !proto.Equal(p, q) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int32_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: p, New: q})
 			}
-			i--
-			dAtA[i] = 0x13
-			i--
-			dAtA[i] = 0xea
 		}
 	}
-	if len(m.RepeatedValue) > 0 {
-		for iNdEx := len(m.RepeatedValue) - 1; iNdEx >= 0; iNdEx-- {
-			if vtmsg, ok := interface{}(m.RepeatedValue[iNdEx]).(interface {
-				MarshalToSizedBufferVT([]byte) (int, error)
+	for i := len(that.RepeatedInt32Wrapper); i < len(this.RepeatedInt32Wrapper); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int32_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedInt32Wrapper[i]})
+	}
+	for i := len(this.RepeatedInt32Wrapper); i < len(that.RepeatedInt32Wrapper); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int32_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedInt32Wrapper[i]})
+	}
+	for i := 0; i < len(this.RepeatedInt64Wrapper) && i < len(that.RepeatedInt64Wrapper); i++ {
+		if p, q := this.RepeatedInt64Wrapper[i], that.RepeatedInt64Wrapper[i]; p != q {
+			if p == nil {
+				p = &wrapperspb.Int64Value{}
+			}
+			if q == nil {
+				q = &wrapperspb.Int64Value{}
+			}
+			if vtpb, ok := interface{}(p).(interface {
+				DiffVT(*wrapperspb.Int64Value) []vtproto.FieldDiff
 			}); ok {
-				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarint(dAtA, i, uint64(size))
-			} else {
-				encoded, err := proto.Marshal(m.RepeatedValue[iNdEx])
-				if err != nil {
-					return 0, err
+				if nested := vtpb.DiffVT(q); len(nested) > 0 {
+					diffs = protohelpers.AppendDiffs(diffs, "repeated_int64_wrapper["+strconv.Itoa(i)+"]", nested)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			} else if !proto.Equal(p, q) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int64_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: p, New: q})
 			}
-			i--
-			dAtA[i] = 0x13
-			i--
-			dAtA[i] = 0xe2
 		}
 	}
-	if len(m.RepeatedAny) > 0 {
-		for iNdEx := len(m.RepeatedAny) - 1; iNdEx >= 0; iNdEx-- {
-			if vtmsg, ok := interface{}(m.RepeatedAny[iNdEx]).(interface {
-				MarshalToSizedBufferVT([]byte) (int, error)
+	for i := len(that.RepeatedInt64Wrapper); i < len(this.RepeatedInt64Wrapper); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int64_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedInt64Wrapper[i]})
+	}
+	for i := len(this.RepeatedInt64Wrapper); i < len(that.RepeatedInt64Wrapper); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int64_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedInt64Wrapper[i]})
+	}
+	for i := 0; i < len(this.RepeatedUint32Wrapper) && i < len(that.RepeatedUint32Wrapper); i++ {
+		if p, q := this.RepeatedUint32Wrapper[i], that.RepeatedUint32Wrapper[i]; p != q {
+			if p == nil {
+				p = &wrapperspb.UInt32Value{}
+			}
+			if q == nil {
+				q = &wrapperspb.UInt32Value{}
+			}
+			if vtpb, ok := interface{}(p).(interface {
+				DiffVT(*wrapperspb.UInt32Value) []vtproto.FieldDiff
 			}); ok {
-				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarint(dAtA, i, uint64(size))
-			} else {
-				encoded, err := proto.Marshal(m.RepeatedAny[iNdEx])
-				if err != nil {
-					return 0, err
+				if nested := vtpb.DiffVT(q); len(nested) > 0 {
+					diffs = protohelpers.AppendDiffs(diffs, "repeated_uint32_wrapper["+strconv.Itoa(i)+"]", nested)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			} else if !proto.Equal(p, q) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_uint32_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: p, New: q})
 			}
-			i--
-			dAtA[i] = 0x13
-			i--
-			dAtA[i] = 0xda
 		}
 	}
-	if len(m.RepeatedFieldmask) > 0 {
-		for iNdEx := len(m.RepeatedFieldmask) - 1; iNdEx >= 0; iNdEx-- {
-			if vtmsg, ok := interface{}(m.RepeatedFieldmask[iNdEx]).(interface {
-				MarshalToSizedBufferVT([]byte) (int, error)
+	for i := len(that.RepeatedUint32Wrapper); i < len(this.RepeatedUint32Wrapper); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_uint32_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedUint32Wrapper[i]})
+	}
+	for i := len(this.RepeatedUint32Wrapper); i < len(that.RepeatedUint32Wrapper); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_uint32_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedUint32Wrapper[i]})
+	}
+	for i := 0; i < len(this.RepeatedUint64Wrapper) && i < len(that.RepeatedUint64Wrapper); i++ {
+		if p, q := this.RepeatedUint64Wrapper[i], that.RepeatedUint64Wrapper[i]; p != q {
+			if p == nil {
+				p = &wrapperspb.UInt64Value{}
+			}
+			if q == nil {
+				q = &wrapperspb.UInt64Value{}
+			}
+			if vtpb, ok := interface{}(p).(interface {
+				DiffVT(*wrapperspb.UInt64Value) []vtproto.FieldDiff
 			}); ok {
-				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarint(dAtA, i, uint64(size))
-			} else {
-				encoded, err := proto.Marshal(m.RepeatedFieldmask[iNdEx])
-				if err != nil {
-					return 0, err
+				if nested := vtpb.DiffVT(q); len(nested) > 0 {
+					diffs = protohelpers.AppendDiffs(diffs, "repeated_uint64_wrapper["+strconv.Itoa(i)+"]", nested)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			} else if !proto.Equal(p, q) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_uint64_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: p, New: q})
 			}
-			i--
-			dAtA[i] = 0x13
-			i--
-			dAtA[i] = 0xca
 		}
 	}
-	if len(m.RepeatedTimestamp) > 0 {
-		for iNdEx := len(m.RepeatedTimestamp) - 1; iNdEx >= 0; iNdEx-- {
-			if vtmsg, ok := interface{}(m.RepeatedTimestamp[iNdEx]).(interface {
-				MarshalToSizedBufferVT([]byte) (int, error)
+	for i := len(that.RepeatedUint64Wrapper); i < len(this.RepeatedUint64Wrapper); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_uint64_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedUint64Wrapper[i]})
+	}
+	for i := len(this.RepeatedUint64Wrapper); i < len(that.RepeatedUint64Wrapper); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_uint64_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedUint64Wrapper[i]})
+	}
+	for i := 0; i < len(this.RepeatedFloatWrapper) && i < len(that.RepeatedFloatWrapper); i++ {
+		if p, q := this.RepeatedFloatWrapper[i], that.RepeatedFloatWrapper[i]; p != q {
+			if p == nil {
+				p = &wrapperspb.FloatValue{}
+			}
+			if q == nil {
+				q = &wrapperspb.FloatValue{}
+			}
+			if vtpb, ok := interface{}(p).(interface {
+				DiffVT(*wrapperspb.FloatValue) []vtproto.FieldDiff
 			}); ok {
-				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarint(dAtA, i, uint64(size))
-			} else {
-				encoded, err := proto.Marshal(m.RepeatedTimestamp[iNdEx])
-				if err != nil {
-					return 0, err
+				if nested := vtpb.DiffVT(q); len(nested) > 0 {
+					diffs = protohelpers.AppendDiffs(diffs, "repeated_float_wrapper["+strconv.Itoa(i)+"]", nested)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			} else if !proto.Equal(p, q) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_float_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: p, New: q})
 			}
-			i--
-			dAtA[i] = 0x13
-			i--
-			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.RepeatedDuration) > 0 {
-		for iNdEx := len(m.RepeatedDuration) - 1; iNdEx >= 0; iNdEx-- {
-			if vtmsg, ok := interface{}(m.RepeatedDuration[iNdEx]).(interface {
-				MarshalToSizedBufferVT([]byte) (int, error)
+	for i := len(that.RepeatedFloatWrapper); i < len(this.RepeatedFloatWrapper); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_float_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedFloatWrapper[i]})
+	}
+	for i := len(this.RepeatedFloatWrapper); i < len(that.RepeatedFloatWrapper); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_float_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedFloatWrapper[i]})
+	}
+	for i := 0; i < len(this.RepeatedDoubleWrapper) && i < len(that.RepeatedDoubleWrapper); i++ {
+		if p, q := this.RepeatedDoubleWrapper[i], that.RepeatedDoubleWrapper[i]; p != q {
+			if p == nil {
+				p = &wrapperspb.DoubleValue{}
+			}
+			if q == nil {
+				q = &wrapperspb.DoubleValue{}
+			}
+			if vtpb, ok := interface{}(p).(interface {
+				DiffVT(*wrapperspb.DoubleValue) []vtproto.FieldDiff
 			}); ok {
-				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarint(dAtA, i, uint64(size))
-			} else {
-				encoded, err := proto.Marshal(m.RepeatedDuration[iNdEx])
-				if err != nil {
-					return 0, err
+				if nested := vtpb.DiffVT(q); len(nested) > 0 {
+					diffs = protohelpers.AppendDiffs(diffs, "repeated_double_wrapper["+strconv.Itoa(i)+"]", nested)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			} else if !proto.Equal(p, q) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_double_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: p, New: q})
 			}
-			i--
-			dAtA[i] = 0x13
-			i--
-			dAtA[i] = 0xba
 		}
 	}
-	if m.OptionalNullValue != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.OptionalNullValue))
-		i--
-		dAtA[i] = 0x13
-		i--
-		dAtA[i] = 0x98
+	for i := len(that.RepeatedDoubleWrapper); i < len(this.RepeatedDoubleWrapper); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_double_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedDoubleWrapper[i]})
 	}
-	if m.OptionalValue != nil {
-		if vtmsg, ok := interface{}(m.OptionalValue).(interface {
-			MarshalToSizedBufferVT([]byte) (int, error)
-		}); ok {
-			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
+	for i := len(this.RepeatedDoubleWrapper); i < len(that.RepeatedDoubleWrapper); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_double_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedDoubleWrapper[i]})
+	}
+	for i := 0; i < len(this.RepeatedStringWrapper) && i < len(that.RepeatedStringWrapper); i++ {
+		if p, q := this.RepeatedStringWrapper[i], that.RepeatedStringWrapper[i]; p != q {
+			if p == nil {
+				p = &wrapperspb.StringValue{}
 			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-		} else {
-			encoded, err := proto.Marshal(m.OptionalValue)
-			if err != nil {
-				return 0, err
+			if q == nil {
+				q = &wrapperspb.StringValue{}
+			}
+			if vtpb, ok := interface{}(p).(interface {
+				DiffVT(*wrapperspb.StringValue) []vtproto.FieldDiff
+			}); ok {
+				if nested := vtpb.DiffVT(q); len(nested) > 0 {
+					diffs = protohelpers.AppendDiffs(diffs, "repeated_string_wrapper["+strconv.Itoa(i)+"]", nested)
+				}
+			} else if !proto.Equal(p, q) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_string_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: p, New: q})
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = encodeVarint(dAtA, i, uint64(len(encoded)))
 		}
-		i--
-		dAtA[i] = 0x13
-		i--
-		dAtA[i] = 0x92
 	}
-	if m.OptionalAny != nil {
-		if vtmsg, ok := interface{}(m.OptionalAny).(interface {
-			MarshalToSizedBufferVT([]byte) (int, error)
-		}); ok {
-			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
+	for i := len(that.RepeatedStringWrapper); i < len(this.RepeatedStringWrapper); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_string_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedStringWrapper[i]})
+	}
+	for i := len(this.RepeatedStringWrapper); i < len(that.RepeatedStringWrapper); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_string_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedStringWrapper[i]})
+	}
+	for i := 0; i < len(this.RepeatedBytesWrapper) && i < len(that.RepeatedBytesWrapper); i++ {
+		if p, q := this.RepeatedBytesWrapper[i], that.RepeatedBytesWrapper[i]; p != q {
+			if p == nil {
+				p = &wrapperspb.BytesValue{}
 			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-		} else {
-			encoded, err := proto.Marshal(m.OptionalAny)
-			if err != nil {
-				return 0, err
+			if q == nil {
+				q = &wrapperspb.BytesValue{}
+			}
+			if vtpb, ok := interface{}(p).(interface {
+				DiffVT(*wrapperspb.BytesValue) []vtproto.FieldDiff
+			}); ok {
+				if nested := vtpb.DiffVT(q); len(nested) > 0 {
+					diffs = protohelpers.AppendDiffs(diffs, "repeated_bytes_wrapper["+strconv.Itoa(i)+"]", nested)
+				}
+			} else if !proto.Equal(p, q) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_bytes_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: p, New: q})
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = encodeVarint(dAtA, i, uint64(len(encoded)))
 		}
-		i--
-		dAtA[i] = 0x13
-		i--
-		dAtA[i] = 0x8a
 	}
-	if m.OptionalStruct != nil {
-		if vtmsg, ok := interface{}(m.OptionalStruct).(interface {
-			MarshalToSizedBufferVT([]byte) (int, error)
+	for i := len(that.RepeatedBytesWrapper); i < len(this.RepeatedBytesWrapper); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_bytes_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedBytesWrapper[i]})
+	}
+	for i := len(this.RepeatedBytesWrapper); i < len(that.RepeatedBytesWrapper); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_bytes_wrapper[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedBytesWrapper[i]})
+	}
+	if p, q := this.OptionalDuration, that.OptionalDuration; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_duration", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_duration", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if vtpb, ok := interface{}(p).(interface {
+			DiffVT(*durationpb.Duration) []vtproto.FieldDiff
 		}); ok {
-			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-		} else {
-			encoded, err := proto.Marshal(m.OptionalStruct)
-			if err != nil {
-				return 0, err
+			if nested := vtpb.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "optional_duration", nested)
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+		} else if !proto.Equal(p, q) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "optional_duration", Kind: vtproto.FieldChanged, Old: p, New: q})
 		}
-		i--
-		dAtA[i] = 0x13
-		i--
-		dAtA[i] = 0x82
 	}
-	if m.OptionalFieldMask != nil {
-		if vtmsg, ok := interface{}(m.OptionalFieldMask).(interface {
-			MarshalToSizedBufferVT([]byte) (int, error)
+	if p, q := this.OptionalTimestamp, that.OptionalTimestamp; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_timestamp", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_timestamp", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if vtpb, ok := interface{}(p).(interface {
+			DiffVT(*timestamppb.Timestamp) []vtproto.FieldDiff
 		}); ok {
-			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-		} else {
-			encoded, err := proto.Marshal(m.OptionalFieldMask)
-			if err != nil {
-				return 0, err
+			if nested := vtpb.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "optional_timestamp", nested)
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+		} else if !proto.Equal(p, q) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "optional_timestamp", Kind: vtproto.FieldChanged, Old: p, New: q})
 		}
-		i--
-		dAtA[i] = 0x12
-		i--
-		dAtA[i] = 0xfa
 	}
-	if m.OptionalTimestamp != nil {
-		if vtmsg, ok := interface{}(m.OptionalTimestamp).(interface {
-			MarshalToSizedBufferVT([]byte) (int, error)
+	if p, q := this.OptionalFieldMask, that.OptionalFieldMask; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field_mask", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field_mask", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if vtpb, ok := interface{}(p).(interface {
+			DiffVT(*fieldmaskpb.FieldMask) []vtproto.FieldDiff
 		}); ok {
-			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-		} else {
-			encoded, err := proto.Marshal(m.OptionalTimestamp)
-			if err != nil {
-				return 0, err
+			if nested := vtpb.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "optional_field_mask", nested)
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+		} else if !proto.Equal(p, q) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field_mask", Kind: vtproto.FieldChanged, Old: p, New: q})
 		}
-		i--
-		dAtA[i] = 0x12
-		i--
-		dAtA[i] = 0xf2
 	}
-	if m.OptionalDuration != nil {
-		if vtmsg, ok := interface{}(m.OptionalDuration).(interface {
-			MarshalToSizedBufferVT([]byte) (int, error)
+	if p, q := this.OptionalStruct, that.OptionalStruct; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_struct", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_struct", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if vtpb, ok := interface{}(p).(interface {
+			DiffVT(*structpb.Struct) []vtproto.FieldDiff
 		}); ok {
-			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
+			if nested := vtpb.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "optional_struct", nested)
 			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-		} else {
-			encoded, err := proto.Marshal(m.OptionalDuration)
-			if err != nil {
-				return 0, err
+		} else if !proto.Equal(p, q) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "optional_struct", Kind: vtproto.FieldChanged, Old: p, New: q})
+		}
+	}
+	if p, q := this.OptionalAny, that.OptionalAny; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_any", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_any", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if vtpb, ok := interface{}(p).(interface {
+			DiffVT(*anypb.Any) []vtproto.FieldDiff
+		}); ok {
+			if nested := vtpb.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "optional_any", nested)
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+		} else if !proto.Equal(p, q) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "optional_any", Kind: vtproto.FieldChanged, Old: p, New: q})
 		}
-		i--
-		dAtA[i] = 0x12
-		i--
-		dAtA[i] = 0xea
 	}
-	if len(m.RepeatedBytesWrapper) > 0 {
-		for iNdEx := len(m.RepeatedBytesWrapper) - 1; iNdEx >= 0; iNdEx-- {
-			if vtmsg, ok := interface{}(m.RepeatedBytesWrapper[iNdEx]).(interface {
-				MarshalToSizedBufferVT([]byte) (int, error)
-			}); ok {
-				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarint(dAtA, i, uint64(size))
-			} else {
-				encoded, err := proto.Marshal(m.RepeatedBytesWrapper[iNdEx])
-				if err != nil {
-					return 0, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+	if p, q := this.OptionalValue, that.OptionalValue; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_value", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_value", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if vtpb, ok := interface{}(p).(interface {
+			DiffVT(*structpb.Value) []vtproto.FieldDiff
+		}); ok {
+			if nested := vtpb.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "optional_value", nested)
 			}
-			i--
-			dAtA[i] = 0xd
-			i--
-			dAtA[i] = 0xda
+		} else if !proto.Equal(p, q) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "optional_value", Kind: vtproto.FieldChanged, Old: p, New: q})
 		}
 	}
-	if len(m.RepeatedStringWrapper) > 0 {
-		for iNdEx := len(m.RepeatedStringWrapper) - 1; iNdEx >= 0; iNdEx-- {
-			if vtmsg, ok := interface{}(m.RepeatedStringWrapper[iNdEx]).(interface {
-				MarshalToSizedBufferVT([]byte) (int, error)
+	if this.OptionalNullValue != that.OptionalNullValue {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_null_value", Kind: vtproto.FieldChanged, Old: this.OptionalNullValue, New: that.OptionalNullValue})
+	}
+	for i := 0; i < len(this.RepeatedDuration) && i < len(that.RepeatedDuration); i++ {
+		if p, q := this.RepeatedDuration[i], that.RepeatedDuration[i]; p != q {
+			if p == nil {
+				p = &durationpb.Duration{}
+			}
+			if q == nil {
+				q = &durationpb.Duration{}
+			}
+			if vtpb, ok := interface{}(p).(interface {
+				DiffVT(*durationpb.Duration) []vtproto.FieldDiff
 			}); ok {
-				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarint(dAtA, i, uint64(size))
-			} else {
-				encoded, err := proto.Marshal(m.RepeatedStringWrapper[iNdEx])
-				if err != nil {
-					return 0, err
+				if nested := vtpb.DiffVT(q); len(nested) > 0 {
+					diffs = protohelpers.AppendDiffs(diffs, "repeated_duration["+strconv.Itoa(i)+"]", nested)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			} else if !proto.Equal(p, q) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_duration[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: p, New: q})
 			}
-			i--
-			dAtA[i] = 0xd
-			i--
-			dAtA[i] = 0xd2
 		}
 	}
-	if len(m.RepeatedDoubleWrapper) > 0 {
-		for iNdEx := len(m.RepeatedDoubleWrapper) - 1; iNdEx >= 0; iNdEx-- {
-			if vtmsg, ok := interface{}(m.RepeatedDoubleWrapper[iNdEx]).(interface {
-				MarshalToSizedBufferVT([]byte) (int, error)
+	for i := len(that.RepeatedDuration); i < len(this.RepeatedDuration); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_duration[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedDuration[i]})
+	}
+	for i := len(this.RepeatedDuration); i < len(that.RepeatedDuration); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_duration[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedDuration[i]})
+	}
+	for i := 0; i < len(this.RepeatedTimestamp) && i < len(that.RepeatedTimestamp); i++ {
+		if p, q := this.RepeatedTimestamp[i], that.RepeatedTimestamp[i]; p != q {
+			if p == nil {
+				p = &timestamppb.Timestamp{}
+			}
+			if q == nil {
+				q = &timestamppb.Timestamp{}
+			}
+			if vtpb, ok := interface{}(p).(interface {
+				DiffVT(*timestamppb.Timestamp) []vtproto.FieldDiff
 			}); ok {
-				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarint(dAtA, i, uint64(size))
-			} else {
-				encoded, err := proto.Marshal(m.RepeatedDoubleWrapper[iNdEx])
-				if err != nil {
-					return 0, err
+				if nested := vtpb.DiffVT(q); len(nested) > 0 {
+					diffs = protohelpers.AppendDiffs(diffs, "repeated_timestamp["+strconv.Itoa(i)+"]", nested)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			} else if !proto.Equal(p, q) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_timestamp[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: p, New: q})
 			}
-			i--
-			dAtA[i] = 0xd
-			i--
-			dAtA[i] = 0xca
 		}
 	}
-	if len(m.RepeatedFloatWrapper) > 0 {
-		for iNdEx := len(m.RepeatedFloatWrapper) - 1; iNdEx >= 0; iNdEx-- {
-			if vtmsg, ok := interface{}(m.RepeatedFloatWrapper[iNdEx]).(interface {
-				MarshalToSizedBufferVT([]byte) (int, error)
+	for i := len(that.RepeatedTimestamp); i < len(this.RepeatedTimestamp); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_timestamp[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedTimestamp[i]})
+	}
+	for i := len(this.RepeatedTimestamp); i < len(that.RepeatedTimestamp); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_timestamp[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedTimestamp[i]})
+	}
+	for i := 0; i < len(this.RepeatedFieldmask) && i < len(that.RepeatedFieldmask); i++ {
+		if p, q := this.RepeatedFieldmask[i], that.RepeatedFieldmask[i]; p != q {
+			if p == nil {
+				p = &fieldmaskpb.FieldMask{}
+			}
+			if q == nil {
+				q = &fieldmaskpb.FieldMask{}
+			}
+			if vtpb, ok := interface{}(p).(interface {
+				DiffVT(*fieldmaskpb.FieldMask) []vtproto.FieldDiff
 			}); ok {
-				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-				if err != nil {
-					return 0, err
+				if nested := vtpb.DiffVT(q); len(nested) > 0 {
+					diffs = protohelpers.AppendDiffs(diffs, "repeated_fieldmask["+strconv.Itoa(i)+"]", nested)
 				}
-				i -= size
-				i = encodeVarint(dAtA, i, uint64(size))
-			} else {
-				encoded, err := proto.Marshal(m.RepeatedFloatWrapper[iNdEx])
-				if err != nil {
-					return 0, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			} else if !proto.Equal(p, q) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_fieldmask[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: p, New: q})
 			}
-			i--
-			dAtA[i] = 0xd
-			i--
-			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.RepeatedUint64Wrapper) > 0 {
-		for iNdEx := len(m.RepeatedUint64Wrapper) - 1; iNdEx >= 0; iNdEx-- {
-			if vtmsg, ok := interface{}(m.RepeatedUint64Wrapper[iNdEx]).(interface {
-				MarshalToSizedBufferVT([]byte) (int, error)
-			}); ok {
-				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarint(dAtA, i, uint64(size))
-			} else {
-				encoded, err := proto.Marshal(m.RepeatedUint64Wrapper[iNdEx])
-				if err != nil {
-					return 0, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = encodeVarint(dAtA, i, uint64(len(encoded)))
-			}
-			i--
-			dAtA[i] = 0xd
-			i--
-			dAtA[i] = 0xba
-		}
+	for i := len(that.RepeatedFieldmask); i < len(this.RepeatedFieldmask); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_fieldmask[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedFieldmask[i]})
 	}
-	if len(m.RepeatedUint32Wrapper) > 0 {
-		for iNdEx := len(m.RepeatedUint32Wrapper) - 1; iNdEx >= 0; iNdEx-- {
-			if vtmsg, ok := interface{}(m.RepeatedUint32Wrapper[iNdEx]).(interface {
-				MarshalToSizedBufferVT([]byte) (int, error)
+	for i := len(this.RepeatedFieldmask); i < len(that.RepeatedFieldmask); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_fieldmask[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedFieldmask[i]})
+	}
+	for i := 0; i < len(this.RepeatedAny) && i < len(that.RepeatedAny); i++ {
+		if p, q := this.RepeatedAny[i], that.RepeatedAny[i]; p != q {
+			if p == nil {
+				p = &anypb.Any{}
+			}
+			if q == nil {
+				q = &anypb.Any{}
+			}
+			if vtpb, ok := interface{}(p).(interface {
+				DiffVT(*anypb.Any) []vtproto.FieldDiff
 			}); ok {
-				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarint(dAtA, i, uint64(size))
-			} else {
-				encoded, err := proto.Marshal(m.RepeatedUint32Wrapper[iNdEx])
-				if err != nil {
-					return 0, err
+				if nested := vtpb.DiffVT(q); len(nested) > 0 {
+					diffs = protohelpers.AppendDiffs(diffs, "repeated_any["+strconv.Itoa(i)+"]", nested)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			} else if !proto.Equal(p, q) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_any[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: p, New: q})
 			}
-			i--
-			dAtA[i] = 0xd
-			i--
-			dAtA[i] = 0xb2
 		}
 	}
-	if len(m.RepeatedInt64Wrapper) > 0 {
-		for iNdEx := len(m.RepeatedInt64Wrapper) - 1; iNdEx >= 0; iNdEx-- {
-			if vtmsg, ok := interface{}(m.RepeatedInt64Wrapper[iNdEx]).(interface {
-				MarshalToSizedBufferVT([]byte) (int, error)
+	for i := len(that.RepeatedAny); i < len(this.RepeatedAny); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_any[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedAny[i]})
+	}
+	for i := len(this.RepeatedAny); i < len(that.RepeatedAny); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_any[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedAny[i]})
+	}
+	for i := 0; i < len(this.RepeatedValue) && i < len(that.RepeatedValue); i++ {
+		if p, q := this.RepeatedValue[i], that.RepeatedValue[i]; p != q {
+			if p == nil {
+				p = &structpb.Value{}
+			}
+			if q == nil {
+				q = &structpb.Value{}
+			}
+			if vtpb, ok := interface{}(p).(interface {
+				DiffVT(*structpb.Value) []vtproto.FieldDiff
 			}); ok {
-				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarint(dAtA, i, uint64(size))
-			} else {
-				encoded, err := proto.Marshal(m.RepeatedInt64Wrapper[iNdEx])
-				if err != nil {
-					return 0, err
+				if nested := vtpb.DiffVT(q); len(nested) > 0 {
+					diffs = protohelpers.AppendDiffs(diffs, "repeated_value["+strconv.Itoa(i)+"]", nested)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			} else if !proto.Equal(p, q) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_value[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: p, New: q})
 			}
-			i--
-			dAtA[i] = 0xd
-			i--
-			dAtA[i] = 0xaa
 		}
 	}
-	if len(m.RepeatedInt32Wrapper) > 0 {
-		for iNdEx := len(m.RepeatedInt32Wrapper) - 1; iNdEx >= 0; iNdEx-- {
-			if vtmsg, ok := interface{}(m.RepeatedInt32Wrapper[iNdEx]).(interface {
-				MarshalToSizedBufferVT([]byte) (int, error)
+	for i := len(that.RepeatedValue); i < len(this.RepeatedValue); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_value[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedValue[i]})
+	}
+	for i := len(this.RepeatedValue); i < len(that.RepeatedValue); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_value[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedValue[i]})
+	}
+	for i := 0; i < len(this.RepeatedListValue) && i < len(that.RepeatedListValue); i++ {
+		if p, q := this.RepeatedListValue[i], that.RepeatedListValue[i]; p != q {
+			if p == nil {
+				p = &structpb.ListValue{}
+			}
+			if q == nil {
+				q = &structpb.ListValue{}
+			}
+			if vtpb, ok := interface{}(p).(interface {
+				DiffVT(*structpb.ListValue) []vtproto.FieldDiff
 			}); ok {
-				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarint(dAtA, i, uint64(size))
-			} else {
-				encoded, err := proto.Marshal(m.RepeatedInt32Wrapper[iNdEx])
-				if err != nil {
-					return 0, err
+				if nested := vtpb.DiffVT(q); len(nested) > 0 {
+					diffs = protohelpers.AppendDiffs(diffs, "repeated_list_value["+strconv.Itoa(i)+"]", nested)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			} else if !proto.Equal(p, q) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_list_value[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: p, New: q})
 			}
-			i--
-			dAtA[i] = 0xd
-			i--
-			dAtA[i] = 0xa2
 		}
 	}
-	if len(m.RepeatedBoolWrapper) > 0 {
-		for iNdEx := len(m.RepeatedBoolWrapper) - 1; iNdEx >= 0; iNdEx-- {
-			if vtmsg, ok := interface{}(m.RepeatedBoolWrapper[iNdEx]).(interface {
-				MarshalToSizedBufferVT([]byte) (int, error)
+	for i := len(that.RepeatedListValue); i < len(this.RepeatedListValue); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_list_value[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedListValue[i]})
+	}
+	for i := len(this.RepeatedListValue); i < len(that.RepeatedListValue); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_list_value[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedListValue[i]})
+	}
+	for i := 0; i < len(this.RepeatedStruct) && i < len(that.RepeatedStruct); i++ {
+		if p, q := this.RepeatedStruct[i], that.RepeatedStruct[i]; p != q {
+			if p == nil {
+				p = &structpb.Struct{}
+			}
+			if q == nil {
+				q = &structpb.Struct{}
+			}
+			if vtpb, ok := interface{}(p).(interface {
+				DiffVT(*structpb.Struct) []vtproto.FieldDiff
 			}); ok {
-				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-				if err != nil {
-					return 0, err
+				if nested := vtpb.DiffVT(q); len(nested) > 0 {
+					diffs = protohelpers.AppendDiffs(diffs, "repeated_struct["+strconv.Itoa(i)+"]", nested)
 				}
-				i -= size
-				i = encodeVarint(dAtA, i, uint64(size))
-			} else {
-				encoded, err := proto.Marshal(m.RepeatedBoolWrapper[iNdEx])
-				if err != nil {
-					return 0, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			} else if !proto.Equal(p, q) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_struct[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: p, New: q})
 			}
-			i--
-			dAtA[i] = 0xd
-			i--
-			dAtA[i] = 0x9a
 		}
 	}
-	if m.OptionalBytesWrapper != nil {
-		if vtmsg, ok := interface{}(m.OptionalBytesWrapper).(interface {
-			MarshalToSizedBufferVT([]byte) (int, error)
-		}); ok {
-			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-		} else {
-			encoded, err := proto.Marshal(m.OptionalBytesWrapper)
-			if err != nil {
-				return 0, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = encodeVarint(dAtA, i, uint64(len(encoded)))
-		}
-		i--
-		dAtA[i] = 0xd
-		i--
-		dAtA[i] = 0x8a
+	for i := len(that.RepeatedStruct); i < len(this.RepeatedStruct); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_struct[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedStruct[i]})
 	}
-	if m.OptionalStringWrapper != nil {
-		if vtmsg, ok := interface{}(m.OptionalStringWrapper).(interface {
-			MarshalToSizedBufferVT([]byte) (int, error)
-		}); ok {
-			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-		} else {
-			encoded, err := proto.Marshal(m.OptionalStringWrapper)
-			if err != nil {
-				return 0, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = encodeVarint(dAtA, i, uint64(len(encoded)))
-		}
-		i--
-		dAtA[i] = 0xd
-		i--
-		dAtA[i] = 0x82
+	for i := len(this.RepeatedStruct); i < len(that.RepeatedStruct); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_struct[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedStruct[i]})
 	}
-	if m.OptionalDoubleWrapper != nil {
-		if vtmsg, ok := interface{}(m.OptionalDoubleWrapper).(interface {
-			MarshalToSizedBufferVT([]byte) (int, error)
-		}); ok {
-			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-		} else {
-			encoded, err := proto.Marshal(m.OptionalDoubleWrapper)
-			if err != nil {
-				return 0, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = encodeVarint(dAtA, i, uint64(len(encoded)))
-		}
-		i--
-		dAtA[i] = 0xc
-		i--
-		dAtA[i] = 0xfa
+	if this.Fieldname1 != that.Fieldname1 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "fieldname1", Kind: vtproto.FieldChanged, Old: this.Fieldname1, New: that.Fieldname1})
 	}
-	if m.OptionalFloatWrapper != nil {
-		if vtmsg, ok := interface{}(m.OptionalFloatWrapper).(interface {
-			MarshalToSizedBufferVT([]byte) (int, error)
-		}); ok {
-			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-		} else {
-			encoded, err := proto.Marshal(m.OptionalFloatWrapper)
-			if err != nil {
-				return 0, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = encodeVarint(dAtA, i, uint64(len(encoded)))
-		}
-		i--
-		dAtA[i] = 0xc
-		i--
-		dAtA[i] = 0xf2
+	if this.FieldName2 != that.FieldName2 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field_name2", Kind: vtproto.FieldChanged, Old: this.FieldName2, New: that.FieldName2})
 	}
-	if m.OptionalUint64Wrapper != nil {
-		if vtmsg, ok := interface{}(m.OptionalUint64Wrapper).(interface {
-			MarshalToSizedBufferVT([]byte) (int, error)
-		}); ok {
-			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-		} else {
-			encoded, err := proto.Marshal(m.OptionalUint64Wrapper)
-			if err != nil {
-				return 0, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = encodeVarint(dAtA, i, uint64(len(encoded)))
-		}
-		i--
-		dAtA[i] = 0xc
-		i--
-		dAtA[i] = 0xea
+	if this.XFieldName3 != that.XFieldName3 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "_field_name3", Kind: vtproto.FieldChanged, Old: this.XFieldName3, New: that.XFieldName3})
 	}
-	if m.OptionalUint32Wrapper != nil {
-		if vtmsg, ok := interface{}(m.OptionalUint32Wrapper).(interface {
-			MarshalToSizedBufferVT([]byte) (int, error)
-		}); ok {
-			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-		} else {
-			encoded, err := proto.Marshal(m.OptionalUint32Wrapper)
-			if err != nil {
-				return 0, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = encodeVarint(dAtA, i, uint64(len(encoded)))
-		}
-		i--
-		dAtA[i] = 0xc
-		i--
-		dAtA[i] = 0xe2
-	}
-	if m.OptionalInt64Wrapper != nil {
-		if vtmsg, ok := interface{}(m.OptionalInt64Wrapper).(interface {
-			MarshalToSizedBufferVT([]byte) (int, error)
-		}); ok {
-			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-		} else {
-			encoded, err := proto.Marshal(m.OptionalInt64Wrapper)
-			if err != nil {
-				return 0, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = encodeVarint(dAtA, i, uint64(len(encoded)))
-		}
-		i--
-		dAtA[i] = 0xc
-		i--
-		dAtA[i] = 0xda
+	if this.Field_Name4_ != that.Field_Name4_ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field__name4_", Kind: vtproto.FieldChanged, Old: this.Field_Name4_, New: that.Field_Name4_})
 	}
-	if m.OptionalInt32Wrapper != nil {
-		if vtmsg, ok := interface{}(m.OptionalInt32Wrapper).(interface {
-			MarshalToSizedBufferVT([]byte) (int, error)
-		}); ok {
-			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-		} else {
-			encoded, err := proto.Marshal(m.OptionalInt32Wrapper)
-			if err != nil {
-				return 0, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = encodeVarint(dAtA, i, uint64(len(encoded)))
-		}
-		i--
-		dAtA[i] = 0xc
-		i--
-		dAtA[i] = 0xd2
+	if this.Field0Name5 != that.Field0Name5 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field0name5", Kind: vtproto.FieldChanged, Old: this.Field0Name5, New: that.Field0Name5})
 	}
-	if m.OptionalBoolWrapper != nil {
-		if vtmsg, ok := interface{}(m.OptionalBoolWrapper).(interface {
-			MarshalToSizedBufferVT([]byte) (int, error)
-		}); ok {
-			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-		} else {
-			encoded, err := proto.Marshal(m.OptionalBoolWrapper)
-			if err != nil {
-				return 0, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = encodeVarint(dAtA, i, uint64(len(encoded)))
-		}
-		i--
-		dAtA[i] = 0xc
-		i--
-		dAtA[i] = 0xca
+	if this.Field_0Name6 != that.Field_0Name6 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field_0_name6", Kind: vtproto.FieldChanged, Old: this.Field_0Name6, New: that.Field_0Name6})
 	}
-	if len(m.UnpackedNestedEnum) > 0 {
-		for iNdEx := len(m.UnpackedNestedEnum) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.UnpackedNestedEnum[iNdEx]))
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xb0
-		}
+	if this.FieldName7 != that.FieldName7 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "fieldName7", Kind: vtproto.FieldChanged, Old: this.FieldName7, New: that.FieldName7})
 	}
-	if len(m.UnpackedBool) > 0 {
-		for iNdEx := len(m.UnpackedBool) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.UnpackedBool[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xa8
-		}
+	if this.FieldName8 != that.FieldName8 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "FieldName8", Kind: vtproto.FieldChanged, Old: this.FieldName8, New: that.FieldName8})
 	}
-	if len(m.UnpackedDouble) > 0 {
-		for iNdEx := len(m.UnpackedDouble) - 1; iNdEx >= 0; iNdEx-- {
-			f1 := math.Float64bits(float64(m.UnpackedDouble[iNdEx]))
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f1))
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0xa1
-		}
+	if this.Field_Name9 != that.Field_Name9 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field_Name9", Kind: vtproto.FieldChanged, Old: this.Field_Name9, New: that.Field_Name9})
 	}
-	if len(m.UnpackedFloat) > 0 {
-		for iNdEx := len(m.UnpackedFloat) - 1; iNdEx >= 0; iNdEx-- {
-			f2 := math.Float32bits(float32(m.UnpackedFloat[iNdEx]))
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f2))
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0x9d
-		}
+	if this.Field_Name10 != that.Field_Name10 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "Field_Name10", Kind: vtproto.FieldChanged, Old: this.Field_Name10, New: that.Field_Name10})
 	}
-	if len(m.UnpackedSfixed64) > 0 {
-		for iNdEx := len(m.UnpackedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.UnpackedSfixed64[iNdEx]))
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0x91
-		}
+	if this.FIELD_NAME11 != that.FIELD_NAME11 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "FIELD_NAME11", Kind: vtproto.FieldChanged, Old: this.FIELD_NAME11, New: that.FIELD_NAME11})
 	}
-	if len(m.UnpackedSfixed32) > 0 {
-		for iNdEx := len(m.UnpackedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.UnpackedSfixed32[iNdEx]))
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0x8d
-		}
+	if this.FIELDName12 != that.FIELDName12 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "FIELD_name12", Kind: vtproto.FieldChanged, Old: this.FIELDName12, New: that.FIELDName12})
 	}
-	if len(m.UnpackedFixed64) > 0 {
-		for iNdEx := len(m.UnpackedFixed64) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.UnpackedFixed64[iNdEx]))
-			i--
-			dAtA[i] = 0x6
-			i--
-			dAtA[i] = 0x81
-		}
+	if this.XFieldName13 != that.XFieldName13 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "__field_name13", Kind: vtproto.FieldChanged, Old: this.XFieldName13, New: that.XFieldName13})
 	}
-	if len(m.UnpackedFixed32) > 0 {
-		for iNdEx := len(m.UnpackedFixed32) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.UnpackedFixed32[iNdEx]))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xfd
-		}
+	if this.X_FieldName14 != that.X_FieldName14 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "__Field_name14", Kind: vtproto.FieldChanged, Old: this.X_FieldName14, New: that.X_FieldName14})
 	}
-	if len(m.UnpackedSint64) > 0 {
-		for iNdEx := len(m.UnpackedSint64) - 1; iNdEx >= 0; iNdEx-- {
-			x3 := (uint64(m.UnpackedSint64[iNdEx]) << 1) ^ uint64((m.UnpackedSint64[iNdEx] >> 63))
-			i = encodeVarint(dAtA, i, uint64(x3))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xf0
-		}
+	if this.Field_Name15 != that.Field_Name15 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field__name15", Kind: vtproto.FieldChanged, Old: this.Field_Name15, New: that.Field_Name15})
 	}
-	if len(m.UnpackedSint32) > 0 {
-		for iNdEx := len(m.UnpackedSint32) - 1; iNdEx >= 0; iNdEx-- {
-			x4 := (uint32(m.UnpackedSint32[iNdEx]) << 1) ^ uint32((m.UnpackedSint32[iNdEx] >> 31))
-			i = encodeVarint(dAtA, i, uint64(x4))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xe8
-		}
+	if this.Field__Name16 != that.Field__Name16 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field__Name16", Kind: vtproto.FieldChanged, Old: this.Field__Name16, New: that.Field__Name16})
 	}
-	if len(m.UnpackedUint64) > 0 {
-		for iNdEx := len(m.UnpackedUint64) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.UnpackedUint64[iNdEx]))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xe0
-		}
+	if this.FieldName17__ != that.FieldName17__ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "field_name17__", Kind: vtproto.FieldChanged, Old: this.FieldName17__, New: that.FieldName17__})
 	}
-	if len(m.UnpackedUint32) > 0 {
-		for iNdEx := len(m.UnpackedUint32) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.UnpackedUint32[iNdEx]))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xd8
-		}
+	if this.FieldName18__ != that.FieldName18__ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "Field_name18__", Kind: vtproto.FieldChanged, Old: this.FieldName18__, New: that.FieldName18__})
 	}
-	if len(m.UnpackedInt64) > 0 {
-		for iNdEx := len(m.UnpackedInt64) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.UnpackedInt64[iNdEx]))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xd0
-		}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
 	}
-	if len(m.UnpackedInt32) > 0 {
-		for iNdEx := len(m.UnpackedInt32) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.UnpackedInt32[iNdEx]))
-			i--
-			dAtA[i] = 0x5
-			i--
-			dAtA[i] = 0xc8
-		}
+	return diffs
+}
+
+func (this *ForeignMessage) DiffVT(that *ForeignMessage) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
 	}
-	if len(m.PackedNestedEnum) > 0 {
-		var pksize6 int
-		for _, num := range m.PackedNestedEnum {
-			pksize6 += sov(uint64(num))
-		}
-		i -= pksize6
-		j5 := i
-		for _, num1 := range m.PackedNestedEnum {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA[j5] = uint8(num)
-			j5++
-		}
-		i = encodeVarint(dAtA, i, uint64(pksize6))
-		i--
-		dAtA[i] = 0x5
-		i--
-		dAtA[i] = 0xc2
+	var diffs []vtproto.FieldDiff
+	if this.C != that.C {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "c", Kind: vtproto.FieldChanged, Old: this.C, New: that.C})
 	}
-	if len(m.PackedBool) > 0 {
-		for iNdEx := len(m.PackedBool) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.PackedBool[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-		}
-		i = encodeVarint(dAtA, i, uint64(len(m.PackedBool)))
-		i--
-		dAtA[i] = 0x5
-		i--
-		dAtA[i] = 0xba
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
 	}
-	if len(m.PackedDouble) > 0 {
-		for iNdEx := len(m.PackedDouble) - 1; iNdEx >= 0; iNdEx-- {
-			f7 := math.Float64bits(float64(m.PackedDouble[iNdEx]))
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f7))
-		}
-		i = encodeVarint(dAtA, i, uint64(len(m.PackedDouble)*8))
-		i--
-		dAtA[i] = 0x5
-		i--
-		dAtA[i] = 0xb2
+	return diffs
+}
+
+func (this *NullHypothesisProto3) DiffVT(that *NullHypothesisProto3) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
 	}
-	if len(m.PackedFloat) > 0 {
-		for iNdEx := len(m.PackedFloat) - 1; iNdEx >= 0; iNdEx-- {
-			f8 := math.Float32bits(float32(m.PackedFloat[iNdEx]))
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f8))
-		}
-		i = encodeVarint(dAtA, i, uint64(len(m.PackedFloat)*4))
-		i--
-		dAtA[i] = 0x5
-		i--
-		dAtA[i] = 0xaa
+	var diffs []vtproto.FieldDiff
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
 	}
-	if len(m.PackedSfixed64) > 0 {
-		for iNdEx := len(m.PackedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.PackedSfixed64[iNdEx]))
-		}
-		i = encodeVarint(dAtA, i, uint64(len(m.PackedSfixed64)*8))
-		i--
-		dAtA[i] = 0x5
-		i--
-		dAtA[i] = 0xa2
+	return diffs
+}
+
+func (this *EnumOnlyProto3) DiffVT(that *EnumOnlyProto3) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
 	}
-	if len(m.PackedSfixed32) > 0 {
-		for iNdEx := len(m.PackedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.PackedSfixed32[iNdEx]))
+	var diffs []vtproto.FieldDiff
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *TestAllTypesProto3_NestedMessage) EqualVT(that *TestAllTypesProto3_NestedMessage) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *TestAllTypesProto3_NestedMessage) EqualVTWithOptions(that *TestAllTypesProto3_NestedMessage, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	if this.A != that.A {
+		return false
+	}
+	if !this.Corecursive.EqualVTWithOptions(that.Corecursive, opts) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *TestAllTypesProto3_NestedMessage) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*TestAllTypesProto3_NestedMessage)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *TestAllTypesProto3) EqualVT(that *TestAllTypesProto3) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *TestAllTypesProto3) EqualVTWithOptions(that *TestAllTypesProto3, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	if this.OneofField == nil && that.OneofField != nil {
+		return false
+	} else if this.OneofField != nil {
+		if that.OneofField == nil {
+			return false
+		}
+		if !this.OneofField.(interface {
+			EqualVTWithOptions(isTestAllTypesProto3_OneofField, vtproto.EqualOptions) bool
+		}).EqualVTWithOptions(that.OneofField, opts) {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(len(m.PackedSfixed32)*4))
-		i--
-		dAtA[i] = 0x5
-		i--
-		dAtA[i] = 0x9a
 	}
-	if len(m.PackedFixed64) > 0 {
-		for iNdEx := len(m.PackedFixed64) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.PackedFixed64[iNdEx]))
+	if this.OptionalInt32 != that.OptionalInt32 {
+		return false
+	}
+	if this.OptionalInt64 != that.OptionalInt64 {
+		return false
+	}
+	if this.OptionalUint32 != that.OptionalUint32 {
+		return false
+	}
+	if this.OptionalUint64 != that.OptionalUint64 {
+		return false
+	}
+	if this.OptionalSint32 != that.OptionalSint32 {
+		return false
+	}
+	if this.OptionalSint64 != that.OptionalSint64 {
+		return false
+	}
+	if this.OptionalFixed32 != that.OptionalFixed32 {
+		return false
+	}
+	if this.OptionalFixed64 != that.OptionalFixed64 {
+		return false
+	}
+	if this.OptionalSfixed32 != that.OptionalSfixed32 {
+		return false
+	}
+	if this.OptionalSfixed64 != that.OptionalSfixed64 {
+		return false
+	}
+	if !opts.EqualFloat32(this.OptionalFloat, that.OptionalFloat) {
+		return false
+	}
+	if !opts.EqualFloat64(this.OptionalDouble, that.OptionalDouble) {
+		return false
+	}
+	if this.OptionalBool != that.OptionalBool {
+		return false
+	}
+	if this.OptionalString != that.OptionalString {
+		return false
+	}
+	if string(this.OptionalBytes) != string(that.OptionalBytes) {
+		return false
+	}
+	if !this.OptionalNestedMessage.EqualVTWithOptions(that.OptionalNestedMessage, opts) {
+		return false
+	}
+	if !this.OptionalForeignMessage.EqualVTWithOptions(that.OptionalForeignMessage, opts) {
+		return false
+	}
+	if this.OptionalNestedEnum != that.OptionalNestedEnum {
+		return false
+	}
+	if this.OptionalForeignEnum != that.OptionalForeignEnum {
+		return false
+	}
+	if this.OptionalAliasedEnum != that.OptionalAliasedEnum {
+		return false
+	}
+	if this.OptionalStringPiece != that.OptionalStringPiece {
+		return false
+	}
+	if this.OptionalCord != that.OptionalCord {
+		return false
+	}
+	if !this.RecursiveMessage.EqualVTWithOptions(that.RecursiveMessage, opts) {
+		return false
+	}
+	if len(this.RepeatedInt32) != len(that.RepeatedInt32) {
+		return false
+	}
+	for i, vx := range this.RepeatedInt32 {
+		vy := that.RepeatedInt32[i]
+		if vx != vy {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(len(m.PackedFixed64)*8))
-		i--
-		dAtA[i] = 0x5
-		i--
-		dAtA[i] = 0x92
 	}
-	if len(m.PackedFixed32) > 0 {
-		for iNdEx := len(m.PackedFixed32) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.PackedFixed32[iNdEx]))
+	if len(this.RepeatedInt64) != len(that.RepeatedInt64) {
+		return false
+	}
+	for i, vx := range this.RepeatedInt64 {
+		vy := that.RepeatedInt64[i]
+		if vx != vy {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(len(m.PackedFixed32)*4))
-		i--
-		dAtA[i] = 0x5
-		i--
-		dAtA[i] = 0x8a
 	}
-	if len(m.PackedSint64) > 0 {
-		var pksize10 int
-		for _, num := range m.PackedSint64 {
-			pksize10 += soz(uint64(num))
+	if len(this.RepeatedUint32) != len(that.RepeatedUint32) {
+		return false
+	}
+	for i, vx := range this.RepeatedUint32 {
+		vy := that.RepeatedUint32[i]
+		if vx != vy {
+			return false
 		}
-		i -= pksize10
-		j9 := i
-		for _, num := range m.PackedSint64 {
-			x11 := (uint64(num) << 1) ^ uint64((num >> 63))
-			for x11 >= 1<<7 {
-				dAtA[j9] = uint8(uint64(x11)&0x7f | 0x80)
-				j9++
-				x11 >>= 7
-			}
-			dAtA[j9] = uint8(x11)
-			j9++
+	}
+	if len(this.RepeatedUint64) != len(that.RepeatedUint64) {
+		return false
+	}
+	for i, vx := range this.RepeatedUint64 {
+		vy := that.RepeatedUint64[i]
+		if vx != vy {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize10))
-		i--
-		dAtA[i] = 0x5
-		i--
-		dAtA[i] = 0x82
 	}
-	if len(m.PackedSint32) > 0 {
-		var pksize13 int
-		for _, num := range m.PackedSint32 {
-			pksize13 += soz(uint64(num))
+	if len(this.RepeatedSint32) != len(that.RepeatedSint32) {
+		return false
+	}
+	for i, vx := range this.RepeatedSint32 {
+		vy := that.RepeatedSint32[i]
+		if vx != vy {
+			return false
 		}
-		i -= pksize13
-		j12 := i
-		for _, num := range m.PackedSint32 {
-			x14 := (uint32(num) << 1) ^ uint32((num >> 31))
-			for x14 >= 1<<7 {
-				dAtA[j12] = uint8(uint64(x14)&0x7f | 0x80)
-				j12++
-				x14 >>= 7
-			}
-			dAtA[j12] = uint8(x14)
-			j12++
+	}
+	if len(this.RepeatedSint64) != len(that.RepeatedSint64) {
+		return false
+	}
+	for i, vx := range this.RepeatedSint64 {
+		vy := that.RepeatedSint64[i]
+		if vx != vy {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize13))
-		i--
-		dAtA[i] = 0x4
-		i--
-		dAtA[i] = 0xfa
 	}
-	if len(m.PackedUint64) > 0 {
-		var pksize16 int
-		for _, num := range m.PackedUint64 {
-			pksize16 += sov(uint64(num))
+	if len(this.RepeatedFixed32) != len(that.RepeatedFixed32) {
+		return false
+	}
+	for i, vx := range this.RepeatedFixed32 {
+		vy := that.RepeatedFixed32[i]
+		if vx != vy {
+			return false
 		}
-		i -= pksize16
-		j15 := i
-		for _, num := range m.PackedUint64 {
-			for num >= 1<<7 {
-				dAtA[j15] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j15++
-			}
-			dAtA[j15] = uint8(num)
-			j15++
-		}
-		i = encodeVarint(dAtA, i, uint64(pksize16))
-		i--
-		dAtA[i] = 0x4
-		i--
-		dAtA[i] = 0xf2
 	}
-	if len(m.PackedUint32) > 0 {
-		var pksize18 int
-		for _, num := range m.PackedUint32 {
-			pksize18 += sov(uint64(num))
+	if len(this.RepeatedFixed64) != len(that.RepeatedFixed64) {
+		return false
+	}
+	for i, vx := range this.RepeatedFixed64 {
+		vy := that.RepeatedFixed64[i]
+		if vx != vy {
+			return false
 		}
-		i -= pksize18
-		j17 := i
-		for _, num := range m.PackedUint32 {
-			for num >= 1<<7 {
-				dAtA[j17] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j17++
-			}
-			dAtA[j17] = uint8(num)
-			j17++
+	}
+	if len(this.RepeatedSfixed32) != len(that.RepeatedSfixed32) {
+		return false
+	}
+	for i, vx := range this.RepeatedSfixed32 {
+		vy := that.RepeatedSfixed32[i]
+		if vx != vy {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize18))
-		i--
-		dAtA[i] = 0x4
-		i--
-		dAtA[i] = 0xea
 	}
-	if len(m.PackedInt64) > 0 {
-		var pksize20 int
-		for _, num := range m.PackedInt64 {
-			pksize20 += sov(uint64(num))
+	if len(this.RepeatedSfixed64) != len(that.RepeatedSfixed64) {
+		return false
+	}
+	for i, vx := range this.RepeatedSfixed64 {
+		vy := that.RepeatedSfixed64[i]
+		if vx != vy {
+			return false
 		}
-		i -= pksize20
-		j19 := i
-		for _, num1 := range m.PackedInt64 {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA[j19] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j19++
-			}
-			dAtA[j19] = uint8(num)
-			j19++
+	}
+	if len(this.RepeatedFloat) != len(that.RepeatedFloat) {
+		return false
+	}
+	for i, vx := range this.RepeatedFloat {
+		vy := that.RepeatedFloat[i]
+		if !opts.EqualFloat32(vx, vy) {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize20))
-		i--
-		dAtA[i] = 0x4
-		i--
-		dAtA[i] = 0xe2
 	}
-	if len(m.PackedInt32) > 0 {
-		var pksize22 int
-		for _, num := range m.PackedInt32 {
-			pksize22 += sov(uint64(num))
+	if len(this.RepeatedDouble) != len(that.RepeatedDouble) {
+		return false
+	}
+	for i, vx := range this.RepeatedDouble {
+		vy := that.RepeatedDouble[i]
+		if !opts.EqualFloat64(vx, vy) {
+			return false
 		}
-		i -= pksize22
-		j21 := i
-		for _, num1 := range m.PackedInt32 {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA[j21] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j21++
-			}
-			dAtA[j21] = uint8(num)
-			j21++
+	}
+	if len(this.RepeatedBool) != len(that.RepeatedBool) {
+		return false
+	}
+	for i, vx := range this.RepeatedBool {
+		vy := that.RepeatedBool[i]
+		if vx != vy {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize22))
-		i--
-		dAtA[i] = 0x4
-		i--
-		dAtA[i] = 0xda
 	}
-	if len(m.MapStringForeignEnum) > 0 {
-		for k := range m.MapStringForeignEnum {
-			v := m.MapStringForeignEnum[k]
-			baseI := i
-			i = encodeVarint(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarint(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xd2
+	if len(this.RepeatedString) != len(that.RepeatedString) {
+		return false
+	}
+	for i, vx := range this.RepeatedString {
+		vy := that.RepeatedString[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapStringNestedEnum) > 0 {
-		for k := range m.MapStringNestedEnum {
-			v := m.MapStringNestedEnum[k]
-			baseI := i
-			i = encodeVarint(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarint(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xca
+	if len(this.RepeatedBytes) != len(that.RepeatedBytes) {
+		return false
+	}
+	for i, vx := range this.RepeatedBytes {
+		vy := that.RepeatedBytes[i]
+		if string(vx) != string(vy) {
+			return false
 		}
 	}
-	if len(m.MapStringForeignMessage) > 0 {
-		for k := range m.MapStringForeignMessage {
-			v := m.MapStringForeignMessage[k]
-			baseI := i
-			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(this.RepeatedNestedMessage) != len(that.RepeatedNestedMessage) {
+		return false
+	}
+	for i, vx := range this.RepeatedNestedMessage {
+		vy := that.RepeatedNestedMessage[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &TestAllTypesProto3_NestedMessage{}
+			}
+			if q == nil {
+				q = &TestAllTypesProto3_NestedMessage{}
+			}
+			if !p.EqualVTWithOptions(q, opts) {
+				return false
 			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarint(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xc2
 		}
 	}
-	if len(m.MapStringNestedMessage) > 0 {
-		for k := range m.MapStringNestedMessage {
-			v := m.MapStringNestedMessage[k]
-			baseI := i
-			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(this.RepeatedForeignMessage) != len(that.RepeatedForeignMessage) {
+		return false
+	}
+	for i, vx := range this.RepeatedForeignMessage {
+		vy := that.RepeatedForeignMessage[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &ForeignMessage{}
+			}
+			if q == nil {
+				q = &ForeignMessage{}
+			}
+			if !p.EqualVTWithOptions(q, opts) {
+				return false
 			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarint(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xba
 		}
 	}
-	if len(m.MapStringBytes) > 0 {
-		for k := range m.MapStringBytes {
-			v := m.MapStringBytes[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarint(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarint(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xb2
+	if len(this.RepeatedNestedEnum) != len(that.RepeatedNestedEnum) {
+		return false
+	}
+	for i, vx := range this.RepeatedNestedEnum {
+		vy := that.RepeatedNestedEnum[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapStringString) > 0 {
-		for k := range m.MapStringString {
-			v := m.MapStringString[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarint(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarint(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xaa
-		}
+	if len(this.RepeatedForeignEnum) != len(that.RepeatedForeignEnum) {
+		return false
 	}
-	if len(m.MapBoolBool) > 0 {
-		for k := range m.MapBoolBool {
-			v := m.MapBoolBool[k]
-			baseI := i
-			i--
-			if v {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x10
-			i--
-			if k {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0xa2
+	for i, vx := range this.RepeatedForeignEnum {
+		vy := that.RepeatedForeignEnum[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapInt32Double) > 0 {
-		for k := range m.MapInt32Double {
-			v := m.MapInt32Double[k]
-			baseI := i
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
-			i--
-			dAtA[i] = 0x11
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0x9a
-		}
+	if len(this.RepeatedStringPiece) != len(that.RepeatedStringPiece) {
+		return false
 	}
-	if len(m.MapInt32Float) > 0 {
-		for k := range m.MapInt32Float {
-			v := m.MapInt32Float[k]
-			baseI := i
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
-			i--
-			dAtA[i] = 0x15
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0x92
+	for i, vx := range this.RepeatedStringPiece {
+		vy := that.RepeatedStringPiece[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapSfixed64Sfixed64) > 0 {
-		for k := range m.MapSfixed64Sfixed64 {
-			v := m.MapSfixed64Sfixed64[k]
-			baseI := i
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
-			i--
-			dAtA[i] = 0x11
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
-			i--
-			dAtA[i] = 0x9
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0x8a
-		}
+	if len(this.RepeatedCord) != len(that.RepeatedCord) {
+		return false
 	}
-	if len(m.MapSfixed32Sfixed32) > 0 {
-		for k := range m.MapSfixed32Sfixed32 {
-			v := m.MapSfixed32Sfixed32[k]
-			baseI := i
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
-			i--
-			dAtA[i] = 0x15
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
-			i--
-			dAtA[i] = 0xd
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4
-			i--
-			dAtA[i] = 0x82
+	for i, vx := range this.RepeatedCord {
+		vy := that.RepeatedCord[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapFixed64Fixed64) > 0 {
-		for k := range m.MapFixed64Fixed64 {
-			v := m.MapFixed64Fixed64[k]
-			baseI := i
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
-			i--
-			dAtA[i] = 0x11
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
-			i--
-			dAtA[i] = 0x9
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xfa
-		}
+	if len(this.MapInt32Int32) != len(that.MapInt32Int32) {
+		return false
 	}
-	if len(m.MapFixed32Fixed32) > 0 {
-		for k := range m.MapFixed32Fixed32 {
-			v := m.MapFixed32Fixed32[k]
-			baseI := i
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
-			i--
-			dAtA[i] = 0x15
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
-			i--
-			dAtA[i] = 0xd
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xf2
+	for i, vx := range this.MapInt32Int32 {
+		vy, ok := that.MapInt32Int32[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapSint64Sint64) > 0 {
-		for k := range m.MapSint64Sint64 {
-			v := m.MapSint64Sint64[k]
-			baseI := i
-			i = encodeVarint(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarint(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xea
+	if len(this.MapInt64Int64) != len(that.MapInt64Int64) {
+		return false
+	}
+	for i, vx := range this.MapInt64Int64 {
+		vy, ok := that.MapInt64Int64[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapSint32Sint32) > 0 {
-		for k := range m.MapSint32Sint32 {
-			v := m.MapSint32Sint32[k]
-			baseI := i
-			i = encodeVarint(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarint(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xe2
+	if len(this.MapUint32Uint32) != len(that.MapUint32Uint32) {
+		return false
+	}
+	for i, vx := range this.MapUint32Uint32 {
+		vy, ok := that.MapUint32Uint32[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapUint64Uint64) > 0 {
-		for k := range m.MapUint64Uint64 {
-			v := m.MapUint64Uint64[k]
-			baseI := i
-			i = encodeVarint(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xda
+	if len(this.MapUint64Uint64) != len(that.MapUint64Uint64) {
+		return false
+	}
+	for i, vx := range this.MapUint64Uint64 {
+		vy, ok := that.MapUint64Uint64[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapUint32Uint32) > 0 {
-		for k := range m.MapUint32Uint32 {
-			v := m.MapUint32Uint32[k]
-			baseI := i
-			i = encodeVarint(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xd2
+	if len(this.MapSint32Sint32) != len(that.MapSint32Sint32) {
+		return false
+	}
+	for i, vx := range this.MapSint32Sint32 {
+		vy, ok := that.MapSint32Sint32[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.MapInt64Int64) > 0 {
-		for k := range m.MapInt64Int64 {
-			v := m.MapInt64Int64[k]
-			baseI := i
-			i = encodeVarint(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xca
-		}
+	if len(this.MapSint64Sint64) != len(that.MapSint64Sint64) {
+		return false
 	}
-	if len(m.MapInt32Int32) > 0 {
-		for k := range m.MapInt32Int32 {
-			v := m.MapInt32Int32[k]
-			baseI := i
-			i = encodeVarint(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xc2
+	for i, vx := range this.MapSint64Sint64 {
+		vy, ok := that.MapSint64Sint64[i]
+		if !ok {
+			return false
 		}
-	}
-	if len(m.RepeatedCord) > 0 {
-		for iNdEx := len(m.RepeatedCord) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RepeatedCord[iNdEx])
-			copy(dAtA[i:], m.RepeatedCord[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.RepeatedCord[iNdEx])))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xba
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.RepeatedStringPiece) > 0 {
-		for iNdEx := len(m.RepeatedStringPiece) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RepeatedStringPiece[iNdEx])
-			copy(dAtA[i:], m.RepeatedStringPiece[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.RepeatedStringPiece[iNdEx])))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0xb2
-		}
+	if len(this.MapFixed32Fixed32) != len(that.MapFixed32Fixed32) {
+		return false
 	}
-	if len(m.RepeatedForeignEnum) > 0 {
-		var pksize24 int
-		for _, num := range m.RepeatedForeignEnum {
-			pksize24 += sov(uint64(num))
+	for i, vx := range this.MapFixed32Fixed32 {
+		vy, ok := that.MapFixed32Fixed32[i]
+		if !ok {
+			return false
 		}
-		i -= pksize24
-		j23 := i
-		for _, num1 := range m.RepeatedForeignEnum {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA[j23] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j23++
-			}
-			dAtA[j23] = uint8(num)
-			j23++
+		if vx != vy {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize24))
-		i--
-		dAtA[i] = 0x3
-		i--
-		dAtA[i] = 0xa2
 	}
-	if len(m.RepeatedNestedEnum) > 0 {
-		var pksize26 int
-		for _, num := range m.RepeatedNestedEnum {
-			pksize26 += sov(uint64(num))
+	if len(this.MapFixed64Fixed64) != len(that.MapFixed64Fixed64) {
+		return false
+	}
+	for i, vx := range this.MapFixed64Fixed64 {
+		vy, ok := that.MapFixed64Fixed64[i]
+		if !ok {
+			return false
 		}
-		i -= pksize26
-		j25 := i
-		for _, num1 := range m.RepeatedNestedEnum {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA[j25] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j25++
-			}
-			dAtA[j25] = uint8(num)
-			j25++
+		if vx != vy {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize26))
-		i--
-		dAtA[i] = 0x3
-		i--
-		dAtA[i] = 0x9a
 	}
-	if len(m.RepeatedForeignMessage) > 0 {
-		for iNdEx := len(m.RepeatedForeignMessage) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.RepeatedForeignMessage[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0x8a
-		}
+	if len(this.MapSfixed32Sfixed32) != len(that.MapSfixed32Sfixed32) {
+		return false
 	}
-	if len(m.RepeatedNestedMessage) > 0 {
-		for iNdEx := len(m.RepeatedNestedMessage) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.RepeatedNestedMessage[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x3
-			i--
-			dAtA[i] = 0x82
+	for i, vx := range this.MapSfixed32Sfixed32 {
+		vy, ok := that.MapSfixed32Sfixed32[i]
+		if !ok {
+			return false
 		}
-	}
-	if len(m.RepeatedBytes) > 0 {
-		for iNdEx := len(m.RepeatedBytes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RepeatedBytes[iNdEx])
-			copy(dAtA[i:], m.RepeatedBytes[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.RepeatedBytes[iNdEx])))
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xea
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.RepeatedString) > 0 {
-		for iNdEx := len(m.RepeatedString) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RepeatedString[iNdEx])
-			copy(dAtA[i:], m.RepeatedString[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.RepeatedString[iNdEx])))
-			i--
-			dAtA[i] = 0x2
-			i--
-			dAtA[i] = 0xe2
-		}
+	if len(this.MapSfixed64Sfixed64) != len(that.MapSfixed64Sfixed64) {
+		return false
 	}
-	if len(m.RepeatedBool) > 0 {
-		for iNdEx := len(m.RepeatedBool) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.RepeatedBool[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
+	for i, vx := range this.MapSfixed64Sfixed64 {
+		vy, ok := that.MapSfixed64Sfixed64[i]
+		if !ok {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(len(m.RepeatedBool)))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xda
-	}
-	if len(m.RepeatedDouble) > 0 {
-		for iNdEx := len(m.RepeatedDouble) - 1; iNdEx >= 0; iNdEx-- {
-			f27 := math.Float64bits(float64(m.RepeatedDouble[iNdEx]))
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f27))
+		if vx != vy {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(len(m.RepeatedDouble)*8))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xd2
 	}
-	if len(m.RepeatedFloat) > 0 {
-		for iNdEx := len(m.RepeatedFloat) - 1; iNdEx >= 0; iNdEx-- {
-			f28 := math.Float32bits(float32(m.RepeatedFloat[iNdEx]))
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f28))
-		}
-		i = encodeVarint(dAtA, i, uint64(len(m.RepeatedFloat)*4))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xca
+	if len(this.MapInt32Float) != len(that.MapInt32Float) {
+		return false
 	}
-	if len(m.RepeatedSfixed64) > 0 {
-		for iNdEx := len(m.RepeatedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.RepeatedSfixed64[iNdEx]))
+	for i, vx := range this.MapInt32Float {
+		vy, ok := that.MapInt32Float[i]
+		if !ok {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(len(m.RepeatedSfixed64)*8))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xc2
-	}
-	if len(m.RepeatedSfixed32) > 0 {
-		for iNdEx := len(m.RepeatedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.RepeatedSfixed32[iNdEx]))
+		if !opts.EqualFloat32(vx, vy) {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(len(m.RepeatedSfixed32)*4))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xba
 	}
-	if len(m.RepeatedFixed64) > 0 {
-		for iNdEx := len(m.RepeatedFixed64) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.RepeatedFixed64[iNdEx]))
-		}
-		i = encodeVarint(dAtA, i, uint64(len(m.RepeatedFixed64)*8))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xb2
+	if len(this.MapInt32Double) != len(that.MapInt32Double) {
+		return false
 	}
-	if len(m.RepeatedFixed32) > 0 {
-		for iNdEx := len(m.RepeatedFixed32) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.RepeatedFixed32[iNdEx]))
+	for i, vx := range this.MapInt32Double {
+		vy, ok := that.MapInt32Double[i]
+		if !ok {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(len(m.RepeatedFixed32)*4))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xaa
-	}
-	if len(m.RepeatedSint64) > 0 {
-		var pksize30 int
-		for _, num := range m.RepeatedSint64 {
-			pksize30 += soz(uint64(num))
+		if !opts.EqualFloat64(vx, vy) {
+			return false
 		}
-		i -= pksize30
-		j29 := i
-		for _, num := range m.RepeatedSint64 {
-			x31 := (uint64(num) << 1) ^ uint64((num >> 63))
-			for x31 >= 1<<7 {
-				dAtA[j29] = uint8(uint64(x31)&0x7f | 0x80)
-				j29++
-				x31 >>= 7
-			}
-			dAtA[j29] = uint8(x31)
-			j29++
-		}
-		i = encodeVarint(dAtA, i, uint64(pksize30))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0xa2
 	}
-	if len(m.RepeatedSint32) > 0 {
-		var pksize33 int
-		for _, num := range m.RepeatedSint32 {
-			pksize33 += soz(uint64(num))
+	if len(this.MapBoolBool) != len(that.MapBoolBool) {
+		return false
+	}
+	for i, vx := range this.MapBoolBool {
+		vy, ok := that.MapBoolBool[i]
+		if !ok {
+			return false
 		}
-		i -= pksize33
-		j32 := i
-		for _, num := range m.RepeatedSint32 {
-			x34 := (uint32(num) << 1) ^ uint32((num >> 31))
-			for x34 >= 1<<7 {
-				dAtA[j32] = uint8(uint64(x34)&0x7f | 0x80)
-				j32++
-				x34 >>= 7
-			}
-			dAtA[j32] = uint8(x34)
-			j32++
+		if vx != vy {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize33))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x9a
 	}
-	if len(m.RepeatedUint64) > 0 {
-		var pksize36 int
-		for _, num := range m.RepeatedUint64 {
-			pksize36 += sov(uint64(num))
+	if len(this.MapStringString) != len(that.MapStringString) {
+		return false
+	}
+	for i, vx := range this.MapStringString {
+		vy, ok := that.MapStringString[i]
+		if !ok {
+			return false
 		}
-		i -= pksize36
-		j35 := i
-		for _, num := range m.RepeatedUint64 {
-			for num >= 1<<7 {
-				dAtA[j35] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j35++
-			}
-			dAtA[j35] = uint8(num)
-			j35++
+		if vx != vy {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize36))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x92
 	}
-	if len(m.RepeatedUint32) > 0 {
-		var pksize38 int
-		for _, num := range m.RepeatedUint32 {
-			pksize38 += sov(uint64(num))
+	if len(this.MapStringBytes) != len(that.MapStringBytes) {
+		return false
+	}
+	for i, vx := range this.MapStringBytes {
+		vy, ok := that.MapStringBytes[i]
+		if !ok {
+			return false
 		}
-		i -= pksize38
-		j37 := i
-		for _, num := range m.RepeatedUint32 {
-			for num >= 1<<7 {
-				dAtA[j37] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j37++
-			}
-			dAtA[j37] = uint8(num)
-			j37++
+		if string(vx) != string(vy) {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize38))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x8a
 	}
-	if len(m.RepeatedInt64) > 0 {
-		var pksize40 int
-		for _, num := range m.RepeatedInt64 {
-			pksize40 += sov(uint64(num))
+	if len(this.MapStringNestedMessage) != len(that.MapStringNestedMessage) {
+		return false
+	}
+	for i, vx := range this.MapStringNestedMessage {
+		vy, ok := that.MapStringNestedMessage[i]
+		if !ok {
+			return false
 		}
-		i -= pksize40
-		j39 := i
-		for _, num1 := range m.RepeatedInt64 {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA[j39] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j39++
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &TestAllTypesProto3_NestedMessage{}
+			}
+			if q == nil {
+				q = &TestAllTypesProto3_NestedMessage{}
+			}
+			if !p.EqualVTWithOptions(q, opts) {
+				return false
 			}
-			dAtA[j39] = uint8(num)
-			j39++
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize40))
-		i--
-		dAtA[i] = 0x2
-		i--
-		dAtA[i] = 0x82
 	}
-	if len(m.RepeatedInt32) > 0 {
-		var pksize42 int
-		for _, num := range m.RepeatedInt32 {
-			pksize42 += sov(uint64(num))
+	if len(this.MapStringForeignMessage) != len(that.MapStringForeignMessage) {
+		return false
+	}
+	for i, vx := range this.MapStringForeignMessage {
+		vy, ok := that.MapStringForeignMessage[i]
+		if !ok {
+			return false
 		}
-		i -= pksize42
-		j41 := i
-		for _, num1 := range m.RepeatedInt32 {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA[j41] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j41++
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &ForeignMessage{}
+			}
+			if q == nil {
+				q = &ForeignMessage{}
+			}
+			if !p.EqualVTWithOptions(q, opts) {
+				return false
 			}
-			dAtA[j41] = uint8(num)
-			j41++
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize42))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xfa
 	}
-	if m.RecursiveMessage != nil {
-		size, err := m.RecursiveMessage.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(this.MapStringNestedEnum) != len(that.MapStringNestedEnum) {
+		return false
+	}
+	for i, vx := range this.MapStringNestedEnum {
+		vy, ok := that.MapStringNestedEnum[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
 		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xda
 	}
-	if len(m.OptionalCord) > 0 {
-		i -= len(m.OptionalCord)
-		copy(dAtA[i:], m.OptionalCord)
-		i = encodeVarint(dAtA, i, uint64(len(m.OptionalCord)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xca
+	if len(this.MapStringForeignEnum) != len(that.MapStringForeignEnum) {
+		return false
 	}
-	if len(m.OptionalStringPiece) > 0 {
-		i -= len(m.OptionalStringPiece)
-		copy(dAtA[i:], m.OptionalStringPiece)
-		i = encodeVarint(dAtA, i, uint64(len(m.OptionalStringPiece)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc2
+	for i, vx := range this.MapStringForeignEnum {
+		vy, ok := that.MapStringForeignEnum[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
+		}
 	}
-	if m.OptionalAliasedEnum != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.OptionalAliasedEnum))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb8
+	if len(this.PackedInt32) != len(that.PackedInt32) {
+		return false
 	}
-	if m.OptionalForeignEnum != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.OptionalForeignEnum))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb0
+	for i, vx := range this.PackedInt32 {
+		vy := that.PackedInt32[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.OptionalNestedEnum != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.OptionalNestedEnum))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa8
+	if len(this.PackedInt64) != len(that.PackedInt64) {
+		return false
 	}
-	if m.OptionalForeignMessage != nil {
-		size, err := m.OptionalForeignMessage.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+	for i, vx := range this.PackedInt64 {
+		vy := that.PackedInt64[i]
+		if vx != vy {
+			return false
 		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x9a
 	}
-	if m.OptionalNestedMessage != nil {
-		size, err := m.OptionalNestedMessage.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(this.PackedUint32) != len(that.PackedUint32) {
+		return false
+	}
+	for i, vx := range this.PackedUint32 {
+		vy := that.PackedUint32[i]
+		if vx != vy {
+			return false
 		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
 	}
-	if len(m.OptionalBytes) > 0 {
-		i -= len(m.OptionalBytes)
-		copy(dAtA[i:], m.OptionalBytes)
-		i = encodeVarint(dAtA, i, uint64(len(m.OptionalBytes)))
-		i--
-		dAtA[i] = 0x7a
+	if len(this.PackedUint64) != len(that.PackedUint64) {
+		return false
 	}
-	if len(m.OptionalString) > 0 {
-		i -= len(m.OptionalString)
-		copy(dAtA[i:], m.OptionalString)
-		i = encodeVarint(dAtA, i, uint64(len(m.OptionalString)))
-		i--
-		dAtA[i] = 0x72
+	for i, vx := range this.PackedUint64 {
+		vy := that.PackedUint64[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.OptionalBool {
-		i--
-		if m.OptionalBool {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(this.PackedSint32) != len(that.PackedSint32) {
+		return false
+	}
+	for i, vx := range this.PackedSint32 {
+		vy := that.PackedSint32[i]
+		if vx != vy {
+			return false
 		}
-		i--
-		dAtA[i] = 0x68
 	}
-	if m.OptionalDouble != 0 {
-		i -= 8
-		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.OptionalDouble))))
-		i--
-		dAtA[i] = 0x61
+	if len(this.PackedSint64) != len(that.PackedSint64) {
+		return false
 	}
-	if m.OptionalFloat != 0 {
-		i -= 4
-		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OptionalFloat))))
-		i--
-		dAtA[i] = 0x5d
+	for i, vx := range this.PackedSint64 {
+		vy := that.PackedSint64[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.OptionalSfixed64 != 0 {
-		i -= 8
-		binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.OptionalSfixed64))
-		i--
-		dAtA[i] = 0x51
+	if len(this.PackedFixed32) != len(that.PackedFixed32) {
+		return false
 	}
-	if m.OptionalSfixed32 != 0 {
-		i -= 4
-		binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.OptionalSfixed32))
-		i--
-		dAtA[i] = 0x4d
+	for i, vx := range this.PackedFixed32 {
+		vy := that.PackedFixed32[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.OptionalFixed64 != 0 {
-		i -= 8
-		binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.OptionalFixed64))
-		i--
-		dAtA[i] = 0x41
+	if len(this.PackedFixed64) != len(that.PackedFixed64) {
+		return false
 	}
-	if m.OptionalFixed32 != 0 {
-		i -= 4
-		binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.OptionalFixed32))
-		i--
-		dAtA[i] = 0x3d
+	for i, vx := range this.PackedFixed64 {
+		vy := that.PackedFixed64[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.OptionalSint64 != 0 {
-		i = encodeVarint(dAtA, i, uint64((uint64(m.OptionalSint64)<<1)^uint64((m.OptionalSint64>>63))))
-		i--
-		dAtA[i] = 0x30
+	if len(this.PackedSfixed32) != len(that.PackedSfixed32) {
+		return false
 	}
-	if m.OptionalSint32 != 0 {
-		i = encodeVarint(dAtA, i, uint64((uint32(m.OptionalSint32)<<1)^uint32((m.OptionalSint32>>31))))
-		i--
-		dAtA[i] = 0x28
+	for i, vx := range this.PackedSfixed32 {
+		vy := that.PackedSfixed32[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.OptionalUint64 != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.OptionalUint64))
-		i--
-		dAtA[i] = 0x20
+	if len(this.PackedSfixed64) != len(that.PackedSfixed64) {
+		return false
 	}
-	if m.OptionalUint32 != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.OptionalUint32))
-		i--
-		dAtA[i] = 0x18
+	for i, vx := range this.PackedSfixed64 {
+		vy := that.PackedSfixed64[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.OptionalInt64 != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.OptionalInt64))
-		i--
-		dAtA[i] = 0x10
+	if len(this.PackedFloat) != len(that.PackedFloat) {
+		return false
 	}
-	if m.OptionalInt32 != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.OptionalInt32))
-		i--
-		dAtA[i] = 0x8
+	for i, vx := range this.PackedFloat {
+		vy := that.PackedFloat[i]
+		if !opts.EqualFloat32(vx, vy) {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TestAllTypesProto3_OneofUint32) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *TestAllTypesProto3_OneofUint32) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarint(dAtA, i, uint64(m.OneofUint32))
-	i--
-	dAtA[i] = 0x6
-	i--
-	dAtA[i] = 0xf8
-	return len(dAtA) - i, nil
-}
-func (m *TestAllTypesProto3_OneofNestedMessage) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *TestAllTypesProto3_OneofNestedMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.OneofNestedMessage != nil {
-		size, err := m.OneofNestedMessage.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(this.PackedDouble) != len(that.PackedDouble) {
+		return false
+	}
+	for i, vx := range this.PackedDouble {
+		vy := that.PackedDouble[i]
+		if !opts.EqualFloat64(vx, vy) {
+			return false
 		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0x7
-		i--
-		dAtA[i] = 0x82
 	}
-	return len(dAtA) - i, nil
-}
-func (m *TestAllTypesProto3_OneofString) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *TestAllTypesProto3_OneofString) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.OneofString)
-	copy(dAtA[i:], m.OneofString)
-	i = encodeVarint(dAtA, i, uint64(len(m.OneofString)))
-	i--
-	dAtA[i] = 0x7
-	i--
-	dAtA[i] = 0x8a
-	return len(dAtA) - i, nil
-}
-func (m *TestAllTypesProto3_OneofBytes) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *TestAllTypesProto3_OneofBytes) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.OneofBytes)
-	copy(dAtA[i:], m.OneofBytes)
-	i = encodeVarint(dAtA, i, uint64(len(m.OneofBytes)))
-	i--
-	dAtA[i] = 0x7
-	i--
-	dAtA[i] = 0x92
-	return len(dAtA) - i, nil
-}
-func (m *TestAllTypesProto3_OneofBool) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *TestAllTypesProto3_OneofBool) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i--
-	if m.OneofBool {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	if len(this.PackedBool) != len(that.PackedBool) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x7
-	i--
-	dAtA[i] = 0x98
-	return len(dAtA) - i, nil
-}
-func (m *TestAllTypesProto3_OneofUint64) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *TestAllTypesProto3_OneofUint64) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarint(dAtA, i, uint64(m.OneofUint64))
-	i--
-	dAtA[i] = 0x7
-	i--
-	dAtA[i] = 0xa0
-	return len(dAtA) - i, nil
-}
-func (m *TestAllTypesProto3_OneofFloat) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *TestAllTypesProto3_OneofFloat) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= 4
-	binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OneofFloat))))
-	i--
-	dAtA[i] = 0x7
-	i--
-	dAtA[i] = 0xad
-	return len(dAtA) - i, nil
-}
-func (m *TestAllTypesProto3_OneofDouble) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *TestAllTypesProto3_OneofDouble) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= 8
-	binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.OneofDouble))))
-	i--
-	dAtA[i] = 0x7
-	i--
-	dAtA[i] = 0xb1
-	return len(dAtA) - i, nil
-}
-func (m *TestAllTypesProto3_OneofEnum) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *TestAllTypesProto3_OneofEnum) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarint(dAtA, i, uint64(m.OneofEnum))
-	i--
-	dAtA[i] = 0x7
-	i--
-	dAtA[i] = 0xb8
-	return len(dAtA) - i, nil
-}
-func (m *TestAllTypesProto3_OneofNullValue) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *TestAllTypesProto3_OneofNullValue) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarint(dAtA, i, uint64(m.OneofNullValue))
-	i--
-	dAtA[i] = 0x7
-	i--
-	dAtA[i] = 0xc0
-	return len(dAtA) - i, nil
-}
-func (m *ForeignMessage) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	for i, vx := range this.PackedBool {
+		vy := that.PackedBool[i]
+		if vx != vy {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ForeignMessage) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *ForeignMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+	if len(this.PackedNestedEnum) != len(that.PackedNestedEnum) {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	for i, vx := range this.PackedNestedEnum {
+		vy := that.PackedNestedEnum[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.C != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.C))
-		i--
-		dAtA[i] = 0x8
+	if len(this.UnpackedInt32) != len(that.UnpackedInt32) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *NullHypothesisProto3) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	for i, vx := range this.UnpackedInt32 {
+		vy := that.UnpackedInt32[i]
+		if vx != vy {
+			return false
+		}
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.UnpackedInt64) != len(that.UnpackedInt64) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *NullHypothesisProto3) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *NullHypothesisProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+	for i, vx := range this.UnpackedInt64 {
+		vy := that.UnpackedInt64[i]
+		if vx != vy {
+			return false
+		}
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if len(this.UnpackedUint32) != len(that.UnpackedUint32) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *EnumOnlyProto3) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	for i, vx := range this.UnpackedUint32 {
+		vy := that.UnpackedUint32[i]
+		if vx != vy {
+			return false
+		}
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.UnpackedUint64) != len(that.UnpackedUint64) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EnumOnlyProto3) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *EnumOnlyProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+	for i, vx := range this.UnpackedUint64 {
+		vy := that.UnpackedUint64[i]
+		if vx != vy {
+			return false
+		}
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if len(this.UnpackedSint32) != len(that.UnpackedSint32) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TestAllTypesProto3_NestedMessage) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	for i, vx := range this.UnpackedSint32 {
+		vy := that.UnpackedSint32[i]
+		if vx != vy {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.A != 0 {
-		n += 1 + sov(uint64(m.A))
+	if len(this.UnpackedSint64) != len(that.UnpackedSint64) {
+		return false
 	}
-	if m.Corecursive != nil {
-		l = m.Corecursive.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	for i, vx := range this.UnpackedSint64 {
+		vy := that.UnpackedSint64[i]
+		if vx != vy {
+			return false
+		}
 	}
-	n += len(m.unknownFields)
-	return n
-}
-
-func (m *TestAllTypesProto3) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if len(this.UnpackedFixed32) != len(that.UnpackedFixed32) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.OptionalInt32 != 0 {
-		n += 1 + sov(uint64(m.OptionalInt32))
+	for i, vx := range this.UnpackedFixed32 {
+		vy := that.UnpackedFixed32[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.OptionalInt64 != 0 {
-		n += 1 + sov(uint64(m.OptionalInt64))
+	if len(this.UnpackedFixed64) != len(that.UnpackedFixed64) {
+		return false
 	}
-	if m.OptionalUint32 != 0 {
-		n += 1 + sov(uint64(m.OptionalUint32))
+	for i, vx := range this.UnpackedFixed64 {
+		vy := that.UnpackedFixed64[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.OptionalUint64 != 0 {
-		n += 1 + sov(uint64(m.OptionalUint64))
+	if len(this.UnpackedSfixed32) != len(that.UnpackedSfixed32) {
+		return false
 	}
-	if m.OptionalSint32 != 0 {
-		n += 1 + soz(uint64(m.OptionalSint32))
+	for i, vx := range this.UnpackedSfixed32 {
+		vy := that.UnpackedSfixed32[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.OptionalSint64 != 0 {
-		n += 1 + soz(uint64(m.OptionalSint64))
+	if len(this.UnpackedSfixed64) != len(that.UnpackedSfixed64) {
+		return false
 	}
-	if m.OptionalFixed32 != 0 {
-		n += 5
+	for i, vx := range this.UnpackedSfixed64 {
+		vy := that.UnpackedSfixed64[i]
+		if vx != vy {
+			return false
+		}
 	}
-	if m.OptionalFixed64 != 0 {
-		n += 9
+	if len(this.UnpackedFloat) != len(that.UnpackedFloat) {
+		return false
 	}
-	if m.OptionalSfixed32 != 0 {
-		n += 5
+	for i, vx := range this.UnpackedFloat {
+		vy := that.UnpackedFloat[i]
+		if !opts.EqualFloat32(vx, vy) {
+			return false
+		}
 	}
-	if m.OptionalSfixed64 != 0 {
-		n += 9
+	if len(this.UnpackedDouble) != len(that.UnpackedDouble) {
+		return false
 	}
-	if m.OptionalFloat != 0 {
-		n += 5
+	for i, vx := range this.UnpackedDouble {
+		vy := that.UnpackedDouble[i]
+		if !opts.EqualFloat64(vx, vy) {
+			return false
+		}
 	}
-	if m.OptionalDouble != 0 {
-		n += 9
+	if len(this.UnpackedBool) != len(that.UnpackedBool) {
+		return false
 	}
-	if m.OptionalBool {
-		n += 2
+	for i, vx := range this.UnpackedBool {
+		vy := that.UnpackedBool[i]
+		if vx != vy {
+			return false
+		}
 	}
-	l = len(m.OptionalString)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	if len(this.UnpackedNestedEnum) != len(that.UnpackedNestedEnum) {
+		return false
 	}
-	l = len(m.OptionalBytes)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
-	}
-	if m.OptionalNestedMessage != nil {
-		l = m.OptionalNestedMessage.SizeVT()
-		n += 2 + l + sov(uint64(l))
-	}
-	if m.OptionalForeignMessage != nil {
-		l = m.OptionalForeignMessage.SizeVT()
-		n += 2 + l + sov(uint64(l))
-	}
-	if m.OptionalNestedEnum != 0 {
-		n += 2 + sov(uint64(m.OptionalNestedEnum))
-	}
-	if m.OptionalForeignEnum != 0 {
-		n += 2 + sov(uint64(m.OptionalForeignEnum))
-	}
-	if m.OptionalAliasedEnum != 0 {
-		n += 2 + sov(uint64(m.OptionalAliasedEnum))
-	}
-	l = len(m.OptionalStringPiece)
-	if l > 0 {
-		n += 2 + l + sov(uint64(l))
-	}
-	l = len(m.OptionalCord)
-	if l > 0 {
-		n += 2 + l + sov(uint64(l))
-	}
-	if m.RecursiveMessage != nil {
-		l = m.RecursiveMessage.SizeVT()
-		n += 2 + l + sov(uint64(l))
-	}
-	if len(m.RepeatedInt32) > 0 {
-		l = 0
-		for _, e := range m.RepeatedInt32 {
-			l += sov(uint64(e))
+	for i, vx := range this.UnpackedNestedEnum {
+		vy := that.UnpackedNestedEnum[i]
+		if vx != vy {
+			return false
 		}
-		n += 2 + sov(uint64(l)) + l
 	}
-	if len(m.RepeatedInt64) > 0 {
-		l = 0
-		for _, e := range m.RepeatedInt64 {
-			l += sov(uint64(e))
+	if equal, ok := interface{}(this.OptionalBoolWrapper).(interface {
+		EqualVTWithOptions(*wrapperspb.BoolValue, vtproto.EqualOptions) bool
+	}); ok {
+		if !equal.EqualVTWithOptions(that.OptionalBoolWrapper, opts) {
+			return false
 		}
-		n += 2 + sov(uint64(l)) + l
-	}
-	if len(m.RepeatedUint32) > 0 {
-		l = 0
-		for _, e := range m.RepeatedUint32 {
-			l += sov(uint64(e))
+	} else if equal, ok := interface{}(this.OptionalBoolWrapper).(interface {
+		EqualVT(*wrapperspb.BoolValue) bool
+	}); ok {
+		if !equal.EqualVT(that.OptionalBoolWrapper) {
+			return false
 		}
-		n += 2 + sov(uint64(l)) + l
+	} else if !proto.Equal(this.OptionalBoolWrapper, that.OptionalBoolWrapper) {
+		return false
 	}
-	if len(m.RepeatedUint64) > 0 {
-		l = 0
-		for _, e := range m.RepeatedUint64 {
-			l += sov(uint64(e))
+	if equal, ok := interface{}(this.OptionalInt32Wrapper).(interface {
+		EqualVTWithOptions(*wrapperspb.Int32Value, vtproto.EqualOptions) bool
+	}); ok {
+		if !equal.EqualVTWithOptions(that.OptionalInt32Wrapper, opts) {
+			return false
 		}
-		n += 2 + sov(uint64(l)) + l
-	}
-	if len(m.RepeatedSint32) > 0 {
-		l = 0
-		for _, e := range m.RepeatedSint32 {
-			l += soz(uint64(e))
+	} else if equal, ok := interface{}(this.OptionalInt32Wrapper).(interface {
+		EqualVT(*wrapperspb.Int32Value) bool
+	}); ok {
+		if !equal.EqualVT(that.OptionalInt32Wrapper) {
+			return false
 		}
-		n += 2 + sov(uint64(l)) + l
+	} else if !proto.Equal(this.OptionalInt32Wrapper, that.OptionalInt32Wrapper) {
+		return false
 	}
-	if len(m.RepeatedSint64) > 0 {
-		l = 0
-		for _, e := range m.RepeatedSint64 {
-			l += soz(uint64(e))
+	if equal, ok := interface{}(this.OptionalInt64Wrapper).(interface {
+		EqualVTWithOptions(*wrapperspb.Int64Value, vtproto.EqualOptions) bool
+	}); ok {
+		if !equal.EqualVTWithOptions(that.OptionalInt64Wrapper, opts) {
+			return false
 		}
-		n += 2 + sov(uint64(l)) + l
-	}
-	if len(m.RepeatedFixed32) > 0 {
-		n += 2 + sov(uint64(len(m.RepeatedFixed32)*4)) + len(m.RepeatedFixed32)*4
-	}
-	if len(m.RepeatedFixed64) > 0 {
-		n += 2 + sov(uint64(len(m.RepeatedFixed64)*8)) + len(m.RepeatedFixed64)*8
-	}
-	if len(m.RepeatedSfixed32) > 0 {
-		n += 2 + sov(uint64(len(m.RepeatedSfixed32)*4)) + len(m.RepeatedSfixed32)*4
-	}
-	if len(m.RepeatedSfixed64) > 0 {
-		n += 2 + sov(uint64(len(m.RepeatedSfixed64)*8)) + len(m.RepeatedSfixed64)*8
-	}
-	if len(m.RepeatedFloat) > 0 {
-		n += 2 + sov(uint64(len(m.RepeatedFloat)*4)) + len(m.RepeatedFloat)*4
-	}
-	if len(m.RepeatedDouble) > 0 {
-		n += 2 + sov(uint64(len(m.RepeatedDouble)*8)) + len(m.RepeatedDouble)*8
-	}
-	if len(m.RepeatedBool) > 0 {
-		n += 2 + sov(uint64(len(m.RepeatedBool))) + len(m.RepeatedBool)*1
-	}
-	if len(m.RepeatedString) > 0 {
-		for _, s := range m.RepeatedString {
-			l = len(s)
-			n += 2 + l + sov(uint64(l))
+	} else if equal, ok := interface{}(this.OptionalInt64Wrapper).(interface {
+		EqualVT(*wrapperspb.Int64Value) bool
+	}); ok {
+		if !equal.EqualVT(that.OptionalInt64Wrapper) {
+			return false
 		}
+	} else if !proto.Equal(this.OptionalInt64Wrapper, that.OptionalInt64Wrapper) {
+		return false
 	}
-	if len(m.RepeatedBytes) > 0 {
-		for _, b := range m.RepeatedBytes {
-			l = len(b)
-			n += 2 + l + sov(uint64(l))
+	if equal, ok := interface{}(this.OptionalUint32Wrapper).(interface {
+		EqualVTWithOptions(*wrapperspb.UInt32Value, vtproto.EqualOptions) bool
+	}); ok {
+		if !equal.EqualVTWithOptions(that.OptionalUint32Wrapper, opts) {
+			return false
 		}
-	}
-	if len(m.RepeatedNestedMessage) > 0 {
-		for _, e := range m.RepeatedNestedMessage {
-			l = e.SizeVT()
-			n += 2 + l + sov(uint64(l))
+	} else if equal, ok := interface{}(this.OptionalUint32Wrapper).(interface {
+		EqualVT(*wrapperspb.UInt32Value) bool
+	}); ok {
+		if !equal.EqualVT(that.OptionalUint32Wrapper) {
+			return false
 		}
+	} else if !proto.Equal(this.OptionalUint32Wrapper, that.OptionalUint32Wrapper) {
+		return false
 	}
-	if len(m.RepeatedForeignMessage) > 0 {
-		for _, e := range m.RepeatedForeignMessage {
-			l = e.SizeVT()
-			n += 2 + l + sov(uint64(l))
+	if equal, ok := interface{}(this.OptionalUint64Wrapper).(interface {
+		EqualVTWithOptions(*wrapperspb.UInt64Value, vtproto.EqualOptions) bool
+	}); ok {
+		if !equal.EqualVTWithOptions(that.OptionalUint64Wrapper, opts) {
+			return false
 		}
-	}
-	if len(m.RepeatedNestedEnum) > 0 {
-		l = 0
-		for _, e := range m.RepeatedNestedEnum {
-			l += sov(uint64(e))
+	} else if equal, ok := interface{}(this.OptionalUint64Wrapper).(interface {
+		EqualVT(*wrapperspb.UInt64Value) bool
+	}); ok {
+		if !equal.EqualVT(that.OptionalUint64Wrapper) {
+			return false
 		}
-		n += 2 + sov(uint64(l)) + l
+	} else if !proto.Equal(this.OptionalUint64Wrapper, that.OptionalUint64Wrapper) {
+		return false
 	}
-	if len(m.RepeatedForeignEnum) > 0 {
-		l = 0
-		for _, e := range m.RepeatedForeignEnum {
-			l += sov(uint64(e))
+	if equal, ok := interface{}(this.OptionalFloatWrapper).(interface {
+		EqualVTWithOptions(*wrapperspb.FloatValue, vtproto.EqualOptions) bool
+	}); ok {
+		if !equal.EqualVTWithOptions(that.OptionalFloatWrapper, opts) {
+			return false
 		}
-		n += 2 + sov(uint64(l)) + l
-	}
-	if len(m.RepeatedStringPiece) > 0 {
-		for _, s := range m.RepeatedStringPiece {
-			l = len(s)
-			n += 2 + l + sov(uint64(l))
+	} else if equal, ok := interface{}(this.OptionalFloatWrapper).(interface {
+		EqualVT(*wrapperspb.FloatValue) bool
+	}); ok {
+		if !equal.EqualVT(that.OptionalFloatWrapper) {
+			return false
 		}
+	} else if !proto.Equal(this.OptionalFloatWrapper, that.OptionalFloatWrapper) {
+		return false
 	}
-	if len(m.RepeatedCord) > 0 {
-		for _, s := range m.RepeatedCord {
-			l = len(s)
-			n += 2 + l + sov(uint64(l))
+	if equal, ok := interface{}(this.OptionalDoubleWrapper).(interface {
+		EqualVTWithOptions(*wrapperspb.DoubleValue, vtproto.EqualOptions) bool
+	}); ok {
+		if !equal.EqualVTWithOptions(that.OptionalDoubleWrapper, opts) {
+			return false
 		}
-	}
-	if len(m.MapInt32Int32) > 0 {
-		for k, v := range m.MapInt32Int32 {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sov(uint64(k)) + 1 + sov(uint64(v))
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+	} else if equal, ok := interface{}(this.OptionalDoubleWrapper).(interface {
+		EqualVT(*wrapperspb.DoubleValue) bool
+	}); ok {
+		if !equal.EqualVT(that.OptionalDoubleWrapper) {
+			return false
 		}
+	} else if !proto.Equal(this.OptionalDoubleWrapper, that.OptionalDoubleWrapper) {
+		return false
 	}
-	if len(m.MapInt64Int64) > 0 {
-		for k, v := range m.MapInt64Int64 {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sov(uint64(k)) + 1 + sov(uint64(v))
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+	if equal, ok := interface{}(this.OptionalStringWrapper).(interface {
+		EqualVTWithOptions(*wrapperspb.StringValue, vtproto.EqualOptions) bool
+	}); ok {
+		if !equal.EqualVTWithOptions(that.OptionalStringWrapper, opts) {
+			return false
 		}
-	}
-	if len(m.MapUint32Uint32) > 0 {
-		for k, v := range m.MapUint32Uint32 {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sov(uint64(k)) + 1 + sov(uint64(v))
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+	} else if equal, ok := interface{}(this.OptionalStringWrapper).(interface {
+		EqualVT(*wrapperspb.StringValue) bool
+	}); ok {
+		if !equal.EqualVT(that.OptionalStringWrapper) {
+			return false
 		}
+	} else if !proto.Equal(this.OptionalStringWrapper, that.OptionalStringWrapper) {
+		return false
 	}
-	if len(m.MapUint64Uint64) > 0 {
-		for k, v := range m.MapUint64Uint64 {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sov(uint64(k)) + 1 + sov(uint64(v))
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+	if equal, ok := interface{}(this.OptionalBytesWrapper).(interface {
+		EqualVTWithOptions(*wrapperspb.BytesValue, vtproto.EqualOptions) bool
+	}); ok {
+		if !equal.EqualVTWithOptions(that.OptionalBytesWrapper, opts) {
+			return false
 		}
-	}
-	if len(m.MapSint32Sint32) > 0 {
-		for k, v := range m.MapSint32Sint32 {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + soz(uint64(k)) + 1 + soz(uint64(v))
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+	} else if equal, ok := interface{}(this.OptionalBytesWrapper).(interface {
+		EqualVT(*wrapperspb.BytesValue) bool
+	}); ok {
+		if !equal.EqualVT(that.OptionalBytesWrapper) {
+			return false
 		}
+	} else if !proto.Equal(this.OptionalBytesWrapper, that.OptionalBytesWrapper) {
+		return false
 	}
-	if len(m.MapSint64Sint64) > 0 {
-		for k, v := range m.MapSint64Sint64 {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + soz(uint64(k)) + 1 + soz(uint64(v))
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
-		}
+	if len(this.RepeatedBoolWrapper) != len(that.RepeatedBoolWrapper) {
+		return false
 	}
-	if len(m.MapFixed32Fixed32) > 0 {
-		for k, v := range m.MapFixed32Fixed32 {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + 4 + 1 + 4
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
-		}
-	}
-	if len(m.MapFixed64Fixed64) > 0 {
-		for k, v := range m.MapFixed64Fixed64 {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + 8 + 1 + 8
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+	for i, vx := range this.RepeatedBoolWrapper {
+		vy := that.RepeatedBoolWrapper[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &wrapperspb.BoolValue{}
+			}
+			if q == nil {
+				q = &wrapperspb.BoolValue{}
+			}
+			if equal, ok := interface{}(p).(interface {
+				EqualVTWithOptions(*wrapperspb.BoolValue, vtproto.EqualOptions) bool
+			}); ok {
+				if !equal.EqualVTWithOptions(q, opts) {
+					return false
+				}
+			} else if equal, ok := interface{}(p).(interface {
+				EqualVT(*wrapperspb.BoolValue) bool
+			}); ok {
+				if !equal.EqualVT(q) {
+					return false
+				}
+			} else if !proto.Equal(p, q) {
+				return false
+			}
 		}
 	}
-	if len(m.MapSfixed32Sfixed32) > 0 {
-		for k, v := range m.MapSfixed32Sfixed32 {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + 4 + 1 + 4
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
-		}
+	if len(this.RepeatedInt32Wrapper) != len(that.RepeatedInt32Wrapper) {
+		return false
 	}
-	if len(m.MapSfixed64Sfixed64) > 0 {
-		for k, v := range m.MapSfixed64Sfixed64 {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + 8 + 1 + 8
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+	for i, vx := range this.RepeatedInt32Wrapper {
+		vy := that.RepeatedInt32Wrapper[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &wrapperspb.Int32Value{}
+			}
+			if q == nil {
+				q = &wrapperspb.Int32Value{}
+			}
+			if equal, ok := interface{}(p).(interface {
+				EqualVTWithOptions(*wrapperspb.Int32Value, vtproto.EqualOptions) bool
+			}); ok {
+				if !equal.EqualVTWithOptions(q, opts) {
+					return false
+				}
+			} else if equal, ok := interface{}(p).(interface {
+				EqualVT(*wrapperspb.Int32Value) bool
+			}); ok {
+				if !equal.EqualVT(q) {
+					return false
+				}
+			} else if !proto.Equal(p, q) {
+				return false
+			}
 		}
 	}
-	if len(m.MapInt32Float) > 0 {
-		for k, v := range m.MapInt32Float {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sov(uint64(k)) + 1 + 4
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
-		}
+	if len(this.RepeatedInt64Wrapper) != len(that.RepeatedInt64Wrapper) {
+		return false
 	}
-	if len(m.MapInt32Double) > 0 {
-		for k, v := range m.MapInt32Double {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sov(uint64(k)) + 1 + 8
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+	for i, vx := range this.RepeatedInt64Wrapper {
+		vy := that.RepeatedInt64Wrapper[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &wrapperspb.Int64Value{}
+			}
+			if q == nil {
+				q = &wrapperspb.Int64Value{}
+			}
+			if equal, ok := interface{}(p).(interface {
+				EqualVTWithOptions(*wrapperspb.Int64Value, vtproto.EqualOptions) bool
+			}); ok {
+				if !equal.EqualVTWithOptions(q, opts) {
+					return false
+				}
+			} else if equal, ok := interface{}(p).(interface {
+				EqualVT(*wrapperspb.Int64Value) bool
+			}); ok {
+				if !equal.EqualVT(q) {
+					return false
+				}
+			} else if !proto.Equal(p, q) {
+				return false
+			}
 		}
 	}
-	if len(m.MapBoolBool) > 0 {
-		for k, v := range m.MapBoolBool {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + 1 + 1 + 1
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
-		}
+	if len(this.RepeatedUint32Wrapper) != len(that.RepeatedUint32Wrapper) {
+		return false
 	}
-	if len(m.MapStringString) > 0 {
-		for k, v := range m.MapStringString {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + len(v) + sov(uint64(len(v)))
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+	for i, vx := range this.RepeatedUint32Wrapper {
+		vy := that.RepeatedUint32Wrapper[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &wrapperspb.UInt32Value{}
+			}
+			if q == nil {
+				q = &wrapperspb.UInt32Value{}
+			}
+			if equal, ok := interface{}(p).(interface {
+				EqualVTWithOptions(*wrapperspb.UInt32Value, vtproto.EqualOptions) bool
+			}); ok {
+				if !equal.EqualVTWithOptions(q, opts) {
+					return false
+				}
+			} else if equal, ok := interface{}(p).(interface {
+				EqualVT(*wrapperspb.UInt32Value) bool
+			}); ok {
+				if !equal.EqualVT(q) {
+					return false
+				}
+			} else if !proto.Equal(p, q) {
+				return false
+			}
 		}
 	}
-	if len(m.MapStringBytes) > 0 {
-		for k, v := range m.MapStringBytes {
-			_ = k
-			_ = v
-			l = 1 + len(v) + sov(uint64(len(v)))
-			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + l
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
-		}
+	if len(this.RepeatedUint64Wrapper) != len(that.RepeatedUint64Wrapper) {
+		return false
 	}
-	if len(m.MapStringNestedMessage) > 0 {
-		for k, v := range m.MapStringNestedMessage {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.SizeVT()
+	for i, vx := range this.RepeatedUint64Wrapper {
+		vy := that.RepeatedUint64Wrapper[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &wrapperspb.UInt64Value{}
+			}
+			if q == nil {
+				q = &wrapperspb.UInt64Value{}
+			}
+			if equal, ok := interface{}(p).(interface {
+				EqualVTWithOptions(*wrapperspb.UInt64Value, vtproto.EqualOptions) bool
+			}); ok {
+				if !equal.EqualVTWithOptions(q, opts) {
+					return false
+				}
+			} else if equal, ok := interface{}(p).(interface {
+				EqualVT(*wrapperspb.UInt64Value) bool
+			}); ok {
+				if !equal.EqualVT(q) {
+					return false
+				}
+			} else if !proto.Equal(p, q) {
+				return false
 			}
-			l += 1 + sov(uint64(l))
-			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + l
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
 		}
 	}
-	if len(m.MapStringForeignMessage) > 0 {
-		for k, v := range m.MapStringForeignMessage {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.SizeVT()
+	if len(this.RepeatedFloatWrapper) != len(that.RepeatedFloatWrapper) {
+		return false
+	}
+	for i, vx := range this.RepeatedFloatWrapper {
+		vy := that.RepeatedFloatWrapper[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &wrapperspb.FloatValue{}
+			}
+			if q == nil {
+				q = &wrapperspb.FloatValue{}
+			}
+			if equal, ok := interface{}(p).(interface {
+				EqualVTWithOptions(*wrapperspb.FloatValue, vtproto.EqualOptions) bool
+			}); ok {
+				if !equal.EqualVTWithOptions(q, opts) {
+					return false
+				}
+			} else if equal, ok := interface{}(p).(interface {
+				EqualVT(*wrapperspb.FloatValue) bool
+			}); ok {
+				if !equal.EqualVT(q) {
+					return false
+				}
+			} else if !proto.Equal(p, q) {
+				return false
 			}
-			l += 1 + sov(uint64(l))
-			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + l
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
 		}
 	}
-	if len(m.MapStringNestedEnum) > 0 {
-		for k, v := range m.MapStringNestedEnum {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + sov(uint64(v))
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
-		}
+	if len(this.RepeatedDoubleWrapper) != len(that.RepeatedDoubleWrapper) {
+		return false
 	}
-	if len(m.MapStringForeignEnum) > 0 {
-		for k, v := range m.MapStringForeignEnum {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + sov(uint64(v))
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+	for i, vx := range this.RepeatedDoubleWrapper {
+		vy := that.RepeatedDoubleWrapper[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &wrapperspb.DoubleValue{}
+			}
+			if q == nil {
+				q = &wrapperspb.DoubleValue{}
+			}
+			if equal, ok := interface{}(p).(interface {
+				EqualVTWithOptions(*wrapperspb.DoubleValue, vtproto.EqualOptions) bool
+			}); ok {
+				if !equal.EqualVTWithOptions(q, opts) {
+					return false
+				}
+			} else if equal, ok := interface{}(p).(interface {
+				EqualVT(*wrapperspb.DoubleValue) bool
+			}); ok {
+				if !equal.EqualVT(q) {
+					return false
+				}
+			} else if !proto.Equal(p, q) {
+				return false
+			}
 		}
 	}
-	if len(m.PackedInt32) > 0 {
-		l = 0
-		for _, e := range m.PackedInt32 {
-			l += sov(uint64(e))
-		}
-		n += 2 + sov(uint64(l)) + l
+	if len(this.RepeatedStringWrapper) != len(that.RepeatedStringWrapper) {
+		return false
 	}
-	if len(m.PackedInt64) > 0 {
-		l = 0
-		for _, e := range m.PackedInt64 {
-			l += sov(uint64(e))
+	for i, vx := range this.RepeatedStringWrapper {
+		vy := that.RepeatedStringWrapper[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &wrapperspb.StringValue{}
+			}
+			if q == nil {
+				q = &wrapperspb.StringValue{}
+			}
+			if equal, ok := interface{}(p).(interface {
+				EqualVTWithOptions(*wrapperspb.StringValue, vtproto.EqualOptions) bool
+			}); ok {
+				if !equal.EqualVTWithOptions(q, opts) {
+					return false
+				}
+			} else if equal, ok := interface{}(p).(interface {
+				EqualVT(*wrapperspb.StringValue) bool
+			}); ok {
+				if !equal.EqualVT(q) {
+					return false
+				}
+			} else if !proto.Equal(p, q) {
+				return false
+			}
 		}
-		n += 2 + sov(uint64(l)) + l
 	}
-	if len(m.PackedUint32) > 0 {
-		l = 0
-		for _, e := range m.PackedUint32 {
-			l += sov(uint64(e))
-		}
-		n += 2 + sov(uint64(l)) + l
+	if len(this.RepeatedBytesWrapper) != len(that.RepeatedBytesWrapper) {
+		return false
 	}
-	if len(m.PackedUint64) > 0 {
-		l = 0
-		for _, e := range m.PackedUint64 {
-			l += sov(uint64(e))
+	for i, vx := range this.RepeatedBytesWrapper {
+		vy := that.RepeatedBytesWrapper[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &wrapperspb.BytesValue{}
+			}
+			if q == nil {
+				q = &wrapperspb.BytesValue{}
+			}
+			if equal, ok := interface{}(p).(interface {
+				EqualVTWithOptions(*wrapperspb.BytesValue, vtproto.EqualOptions) bool
+			}); ok {
+				if !equal.EqualVTWithOptions(q, opts) {
+					return false
+				}
+			} else if equal, ok := interface{}(p).(interface {
+				EqualVT(*wrapperspb.BytesValue) bool
+			}); ok {
+				if !equal.EqualVT(q) {
+					return false
+				}
+			} else if !proto.Equal(p, q) {
+				return false
+			}
 		}
-		n += 2 + sov(uint64(l)) + l
 	}
-	if len(m.PackedSint32) > 0 {
-		l = 0
-		for _, e := range m.PackedSint32 {
-			l += soz(uint64(e))
+	if equal, ok := interface{}(this.OptionalDuration).(interface {
+		EqualVTWithOptions(*durationpb.Duration, vtproto.EqualOptions) bool
+	}); ok {
+		if !equal.EqualVTWithOptions(that.OptionalDuration, opts) {
+			return false
 		}
-		n += 2 + sov(uint64(l)) + l
-	}
-	if len(m.PackedSint64) > 0 {
-		l = 0
-		for _, e := range m.PackedSint64 {
-			l += soz(uint64(e))
+	} else if equal, ok := interface{}(this.OptionalDuration).(interface {
+		EqualVT(*durationpb.Duration) bool
+	}); ok {
+		if !equal.EqualVT(that.OptionalDuration) {
+			return false
 		}
-		n += 2 + sov(uint64(l)) + l
-	}
-	if len(m.PackedFixed32) > 0 {
-		n += 2 + sov(uint64(len(m.PackedFixed32)*4)) + len(m.PackedFixed32)*4
-	}
-	if len(m.PackedFixed64) > 0 {
-		n += 2 + sov(uint64(len(m.PackedFixed64)*8)) + len(m.PackedFixed64)*8
-	}
-	if len(m.PackedSfixed32) > 0 {
-		n += 2 + sov(uint64(len(m.PackedSfixed32)*4)) + len(m.PackedSfixed32)*4
-	}
-	if len(m.PackedSfixed64) > 0 {
-		n += 2 + sov(uint64(len(m.PackedSfixed64)*8)) + len(m.PackedSfixed64)*8
-	}
-	if len(m.PackedFloat) > 0 {
-		n += 2 + sov(uint64(len(m.PackedFloat)*4)) + len(m.PackedFloat)*4
-	}
-	if len(m.PackedDouble) > 0 {
-		n += 2 + sov(uint64(len(m.PackedDouble)*8)) + len(m.PackedDouble)*8
-	}
-	if len(m.PackedBool) > 0 {
-		n += 2 + sov(uint64(len(m.PackedBool))) + len(m.PackedBool)*1
+	} else if !proto.Equal(this.OptionalDuration, that.OptionalDuration) {
+		return false
 	}
-	if len(m.PackedNestedEnum) > 0 {
-		l = 0
-		for _, e := range m.PackedNestedEnum {
-			l += sov(uint64(e))
+	if equal, ok := interface{}(this.OptionalTimestamp).(interface {
+		EqualVTWithOptions(*timestamppb.Timestamp, vtproto.EqualOptions) bool
+	}); ok {
+		if !equal.EqualVTWithOptions(that.OptionalTimestamp, opts) {
+			return false
 		}
-		n += 2 + sov(uint64(l)) + l
-	}
-	if len(m.UnpackedInt32) > 0 {
-		for _, e := range m.UnpackedInt32 {
-			n += 2 + sov(uint64(e))
+	} else if equal, ok := interface{}(this.OptionalTimestamp).(interface {
+		EqualVT(*timestamppb.Timestamp) bool
+	}); ok {
+		if !equal.EqualVT(that.OptionalTimestamp) {
+			return false
 		}
+	} else if !proto.Equal(this.OptionalTimestamp, that.OptionalTimestamp) {
+		return false
 	}
-	if len(m.UnpackedInt64) > 0 {
-		for _, e := range m.UnpackedInt64 {
-			n += 2 + sov(uint64(e))
+	if equal, ok := interface{}(this.OptionalFieldMask).(interface {
+		EqualVTWithOptions(*fieldmaskpb.FieldMask, vtproto.EqualOptions) bool
+	}); ok {
+		if !equal.EqualVTWithOptions(that.OptionalFieldMask, opts) {
+			return false
 		}
-	}
-	if len(m.UnpackedUint32) > 0 {
-		for _, e := range m.UnpackedUint32 {
-			n += 2 + sov(uint64(e))
+	} else if equal, ok := interface{}(this.OptionalFieldMask).(interface {
+		EqualVT(*fieldmaskpb.FieldMask) bool
+	}); ok {
+		if !equal.EqualVT(that.OptionalFieldMask) {
+			return false
 		}
+	} else if !proto.Equal(this.OptionalFieldMask, that.OptionalFieldMask) {
+		return false
 	}
-	if len(m.UnpackedUint64) > 0 {
-		for _, e := range m.UnpackedUint64 {
-			n += 2 + sov(uint64(e))
+	if equal, ok := interface{}(this.OptionalStruct).(interface {
+		EqualVTWithOptions(*structpb.Struct, vtproto.EqualOptions) bool
+	}); ok {
+		if !equal.EqualVTWithOptions(that.OptionalStruct, opts) {
+			return false
 		}
-	}
-	if len(m.UnpackedSint32) > 0 {
-		for _, e := range m.UnpackedSint32 {
-			n += 2 + soz(uint64(e))
+	} else if equal, ok := interface{}(this.OptionalStruct).(interface{ EqualVT(*structpb.Struct) bool }); ok {
+		if !equal.EqualVT(that.OptionalStruct) {
+			return false
 		}
+	} else if !proto.Equal(this.OptionalStruct, that.OptionalStruct) {
+		return false
 	}
-	if len(m.UnpackedSint64) > 0 {
-		for _, e := range m.UnpackedSint64 {
-			n += 2 + soz(uint64(e))
+	if equal, ok := interface{}(this.OptionalAny).(interface {
+		EqualVTWithOptions(*anypb.Any, vtproto.EqualOptions) bool
+	}); ok {
+		if !equal.EqualVTWithOptions(that.OptionalAny, opts) {
+			return false
 		}
+	} else if equal, ok := interface{}(this.OptionalAny).(interface{ EqualVT(*anypb.Any) bool }); ok {
+		if !equal.EqualVT(that.OptionalAny) {
+			return false
+		}
+	} else if !proto.Equal(this.OptionalAny, that.OptionalAny) {
+		return false
 	}
-	if len(m.UnpackedFixed32) > 0 {
-		n += 6 * len(m.UnpackedFixed32)
-	}
-	if len(m.UnpackedFixed64) > 0 {
-		n += 10 * len(m.UnpackedFixed64)
-	}
-	if len(m.UnpackedSfixed32) > 0 {
-		n += 6 * len(m.UnpackedSfixed32)
-	}
-	if len(m.UnpackedSfixed64) > 0 {
-		n += 10 * len(m.UnpackedSfixed64)
-	}
-	if len(m.UnpackedFloat) > 0 {
-		n += 6 * len(m.UnpackedFloat)
+	if equal, ok := interface{}(this.OptionalValue).(interface {
+		EqualVTWithOptions(*structpb.Value, vtproto.EqualOptions) bool
+	}); ok {
+		if !equal.EqualVTWithOptions(that.OptionalValue, opts) {
+			return false
+		}
+	} else if equal, ok := interface{}(this.OptionalValue).(interface{ EqualVT(*structpb.Value) bool }); ok {
+		if !equal.EqualVT(that.OptionalValue) {
+			return false
+		}
+	} else if !proto.Equal(this.OptionalValue, that.OptionalValue) {
+		return false
 	}
-	if len(m.UnpackedDouble) > 0 {
-		n += 10 * len(m.UnpackedDouble)
+	if this.OptionalNullValue != that.OptionalNullValue {
+		return false
 	}
-	if len(m.UnpackedBool) > 0 {
-		n += 3 * len(m.UnpackedBool)
+	if len(this.RepeatedDuration) != len(that.RepeatedDuration) {
+		return false
 	}
-	if len(m.UnpackedNestedEnum) > 0 {
-		for _, e := range m.UnpackedNestedEnum {
-			n += 2 + sov(uint64(e))
+	for i, vx := range this.RepeatedDuration {
+		vy := that.RepeatedDuration[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &durationpb.Duration{}
+			}
+			if q == nil {
+				q = &durationpb.Duration{}
+			}
+			if equal, ok := interface{}(p).(interface {
+				EqualVTWithOptions(*durationpb.Duration, vtproto.EqualOptions) bool
+			}); ok {
+				if !equal.EqualVTWithOptions(q, opts) {
+					return false
+				}
+			} else if equal, ok := interface{}(p).(interface {
+				EqualVT(*durationpb.Duration) bool
+			}); ok {
+				if !equal.EqualVT(q) {
+					return false
+				}
+			} else if !proto.Equal(p, q) {
+				return false
+			}
 		}
 	}
-	if vtmsg, ok := m.OneofField.(interface{ SizeVT() int }); ok {
-		n += vtmsg.SizeVT()
+	if len(this.RepeatedTimestamp) != len(that.RepeatedTimestamp) {
+		return false
 	}
-	if m.OptionalBoolWrapper != nil {
-		if size, ok := interface{}(m.OptionalBoolWrapper).(interface {
-			SizeVT() int
-		}); ok {
-			l = size.SizeVT()
-		} else {
-			l = proto.Size(m.OptionalBoolWrapper)
+	for i, vx := range this.RepeatedTimestamp {
+		vy := that.RepeatedTimestamp[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &timestamppb.Timestamp{}
+			}
+			if q == nil {
+				q = &timestamppb.Timestamp{}
+			}
+			if equal, ok := interface{}(p).(interface {
+				EqualVTWithOptions(*timestamppb.Timestamp, vtproto.EqualOptions) bool
+			}); ok {
+				if !equal.EqualVTWithOptions(q, opts) {
+					return false
+				}
+			} else if equal, ok := interface{}(p).(interface {
+				EqualVT(*timestamppb.Timestamp) bool
+			}); ok {
+				if !equal.EqualVT(q) {
+					return false
+				}
+			} else if !proto.Equal(p, q) {
+				return false
+			}
 		}
-		n += 2 + l + sov(uint64(l))
 	}
-	if m.OptionalInt32Wrapper != nil {
-		if size, ok := interface{}(m.OptionalInt32Wrapper).(interface {
-			SizeVT() int
-		}); ok {
-			l = size.SizeVT()
-		} else {
-			l = proto.Size(m.OptionalInt32Wrapper)
-		}
-		n += 2 + l + sov(uint64(l))
+	if len(this.RepeatedFieldmask) != len(that.RepeatedFieldmask) {
+		return false
 	}
-	if m.OptionalInt64Wrapper != nil {
-		if size, ok := interface{}(m.OptionalInt64Wrapper).(interface {
-			SizeVT() int
-		}); ok {
-			l = size.SizeVT()
-		} else {
-			l = proto.Size(m.OptionalInt64Wrapper)
-		}
-		n += 2 + l + sov(uint64(l))
-	}
-	if m.OptionalUint32Wrapper != nil {
-		if size, ok := interface{}(m.OptionalUint32Wrapper).(interface {
-			SizeVT() int
-		}); ok {
-			l = size.SizeVT()
-		} else {
-			l = proto.Size(m.OptionalUint32Wrapper)
-		}
-		n += 2 + l + sov(uint64(l))
-	}
-	if m.OptionalUint64Wrapper != nil {
-		if size, ok := interface{}(m.OptionalUint64Wrapper).(interface {
-			SizeVT() int
-		}); ok {
-			l = size.SizeVT()
-		} else {
-			l = proto.Size(m.OptionalUint64Wrapper)
-		}
-		n += 2 + l + sov(uint64(l))
-	}
-	if m.OptionalFloatWrapper != nil {
-		if size, ok := interface{}(m.OptionalFloatWrapper).(interface {
-			SizeVT() int
-		}); ok {
-			l = size.SizeVT()
-		} else {
-			l = proto.Size(m.OptionalFloatWrapper)
-		}
-		n += 2 + l + sov(uint64(l))
-	}
-	if m.OptionalDoubleWrapper != nil {
-		if size, ok := interface{}(m.OptionalDoubleWrapper).(interface {
-			SizeVT() int
-		}); ok {
-			l = size.SizeVT()
-		} else {
-			l = proto.Size(m.OptionalDoubleWrapper)
-		}
-		n += 2 + l + sov(uint64(l))
-	}
-	if m.OptionalStringWrapper != nil {
-		if size, ok := interface{}(m.OptionalStringWrapper).(interface {
-			SizeVT() int
-		}); ok {
-			l = size.SizeVT()
-		} else {
-			l = proto.Size(m.OptionalStringWrapper)
-		}
-		n += 2 + l + sov(uint64(l))
-	}
-	if m.OptionalBytesWrapper != nil {
-		if size, ok := interface{}(m.OptionalBytesWrapper).(interface {
-			SizeVT() int
-		}); ok {
-			l = size.SizeVT()
-		} else {
-			l = proto.Size(m.OptionalBytesWrapper)
-		}
-		n += 2 + l + sov(uint64(l))
-	}
-	if len(m.RepeatedBoolWrapper) > 0 {
-		for _, e := range m.RepeatedBoolWrapper {
-			if size, ok := interface{}(e).(interface {
-				SizeVT() int
-			}); ok {
-				l = size.SizeVT()
-			} else {
-				l = proto.Size(e)
+	for i, vx := range this.RepeatedFieldmask {
+		vy := that.RepeatedFieldmask[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &fieldmaskpb.FieldMask{}
 			}
-			n += 2 + l + sov(uint64(l))
-		}
-	}
-	if len(m.RepeatedInt32Wrapper) > 0 {
-		for _, e := range m.RepeatedInt32Wrapper {
-			if size, ok := interface{}(e).(interface {
-				SizeVT() int
-			}); ok {
-				l = size.SizeVT()
-			} else {
-				l = proto.Size(e)
+			if q == nil {
+				q = &fieldmaskpb.FieldMask{}
 			}
-			n += 2 + l + sov(uint64(l))
-		}
-	}
-	if len(m.RepeatedInt64Wrapper) > 0 {
-		for _, e := range m.RepeatedInt64Wrapper {
-			if size, ok := interface{}(e).(interface {
-				SizeVT() int
+			if equal, ok := interface{}(p).(interface {
+				EqualVTWithOptions(*fieldmaskpb.FieldMask, vtproto.EqualOptions) bool
 			}); ok {
-				l = size.SizeVT()
-			} else {
-				l = proto.Size(e)
-			}
-			n += 2 + l + sov(uint64(l))
-		}
-	}
-	if len(m.RepeatedUint32Wrapper) > 0 {
-		for _, e := range m.RepeatedUint32Wrapper {
-			if size, ok := interface{}(e).(interface {
-				SizeVT() int
+				if !equal.EqualVTWithOptions(q, opts) {
+					return false
+				}
+			} else if equal, ok := interface{}(p).(interface {
+				EqualVT(*fieldmaskpb.FieldMask) bool
 			}); ok {
-				l = size.SizeVT()
-			} else {
-				l = proto.Size(e)
+				if !equal.EqualVT(q) {
+					return false
+				}
+			} else if !proto.Equal(p, q) {
+				return false
 			}
-			n += 2 + l + sov(uint64(l))
 		}
 	}
-	if len(m.RepeatedUint64Wrapper) > 0 {
-		for _, e := range m.RepeatedUint64Wrapper {
-			if size, ok := interface{}(e).(interface {
-				SizeVT() int
-			}); ok {
-				l = size.SizeVT()
-			} else {
-				l = proto.Size(e)
-			}
-			n += 2 + l + sov(uint64(l))
-		}
+	if len(this.RepeatedAny) != len(that.RepeatedAny) {
+		return false
 	}
-	if len(m.RepeatedFloatWrapper) > 0 {
-		for _, e := range m.RepeatedFloatWrapper {
-			if size, ok := interface{}(e).(interface {
-				SizeVT() int
+	for i, vx := range this.RepeatedAny {
+		vy := that.RepeatedAny[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &anypb.Any{}
+			}
+			if q == nil {
+				q = &anypb.Any{}
+			}
+			if equal, ok := interface{}(p).(interface {
+				EqualVTWithOptions(*anypb.Any, vtproto.EqualOptions) bool
 			}); ok {
-				l = size.SizeVT()
-			} else {
-				l = proto.Size(e)
+				if !equal.EqualVTWithOptions(q, opts) {
+					return false
+				}
+			} else if equal, ok := interface{}(p).(interface{ EqualVT(*anypb.Any) bool }); ok {
+				if !equal.EqualVT(q) {
+					return false
+				}
+			} else if !proto.Equal(p, q) {
+				return false
 			}
-			n += 2 + l + sov(uint64(l))
 		}
 	}
-	if len(m.RepeatedDoubleWrapper) > 0 {
-		for _, e := range m.RepeatedDoubleWrapper {
-			if size, ok := interface{}(e).(interface {
-				SizeVT() int
+	if len(this.RepeatedValue) != len(that.RepeatedValue) {
+		return false
+	}
+	for i, vx := range this.RepeatedValue {
+		vy := that.RepeatedValue[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &structpb.Value{}
+			}
+			if q == nil {
+				q = &structpb.Value{}
+			}
+			if equal, ok := interface{}(p).(interface {
+				EqualVTWithOptions(*structpb.Value, vtproto.EqualOptions) bool
 			}); ok {
-				l = size.SizeVT()
-			} else {
-				l = proto.Size(e)
+				if !equal.EqualVTWithOptions(q, opts) {
+					return false
+				}
+			} else if equal, ok := interface{}(p).(interface{ EqualVT(*structpb.Value) bool }); ok {
+				if !equal.EqualVT(q) {
+					return false
+				}
+			} else if !proto.Equal(p, q) {
+				return false
 			}
-			n += 2 + l + sov(uint64(l))
 		}
 	}
-	if len(m.RepeatedStringWrapper) > 0 {
-		for _, e := range m.RepeatedStringWrapper {
-			if size, ok := interface{}(e).(interface {
-				SizeVT() int
+	if len(this.RepeatedListValue) != len(that.RepeatedListValue) {
+		return false
+	}
+	for i, vx := range this.RepeatedListValue {
+		vy := that.RepeatedListValue[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &structpb.ListValue{}
+			}
+			if q == nil {
+				q = &structpb.ListValue{}
+			}
+			if equal, ok := interface{}(p).(interface {
+				EqualVTWithOptions(*structpb.ListValue, vtproto.EqualOptions) bool
 			}); ok {
-				l = size.SizeVT()
-			} else {
-				l = proto.Size(e)
+				if !equal.EqualVTWithOptions(q, opts) {
+					return false
+				}
+			} else if equal, ok := interface{}(p).(interface {
+				EqualVT(*structpb.ListValue) bool
+			}); ok {
+				if !equal.EqualVT(q) {
+					return false
+				}
+			} else if !proto.Equal(p, q) {
+				return false
 			}
-			n += 2 + l + sov(uint64(l))
 		}
 	}
-	if len(m.RepeatedBytesWrapper) > 0 {
-		for _, e := range m.RepeatedBytesWrapper {
-			if size, ok := interface{}(e).(interface {
-				SizeVT() int
+	if len(this.RepeatedStruct) != len(that.RepeatedStruct) {
+		return false
+	}
+	for i, vx := range this.RepeatedStruct {
+		vy := that.RepeatedStruct[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &structpb.Struct{}
+			}
+			if q == nil {
+				q = &structpb.Struct{}
+			}
+			if equal, ok := interface{}(p).(interface {
+				EqualVTWithOptions(*structpb.Struct, vtproto.EqualOptions) bool
 			}); ok {
-				l = size.SizeVT()
-			} else {
-				l = proto.Size(e)
+				if !equal.EqualVTWithOptions(q, opts) {
+					return false
+				}
+			} else if equal, ok := interface{}(p).(interface{ EqualVT(*structpb.Struct) bool }); ok {
+				if !equal.EqualVT(q) {
+					return false
+				}
+			} else if !proto.Equal(p, q) {
+				return false
 			}
-			n += 2 + l + sov(uint64(l))
 		}
 	}
-	if m.OptionalDuration != nil {
-		if size, ok := interface{}(m.OptionalDuration).(interface {
-			SizeVT() int
-		}); ok {
-			l = size.SizeVT()
-		} else {
-			l = proto.Size(m.OptionalDuration)
-		}
-		n += 2 + l + sov(uint64(l))
+	if this.Fieldname1 != that.Fieldname1 {
+		return false
 	}
-	if m.OptionalTimestamp != nil {
-		if size, ok := interface{}(m.OptionalTimestamp).(interface {
-			SizeVT() int
-		}); ok {
-			l = size.SizeVT()
-		} else {
-			l = proto.Size(m.OptionalTimestamp)
-		}
-		n += 2 + l + sov(uint64(l))
+	if this.FieldName2 != that.FieldName2 {
+		return false
 	}
-	if m.OptionalFieldMask != nil {
-		if size, ok := interface{}(m.OptionalFieldMask).(interface {
-			SizeVT() int
-		}); ok {
-			l = size.SizeVT()
-		} else {
-			l = proto.Size(m.OptionalFieldMask)
-		}
-		n += 2 + l + sov(uint64(l))
+	if this.XFieldName3 != that.XFieldName3 {
+		return false
 	}
-	if m.OptionalStruct != nil {
-		if size, ok := interface{}(m.OptionalStruct).(interface {
-			SizeVT() int
-		}); ok {
-			l = size.SizeVT()
-		} else {
-			l = proto.Size(m.OptionalStruct)
-		}
-		n += 2 + l + sov(uint64(l))
+	if this.Field_Name4_ != that.Field_Name4_ {
+		return false
 	}
-	if m.OptionalAny != nil {
-		if size, ok := interface{}(m.OptionalAny).(interface {
-			SizeVT() int
-		}); ok {
-			l = size.SizeVT()
-		} else {
-			l = proto.Size(m.OptionalAny)
-		}
-		n += 2 + l + sov(uint64(l))
+	if this.Field0Name5 != that.Field0Name5 {
+		return false
 	}
-	if m.OptionalValue != nil {
-		if size, ok := interface{}(m.OptionalValue).(interface {
-			SizeVT() int
-		}); ok {
-			l = size.SizeVT()
-		} else {
-			l = proto.Size(m.OptionalValue)
-		}
-		n += 2 + l + sov(uint64(l))
+	if this.Field_0Name6 != that.Field_0Name6 {
+		return false
 	}
-	if m.OptionalNullValue != 0 {
-		n += 2 + sov(uint64(m.OptionalNullValue))
+	if this.FieldName7 != that.FieldName7 {
+		return false
 	}
-	if len(m.RepeatedDuration) > 0 {
-		for _, e := range m.RepeatedDuration {
-			if size, ok := interface{}(e).(interface {
-				SizeVT() int
-			}); ok {
-				l = size.SizeVT()
-			} else {
-				l = proto.Size(e)
-			}
-			n += 2 + l + sov(uint64(l))
-		}
+	if this.FieldName8 != that.FieldName8 {
+		return false
 	}
-	if len(m.RepeatedTimestamp) > 0 {
-		for _, e := range m.RepeatedTimestamp {
-			if size, ok := interface{}(e).(interface {
-				SizeVT() int
-			}); ok {
-				l = size.SizeVT()
-			} else {
-				l = proto.Size(e)
-			}
-			n += 2 + l + sov(uint64(l))
-		}
+	if this.Field_Name9 != that.Field_Name9 {
+		return false
 	}
-	if len(m.RepeatedFieldmask) > 0 {
-		for _, e := range m.RepeatedFieldmask {
-			if size, ok := interface{}(e).(interface {
-				SizeVT() int
-			}); ok {
-				l = size.SizeVT()
-			} else {
-				l = proto.Size(e)
-			}
-			n += 2 + l + sov(uint64(l))
-		}
+	if this.Field_Name10 != that.Field_Name10 {
+		return false
 	}
-	if len(m.RepeatedAny) > 0 {
-		for _, e := range m.RepeatedAny {
-			if size, ok := interface{}(e).(interface {
-				SizeVT() int
-			}); ok {
-				l = size.SizeVT()
-			} else {
-				l = proto.Size(e)
-			}
-			n += 2 + l + sov(uint64(l))
-		}
+	if this.FIELD_NAME11 != that.FIELD_NAME11 {
+		return false
 	}
-	if len(m.RepeatedValue) > 0 {
-		for _, e := range m.RepeatedValue {
-			if size, ok := interface{}(e).(interface {
-				SizeVT() int
-			}); ok {
-				l = size.SizeVT()
-			} else {
-				l = proto.Size(e)
-			}
-			n += 2 + l + sov(uint64(l))
-		}
+	if this.FIELDName12 != that.FIELDName12 {
+		return false
 	}
-	if len(m.RepeatedListValue) > 0 {
-		for _, e := range m.RepeatedListValue {
-			if size, ok := interface{}(e).(interface {
-				SizeVT() int
-			}); ok {
-				l = size.SizeVT()
-			} else {
-				l = proto.Size(e)
-			}
-			n += 2 + l + sov(uint64(l))
-		}
+	if this.XFieldName13 != that.XFieldName13 {
+		return false
 	}
-	if len(m.RepeatedStruct) > 0 {
-		for _, e := range m.RepeatedStruct {
-			if size, ok := interface{}(e).(interface {
-				SizeVT() int
-			}); ok {
-				l = size.SizeVT()
-			} else {
-				l = proto.Size(e)
-			}
-			n += 2 + l + sov(uint64(l))
-		}
+	if this.X_FieldName14 != that.X_FieldName14 {
+		return false
 	}
-	if m.Fieldname1 != 0 {
-		n += 2 + sov(uint64(m.Fieldname1))
+	if this.Field_Name15 != that.Field_Name15 {
+		return false
 	}
-	if m.FieldName2 != 0 {
-		n += 2 + sov(uint64(m.FieldName2))
+	if this.Field__Name16 != that.Field__Name16 {
+		return false
 	}
-	if m.XFieldName3 != 0 {
-		n += 2 + sov(uint64(m.XFieldName3))
+	if this.FieldName17__ != that.FieldName17__ {
+		return false
 	}
-	if m.Field_Name4_ != 0 {
-		n += 2 + sov(uint64(m.Field_Name4_))
+	if this.FieldName18__ != that.FieldName18__ {
+		return false
 	}
-	if m.Field0Name5 != 0 {
-		n += 2 + sov(uint64(m.Field0Name5))
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *TestAllTypesProto3) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*TestAllTypesProto3)
+	if !ok {
+		return false
 	}
-	if m.Field_0Name6 != 0 {
-		n += 2 + sov(uint64(m.Field_0Name6))
+	return this.EqualVT(that)
+}
+
+func (this *TestAllTypesProto3_OneofUint32) EqualVT(thatIface isTestAllTypesProto3_OneofField) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *TestAllTypesProto3_OneofUint32) EqualVTWithOptions(thatIface isTestAllTypesProto3_OneofField, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*TestAllTypesProto3_OneofUint32)
+	if !ok {
+		return false
 	}
-	if m.FieldName7 != 0 {
-		n += 2 + sov(uint64(m.FieldName7))
+	if this == that {
+		return true
 	}
-	if m.FieldName8 != 0 {
-		n += 2 + sov(uint64(m.FieldName8))
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
 	}
-	if m.Field_Name9 != 0 {
-		n += 2 + sov(uint64(m.Field_Name9))
+	if this.OneofUint32 != that.OneofUint32 {
+		return false
 	}
-	if m.Field_Name10 != 0 {
-		n += 2 + sov(uint64(m.Field_Name10))
+	return true
+}
+
+func (this *TestAllTypesProto3_OneofNestedMessage) EqualVT(thatIface isTestAllTypesProto3_OneofField) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *TestAllTypesProto3_OneofNestedMessage) EqualVTWithOptions(thatIface isTestAllTypesProto3_OneofField, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*TestAllTypesProto3_OneofNestedMessage)
+	if !ok {
+		return false
 	}
-	if m.FIELD_NAME11 != 0 {
-		n += 2 + sov(uint64(m.FIELD_NAME11))
+	if this == that {
+		return true
 	}
-	if m.FIELDName12 != 0 {
-		n += 2 + sov(uint64(m.FIELDName12))
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
 	}
-	if m.XFieldName13 != 0 {
-		n += 2 + sov(uint64(m.XFieldName13))
+	if p, q := this.OneofNestedMessage, that.OneofNestedMessage; p != q {
+		if p == nil {
+			p = &TestAllTypesProto3_NestedMessage{}
+		}
+		if q == nil {
+			q = &TestAllTypesProto3_NestedMessage{}
+		}
+		if !p.EqualVTWithOptions(q, opts) {
+			return false
+		}
 	}
-	if m.X_FieldName14 != 0 {
-		n += 2 + sov(uint64(m.X_FieldName14))
+	return true
+}
+
+func (this *TestAllTypesProto3_OneofString) EqualVT(thatIface isTestAllTypesProto3_OneofField) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *TestAllTypesProto3_OneofString) EqualVTWithOptions(thatIface isTestAllTypesProto3_OneofField, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*TestAllTypesProto3_OneofString)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.OneofString != that.OneofString {
+		return false
+	}
+	return true
+}
+
+func (this *TestAllTypesProto3_OneofBytes) EqualVT(thatIface isTestAllTypesProto3_OneofField) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *TestAllTypesProto3_OneofBytes) EqualVTWithOptions(thatIface isTestAllTypesProto3_OneofField, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*TestAllTypesProto3_OneofBytes)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if string(this.OneofBytes) != string(that.OneofBytes) {
+		return false
+	}
+	return true
+}
+
+func (this *TestAllTypesProto3_OneofBool) EqualVT(thatIface isTestAllTypesProto3_OneofField) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *TestAllTypesProto3_OneofBool) EqualVTWithOptions(thatIface isTestAllTypesProto3_OneofField, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*TestAllTypesProto3_OneofBool)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.OneofBool != that.OneofBool {
+		return false
+	}
+	return true
+}
+
+func (this *TestAllTypesProto3_OneofUint64) EqualVT(thatIface isTestAllTypesProto3_OneofField) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *TestAllTypesProto3_OneofUint64) EqualVTWithOptions(thatIface isTestAllTypesProto3_OneofField, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*TestAllTypesProto3_OneofUint64)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.OneofUint64 != that.OneofUint64 {
+		return false
+	}
+	return true
+}
+
+func (this *TestAllTypesProto3_OneofFloat) EqualVT(thatIface isTestAllTypesProto3_OneofField) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *TestAllTypesProto3_OneofFloat) EqualVTWithOptions(thatIface isTestAllTypesProto3_OneofField, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*TestAllTypesProto3_OneofFloat)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if !opts.EqualFloat32(this.OneofFloat, that.OneofFloat) {
+		return false
+	}
+	return true
+}
+
+func (this *TestAllTypesProto3_OneofDouble) EqualVT(thatIface isTestAllTypesProto3_OneofField) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *TestAllTypesProto3_OneofDouble) EqualVTWithOptions(thatIface isTestAllTypesProto3_OneofField, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*TestAllTypesProto3_OneofDouble)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if !opts.EqualFloat64(this.OneofDouble, that.OneofDouble) {
+		return false
+	}
+	return true
+}
+
+func (this *TestAllTypesProto3_OneofEnum) EqualVT(thatIface isTestAllTypesProto3_OneofField) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *TestAllTypesProto3_OneofEnum) EqualVTWithOptions(thatIface isTestAllTypesProto3_OneofField, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*TestAllTypesProto3_OneofEnum)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.OneofEnum != that.OneofEnum {
+		return false
+	}
+	return true
+}
+
+func (this *TestAllTypesProto3_OneofNullValue) EqualVT(thatIface isTestAllTypesProto3_OneofField) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *TestAllTypesProto3_OneofNullValue) EqualVTWithOptions(thatIface isTestAllTypesProto3_OneofField, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*TestAllTypesProto3_OneofNullValue)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.OneofNullValue != that.OneofNullValue {
+		return false
+	}
+	return true
+}
+
+func (this *ForeignMessage) EqualVT(that *ForeignMessage) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *ForeignMessage) EqualVTWithOptions(that *ForeignMessage, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	if this.C != that.C {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *ForeignMessage) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*ForeignMessage)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *NullHypothesisProto3) EqualVT(that *NullHypothesisProto3) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *NullHypothesisProto3) EqualVTWithOptions(that *NullHypothesisProto3, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *NullHypothesisProto3) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*NullHypothesisProto3)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *EnumOnlyProto3) EqualVT(that *EnumOnlyProto3) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *EnumOnlyProto3) EqualVTWithOptions(that *EnumOnlyProto3, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *EnumOnlyProto3) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*EnumOnlyProto3)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (m *TestAllTypesProto3_NestedMessage) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *TestAllTypesProto3_NestedMessage) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	if m.A != 0 {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(m.A))
+	}
+	if m.Corecursive != nil {
+		protohelpers.HashUint64(h, s, 2)
+		m.Corecursive.hashVT(h, s)
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *TestAllTypesProto3) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *TestAllTypesProto3) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	var entry hash.Hash64
+	if m.OptionalInt32 != 0 {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(m.OptionalInt32))
+	}
+	if m.OptionalInt64 != 0 {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashUint64(h, s, uint64(m.OptionalInt64))
+	}
+	if m.OptionalUint32 != 0 {
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(m.OptionalUint32))
+	}
+	if m.OptionalUint64 != 0 {
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashUint64(h, s, uint64(m.OptionalUint64))
+	}
+	if m.OptionalSint32 != 0 {
+		protohelpers.HashUint64(h, s, 5)
+		protohelpers.HashUint64(h, s, uint64(m.OptionalSint32))
+	}
+	if m.OptionalSint64 != 0 {
+		protohelpers.HashUint64(h, s, 6)
+		protohelpers.HashUint64(h, s, uint64(m.OptionalSint64))
+	}
+	if m.OptionalFixed32 != 0 {
+		protohelpers.HashUint64(h, s, 7)
+		protohelpers.HashUint64(h, s, uint64(m.OptionalFixed32))
+	}
+	if m.OptionalFixed64 != 0 {
+		protohelpers.HashUint64(h, s, 8)
+		protohelpers.HashUint64(h, s, uint64(m.OptionalFixed64))
+	}
+	if m.OptionalSfixed32 != 0 {
+		protohelpers.HashUint64(h, s, 9)
+		protohelpers.HashUint64(h, s, uint64(m.OptionalSfixed32))
+	}
+	if m.OptionalSfixed64 != 0 {
+		protohelpers.HashUint64(h, s, 10)
+		protohelpers.HashUint64(h, s, uint64(m.OptionalSfixed64))
+	}
+	if m.OptionalFloat != 0 {
+		protohelpers.HashUint64(h, s, 11)
+		protohelpers.HashFloat32(h, s, m.OptionalFloat)
+	}
+	if m.OptionalDouble != 0 {
+		protohelpers.HashUint64(h, s, 12)
+		protohelpers.HashFloat64(h, s, m.OptionalDouble)
+	}
+	if m.OptionalBool {
+		protohelpers.HashUint64(h, s, 13)
+		protohelpers.HashBool(h, s, m.OptionalBool)
+	}
+	if m.OptionalString != "" {
+		protohelpers.HashUint64(h, s, 14)
+		protohelpers.HashString(h, s, m.OptionalString)
+	}
+	if len(m.OptionalBytes) > 0 {
+		protohelpers.HashUint64(h, s, 15)
+		protohelpers.HashBytes(h, s, m.OptionalBytes)
+	}
+	if m.OptionalNestedMessage != nil {
+		protohelpers.HashUint64(h, s, 18)
+		m.OptionalNestedMessage.hashVT(h, s)
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.OptionalForeignMessage != nil {
+		protohelpers.HashUint64(h, s, 19)
+		m.OptionalForeignMessage.hashVT(h, s)
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.OptionalNestedEnum != 0 {
+		protohelpers.HashUint64(h, s, 21)
+		protohelpers.HashUint64(h, s, uint64(m.OptionalNestedEnum))
+	}
+	if m.OptionalForeignEnum != 0 {
+		protohelpers.HashUint64(h, s, 22)
+		protohelpers.HashUint64(h, s, uint64(m.OptionalForeignEnum))
+	}
+	if m.OptionalAliasedEnum != 0 {
+		protohelpers.HashUint64(h, s, 23)
+		protohelpers.HashUint64(h, s, uint64(m.OptionalAliasedEnum))
+	}
+	if m.OptionalStringPiece != "" {
+		protohelpers.HashUint64(h, s, 24)
+		protohelpers.HashString(h, s, m.OptionalStringPiece)
+	}
+	if m.OptionalCord != "" {
+		protohelpers.HashUint64(h, s, 25)
+		protohelpers.HashString(h, s, m.OptionalCord)
+	}
+	if m.RecursiveMessage != nil {
+		protohelpers.HashUint64(h, s, 27)
+		m.RecursiveMessage.hashVT(h, s)
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if len(m.RepeatedInt32) > 0 {
+		protohelpers.HashUint64(h, s, 31)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedInt32)))
+		for _, v := range m.RepeatedInt32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.RepeatedInt64) > 0 {
+		protohelpers.HashUint64(h, s, 32)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedInt64)))
+		for _, v := range m.RepeatedInt64 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.RepeatedUint32) > 0 {
+		protohelpers.HashUint64(h, s, 33)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedUint32)))
+		for _, v := range m.RepeatedUint32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.RepeatedUint64) > 0 {
+		protohelpers.HashUint64(h, s, 34)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedUint64)))
+		for _, v := range m.RepeatedUint64 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.RepeatedSint32) > 0 {
+		protohelpers.HashUint64(h, s, 35)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedSint32)))
+		for _, v := range m.RepeatedSint32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.RepeatedSint64) > 0 {
+		protohelpers.HashUint64(h, s, 36)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedSint64)))
+		for _, v := range m.RepeatedSint64 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.RepeatedFixed32) > 0 {
+		protohelpers.HashUint64(h, s, 37)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedFixed32)))
+		for _, v := range m.RepeatedFixed32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.RepeatedFixed64) > 0 {
+		protohelpers.HashUint64(h, s, 38)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedFixed64)))
+		for _, v := range m.RepeatedFixed64 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.RepeatedSfixed32) > 0 {
+		protohelpers.HashUint64(h, s, 39)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedSfixed32)))
+		for _, v := range m.RepeatedSfixed32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.RepeatedSfixed64) > 0 {
+		protohelpers.HashUint64(h, s, 40)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedSfixed64)))
+		for _, v := range m.RepeatedSfixed64 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.RepeatedFloat) > 0 {
+		protohelpers.HashUint64(h, s, 41)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedFloat)))
+		for _, v := range m.RepeatedFloat {
+			protohelpers.HashFloat32(h, s, v)
+		}
+	}
+	if len(m.RepeatedDouble) > 0 {
+		protohelpers.HashUint64(h, s, 42)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedDouble)))
+		for _, v := range m.RepeatedDouble {
+			protohelpers.HashFloat64(h, s, v)
+		}
+	}
+	if len(m.RepeatedBool) > 0 {
+		protohelpers.HashUint64(h, s, 43)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedBool)))
+		for _, v := range m.RepeatedBool {
+			protohelpers.HashBool(h, s, v)
+		}
+	}
+	if len(m.RepeatedString) > 0 {
+		protohelpers.HashUint64(h, s, 44)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedString)))
+		for _, v := range m.RepeatedString {
+			protohelpers.HashString(h, s, v)
+		}
+	}
+	if len(m.RepeatedBytes) > 0 {
+		protohelpers.HashUint64(h, s, 45)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedBytes)))
+		for _, v := range m.RepeatedBytes {
+			protohelpers.HashBytes(h, s, v)
+		}
+	}
+	if len(m.RepeatedNestedMessage) > 0 {
+		protohelpers.HashUint64(h, s, 48)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedNestedMessage)))
+		for _, v := range m.RepeatedNestedMessage {
+			v.hashVT(h, s)
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if len(m.RepeatedForeignMessage) > 0 {
+		protohelpers.HashUint64(h, s, 49)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedForeignMessage)))
+		for _, v := range m.RepeatedForeignMessage {
+			v.hashVT(h, s)
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if len(m.RepeatedNestedEnum) > 0 {
+		protohelpers.HashUint64(h, s, 51)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedNestedEnum)))
+		for _, v := range m.RepeatedNestedEnum {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.RepeatedForeignEnum) > 0 {
+		protohelpers.HashUint64(h, s, 52)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedForeignEnum)))
+		for _, v := range m.RepeatedForeignEnum {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.RepeatedStringPiece) > 0 {
+		protohelpers.HashUint64(h, s, 54)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedStringPiece)))
+		for _, v := range m.RepeatedStringPiece {
+			protohelpers.HashString(h, s, v)
+		}
+	}
+	if len(m.RepeatedCord) > 0 {
+		protohelpers.HashUint64(h, s, 55)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedCord)))
+		for _, v := range m.RepeatedCord {
+			protohelpers.HashString(h, s, v)
+		}
+	}
+	if len(m.MapInt32Int32) > 0 {
+		protohelpers.HashUint64(h, s, 56)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapInt32Int32)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.MapInt32Int32 {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.MapInt64Int64) > 0 {
+		protohelpers.HashUint64(h, s, 57)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapInt64Int64)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.MapInt64Int64 {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.MapUint32Uint32) > 0 {
+		protohelpers.HashUint64(h, s, 58)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapUint32Uint32)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.MapUint32Uint32 {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.MapUint64Uint64) > 0 {
+		protohelpers.HashUint64(h, s, 59)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapUint64Uint64)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.MapUint64Uint64 {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.MapSint32Sint32) > 0 {
+		protohelpers.HashUint64(h, s, 60)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapSint32Sint32)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.MapSint32Sint32 {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.MapSint64Sint64) > 0 {
+		protohelpers.HashUint64(h, s, 61)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapSint64Sint64)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.MapSint64Sint64 {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.MapFixed32Fixed32) > 0 {
+		protohelpers.HashUint64(h, s, 62)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapFixed32Fixed32)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.MapFixed32Fixed32 {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.MapFixed64Fixed64) > 0 {
+		protohelpers.HashUint64(h, s, 63)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapFixed64Fixed64)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.MapFixed64Fixed64 {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.MapSfixed32Sfixed32) > 0 {
+		protohelpers.HashUint64(h, s, 64)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapSfixed32Sfixed32)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.MapSfixed32Sfixed32 {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.MapSfixed64Sfixed64) > 0 {
+		protohelpers.HashUint64(h, s, 65)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapSfixed64Sfixed64)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.MapSfixed64Sfixed64 {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.MapInt32Float) > 0 {
+		protohelpers.HashUint64(h, s, 66)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapInt32Float)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.MapInt32Float {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashFloat32(entry, s, v)
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.MapInt32Double) > 0 {
+		protohelpers.HashUint64(h, s, 67)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapInt32Double)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.MapInt32Double {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashFloat64(entry, s, v)
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.MapBoolBool) > 0 {
+		protohelpers.HashUint64(h, s, 68)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapBoolBool)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.MapBoolBool {
+			entry.Reset()
+			protohelpers.HashBool(entry, s, k)
+			protohelpers.HashBool(entry, s, v)
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.MapStringString) > 0 {
+		protohelpers.HashUint64(h, s, 69)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapStringString)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.MapStringString {
+			entry.Reset()
+			protohelpers.HashString(entry, s, k)
+			protohelpers.HashString(entry, s, v)
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.MapStringBytes) > 0 {
+		protohelpers.HashUint64(h, s, 70)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapStringBytes)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.MapStringBytes {
+			entry.Reset()
+			protohelpers.HashString(entry, s, k)
+			protohelpers.HashBytes(entry, s, v)
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.MapStringNestedMessage) > 0 {
+		protohelpers.HashUint64(h, s, 71)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapStringNestedMessage)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.MapStringNestedMessage {
+			entry.Reset()
+			protohelpers.HashString(entry, s, k)
+			v.hashVT(entry, s)
+			protohelpers.HashUint64(entry, s, 0)
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.MapStringForeignMessage) > 0 {
+		protohelpers.HashUint64(h, s, 72)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapStringForeignMessage)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.MapStringForeignMessage {
+			entry.Reset()
+			protohelpers.HashString(entry, s, k)
+			v.hashVT(entry, s)
+			protohelpers.HashUint64(entry, s, 0)
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.MapStringNestedEnum) > 0 {
+		protohelpers.HashUint64(h, s, 73)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapStringNestedEnum)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.MapStringNestedEnum {
+			entry.Reset()
+			protohelpers.HashString(entry, s, k)
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.MapStringForeignEnum) > 0 {
+		protohelpers.HashUint64(h, s, 74)
+		protohelpers.HashUint64(h, s, uint64(len(m.MapStringForeignEnum)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.MapStringForeignEnum {
+			entry.Reset()
+			protohelpers.HashString(entry, s, k)
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.PackedInt32) > 0 {
+		protohelpers.HashUint64(h, s, 75)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedInt32)))
+		for _, v := range m.PackedInt32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.PackedInt64) > 0 {
+		protohelpers.HashUint64(h, s, 76)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedInt64)))
+		for _, v := range m.PackedInt64 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.PackedUint32) > 0 {
+		protohelpers.HashUint64(h, s, 77)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedUint32)))
+		for _, v := range m.PackedUint32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.PackedUint64) > 0 {
+		protohelpers.HashUint64(h, s, 78)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedUint64)))
+		for _, v := range m.PackedUint64 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.PackedSint32) > 0 {
+		protohelpers.HashUint64(h, s, 79)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedSint32)))
+		for _, v := range m.PackedSint32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.PackedSint64) > 0 {
+		protohelpers.HashUint64(h, s, 80)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedSint64)))
+		for _, v := range m.PackedSint64 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.PackedFixed32) > 0 {
+		protohelpers.HashUint64(h, s, 81)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedFixed32)))
+		for _, v := range m.PackedFixed32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.PackedFixed64) > 0 {
+		protohelpers.HashUint64(h, s, 82)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedFixed64)))
+		for _, v := range m.PackedFixed64 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.PackedSfixed32) > 0 {
+		protohelpers.HashUint64(h, s, 83)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedSfixed32)))
+		for _, v := range m.PackedSfixed32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.PackedSfixed64) > 0 {
+		protohelpers.HashUint64(h, s, 84)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedSfixed64)))
+		for _, v := range m.PackedSfixed64 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.PackedFloat) > 0 {
+		protohelpers.HashUint64(h, s, 85)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedFloat)))
+		for _, v := range m.PackedFloat {
+			protohelpers.HashFloat32(h, s, v)
+		}
+	}
+	if len(m.PackedDouble) > 0 {
+		protohelpers.HashUint64(h, s, 86)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedDouble)))
+		for _, v := range m.PackedDouble {
+			protohelpers.HashFloat64(h, s, v)
+		}
+	}
+	if len(m.PackedBool) > 0 {
+		protohelpers.HashUint64(h, s, 87)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedBool)))
+		for _, v := range m.PackedBool {
+			protohelpers.HashBool(h, s, v)
+		}
+	}
+	if len(m.PackedNestedEnum) > 0 {
+		protohelpers.HashUint64(h, s, 88)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedNestedEnum)))
+		for _, v := range m.PackedNestedEnum {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.UnpackedInt32) > 0 {
+		protohelpers.HashUint64(h, s, 89)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedInt32)))
+		for _, v := range m.UnpackedInt32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.UnpackedInt64) > 0 {
+		protohelpers.HashUint64(h, s, 90)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedInt64)))
+		for _, v := range m.UnpackedInt64 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.UnpackedUint32) > 0 {
+		protohelpers.HashUint64(h, s, 91)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedUint32)))
+		for _, v := range m.UnpackedUint32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.UnpackedUint64) > 0 {
+		protohelpers.HashUint64(h, s, 92)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedUint64)))
+		for _, v := range m.UnpackedUint64 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.UnpackedSint32) > 0 {
+		protohelpers.HashUint64(h, s, 93)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedSint32)))
+		for _, v := range m.UnpackedSint32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.UnpackedSint64) > 0 {
+		protohelpers.HashUint64(h, s, 94)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedSint64)))
+		for _, v := range m.UnpackedSint64 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.UnpackedFixed32) > 0 {
+		protohelpers.HashUint64(h, s, 95)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedFixed32)))
+		for _, v := range m.UnpackedFixed32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.UnpackedFixed64) > 0 {
+		protohelpers.HashUint64(h, s, 96)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedFixed64)))
+		for _, v := range m.UnpackedFixed64 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.UnpackedSfixed32) > 0 {
+		protohelpers.HashUint64(h, s, 97)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedSfixed32)))
+		for _, v := range m.UnpackedSfixed32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.UnpackedSfixed64) > 0 {
+		protohelpers.HashUint64(h, s, 98)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedSfixed64)))
+		for _, v := range m.UnpackedSfixed64 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.UnpackedFloat) > 0 {
+		protohelpers.HashUint64(h, s, 99)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedFloat)))
+		for _, v := range m.UnpackedFloat {
+			protohelpers.HashFloat32(h, s, v)
+		}
+	}
+	if len(m.UnpackedDouble) > 0 {
+		protohelpers.HashUint64(h, s, 100)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedDouble)))
+		for _, v := range m.UnpackedDouble {
+			protohelpers.HashFloat64(h, s, v)
+		}
+	}
+	if len(m.UnpackedBool) > 0 {
+		protohelpers.HashUint64(h, s, 101)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedBool)))
+		for _, v := range m.UnpackedBool {
+			protohelpers.HashBool(h, s, v)
+		}
+	}
+	if len(m.UnpackedNestedEnum) > 0 {
+		protohelpers.HashUint64(h, s, 102)
+		protohelpers.HashUint64(h, s, uint64(len(m.UnpackedNestedEnum)))
+		for _, v := range m.UnpackedNestedEnum {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	switch c := m.OneofField.(type) {
+	case *TestAllTypesProto3_OneofUint32:
+		protohelpers.HashUint64(h, s, 111)
+		protohelpers.HashUint64(h, s, uint64(c.OneofUint32))
+	case *TestAllTypesProto3_OneofNestedMessage:
+		protohelpers.HashUint64(h, s, 112)
+		c.OneofNestedMessage.hashVT(h, s)
+		protohelpers.HashUint64(h, s, 0)
+	case *TestAllTypesProto3_OneofString:
+		protohelpers.HashUint64(h, s, 113)
+		protohelpers.HashString(h, s, c.OneofString)
+	case *TestAllTypesProto3_OneofBytes:
+		protohelpers.HashUint64(h, s, 114)
+		protohelpers.HashBytes(h, s, c.OneofBytes)
+	case *TestAllTypesProto3_OneofBool:
+		protohelpers.HashUint64(h, s, 115)
+		protohelpers.HashBool(h, s, c.OneofBool)
+	case *TestAllTypesProto3_OneofUint64:
+		protohelpers.HashUint64(h, s, 116)
+		protohelpers.HashUint64(h, s, uint64(c.OneofUint64))
+	case *TestAllTypesProto3_OneofFloat:
+		protohelpers.HashUint64(h, s, 117)
+		protohelpers.HashFloat32(h, s, c.OneofFloat)
+	case *TestAllTypesProto3_OneofDouble:
+		protohelpers.HashUint64(h, s, 118)
+		protohelpers.HashFloat64(h, s, c.OneofDouble)
+	case *TestAllTypesProto3_OneofEnum:
+		protohelpers.HashUint64(h, s, 119)
+		protohelpers.HashUint64(h, s, uint64(c.OneofEnum))
+	case *TestAllTypesProto3_OneofNullValue:
+		protohelpers.HashUint64(h, s, 120)
+		protohelpers.HashUint64(h, s, uint64(c.OneofNullValue))
+	}
+	if m.OptionalBoolWrapper != nil {
+		protohelpers.HashUint64(h, s, 201)
+		if vtpb, ok := interface{}(m.OptionalBoolWrapper).(interface{ HashVT(hash.Hash64) }); ok {
+			vtpb.HashVT(h)
+		} else {
+			protohelpers.HashMessage(h, m.OptionalBoolWrapper)
+		}
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.OptionalInt32Wrapper != nil {
+		protohelpers.HashUint64(h, s, 202)
+		if vtpb, ok := interface{}(m.OptionalInt32Wrapper).(interface{ HashVT(hash.Hash64) }); ok {
+			vtpb.HashVT(h)
+		} else {
+			protohelpers.HashMessage(h, m.OptionalInt32Wrapper)
+		}
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.OptionalInt64Wrapper != nil {
+		protohelpers.HashUint64(h, s, 203)
+		if vtpb, ok := interface{}(m.OptionalInt64Wrapper).(interface{ HashVT(hash.Hash64) }); ok {
+			vtpb.HashVT(h)
+		} else {
+			protohelpers.HashMessage(h, m.OptionalInt64Wrapper)
+		}
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.OptionalUint32Wrapper != nil {
+		protohelpers.HashUint64(h, s, 204)
+		if vtpb, ok := interface{}(m.OptionalUint32Wrapper).(interface{ HashVT(hash.Hash64) }); ok {
+			vtpb.HashVT(h)
+		} else {
+			protohelpers.HashMessage(h, m.OptionalUint32Wrapper)
+		}
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.OptionalUint64Wrapper != nil {
+		protohelpers.HashUint64(h, s, 205)
+		if vtpb, ok := interface{}(m.OptionalUint64Wrapper).(interface{ HashVT(hash.Hash64) }); ok {
+			vtpb.HashVT(h)
+		} else {
+			protohelpers.HashMessage(h, m.OptionalUint64Wrapper)
+		}
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.OptionalFloatWrapper != nil {
+		protohelpers.HashUint64(h, s, 206)
+		if vtpb, ok := interface{}(m.OptionalFloatWrapper).(interface{ HashVT(hash.Hash64) }); ok {
+			vtpb.HashVT(h)
+		} else {
+			protohelpers.HashMessage(h, m.OptionalFloatWrapper)
+		}
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.OptionalDoubleWrapper != nil {
+		protohelpers.HashUint64(h, s, 207)
+		if vtpb, ok := interface{}(m.OptionalDoubleWrapper).(interface{ HashVT(hash.Hash64) }); ok {
+			vtpb.HashVT(h)
+		} else {
+			protohelpers.HashMessage(h, m.OptionalDoubleWrapper)
+		}
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.OptionalStringWrapper != nil {
+		protohelpers.HashUint64(h, s, 208)
+		if vtpb, ok := interface{}(m.OptionalStringWrapper).(interface{ HashVT(hash.Hash64) }); ok {
+			vtpb.HashVT(h)
+		} else {
+			protohelpers.HashMessage(h, m.OptionalStringWrapper)
+		}
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.OptionalBytesWrapper != nil {
+		protohelpers.HashUint64(h, s, 209)
+		if vtpb, ok := interface{}(m.OptionalBytesWrapper).(interface{ HashVT(hash.Hash64) }); ok {
+			vtpb.HashVT(h)
+		} else {
+			protohelpers.HashMessage(h, m.OptionalBytesWrapper)
+		}
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if len(m.RepeatedBoolWrapper) > 0 {
+		protohelpers.HashUint64(h, s, 211)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedBoolWrapper)))
+		for _, v := range m.RepeatedBoolWrapper {
+			if vtpb, ok := interface{}(v).(interface{ HashVT(hash.Hash64) }); ok {
+				vtpb.HashVT(h)
+			} else {
+				protohelpers.HashMessage(h, v)
+			}
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if len(m.RepeatedInt32Wrapper) > 0 {
+		protohelpers.HashUint64(h, s, 212)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedInt32Wrapper)))
+		for _, v := range m.RepeatedInt32Wrapper {
+			if vtpb, ok := interface{}(v).(interface{ HashVT(hash.Hash64) }); ok {
+				vtpb.HashVT(h)
+			} else {
+				protohelpers.HashMessage(h, v)
+			}
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if len(m.RepeatedInt64Wrapper) > 0 {
+		protohelpers.HashUint64(h, s, 213)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedInt64Wrapper)))
+		for _, v := range m.RepeatedInt64Wrapper {
+			if vtpb, ok := interface{}(v).(interface{ HashVT(hash.Hash64) }); ok {
+				vtpb.HashVT(h)
+			} else {
+				protohelpers.HashMessage(h, v)
+			}
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if len(m.RepeatedUint32Wrapper) > 0 {
+		protohelpers.HashUint64(h, s, 214)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedUint32Wrapper)))
+		for _, v := range m.RepeatedUint32Wrapper {
+			if vtpb, ok := interface{}(v).(interface{ HashVT(hash.Hash64) }); ok {
+				vtpb.HashVT(h)
+			} else {
+				protohelpers.HashMessage(h, v)
+			}
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if len(m.RepeatedUint64Wrapper) > 0 {
+		protohelpers.HashUint64(h, s, 215)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedUint64Wrapper)))
+		for _, v := range m.RepeatedUint64Wrapper {
+			if vtpb, ok := interface{}(v).(interface{ HashVT(hash.Hash64) }); ok {
+				vtpb.HashVT(h)
+			} else {
+				protohelpers.HashMessage(h, v)
+			}
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if len(m.RepeatedFloatWrapper) > 0 {
+		protohelpers.HashUint64(h, s, 216)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedFloatWrapper)))
+		for _, v := range m.RepeatedFloatWrapper {
+			if vtpb, ok := interface{}(v).(interface{ HashVT(hash.Hash64) }); ok {
+				vtpb.HashVT(h)
+			} else {
+				protohelpers.HashMessage(h, v)
+			}
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if len(m.RepeatedDoubleWrapper) > 0 {
+		protohelpers.HashUint64(h, s, 217)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedDoubleWrapper)))
+		for _, v := range m.RepeatedDoubleWrapper {
+			if vtpb, ok := interface{}(v).(interface{ HashVT(hash.Hash64) }); ok {
+				vtpb.HashVT(h)
+			} else {
+				protohelpers.HashMessage(h, v)
+			}
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if len(m.RepeatedStringWrapper) > 0 {
+		protohelpers.HashUint64(h, s, 218)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedStringWrapper)))
+		for _, v := range m.RepeatedStringWrapper {
+			if vtpb, ok := interface{}(v).(interface{ HashVT(hash.Hash64) }); ok {
+				vtpb.HashVT(h)
+			} else {
+				protohelpers.HashMessage(h, v)
+			}
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if len(m.RepeatedBytesWrapper) > 0 {
+		protohelpers.HashUint64(h, s, 219)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedBytesWrapper)))
+		for _, v := range m.RepeatedBytesWrapper {
+			if vtpb, ok := interface{}(v).(interface{ HashVT(hash.Hash64) }); ok {
+				vtpb.HashVT(h)
+			} else {
+				protohelpers.HashMessage(h, v)
+			}
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if m.OptionalDuration != nil {
+		protohelpers.HashUint64(h, s, 301)
+		if vtpb, ok := interface{}(m.OptionalDuration).(interface{ HashVT(hash.Hash64) }); ok {
+			vtpb.HashVT(h)
+		} else {
+			protohelpers.HashMessage(h, m.OptionalDuration)
+		}
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.OptionalTimestamp != nil {
+		protohelpers.HashUint64(h, s, 302)
+		if vtpb, ok := interface{}(m.OptionalTimestamp).(interface{ HashVT(hash.Hash64) }); ok {
+			vtpb.HashVT(h)
+		} else {
+			protohelpers.HashMessage(h, m.OptionalTimestamp)
+		}
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.OptionalFieldMask != nil {
+		protohelpers.HashUint64(h, s, 303)
+		if vtpb, ok := interface{}(m.OptionalFieldMask).(interface{ HashVT(hash.Hash64) }); ok {
+			vtpb.HashVT(h)
+		} else {
+			protohelpers.HashMessage(h, m.OptionalFieldMask)
+		}
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.OptionalStruct != nil {
+		protohelpers.HashUint64(h, s, 304)
+		if vtpb, ok := interface{}(m.OptionalStruct).(interface{ HashVT(hash.Hash64) }); ok {
+			vtpb.HashVT(h)
+		} else {
+			protohelpers.HashMessage(h, m.OptionalStruct)
+		}
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.OptionalAny != nil {
+		protohelpers.HashUint64(h, s, 305)
+		if vtpb, ok := interface{}(m.OptionalAny).(interface{ HashVT(hash.Hash64) }); ok {
+			vtpb.HashVT(h)
+		} else {
+			protohelpers.HashMessage(h, m.OptionalAny)
+		}
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.OptionalValue != nil {
+		protohelpers.HashUint64(h, s, 306)
+		if vtpb, ok := interface{}(m.OptionalValue).(interface{ HashVT(hash.Hash64) }); ok {
+			vtpb.HashVT(h)
+		} else {
+			protohelpers.HashMessage(h, m.OptionalValue)
+		}
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.OptionalNullValue != 0 {
+		protohelpers.HashUint64(h, s, 307)
+		protohelpers.HashUint64(h, s, uint64(m.OptionalNullValue))
+	}
+	if len(m.RepeatedDuration) > 0 {
+		protohelpers.HashUint64(h, s, 311)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedDuration)))
+		for _, v := range m.RepeatedDuration {
+			if vtpb, ok := interface{}(v).(interface{ HashVT(hash.Hash64) }); ok {
+				vtpb.HashVT(h)
+			} else {
+				protohelpers.HashMessage(h, v)
+			}
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if len(m.RepeatedTimestamp) > 0 {
+		protohelpers.HashUint64(h, s, 312)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedTimestamp)))
+		for _, v := range m.RepeatedTimestamp {
+			if vtpb, ok := interface{}(v).(interface{ HashVT(hash.Hash64) }); ok {
+				vtpb.HashVT(h)
+			} else {
+				protohelpers.HashMessage(h, v)
+			}
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if len(m.RepeatedFieldmask) > 0 {
+		protohelpers.HashUint64(h, s, 313)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedFieldmask)))
+		for _, v := range m.RepeatedFieldmask {
+			if vtpb, ok := interface{}(v).(interface{ HashVT(hash.Hash64) }); ok {
+				vtpb.HashVT(h)
+			} else {
+				protohelpers.HashMessage(h, v)
+			}
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if len(m.RepeatedAny) > 0 {
+		protohelpers.HashUint64(h, s, 315)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedAny)))
+		for _, v := range m.RepeatedAny {
+			if vtpb, ok := interface{}(v).(interface{ HashVT(hash.Hash64) }); ok {
+				vtpb.HashVT(h)
+			} else {
+				protohelpers.HashMessage(h, v)
+			}
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if len(m.RepeatedValue) > 0 {
+		protohelpers.HashUint64(h, s, 316)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedValue)))
+		for _, v := range m.RepeatedValue {
+			if vtpb, ok := interface{}(v).(interface{ HashVT(hash.Hash64) }); ok {
+				vtpb.HashVT(h)
+			} else {
+				protohelpers.HashMessage(h, v)
+			}
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if len(m.RepeatedListValue) > 0 {
+		protohelpers.HashUint64(h, s, 317)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedListValue)))
+		for _, v := range m.RepeatedListValue {
+			if vtpb, ok := interface{}(v).(interface{ HashVT(hash.Hash64) }); ok {
+				vtpb.HashVT(h)
+			} else {
+				protohelpers.HashMessage(h, v)
+			}
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if len(m.RepeatedStruct) > 0 {
+		protohelpers.HashUint64(h, s, 324)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedStruct)))
+		for _, v := range m.RepeatedStruct {
+			if vtpb, ok := interface{}(v).(interface{ HashVT(hash.Hash64) }); ok {
+				vtpb.HashVT(h)
+			} else {
+				protohelpers.HashMessage(h, v)
+			}
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if m.Fieldname1 != 0 {
+		protohelpers.HashUint64(h, s, 401)
+		protohelpers.HashUint64(h, s, uint64(m.Fieldname1))
+	}
+	if m.FieldName2 != 0 {
+		protohelpers.HashUint64(h, s, 402)
+		protohelpers.HashUint64(h, s, uint64(m.FieldName2))
+	}
+	if m.XFieldName3 != 0 {
+		protohelpers.HashUint64(h, s, 403)
+		protohelpers.HashUint64(h, s, uint64(m.XFieldName3))
+	}
+	if m.Field_Name4_ != 0 {
+		protohelpers.HashUint64(h, s, 404)
+		protohelpers.HashUint64(h, s, uint64(m.Field_Name4_))
+	}
+	if m.Field0Name5 != 0 {
+		protohelpers.HashUint64(h, s, 405)
+		protohelpers.HashUint64(h, s, uint64(m.Field0Name5))
+	}
+	if m.Field_0Name6 != 0 {
+		protohelpers.HashUint64(h, s, 406)
+		protohelpers.HashUint64(h, s, uint64(m.Field_0Name6))
+	}
+	if m.FieldName7 != 0 {
+		protohelpers.HashUint64(h, s, 407)
+		protohelpers.HashUint64(h, s, uint64(m.FieldName7))
+	}
+	if m.FieldName8 != 0 {
+		protohelpers.HashUint64(h, s, 408)
+		protohelpers.HashUint64(h, s, uint64(m.FieldName8))
+	}
+	if m.Field_Name9 != 0 {
+		protohelpers.HashUint64(h, s, 409)
+		protohelpers.HashUint64(h, s, uint64(m.Field_Name9))
+	}
+	if m.Field_Name10 != 0 {
+		protohelpers.HashUint64(h, s, 410)
+		protohelpers.HashUint64(h, s, uint64(m.Field_Name10))
+	}
+	if m.FIELD_NAME11 != 0 {
+		protohelpers.HashUint64(h, s, 411)
+		protohelpers.HashUint64(h, s, uint64(m.FIELD_NAME11))
+	}
+	if m.FIELDName12 != 0 {
+		protohelpers.HashUint64(h, s, 412)
+		protohelpers.HashUint64(h, s, uint64(m.FIELDName12))
+	}
+	if m.XFieldName13 != 0 {
+		protohelpers.HashUint64(h, s, 413)
+		protohelpers.HashUint64(h, s, uint64(m.XFieldName13))
+	}
+	if m.X_FieldName14 != 0 {
+		protohelpers.HashUint64(h, s, 414)
+		protohelpers.HashUint64(h, s, uint64(m.X_FieldName14))
+	}
+	if m.Field_Name15 != 0 {
+		protohelpers.HashUint64(h, s, 415)
+		protohelpers.HashUint64(h, s, uint64(m.Field_Name15))
+	}
+	if m.Field__Name16 != 0 {
+		protohelpers.HashUint64(h, s, 416)
+		protohelpers.HashUint64(h, s, uint64(m.Field__Name16))
+	}
+	if m.FieldName17__ != 0 {
+		protohelpers.HashUint64(h, s, 417)
+		protohelpers.HashUint64(h, s, uint64(m.FieldName17__))
+	}
+	if m.FieldName18__ != 0 {
+		protohelpers.HashUint64(h, s, 418)
+		protohelpers.HashUint64(h, s, uint64(m.FieldName18__))
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *ForeignMessage) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *ForeignMessage) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	if m.C != 0 {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(m.C))
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *NullHypothesisProto3) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *NullHypothesisProto3) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *EnumOnlyProto3) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *EnumOnlyProto3) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *TestAllTypesProto3_NestedMessage) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *TestAllTypesProto3_NestedMessage) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &TestAllTypesProto3_NestedMessage{}
+	}
+	w.ObjectStart()
+	if m.A != 0 {
+		w.Field("a", "a")
+		w.Int32(m.A)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("a", "a")
+		w.Int32(m.GetA())
+	}
+	if m.Corecursive != nil {
+		w.Field("corecursive", "corecursive")
+		if err := m.Corecursive.MarshalJSONToVT(w); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("corecursive", "corecursive")
+		w.Null()
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *TestAllTypesProto3_NestedMessage) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *TestAllTypesProto3_NestedMessage) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [2]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "a":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.A = v
+		case "corecursive":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(TestAllTypesProto3)
+			if err := v.UnmarshalJSONFromVT(r); err != nil {
+				return err
+			}
+			m.Corecursive = v
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *TestAllTypesProto3) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *TestAllTypesProto3) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &TestAllTypesProto3{}
+	}
+	w.ObjectStart()
+	if m.OptionalInt32 != 0 {
+		w.Field("optionalInt32", "optional_int32")
+		w.Int32(m.OptionalInt32)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalInt32", "optional_int32")
+		w.Int32(m.GetOptionalInt32())
+	}
+	if m.OptionalInt64 != 0 {
+		w.Field("optionalInt64", "optional_int64")
+		w.Int64(m.OptionalInt64)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalInt64", "optional_int64")
+		w.Int64(m.GetOptionalInt64())
+	}
+	if m.OptionalUint32 != 0 {
+		w.Field("optionalUint32", "optional_uint32")
+		w.Uint32(m.OptionalUint32)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalUint32", "optional_uint32")
+		w.Uint32(m.GetOptionalUint32())
+	}
+	if m.OptionalUint64 != 0 {
+		w.Field("optionalUint64", "optional_uint64")
+		w.Uint64(m.OptionalUint64)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalUint64", "optional_uint64")
+		w.Uint64(m.GetOptionalUint64())
+	}
+	if m.OptionalSint32 != 0 {
+		w.Field("optionalSint32", "optional_sint32")
+		w.Int32(m.OptionalSint32)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalSint32", "optional_sint32")
+		w.Int32(m.GetOptionalSint32())
+	}
+	if m.OptionalSint64 != 0 {
+		w.Field("optionalSint64", "optional_sint64")
+		w.Int64(m.OptionalSint64)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalSint64", "optional_sint64")
+		w.Int64(m.GetOptionalSint64())
+	}
+	if m.OptionalFixed32 != 0 {
+		w.Field("optionalFixed32", "optional_fixed32")
+		w.Uint32(m.OptionalFixed32)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalFixed32", "optional_fixed32")
+		w.Uint32(m.GetOptionalFixed32())
+	}
+	if m.OptionalFixed64 != 0 {
+		w.Field("optionalFixed64", "optional_fixed64")
+		w.Uint64(m.OptionalFixed64)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalFixed64", "optional_fixed64")
+		w.Uint64(m.GetOptionalFixed64())
+	}
+	if m.OptionalSfixed32 != 0 {
+		w.Field("optionalSfixed32", "optional_sfixed32")
+		w.Int32(m.OptionalSfixed32)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalSfixed32", "optional_sfixed32")
+		w.Int32(m.GetOptionalSfixed32())
+	}
+	if m.OptionalSfixed64 != 0 {
+		w.Field("optionalSfixed64", "optional_sfixed64")
+		w.Int64(m.OptionalSfixed64)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalSfixed64", "optional_sfixed64")
+		w.Int64(m.GetOptionalSfixed64())
+	}
+	if m.OptionalFloat != 0 || math.Signbit(float64(m.OptionalFloat)) {
+		w.Field("optionalFloat", "optional_float")
+		w.Float32(m.OptionalFloat)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalFloat", "optional_float")
+		w.Float32(m.GetOptionalFloat())
+	}
+	if m.OptionalDouble != 0 || math.Signbit(float64(m.OptionalDouble)) {
+		w.Field("optionalDouble", "optional_double")
+		w.Float64(m.OptionalDouble)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalDouble", "optional_double")
+		w.Float64(m.GetOptionalDouble())
+	}
+	if m.OptionalBool {
+		w.Field("optionalBool", "optional_bool")
+		w.Bool(m.OptionalBool)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalBool", "optional_bool")
+		w.Bool(m.GetOptionalBool())
+	}
+	if m.OptionalString != "" {
+		w.Field("optionalString", "optional_string")
+		if err := w.String(m.OptionalString); err != nil {
+			return errors.New("proto: field protobuf_test_messages.proto3.TestAllTypesProto3.optional_string contains invalid UTF-8")
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalString", "optional_string")
+		if err := w.String(m.GetOptionalString()); err != nil {
+			return errors.New("proto: field protobuf_test_messages.proto3.TestAllTypesProto3.optional_string contains invalid UTF-8")
+		}
+	}
+	if len(m.OptionalBytes) > 0 {
+		w.Field("optionalBytes", "optional_bytes")
+		w.Base64(m.OptionalBytes)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalBytes", "optional_bytes")
+		w.Base64(m.GetOptionalBytes())
+	}
+	if m.OptionalNestedMessage != nil {
+		w.Field("optionalNestedMessage", "optional_nested_message")
+		if err := m.OptionalNestedMessage.MarshalJSONToVT(w); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalNestedMessage", "optional_nested_message")
+		w.Null()
+	}
+	if m.OptionalForeignMessage != nil {
+		w.Field("optionalForeignMessage", "optional_foreign_message")
+		if err := m.OptionalForeignMessage.MarshalJSONToVT(w); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalForeignMessage", "optional_foreign_message")
+		w.Null()
+	}
+	if m.OptionalNestedEnum != 0 {
+		w.Field("optionalNestedEnum", "optional_nested_enum")
+		w.Enum(int32(m.OptionalNestedEnum), TestAllTypesProto3_NestedEnum_name)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalNestedEnum", "optional_nested_enum")
+		w.Enum(int32(m.GetOptionalNestedEnum()), TestAllTypesProto3_NestedEnum_name)
+	}
+	if m.OptionalForeignEnum != 0 {
+		w.Field("optionalForeignEnum", "optional_foreign_enum")
+		w.Enum(int32(m.OptionalForeignEnum), ForeignEnum_name)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalForeignEnum", "optional_foreign_enum")
+		w.Enum(int32(m.GetOptionalForeignEnum()), ForeignEnum_name)
+	}
+	if m.OptionalAliasedEnum != 0 {
+		w.Field("optionalAliasedEnum", "optional_aliased_enum")
+		w.Enum(int32(m.OptionalAliasedEnum), TestAllTypesProto3_AliasedEnum_name)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalAliasedEnum", "optional_aliased_enum")
+		w.Enum(int32(m.GetOptionalAliasedEnum()), TestAllTypesProto3_AliasedEnum_name)
+	}
+	if m.OptionalStringPiece != "" {
+		w.Field("optionalStringPiece", "optional_string_piece")
+		if err := w.String(m.OptionalStringPiece); err != nil {
+			return errors.New("proto: field protobuf_test_messages.proto3.TestAllTypesProto3.optional_string_piece contains invalid UTF-8")
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalStringPiece", "optional_string_piece")
+		if err := w.String(m.GetOptionalStringPiece()); err != nil {
+			return errors.New("proto: field protobuf_test_messages.proto3.TestAllTypesProto3.optional_string_piece contains invalid UTF-8")
+		}
+	}
+	if m.OptionalCord != "" {
+		w.Field("optionalCord", "optional_cord")
+		if err := w.String(m.OptionalCord); err != nil {
+			return errors.New("proto: field protobuf_test_messages.proto3.TestAllTypesProto3.optional_cord contains invalid UTF-8")
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalCord", "optional_cord")
+		if err := w.String(m.GetOptionalCord()); err != nil {
+			return errors.New("proto: field protobuf_test_messages.proto3.TestAllTypesProto3.optional_cord contains invalid UTF-8")
+		}
+	}
+	if m.RecursiveMessage != nil {
+		w.Field("recursiveMessage", "recursive_message")
+		if err := m.RecursiveMessage.MarshalJSONToVT(w); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("recursiveMessage", "recursive_message")
+		w.Null()
+	}
+	if len(m.RepeatedInt32) > 0 {
+		w.Field("repeatedInt32", "repeated_int32")
+		w.ArrayStart()
+		for _, v := range m.RepeatedInt32 {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedInt32", "repeated_int32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedInt64) > 0 {
+		w.Field("repeatedInt64", "repeated_int64")
+		w.ArrayStart()
+		for _, v := range m.RepeatedInt64 {
+			w.Int64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedInt64", "repeated_int64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedUint32) > 0 {
+		w.Field("repeatedUint32", "repeated_uint32")
+		w.ArrayStart()
+		for _, v := range m.RepeatedUint32 {
+			w.Uint32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedUint32", "repeated_uint32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedUint64) > 0 {
+		w.Field("repeatedUint64", "repeated_uint64")
+		w.ArrayStart()
+		for _, v := range m.RepeatedUint64 {
+			w.Uint64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedUint64", "repeated_uint64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedSint32) > 0 {
+		w.Field("repeatedSint32", "repeated_sint32")
+		w.ArrayStart()
+		for _, v := range m.RepeatedSint32 {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedSint32", "repeated_sint32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedSint64) > 0 {
+		w.Field("repeatedSint64", "repeated_sint64")
+		w.ArrayStart()
+		for _, v := range m.RepeatedSint64 {
+			w.Int64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedSint64", "repeated_sint64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedFixed32) > 0 {
+		w.Field("repeatedFixed32", "repeated_fixed32")
+		w.ArrayStart()
+		for _, v := range m.RepeatedFixed32 {
+			w.Uint32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedFixed32", "repeated_fixed32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedFixed64) > 0 {
+		w.Field("repeatedFixed64", "repeated_fixed64")
+		w.ArrayStart()
+		for _, v := range m.RepeatedFixed64 {
+			w.Uint64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedFixed64", "repeated_fixed64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedSfixed32) > 0 {
+		w.Field("repeatedSfixed32", "repeated_sfixed32")
+		w.ArrayStart()
+		for _, v := range m.RepeatedSfixed32 {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedSfixed32", "repeated_sfixed32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedSfixed64) > 0 {
+		w.Field("repeatedSfixed64", "repeated_sfixed64")
+		w.ArrayStart()
+		for _, v := range m.RepeatedSfixed64 {
+			w.Int64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedSfixed64", "repeated_sfixed64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedFloat) > 0 {
+		w.Field("repeatedFloat", "repeated_float")
+		w.ArrayStart()
+		for _, v := range m.RepeatedFloat {
+			w.Float32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedFloat", "repeated_float")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedDouble) > 0 {
+		w.Field("repeatedDouble", "repeated_double")
+		w.ArrayStart()
+		for _, v := range m.RepeatedDouble {
+			w.Float64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedDouble", "repeated_double")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedBool) > 0 {
+		w.Field("repeatedBool", "repeated_bool")
+		w.ArrayStart()
+		for _, v := range m.RepeatedBool {
+			w.Bool(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedBool", "repeated_bool")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedString) > 0 {
+		w.Field("repeatedString", "repeated_string")
+		w.ArrayStart()
+		for _, v := range m.RepeatedString {
+			if err := w.String(v); err != nil {
+				return errors.New("proto: field protobuf_test_messages.proto3.TestAllTypesProto3.repeated_string contains invalid UTF-8")
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedString", "repeated_string")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedBytes) > 0 {
+		w.Field("repeatedBytes", "repeated_bytes")
+		w.ArrayStart()
+		for _, v := range m.RepeatedBytes {
+			w.Base64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedBytes", "repeated_bytes")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedNestedMessage) > 0 {
+		w.Field("repeatedNestedMessage", "repeated_nested_message")
+		w.ArrayStart()
+		for _, v := range m.RepeatedNestedMessage {
+			if err := v.MarshalJSONToVT(w); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedNestedMessage", "repeated_nested_message")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedForeignMessage) > 0 {
+		w.Field("repeatedForeignMessage", "repeated_foreign_message")
+		w.ArrayStart()
+		for _, v := range m.RepeatedForeignMessage {
+			if err := v.MarshalJSONToVT(w); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedForeignMessage", "repeated_foreign_message")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedNestedEnum) > 0 {
+		w.Field("repeatedNestedEnum", "repeated_nested_enum")
+		w.ArrayStart()
+		for _, v := range m.RepeatedNestedEnum {
+			w.Enum(int32(v), TestAllTypesProto3_NestedEnum_name)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedNestedEnum", "repeated_nested_enum")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedForeignEnum) > 0 {
+		w.Field("repeatedForeignEnum", "repeated_foreign_enum")
+		w.ArrayStart()
+		for _, v := range m.RepeatedForeignEnum {
+			w.Enum(int32(v), ForeignEnum_name)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedForeignEnum", "repeated_foreign_enum")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedStringPiece) > 0 {
+		w.Field("repeatedStringPiece", "repeated_string_piece")
+		w.ArrayStart()
+		for _, v := range m.RepeatedStringPiece {
+			if err := w.String(v); err != nil {
+				return errors.New("proto: field protobuf_test_messages.proto3.TestAllTypesProto3.repeated_string_piece contains invalid UTF-8")
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedStringPiece", "repeated_string_piece")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedCord) > 0 {
+		w.Field("repeatedCord", "repeated_cord")
+		w.ArrayStart()
+		for _, v := range m.RepeatedCord {
+			if err := w.String(v); err != nil {
+				return errors.New("proto: field protobuf_test_messages.proto3.TestAllTypesProto3.repeated_cord contains invalid UTF-8")
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedCord", "repeated_cord")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedInt32) > 0 {
+		w.Field("packedInt32", "packed_int32")
+		w.ArrayStart()
+		for _, v := range m.PackedInt32 {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedInt32", "packed_int32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedInt64) > 0 {
+		w.Field("packedInt64", "packed_int64")
+		w.ArrayStart()
+		for _, v := range m.PackedInt64 {
+			w.Int64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedInt64", "packed_int64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedUint32) > 0 {
+		w.Field("packedUint32", "packed_uint32")
+		w.ArrayStart()
+		for _, v := range m.PackedUint32 {
+			w.Uint32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedUint32", "packed_uint32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedUint64) > 0 {
+		w.Field("packedUint64", "packed_uint64")
+		w.ArrayStart()
+		for _, v := range m.PackedUint64 {
+			w.Uint64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedUint64", "packed_uint64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedSint32) > 0 {
+		w.Field("packedSint32", "packed_sint32")
+		w.ArrayStart()
+		for _, v := range m.PackedSint32 {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedSint32", "packed_sint32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedSint64) > 0 {
+		w.Field("packedSint64", "packed_sint64")
+		w.ArrayStart()
+		for _, v := range m.PackedSint64 {
+			w.Int64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedSint64", "packed_sint64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedFixed32) > 0 {
+		w.Field("packedFixed32", "packed_fixed32")
+		w.ArrayStart()
+		for _, v := range m.PackedFixed32 {
+			w.Uint32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedFixed32", "packed_fixed32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedFixed64) > 0 {
+		w.Field("packedFixed64", "packed_fixed64")
+		w.ArrayStart()
+		for _, v := range m.PackedFixed64 {
+			w.Uint64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedFixed64", "packed_fixed64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedSfixed32) > 0 {
+		w.Field("packedSfixed32", "packed_sfixed32")
+		w.ArrayStart()
+		for _, v := range m.PackedSfixed32 {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedSfixed32", "packed_sfixed32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedSfixed64) > 0 {
+		w.Field("packedSfixed64", "packed_sfixed64")
+		w.ArrayStart()
+		for _, v := range m.PackedSfixed64 {
+			w.Int64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedSfixed64", "packed_sfixed64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedFloat) > 0 {
+		w.Field("packedFloat", "packed_float")
+		w.ArrayStart()
+		for _, v := range m.PackedFloat {
+			w.Float32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedFloat", "packed_float")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedDouble) > 0 {
+		w.Field("packedDouble", "packed_double")
+		w.ArrayStart()
+		for _, v := range m.PackedDouble {
+			w.Float64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedDouble", "packed_double")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedBool) > 0 {
+		w.Field("packedBool", "packed_bool")
+		w.ArrayStart()
+		for _, v := range m.PackedBool {
+			w.Bool(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedBool", "packed_bool")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.PackedNestedEnum) > 0 {
+		w.Field("packedNestedEnum", "packed_nested_enum")
+		w.ArrayStart()
+		for _, v := range m.PackedNestedEnum {
+			w.Enum(int32(v), TestAllTypesProto3_NestedEnum_name)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedNestedEnum", "packed_nested_enum")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedInt32) > 0 {
+		w.Field("unpackedInt32", "unpacked_int32")
+		w.ArrayStart()
+		for _, v := range m.UnpackedInt32 {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedInt32", "unpacked_int32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedInt64) > 0 {
+		w.Field("unpackedInt64", "unpacked_int64")
+		w.ArrayStart()
+		for _, v := range m.UnpackedInt64 {
+			w.Int64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedInt64", "unpacked_int64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedUint32) > 0 {
+		w.Field("unpackedUint32", "unpacked_uint32")
+		w.ArrayStart()
+		for _, v := range m.UnpackedUint32 {
+			w.Uint32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedUint32", "unpacked_uint32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedUint64) > 0 {
+		w.Field("unpackedUint64", "unpacked_uint64")
+		w.ArrayStart()
+		for _, v := range m.UnpackedUint64 {
+			w.Uint64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedUint64", "unpacked_uint64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedSint32) > 0 {
+		w.Field("unpackedSint32", "unpacked_sint32")
+		w.ArrayStart()
+		for _, v := range m.UnpackedSint32 {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedSint32", "unpacked_sint32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedSint64) > 0 {
+		w.Field("unpackedSint64", "unpacked_sint64")
+		w.ArrayStart()
+		for _, v := range m.UnpackedSint64 {
+			w.Int64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedSint64", "unpacked_sint64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedFixed32) > 0 {
+		w.Field("unpackedFixed32", "unpacked_fixed32")
+		w.ArrayStart()
+		for _, v := range m.UnpackedFixed32 {
+			w.Uint32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedFixed32", "unpacked_fixed32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedFixed64) > 0 {
+		w.Field("unpackedFixed64", "unpacked_fixed64")
+		w.ArrayStart()
+		for _, v := range m.UnpackedFixed64 {
+			w.Uint64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedFixed64", "unpacked_fixed64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedSfixed32) > 0 {
+		w.Field("unpackedSfixed32", "unpacked_sfixed32")
+		w.ArrayStart()
+		for _, v := range m.UnpackedSfixed32 {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedSfixed32", "unpacked_sfixed32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedSfixed64) > 0 {
+		w.Field("unpackedSfixed64", "unpacked_sfixed64")
+		w.ArrayStart()
+		for _, v := range m.UnpackedSfixed64 {
+			w.Int64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedSfixed64", "unpacked_sfixed64")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedFloat) > 0 {
+		w.Field("unpackedFloat", "unpacked_float")
+		w.ArrayStart()
+		for _, v := range m.UnpackedFloat {
+			w.Float32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedFloat", "unpacked_float")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedDouble) > 0 {
+		w.Field("unpackedDouble", "unpacked_double")
+		w.ArrayStart()
+		for _, v := range m.UnpackedDouble {
+			w.Float64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedDouble", "unpacked_double")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedBool) > 0 {
+		w.Field("unpackedBool", "unpacked_bool")
+		w.ArrayStart()
+		for _, v := range m.UnpackedBool {
+			w.Bool(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedBool", "unpacked_bool")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.UnpackedNestedEnum) > 0 {
+		w.Field("unpackedNestedEnum", "unpacked_nested_enum")
+		w.ArrayStart()
+		for _, v := range m.UnpackedNestedEnum {
+			w.Enum(int32(v), TestAllTypesProto3_NestedEnum_name)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("unpackedNestedEnum", "unpacked_nested_enum")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.MapInt32Int32) > 0 {
+		w.Field("mapInt32Int32", "map_int32_int32")
+		keys := make([]int32, 0, len(m.MapInt32Int32))
+		for k := range m.MapInt32Int32 {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			w.Int32(m.MapInt32Int32[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapInt32Int32", "map_int32_int32")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapInt64Int64) > 0 {
+		w.Field("mapInt64Int64", "map_int64_int64")
+		keys := make([]int64, 0, len(m.MapInt64Int64))
+		for k := range m.MapInt64Int64 {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			w.Int64(m.MapInt64Int64[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapInt64Int64", "map_int64_int64")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapUint32Uint32) > 0 {
+		w.Field("mapUint32Uint32", "map_uint32_uint32")
+		keys := make([]uint32, 0, len(m.MapUint32Uint32))
+		for k := range m.MapUint32Uint32 {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatUint(uint64(k), 10))
+			w.Uint32(m.MapUint32Uint32[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapUint32Uint32", "map_uint32_uint32")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapUint64Uint64) > 0 {
+		w.Field("mapUint64Uint64", "map_uint64_uint64")
+		keys := make([]uint64, 0, len(m.MapUint64Uint64))
+		for k := range m.MapUint64Uint64 {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatUint(uint64(k), 10))
+			w.Uint64(m.MapUint64Uint64[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapUint64Uint64", "map_uint64_uint64")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapSint32Sint32) > 0 {
+		w.Field("mapSint32Sint32", "map_sint32_sint32")
+		keys := make([]int32, 0, len(m.MapSint32Sint32))
+		for k := range m.MapSint32Sint32 {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			w.Int32(m.MapSint32Sint32[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapSint32Sint32", "map_sint32_sint32")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapSint64Sint64) > 0 {
+		w.Field("mapSint64Sint64", "map_sint64_sint64")
+		keys := make([]int64, 0, len(m.MapSint64Sint64))
+		for k := range m.MapSint64Sint64 {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			w.Int64(m.MapSint64Sint64[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapSint64Sint64", "map_sint64_sint64")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapFixed32Fixed32) > 0 {
+		w.Field("mapFixed32Fixed32", "map_fixed32_fixed32")
+		keys := make([]uint32, 0, len(m.MapFixed32Fixed32))
+		for k := range m.MapFixed32Fixed32 {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatUint(uint64(k), 10))
+			w.Uint32(m.MapFixed32Fixed32[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapFixed32Fixed32", "map_fixed32_fixed32")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapFixed64Fixed64) > 0 {
+		w.Field("mapFixed64Fixed64", "map_fixed64_fixed64")
+		keys := make([]uint64, 0, len(m.MapFixed64Fixed64))
+		for k := range m.MapFixed64Fixed64 {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatUint(uint64(k), 10))
+			w.Uint64(m.MapFixed64Fixed64[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapFixed64Fixed64", "map_fixed64_fixed64")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapSfixed32Sfixed32) > 0 {
+		w.Field("mapSfixed32Sfixed32", "map_sfixed32_sfixed32")
+		keys := make([]int32, 0, len(m.MapSfixed32Sfixed32))
+		for k := range m.MapSfixed32Sfixed32 {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			w.Int32(m.MapSfixed32Sfixed32[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapSfixed32Sfixed32", "map_sfixed32_sfixed32")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapSfixed64Sfixed64) > 0 {
+		w.Field("mapSfixed64Sfixed64", "map_sfixed64_sfixed64")
+		keys := make([]int64, 0, len(m.MapSfixed64Sfixed64))
+		for k := range m.MapSfixed64Sfixed64 {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			w.Int64(m.MapSfixed64Sfixed64[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapSfixed64Sfixed64", "map_sfixed64_sfixed64")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapInt32Float) > 0 {
+		w.Field("mapInt32Float", "map_int32_float")
+		keys := make([]int32, 0, len(m.MapInt32Float))
+		for k := range m.MapInt32Float {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			w.Float32(m.MapInt32Float[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapInt32Float", "map_int32_float")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapInt32Double) > 0 {
+		w.Field("mapInt32Double", "map_int32_double")
+		keys := make([]int32, 0, len(m.MapInt32Double))
+		for k := range m.MapInt32Double {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			w.Float64(m.MapInt32Double[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapInt32Double", "map_int32_double")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapBoolBool) > 0 {
+		w.Field("mapBoolBool", "map_bool_bool")
+		keys := make([]bool, 0, len(m.MapBoolBool))
+		for k := range m.MapBoolBool {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return !keys[i] && keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatBool(k))
+			w.Bool(m.MapBoolBool[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapBoolBool", "map_bool_bool")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapStringString) > 0 {
+		w.Field("mapStringString", "map_string_string")
+		keys := make([]string, 0, len(m.MapStringString))
+		for k := range m.MapStringString {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		w.ObjectStart()
+		for _, k := range keys {
+			if err := w.Name(k); err != nil {
+				return errors.New("proto: field protobuf_test_messages.proto3.TestAllTypesProto3.MapStringStringEntry.key contains invalid UTF-8")
+			}
+			if err := w.String(m.MapStringString[k]); err != nil {
+				return errors.New("proto: field protobuf_test_messages.proto3.TestAllTypesProto3.MapStringStringEntry.value contains invalid UTF-8")
+			}
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapStringString", "map_string_string")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapStringBytes) > 0 {
+		w.Field("mapStringBytes", "map_string_bytes")
+		keys := make([]string, 0, len(m.MapStringBytes))
+		for k := range m.MapStringBytes {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		w.ObjectStart()
+		for _, k := range keys {
+			if err := w.Name(k); err != nil {
+				return errors.New("proto: field protobuf_test_messages.proto3.TestAllTypesProto3.MapStringBytesEntry.key contains invalid UTF-8")
+			}
+			w.Base64(m.MapStringBytes[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapStringBytes", "map_string_bytes")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapStringNestedMessage) > 0 {
+		w.Field("mapStringNestedMessage", "map_string_nested_message")
+		keys := make([]string, 0, len(m.MapStringNestedMessage))
+		for k := range m.MapStringNestedMessage {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		w.ObjectStart()
+		for _, k := range keys {
+			if err := w.Name(k); err != nil {
+				return errors.New("proto: field protobuf_test_messages.proto3.TestAllTypesProto3.MapStringNestedMessageEntry.key contains invalid UTF-8")
+			}
+			if err := m.MapStringNestedMessage[k].MarshalJSONToVT(w); err != nil {
+				return err
+			}
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapStringNestedMessage", "map_string_nested_message")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapStringForeignMessage) > 0 {
+		w.Field("mapStringForeignMessage", "map_string_foreign_message")
+		keys := make([]string, 0, len(m.MapStringForeignMessage))
+		for k := range m.MapStringForeignMessage {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		w.ObjectStart()
+		for _, k := range keys {
+			if err := w.Name(k); err != nil {
+				return errors.New("proto: field protobuf_test_messages.proto3.TestAllTypesProto3.MapStringForeignMessageEntry.key contains invalid UTF-8")
+			}
+			if err := m.MapStringForeignMessage[k].MarshalJSONToVT(w); err != nil {
+				return err
+			}
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapStringForeignMessage", "map_string_foreign_message")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapStringNestedEnum) > 0 {
+		w.Field("mapStringNestedEnum", "map_string_nested_enum")
+		keys := make([]string, 0, len(m.MapStringNestedEnum))
+		for k := range m.MapStringNestedEnum {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		w.ObjectStart()
+		for _, k := range keys {
+			if err := w.Name(k); err != nil {
+				return errors.New("proto: field protobuf_test_messages.proto3.TestAllTypesProto3.MapStringNestedEnumEntry.key contains invalid UTF-8")
+			}
+			w.Enum(int32(m.MapStringNestedEnum[k]), TestAllTypesProto3_NestedEnum_name)
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapStringNestedEnum", "map_string_nested_enum")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.MapStringForeignEnum) > 0 {
+		w.Field("mapStringForeignEnum", "map_string_foreign_enum")
+		keys := make([]string, 0, len(m.MapStringForeignEnum))
+		for k := range m.MapStringForeignEnum {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		w.ObjectStart()
+		for _, k := range keys {
+			if err := w.Name(k); err != nil {
+				return errors.New("proto: field protobuf_test_messages.proto3.TestAllTypesProto3.MapStringForeignEnumEntry.key contains invalid UTF-8")
+			}
+			w.Enum(int32(m.MapStringForeignEnum[k]), ForeignEnum_name)
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("mapStringForeignEnum", "map_string_foreign_enum")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if c, ok := m.OneofField.(*TestAllTypesProto3_OneofUint32); ok {
+		w.Field("oneofUint32", "oneof_uint32")
+		w.Uint32(c.OneofUint32)
+	}
+	if c, ok := m.OneofField.(*TestAllTypesProto3_OneofNestedMessage); ok {
+		w.Field("oneofNestedMessage", "oneof_nested_message")
+		if err := c.OneofNestedMessage.MarshalJSONToVT(w); err != nil {
+			return err
+		}
+	}
+	if c, ok := m.OneofField.(*TestAllTypesProto3_OneofString); ok {
+		w.Field("oneofString", "oneof_string")
+		if err := w.String(c.OneofString); err != nil {
+			return errors.New("proto: field protobuf_test_messages.proto3.TestAllTypesProto3.oneof_string contains invalid UTF-8")
+		}
+	}
+	if c, ok := m.OneofField.(*TestAllTypesProto3_OneofBytes); ok {
+		w.Field("oneofBytes", "oneof_bytes")
+		w.Base64(c.OneofBytes)
+	}
+	if c, ok := m.OneofField.(*TestAllTypesProto3_OneofBool); ok {
+		w.Field("oneofBool", "oneof_bool")
+		w.Bool(c.OneofBool)
+	}
+	if c, ok := m.OneofField.(*TestAllTypesProto3_OneofUint64); ok {
+		w.Field("oneofUint64", "oneof_uint64")
+		w.Uint64(c.OneofUint64)
+	}
+	if c, ok := m.OneofField.(*TestAllTypesProto3_OneofFloat); ok {
+		w.Field("oneofFloat", "oneof_float")
+		w.Float32(c.OneofFloat)
+	}
+	if c, ok := m.OneofField.(*TestAllTypesProto3_OneofDouble); ok {
+		w.Field("oneofDouble", "oneof_double")
+		w.Float64(c.OneofDouble)
+	}
+	if c, ok := m.OneofField.(*TestAllTypesProto3_OneofEnum); ok {
+		w.Field("oneofEnum", "oneof_enum")
+		w.Enum(int32(c.OneofEnum), TestAllTypesProto3_NestedEnum_name)
+	}
+	if _, ok := m.OneofField.(*TestAllTypesProto3_OneofNullValue); ok {
+		w.Field("oneofNullValue", "oneof_null_value")
+		w.Null()
+	}
+	if m.OptionalBoolWrapper != nil {
+		w.Field("optionalBoolWrapper", "optional_bool_wrapper")
+		if err := w.Message(m.OptionalBoolWrapper); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalBoolWrapper", "optional_bool_wrapper")
+		w.Null()
+	}
+	if m.OptionalInt32Wrapper != nil {
+		w.Field("optionalInt32Wrapper", "optional_int32_wrapper")
+		if err := w.Message(m.OptionalInt32Wrapper); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalInt32Wrapper", "optional_int32_wrapper")
+		w.Null()
+	}
+	if m.OptionalInt64Wrapper != nil {
+		w.Field("optionalInt64Wrapper", "optional_int64_wrapper")
+		if err := w.Message(m.OptionalInt64Wrapper); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalInt64Wrapper", "optional_int64_wrapper")
+		w.Null()
+	}
+	if m.OptionalUint32Wrapper != nil {
+		w.Field("optionalUint32Wrapper", "optional_uint32_wrapper")
+		if err := w.Message(m.OptionalUint32Wrapper); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalUint32Wrapper", "optional_uint32_wrapper")
+		w.Null()
+	}
+	if m.OptionalUint64Wrapper != nil {
+		w.Field("optionalUint64Wrapper", "optional_uint64_wrapper")
+		if err := w.Message(m.OptionalUint64Wrapper); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalUint64Wrapper", "optional_uint64_wrapper")
+		w.Null()
+	}
+	if m.OptionalFloatWrapper != nil {
+		w.Field("optionalFloatWrapper", "optional_float_wrapper")
+		if err := w.Message(m.OptionalFloatWrapper); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalFloatWrapper", "optional_float_wrapper")
+		w.Null()
+	}
+	if m.OptionalDoubleWrapper != nil {
+		w.Field("optionalDoubleWrapper", "optional_double_wrapper")
+		if err := w.Message(m.OptionalDoubleWrapper); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalDoubleWrapper", "optional_double_wrapper")
+		w.Null()
+	}
+	if m.OptionalStringWrapper != nil {
+		w.Field("optionalStringWrapper", "optional_string_wrapper")
+		if err := w.Message(m.OptionalStringWrapper); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalStringWrapper", "optional_string_wrapper")
+		w.Null()
+	}
+	if m.OptionalBytesWrapper != nil {
+		w.Field("optionalBytesWrapper", "optional_bytes_wrapper")
+		if err := w.Message(m.OptionalBytesWrapper); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalBytesWrapper", "optional_bytes_wrapper")
+		w.Null()
+	}
+	if len(m.RepeatedBoolWrapper) > 0 {
+		w.Field("repeatedBoolWrapper", "repeated_bool_wrapper")
+		w.ArrayStart()
+		for _, v := range m.RepeatedBoolWrapper {
+			if err := w.Message(v); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedBoolWrapper", "repeated_bool_wrapper")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedInt32Wrapper) > 0 {
+		w.Field("repeatedInt32Wrapper", "repeated_int32_wrapper")
+		w.ArrayStart()
+		for _, v := range m.RepeatedInt32Wrapper {
+			if err := w.Message(v); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedInt32Wrapper", "repeated_int32_wrapper")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedInt64Wrapper) > 0 {
+		w.Field("repeatedInt64Wrapper", "repeated_int64_wrapper")
+		w.ArrayStart()
+		for _, v := range m.RepeatedInt64Wrapper {
+			if err := w.Message(v); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedInt64Wrapper", "repeated_int64_wrapper")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedUint32Wrapper) > 0 {
+		w.Field("repeatedUint32Wrapper", "repeated_uint32_wrapper")
+		w.ArrayStart()
+		for _, v := range m.RepeatedUint32Wrapper {
+			if err := w.Message(v); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedUint32Wrapper", "repeated_uint32_wrapper")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedUint64Wrapper) > 0 {
+		w.Field("repeatedUint64Wrapper", "repeated_uint64_wrapper")
+		w.ArrayStart()
+		for _, v := range m.RepeatedUint64Wrapper {
+			if err := w.Message(v); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedUint64Wrapper", "repeated_uint64_wrapper")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedFloatWrapper) > 0 {
+		w.Field("repeatedFloatWrapper", "repeated_float_wrapper")
+		w.ArrayStart()
+		for _, v := range m.RepeatedFloatWrapper {
+			if err := w.Message(v); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedFloatWrapper", "repeated_float_wrapper")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedDoubleWrapper) > 0 {
+		w.Field("repeatedDoubleWrapper", "repeated_double_wrapper")
+		w.ArrayStart()
+		for _, v := range m.RepeatedDoubleWrapper {
+			if err := w.Message(v); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedDoubleWrapper", "repeated_double_wrapper")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedStringWrapper) > 0 {
+		w.Field("repeatedStringWrapper", "repeated_string_wrapper")
+		w.ArrayStart()
+		for _, v := range m.RepeatedStringWrapper {
+			if err := w.Message(v); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedStringWrapper", "repeated_string_wrapper")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedBytesWrapper) > 0 {
+		w.Field("repeatedBytesWrapper", "repeated_bytes_wrapper")
+		w.ArrayStart()
+		for _, v := range m.RepeatedBytesWrapper {
+			if err := w.Message(v); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedBytesWrapper", "repeated_bytes_wrapper")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if m.OptionalDuration != nil {
+		w.Field("optionalDuration", "optional_duration")
+		if err := w.Message(m.OptionalDuration); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalDuration", "optional_duration")
+		w.Null()
+	}
+	if m.OptionalTimestamp != nil {
+		w.Field("optionalTimestamp", "optional_timestamp")
+		if err := w.Message(m.OptionalTimestamp); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalTimestamp", "optional_timestamp")
+		w.Null()
+	}
+	if m.OptionalFieldMask != nil {
+		w.Field("optionalFieldMask", "optional_field_mask")
+		if err := w.Message(m.OptionalFieldMask); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalFieldMask", "optional_field_mask")
+		w.Null()
+	}
+	if m.OptionalStruct != nil {
+		w.Field("optionalStruct", "optional_struct")
+		if err := w.Message(m.OptionalStruct); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalStruct", "optional_struct")
+		w.Null()
+	}
+	if m.OptionalAny != nil {
+		w.Field("optionalAny", "optional_any")
+		if err := w.Message(m.OptionalAny); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalAny", "optional_any")
+		w.Null()
+	}
+	if m.OptionalValue != nil {
+		w.Field("optionalValue", "optional_value")
+		if err := w.Message(m.OptionalValue); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalValue", "optional_value")
+		w.Null()
+	}
+	if m.OptionalNullValue != 0 {
+		w.Field("optionalNullValue", "optional_null_value")
+		w.Null()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalNullValue", "optional_null_value")
+		w.Null()
+	}
+	if len(m.RepeatedDuration) > 0 {
+		w.Field("repeatedDuration", "repeated_duration")
+		w.ArrayStart()
+		for _, v := range m.RepeatedDuration {
+			if err := w.Message(v); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedDuration", "repeated_duration")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedTimestamp) > 0 {
+		w.Field("repeatedTimestamp", "repeated_timestamp")
+		w.ArrayStart()
+		for _, v := range m.RepeatedTimestamp {
+			if err := w.Message(v); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedTimestamp", "repeated_timestamp")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedFieldmask) > 0 {
+		w.Field("repeatedFieldmask", "repeated_fieldmask")
+		w.ArrayStart()
+		for _, v := range m.RepeatedFieldmask {
+			if err := w.Message(v); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedFieldmask", "repeated_fieldmask")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedStruct) > 0 {
+		w.Field("repeatedStruct", "repeated_struct")
+		w.ArrayStart()
+		for _, v := range m.RepeatedStruct {
+			if err := w.Message(v); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedStruct", "repeated_struct")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedAny) > 0 {
+		w.Field("repeatedAny", "repeated_any")
+		w.ArrayStart()
+		for _, v := range m.RepeatedAny {
+			if err := w.Message(v); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedAny", "repeated_any")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedValue) > 0 {
+		w.Field("repeatedValue", "repeated_value")
+		w.ArrayStart()
+		for _, v := range m.RepeatedValue {
+			if err := w.Message(v); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedValue", "repeated_value")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedListValue) > 0 {
+		w.Field("repeatedListValue", "repeated_list_value")
+		w.ArrayStart()
+		for _, v := range m.RepeatedListValue {
+			if err := w.Message(v); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedListValue", "repeated_list_value")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if m.Fieldname1 != 0 {
+		w.Field("fieldname1", "fieldname1")
+		w.Int32(m.Fieldname1)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("fieldname1", "fieldname1")
+		w.Int32(m.GetFieldname1())
+	}
+	if m.FieldName2 != 0 {
+		w.Field("fieldName2", "field_name2")
+		w.Int32(m.FieldName2)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("fieldName2", "field_name2")
+		w.Int32(m.GetFieldName2())
+	}
+	if m.XFieldName3 != 0 {
+		w.Field("FieldName3", "_field_name3")
+		w.Int32(m.XFieldName3)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("FieldName3", "_field_name3")
+		w.Int32(m.GetXFieldName3())
+	}
+	if m.Field_Name4_ != 0 {
+		w.Field("fieldName4", "field__name4_")
+		w.Int32(m.Field_Name4_)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("fieldName4", "field__name4_")
+		w.Int32(m.GetField_Name4_())
+	}
+	if m.Field0Name5 != 0 {
+		w.Field("field0name5", "field0name5")
+		w.Int32(m.Field0Name5)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("field0name5", "field0name5")
+		w.Int32(m.GetField0Name5())
+	}
+	if m.Field_0Name6 != 0 {
+		w.Field("field0Name6", "field_0_name6")
+		w.Int32(m.Field_0Name6)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("field0Name6", "field_0_name6")
+		w.Int32(m.GetField_0Name6())
+	}
+	if m.FieldName7 != 0 {
+		w.Field("fieldName7", "fieldName7")
+		w.Int32(m.FieldName7)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("fieldName7", "fieldName7")
+		w.Int32(m.GetFieldName7())
+	}
+	if m.FieldName8 != 0 {
+		w.Field("FieldName8", "FieldName8")
+		w.Int32(m.FieldName8)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("FieldName8", "FieldName8")
+		w.Int32(m.GetFieldName8())
+	}
+	if m.Field_Name9 != 0 {
+		w.Field("fieldName9", "field_Name9")
+		w.Int32(m.Field_Name9)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("fieldName9", "field_Name9")
+		w.Int32(m.GetField_Name9())
+	}
+	if m.Field_Name10 != 0 {
+		w.Field("FieldName10", "Field_Name10")
+		w.Int32(m.Field_Name10)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("FieldName10", "Field_Name10")
+		w.Int32(m.GetField_Name10())
+	}
+	if m.FIELD_NAME11 != 0 {
+		w.Field("FIELDNAME11", "FIELD_NAME11")
+		w.Int32(m.FIELD_NAME11)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("FIELDNAME11", "FIELD_NAME11")
+		w.Int32(m.GetFIELD_NAME11())
+	}
+	if m.FIELDName12 != 0 {
+		w.Field("FIELDName12", "FIELD_name12")
+		w.Int32(m.FIELDName12)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("FIELDName12", "FIELD_name12")
+		w.Int32(m.GetFIELDName12())
+	}
+	if m.XFieldName13 != 0 {
+		w.Field("FieldName13", "__field_name13")
+		w.Int32(m.XFieldName13)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("FieldName13", "__field_name13")
+		w.Int32(m.GetXFieldName13())
+	}
+	if m.X_FieldName14 != 0 {
+		w.Field("FieldName14", "__Field_name14")
+		w.Int32(m.X_FieldName14)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("FieldName14", "__Field_name14")
+		w.Int32(m.GetX_FieldName14())
+	}
+	if m.Field_Name15 != 0 {
+		w.Field("fieldName15", "field__name15")
+		w.Int32(m.Field_Name15)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("fieldName15", "field__name15")
+		w.Int32(m.GetField_Name15())
+	}
+	if m.Field__Name16 != 0 {
+		w.Field("fieldName16", "field__Name16")
+		w.Int32(m.Field__Name16)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("fieldName16", "field__Name16")
+		w.Int32(m.GetField__Name16())
+	}
+	if m.FieldName17__ != 0 {
+		w.Field("fieldName17", "field_name17__")
+		w.Int32(m.FieldName17__)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("fieldName17", "field_name17__")
+		w.Int32(m.GetFieldName17__())
+	}
+	if m.FieldName18__ != 0 {
+		w.Field("FieldName18", "Field_name18__")
+		w.Int32(m.FieldName18__)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("FieldName18", "Field_name18__")
+		w.Int32(m.GetFieldName18__())
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *TestAllTypesProto3) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *TestAllTypesProto3) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [152]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "optionalInt32", "optional_int32":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.OptionalInt32 = v
+		case "optionalInt64", "optional_int64":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt64()
+			if err != nil {
+				return err
+			}
+			m.OptionalInt64 = v
+		case "optionalUint32", "optional_uint32":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint32()
+			if err != nil {
+				return err
+			}
+			m.OptionalUint32 = v
+		case "optionalUint64", "optional_uint64":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint64()
+			if err != nil {
+				return err
+			}
+			m.OptionalUint64 = v
+		case "optionalSint32", "optional_sint32":
+			if seen[4] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[4] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.OptionalSint32 = v
+		case "optionalSint64", "optional_sint64":
+			if seen[5] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[5] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt64()
+			if err != nil {
+				return err
+			}
+			m.OptionalSint64 = v
+		case "optionalFixed32", "optional_fixed32":
+			if seen[6] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[6] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint32()
+			if err != nil {
+				return err
+			}
+			m.OptionalFixed32 = v
+		case "optionalFixed64", "optional_fixed64":
+			if seen[7] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[7] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint64()
+			if err != nil {
+				return err
+			}
+			m.OptionalFixed64 = v
+		case "optionalSfixed32", "optional_sfixed32":
+			if seen[8] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[8] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.OptionalSfixed32 = v
+		case "optionalSfixed64", "optional_sfixed64":
+			if seen[9] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[9] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt64()
+			if err != nil {
+				return err
+			}
+			m.OptionalSfixed64 = v
+		case "optionalFloat", "optional_float":
+			if seen[10] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[10] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadFloat32()
+			if err != nil {
+				return err
+			}
+			m.OptionalFloat = v
+		case "optionalDouble", "optional_double":
+			if seen[11] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[11] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadFloat64()
+			if err != nil {
+				return err
+			}
+			m.OptionalDouble = v
+		case "optionalBool", "optional_bool":
+			if seen[12] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[12] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadBool()
+			if err != nil {
+				return err
+			}
+			m.OptionalBool = v
+		case "optionalString", "optional_string":
+			if seen[13] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[13] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.OptionalString = v
+		case "optionalBytes", "optional_bytes":
+			if seen[14] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[14] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadBytes()
+			if err != nil {
+				return err
+			}
+			m.OptionalBytes = v
+		case "optionalNestedMessage", "optional_nested_message":
+			if seen[15] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[15] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(TestAllTypesProto3_NestedMessage)
+			if err := v.UnmarshalJSONFromVT(r); err != nil {
+				return err
+			}
+			m.OptionalNestedMessage = v
+		case "optionalForeignMessage", "optional_foreign_message":
+			if seen[16] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[16] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(ForeignMessage)
+			if err := v.UnmarshalJSONFromVT(r); err != nil {
+				return err
+			}
+			m.OptionalForeignMessage = v
+		case "optionalNestedEnum", "optional_nested_enum":
+			if seen[17] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[17] = true
+			if r.SkipNull() {
+				continue
+			}
+			e, ok, err := r.ReadEnum(TestAllTypesProto3_NestedEnum_value)
+			if err != nil {
+				return err
+			}
+			if ok {
+				v := TestAllTypesProto3_NestedEnum(e)
+				m.OptionalNestedEnum = v
+			}
+		case "optionalForeignEnum", "optional_foreign_enum":
+			if seen[18] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[18] = true
+			if r.SkipNull() {
+				continue
+			}
+			e, ok, err := r.ReadEnum(ForeignEnum_value)
+			if err != nil {
+				return err
+			}
+			if ok {
+				v := ForeignEnum(e)
+				m.OptionalForeignEnum = v
+			}
+		case "optionalAliasedEnum", "optional_aliased_enum":
+			if seen[19] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[19] = true
+			if r.SkipNull() {
+				continue
+			}
+			e, ok, err := r.ReadEnum(TestAllTypesProto3_AliasedEnum_value)
+			if err != nil {
+				return err
+			}
+			if ok {
+				v := TestAllTypesProto3_AliasedEnum(e)
+				m.OptionalAliasedEnum = v
+			}
+		case "optionalStringPiece", "optional_string_piece":
+			if seen[20] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[20] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.OptionalStringPiece = v
+		case "optionalCord", "optional_cord":
+			if seen[21] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[21] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.OptionalCord = v
+		case "recursiveMessage", "recursive_message":
+			if seen[22] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[22] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(TestAllTypesProto3)
+			if err := v.UnmarshalJSONFromVT(r); err != nil {
+				return err
+			}
+			m.RecursiveMessage = v
+		case "repeatedInt32", "repeated_int32":
+			if seen[23] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[23] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.RepeatedInt32 = append(m.RepeatedInt32, v)
+			}
+		case "repeatedInt64", "repeated_int64":
+			if seen[24] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[24] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.RepeatedInt64 = append(m.RepeatedInt64, v)
+			}
+		case "repeatedUint32", "repeated_uint32":
+			if seen[25] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[25] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint32()
+				if err != nil {
+					return err
+				}
+				m.RepeatedUint32 = append(m.RepeatedUint32, v)
+			}
+		case "repeatedUint64", "repeated_uint64":
+			if seen[26] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[26] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint64()
+				if err != nil {
+					return err
+				}
+				m.RepeatedUint64 = append(m.RepeatedUint64, v)
+			}
+		case "repeatedSint32", "repeated_sint32":
+			if seen[27] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[27] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.RepeatedSint32 = append(m.RepeatedSint32, v)
+			}
+		case "repeatedSint64", "repeated_sint64":
+			if seen[28] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[28] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.RepeatedSint64 = append(m.RepeatedSint64, v)
+			}
+		case "repeatedFixed32", "repeated_fixed32":
+			if seen[29] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[29] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint32()
+				if err != nil {
+					return err
+				}
+				m.RepeatedFixed32 = append(m.RepeatedFixed32, v)
+			}
+		case "repeatedFixed64", "repeated_fixed64":
+			if seen[30] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[30] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint64()
+				if err != nil {
+					return err
+				}
+				m.RepeatedFixed64 = append(m.RepeatedFixed64, v)
+			}
+		case "repeatedSfixed32", "repeated_sfixed32":
+			if seen[31] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[31] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.RepeatedSfixed32 = append(m.RepeatedSfixed32, v)
+			}
+		case "repeatedSfixed64", "repeated_sfixed64":
+			if seen[32] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[32] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.RepeatedSfixed64 = append(m.RepeatedSfixed64, v)
+			}
+		case "repeatedFloat", "repeated_float":
+			if seen[33] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[33] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadFloat32()
+				if err != nil {
+					return err
+				}
+				m.RepeatedFloat = append(m.RepeatedFloat, v)
+			}
+		case "repeatedDouble", "repeated_double":
+			if seen[34] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[34] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadFloat64()
+				if err != nil {
+					return err
+				}
+				m.RepeatedDouble = append(m.RepeatedDouble, v)
+			}
+		case "repeatedBool", "repeated_bool":
+			if seen[35] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[35] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadBool()
+				if err != nil {
+					return err
+				}
+				m.RepeatedBool = append(m.RepeatedBool, v)
+			}
+		case "repeatedString", "repeated_string":
+			if seen[36] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[36] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadString()
+				if err != nil {
+					return err
+				}
+				m.RepeatedString = append(m.RepeatedString, v)
+			}
+		case "repeatedBytes", "repeated_bytes":
+			if seen[37] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[37] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadBytes()
+				if err != nil {
+					return err
+				}
+				m.RepeatedBytes = append(m.RepeatedBytes, v)
+			}
+		case "repeatedNestedMessage", "repeated_nested_message":
+			if seen[38] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[38] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(TestAllTypesProto3_NestedMessage)
+				if err := v.UnmarshalJSONFromVT(r); err != nil {
+					return err
+				}
+				m.RepeatedNestedMessage = append(m.RepeatedNestedMessage, v)
+			}
+		case "repeatedForeignMessage", "repeated_foreign_message":
+			if seen[39] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[39] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(ForeignMessage)
+				if err := v.UnmarshalJSONFromVT(r); err != nil {
+					return err
+				}
+				m.RepeatedForeignMessage = append(m.RepeatedForeignMessage, v)
+			}
+		case "repeatedNestedEnum", "repeated_nested_enum":
+			if seen[40] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[40] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				e, ok, err := r.ReadEnum(TestAllTypesProto3_NestedEnum_value)
+				if err != nil {
+					return err
+				}
+				if ok {
+					v := TestAllTypesProto3_NestedEnum(e)
+					m.RepeatedNestedEnum = append(m.RepeatedNestedEnum, v)
+				}
+			}
+		case "repeatedForeignEnum", "repeated_foreign_enum":
+			if seen[41] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[41] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				e, ok, err := r.ReadEnum(ForeignEnum_value)
+				if err != nil {
+					return err
+				}
+				if ok {
+					v := ForeignEnum(e)
+					m.RepeatedForeignEnum = append(m.RepeatedForeignEnum, v)
+				}
+			}
+		case "repeatedStringPiece", "repeated_string_piece":
+			if seen[42] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[42] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadString()
+				if err != nil {
+					return err
+				}
+				m.RepeatedStringPiece = append(m.RepeatedStringPiece, v)
+			}
+		case "repeatedCord", "repeated_cord":
+			if seen[43] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[43] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadString()
+				if err != nil {
+					return err
+				}
+				m.RepeatedCord = append(m.RepeatedCord, v)
+			}
+		case "packedInt32", "packed_int32":
+			if seen[44] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[44] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.PackedInt32 = append(m.PackedInt32, v)
+			}
+		case "packedInt64", "packed_int64":
+			if seen[45] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[45] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.PackedInt64 = append(m.PackedInt64, v)
+			}
+		case "packedUint32", "packed_uint32":
+			if seen[46] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[46] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint32()
+				if err != nil {
+					return err
+				}
+				m.PackedUint32 = append(m.PackedUint32, v)
+			}
+		case "packedUint64", "packed_uint64":
+			if seen[47] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[47] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint64()
+				if err != nil {
+					return err
+				}
+				m.PackedUint64 = append(m.PackedUint64, v)
+			}
+		case "packedSint32", "packed_sint32":
+			if seen[48] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[48] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.PackedSint32 = append(m.PackedSint32, v)
+			}
+		case "packedSint64", "packed_sint64":
+			if seen[49] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[49] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.PackedSint64 = append(m.PackedSint64, v)
+			}
+		case "packedFixed32", "packed_fixed32":
+			if seen[50] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[50] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint32()
+				if err != nil {
+					return err
+				}
+				m.PackedFixed32 = append(m.PackedFixed32, v)
+			}
+		case "packedFixed64", "packed_fixed64":
+			if seen[51] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[51] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint64()
+				if err != nil {
+					return err
+				}
+				m.PackedFixed64 = append(m.PackedFixed64, v)
+			}
+		case "packedSfixed32", "packed_sfixed32":
+			if seen[52] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[52] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.PackedSfixed32 = append(m.PackedSfixed32, v)
+			}
+		case "packedSfixed64", "packed_sfixed64":
+			if seen[53] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[53] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.PackedSfixed64 = append(m.PackedSfixed64, v)
+			}
+		case "packedFloat", "packed_float":
+			if seen[54] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[54] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadFloat32()
+				if err != nil {
+					return err
+				}
+				m.PackedFloat = append(m.PackedFloat, v)
+			}
+		case "packedDouble", "packed_double":
+			if seen[55] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[55] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadFloat64()
+				if err != nil {
+					return err
+				}
+				m.PackedDouble = append(m.PackedDouble, v)
+			}
+		case "packedBool", "packed_bool":
+			if seen[56] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[56] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadBool()
+				if err != nil {
+					return err
+				}
+				m.PackedBool = append(m.PackedBool, v)
+			}
+		case "packedNestedEnum", "packed_nested_enum":
+			if seen[57] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[57] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				e, ok, err := r.ReadEnum(TestAllTypesProto3_NestedEnum_value)
+				if err != nil {
+					return err
+				}
+				if ok {
+					v := TestAllTypesProto3_NestedEnum(e)
+					m.PackedNestedEnum = append(m.PackedNestedEnum, v)
+				}
+			}
+		case "unpackedInt32", "unpacked_int32":
+			if seen[58] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[58] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.UnpackedInt32 = append(m.UnpackedInt32, v)
+			}
+		case "unpackedInt64", "unpacked_int64":
+			if seen[59] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[59] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.UnpackedInt64 = append(m.UnpackedInt64, v)
+			}
+		case "unpackedUint32", "unpacked_uint32":
+			if seen[60] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[60] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint32()
+				if err != nil {
+					return err
+				}
+				m.UnpackedUint32 = append(m.UnpackedUint32, v)
+			}
+		case "unpackedUint64", "unpacked_uint64":
+			if seen[61] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[61] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint64()
+				if err != nil {
+					return err
+				}
+				m.UnpackedUint64 = append(m.UnpackedUint64, v)
+			}
+		case "unpackedSint32", "unpacked_sint32":
+			if seen[62] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[62] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.UnpackedSint32 = append(m.UnpackedSint32, v)
+			}
+		case "unpackedSint64", "unpacked_sint64":
+			if seen[63] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[63] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.UnpackedSint64 = append(m.UnpackedSint64, v)
+			}
+		case "unpackedFixed32", "unpacked_fixed32":
+			if seen[64] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[64] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint32()
+				if err != nil {
+					return err
+				}
+				m.UnpackedFixed32 = append(m.UnpackedFixed32, v)
+			}
+		case "unpackedFixed64", "unpacked_fixed64":
+			if seen[65] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[65] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint64()
+				if err != nil {
+					return err
+				}
+				m.UnpackedFixed64 = append(m.UnpackedFixed64, v)
+			}
+		case "unpackedSfixed32", "unpacked_sfixed32":
+			if seen[66] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[66] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.UnpackedSfixed32 = append(m.UnpackedSfixed32, v)
+			}
+		case "unpackedSfixed64", "unpacked_sfixed64":
+			if seen[67] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[67] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.UnpackedSfixed64 = append(m.UnpackedSfixed64, v)
+			}
+		case "unpackedFloat", "unpacked_float":
+			if seen[68] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[68] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadFloat32()
+				if err != nil {
+					return err
+				}
+				m.UnpackedFloat = append(m.UnpackedFloat, v)
+			}
+		case "unpackedDouble", "unpacked_double":
+			if seen[69] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[69] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadFloat64()
+				if err != nil {
+					return err
+				}
+				m.UnpackedDouble = append(m.UnpackedDouble, v)
+			}
+		case "unpackedBool", "unpacked_bool":
+			if seen[70] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[70] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadBool()
+				if err != nil {
+					return err
+				}
+				m.UnpackedBool = append(m.UnpackedBool, v)
+			}
+		case "unpackedNestedEnum", "unpacked_nested_enum":
+			if seen[71] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[71] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				e, ok, err := r.ReadEnum(TestAllTypesProto3_NestedEnum_value)
+				if err != nil {
+					return err
+				}
+				if ok {
+					v := TestAllTypesProto3_NestedEnum(e)
+					m.UnpackedNestedEnum = append(m.UnpackedNestedEnum, v)
+				}
+			}
+		case "mapInt32Int32", "map_int32_int32":
+			if seen[72] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[72] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapInt32Int32 == nil {
+				m.MapInt32Int32 = make(map[int32]int32)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int32Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapInt32Int32[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.MapInt32Int32[k] = v
+			}
+		case "mapInt64Int64", "map_int64_int64":
+			if seen[73] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[73] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapInt64Int64 == nil {
+				m.MapInt64Int64 = make(map[int64]int64)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int64Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapInt64Int64[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.MapInt64Int64[k] = v
+			}
+		case "mapUint32Uint32", "map_uint32_uint32":
+			if seen[74] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[74] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapUint32Uint32 == nil {
+				m.MapUint32Uint32 = make(map[uint32]uint32)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Uint32Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapUint32Uint32[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadUint32()
+				if err != nil {
+					return err
+				}
+				m.MapUint32Uint32[k] = v
+			}
+		case "mapUint64Uint64", "map_uint64_uint64":
+			if seen[75] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[75] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapUint64Uint64 == nil {
+				m.MapUint64Uint64 = make(map[uint64]uint64)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Uint64Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapUint64Uint64[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadUint64()
+				if err != nil {
+					return err
+				}
+				m.MapUint64Uint64[k] = v
+			}
+		case "mapSint32Sint32", "map_sint32_sint32":
+			if seen[76] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[76] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapSint32Sint32 == nil {
+				m.MapSint32Sint32 = make(map[int32]int32)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int32Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapSint32Sint32[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.MapSint32Sint32[k] = v
+			}
+		case "mapSint64Sint64", "map_sint64_sint64":
+			if seen[77] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[77] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapSint64Sint64 == nil {
+				m.MapSint64Sint64 = make(map[int64]int64)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int64Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapSint64Sint64[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.MapSint64Sint64[k] = v
+			}
+		case "mapFixed32Fixed32", "map_fixed32_fixed32":
+			if seen[78] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[78] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapFixed32Fixed32 == nil {
+				m.MapFixed32Fixed32 = make(map[uint32]uint32)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Uint32Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapFixed32Fixed32[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadUint32()
+				if err != nil {
+					return err
+				}
+				m.MapFixed32Fixed32[k] = v
+			}
+		case "mapFixed64Fixed64", "map_fixed64_fixed64":
+			if seen[79] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[79] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapFixed64Fixed64 == nil {
+				m.MapFixed64Fixed64 = make(map[uint64]uint64)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Uint64Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapFixed64Fixed64[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadUint64()
+				if err != nil {
+					return err
+				}
+				m.MapFixed64Fixed64[k] = v
+			}
+		case "mapSfixed32Sfixed32", "map_sfixed32_sfixed32":
+			if seen[80] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[80] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapSfixed32Sfixed32 == nil {
+				m.MapSfixed32Sfixed32 = make(map[int32]int32)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int32Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapSfixed32Sfixed32[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.MapSfixed32Sfixed32[k] = v
+			}
+		case "mapSfixed64Sfixed64", "map_sfixed64_sfixed64":
+			if seen[81] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[81] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapSfixed64Sfixed64 == nil {
+				m.MapSfixed64Sfixed64 = make(map[int64]int64)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int64Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapSfixed64Sfixed64[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.MapSfixed64Sfixed64[k] = v
+			}
+		case "mapInt32Float", "map_int32_float":
+			if seen[82] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[82] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapInt32Float == nil {
+				m.MapInt32Float = make(map[int32]float32)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int32Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapInt32Float[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadFloat32()
+				if err != nil {
+					return err
+				}
+				m.MapInt32Float[k] = v
+			}
+		case "mapInt32Double", "map_int32_double":
+			if seen[83] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[83] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapInt32Double == nil {
+				m.MapInt32Double = make(map[int32]float64)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int32Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapInt32Double[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadFloat64()
+				if err != nil {
+					return err
+				}
+				m.MapInt32Double[k] = v
+			}
+		case "mapBoolBool", "map_bool_bool":
+			if seen[84] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[84] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapBoolBool == nil {
+				m.MapBoolBool = make(map[bool]bool)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.BoolKey(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.MapBoolBool[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadBool()
+				if err != nil {
+					return err
+				}
+				m.MapBoolBool[k] = v
+			}
+		case "mapStringString", "map_string_string":
+			if seen[85] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[85] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapStringString == nil {
+				m.MapStringString = make(map[string]string)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k := name
+				if _, ok := m.MapStringString[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadString()
+				if err != nil {
+					return err
+				}
+				m.MapStringString[k] = v
+			}
+		case "mapStringBytes", "map_string_bytes":
+			if seen[86] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[86] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapStringBytes == nil {
+				m.MapStringBytes = make(map[string][]byte)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k := name
+				if _, ok := m.MapStringBytes[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadBytes()
+				if err != nil {
+					return err
+				}
+				m.MapStringBytes[k] = v
+			}
+		case "mapStringNestedMessage", "map_string_nested_message":
+			if seen[87] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[87] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapStringNestedMessage == nil {
+				m.MapStringNestedMessage = make(map[string]*TestAllTypesProto3_NestedMessage)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k := name
+				if _, ok := m.MapStringNestedMessage[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v := new(TestAllTypesProto3_NestedMessage)
+				if err := v.UnmarshalJSONFromVT(r); err != nil {
+					return err
+				}
+				m.MapStringNestedMessage[k] = v
+			}
+		case "mapStringForeignMessage", "map_string_foreign_message":
+			if seen[88] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[88] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapStringForeignMessage == nil {
+				m.MapStringForeignMessage = make(map[string]*ForeignMessage)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k := name
+				if _, ok := m.MapStringForeignMessage[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v := new(ForeignMessage)
+				if err := v.UnmarshalJSONFromVT(r); err != nil {
+					return err
+				}
+				m.MapStringForeignMessage[k] = v
+			}
+		case "mapStringNestedEnum", "map_string_nested_enum":
+			if seen[89] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[89] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapStringNestedEnum == nil {
+				m.MapStringNestedEnum = make(map[string]TestAllTypesProto3_NestedEnum)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k := name
+				if _, ok := m.MapStringNestedEnum[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				e, ok, err := r.ReadEnum(TestAllTypesProto3_NestedEnum_value)
+				if err != nil {
+					return err
+				}
+				if ok {
+					v := TestAllTypesProto3_NestedEnum(e)
+					m.MapStringNestedEnum[k] = v
+				}
+			}
+		case "mapStringForeignEnum", "map_string_foreign_enum":
+			if seen[90] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[90] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.MapStringForeignEnum == nil {
+				m.MapStringForeignEnum = make(map[string]ForeignEnum)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k := name
+				if _, ok := m.MapStringForeignEnum[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				e, ok, err := r.ReadEnum(ForeignEnum_value)
+				if err != nil {
+					return err
+				}
+				if ok {
+					v := ForeignEnum(e)
+					m.MapStringForeignEnum[k] = v
+				}
+			}
+		case "oneofUint32", "oneof_uint32":
+			if seen[91] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[91] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[151] {
+				return r.Errorf("oneof protobuf_test_messages.proto3.TestAllTypesProto3.oneof_field is already set")
+			}
+			seen[151] = true
+			v, err := r.ReadUint32()
+			if err != nil {
+				return err
+			}
+			m.OneofField = &TestAllTypesProto3_OneofUint32{OneofUint32: v}
+		case "oneofNestedMessage", "oneof_nested_message":
+			if seen[92] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[92] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[151] {
+				return r.Errorf("oneof protobuf_test_messages.proto3.TestAllTypesProto3.oneof_field is already set")
+			}
+			seen[151] = true
+			v := new(TestAllTypesProto3_NestedMessage)
+			if err := v.UnmarshalJSONFromVT(r); err != nil {
+				return err
+			}
+			m.OneofField = &TestAllTypesProto3_OneofNestedMessage{OneofNestedMessage: v}
+		case "oneofString", "oneof_string":
+			if seen[93] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[93] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[151] {
+				return r.Errorf("oneof protobuf_test_messages.proto3.TestAllTypesProto3.oneof_field is already set")
+			}
+			seen[151] = true
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.OneofField = &TestAllTypesProto3_OneofString{OneofString: v}
+		case "oneofBytes", "oneof_bytes":
+			if seen[94] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[94] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[151] {
+				return r.Errorf("oneof protobuf_test_messages.proto3.TestAllTypesProto3.oneof_field is already set")
+			}
+			seen[151] = true
+			v, err := r.ReadBytes()
+			if err != nil {
+				return err
+			}
+			m.OneofField = &TestAllTypesProto3_OneofBytes{OneofBytes: v}
+		case "oneofBool", "oneof_bool":
+			if seen[95] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[95] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[151] {
+				return r.Errorf("oneof protobuf_test_messages.proto3.TestAllTypesProto3.oneof_field is already set")
+			}
+			seen[151] = true
+			v, err := r.ReadBool()
+			if err != nil {
+				return err
+			}
+			m.OneofField = &TestAllTypesProto3_OneofBool{OneofBool: v}
+		case "oneofUint64", "oneof_uint64":
+			if seen[96] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[96] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[151] {
+				return r.Errorf("oneof protobuf_test_messages.proto3.TestAllTypesProto3.oneof_field is already set")
+			}
+			seen[151] = true
+			v, err := r.ReadUint64()
+			if err != nil {
+				return err
+			}
+			m.OneofField = &TestAllTypesProto3_OneofUint64{OneofUint64: v}
+		case "oneofFloat", "oneof_float":
+			if seen[97] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[97] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[151] {
+				return r.Errorf("oneof protobuf_test_messages.proto3.TestAllTypesProto3.oneof_field is already set")
+			}
+			seen[151] = true
+			v, err := r.ReadFloat32()
+			if err != nil {
+				return err
+			}
+			m.OneofField = &TestAllTypesProto3_OneofFloat{OneofFloat: v}
+		case "oneofDouble", "oneof_double":
+			if seen[98] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[98] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[151] {
+				return r.Errorf("oneof protobuf_test_messages.proto3.TestAllTypesProto3.oneof_field is already set")
+			}
+			seen[151] = true
+			v, err := r.ReadFloat64()
+			if err != nil {
+				return err
+			}
+			m.OneofField = &TestAllTypesProto3_OneofDouble{OneofDouble: v}
+		case "oneofEnum", "oneof_enum":
+			if seen[99] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[99] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[151] {
+				return r.Errorf("oneof protobuf_test_messages.proto3.TestAllTypesProto3.oneof_field is already set")
+			}
+			seen[151] = true
+			e, ok, err := r.ReadEnum(TestAllTypesProto3_NestedEnum_value)
+			if err != nil {
+				return err
+			}
+			if ok {
+				v := TestAllTypesProto3_NestedEnum(e)
+				m.OneofField = &TestAllTypesProto3_OneofEnum{OneofEnum: v}
+			}
+		case "oneofNullValue", "oneof_null_value":
+			if seen[100] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[100] = true
+			if seen[151] {
+				return r.Errorf("oneof protobuf_test_messages.proto3.TestAllTypesProto3.oneof_field is already set")
+			}
+			seen[151] = true
+			e, ok, err := r.ReadNullValue()
+			if err != nil {
+				return err
+			}
+			if ok {
+				v := structpb.NullValue(e)
+				m.OneofField = &TestAllTypesProto3_OneofNullValue{OneofNullValue: v}
+			}
+		case "optionalBoolWrapper", "optional_bool_wrapper":
+			if seen[101] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[101] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(wrapperspb.BoolValue)
+			if err := r.ReadMessage(v); err != nil {
+				return err
+			}
+			m.OptionalBoolWrapper = v
+		case "optionalInt32Wrapper", "optional_int32_wrapper":
+			if seen[102] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[102] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(wrapperspb.Int32Value)
+			if err := r.ReadMessage(v); err != nil {
+				return err
+			}
+			m.OptionalInt32Wrapper = v
+		case "optionalInt64Wrapper", "optional_int64_wrapper":
+			if seen[103] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[103] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(wrapperspb.Int64Value)
+			if err := r.ReadMessage(v); err != nil {
+				return err
+			}
+			m.OptionalInt64Wrapper = v
+		case "optionalUint32Wrapper", "optional_uint32_wrapper":
+			if seen[104] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[104] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(wrapperspb.UInt32Value)
+			if err := r.ReadMessage(v); err != nil {
+				return err
+			}
+			m.OptionalUint32Wrapper = v
+		case "optionalUint64Wrapper", "optional_uint64_wrapper":
+			if seen[105] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[105] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(wrapperspb.UInt64Value)
+			if err := r.ReadMessage(v); err != nil {
+				return err
+			}
+			m.OptionalUint64Wrapper = v
+		case "optionalFloatWrapper", "optional_float_wrapper":
+			if seen[106] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[106] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(wrapperspb.FloatValue)
+			if err := r.ReadMessage(v); err != nil {
+				return err
+			}
+			m.OptionalFloatWrapper = v
+		case "optionalDoubleWrapper", "optional_double_wrapper":
+			if seen[107] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[107] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(wrapperspb.DoubleValue)
+			if err := r.ReadMessage(v); err != nil {
+				return err
+			}
+			m.OptionalDoubleWrapper = v
+		case "optionalStringWrapper", "optional_string_wrapper":
+			if seen[108] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[108] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(wrapperspb.StringValue)
+			if err := r.ReadMessage(v); err != nil {
+				return err
+			}
+			m.OptionalStringWrapper = v
+		case "optionalBytesWrapper", "optional_bytes_wrapper":
+			if seen[109] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[109] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(wrapperspb.BytesValue)
+			if err := r.ReadMessage(v); err != nil {
+				return err
+			}
+			m.OptionalBytesWrapper = v
+		case "repeatedBoolWrapper", "repeated_bool_wrapper":
+			if seen[110] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[110] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(wrapperspb.BoolValue)
+				if err := r.ReadMessage(v); err != nil {
+					return err
+				}
+				m.RepeatedBoolWrapper = append(m.RepeatedBoolWrapper, v)
+			}
+		case "repeatedInt32Wrapper", "repeated_int32_wrapper":
+			if seen[111] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[111] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(wrapperspb.Int32Value)
+				if err := r.ReadMessage(v); err != nil {
+					return err
+				}
+				m.RepeatedInt32Wrapper = append(m.RepeatedInt32Wrapper, v)
+			}
+		case "repeatedInt64Wrapper", "repeated_int64_wrapper":
+			if seen[112] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[112] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(wrapperspb.Int64Value)
+				if err := r.ReadMessage(v); err != nil {
+					return err
+				}
+				m.RepeatedInt64Wrapper = append(m.RepeatedInt64Wrapper, v)
+			}
+		case "repeatedUint32Wrapper", "repeated_uint32_wrapper":
+			if seen[113] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[113] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(wrapperspb.UInt32Value)
+				if err := r.ReadMessage(v); err != nil {
+					return err
+				}
+				m.RepeatedUint32Wrapper = append(m.RepeatedUint32Wrapper, v)
+			}
+		case "repeatedUint64Wrapper", "repeated_uint64_wrapper":
+			if seen[114] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[114] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(wrapperspb.UInt64Value)
+				if err := r.ReadMessage(v); err != nil {
+					return err
+				}
+				m.RepeatedUint64Wrapper = append(m.RepeatedUint64Wrapper, v)
+			}
+		case "repeatedFloatWrapper", "repeated_float_wrapper":
+			if seen[115] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[115] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(wrapperspb.FloatValue)
+				if err := r.ReadMessage(v); err != nil {
+					return err
+				}
+				m.RepeatedFloatWrapper = append(m.RepeatedFloatWrapper, v)
+			}
+		case "repeatedDoubleWrapper", "repeated_double_wrapper":
+			if seen[116] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[116] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(wrapperspb.DoubleValue)
+				if err := r.ReadMessage(v); err != nil {
+					return err
+				}
+				m.RepeatedDoubleWrapper = append(m.RepeatedDoubleWrapper, v)
+			}
+		case "repeatedStringWrapper", "repeated_string_wrapper":
+			if seen[117] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[117] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(wrapperspb.StringValue)
+				if err := r.ReadMessage(v); err != nil {
+					return err
+				}
+				m.RepeatedStringWrapper = append(m.RepeatedStringWrapper, v)
+			}
+		case "repeatedBytesWrapper", "repeated_bytes_wrapper":
+			if seen[118] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[118] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(wrapperspb.BytesValue)
+				if err := r.ReadMessage(v); err != nil {
+					return err
+				}
+				m.RepeatedBytesWrapper = append(m.RepeatedBytesWrapper, v)
+			}
+		case "optionalDuration", "optional_duration":
+			if seen[119] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[119] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(durationpb.Duration)
+			if err := r.ReadMessage(v); err != nil {
+				return err
+			}
+			m.OptionalDuration = v
+		case "optionalTimestamp", "optional_timestamp":
+			if seen[120] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[120] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(timestamppb.Timestamp)
+			if err := r.ReadMessage(v); err != nil {
+				return err
+			}
+			m.OptionalTimestamp = v
+		case "optionalFieldMask", "optional_field_mask":
+			if seen[121] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[121] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(fieldmaskpb.FieldMask)
+			if err := r.ReadMessage(v); err != nil {
+				return err
+			}
+			m.OptionalFieldMask = v
+		case "optionalStruct", "optional_struct":
+			if seen[122] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[122] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(structpb.Struct)
+			if err := r.ReadMessage(v); err != nil {
+				return err
+			}
+			m.OptionalStruct = v
+		case "optionalAny", "optional_any":
+			if seen[123] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[123] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(anypb.Any)
+			if err := r.ReadMessage(v); err != nil {
+				return err
+			}
+			m.OptionalAny = v
+		case "optionalValue", "optional_value":
+			if seen[124] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[124] = true
+			v := new(structpb.Value)
+			if err := r.ReadMessage(v); err != nil {
+				return err
+			}
+			m.OptionalValue = v
+		case "optionalNullValue", "optional_null_value":
+			if seen[125] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[125] = true
+			e, ok, err := r.ReadNullValue()
+			if err != nil {
+				return err
+			}
+			if ok {
+				v := structpb.NullValue(e)
+				m.OptionalNullValue = v
+			}
+		case "repeatedDuration", "repeated_duration":
+			if seen[126] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[126] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(durationpb.Duration)
+				if err := r.ReadMessage(v); err != nil {
+					return err
+				}
+				m.RepeatedDuration = append(m.RepeatedDuration, v)
+			}
+		case "repeatedTimestamp", "repeated_timestamp":
+			if seen[127] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[127] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(timestamppb.Timestamp)
+				if err := r.ReadMessage(v); err != nil {
+					return err
+				}
+				m.RepeatedTimestamp = append(m.RepeatedTimestamp, v)
+			}
+		case "repeatedFieldmask", "repeated_fieldmask":
+			if seen[128] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[128] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(fieldmaskpb.FieldMask)
+				if err := r.ReadMessage(v); err != nil {
+					return err
+				}
+				m.RepeatedFieldmask = append(m.RepeatedFieldmask, v)
+			}
+		case "repeatedStruct", "repeated_struct":
+			if seen[129] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[129] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(structpb.Struct)
+				if err := r.ReadMessage(v); err != nil {
+					return err
+				}
+				m.RepeatedStruct = append(m.RepeatedStruct, v)
+			}
+		case "repeatedAny", "repeated_any":
+			if seen[130] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[130] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(anypb.Any)
+				if err := r.ReadMessage(v); err != nil {
+					return err
+				}
+				m.RepeatedAny = append(m.RepeatedAny, v)
+			}
+		case "repeatedValue", "repeated_value":
+			if seen[131] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[131] = true
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(structpb.Value)
+				if err := r.ReadMessage(v); err != nil {
+					return err
+				}
+				m.RepeatedValue = append(m.RepeatedValue, v)
+			}
+		case "repeatedListValue", "repeated_list_value":
+			if seen[132] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[132] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(structpb.ListValue)
+				if err := r.ReadMessage(v); err != nil {
+					return err
+				}
+				m.RepeatedListValue = append(m.RepeatedListValue, v)
+			}
+		case "fieldname1":
+			if seen[133] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[133] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Fieldname1 = v
+		case "fieldName2", "field_name2":
+			if seen[134] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[134] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.FieldName2 = v
+		case "FieldName3", "_field_name3":
+			if seen[135] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[135] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.XFieldName3 = v
+		case "fieldName4", "field__name4_":
+			if seen[136] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[136] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Field_Name4_ = v
+		case "field0name5":
+			if seen[137] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[137] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Field0Name5 = v
+		case "field0Name6", "field_0_name6":
+			if seen[138] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[138] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Field_0Name6 = v
+		case "fieldName7":
+			if seen[139] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[139] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.FieldName7 = v
+		case "FieldName8":
+			if seen[140] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[140] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.FieldName8 = v
+		case "fieldName9", "field_Name9":
+			if seen[141] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[141] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Field_Name9 = v
+		case "FieldName10", "Field_Name10":
+			if seen[142] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[142] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Field_Name10 = v
+		case "FIELDNAME11", "FIELD_NAME11":
+			if seen[143] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[143] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.FIELD_NAME11 = v
+		case "FIELDName12", "FIELD_name12":
+			if seen[144] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[144] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.FIELDName12 = v
+		case "FieldName13", "__field_name13":
+			if seen[145] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[145] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.XFieldName13 = v
+		case "FieldName14", "__Field_name14":
+			if seen[146] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[146] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.X_FieldName14 = v
+		case "fieldName15", "field__name15":
+			if seen[147] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[147] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Field_Name15 = v
+		case "fieldName16", "field__Name16":
+			if seen[148] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[148] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Field__Name16 = v
+		case "fieldName17", "field_name17__":
+			if seen[149] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[149] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.FieldName17__ = v
+		case "FieldName18", "Field_name18__":
+			if seen[150] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[150] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.FieldName18__ = v
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *ForeignMessage) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *ForeignMessage) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &ForeignMessage{}
+	}
+	w.ObjectStart()
+	if m.C != 0 {
+		w.Field("c", "c")
+		w.Int32(m.C)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("c", "c")
+		w.Int32(m.GetC())
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *ForeignMessage) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *ForeignMessage) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [1]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "c":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.C = v
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *NullHypothesisProto3) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *NullHypothesisProto3) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &NullHypothesisProto3{}
+	}
+	w.ObjectStart()
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *NullHypothesisProto3) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *NullHypothesisProto3) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *EnumOnlyProto3) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *EnumOnlyProto3) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &EnumOnlyProto3{}
+	}
+	w.ObjectStart()
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *EnumOnlyProto3) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *EnumOnlyProto3) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *TestAllTypesProto3_NestedMessage) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto3_NestedMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto3_NestedMessage) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto3_NestedMessage) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto3_NestedMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Corecursive != nil {
+		size, err := m.Corecursive.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x12
+	}
+	if m.A != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.A))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestAllTypesProto3) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto3) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto3) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto3) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if vtmsg, ok := m.OneofField.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if m.FieldName18__ != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.FieldName18__))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x90
+		dAtA[i+1] = 0x1a
+	}
+	if m.FieldName17__ != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.FieldName17__))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x88
+		dAtA[i+1] = 0x1a
+	}
+	if m.Field__Name16 != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Field__Name16))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x80
+		dAtA[i+1] = 0x1a
+	}
+	if m.Field_Name15 != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Field_Name15))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xf8
+		dAtA[i+1] = 0x19
+	}
+	if m.X_FieldName14 != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.X_FieldName14))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xf0
+		dAtA[i+1] = 0x19
+	}
+	if m.XFieldName13 != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.XFieldName13))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xe8
+		dAtA[i+1] = 0x19
+	}
+	if m.FIELDName12 != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.FIELDName12))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xe0
+		dAtA[i+1] = 0x19
+	}
+	if m.FIELD_NAME11 != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.FIELD_NAME11))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xd8
+		dAtA[i+1] = 0x19
+	}
+	if m.Field_Name10 != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Field_Name10))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xd0
+		dAtA[i+1] = 0x19
+	}
+	if m.Field_Name9 != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Field_Name9))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xc8
+		dAtA[i+1] = 0x19
+	}
+	if m.FieldName8 != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.FieldName8))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xc0
+		dAtA[i+1] = 0x19
+	}
+	if m.FieldName7 != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.FieldName7))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xb8
+		dAtA[i+1] = 0x19
+	}
+	if m.Field_0Name6 != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Field_0Name6))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xb0
+		dAtA[i+1] = 0x19
+	}
+	if m.Field0Name5 != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Field0Name5))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xa8
+		dAtA[i+1] = 0x19
+	}
+	if m.Field_Name4_ != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Field_Name4_))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xa0
+		dAtA[i+1] = 0x19
+	}
+	if m.XFieldName3 != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.XFieldName3))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x98
+		dAtA[i+1] = 0x19
+	}
+	if m.FieldName2 != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.FieldName2))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x90
+		dAtA[i+1] = 0x19
+	}
+	if m.Fieldname1 != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Fieldname1))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x88
+		dAtA[i+1] = 0x19
+	}
+	if len(m.RepeatedStruct) > 0 {
+		for iNdEx := len(m.RepeatedStruct) - 1; iNdEx >= 0; iNdEx-- {
+			if vtmsg, ok := interface{}(m.RepeatedStruct[iNdEx]).(interface {
+				MarshalToSizedBufferVT([]byte) (int, error)
+			}); ok {
+				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarint(dAtA, i, uint64(size))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			} else {
+				encoded, err := proto.Marshal(m.RepeatedStruct[iNdEx])
+				if err != nil {
+					return 0, err
+				}
+				i -= len(encoded)
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				copy(dAtA[i:], encoded)
+				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa2
+			dAtA[i+1] = 0x14
+		}
+	}
+	if len(m.RepeatedListValue) > 0 {
+		for iNdEx := len(m.RepeatedListValue) - 1; iNdEx >= 0; iNdEx-- {
+			if vtmsg, ok := interface{}(m.RepeatedListValue[iNdEx]).(interface {
+				MarshalToSizedBufferVT([]byte) (int, error)
+			}); ok {
+				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarint(dAtA, i, uint64(size))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			} else {
+				encoded, err := proto.Marshal(m.RepeatedListValue[iNdEx])
+				if err != nil {
+					return 0, err
+				}
+				i -= len(encoded)
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				copy(dAtA[i:], encoded)
+				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xea
+			dAtA[i+1] = 0x13
+		}
+	}
+	if len(m.RepeatedValue) > 0 {
+		for iNdEx := len(m.RepeatedValue) - 1; iNdEx >= 0; iNdEx-- {
+			if vtmsg, ok := interface{}(m.RepeatedValue[iNdEx]).(interface {
+				MarshalToSizedBufferVT([]byte) (int, error)
+			}); ok {
+				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarint(dAtA, i, uint64(size))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			} else {
+				encoded, err := proto.Marshal(m.RepeatedValue[iNdEx])
+				if err != nil {
+					return 0, err
+				}
+				i -= len(encoded)
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				copy(dAtA[i:], encoded)
+				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xe2
+			dAtA[i+1] = 0x13
+		}
+	}
+	if len(m.RepeatedAny) > 0 {
+		for iNdEx := len(m.RepeatedAny) - 1; iNdEx >= 0; iNdEx-- {
+			if vtmsg, ok := interface{}(m.RepeatedAny[iNdEx]).(interface {
+				MarshalToSizedBufferVT([]byte) (int, error)
+			}); ok {
+				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarint(dAtA, i, uint64(size))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			} else {
+				encoded, err := proto.Marshal(m.RepeatedAny[iNdEx])
+				if err != nil {
+					return 0, err
+				}
+				i -= len(encoded)
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				copy(dAtA[i:], encoded)
+				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xda
+			dAtA[i+1] = 0x13
+		}
+	}
+	if len(m.RepeatedFieldmask) > 0 {
+		for iNdEx := len(m.RepeatedFieldmask) - 1; iNdEx >= 0; iNdEx-- {
+			if vtmsg, ok := interface{}(m.RepeatedFieldmask[iNdEx]).(interface {
+				MarshalToSizedBufferVT([]byte) (int, error)
+			}); ok {
+				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarint(dAtA, i, uint64(size))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			} else {
+				encoded, err := proto.Marshal(m.RepeatedFieldmask[iNdEx])
+				if err != nil {
+					return 0, err
+				}
+				i -= len(encoded)
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				copy(dAtA[i:], encoded)
+				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xca
+			dAtA[i+1] = 0x13
+		}
+	}
+	if len(m.RepeatedTimestamp) > 0 {
+		for iNdEx := len(m.RepeatedTimestamp) - 1; iNdEx >= 0; iNdEx-- {
+			if vtmsg, ok := interface{}(m.RepeatedTimestamp[iNdEx]).(interface {
+				MarshalToSizedBufferVT([]byte) (int, error)
+			}); ok {
+				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarint(dAtA, i, uint64(size))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			} else {
+				encoded, err := proto.Marshal(m.RepeatedTimestamp[iNdEx])
+				if err != nil {
+					return 0, err
+				}
+				i -= len(encoded)
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				copy(dAtA[i:], encoded)
+				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xc2
+			dAtA[i+1] = 0x13
+		}
+	}
+	if len(m.RepeatedDuration) > 0 {
+		for iNdEx := len(m.RepeatedDuration) - 1; iNdEx >= 0; iNdEx-- {
+			if vtmsg, ok := interface{}(m.RepeatedDuration[iNdEx]).(interface {
+				MarshalToSizedBufferVT([]byte) (int, error)
+			}); ok {
+				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarint(dAtA, i, uint64(size))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			} else {
+				encoded, err := proto.Marshal(m.RepeatedDuration[iNdEx])
+				if err != nil {
+					return 0, err
+				}
+				i -= len(encoded)
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				copy(dAtA[i:], encoded)
+				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xba
+			dAtA[i+1] = 0x13
+		}
+	}
+	if m.OptionalNullValue != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.OptionalNullValue))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x98
+		dAtA[i+1] = 0x13
+	}
+	if m.OptionalValue != nil {
+		if vtmsg, ok := interface{}(m.OptionalValue).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		} else {
+			encoded, err := proto.Marshal(m.OptionalValue)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x92
+		dAtA[i+1] = 0x13
+	}
+	if m.OptionalAny != nil {
+		if vtmsg, ok := interface{}(m.OptionalAny).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		} else {
+			encoded, err := proto.Marshal(m.OptionalAny)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8a
+		dAtA[i+1] = 0x13
+	}
+	if m.OptionalStruct != nil {
+		if vtmsg, ok := interface{}(m.OptionalStruct).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		} else {
+			encoded, err := proto.Marshal(m.OptionalStruct)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x82
+		dAtA[i+1] = 0x13
+	}
+	if m.OptionalFieldMask != nil {
+		if vtmsg, ok := interface{}(m.OptionalFieldMask).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		} else {
+			encoded, err := proto.Marshal(m.OptionalFieldMask)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xfa
+		dAtA[i+1] = 0x12
+	}
+	if m.OptionalTimestamp != nil {
+		if vtmsg, ok := interface{}(m.OptionalTimestamp).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		} else {
+			encoded, err := proto.Marshal(m.OptionalTimestamp)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xf2
+		dAtA[i+1] = 0x12
+	}
+	if m.OptionalDuration != nil {
+		if vtmsg, ok := interface{}(m.OptionalDuration).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		} else {
+			encoded, err := proto.Marshal(m.OptionalDuration)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xea
+		dAtA[i+1] = 0x12
+	}
+	if len(m.RepeatedBytesWrapper) > 0 {
+		for iNdEx := len(m.RepeatedBytesWrapper) - 1; iNdEx >= 0; iNdEx-- {
+			if vtmsg, ok := interface{}(m.RepeatedBytesWrapper[iNdEx]).(interface {
+				MarshalToSizedBufferVT([]byte) (int, error)
+			}); ok {
+				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarint(dAtA, i, uint64(size))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			} else {
+				encoded, err := proto.Marshal(m.RepeatedBytesWrapper[iNdEx])
+				if err != nil {
+					return 0, err
+				}
+				i -= len(encoded)
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				copy(dAtA[i:], encoded)
+				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xda
+			dAtA[i+1] = 0xd
+		}
+	}
+	if len(m.RepeatedStringWrapper) > 0 {
+		for iNdEx := len(m.RepeatedStringWrapper) - 1; iNdEx >= 0; iNdEx-- {
+			if vtmsg, ok := interface{}(m.RepeatedStringWrapper[iNdEx]).(interface {
+				MarshalToSizedBufferVT([]byte) (int, error)
+			}); ok {
+				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarint(dAtA, i, uint64(size))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			} else {
+				encoded, err := proto.Marshal(m.RepeatedStringWrapper[iNdEx])
+				if err != nil {
+					return 0, err
+				}
+				i -= len(encoded)
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				copy(dAtA[i:], encoded)
+				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xd2
+			dAtA[i+1] = 0xd
+		}
+	}
+	if len(m.RepeatedDoubleWrapper) > 0 {
+		for iNdEx := len(m.RepeatedDoubleWrapper) - 1; iNdEx >= 0; iNdEx-- {
+			if vtmsg, ok := interface{}(m.RepeatedDoubleWrapper[iNdEx]).(interface {
+				MarshalToSizedBufferVT([]byte) (int, error)
+			}); ok {
+				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarint(dAtA, i, uint64(size))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			} else {
+				encoded, err := proto.Marshal(m.RepeatedDoubleWrapper[iNdEx])
+				if err != nil {
+					return 0, err
+				}
+				i -= len(encoded)
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				copy(dAtA[i:], encoded)
+				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xca
+			dAtA[i+1] = 0xd
+		}
+	}
+	if len(m.RepeatedFloatWrapper) > 0 {
+		for iNdEx := len(m.RepeatedFloatWrapper) - 1; iNdEx >= 0; iNdEx-- {
+			if vtmsg, ok := interface{}(m.RepeatedFloatWrapper[iNdEx]).(interface {
+				MarshalToSizedBufferVT([]byte) (int, error)
+			}); ok {
+				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarint(dAtA, i, uint64(size))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			} else {
+				encoded, err := proto.Marshal(m.RepeatedFloatWrapper[iNdEx])
+				if err != nil {
+					return 0, err
+				}
+				i -= len(encoded)
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				copy(dAtA[i:], encoded)
+				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xc2
+			dAtA[i+1] = 0xd
+		}
+	}
+	if len(m.RepeatedUint64Wrapper) > 0 {
+		for iNdEx := len(m.RepeatedUint64Wrapper) - 1; iNdEx >= 0; iNdEx-- {
+			if vtmsg, ok := interface{}(m.RepeatedUint64Wrapper[iNdEx]).(interface {
+				MarshalToSizedBufferVT([]byte) (int, error)
+			}); ok {
+				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarint(dAtA, i, uint64(size))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			} else {
+				encoded, err := proto.Marshal(m.RepeatedUint64Wrapper[iNdEx])
+				if err != nil {
+					return 0, err
+				}
+				i -= len(encoded)
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				copy(dAtA[i:], encoded)
+				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xba
+			dAtA[i+1] = 0xd
+		}
+	}
+	if len(m.RepeatedUint32Wrapper) > 0 {
+		for iNdEx := len(m.RepeatedUint32Wrapper) - 1; iNdEx >= 0; iNdEx-- {
+			if vtmsg, ok := interface{}(m.RepeatedUint32Wrapper[iNdEx]).(interface {
+				MarshalToSizedBufferVT([]byte) (int, error)
+			}); ok {
+				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarint(dAtA, i, uint64(size))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			} else {
+				encoded, err := proto.Marshal(m.RepeatedUint32Wrapper[iNdEx])
+				if err != nil {
+					return 0, err
+				}
+				i -= len(encoded)
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				copy(dAtA[i:], encoded)
+				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xb2
+			dAtA[i+1] = 0xd
+		}
+	}
+	if len(m.RepeatedInt64Wrapper) > 0 {
+		for iNdEx := len(m.RepeatedInt64Wrapper) - 1; iNdEx >= 0; iNdEx-- {
+			if vtmsg, ok := interface{}(m.RepeatedInt64Wrapper[iNdEx]).(interface {
+				MarshalToSizedBufferVT([]byte) (int, error)
+			}); ok {
+				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarint(dAtA, i, uint64(size))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			} else {
+				encoded, err := proto.Marshal(m.RepeatedInt64Wrapper[iNdEx])
+				if err != nil {
+					return 0, err
+				}
+				i -= len(encoded)
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				copy(dAtA[i:], encoded)
+				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xaa
+			dAtA[i+1] = 0xd
+		}
+	}
+	if len(m.RepeatedInt32Wrapper) > 0 {
+		for iNdEx := len(m.RepeatedInt32Wrapper) - 1; iNdEx >= 0; iNdEx-- {
+			if vtmsg, ok := interface{}(m.RepeatedInt32Wrapper[iNdEx]).(interface {
+				MarshalToSizedBufferVT([]byte) (int, error)
+			}); ok {
+				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarint(dAtA, i, uint64(size))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			} else {
+				encoded, err := proto.Marshal(m.RepeatedInt32Wrapper[iNdEx])
+				if err != nil {
+					return 0, err
+				}
+				i -= len(encoded)
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				copy(dAtA[i:], encoded)
+				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa2
+			dAtA[i+1] = 0xd
+		}
+	}
+	if len(m.RepeatedBoolWrapper) > 0 {
+		for iNdEx := len(m.RepeatedBoolWrapper) - 1; iNdEx >= 0; iNdEx-- {
+			if vtmsg, ok := interface{}(m.RepeatedBoolWrapper[iNdEx]).(interface {
+				MarshalToSizedBufferVT([]byte) (int, error)
+			}); ok {
+				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarint(dAtA, i, uint64(size))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			} else {
+				encoded, err := proto.Marshal(m.RepeatedBoolWrapper[iNdEx])
+				if err != nil {
+					return 0, err
+				}
+				i -= len(encoded)
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				copy(dAtA[i:], encoded)
+				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x9a
+			dAtA[i+1] = 0xd
+		}
+	}
+	if m.OptionalBytesWrapper != nil {
+		if vtmsg, ok := interface{}(m.OptionalBytesWrapper).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		} else {
+			encoded, err := proto.Marshal(m.OptionalBytesWrapper)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8a
+		dAtA[i+1] = 0xd
+	}
+	if m.OptionalStringWrapper != nil {
+		if vtmsg, ok := interface{}(m.OptionalStringWrapper).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		} else {
+			encoded, err := proto.Marshal(m.OptionalStringWrapper)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x82
+		dAtA[i+1] = 0xd
+	}
+	if m.OptionalDoubleWrapper != nil {
+		if vtmsg, ok := interface{}(m.OptionalDoubleWrapper).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		} else {
+			encoded, err := proto.Marshal(m.OptionalDoubleWrapper)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xfa
+		dAtA[i+1] = 0xc
+	}
+	if m.OptionalFloatWrapper != nil {
+		if vtmsg, ok := interface{}(m.OptionalFloatWrapper).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		} else {
+			encoded, err := proto.Marshal(m.OptionalFloatWrapper)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xf2
+		dAtA[i+1] = 0xc
+	}
+	if m.OptionalUint64Wrapper != nil {
+		if vtmsg, ok := interface{}(m.OptionalUint64Wrapper).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		} else {
+			encoded, err := proto.Marshal(m.OptionalUint64Wrapper)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xea
+		dAtA[i+1] = 0xc
+	}
+	if m.OptionalUint32Wrapper != nil {
+		if vtmsg, ok := interface{}(m.OptionalUint32Wrapper).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		} else {
+			encoded, err := proto.Marshal(m.OptionalUint32Wrapper)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xe2
+		dAtA[i+1] = 0xc
+	}
+	if m.OptionalInt64Wrapper != nil {
+		if vtmsg, ok := interface{}(m.OptionalInt64Wrapper).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		} else {
+			encoded, err := proto.Marshal(m.OptionalInt64Wrapper)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xda
+		dAtA[i+1] = 0xc
+	}
+	if m.OptionalInt32Wrapper != nil {
+		if vtmsg, ok := interface{}(m.OptionalInt32Wrapper).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		} else {
+			encoded, err := proto.Marshal(m.OptionalInt32Wrapper)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xd2
+		dAtA[i+1] = 0xc
+	}
+	if m.OptionalBoolWrapper != nil {
+		if vtmsg, ok := interface{}(m.OptionalBoolWrapper).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		} else {
+			encoded, err := proto.Marshal(m.OptionalBoolWrapper)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xca
+		dAtA[i+1] = 0xc
+	}
+	if len(m.UnpackedNestedEnum) > 0 {
+		for iNdEx := len(m.UnpackedNestedEnum) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.UnpackedNestedEnum[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xb0
+			dAtA[i+1] = 0x6
+		}
+	}
+	if len(m.UnpackedBool) > 0 {
+		for iNdEx := len(m.UnpackedBool) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			if m.UnpackedBool[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa8
+			dAtA[i+1] = 0x6
+		}
+	}
+	if len(m.UnpackedDouble) > 0 {
+		for iNdEx := len(m.UnpackedDouble) - 1; iNdEx >= 0; iNdEx-- {
+			f1 := math.Float64bits(float64(m.UnpackedDouble[iNdEx]))
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f1))
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa1
+			dAtA[i+1] = 0x6
+		}
+	}
+	if len(m.UnpackedFloat) > 0 {
+		for iNdEx := len(m.UnpackedFloat) - 1; iNdEx >= 0; iNdEx-- {
+			f2 := math.Float32bits(float32(m.UnpackedFloat[iNdEx]))
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f2))
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x9d
+			dAtA[i+1] = 0x6
+		}
+	}
+	if len(m.UnpackedSfixed64) > 0 {
+		for iNdEx := len(m.UnpackedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.UnpackedSfixed64[iNdEx]))
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x91
+			dAtA[i+1] = 0x6
+		}
+	}
+	if len(m.UnpackedSfixed32) > 0 {
+		for iNdEx := len(m.UnpackedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.UnpackedSfixed32[iNdEx]))
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8d
+			dAtA[i+1] = 0x6
+		}
+	}
+	if len(m.UnpackedFixed64) > 0 {
+		for iNdEx := len(m.UnpackedFixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.UnpackedFixed64[iNdEx]))
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x81
+			dAtA[i+1] = 0x6
+		}
+	}
+	if len(m.UnpackedFixed32) > 0 {
+		for iNdEx := len(m.UnpackedFixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.UnpackedFixed32[iNdEx]))
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xfd
+			dAtA[i+1] = 0x5
+		}
+	}
+	if len(m.UnpackedSint64) > 0 {
+		for iNdEx := len(m.UnpackedSint64) - 1; iNdEx >= 0; iNdEx-- {
+			x3 := (uint64(m.UnpackedSint64[iNdEx]) << 1) ^ uint64((m.UnpackedSint64[iNdEx] >> 63))
+			i = encodeVarint(dAtA, i, uint64(x3))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xf0
+			dAtA[i+1] = 0x5
+		}
+	}
+	if len(m.UnpackedSint32) > 0 {
+		for iNdEx := len(m.UnpackedSint32) - 1; iNdEx >= 0; iNdEx-- {
+			x4 := (uint32(m.UnpackedSint32[iNdEx]) << 1) ^ uint32((m.UnpackedSint32[iNdEx] >> 31))
+			i = encodeVarint(dAtA, i, uint64(x4))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xe8
+			dAtA[i+1] = 0x5
+		}
+	}
+	if len(m.UnpackedUint64) > 0 {
+		for iNdEx := len(m.UnpackedUint64) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.UnpackedUint64[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xe0
+			dAtA[i+1] = 0x5
+		}
+	}
+	if len(m.UnpackedUint32) > 0 {
+		for iNdEx := len(m.UnpackedUint32) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.UnpackedUint32[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xd8
+			dAtA[i+1] = 0x5
+		}
+	}
+	if len(m.UnpackedInt64) > 0 {
+		for iNdEx := len(m.UnpackedInt64) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.UnpackedInt64[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xd0
+			dAtA[i+1] = 0x5
+		}
+	}
+	if len(m.UnpackedInt32) > 0 {
+		for iNdEx := len(m.UnpackedInt32) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.UnpackedInt32[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xc8
+			dAtA[i+1] = 0x5
+		}
+	}
+	if len(m.PackedNestedEnum) > 0 {
+		var pksize6 int
+		for _, num := range m.PackedNestedEnum {
+			pksize6 += sov(uint64(num))
+		}
+		i -= pksize6
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j5 := i
+		for _, num1 := range m.PackedNestedEnum {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA[j5] = uint8(num)
+			j5++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize6))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xc2
+		dAtA[i+1] = 0x5
+	}
+	if len(m.PackedBool) > 0 {
+		for iNdEx := len(m.PackedBool) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			if m.PackedBool[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedBool)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xba
+		dAtA[i+1] = 0x5
+	}
+	if len(m.PackedDouble) > 0 {
+		for iNdEx := len(m.PackedDouble) - 1; iNdEx >= 0; iNdEx-- {
+			f7 := math.Float64bits(float64(m.PackedDouble[iNdEx]))
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f7))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedDouble)*8))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xb2
+		dAtA[i+1] = 0x5
+	}
+	if len(m.PackedFloat) > 0 {
+		for iNdEx := len(m.PackedFloat) - 1; iNdEx >= 0; iNdEx-- {
+			f8 := math.Float32bits(float32(m.PackedFloat[iNdEx]))
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f8))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedFloat)*4))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xaa
+		dAtA[i+1] = 0x5
+	}
+	if len(m.PackedSfixed64) > 0 {
+		for iNdEx := len(m.PackedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.PackedSfixed64[iNdEx]))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedSfixed64)*8))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xa2
+		dAtA[i+1] = 0x5
+	}
+	if len(m.PackedSfixed32) > 0 {
+		for iNdEx := len(m.PackedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.PackedSfixed32[iNdEx]))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedSfixed32)*4))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x9a
+		dAtA[i+1] = 0x5
+	}
+	if len(m.PackedFixed64) > 0 {
+		for iNdEx := len(m.PackedFixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.PackedFixed64[iNdEx]))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedFixed64)*8))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x92
+		dAtA[i+1] = 0x5
+	}
+	if len(m.PackedFixed32) > 0 {
+		for iNdEx := len(m.PackedFixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.PackedFixed32[iNdEx]))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedFixed32)*4))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8a
+		dAtA[i+1] = 0x5
+	}
+	if len(m.PackedSint64) > 0 {
+		var pksize10 int
+		for _, num := range m.PackedSint64 {
+			pksize10 += soz(uint64(num))
+		}
+		i -= pksize10
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j9 := i
+		for _, num := range m.PackedSint64 {
+			x11 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x11 >= 1<<7 {
+				dAtA[j9] = uint8(uint64(x11)&0x7f | 0x80)
+				j9++
+				x11 >>= 7
+			}
+			dAtA[j9] = uint8(x11)
+			j9++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize10))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x82
+		dAtA[i+1] = 0x5
+	}
+	if len(m.PackedSint32) > 0 {
+		var pksize13 int
+		for _, num := range m.PackedSint32 {
+			pksize13 += soz(uint64(num))
+		}
+		i -= pksize13
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j12 := i
+		for _, num := range m.PackedSint32 {
+			x14 := (uint32(num) << 1) ^ uint32((num >> 31))
+			for x14 >= 1<<7 {
+				dAtA[j12] = uint8(uint64(x14)&0x7f | 0x80)
+				j12++
+				x14 >>= 7
+			}
+			dAtA[j12] = uint8(x14)
+			j12++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize13))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xfa
+		dAtA[i+1] = 0x4
+	}
+	if len(m.PackedUint64) > 0 {
+		var pksize16 int
+		for _, num := range m.PackedUint64 {
+			pksize16 += sov(uint64(num))
+		}
+		i -= pksize16
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j15 := i
+		for _, num := range m.PackedUint64 {
+			for num >= 1<<7 {
+				dAtA[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA[j15] = uint8(num)
+			j15++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize16))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xf2
+		dAtA[i+1] = 0x4
+	}
+	if len(m.PackedUint32) > 0 {
+		var pksize18 int
+		for _, num := range m.PackedUint32 {
+			pksize18 += sov(uint64(num))
+		}
+		i -= pksize18
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j17 := i
+		for _, num := range m.PackedUint32 {
+			for num >= 1<<7 {
+				dAtA[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA[j17] = uint8(num)
+			j17++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize18))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xea
+		dAtA[i+1] = 0x4
+	}
+	if len(m.PackedInt64) > 0 {
+		var pksize20 int
+		for _, num := range m.PackedInt64 {
+			pksize20 += sov(uint64(num))
+		}
+		i -= pksize20
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j19 := i
+		for _, num1 := range m.PackedInt64 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA[j19] = uint8(num)
+			j19++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize20))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xe2
+		dAtA[i+1] = 0x4
+	}
+	if len(m.PackedInt32) > 0 {
+		var pksize22 int
+		for _, num := range m.PackedInt32 {
+			pksize22 += sov(uint64(num))
+		}
+		i -= pksize22
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j21 := i
+		for _, num1 := range m.PackedInt32 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j21] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j21++
+			}
+			dAtA[j21] = uint8(num)
+			j21++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize22))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xda
+		dAtA[i+1] = 0x4
+	}
+	if len(m.MapStringForeignEnum) > 0 {
+		for k := range m.MapStringForeignEnum {
+			v := m.MapStringForeignEnum[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i -= len(k)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xd2
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapStringNestedEnum) > 0 {
+		for k := range m.MapStringNestedEnum {
+			v := m.MapStringNestedEnum[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i -= len(k)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xca
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapStringForeignMessage) > 0 {
+		for k := range m.MapStringForeignMessage {
+			v := m.MapStringForeignMessage[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+			i -= len(k)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xc2
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapStringNestedMessage) > 0 {
+		for k := range m.MapStringNestedMessage {
+			v := m.MapStringNestedMessage[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+			i -= len(k)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xba
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapStringBytes) > 0 {
+		for k := range m.MapStringBytes {
+			v := m.MapStringBytes[k]
+			baseI := i
+			i -= len(v)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+			i -= len(k)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xb2
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapStringString) > 0 {
+		for k := range m.MapStringString {
+			v := m.MapStringString[k]
+			baseI := i
+			i -= len(v)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+			i -= len(k)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xaa
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapBoolBool) > 0 {
+		for k := range m.MapBoolBool {
+			v := m.MapBoolBool[k]
+			baseI := i
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			if k {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa2
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapInt32Double) > 0 {
+		for k := range m.MapInt32Double {
+			v := m.MapInt32Double[k]
+			baseI := i
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x11
+			i = encodeVarint(dAtA, i, uint64(k))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x9a
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapInt32Float) > 0 {
+		for k := range m.MapInt32Float {
+			v := m.MapInt32Float[k]
+			baseI := i
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x15
+			i = encodeVarint(dAtA, i, uint64(k))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x92
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapSfixed64Sfixed64) > 0 {
+		for k := range m.MapSfixed64Sfixed64 {
+			v := m.MapSfixed64Sfixed64[k]
+			baseI := i
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x11
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x9
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8a
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapSfixed32Sfixed32) > 0 {
+		for k := range m.MapSfixed32Sfixed32 {
+			v := m.MapSfixed32Sfixed32[k]
+			baseI := i
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x15
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xd
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x82
+			dAtA[i+1] = 0x4
+		}
+	}
+	if len(m.MapFixed64Fixed64) > 0 {
+		for k := range m.MapFixed64Fixed64 {
+			v := m.MapFixed64Fixed64[k]
+			baseI := i
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x11
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x9
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xfa
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.MapFixed32Fixed32) > 0 {
+		for k := range m.MapFixed32Fixed32 {
+			v := m.MapFixed32Fixed32[k]
+			baseI := i
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x15
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xd
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xf2
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.MapSint64Sint64) > 0 {
+		for k := range m.MapSint64Sint64 {
+			v := m.MapSint64Sint64[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i = encodeVarint(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xea
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.MapSint32Sint32) > 0 {
+		for k := range m.MapSint32Sint32 {
+			v := m.MapSint32Sint32[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i = encodeVarint(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xe2
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.MapUint64Uint64) > 0 {
+		for k := range m.MapUint64Uint64 {
+			v := m.MapUint64Uint64[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i = encodeVarint(dAtA, i, uint64(k))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xda
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.MapUint32Uint32) > 0 {
+		for k := range m.MapUint32Uint32 {
+			v := m.MapUint32Uint32[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i = encodeVarint(dAtA, i, uint64(k))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xd2
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.MapInt64Int64) > 0 {
+		for k := range m.MapInt64Int64 {
+			v := m.MapInt64Int64[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i = encodeVarint(dAtA, i, uint64(k))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xca
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.MapInt32Int32) > 0 {
+		for k := range m.MapInt32Int32 {
+			v := m.MapInt32Int32[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i = encodeVarint(dAtA, i, uint64(k))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xc2
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.RepeatedCord) > 0 {
+		for iNdEx := len(m.RepeatedCord) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RepeatedCord[iNdEx])
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], m.RepeatedCord[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.RepeatedCord[iNdEx])))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xba
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.RepeatedStringPiece) > 0 {
+		for iNdEx := len(m.RepeatedStringPiece) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RepeatedStringPiece[iNdEx])
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], m.RepeatedStringPiece[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.RepeatedStringPiece[iNdEx])))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xb2
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.RepeatedForeignEnum) > 0 {
+		var pksize24 int
+		for _, num := range m.RepeatedForeignEnum {
+			pksize24 += sov(uint64(num))
+		}
+		i -= pksize24
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j23 := i
+		for _, num1 := range m.RepeatedForeignEnum {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j23] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j23++
+			}
+			dAtA[j23] = uint8(num)
+			j23++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize24))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xa2
+		dAtA[i+1] = 0x3
+	}
+	if len(m.RepeatedNestedEnum) > 0 {
+		var pksize26 int
+		for _, num := range m.RepeatedNestedEnum {
+			pksize26 += sov(uint64(num))
+		}
+		i -= pksize26
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j25 := i
+		for _, num1 := range m.RepeatedNestedEnum {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j25] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j25++
+			}
+			dAtA[j25] = uint8(num)
+			j25++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize26))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x9a
+		dAtA[i+1] = 0x3
+	}
+	if len(m.RepeatedForeignMessage) > 0 {
+		for iNdEx := len(m.RepeatedForeignMessage) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.RepeatedForeignMessage[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8a
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.RepeatedNestedMessage) > 0 {
+		for iNdEx := len(m.RepeatedNestedMessage) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.RepeatedNestedMessage[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x82
+			dAtA[i+1] = 0x3
+		}
+	}
+	if len(m.RepeatedBytes) > 0 {
+		for iNdEx := len(m.RepeatedBytes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RepeatedBytes[iNdEx])
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], m.RepeatedBytes[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.RepeatedBytes[iNdEx])))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xea
+			dAtA[i+1] = 0x2
+		}
+	}
+	if len(m.RepeatedString) > 0 {
+		for iNdEx := len(m.RepeatedString) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RepeatedString[iNdEx])
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], m.RepeatedString[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.RepeatedString[iNdEx])))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xe2
+			dAtA[i+1] = 0x2
+		}
+	}
+	if len(m.RepeatedBool) > 0 {
+		for iNdEx := len(m.RepeatedBool) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			if m.RepeatedBool[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.RepeatedBool)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xda
+		dAtA[i+1] = 0x2
+	}
+	if len(m.RepeatedDouble) > 0 {
+		for iNdEx := len(m.RepeatedDouble) - 1; iNdEx >= 0; iNdEx-- {
+			f27 := math.Float64bits(float64(m.RepeatedDouble[iNdEx]))
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f27))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.RepeatedDouble)*8))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xd2
+		dAtA[i+1] = 0x2
+	}
+	if len(m.RepeatedFloat) > 0 {
+		for iNdEx := len(m.RepeatedFloat) - 1; iNdEx >= 0; iNdEx-- {
+			f28 := math.Float32bits(float32(m.RepeatedFloat[iNdEx]))
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f28))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.RepeatedFloat)*4))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xca
+		dAtA[i+1] = 0x2
+	}
+	if len(m.RepeatedSfixed64) > 0 {
+		for iNdEx := len(m.RepeatedSfixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.RepeatedSfixed64[iNdEx]))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.RepeatedSfixed64)*8))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xc2
+		dAtA[i+1] = 0x2
+	}
+	if len(m.RepeatedSfixed32) > 0 {
+		for iNdEx := len(m.RepeatedSfixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.RepeatedSfixed32[iNdEx]))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.RepeatedSfixed32)*4))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xba
+		dAtA[i+1] = 0x2
+	}
+	if len(m.RepeatedFixed64) > 0 {
+		for iNdEx := len(m.RepeatedFixed64) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.RepeatedFixed64[iNdEx]))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.RepeatedFixed64)*8))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xb2
+		dAtA[i+1] = 0x2
+	}
+	if len(m.RepeatedFixed32) > 0 {
+		for iNdEx := len(m.RepeatedFixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.RepeatedFixed32[iNdEx]))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.RepeatedFixed32)*4))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xaa
+		dAtA[i+1] = 0x2
+	}
+	if len(m.RepeatedSint64) > 0 {
+		var pksize30 int
+		for _, num := range m.RepeatedSint64 {
+			pksize30 += soz(uint64(num))
+		}
+		i -= pksize30
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j29 := i
+		for _, num := range m.RepeatedSint64 {
+			x31 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x31 >= 1<<7 {
+				dAtA[j29] = uint8(uint64(x31)&0x7f | 0x80)
+				j29++
+				x31 >>= 7
+			}
+			dAtA[j29] = uint8(x31)
+			j29++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize30))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xa2
+		dAtA[i+1] = 0x2
+	}
+	if len(m.RepeatedSint32) > 0 {
+		var pksize33 int
+		for _, num := range m.RepeatedSint32 {
+			pksize33 += soz(uint64(num))
+		}
+		i -= pksize33
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j32 := i
+		for _, num := range m.RepeatedSint32 {
+			x34 := (uint32(num) << 1) ^ uint32((num >> 31))
+			for x34 >= 1<<7 {
+				dAtA[j32] = uint8(uint64(x34)&0x7f | 0x80)
+				j32++
+				x34 >>= 7
+			}
+			dAtA[j32] = uint8(x34)
+			j32++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize33))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x9a
+		dAtA[i+1] = 0x2
+	}
+	if len(m.RepeatedUint64) > 0 {
+		var pksize36 int
+		for _, num := range m.RepeatedUint64 {
+			pksize36 += sov(uint64(num))
+		}
+		i -= pksize36
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j35 := i
+		for _, num := range m.RepeatedUint64 {
+			for num >= 1<<7 {
+				dAtA[j35] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j35++
+			}
+			dAtA[j35] = uint8(num)
+			j35++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize36))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x92
+		dAtA[i+1] = 0x2
+	}
+	if len(m.RepeatedUint32) > 0 {
+		var pksize38 int
+		for _, num := range m.RepeatedUint32 {
+			pksize38 += sov(uint64(num))
+		}
+		i -= pksize38
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j37 := i
+		for _, num := range m.RepeatedUint32 {
+			for num >= 1<<7 {
+				dAtA[j37] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j37++
+			}
+			dAtA[j37] = uint8(num)
+			j37++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize38))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8a
+		dAtA[i+1] = 0x2
+	}
+	if len(m.RepeatedInt64) > 0 {
+		var pksize40 int
+		for _, num := range m.RepeatedInt64 {
+			pksize40 += sov(uint64(num))
+		}
+		i -= pksize40
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j39 := i
+		for _, num1 := range m.RepeatedInt64 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j39] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j39++
+			}
+			dAtA[j39] = uint8(num)
+			j39++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize40))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x82
+		dAtA[i+1] = 0x2
+	}
+	if len(m.RepeatedInt32) > 0 {
+		var pksize42 int
+		for _, num := range m.RepeatedInt32 {
+			pksize42 += sov(uint64(num))
+		}
+		i -= pksize42
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j41 := i
+		for _, num1 := range m.RepeatedInt32 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j41] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j41++
+			}
+			dAtA[j41] = uint8(num)
+			j41++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize42))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xfa
+		dAtA[i+1] = 0x1
+	}
+	if m.RecursiveMessage != nil {
+		size, err := m.RecursiveMessage.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xda
+		dAtA[i+1] = 0x1
+	}
+	if len(m.OptionalCord) > 0 {
+		i -= len(m.OptionalCord)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.OptionalCord)
+		i = encodeVarint(dAtA, i, uint64(len(m.OptionalCord)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xca
+		dAtA[i+1] = 0x1
+	}
+	if len(m.OptionalStringPiece) > 0 {
+		i -= len(m.OptionalStringPiece)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.OptionalStringPiece)
+		i = encodeVarint(dAtA, i, uint64(len(m.OptionalStringPiece)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xc2
+		dAtA[i+1] = 0x1
+	}
+	if m.OptionalAliasedEnum != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.OptionalAliasedEnum))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xb8
+		dAtA[i+1] = 0x1
+	}
+	if m.OptionalForeignEnum != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.OptionalForeignEnum))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xb0
+		dAtA[i+1] = 0x1
+	}
+	if m.OptionalNestedEnum != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.OptionalNestedEnum))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xa8
+		dAtA[i+1] = 0x1
+	}
+	if m.OptionalForeignMessage != nil {
+		size, err := m.OptionalForeignMessage.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x9a
+		dAtA[i+1] = 0x1
+	}
+	if m.OptionalNestedMessage != nil {
+		size, err := m.OptionalNestedMessage.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x92
+		dAtA[i+1] = 0x1
+	}
+	if len(m.OptionalBytes) > 0 {
+		i -= len(m.OptionalBytes)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.OptionalBytes)
+		i = encodeVarint(dAtA, i, uint64(len(m.OptionalBytes)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x7a
+	}
+	if len(m.OptionalString) > 0 {
+		i -= len(m.OptionalString)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.OptionalString)
+		i = encodeVarint(dAtA, i, uint64(len(m.OptionalString)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x72
+	}
+	if m.OptionalBool {
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		if m.OptionalBool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x68
+	}
+	if m.OptionalDouble != 0 {
+		i -= 8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.OptionalDouble))))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x61
+	}
+	if m.OptionalFloat != 0 {
+		i -= 4
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OptionalFloat))))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x5d
+	}
+	if m.OptionalSfixed64 != 0 {
+		i -= 8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.OptionalSfixed64))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x51
+	}
+	if m.OptionalSfixed32 != 0 {
+		i -= 4
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.OptionalSfixed32))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x4d
+	}
+	if m.OptionalFixed64 != 0 {
+		i -= 8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.OptionalFixed64))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x41
+	}
+	if m.OptionalFixed32 != 0 {
+		i -= 4
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.OptionalFixed32))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x3d
+	}
+	if m.OptionalSint64 != 0 {
+		i = encodeVarint(dAtA, i, uint64((uint64(m.OptionalSint64)<<1)^uint64((m.OptionalSint64>>63))))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x30
+	}
+	if m.OptionalSint32 != 0 {
+		i = encodeVarint(dAtA, i, uint64((uint32(m.OptionalSint32)<<1)^uint32((m.OptionalSint32>>31))))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x28
+	}
+	if m.OptionalUint64 != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.OptionalUint64))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x20
+	}
+	if m.OptionalUint32 != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.OptionalUint32))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x18
+	}
+	if m.OptionalInt64 != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.OptionalInt64))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x10
+	}
+	if m.OptionalInt32 != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.OptionalInt32))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestAllTypesProto3_OneofUint32) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto3_OneofUint32) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto3_OneofUint32) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarint(dAtA, i, uint64(m.OneofUint32))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i -= 2
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0xf8
+	dAtA[i+1] = 0x6
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto3_OneofNestedMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto3_OneofNestedMessage) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto3_OneofNestedMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OneofNestedMessage != nil {
+		size, err := m.OneofNestedMessage.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x82
+		dAtA[i+1] = 0x7
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto3_OneofString) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto3_OneofString) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto3_OneofString) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.OneofString)
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	copy(dAtA[i:], m.OneofString)
+	i = encodeVarint(dAtA, i, uint64(len(m.OneofString)))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i -= 2
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0x8a
+	dAtA[i+1] = 0x7
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto3_OneofBytes) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto3_OneofBytes) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto3_OneofBytes) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.OneofBytes)
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	copy(dAtA[i:], m.OneofBytes)
+	i = encodeVarint(dAtA, i, uint64(len(m.OneofBytes)))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i -= 2
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0x92
+	dAtA[i+1] = 0x7
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto3_OneofBool) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto3_OneofBool) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto3_OneofBool) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i--
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	if m.OneofBool {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i -= 2
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0x98
+	dAtA[i+1] = 0x7
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto3_OneofUint64) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto3_OneofUint64) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto3_OneofUint64) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarint(dAtA, i, uint64(m.OneofUint64))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i -= 2
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0xa0
+	dAtA[i+1] = 0x7
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto3_OneofFloat) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto3_OneofFloat) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto3_OneofFloat) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 4
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.OneofFloat))))
+	i -= 2
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0xad
+	dAtA[i+1] = 0x7
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto3_OneofDouble) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto3_OneofDouble) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto3_OneofDouble) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 8
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.OneofDouble))))
+	i -= 2
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0xb1
+	dAtA[i+1] = 0x7
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto3_OneofEnum) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto3_OneofEnum) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto3_OneofEnum) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarint(dAtA, i, uint64(m.OneofEnum))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i -= 2
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0xb8
+	dAtA[i+1] = 0x7
+	return len(dAtA) - i, nil
+}
+func (m *TestAllTypesProto3_OneofNullValue) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *TestAllTypesProto3_OneofNullValue) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *TestAllTypesProto3_OneofNullValue) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarint(dAtA, i, uint64(m.OneofNullValue))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i -= 2
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0xc0
+	dAtA[i+1] = 0x7
+	return len(dAtA) - i, nil
+}
+func (m *ForeignMessage) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ForeignMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *ForeignMessage) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ForeignMessage) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ForeignMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.C != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.C))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NullHypothesisProto3) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *NullHypothesisProto3) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *NullHypothesisProto3) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *NullHypothesisProto3) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *NullHypothesisProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnumOnlyProto3) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *EnumOnlyProto3) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *EnumOnlyProto3) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *EnumOnlyProto3) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *EnumOnlyProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TestAllTypesProto3_NestedMessage) MarshalVTPooled() (*vtproto.Buffer, error) {
+	size := m.SizeVT()
+	buf := vtproto.NewBuffer(size)
+	n, err := m.MarshalToSizedBufferVT(buf.Bytes())
+	if err == nil && n != size {
+		err = ErrSizeMismatch
+	}
+	if err != nil {
+		buf.Free()
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (m *TestAllTypesProto3) MarshalVTPooled() (*vtproto.Buffer, error) {
+	size := m.SizeVT()
+	buf := vtproto.NewBuffer(size)
+	n, err := m.MarshalToSizedBufferVT(buf.Bytes())
+	if err == nil && n != size {
+		err = ErrSizeMismatch
+	}
+	if err != nil {
+		buf.Free()
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (m *ForeignMessage) MarshalVTPooled() (*vtproto.Buffer, error) {
+	size := m.SizeVT()
+	buf := vtproto.NewBuffer(size)
+	n, err := m.MarshalToSizedBufferVT(buf.Bytes())
+	if err == nil && n != size {
+		err = ErrSizeMismatch
+	}
+	if err != nil {
+		buf.Free()
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (m *NullHypothesisProto3) MarshalVTPooled() (*vtproto.Buffer, error) {
+	size := m.SizeVT()
+	buf := vtproto.NewBuffer(size)
+	n, err := m.MarshalToSizedBufferVT(buf.Bytes())
+	if err == nil && n != size {
+		err = ErrSizeMismatch
+	}
+	if err != nil {
+		buf.Free()
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (m *EnumOnlyProto3) MarshalVTPooled() (*vtproto.Buffer, error) {
+	size := m.SizeVT()
+	buf := vtproto.NewBuffer(size)
+	n, err := m.MarshalToSizedBufferVT(buf.Bytes())
+	if err == nil && n != size {
+		err = ErrSizeMismatch
+	}
+	if err != nil {
+		buf.Free()
+		return nil, err
+	}
+	return buf, nil
+}
+
+func (m *TestAllTypesProto3_NestedMessage) MergeVT(src *TestAllTypesProto3_NestedMessage) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.A != 0 {
+		m.A = src.A
+	}
+	if src.Corecursive != nil {
+		if m.Corecursive == nil {
+			m.Corecursive = &TestAllTypesProto3{}
+		}
+		m.Corecursive.MergeVT(src.Corecursive)
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *TestAllTypesProto3_NestedMessage) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*TestAllTypesProto3_NestedMessage); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *TestAllTypesProto3) MergeVT(src *TestAllTypesProto3) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.OptionalInt32 != 0 {
+		m.OptionalInt32 = src.OptionalInt32
+	}
+	if src.OptionalInt64 != 0 {
+		m.OptionalInt64 = src.OptionalInt64
+	}
+	if src.OptionalUint32 != 0 {
+		m.OptionalUint32 = src.OptionalUint32
+	}
+	if src.OptionalUint64 != 0 {
+		m.OptionalUint64 = src.OptionalUint64
+	}
+	if src.OptionalSint32 != 0 {
+		m.OptionalSint32 = src.OptionalSint32
+	}
+	if src.OptionalSint64 != 0 {
+		m.OptionalSint64 = src.OptionalSint64
+	}
+	if src.OptionalFixed32 != 0 {
+		m.OptionalFixed32 = src.OptionalFixed32
+	}
+	if src.OptionalFixed64 != 0 {
+		m.OptionalFixed64 = src.OptionalFixed64
+	}
+	if src.OptionalSfixed32 != 0 {
+		m.OptionalSfixed32 = src.OptionalSfixed32
+	}
+	if src.OptionalSfixed64 != 0 {
+		m.OptionalSfixed64 = src.OptionalSfixed64
+	}
+	if src.OptionalFloat != 0 {
+		m.OptionalFloat = src.OptionalFloat
+	}
+	if src.OptionalDouble != 0 {
+		m.OptionalDouble = src.OptionalDouble
+	}
+	if src.OptionalBool {
+		m.OptionalBool = true
+	}
+	if src.OptionalString != "" {
+		m.OptionalString = src.OptionalString
+	}
+	if len(src.OptionalBytes) > 0 {
+		m.OptionalBytes = append([]byte{}, src.OptionalBytes...)
+	}
+	if src.OptionalNestedMessage != nil {
+		if m.OptionalNestedMessage == nil {
+			m.OptionalNestedMessage = &TestAllTypesProto3_NestedMessage{}
+		}
+		m.OptionalNestedMessage.MergeVT(src.OptionalNestedMessage)
+	}
+	if src.OptionalForeignMessage != nil {
+		if m.OptionalForeignMessage == nil {
+			m.OptionalForeignMessage = &ForeignMessage{}
+		}
+		m.OptionalForeignMessage.MergeVT(src.OptionalForeignMessage)
+	}
+	if src.OptionalNestedEnum != 0 {
+		m.OptionalNestedEnum = src.OptionalNestedEnum
+	}
+	if src.OptionalForeignEnum != 0 {
+		m.OptionalForeignEnum = src.OptionalForeignEnum
+	}
+	if src.OptionalAliasedEnum != 0 {
+		m.OptionalAliasedEnum = src.OptionalAliasedEnum
+	}
+	if src.OptionalStringPiece != "" {
+		m.OptionalStringPiece = src.OptionalStringPiece
+	}
+	if src.OptionalCord != "" {
+		m.OptionalCord = src.OptionalCord
+	}
+	if src.RecursiveMessage != nil {
+		if m.RecursiveMessage == nil {
+			m.RecursiveMessage = &TestAllTypesProto3{}
+		}
+		m.RecursiveMessage.MergeVT(src.RecursiveMessage)
+	}
+	m.RepeatedInt32 = append(m.RepeatedInt32, src.RepeatedInt32...)
+	m.RepeatedInt64 = append(m.RepeatedInt64, src.RepeatedInt64...)
+	m.RepeatedUint32 = append(m.RepeatedUint32, src.RepeatedUint32...)
+	m.RepeatedUint64 = append(m.RepeatedUint64, src.RepeatedUint64...)
+	m.RepeatedSint32 = append(m.RepeatedSint32, src.RepeatedSint32...)
+	m.RepeatedSint64 = append(m.RepeatedSint64, src.RepeatedSint64...)
+	m.RepeatedFixed32 = append(m.RepeatedFixed32, src.RepeatedFixed32...)
+	m.RepeatedFixed64 = append(m.RepeatedFixed64, src.RepeatedFixed64...)
+	m.RepeatedSfixed32 = append(m.RepeatedSfixed32, src.RepeatedSfixed32...)
+	m.RepeatedSfixed64 = append(m.RepeatedSfixed64, src.RepeatedSfixed64...)
+	m.RepeatedFloat = append(m.RepeatedFloat, src.RepeatedFloat...)
+	m.RepeatedDouble = append(m.RepeatedDouble, src.RepeatedDouble...)
+	m.RepeatedBool = append(m.RepeatedBool, src.RepeatedBool...)
+	m.RepeatedString = append(m.RepeatedString, src.RepeatedString...)
+	for _, v := range src.RepeatedBytes {
+		m.RepeatedBytes = append(m.RepeatedBytes, append([]byte{}, v...))
+	}
+	for _, v := range src.RepeatedNestedMessage {
+		tmpMsg := &TestAllTypesProto3_NestedMessage{}
+		tmpMsg.MergeVT(v)
+		m.RepeatedNestedMessage = append(m.RepeatedNestedMessage, tmpMsg)
+	}
+	for _, v := range src.RepeatedForeignMessage {
+		tmpMsg := &ForeignMessage{}
+		tmpMsg.MergeVT(v)
+		m.RepeatedForeignMessage = append(m.RepeatedForeignMessage, tmpMsg)
+	}
+	m.RepeatedNestedEnum = append(m.RepeatedNestedEnum, src.RepeatedNestedEnum...)
+	m.RepeatedForeignEnum = append(m.RepeatedForeignEnum, src.RepeatedForeignEnum...)
+	m.RepeatedStringPiece = append(m.RepeatedStringPiece, src.RepeatedStringPiece...)
+	m.RepeatedCord = append(m.RepeatedCord, src.RepeatedCord...)
+	if len(src.MapInt32Int32) > 0 {
+		if m.MapInt32Int32 == nil {
+			m.MapInt32Int32 = make(map[int32]int32, len(src.MapInt32Int32))
+		}
+		for k, v := range src.MapInt32Int32 {
+			m.MapInt32Int32[k] = v
+		}
+	}
+	if len(src.MapInt64Int64) > 0 {
+		if m.MapInt64Int64 == nil {
+			m.MapInt64Int64 = make(map[int64]int64, len(src.MapInt64Int64))
+		}
+		for k, v := range src.MapInt64Int64 {
+			m.MapInt64Int64[k] = v
+		}
+	}
+	if len(src.MapUint32Uint32) > 0 {
+		if m.MapUint32Uint32 == nil {
+			m.MapUint32Uint32 = make(map[uint32]uint32, len(src.MapUint32Uint32))
+		}
+		for k, v := range src.MapUint32Uint32 {
+			m.MapUint32Uint32[k] = v
+		}
+	}
+	if len(src.MapUint64Uint64) > 0 {
+		if m.MapUint64Uint64 == nil {
+			m.MapUint64Uint64 = make(map[uint64]uint64, len(src.MapUint64Uint64))
+		}
+		for k, v := range src.MapUint64Uint64 {
+			m.MapUint64Uint64[k] = v
+		}
+	}
+	if len(src.MapSint32Sint32) > 0 {
+		if m.MapSint32Sint32 == nil {
+			m.MapSint32Sint32 = make(map[int32]int32, len(src.MapSint32Sint32))
+		}
+		for k, v := range src.MapSint32Sint32 {
+			m.MapSint32Sint32[k] = v
+		}
+	}
+	if len(src.MapSint64Sint64) > 0 {
+		if m.MapSint64Sint64 == nil {
+			m.MapSint64Sint64 = make(map[int64]int64, len(src.MapSint64Sint64))
+		}
+		for k, v := range src.MapSint64Sint64 {
+			m.MapSint64Sint64[k] = v
+		}
+	}
+	if len(src.MapFixed32Fixed32) > 0 {
+		if m.MapFixed32Fixed32 == nil {
+			m.MapFixed32Fixed32 = make(map[uint32]uint32, len(src.MapFixed32Fixed32))
+		}
+		for k, v := range src.MapFixed32Fixed32 {
+			m.MapFixed32Fixed32[k] = v
+		}
+	}
+	if len(src.MapFixed64Fixed64) > 0 {
+		if m.MapFixed64Fixed64 == nil {
+			m.MapFixed64Fixed64 = make(map[uint64]uint64, len(src.MapFixed64Fixed64))
+		}
+		for k, v := range src.MapFixed64Fixed64 {
+			m.MapFixed64Fixed64[k] = v
+		}
+	}
+	if len(src.MapSfixed32Sfixed32) > 0 {
+		if m.MapSfixed32Sfixed32 == nil {
+			m.MapSfixed32Sfixed32 = make(map[int32]int32, len(src.MapSfixed32Sfixed32))
+		}
+		for k, v := range src.MapSfixed32Sfixed32 {
+			m.MapSfixed32Sfixed32[k] = v
+		}
+	}
+	if len(src.MapSfixed64Sfixed64) > 0 {
+		if m.MapSfixed64Sfixed64 == nil {
+			m.MapSfixed64Sfixed64 = make(map[int64]int64, len(src.MapSfixed64Sfixed64))
+		}
+		for k, v := range src.MapSfixed64Sfixed64 {
+			m.MapSfixed64Sfixed64[k] = v
+		}
+	}
+	if len(src.MapInt32Float) > 0 {
+		if m.MapInt32Float == nil {
+			m.MapInt32Float = make(map[int32]float32, len(src.MapInt32Float))
+		}
+		for k, v := range src.MapInt32Float {
+			m.MapInt32Float[k] = v
+		}
+	}
+	if len(src.MapInt32Double) > 0 {
+		if m.MapInt32Double == nil {
+			m.MapInt32Double = make(map[int32]float64, len(src.MapInt32Double))
+		}
+		for k, v := range src.MapInt32Double {
+			m.MapInt32Double[k] = v
+		}
+	}
+	if len(src.MapBoolBool) > 0 {
+		if m.MapBoolBool == nil {
+			m.MapBoolBool = make(map[bool]bool, len(src.MapBoolBool))
+		}
+		for k, v := range src.MapBoolBool {
+			m.MapBoolBool[k] = v
+		}
+	}
+	if len(src.MapStringString) > 0 {
+		if m.MapStringString == nil {
+			m.MapStringString = make(map[string]string, len(src.MapStringString))
+		}
+		for k, v := range src.MapStringString {
+			m.MapStringString[k] = v
+		}
+	}
+	if len(src.MapStringBytes) > 0 {
+		if m.MapStringBytes == nil {
+			m.MapStringBytes = make(map[string][]byte, len(src.MapStringBytes))
+		}
+		for k, v := range src.MapStringBytes {
+			m.MapStringBytes[k] = append([]byte{}, v...)
+		}
+	}
+	if len(src.MapStringNestedMessage) > 0 {
+		if m.MapStringNestedMessage == nil {
+			m.MapStringNestedMessage = make(map[string]*TestAllTypesProto3_NestedMessage, len(src.MapStringNestedMessage))
+		}
+		for k, v := range src.MapStringNestedMessage {
+			tmpMsg := &TestAllTypesProto3_NestedMessage{}
+			tmpMsg.MergeVT(v)
+			m.MapStringNestedMessage[k] = tmpMsg
+		}
+	}
+	if len(src.MapStringForeignMessage) > 0 {
+		if m.MapStringForeignMessage == nil {
+			m.MapStringForeignMessage = make(map[string]*ForeignMessage, len(src.MapStringForeignMessage))
+		}
+		for k, v := range src.MapStringForeignMessage {
+			tmpMsg := &ForeignMessage{}
+			tmpMsg.MergeVT(v)
+			m.MapStringForeignMessage[k] = tmpMsg
+		}
+	}
+	if len(src.MapStringNestedEnum) > 0 {
+		if m.MapStringNestedEnum == nil {
+			m.MapStringNestedEnum = make(map[string]TestAllTypesProto3_NestedEnum, len(src.MapStringNestedEnum))
+		}
+		for k, v := range src.MapStringNestedEnum {
+			m.MapStringNestedEnum[k] = v
+		}
+	}
+	if len(src.MapStringForeignEnum) > 0 {
+		if m.MapStringForeignEnum == nil {
+			m.MapStringForeignEnum = make(map[string]ForeignEnum, len(src.MapStringForeignEnum))
+		}
+		for k, v := range src.MapStringForeignEnum {
+			m.MapStringForeignEnum[k] = v
+		}
+	}
+	m.PackedInt32 = append(m.PackedInt32, src.PackedInt32...)
+	m.PackedInt64 = append(m.PackedInt64, src.PackedInt64...)
+	m.PackedUint32 = append(m.PackedUint32, src.PackedUint32...)
+	m.PackedUint64 = append(m.PackedUint64, src.PackedUint64...)
+	m.PackedSint32 = append(m.PackedSint32, src.PackedSint32...)
+	m.PackedSint64 = append(m.PackedSint64, src.PackedSint64...)
+	m.PackedFixed32 = append(m.PackedFixed32, src.PackedFixed32...)
+	m.PackedFixed64 = append(m.PackedFixed64, src.PackedFixed64...)
+	m.PackedSfixed32 = append(m.PackedSfixed32, src.PackedSfixed32...)
+	m.PackedSfixed64 = append(m.PackedSfixed64, src.PackedSfixed64...)
+	m.PackedFloat = append(m.PackedFloat, src.PackedFloat...)
+	m.PackedDouble = append(m.PackedDouble, src.PackedDouble...)
+	m.PackedBool = append(m.PackedBool, src.PackedBool...)
+	m.PackedNestedEnum = append(m.PackedNestedEnum, src.PackedNestedEnum...)
+	m.UnpackedInt32 = append(m.UnpackedInt32, src.UnpackedInt32...)
+	m.UnpackedInt64 = append(m.UnpackedInt64, src.UnpackedInt64...)
+	m.UnpackedUint32 = append(m.UnpackedUint32, src.UnpackedUint32...)
+	m.UnpackedUint64 = append(m.UnpackedUint64, src.UnpackedUint64...)
+	m.UnpackedSint32 = append(m.UnpackedSint32, src.UnpackedSint32...)
+	m.UnpackedSint64 = append(m.UnpackedSint64, src.UnpackedSint64...)
+	m.UnpackedFixed32 = append(m.UnpackedFixed32, src.UnpackedFixed32...)
+	m.UnpackedFixed64 = append(m.UnpackedFixed64, src.UnpackedFixed64...)
+	m.UnpackedSfixed32 = append(m.UnpackedSfixed32, src.UnpackedSfixed32...)
+	m.UnpackedSfixed64 = append(m.UnpackedSfixed64, src.UnpackedSfixed64...)
+	m.UnpackedFloat = append(m.UnpackedFloat, src.UnpackedFloat...)
+	m.UnpackedDouble = append(m.UnpackedDouble, src.UnpackedDouble...)
+	m.UnpackedBool = append(m.UnpackedBool, src.UnpackedBool...)
+	m.UnpackedNestedEnum = append(m.UnpackedNestedEnum, src.UnpackedNestedEnum...)
+	switch srcOneof := src.OneofField.(type) {
+	case *TestAllTypesProto3_OneofUint32:
+		m.OneofField = &TestAllTypesProto3_OneofUint32{OneofUint32: srcOneof.OneofUint32}
+	case *TestAllTypesProto3_OneofNestedMessage:
+		if dstOneof, ok := m.OneofField.(*TestAllTypesProto3_OneofNestedMessage); ok && dstOneof.OneofNestedMessage != nil {
+			if srcOneof.OneofNestedMessage != nil {
+				dstOneof.OneofNestedMessage.MergeVT(srcOneof.OneofNestedMessage)
+			}
+		} else {
+			tmpMsg := &TestAllTypesProto3_NestedMessage{}
+			tmpMsg.MergeVT(srcOneof.OneofNestedMessage)
+			m.OneofField = &TestAllTypesProto3_OneofNestedMessage{OneofNestedMessage: tmpMsg}
+		}
+	case *TestAllTypesProto3_OneofString:
+		m.OneofField = &TestAllTypesProto3_OneofString{OneofString: srcOneof.OneofString}
+	case *TestAllTypesProto3_OneofBytes:
+		m.OneofField = &TestAllTypesProto3_OneofBytes{OneofBytes: append([]byte{}, srcOneof.OneofBytes...)}
+	case *TestAllTypesProto3_OneofBool:
+		m.OneofField = &TestAllTypesProto3_OneofBool{OneofBool: srcOneof.OneofBool}
+	case *TestAllTypesProto3_OneofUint64:
+		m.OneofField = &TestAllTypesProto3_OneofUint64{OneofUint64: srcOneof.OneofUint64}
+	case *TestAllTypesProto3_OneofFloat:
+		m.OneofField = &TestAllTypesProto3_OneofFloat{OneofFloat: srcOneof.OneofFloat}
+	case *TestAllTypesProto3_OneofDouble:
+		m.OneofField = &TestAllTypesProto3_OneofDouble{OneofDouble: srcOneof.OneofDouble}
+	case *TestAllTypesProto3_OneofEnum:
+		m.OneofField = &TestAllTypesProto3_OneofEnum{OneofEnum: srcOneof.OneofEnum}
+	case *TestAllTypesProto3_OneofNullValue:
+		m.OneofField = &TestAllTypesProto3_OneofNullValue{OneofNullValue: srcOneof.OneofNullValue}
+	}
+	if src.OptionalBoolWrapper != nil {
+		if m.OptionalBoolWrapper == nil {
+			m.OptionalBoolWrapper = &wrapperspb.BoolValue{}
+		}
+		if vtpb, ok := interface{}(m.OptionalBoolWrapper).(interface{ MergeVT(*wrapperspb.BoolValue) }); ok {
+			vtpb.MergeVT(src.OptionalBoolWrapper)
+		} else {
+			proto.Merge(m.OptionalBoolWrapper, src.OptionalBoolWrapper)
+		}
+	}
+	if src.OptionalInt32Wrapper != nil {
+		if m.OptionalInt32Wrapper == nil {
+			m.OptionalInt32Wrapper = &wrapperspb.Int32Value{}
+		}
+		if vtpb, ok := interface{}(m.OptionalInt32Wrapper).(interface{ MergeVT(*wrapperspb.Int32Value) }); ok {
+			vtpb.MergeVT(src.OptionalInt32Wrapper)
+		} else {
+			proto.Merge(m.OptionalInt32Wrapper, src.OptionalInt32Wrapper)
+		}
+	}
+	if src.OptionalInt64Wrapper != nil {
+		if m.OptionalInt64Wrapper == nil {
+			m.OptionalInt64Wrapper = &wrapperspb.Int64Value{}
+		}
+		if vtpb, ok := interface{}(m.OptionalInt64Wrapper).(interface{ MergeVT(*wrapperspb.Int64Value) }); ok {
+			vtpb.MergeVT(src.OptionalInt64Wrapper)
+		} else {
+			proto.Merge(m.OptionalInt64Wrapper, src.OptionalInt64Wrapper)
+		}
+	}
+	if src.OptionalUint32Wrapper != nil {
+		if m.OptionalUint32Wrapper == nil {
+			m.OptionalUint32Wrapper = &wrapperspb.UInt32Value{}
+		}
+		if vtpb, ok := interface{}(m.OptionalUint32Wrapper).(interface{ MergeVT(*wrapperspb.UInt32Value) }); ok {
+			vtpb.MergeVT(src.OptionalUint32Wrapper)
+		} else {
+			proto.Merge(m.OptionalUint32Wrapper, src.OptionalUint32Wrapper)
+		}
+	}
+	if src.OptionalUint64Wrapper != nil {
+		if m.OptionalUint64Wrapper == nil {
+			m.OptionalUint64Wrapper = &wrapperspb.UInt64Value{}
+		}
+		if vtpb, ok := interface{}(m.OptionalUint64Wrapper).(interface{ MergeVT(*wrapperspb.UInt64Value) }); ok {
+			vtpb.MergeVT(src.OptionalUint64Wrapper)
+		} else {
+			proto.Merge(m.OptionalUint64Wrapper, src.OptionalUint64Wrapper)
+		}
+	}
+	if src.OptionalFloatWrapper != nil {
+		if m.OptionalFloatWrapper == nil {
+			m.OptionalFloatWrapper = &wrapperspb.FloatValue{}
+		}
+		if vtpb, ok := interface{}(m.OptionalFloatWrapper).(interface{ MergeVT(*wrapperspb.FloatValue) }); ok {
+			vtpb.MergeVT(src.OptionalFloatWrapper)
+		} else {
+			proto.Merge(m.OptionalFloatWrapper, src.OptionalFloatWrapper)
+		}
+	}
+	if src.OptionalDoubleWrapper != nil {
+		if m.OptionalDoubleWrapper == nil {
+			m.OptionalDoubleWrapper = &wrapperspb.DoubleValue{}
+		}
+		if vtpb, ok := interface{}(m.OptionalDoubleWrapper).(interface{ MergeVT(*wrapperspb.DoubleValue) }); ok {
+			vtpb.MergeVT(src.OptionalDoubleWrapper)
+		} else {
+			proto.Merge(m.OptionalDoubleWrapper, src.OptionalDoubleWrapper)
+		}
+	}
+	if src.OptionalStringWrapper != nil {
+		if m.OptionalStringWrapper == nil {
+			m.OptionalStringWrapper = &wrapperspb.StringValue{}
+		}
+		if vtpb, ok := interface{}(m.OptionalStringWrapper).(interface{ MergeVT(*wrapperspb.StringValue) }); ok {
+			vtpb.MergeVT(src.OptionalStringWrapper)
+		} else {
+			proto.Merge(m.OptionalStringWrapper, src.OptionalStringWrapper)
+		}
+	}
+	if src.OptionalBytesWrapper != nil {
+		if m.OptionalBytesWrapper == nil {
+			m.OptionalBytesWrapper = &wrapperspb.BytesValue{}
+		}
+		if vtpb, ok := interface{}(m.OptionalBytesWrapper).(interface{ MergeVT(*wrapperspb.BytesValue) }); ok {
+			vtpb.MergeVT(src.OptionalBytesWrapper)
+		} else {
+			proto.Merge(m.OptionalBytesWrapper, src.OptionalBytesWrapper)
+		}
+	}
+	for _, v := range src.RepeatedBoolWrapper {
+		tmpMsg := &wrapperspb.BoolValue{}
+		if vtpb, ok := interface{}(tmpMsg).(interface{ MergeVT(*wrapperspb.BoolValue) }); ok {
+			vtpb.MergeVT(v)
+		} else {
+			proto.Merge(tmpMsg, v)
+		}
+		m.RepeatedBoolWrapper = append(m.RepeatedBoolWrapper, tmpMsg)
+	}
+	for _, v := range src.RepeatedInt32Wrapper {
+		tmpMsg := &wrapperspb.Int32Value{}
+		if vtpb, ok := interface{}(tmpMsg).(interface{ MergeVT(*wrapperspb.Int32Value) }); ok {
+			vtpb.MergeVT(v)
+		} else {
+			proto.Merge(tmpMsg, v)
+		}
+		m.RepeatedInt32Wrapper = append(m.RepeatedInt32Wrapper, tmpMsg)
+	}
+	for _, v := range src.RepeatedInt64Wrapper {
+		tmpMsg := &wrapperspb.Int64Value{}
+		if vtpb, ok := interface{}(tmpMsg).(interface{ MergeVT(*wrapperspb.Int64Value) }); ok {
+			vtpb.MergeVT(v)
+		} else {
+			proto.Merge(tmpMsg, v)
+		}
+		m.RepeatedInt64Wrapper = append(m.RepeatedInt64Wrapper, tmpMsg)
+	}
+	for _, v := range src.RepeatedUint32Wrapper {
+		tmpMsg := &wrapperspb.UInt32Value{}
+		if vtpb, ok := interface{}(tmpMsg).(interface{ MergeVT(*wrapperspb.UInt32Value) }); ok {
+			vtpb.MergeVT(v)
+		} else {
+			proto.Merge(tmpMsg, v)
+		}
+		m.RepeatedUint32Wrapper = append(m.RepeatedUint32Wrapper, tmpMsg)
+	}
+	for _, v := range src.RepeatedUint64Wrapper {
+		tmpMsg := &wrapperspb.UInt64Value{}
+		if vtpb, ok := interface{}(tmpMsg).(interface{ MergeVT(*wrapperspb.UInt64Value) }); ok {
+			vtpb.MergeVT(v)
+		} else {
+			proto.Merge(tmpMsg, v)
+		}
+		m.RepeatedUint64Wrapper = append(m.RepeatedUint64Wrapper, tmpMsg)
+	}
+	for _, v := range src.RepeatedFloatWrapper {
+		tmpMsg := &wrapperspb.FloatValue{}
+		if vtpb, ok := interface{}(tmpMsg).(interface{ MergeVT(*wrapperspb.FloatValue) }); ok {
+			vtpb.MergeVT(v)
+		} else {
+			proto.Merge(tmpMsg, v)
+		}
+		m.RepeatedFloatWrapper = append(m.RepeatedFloatWrapper, tmpMsg)
+	}
+	for _, v := range src.RepeatedDoubleWrapper {
+		tmpMsg := &wrapperspb.DoubleValue{}
+		if vtpb, ok := interface{}(tmpMsg).(interface{ MergeVT(*wrapperspb.DoubleValue) }); ok {
+			vtpb.MergeVT(v)
+		} else {
+			proto.Merge(tmpMsg, v)
+		}
+		m.RepeatedDoubleWrapper = append(m.RepeatedDoubleWrapper, tmpMsg)
+	}
+	for _, v := range src.RepeatedStringWrapper {
+		tmpMsg := &wrapperspb.StringValue{}
+		if vtpb, ok := interface{}(tmpMsg).(interface{ MergeVT(*wrapperspb.StringValue) }); ok {
+			vtpb.MergeVT(v)
+		} else {
+			proto.Merge(tmpMsg, v)
+		}
+		m.RepeatedStringWrapper = append(m.RepeatedStringWrapper, tmpMsg)
+	}
+	for _, v := range src.RepeatedBytesWrapper {
+		tmpMsg := &wrapperspb.BytesValue{}
+		if vtpb, ok := interface{}(tmpMsg).(interface{ MergeVT(*wrapperspb.BytesValue) }); ok {
+			vtpb.MergeVT(v)
+		} else {
+			proto.Merge(tmpMsg, v)
+		}
+		m.RepeatedBytesWrapper = append(m.RepeatedBytesWrapper, tmpMsg)
+	}
+	if src.OptionalDuration != nil {
+		if m.OptionalDuration == nil {
+			m.OptionalDuration = &durationpb.Duration{}
+		}
+		if vtpb, ok := interface{}(m.OptionalDuration).(interface{ MergeVT(*durationpb.Duration) }); ok {
+			vtpb.MergeVT(src.OptionalDuration)
+		} else {
+			proto.Merge(m.OptionalDuration, src.OptionalDuration)
+		}
+	}
+	if src.OptionalTimestamp != nil {
+		if m.OptionalTimestamp == nil {
+			m.OptionalTimestamp = &timestamppb.Timestamp{}
+		}
+		if vtpb, ok := interface{}(m.OptionalTimestamp).(interface{ MergeVT(*timestamppb.Timestamp) }); ok {
+			vtpb.MergeVT(src.OptionalTimestamp)
+		} else {
+			proto.Merge(m.OptionalTimestamp, src.OptionalTimestamp)
+		}
+	}
+	if src.OptionalFieldMask != nil {
+		if m.OptionalFieldMask == nil {
+			m.OptionalFieldMask = &fieldmaskpb.FieldMask{}
+		}
+		if vtpb, ok := interface{}(m.OptionalFieldMask).(interface{ MergeVT(*fieldmaskpb.FieldMask) }); ok {
+			vtpb.MergeVT(src.OptionalFieldMask)
+		} else {
+			proto.Merge(m.OptionalFieldMask, src.OptionalFieldMask)
+		}
+	}
+	if src.OptionalStruct != nil {
+		if m.OptionalStruct == nil {
+			m.OptionalStruct = &structpb.Struct{}
+		}
+		if vtpb, ok := interface{}(m.OptionalStruct).(interface{ MergeVT(*structpb.Struct) }); ok {
+			vtpb.MergeVT(src.OptionalStruct)
+		} else {
+			proto.Merge(m.OptionalStruct, src.OptionalStruct)
+		}
+	}
+	if src.OptionalAny != nil {
+		if m.OptionalAny == nil {
+			m.OptionalAny = &anypb.Any{}
+		}
+		if vtpb, ok := interface{}(m.OptionalAny).(interface{ MergeVT(*anypb.Any) }); ok {
+			vtpb.MergeVT(src.OptionalAny)
+		} else {
+			proto.Merge(m.OptionalAny, src.OptionalAny)
+		}
+	}
+	if src.OptionalValue != nil {
+		if m.OptionalValue == nil {
+			m.OptionalValue = &structpb.Value{}
+		}
+		if vtpb, ok := interface{}(m.OptionalValue).(interface{ MergeVT(*structpb.Value) }); ok {
+			vtpb.MergeVT(src.OptionalValue)
+		} else {
+			proto.Merge(m.OptionalValue, src.OptionalValue)
+		}
+	}
+	if src.OptionalNullValue != 0 {
+		m.OptionalNullValue = src.OptionalNullValue
+	}
+	for _, v := range src.RepeatedDuration {
+		tmpMsg := &durationpb.Duration{}
+		if vtpb, ok := interface{}(tmpMsg).(interface{ MergeVT(*durationpb.Duration) }); ok {
+			vtpb.MergeVT(v)
+		} else {
+			proto.Merge(tmpMsg, v)
+		}
+		m.RepeatedDuration = append(m.RepeatedDuration, tmpMsg)
+	}
+	for _, v := range src.RepeatedTimestamp {
+		tmpMsg := &timestamppb.Timestamp{}
+		if vtpb, ok := interface{}(tmpMsg).(interface{ MergeVT(*timestamppb.Timestamp) }); ok {
+			vtpb.MergeVT(v)
+		} else {
+			proto.Merge(tmpMsg, v)
+		}
+		m.RepeatedTimestamp = append(m.RepeatedTimestamp, tmpMsg)
+	}
+	for _, v := range src.RepeatedFieldmask {
+		tmpMsg := &fieldmaskpb.FieldMask{}
+		if vtpb, ok := interface{}(tmpMsg).(interface{ MergeVT(*fieldmaskpb.FieldMask) }); ok {
+			vtpb.MergeVT(v)
+		} else {
+			proto.Merge(tmpMsg, v)
+		}
+		m.RepeatedFieldmask = append(m.RepeatedFieldmask, tmpMsg)
+	}
+	for _, v := range src.RepeatedAny {
+		tmpMsg := &anypb.Any{}
+		if vtpb, ok := interface{}(tmpMsg).(interface{ MergeVT(*anypb.Any) }); ok {
+			vtpb.MergeVT(v)
+		} else {
+			proto.Merge(tmpMsg, v)
+		}
+		m.RepeatedAny = append(m.RepeatedAny, tmpMsg)
+	}
+	for _, v := range src.RepeatedValue {
+		tmpMsg := &structpb.Value{}
+		if vtpb, ok := interface{}(tmpMsg).(interface{ MergeVT(*structpb.Value) }); ok {
+			vtpb.MergeVT(v)
+		} else {
+			proto.Merge(tmpMsg, v)
+		}
+		m.RepeatedValue = append(m.RepeatedValue, tmpMsg)
+	}
+	for _, v := range src.RepeatedListValue {
+		tmpMsg := &structpb.ListValue{}
+		if vtpb, ok := interface{}(tmpMsg).(interface{ MergeVT(*structpb.ListValue) }); ok {
+			vtpb.MergeVT(v)
+		} else {
+			proto.Merge(tmpMsg, v)
+		}
+		m.RepeatedListValue = append(m.RepeatedListValue, tmpMsg)
+	}
+	for _, v := range src.RepeatedStruct {
+		tmpMsg := &structpb.Struct{}
+		if vtpb, ok := interface{}(tmpMsg).(interface{ MergeVT(*structpb.Struct) }); ok {
+			vtpb.MergeVT(v)
+		} else {
+			proto.Merge(tmpMsg, v)
+		}
+		m.RepeatedStruct = append(m.RepeatedStruct, tmpMsg)
+	}
+	if src.Fieldname1 != 0 {
+		m.Fieldname1 = src.Fieldname1
+	}
+	if src.FieldName2 != 0 {
+		m.FieldName2 = src.FieldName2
+	}
+	if src.XFieldName3 != 0 {
+		m.XFieldName3 = src.XFieldName3
+	}
+	if src.Field_Name4_ != 0 {
+		m.Field_Name4_ = src.Field_Name4_
+	}
+	if src.Field0Name5 != 0 {
+		m.Field0Name5 = src.Field0Name5
+	}
+	if src.Field_0Name6 != 0 {
+		m.Field_0Name6 = src.Field_0Name6
+	}
+	if src.FieldName7 != 0 {
+		m.FieldName7 = src.FieldName7
+	}
+	if src.FieldName8 != 0 {
+		m.FieldName8 = src.FieldName8
+	}
+	if src.Field_Name9 != 0 {
+		m.Field_Name9 = src.Field_Name9
+	}
+	if src.Field_Name10 != 0 {
+		m.Field_Name10 = src.Field_Name10
+	}
+	if src.FIELD_NAME11 != 0 {
+		m.FIELD_NAME11 = src.FIELD_NAME11
+	}
+	if src.FIELDName12 != 0 {
+		m.FIELDName12 = src.FIELDName12
+	}
+	if src.XFieldName13 != 0 {
+		m.XFieldName13 = src.XFieldName13
+	}
+	if src.X_FieldName14 != 0 {
+		m.X_FieldName14 = src.X_FieldName14
+	}
+	if src.Field_Name15 != 0 {
+		m.Field_Name15 = src.Field_Name15
+	}
+	if src.Field__Name16 != 0 {
+		m.Field__Name16 = src.Field__Name16
+	}
+	if src.FieldName17__ != 0 {
+		m.FieldName17__ = src.FieldName17__
+	}
+	if src.FieldName18__ != 0 {
+		m.FieldName18__ = src.FieldName18__
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *TestAllTypesProto3) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*TestAllTypesProto3); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *ForeignMessage) MergeVT(src *ForeignMessage) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.C != 0 {
+		m.C = src.C
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *ForeignMessage) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*ForeignMessage); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *NullHypothesisProto3) MergeVT(src *NullHypothesisProto3) {
+	if m == nil || src == nil {
+		return
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *NullHypothesisProto3) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*NullHypothesisProto3); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *EnumOnlyProto3) MergeVT(src *EnumOnlyProto3) {
+	if m == nil || src == nil {
+		return
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *EnumOnlyProto3) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*EnumOnlyProto3); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *TestAllTypesProto3_NestedMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.A != 0 {
+		n += 1 + sov(uint64(m.A))
+	}
+	if m.Corecursive != nil {
+		l = m.Corecursive.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *TestAllTypesProto3) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OptionalInt32 != 0 {
+		n += 1 + sov(uint64(m.OptionalInt32))
+	}
+	if m.OptionalInt64 != 0 {
+		n += 1 + sov(uint64(m.OptionalInt64))
+	}
+	if m.OptionalUint32 != 0 {
+		n += 1 + sov(uint64(m.OptionalUint32))
+	}
+	if m.OptionalUint64 != 0 {
+		n += 1 + sov(uint64(m.OptionalUint64))
+	}
+	if m.OptionalSint32 != 0 {
+		n += 1 + soz(uint64(m.OptionalSint32))
+	}
+	if m.OptionalSint64 != 0 {
+		n += 1 + soz(uint64(m.OptionalSint64))
+	}
+	if m.OptionalFixed32 != 0 {
+		n += 5
+	}
+	if m.OptionalFixed64 != 0 {
+		n += 9
+	}
+	if m.OptionalSfixed32 != 0 {
+		n += 5
+	}
+	if m.OptionalSfixed64 != 0 {
+		n += 9
+	}
+	if m.OptionalFloat != 0 {
+		n += 5
+	}
+	if m.OptionalDouble != 0 {
+		n += 9
+	}
+	if m.OptionalBool {
+		n += 2
+	}
+	l = len(m.OptionalString)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.OptionalBytes)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.OptionalNestedMessage != nil {
+		l = m.OptionalNestedMessage.SizeVT()
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.OptionalForeignMessage != nil {
+		l = m.OptionalForeignMessage.SizeVT()
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.OptionalNestedEnum != 0 {
+		n += 2 + sov(uint64(m.OptionalNestedEnum))
+	}
+	if m.OptionalForeignEnum != 0 {
+		n += 2 + sov(uint64(m.OptionalForeignEnum))
+	}
+	if m.OptionalAliasedEnum != 0 {
+		n += 2 + sov(uint64(m.OptionalAliasedEnum))
+	}
+	l = len(m.OptionalStringPiece)
+	if l > 0 {
+		n += 2 + l + sov(uint64(l))
+	}
+	l = len(m.OptionalCord)
+	if l > 0 {
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.RecursiveMessage != nil {
+		l = m.RecursiveMessage.SizeVT()
+		n += 2 + l + sov(uint64(l))
+	}
+	if len(m.RepeatedInt32) > 0 {
+		l = 0
+		for _, e := range m.RepeatedInt32 {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.RepeatedInt64) > 0 {
+		l = 0
+		for _, e := range m.RepeatedInt64 {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.RepeatedUint32) > 0 {
+		l = 0
+		for _, e := range m.RepeatedUint32 {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.RepeatedUint64) > 0 {
+		l = 0
+		for _, e := range m.RepeatedUint64 {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.RepeatedSint32) > 0 {
+		l = 0
+		for _, e := range m.RepeatedSint32 {
+			l += soz(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.RepeatedSint64) > 0 {
+		l = 0
+		for _, e := range m.RepeatedSint64 {
+			l += soz(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.RepeatedFixed32) > 0 {
+		n += 2 + sov(uint64(len(m.RepeatedFixed32)*4)) + len(m.RepeatedFixed32)*4
+	}
+	if len(m.RepeatedFixed64) > 0 {
+		n += 2 + sov(uint64(len(m.RepeatedFixed64)*8)) + len(m.RepeatedFixed64)*8
+	}
+	if len(m.RepeatedSfixed32) > 0 {
+		n += 2 + sov(uint64(len(m.RepeatedSfixed32)*4)) + len(m.RepeatedSfixed32)*4
+	}
+	if len(m.RepeatedSfixed64) > 0 {
+		n += 2 + sov(uint64(len(m.RepeatedSfixed64)*8)) + len(m.RepeatedSfixed64)*8
+	}
+	if len(m.RepeatedFloat) > 0 {
+		n += 2 + sov(uint64(len(m.RepeatedFloat)*4)) + len(m.RepeatedFloat)*4
+	}
+	if len(m.RepeatedDouble) > 0 {
+		n += 2 + sov(uint64(len(m.RepeatedDouble)*8)) + len(m.RepeatedDouble)*8
+	}
+	if len(m.RepeatedBool) > 0 {
+		n += 2 + sov(uint64(len(m.RepeatedBool))) + len(m.RepeatedBool)*1
+	}
+	if len(m.RepeatedString) > 0 {
+		for _, s := range m.RepeatedString {
+			l = len(s)
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedBytes) > 0 {
+		for _, b := range m.RepeatedBytes {
+			l = len(b)
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedNestedMessage) > 0 {
+		for _, e := range m.RepeatedNestedMessage {
+			l = e.SizeVT()
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedForeignMessage) > 0 {
+		for _, e := range m.RepeatedForeignMessage {
+			l = e.SizeVT()
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedNestedEnum) > 0 {
+		l = 0
+		for _, e := range m.RepeatedNestedEnum {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.RepeatedForeignEnum) > 0 {
+		l = 0
+		for _, e := range m.RepeatedForeignEnum {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.RepeatedStringPiece) > 0 {
+		for _, s := range m.RepeatedStringPiece {
+			l = len(s)
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedCord) > 0 {
+		for _, s := range m.RepeatedCord {
+			l = len(s)
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.MapInt32Int32) > 0 {
+		for k, v := range m.MapInt32Int32 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sov(uint64(k)) + 1 + sov(uint64(v))
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapInt64Int64) > 0 {
+		for k, v := range m.MapInt64Int64 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sov(uint64(k)) + 1 + sov(uint64(v))
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapUint32Uint32) > 0 {
+		for k, v := range m.MapUint32Uint32 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sov(uint64(k)) + 1 + sov(uint64(v))
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapUint64Uint64) > 0 {
+		for k, v := range m.MapUint64Uint64 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sov(uint64(k)) + 1 + sov(uint64(v))
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapSint32Sint32) > 0 {
+		for k, v := range m.MapSint32Sint32 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + soz(uint64(k)) + 1 + soz(uint64(v))
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapSint64Sint64) > 0 {
+		for k, v := range m.MapSint64Sint64 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + soz(uint64(k)) + 1 + soz(uint64(v))
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapFixed32Fixed32) > 0 {
+		for k, v := range m.MapFixed32Fixed32 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + 4
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapFixed64Fixed64) > 0 {
+		for k, v := range m.MapFixed64Fixed64 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + 8
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapSfixed32Sfixed32) > 0 {
+		for k, v := range m.MapSfixed32Sfixed32 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + 4
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapSfixed64Sfixed64) > 0 {
+		for k, v := range m.MapSfixed64Sfixed64 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + 8
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapInt32Float) > 0 {
+		for k, v := range m.MapInt32Float {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sov(uint64(k)) + 1 + 4
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapInt32Double) > 0 {
+		for k, v := range m.MapInt32Double {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sov(uint64(k)) + 1 + 8
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapBoolBool) > 0 {
+		for k, v := range m.MapBoolBool {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 1 + 1 + 1
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapStringString) > 0 {
+		for k, v := range m.MapStringString {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + len(v) + sov(uint64(len(v)))
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapStringBytes) > 0 {
+		for k, v := range m.MapStringBytes {
+			_ = k
+			_ = v
+			l = 1 + len(v) + sov(uint64(len(v)))
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapStringNestedMessage) > 0 {
+		for k, v := range m.MapStringNestedMessage {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.SizeVT()
+			}
+			l += 1 + sov(uint64(l))
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapStringForeignMessage) > 0 {
+		for k, v := range m.MapStringForeignMessage {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.SizeVT()
+			}
+			l += 1 + sov(uint64(l))
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapStringNestedEnum) > 0 {
+		for k, v := range m.MapStringNestedEnum {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + sov(uint64(v))
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.MapStringForeignEnum) > 0 {
+		for k, v := range m.MapStringForeignEnum {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + sov(uint64(v))
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.PackedInt32) > 0 {
+		l = 0
+		for _, e := range m.PackedInt32 {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.PackedInt64) > 0 {
+		l = 0
+		for _, e := range m.PackedInt64 {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.PackedUint32) > 0 {
+		l = 0
+		for _, e := range m.PackedUint32 {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.PackedUint64) > 0 {
+		l = 0
+		for _, e := range m.PackedUint64 {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.PackedSint32) > 0 {
+		l = 0
+		for _, e := range m.PackedSint32 {
+			l += soz(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.PackedSint64) > 0 {
+		l = 0
+		for _, e := range m.PackedSint64 {
+			l += soz(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.PackedFixed32) > 0 {
+		n += 2 + sov(uint64(len(m.PackedFixed32)*4)) + len(m.PackedFixed32)*4
+	}
+	if len(m.PackedFixed64) > 0 {
+		n += 2 + sov(uint64(len(m.PackedFixed64)*8)) + len(m.PackedFixed64)*8
+	}
+	if len(m.PackedSfixed32) > 0 {
+		n += 2 + sov(uint64(len(m.PackedSfixed32)*4)) + len(m.PackedSfixed32)*4
+	}
+	if len(m.PackedSfixed64) > 0 {
+		n += 2 + sov(uint64(len(m.PackedSfixed64)*8)) + len(m.PackedSfixed64)*8
+	}
+	if len(m.PackedFloat) > 0 {
+		n += 2 + sov(uint64(len(m.PackedFloat)*4)) + len(m.PackedFloat)*4
+	}
+	if len(m.PackedDouble) > 0 {
+		n += 2 + sov(uint64(len(m.PackedDouble)*8)) + len(m.PackedDouble)*8
+	}
+	if len(m.PackedBool) > 0 {
+		n += 2 + sov(uint64(len(m.PackedBool))) + len(m.PackedBool)*1
+	}
+	if len(m.PackedNestedEnum) > 0 {
+		l = 0
+		for _, e := range m.PackedNestedEnum {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.UnpackedInt32) > 0 {
+		for _, e := range m.UnpackedInt32 {
+			n += 2 + sov(uint64(e))
+		}
+	}
+	if len(m.UnpackedInt64) > 0 {
+		for _, e := range m.UnpackedInt64 {
+			n += 2 + sov(uint64(e))
+		}
+	}
+	if len(m.UnpackedUint32) > 0 {
+		for _, e := range m.UnpackedUint32 {
+			n += 2 + sov(uint64(e))
+		}
+	}
+	if len(m.UnpackedUint64) > 0 {
+		for _, e := range m.UnpackedUint64 {
+			n += 2 + sov(uint64(e))
+		}
+	}
+	if len(m.UnpackedSint32) > 0 {
+		for _, e := range m.UnpackedSint32 {
+			n += 2 + soz(uint64(e))
+		}
+	}
+	if len(m.UnpackedSint64) > 0 {
+		for _, e := range m.UnpackedSint64 {
+			n += 2 + soz(uint64(e))
+		}
+	}
+	if len(m.UnpackedFixed32) > 0 {
+		n += 6 * len(m.UnpackedFixed32)
+	}
+	if len(m.UnpackedFixed64) > 0 {
+		n += 10 * len(m.UnpackedFixed64)
+	}
+	if len(m.UnpackedSfixed32) > 0 {
+		n += 6 * len(m.UnpackedSfixed32)
+	}
+	if len(m.UnpackedSfixed64) > 0 {
+		n += 10 * len(m.UnpackedSfixed64)
+	}
+	if len(m.UnpackedFloat) > 0 {
+		n += 6 * len(m.UnpackedFloat)
+	}
+	if len(m.UnpackedDouble) > 0 {
+		n += 10 * len(m.UnpackedDouble)
+	}
+	if len(m.UnpackedBool) > 0 {
+		n += 3 * len(m.UnpackedBool)
+	}
+	if len(m.UnpackedNestedEnum) > 0 {
+		for _, e := range m.UnpackedNestedEnum {
+			n += 2 + sov(uint64(e))
+		}
+	}
+	if vtmsg, ok := m.OneofField.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	if m.OptionalBoolWrapper != nil {
+		if size, ok := interface{}(m.OptionalBoolWrapper).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.OptionalBoolWrapper)
+		}
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.OptionalInt32Wrapper != nil {
+		if size, ok := interface{}(m.OptionalInt32Wrapper).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.OptionalInt32Wrapper)
+		}
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.OptionalInt64Wrapper != nil {
+		if size, ok := interface{}(m.OptionalInt64Wrapper).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.OptionalInt64Wrapper)
+		}
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.OptionalUint32Wrapper != nil {
+		if size, ok := interface{}(m.OptionalUint32Wrapper).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.OptionalUint32Wrapper)
+		}
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.OptionalUint64Wrapper != nil {
+		if size, ok := interface{}(m.OptionalUint64Wrapper).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.OptionalUint64Wrapper)
+		}
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.OptionalFloatWrapper != nil {
+		if size, ok := interface{}(m.OptionalFloatWrapper).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.OptionalFloatWrapper)
+		}
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.OptionalDoubleWrapper != nil {
+		if size, ok := interface{}(m.OptionalDoubleWrapper).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.OptionalDoubleWrapper)
+		}
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.OptionalStringWrapper != nil {
+		if size, ok := interface{}(m.OptionalStringWrapper).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.OptionalStringWrapper)
+		}
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.OptionalBytesWrapper != nil {
+		if size, ok := interface{}(m.OptionalBytesWrapper).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.OptionalBytesWrapper)
+		}
+		n += 2 + l + sov(uint64(l))
+	}
+	if len(m.RepeatedBoolWrapper) > 0 {
+		for _, e := range m.RepeatedBoolWrapper {
+			if size, ok := interface{}(e).(interface {
+				SizeVT() int
+			}); ok {
+				l = size.SizeVT()
+			} else {
+				l = proto.Size(e)
+			}
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedInt32Wrapper) > 0 {
+		for _, e := range m.RepeatedInt32Wrapper {
+			if size, ok := interface{}(e).(interface {
+				SizeVT() int
+			}); ok {
+				l = size.SizeVT()
+			} else {
+				l = proto.Size(e)
+			}
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedInt64Wrapper) > 0 {
+		for _, e := range m.RepeatedInt64Wrapper {
+			if size, ok := interface{}(e).(interface {
+				SizeVT() int
+			}); ok {
+				l = size.SizeVT()
+			} else {
+				l = proto.Size(e)
+			}
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedUint32Wrapper) > 0 {
+		for _, e := range m.RepeatedUint32Wrapper {
+			if size, ok := interface{}(e).(interface {
+				SizeVT() int
+			}); ok {
+				l = size.SizeVT()
+			} else {
+				l = proto.Size(e)
+			}
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedUint64Wrapper) > 0 {
+		for _, e := range m.RepeatedUint64Wrapper {
+			if size, ok := interface{}(e).(interface {
+				SizeVT() int
+			}); ok {
+				l = size.SizeVT()
+			} else {
+				l = proto.Size(e)
+			}
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedFloatWrapper) > 0 {
+		for _, e := range m.RepeatedFloatWrapper {
+			if size, ok := interface{}(e).(interface {
+				SizeVT() int
+			}); ok {
+				l = size.SizeVT()
+			} else {
+				l = proto.Size(e)
+			}
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedDoubleWrapper) > 0 {
+		for _, e := range m.RepeatedDoubleWrapper {
+			if size, ok := interface{}(e).(interface {
+				SizeVT() int
+			}); ok {
+				l = size.SizeVT()
+			} else {
+				l = proto.Size(e)
+			}
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedStringWrapper) > 0 {
+		for _, e := range m.RepeatedStringWrapper {
+			if size, ok := interface{}(e).(interface {
+				SizeVT() int
+			}); ok {
+				l = size.SizeVT()
+			} else {
+				l = proto.Size(e)
+			}
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedBytesWrapper) > 0 {
+		for _, e := range m.RepeatedBytesWrapper {
+			if size, ok := interface{}(e).(interface {
+				SizeVT() int
+			}); ok {
+				l = size.SizeVT()
+			} else {
+				l = proto.Size(e)
+			}
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if m.OptionalDuration != nil {
+		if size, ok := interface{}(m.OptionalDuration).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.OptionalDuration)
+		}
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.OptionalTimestamp != nil {
+		if size, ok := interface{}(m.OptionalTimestamp).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.OptionalTimestamp)
+		}
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.OptionalFieldMask != nil {
+		if size, ok := interface{}(m.OptionalFieldMask).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.OptionalFieldMask)
+		}
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.OptionalStruct != nil {
+		if size, ok := interface{}(m.OptionalStruct).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.OptionalStruct)
+		}
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.OptionalAny != nil {
+		if size, ok := interface{}(m.OptionalAny).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.OptionalAny)
+		}
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.OptionalValue != nil {
+		if size, ok := interface{}(m.OptionalValue).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.OptionalValue)
+		}
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.OptionalNullValue != 0 {
+		n += 2 + sov(uint64(m.OptionalNullValue))
+	}
+	if len(m.RepeatedDuration) > 0 {
+		for _, e := range m.RepeatedDuration {
+			if size, ok := interface{}(e).(interface {
+				SizeVT() int
+			}); ok {
+				l = size.SizeVT()
+			} else {
+				l = proto.Size(e)
+			}
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedTimestamp) > 0 {
+		for _, e := range m.RepeatedTimestamp {
+			if size, ok := interface{}(e).(interface {
+				SizeVT() int
+			}); ok {
+				l = size.SizeVT()
+			} else {
+				l = proto.Size(e)
+			}
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedFieldmask) > 0 {
+		for _, e := range m.RepeatedFieldmask {
+			if size, ok := interface{}(e).(interface {
+				SizeVT() int
+			}); ok {
+				l = size.SizeVT()
+			} else {
+				l = proto.Size(e)
+			}
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedAny) > 0 {
+		for _, e := range m.RepeatedAny {
+			if size, ok := interface{}(e).(interface {
+				SizeVT() int
+			}); ok {
+				l = size.SizeVT()
+			} else {
+				l = proto.Size(e)
+			}
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedValue) > 0 {
+		for _, e := range m.RepeatedValue {
+			if size, ok := interface{}(e).(interface {
+				SizeVT() int
+			}); ok {
+				l = size.SizeVT()
+			} else {
+				l = proto.Size(e)
+			}
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedListValue) > 0 {
+		for _, e := range m.RepeatedListValue {
+			if size, ok := interface{}(e).(interface {
+				SizeVT() int
+			}); ok {
+				l = size.SizeVT()
+			} else {
+				l = proto.Size(e)
+			}
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedStruct) > 0 {
+		for _, e := range m.RepeatedStruct {
+			if size, ok := interface{}(e).(interface {
+				SizeVT() int
+			}); ok {
+				l = size.SizeVT()
+			} else {
+				l = proto.Size(e)
+			}
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if m.Fieldname1 != 0 {
+		n += 2 + sov(uint64(m.Fieldname1))
+	}
+	if m.FieldName2 != 0 {
+		n += 2 + sov(uint64(m.FieldName2))
+	}
+	if m.XFieldName3 != 0 {
+		n += 2 + sov(uint64(m.XFieldName3))
+	}
+	if m.Field_Name4_ != 0 {
+		n += 2 + sov(uint64(m.Field_Name4_))
+	}
+	if m.Field0Name5 != 0 {
+		n += 2 + sov(uint64(m.Field0Name5))
+	}
+	if m.Field_0Name6 != 0 {
+		n += 2 + sov(uint64(m.Field_0Name6))
+	}
+	if m.FieldName7 != 0 {
+		n += 2 + sov(uint64(m.FieldName7))
+	}
+	if m.FieldName8 != 0 {
+		n += 2 + sov(uint64(m.FieldName8))
+	}
+	if m.Field_Name9 != 0 {
+		n += 2 + sov(uint64(m.Field_Name9))
+	}
+	if m.Field_Name10 != 0 {
+		n += 2 + sov(uint64(m.Field_Name10))
+	}
+	if m.FIELD_NAME11 != 0 {
+		n += 2 + sov(uint64(m.FIELD_NAME11))
+	}
+	if m.FIELDName12 != 0 {
+		n += 2 + sov(uint64(m.FIELDName12))
+	}
+	if m.XFieldName13 != 0 {
+		n += 2 + sov(uint64(m.XFieldName13))
+	}
+	if m.X_FieldName14 != 0 {
+		n += 2 + sov(uint64(m.X_FieldName14))
+	}
+	if m.Field_Name15 != 0 {
+		n += 2 + sov(uint64(m.Field_Name15))
+	}
+	if m.Field__Name16 != 0 {
+		n += 2 + sov(uint64(m.Field__Name16))
+	}
+	if m.FieldName17__ != 0 {
+		n += 2 + sov(uint64(m.FieldName17__))
+	}
+	if m.FieldName18__ != 0 {
+		n += 2 + sov(uint64(m.FieldName18__))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *TestAllTypesProto3_OneofUint32) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2 + sov(uint64(m.OneofUint32))
+	return n
+}
+func (m *TestAllTypesProto3_OneofNestedMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OneofNestedMessage != nil {
+		l = m.OneofNestedMessage.SizeVT()
+		n += 2 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *TestAllTypesProto3_OneofString) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OneofString)
+	n += 2 + l + sov(uint64(l))
+	return n
+}
+func (m *TestAllTypesProto3_OneofBytes) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OneofBytes)
+	n += 2 + l + sov(uint64(l))
+	return n
+}
+func (m *TestAllTypesProto3_OneofBool) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 3
+	return n
+}
+func (m *TestAllTypesProto3_OneofUint64) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2 + sov(uint64(m.OneofUint64))
+	return n
+}
+func (m *TestAllTypesProto3_OneofFloat) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 6
+	return n
+}
+func (m *TestAllTypesProto3_OneofDouble) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 10
+	return n
+}
+func (m *TestAllTypesProto3_OneofEnum) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2 + sov(uint64(m.OneofEnum))
+	return n
+}
+func (m *TestAllTypesProto3_OneofNullValue) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2 + sov(uint64(m.OneofNullValue))
+	return n
+}
+func (m *ForeignMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.C != 0 {
+		n += 1 + sov(uint64(m.C))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *NullHypothesisProto3) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *EnumOnlyProto3) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *TestAllTypesProto3_NestedMessage) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *TestAllTypesProto3_NestedMessage) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *TestAllTypesProto3_NestedMessage) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *TestAllTypesProto3_NestedMessage) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*TestAllTypesProto3_NestedMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TestAllTypesProto3_NestedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TestAllTypesProto3_NestedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			m.A = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.A |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Corecursive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Corecursive == nil {
+				m.Corecursive = &TestAllTypesProto3{}
+			}
+			if err := m.Corecursive.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *TestAllTypesProto3) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *TestAllTypesProto3) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *TestAllTypesProto3) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*TestAllTypesProto3)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TestAllTypesProto3: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TestAllTypesProto3: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalInt32", wireType)
+			}
+			m.OptionalInt32 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OptionalInt32 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalInt64", wireType)
+			}
+			m.OptionalInt64 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OptionalInt64 |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalUint32", wireType)
+			}
+			m.OptionalUint32 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OptionalUint32 |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalUint64", wireType)
+			}
+			m.OptionalUint64 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OptionalUint64 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSint32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.OptionalSint32 = v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			m.OptionalSint64 = int64(v)
+		case 7:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalFixed32", wireType)
+			}
+			m.OptionalFixed32 = 0
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionalFixed32 = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalFixed64", wireType)
+			}
+			m.OptionalFixed64 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionalFixed64 = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 9:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSfixed32", wireType)
+			}
+			m.OptionalSfixed32 = 0
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionalSfixed32 = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSfixed64", wireType)
+			}
+			m.OptionalSfixed64 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionalSfixed64 = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalFloat", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.OptionalFloat = float32(math.Float32frombits(v))
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalDouble", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.OptionalDouble = float64(math.Float64frombits(v))
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalBool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalBool = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.OptionalString = string(string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionalBytes = append(m.OptionalBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.OptionalBytes == nil {
+				m.OptionalBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalNestedMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalNestedMessage == nil {
+				m.OptionalNestedMessage = &TestAllTypesProto3_NestedMessage{}
+			}
+			if err := m.OptionalNestedMessage.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalForeignMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalForeignMessage == nil {
+				m.OptionalForeignMessage = &ForeignMessage{}
+			}
+			if err := m.OptionalForeignMessage.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalNestedEnum", wireType)
+			}
+			m.OptionalNestedEnum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OptionalNestedEnum |= TestAllTypesProto3_NestedEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalForeignEnum", wireType)
+			}
+			m.OptionalForeignEnum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OptionalForeignEnum |= ForeignEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalAliasedEnum", wireType)
+			}
+			m.OptionalAliasedEnum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OptionalAliasedEnum |= TestAllTypesProto3_AliasedEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalStringPiece", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.OptionalStringPiece = string(string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalCord", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.OptionalCord = string(string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecursiveMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RecursiveMessage == nil {
+				m.RecursiveMessage = &TestAllTypesProto3{}
+			}
+			if err := m.RecursiveMessage.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedInt32 = append(m.RepeatedInt32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedInt32) == 0 {
+					m.RepeatedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedInt32 = append(m.RepeatedInt32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt32", wireType)
+			}
+		case 32:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedInt64 = append(m.RepeatedInt64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedInt64) == 0 {
+					m.RepeatedInt64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedInt64 = append(m.RepeatedInt64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt64", wireType)
+			}
+		case 33:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedUint32 = append(m.RepeatedUint32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedUint32) == 0 {
+					m.RepeatedUint32 = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedUint32 = append(m.RepeatedUint32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint32", wireType)
+			}
+		case 34:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedUint64 = append(m.RepeatedUint64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedUint64) == 0 {
+					m.RepeatedUint64 = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedUint64 = append(m.RepeatedUint64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint64", wireType)
+			}
+		case 35:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				m.RepeatedSint32 = append(m.RepeatedSint32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedSint32) == 0 {
+					m.RepeatedSint32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+					m.RepeatedSint32 = append(m.RepeatedSint32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedSint32", wireType)
+			}
+		case 36:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				m.RepeatedSint64 = append(m.RepeatedSint64, int64(v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedSint64) == 0 {
+					m.RepeatedSint64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					m.RepeatedSint64 = append(m.RepeatedSint64, int64(v))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedSint64", wireType)
+			}
+		case 37:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.RepeatedFixed32 = append(m.RepeatedFixed32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.RepeatedFixed32) == 0 {
+					m.RepeatedFixed32 = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.RepeatedFixed32 = append(m.RepeatedFixed32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedFixed32", wireType)
+			}
+		case 38:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.RepeatedFixed64 = append(m.RepeatedFixed64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.RepeatedFixed64) == 0 {
+					m.RepeatedFixed64 = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.RepeatedFixed64 = append(m.RepeatedFixed64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedFixed64", wireType)
+			}
+		case 39:
+			if wireType == 5 {
+				var v int32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.RepeatedSfixed32 = append(m.RepeatedSfixed32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.RepeatedSfixed32) == 0 {
+					m.RepeatedSfixed32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.RepeatedSfixed32 = append(m.RepeatedSfixed32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedSfixed32", wireType)
+			}
+		case 40:
+			if wireType == 1 {
+				var v int64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.RepeatedSfixed64 = append(m.RepeatedSfixed64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.RepeatedSfixed64) == 0 {
+					m.RepeatedSfixed64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.RepeatedSfixed64 = append(m.RepeatedSfixed64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedSfixed64", wireType)
+			}
+		case 41:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				m.RepeatedFloat = append(m.RepeatedFloat, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.RepeatedFloat) == 0 {
+					m.RepeatedFloat = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					v2 := float32(math.Float32frombits(v))
+					m.RepeatedFloat = append(m.RepeatedFloat, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedFloat", wireType)
+			}
+		case 42:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.RepeatedDouble = append(m.RepeatedDouble, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.RepeatedDouble) == 0 {
+					m.RepeatedDouble = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.RepeatedDouble = append(m.RepeatedDouble, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedDouble", wireType)
+			}
+		case 43:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedBool = append(m.RepeatedBool, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.RepeatedBool) == 0 {
+					m.RepeatedBool = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedBool = append(m.RepeatedBool, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedBool", wireType)
+			}
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.RepeatedString = append(m.RepeatedString, string(string(dAtA[iNdEx:postIndex])))
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedBytes = append(m.RepeatedBytes, make([]byte, postIndex-iNdEx))
+			copy(m.RepeatedBytes[len(m.RepeatedBytes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedNestedMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedNestedMessage = append(m.RepeatedNestedMessage, &TestAllTypesProto3_NestedMessage{})
+			if err := m.RepeatedNestedMessage[len(m.RepeatedNestedMessage)-1].unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedForeignMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedForeignMessage = append(m.RepeatedForeignMessage, &ForeignMessage{})
+			if err := m.RepeatedForeignMessage[len(m.RepeatedForeignMessage)-1].unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 51:
+			if wireType == 0 {
+				var v TestAllTypesProto3_NestedEnum
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= TestAllTypesProto3_NestedEnum(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedNestedEnum = append(m.RepeatedNestedEnum, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.RepeatedNestedEnum) == 0 {
+					m.RepeatedNestedEnum = make([]TestAllTypesProto3_NestedEnum, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v TestAllTypesProto3_NestedEnum
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= TestAllTypesProto3_NestedEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedNestedEnum = append(m.RepeatedNestedEnum, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedNestedEnum", wireType)
+			}
+		case 52:
+			if wireType == 0 {
+				var v ForeignEnum
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ForeignEnum(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedForeignEnum = append(m.RepeatedForeignEnum, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.RepeatedForeignEnum) == 0 {
+					m.RepeatedForeignEnum = make([]ForeignEnum, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ForeignEnum
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ForeignEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedForeignEnum = append(m.RepeatedForeignEnum, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedForeignEnum", wireType)
+			}
+		case 54:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedStringPiece", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.RepeatedStringPiece = append(m.RepeatedStringPiece, string(string(dAtA[iNdEx:postIndex])))
+			iNdEx = postIndex
+		case 55:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedCord", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.RepeatedCord = append(m.RepeatedCord, string(string(dAtA[iNdEx:postIndex])))
+			iNdEx = postIndex
+		case 56:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapInt32Int32", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapInt32Int32 == nil {
+				m.MapInt32Int32 = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapInt32Int32[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 57:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapInt64Int64", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapInt64Int64 == nil {
+				m.MapInt64Int64 = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapInt64Int64[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapUint32Uint32", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapUint32Uint32 == nil {
+				m.MapUint32Uint32 = make(map[uint32]uint32)
+			}
+			var mapkey uint32
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapUint32Uint32[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapUint64Uint64", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapUint64Uint64 == nil {
+				m.MapUint64Uint64 = make(map[uint64]uint64)
+			}
+			var mapkey uint64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapUint64Uint64[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 60:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapSint32Sint32", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapSint32Sint32 == nil {
+				m.MapSint32Sint32 = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapvaluetemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = int32((uint32(mapvaluetemp) >> 1) ^ uint32(((mapvaluetemp&1)<<31)>>31))
+					mapvalue = int32(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapSint32Sint32[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 61:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapSint64Sint64", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapSint64Sint64 == nil {
+				m.MapSint64Sint64 = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = (mapvaluetemp >> 1) ^ uint64((int64(mapvaluetemp&1)<<63)>>63)
+					mapvalue = int64(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapSint64Sint64[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 62:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapFixed32Fixed32", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapFixed32Fixed32 == nil {
+				m.MapFixed32Fixed32 = make(map[uint32]uint32)
+			}
+			var mapkey uint32
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapFixed32Fixed32[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 63:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapFixed64Fixed64", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapFixed64Fixed64 == nil {
+				m.MapFixed64Fixed64 = make(map[uint64]uint64)
+			}
+			var mapkey uint64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapFixed64Fixed64[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 64:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapSfixed32Sfixed32", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapSfixed32Sfixed32 == nil {
+				m.MapSfixed32Sfixed32 = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapSfixed32Sfixed32[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 65:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapSfixed64Sfixed64", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapSfixed64Sfixed64 == nil {
+				m.MapSfixed64Sfixed64 = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapSfixed64Sfixed64[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 66:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapInt32Float", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapInt32Float == nil {
+				m.MapInt32Float = make(map[int32]float32)
+			}
+			var mapkey int32
+			var mapvalue float32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					mapvalue = math.Float32frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapInt32Float[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 67:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapInt32Double", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapInt32Double == nil {
+				m.MapInt32Double = make(map[int32]float64)
+			}
+			var mapkey int32
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapInt32Double[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 68:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapBoolBool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapBoolBool == nil {
+				m.MapBoolBool = make(map[bool]bool)
+			}
+			var mapkey bool
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkey = bool(mapkeytemp != 0)
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapBoolBool[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 69:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapStringString", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapStringString == nil {
+				m.MapStringString = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapvalue]) {
+						return ErrInvalidUTF8
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapStringString[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 70:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapStringBytes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapStringBytes == nil {
+				m.MapStringBytes = make(map[string][]byte)
+			}
+			var mapkey string
+			var mapvalue []byte
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLength
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapStringBytes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 71:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapStringNestedMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapStringNestedMessage == nil {
+				m.MapStringNestedMessage = make(map[string]*TestAllTypesProto3_NestedMessage)
+			}
+			var mapkey string
+			var mapvalue *TestAllTypesProto3_NestedMessage
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &TestAllTypesProto3_NestedMessage{}
+					if err := mapvalue.unmarshalVT(dAtA[iNdEx:postmsgIndex], opts, depth); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapStringNestedMessage[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 72:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapStringForeignMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapStringForeignMessage == nil {
+				m.MapStringForeignMessage = make(map[string]*ForeignMessage)
+			}
+			var mapkey string
+			var mapvalue *ForeignMessage
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ForeignMessage{}
+					if err := mapvalue.unmarshalVT(dAtA[iNdEx:postmsgIndex], opts, depth); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapStringForeignMessage[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 73:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapStringNestedEnum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapStringNestedEnum == nil {
+				m.MapStringNestedEnum = make(map[string]TestAllTypesProto3_NestedEnum)
+			}
+			var mapkey string
+			var mapvalue TestAllTypesProto3_NestedEnum
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= TestAllTypesProto3_NestedEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapStringNestedEnum[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 74:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MapStringForeignEnum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MapStringForeignEnum == nil {
+				m.MapStringForeignEnum = make(map[string]ForeignEnum)
+			}
+			var mapkey string
+			var mapvalue ForeignEnum
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= ForeignEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MapStringForeignEnum[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 75:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedInt32 = append(m.PackedInt32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedInt32) == 0 {
+					m.PackedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PackedInt32 = append(m.PackedInt32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedInt32", wireType)
+			}
+		case 76:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedInt64 = append(m.PackedInt64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedInt64) == 0 {
+					m.PackedInt64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PackedInt64 = append(m.PackedInt64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedInt64", wireType)
+			}
+		case 77:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedUint32 = append(m.PackedUint32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedUint32) == 0 {
+					m.PackedUint32 = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PackedUint32 = append(m.PackedUint32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedUint32", wireType)
+			}
+		case 78:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedUint64 = append(m.PackedUint64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedUint64) == 0 {
+					m.PackedUint64 = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PackedUint64 = append(m.PackedUint64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedUint64", wireType)
+			}
+		case 79:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				m.PackedSint32 = append(m.PackedSint32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedSint32) == 0 {
+					m.PackedSint32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+					m.PackedSint32 = append(m.PackedSint32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedSint32", wireType)
+			}
+		case 80:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				m.PackedSint64 = append(m.PackedSint64, int64(v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedSint64) == 0 {
+					m.PackedSint64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					m.PackedSint64 = append(m.PackedSint64, int64(v))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedSint64", wireType)
+			}
+		case 81:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.PackedFixed32 = append(m.PackedFixed32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.PackedFixed32) == 0 {
+					m.PackedFixed32 = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.PackedFixed32 = append(m.PackedFixed32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedFixed32", wireType)
+			}
+		case 82:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.PackedFixed64 = append(m.PackedFixed64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.PackedFixed64) == 0 {
+					m.PackedFixed64 = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.PackedFixed64 = append(m.PackedFixed64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedFixed64", wireType)
+			}
+		case 83:
+			if wireType == 5 {
+				var v int32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.PackedSfixed32 = append(m.PackedSfixed32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.PackedSfixed32) == 0 {
+					m.PackedSfixed32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.PackedSfixed32 = append(m.PackedSfixed32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedSfixed32", wireType)
+			}
+		case 84:
+			if wireType == 1 {
+				var v int64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.PackedSfixed64 = append(m.PackedSfixed64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.PackedSfixed64) == 0 {
+					m.PackedSfixed64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.PackedSfixed64 = append(m.PackedSfixed64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedSfixed64", wireType)
+			}
+		case 85:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				m.PackedFloat = append(m.PackedFloat, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.PackedFloat) == 0 {
+					m.PackedFloat = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					v2 := float32(math.Float32frombits(v))
+					m.PackedFloat = append(m.PackedFloat, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedFloat", wireType)
+			}
+		case 86:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.PackedDouble = append(m.PackedDouble, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.PackedDouble) == 0 {
+					m.PackedDouble = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.PackedDouble = append(m.PackedDouble, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedDouble", wireType)
+			}
+		case 87:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedBool = append(m.PackedBool, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.PackedBool) == 0 {
+					m.PackedBool = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PackedBool = append(m.PackedBool, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedBool", wireType)
+			}
+		case 88:
+			if wireType == 0 {
+				var v TestAllTypesProto3_NestedEnum
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= TestAllTypesProto3_NestedEnum(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedNestedEnum = append(m.PackedNestedEnum, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.PackedNestedEnum) == 0 {
+					m.PackedNestedEnum = make([]TestAllTypesProto3_NestedEnum, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v TestAllTypesProto3_NestedEnum
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= TestAllTypesProto3_NestedEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PackedNestedEnum = append(m.PackedNestedEnum, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedNestedEnum", wireType)
+			}
+		case 89:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnpackedInt32 = append(m.UnpackedInt32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnpackedInt32) == 0 {
+					m.UnpackedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnpackedInt32 = append(m.UnpackedInt32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedInt32", wireType)
+			}
+		case 90:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnpackedInt64 = append(m.UnpackedInt64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnpackedInt64) == 0 {
+					m.UnpackedInt64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnpackedInt64 = append(m.UnpackedInt64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedInt64", wireType)
+			}
+		case 91:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnpackedUint32 = append(m.UnpackedUint32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnpackedUint32) == 0 {
+					m.UnpackedUint32 = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnpackedUint32 = append(m.UnpackedUint32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedUint32", wireType)
+			}
+		case 92:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnpackedUint64 = append(m.UnpackedUint64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnpackedUint64) == 0 {
+					m.UnpackedUint64 = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnpackedUint64 = append(m.UnpackedUint64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedUint64", wireType)
+			}
+		case 93:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				m.UnpackedSint32 = append(m.UnpackedSint32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnpackedSint32) == 0 {
+					m.UnpackedSint32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+					m.UnpackedSint32 = append(m.UnpackedSint32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedSint32", wireType)
+			}
+		case 94:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				m.UnpackedSint64 = append(m.UnpackedSint64, int64(v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnpackedSint64) == 0 {
+					m.UnpackedSint64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					m.UnpackedSint64 = append(m.UnpackedSint64, int64(v))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedSint64", wireType)
+			}
+		case 95:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.UnpackedFixed32 = append(m.UnpackedFixed32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.UnpackedFixed32) == 0 {
+					m.UnpackedFixed32 = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.UnpackedFixed32 = append(m.UnpackedFixed32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedFixed32", wireType)
+			}
+		case 96:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.UnpackedFixed64 = append(m.UnpackedFixed64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.UnpackedFixed64) == 0 {
+					m.UnpackedFixed64 = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.UnpackedFixed64 = append(m.UnpackedFixed64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedFixed64", wireType)
+			}
+		case 97:
+			if wireType == 5 {
+				var v int32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.UnpackedSfixed32 = append(m.UnpackedSfixed32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.UnpackedSfixed32) == 0 {
+					m.UnpackedSfixed32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.UnpackedSfixed32 = append(m.UnpackedSfixed32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedSfixed32", wireType)
+			}
+		case 98:
+			if wireType == 1 {
+				var v int64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.UnpackedSfixed64 = append(m.UnpackedSfixed64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.UnpackedSfixed64) == 0 {
+					m.UnpackedSfixed64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.UnpackedSfixed64 = append(m.UnpackedSfixed64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedSfixed64", wireType)
+			}
+		case 99:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				m.UnpackedFloat = append(m.UnpackedFloat, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.UnpackedFloat) == 0 {
+					m.UnpackedFloat = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					v2 := float32(math.Float32frombits(v))
+					m.UnpackedFloat = append(m.UnpackedFloat, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedFloat", wireType)
+			}
+		case 100:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.UnpackedDouble = append(m.UnpackedDouble, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.UnpackedDouble) == 0 {
+					m.UnpackedDouble = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.UnpackedDouble = append(m.UnpackedDouble, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedDouble", wireType)
+			}
+		case 101:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnpackedBool = append(m.UnpackedBool, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.UnpackedBool) == 0 {
+					m.UnpackedBool = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnpackedBool = append(m.UnpackedBool, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedBool", wireType)
+			}
+		case 102:
+			if wireType == 0 {
+				var v TestAllTypesProto3_NestedEnum
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= TestAllTypesProto3_NestedEnum(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnpackedNestedEnum = append(m.UnpackedNestedEnum, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.UnpackedNestedEnum) == 0 {
+					m.UnpackedNestedEnum = make([]TestAllTypesProto3_NestedEnum, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v TestAllTypesProto3_NestedEnum
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= TestAllTypesProto3_NestedEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnpackedNestedEnum = append(m.UnpackedNestedEnum, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnpackedNestedEnum", wireType)
+			}
+		case 111:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofUint32", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OneofField = &TestAllTypesProto3_OneofUint32{OneofUint32: v}
+		case 112:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofNestedMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.OneofField.(*TestAllTypesProto3_OneofNestedMessage); ok {
+				if err := oneof.OneofNestedMessage.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+					return err
+				}
+			} else {
+				v := &TestAllTypesProto3_NestedMessage{}
+				if err := v.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+					return err
+				}
+				m.OneofField = &TestAllTypesProto3_OneofNestedMessage{OneofNestedMessage: v}
+			}
+			iNdEx = postIndex
+		case 113:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.OneofField = &TestAllTypesProto3_OneofString{OneofString: string(string(dAtA[iNdEx:postIndex]))}
+			iNdEx = postIndex
+		case 114:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.OneofField = &TestAllTypesProto3_OneofBytes{OneofBytes: v}
+			iNdEx = postIndex
+		case 115:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofBool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.OneofField = &TestAllTypesProto3_OneofBool{OneofBool: b}
+		case 116:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofUint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OneofField = &TestAllTypesProto3_OneofUint64{OneofUint64: v}
+		case 117:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofFloat", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.OneofField = &TestAllTypesProto3_OneofFloat{OneofFloat: float32(math.Float32frombits(v))}
+		case 118:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofDouble", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.OneofField = &TestAllTypesProto3_OneofDouble{OneofDouble: float64(math.Float64frombits(v))}
+		case 119:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofEnum", wireType)
+			}
+			var v TestAllTypesProto3_NestedEnum
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= TestAllTypesProto3_NestedEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OneofField = &TestAllTypesProto3_OneofEnum{OneofEnum: v}
+		case 120:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofNullValue", wireType)
+			}
+			var v structpb.NullValue
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= structpb.NullValue(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OneofField = &TestAllTypesProto3_OneofNullValue{OneofNullValue: v}
+		case 201:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalBoolWrapper", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalBoolWrapper == nil {
+				m.OptionalBoolWrapper = &wrapperspb.BoolValue{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.OptionalBoolWrapper).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalBoolWrapper); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 202:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalInt32Wrapper", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalInt32Wrapper == nil {
+				m.OptionalInt32Wrapper = &wrapperspb.Int32Value{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.OptionalInt32Wrapper).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalInt32Wrapper); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 203:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalInt64Wrapper", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalInt64Wrapper == nil {
+				m.OptionalInt64Wrapper = &wrapperspb.Int64Value{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.OptionalInt64Wrapper).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalInt64Wrapper); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 204:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalUint32Wrapper", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalUint32Wrapper == nil {
+				m.OptionalUint32Wrapper = &wrapperspb.UInt32Value{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.OptionalUint32Wrapper).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalUint32Wrapper); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 205:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalUint64Wrapper", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalUint64Wrapper == nil {
+				m.OptionalUint64Wrapper = &wrapperspb.UInt64Value{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.OptionalUint64Wrapper).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalUint64Wrapper); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 206:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalFloatWrapper", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalFloatWrapper == nil {
+				m.OptionalFloatWrapper = &wrapperspb.FloatValue{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.OptionalFloatWrapper).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalFloatWrapper); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 207:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalDoubleWrapper", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalDoubleWrapper == nil {
+				m.OptionalDoubleWrapper = &wrapperspb.DoubleValue{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.OptionalDoubleWrapper).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalDoubleWrapper); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 208:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalStringWrapper", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalStringWrapper == nil {
+				m.OptionalStringWrapper = &wrapperspb.StringValue{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.OptionalStringWrapper).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalStringWrapper); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 209:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalBytesWrapper", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalBytesWrapper == nil {
+				m.OptionalBytesWrapper = &wrapperspb.BytesValue{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.OptionalBytesWrapper).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalBytesWrapper); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 211:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedBoolWrapper", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedBoolWrapper = append(m.RepeatedBoolWrapper, &wrapperspb.BoolValue{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.RepeatedBoolWrapper[len(m.RepeatedBoolWrapper)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedBoolWrapper[len(m.RepeatedBoolWrapper)-1]); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 212:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt32Wrapper", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedInt32Wrapper = append(m.RepeatedInt32Wrapper, &wrapperspb.Int32Value{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.RepeatedInt32Wrapper[len(m.RepeatedInt32Wrapper)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedInt32Wrapper[len(m.RepeatedInt32Wrapper)-1]); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 213:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt64Wrapper", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedInt64Wrapper = append(m.RepeatedInt64Wrapper, &wrapperspb.Int64Value{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.RepeatedInt64Wrapper[len(m.RepeatedInt64Wrapper)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedInt64Wrapper[len(m.RepeatedInt64Wrapper)-1]); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 214:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint32Wrapper", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedUint32Wrapper = append(m.RepeatedUint32Wrapper, &wrapperspb.UInt32Value{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.RepeatedUint32Wrapper[len(m.RepeatedUint32Wrapper)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedUint32Wrapper[len(m.RepeatedUint32Wrapper)-1]); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 215:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedUint64Wrapper", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedUint64Wrapper = append(m.RepeatedUint64Wrapper, &wrapperspb.UInt64Value{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.RepeatedUint64Wrapper[len(m.RepeatedUint64Wrapper)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedUint64Wrapper[len(m.RepeatedUint64Wrapper)-1]); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 216:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedFloatWrapper", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedFloatWrapper = append(m.RepeatedFloatWrapper, &wrapperspb.FloatValue{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.RepeatedFloatWrapper[len(m.RepeatedFloatWrapper)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedFloatWrapper[len(m.RepeatedFloatWrapper)-1]); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 217:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedDoubleWrapper", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedDoubleWrapper = append(m.RepeatedDoubleWrapper, &wrapperspb.DoubleValue{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.RepeatedDoubleWrapper[len(m.RepeatedDoubleWrapper)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedDoubleWrapper[len(m.RepeatedDoubleWrapper)-1]); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 218:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedStringWrapper", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedStringWrapper = append(m.RepeatedStringWrapper, &wrapperspb.StringValue{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.RepeatedStringWrapper[len(m.RepeatedStringWrapper)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedStringWrapper[len(m.RepeatedStringWrapper)-1]); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 219:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedBytesWrapper", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedBytesWrapper = append(m.RepeatedBytesWrapper, &wrapperspb.BytesValue{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.RepeatedBytesWrapper[len(m.RepeatedBytesWrapper)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedBytesWrapper[len(m.RepeatedBytesWrapper)-1]); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 301:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalDuration == nil {
+				m.OptionalDuration = &durationpb.Duration{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.OptionalDuration).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalDuration); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 302:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalTimestamp == nil {
+				m.OptionalTimestamp = &timestamppb.Timestamp{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.OptionalTimestamp).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalTimestamp); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 303:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalFieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalFieldMask == nil {
+				m.OptionalFieldMask = &fieldmaskpb.FieldMask{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.OptionalFieldMask).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalFieldMask); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 304:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalStruct", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalStruct == nil {
+				m.OptionalStruct = &structpb.Struct{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.OptionalStruct).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalStruct); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 305:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalAny", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalAny == nil {
+				m.OptionalAny = &anypb.Any{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.OptionalAny).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalAny); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 306:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalValue == nil {
+				m.OptionalValue = &structpb.Value{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.OptionalValue).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalValue); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 307:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalNullValue", wireType)
+			}
+			m.OptionalNullValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OptionalNullValue |= structpb.NullValue(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 311:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedDuration = append(m.RepeatedDuration, &durationpb.Duration{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.RepeatedDuration[len(m.RepeatedDuration)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedDuration[len(m.RepeatedDuration)-1]); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 312:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedTimestamp = append(m.RepeatedTimestamp, &timestamppb.Timestamp{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.RepeatedTimestamp[len(m.RepeatedTimestamp)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedTimestamp[len(m.RepeatedTimestamp)-1]); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 313:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedFieldmask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedFieldmask = append(m.RepeatedFieldmask, &fieldmaskpb.FieldMask{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.RepeatedFieldmask[len(m.RepeatedFieldmask)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedFieldmask[len(m.RepeatedFieldmask)-1]); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 315:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedAny", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedAny = append(m.RepeatedAny, &anypb.Any{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.RepeatedAny[len(m.RepeatedAny)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedAny[len(m.RepeatedAny)-1]); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 316:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedValue = append(m.RepeatedValue, &structpb.Value{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.RepeatedValue[len(m.RepeatedValue)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedValue[len(m.RepeatedValue)-1]); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 317:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedListValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedListValue = append(m.RepeatedListValue, &structpb.ListValue{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.RepeatedListValue[len(m.RepeatedListValue)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedListValue[len(m.RepeatedListValue)-1]); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 324:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedStruct", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedStruct = append(m.RepeatedStruct, &structpb.Struct{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.RepeatedStruct[len(m.RepeatedStruct)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedStruct[len(m.RepeatedStruct)-1]); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 401:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fieldname1", wireType)
+			}
+			m.Fieldname1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fieldname1 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 402:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldName2", wireType)
+			}
+			m.FieldName2 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FieldName2 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 403:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XFieldName3", wireType)
+			}
+			m.XFieldName3 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.XFieldName3 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 404:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field_Name4_", wireType)
+			}
+			m.Field_Name4_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field_Name4_ |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 405:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field0Name5", wireType)
+			}
+			m.Field0Name5 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field0Name5 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 406:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field_0Name6", wireType)
+			}
+			m.Field_0Name6 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field_0Name6 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 407:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldName7", wireType)
+			}
+			m.FieldName7 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FieldName7 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 408:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldName8", wireType)
+			}
+			m.FieldName8 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FieldName8 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 409:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field_Name9", wireType)
+			}
+			m.Field_Name9 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field_Name9 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 410:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field_Name10", wireType)
+			}
+			m.Field_Name10 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field_Name10 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 411:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FIELD_NAME11", wireType)
+			}
+			m.FIELD_NAME11 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FIELD_NAME11 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 412:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FIELDName12", wireType)
+			}
+			m.FIELDName12 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FIELDName12 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 413:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XFieldName13", wireType)
+			}
+			m.XFieldName13 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.XFieldName13 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 414:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X_FieldName14", wireType)
+			}
+			m.X_FieldName14 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.X_FieldName14 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 415:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field_Name15", wireType)
+			}
+			m.Field_Name15 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field_Name15 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 416:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field__Name16", wireType)
+			}
+			m.Field__Name16 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Field__Name16 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 417:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldName17__", wireType)
+			}
+			m.FieldName17__ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FieldName17__ |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 418:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldName18__", wireType)
+			}
+			m.FieldName18__ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FieldName18__ |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForeignMessage) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *ForeignMessage) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
 	}
-	if m.Field_Name15 != 0 {
-		n += 2 + sov(uint64(m.Field_Name15))
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
 	}
-	if m.Field__Name16 != 0 {
-		n += 2 + sov(uint64(m.Field__Name16))
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *ForeignMessage) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
 	}
-	if m.FieldName17__ != 0 {
-		n += 2 + sov(uint64(m.FieldName17__))
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
 	}
-	if m.FieldName18__ != 0 {
-		n += 2 + sov(uint64(m.FieldName18__))
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return end, nil
 }
 
-func (m *TestAllTypesProto3_OneofUint32) SizeVT() (n int) {
-	if m == nil {
-		return 0
+func (m *ForeignMessage) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
 	}
-	var l int
-	_ = l
-	n += 2 + sov(uint64(m.OneofUint32))
-	return n
-}
-func (m *TestAllTypesProto3_OneofNestedMessage) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*ForeignMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForeignMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForeignMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+			}
+			m.C = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.C |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	if m.OneofNestedMessage != nil {
-		l = m.OneofNestedMessage.SizeVT()
-		n += 2 + l + sov(uint64(l))
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-func (m *TestAllTypesProto3_OneofString) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OneofString)
-	n += 2 + l + sov(uint64(l))
-	return n
+func (m *NullHypothesisProto3) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
 }
-func (m *TestAllTypesProto3_OneofBytes) SizeVT() (n int) {
-	if m == nil {
-		return 0
+
+func (m *NullHypothesisProto3) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
 	}
-	var l int
-	_ = l
-	l = len(m.OneofBytes)
-	n += 2 + l + sov(uint64(l))
-	return n
-}
-func (m *TestAllTypesProto3_OneofBool) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
 	}
-	var l int
-	_ = l
-	n += 3
-	return n
+	return m.unmarshalVT(dAtA, opts, depth)
 }
-func (m *TestAllTypesProto3_OneofUint64) SizeVT() (n int) {
-	if m == nil {
-		return 0
+
+func (m *NullHypothesisProto3) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
 	}
-	var l int
-	_ = l
-	n += 2 + sov(uint64(m.OneofUint64))
-	return n
-}
-func (m *TestAllTypesProto3_OneofFloat) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	n += 6
-	return n
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
 }
-func (m *TestAllTypesProto3_OneofDouble) SizeVT() (n int) {
-	if m == nil {
-		return 0
+
+func (m *NullHypothesisProto3) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
 	}
-	var l int
-	_ = l
-	n += 10
-	return n
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*NullHypothesisProto3)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NullHypothesisProto3: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NullHypothesisProto3: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *TestAllTypesProto3_OneofEnum) SizeVT() (n int) {
-	if m == nil {
-		return 0
+func (m *EnumOnlyProto3) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *EnumOnlyProto3) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
 	}
-	var l int
-	_ = l
-	n += 2 + sov(uint64(m.OneofEnum))
-	return n
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
 }
-func (m *TestAllTypesProto3_OneofNullValue) SizeVT() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EnumOnlyProto3) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
 	}
-	var l int
-	_ = l
-	n += 2 + sov(uint64(m.OneofNullValue))
-	return n
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
 }
-func (m *ForeignMessage) SizeVT() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EnumOnlyProto3) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
 	}
-	var l int
-	_ = l
-	if m.C != 0 {
-		n += 1 + sov(uint64(m.C))
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*EnumOnlyProto3)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnumOnlyProto3: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnumOnlyProto3: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
 	}
-	n += len(m.unknownFields)
-	return n
-}
+	preIndex = iNdEx
 
-func (m *NullHypothesisProto3) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	n += len(m.unknownFields)
-	return n
+	return nil
+}
+func (m *TestAllTypesProto3_NestedMessage) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
 }
 
-func (m *EnumOnlyProto3) SizeVT() (n int) {
-	if m == nil {
-		return 0
+func (m *TestAllTypesProto3_NestedMessage) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
 	}
-	var l int
-	_ = l
-	n += len(m.unknownFields)
-	return n
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
 }
 
-func (m *TestAllTypesProto3_NestedMessage) UnmarshalVT(dAtA []byte) error {
+func (m *TestAllTypesProto3_NestedMessage) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*TestAllTypesProto3_NestedMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -6143,7 +27090,7 @@ func (m *TestAllTypesProto3_NestedMessage) UnmarshalVT(dAtA []byte) error {
 			if m.Corecursive == nil {
 				m.Corecursive = &TestAllTypesProto3{}
 			}
-			if err := m.Corecursive.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Corecursive.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6159,21 +27106,49 @@ func (m *TestAllTypesProto3_NestedMessage) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
+func (m *TestAllTypesProto3) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *TestAllTypesProto3) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *TestAllTypesProto3) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*TestAllTypesProto3)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -6428,7 +27403,14 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptionalString = string(dAtA[iNdEx:postIndex])
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.OptionalString = string(stringValue)
 			iNdEx = postIndex
 		case 15:
 			if wireType != 2 {
@@ -6459,10 +27441,7 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptionalBytes = append(m.OptionalBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.OptionalBytes == nil {
-				m.OptionalBytes = []byte{}
-			}
+			m.OptionalBytes = dAtA[iNdEx:postIndex:postIndex]
 			iNdEx = postIndex
 		case 18:
 			if wireType != 2 {
@@ -6496,7 +27475,7 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if m.OptionalNestedMessage == nil {
 				m.OptionalNestedMessage = &TestAllTypesProto3_NestedMessage{}
 			}
-			if err := m.OptionalNestedMessage.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OptionalNestedMessage.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6532,7 +27511,7 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if m.OptionalForeignMessage == nil {
 				m.OptionalForeignMessage = &ForeignMessage{}
 			}
-			if err := m.OptionalForeignMessage.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OptionalForeignMessage.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6623,7 +27602,14 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptionalStringPiece = string(dAtA[iNdEx:postIndex])
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.OptionalStringPiece = string(stringValue)
 			iNdEx = postIndex
 		case 25:
 			if wireType != 2 {
@@ -6655,7 +27641,14 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptionalCord = string(dAtA[iNdEx:postIndex])
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.OptionalCord = string(stringValue)
 			iNdEx = postIndex
 		case 27:
 			if wireType != 2 {
@@ -6689,7 +27682,7 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if m.RecursiveMessage == nil {
 				m.RecursiveMessage = &TestAllTypesProto3{}
 			}
-			if err := m.RecursiveMessage.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RecursiveMessage.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7569,7 +28562,14 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepeatedString = append(m.RepeatedString, string(dAtA[iNdEx:postIndex]))
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.RepeatedString = append(m.RepeatedString, string(stringValue))
 			iNdEx = postIndex
 		case 45:
 			if wireType != 2 {
@@ -7600,8 +28600,7 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepeatedBytes = append(m.RepeatedBytes, make([]byte, postIndex-iNdEx))
-			copy(m.RepeatedBytes[len(m.RepeatedBytes)-1], dAtA[iNdEx:postIndex])
+			m.RepeatedBytes = append(m.RepeatedBytes, dAtA[iNdEx:postIndex:postIndex])
 			iNdEx = postIndex
 		case 48:
 			if wireType != 2 {
@@ -7633,7 +28632,7 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.RepeatedNestedMessage = append(m.RepeatedNestedMessage, &TestAllTypesProto3_NestedMessage{})
-			if err := m.RepeatedNestedMessage[len(m.RepeatedNestedMessage)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RepeatedNestedMessage[len(m.RepeatedNestedMessage)-1].unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7667,7 +28666,7 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.RepeatedForeignMessage = append(m.RepeatedForeignMessage, &ForeignMessage{})
-			if err := m.RepeatedForeignMessage[len(m.RepeatedForeignMessage)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RepeatedForeignMessage[len(m.RepeatedForeignMessage)-1].unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7839,7 +28838,14 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepeatedStringPiece = append(m.RepeatedStringPiece, string(dAtA[iNdEx:postIndex]))
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.RepeatedStringPiece = append(m.RepeatedStringPiece, string(stringValue))
 			iNdEx = postIndex
 		case 55:
 			if wireType != 2 {
@@ -7871,7 +28877,14 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepeatedCord = append(m.RepeatedCord, string(dAtA[iNdEx:postIndex]))
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.RepeatedCord = append(m.RepeatedCord, string(stringValue))
 			iNdEx = postIndex
 		case 56:
 			if wireType != 2 {
@@ -9169,7 +30182,12 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					if intStringLenmapkey > 0 {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var stringLenmapvalue uint64
@@ -9198,7 +30216,12 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapvalue]) {
+						return ErrInvalidUTF8
+					}
+					if intStringLenmapvalue > 0 {
+						mapvalue = unsafe.String(&dAtA[iNdEx], intStringLenmapvalue)
+					}
 					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
@@ -9296,7 +30319,12 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					if intStringLenmapkey > 0 {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var mapbyteLen uint64
@@ -9325,8 +30353,7 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 					if postbytesIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = make([]byte, mapbyteLen)
-					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					mapvalue = dAtA[iNdEx:postbytesIndex:postbytesIndex]
 					iNdEx = postbytesIndex
 				} else {
 					iNdEx = entryPreIndex
@@ -9424,7 +30451,12 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					if intStringLenmapkey > 0 {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var mapmsglen int
@@ -9453,7 +30485,7 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 						return io.ErrUnexpectedEOF
 					}
 					mapvalue = &TestAllTypesProto3_NestedMessage{}
-					if err := mapvalue.UnmarshalVT(dAtA[iNdEx:postmsgIndex]); err != nil {
+					if err := mapvalue.unmarshalVTUnsafe(dAtA[iNdEx:postmsgIndex], opts, depth); err != nil {
 						return err
 					}
 					iNdEx = postmsgIndex
@@ -9553,7 +30585,12 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					if intStringLenmapkey > 0 {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var mapmsglen int
@@ -9582,7 +30619,7 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 						return io.ErrUnexpectedEOF
 					}
 					mapvalue = &ForeignMessage{}
-					if err := mapvalue.UnmarshalVT(dAtA[iNdEx:postmsgIndex]); err != nil {
+					if err := mapvalue.unmarshalVTUnsafe(dAtA[iNdEx:postmsgIndex], opts, depth); err != nil {
 						return err
 					}
 					iNdEx = postmsgIndex
@@ -9682,7 +30719,12 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					if intStringLenmapkey > 0 {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					for shift := uint(0); ; shift += 7 {
@@ -9795,7 +30837,12 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					if intStringLenmapkey > 0 {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					for shift := uint(0); ; shift += 7 {
@@ -11709,12 +32756,12 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if oneof, ok := m.OneofField.(*TestAllTypesProto3_OneofNestedMessage); ok {
-				if err := oneof.OneofNestedMessage.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := oneof.OneofNestedMessage.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
 					return err
 				}
 			} else {
 				v := &TestAllTypesProto3_NestedMessage{}
-				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := v.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
 					return err
 				}
 				m.OneofField = &TestAllTypesProto3_OneofNestedMessage{OneofNestedMessage: v}
@@ -11750,7 +32797,14 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OneofField = &TestAllTypesProto3_OneofString{OneofString: string(dAtA[iNdEx:postIndex])}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.OneofField = &TestAllTypesProto3_OneofString{OneofString: string(stringValue)}
 			iNdEx = postIndex
 		case 114:
 			if wireType != 2 {
@@ -11781,9 +32835,7 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.OneofField = &TestAllTypesProto3_OneofBytes{OneofBytes: v}
+			m.OneofField = &TestAllTypesProto3_OneofBytes{OneofBytes: dAtA[iNdEx:postIndex:postIndex]}
 			iNdEx = postIndex
 		case 115:
 			if wireType != 0 {
@@ -11920,14 +32972,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if m.OptionalBoolWrapper == nil {
 				m.OptionalBoolWrapper = &wrapperspb.BoolValue{}
 			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.OptionalBoolWrapper).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.OptionalBoolWrapper).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.OptionalBoolWrapper); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalBoolWrapper); err != nil {
 					return err
 				}
 			}
@@ -11964,14 +33040,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if m.OptionalInt32Wrapper == nil {
 				m.OptionalInt32Wrapper = &wrapperspb.Int32Value{}
 			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.OptionalInt32Wrapper).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.OptionalInt32Wrapper).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.OptionalInt32Wrapper); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalInt32Wrapper); err != nil {
 					return err
 				}
 			}
@@ -12008,14 +33108,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if m.OptionalInt64Wrapper == nil {
 				m.OptionalInt64Wrapper = &wrapperspb.Int64Value{}
 			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.OptionalInt64Wrapper).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.OptionalInt64Wrapper).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.OptionalInt64Wrapper); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalInt64Wrapper); err != nil {
 					return err
 				}
 			}
@@ -12052,14 +33176,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if m.OptionalUint32Wrapper == nil {
 				m.OptionalUint32Wrapper = &wrapperspb.UInt32Value{}
 			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.OptionalUint32Wrapper).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.OptionalUint32Wrapper).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.OptionalUint32Wrapper); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalUint32Wrapper); err != nil {
 					return err
 				}
 			}
@@ -12096,14 +33244,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if m.OptionalUint64Wrapper == nil {
 				m.OptionalUint64Wrapper = &wrapperspb.UInt64Value{}
 			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.OptionalUint64Wrapper).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.OptionalUint64Wrapper).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.OptionalUint64Wrapper); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalUint64Wrapper); err != nil {
 					return err
 				}
 			}
@@ -12140,14 +33312,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if m.OptionalFloatWrapper == nil {
 				m.OptionalFloatWrapper = &wrapperspb.FloatValue{}
 			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.OptionalFloatWrapper).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.OptionalFloatWrapper).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.OptionalFloatWrapper); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalFloatWrapper); err != nil {
 					return err
 				}
 			}
@@ -12184,14 +33380,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if m.OptionalDoubleWrapper == nil {
 				m.OptionalDoubleWrapper = &wrapperspb.DoubleValue{}
 			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.OptionalDoubleWrapper).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.OptionalDoubleWrapper).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.OptionalDoubleWrapper); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalDoubleWrapper); err != nil {
 					return err
 				}
 			}
@@ -12228,14 +33448,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if m.OptionalStringWrapper == nil {
 				m.OptionalStringWrapper = &wrapperspb.StringValue{}
 			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.OptionalStringWrapper).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.OptionalStringWrapper).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.OptionalStringWrapper); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalStringWrapper); err != nil {
 					return err
 				}
 			}
@@ -12272,14 +33516,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if m.OptionalBytesWrapper == nil {
 				m.OptionalBytesWrapper = &wrapperspb.BytesValue{}
 			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.OptionalBytesWrapper).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.OptionalBytesWrapper).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.OptionalBytesWrapper); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalBytesWrapper); err != nil {
 					return err
 				}
 			}
@@ -12314,14 +33582,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.RepeatedBoolWrapper = append(m.RepeatedBoolWrapper, &wrapperspb.BoolValue{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.RepeatedBoolWrapper[len(m.RepeatedBoolWrapper)-1]).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.RepeatedBoolWrapper[len(m.RepeatedBoolWrapper)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedBoolWrapper[len(m.RepeatedBoolWrapper)-1]); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedBoolWrapper[len(m.RepeatedBoolWrapper)-1]); err != nil {
 					return err
 				}
 			}
@@ -12356,14 +33648,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.RepeatedInt32Wrapper = append(m.RepeatedInt32Wrapper, &wrapperspb.Int32Value{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.RepeatedInt32Wrapper[len(m.RepeatedInt32Wrapper)-1]).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.RepeatedInt32Wrapper[len(m.RepeatedInt32Wrapper)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedInt32Wrapper[len(m.RepeatedInt32Wrapper)-1]); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedInt32Wrapper[len(m.RepeatedInt32Wrapper)-1]); err != nil {
 					return err
 				}
 			}
@@ -12398,14 +33714,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.RepeatedInt64Wrapper = append(m.RepeatedInt64Wrapper, &wrapperspb.Int64Value{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.RepeatedInt64Wrapper[len(m.RepeatedInt64Wrapper)-1]).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.RepeatedInt64Wrapper[len(m.RepeatedInt64Wrapper)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedInt64Wrapper[len(m.RepeatedInt64Wrapper)-1]); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedInt64Wrapper[len(m.RepeatedInt64Wrapper)-1]); err != nil {
 					return err
 				}
 			}
@@ -12440,14 +33780,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.RepeatedUint32Wrapper = append(m.RepeatedUint32Wrapper, &wrapperspb.UInt32Value{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.RepeatedUint32Wrapper[len(m.RepeatedUint32Wrapper)-1]).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.RepeatedUint32Wrapper[len(m.RepeatedUint32Wrapper)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedUint32Wrapper[len(m.RepeatedUint32Wrapper)-1]); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedUint32Wrapper[len(m.RepeatedUint32Wrapper)-1]); err != nil {
 					return err
 				}
 			}
@@ -12482,14 +33846,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.RepeatedUint64Wrapper = append(m.RepeatedUint64Wrapper, &wrapperspb.UInt64Value{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.RepeatedUint64Wrapper[len(m.RepeatedUint64Wrapper)-1]).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.RepeatedUint64Wrapper[len(m.RepeatedUint64Wrapper)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedUint64Wrapper[len(m.RepeatedUint64Wrapper)-1]); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedUint64Wrapper[len(m.RepeatedUint64Wrapper)-1]); err != nil {
 					return err
 				}
 			}
@@ -12524,14 +33912,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.RepeatedFloatWrapper = append(m.RepeatedFloatWrapper, &wrapperspb.FloatValue{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.RepeatedFloatWrapper[len(m.RepeatedFloatWrapper)-1]).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.RepeatedFloatWrapper[len(m.RepeatedFloatWrapper)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedFloatWrapper[len(m.RepeatedFloatWrapper)-1]); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedFloatWrapper[len(m.RepeatedFloatWrapper)-1]); err != nil {
 					return err
 				}
 			}
@@ -12566,14 +33978,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.RepeatedDoubleWrapper = append(m.RepeatedDoubleWrapper, &wrapperspb.DoubleValue{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.RepeatedDoubleWrapper[len(m.RepeatedDoubleWrapper)-1]).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.RepeatedDoubleWrapper[len(m.RepeatedDoubleWrapper)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedDoubleWrapper[len(m.RepeatedDoubleWrapper)-1]); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedDoubleWrapper[len(m.RepeatedDoubleWrapper)-1]); err != nil {
 					return err
 				}
 			}
@@ -12608,14 +34044,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.RepeatedStringWrapper = append(m.RepeatedStringWrapper, &wrapperspb.StringValue{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.RepeatedStringWrapper[len(m.RepeatedStringWrapper)-1]).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.RepeatedStringWrapper[len(m.RepeatedStringWrapper)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedStringWrapper[len(m.RepeatedStringWrapper)-1]); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedStringWrapper[len(m.RepeatedStringWrapper)-1]); err != nil {
 					return err
 				}
 			}
@@ -12650,14 +34110,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.RepeatedBytesWrapper = append(m.RepeatedBytesWrapper, &wrapperspb.BytesValue{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.RepeatedBytesWrapper[len(m.RepeatedBytesWrapper)-1]).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.RepeatedBytesWrapper[len(m.RepeatedBytesWrapper)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedBytesWrapper[len(m.RepeatedBytesWrapper)-1]); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedBytesWrapper[len(m.RepeatedBytesWrapper)-1]); err != nil {
 					return err
 				}
 			}
@@ -12694,14 +34178,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if m.OptionalDuration == nil {
 				m.OptionalDuration = &durationpb.Duration{}
 			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.OptionalDuration).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.OptionalDuration).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.OptionalDuration); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalDuration); err != nil {
 					return err
 				}
 			}
@@ -12738,14 +34246,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if m.OptionalTimestamp == nil {
 				m.OptionalTimestamp = &timestamppb.Timestamp{}
 			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.OptionalTimestamp).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.OptionalTimestamp).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.OptionalTimestamp); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalTimestamp); err != nil {
 					return err
 				}
 			}
@@ -12782,14 +34314,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if m.OptionalFieldMask == nil {
 				m.OptionalFieldMask = &fieldmaskpb.FieldMask{}
 			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.OptionalFieldMask).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.OptionalFieldMask).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.OptionalFieldMask); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalFieldMask); err != nil {
 					return err
 				}
 			}
@@ -12826,14 +34382,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if m.OptionalStruct == nil {
 				m.OptionalStruct = &structpb.Struct{}
 			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.OptionalStruct).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.OptionalStruct).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.OptionalStruct); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalStruct); err != nil {
 					return err
 				}
 			}
@@ -12870,14 +34450,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if m.OptionalAny == nil {
 				m.OptionalAny = &anypb.Any{}
 			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.OptionalAny).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.OptionalAny).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.OptionalAny); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalAny); err != nil {
 					return err
 				}
 			}
@@ -12914,14 +34518,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if m.OptionalValue == nil {
 				m.OptionalValue = &structpb.Value{}
 			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.OptionalValue).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.OptionalValue).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.OptionalValue); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.OptionalValue); err != nil {
 					return err
 				}
 			}
@@ -12975,14 +34603,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.RepeatedDuration = append(m.RepeatedDuration, &durationpb.Duration{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.RepeatedDuration[len(m.RepeatedDuration)-1]).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.RepeatedDuration[len(m.RepeatedDuration)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedDuration[len(m.RepeatedDuration)-1]); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedDuration[len(m.RepeatedDuration)-1]); err != nil {
 					return err
 				}
 			}
@@ -13017,14 +34669,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.RepeatedTimestamp = append(m.RepeatedTimestamp, &timestamppb.Timestamp{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.RepeatedTimestamp[len(m.RepeatedTimestamp)-1]).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.RepeatedTimestamp[len(m.RepeatedTimestamp)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedTimestamp[len(m.RepeatedTimestamp)-1]); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedTimestamp[len(m.RepeatedTimestamp)-1]); err != nil {
 					return err
 				}
 			}
@@ -13059,14 +34735,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.RepeatedFieldmask = append(m.RepeatedFieldmask, &fieldmaskpb.FieldMask{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.RepeatedFieldmask[len(m.RepeatedFieldmask)-1]).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.RepeatedFieldmask[len(m.RepeatedFieldmask)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedFieldmask[len(m.RepeatedFieldmask)-1]); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedFieldmask[len(m.RepeatedFieldmask)-1]); err != nil {
 					return err
 				}
 			}
@@ -13101,14 +34801,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.RepeatedAny = append(m.RepeatedAny, &anypb.Any{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.RepeatedAny[len(m.RepeatedAny)-1]).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.RepeatedAny[len(m.RepeatedAny)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedAny[len(m.RepeatedAny)-1]); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedAny[len(m.RepeatedAny)-1]); err != nil {
 					return err
 				}
 			}
@@ -13143,14 +34867,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.RepeatedValue = append(m.RepeatedValue, &structpb.Value{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.RepeatedValue[len(m.RepeatedValue)-1]).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.RepeatedValue[len(m.RepeatedValue)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedValue[len(m.RepeatedValue)-1]); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedValue[len(m.RepeatedValue)-1]); err != nil {
 					return err
 				}
 			}
@@ -13185,14 +34933,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.RepeatedListValue = append(m.RepeatedListValue, &structpb.ListValue{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.RepeatedListValue[len(m.RepeatedListValue)-1]).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.RepeatedListValue[len(m.RepeatedListValue)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedListValue[len(m.RepeatedListValue)-1]); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedListValue[len(m.RepeatedListValue)-1]); err != nil {
 					return err
 				}
 			}
@@ -13227,14 +34999,38 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.RepeatedStruct = append(m.RepeatedStruct, &structpb.Struct{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
 			if unmarshal, ok := interface{}(m.RepeatedStruct[len(m.RepeatedStruct)-1]).(interface {
-				UnmarshalVT([]byte) error
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.RepeatedStruct[len(m.RepeatedStruct)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
 			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
 					return err
 				}
 			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedStruct[len(m.RepeatedStruct)-1]); err != nil {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.RepeatedStruct[len(m.RepeatedStruct)-1]); err != nil {
 					return err
 				}
 			}
@@ -13593,21 +35389,49 @@ func (m *TestAllTypesProto3) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ForeignMessage) UnmarshalVT(dAtA []byte) error {
+func (m *ForeignMessage) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *ForeignMessage) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *ForeignMessage) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*ForeignMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -13663,21 +35487,49 @@ func (m *ForeignMessage) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *NullHypothesisProto3) UnmarshalVT(dAtA []byte) error {
+func (m *NullHypothesisProto3) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *NullHypothesisProto3) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *NullHypothesisProto3) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*NullHypothesisProto3)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -13714,21 +35566,49 @@ func (m *NullHypothesisProto3) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *EnumOnlyProto3) UnmarshalVT(dAtA []byte) error {
+func (m *EnumOnlyProto3) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *EnumOnlyProto3) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *EnumOnlyProto3) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*EnumOnlyProto3)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -13765,10 +35645,13 @@ func (m *EnumOnlyProto3) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
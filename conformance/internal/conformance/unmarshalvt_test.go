@@ -0,0 +1,61 @@
+package conformance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func scalarMessage() *TestAllTypesProto3 {
+	return &TestAllTypesProto3{
+		OptionalInt32:   -42,
+		OptionalInt64:   1 << 40,
+		OptionalUint32:  42,
+		OptionalFixed64: 7,
+		OptionalDouble:  3.14,
+		OptionalBool:    true,
+		RepeatedInt32:   nil,
+	}
+}
+
+func TestUnmarshalVTStackAllocs(t *testing.T) {
+	data, err := scalarMessage().MarshalVT()
+	require.NoError(t, err)
+
+	// decoding scalars into a message on the stack does not allocate
+	allocs := testing.AllocsPerRun(100, func() {
+		var m TestAllTypesProto3
+		if err := m.UnmarshalVT(data); err != nil {
+			panic(err)
+		}
+	})
+	require.Zero(t, allocs)
+}
+
+func BenchmarkUnmarshalVT(b *testing.B) {
+	scalars, err := scalarMessage().MarshalVT()
+	require.NoError(b, err)
+	full := testHashMessage()
+	MutateFields(full)
+	nested, err := full.MarshalVT()
+	require.NoError(b, err)
+
+	b.Run("scalars", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var m TestAllTypesProto3
+			if err := m.UnmarshalVT(scalars); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("all types", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var m TestAllTypesProto3
+			if err := m.UnmarshalVT(nested); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
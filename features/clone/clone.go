@@ -6,6 +6,8 @@
 package clone
 
 import (
+	"strconv"
+
 	"github.com/planetscale/vtprotobuf/generator"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -14,6 +16,7 @@ import (
 const (
 	cloneName        = "CloneVT"
 	cloneGenericName = "CloneGenericVT"
+	copyToName       = "CopyToVT"
 )
 
 var (
@@ -38,10 +41,8 @@ func (p *clone) Name() string {
 }
 
 func (p *clone) GenerateFile(file *protogen.File) bool {
-	proto3 := file.Desc.Syntax() == protoreflect.Proto3
-
 	for _, message := range file.Messages {
-		p.processMessage(proto3, message)
+		p.processMessage(message)
 	}
 
 	return p.once
@@ -88,7 +89,7 @@ func (p *clone) cloneFieldSingular(lhs, rhs string, kind protoreflect.Kind, mess
 }
 
 // cloneField generates the code for cloning a field in a protobuf.
-func (p *clone) cloneField(lhsBase, rhsBase string, allFieldsNullable bool, field *protogen.Field) {
+func (p *clone) cloneField(lhsBase, rhsBase string, field *protogen.Field) {
 	// At this point, if we encounter a non-synthetic oneof, we assume it to be the representative
 	// field for that oneof.
 	if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
@@ -96,7 +97,7 @@ func (p *clone) cloneField(lhsBase, rhsBase string, allFieldsNullable bool, fiel
 		return
 	}
 
-	if !isReference(allFieldsNullable, field) {
+	if !isReference(field) {
 		panic("method should not be invoked for non-reference fields")
 	}
 
@@ -141,10 +142,20 @@ func (p *clone) cloneField(lhsBase, rhsBase string, allFieldsNullable bool, fiel
 	p.P(`}`)
 }
 
-func (p *clone) generateCloneMethodsForMessage(proto3 bool, message *protogen.Message) {
+func (p *clone) generateCloneMethodsForMessage(message *protogen.Message) {
 	ccTypeName := message.GoIdent.GoName
 	p.P(`func (m *`, ccTypeName, `) `, cloneName, `() *`, ccTypeName, ` {`)
-	p.body(!proto3, ccTypeName, message.Fields, true)
+	if p.ShouldPool(message) {
+		// Copying into a message from the pool reuses the memory it still holds
+		p.P(`if m == nil {`)
+		p.P(`return (*`, ccTypeName, `)(nil)`)
+		p.P(`}`)
+		p.Alloc("r", message)
+		p.P(`m.`, copyToName, `(r)`)
+		p.P(`return r`)
+	} else {
+		p.body(ccTypeName, message.Fields, message)
+	}
 	p.P(`}`)
 	p.P()
 	p.P(`func (m *`, ccTypeName, `) `, cloneGenericName, `() `, protoPkg.Ident("Message"), ` {`)
@@ -155,8 +166,9 @@ func (p *clone) generateCloneMethodsForMessage(proto3 bool, message *protogen.Me
 
 // body generates the code for the actual cloning logic of a structure containing the given fields.
 // In practice, those can be the fields of a message, or of a oneof struct.
-// The object to be cloned is assumed to be called "m".
-func (p *clone) body(allFieldsNullable bool, ccTypeName string, fields []*protogen.Field, cloneUnknownFields bool) {
+// The object to be cloned is assumed to be called "m". When cloning a message rather than a
+// oneof struct, its extensions and unknown fields are cloned too.
+func (p *clone) body(ccTypeName string, fields []*protogen.Field, message *protogen.Message) {
 	// The method body for a message or a oneof wrapper always starts with a nil check.
 	p.P(`if m == nil {`)
 	// We use an explicitly typed nil to avoid returning the nil interface in the oneof wrapper
@@ -181,7 +193,7 @@ func (p *clone) body(allFieldsNullable bool, ccTypeName string, fields []*protog
 			continue
 		}
 
-		if !isReference(allFieldsNullable, field) {
+		if !isReference(field) {
 			p.P(field.GoName, `: m.`, field.GoName, `,`)
 			continue
 		}
@@ -197,10 +209,14 @@ func (p *clone) body(allFieldsNullable bool, ccTypeName string, fields []*protog
 
 	// Generate explicit assignment statements for all reference fields.
 	for _, field := range refFields {
-		p.cloneField("r", "m", allFieldsNullable, field)
+		p.cloneField("r", "m", field)
 	}
 
-	if cloneUnknownFields {
+	if message != nil && p.IsExtendable(message) {
+		p.extensions("r", message)
+	}
+
+	if message != nil {
 		// Clone unknown fields, if any
 		p.P(`if len(m.unknownFields) > 0 {`)
 		p.P(`r.unknownFields = make([]byte, len(m.unknownFields))`)
@@ -211,6 +227,55 @@ func (p *clone) body(allFieldsNullable bool, ccTypeName string, fields []*protog
 	p.P(`return r`)
 }
 
+// extensions generates the code that clones the extension fields of "m" into dst. The
+// extensions known at generation time are cloned inline, all others with protohelpers.
+func (p *clone) extensions(dst string, message *protogen.Message) {
+	known := p.KnownExtensions(message)
+	if len(known) == 0 {
+		p.P(`for _, x := range m.extensionFields {`)
+	} else {
+		p.P(`for num, x := range m.extensionFields {`)
+		p.P(`switch num {`)
+		for _, ext := range known {
+			p.P(`case `, strconv.Itoa(int(ext.Desc.Number())), `:`)
+			p.cloneExtension(dst, ext)
+		}
+		p.P(`default:`)
+	}
+	p.P(dst, `.ProtoReflect().Set(x.Type().TypeDescriptor(), `, p.Ident(generator.ProtoHelpersPkg, "CloneExtension"), `(x.Type(), x.Value()))`)
+	if len(known) > 0 {
+		p.P(`}`)
+	}
+	p.P(`}`)
+}
+
+// cloneExtension generates the statements for cloning an extension known at generation time.
+func (p *clone) cloneExtension(dst string, ext *protogen.Extension) {
+	goType, _ := p.FieldGoType(ext)
+	kind := ext.Desc.Kind()
+	xt := p.ExtensionType(ext)
+
+	p.P(`rhs := `, p.ExtensionValue("m", ext))
+	switch {
+	case ext.Desc.IsList():
+		p.P(`tmpContainer := make(`, goType, `, len(rhs))`)
+		if isScalar(kind) {
+			p.P(`copy(tmpContainer, rhs)`)
+		} else {
+			p.P(`for k, v := range rhs {`)
+			p.cloneFieldSingular("tmpContainer[k]", "v", kind, ext.Message)
+			p.P(`}`)
+		}
+		p.P(protoPkg.Ident("SetExtension"), `(`, dst, `, `, xt, `, tmpContainer)`)
+	case isScalar(kind):
+		p.P(protoPkg.Ident("SetExtension"), `(`, dst, `, `, xt, `, rhs)`)
+	default:
+		p.P(`var tmpVal `, goType)
+		p.cloneFieldSingular("tmpVal", "rhs", kind, ext.Message)
+		p.P(protoPkg.Ident("SetExtension"), `(`, dst, `, `, xt, `, tmpVal)`)
+	}
+}
+
 // generateCloneMethodsForOneof generates the clone method for the oneof wrapper type of a
 // field in a oneof.
 func (p *clone) generateCloneMethodsForOneof(field *protogen.Field) {
@@ -221,8 +286,7 @@ func (p *clone) generateCloneMethodsForOneof(field *protogen.Field) {
 	// Create a "fake" field for the single oneof member, pretending it is not a oneof field.
 	fieldInOneof := *field
 	fieldInOneof.Oneof = nil
-	// If we have a scalar field in a oneof, that field is never nullable, even when using proto2
-	p.body(false, ccTypeName, []*protogen.Field{&fieldInOneof}, false)
+	p.body(ccTypeName, []*protogen.Field{&fieldInOneof}, nil)
 	p.P(`}`)
 	p.P()
 }
@@ -236,30 +300,187 @@ func (p *clone) processMessageOneofs(message *protogen.Message) {
 	}
 }
 
-func (p *clone) processMessage(proto3 bool, message *protogen.Message) {
+func (p *clone) processMessage(message *protogen.Message) {
 	for _, nested := range message.Messages {
-		p.processMessage(proto3, nested)
+		p.processMessage(nested)
 	}
 
-	if message.Desc.IsMapEntry() {
+	if message.Desc.IsMapEntry() || !p.ShouldGenerate(message) {
 		return
 	}
 
 	p.once = true
 
-	p.generateCloneMethodsForMessage(proto3, message)
+	p.generateCloneMethodsForMessage(message)
+	p.generateCopyToMethodForMessage(message)
 	p.processMessageOneofs(message)
 }
 
+func (p *clone) generateCopyToMethodForMessage(message *protogen.Message) {
+	ccTypeName := message.GoIdent.GoName
+	p.P(`func (m *`, ccTypeName, `) `, copyToName, `(dst *`, ccTypeName, `) {`)
+	p.P(`if m == nil {`)
+	if p.ShouldPool(message) {
+		p.P(`dst.ResetVT()`)
+	} else {
+		p.P(`dst.Reset()`)
+	}
+	p.P(`return`)
+	p.P(`}`)
+
+	oneofs := make(map[string]struct{}, len(message.Fields))
+	for _, field := range message.Fields {
+		if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
+			if _, ok := oneofs[field.Oneof.GoName]; !ok {
+				p.copyOneofField(field.Oneof)
+				oneofs[field.Oneof.GoName] = struct{}{}
+			}
+			continue
+		}
+		p.copyField(field)
+	}
+
+	if p.IsExtendable(message) {
+		p.P(`for num := range dst.extensionFields {`)
+		p.P(`delete(dst.extensionFields, num)`)
+		p.P(`}`)
+		p.extensions("dst", message)
+	}
+	p.P(`dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)`)
+	p.P(`}`)
+	p.P()
+}
+
+// copyMessage generates the code for copying the message src into the non-nil message dst.
+func (p *clone) copyMessage(dst, src string, message *protogen.Message) {
+	if p.IsLocalMessage(message) {
+		p.P(src, `.`, copyToName, `(`, dst, `)`)
+		return
+	}
+	p.P(`if vtpb, ok := interface{}(`, src, `).(interface{ `, copyToName, `(*`, message.GoIdent, `) }); ok {`)
+	p.P(`vtpb.`, copyToName, `(`, dst, `)`)
+	p.P(`} else {`)
+	p.P(protoPkg.Ident("Reset"), `(`, dst, `)`)
+	p.P(protoPkg.Ident("Merge"), `(`, dst, `, `, src, `)`)
+	p.P(`}`)
+}
+
+// copyValue generates the code for copying the singular value src of the given kind into
+// dst, reusing the memory already held by dst.
+func (p *clone) copyValue(dst, src string, kind protoreflect.Kind, message *protogen.Message) {
+	switch {
+	case kind == protoreflect.MessageKind, kind == protoreflect.GroupKind:
+		p.P(`if `, dst, ` == nil {`)
+		p.cloneFieldSingular(dst, src, kind, message)
+		p.P(`} else {`)
+		p.copyMessage(dst, src, message)
+		p.P(`}`)
+	case kind == protoreflect.BytesKind:
+		p.P(dst, ` = append(`, dst, `[:0], `, src, `...)`)
+	case isScalar(kind):
+		p.P(dst, ` = `, src)
+	default:
+		panic("unexpected")
+	}
+}
+
+// copyField generates the code for copying a field that is not part of a oneof from "m"
+// into "dst".
+func (p *clone) copyField(field *protogen.Field) {
+	dst := "dst." + field.GoName
+	src := "m." + field.GoName
+	kind := field.Desc.Kind()
+
+	switch {
+	case field.Desc.IsMap():
+		goType, _ := p.FieldGoType(field)
+		value := field.Message.Fields[1]
+		p.P(`if `, dst, ` == nil && len(`, src, `) > 0 {`)
+		p.P(dst, ` = make(`, goType, `, len(`, src, `))`)
+		p.P(`}`)
+		p.P(`for k := range `, dst, ` {`)
+		p.P(`if _, ok := `, src, `[k]; !ok {`)
+		p.P(`delete(`, dst, `, k)`)
+		p.P(`}`)
+		p.P(`}`)
+		p.P(`for k, v := range `, src, ` {`)
+		p.copyValue(dst+"[k]", "v", value.Desc.Kind(), value.Message)
+		p.P(`}`)
+	case field.Desc.IsList():
+		if isScalar(kind) {
+			p.P(dst, ` = append(`, dst, `[:0], `, src, `...)`)
+			return
+		}
+		goType, _ := p.FieldGoType(field)
+		p.P(`if len(`, src, `) > cap(`, dst, `) {`)
+		p.P(`tmpContainer := make(`, goType, `, len(`, src, `))`)
+		p.P(`copy(tmpContainer, `, dst, `[:cap(`, dst, `)])`)
+		p.P(dst, ` = tmpContainer`)
+		p.P(`} else {`)
+		p.P(dst, ` = `, dst, `[:len(`, src, `)]`)
+		p.P(`}`)
+		p.P(`for k, v := range `, src, ` {`)
+		p.copyValue(dst+"[k]", "v", kind, field.Message)
+		p.P(`}`)
+	case kind == protoreflect.MessageKind, kind == protoreflect.GroupKind:
+		p.P(`if `, src, ` == nil {`)
+		p.P(dst, ` = nil`)
+		p.P(`} else {`)
+		p.copyValue(dst, src, kind, field.Message)
+		p.P(`}`)
+	case kind == protoreflect.BytesKind && field.Desc.HasPresence():
+		// A nil slice marks the field as not set
+		p.P(`if `, src, ` == nil {`)
+		p.P(dst, ` = nil`)
+		p.P(`} else if `, dst, ` == nil {`)
+		p.P(dst, ` = append([]byte{}, `, src, `...)`)
+		p.P(`} else {`)
+		p.P(dst, ` = append(`, dst, `[:0], `, src, `...)`)
+		p.P(`}`)
+	case kind != protoreflect.BytesKind && isReference(field):
+		goType, _ := p.FieldGoType(field)
+		p.P(`if `, src, ` == nil {`)
+		p.P(dst, ` = nil`)
+		p.P(`} else {`)
+		p.P(`if `, dst, ` == nil {`)
+		p.P(dst, ` = new(`, goType, `)`)
+		p.P(`}`)
+		p.P(`*`, dst, ` = *`, src)
+		p.P(`}`)
+	default:
+		p.copyValue(dst, src, kind, nil)
+	}
+}
+
+// copyOneofField generates the code for copying a oneof from "m" into "dst". The wrapper
+// of dst is reused if the same field is set in both messages.
+func (p *clone) copyOneofField(oneof *protogen.Oneof) {
+	dst := "dst." + oneof.GoName
+	p.P(`switch c := m.`, oneof.GoName, `.(type) {`)
+	for _, field := range oneof.Fields {
+		p.P(`case *`, field.GoIdent, `:`)
+		p.P(`if d, ok := `, dst, `.(*`, field.GoIdent, `); ok {`)
+		p.copyValue("d."+field.GoName, "c."+field.GoName, field.Desc.Kind(), field.Message)
+		p.P(`} else {`)
+		p.P(dst, ` = c.`, cloneName, `()`)
+		p.P(`}`)
+	}
+	p.P(`default:`)
+	p.P(dst, ` = nil`)
+	p.P(`}`)
+}
+
 // isReference checks whether the Go equivalent of the given field is of reference type, i.e., can be nil.
-func isReference(allFieldsNullable bool, field *protogen.Field) bool {
-	if allFieldsNullable || field.Oneof != nil || field.Message != nil || field.Desc.Cardinality() == protoreflect.Repeated || field.Desc.Kind() == protoreflect.BytesKind {
+func isReference(field *protogen.Field) bool {
+	if field.Oneof != nil || field.Message != nil || field.Desc.Cardinality() == protoreflect.Repeated || field.Desc.Kind() == protoreflect.BytesKind {
 		return true
 	}
 	if !isScalar(field.Desc.Kind()) {
 		panic("unexpected non-reference, non-scalar field")
 	}
-	return false
+	// Scalar fields with presence are pointers, except in the wrapper struct of a oneof
+	// member, which generateCloneMethodsForOneof clones as if it was not in a oneof.
+	return field.Desc.HasPresence() && field.Desc.ContainingOneof() == nil
 }
 
 func isScalar(kind protoreflect.Kind) bool {
@@ -0,0 +1,280 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package diff
+
+import (
+	"sort"
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/planetscale/vtprotobuf/generator"
+)
+
+const diffName = "DiffVT"
+
+func init() {
+	generator.RegisterOptInFeature("diff", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &diff{GeneratedFile: gen}
+	})
+}
+
+type diff struct {
+	*generator.GeneratedFile
+	once bool
+}
+
+var _ generator.FeatureGenerator = (*diff)(nil)
+
+func (p *diff) GenerateFile(file *protogen.File) bool {
+	for _, message := range file.Messages {
+		p.processMessage(message)
+	}
+
+	return p.once
+}
+
+func (p *diff) GenerateHelpers() {
+}
+
+func (p *diff) processMessage(message *protogen.Message) {
+	for _, nested := range message.Messages {
+		p.processMessage(nested)
+	}
+
+	if message.Desc.IsMapEntry() || !p.ShouldGenerate(message) {
+		return
+	}
+
+	p.once = true
+	ccTypeName := message.GoIdent.GoName
+	fieldDiff := p.Ident(generator.VTProtoPkg, "FieldDiff")
+
+	fields := make([]*protogen.Field, len(message.Fields))
+	copy(fields, message.Fields)
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Desc.Number() < fields[j].Desc.Number()
+	})
+
+	p.P(`func (this *`, ccTypeName, `) `, diffName, `(that *`, ccTypeName, `) []`, fieldDiff, ` {`)
+	p.P(`if this == nil && that == nil {`)
+	p.P(`return nil`)
+	p.P(`} else if this == nil {`)
+	p.P(`return []`, fieldDiff, `{{Kind: `, p.kind("FieldAdded"), `, New: that}}`)
+	p.P(`} else if that == nil {`)
+	p.P(`return []`, fieldDiff, `{{Kind: `, p.kind("FieldRemoved"), `, Old: this}}`)
+	p.P(`}`)
+	p.P(`var diffs []`, fieldDiff)
+	oneofs := make(map[*protogen.Oneof]bool)
+	for _, field := range fields {
+		if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
+			if !oneofs[field.Oneof] {
+				oneofs[field.Oneof] = true
+				p.diffOneof(field.Oneof)
+			}
+			continue
+		}
+		p.diffField(field)
+	}
+	if p.IsExtendable(message) {
+		p.P(`diffs = `, p.Ident(generator.ProtoHelpersPkg, "DiffExtensions"), `(diffs, this.extensionFields, that.extensionFields)`)
+	}
+	p.P(`if string(this.unknownFields) != string(that.unknownFields) {`)
+	p.appendDiff(`"<unknown>"`, "FieldChanged", "this.unknownFields", "that.unknownFields")
+	p.P(`}`)
+	p.P(`return diffs`)
+	p.P(`}`)
+	p.P()
+}
+
+func (p *diff) kind(name string) string {
+	return p.Ident(generator.VTProtoPkg, name)
+}
+
+// appendDiff generates the statement appending a FieldDiff of the given kind to diffs.
+// Old or New are left unset when they are "nil".
+func (p *diff) appendDiff(path, kind, old, new string) {
+	values := ""
+	if old != "nil" {
+		values += `, Old: ` + old
+	}
+	if new != "nil" {
+		values += `, New: ` + new
+	}
+	p.P(`diffs = append(diffs, `, p.Ident(generator.VTProtoPkg, "FieldDiff"), `{Path: `, path, `, Kind: `, p.kind(kind), values, `})`)
+}
+
+// diffMessage generates the code that appends to diffs the differences between the
+// non-nil messages lhs and rhs, nested in the field at path.
+func (p *diff) diffMessage(lhs, rhs, path string, message *protogen.Message) {
+	appendDiffs := p.Ident(generator.ProtoHelpersPkg, "AppendDiffs")
+	if p.IsLocalMessage(message) {
+		p.P(`if nested := `, lhs, `.`, diffName, `(`, rhs, `); len(nested) > 0 {`)
+		p.P(`diffs = `, appendDiffs, `(diffs, `, path, `, nested)`)
+		p.P(`}`)
+		return
+	}
+	// lhs is a concrete type, we need to first convert it to an interface in order to use an
+	// interface type assertion.
+	p.P(`if vtpb, ok := interface{}(`, lhs, `).(interface{ `, diffName, `(*`, message.GoIdent, `) []`, p.Ident(generator.VTProtoPkg, "FieldDiff"), ` }); ok {`)
+	p.P(`if nested := vtpb.`, diffName, `(`, rhs, `); len(nested) > 0 {`)
+	p.P(`diffs = `, appendDiffs, `(diffs, `, path, `, nested)`)
+	p.P(`}`)
+	p.P(`} else if !`, p.Ident(generator.ProtoPkg, "Equal"), `(`, lhs, `, `, rhs, `) {`)
+	p.appendDiff(path, "FieldChanged", lhs, rhs)
+	p.P(`}`)
+}
+
+// diffValue generates the code that appends to diffs the difference between the values
+// lhs and rhs of the given kind, if any. Like in EqualVT, nullable values are only equal
+// if both or none of them are nil, while a nil message that is not nullable is equal to
+// an empty one.
+func (p *diff) diffValue(lhs, rhs, path string, kind protoreflect.Kind, message *protogen.Message, nullable bool) {
+	switch {
+	case kind == protoreflect.MessageKind || kind == protoreflect.GroupKind:
+		if nullable {
+			p.P(`if p, q := `, lhs, `, `, rhs, `; p == nil && q != nil {`)
+			p.appendDiff(path, "FieldAdded", "nil", "q")
+			p.P(`} else if p != nil && q == nil {`)
+			p.appendDiff(path, "FieldRemoved", "p", "nil")
+			p.P(`} else if p != q {`)
+			p.diffMessage("p", "q", path, message)
+			p.P(`}`)
+			return
+		}
+		p.P(`if p, q := `, lhs, `, `, rhs, `; p != q {`)
+		p.P(`if p == nil {`)
+		p.P(`p = &`, message.GoIdent, `{}`)
+		p.P(`}`)
+		p.P(`if q == nil {`)
+		p.P(`q = &`, message.GoIdent, `{}`)
+		p.P(`}`)
+		p.diffMessage("p", "q", path, message)
+		p.P(`}`)
+	case kind == protoreflect.BytesKind:
+		if nullable {
+			p.P(`if p, q := `, lhs, `, `, rhs, `; p == nil && q != nil {`)
+			p.appendDiff(path, "FieldAdded", "nil", "q")
+			p.P(`} else if p != nil && q == nil {`)
+			p.appendDiff(path, "FieldRemoved", "p", "nil")
+			p.P(`} else if string(p) != string(q) {`)
+			p.appendDiff(path, "FieldChanged", "p", "q")
+			p.P(`}`)
+			return
+		}
+		p.P(`if string(`, lhs, `) != string(`, rhs, `) {`)
+		p.appendDiff(path, "FieldChanged", lhs, rhs)
+		p.P(`}`)
+	default:
+		if nullable {
+			p.P(`if p, q := `, lhs, `, `, rhs, `; p == nil && q != nil {`)
+			p.appendDiff(path, "FieldAdded", "nil", "*q")
+			p.P(`} else if p != nil && q == nil {`)
+			p.appendDiff(path, "FieldRemoved", "*p", "nil")
+			p.P(`} else if p != nil && *p != *q {`)
+			p.appendDiff(path, "FieldChanged", "*p", "*q")
+			p.P(`}`)
+			return
+		}
+		p.P(`if `, lhs, ` != `, rhs, ` {`)
+		p.appendDiff(path, "FieldChanged", lhs, rhs)
+		p.P(`}`)
+	}
+}
+
+// mapKey returns an expression that formats the map key k of the given kind in a path.
+func (p *diff) mapKey(k string, kind protoreflect.Kind) string {
+	switch kind {
+	case protoreflect.StringKind:
+		return p.Ident("strconv", "Quote") + `(` + k + `)`
+	case protoreflect.BoolKind:
+		return p.Ident("strconv", "FormatBool") + `(` + k + `)`
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return p.Ident("strconv", "FormatUint") + `(uint64(` + k + `), 10)`
+	default:
+		return p.Ident("strconv", "FormatInt") + `(int64(` + k + `), 10)`
+	}
+}
+
+// mapKeyLess returns the body of the function sorting the keys of a map of the given kind.
+func mapKeyLess(kind protoreflect.Kind) string {
+	if kind == protoreflect.BoolKind {
+		return `!keys[i] && keys[j]`
+	}
+	return `keys[i] < keys[j]`
+}
+
+// diffField generates the code that appends to diffs the differences in a field that is
+// not part of a oneof. The entries of maps are compared in the order of their keys.
+func (p *diff) diffField(field *protogen.Field) {
+	lhs := "this." + field.GoName
+	rhs := "that." + field.GoName
+	name := string(field.Desc.Name())
+	kind := field.Desc.Kind()
+
+	switch {
+	case field.Desc.IsMap():
+		key, value := field.Message.Fields[0], field.Message.Fields[1]
+		keyType, _ := p.FieldGoType(key)
+		path := strconv.Quote(name+"[") + ` + ` + p.mapKey("k", key.Desc.Kind()) + ` + "]"`
+		p.P(`if len(`, lhs, `) > 0 || len(`, rhs, `) > 0 {`)
+		p.P(`keys := make([]`, keyType, `, 0, len(`, lhs, `)+len(`, rhs, `))`)
+		p.P(`for k := range `, lhs, ` {`)
+		p.P(`keys = append(keys, k)`)
+		p.P(`}`)
+		p.P(`for k := range `, rhs, ` {`)
+		p.P(`if _, ok := `, lhs, `[k]; !ok {`)
+		p.P(`keys = append(keys, k)`)
+		p.P(`}`)
+		p.P(`}`)
+		p.P(p.Ident("sort", "Slice"), `(keys, func(i, j int) bool { return `, mapKeyLess(key.Desc.Kind()), ` })`)
+		p.P(`for _, k := range keys {`)
+		p.P(`vx, xok := `, lhs, `[k]`)
+		p.P(`vy, yok := `, rhs, `[k]`)
+		p.P(`if !yok {`)
+		p.appendDiff(path, "FieldRemoved", "vx", "nil")
+		p.P(`} else if !xok {`)
+		p.appendDiff(path, "FieldAdded", "nil", "vy")
+		p.P(`} else {`)
+		p.diffValue("vx", "vy", path, value.Desc.Kind(), value.Message, false)
+		p.P(`}`)
+		p.P(`}`)
+		p.P(`}`)
+	case field.Desc.IsList():
+		path := strconv.Quote(name+"[") + ` + ` + p.Ident("strconv", "Itoa") + `(i) + "]"`
+		p.P(`for i := 0; i < len(`, lhs, `) && i < len(`, rhs, `); i++ {`)
+		p.diffValue(lhs+"[i]", rhs+"[i]", path, kind, field.Message, false)
+		p.P(`}`)
+		p.P(`for i := len(`, rhs, `); i < len(`, lhs, `); i++ {`)
+		p.appendDiff(path, "FieldRemoved", lhs+"[i]", "nil")
+		p.P(`}`)
+		p.P(`for i := len(`, lhs, `); i < len(`, rhs, `); i++ {`)
+		p.appendDiff(path, "FieldAdded", "nil", rhs+"[i]")
+		p.P(`}`)
+	default:
+		nullable := field.Message != nil || field.Desc.HasPresence()
+		p.diffValue(lhs, rhs, strconv.Quote(name), kind, field.Message, nullable)
+	}
+}
+
+// diffOneof generates the code that appends to diffs the differences in a oneof. A
+// member that is only set in one of the messages is reported as added or removed.
+func (p *diff) diffOneof(oneof *protogen.Oneof) {
+	lhs := "this." + oneof.GoName
+	rhs := "that." + oneof.GoName
+	for _, field := range oneof.Fields {
+		path := strconv.Quote(string(field.Desc.Name()))
+		p.P(`if x, ok := `, lhs, `.(*`, field.GoIdent, `); ok {`)
+		p.P(`if y, ok := `, rhs, `.(*`, field.GoIdent, `); ok {`)
+		p.diffValue("x."+field.GoName, "y."+field.GoName, path, field.Desc.Kind(), field.Message, false)
+		p.P(`} else {`)
+		p.appendDiff(path, "FieldRemoved", "x."+field.GoName, "nil")
+		p.P(`}`)
+		p.P(`} else if y, ok := `, rhs, `.(*`, field.GoIdent, `); ok {`)
+		p.appendDiff(path, "FieldAdded", "nil", "y."+field.GoName)
+		p.P(`}`)
+	}
+}
@@ -5,6 +5,7 @@ package equal
 import (
 	"fmt"
 	"sort"
+	"strconv"
 
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -28,21 +29,24 @@ var _ generator.FeatureGenerator = (*equal)(nil)
 func (p *equal) Name() string     { return "equal" }
 func (p *equal) GenerateHelpers() {}
 func (p *equal) GenerateFile(file *protogen.File) bool {
-	proto3 := file.Desc.Syntax() == protoreflect.Proto3
 	for _, message := range file.Messages {
-		p.message(proto3, message)
+		p.message(message)
 	}
 	return p.once
 }
 
-const equalName = "EqualVT"
+const (
+	equalName            = "EqualVT"
+	equalWithOptionsName = "EqualVTWithOptions"
+	equalMessageName     = "EqualMessageVT"
+)
 
-func (p *equal) message(proto3 bool, message *protogen.Message) {
+func (p *equal) message(message *protogen.Message) {
 	for _, nested := range message.Messages {
-		p.message(proto3, nested)
+		p.message(nested)
 	}
 
-	if message.Desc.IsMapEntry() {
+	if message.Desc.IsMapEntry() || !p.ShouldGenerate(message) {
 		return
 	}
 
@@ -50,6 +54,11 @@ func (p *equal) message(proto3 bool, message *protogen.Message) {
 
 	ccTypeName := message.GoIdent
 	p.P(`func (this *`, ccTypeName, `) `, equalName, `(that *`, ccTypeName, `) bool {`)
+	p.P(`return this.`, equalWithOptionsName, `(that, `, p.equalOptions(), `{})`)
+	p.P(`}`)
+	p.P()
+
+	p.P(`func (this *`, ccTypeName, `) `, equalWithOptionsName, `(that *`, ccTypeName, `, opts `, p.equalOptions(), `) bool {`)
 
 	p.P(`if this == nil {`)
 	p.P(`	return that == nil`)
@@ -82,7 +91,9 @@ func (p *equal) message(proto3 bool, message *protogen.Message) {
 			p.P(`		return false`)
 			p.P(`	}`)
 			ccInterfaceName := fmt.Sprintf("is%s", field.Oneof.GoIdent.GoName)
-			p.P(`if !this.`, fieldname, `.(interface{ `, equalName, `(`, ccInterfaceName, `) bool }).`, equalName, `(that.`, fieldname, `) {`)
+			p.P(`if !this.`, fieldname, `.(interface {`)
+			p.P(equalWithOptionsName, `(`, ccInterfaceName, `, `, p.equalOptions(), `) bool`)
+			p.P(`}).`, equalWithOptionsName, `(that.`, fieldname, `, opts) {`)
 			p.P(`return false`)
 			p.P(`}`)
 			p.P(`}`)
@@ -91,13 +102,26 @@ func (p *equal) message(proto3 bool, message *protogen.Message) {
 
 	for _, field := range message.Fields {
 		oneof := field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
-		nullable := field.Message != nil || (field.Oneof != nil && field.Oneof.Desc.IsSynthetic()) || (!proto3 && !oneof)
+		nullable := field.Message != nil || field.Desc.HasPresence()
 		if !oneof {
 			p.field(field, nullable)
 		}
 	}
 
-	p.P(`return string(this.unknownFields) == string(that.unknownFields)`)
+	if p.IsExtendable(message) {
+		p.extensions(message)
+	}
+
+	p.P(`return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)`)
+	p.P(`}`)
+	p.P()
+
+	p.P(`func (this *`, ccTypeName, `) `, equalMessageName, `(thatMsg `, p.Ident(generator.ProtoPkg, "Message"), `) bool {`)
+	p.P(`that, ok := thatMsg.(*`, ccTypeName, `)`)
+	p.P(`if !ok {`)
+	p.P(`return false`)
+	p.P(`}`)
+	p.P(`return this.`, equalName, `(that)`)
 	p.P(`}`)
 	p.P()
 
@@ -116,6 +140,11 @@ func (p *equal) oneof(field *protogen.Field) {
 	fieldname := field.GoName
 
 	p.P(`func (this *`, ccTypeName, `) `, equalName, `(thatIface `, ccInterfaceName, `) bool {`)
+	p.P(`return this.`, equalWithOptionsName, `(thatIface, `, p.equalOptions(), `{})`)
+	p.P(`}`)
+	p.P()
+
+	p.P(`func (this *`, ccTypeName, `) `, equalWithOptionsName, `(thatIface `, ccInterfaceName, `, opts `, p.equalOptions(), `) bool {`)
 	p.P(`that, ok := thatIface.(*`, ccTypeName, `)`)
 	p.P(`if !ok {`)
 	p.P(`return false`)
@@ -132,7 +161,7 @@ func (p *equal) oneof(field *protogen.Field) {
 	kind := field.Desc.Kind()
 	switch {
 	case isScalar(kind):
-		p.compareScalar(lhs, rhs, false)
+		p.compareScalar(lhs, rhs, kind, false)
 	case kind == protoreflect.BytesKind:
 		p.compareBytes(lhs, rhs, false)
 	case kind == protoreflect.MessageKind || kind == protoreflect.GroupKind:
@@ -147,11 +176,13 @@ func (p *equal) oneof(field *protogen.Field) {
 
 func (p *equal) field(field *protogen.Field, nullable bool) {
 	fieldname := field.GoName
-
-	repeated := field.Desc.Cardinality() == protoreflect.Repeated
 	lhs := fmt.Sprintf("this.%s", fieldname)
 	rhs := fmt.Sprintf("that.%s", fieldname)
+	p.compare(field, lhs, rhs, nullable)
+}
 
+func (p *equal) compare(field *protogen.Field, lhs, rhs string, nullable bool) {
+	repeated := field.Desc.Cardinality() == protoreflect.Repeated
 	if repeated {
 		p.P(`if len(`, lhs, `) != len(`, rhs, `) {`)
 		p.P(`	return false`)
@@ -174,7 +205,7 @@ func (p *equal) field(field *protogen.Field, nullable bool) {
 	kind := field.Desc.Kind()
 	switch {
 	case isScalar(kind):
-		p.compareScalar(lhs, rhs, nullable)
+		p.compareScalar(lhs, rhs, kind, nullable)
 
 	case kind == protoreflect.BytesKind:
 		p.compareBytes(lhs, rhs, nullable)
@@ -192,10 +223,52 @@ func (p *equal) field(field *protogen.Field, nullable bool) {
 	}
 }
 
-func (p *equal) compareScalar(lhs, rhs string, nullable bool) {
-	if nullable {
+// extensions generates the code that compares the extension fields of both messages. The
+// extensions known at generation time are compared inline, all others with protohelpers.
+func (p *equal) extensions(message *protogen.Message) {
+	p.P(`if len(this.extensionFields) != len(that.extensionFields) {`)
+	p.P(`	return false`)
+	p.P(`}`)
+	p.P(`for num, x := range this.extensionFields {`)
+	p.P(`y, ok := that.extensionFields[num]`)
+	p.P(`if !ok {`)
+	p.P(`	return false`)
+	p.P(`}`)
+	known := p.KnownExtensions(message)
+	if len(known) > 0 {
+		p.P(`switch num {`)
+		for _, ext := range known {
+			p.P(`case `, strconv.Itoa(int(ext.Desc.Number())), `:`)
+			p.P(`ex, ey := `, p.ExtensionValue("this", ext), `, `, p.ExtensionValue("that", ext))
+			p.compare(ext, "ex", "ey", false)
+		}
+		p.P(`default:`)
+	}
+	p.P(`if x.Type() != y.Type() || !`, p.Ident(generator.ProtoHelpersPkg, "EqualExtensionWithOptions"), `(x.Type(), x.Value(), y.Value(), opts) {`)
+	p.P(`	return false`)
+	p.P(`}`)
+	if len(known) > 0 {
+		p.P(`}`)
+	}
+	p.P(`}`)
+}
+
+func (p *equal) compareScalar(lhs, rhs string, kind protoreflect.Kind, nullable bool) {
+	switch {
+	case kind == protoreflect.FloatKind || kind == protoreflect.DoubleKind:
+		// Floating point values are compared according to the options
+		equalFloat := "opts.EqualFloat64"
+		if kind == protoreflect.FloatKind {
+			equalFloat = "opts.EqualFloat32"
+		}
+		if nullable {
+			p.P(`if p, q := `, lhs, `, `, rhs, `; (p == nil && q != nil) || (p != nil && (q == nil || !`, equalFloat, `(*p, *q))) {`)
+		} else {
+			p.P(`if !`, equalFloat, `(`, lhs, `, `, rhs, `) {`)
+		}
+	case nullable:
 		p.P(`if p, q := `, lhs, `, `, rhs, `; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {`)
-	} else {
+	default:
 		p.P(`if `, lhs, ` != `, rhs, ` {`)
 	}
 	p.P(`	return false`)
@@ -230,12 +303,16 @@ func (p *equal) compareCall(lhs, rhs string, msg *protogen.Message, nullable boo
 		lhs, rhs = "p", "q"
 	}
 	if msg != nil && msg.Desc != nil && msg.Desc.ParentFile() != nil && p.IsLocalMessage(msg) {
-		p.P(`if !`, lhs, `.`, equalName, `(`, rhs, `) {`)
+		p.P(`if !`, lhs, `.`, equalWithOptionsName, `(`, rhs, `, opts) {`)
 		p.P(`	return false`)
 		p.P(`}`)
 		return
 	}
-	p.P(`if equal, ok := interface{}(`, lhs, `).(interface { `, equalName, `(*`, p.QualifiedGoIdent(msg.GoIdent), `) bool }); ok {`)
+	p.P(`if equal, ok := interface{}(`, lhs, `).(interface { `, equalWithOptionsName, `(*`, p.QualifiedGoIdent(msg.GoIdent), `, `, p.equalOptions(), `) bool }); ok {`)
+	p.P(`	if !equal.`, equalWithOptionsName, `(`, rhs, `, opts) {`)
+	p.P(`		return false`)
+	p.P(`	}`)
+	p.P(`} else if equal, ok := interface{}(`, lhs, `).(interface { `, equalName, `(*`, p.QualifiedGoIdent(msg.GoIdent), `) bool }); ok {`)
 	p.P(`	if !equal.`, equalName, `(`, rhs, `) {`)
 	p.P(`		return false`)
 	p.P(`	}`)
@@ -244,6 +321,10 @@ func (p *equal) compareCall(lhs, rhs string, msg *protogen.Message, nullable boo
 	p.P(`}`)
 }
 
+func (p *equal) equalOptions() string {
+	return p.Ident(generator.VTProtoPkg, "EqualOptions")
+}
+
 func isScalar(kind protoreflect.Kind) bool {
 	switch kind {
 	case
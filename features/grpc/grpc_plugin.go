@@ -25,7 +25,7 @@ type grpc struct {
 }
 
 func (g *grpc) GenerateFile(file *protogen.File) bool {
-	if len(file.Services) == 0 {
+	if len(file.Services) == 0 || !g.ShouldGenerateFile(file) {
 		return false
 	}
 
@@ -0,0 +1,277 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hash
+
+import (
+	"sort"
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/planetscale/vtprotobuf/generator"
+)
+
+const (
+	hashName         = "HashVT"
+	hashInternalName = "hashVT"
+)
+
+func init() {
+	generator.RegisterOptInFeature("hash", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &hash{GeneratedFile: gen}
+	})
+}
+
+type hash struct {
+	*generator.GeneratedFile
+	once bool
+}
+
+var _ generator.FeatureGenerator = (*hash)(nil)
+
+func (p *hash) GenerateFile(file *protogen.File) bool {
+	for _, message := range file.Messages {
+		p.processMessage(message)
+	}
+
+	return p.once
+}
+
+func (p *hash) GenerateHelpers() {
+}
+
+func (p *hash) processMessage(message *protogen.Message) {
+	for _, nested := range message.Messages {
+		p.processMessage(nested)
+	}
+
+	if message.Desc.IsMapEntry() || !p.ShouldGenerate(message) {
+		return
+	}
+
+	p.once = true
+	ccTypeName := message.GoIdent.GoName
+
+	fields := make([]*protogen.Field, len(message.Fields))
+	copy(fields, message.Fields)
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Desc.Number() < fields[j].Desc.Number()
+	})
+
+	p.P(`func (m *`, ccTypeName, `) `, hashName, `(h `, p.Ident("hash", "Hash64"), `) {`)
+	p.P(`var s `, p.helper("HashScratch"))
+	p.P(`m.`, hashInternalName, `(h, &s)`)
+	p.P(`}`)
+	p.P()
+
+	p.P(`func (m *`, ccTypeName, `) `, hashInternalName, `(h `, p.Ident("hash", "Hash64"), `, s *`, p.helper("HashScratch"), `) {`)
+	p.P(`if m == nil {`)
+	p.P(`return`)
+	p.P(`}`)
+	needEntry := p.IsExtendable(message)
+	for _, field := range fields {
+		needEntry = needEntry || field.Desc.IsMap()
+	}
+	if needEntry {
+		// the entries of all maps and extensions are hashed with the same hash.Hash64,
+		// created the first time it is needed
+		p.P(`var entry `, p.Ident("hash", "Hash64"))
+	}
+	oneofs := make(map[*protogen.Oneof]bool)
+	for _, field := range fields {
+		if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
+			if !oneofs[field.Oneof] {
+				oneofs[field.Oneof] = true
+				p.hashOneof(field.Oneof)
+			}
+			continue
+		}
+		p.hashField(field)
+	}
+	if p.IsExtendable(message) {
+		p.extensions(message)
+	}
+	// like a nil message, an empty one writes nothing
+	p.P(`if len(m.unknownFields) > 0 {`)
+	p.P(p.helper("HashBytes"), `(h, s, m.unknownFields)`)
+	p.P(`}`)
+	p.P(`}`)
+	p.P()
+}
+
+func (p *hash) helper(name string) string {
+	return p.Ident(generator.ProtoHelpersPkg, name)
+}
+
+func (p *hash) hashNumber(num protoreflect.FieldNumber) {
+	p.P(p.helper("HashUint64"), `(h, s, `, strconv.Itoa(int(num)), `)`)
+}
+
+// hashMessage generates the code that writes the message v to h. A nil message is
+// written like an empty one, and the end of the message is marked with a zero.
+func (p *hash) hashMessage(h, v string, message *protogen.Message) {
+	if p.IsLocalMessage(message) {
+		p.P(v, `.`, hashInternalName, `(`, h, `, s)`)
+	} else {
+		// v is a concrete type, we need to first convert it to an interface in order to use an
+		// interface type assertion.
+		p.P(`if vtpb, ok := interface{}(`, v, `).(interface{ `, hashName, `(`, p.Ident("hash", "Hash64"), `) }); ok {`)
+		p.P(`vtpb.`, hashName, `(`, h, `)`)
+		p.P(`} else {`)
+		p.P(p.helper("HashMessage"), `(`, h, `, `, v, `)`)
+		p.P(`}`)
+	}
+	p.P(p.helper("HashUint64"), `(`, h, `, s, 0)`)
+}
+
+// hashValue generates the code that writes the singular value v of the given kind to h.
+func (p *hash) hashValue(h, v string, kind protoreflect.Kind, message *protogen.Message) {
+	switch kind {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		p.hashMessage(h, v, message)
+	case protoreflect.BoolKind:
+		p.P(p.helper("HashBool"), `(`, h, `, s, `, v, `)`)
+	case protoreflect.FloatKind:
+		p.P(p.helper("HashFloat32"), `(`, h, `, s, `, v, `)`)
+	case protoreflect.DoubleKind:
+		p.P(p.helper("HashFloat64"), `(`, h, `, s, `, v, `)`)
+	case protoreflect.StringKind:
+		p.P(p.helper("HashString"), `(`, h, `, s, `, v, `)`)
+	case protoreflect.BytesKind:
+		p.P(p.helper("HashBytes"), `(`, h, `, s, `, v, `)`)
+	default:
+		p.P(p.helper("HashUint64"), `(`, h, `, s, uint64(`, v, `))`)
+	}
+}
+
+// hashMap generates the code that writes the map v to h. Each entry is hashed
+// separately and the results are summed, so that the order in which the map is
+// ranged over doesn't matter.
+func (p *hash) hashMap(v string, field *protogen.Field) {
+	key, value := field.Message.Fields[0], field.Message.Fields[1]
+	p.P(p.helper("HashUint64"), `(h, s, uint64(len(`, v, `)))`)
+	p.P(`var sum uint64`)
+	p.P(`if entry == nil {`)
+	p.P(`entry = `, p.Ident("hash/fnv", "New64a"), `()`)
+	p.P(`}`)
+	p.P(`for k, v := range `, v, ` {`)
+	p.P(`entry.Reset()`)
+	p.hashValue("entry", "k", key.Desc.Kind(), nil)
+	p.hashValue("entry", "v", value.Desc.Kind(), value.Message)
+	p.P(`sum += entry.Sum64()`)
+	p.P(`}`)
+	p.P(p.helper("HashUint64"), `(h, s, sum)`)
+}
+
+// hashField generates the code that writes a field that is not part of a oneof to h,
+// preceded by its number. Fields that are not set, or set to the zero value if they
+// have no presence, are skipped like when marshalling.
+func (p *hash) hashField(field *protogen.Field) {
+	v := "m." + field.GoName
+	kind := field.Desc.Kind()
+
+	switch {
+	case field.Desc.IsMap():
+		p.P(`if len(`, v, `) > 0 {`)
+		p.hashNumber(field.Desc.Number())
+		p.hashMap(v, field)
+	case field.Desc.IsList():
+		p.P(`if len(`, v, `) > 0 {`)
+		p.hashNumber(field.Desc.Number())
+		p.P(p.helper("HashUint64"), `(h, s, uint64(len(`, v, `)))`)
+		p.P(`for _, v := range `, v, ` {`)
+		p.hashValue("h", "v", kind, field.Message)
+		p.P(`}`)
+	case kind == protoreflect.MessageKind || kind == protoreflect.GroupKind:
+		p.P(`if `, v, ` != nil {`)
+		p.hashNumber(field.Desc.Number())
+		p.hashValue("h", v, kind, field.Message)
+	case field.Desc.HasPresence():
+		// Scalars with presence are pointers, bytes are nil when not present
+		p.P(`if `, v, ` != nil {`)
+		p.hashNumber(field.Desc.Number())
+		if kind == protoreflect.BytesKind {
+			p.hashValue("h", v, kind, nil)
+		} else {
+			p.hashValue("h", "*"+v, kind, nil)
+		}
+	case kind == protoreflect.BytesKind:
+		p.P(`if len(`, v, `) > 0 {`)
+		p.hashNumber(field.Desc.Number())
+		p.hashValue("h", v, kind, nil)
+	case kind == protoreflect.BoolKind:
+		p.P(`if `, v, ` {`)
+		p.hashNumber(field.Desc.Number())
+		p.hashValue("h", v, kind, nil)
+	case kind == protoreflect.StringKind:
+		p.P(`if `, v, ` != "" {`)
+		p.hashNumber(field.Desc.Number())
+		p.hashValue("h", v, kind, nil)
+	default:
+		p.P(`if `, v, ` != 0 {`)
+		p.hashNumber(field.Desc.Number())
+		p.hashValue("h", v, kind, nil)
+	}
+	p.P(`}`)
+}
+
+// hashOneof generates the code that writes the member set in a oneof to h, preceded by
+// its number.
+func (p *hash) hashOneof(oneof *protogen.Oneof) {
+	p.P(`switch c := m.`, oneof.GoName, `.(type) {`)
+	for _, field := range oneof.Fields {
+		p.P(`case *`, field.GoIdent, `:`)
+		p.hashNumber(field.Desc.Number())
+		p.hashValue("h", "c."+field.GoName, field.Desc.Kind(), field.Message)
+	}
+	p.P(`}`)
+}
+
+// extensions generates the code that writes the extension fields of "m" to h. They are
+// stored in a map, so they are hashed separately and the results are summed. The
+// extensions known at generation time are hashed inline, all others with protohelpers.
+func (p *hash) extensions(message *protogen.Message) {
+	p.P(`if len(m.extensionFields) > 0 {`)
+	p.P(`var sum uint64`)
+	p.P(`if entry == nil {`)
+	p.P(`entry = `, p.Ident("hash/fnv", "New64a"), `()`)
+	p.P(`}`)
+	p.P(`for num, x := range m.extensionFields {`)
+	p.P(`entry.Reset()`)
+	p.P(p.helper("HashUint64"), `(entry, s, uint64(num))`)
+	known := p.KnownExtensions(message)
+	if len(known) > 0 {
+		p.P(`switch num {`)
+		for _, ext := range known {
+			p.P(`case `, strconv.Itoa(int(ext.Desc.Number())), `:`)
+			p.hashExtension(ext)
+		}
+		p.P(`default:`)
+	}
+	p.P(p.helper("HashExtension"), `(entry, x.Type(), x.Value())`)
+	if len(known) > 0 {
+		p.P(`}`)
+	}
+	p.P(`sum += entry.Sum64()`)
+	p.P(`}`)
+	p.P(p.helper("HashUint64"), `(h, s, sum)`)
+	p.P(`}`)
+}
+
+// hashExtension generates the statements for writing an extension known at generation
+// time to the entry hash.
+func (p *hash) hashExtension(ext *protogen.Extension) {
+	kind := ext.Desc.Kind()
+	p.P(`v := `, p.ExtensionValue("m", ext))
+	if ext.Desc.IsList() {
+		p.P(p.helper("HashUint64"), `(entry, s, uint64(len(v)))`)
+		p.P(`for _, v := range v {`)
+		p.hashValue("entry", "v", kind, ext.Message)
+		p.P(`}`)
+		return
+	}
+	p.hashValue("entry", "v", kind, ext.Message)
+}
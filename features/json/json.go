@@ -0,0 +1,260 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package json
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/planetscale/vtprotobuf/generator"
+)
+
+const (
+	marshalName   = "MarshalJSONVT"
+	marshalToName = "MarshalJSONToVT"
+)
+
+func init() {
+	generator.RegisterOptInFeature("json", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &json{GeneratedFile: gen}
+	})
+}
+
+type json struct {
+	*generator.GeneratedFile
+	once bool
+}
+
+var _ generator.FeatureGenerator = (*json)(nil)
+
+func (p *json) GenerateFile(file *protogen.File) bool {
+	for _, message := range file.Messages {
+		p.processMessage(message)
+	}
+
+	return p.once
+}
+
+func (p *json) GenerateHelpers() {
+}
+
+func (p *json) processMessage(message *protogen.Message) {
+	for _, nested := range message.Messages {
+		p.processMessage(nested)
+	}
+
+	if message.Desc.IsMapEntry() || !p.ShouldGenerate(message) {
+		return
+	}
+
+	p.once = true
+	ccTypeName := message.GoIdent.GoName
+
+	p.P(`func (m *`, ccTypeName, `) `, marshalName, `() ([]byte, error) {`)
+	p.P(`return `, p.Ident(generator.VTProtoPkg, "JSONMarshalOptions"), `{}.Marshal(m)`)
+	p.P(`}`)
+	p.P()
+
+	p.P(`func (m *`, ccTypeName, `) `, marshalToName, `(w *`, p.Ident(generator.VTProtoPkg, "JSONWriter"), `) error {`)
+	p.P(`if m == nil {`)
+	p.P(`// a nil message is written like an empty one`)
+	p.P(`m = &`, ccTypeName, `{}`)
+	p.P(`}`)
+	// protojson writes the fields in declaration order
+	fields := sortedByIndex(message)
+
+	p.P(`w.ObjectStart()`)
+	for _, field := range fields {
+		if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
+			c := "c"
+			if isNullValue(field.Enum) {
+				c = "_"
+			}
+			p.P(`if `, c, `, ok := m.`, field.Oneof.GoName, `.(*`, field.GoIdent, `); ok {`)
+			p.fieldName(field)
+			p.writeValue("c."+field.GoName, field.Desc, field.Message, field.Enum)
+			p.P(`}`)
+			continue
+		}
+		p.writeField(field)
+	}
+	if p.IsExtendable(message) {
+		p.P(`if err := `, p.Ident(generator.ProtoHelpersPkg, "MarshalJSONExtensions"), `(w, m.extensionFields); err != nil {`)
+		p.P(`return err`)
+		p.P(`}`)
+	}
+	p.P(`w.ObjectEnd()`)
+	p.P(`return nil`)
+	p.P(`}`)
+	p.P()
+
+	p.generateUnmarshal(message, fields)
+}
+
+func (p *json) fieldName(field *protogen.Field) {
+	p.P(`w.Field(`, strconv.Quote(field.Desc.JSONName()), `, `, strconv.Quote(field.Desc.TextName()), `)`)
+}
+
+// writeField generates the code that writes a field that is not part of a oneof if it
+// is populated, i.e. when protoreflect.Message.Has would report it as set, or with
+// its default value if EmitUnpopulated is set. Like protojson, EmitUnpopulated
+// doesn't apply to proto3 optional fields, which are members of synthetic oneofs.
+func (p *json) writeField(field *protogen.Field) {
+	v := "m." + field.GoName
+	kind := field.Desc.Kind()
+
+	switch {
+	case field.Desc.IsMap(), field.Desc.IsList(), kind == protoreflect.BytesKind && !field.Desc.HasPresence():
+		p.P(`if len(`, v, `) > 0 {`)
+	case kind == protoreflect.MessageKind || kind == protoreflect.GroupKind || field.Desc.HasPresence():
+		p.P(`if `, v, ` != nil {`)
+	case kind == protoreflect.BoolKind:
+		p.P(`if `, v, ` {`)
+	case kind == protoreflect.StringKind:
+		p.P(`if `, v, ` != "" {`)
+	case kind == protoreflect.FloatKind || kind == protoreflect.DoubleKind:
+		// negative zero is populated
+		p.P(`if `, v, ` != 0 || `, p.Ident("math", "Signbit"), `(float64(`, v, `)) {`)
+	default:
+		p.P(`if `, v, ` != 0 {`)
+	}
+	p.fieldName(field)
+	if field.Desc.HasPresence() && kind != protoreflect.BytesKind && kind != protoreflect.MessageKind && kind != protoreflect.GroupKind {
+		p.writeValue("*"+v, field.Desc, field.Message, field.Enum)
+	} else {
+		p.writeValue(v, field.Desc, field.Message, field.Enum)
+	}
+
+	if field.Oneof != nil {
+		p.P(`}`)
+		return
+	}
+	p.P(`} else if w.Options().EmitUnpopulated {`)
+	p.fieldName(field)
+	switch {
+	case field.Desc.IsMap():
+		p.P(`w.ObjectStart()`)
+		p.P(`w.ObjectEnd()`)
+	case field.Desc.IsList():
+		p.P(`w.ArrayStart()`)
+		p.P(`w.ArrayEnd()`)
+	case kind == protoreflect.MessageKind || kind == protoreflect.GroupKind || field.Desc.Syntax() == protoreflect.Proto2:
+		p.P(`w.Null()`)
+	default:
+		// the getter returns the default value of fields with presence
+		p.writeSingular(`m.Get`+field.GoName+`()`, field.Desc, nil, field.Enum)
+	}
+	p.P(`}`)
+}
+
+// writeValue generates the code that writes the value v of the given field, which may
+// be a list or a map.
+func (p *json) writeValue(v string, fd protoreflect.FieldDescriptor, message *protogen.Message, enum *protogen.Enum) {
+	switch {
+	case fd.IsMap():
+		p.writeMap(v, fd, message)
+	case fd.IsList():
+		p.P(`w.ArrayStart()`)
+		p.P(`for _, v := range `, v, ` {`)
+		p.writeSingular("v", fd, message, enum)
+		p.P(`}`)
+		p.P(`w.ArrayEnd()`)
+	default:
+		p.writeSingular(v, fd, message, enum)
+	}
+}
+
+// writeMap generates the code that writes the map v as an object, whose entries are
+// sorted by key like protojson does.
+func (p *json) writeMap(v string, fd protoreflect.FieldDescriptor, entry *protogen.Message) {
+	key, value := entry.Fields[0], entry.Fields[1]
+	keyKind := key.Desc.Kind()
+	keyType, _ := p.FieldGoType(key)
+
+	p.P(`keys := make([]`, keyType, `, 0, len(`, v, `))`)
+	p.P(`for k := range `, v, ` {`)
+	p.P(`keys = append(keys, k)`)
+	p.P(`}`)
+	switch keyKind {
+	case protoreflect.StringKind:
+		p.P(p.Ident("sort", "Strings"), `(keys)`)
+	case protoreflect.BoolKind:
+		p.P(p.Ident("sort", "Slice"), `(keys, func(i, j int) bool { return !keys[i] && keys[j] })`)
+	default:
+		p.P(p.Ident("sort", "Slice"), `(keys, func(i, j int) bool { return keys[i] < keys[j] })`)
+	}
+
+	p.P(`w.ObjectStart()`)
+	p.P(`for _, k := range keys {`)
+	switch keyKind {
+	case protoreflect.StringKind:
+		p.P(`if err := w.Name(k); err != nil {`)
+		p.P(`return `, p.invalidUTF8(key.Desc))
+		p.P(`}`)
+	case protoreflect.BoolKind:
+		p.P(`w.Name(`, p.Ident("strconv", "FormatBool"), `(k))`)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		p.P(`w.Name(`, p.Ident("strconv", "FormatInt"), `(int64(k), 10))`)
+	default:
+		p.P(`w.Name(`, p.Ident("strconv", "FormatUint"), `(uint64(k), 10))`)
+	}
+	p.writeSingular(v+"[k]", value.Desc, value.Message, value.Enum)
+	p.P(`}`)
+	p.P(`w.ObjectEnd()`)
+}
+
+// isNullValue reports whether enum is google.protobuf.NullValue, which is always
+// written as null.
+func isNullValue(enum *protogen.Enum) bool {
+	return enum != nil && enum.Desc.FullName() == "google.protobuf.NullValue"
+}
+
+func (p *json) invalidUTF8(fd protoreflect.FieldDescriptor) string {
+	return p.Ident("errors", "New") + `(` + strconv.Quote("proto: field "+string(fd.FullName())+" contains invalid UTF-8") + `)`
+}
+
+// writeSingular generates the code that writes the singular value v of the given field.
+func (p *json) writeSingular(v string, fd protoreflect.FieldDescriptor, message *protogen.Message, enum *protogen.Enum) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if p.IsLocalMessage(message) {
+			p.P(`if err := `, v, `.`, marshalToName, `(w); err != nil {`)
+		} else {
+			p.P(`if err := w.Message(`, v, `); err != nil {`)
+		}
+		p.P(`return err`)
+		p.P(`}`)
+	case protoreflect.EnumKind:
+		if isNullValue(enum) {
+			p.P(`w.Null()`)
+		} else {
+			names := protogen.GoIdent{GoName: enum.GoIdent.GoName + "_name", GoImportPath: enum.GoIdent.GoImportPath}
+			p.P(`w.Enum(int32(`, v, `), `, names, `)`)
+		}
+	case protoreflect.StringKind:
+		p.P(`if err := w.String(`, v, `); err != nil {`)
+		p.P(`return `, p.invalidUTF8(fd))
+		p.P(`}`)
+	case protoreflect.BytesKind:
+		p.P(`w.Base64(`, v, `)`)
+	case protoreflect.BoolKind:
+		p.P(`w.Bool(`, v, `)`)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		p.P(`w.Int32(`, v, `)`)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		p.P(`w.Uint32(`, v, `)`)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		p.P(`w.Int64(`, v, `)`)
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		p.P(`w.Uint64(`, v, `)`)
+	case protoreflect.FloatKind:
+		p.P(`w.Float32(`, v, `)`)
+	case protoreflect.DoubleKind:
+		p.P(`w.Float64(`, v, `)`)
+	}
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package json
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/planetscale/vtprotobuf/generator"
+)
+
+const (
+	unmarshalName     = "UnmarshalJSONVT"
+	unmarshalFromName = "UnmarshalJSONFromVT"
+)
+
+func (p *json) generateUnmarshal(message *protogen.Message, fields []*protogen.Field) {
+	ccTypeName := message.GoIdent.GoName
+
+	p.P(`func (m *`, ccTypeName, `) `, unmarshalName, `(b []byte) error {`)
+	p.P(`return `, p.Ident(generator.VTProtoPkg, "JSONUnmarshalOptions"), `{}.Unmarshal(b, m)`)
+	p.P(`}`)
+	p.P()
+
+	// like protojson, a field can be named by its JSON name or, if no field has
+	// this JSON name, by its name in the .proto file
+	names := make(map[string]*protogen.Field)
+	for _, field := range fields {
+		names[field.Desc.JSONName()] = field
+	}
+	for _, field := range fields {
+		if _, ok := names[field.Desc.TextName()]; !ok {
+			names[field.Desc.TextName()] = field
+		}
+	}
+
+	// the fields that have been read are tracked by index, followed by the oneofs
+	// that have been set
+	var seenLen int
+	for _, field := range fields {
+		if !field.Desc.IsWeak() {
+			seenLen = len(fields) + len(message.Oneofs)
+			break
+		}
+	}
+
+	p.P(`func (m *`, ccTypeName, `) `, unmarshalFromName, `(r *`, p.Ident(generator.VTProtoPkg, "JSONReader"), `) error {`)
+	p.P(`if err := r.EnterMessage(); err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
+	p.P(`defer r.LeaveMessage()`)
+	p.P(`if err := r.ReadObjectStart(); err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
+	if seenLen > 0 {
+		p.P(`var seen [`, seenLen, `]bool`)
+	}
+	p.P(`for {`)
+	p.P(`name, ok, err := r.ReadName()`)
+	p.P(`if err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
+	p.P(`if !ok {`)
+	p.P(`return nil`)
+	p.P(`}`)
+	p.P(`switch name {`)
+	for _, field := range fields {
+		if field.Desc.IsWeak() {
+			continue
+		}
+		var cases []string
+		for _, name := range []string{field.Desc.JSONName(), field.Desc.TextName()} {
+			if names[name] == field {
+				cases = append(cases, strconv.Quote(name))
+				delete(names, name)
+			}
+		}
+		if len(cases) == 0 {
+			continue
+		}
+		p.P(`case `, strings.Join(cases, ", "), `:`)
+		p.readField(field, len(fields))
+	}
+	p.P(`default:`)
+	if p.IsExtendable(message) {
+		p.P(`if err := `, p.Ident(generator.ProtoHelpersPkg, "UnmarshalJSONExtension"), `(r, m, name); err != nil {`)
+	} else {
+		p.P(`if err := r.UnknownField(name); err != nil {`)
+	}
+	p.P(`return err`)
+	p.P(`}`)
+	p.P(`}`)
+	p.P(`}`)
+	p.P(`}`)
+	p.P()
+}
+
+// acceptsNull reports whether null is not the same as omitting the given field. It
+// is a value of google.protobuf.Value and google.protobuf.NullValue fields, and an
+// error for repeated ones.
+func acceptsNull(field *protogen.Field) bool {
+	if field.Desc.IsMap() {
+		return false
+	}
+	return isNullValue(field.Enum) || (field.Message != nil && field.Message.Desc.FullName() == "google.protobuf.Value")
+}
+
+// readField generates the case reading the value of a field. The field is tracked in
+// the seen array at its declaration index, and its oneof at oneofOffset plus the
+// index of the oneof.
+func (p *json) readField(field *protogen.Field, oneofOffset int) {
+	index := field.Desc.Index()
+	p.P(`if seen[`, index, `] {`)
+	p.P(`return r.Errorf("duplicate field %q", name)`)
+	p.P(`}`)
+	p.P(`seen[`, index, `] = true`)
+	if !acceptsNull(field) {
+		p.P(`if r.SkipNull() {`)
+		p.P(`continue`)
+		p.P(`}`)
+	}
+
+	v := "m." + field.GoName
+	switch {
+	case field.Desc.IsMap():
+		p.readMap(v, field)
+	case field.Desc.IsList():
+		p.P(`if err := r.ReadArrayStart(); err != nil {`)
+		p.P(`return err`)
+		p.P(`}`)
+		p.P(`for {`)
+		p.P(`ok, err := r.ReadArrayNext()`)
+		p.P(`if err != nil {`)
+		p.P(`return err`)
+		p.P(`}`)
+		p.P(`if !ok {`)
+		p.P(`break`)
+		p.P(`}`)
+		p.readSingular(field.Desc, field.Message, field.Enum, func(e string) {
+			p.P(v, ` = append(`, v, `, `, e, `)`)
+		})
+		p.P(`}`)
+	case field.Oneof != nil && !field.Oneof.Desc.IsSynthetic():
+		oneof := oneofOffset + field.Oneof.Desc.Index()
+		p.P(`if seen[`, oneof, `] {`)
+		p.P(`return r.Errorf("oneof `, field.Oneof.Desc.FullName(), ` is already set")`)
+		p.P(`}`)
+		p.P(`seen[`, oneof, `] = true`)
+		p.readSingular(field.Desc, field.Message, field.Enum, func(e string) {
+			p.P(`m.`, field.Oneof.GoName, ` = &`, field.GoIdent, `{`, field.GoName, `: `, e, `}`)
+		})
+	default:
+		kind := field.Desc.Kind()
+		pointer := field.Desc.HasPresence() && kind != protoreflect.BytesKind && kind != protoreflect.MessageKind && kind != protoreflect.GroupKind
+		p.readSingular(field.Desc, field.Message, field.Enum, func(e string) {
+			if pointer {
+				p.P(v, ` = &`, e)
+			} else {
+				p.P(v, ` = `, e)
+			}
+		})
+	}
+}
+
+// readMap generates the code reading the object holding the map v, whose member
+// names are the keys of the map.
+func (p *json) readMap(v string, field *protogen.Field) {
+	key, value := field.Message.Fields[0], field.Message.Fields[1]
+	goType, _ := p.FieldGoType(field)
+
+	p.P(`if err := r.ReadObjectStart(); err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
+	p.P(`if `, v, ` == nil {`)
+	p.P(v, ` = make(`, goType, `)`)
+	p.P(`}`)
+	p.P(`for {`)
+	p.P(`name, ok, err := r.ReadName()`)
+	p.P(`if err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
+	p.P(`if !ok {`)
+	p.P(`break`)
+	p.P(`}`)
+	switch key.Desc.Kind() {
+	case protoreflect.StringKind:
+		p.P(`k := name`)
+	case protoreflect.BoolKind:
+		p.readKey("BoolKey")
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		p.readKey("Int32Key")
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		p.readKey("Int64Key")
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		p.readKey("Uint32Key")
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		p.readKey("Uint64Key")
+	}
+	p.P(`if _, ok := `, v, `[k]; ok {`)
+	p.P(`return r.Errorf("duplicate map key %q", name)`)
+	p.P(`}`)
+	p.readSingular(value.Desc, value.Message, value.Enum, func(e string) {
+		p.P(v, `[k] = `, e)
+	})
+	p.P(`}`)
+}
+
+func (p *json) readKey(method string) {
+	p.P(`k, err := r.`, method, `(name)`)
+	p.P(`if err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
+}
+
+// readSingular generates the code reading a singular value of the given field, and
+// calls assign to generate the code storing the expression holding it. Like in
+// protojson, the enum values with an unknown name are ignored if DiscardUnknown
+// is set.
+func (p *json) readSingular(fd protoreflect.FieldDescriptor, message *protogen.Message, enum *protogen.Enum, assign func(string)) {
+	var method string
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		p.Alloc("v", message)
+		if p.IsLocalMessage(message) {
+			p.P(`if err := v.`, unmarshalFromName, `(r); err != nil {`)
+		} else {
+			p.P(`if err := r.ReadMessage(v); err != nil {`)
+		}
+		p.P(`return err`)
+		p.P(`}`)
+		assign("v")
+		return
+	case protoreflect.EnumKind:
+		if isNullValue(enum) {
+			p.P(`e, ok, err := r.ReadNullValue()`)
+		} else {
+			values := protogen.GoIdent{GoName: enum.GoIdent.GoName + "_value", GoImportPath: enum.GoIdent.GoImportPath}
+			p.P(`e, ok, err := r.ReadEnum(`, values, `)`)
+		}
+		p.P(`if err != nil {`)
+		p.P(`return err`)
+		p.P(`}`)
+		p.P(`if ok {`)
+		p.P(`v := `, enum.GoIdent, `(e)`)
+		assign("v")
+		p.P(`}`)
+		return
+	case protoreflect.StringKind:
+		method = "ReadString"
+	case protoreflect.BytesKind:
+		method = "ReadBytes"
+	case protoreflect.BoolKind:
+		method = "ReadBool"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		method = "ReadInt32"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		method = "ReadUint32"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		method = "ReadInt64"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		method = "ReadUint64"
+	case protoreflect.FloatKind:
+		method = "ReadFloat32"
+	case protoreflect.DoubleKind:
+		method = "ReadFloat64"
+	}
+	p.P(`v, err := r.`, method, `()`)
+	p.P(`if err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
+	assign("v")
+}
+
+// sortedByIndex returns the fields of message in declaration order.
+func sortedByIndex(message *protogen.Message) []*protogen.Field {
+	// other features sort message.Fields in place
+	fields := make([]*protogen.Field, len(message.Fields))
+	copy(fields, message.Fields)
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Desc.Index() < fields[j].Desc.Index()
+	})
+	return fields
+}
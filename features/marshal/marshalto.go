@@ -20,8 +20,11 @@ import (
 
 func init() {
 	generator.RegisterFeature("marshal", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
-		return &marshal{GeneratedFile: gen, Stable: false}
-	})
+		return &marshal{GeneratedFile: gen}
+	}, "size")
+	generator.RegisterOptInFeature("marshal_pooled", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &marshal{GeneratedFile: gen, pooled: true}
+	}, "marshal")
 }
 
 type counter int
@@ -37,22 +40,46 @@ func (cnt *counter) Current() string {
 
 type marshal struct {
 	*generator.GeneratedFile
+	// Stable is set for messages that must be marshaled deterministically,
+	// i.e. with their map entries sorted by key.
 	Stable, once bool
+	// pooled generates MarshalVTPooled, which marshals into a buffer from the
+	// pool of the vtproto package, instead of the other marshal methods.
+	pooled bool
 }
 
 var _ generator.FeatureGenerator = (*marshal)(nil)
 
 func (p *marshal) GenerateFile(file *protogen.File) bool {
-	proto3 := file.Desc.Syntax() == protoreflect.Proto3
 	for _, message := range file.Messages {
-		p.message(proto3, message)
+		p.message(message)
 	}
 	return p.once
 }
 
 func (p *marshal) GenerateHelpers() {
+	if p.pooled {
+		// The helpers are generated by the marshal feature, which is always
+		// enabled along with marshal_pooled.
+		return
+	}
+	if p.Ext.SharedHelpers {
+		p.P(`var (`)
+		p.P(`ErrBufferTooSmall = `, p.Helper("ErrBufferTooSmall"))
+		p.P(`ErrSizeMismatch = `, p.Helper("ErrSizeMismatch"))
+		p.P(`)`)
+		return
+	}
+	p.P(`var (`)
+	p.P(`ErrBufferTooSmall = `, p.Ident("fmt", "Errorf"), `("proto: buffer too small to marshal the message")`)
+	p.P(`ErrSizeMismatch = `, p.Ident("fmt", "Errorf"), `("proto: message size changed while marshaling")`)
+	p.P(`)`)
+	p.P()
 	p.P(`func encodeVarint(dAtA []byte, offset int, v uint64) int {`)
 	p.P(`offset -= sov(v)`)
+	p.P(`if offset < 0 {`)
+	p.P(`return offset`)
+	p.P(`}`)
 	p.P(`base := offset`)
 	p.P(`for v >= 1<<7 {`)
 	p.P(`dAtA[offset] = uint8(v&0x7f|0x80)`)
@@ -64,18 +91,38 @@ func (p *marshal) GenerateHelpers() {
 	p.P(`}`)
 }
 
+// reserve generates the code that moves i back by the given size, to write that many
+// bytes at dAtA[i:], or returns ErrBufferTooSmall if they don't fit in dAtA.
+func (p *marshal) reserve(size ...string) {
+	if n := strings.Join(size, ""); n == "1" {
+		p.P(`i--`)
+	} else {
+		p.P(`i -= `, n)
+	}
+	p.checkBuffer()
+}
+
+// checkBuffer generates the code that returns ErrBufferTooSmall if i has been
+// moved past the start of dAtA.
+func (p *marshal) checkBuffer() {
+	p.P(`if i < 0 {`)
+	p.P(`return 0, `, p.Helper("ErrBufferTooSmall"))
+	p.P(`}`)
+}
+
 func (p *marshal) encodeFixed64(varName ...string) {
-	p.P(`i -= 8`)
+	p.reserve("8")
 	p.P(p.Ident("encoding/binary", "LittleEndian"), `.PutUint64(dAtA[i:], uint64(`, strings.Join(varName, ""), `))`)
 }
 
 func (p *marshal) encodeFixed32(varName ...string) {
-	p.P(`i -= 4`)
+	p.reserve("4")
 	p.P(p.Ident("encoding/binary", "LittleEndian"), `.PutUint32(dAtA[i:], uint32(`, strings.Join(varName, ""), `))`)
 }
 
 func (p *marshal) encodeVarint(varName ...string) {
-	p.P(`i = encodeVarint(dAtA, i, uint64(`, strings.Join(varName, ""), `))`)
+	p.P(`i = `, p.Helper("encodeVarint"), `(dAtA, i, uint64(`, strings.Join(varName, ""), `))`)
+	p.checkBuffer()
 }
 
 func (p *marshal) encodeKey(fieldNumber protoreflect.FieldNumber, wireType protowire.Type) {
@@ -87,9 +134,13 @@ func (p *marshal) encodeKey(fieldNumber protoreflect.FieldNumber, wireType proto
 		x >>= 7
 	}
 	keybuf = append(keybuf, uint8(x))
-	for i = len(keybuf) - 1; i >= 0; i-- {
-		p.P(`i--`)
-		p.P(`dAtA[i] = `, fmt.Sprintf("%#v", keybuf[i]))
+	p.reserve(strconv.Itoa(len(keybuf)))
+	for i = 0; i < len(keybuf); i++ {
+		if i == 0 {
+			p.P(`dAtA[i] = `, fmt.Sprintf("%#v", keybuf[i]))
+		} else {
+			p.P(`dAtA[i+`, strconv.Itoa(i), `] = `, fmt.Sprintf("%#v", keybuf[i]))
+		}
 	}
 }
 
@@ -106,14 +157,14 @@ func (p *marshal) mapField(kvField *protogen.Field, varName string) {
 	case protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind:
 		p.encodeFixed32(varName)
 	case protoreflect.BoolKind:
-		p.P(`i--`)
+		p.reserve("1")
 		p.P(`if `, varName, ` {`)
 		p.P(`dAtA[i] = 1`)
 		p.P(`} else {`)
 		p.P(`dAtA[i] = 0`)
 		p.P(`}`)
 	case protoreflect.StringKind, protoreflect.BytesKind:
-		p.P(`i -= len(`, varName, `)`)
+		p.reserve(`len(`, varName, `)`)
 		p.P(`copy(dAtA[i:], `, varName, `)`)
 		p.encodeVarint(`len(`, varName, `)`)
 	case protoreflect.Sint32Kind:
@@ -125,19 +176,18 @@ func (p *marshal) mapField(kvField *protogen.Field, varName string) {
 	}
 }
 
-func (p *marshal) field(proto3, oneof bool, numGen *counter, field *protogen.Field) {
-	fieldname := field.GoName
-	nullable := field.Message != nil || (field.Oneof != nil && field.Oneof.Desc.IsSynthetic()) || (!proto3 && !oneof)
+func (p *marshal) field(oneof bool, numGen *counter, field *protogen.Field, varName string) {
+	nullable := field.Message != nil || (!oneof && field.Desc.HasPresence())
 	repeated := field.Desc.Cardinality() == protoreflect.Repeated
 	if repeated {
-		p.P(`if len(m.`, fieldname, `) > 0 {`)
+		p.P(`if len(`, varName, `) > 0 {`)
 	} else if nullable {
 		if field.Desc.Cardinality() == protoreflect.Required {
-			p.P(`if m.`, fieldname, ` == nil {`)
+			p.P(`if `, varName, ` == nil {`)
 			p.P(`return 0, `, p.Ident("fmt", "Errorf"), `("proto: required field `, field.Desc.Name(), ` not set")`)
 			p.P(`} else {`)
 		} else {
-			p.P(`if m.`, fieldname, ` != nil {`)
+			p.P(`if `, varName, ` != nil {`)
 		}
 	}
 	packed := field.Desc.IsPacked()
@@ -149,54 +199,54 @@ func (p *marshal) field(proto3, oneof bool, numGen *counter, field *protogen.Fie
 	switch field.Desc.Kind() {
 	case protoreflect.DoubleKind:
 		if packed {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(varName)
 			p.P(`f`, numGen.Next(), ` := `, p.Ident("math", "Float64bits"), `(float64(`, val, `))`)
 			p.encodeFixed64("f", numGen.Current())
 			p.P(`}`)
-			p.encodeVarint(`len(m.`, fieldname, `) * 8`)
+			p.encodeVarint(`len(`, varName, `) * 8`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(varName)
 			p.P(`f`, numGen.Next(), ` := `, p.Ident("math", "Float64bits"), `(float64(`, val, `))`)
 			p.encodeFixed64("f", numGen.Current())
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else if nullable {
-			p.encodeFixed64(p.Ident("math", "Float64bits"), `(float64(*m.`+fieldname, `))`)
+			p.encodeFixed64(p.Ident("math", "Float64bits"), `(float64(*`+varName, `))`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if !oneof {
-			p.P(`if m.`, fieldname, ` != 0 {`)
-			p.encodeFixed64(p.Ident("math", "Float64bits"), `(float64(m.`, fieldname, `))`)
+			p.P(`if `, varName, ` != 0 {`)
+			p.encodeFixed64(p.Ident("math", "Float64bits"), `(float64(`, varName, `))`)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else {
-			p.encodeFixed64(p.Ident("math", "Float64bits"), `(float64(m.`+fieldname, `))`)
+			p.encodeFixed64(p.Ident("math", "Float64bits"), `(float64(`+varName, `))`)
 			p.encodeKey(fieldNumber, wireType)
 		}
 	case protoreflect.FloatKind:
 		if packed {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(varName)
 			p.P(`f`, numGen.Next(), ` := `, p.Ident("math", "Float32bits"), `(float32(`, val, `))`)
 			p.encodeFixed32("f" + numGen.Current())
 			p.P(`}`)
-			p.encodeVarint(`len(m.`, fieldname, `) * 4`)
+			p.encodeVarint(`len(`, varName, `) * 4`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(varName)
 			p.P(`f`, numGen.Next(), ` := `, p.Ident("math", "Float32bits"), `(float32(`, val, `))`)
 			p.encodeFixed32("f" + numGen.Current())
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else if nullable {
-			p.encodeFixed32(p.Ident("math", "Float32bits"), `(float32(*m.`+fieldname, `))`)
+			p.encodeFixed32(p.Ident("math", "Float32bits"), `(float32(*`+varName, `))`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if !oneof {
-			p.P(`if m.`, fieldname, ` != 0 {`)
-			p.encodeFixed32(p.Ident("math", "Float32bits"), `(float32(m.`+fieldname, `))`)
+			p.P(`if `, varName, ` != 0 {`)
+			p.encodeFixed32(p.Ident("math", "Float32bits"), `(float32(`+varName, `))`)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else {
-			p.encodeFixed32(p.Ident("math", "Float32bits"), `(float32(m.`+fieldname, `))`)
+			p.encodeFixed32(p.Ident("math", "Float32bits"), `(float32(`+varName, `))`)
 			p.encodeKey(fieldNumber, wireType)
 		}
 	case protoreflect.Int64Kind, protoreflect.Uint64Kind, protoreflect.Int32Kind, protoreflect.Uint32Kind, protoreflect.EnumKind:
@@ -205,19 +255,19 @@ func (p *marshal) field(proto3, oneof bool, numGen *counter, field *protogen.Fie
 			total := "pksize" + numGen.Next()
 
 			p.P(`var `, total, ` int`)
-			p.P(`for _, num := range m.`, fieldname, ` {`)
-			p.P(total, ` += sov(uint64(num))`)
+			p.P(`for _, num := range `, varName, ` {`)
+			p.P(total, ` += `, p.Helper("sov"), `(uint64(num))`)
 			p.P(`}`)
 
-			p.P(`i -= `, total)
+			p.reserve(total)
 			p.P(jvar, `:= i`)
 
 			switch field.Desc.Kind() {
 			case protoreflect.Int64Kind, protoreflect.Int32Kind, protoreflect.EnumKind:
-				p.P(`for _, num1 := range m.`, fieldname, ` {`)
+				p.P(`for _, num1 := range `, varName, ` {`)
 				p.P(`num := uint64(num1)`)
 			default:
-				p.P(`for _, num := range m.`, fieldname, ` {`)
+				p.P(`for _, num := range `, varName, ` {`)
 			}
 			p.P(`for num >= 1<<7 {`)
 			p.P(`dAtA[`, jvar, `] = uint8(uint64(num)&0x7f|0x80)`)
@@ -231,85 +281,85 @@ func (p *marshal) field(proto3, oneof bool, numGen *counter, field *protogen.Fie
 			p.encodeVarint(total)
 			p.encodeKey(fieldNumber, wireType)
 		} else if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(varName)
 			p.encodeVarint(val)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else if nullable {
-			p.encodeVarint(`*m.`, fieldname)
+			p.encodeVarint(`*`, varName)
 			p.encodeKey(fieldNumber, wireType)
 		} else if !oneof {
-			p.P(`if m.`, fieldname, ` != 0 {`)
-			p.encodeVarint(`m.`, fieldname)
+			p.P(`if `, varName, ` != 0 {`)
+			p.encodeVarint(varName)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else {
-			p.encodeVarint(`m.`, fieldname)
+			p.encodeVarint(varName)
 			p.encodeKey(fieldNumber, wireType)
 		}
 	case protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind:
 		if packed {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(varName)
 			p.encodeFixed64(val)
 			p.P(`}`)
-			p.encodeVarint(`len(m.`, fieldname, `) * 8`)
+			p.encodeVarint(`len(`, varName, `) * 8`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(varName)
 			p.encodeFixed64(val)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else if nullable {
-			p.encodeFixed64("*m.", fieldname)
+			p.encodeFixed64("*", varName)
 			p.encodeKey(fieldNumber, wireType)
 		} else if !oneof {
-			p.P(`if m.`, fieldname, ` != 0 {`)
-			p.encodeFixed64("m.", fieldname)
+			p.P(`if `, varName, ` != 0 {`)
+			p.encodeFixed64(varName)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else {
-			p.encodeFixed64("m.", fieldname)
+			p.encodeFixed64(varName)
 			p.encodeKey(fieldNumber, wireType)
 		}
 	case protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind:
 		if packed {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(varName)
 			p.encodeFixed32(val)
 			p.P(`}`)
-			p.encodeVarint(`len(m.`, fieldname, `) * 4`)
+			p.encodeVarint(`len(`, varName, `) * 4`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(varName)
 			p.encodeFixed32(val)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else if nullable {
-			p.encodeFixed32("*m." + fieldname)
+			p.encodeFixed32("*" + varName)
 			p.encodeKey(fieldNumber, wireType)
 		} else if !oneof {
-			p.P(`if m.`, fieldname, ` != 0 {`)
-			p.encodeFixed32("m." + fieldname)
+			p.P(`if `, varName, ` != 0 {`)
+			p.encodeFixed32(varName)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else {
-			p.encodeFixed32("m." + fieldname)
+			p.encodeFixed32(varName)
 			p.encodeKey(fieldNumber, wireType)
 		}
 	case protoreflect.BoolKind:
 		if packed {
-			val := p.reverseListRange(`m.`, fieldname)
-			p.P(`i--`)
+			val := p.reverseListRange(varName)
+			p.reserve("1")
 			p.P(`if `, val, ` {`)
 			p.P(`dAtA[i] = 1`)
 			p.P(`} else {`)
 			p.P(`dAtA[i] = 0`)
 			p.P(`}`)
 			p.P(`}`)
-			p.encodeVarint(`len(m.`, fieldname, `)`)
+			p.encodeVarint(`len(`, varName, `)`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
-			p.P(`i--`)
+			val := p.reverseListRange(varName)
+			p.reserve("1")
 			p.P(`if `, val, ` {`)
 			p.P(`dAtA[i] = 1`)
 			p.P(`} else {`)
@@ -318,17 +368,17 @@ func (p *marshal) field(proto3, oneof bool, numGen *counter, field *protogen.Fie
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else if nullable {
-			p.P(`i--`)
-			p.P(`if *m.`, fieldname, ` {`)
+			p.reserve("1")
+			p.P(`if *`, varName, ` {`)
 			p.P(`dAtA[i] = 1`)
 			p.P(`} else {`)
 			p.P(`dAtA[i] = 0`)
 			p.P(`}`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if !oneof {
-			p.P(`if m.`, fieldname, ` {`)
-			p.P(`i--`)
-			p.P(`if m.`, fieldname, ` {`)
+			p.P(`if `, varName, ` {`)
+			p.reserve("1")
+			p.P(`if `, varName, ` {`)
 			p.P(`dAtA[i] = 1`)
 			p.P(`} else {`)
 			p.P(`dAtA[i] = 0`)
@@ -336,8 +386,8 @@ func (p *marshal) field(proto3, oneof bool, numGen *counter, field *protogen.Fie
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else {
-			p.P(`i--`)
-			p.P(`if m.`, fieldname, ` {`)
+			p.reserve("1")
+			p.P(`if `, varName, ` {`)
 			p.P(`dAtA[i] = 1`)
 			p.P(`} else {`)
 			p.P(`dAtA[i] = 0`)
@@ -346,34 +396,42 @@ func (p *marshal) field(proto3, oneof bool, numGen *counter, field *protogen.Fie
 		}
 	case protoreflect.StringKind:
 		if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
-			p.P(`i -= len(`, val, `)`)
+			val := p.reverseListRange(varName)
+			p.reserve(`len(`, val, `)`)
 			p.P(`copy(dAtA[i:], `, val, `)`)
 			p.encodeVarint(`len(`, val, `)`)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else if nullable {
-			p.P(`i -= len(*m.`, fieldname, `)`)
-			p.P(`copy(dAtA[i:], *m.`, fieldname, `)`)
-			p.encodeVarint(`len(*m.`, fieldname, `)`)
+			p.reserve(`len(*`, varName, `)`)
+			p.P(`copy(dAtA[i:], *`, varName, `)`)
+			p.encodeVarint(`len(*`, varName, `)`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if !oneof {
-			p.P(`if len(m.`, fieldname, `) > 0 {`)
-			p.P(`i -= len(m.`, fieldname, `)`)
-			p.P(`copy(dAtA[i:], m.`, fieldname, `)`)
-			p.encodeVarint(`len(m.`, fieldname, `)`)
+			p.P(`if len(`, varName, `) > 0 {`)
+			p.reserve(`len(`, varName, `)`)
+			p.P(`copy(dAtA[i:], `, varName, `)`)
+			p.encodeVarint(`len(`, varName, `)`)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else {
-			p.P(`i -= len(m.`, fieldname, `)`)
-			p.P(`copy(dAtA[i:], m.`, fieldname, `)`)
-			p.encodeVarint(`len(m.`, fieldname, `)`)
+			p.reserve(`len(`, varName, `)`)
+			p.P(`copy(dAtA[i:], `, varName, `)`)
+			p.encodeVarint(`len(`, varName, `)`)
 			p.encodeKey(fieldNumber, wireType)
 		}
 	case protoreflect.GroupKind:
-		p.encodeKey(fieldNumber, protowire.EndGroupType)
-		p.marshalBackward(`m.`+fieldname, false, field.Message)
-		p.encodeKey(fieldNumber, protowire.StartGroupType)
+		if repeated {
+			val := p.reverseListRange(varName)
+			p.encodeKey(fieldNumber, protowire.EndGroupType)
+			p.marshalBackward(val, false, field.Message)
+			p.encodeKey(fieldNumber, protowire.StartGroupType)
+			p.P(`}`)
+		} else {
+			p.encodeKey(fieldNumber, protowire.EndGroupType)
+			p.marshalBackward(varName, false, field.Message)
+			p.encodeKey(fieldNumber, protowire.StartGroupType)
+		}
 	case protoreflect.MessageKind:
 		if field.Desc.IsMap() {
 			goTypK, _ := p.FieldGoType(field.Message.Fields[0])
@@ -381,24 +439,29 @@ func (p *marshal) field(proto3, oneof bool, numGen *counter, field *protogen.Fie
 			valKind := field.Message.Fields[1].Desc.Kind()
 
 			var val string
-			if p.Stable && keyKind != protoreflect.BoolKind {
-				keysName := `keysFor` + fieldname
-				p.P(keysName, ` := make([]`, goTypK, `, 0, len(m.`, fieldname, `))`)
-				p.P(`for k := range m.`, fieldname, ` {`)
+			if p.Stable {
+				keysName := `keysFor` + field.GoName
+				p.P(keysName, ` := make([]`, goTypK, `, 0, len(`, varName, `))`)
+				p.P(`for k := range `, varName, ` {`)
 				p.P(keysName, ` = append(`, keysName, `, `, goTypK, `(k))`)
 				p.P(`}`)
 				p.P(p.Ident("sort", "Slice"), `(`, keysName, `, func(i, j int) bool {`)
-				p.P(`return `, keysName, `[i] < `, keysName, `[j]`)
+				if keyKind == protoreflect.BoolKind {
+					// false sorts before true, like in proto.MarshalOptions{Deterministic: true}
+					p.P(`return !`, keysName, `[i] && `, keysName, `[j]`)
+				} else {
+					p.P(`return `, keysName, `[i] < `, keysName, `[j]`)
+				}
 				p.P(`})`)
 				val = p.reverseListRange(keysName)
 			} else {
-				p.P(`for k := range m.`, fieldname, ` {`)
+				p.P(`for k := range `, varName, ` {`)
 				val = "k"
 			}
 			if p.Stable {
-				p.P(`v := m.`, fieldname, `[`, goTypK, `(`, val, `)]`)
+				p.P(`v := `, varName, `[`, goTypK, `(`, val, `)]`)
 			} else {
-				p.P(`v := m.`, fieldname, `[`, val, `]`)
+				p.P(`v := `, varName, `[`, val, `]`)
 			}
 			p.P(`baseI := i`)
 
@@ -412,33 +475,33 @@ func (p *marshal) field(proto3, oneof bool, numGen *counter, field *protogen.Fie
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(varName)
 			p.marshalBackward(val, true, field.Message)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else {
-			p.marshalBackward(`m.`+fieldname, true, field.Message)
+			p.marshalBackward(varName, true, field.Message)
 			p.encodeKey(fieldNumber, wireType)
 		}
 	case protoreflect.BytesKind:
 		if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
-			p.P(`i -= len(`, val, `)`)
+			val := p.reverseListRange(varName)
+			p.reserve(`len(`, val, `)`)
 			p.P(`copy(dAtA[i:], `, val, `)`)
 			p.encodeVarint(`len(`, val, `)`)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
-		} else if !oneof && proto3 {
-			p.P(`if len(m.`, fieldname, `) > 0 {`)
-			p.P(`i -= len(m.`, fieldname, `)`)
-			p.P(`copy(dAtA[i:], m.`, fieldname, `)`)
-			p.encodeVarint(`len(m.`, fieldname, `)`)
+		} else if !oneof && !nullable {
+			p.P(`if len(`, varName, `) > 0 {`)
+			p.reserve(`len(`, varName, `)`)
+			p.P(`copy(dAtA[i:], `, varName, `)`)
+			p.encodeVarint(`len(`, varName, `)`)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else {
-			p.P(`i -= len(m.`, fieldname, `)`)
-			p.P(`copy(dAtA[i:], m.`, fieldname, `)`)
-			p.encodeVarint(`len(m.`, fieldname, `)`)
+			p.reserve(`len(`, varName, `)`)
+			p.P(`copy(dAtA[i:], `, varName, `)`)
+			p.encodeVarint(`len(`, varName, `)`)
 			p.encodeKey(fieldNumber, wireType)
 		}
 	case protoreflect.Sint32Kind:
@@ -447,13 +510,13 @@ func (p *marshal) field(proto3, oneof bool, numGen *counter, field *protogen.Fie
 			total := "pksize" + numGen.Next()
 
 			p.P(`var `, total, ` int`)
-			p.P(`for _, num := range m.`, fieldname, ` {`)
-			p.P(total, ` += soz(uint64(num))`)
+			p.P(`for _, num := range `, varName, ` {`)
+			p.P(total, ` += `, p.Helper("soz"), `(uint64(num))`)
 			p.P(`}`)
-			p.P(`i -= `, total)
+			p.reserve(total)
 			p.P(jvar, `:= i`)
 
-			p.P(`for _, num := range m.`, fieldname, ` {`)
+			p.P(`for _, num := range `, varName, ` {`)
 			xvar := "x" + numGen.Next()
 			p.P(xvar, ` := (uint32(num) << 1) ^ uint32((num >> 31))`)
 			p.P(`for `, xvar, ` >= 1<<7 {`)
@@ -468,21 +531,21 @@ func (p *marshal) field(proto3, oneof bool, numGen *counter, field *protogen.Fie
 			p.encodeVarint(total)
 			p.encodeKey(fieldNumber, wireType)
 		} else if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(varName)
 			p.P(`x`, numGen.Next(), ` := (uint32(`, val, `) << 1) ^ uint32((`, val, ` >> 31))`)
 			p.encodeVarint(`x`, numGen.Current())
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else if nullable {
-			p.encodeVarint(`(uint32(*m.`, fieldname, `) << 1) ^ uint32((*m.`, fieldname, ` >> 31))`)
+			p.encodeVarint(`(uint32(*`, varName, `) << 1) ^ uint32((*`, varName, ` >> 31))`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if !oneof {
-			p.P(`if m.`, fieldname, ` != 0 {`)
-			p.encodeVarint(`(uint32(m.`, fieldname, `) << 1) ^ uint32((m.`, fieldname, ` >> 31))`)
+			p.P(`if `, varName, ` != 0 {`)
+			p.encodeVarint(`(uint32(`, varName, `) << 1) ^ uint32((`, varName, ` >> 31))`)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else {
-			p.encodeVarint(`(uint32(m.`, fieldname, `) << 1) ^ uint32((m.`, fieldname, ` >> 31))`)
+			p.encodeVarint(`(uint32(`, varName, `) << 1) ^ uint32((`, varName, ` >> 31))`)
 			p.encodeKey(fieldNumber, wireType)
 		}
 	case protoreflect.Sint64Kind:
@@ -491,13 +554,13 @@ func (p *marshal) field(proto3, oneof bool, numGen *counter, field *protogen.Fie
 			total := "pksize" + numGen.Next()
 
 			p.P(`var `, total, ` int`)
-			p.P(`for _, num := range m.`, fieldname, ` {`)
-			p.P(total, ` += soz(uint64(num))`)
+			p.P(`for _, num := range `, varName, ` {`)
+			p.P(total, ` += `, p.Helper("soz"), `(uint64(num))`)
 			p.P(`}`)
-			p.P(`i -= `, total)
+			p.reserve(total)
 			p.P(jvar, `:= i`)
 
-			p.P(`for _, num := range m.`, fieldname, ` {`)
+			p.P(`for _, num := range `, varName, ` {`)
 			xvar := "x" + numGen.Next()
 			p.P(xvar, ` := (uint64(num) << 1) ^ uint64((num >> 63))`)
 			p.P(`for `, xvar, ` >= 1<<7 {`)
@@ -512,21 +575,21 @@ func (p *marshal) field(proto3, oneof bool, numGen *counter, field *protogen.Fie
 			p.encodeVarint(total)
 			p.encodeKey(fieldNumber, wireType)
 		} else if repeated {
-			val := p.reverseListRange(`m.`, fieldname)
+			val := p.reverseListRange(varName)
 			p.P(`x`, numGen.Next(), ` := (uint64(`, val, `) << 1) ^ uint64((`, val, ` >> 63))`)
 			p.encodeVarint("x" + numGen.Current())
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else if nullable {
-			p.encodeVarint(`(uint64(*m.`, fieldname, `) << 1) ^ uint64((*m.`, fieldname, ` >> 63))`)
+			p.encodeVarint(`(uint64(*`, varName, `) << 1) ^ uint64((*`, varName, ` >> 63))`)
 			p.encodeKey(fieldNumber, wireType)
 		} else if !oneof {
-			p.P(`if m.`, fieldname, ` != 0 {`)
-			p.encodeVarint(`(uint64(m.`, fieldname, `) << 1) ^ uint64((m.`, fieldname, ` >> 63))`)
+			p.P(`if `, varName, ` != 0 {`)
+			p.encodeVarint(`(uint64(`, varName, `) << 1) ^ uint64((`, varName, ` >> 63))`)
 			p.encodeKey(fieldNumber, wireType)
 			p.P(`}`)
 		} else {
-			p.encodeVarint(`(uint64(m.`, fieldname, `) << 1) ^ uint64((m.`, fieldname, ` >> 63))`)
+			p.encodeVarint(`(uint64(`, varName, `) << 1) ^ uint64((`, varName, ` >> 63))`)
 			p.encodeKey(fieldNumber, wireType)
 		}
 	default:
@@ -537,19 +600,24 @@ func (p *marshal) field(proto3, oneof bool, numGen *counter, field *protogen.Fie
 	}
 }
 
-func (p *marshal) message(proto3 bool, message *protogen.Message) {
+func (p *marshal) message(message *protogen.Message) {
 	for _, nested := range message.Messages {
-		p.message(proto3, nested)
+		p.message(nested)
 	}
 
-	if message.Desc.IsMapEntry() {
+	if message.Desc.IsMapEntry() || !p.ShouldGenerate(message) {
 		return
 	}
 
 	p.once = true
+	ccTypeName := message.GoIdent
+	if p.pooled {
+		p.marshalPooled(ccTypeName)
+		return
+	}
+	p.Stable = p.ShouldMarshalDeterministic(message)
 
 	var numGen counter
-	ccTypeName := message.GoIdent
 
 	p.P(`func (m *`, ccTypeName, `) MarshalVT() (dAtA []byte, err error) {`)
 	p.P(`if m == nil {`)
@@ -557,18 +625,19 @@ func (p *marshal) message(proto3 bool, message *protogen.Message) {
 	p.P(`}`)
 	p.P(`size := m.SizeVT()`)
 	p.P(`dAtA = make([]byte, size)`)
-	p.P(`n, err := m.MarshalToSizedBufferVT(dAtA[:size])`)
+	p.P(`n, err := m.MarshalToSizedBufferVT(dAtA)`)
 	p.P(`if err != nil {`)
 	p.P(`return nil, err`)
 	p.P(`}`)
-	p.P(`return dAtA[:n], nil`)
+	p.P(`if n != size {`)
+	p.P(`return nil, `, p.Helper("ErrSizeMismatch"))
 	p.P(`}`)
-	p.P(``)
-	p.P(`func (m *`, ccTypeName, `) MarshalToVT(dAtA []byte) (int, error) {`)
-	p.P(`size := m.SizeVT()`)
-	p.P(`return m.MarshalToSizedBufferVT(dAtA[:size])`)
+	p.P(`return dAtA, nil`)
 	p.P(`}`)
 	p.P(``)
+	p.marshalTo(ccTypeName)
+	p.marshalAppend(ccTypeName)
+	p.marshalDelimited(ccTypeName)
 	p.P(`func (m *`, ccTypeName, `) MarshalToSizedBufferVT(dAtA []byte) (int, error) {`)
 	p.P(`if m == nil {`)
 	p.P(`return 0, nil`)
@@ -578,7 +647,7 @@ func (p *marshal) message(proto3 bool, message *protogen.Message) {
 	p.P(`var l int`)
 	p.P(`_ = l`)
 	p.P(`if m.unknownFields != nil {`)
-	p.P(`i -= len(m.unknownFields)`)
+	p.reserve(`len(m.unknownFields)`)
 	p.P(`copy(dAtA[i:], m.unknownFields)`)
 	p.P(`}`)
 
@@ -614,9 +683,12 @@ func (p *marshal) message(proto3 bool, message *protogen.Message) {
 		field := message.Fields[i]
 		oneof := field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
 		if !oneof {
-			p.field(proto3, false, &numGen, field)
+			p.field(false, &numGen, field, "m."+field.GoName)
 		}
 	}
+	if p.IsExtendable(message) {
+		p.extensions(&numGen, message)
+	}
 	p.P(`return len(dAtA) - i, nil`)
 	p.P(`}`)
 	p.P()
@@ -627,19 +699,132 @@ func (p *marshal) message(proto3 bool, message *protogen.Message) {
 			continue
 		}
 		ccTypeName := field.GoIdent
-		p.P(`func (m *`, ccTypeName, `) MarshalToVT(dAtA []byte) (int, error) {`)
-		p.P(`size := m.SizeVT()`)
-		p.P(`return m.MarshalToSizedBufferVT(dAtA[:size])`)
-		p.P(`}`)
-		p.P(``)
+		p.marshalTo(ccTypeName)
+		p.marshalAppend(ccTypeName)
 		p.P(`func (m *`, ccTypeName, `) MarshalToSizedBufferVT(dAtA []byte) (int, error) {`)
 		p.P(`i := len(dAtA)`)
-		p.field(proto3, true, &numGen, field)
+		p.field(true, &numGen, field, "m."+field.GoName)
 		p.P(`return len(dAtA) - i, nil`)
 		p.P(`}`)
 	}
 }
 
+// marshalTo generates the MarshalToVT method, which encodes the message at the start
+// of dAtA, or fails if dAtA is too small to hold it.
+func (p *marshal) marshalTo(ccTypeName protogen.GoIdent) {
+	p.P(`func (m *`, ccTypeName, `) MarshalToVT(dAtA []byte) (int, error) {`)
+	p.P(`size := m.SizeVT()`)
+	p.P(`if len(dAtA) < size {`)
+	p.P(`return 0, `, p.Helper("ErrBufferTooSmall"))
+	p.P(`}`)
+	p.P(`n, err := m.MarshalToSizedBufferVT(dAtA[:size])`)
+	p.P(`if err != nil {`)
+	p.P(`return 0, err`)
+	p.P(`}`)
+	p.P(`if n != size {`)
+	p.P(`return 0, `, p.Helper("ErrSizeMismatch"))
+	p.P(`}`)
+	p.P(`return n, nil`)
+	p.P(`}`)
+	p.P(``)
+}
+
+// marshalAppend generates the MarshalAppendVT method, which encodes the message after
+// the contents of dAtA, growing it at most once.
+func (p *marshal) marshalAppend(ccTypeName protogen.GoIdent) {
+	p.P(`func (m *`, ccTypeName, `) MarshalAppendVT(dAtA []byte) ([]byte, error) {`)
+	p.P(`if m == nil {`)
+	p.P(`return dAtA, nil`)
+	p.P(`}`)
+	p.P(`l := len(dAtA)`)
+	p.P(`size := m.SizeVT()`)
+	p.P(`dAtA = append(dAtA, make([]byte, size)...)`)
+	p.P(`n, err := m.MarshalToSizedBufferVT(dAtA[l:])`)
+	p.P(`if err != nil {`)
+	p.P(`return dAtA[:l], err`)
+	p.P(`}`)
+	p.P(`if n != size {`)
+	p.P(`return dAtA[:l], `, p.Helper("ErrSizeMismatch"))
+	p.P(`}`)
+	p.P(`return dAtA, nil`)
+	p.P(`}`)
+	p.P(``)
+}
+
+// marshalDelimited generates the MarshalDelimitedVT method, which encodes the message
+// as a varint size-delimited record, in the format of protodelim.
+func (p *marshal) marshalDelimited(ccTypeName protogen.GoIdent) {
+	p.P(`func (m *`, ccTypeName, `) MarshalDelimitedVT() (dAtA []byte, err error) {`)
+	p.P(`size := m.SizeVT()`)
+	p.P(`l := `, p.Helper("sov"), `(uint64(size))`)
+	p.P(`dAtA = make([]byte, l+size)`)
+	p.P(p.Helper("encodeVarint"), `(dAtA, l, uint64(size))`)
+	p.P(`n, err := m.MarshalToSizedBufferVT(dAtA[l:])`)
+	p.P(`if err != nil {`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	p.P(`if n != size {`)
+	p.P(`return nil, `, p.Helper("ErrSizeMismatch"))
+	p.P(`}`)
+	p.P(`return dAtA, nil`)
+	p.P(`}`)
+	p.P(``)
+}
+
+// marshalPooled generates the MarshalVTPooled method, which encodes the message into
+// a buffer taken from the pool of the vtproto package.
+func (p *marshal) marshalPooled(ccTypeName protogen.GoIdent) {
+	p.P(`func (m *`, ccTypeName, `) MarshalVTPooled() (*`, p.Ident(generator.VTProtoPkg, "Buffer"), `, error) {`)
+	p.P(`size := m.SizeVT()`)
+	p.P(`buf := `, p.Ident(generator.VTProtoPkg, "NewBuffer"), `(size)`)
+	p.P(`n, err := m.MarshalToSizedBufferVT(buf.Bytes())`)
+	p.P(`if err == nil && n != size {`)
+	p.P(`err = `, p.Helper("ErrSizeMismatch"))
+	p.P(`}`)
+	p.P(`if err != nil {`)
+	p.P(`buf.Free()`)
+	p.P(`return nil, err`)
+	p.P(`}`)
+	p.P(`return buf, nil`)
+	p.P(`}`)
+	p.P(``)
+}
+
+// extensions generates the code that marshals the extension fields of the message. Like
+// proto.Marshal, extensions are marshaled before all other fields and sorted by field number.
+// The extensions known at generation time are marshaled inline, all others with protohelpers.
+func (p *marshal) extensions(numGen *counter, message *protogen.Message) {
+	p.P(`if len(m.extensionFields) > 0 {`)
+	p.P(`extensionNums := make([]int32, 0, len(m.extensionFields))`)
+	p.P(`for num := range m.extensionFields {`)
+	p.P(`extensionNums = append(extensionNums, num)`)
+	p.P(`}`)
+	p.P(p.Ident("sort", "Slice"), `(extensionNums, func(i, j int) bool {`)
+	p.P(`return extensionNums[i] > extensionNums[j]`)
+	p.P(`})`)
+	p.P(`for _, num := range extensionNums {`)
+	known := p.KnownExtensions(message)
+	if len(known) > 0 {
+		p.P(`switch num {`)
+		for _, ext := range known {
+			p.P(`case `, strconv.Itoa(int(ext.Desc.Number())), `:`)
+			p.P(`v := `, p.ExtensionValue("m", ext))
+			p.field(true, numGen, ext, "v")
+		}
+		p.P(`default:`)
+	}
+	p.P(`x := m.extensionFields[num]`)
+	p.reserve(p.Ident(generator.ProtoHelpersPkg, "SizeOfExtension"), `(x.Type(), x.Value())`)
+	p.P(`if _, err := `, p.Ident(generator.ProtoHelpersPkg, "AppendExtension"), `(dAtA[:i], x.Type(), x.Value()); err != nil {`)
+	p.P(`return 0, err`)
+	p.P(`}`)
+	if len(known) > 0 {
+		p.P(`}`)
+	}
+	p.P(`}`)
+	p.P(`}`)
+}
+
 func (p *marshal) reverseListRange(expression ...string) string {
 	exp := strings.Join(expression, "")
 	p.P(`for iNdEx := len(`, exp, `) - 1; iNdEx >= 0; iNdEx-- {`)
@@ -668,11 +853,15 @@ func (p *marshal) marshalBackward(varName string, varInt bool, message *protogen
 
 	if !local {
 		p.P(`} else {`)
-		p.P(`encoded, err := `, p.Ident(generator.ProtoPkg, "Marshal"), `(`, varName, `)`)
+		if p.Stable {
+			p.P(`encoded, err := `, p.Ident(generator.ProtoPkg, "MarshalOptions"), `{Deterministic: true}.Marshal(`, varName, `)`)
+		} else {
+			p.P(`encoded, err := `, p.Ident(generator.ProtoPkg, "Marshal"), `(`, varName, `)`)
+		}
 		p.P(`if err != nil {`)
 		p.P(`return 0, err`)
 		p.P(`}`)
-		p.P(`i -= len(encoded)`)
+		p.reserve(`len(encoded)`)
 		p.P(`copy(dAtA[i:], encoded)`)
 		if varInt {
 			p.encodeVarint(`len(encoded)`)
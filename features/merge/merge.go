@@ -0,0 +1,270 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package merge
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/planetscale/vtprotobuf/generator"
+)
+
+const (
+	mergeName        = "MergeVT"
+	mergeGenericName = "MergeGenericVT"
+)
+
+func init() {
+	generator.RegisterFeature("merge", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &merge{GeneratedFile: gen}
+	})
+}
+
+type merge struct {
+	*generator.GeneratedFile
+	once bool
+}
+
+var _ generator.FeatureGenerator = (*merge)(nil)
+
+func (p *merge) GenerateFile(file *protogen.File) bool {
+	for _, message := range file.Messages {
+		p.processMessage(message)
+	}
+
+	return p.once
+}
+
+func (p *merge) GenerateHelpers() {
+}
+
+func (p *merge) processMessage(message *protogen.Message) {
+	for _, nested := range message.Messages {
+		p.processMessage(nested)
+	}
+
+	if message.Desc.IsMapEntry() || !p.ShouldGenerate(message) {
+		return
+	}
+
+	p.once = true
+	ccTypeName := message.GoIdent.GoName
+
+	p.P(`func (m *`, ccTypeName, `) `, mergeName, `(src *`, ccTypeName, `) {`)
+	p.P(`if m == nil || src == nil {`)
+	p.P(`return`)
+	p.P(`}`)
+	oneofs := make(map[*protogen.Oneof]bool)
+	for _, field := range message.Fields {
+		if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
+			if !oneofs[field.Oneof] {
+				oneofs[field.Oneof] = true
+				p.mergeOneof(field.Oneof)
+			}
+			continue
+		}
+		p.mergeField(field)
+	}
+	if p.IsExtendable(message) {
+		p.extensions(message)
+	}
+	p.P(`m.unknownFields = append(m.unknownFields, src.unknownFields...)`)
+	p.P(`}`)
+	p.P()
+
+	p.P(`func (m *`, ccTypeName, `) `, mergeGenericName, `(src `, p.Ident(generator.ProtoPkg, "Message"), `) {`)
+	p.P(`if src, ok := src.(*`, ccTypeName, `); ok {`)
+	p.P(`m.`, mergeName, `(src)`)
+	p.P(`} else {`)
+	p.P(p.Ident(generator.ProtoPkg, "Merge"), `(m, src)`)
+	p.P(`}`)
+	p.P(`}`)
+	p.P()
+}
+
+// mergeMessage generates the code that merges the message src into the non-nil
+// message dst, both of the given type.
+func (p *merge) mergeMessage(dst, src string, message *protogen.Message) {
+	if p.IsLocalMessage(message) {
+		p.P(dst, `.`, mergeName, `(`, src, `)`)
+		return
+	}
+	// dst is a concrete type, we need to first convert it to an interface in order to use an
+	// interface type assertion.
+	p.P(`if vtpb, ok := interface{}(`, dst, `).(interface{ `, mergeName, `(*`, message.GoIdent, `) }); ok {`)
+	p.P(`vtpb.`, mergeName, `(`, src, `)`)
+	p.P(`} else {`)
+	p.P(p.Ident(generator.ProtoPkg, "Merge"), `(`, dst, `, `, src, `)`)
+	p.P(`}`)
+}
+
+// copyValue returns an expression that evaluates to a deep copy of the singular value
+// src of the given kind, generating the statements that build it if needed.
+func (p *merge) copyValue(src string, kind protoreflect.Kind, message *protogen.Message) string {
+	switch kind {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		p.P(`tmpMsg := &`, message.GoIdent, `{}`)
+		p.mergeMessage("tmpMsg", src, message)
+		return "tmpMsg"
+	case protoreflect.BytesKind:
+		return `append([]byte{}, ` + src + `...)`
+	default:
+		return src
+	}
+}
+
+// mergeField generates the code that merges a field that is not part of a oneof.
+func (p *merge) mergeField(field *protogen.Field) {
+	dst := "m." + field.GoName
+	src := "src." + field.GoName
+	kind := field.Desc.Kind()
+
+	switch {
+	case field.Desc.IsMap():
+		goType, _ := p.FieldGoType(field)
+		value := field.Message.Fields[1]
+		p.P(`if len(`, src, `) > 0 {`)
+		p.P(`if `, dst, ` == nil {`)
+		p.P(dst, ` = make(`, goType, `, len(`, src, `))`)
+		p.P(`}`)
+		p.P(`for k, v := range `, src, ` {`)
+		p.P(dst, `[k] = `, p.copyValue("v", value.Desc.Kind(), value.Message))
+		p.P(`}`)
+		p.P(`}`)
+	case field.Desc.IsList():
+		if kind == protoreflect.MessageKind || kind == protoreflect.GroupKind || kind == protoreflect.BytesKind {
+			p.P(`for _, v := range `, src, ` {`)
+			p.P(dst, ` = append(`, dst, `, `, p.copyValue("v", kind, field.Message), `)`)
+			p.P(`}`)
+		} else {
+			p.P(dst, ` = append(`, dst, `, `, src, `...)`)
+		}
+	case kind == protoreflect.MessageKind || kind == protoreflect.GroupKind:
+		p.P(`if `, src, ` != nil {`)
+		p.P(`if `, dst, ` == nil {`)
+		p.P(dst, ` = &`, field.Message.GoIdent, `{}`)
+		p.P(`}`)
+		p.mergeMessage(dst, src, field.Message)
+		p.P(`}`)
+	case field.Desc.HasPresence():
+		// Scalars with presence are pointers, bytes are nil when not present
+		p.P(`if `, src, ` != nil {`)
+		if kind == protoreflect.BytesKind {
+			p.P(dst, ` = `, p.copyValue(src, kind, nil))
+		} else {
+			p.P(`tmpVal := *`, src)
+			p.P(dst, ` = &tmpVal`)
+		}
+		p.P(`}`)
+	case kind == protoreflect.BytesKind:
+		p.P(`if len(`, src, `) > 0 {`)
+		p.P(dst, ` = `, p.copyValue(src, kind, nil))
+		p.P(`}`)
+	case kind == protoreflect.BoolKind:
+		p.P(`if `, src, ` {`)
+		p.P(dst, ` = true`)
+		p.P(`}`)
+	default:
+		p.P(`if `, src, ` != `, zeroValue(kind), ` {`)
+		p.P(dst, ` = `, src)
+		p.P(`}`)
+	}
+}
+
+// mergeOneof generates the code that merges a oneof: a message is merged into the
+// destination if it has the same member set, any other member replaces it.
+func (p *merge) mergeOneof(oneof *protogen.Oneof) {
+	dst := "m." + oneof.GoName
+	src := "src." + oneof.GoName
+
+	p.P(`switch srcOneof := `, src, `.(type) {`)
+	for _, field := range oneof.Fields {
+		p.P(`case *`, field.GoIdent, `:`)
+		kind := field.Desc.Kind()
+		switch kind {
+		case protoreflect.MessageKind, protoreflect.GroupKind:
+			p.P(`if dstOneof, ok := `, dst, `.(*`, field.GoIdent, `); ok && dstOneof.`, field.GoName, ` != nil {`)
+			p.P(`if srcOneof.`, field.GoName, ` != nil {`)
+			p.mergeMessage("dstOneof."+field.GoName, "srcOneof."+field.GoName, field.Message)
+			p.P(`}`)
+			p.P(`} else {`)
+			value := p.copyValue("srcOneof."+field.GoName, kind, field.Message)
+			p.P(dst, ` = &`, field.GoIdent, `{`, field.GoName, `: `, value, `}`)
+			p.P(`}`)
+		case protoreflect.BytesKind:
+			value := p.copyValue("srcOneof."+field.GoName, kind, nil)
+			p.P(dst, ` = &`, field.GoIdent, `{`, field.GoName, `: `, value, `}`)
+		default:
+			p.P(dst, ` = &`, field.GoIdent, `{`, field.GoName, `: srcOneof.`, field.GoName, `}`)
+		}
+	}
+	p.P(`}`)
+}
+
+// extensions generates the code that merges the extension fields of "src" into "m". The
+// extensions known at generation time are merged inline, all others with protohelpers.
+func (p *merge) extensions(message *protogen.Message) {
+	known := p.KnownExtensions(message)
+	if len(known) == 0 {
+		p.P(`for _, x := range src.extensionFields {`)
+	} else {
+		p.P(`for num, x := range src.extensionFields {`)
+		p.P(`switch num {`)
+		for _, ext := range known {
+			p.P(`case `, strconv.Itoa(int(ext.Desc.Number())), `:`)
+			p.mergeExtension(ext)
+		}
+		p.P(`default:`)
+	}
+	p.P(p.Ident(generator.ProtoHelpersPkg, "MergeExtension"), `(m.ProtoReflect(), x.Type(), x.Value())`)
+	if len(known) > 0 {
+		p.P(`}`)
+	}
+	p.P(`}`)
+}
+
+// mergeExtension generates the statements for merging an extension known at generation time.
+func (p *merge) mergeExtension(ext *protogen.Extension) {
+	kind := ext.Desc.Kind()
+	xt := p.ExtensionType(ext)
+	setExtension := p.Ident(generator.ProtoPkg, "SetExtension")
+
+	p.P(`rhs := `, p.ExtensionValue("src", ext))
+	switch {
+	case ext.Desc.IsList():
+		p.P(`lhs := `, p.ExtensionValue("m", ext))
+		if kind == protoreflect.MessageKind || kind == protoreflect.BytesKind {
+			p.P(`for _, v := range rhs {`)
+			p.P(`lhs = append(lhs, `, p.copyValue("v", kind, ext.Message), `)`)
+			p.P(`}`)
+		} else {
+			p.P(`lhs = append(lhs, rhs...)`)
+		}
+		p.P(setExtension, `(m, `, xt, `, lhs)`)
+	case kind == protoreflect.MessageKind:
+		p.P(`lhs := `, p.ExtensionValue("m", ext))
+		p.P(`if lhs == nil {`)
+		p.P(`lhs = &`, ext.Message.GoIdent, `{}`)
+		p.P(`}`)
+		p.mergeMessage("lhs", "rhs", ext.Message)
+		p.P(setExtension, `(m, `, xt, `, lhs)`)
+	case kind == protoreflect.BytesKind:
+		p.P(setExtension, `(m, `, xt, `, `, p.copyValue("rhs", kind, nil), `)`)
+	default:
+		p.P(setExtension, `(m, `, xt, `, rhs)`)
+	}
+}
+
+// zeroValue returns the Go zero value of a numeric or string field of the given kind.
+func zeroValue(kind protoreflect.Kind) string {
+	switch kind {
+	case protoreflect.StringKind:
+		return `""`
+	default:
+		return "0"
+	}
+}
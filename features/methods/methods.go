@@ -0,0 +1,63 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package methods
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/planetscale/vtprotobuf/generator"
+)
+
+func init() {
+	generator.RegisterOptInFeature("methods", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &methods{GeneratedFile: gen}
+	}, "marshal", "size", "unmarshal", "merge")
+}
+
+// methods generates an init function registering the generated marshal, size,
+// unmarshal and merge methods of the messages as their protoiface.Methods, so that
+// the proto package uses them.
+type methods struct {
+	*generator.GeneratedFile
+	messages []*protogen.Message
+}
+
+var _ generator.FeatureGenerator = (*methods)(nil)
+
+func (p *methods) GenerateFile(file *protogen.File) bool {
+	for _, message := range file.Messages {
+		p.processMessage(message)
+	}
+	if len(p.messages) == 0 {
+		return false
+	}
+
+	// the init functions of a package run in the order of its file names, so the
+	// messages have been registered by the .pb.go file at this point
+	p.P(`func init() {`)
+	for _, message := range p.messages {
+		deterministic := p.ShouldMarshalDeterministic(message)
+		p.P(p.Ident(generator.VTProtoPkg, "RegisterMethods"), `((*`, message.GoIdent, `)(nil), `, strconv.FormatBool(deterministic), `)`)
+	}
+	p.P(`}`)
+	p.P()
+	return true
+}
+
+func (p *methods) GenerateHelpers() {
+}
+
+func (p *methods) processMessage(message *protogen.Message) {
+	for _, nested := range message.Messages {
+		p.processMessage(nested)
+	}
+
+	if message.Desc.IsMapEntry() || !p.ShouldGenerate(message) {
+		return
+	}
+	p.messages = append(p.messages, message)
+}
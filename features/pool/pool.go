@@ -72,8 +72,11 @@ func (p *pool) message(message *protogen.Message) {
 					p.P(`m.`, fieldName, `.ReturnToVTPool()`)
 				}
 			case protoreflect.BytesKind:
-				p.P(fmt.Sprintf("f%d", len(saved)), ` := m.`, fieldName, `[:0]`)
-				saved = append(saved, field)
+				// A non-nil slice would mark fields with explicit presence as set
+				if !field.Desc.HasPresence() {
+					p.P(fmt.Sprintf("f%d", len(saved)), ` := m.`, fieldName, `[:0]`)
+					saved = append(saved, field)
+				}
 			}
 		}
 	}
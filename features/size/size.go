@@ -33,15 +33,17 @@ func (p *size) Name() string {
 }
 
 func (p *size) GenerateFile(file *protogen.File) bool {
-	proto3 := file.Desc.Syntax() == protoreflect.Proto3
 	for _, message := range file.Messages {
-		p.message(proto3, message)
+		p.message(message)
 	}
 
 	return p.once
 }
 
 func (p *size) GenerateHelpers() {
+	if p.Ext.SharedHelpers {
+		return
+	}
 	p.P(`
 	func sov(x uint64) (n int) {
                 return (`, p.Ident("math/bits", "Len64"), `(x | 1) + 6)/ 7
@@ -67,14 +69,13 @@ func (p *size) messageSize(varName, sizeName string, message *protogen.Message)
 	}
 }
 
-func (p *size) field(proto3, oneof bool, field *protogen.Field, sizeName string) {
-	fieldname := field.GoName
-	nullable := field.Message != nil || (field.Oneof != nil && field.Oneof.Desc.IsSynthetic()) || (!proto3 && !oneof)
+func (p *size) field(oneof bool, field *protogen.Field, varName, sizeName string) {
+	nullable := field.Message != nil || (!oneof && field.Desc.HasPresence())
 	repeated := field.Desc.Cardinality() == protoreflect.Repeated
 	if repeated {
-		p.P(`if len(m.`, fieldname, `) > 0 {`)
+		p.P(`if len(`, varName, `) > 0 {`)
 	} else if nullable {
-		p.P(`if m.`, fieldname, ` != nil {`)
+		p.P(`if `, varName, ` != nil {`)
 	}
 	packed := field.Desc.IsPacked()
 	wireType := generator.ProtoWireType(field.Desc.Kind())
@@ -86,11 +87,11 @@ func (p *size) field(proto3, oneof bool, field *protogen.Field, sizeName string)
 	switch field.Desc.Kind() {
 	case protoreflect.DoubleKind, protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind:
 		if packed {
-			p.P(`n+=`, strconv.Itoa(key), `+sov(uint64(len(m.`, fieldname, `)*8))`, `+len(m.`, fieldname, `)*8`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("sov"), `(uint64(len(`, varName, `)*8))`, `+len(`, varName, `)*8`)
 		} else if repeated {
-			p.P(`n+=`, strconv.Itoa(key+8), `*len(m.`, fieldname, `)`)
+			p.P(`n+=`, strconv.Itoa(key+8), `*len(`, varName, `)`)
 		} else if !oneof && !nullable {
-			p.P(`if m.`, fieldname, ` != 0 {`)
+			p.P(`if `, varName, ` != 0 {`)
 			p.P(`n+=`, strconv.Itoa(key+8))
 			p.P(`}`)
 		} else {
@@ -98,11 +99,11 @@ func (p *size) field(proto3, oneof bool, field *protogen.Field, sizeName string)
 		}
 	case protoreflect.FloatKind, protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind:
 		if packed {
-			p.P(`n+=`, strconv.Itoa(key), `+sov(uint64(len(m.`, fieldname, `)*4))`, `+len(m.`, fieldname, `)*4`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("sov"), `(uint64(len(`, varName, `)*4))`, `+len(`, varName, `)*4`)
 		} else if repeated {
-			p.P(`n+=`, strconv.Itoa(key+4), `*len(m.`, fieldname, `)`)
+			p.P(`n+=`, strconv.Itoa(key+4), `*len(`, varName, `)`)
 		} else if !oneof && !nullable {
-			p.P(`if m.`, fieldname, ` != 0 {`)
+			p.P(`if `, varName, ` != 0 {`)
 			p.P(`n+=`, strconv.Itoa(key+4))
 			p.P(`}`)
 		} else {
@@ -111,30 +112,30 @@ func (p *size) field(proto3, oneof bool, field *protogen.Field, sizeName string)
 	case protoreflect.Int64Kind, protoreflect.Uint64Kind, protoreflect.Uint32Kind, protoreflect.EnumKind, protoreflect.Int32Kind:
 		if packed {
 			p.P(`l = 0`)
-			p.P(`for _, e := range m.`, fieldname, ` {`)
-			p.P(`l+=sov(uint64(e))`)
+			p.P(`for _, e := range `, varName, ` {`)
+			p.P(`l+=`, p.Helper("sov"), `(uint64(e))`)
 			p.P(`}`)
-			p.P(`n+=`, strconv.Itoa(key), `+sov(uint64(l))+l`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("sov"), `(uint64(l))+l`)
 		} else if repeated {
-			p.P(`for _, e := range m.`, fieldname, ` {`)
-			p.P(`n+=`, strconv.Itoa(key), `+sov(uint64(e))`)
+			p.P(`for _, e := range `, varName, ` {`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("sov"), `(uint64(e))`)
 			p.P(`}`)
 		} else if nullable {
-			p.P(`n+=`, strconv.Itoa(key), `+sov(uint64(*m.`, fieldname, `))`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("sov"), `(uint64(*`, varName, `))`)
 		} else if !oneof {
-			p.P(`if m.`, fieldname, ` != 0 {`)
-			p.P(`n+=`, strconv.Itoa(key), `+sov(uint64(m.`, fieldname, `))`)
+			p.P(`if `, varName, ` != 0 {`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("sov"), `(uint64(`, varName, `))`)
 			p.P(`}`)
 		} else {
-			p.P(`n+=`, strconv.Itoa(key), `+sov(uint64(m.`, fieldname, `))`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("sov"), `(uint64(`, varName, `))`)
 		}
 	case protoreflect.BoolKind:
 		if packed {
-			p.P(`n+=`, strconv.Itoa(key), `+sov(uint64(len(m.`, fieldname, `)))`, `+len(m.`, fieldname, `)*1`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("sov"), `(uint64(len(`, varName, `)))`, `+len(`, varName, `)*1`)
 		} else if repeated {
-			p.P(`n+=`, strconv.Itoa(key+1), `*len(m.`, fieldname, `)`)
+			p.P(`n+=`, strconv.Itoa(key+1), `*len(`, varName, `)`)
 		} else if !oneof && !nullable {
-			p.P(`if m.`, fieldname, ` {`)
+			p.P(`if `, varName, ` {`)
 			p.P(`n+=`, strconv.Itoa(key+1))
 			p.P(`}`)
 		} else {
@@ -142,31 +143,38 @@ func (p *size) field(proto3, oneof bool, field *protogen.Field, sizeName string)
 		}
 	case protoreflect.StringKind:
 		if repeated {
-			p.P(`for _, s := range m.`, fieldname, ` { `)
+			p.P(`for _, s := range `, varName, ` { `)
 			p.P(`l = len(s)`)
-			p.P(`n+=`, strconv.Itoa(key), `+l+sov(uint64(l))`)
+			p.P(`n+=`, strconv.Itoa(key), `+l+`, p.Helper("sov"), `(uint64(l))`)
 			p.P(`}`)
 		} else if nullable {
-			p.P(`l=len(*m.`, fieldname, `)`)
-			p.P(`n+=`, strconv.Itoa(key), `+l+sov(uint64(l))`)
+			p.P(`l=len(*`, varName, `)`)
+			p.P(`n+=`, strconv.Itoa(key), `+l+`, p.Helper("sov"), `(uint64(l))`)
 		} else if !oneof {
-			p.P(`l=len(m.`, fieldname, `)`)
+			p.P(`l=len(`, varName, `)`)
 			p.P(`if l > 0 {`)
-			p.P(`n+=`, strconv.Itoa(key), `+l+sov(uint64(l))`)
+			p.P(`n+=`, strconv.Itoa(key), `+l+`, p.Helper("sov"), `(uint64(l))`)
 			p.P(`}`)
 		} else {
-			p.P(`l=len(m.`, fieldname, `)`)
-			p.P(`n+=`, strconv.Itoa(key), `+l+sov(uint64(l))`)
+			p.P(`l=len(`, varName, `)`)
+			p.P(`n+=`, strconv.Itoa(key), `+l+`, p.Helper("sov"), `(uint64(l))`)
 		}
 	case protoreflect.GroupKind:
-		p.messageSize("m."+fieldname, sizeName, field.Message)
-		p.P(`n+=l+`, strconv.Itoa(2*key))
+		if repeated {
+			p.P(`for _, e := range `, varName, ` { `)
+			p.messageSize("e", sizeName, field.Message)
+			p.P(`n+=l+`, strconv.Itoa(2*key))
+			p.P(`}`)
+		} else {
+			p.messageSize(varName, sizeName, field.Message)
+			p.P(`n+=l+`, strconv.Itoa(2*key))
+		}
 	case protoreflect.MessageKind:
 		if field.Desc.IsMap() {
 			fieldKeySize := generator.KeySize(field.Desc.Number(), generator.ProtoWireType(field.Desc.Kind()))
 			keyKeySize := generator.KeySize(1, generator.ProtoWireType(field.Message.Fields[0].Desc.Kind()))
 			valueKeySize := generator.KeySize(2, generator.ProtoWireType(field.Message.Fields[1].Desc.Kind()))
-			p.P(`for k, v := range m.`, fieldname, ` { `)
+			p.P(`for k, v := range `, varName, ` { `)
 			p.P(`_ = k`)
 			p.P(`_ = v`)
 			sum := []string{strconv.Itoa(keyKeySize)}
@@ -177,13 +185,13 @@ func (p *size) field(proto3, oneof bool, field *protogen.Field, sizeName string)
 			case protoreflect.FloatKind, protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind:
 				sum = append(sum, `4`)
 			case protoreflect.Int64Kind, protoreflect.Uint64Kind, protoreflect.Uint32Kind, protoreflect.EnumKind, protoreflect.Int32Kind:
-				sum = append(sum, `sov(uint64(k))`)
+				sum = append(sum, p.Helper("sov")+`(uint64(k))`)
 			case protoreflect.BoolKind:
 				sum = append(sum, `1`)
 			case protoreflect.StringKind, protoreflect.BytesKind:
-				sum = append(sum, `len(k)+sov(uint64(len(k)))`)
+				sum = append(sum, `len(k)+`+p.Helper("sov")+`(uint64(len(k)))`)
 			case protoreflect.Sint32Kind, protoreflect.Sint64Kind:
-				sum = append(sum, `soz(uint64(k))`)
+				sum = append(sum, p.Helper("soz")+`(uint64(k))`)
 			}
 
 			switch field.Message.Fields[1].Desc.Kind() {
@@ -195,73 +203,73 @@ func (p *size) field(proto3, oneof bool, field *protogen.Field, sizeName string)
 				sum = append(sum, strconv.Itoa(4))
 			case protoreflect.Int64Kind, protoreflect.Uint64Kind, protoreflect.Uint32Kind, protoreflect.EnumKind, protoreflect.Int32Kind:
 				sum = append(sum, strconv.Itoa(valueKeySize))
-				sum = append(sum, `sov(uint64(v))`)
+				sum = append(sum, p.Helper("sov")+`(uint64(v))`)
 			case protoreflect.BoolKind:
 				sum = append(sum, strconv.Itoa(valueKeySize))
 				sum = append(sum, `1`)
 			case protoreflect.StringKind:
 				sum = append(sum, strconv.Itoa(valueKeySize))
-				sum = append(sum, `len(v)+sov(uint64(len(v)))`)
+				sum = append(sum, `len(v)+`+p.Helper("sov")+`(uint64(len(v)))`)
 			case protoreflect.BytesKind:
-				p.P(`l = `, strconv.Itoa(valueKeySize), ` + len(v)+sov(uint64(len(v)))`)
+				p.P(`l = `, strconv.Itoa(valueKeySize), ` + len(v)+`, p.Helper("sov"), `(uint64(len(v)))`)
 				sum = append(sum, `l`)
 			case protoreflect.Sint32Kind, protoreflect.Sint64Kind:
 				sum = append(sum, strconv.Itoa(valueKeySize))
-				sum = append(sum, `soz(uint64(v))`)
+				sum = append(sum, p.Helper("soz")+`(uint64(v))`)
 			case protoreflect.MessageKind:
 				p.P(`l = 0`)
 				p.P(`if v != nil {`)
 				p.messageSize("v", sizeName, field.Message.Fields[1].Message)
 				p.P(`}`)
-				p.P(`l += `, strconv.Itoa(valueKeySize), ` + sov(uint64(l))`)
+				p.P(`l += `, strconv.Itoa(valueKeySize), ` + `, p.Helper("sov"), `(uint64(l))`)
 				sum = append(sum, `l`)
 			}
 			p.P(`mapEntrySize := `, strings.Join(sum, "+"))
-			p.P(`n+=mapEntrySize+`, fieldKeySize, `+sov(uint64(mapEntrySize))`)
+			p.P(`n+=mapEntrySize+`, fieldKeySize, `+`, p.Helper("sov"), `(uint64(mapEntrySize))`)
 			p.P(`}`)
 		} else if field.Desc.IsList() {
-			p.P(`for _, e := range m.`, fieldname, ` { `)
+			p.P(`for _, e := range `, varName, ` { `)
 			p.messageSize("e", sizeName, field.Message)
-			p.P(`n+=`, strconv.Itoa(key), `+l+sov(uint64(l))`)
+			p.P(`n+=`, strconv.Itoa(key), `+l+`, p.Helper("sov"), `(uint64(l))`)
 			p.P(`}`)
 		} else {
-			p.messageSize("m."+fieldname, sizeName, field.Message)
-			p.P(`n+=`, strconv.Itoa(key), `+l+sov(uint64(l))`)
+			p.messageSize(varName, sizeName, field.Message)
+			p.P(`n+=`, strconv.Itoa(key), `+l+`, p.Helper("sov"), `(uint64(l))`)
 		}
 	case protoreflect.BytesKind:
 		if repeated {
-			p.P(`for _, b := range m.`, fieldname, ` { `)
+			p.P(`for _, b := range `, varName, ` { `)
 			p.P(`l = len(b)`)
-			p.P(`n+=`, strconv.Itoa(key), `+l+sov(uint64(l))`)
+			p.P(`n+=`, strconv.Itoa(key), `+l+`, p.Helper("sov"), `(uint64(l))`)
 			p.P(`}`)
-		} else if !oneof && proto3 {
-			p.P(`l=len(m.`, fieldname, `)`)
+		} else if !oneof && !nullable {
+			p.P(`l=len(`, varName, `)`)
 			p.P(`if l > 0 {`)
-			p.P(`n+=`, strconv.Itoa(key), `+l+sov(uint64(l))`)
+			p.P(`n+=`, strconv.Itoa(key), `+l+`, p.Helper("sov"), `(uint64(l))`)
 			p.P(`}`)
 		} else {
-			p.P(`l=len(m.`, fieldname, `)`)
-			p.P(`n+=`, strconv.Itoa(key), `+l+sov(uint64(l))`)
+			p.P(`l=len(`, varName, `)`)
+			p.P(`n+=`, strconv.Itoa(key), `+l+`, p.Helper("sov"), `(uint64(l))`)
 		}
 	case protoreflect.Sint32Kind, protoreflect.Sint64Kind:
 		if packed {
 			p.P(`l = 0`)
-			p.P(`for _, e := range m.`, fieldname, ` {`)
-			p.P(`l+=soz(uint64(e))`)
+			p.P(`for _, e := range `, varName, ` {`)
+			p.P(`l+=`, p.Helper("soz"), `(uint64(e))`)
 			p.P(`}`)
-			p.P(`n+=`, strconv.Itoa(key), `+sov(uint64(l))+l`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("sov"), `(uint64(l))+l`)
 		} else if repeated {
-			p.P(`for _, e := range m.`, fieldname, ` {`)
-			p.P(`n+=`, strconv.Itoa(key), `+soz(uint64(e))`)
+			p.P(`for _, e := range `, varName, ` {`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("soz"), `(uint64(e))`)
 			p.P(`}`)
 		} else if nullable {
-			p.P(`n+=`, strconv.Itoa(key), `+soz(uint64(*m.`, fieldname, `))`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("soz"), `(uint64(*`, varName, `))`)
 		} else if !oneof {
-			p.P(`if m.`, fieldname, ` != 0 {`)
-			p.P(`n+=`, strconv.Itoa(key), `+soz(uint64(m.`, fieldname, `))`)
+			p.P(`if `, varName, ` != 0 {`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("soz"), `(uint64(`, varName, `))`)
 			p.P(`}`)
 		} else {
-			p.P(`n+=`, strconv.Itoa(key), `+soz(uint64(m.`, fieldname, `))`)
+			p.P(`n+=`, strconv.Itoa(key), `+`, p.Helper("soz"), `(uint64(`, varName, `))`)
 		}
 	default:
 		panic("not implemented")
@@ -271,12 +279,12 @@ func (p *size) field(proto3, oneof bool, field *protogen.Field, sizeName string)
 	}
 }
 
-func (p *size) message(proto3 bool, message *protogen.Message) {
+func (p *size) message(message *protogen.Message) {
 	for _, nested := range message.Messages {
-		p.message(proto3, nested)
+		p.message(nested)
 	}
 
-	if message.Desc.IsMapEntry() {
+	if message.Desc.IsMapEntry() || !p.ShouldGenerate(message) {
 		return
 	}
 
@@ -295,7 +303,7 @@ func (p *size) message(proto3 bool, message *protogen.Message) {
 	for _, field := range message.Fields {
 		oneof := field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
 		if !oneof {
-			p.field(proto3, false, field, sizeName)
+			p.field(false, field, "m."+field.GoName, sizeName)
 		} else {
 			fieldname := field.Oneof.GoName
 			if _, ok := oneofs[fieldname]; !ok {
@@ -306,6 +314,9 @@ func (p *size) message(proto3 bool, message *protogen.Message) {
 			}
 		}
 	}
+	if p.IsExtendable(message) {
+		p.extensions(message, sizeName)
+	}
 	p.P(`n+=len(m.unknownFields)`)
 	p.P(`return n`)
 	p.P(`}`)
@@ -322,8 +333,45 @@ func (p *size) message(proto3 bool, message *protogen.Message) {
 		p.P(`}`)
 		p.P(`var l int`)
 		p.P(`_ = l`)
-		p.field(proto3, true, field, sizeName)
+		p.field(true, field, "m."+field.GoName, sizeName)
 		p.P(`return n`)
 		p.P(`}`)
 	}
 }
+
+// extensions generates the code that sizes the extension fields of the message. The
+// extensions known at generation time are sized inline, all others with protohelpers.
+func (p *size) extensions(message *protogen.Message, sizeName string) {
+	known := p.KnownExtensions(message)
+	if len(known) == 0 {
+		p.P(`for _, x := range m.extensionFields {`)
+	} else {
+		p.P(`for num, x := range m.extensionFields {`)
+		p.P(`switch num {`)
+		for _, ext := range known {
+			p.P(`case `, strconv.Itoa(int(ext.Desc.Number())), `:`)
+			if ext.Desc.IsList() || !isFixedSize(ext.Desc.Kind()) {
+				p.P(`v := `, p.ExtensionValue("m", ext))
+			}
+			p.field(true, ext, "v", sizeName)
+		}
+		p.P(`default:`)
+	}
+	p.P(`n+=`, p.Ident(generator.ProtoHelpersPkg, "SizeOfExtension"), `(x.Type(), x.Value())`)
+	if len(known) > 0 {
+		p.P(`}`)
+	}
+	p.P(`}`)
+}
+
+// isFixedSize returns whether a singular field of the given kind always has the same size,
+// in which case its size can be computed without looking at its value.
+func isFixedSize(kind protoreflect.Kind) bool {
+	switch kind {
+	case protoreflect.DoubleKind, protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.FloatKind, protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.BoolKind:
+		return true
+	}
+	return false
+}
@@ -21,25 +21,47 @@ func init() {
 	generator.RegisterFeature("unmarshal", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
 		return &unmarshal{GeneratedFile: gen}
 	})
+	generator.RegisterOptInFeature("unmarshal_unsafe", func(gen *generator.GeneratedFile) generator.FeatureGenerator {
+		return &unmarshal{GeneratedFile: gen, unsafe: true}
+	}, "unmarshal")
 }
 
 type unmarshal struct {
 	*generator.GeneratedFile
-	once bool
+	// unsafe generates UnmarshalVTUnsafe, which aliases the input buffer in the
+	// string and bytes fields instead of copying it.
+	unsafe bool
+	once   bool
 }
 
 var _ generator.FeatureGenerator = (*unmarshal)(nil)
 
 func (p *unmarshal) GenerateFile(file *protogen.File) bool {
-	proto3 := file.Desc.Syntax() == protoreflect.Proto3
 	for _, message := range file.Messages {
-		p.message(proto3, message)
+		p.message(message)
 	}
 
 	return p.once
 }
 
 func (p *unmarshal) GenerateHelpers() {
+	if p.unsafe {
+		// The helpers are generated by the unmarshal feature, which is always
+		// enabled along with unmarshal_unsafe.
+		return
+	}
+	if p.Ext.SharedHelpers {
+		// Keep exporting the errors from the generated package, so that existing
+		// code comparing against them keeps working.
+		p.P(`var (`)
+		p.P(`ErrInvalidLength = `, p.Helper("ErrInvalidLength"))
+		p.P(`ErrIntOverflow = `, p.Helper("ErrIntOverflow"))
+		p.P(`ErrUnexpectedEndOfGroup = `, p.Helper("ErrUnexpectedEndOfGroup"))
+		p.P(`ErrInvalidUTF8 = `, p.Helper("ErrInvalidUTF8"))
+		p.P(`ErrRecursionLimitExceeded = `, p.Helper("ErrRecursionLimitExceeded"))
+		p.P(`)`)
+		return
+	}
 	p.P(`func skip(dAtA []byte) (n int, err error) {
 		l := len(dAtA)
 		iNdEx := 0
@@ -123,36 +145,118 @@ func (p *unmarshal) GenerateHelpers() {
 		ErrInvalidLength = `, p.Ident("fmt", "Errorf"), `("proto: negative length found during unmarshaling")
 		ErrIntOverflow = `, p.Ident("fmt", "Errorf"), `("proto: integer overflow")
 		ErrUnexpectedEndOfGroup = `, p.Ident("fmt", "Errorf"), `("proto: unexpected end of group")
+		ErrInvalidUTF8 = `, p.Ident("fmt", "Errorf"), `("proto: string field contains invalid UTF-8")
+		ErrRecursionLimitExceeded = `, p.Ident("fmt", "Errorf"), `("proto: exceeded maximum recursion depth")
 	)
 	`)
 }
 
+// methodName returns the name of the generated unmarshal method.
+func (p *unmarshal) methodName() string {
+	if p.unsafe {
+		return "UnmarshalVTUnsafe"
+	}
+	return "UnmarshalVT"
+}
+
+// internalMethodName returns the name of the unexported method that implements
+// the unmarshal method, and carries the options and the remaining recursion depth
+// across the local messages.
+func (p *unmarshal) internalMethodName() string {
+	if p.unsafe {
+		return "unmarshalVTUnsafe"
+	}
+	return "unmarshalVT"
+}
+
+// decodeMessage generates the code that decodes buf into the message in varName,
+// passing down the options and the remaining recursion depth of the message being
+// decoded.
 func (p *unmarshal) decodeMessage(varName, buf string, message *protogen.Message) {
 	local := p.IsLocalMessage(message)
 
 	if local {
-		p.P(`if err := `, varName, `.UnmarshalVT(`, buf, `); err != nil {`)
+		p.P(`if err := `, varName, `.`, p.internalMethodName(), `(`, buf, `, opts, depth); err != nil {`)
 		p.P(`return err`)
 		p.P(`}`)
-	} else {
-		p.P(`if unmarshal, ok := interface{}(`, varName, `).(interface{`)
-		p.P(`UnmarshalVT([]byte) error`)
+		return
+	}
+
+	// A zero RecursionLimit selects the default limit
+	p.P(`if depth == 0 {`)
+	p.P(`return `, p.Helper("ErrRecursionLimitExceeded"))
+	p.P(`}`)
+	methods := []string{p.methodName() + "WithOptions"}
+	if p.unsafe {
+		// Messages generated without unmarshal_unsafe can still be decoded
+		// without reflection, at the cost of copying their fields.
+		methods = append(methods, "UnmarshalVTWithOptions")
+	}
+	for i, method := range methods {
+		if i > 0 {
+			p.P(`} else if unmarshal, ok := interface{}(`, varName, `).(interface{`)
+		} else {
+			p.P(`if unmarshal, ok := interface{}(`, varName, `).(interface{`)
+		}
+		p.P(method, `([]byte, `, p.Ident(generator.VTProtoPkg, "UnmarshalOptions"), `) error`)
 		p.P(`}); ok{`)
-		p.P(`if err := unmarshal.UnmarshalVT(`, buf, `); err != nil {`)
-		p.P(`return err`)
-		p.P(`}`)
-		p.P(`} else {`)
-		p.P(`if err := `, p.Ident(generator.ProtoPkg, "Unmarshal"), `(`, buf, `, `, varName, `); err != nil {`)
+		p.P(`if err := unmarshal.`, method, `(`, buf, `, `, p.Ident(generator.VTProtoPkg, "UnmarshalOptions"), `{`)
+		p.P(`Merge: true,`)
+		p.P(`AllowPartial: opts.AllowPartial,`)
+		p.P(`DiscardUnknown: opts.DiscardUnknown,`)
+		p.P(`RecursionLimit: depth,`)
+		p.P(`}); err != nil {`)
 		p.P(`return err`)
 		p.P(`}`)
-		p.P(`}`)
 	}
+	p.P(`} else {`)
+	p.P(`if err := (`, p.Ident(generator.ProtoPkg, "UnmarshalOptions"), `{`)
+	p.P(`Merge: true,`)
+	p.P(`AllowPartial: opts.AllowPartial,`)
+	p.P(`DiscardUnknown: opts.DiscardUnknown,`)
+	p.P(`RecursionLimit: depth,`)
+	p.P(`}).Unmarshal(`, buf, `, `, varName, `); err != nil {`)
+	p.P(`return err`)
+	p.P(`}`)
+	p.P(`}`)
+}
+
+// validateUTF8 generates the code that rejects the string encoded in buf if the
+// given field requires its values to be valid UTF-8.
+func (p *unmarshal) validateUTF8(field *protogen.Field, buf string) {
+	if !p.ShouldValidateUTF8(field) {
+		return
+	}
+	p.P(`if !`, p.Ident("unicode/utf8", "Valid"), `(`, buf, `) {`)
+	p.P(`return `, p.Helper("ErrInvalidUTF8"))
+	p.P(`}`)
+}
+
+// stringValue generates the code that converts the bytes in dAtA[iNdEx:end] to a
+// string and returns the expression holding it. When unsafe, the string points
+// into dAtA instead of holding a copy of it.
+func (p *unmarshal) stringValue(varName, end, length string) string {
+	if !p.unsafe {
+		return `string(dAtA[iNdEx:` + end + `])`
+	}
+	p.P(`var `, varName, ` string`)
+	p.P(`if `, length, ` > 0 {`)
+	p.P(varName, ` = `, p.Ident("unsafe", "String"), `(&dAtA[iNdEx], `, length, `)`)
+	p.P(`}`)
+	return varName
+}
+
+// bytesValue returns the expression that aliases the bytes in dAtA[iNdEx:end].
+// Its capacity is capped to its length, so that appending to the field never
+// overwrites the rest of the input buffer.
+func (p *unmarshal) bytesValue(end string) string {
+	return `dAtA[iNdEx:` + end + `:` + end + `]`
 }
 
 func (p *unmarshal) decodeVarint(varName string, typName string) {
 	p.P(`for shift := uint(0); ; shift += 7 {`)
 	p.P(`if shift >= 64 {`)
-	p.P(`return ErrIntOverflow`)
+	p.P(`return `, p.Helper("ErrIntOverflow"))
 	p.P(`}`)
 	p.P(`if iNdEx >= l {`)
 	p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
@@ -255,26 +359,33 @@ func (p *unmarshal) mapField(varName string, field *protogen.Field) {
 		p.decodeVarint("stringLen"+varName, "uint64")
 		p.P(`intStringLen`, varName, ` := int(stringLen`, varName, `)`)
 		p.P(`if intStringLen`, varName, ` < 0 {`)
-		p.P(`return ErrInvalidLength`)
+		p.P(`return `, p.Helper("ErrInvalidLength"))
 		p.P(`}`)
 		p.P(`postStringIndex`, varName, ` := iNdEx + intStringLen`, varName)
 		p.P(`if postStringIndex`, varName, ` < 0 {`)
-		p.P(`return ErrInvalidLength`)
+		p.P(`return `, p.Helper("ErrInvalidLength"))
 		p.P(`}`)
 		p.P(`if postStringIndex`, varName, ` > l {`)
 		p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
 		p.P(`}`)
-		p.P(varName, ` = `, "string", `(dAtA[iNdEx:postStringIndex`, varName, `])`)
+		p.validateUTF8(field, `dAtA[iNdEx:postStringIndex`+varName+`]`)
+		if p.unsafe {
+			p.P(`if intStringLen`, varName, ` > 0 {`)
+			p.P(varName, ` = `, p.Ident("unsafe", "String"), `(&dAtA[iNdEx], intStringLen`, varName, `)`)
+			p.P(`}`)
+		} else {
+			p.P(varName, ` = `, "string", `(dAtA[iNdEx:postStringIndex`, varName, `])`)
+		}
 		p.P(`iNdEx = postStringIndex`, varName)
 	case protoreflect.MessageKind:
 		p.P(`var mapmsglen int`)
 		p.decodeVarint("mapmsglen", "int")
 		p.P(`if mapmsglen < 0 {`)
-		p.P(`return ErrInvalidLength`)
+		p.P(`return `, p.Helper("ErrInvalidLength"))
 		p.P(`}`)
 		p.P(`postmsgIndex := iNdEx + mapmsglen`)
 		p.P(`if postmsgIndex < 0 {`)
-		p.P(`return ErrInvalidLength`)
+		p.P(`return `, p.Helper("ErrInvalidLength"))
 		p.P(`}`)
 		p.P(`if postmsgIndex > l {`)
 		p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
@@ -288,17 +399,21 @@ func (p *unmarshal) mapField(varName string, field *protogen.Field) {
 		p.decodeVarint("mapbyteLen", "uint64")
 		p.P(`intMapbyteLen := int(mapbyteLen)`)
 		p.P(`if intMapbyteLen < 0 {`)
-		p.P(`return ErrInvalidLength`)
+		p.P(`return `, p.Helper("ErrInvalidLength"))
 		p.P(`}`)
 		p.P(`postbytesIndex := iNdEx + intMapbyteLen`)
 		p.P(`if postbytesIndex < 0 {`)
-		p.P(`return ErrInvalidLength`)
+		p.P(`return `, p.Helper("ErrInvalidLength"))
 		p.P(`}`)
 		p.P(`if postbytesIndex > l {`)
 		p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
 		p.P(`}`)
-		p.P(varName, ` = make([]byte, mapbyteLen)`)
-		p.P(`copy(`, varName, `, dAtA[iNdEx:postbytesIndex])`)
+		if p.unsafe {
+			p.P(varName, ` = `, p.bytesValue("postbytesIndex"))
+		} else {
+			p.P(varName, ` = make([]byte, mapbyteLen)`)
+			p.P(`copy(`, varName, `, dAtA[iNdEx:postbytesIndex])`)
+		}
 		p.P(`iNdEx = postbytesIndex`)
 	case protoreflect.Uint32Kind:
 		p.decodeVarint(varName, "uint32")
@@ -333,215 +448,215 @@ func (p *unmarshal) noStarOrSliceType(field *protogen.Field) string {
 	return typ
 }
 
-func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *protogen.Message, proto3 bool) {
+func (p *unmarshal) fieldItem(field *protogen.Field, varName string, message *protogen.Message) {
 	repeated := field.Desc.Cardinality() == protoreflect.Repeated
 	typ := p.noStarOrSliceType(field)
 	oneof := field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
-	nullable := field.Oneof != nil && field.Oneof.Desc.IsSynthetic()
+	// Extensions are decoded into a local variable holding their Go value, see extension
+	nullable := field.Desc.HasPresence() && !field.Desc.IsExtension()
 
 	switch field.Desc.Kind() {
 	case protoreflect.DoubleKind:
 		p.P(`var v uint64`)
 		p.decodeFixed64("v", "uint64")
 		if oneof {
-			p.P(`m.`, fieldname, ` = &`, field.GoIdent, `{`, field.GoName, ": ", typ, "(", p.Ident("math", `Float64frombits`), `(v))}`)
+			p.P(varName, ` = &`, field.GoIdent, `{`, field.GoName, ": ", typ, "(", p.Ident("math", `Float64frombits`), `(v))}`)
 		} else if repeated {
 			p.P(`v2 := `, typ, "(", p.Ident("math", "Float64frombits"), `(v))`)
-			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v2)`)
-		} else if proto3 && !nullable {
-			p.P(`m.`, fieldname, ` = `, typ, "(", p.Ident("math", "Float64frombits"), `(v))`)
+			p.P(varName, ` = append(`, varName, `, v2)`)
+		} else if !nullable {
+			p.P(varName, ` = `, typ, "(", p.Ident("math", "Float64frombits"), `(v))`)
 		} else {
 			p.P(`v2 := `, typ, "(", p.Ident("math", "Float64frombits"), `(v))`)
-			p.P(`m.`, fieldname, ` = &v2`)
+			p.P(varName, ` = &v2`)
 		}
 	case protoreflect.FloatKind:
 		p.P(`var v uint32`)
 		p.decodeFixed32("v", "uint32")
 		if oneof {
-			p.P(`m.`, fieldname, ` = &`, field.GoIdent, `{`, field.GoName, ": ", typ, "(", p.Ident("math", "Float32frombits"), `(v))}`)
+			p.P(varName, ` = &`, field.GoIdent, `{`, field.GoName, ": ", typ, "(", p.Ident("math", "Float32frombits"), `(v))}`)
 		} else if repeated {
 			p.P(`v2 := `, typ, "(", p.Ident("math", "Float32frombits"), `(v))`)
-			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v2)`)
-		} else if proto3 && !nullable {
-			p.P(`m.`, fieldname, ` = `, typ, "(", p.Ident("math", "Float32frombits"), `(v))`)
+			p.P(varName, ` = append(`, varName, `, v2)`)
+		} else if !nullable {
+			p.P(varName, ` = `, typ, "(", p.Ident("math", "Float32frombits"), `(v))`)
 		} else {
 			p.P(`v2 := `, typ, "(", p.Ident("math", "Float32frombits"), `(v))`)
-			p.P(`m.`, fieldname, ` = &v2`)
+			p.P(varName, ` = &v2`)
 		}
 	case protoreflect.Int64Kind:
 		if oneof {
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
-			p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
+			p.P(varName, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
 		} else if repeated {
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
-			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
-		} else if proto3 && !nullable {
-			p.P(`m.`, fieldname, ` = 0`)
-			p.decodeVarint("m."+fieldname, typ)
+			p.P(varName, ` = append(`, varName, `, v)`)
+		} else if !nullable {
+			p.P(varName, ` = 0`)
+			p.decodeVarint(varName, typ)
 		} else {
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
-			p.P(`m.`, fieldname, ` = &v`)
+			p.P(varName, ` = &v`)
 		}
 	case protoreflect.Uint64Kind:
 		if oneof {
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
-			p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
+			p.P(varName, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
 		} else if repeated {
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
-			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
-		} else if proto3 && !nullable {
-			p.P(`m.`, fieldname, ` = 0`)
-			p.decodeVarint("m."+fieldname, typ)
+			p.P(varName, ` = append(`, varName, `, v)`)
+		} else if !nullable {
+			p.P(varName, ` = 0`)
+			p.decodeVarint(varName, typ)
 		} else {
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
-			p.P(`m.`, fieldname, ` = &v`)
+			p.P(varName, ` = &v`)
 		}
 	case protoreflect.Int32Kind:
 		if oneof {
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
-			p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
+			p.P(varName, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
 		} else if repeated {
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
-			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
-		} else if proto3 && !nullable {
-			p.P(`m.`, fieldname, ` = 0`)
-			p.decodeVarint("m."+fieldname, typ)
+			p.P(varName, ` = append(`, varName, `, v)`)
+		} else if !nullable {
+			p.P(varName, ` = 0`)
+			p.decodeVarint(varName, typ)
 		} else {
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
-			p.P(`m.`, fieldname, ` = &v`)
+			p.P(varName, ` = &v`)
 		}
 	case protoreflect.Fixed64Kind:
 		if oneof {
 			p.P(`var v `, typ)
 			p.decodeFixed64("v", typ)
-			p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
+			p.P(varName, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
 		} else if repeated {
 			p.P(`var v `, typ)
 			p.decodeFixed64("v", typ)
-			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
-		} else if proto3 && !nullable {
-			p.P(`m.`, fieldname, ` = 0`)
-			p.decodeFixed64("m."+fieldname, typ)
+			p.P(varName, ` = append(`, varName, `, v)`)
+		} else if !nullable {
+			p.P(varName, ` = 0`)
+			p.decodeFixed64(varName, typ)
 		} else {
 			p.P(`var v `, typ)
 			p.decodeFixed64("v", typ)
-			p.P(`m.`, fieldname, ` = &v`)
+			p.P(varName, ` = &v`)
 		}
 	case protoreflect.Fixed32Kind:
 		if oneof {
 			p.P(`var v `, typ)
 			p.decodeFixed32("v", typ)
-			p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
+			p.P(varName, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
 		} else if repeated {
 			p.P(`var v `, typ)
 			p.decodeFixed32("v", typ)
-			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
-		} else if proto3 && !nullable {
-			p.P(`m.`, fieldname, ` = 0`)
-			p.decodeFixed32("m."+fieldname, typ)
+			p.P(varName, ` = append(`, varName, `, v)`)
+		} else if !nullable {
+			p.P(varName, ` = 0`)
+			p.decodeFixed32(varName, typ)
 		} else {
 			p.P(`var v `, typ)
 			p.decodeFixed32("v", typ)
-			p.P(`m.`, fieldname, ` = &v`)
+			p.P(varName, ` = &v`)
 		}
 	case protoreflect.BoolKind:
 		p.P(`var v int`)
 		p.decodeVarint("v", "int")
 		if oneof {
 			p.P(`b := `, typ, `(v != 0)`)
-			p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: b}`)
+			p.P(varName, ` = &`, field.GoIdent, "{", field.GoName, `: b}`)
 		} else if repeated {
-			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, typ, `(v != 0))`)
-		} else if proto3 && !nullable {
-			p.P(`m.`, fieldname, ` = `, typ, `(v != 0)`)
+			p.P(varName, ` = append(`, varName, `, `, typ, `(v != 0))`)
+		} else if !nullable {
+			p.P(varName, ` = `, typ, `(v != 0)`)
 		} else {
 			p.P(`b := `, typ, `(v != 0)`)
-			p.P(`m.`, fieldname, ` = &b`)
+			p.P(varName, ` = &b`)
 		}
 	case protoreflect.StringKind:
 		p.P(`var stringLen uint64`)
 		p.decodeVarint("stringLen", "uint64")
 		p.P(`intStringLen := int(stringLen)`)
 		p.P(`if intStringLen < 0 {`)
-		p.P(`return ErrInvalidLength`)
+		p.P(`return `, p.Helper("ErrInvalidLength"))
 		p.P(`}`)
 		p.P(`postIndex := iNdEx + intStringLen`)
 		p.P(`if postIndex < 0 {`)
-		p.P(`return ErrInvalidLength`)
+		p.P(`return `, p.Helper("ErrInvalidLength"))
 		p.P(`}`)
 		p.P(`if postIndex > l {`)
 		p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
 		p.P(`}`)
+		p.validateUTF8(field, `dAtA[iNdEx:postIndex]`)
+		str := p.stringValue("stringValue", "postIndex", "intStringLen")
 		if oneof {
-			p.P(`m.`, fieldname, ` = &`, field.GoIdent, `{`, field.GoName, ": ", typ, `(dAtA[iNdEx:postIndex])}`)
+			p.P(varName, ` = &`, field.GoIdent, `{`, field.GoName, ": ", typ, `(`, str, `)}`)
 		} else if repeated {
-			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, typ, `(dAtA[iNdEx:postIndex]))`)
-		} else if proto3 && !nullable {
-			p.P(`m.`, fieldname, ` = `, typ, `(dAtA[iNdEx:postIndex])`)
+			p.P(varName, ` = append(`, varName, `, `, typ, `(`, str, `))`)
+		} else if !nullable {
+			p.P(varName, ` = `, typ, `(`, str, `)`)
 		} else {
-			p.P(`s := `, typ, `(dAtA[iNdEx:postIndex])`)
-			p.P(`m.`, fieldname, ` = &s`)
+			p.P(`s := `, typ, `(`, str, `)`)
+			p.P(varName, ` = &s`)
 		}
 		p.P(`iNdEx = postIndex`)
 	case protoreflect.GroupKind:
 		p.P(`groupStart := iNdEx`)
+		p.P(`var groupEnd int`)
 		p.P(`for {`)
-		p.P(`maybeGroupEnd := iNdEx`)
+		p.P(`groupEnd = iNdEx`)
 		p.P(`var groupFieldWire uint64`)
 		p.decodeVarint("groupFieldWire", "uint64")
-		p.P(`groupWireType := int(wire & 0x7)`)
-		p.P(`if groupWireType == `, strconv.Itoa(int(protowire.EndGroupType)), `{`)
-		p.decodeMessage("m."+fieldname, "dAtA[groupStart:maybeGroupEnd]", field.Message)
+		p.P(`if groupFieldWire&0x7 == `, strconv.Itoa(int(protowire.EndGroupType)), `{`)
+		p.P(`if int32(groupFieldWire>>3) != `, strconv.Itoa(int(field.Desc.Number())), `{`)
+		p.P(`return `, p.Ident("fmt", "Errorf"), `("proto: mismatched end group for field `, field.GoName, `")`)
+		p.P(`}`)
 		p.P(`break`)
 		p.P(`}`)
-		p.P(`skippy, err := skip(dAtA[iNdEx:])`)
+		p.P(`iNdEx = groupEnd`)
+		p.P(`skippy, err := `, p.Helper("skip"), `(dAtA[iNdEx:])`)
 		p.P(`if err != nil {`)
 		p.P(`return err`)
 		p.P(`}`)
 		p.P(`if (skippy < 0) || (iNdEx + skippy) < 0 {`)
-		p.P(`return ErrInvalidLength`)
+		p.P(`return `, p.Helper("ErrInvalidLength"))
+		p.P(`}`)
+		p.P(`if (iNdEx + skippy) > l {`)
+		p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
 		p.P(`}`)
 		p.P(`iNdEx += skippy`)
 		p.P(`}`)
+		p.messageItem(field, varName, `dAtA[groupStart:groupEnd]`, message)
 	case protoreflect.MessageKind:
 		p.P(`var msglen int`)
 		p.decodeVarint("msglen", "int")
 		p.P(`if msglen < 0 {`)
-		p.P(`return ErrInvalidLength`)
+		p.P(`return `, p.Helper("ErrInvalidLength"))
 		p.P(`}`)
 		p.P(`postIndex := iNdEx + msglen`)
 		p.P(`if postIndex < 0 {`)
-		p.P(`return ErrInvalidLength`)
+		p.P(`return `, p.Helper("ErrInvalidLength"))
 		p.P(`}`)
 		p.P(`if postIndex > l {`)
 		p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
 		p.P(`}`)
-		if oneof {
-			buf := `dAtA[iNdEx:postIndex]`
-			msgname := p.noStarOrSliceType(field)
-			p.P(`if oneof, ok := m.`, fieldname, `.(*`, field.GoIdent, `); ok {`)
-			p.decodeMessage("oneof."+field.GoName, buf, field.Message)
-			p.P(`} else {`)
-			p.P(`v := &`, msgname, `{}`)
-			p.decodeMessage("v", buf, field.Message)
-			p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
-			p.P(`}`)
-		} else if field.Desc.IsMap() {
+		if field.Desc.IsMap() {
 			goTyp, _ := p.FieldGoType(field)
 			goTypK, _ := p.FieldGoType(field.Message.Fields[0])
 			goTypV, _ := p.FieldGoType(field.Message.Fields[1])
 
-			p.P(`if m.`, fieldname, ` == nil {`)
-			p.P(`m.`, fieldname, ` = make(`, goTyp, `)`)
+			p.P(`if `, varName, ` == nil {`)
+			p.P(varName, ` = make(`, goTyp, `)`)
 			p.P(`}`)
 
 			p.P("var mapkey ", goTypK)
@@ -559,12 +674,12 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 			p.mapField("mapvalue", field.Message.Fields[1])
 			p.P(`} else {`)
 			p.P(`iNdEx = entryPreIndex`)
-			p.P(`skippy, err := skip(dAtA[iNdEx:])`)
+			p.P(`skippy, err := `, p.Helper("skip"), `(dAtA[iNdEx:])`)
 			p.P(`if err != nil {`)
 			p.P(`return err`)
 			p.P(`}`)
 			p.P(`if (skippy < 0) || (iNdEx + skippy) < 0 {`)
-			p.P(`return ErrInvalidLength`)
+			p.P(`return `, p.Helper("ErrInvalidLength"))
 			p.P(`}`)
 			p.P(`if (iNdEx + skippy) > postIndex {`)
 			p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
@@ -572,32 +687,9 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 			p.P(`iNdEx += skippy`)
 			p.P(`}`)
 			p.P(`}`)
-			p.P(`m.`, fieldname, `[mapkey] = mapvalue`)
-		} else if repeated {
-			if p.ShouldPool(message) {
-				p.P(`if len(m.`, fieldname, `) == cap(m.`, fieldname, `) {`)
-				p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, &`, field.Message.GoIdent, `{})`)
-				p.P(`} else {`)
-				p.P(`m.`, fieldname, ` = m.`, fieldname, `[:len(m.`, fieldname, `) + 1]`)
-				p.P(`if m.`, fieldname, `[len(m.`, fieldname, `) - 1] == nil {`)
-				p.P(`m.`, fieldname, `[len(m.`, fieldname, `) - 1] = &`, field.Message.GoIdent, `{}`)
-				p.P(`}`)
-				p.P(`}`)
-			} else {
-				p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, &`, field.Message.GoIdent, `{})`)
-			}
-			varname := fmt.Sprintf("m.%s[len(m.%s) - 1]", fieldname, fieldname)
-			buf := `dAtA[iNdEx:postIndex]`
-			p.decodeMessage(varname, buf, field.Message)
+			p.P(varName, `[mapkey] = mapvalue`)
 		} else {
-			p.P(`if m.`, fieldname, ` == nil {`)
-			if p.ShouldPool(message) && p.ShouldPool(field.Message) {
-				p.P(`m.`, fieldname, ` = `, field.Message.GoIdent, `FromVTPool()`)
-			} else {
-				p.P(`m.`, fieldname, ` = &`, field.Message.GoIdent, `{}`)
-			}
-			p.P(`}`)
-			p.decodeMessage("m."+fieldname, "dAtA[iNdEx:postIndex]", field.Message)
+			p.messageItem(field, varName, `dAtA[iNdEx:postIndex]`, message)
 		}
 		p.P(`iNdEx = postIndex`)
 
@@ -605,26 +697,34 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 		p.P(`var byteLen int`)
 		p.decodeVarint("byteLen", "int")
 		p.P(`if byteLen < 0 {`)
-		p.P(`return ErrInvalidLength`)
+		p.P(`return `, p.Helper("ErrInvalidLength"))
 		p.P(`}`)
 		p.P(`postIndex := iNdEx + byteLen`)
 		p.P(`if postIndex < 0 {`)
-		p.P(`return ErrInvalidLength`)
+		p.P(`return `, p.Helper("ErrInvalidLength"))
 		p.P(`}`)
 		p.P(`if postIndex > l {`)
 		p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
 		p.P(`}`)
-		if oneof {
+		if p.unsafe {
+			if oneof {
+				p.P(varName, ` = &`, field.GoIdent, "{", field.GoName, `: `, p.bytesValue("postIndex"), `}`)
+			} else if repeated {
+				p.P(varName, ` = append(`, varName, `, `, p.bytesValue("postIndex"), `)`)
+			} else {
+				p.P(varName, ` = `, p.bytesValue("postIndex"))
+			}
+		} else if oneof {
 			p.P(`v := make([]byte, postIndex-iNdEx)`)
 			p.P(`copy(v, dAtA[iNdEx:postIndex])`)
-			p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
+			p.P(varName, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
 		} else if repeated {
-			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, make([]byte, postIndex-iNdEx))`)
-			p.P(`copy(m.`, fieldname, `[len(m.`, fieldname, `)-1], dAtA[iNdEx:postIndex])`)
+			p.P(varName, ` = append(`, varName, `, make([]byte, postIndex-iNdEx))`)
+			p.P(`copy(`, varName, `[len(`, varName, `)-1], dAtA[iNdEx:postIndex])`)
 		} else {
-			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `[:0] , dAtA[iNdEx:postIndex]...)`)
-			p.P(`if m.`, fieldname, ` == nil {`)
-			p.P(`m.`, fieldname, ` = []byte{}`)
+			p.P(varName, ` = append(`, varName, `[:0] , dAtA[iNdEx:postIndex]...)`)
+			p.P(`if `, varName, ` == nil {`)
+			p.P(varName, ` = []byte{}`)
 			p.P(`}`)
 		}
 		p.P(`iNdEx = postIndex`)
@@ -632,123 +732,195 @@ func (p *unmarshal) fieldItem(field *protogen.Field, fieldname string, message *
 		if oneof {
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
-			p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
+			p.P(varName, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
 		} else if repeated {
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
-			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
-		} else if proto3 && !nullable {
-			p.P(`m.`, fieldname, ` = 0`)
-			p.decodeVarint("m."+fieldname, typ)
+			p.P(varName, ` = append(`, varName, `, v)`)
+		} else if !nullable {
+			p.P(varName, ` = 0`)
+			p.decodeVarint(varName, typ)
 		} else {
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
-			p.P(`m.`, fieldname, ` = &v`)
+			p.P(varName, ` = &v`)
 		}
 	case protoreflect.EnumKind:
 		if oneof {
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
-			p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
+			p.P(varName, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
 		} else if repeated {
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
-			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
-		} else if proto3 && !nullable {
-			p.P(`m.`, fieldname, ` = 0`)
-			p.decodeVarint("m."+fieldname, typ)
+			p.P(varName, ` = append(`, varName, `, v)`)
+		} else if !nullable {
+			p.P(varName, ` = 0`)
+			p.decodeVarint(varName, typ)
 		} else {
 			p.P(`var v `, typ)
 			p.decodeVarint("v", typ)
-			p.P(`m.`, fieldname, ` = &v`)
+			p.P(varName, ` = &v`)
 		}
 	case protoreflect.Sfixed32Kind:
 		if oneof {
 			p.P(`var v `, typ)
 			p.decodeFixed32("v", typ)
-			p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
+			p.P(varName, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
 		} else if repeated {
 			p.P(`var v `, typ)
 			p.decodeFixed32("v", typ)
-			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
-		} else if proto3 && !nullable {
-			p.P(`m.`, fieldname, ` = 0`)
-			p.decodeFixed32("m."+fieldname, typ)
+			p.P(varName, ` = append(`, varName, `, v)`)
+		} else if !nullable {
+			p.P(varName, ` = 0`)
+			p.decodeFixed32(varName, typ)
 		} else {
 			p.P(`var v `, typ)
 			p.decodeFixed32("v", typ)
-			p.P(`m.`, fieldname, ` = &v`)
+			p.P(varName, ` = &v`)
 		}
 	case protoreflect.Sfixed64Kind:
 		if oneof {
 			p.P(`var v `, typ)
 			p.decodeFixed64("v", typ)
-			p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
+			p.P(varName, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
 		} else if repeated {
 			p.P(`var v `, typ)
 			p.decodeFixed64("v", typ)
-			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
-		} else if proto3 && !nullable {
-			p.P(`m.`, fieldname, ` = 0`)
-			p.decodeFixed64("m."+fieldname, typ)
+			p.P(varName, ` = append(`, varName, `, v)`)
+		} else if !nullable {
+			p.P(varName, ` = 0`)
+			p.decodeFixed64(varName, typ)
 		} else {
 			p.P(`var v `, typ)
 			p.decodeFixed64("v", typ)
-			p.P(`m.`, fieldname, ` = &v`)
+			p.P(varName, ` = &v`)
 		}
 	case protoreflect.Sint32Kind:
 		p.P(`var v `, typ)
 		p.decodeVarint("v", typ)
 		p.P(`v = `, typ, `((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))`)
 		if oneof {
-			p.P(`m.`, fieldname, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
+			p.P(varName, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
 		} else if repeated {
-			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, v)`)
-		} else if proto3 && !nullable {
-			p.P(`m.`, fieldname, ` = v`)
+			p.P(varName, ` = append(`, varName, `, v)`)
+		} else if !nullable {
+			p.P(varName, ` = v`)
 		} else {
-			p.P(`m.`, fieldname, ` = &v`)
+			p.P(varName, ` = &v`)
 		}
 	case protoreflect.Sint64Kind:
 		p.P(`var v uint64`)
 		p.decodeVarint("v", "uint64")
 		p.P(`v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)`)
 		if oneof {
-			p.P(`m.`, fieldname, ` = &`, field.GoIdent, `{`, field.GoName, ": ", typ, `(v)}`)
+			p.P(varName, ` = &`, field.GoIdent, `{`, field.GoName, ": ", typ, `(v)}`)
 		} else if repeated {
-			p.P(`m.`, fieldname, ` = append(m.`, fieldname, `, `, typ, `(v))`)
-		} else if proto3 && !nullable {
-			p.P(`m.`, fieldname, ` = `, typ, `(v)`)
+			p.P(varName, ` = append(`, varName, `, `, typ, `(v))`)
+		} else if !nullable {
+			p.P(varName, ` = `, typ, `(v)`)
 		} else {
 			p.P(`v2 := `, typ, `(v)`)
-			p.P(`m.`, fieldname, ` = &v2`)
+			p.P(varName, ` = &v2`)
 		}
 	default:
 		panic("not implemented")
 	}
 }
 
-func (p *unmarshal) field(proto3, oneof bool, field *protogen.Field, message *protogen.Message, required protoreflect.FieldNumbers) {
+// messageItem generates the code that decodes the message or group encoded in buf
+// into the given field, allocating it if needed.
+func (p *unmarshal) messageItem(field *protogen.Field, varName, buf string, message *protogen.Message) {
+	if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
+		msgname := p.noStarOrSliceType(field)
+		p.P(`if oneof, ok := `, varName, `.(*`, field.GoIdent, `); ok {`)
+		p.decodeMessage("oneof."+field.GoName, buf, field.Message)
+		p.P(`} else {`)
+		p.P(`v := &`, msgname, `{}`)
+		p.decodeMessage("v", buf, field.Message)
+		p.P(varName, ` = &`, field.GoIdent, "{", field.GoName, `: v}`)
+		p.P(`}`)
+	} else if field.Desc.IsList() {
+		if p.ShouldPool(message) {
+			p.P(`if len(`, varName, `) == cap(`, varName, `) {`)
+			p.P(varName, ` = append(`, varName, `, &`, field.Message.GoIdent, `{})`)
+			p.P(`} else {`)
+			p.P(varName, ` = `, varName, `[:len(`, varName, `) + 1]`)
+			p.P(`if `, varName, `[len(`, varName, `) - 1] == nil {`)
+			p.P(varName, `[len(`, varName, `) - 1] = &`, field.Message.GoIdent, `{}`)
+			p.P(`}`)
+			p.P(`}`)
+		} else {
+			p.P(varName, ` = append(`, varName, `, &`, field.Message.GoIdent, `{})`)
+		}
+		varname := fmt.Sprintf("%s[len(%s) - 1]", varName, varName)
+		p.decodeMessage(varname, buf, field.Message)
+	} else {
+		p.P(`if `, varName, ` == nil {`)
+		if p.ShouldPool(message) && p.ShouldPool(field.Message) {
+			p.P(varName, ` = `, field.Message.GoIdent, `FromVTPool()`)
+		} else {
+			p.P(varName, ` = &`, field.Message.GoIdent, `{}`)
+		}
+		p.P(`}`)
+		p.decodeMessage(varName, buf, field.Message)
+	}
+}
+
+func (p *unmarshal) field(oneof bool, field *protogen.Field, message *protogen.Message, required protoreflect.FieldNumbers) {
 	fieldname := field.GoName
-	errFieldname := fieldname
 	if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
 		fieldname = field.Oneof.GoName
 	}
 
 	p.P(`case `, strconv.Itoa(int(field.Desc.Number())), `:`)
+	p.fieldValue(field, "m."+fieldname, field.GoName, message)
+
+	if field.Desc.Cardinality() == protoreflect.Required {
+		var fieldBit int
+		for fieldBit = 0; fieldBit < required.Len(); fieldBit++ {
+			if required.Get(fieldBit) == field.Desc.Number() {
+				break
+			}
+		}
+		if fieldBit == required.Len() {
+			panic("missing required field")
+		}
+		p.P(`hasFields[`, strconv.Itoa(fieldBit/64), `] |= uint64(`, fmt.Sprintf("0x%08x", uint64(1)<<(fieldBit%64)), `)`)
+	}
+}
+
+// extension generates the case that decodes an extension known at generation time. The
+// current value of the extension is decoded into and then stored back in the message, so
+// repeated and message extensions are merged like regular fields.
+func (p *unmarshal) extension(ext *protogen.Extension, message *protogen.Message) {
+	p.P(`case `, strconv.Itoa(int(ext.Desc.Number())), `:`)
+	if ext.Desc.IsList() || ext.Message != nil {
+		p.P(`ext := `, p.ExtensionValue("m", ext))
+	} else {
+		goType, _ := p.FieldGoType(ext)
+		p.P(`var ext `, goType)
+	}
+	p.fieldValue(ext, "ext", ext.GoName, message)
+	p.P(p.Ident(generator.ProtoPkg, "SetExtension"), `(m, `, p.ExtensionType(ext), `, ext)`)
+}
+
+// fieldValue generates the code that decodes the value of the given field into varName,
+// which is reported as errFieldname when the wire type does not match.
+func (p *unmarshal) fieldValue(field *protogen.Field, varName, errFieldname string, message *protogen.Message) {
 	wireType := generator.ProtoWireType(field.Desc.Kind())
-	if field.Desc.IsList() && wireType != protowire.BytesType {
+	if field.Desc.IsList() && wireType != protowire.BytesType && wireType != protowire.StartGroupType {
 		p.P(`if wireType == `, strconv.Itoa(int(wireType)), `{`)
-		p.fieldItem(field, fieldname, message, false)
+		p.fieldItem(field, varName, message)
 		p.P(`} else if wireType == `, strconv.Itoa(int(protowire.BytesType)), `{`)
 		p.P(`var packedLen int`)
 		p.decodeVarint("packedLen", "int")
 		p.P(`if packedLen < 0 {`)
-		p.P(`return ErrInvalidLength`)
+		p.P(`return `, p.Helper("ErrInvalidLength"))
 		p.P(`}`)
 		p.P(`postIndex := iNdEx + packedLen`)
 		p.P(`if postIndex < 0 {`)
-		p.P(`return ErrInvalidLength`)
+		p.P(`return `, p.Helper("ErrInvalidLength"))
 		p.P(`}`)
 		p.P(`if postIndex > l {`)
 		p.P(`return `, p.Ident("io", "ErrUnexpectedEOF"))
@@ -773,17 +945,17 @@ func (p *unmarshal) field(proto3, oneof bool, field *protogen.Field, message *pr
 		}
 
 		if p.ShouldPool(message) {
-			p.P(`if elementCount != 0 && len(m.`, fieldname, `) == 0 && cap(m.`, fieldname, `) < elementCount {`)
+			p.P(`if elementCount != 0 && len(`, varName, `) == 0 && cap(`, varName, `) < elementCount {`)
 		} else {
-			p.P(`if elementCount != 0 && len(m.`, fieldname, `) == 0 {`)
+			p.P(`if elementCount != 0 && len(`, varName, `) == 0 {`)
 		}
 
 		fieldtyp, _ := p.FieldGoType(field)
-		p.P(`m.`, fieldname, ` = make(`, fieldtyp, `, 0, elementCount)`)
+		p.P(varName, ` = make(`, fieldtyp, `, 0, elementCount)`)
 		p.P(`}`)
 
 		p.P(`for iNdEx < postIndex {`)
-		p.fieldItem(field, fieldname, message, false)
+		p.fieldItem(field, varName, message)
 		p.P(`}`)
 		p.P(`} else {`)
 		p.P(`return `, p.Ident("fmt", "Errorf"), `("proto: wrong wireType = %d for field `, errFieldname, `", wireType)`)
@@ -792,29 +964,38 @@ func (p *unmarshal) field(proto3, oneof bool, field *protogen.Field, message *pr
 		p.P(`if wireType != `, strconv.Itoa(int(wireType)), `{`)
 		p.P(`return `, p.Ident("fmt", "Errorf"), `("proto: wrong wireType = %d for field `, errFieldname, `", wireType)`)
 		p.P(`}`)
-		p.fieldItem(field, fieldname, message, proto3)
+		p.fieldItem(field, varName, message)
 	}
+}
 
-	if field.Desc.Cardinality() == protoreflect.Required {
-		var fieldBit int
-		for fieldBit = 0; fieldBit < required.Len(); fieldBit++ {
-			if required.Get(fieldBit) == field.Desc.Number() {
-				break
-			}
-		}
-		if fieldBit == required.Len() {
-			panic("missing required field")
-		}
-		p.P(`hasFields[`, strconv.Itoa(fieldBit/64), `] |= uint64(`, fmt.Sprintf("0x%08x", uint64(1)<<(fieldBit%64)), `)`)
-	}
+// unmarshalDelimited generates the UnmarshalDelimitedVT method, which decodes the
+// varint size-delimited record at the start of dAtA, in the format of protodelim,
+// and returns its length.
+func (p *unmarshal) unmarshalDelimited(ccTypeName protogen.GoIdent) {
+	protowirePkg := "google.golang.org/protobuf/encoding/protowire"
+	p.P(`func (m *`, ccTypeName, `) UnmarshalDelimitedVT(dAtA []byte) (int, error) {`)
+	p.P(`size, n := `, p.Ident(protowirePkg, "ConsumeVarint"), `(dAtA)`)
+	p.P(`if n < 0 {`)
+	p.P(`return 0, `, p.Ident(protowirePkg, "ParseError"), `(n)`)
+	p.P(`}`)
+	p.P(`if size > uint64(len(dAtA)-n) {`)
+	p.P(`return 0, `, p.Ident("io", "ErrUnexpectedEOF"))
+	p.P(`}`)
+	p.P(`end := n + int(size)`)
+	p.P(`if err := m.UnmarshalVT(dAtA[n:end]); err != nil {`)
+	p.P(`return 0, err`)
+	p.P(`}`)
+	p.P(`return end, nil`)
+	p.P(`}`)
+	p.P()
 }
 
-func (p *unmarshal) message(proto3 bool, message *protogen.Message) {
+func (p *unmarshal) message(message *protogen.Message) {
 	for _, nested := range message.Messages {
-		p.message(proto3, nested)
+		p.message(nested)
 	}
 
-	if message.Desc.IsMapEntry() {
+	if message.Desc.IsMapEntry() || !p.ShouldGenerate(message) {
 		return
 	}
 
@@ -822,14 +1003,52 @@ func (p *unmarshal) message(proto3 bool, message *protogen.Message) {
 	ccTypeName := message.GoIdent
 	required := message.Desc.RequiredNumbers()
 
-	p.P(`func (m *`, ccTypeName, `) UnmarshalVT(dAtA []byte) error {`)
+	defaultRecursionLimit := p.Ident("google.golang.org/protobuf/encoding/protowire", "DefaultRecursionLimit")
+	unmarshalOptions := p.Ident(generator.VTProtoPkg, "UnmarshalOptions")
+
+	p.P(`func (m *`, ccTypeName, `) `, p.methodName(), `(dAtA []byte) error {`)
+	p.P(`return m.`, p.internalMethodName(), `(dAtA, `, unmarshalOptions, `{}, `, defaultRecursionLimit, `)`)
+	p.P(`}`)
+	p.P()
+	p.P(`func (m *`, ccTypeName, `) `, p.methodName(), `WithOptions(dAtA []byte, opts `, unmarshalOptions, `) error {`)
+	p.P(`if !opts.Merge {`)
+	if p.ShouldPool(message) {
+		p.P(`m.ResetVT()`)
+	} else {
+		p.P(`m.Reset()`)
+	}
+	p.P(`}`)
+	p.P(`depth := opts.RecursionLimit`)
+	p.P(`if depth == 0 {`)
+	p.P(`depth = `, defaultRecursionLimit)
+	p.P(`}`)
+	p.P(`return m.`, p.internalMethodName(), `(dAtA, opts, depth)`)
+	p.P(`}`)
+	p.P()
+	if !p.unsafe {
+		p.unmarshalDelimited(ccTypeName)
+	}
+	p.P(`func (m *`, ccTypeName, `) `, p.internalMethodName(), `(dAtA []byte, opts `, unmarshalOptions, `, depth int) (err error) {`)
+	p.P(`depth--`)
+	p.P(`if depth < 0 {`)
+	p.P(`return `, p.Helper("ErrRecursionLimitExceeded"))
+	p.P(`}`)
+	// Every error is reported at the offset of the field being decoded, which is also
+	// where the errors of the nested messages are made relative to.
+	p.P(`var preIndex int`)
+	p.P(`defer func() {`)
+	p.P(`if err != nil {`)
+	// the descriptor is not taken from m, which would make every receiver escape
+	p.P(`err = `, p.Ident(generator.ProtoHelpersPkg, "WrapDecodeError"), `(err, (*`, ccTypeName, `)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)`)
+	p.P(`}`)
+	p.P(`}()`)
 	if required.Len() > 0 {
 		p.P(`var hasFields [`, strconv.Itoa(1+(required.Len()-1)/64), `]uint64`)
 	}
 	p.P(`l := len(dAtA)`)
 	p.P(`iNdEx := 0`)
 	p.P(`for iNdEx < l {`)
-	p.P(`preIndex := iNdEx`)
+	p.P(`preIndex = iNdEx`)
 	p.P(`var wire uint64`)
 	p.decodeVarint("wire", "uint64")
 	p.P(`fieldNum := int32(wire >> 3)`)
@@ -842,21 +1061,24 @@ func (p *unmarshal) message(proto3 bool, message *protogen.Message) {
 	p.P(`}`)
 	p.P(`switch fieldNum {`)
 	for _, field := range message.Fields {
-		p.field(proto3, false, field, message, required)
+		p.field(false, field, message, required)
+	}
+	for _, ext := range p.KnownExtensions(message) {
+		p.extension(ext, message)
 	}
 	p.P(`default:`)
 	p.P(`iNdEx=preIndex`)
-	p.P(`skippy, err := skip(dAtA[iNdEx:])`)
+	p.P(`skippy, err := `, p.Helper("skip"), `(dAtA[iNdEx:])`)
 	p.P(`if err != nil {`)
 	p.P(`return err`)
 	p.P(`}`)
 	p.P(`if (skippy < 0) || (iNdEx + skippy) < 0 {`)
-	p.P(`return ErrInvalidLength`)
+	p.P(`return `, p.Helper("ErrInvalidLength"))
 	p.P(`}`)
 	p.P(`if (iNdEx + skippy) > l {`)
 	p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
 	p.P(`}`)
-	if message.Desc.ExtensionRanges().Len() > 0 {
+	if p.IsExtendable(message) {
 		c := []string{}
 		eranges := message.Desc.ExtensionRanges()
 		for e := 0; e < eranges.Len(); e++ {
@@ -864,21 +1086,30 @@ func (p *unmarshal) message(proto3 bool, message *protogen.Message) {
 			c = append(c, `((fieldNum >= `+strconv.Itoa(int(erange[0]))+`) && (fieldNum < `+strconv.Itoa(int(erange[1]))+`))`)
 		}
 		p.P(`if `, strings.Join(c, "||"), `{`)
-		p.P(`err = `, p.Ident(generator.ProtoPkg, "UnmarshalOptions"), `{AllowPartial: true}.Unmarshal(dAtA[iNdEx:iNdEx+skippy], m)`)
+		p.P(`found, err := `, p.Ident(generator.ProtoHelpersPkg, "UnmarshalExtension"), `(m.ProtoReflect(), dAtA[iNdEx:iNdEx+skippy], depth)`)
 		p.P(`if err != nil {`)
 		p.P(`return err`)
 		p.P(`}`)
+		p.P(`if !found && !opts.DiscardUnknown {`)
+		p.P(`m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)`)
+		p.P(`}`)
 		p.P(`iNdEx += skippy`)
 		p.P(`} else {`)
 	}
+	p.P(`if !opts.DiscardUnknown {`)
 	p.P(`m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)`)
+	p.P(`}`)
 	p.P(`iNdEx += skippy`)
-	if message.Desc.ExtensionRanges().Len() > 0 {
+	if p.IsExtendable(message) {
 		p.P(`}`)
 	}
 	p.P(`}`)
 	p.P(`}`)
+	p.P(`preIndex = iNdEx`)
 
+	if required.Len() > 0 {
+		p.P(`if !opts.AllowPartial {`)
+	}
 	for _, field := range message.Fields {
 		if field.Desc.Cardinality() != protoreflect.Required {
 			continue
@@ -896,6 +1127,9 @@ func (p *unmarshal) message(proto3 bool, message *protogen.Message) {
 		p.P(`return `, p.Ident("fmt", "Errorf"), `("proto: required field `, field.Desc.Name(), ` not set")`)
 		p.P(`}`)
 	}
+	if required.Len() > 0 {
+		p.P(`}`)
+	}
 	p.P()
 	p.P(`if iNdEx > l {`)
 	p.P(`return `, p.Ident("io", `ErrUnexpectedEOF`))
@@ -0,0 +1,90 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"sort"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// extensionsByExtendee indexes all the extensions declared in the given files
+// by the full name of the message they extend.
+func extensionsByExtendee(allFiles []*protogen.File) map[protoreflect.FullName][]*protogen.Extension {
+	byExtendee := make(map[protoreflect.FullName][]*protogen.Extension)
+	add := func(extensions []*protogen.Extension) {
+		for _, ext := range extensions {
+			name := ext.Desc.ContainingMessage().FullName()
+			byExtendee[name] = append(byExtendee[name], ext)
+		}
+	}
+
+	var walk func(messages []*protogen.Message)
+	walk = func(messages []*protogen.Message) {
+		for _, message := range messages {
+			add(message.Extensions)
+			walk(message.Messages)
+		}
+	}
+
+	for _, file := range allFiles {
+		add(file.Extensions)
+		walk(file.Messages)
+	}
+	return byExtendee
+}
+
+// IsExtendable returns whether the generated code for the given message must handle
+// extension fields, i.e. whether the message declares extension ranges. Messages using
+// the legacy MessageSet wire format are not considered extendable: their extensions are
+// always preserved as unknown fields.
+func (p *GeneratedFile) IsExtendable(message *protogen.Message) bool {
+	if message.Desc.ExtensionRanges().Len() == 0 {
+		return false
+	}
+	opts, ok := message.Desc.Options().(*descriptorpb.MessageOptions)
+	return !ok || !opts.GetMessageSetWireFormat()
+}
+
+// KnownExtensions returns the extensions of the given message that the generated code
+// can handle without reflection, sorted by field number. These are the extensions declared
+// in the same Go package as the message, which can be referenced without introducing an
+// import cycle. All other extensions are handled at runtime by the protohelpers package.
+func (p *GeneratedFile) KnownExtensions(message *protogen.Message) []*protogen.Extension {
+	if !p.IsExtendable(message) {
+		return nil
+	}
+
+	var known []*protogen.Extension
+	for _, ext := range p.extensions[message.Desc.FullName()] {
+		if ext.GoIdent.GoImportPath != message.GoIdent.GoImportPath {
+			continue
+		}
+		// Groups are decoded as unknown extensions, see protohelpers.UnmarshalExtension
+		if ext.Desc.Kind() == protoreflect.GroupKind {
+			continue
+		}
+		known = append(known, ext)
+	}
+	sort.Slice(known, func(i, j int) bool {
+		return known[i].Desc.Number() < known[j].Desc.Number()
+	})
+	return known
+}
+
+// ExtensionType returns the name of the variable declared by protoc-gen-go that holds
+// the type of the given extension.
+func (p *GeneratedFile) ExtensionType(ext *protogen.Extension) string {
+	return p.QualifiedGoIdent(ext.GoIdent.GoImportPath.Ident("E_" + ext.GoIdent.GoName))
+}
+
+// ExtensionValue returns an expression that evaluates to the Go value of the given
+// extension in the message named varName, as returned by proto.GetExtension.
+func (p *GeneratedFile) ExtensionValue(varName string, ext *protogen.Extension) string {
+	goType, _ := p.FieldGoType(ext)
+	return p.Ident(ProtoPkg, "GetExtension") + `(` + varName + `, ` + p.ExtensionType(ext) + `).(` + goType + `)`
+}
@@ -7,48 +7,98 @@ package generator
 import (
 	"fmt"
 	"sort"
+	"strings"
 
 	"google.golang.org/protobuf/compiler/protogen"
 )
 
 var defaultFeatures = make(map[string]Feature)
+var featureDependencies = make(map[string][]string)
+var optInFeatures = make(map[string]bool)
 
-func findFeatures(featureNames []string) ([]Feature, error) {
-	required := make(map[string]Feature)
+// findFeatures resolves a list of feature selectors into the set of feature names
+// they enable. Each selector is either the name of a feature or "all", optionally
+// followed by one or more exclusions, e.g. "all-grpc" or "all-equal-clone".
+// "all" does not include the opt-in features, which must be selected by name.
+// The features required by the selected features are always enabled as well.
+func findFeatures(featureNames []string) (map[string]bool, error) {
+	required := make(map[string]bool)
 	for _, name := range featureNames {
-		if name == "all" {
-			required = defaultFeatures
-			break
+		if name == "" {
+			continue
 		}
+		parts := strings.Split(name, "-")
 
-		feat, ok := defaultFeatures[name]
-		if !ok {
-			return nil, fmt.Errorf("unknown feature: %q", name)
+		include, exclude := parts[0], parts[1:]
+		if include == "all" {
+			for name := range defaultFeatures {
+				if !optInFeatures[name] {
+					required[name] = true
+				}
+			}
+		} else {
+			if _, ok := defaultFeatures[include]; !ok {
+				return nil, fmt.Errorf("unknown feature: %q", include)
+			}
+			required[include] = true
+		}
+
+		for _, name := range exclude {
+			if _, ok := defaultFeatures[name]; !ok {
+				return nil, fmt.Errorf("unknown feature: %q", name)
+			}
+			delete(required, name)
 		}
-		required[name] = feat
 	}
 
-	type namefeat struct {
-		name string
-		feat Feature
+	var require func(name string) error
+	require = func(name string) error {
+		for _, dep := range featureDependencies[name] {
+			if _, ok := defaultFeatures[dep]; !ok {
+				return fmt.Errorf("feature %q requires unknown feature %q", name, dep)
+			}
+			if !required[dep] {
+				required[dep] = true
+				if err := require(dep); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
 	}
-	var sorted []namefeat
-	for name, feat := range required {
-		sorted = append(sorted, namefeat{name, feat})
+	for name := range required {
+		if err := require(name); err != nil {
+			return nil, err
+		}
 	}
-	sort.Slice(sorted, func(i, j int) bool {
-		return sorted[i].name < sorted[j].name
-	})
+	return required, nil
+}
 
-	var features []Feature
-	for _, sp := range sorted {
-		features = append(features, sp.feat)
+// sortedFeatureNames returns the names of all the registered features, in the
+// order in which they must be generated.
+func sortedFeatureNames() []string {
+	var names []string
+	for name := range defaultFeatures {
+		names = append(names, name)
 	}
-	return features, nil
+	sort.Strings(names)
+	return names
 }
 
-func RegisterFeature(name string, feat Feature) {
+// RegisterFeature makes a feature available to the generator under the given name.
+// If the code generated by the feature relies on the methods generated by other
+// features, their names must be passed as requires: they will be enabled whenever
+// this feature is.
+func RegisterFeature(name string, feat Feature, requires ...string) {
 	defaultFeatures[name] = feat
+	featureDependencies[name] = requires
+}
+
+// RegisterOptInFeature is like RegisterFeature, but the feature is not selected by
+// "all": it is only generated when it is enabled by name.
+func RegisterOptInFeature(name string, feat Feature, requires ...string) {
+	RegisterFeature(name, feat, requires...)
+	optInFeatures[name] = true
 }
 
 type Feature func(gen *GeneratedFile) FeatureGenerator
@@ -0,0 +1,73 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindFeatures(t *testing.T) {
+	nop := func(gen *GeneratedFile) FeatureGenerator { return nil }
+	RegisterFeature("test_base", nop)
+	RegisterFeature("test_dependent", nop, "test_base")
+	RegisterFeature("test_other", nop)
+	RegisterOptInFeature("test_opt_in", nop, "test_base")
+	defer func() {
+		for _, name := range []string{"test_base", "test_dependent", "test_other", "test_opt_in"} {
+			delete(defaultFeatures, name)
+			delete(featureDependencies, name)
+			delete(optInFeatures, name)
+		}
+	}()
+
+	for _, tc := range []struct {
+		names    []string
+		expected map[string]bool
+	}{
+		{
+			names:    []string{"test_base", "test_other"},
+			expected: map[string]bool{"test_base": true, "test_other": true},
+		},
+		{
+			names:    []string{"all"},
+			expected: map[string]bool{"test_base": true, "test_dependent": true, "test_other": true},
+		},
+		{
+			names:    []string{"all-test_other"},
+			expected: map[string]bool{"test_base": true, "test_dependent": true},
+		},
+		{
+			names:    []string{"test_dependent"},
+			expected: map[string]bool{"test_base": true, "test_dependent": true},
+		},
+		{
+			names:    []string{"all-test_dependent-test_other"},
+			expected: map[string]bool{"test_base": true},
+		},
+		{
+			names:    []string{"test_opt_in"},
+			expected: map[string]bool{"test_base": true, "test_opt_in": true},
+		},
+		{
+			names:    []string{"all", "test_opt_in"},
+			expected: map[string]bool{"test_base": true, "test_dependent": true, "test_other": true, "test_opt_in": true},
+		},
+	} {
+		features, err := findFeatures(tc.names)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, features, "features for %v", tc.names)
+	}
+
+	_, err := findFeatures([]string{"test_unknown"})
+	require.Error(t, err)
+
+	_, err = findFeatures([]string{"all-test_unknown"})
+	require.Error(t, err)
+
+	RegisterFeature("test_broken", nop, "test_unknown")
+	defer delete(defaultFeatures, "test_broken")
+	defer delete(featureDependencies, "test_broken")
+
+	_, err = findFeatures([]string{"test_broken"})
+	require.Error(t, err)
+}
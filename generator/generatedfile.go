@@ -18,14 +18,65 @@ type GeneratedFile struct {
 	*protogen.GeneratedFile
 	Ext           *Extensions
 	LocalPackages map[string]bool
+
+	features   *featureSelection
+	feature    string
+	extensions map[protoreflect.FullName][]*protogen.Extension
+}
+
+// forFeature returns a copy of the file to be used by the given feature's generator.
+func (p *GeneratedFile) forFeature(name string) *GeneratedFile {
+	gf := *p
+	gf.feature = name
+	return &gf
+}
+
+// ShouldGenerate returns whether the feature being generated is enabled for the
+// given message, as selected by the vtproto.features and vtproto.skip options.
+func (p *GeneratedFile) ShouldGenerate(message *protogen.Message) bool {
+	return p.features.forMessage(message)[p.feature]
+}
+
+// ShouldGenerateFile returns whether the feature being generated is enabled for
+// the given file as a whole, as selected by the vtproto.features_all option.
+func (p *GeneratedFile) ShouldGenerateFile(file *protogen.File) bool {
+	return p.features.forFile(file)[p.feature]
 }
 
 func (p *GeneratedFile) Ident(path, ident string) string {
 	return p.QualifiedGoIdent(protogen.GoImportPath(path).Ident(ident))
 }
 
+var sharedHelpers = map[string]string{
+	"encodeVarint":              "EncodeVarint",
+	"sov":                       "SizeOfVarint",
+	"soz":                       "SizeOfZigzag",
+	"skip":                      "Skip",
+	"ErrInvalidLength":          "ErrInvalidLength",
+	"ErrIntOverflow":            "ErrIntOverflow",
+	"ErrUnexpectedEndOfGroup":   "ErrUnexpectedEndOfGroup",
+	"ErrInvalidUTF8":            "ErrInvalidUTF8",
+	"ErrRecursionLimitExceeded": "ErrRecursionLimitExceeded",
+	"ErrBufferTooSmall":         "ErrBufferTooSmall",
+	"ErrSizeMismatch":           "ErrSizeMismatch",
+}
+
+// Helper returns the identifier to use in generated code to refer to one of the
+// helpers emitted by the features' GenerateHelpers. When shared helpers are enabled,
+// this is the equivalent identifier in the protohelpers package instead.
+func (p *GeneratedFile) Helper(name string) string {
+	if p.Ext.SharedHelpers {
+		shared, ok := sharedHelpers[name]
+		if !ok {
+			panic("unknown helper: " + name)
+		}
+		return p.Ident(ProtoHelpersPkg, shared)
+	}
+	return name
+}
+
 func (b *GeneratedFile) ShouldPool(message *protogen.Message) bool {
-	if message == nil {
+	if message == nil || !b.features.forMessage(message)["pool"] {
 		return false
 	}
 	if b.Ext.Poolable[message.GoIdent] {
@@ -38,6 +89,20 @@ func (b *GeneratedFile) ShouldPool(message *protogen.Message) bool {
 	return false
 }
 
+func (b *GeneratedFile) ShouldMarshalDeterministic(message *protogen.Message) bool {
+	if message == nil {
+		return false
+	}
+	if proto.HasExtension(message.Desc.Options(), vtproto.E_Deterministic) {
+		return proto.GetExtension(message.Desc.Options(), vtproto.E_Deterministic).(bool)
+	}
+	fileOpts := message.Desc.ParentFile().Options()
+	if proto.HasExtension(fileOpts, vtproto.E_DeterministicAll) {
+		return proto.GetExtension(fileOpts, vtproto.E_DeterministicAll).(bool)
+	}
+	return b.Ext.Deterministic
+}
+
 func (b *GeneratedFile) Alloc(vname string, message *protogen.Message) {
 	if b.ShouldPool(message) {
 		b.P(vname, " := ", message.GoIdent, `FromVTPool()`)
@@ -89,7 +154,58 @@ func (p *GeneratedFile) FieldGoType(field *protogen.Field) (goType string, point
 	return goType, pointer
 }
 
+// ShouldValidateUTF8 returns whether the generated code must check that the values
+// of the given string field are valid UTF-8 when unmarshaling. Like proto.Unmarshal,
+// this is the case for proto3 strings and for strings with the utf8_validation = VERIFY
+// feature in files using editions. The validation can be disabled with the
+// vtproto.skip_utf8_validation field option or, for all fields that do not set the
+// option, with the skip-utf8-validation flag.
+func (p *GeneratedFile) ShouldValidateUTF8(field *protogen.Field) bool {
+	if field.Desc.Kind() != protoreflect.StringKind || !enforceUTF8(field.Desc) {
+		return false
+	}
+	opts := field.Desc.Options()
+	if entry := field.Desc.ContainingMessage(); entry.IsMapEntry() {
+		// The fields of map entries have no options: use the ones of the map field
+		if fd := mapFieldOf(entry); fd != nil {
+			opts = fd.Options()
+		}
+	}
+	if proto.HasExtension(opts, vtproto.E_SkipUtf8Validation) {
+		return !proto.GetExtension(opts, vtproto.E_SkipUtf8Validation).(bool)
+	}
+	return !p.Ext.SkipUTF8Validation
+}
+
+// enforceUTF8 returns whether proto.Unmarshal rejects invalid UTF-8 in the given field.
+func enforceUTF8(fd protoreflect.FieldDescriptor) bool {
+	// The resolved utf8_validation feature is not part of the protoreflect API, but the
+	// descriptors built by protodesc expose it like the runtime's own decoder expects.
+	if fd, ok := fd.(interface{ EnforceUTF8() bool }); ok {
+		return fd.EnforceUTF8()
+	}
+	return fd.Syntax() == protoreflect.Proto3
+}
+
+// mapFieldOf returns the field of type map whose entries are described by entry.
+func mapFieldOf(entry protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	parent, ok := entry.Parent().(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil
+	}
+	fields := parent.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if fd := fields.Get(i); fd.IsMap() && fd.Message().FullName() == entry.FullName() {
+			return fd
+		}
+	}
+	return nil
+}
+
+// IsLocalMessage returns whether the given message is part of the packages being
+// generated and has the current feature enabled, i.e. whether the generated code
+// can rely on the feature's methods being available on it.
 func (p *GeneratedFile) IsLocalMessage(message *protogen.Message) bool {
 	pkg := string(message.Desc.ParentFile().Package())
-	return p.LocalPackages[pkg]
+	return p.LocalPackages[pkg] && p.ShouldGenerate(message)
 }
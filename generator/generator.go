@@ -8,27 +8,39 @@ import (
 	"runtime/debug"
 
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/runtime/protoimpl"
 )
 
 type featureHelpers struct {
 	path    protogen.GoImportPath
-	feature int
+	feature string
 }
 
 type Extensions struct {
-	Poolable map[protogen.GoIdent]bool
+	Poolable      map[protogen.GoIdent]bool
+	Deterministic bool
+	SharedHelpers bool
+	// SkipUTF8Validation disables the validation of string fields when unmarshaling,
+	// unless re-enabled for a field with the vtproto.skip_utf8_validation option.
+	SkipUTF8Validation bool
 }
 
 type Generator struct {
-	seen     map[featureHelpers]bool
-	ext      *Extensions
-	features []Feature
-	local    map[string]bool
+	seen       map[featureHelpers]bool
+	ext        *Extensions
+	features   *featureSelection
+	local      map[string]bool
+	extensions map[protoreflect.FullName][]*protogen.Extension
 }
 
 func NewGenerator(allFiles []*protogen.File, featureNames []string, ext *Extensions) (*Generator, error) {
-	features, err := findFeatures(featureNames)
+	defaults, err := findFeatures(featureNames)
+	if err != nil {
+		return nil, err
+	}
+
+	features, err := newFeatureSelection(allFiles, defaults)
 	if err != nil {
 		return nil, err
 	}
@@ -41,10 +53,11 @@ func NewGenerator(allFiles []*protogen.File, featureNames []string, ext *Extensi
 	}
 
 	return &Generator{
-		seen:     make(map[featureHelpers]bool),
-		ext:      ext,
-		features: features,
-		local:    local,
+		seen:       make(map[featureHelpers]bool),
+		ext:        ext,
+		features:   features,
+		local:      local,
+		extensions: extensionsByExtendee(allFiles),
 	}, nil
 }
 
@@ -53,6 +66,8 @@ func (gen *Generator) GenerateFile(gf *protogen.GeneratedFile, file *protogen.Fi
 		GeneratedFile: gf,
 		Ext:           gen.ext,
 		LocalPackages: gen.local,
+		features:      gen.features,
+		extensions:    gen.extensions,
 	}
 
 	p.P("// Code generated by protoc-gen-go-vtproto. DO NOT EDIT.")
@@ -74,14 +89,18 @@ func (gen *Generator) GenerateFile(gf *protogen.GeneratedFile, file *protogen.Fi
 	p.P()
 
 	var generated bool
-	for fidx, feat := range gen.features {
-		featGenerator := feat(p)
+	enabled := gen.features.anyEnabled(file)
+	for _, name := range sortedFeatureNames() {
+		if !enabled[name] {
+			continue
+		}
+		featGenerator := defaultFeatures[name](p.forFeature(name))
 		if featGenerator.GenerateFile(file) {
 			generated = true
 
 			helpersForPlugin := featureHelpers{
 				path:    file.GoImportPath,
-				feature: fidx,
+				feature: name,
 			}
 			if !gen.seen[helpersForPlugin] {
 				featGenerator.GenerateHelpers()
@@ -11,6 +11,10 @@ import (
 
 const ProtoPkg = "google.golang.org/protobuf/proto"
 
+const ProtoHelpersPkg = "github.com/planetscale/vtprotobuf/protohelpers"
+
+const VTProtoPkg = "github.com/planetscale/vtprotobuf/vtproto"
+
 func KeySize(fieldNumber protoreflect.FieldNumber, wireType protowire.Type) int {
 	x := uint32(fieldNumber)<<3 | uint32(wireType)
 	size := 0
@@ -0,0 +1,115 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+type ObjectSet map[protogen.GoIdent]bool
+
+func (o ObjectSet) String() string {
+	return fmt.Sprintf("%#v", o)
+}
+
+func (o ObjectSet) Set(s string) error {
+	idx := strings.LastIndexByte(s, '.')
+	if idx < 0 {
+		return fmt.Errorf("invalid object name: %q", s)
+	}
+
+	ident := protogen.GoIdent{
+		GoImportPath: protogen.GoImportPath(s[0:idx]),
+		GoName:       s[idx+1:],
+	}
+	o[ident] = true
+	return nil
+}
+
+// Options configures a protoc plugin started with Main.
+type Options struct {
+	// Flags is the set of plugin parameters (as passed with --go-vtproto_opt)
+	// accepted by the plugin. The built-in parameters are added to it, so custom
+	// features can declare their own parameters here. If nil, only the built-in
+	// parameters are accepted.
+	Flags *flag.FlagSet
+}
+
+// Main runs a protoc plugin that generates code for all the features registered
+// with RegisterFeature. It is the entrypoint of protoc-gen-go-vtproto, and can be
+// used to build custom plugins that ship their own features in addition to (or
+// instead of) the ones in this repository:
+//
+//	func main() {
+//		generator.RegisterFeature("myfeature", newMyFeature, "size")
+//		generator.Main(generator.Options{})
+//	}
+func Main(opts Options) {
+	var allowEmpty bool
+	var deterministic bool
+	var sharedHelpers bool
+	var skipUTF8Validation bool
+	var features string
+	poolable := make(ObjectSet)
+
+	f := opts.Flags
+	if f == nil {
+		f = new(flag.FlagSet)
+	}
+	f.BoolVar(&allowEmpty, "allow-empty", false, "allow generation of empty files")
+	f.Var(poolable, "pool", "use memory pooling for this object")
+	f.BoolVar(&deterministic, "deterministic", false, "sort map keys when marshaling, matching proto.MarshalOptions{Deterministic: true}")
+	f.BoolVar(&sharedHelpers, "shared-helpers", false, "use the helpers from the protohelpers package instead of generating them in each package")
+	f.BoolVar(&skipUTF8Validation, "skip-utf8-validation", false, "do not check that string fields are valid UTF-8 when unmarshaling")
+	f.StringVar(&features, "features", "all", "list of features to generate (separated by '+')")
+
+	protogen.Options{ParamFunc: f.Set}.Run(func(plugin *protogen.Plugin) error {
+		ext := &Extensions{
+			Poolable:           poolable,
+			Deterministic:      deterministic,
+			SharedHelpers:      sharedHelpers,
+			SkipUTF8Validation: skipUTF8Validation,
+		}
+		return generateAllFiles(plugin, strings.Split(features, "+"), ext, allowEmpty)
+	})
+}
+
+var SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL | pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)
+
+// SupportedEditionsMinimum and SupportedEditionsMaximum are the range of editions
+// advertised to protoc when SupportedFeatures includes FEATURE_SUPPORTS_EDITIONS.
+var (
+	SupportedEditionsMinimum = descriptorpb.Edition_EDITION_PROTO2
+	SupportedEditionsMaximum = descriptorpb.Edition_EDITION_2023
+)
+
+func generateAllFiles(plugin *protogen.Plugin, featureNames []string, ext *Extensions, allowEmpty bool) error {
+	gen, err := NewGenerator(plugin.Files, featureNames, ext)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range plugin.Files {
+		if !file.Generate {
+			continue
+		}
+
+		gf := plugin.NewGeneratedFile(file.GeneratedFilenamePrefix+"_vtproto.pb.go", file.GoImportPath)
+		if !gen.GenerateFile(gf, file) && !allowEmpty {
+			gf.Skip()
+		}
+	}
+
+	plugin.SupportedFeatures = SupportedFeatures
+	plugin.SupportedEditionsMinimum = SupportedEditionsMinimum
+	plugin.SupportedEditionsMaximum = SupportedEditionsMaximum
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// featureSelection decides which features are generated for each file and message.
+// By default, the features given on the command line are used, but they can be
+// overridden for a whole file with the vtproto.features_all option, or for a single
+// message with the vtproto.features and vtproto.skip options.
+type featureSelection struct {
+	defaults map[string]bool
+	parsed   map[string]map[string]bool
+}
+
+func newFeatureSelection(allFiles []*protogen.File, defaults map[string]bool) (*featureSelection, error) {
+	sel := &featureSelection{
+		defaults: defaults,
+		parsed:   make(map[string]map[string]bool),
+	}
+
+	var validate func(message *protogen.Message) error
+	validate = func(message *protogen.Message) error {
+		if expr, ok := featuresOption(message.Desc.Options(), vtproto.E_Features); ok {
+			if _, err := sel.parse(expr); err != nil {
+				return fmt.Errorf("%s: invalid vtproto.features option: %w", message.Desc.FullName(), err)
+			}
+		}
+		for _, nested := range message.Messages {
+			if err := validate(nested); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, file := range allFiles {
+		if expr, ok := featuresOption(file.Desc.Options(), vtproto.E_FeaturesAll); ok {
+			if _, err := sel.parse(expr); err != nil {
+				return nil, fmt.Errorf("%s: invalid vtproto.features_all option: %w", file.Desc.Path(), err)
+			}
+		}
+		for _, message := range file.Messages {
+			if err := validate(message); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return sel, nil
+}
+
+func featuresOption(opts proto.Message, xt protoreflect.ExtensionType) (string, bool) {
+	if !proto.HasExtension(opts, xt) {
+		return "", false
+	}
+	return proto.GetExtension(opts, xt).(string), true
+}
+
+func (sel *featureSelection) parse(expr string) (map[string]bool, error) {
+	if features, ok := sel.parsed[expr]; ok {
+		return features, nil
+	}
+	features, err := findFeatures(strings.Split(expr, "+"))
+	if err != nil {
+		return nil, err
+	}
+	sel.parsed[expr] = features
+	return features, nil
+}
+
+// forFile returns the features enabled at the file level, which apply to all the
+// messages in the file that do not override them, and to the file's services.
+func (sel *featureSelection) forFile(file *protogen.File) map[string]bool {
+	if expr, ok := featuresOption(file.Desc.Options(), vtproto.E_FeaturesAll); ok {
+		return sel.parsed[expr]
+	}
+	return sel.defaults
+}
+
+// forMessage returns the features enabled for a single message.
+func (sel *featureSelection) forMessage(message *protogen.Message) map[string]bool {
+	opts := message.Desc.Options()
+	if skip, ok := proto.GetExtension(opts, vtproto.E_Skip).(bool); ok && skip {
+		return nil
+	}
+	if expr, ok := featuresOption(opts, vtproto.E_Features); ok {
+		return sel.parsed[expr]
+	}
+	fileOpts := message.Desc.ParentFile().Options()
+	if expr, ok := featuresOption(fileOpts, vtproto.E_FeaturesAll); ok {
+		return sel.parsed[expr]
+	}
+	return sel.defaults
+}
+
+// anyEnabled returns all the features that must be run for the given file, i.e.
+// those that are enabled either for the file itself or for any of its messages.
+func (sel *featureSelection) anyEnabled(file *protogen.File) map[string]bool {
+	enabled := make(map[string]bool)
+	for name := range sel.forFile(file) {
+		enabled[name] = true
+	}
+
+	var visit func(message *protogen.Message)
+	visit = func(message *protogen.Message) {
+		for name := range sel.forMessage(message) {
+			enabled[name] = true
+		}
+		for _, nested := range message.Messages {
+			visit(nested)
+		}
+	}
+	for _, message := range file.Messages {
+		visit(message)
+	}
+	return enabled
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package protohelpers
+
+import (
+	"sort"
+
+	"google.golang.org/protobuf/runtime/protoimpl"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+// AppendDiffs appends to diffs the differences found in the messages nested in the
+// field at path, making their paths relative to the message holding the field.
+func AppendDiffs(diffs []vtproto.FieldDiff, path string, nested []vtproto.FieldDiff) []vtproto.FieldDiff {
+	for _, d := range nested {
+		if d.Path == "" {
+			d.Path = path
+		} else {
+			d.Path = path + "." + d.Path
+		}
+		diffs = append(diffs, d)
+	}
+	return diffs
+}
+
+// DiffExtensions appends to diffs the differences between the extension fields x of
+// the old message and y of the new one, in field number order. Like the generated
+// EqualVT methods, it compares the values with EqualExtension.
+func DiffExtensions(diffs []vtproto.FieldDiff, x, y protoimpl.ExtensionFields) []vtproto.FieldDiff {
+	nums := make([]int32, 0, len(x)+len(y))
+	for num := range x {
+		nums = append(nums, num)
+	}
+	for num := range y {
+		if _, ok := x[num]; !ok {
+			nums = append(nums, num)
+		}
+	}
+	sort.Slice(nums, func(i, j int) bool { return nums[i] < nums[j] })
+
+	for _, num := range nums {
+		fx, xok := x[num]
+		fy, yok := y[num]
+		switch {
+		case !yok:
+			diffs = append(diffs, vtproto.FieldDiff{Path: extensionPath(fx), Kind: vtproto.FieldRemoved, Old: extensionValue(fx)})
+		case !xok:
+			diffs = append(diffs, vtproto.FieldDiff{Path: extensionPath(fy), Kind: vtproto.FieldAdded, New: extensionValue(fy)})
+		case fx.Type() != fy.Type() || !EqualExtension(fx.Type(), fx.Value(), fy.Value()):
+			diffs = append(diffs, vtproto.FieldDiff{Path: extensionPath(fx), Kind: vtproto.FieldChanged, Old: extensionValue(fx), New: extensionValue(fy)})
+		}
+	}
+	return diffs
+}
+
+func extensionPath(f protoimpl.ExtensionFieldV1) string {
+	return "[" + string(f.Type().TypeDescriptor().FullName()) + "]"
+}
+
+// extensionValue returns the Go value of f, as returned by proto.GetExtension.
+func extensionValue(f protoimpl.ExtensionFieldV1) interface{} {
+	return f.Type().InterfaceOf(f.Value())
+}
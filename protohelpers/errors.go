@@ -0,0 +1,101 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package protohelpers
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+// WrapDecodeError returns the *vtproto.DecodeError reported by the generated code
+// when decoding the message md from b fails with err, while decoding the field whose
+// tag starts at offset. If err is a *vtproto.DecodeError returned by a message nested
+// in that field, its path and offset are made relative to b.
+func WrapDecodeError(err error, md protoreflect.MessageDescriptor, b []byte, offset int) error {
+	var num protowire.Number
+	var typ protowire.Type
+	var n int
+	if offset < len(b) {
+		num, typ, n = protowire.ConsumeTag(b[offset:])
+		if n < 0 {
+			num, typ, n = 0, 0, 0
+		}
+	}
+	name := fieldName(md, num)
+
+	nested, ok := err.(*vtproto.DecodeError)
+	if !ok {
+		decodeErr := &vtproto.DecodeError{Offset: offset, Err: err}
+		if num != 0 {
+			decodeErr.PrependField(name, num, typ)
+		}
+		return decodeErr
+	}
+	if num != 0 {
+		// the nested message as a whole may be invalid, e.g. because one of its
+		// required fields is not set: it is then reported at the field holding it
+		nested.Offset += offset + n + nestedOffset(md, num, typ, b[offset+n:])
+		nested.PrependField(name, num, typ)
+	}
+	return nested
+}
+
+// fieldName returns the name of the field num of md in the path of a DecodeError.
+func fieldName(md protoreflect.MessageDescriptor, num protowire.Number) string {
+	if num == 0 {
+		return ""
+	}
+	if fd := md.Fields().ByNumber(num); fd != nil {
+		return string(fd.Name())
+	}
+	if xt, err := protoregistry.GlobalTypes.FindExtensionByNumber(md.FullName(), num); err == nil {
+		return "[" + string(xt.TypeDescriptor().FullName()) + "]"
+	}
+	return strconv.Itoa(int(num))
+}
+
+// nestedOffset returns the offset in b, which holds the value of the field num of md,
+// of the encoding of the message nested in it.
+func nestedOffset(md protoreflect.MessageDescriptor, num protowire.Number, typ protowire.Type, b []byte) int {
+	if typ != protowire.BytesType {
+		// groups are not length-prefixed
+		return 0
+	}
+	_, n := protowire.ConsumeVarint(b)
+	if n < 0 {
+		return 0
+	}
+	fd := md.Fields().ByNumber(num)
+	if fd == nil || !fd.IsMap() {
+		return n
+	}
+
+	// The message is the value of a map entry: find it in the entry.
+	entry, _ := protowire.ConsumeBytes(b)
+	for i := 0; i < len(entry); {
+		num, typ, m := protowire.ConsumeTag(entry[i:])
+		if m < 0 {
+			break
+		}
+		if num == 2 && typ == protowire.BytesType {
+			_, l := protowire.ConsumeVarint(entry[i+m:])
+			if l < 0 {
+				break
+			}
+			return n + i + m + l
+		}
+		l := protowire.ConsumeFieldValue(num, typ, entry[i+m:])
+		if l < 0 {
+			break
+		}
+		i += m + l
+	}
+	return n
+}
@@ -0,0 +1,445 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package protohelpers
+
+import (
+	"bytes"
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+// The helpers in this file are used by the generated code to handle the extension
+// fields whose type is not known at generation time, i.e. extensions declared in a
+// different Go package than the message they extend. They work on the values of
+// the extensions directly, so they never call back into the generated methods of
+// the extended message.
+
+// SizeOfExtension returns the size of the wire encoding of the extension field
+// of type xt with value v.
+func SizeOfExtension(xt protoreflect.ExtensionType, v protoreflect.Value) int {
+	fd := xt.TypeDescriptor()
+	num := fd.Number()
+
+	if fd.IsList() {
+		list := v.List()
+		if list.Len() == 0 {
+			return 0
+		}
+		if fd.IsPacked() {
+			n := 0
+			for i := 0; i < list.Len(); i++ {
+				n += sizeOfValue(fd, list.Get(i))
+			}
+			return protowire.SizeTag(num) + protowire.SizeBytes(n)
+		}
+		n := 0
+		for i := 0; i < list.Len(); i++ {
+			n += sizeOfField(fd, list.Get(i))
+		}
+		return n
+	}
+	return sizeOfField(fd, v)
+}
+
+func sizeOfField(fd protoreflect.FieldDescriptor, v protoreflect.Value) int {
+	switch fd.Kind() {
+	case protoreflect.GroupKind:
+		return 2*protowire.SizeTag(fd.Number()) + sizeOfValue(fd, v)
+	case protoreflect.MessageKind, protoreflect.StringKind, protoreflect.BytesKind:
+		return protowire.SizeTag(fd.Number()) + protowire.SizeBytes(sizeOfValue(fd, v))
+	default:
+		return protowire.SizeTag(fd.Number()) + sizeOfValue(fd, v)
+	}
+}
+
+func sizeOfValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) int {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return 1
+	case protoreflect.EnumKind:
+		return protowire.SizeVarint(uint64(v.Enum()))
+	case protoreflect.Int32Kind, protoreflect.Int64Kind:
+		return protowire.SizeVarint(uint64(v.Int()))
+	case protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+		return protowire.SizeVarint(protowire.EncodeZigZag(v.Int()))
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind:
+		return protowire.SizeVarint(v.Uint())
+	case protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind, protoreflect.FloatKind:
+		return 4
+	case protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind, protoreflect.DoubleKind:
+		return 8
+	case protoreflect.StringKind:
+		return len(v.String())
+	case protoreflect.BytesKind:
+		return len(v.Bytes())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return proto.Size(v.Message().Interface())
+	default:
+		panic("unexpected kind: " + fd.Kind().String())
+	}
+}
+
+// AppendExtension appends the wire encoding of the extension field of type xt
+// with value v to b. Exactly SizeOfExtension(xt, v) bytes are appended, so the
+// generated code can use it to marshal into a buffer that has already been sized.
+func AppendExtension(b []byte, xt protoreflect.ExtensionType, v protoreflect.Value) ([]byte, error) {
+	fd := xt.TypeDescriptor()
+	num := fd.Number()
+
+	if fd.IsList() {
+		list := v.List()
+		if list.Len() == 0 {
+			return b, nil
+		}
+		if fd.IsPacked() {
+			n := 0
+			for i := 0; i < list.Len(); i++ {
+				n += sizeOfValue(fd, list.Get(i))
+			}
+			b = protowire.AppendTag(b, num, protowire.BytesType)
+			b = protowire.AppendVarint(b, uint64(n))
+			for i := 0; i < list.Len(); i++ {
+				b = appendValue(b, fd, list.Get(i))
+			}
+			return b, nil
+		}
+		var err error
+		for i := 0; i < list.Len(); i++ {
+			if b, err = appendField(b, fd, list.Get(i)); err != nil {
+				return b, err
+			}
+		}
+		return b, nil
+	}
+	return appendField(b, fd, v)
+}
+
+var marshalOptions = proto.MarshalOptions{Deterministic: true}
+
+func appendField(b []byte, fd protoreflect.FieldDescriptor, v protoreflect.Value) ([]byte, error) {
+	num := fd.Number()
+	switch fd.Kind() {
+	case protoreflect.GroupKind:
+		b = protowire.AppendTag(b, num, protowire.StartGroupType)
+		b, err := marshalOptions.MarshalAppend(b, v.Message().Interface())
+		if err != nil {
+			return b, err
+		}
+		return protowire.AppendTag(b, num, protowire.EndGroupType), nil
+	case protoreflect.MessageKind:
+		m := v.Message().Interface()
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		b = protowire.AppendVarint(b, uint64(proto.Size(m)))
+		return marshalOptions.MarshalAppend(b, m)
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		b = protowire.AppendVarint(b, uint64(sizeOfValue(fd, v)))
+		return appendValue(b, fd, v), nil
+	default:
+		b = protowire.AppendTag(b, num, wireTypes[fd.Kind()])
+		return appendValue(b, fd, v), nil
+	}
+}
+
+func appendValue(b []byte, fd protoreflect.FieldDescriptor, v protoreflect.Value) []byte {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return protowire.AppendVarint(b, protowire.EncodeBool(v.Bool()))
+	case protoreflect.EnumKind:
+		return protowire.AppendVarint(b, uint64(v.Enum()))
+	case protoreflect.Int32Kind, protoreflect.Int64Kind:
+		return protowire.AppendVarint(b, uint64(v.Int()))
+	case protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+		return protowire.AppendVarint(b, protowire.EncodeZigZag(v.Int()))
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind:
+		return protowire.AppendVarint(b, v.Uint())
+	case protoreflect.Sfixed32Kind:
+		return protowire.AppendFixed32(b, uint32(v.Int()))
+	case protoreflect.Fixed32Kind:
+		return protowire.AppendFixed32(b, uint32(v.Uint()))
+	case protoreflect.FloatKind:
+		return protowire.AppendFixed32(b, math.Float32bits(float32(v.Float())))
+	case protoreflect.Sfixed64Kind:
+		return protowire.AppendFixed64(b, uint64(v.Int()))
+	case protoreflect.Fixed64Kind:
+		return protowire.AppendFixed64(b, v.Uint())
+	case protoreflect.DoubleKind:
+		return protowire.AppendFixed64(b, math.Float64bits(v.Float()))
+	case protoreflect.StringKind:
+		return append(b, v.String()...)
+	case protoreflect.BytesKind:
+		return append(b, v.Bytes()...)
+	default:
+		panic("unexpected kind: " + fd.Kind().String())
+	}
+}
+
+var wireTypes = map[protoreflect.Kind]protowire.Type{
+	protoreflect.BoolKind:     protowire.VarintType,
+	protoreflect.EnumKind:     protowire.VarintType,
+	protoreflect.Int32Kind:    protowire.VarintType,
+	protoreflect.Sint32Kind:   protowire.VarintType,
+	protoreflect.Uint32Kind:   protowire.VarintType,
+	protoreflect.Int64Kind:    protowire.VarintType,
+	protoreflect.Sint64Kind:   protowire.VarintType,
+	protoreflect.Uint64Kind:   protowire.VarintType,
+	protoreflect.Sfixed32Kind: protowire.Fixed32Type,
+	protoreflect.Fixed32Kind:  protowire.Fixed32Type,
+	protoreflect.FloatKind:    protowire.Fixed32Type,
+	protoreflect.Sfixed64Kind: protowire.Fixed64Type,
+	protoreflect.Fixed64Kind:  protowire.Fixed64Type,
+	protoreflect.DoubleKind:   protowire.Fixed64Type,
+	protoreflect.StringKind:   protowire.BytesType,
+	protoreflect.BytesKind:    protowire.BytesType,
+	protoreflect.MessageKind:  protowire.BytesType,
+	protoreflect.GroupKind:    protowire.StartGroupType,
+}
+
+// UnmarshalExtension decodes b, which must hold a single field (tag and value) in an
+// extension range of m, and merges it into m. The type of the extension is looked up
+// in protoregistry.GlobalTypes: if it is not registered, or if the wire type in b does
+// not match it, UnmarshalExtension returns false and the caller is expected to keep
+// b as an unknown field. depth is the recursion depth left for decoding the messages
+// nested in m.
+func UnmarshalExtension(m protoreflect.Message, b []byte, depth int) (bool, error) {
+	num, wtyp, n := protowire.ConsumeTag(b)
+	if n < 0 {
+		return false, protowire.ParseError(n)
+	}
+	b = b[n:]
+
+	xt, err := protoregistry.GlobalTypes.FindExtensionByNumber(m.Descriptor().FullName(), num)
+	if err == protoregistry.NotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	fd := xt.TypeDescriptor()
+
+	if fd.IsList() {
+		list := m.Mutable(fd).List()
+		if wtyp == protowire.BytesType && wireTypes[fd.Kind()] != protowire.BytesType {
+			packed, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return false, protowire.ParseError(n)
+			}
+			for len(packed) > 0 {
+				v, n := consumeScalar(fd, wireTypes[fd.Kind()], packed)
+				if n < 0 {
+					return false, protowire.ParseError(n)
+				}
+				list.Append(v)
+				packed = packed[n:]
+			}
+			return true, nil
+		}
+		if wtyp != wireTypes[fd.Kind()] {
+			return false, nil
+		}
+		switch fd.Kind() {
+		case protoreflect.MessageKind, protoreflect.GroupKind:
+			v := list.NewElement()
+			if err := unmarshalMessage(fd, wtyp, b, v.Message(), depth); err != nil {
+				return false, err
+			}
+			list.Append(v)
+		default:
+			v, n := consumeScalar(fd, wtyp, b)
+			if n < 0 {
+				return false, protowire.ParseError(n)
+			}
+			list.Append(v)
+		}
+		return true, nil
+	}
+
+	if wtyp != wireTypes[fd.Kind()] {
+		return false, nil
+	}
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if err := unmarshalMessage(fd, wtyp, b, m.Mutable(fd).Message(), depth); err != nil {
+			return false, err
+		}
+	default:
+		v, n := consumeScalar(fd, wtyp, b)
+		if n < 0 {
+			return false, protowire.ParseError(n)
+		}
+		m.Set(fd, v)
+	}
+	return true, nil
+}
+
+func unmarshalMessage(fd protoreflect.FieldDescriptor, wtyp protowire.Type, b []byte, m protoreflect.Message, depth int) error {
+	// A zero RecursionLimit selects the default limit of the proto package
+	if depth <= 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var v []byte
+	var n int
+	if wtyp == protowire.StartGroupType {
+		v, n = protowire.ConsumeGroup(fd.Number(), b)
+	} else {
+		v, n = protowire.ConsumeBytes(b)
+	}
+	if n < 0 {
+		return protowire.ParseError(n)
+	}
+	opts := proto.UnmarshalOptions{Merge: true, AllowPartial: true, RecursionLimit: depth}
+	return opts.Unmarshal(v, m.Interface())
+}
+
+func consumeScalar(fd protoreflect.FieldDescriptor, wtyp protowire.Type, b []byte) (protoreflect.Value, int) {
+	switch wtyp {
+	case protowire.VarintType:
+		v, n := protowire.ConsumeVarint(b)
+		if n < 0 {
+			return protoreflect.Value{}, n
+		}
+		switch fd.Kind() {
+		case protoreflect.BoolKind:
+			return protoreflect.ValueOfBool(protowire.DecodeBool(v)), n
+		case protoreflect.EnumKind:
+			return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)), n
+		case protoreflect.Int32Kind:
+			return protoreflect.ValueOfInt32(int32(v)), n
+		case protoreflect.Int64Kind:
+			return protoreflect.ValueOfInt64(int64(v)), n
+		case protoreflect.Sint32Kind:
+			return protoreflect.ValueOfInt32(int32(protowire.DecodeZigZag(v & math.MaxUint32))), n
+		case protoreflect.Sint64Kind:
+			return protoreflect.ValueOfInt64(protowire.DecodeZigZag(v)), n
+		case protoreflect.Uint32Kind:
+			return protoreflect.ValueOfUint32(uint32(v)), n
+		default:
+			return protoreflect.ValueOfUint64(v), n
+		}
+	case protowire.Fixed32Type:
+		v, n := protowire.ConsumeFixed32(b)
+		if n < 0 {
+			return protoreflect.Value{}, n
+		}
+		switch fd.Kind() {
+		case protoreflect.Sfixed32Kind:
+			return protoreflect.ValueOfInt32(int32(v)), n
+		case protoreflect.FloatKind:
+			return protoreflect.ValueOfFloat32(math.Float32frombits(v)), n
+		default:
+			return protoreflect.ValueOfUint32(v), n
+		}
+	case protowire.Fixed64Type:
+		v, n := protowire.ConsumeFixed64(b)
+		if n < 0 {
+			return protoreflect.Value{}, n
+		}
+		switch fd.Kind() {
+		case protoreflect.Sfixed64Kind:
+			return protoreflect.ValueOfInt64(int64(v)), n
+		case protoreflect.DoubleKind:
+			return protoreflect.ValueOfFloat64(math.Float64frombits(v)), n
+		default:
+			return protoreflect.ValueOfUint64(v), n
+		}
+	default:
+		v, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return protoreflect.Value{}, n
+		}
+		if fd.Kind() == protoreflect.StringKind {
+			return protoreflect.ValueOfString(string(v)), n
+		}
+		return protoreflect.ValueOfBytes(append([]byte{}, v...)), n
+	}
+}
+
+// EqualExtension returns whether the values x and y of the extension field of type xt are equal.
+// Like the generated EqualVT methods, floating point values are compared with ==, so NaN values
+// are never equal.
+func EqualExtension(xt protoreflect.ExtensionType, x, y protoreflect.Value) bool {
+	return EqualExtensionWithOptions(xt, x, y, vtproto.EqualOptions{})
+}
+
+// EqualExtensionWithOptions is like EqualExtension, but compares floating point values
+// according to opts. Messages are always compared with proto.Equal.
+func EqualExtensionWithOptions(xt protoreflect.ExtensionType, x, y protoreflect.Value, opts vtproto.EqualOptions) bool {
+	fd := xt.TypeDescriptor()
+	if fd.IsList() {
+		lx, ly := x.List(), y.List()
+		if lx.Len() != ly.Len() {
+			return false
+		}
+		for i := 0; i < lx.Len(); i++ {
+			if !equalValue(fd, lx.Get(i), ly.Get(i), opts) {
+				return false
+			}
+		}
+		return true
+	}
+	return equalValue(fd, x, y, opts)
+}
+
+func equalValue(fd protoreflect.FieldDescriptor, x, y protoreflect.Value, opts vtproto.EqualOptions) bool {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return proto.Equal(x.Message().Interface(), y.Message().Interface())
+	case protoreflect.BytesKind:
+		return bytes.Equal(x.Bytes(), y.Bytes())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return opts.EqualFloat64(x.Float(), y.Float())
+	default:
+		return x.Interface() == y.Interface()
+	}
+}
+
+// CloneExtension returns a deep copy of the value v of the extension field of type xt.
+func CloneExtension(xt protoreflect.ExtensionType, v protoreflect.Value) protoreflect.Value {
+	fd := xt.TypeDescriptor()
+	if fd.IsList() {
+		clone := xt.New()
+		list, cloneList := v.List(), clone.List()
+		for i := 0; i < list.Len(); i++ {
+			cloneList.Append(cloneValue(fd, list.Get(i)))
+		}
+		return clone
+	}
+	return cloneValue(fd, v)
+}
+
+// MergeExtension merges the value v of the extension field of type xt into m, like
+// proto.Merge does: the elements of lists are appended, messages are merged, and any
+// other value replaces the current one.
+func MergeExtension(m protoreflect.Message, xt protoreflect.ExtensionType, v protoreflect.Value) {
+	fd := xt.TypeDescriptor()
+	switch {
+	case fd.IsList():
+		list, dst := v.List(), m.Mutable(fd).List()
+		for i := 0; i < list.Len(); i++ {
+			dst.Append(cloneValue(fd, list.Get(i)))
+		}
+	case fd.Message() != nil:
+		proto.Merge(m.Mutable(fd).Message().Interface(), v.Message().Interface())
+	default:
+		m.Set(fd, cloneValue(fd, v))
+	}
+}
+
+func cloneValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) protoreflect.Value {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return protoreflect.ValueOfMessage(proto.Clone(v.Message().Interface()).ProtoReflect())
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes(append([]byte{}, v.Bytes()...))
+	default:
+		return v
+	}
+}
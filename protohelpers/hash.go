@@ -0,0 +1,194 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package protohelpers
+
+import (
+	"encoding/binary"
+	"hash"
+	"hash/fnv"
+	"io"
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// HashScratch is the buffer through which the hash helpers write values to a
+// hash.Hash64. Slices passed to the Write method of an interface are moved to the
+// heap, so the generated HashVT methods declare a single HashScratch per call
+// instead of allocating one for every value.
+type HashScratch [64]byte
+
+// HashUint64 writes v to h.
+func HashUint64(h hash.Hash64, s *HashScratch, v uint64) {
+	binary.LittleEndian.PutUint64(s[:8], v)
+	h.Write(s[:8])
+}
+
+// HashBool writes v to h.
+func HashBool(h hash.Hash64, s *HashScratch, v bool) {
+	if v {
+		HashUint64(h, s, 1)
+	} else {
+		HashUint64(h, s, 0)
+	}
+}
+
+// HashFloat64 writes v to h. Negative zero is written as zero, since the two
+// compare equal.
+func HashFloat64(h hash.Hash64, s *HashScratch, v float64) {
+	if v == 0 {
+		v = 0
+	}
+	HashUint64(h, s, math.Float64bits(v))
+}
+
+// HashFloat32 writes v to h. Negative zero is written as zero, since the two
+// compare equal.
+func HashFloat32(h hash.Hash64, s *HashScratch, v float32) {
+	if v == 0 {
+		v = 0
+	}
+	HashUint64(h, s, uint64(math.Float32bits(v)))
+}
+
+// HashString writes the length of v followed by v to h. Unless h implements
+// io.StringWriter, v is copied to it through s rather than converted to a slice.
+func HashString(h hash.Hash64, s *HashScratch, v string) {
+	HashUint64(h, s, uint64(len(v)))
+	if sw, ok := h.(io.StringWriter); ok {
+		sw.WriteString(v)
+		return
+	}
+	for len(v) > 0 {
+		n := copy(s[:], v)
+		h.Write(s[:n])
+		v = v[n:]
+	}
+}
+
+// HashBytes writes the length of v followed by v to h.
+func HashBytes(h hash.Hash64, s *HashScratch, v []byte) {
+	HashUint64(h, s, uint64(len(v)))
+	h.Write(v)
+}
+
+// HashMessage writes m to h using reflection. It is used by the generated HashVT
+// methods for the messages that don't implement HashVT themselves, and hashes
+// messages that are equal according to proto.Equal to the same value.
+func HashMessage(h hash.Hash64, m proto.Message) {
+	var s HashScratch
+	hashMessage(h, &s, m.ProtoReflect())
+}
+
+// HashExtension writes the value v of the extension field of type xt to h.
+func HashExtension(h hash.Hash64, xt protoreflect.ExtensionType, v protoreflect.Value) {
+	var s HashScratch
+	hashField(h, &s, xt.TypeDescriptor(), v)
+}
+
+func hashMessage(h hash.Hash64, s *HashScratch, m protoreflect.Message) {
+	if vtpb, ok := m.Interface().(interface{ HashVT(hash.Hash64) }); ok {
+		vtpb.HashVT(h)
+		return
+	}
+
+	// The fields are ranged over in an undefined order, so each of them is hashed
+	// separately and the results are combined with a commutative operation.
+	var sum uint64
+	entry := fnv.New64a()
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if !fd.HasPresence() && (fd.Kind() == protoreflect.FloatKind || fd.Kind() == protoreflect.DoubleKind) && v.Float() == 0 {
+			// negative zero is populated, but equal to an unset field
+			return true
+		}
+		entry.Reset()
+		HashUint64(entry, s, uint64(fd.Number()))
+		hashField(entry, s, fd, v)
+		sum += entry.Sum64()
+		return true
+	})
+	HashUint64(h, s, sum)
+
+	// proto.Equal compares the unknown fields with different numbers regardless
+	// of their order.
+	unknown := m.GetUnknown()
+	if len(unknown) == 0 {
+		return
+	}
+	fields := make(map[protowire.Number][]byte)
+	for len(unknown) > 0 {
+		num, typ, n := protowire.ConsumeTag(unknown)
+		if n < 0 {
+			h.Write(unknown)
+			return
+		}
+		l := protowire.ConsumeFieldValue(num, typ, unknown[n:])
+		if l < 0 {
+			h.Write(unknown)
+			return
+		}
+		fields[num] = append(fields[num], unknown[:n+l]...)
+		unknown = unknown[n+l:]
+	}
+	sum = 0
+	for _, b := range fields {
+		entry.Reset()
+		entry.Write(b)
+		sum += entry.Sum64()
+	}
+	HashUint64(h, s, sum)
+}
+
+func hashField(h hash.Hash64, s *HashScratch, fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+	switch {
+	case fd.IsList():
+		list := v.List()
+		HashUint64(h, s, uint64(list.Len()))
+		for i := 0; i < list.Len(); i++ {
+			hashValue(h, s, fd, list.Get(i))
+		}
+	case fd.IsMap():
+		var sum uint64
+		entry := fnv.New64a()
+		v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			entry.Reset()
+			hashValue(entry, s, fd.MapKey(), k.Value())
+			hashValue(entry, s, fd.MapValue(), v)
+			sum += entry.Sum64()
+			return true
+		})
+		HashUint64(h, s, uint64(v.Map().Len()))
+		HashUint64(h, s, sum)
+	default:
+		hashValue(h, s, fd, v)
+	}
+}
+
+func hashValue(h hash.Hash64, s *HashScratch, fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		hashMessage(h, s, v.Message())
+		HashUint64(h, s, 0)
+	case protoreflect.BoolKind:
+		HashBool(h, s, v.Bool())
+	case protoreflect.EnumKind:
+		HashUint64(h, s, uint64(v.Enum()))
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		HashUint64(h, s, uint64(v.Int()))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		HashUint64(h, s, v.Uint())
+	case protoreflect.FloatKind:
+		HashFloat32(h, s, float32(v.Float()))
+	case protoreflect.DoubleKind:
+		HashFloat64(h, s, v.Float())
+	case protoreflect.StringKind:
+		HashString(h, s, v.String())
+	case protoreflect.BytesKind:
+		HashBytes(h, s, v.Bytes())
+	}
+}
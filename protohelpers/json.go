@@ -0,0 +1,84 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package protohelpers
+
+import (
+	"sort"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/runtime/protoimpl"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+// MarshalJSONExtensions writes the extension fields x to w, sorted by their full
+// name like protojson does. Each extension is named "[full.name]".
+func MarshalJSONExtensions(w *vtproto.JSONWriter, x protoimpl.ExtensionFields) error {
+	fields := make([]protoimpl.ExtensionFieldV1, 0, len(x))
+	for _, f := range x {
+		if v := f.Value(); !v.IsValid() || (f.Type().TypeDescriptor().IsList() && v.List().Len() == 0) {
+			continue
+		}
+		fields = append(fields, f)
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Type().TypeDescriptor().FullName() < fields[j].Type().TypeDescriptor().FullName()
+	})
+
+	for _, f := range fields {
+		fd := f.Type().TypeDescriptor()
+		w.Name(extensionPath(f))
+		if err := w.Value(fd, f.Value()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnmarshalJSONExtension reads the value of the member name of the object holding
+// m, which is not one of its fields. If name is "[full.name]" and names a
+// registered extension of m, the value is stored in that extension field; it is
+// handled like an unknown field otherwise.
+func UnmarshalJSONExtension(r *vtproto.JSONReader, m proto.Message, name string) error {
+	if len(name) < 2 || name[0] != '[' || name[len(name)-1] != ']' {
+		return r.UnknownField(name)
+	}
+	xt, err := protoregistry.GlobalTypes.FindExtensionByName(protoreflect.FullName(name[1 : len(name)-1]))
+	if err != nil {
+		if err == protoregistry.NotFound {
+			return r.UnknownField(name)
+		}
+		return r.Errorf("unable to resolve %s: %v", name, err)
+	}
+	fd := xt.TypeDescriptor()
+	msg := m.ProtoReflect()
+	if fd.ContainingMessage().FullName() != msg.Descriptor().FullName() {
+		return r.Errorf("message %v cannot be extended by %v", msg.Descriptor().FullName(), fd.FullName())
+	}
+	if msg.Has(fd) {
+		return r.Errorf("duplicate field %s", name)
+	}
+	raw, err := r.ReadRaw()
+	if err != nil {
+		return err
+	}
+
+	// extensions are rare enough to be read with protojson, in a message holding
+	// only this one
+	b := make([]byte, 0, len(name)+len(raw)+5)
+	b = append(append(append(b, '{'), strconv.Quote(name)...), ':')
+	b = append(append(b, raw...), '}')
+	tmp := msg.New()
+	if err := r.ProtoJSON().Unmarshal(b, tmp.Interface()); err != nil {
+		return err
+	}
+	if tmp.Has(fd) {
+		msg.Set(fd, tmp.Get(fd))
+	}
+	return nil
+}
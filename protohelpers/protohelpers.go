@@ -0,0 +1,142 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Copyright (c) 2013, The GoGo Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package protohelpers provides the runtime helpers used by the code generated
+// by protoc-gen-go-vtproto with the shared-helpers option, instead of emitting
+// a private copy of them in every generated package.
+package protohelpers
+
+import (
+	"fmt"
+	"io"
+	"math/bits"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+var (
+	// ErrInvalidLength is returned when decoding a negative length.
+	ErrInvalidLength = fmt.Errorf("proto: negative length found during unmarshaling")
+	// ErrIntOverflow is returned when decoding a varint representation of an integer that overflows 64 bits.
+	ErrIntOverflow = fmt.Errorf("proto: integer overflow")
+	// ErrUnexpectedEndOfGroup is returned when decoding a group end without a corresponding group start.
+	ErrUnexpectedEndOfGroup = fmt.Errorf("proto: unexpected end of group")
+	// ErrInvalidUTF8 is returned when decoding a string field that must be valid UTF-8 but is not.
+	ErrInvalidUTF8 = fmt.Errorf("proto: string field contains invalid UTF-8")
+	// ErrRecursionLimitExceeded is returned when decoding messages nested deeper than the recursion limit.
+	ErrRecursionLimitExceeded = fmt.Errorf("proto: exceeded maximum recursion depth")
+	// ErrBufferTooSmall is returned when marshaling to a buffer that cannot hold the message.
+	ErrBufferTooSmall = vtproto.ErrBufferTooSmall
+	// ErrSizeMismatch is returned when a message is not of the size reported by SizeVT once marshalled.
+	ErrSizeMismatch = vtproto.ErrSizeMismatch
+)
+
+// EncodeVarint encodes v as a varint that ends right before offset in dAtA,
+// and returns the offset at which the encoded value starts. If v doesn't fit
+// before offset, nothing is written and the returned offset is negative.
+func EncodeVarint(dAtA []byte, offset int, v uint64) int {
+	offset -= SizeOfVarint(v)
+	if offset < 0 {
+		return offset
+	}
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+// SizeOfVarint returns the size of the varint encoding of x.
+func SizeOfVarint(x uint64) (n int) {
+	return (bits.Len64(x|1) + 6) / 7
+}
+
+// SizeOfZigzag returns the size of the zigzag varint encoding of x.
+func SizeOfZigzag(x uint64) (n int) {
+	return SizeOfVarint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+// Skip returns the length of the first field (tag included) encoded in dAtA.
+func Skip(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLength
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroup
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLength
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
@@ -0,0 +1,70 @@
+package protohelpers
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestSizes(t *testing.T) {
+	for _, v := range []uint64{0, 1, 127, 128, 1 << 20, 1<<63 - 1, 1 << 63, ^uint64(0)} {
+		require.Equal(t, protowire.SizeVarint(v), SizeOfVarint(v))
+		require.Equal(t, protowire.SizeVarint(protowire.EncodeZigZag(int64(v))), SizeOfZigzag(v))
+
+		buf := make([]byte, SizeOfVarint(v))
+		require.Equal(t, 0, EncodeVarint(buf, len(buf), v))
+		require.Equal(t, protowire.AppendVarint(nil, v), buf)
+	}
+}
+
+func TestEncodeVarintShortBuffer(t *testing.T) {
+	buf := []byte{0xff, 0xff}
+	require.Negative(t, EncodeVarint(buf, len(buf), 1<<20))
+	require.Equal(t, []byte{0xff, 0xff}, buf)
+	require.Equal(t, 1, EncodeVarint(buf, len(buf), 1))
+}
+
+func TestSkip(t *testing.T) {
+	var b []byte
+	b = protowire.AppendTag(b, 1, protowire.VarintType)
+	b = protowire.AppendVarint(b, 1<<50)
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	b = protowire.AppendString(b, "hello")
+	b = protowire.AppendTag(b, 3, protowire.StartGroupType)
+	b = protowire.AppendTag(b, 4, protowire.Fixed32Type)
+	b = protowire.AppendFixed32(b, 42)
+	b = protowire.AppendTag(b, 3, protowire.EndGroupType)
+	b = protowire.AppendTag(b, 5, protowire.Fixed64Type)
+	b = protowire.AppendFixed64(b, 42)
+
+	for len(b) > 0 {
+		_, _, tagLen := protowire.ConsumeTag(b)
+		num, typ, _ := protowire.ConsumeTag(b)
+		expected := tagLen + protowire.ConsumeFieldValue(num, typ, b[tagLen:])
+
+		n, err := Skip(b)
+		require.NoError(t, err)
+		require.Equal(t, expected, n)
+		b = b[n:]
+	}
+
+	_, err := Skip(protowire.AppendTag(nil, 1, protowire.EndGroupType))
+	require.Equal(t, ErrUnexpectedEndOfGroup, err)
+}
+
+func TestHashString(t *testing.T) {
+	var s HashScratch
+	for _, v := range []string{"", "short", strings.Repeat("long", 100)} {
+		h := fnv.New64a()
+		HashString(h, &s, v)
+
+		expected := fnv.New64a()
+		expected.Write(binary.LittleEndian.AppendUint64(nil, uint64(len(v))))
+		expected.Write([]byte(v))
+		require.Equal(t, expected.Sum64(), h.Sum64(), "length %d", len(v))
+	}
+}
@@ -0,0 +1,429 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.20.0
+// source: deterministic/deterministic.proto
+
+package deterministic
+
+import (
+	_ "github.com/planetscale/vtprotobuf/vtproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Maps struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StringKeys   map[string]int64   `protobuf:"bytes,1,rep,name=string_keys,json=stringKeys,proto3" json:"string_keys,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	BoolKeys     map[bool]string    `protobuf:"bytes,2,rep,name=bool_keys,json=boolKeys,proto3" json:"bool_keys,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Int32Keys    map[int32][]byte   `protobuf:"bytes,3,rep,name=int32_keys,json=int32Keys,proto3" json:"int32_keys,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Int64Keys    map[int64]*Nested  `protobuf:"bytes,4,rep,name=int64_keys,json=int64Keys,proto3" json:"int64_keys,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Uint32Keys   map[uint32]bool    `protobuf:"bytes,5,rep,name=uint32_keys,json=uint32Keys,proto3" json:"uint32_keys,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Uint64Keys   map[uint64]float64 `protobuf:"bytes,6,rep,name=uint64_keys,json=uint64Keys,proto3" json:"uint64_keys,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Sint32Keys   map[int32]float32  `protobuf:"bytes,7,rep,name=sint32_keys,json=sint32Keys,proto3" json:"sint32_keys,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	Sint64Keys   map[int64]string   `protobuf:"bytes,8,rep,name=sint64_keys,json=sint64Keys,proto3" json:"sint64_keys,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Fixed32Keys  map[uint32]int32   `protobuf:"bytes,9,rep,name=fixed32_keys,json=fixed32Keys,proto3" json:"fixed32_keys,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Fixed64Keys  map[uint64]int32   `protobuf:"bytes,10,rep,name=fixed64_keys,json=fixed64Keys,proto3" json:"fixed64_keys,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Sfixed32Keys map[int32]int32    `protobuf:"bytes,11,rep,name=sfixed32_keys,json=sfixed32Keys,proto3" json:"sfixed32_keys,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Sfixed64Keys map[int64]int32    `protobuf:"bytes,12,rep,name=sfixed64_keys,json=sfixed64Keys,proto3" json:"sfixed64_keys,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Nested       []*Nested          `protobuf:"bytes,13,rep,name=nested,proto3" json:"nested,omitempty"`
+}
+
+func (x *Maps) Reset() {
+	*x = Maps{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deterministic_deterministic_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Maps) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Maps) ProtoMessage() {}
+
+func (x *Maps) ProtoReflect() protoreflect.Message {
+	mi := &file_deterministic_deterministic_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Maps.ProtoReflect.Descriptor instead.
+func (*Maps) Descriptor() ([]byte, []int) {
+	return file_deterministic_deterministic_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Maps) GetStringKeys() map[string]int64 {
+	if x != nil {
+		return x.StringKeys
+	}
+	return nil
+}
+
+func (x *Maps) GetBoolKeys() map[bool]string {
+	if x != nil {
+		return x.BoolKeys
+	}
+	return nil
+}
+
+func (x *Maps) GetInt32Keys() map[int32][]byte {
+	if x != nil {
+		return x.Int32Keys
+	}
+	return nil
+}
+
+func (x *Maps) GetInt64Keys() map[int64]*Nested {
+	if x != nil {
+		return x.Int64Keys
+	}
+	return nil
+}
+
+func (x *Maps) GetUint32Keys() map[uint32]bool {
+	if x != nil {
+		return x.Uint32Keys
+	}
+	return nil
+}
+
+func (x *Maps) GetUint64Keys() map[uint64]float64 {
+	if x != nil {
+		return x.Uint64Keys
+	}
+	return nil
+}
+
+func (x *Maps) GetSint32Keys() map[int32]float32 {
+	if x != nil {
+		return x.Sint32Keys
+	}
+	return nil
+}
+
+func (x *Maps) GetSint64Keys() map[int64]string {
+	if x != nil {
+		return x.Sint64Keys
+	}
+	return nil
+}
+
+func (x *Maps) GetFixed32Keys() map[uint32]int32 {
+	if x != nil {
+		return x.Fixed32Keys
+	}
+	return nil
+}
+
+func (x *Maps) GetFixed64Keys() map[uint64]int32 {
+	if x != nil {
+		return x.Fixed64Keys
+	}
+	return nil
+}
+
+func (x *Maps) GetSfixed32Keys() map[int32]int32 {
+	if x != nil {
+		return x.Sfixed32Keys
+	}
+	return nil
+}
+
+func (x *Maps) GetSfixed64Keys() map[int64]int32 {
+	if x != nil {
+		return x.Sfixed64Keys
+	}
+	return nil
+}
+
+func (x *Maps) GetNested() []*Nested {
+	if x != nil {
+		return x.Nested
+	}
+	return nil
+}
+
+type Nested struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Labels map[string]string `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Nested) Reset() {
+	*x = Nested{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_deterministic_deterministic_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Nested) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nested) ProtoMessage() {}
+
+func (x *Nested) ProtoReflect() protoreflect.Message {
+	mi := &file_deterministic_deterministic_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nested.ProtoReflect.Descriptor instead.
+func (*Nested) Descriptor() ([]byte, []int) {
+	return file_deterministic_deterministic_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Nested) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+var File_deterministic_deterministic_proto protoreflect.FileDescriptor
+
+var file_deterministic_deterministic_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x2f,
+	0x64, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x2f, 0x76, 0x74, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65,
+	0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcc, 0x0b, 0x0a, 0x04, 0x4d, 0x61, 0x70,
+	0x73, 0x12, 0x36, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x4d, 0x61, 0x70, 0x73, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x62, 0x6f, 0x6f,
+	0x6c, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x4d,
+	0x61, 0x70, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x08, 0x62, 0x6f, 0x6f, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x4d, 0x61, 0x70, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4b, 0x65, 0x79, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4b, 0x65, 0x79, 0x73,
+	0x12, 0x33, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x4d, 0x61, 0x70, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x4d, 0x61, 0x70,
+	0x73, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0a, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x36, 0x0a,
+	0x0b, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x4d, 0x61, 0x70, 0x73, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x75, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x4d, 0x61, 0x70,
+	0x73, 0x2e, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0a, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x36, 0x0a,
+	0x0b, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x4d, 0x61, 0x70, 0x73, 0x2e, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x73, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x39, 0x0a, 0x0c, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61,
+	0x70, 0x73, 0x2e, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0b, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4b, 0x65, 0x79, 0x73,
+	0x12, 0x39, 0x0a, 0x0c, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6b, 0x65, 0x79, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4d, 0x61, 0x70, 0x73, 0x2e, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x36, 0x34, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x73,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x4d, 0x61, 0x70, 0x73, 0x2e, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x33, 0x32, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x73, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x4d, 0x61, 0x70, 0x73, 0x2e, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
+	0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x73, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x36, 0x34, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1f, 0x0a, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x52, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x1a, 0x3d, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3b, 0x0a, 0x0d, 0x42, 0x6f, 0x6f, 0x6c, 0x4b,
+	0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4b, 0x65, 0x79,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x45, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4b, 0x65, 0x79, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x55, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x55, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x53, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x11, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x12, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x07, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
+	0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
+	0x32, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x10, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x70, 0x0a, 0x06, 0x4e, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x12, 0x2b, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39,
+	0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x1d, 0xe8, 0xe7, 0x1e, 0x01, 0x5a,
+	0x17, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x64, 0x65, 0x74, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x74, 0x69, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_deterministic_deterministic_proto_rawDescOnce sync.Once
+	file_deterministic_deterministic_proto_rawDescData = file_deterministic_deterministic_proto_rawDesc
+)
+
+func file_deterministic_deterministic_proto_rawDescGZIP() []byte {
+	file_deterministic_deterministic_proto_rawDescOnce.Do(func() {
+		file_deterministic_deterministic_proto_rawDescData = protoimpl.X.CompressGZIP(file_deterministic_deterministic_proto_rawDescData)
+	})
+	return file_deterministic_deterministic_proto_rawDescData
+}
+
+var file_deterministic_deterministic_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_deterministic_deterministic_proto_goTypes = []any{
+	(*Maps)(nil),   // 0: Maps
+	(*Nested)(nil), // 1: Nested
+	nil,            // 2: Maps.StringKeysEntry
+	nil,            // 3: Maps.BoolKeysEntry
+	nil,            // 4: Maps.Int32KeysEntry
+	nil,            // 5: Maps.Int64KeysEntry
+	nil,            // 6: Maps.Uint32KeysEntry
+	nil,            // 7: Maps.Uint64KeysEntry
+	nil,            // 8: Maps.Sint32KeysEntry
+	nil,            // 9: Maps.Sint64KeysEntry
+	nil,            // 10: Maps.Fixed32KeysEntry
+	nil,            // 11: Maps.Fixed64KeysEntry
+	nil,            // 12: Maps.Sfixed32KeysEntry
+	nil,            // 13: Maps.Sfixed64KeysEntry
+	nil,            // 14: Nested.LabelsEntry
+}
+var file_deterministic_deterministic_proto_depIdxs = []int32{
+	2,  // 0: Maps.string_keys:type_name -> Maps.StringKeysEntry
+	3,  // 1: Maps.bool_keys:type_name -> Maps.BoolKeysEntry
+	4,  // 2: Maps.int32_keys:type_name -> Maps.Int32KeysEntry
+	5,  // 3: Maps.int64_keys:type_name -> Maps.Int64KeysEntry
+	6,  // 4: Maps.uint32_keys:type_name -> Maps.Uint32KeysEntry
+	7,  // 5: Maps.uint64_keys:type_name -> Maps.Uint64KeysEntry
+	8,  // 6: Maps.sint32_keys:type_name -> Maps.Sint32KeysEntry
+	9,  // 7: Maps.sint64_keys:type_name -> Maps.Sint64KeysEntry
+	10, // 8: Maps.fixed32_keys:type_name -> Maps.Fixed32KeysEntry
+	11, // 9: Maps.fixed64_keys:type_name -> Maps.Fixed64KeysEntry
+	12, // 10: Maps.sfixed32_keys:type_name -> Maps.Sfixed32KeysEntry
+	13, // 11: Maps.sfixed64_keys:type_name -> Maps.Sfixed64KeysEntry
+	1,  // 12: Maps.nested:type_name -> Nested
+	14, // 13: Nested.labels:type_name -> Nested.LabelsEntry
+	1,  // 14: Maps.Int64KeysEntry.value:type_name -> Nested
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_deterministic_deterministic_proto_init() }
+func file_deterministic_deterministic_proto_init() {
+	if File_deterministic_deterministic_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_deterministic_deterministic_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Maps); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_deterministic_deterministic_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Nested); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_deterministic_deterministic_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_deterministic_deterministic_proto_goTypes,
+		DependencyIndexes: file_deterministic_deterministic_proto_depIdxs,
+		MessageInfos:      file_deterministic_deterministic_proto_msgTypes,
+	}.Build()
+	File_deterministic_deterministic_proto = out.File
+	file_deterministic_deterministic_proto_rawDesc = nil
+	file_deterministic_deterministic_proto_goTypes = nil
+	file_deterministic_deterministic_proto_depIdxs = nil
+}
@@ -0,0 +1,74 @@
+package deterministic
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func testMaps() *Maps {
+	m := &Maps{
+		StringKeys:   map[string]int64{},
+		BoolKeys:     map[bool]string{true: "yes", false: "no"},
+		Int32Keys:    map[int32][]byte{},
+		Int64Keys:    map[int64]*Nested{},
+		Uint32Keys:   map[uint32]bool{},
+		Uint64Keys:   map[uint64]float64{},
+		Sint32Keys:   map[int32]float32{},
+		Sint64Keys:   map[int64]string{},
+		Fixed32Keys:  map[uint32]int32{},
+		Fixed64Keys:  map[uint64]int32{},
+		Sfixed32Keys: map[int32]int32{},
+		Sfixed64Keys: map[int64]int32{},
+	}
+	for i := -50; i < 50; i++ {
+		nested := &Nested{Labels: map[string]string{}}
+		for j := 0; j < 10; j++ {
+			nested.Labels[fmt.Sprintf("label-%d", j)] = fmt.Sprint(i * j)
+		}
+		m.StringKeys[fmt.Sprint(i)] = int64(i)
+		m.Int32Keys[int32(i)] = []byte(fmt.Sprint(i))
+		m.Int64Keys[int64(i)] = nested
+		m.Uint32Keys[uint32(i)] = i%2 == 0
+		m.Uint64Keys[uint64(i)] = float64(i) / 3
+		m.Sint32Keys[int32(i)] = float32(i) / 7
+		m.Sint64Keys[int64(i)] = fmt.Sprint(i)
+		m.Fixed32Keys[uint32(i)] = int32(i)
+		m.Fixed64Keys[uint64(i)] = int32(i)
+		m.Sfixed32Keys[int32(i)] = int32(i)
+		m.Sfixed64Keys[int64(i)] = int32(i)
+		m.Nested = append(m.Nested, nested)
+	}
+	return m
+}
+
+func TestMarshalVTDeterministic(t *testing.T) {
+	msg := testMaps()
+
+	expected, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		got, err := msg.MarshalVT()
+		require.NoError(t, err)
+		require.Equal(t, expected, got)
+	}
+}
+
+func TestMarshalVTDeterministicBoolKeys(t *testing.T) {
+	for _, keys := range []map[bool]string{
+		{true: "yes"},
+		{false: "no"},
+		{true: "yes", false: "no"},
+	} {
+		msg := &Maps{BoolKeys: keys}
+		expected, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+		require.NoError(t, err)
+
+		got, err := msg.MarshalVT()
+		require.NoError(t, err)
+		require.Equal(t, expected, got)
+	}
+}
@@ -0,0 +1,6292 @@
+// Code generated by protoc-gen-go-vtproto. DO NOT EDIT.
+// protoc-gen-go-vtproto version: (devel)
+// source: deterministic/deterministic.proto
+
+package deterministic
+
+import (
+	binary "encoding/binary"
+	errors "errors"
+	fmt "fmt"
+	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
+	vtproto "github.com/planetscale/vtprotobuf/vtproto"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	proto "google.golang.org/protobuf/proto"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	hash "hash"
+	fnv "hash/fnv"
+	io "io"
+	math "math"
+	bits "math/bits"
+	sort "sort"
+	strconv "strconv"
+	utf8 "unicode/utf8"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+func (m *Maps) CloneVT() *Maps {
+	if m == nil {
+		return (*Maps)(nil)
+	}
+	r := &Maps{}
+	if rhs := m.StringKeys; rhs != nil {
+		tmpContainer := make(map[string]int64, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v
+		}
+		r.StringKeys = tmpContainer
+	}
+	if rhs := m.BoolKeys; rhs != nil {
+		tmpContainer := make(map[bool]string, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v
+		}
+		r.BoolKeys = tmpContainer
+	}
+	if rhs := m.Int32Keys; rhs != nil {
+		tmpContainer := make(map[int32][]byte, len(rhs))
+		for k, v := range rhs {
+			tmpBytes := make([]byte, len(v))
+			copy(tmpBytes, v)
+			tmpContainer[k] = tmpBytes
+		}
+		r.Int32Keys = tmpContainer
+	}
+	if rhs := m.Int64Keys; rhs != nil {
+		tmpContainer := make(map[int64]*Nested, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.Int64Keys = tmpContainer
+	}
+	if rhs := m.Uint32Keys; rhs != nil {
+		tmpContainer := make(map[uint32]bool, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v
+		}
+		r.Uint32Keys = tmpContainer
+	}
+	if rhs := m.Uint64Keys; rhs != nil {
+		tmpContainer := make(map[uint64]float64, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v
+		}
+		r.Uint64Keys = tmpContainer
+	}
+	if rhs := m.Sint32Keys; rhs != nil {
+		tmpContainer := make(map[int32]float32, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v
+		}
+		r.Sint32Keys = tmpContainer
+	}
+	if rhs := m.Sint64Keys; rhs != nil {
+		tmpContainer := make(map[int64]string, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v
+		}
+		r.Sint64Keys = tmpContainer
+	}
+	if rhs := m.Fixed32Keys; rhs != nil {
+		tmpContainer := make(map[uint32]int32, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v
+		}
+		r.Fixed32Keys = tmpContainer
+	}
+	if rhs := m.Fixed64Keys; rhs != nil {
+		tmpContainer := make(map[uint64]int32, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v
+		}
+		r.Fixed64Keys = tmpContainer
+	}
+	if rhs := m.Sfixed32Keys; rhs != nil {
+		tmpContainer := make(map[int32]int32, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v
+		}
+		r.Sfixed32Keys = tmpContainer
+	}
+	if rhs := m.Sfixed64Keys; rhs != nil {
+		tmpContainer := make(map[int64]int32, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v
+		}
+		r.Sfixed64Keys = tmpContainer
+	}
+	if rhs := m.Nested; rhs != nil {
+		tmpContainer := make([]*Nested, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.Nested = tmpContainer
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *Maps) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *Maps) CopyToVT(dst *Maps) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if dst.StringKeys == nil && len(m.StringKeys) > 0 {
+		dst.StringKeys = make(map[string]int64, len(m.StringKeys))
+	}
+	for k := range dst.StringKeys {
+		if _, ok := m.StringKeys[k]; !ok {
+			delete(dst.StringKeys, k)
+		}
+	}
+	for k, v := range m.StringKeys {
+		dst.StringKeys[k] = v
+	}
+	if dst.BoolKeys == nil && len(m.BoolKeys) > 0 {
+		dst.BoolKeys = make(map[bool]string, len(m.BoolKeys))
+	}
+	for k := range dst.BoolKeys {
+		if _, ok := m.BoolKeys[k]; !ok {
+			delete(dst.BoolKeys, k)
+		}
+	}
+	for k, v := range m.BoolKeys {
+		dst.BoolKeys[k] = v
+	}
+	if dst.Int32Keys == nil && len(m.Int32Keys) > 0 {
+		dst.Int32Keys = make(map[int32][]byte, len(m.Int32Keys))
+	}
+	for k := range dst.Int32Keys {
+		if _, ok := m.Int32Keys[k]; !ok {
+			delete(dst.Int32Keys, k)
+		}
+	}
+	for k, v := range m.Int32Keys {
+		dst.Int32Keys[k] = append(dst.Int32Keys[k][:0], v...)
+	}
+	if dst.Int64Keys == nil && len(m.Int64Keys) > 0 {
+		dst.Int64Keys = make(map[int64]*Nested, len(m.Int64Keys))
+	}
+	for k := range dst.Int64Keys {
+		if _, ok := m.Int64Keys[k]; !ok {
+			delete(dst.Int64Keys, k)
+		}
+	}
+	for k, v := range m.Int64Keys {
+		if dst.Int64Keys[k] == nil {
+			dst.Int64Keys[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.Int64Keys[k])
+		}
+	}
+	if dst.Uint32Keys == nil && len(m.Uint32Keys) > 0 {
+		dst.Uint32Keys = make(map[uint32]bool, len(m.Uint32Keys))
+	}
+	for k := range dst.Uint32Keys {
+		if _, ok := m.Uint32Keys[k]; !ok {
+			delete(dst.Uint32Keys, k)
+		}
+	}
+	for k, v := range m.Uint32Keys {
+		dst.Uint32Keys[k] = v
+	}
+	if dst.Uint64Keys == nil && len(m.Uint64Keys) > 0 {
+		dst.Uint64Keys = make(map[uint64]float64, len(m.Uint64Keys))
+	}
+	for k := range dst.Uint64Keys {
+		if _, ok := m.Uint64Keys[k]; !ok {
+			delete(dst.Uint64Keys, k)
+		}
+	}
+	for k, v := range m.Uint64Keys {
+		dst.Uint64Keys[k] = v
+	}
+	if dst.Sint32Keys == nil && len(m.Sint32Keys) > 0 {
+		dst.Sint32Keys = make(map[int32]float32, len(m.Sint32Keys))
+	}
+	for k := range dst.Sint32Keys {
+		if _, ok := m.Sint32Keys[k]; !ok {
+			delete(dst.Sint32Keys, k)
+		}
+	}
+	for k, v := range m.Sint32Keys {
+		dst.Sint32Keys[k] = v
+	}
+	if dst.Sint64Keys == nil && len(m.Sint64Keys) > 0 {
+		dst.Sint64Keys = make(map[int64]string, len(m.Sint64Keys))
+	}
+	for k := range dst.Sint64Keys {
+		if _, ok := m.Sint64Keys[k]; !ok {
+			delete(dst.Sint64Keys, k)
+		}
+	}
+	for k, v := range m.Sint64Keys {
+		dst.Sint64Keys[k] = v
+	}
+	if dst.Fixed32Keys == nil && len(m.Fixed32Keys) > 0 {
+		dst.Fixed32Keys = make(map[uint32]int32, len(m.Fixed32Keys))
+	}
+	for k := range dst.Fixed32Keys {
+		if _, ok := m.Fixed32Keys[k]; !ok {
+			delete(dst.Fixed32Keys, k)
+		}
+	}
+	for k, v := range m.Fixed32Keys {
+		dst.Fixed32Keys[k] = v
+	}
+	if dst.Fixed64Keys == nil && len(m.Fixed64Keys) > 0 {
+		dst.Fixed64Keys = make(map[uint64]int32, len(m.Fixed64Keys))
+	}
+	for k := range dst.Fixed64Keys {
+		if _, ok := m.Fixed64Keys[k]; !ok {
+			delete(dst.Fixed64Keys, k)
+		}
+	}
+	for k, v := range m.Fixed64Keys {
+		dst.Fixed64Keys[k] = v
+	}
+	if dst.Sfixed32Keys == nil && len(m.Sfixed32Keys) > 0 {
+		dst.Sfixed32Keys = make(map[int32]int32, len(m.Sfixed32Keys))
+	}
+	for k := range dst.Sfixed32Keys {
+		if _, ok := m.Sfixed32Keys[k]; !ok {
+			delete(dst.Sfixed32Keys, k)
+		}
+	}
+	for k, v := range m.Sfixed32Keys {
+		dst.Sfixed32Keys[k] = v
+	}
+	if dst.Sfixed64Keys == nil && len(m.Sfixed64Keys) > 0 {
+		dst.Sfixed64Keys = make(map[int64]int32, len(m.Sfixed64Keys))
+	}
+	for k := range dst.Sfixed64Keys {
+		if _, ok := m.Sfixed64Keys[k]; !ok {
+			delete(dst.Sfixed64Keys, k)
+		}
+	}
+	for k, v := range m.Sfixed64Keys {
+		dst.Sfixed64Keys[k] = v
+	}
+	if len(m.Nested) > cap(dst.Nested) {
+		tmpContainer := make([]*Nested, len(m.Nested))
+		copy(tmpContainer, dst.Nested[:cap(dst.Nested)])
+		dst.Nested = tmpContainer
+	} else {
+		dst.Nested = dst.Nested[:len(m.Nested)]
+	}
+	for k, v := range m.Nested {
+		if dst.Nested[k] == nil {
+			dst.Nested[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.Nested[k])
+		}
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *Nested) CloneVT() *Nested {
+	if m == nil {
+		return (*Nested)(nil)
+	}
+	r := &Nested{}
+	if rhs := m.Labels; rhs != nil {
+		tmpContainer := make(map[string]string, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v
+		}
+		r.Labels = tmpContainer
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *Nested) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *Nested) CopyToVT(dst *Nested) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if dst.Labels == nil && len(m.Labels) > 0 {
+		dst.Labels = make(map[string]string, len(m.Labels))
+	}
+	for k := range dst.Labels {
+		if _, ok := m.Labels[k]; !ok {
+			delete(dst.Labels, k)
+		}
+	}
+	for k, v := range m.Labels {
+		dst.Labels[k] = v
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (this *Maps) DiffVT(that *Maps) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if len(this.StringKeys) > 0 || len(that.StringKeys) > 0 {
+		keys := make([]string, 0, len(this.StringKeys)+len(that.StringKeys))
+		for k := range this.StringKeys {
+			keys = append(keys, k)
+		}
+		for k := range that.StringKeys {
+			if _, ok := this.StringKeys[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.StringKeys[k]
+			vy, yok := that.StringKeys[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "string_keys[" + strconv.Quote(k) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "string_keys[" + strconv.Quote(k) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "string_keys[" + strconv.Quote(k) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
+	}
+	if len(this.BoolKeys) > 0 || len(that.BoolKeys) > 0 {
+		keys := make([]bool, 0, len(this.BoolKeys)+len(that.BoolKeys))
+		for k := range this.BoolKeys {
+			keys = append(keys, k)
+		}
+		for k := range that.BoolKeys {
+			if _, ok := this.BoolKeys[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return !keys[i] && keys[j] })
+		for _, k := range keys {
+			vx, xok := this.BoolKeys[k]
+			vy, yok := that.BoolKeys[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "bool_keys[" + strconv.FormatBool(k) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "bool_keys[" + strconv.FormatBool(k) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "bool_keys[" + strconv.FormatBool(k) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
+	}
+	if len(this.Int32Keys) > 0 || len(that.Int32Keys) > 0 {
+		keys := make([]int32, 0, len(this.Int32Keys)+len(that.Int32Keys))
+		for k := range this.Int32Keys {
+			keys = append(keys, k)
+		}
+		for k := range that.Int32Keys {
+			if _, ok := this.Int32Keys[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.Int32Keys[k]
+			vy, yok := that.Int32Keys[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "int32_keys[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "int32_keys[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if string(vx) != string(vy) {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "int32_keys[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
+	}
+	if len(this.Int64Keys) > 0 || len(that.Int64Keys) > 0 {
+		keys := make([]int64, 0, len(this.Int64Keys)+len(that.Int64Keys))
+		for k := range this.Int64Keys {
+			keys = append(keys, k)
+		}
+		for k := range that.Int64Keys {
+			if _, ok := this.Int64Keys[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.Int64Keys[k]
+			vy, yok := that.Int64Keys[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "int64_keys[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "int64_keys[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if p, q := vx, vy; p != q {
+					if p == nil {
+						p = &Nested{}
+					}
+					if q == nil {
+						q = &Nested{}
+					}
+					if nested := p.DiffVT(q); len(nested) > 0 {
+						diffs = protohelpers.AppendDiffs(diffs, "int64_keys["+strconv.FormatInt(int64(k), 10)+"]", nested)
+					}
+				}
+			}
+		}
+	}
+	if len(this.Uint32Keys) > 0 || len(that.Uint32Keys) > 0 {
+		keys := make([]uint32, 0, len(this.Uint32Keys)+len(that.Uint32Keys))
+		for k := range this.Uint32Keys {
+			keys = append(keys, k)
+		}
+		for k := range that.Uint32Keys {
+			if _, ok := this.Uint32Keys[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.Uint32Keys[k]
+			vy, yok := that.Uint32Keys[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "uint32_keys[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "uint32_keys[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "uint32_keys[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
+	}
+	if len(this.Uint64Keys) > 0 || len(that.Uint64Keys) > 0 {
+		keys := make([]uint64, 0, len(this.Uint64Keys)+len(that.Uint64Keys))
+		for k := range this.Uint64Keys {
+			keys = append(keys, k)
+		}
+		for k := range that.Uint64Keys {
+			if _, ok := this.Uint64Keys[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.Uint64Keys[k]
+			vy, yok := that.Uint64Keys[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "uint64_keys[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "uint64_keys[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "uint64_keys[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
+	}
+	if len(this.Sint32Keys) > 0 || len(that.Sint32Keys) > 0 {
+		keys := make([]int32, 0, len(this.Sint32Keys)+len(that.Sint32Keys))
+		for k := range this.Sint32Keys {
+			keys = append(keys, k)
+		}
+		for k := range that.Sint32Keys {
+			if _, ok := this.Sint32Keys[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.Sint32Keys[k]
+			vy, yok := that.Sint32Keys[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "sint32_keys[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "sint32_keys[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "sint32_keys[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
+	}
+	if len(this.Sint64Keys) > 0 || len(that.Sint64Keys) > 0 {
+		keys := make([]int64, 0, len(this.Sint64Keys)+len(that.Sint64Keys))
+		for k := range this.Sint64Keys {
+			keys = append(keys, k)
+		}
+		for k := range that.Sint64Keys {
+			if _, ok := this.Sint64Keys[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.Sint64Keys[k]
+			vy, yok := that.Sint64Keys[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "sint64_keys[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "sint64_keys[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "sint64_keys[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
+	}
+	if len(this.Fixed32Keys) > 0 || len(that.Fixed32Keys) > 0 {
+		keys := make([]uint32, 0, len(this.Fixed32Keys)+len(that.Fixed32Keys))
+		for k := range this.Fixed32Keys {
+			keys = append(keys, k)
+		}
+		for k := range that.Fixed32Keys {
+			if _, ok := this.Fixed32Keys[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.Fixed32Keys[k]
+			vy, yok := that.Fixed32Keys[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "fixed32_keys[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "fixed32_keys[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "fixed32_keys[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
+	}
+	if len(this.Fixed64Keys) > 0 || len(that.Fixed64Keys) > 0 {
+		keys := make([]uint64, 0, len(this.Fixed64Keys)+len(that.Fixed64Keys))
+		for k := range this.Fixed64Keys {
+			keys = append(keys, k)
+		}
+		for k := range that.Fixed64Keys {
+			if _, ok := this.Fixed64Keys[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.Fixed64Keys[k]
+			vy, yok := that.Fixed64Keys[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "fixed64_keys[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "fixed64_keys[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "fixed64_keys[" + strconv.FormatUint(uint64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
+	}
+	if len(this.Sfixed32Keys) > 0 || len(that.Sfixed32Keys) > 0 {
+		keys := make([]int32, 0, len(this.Sfixed32Keys)+len(that.Sfixed32Keys))
+		for k := range this.Sfixed32Keys {
+			keys = append(keys, k)
+		}
+		for k := range that.Sfixed32Keys {
+			if _, ok := this.Sfixed32Keys[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.Sfixed32Keys[k]
+			vy, yok := that.Sfixed32Keys[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "sfixed32_keys[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "sfixed32_keys[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "sfixed32_keys[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
+	}
+	if len(this.Sfixed64Keys) > 0 || len(that.Sfixed64Keys) > 0 {
+		keys := make([]int64, 0, len(this.Sfixed64Keys)+len(that.Sfixed64Keys))
+		for k := range this.Sfixed64Keys {
+			keys = append(keys, k)
+		}
+		for k := range that.Sfixed64Keys {
+			if _, ok := this.Sfixed64Keys[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.Sfixed64Keys[k]
+			vy, yok := that.Sfixed64Keys[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "sfixed64_keys[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "sfixed64_keys[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "sfixed64_keys[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
+	}
+	for i := 0; i < len(this.Nested) && i < len(that.Nested); i++ {
+		if p, q := this.Nested[i], that.Nested[i]; p != q {
+			if p == nil {
+				p = &Nested{}
+			}
+			if q == nil {
+				q = &Nested{}
+			}
+			if nested := p.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "nested["+strconv.Itoa(i)+"]", nested)
+			}
+		}
+	}
+	for i := len(that.Nested); i < len(this.Nested); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "nested[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.Nested[i]})
+	}
+	for i := len(this.Nested); i < len(that.Nested); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "nested[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.Nested[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *Nested) DiffVT(that *Nested) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if len(this.Labels) > 0 || len(that.Labels) > 0 {
+		keys := make([]string, 0, len(this.Labels)+len(that.Labels))
+		for k := range this.Labels {
+			keys = append(keys, k)
+		}
+		for k := range that.Labels {
+			if _, ok := this.Labels[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.Labels[k]
+			vy, yok := that.Labels[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "labels[" + strconv.Quote(k) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "labels[" + strconv.Quote(k) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "labels[" + strconv.Quote(k) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *Maps) EqualVT(that *Maps) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Maps) EqualVTWithOptions(that *Maps, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	if len(this.StringKeys) != len(that.StringKeys) {
+		return false
+	}
+	for i, vx := range this.StringKeys {
+		vy, ok := that.StringKeys[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.BoolKeys) != len(that.BoolKeys) {
+		return false
+	}
+	for i, vx := range this.BoolKeys {
+		vy, ok := that.BoolKeys[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.Int32Keys) != len(that.Int32Keys) {
+		return false
+	}
+	for i, vx := range this.Int32Keys {
+		vy, ok := that.Int32Keys[i]
+		if !ok {
+			return false
+		}
+		if string(vx) != string(vy) {
+			return false
+		}
+	}
+	if len(this.Int64Keys) != len(that.Int64Keys) {
+		return false
+	}
+	for i, vx := range this.Int64Keys {
+		vy, ok := that.Int64Keys[i]
+		if !ok {
+			return false
+		}
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Nested{}
+			}
+			if q == nil {
+				q = &Nested{}
+			}
+			if !p.EqualVTWithOptions(q, opts) {
+				return false
+			}
+		}
+	}
+	if len(this.Uint32Keys) != len(that.Uint32Keys) {
+		return false
+	}
+	for i, vx := range this.Uint32Keys {
+		vy, ok := that.Uint32Keys[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.Uint64Keys) != len(that.Uint64Keys) {
+		return false
+	}
+	for i, vx := range this.Uint64Keys {
+		vy, ok := that.Uint64Keys[i]
+		if !ok {
+			return false
+		}
+		if !opts.EqualFloat64(vx, vy) {
+			return false
+		}
+	}
+	if len(this.Sint32Keys) != len(that.Sint32Keys) {
+		return false
+	}
+	for i, vx := range this.Sint32Keys {
+		vy, ok := that.Sint32Keys[i]
+		if !ok {
+			return false
+		}
+		if !opts.EqualFloat32(vx, vy) {
+			return false
+		}
+	}
+	if len(this.Sint64Keys) != len(that.Sint64Keys) {
+		return false
+	}
+	for i, vx := range this.Sint64Keys {
+		vy, ok := that.Sint64Keys[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.Fixed32Keys) != len(that.Fixed32Keys) {
+		return false
+	}
+	for i, vx := range this.Fixed32Keys {
+		vy, ok := that.Fixed32Keys[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.Fixed64Keys) != len(that.Fixed64Keys) {
+		return false
+	}
+	for i, vx := range this.Fixed64Keys {
+		vy, ok := that.Fixed64Keys[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.Sfixed32Keys) != len(that.Sfixed32Keys) {
+		return false
+	}
+	for i, vx := range this.Sfixed32Keys {
+		vy, ok := that.Sfixed32Keys[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.Sfixed64Keys) != len(that.Sfixed64Keys) {
+		return false
+	}
+	for i, vx := range this.Sfixed64Keys {
+		vy, ok := that.Sfixed64Keys[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.Nested) != len(that.Nested) {
+		return false
+	}
+	for i, vx := range this.Nested {
+		vy := that.Nested[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Nested{}
+			}
+			if q == nil {
+				q = &Nested{}
+			}
+			if !p.EqualVTWithOptions(q, opts) {
+				return false
+			}
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Maps) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Maps)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *Nested) EqualVT(that *Nested) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Nested) EqualVTWithOptions(that *Nested, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	if len(this.Labels) != len(that.Labels) {
+		return false
+	}
+	for i, vx := range this.Labels {
+		vy, ok := that.Labels[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Nested) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Nested)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (m *Maps) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *Maps) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	var entry hash.Hash64
+	if len(m.StringKeys) > 0 {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(len(m.StringKeys)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.StringKeys {
+			entry.Reset()
+			protohelpers.HashString(entry, s, k)
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.BoolKeys) > 0 {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashUint64(h, s, uint64(len(m.BoolKeys)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.BoolKeys {
+			entry.Reset()
+			protohelpers.HashBool(entry, s, k)
+			protohelpers.HashString(entry, s, v)
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.Int32Keys) > 0 {
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(len(m.Int32Keys)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.Int32Keys {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashBytes(entry, s, v)
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.Int64Keys) > 0 {
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashUint64(h, s, uint64(len(m.Int64Keys)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.Int64Keys {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			v.hashVT(entry, s)
+			protohelpers.HashUint64(entry, s, 0)
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.Uint32Keys) > 0 {
+		protohelpers.HashUint64(h, s, 5)
+		protohelpers.HashUint64(h, s, uint64(len(m.Uint32Keys)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.Uint32Keys {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashBool(entry, s, v)
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.Uint64Keys) > 0 {
+		protohelpers.HashUint64(h, s, 6)
+		protohelpers.HashUint64(h, s, uint64(len(m.Uint64Keys)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.Uint64Keys {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashFloat64(entry, s, v)
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.Sint32Keys) > 0 {
+		protohelpers.HashUint64(h, s, 7)
+		protohelpers.HashUint64(h, s, uint64(len(m.Sint32Keys)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.Sint32Keys {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashFloat32(entry, s, v)
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.Sint64Keys) > 0 {
+		protohelpers.HashUint64(h, s, 8)
+		protohelpers.HashUint64(h, s, uint64(len(m.Sint64Keys)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.Sint64Keys {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashString(entry, s, v)
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.Fixed32Keys) > 0 {
+		protohelpers.HashUint64(h, s, 9)
+		protohelpers.HashUint64(h, s, uint64(len(m.Fixed32Keys)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.Fixed32Keys {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.Fixed64Keys) > 0 {
+		protohelpers.HashUint64(h, s, 10)
+		protohelpers.HashUint64(h, s, uint64(len(m.Fixed64Keys)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.Fixed64Keys {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.Sfixed32Keys) > 0 {
+		protohelpers.HashUint64(h, s, 11)
+		protohelpers.HashUint64(h, s, uint64(len(m.Sfixed32Keys)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.Sfixed32Keys {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.Sfixed64Keys) > 0 {
+		protohelpers.HashUint64(h, s, 12)
+		protohelpers.HashUint64(h, s, uint64(len(m.Sfixed64Keys)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.Sfixed64Keys {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.Nested) > 0 {
+		protohelpers.HashUint64(h, s, 13)
+		protohelpers.HashUint64(h, s, uint64(len(m.Nested)))
+		for _, v := range m.Nested {
+			v.hashVT(h, s)
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *Nested) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *Nested) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	var entry hash.Hash64
+	if len(m.Labels) > 0 {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(len(m.Labels)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.Labels {
+			entry.Reset()
+			protohelpers.HashString(entry, s, k)
+			protohelpers.HashString(entry, s, v)
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *Maps) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *Maps) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &Maps{}
+	}
+	w.ObjectStart()
+	if len(m.StringKeys) > 0 {
+		w.Field("stringKeys", "string_keys")
+		keys := make([]string, 0, len(m.StringKeys))
+		for k := range m.StringKeys {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		w.ObjectStart()
+		for _, k := range keys {
+			if err := w.Name(k); err != nil {
+				return errors.New("proto: field Maps.StringKeysEntry.key contains invalid UTF-8")
+			}
+			w.Int64(m.StringKeys[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("stringKeys", "string_keys")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.BoolKeys) > 0 {
+		w.Field("boolKeys", "bool_keys")
+		keys := make([]bool, 0, len(m.BoolKeys))
+		for k := range m.BoolKeys {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return !keys[i] && keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatBool(k))
+			if err := w.String(m.BoolKeys[k]); err != nil {
+				return errors.New("proto: field Maps.BoolKeysEntry.value contains invalid UTF-8")
+			}
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("boolKeys", "bool_keys")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.Int32Keys) > 0 {
+		w.Field("int32Keys", "int32_keys")
+		keys := make([]int32, 0, len(m.Int32Keys))
+		for k := range m.Int32Keys {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			w.Base64(m.Int32Keys[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("int32Keys", "int32_keys")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.Int64Keys) > 0 {
+		w.Field("int64Keys", "int64_keys")
+		keys := make([]int64, 0, len(m.Int64Keys))
+		for k := range m.Int64Keys {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			if err := m.Int64Keys[k].MarshalJSONToVT(w); err != nil {
+				return err
+			}
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("int64Keys", "int64_keys")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.Uint32Keys) > 0 {
+		w.Field("uint32Keys", "uint32_keys")
+		keys := make([]uint32, 0, len(m.Uint32Keys))
+		for k := range m.Uint32Keys {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatUint(uint64(k), 10))
+			w.Bool(m.Uint32Keys[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("uint32Keys", "uint32_keys")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.Uint64Keys) > 0 {
+		w.Field("uint64Keys", "uint64_keys")
+		keys := make([]uint64, 0, len(m.Uint64Keys))
+		for k := range m.Uint64Keys {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatUint(uint64(k), 10))
+			w.Float64(m.Uint64Keys[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("uint64Keys", "uint64_keys")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.Sint32Keys) > 0 {
+		w.Field("sint32Keys", "sint32_keys")
+		keys := make([]int32, 0, len(m.Sint32Keys))
+		for k := range m.Sint32Keys {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			w.Float32(m.Sint32Keys[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("sint32Keys", "sint32_keys")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.Sint64Keys) > 0 {
+		w.Field("sint64Keys", "sint64_keys")
+		keys := make([]int64, 0, len(m.Sint64Keys))
+		for k := range m.Sint64Keys {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			if err := w.String(m.Sint64Keys[k]); err != nil {
+				return errors.New("proto: field Maps.Sint64KeysEntry.value contains invalid UTF-8")
+			}
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("sint64Keys", "sint64_keys")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.Fixed32Keys) > 0 {
+		w.Field("fixed32Keys", "fixed32_keys")
+		keys := make([]uint32, 0, len(m.Fixed32Keys))
+		for k := range m.Fixed32Keys {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatUint(uint64(k), 10))
+			w.Int32(m.Fixed32Keys[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("fixed32Keys", "fixed32_keys")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.Fixed64Keys) > 0 {
+		w.Field("fixed64Keys", "fixed64_keys")
+		keys := make([]uint64, 0, len(m.Fixed64Keys))
+		for k := range m.Fixed64Keys {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatUint(uint64(k), 10))
+			w.Int32(m.Fixed64Keys[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("fixed64Keys", "fixed64_keys")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.Sfixed32Keys) > 0 {
+		w.Field("sfixed32Keys", "sfixed32_keys")
+		keys := make([]int32, 0, len(m.Sfixed32Keys))
+		for k := range m.Sfixed32Keys {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			w.Int32(m.Sfixed32Keys[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("sfixed32Keys", "sfixed32_keys")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.Sfixed64Keys) > 0 {
+		w.Field("sfixed64Keys", "sfixed64_keys")
+		keys := make([]int64, 0, len(m.Sfixed64Keys))
+		for k := range m.Sfixed64Keys {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			w.Int32(m.Sfixed64Keys[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("sfixed64Keys", "sfixed64_keys")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.Nested) > 0 {
+		w.Field("nested", "nested")
+		w.ArrayStart()
+		for _, v := range m.Nested {
+			if err := v.MarshalJSONToVT(w); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("nested", "nested")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *Maps) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *Maps) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [13]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "stringKeys", "string_keys":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.StringKeys == nil {
+				m.StringKeys = make(map[string]int64)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k := name
+				if _, ok := m.StringKeys[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.StringKeys[k] = v
+			}
+		case "boolKeys", "bool_keys":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.BoolKeys == nil {
+				m.BoolKeys = make(map[bool]string)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.BoolKey(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.BoolKeys[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadString()
+				if err != nil {
+					return err
+				}
+				m.BoolKeys[k] = v
+			}
+		case "int32Keys", "int32_keys":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.Int32Keys == nil {
+				m.Int32Keys = make(map[int32][]byte)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int32Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.Int32Keys[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadBytes()
+				if err != nil {
+					return err
+				}
+				m.Int32Keys[k] = v
+			}
+		case "int64Keys", "int64_keys":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.Int64Keys == nil {
+				m.Int64Keys = make(map[int64]*Nested)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int64Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.Int64Keys[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v := new(Nested)
+				if err := v.UnmarshalJSONFromVT(r); err != nil {
+					return err
+				}
+				m.Int64Keys[k] = v
+			}
+		case "uint32Keys", "uint32_keys":
+			if seen[4] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[4] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.Uint32Keys == nil {
+				m.Uint32Keys = make(map[uint32]bool)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Uint32Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.Uint32Keys[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadBool()
+				if err != nil {
+					return err
+				}
+				m.Uint32Keys[k] = v
+			}
+		case "uint64Keys", "uint64_keys":
+			if seen[5] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[5] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.Uint64Keys == nil {
+				m.Uint64Keys = make(map[uint64]float64)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Uint64Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.Uint64Keys[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadFloat64()
+				if err != nil {
+					return err
+				}
+				m.Uint64Keys[k] = v
+			}
+		case "sint32Keys", "sint32_keys":
+			if seen[6] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[6] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.Sint32Keys == nil {
+				m.Sint32Keys = make(map[int32]float32)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int32Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.Sint32Keys[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadFloat32()
+				if err != nil {
+					return err
+				}
+				m.Sint32Keys[k] = v
+			}
+		case "sint64Keys", "sint64_keys":
+			if seen[7] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[7] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.Sint64Keys == nil {
+				m.Sint64Keys = make(map[int64]string)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int64Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.Sint64Keys[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadString()
+				if err != nil {
+					return err
+				}
+				m.Sint64Keys[k] = v
+			}
+		case "fixed32Keys", "fixed32_keys":
+			if seen[8] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[8] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.Fixed32Keys == nil {
+				m.Fixed32Keys = make(map[uint32]int32)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Uint32Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.Fixed32Keys[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.Fixed32Keys[k] = v
+			}
+		case "fixed64Keys", "fixed64_keys":
+			if seen[9] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[9] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.Fixed64Keys == nil {
+				m.Fixed64Keys = make(map[uint64]int32)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Uint64Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.Fixed64Keys[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.Fixed64Keys[k] = v
+			}
+		case "sfixed32Keys", "sfixed32_keys":
+			if seen[10] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[10] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.Sfixed32Keys == nil {
+				m.Sfixed32Keys = make(map[int32]int32)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int32Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.Sfixed32Keys[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.Sfixed32Keys[k] = v
+			}
+		case "sfixed64Keys", "sfixed64_keys":
+			if seen[11] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[11] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.Sfixed64Keys == nil {
+				m.Sfixed64Keys = make(map[int64]int32)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int64Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.Sfixed64Keys[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.Sfixed64Keys[k] = v
+			}
+		case "nested":
+			if seen[12] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[12] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(Nested)
+				if err := v.UnmarshalJSONFromVT(r); err != nil {
+					return err
+				}
+				m.Nested = append(m.Nested, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *Nested) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *Nested) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &Nested{}
+	}
+	w.ObjectStart()
+	if len(m.Labels) > 0 {
+		w.Field("labels", "labels")
+		keys := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		w.ObjectStart()
+		for _, k := range keys {
+			if err := w.Name(k); err != nil {
+				return errors.New("proto: field Nested.LabelsEntry.key contains invalid UTF-8")
+			}
+			if err := w.String(m.Labels[k]); err != nil {
+				return errors.New("proto: field Nested.LabelsEntry.value contains invalid UTF-8")
+			}
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("labels", "labels")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *Nested) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *Nested) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [1]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "labels":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k := name
+				if _, ok := m.Labels[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadString()
+				if err != nil {
+					return err
+				}
+				m.Labels[k] = v
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *Maps) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Maps) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Maps) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Maps) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Maps) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Nested) > 0 {
+		for iNdEx := len(m.Nested) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Nested[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Sfixed64Keys) > 0 {
+		keysForSfixed64Keys := make([]int64, 0, len(m.Sfixed64Keys))
+		for k := range m.Sfixed64Keys {
+			keysForSfixed64Keys = append(keysForSfixed64Keys, int64(k))
+		}
+		sort.Slice(keysForSfixed64Keys, func(i, j int) bool {
+			return keysForSfixed64Keys[i] < keysForSfixed64Keys[j]
+		})
+		for iNdEx := len(keysForSfixed64Keys) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Sfixed64Keys[int64(keysForSfixed64Keys[iNdEx])]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(keysForSfixed64Keys[iNdEx]))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x9
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Sfixed32Keys) > 0 {
+		keysForSfixed32Keys := make([]int32, 0, len(m.Sfixed32Keys))
+		for k := range m.Sfixed32Keys {
+			keysForSfixed32Keys = append(keysForSfixed32Keys, int32(k))
+		}
+		sort.Slice(keysForSfixed32Keys, func(i, j int) bool {
+			return keysForSfixed32Keys[i] < keysForSfixed32Keys[j]
+		})
+		for iNdEx := len(keysForSfixed32Keys) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Sfixed32Keys[int32(keysForSfixed32Keys[iNdEx])]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(keysForSfixed32Keys[iNdEx]))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xd
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Fixed64Keys) > 0 {
+		keysForFixed64Keys := make([]uint64, 0, len(m.Fixed64Keys))
+		for k := range m.Fixed64Keys {
+			keysForFixed64Keys = append(keysForFixed64Keys, uint64(k))
+		}
+		sort.Slice(keysForFixed64Keys, func(i, j int) bool {
+			return keysForFixed64Keys[i] < keysForFixed64Keys[j]
+		})
+		for iNdEx := len(keysForFixed64Keys) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Fixed64Keys[uint64(keysForFixed64Keys[iNdEx])]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(keysForFixed64Keys[iNdEx]))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x9
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Fixed32Keys) > 0 {
+		keysForFixed32Keys := make([]uint32, 0, len(m.Fixed32Keys))
+		for k := range m.Fixed32Keys {
+			keysForFixed32Keys = append(keysForFixed32Keys, uint32(k))
+		}
+		sort.Slice(keysForFixed32Keys, func(i, j int) bool {
+			return keysForFixed32Keys[i] < keysForFixed32Keys[j]
+		})
+		for iNdEx := len(keysForFixed32Keys) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Fixed32Keys[uint32(keysForFixed32Keys[iNdEx])]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(keysForFixed32Keys[iNdEx]))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xd
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Sint64Keys) > 0 {
+		keysForSint64Keys := make([]int64, 0, len(m.Sint64Keys))
+		for k := range m.Sint64Keys {
+			keysForSint64Keys = append(keysForSint64Keys, int64(k))
+		}
+		sort.Slice(keysForSint64Keys, func(i, j int) bool {
+			return keysForSint64Keys[i] < keysForSint64Keys[j]
+		})
+		for iNdEx := len(keysForSint64Keys) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Sint64Keys[int64(keysForSint64Keys[iNdEx])]
+			baseI := i
+			i -= len(v)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+			i = encodeVarint(dAtA, i, uint64((uint64(keysForSint64Keys[iNdEx])<<1)^uint64((keysForSint64Keys[iNdEx]>>63))))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Sint32Keys) > 0 {
+		keysForSint32Keys := make([]int32, 0, len(m.Sint32Keys))
+		for k := range m.Sint32Keys {
+			keysForSint32Keys = append(keysForSint32Keys, int32(k))
+		}
+		sort.Slice(keysForSint32Keys, func(i, j int) bool {
+			return keysForSint32Keys[i] < keysForSint32Keys[j]
+		})
+		for iNdEx := len(keysForSint32Keys) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Sint32Keys[int32(keysForSint32Keys[iNdEx])]
+			baseI := i
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x15
+			i = encodeVarint(dAtA, i, uint64((uint32(keysForSint32Keys[iNdEx])<<1)^uint32((keysForSint32Keys[iNdEx]>>31))))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Uint64Keys) > 0 {
+		keysForUint64Keys := make([]uint64, 0, len(m.Uint64Keys))
+		for k := range m.Uint64Keys {
+			keysForUint64Keys = append(keysForUint64Keys, uint64(k))
+		}
+		sort.Slice(keysForUint64Keys, func(i, j int) bool {
+			return keysForUint64Keys[i] < keysForUint64Keys[j]
+		})
+		for iNdEx := len(keysForUint64Keys) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Uint64Keys[uint64(keysForUint64Keys[iNdEx])]
+			baseI := i
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x11
+			i = encodeVarint(dAtA, i, uint64(keysForUint64Keys[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Uint32Keys) > 0 {
+		keysForUint32Keys := make([]uint32, 0, len(m.Uint32Keys))
+		for k := range m.Uint32Keys {
+			keysForUint32Keys = append(keysForUint32Keys, uint32(k))
+		}
+		sort.Slice(keysForUint32Keys, func(i, j int) bool {
+			return keysForUint32Keys[i] < keysForUint32Keys[j]
+		})
+		for iNdEx := len(keysForUint32Keys) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Uint32Keys[uint32(keysForUint32Keys[iNdEx])]
+			baseI := i
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i = encodeVarint(dAtA, i, uint64(keysForUint32Keys[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Int64Keys) > 0 {
+		keysForInt64Keys := make([]int64, 0, len(m.Int64Keys))
+		for k := range m.Int64Keys {
+			keysForInt64Keys = append(keysForInt64Keys, int64(k))
+		}
+		sort.Slice(keysForInt64Keys, func(i, j int) bool {
+			return keysForInt64Keys[i] < keysForInt64Keys[j]
+		})
+		for iNdEx := len(keysForInt64Keys) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Int64Keys[int64(keysForInt64Keys[iNdEx])]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+			i = encodeVarint(dAtA, i, uint64(keysForInt64Keys[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Int32Keys) > 0 {
+		keysForInt32Keys := make([]int32, 0, len(m.Int32Keys))
+		for k := range m.Int32Keys {
+			keysForInt32Keys = append(keysForInt32Keys, int32(k))
+		}
+		sort.Slice(keysForInt32Keys, func(i, j int) bool {
+			return keysForInt32Keys[i] < keysForInt32Keys[j]
+		})
+		for iNdEx := len(keysForInt32Keys) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Int32Keys[int32(keysForInt32Keys[iNdEx])]
+			baseI := i
+			i -= len(v)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+			i = encodeVarint(dAtA, i, uint64(keysForInt32Keys[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BoolKeys) > 0 {
+		keysForBoolKeys := make([]bool, 0, len(m.BoolKeys))
+		for k := range m.BoolKeys {
+			keysForBoolKeys = append(keysForBoolKeys, bool(k))
+		}
+		sort.Slice(keysForBoolKeys, func(i, j int) bool {
+			return !keysForBoolKeys[i] && keysForBoolKeys[j]
+		})
+		for iNdEx := len(keysForBoolKeys) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.BoolKeys[bool(keysForBoolKeys[iNdEx])]
+			baseI := i
+			i -= len(v)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			if keysForBoolKeys[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StringKeys) > 0 {
+		keysForStringKeys := make([]string, 0, len(m.StringKeys))
+		for k := range m.StringKeys {
+			keysForStringKeys = append(keysForStringKeys, string(k))
+		}
+		sort.Slice(keysForStringKeys, func(i, j int) bool {
+			return keysForStringKeys[i] < keysForStringKeys[j]
+		})
+		for iNdEx := len(keysForStringKeys) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.StringKeys[string(keysForStringKeys[iNdEx])]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i -= len(keysForStringKeys[iNdEx])
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], keysForStringKeys[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(keysForStringKeys[iNdEx])))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Nested) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Nested) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Nested) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Nested) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Nested) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		sort.Slice(keysForLabels, func(i, j int) bool {
+			return keysForLabels[i] < keysForLabels[j]
+		})
+		for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Labels[string(keysForLabels[iNdEx])]
+			baseI := i
+			i -= len(v)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+			i -= len(keysForLabels[iNdEx])
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], keysForLabels[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(keysForLabels[iNdEx])))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+var (
+	ErrBufferTooSmall = fmt.Errorf("proto: buffer too small to marshal the message")
+	ErrSizeMismatch   = fmt.Errorf("proto: message size changed while marshaling")
+)
+
+func encodeVarint(dAtA []byte, offset int, v uint64) int {
+	offset -= sov(v)
+	if offset < 0 {
+		return offset
+	}
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Maps) MergeVT(src *Maps) {
+	if m == nil || src == nil {
+		return
+	}
+	if len(src.StringKeys) > 0 {
+		if m.StringKeys == nil {
+			m.StringKeys = make(map[string]int64, len(src.StringKeys))
+		}
+		for k, v := range src.StringKeys {
+			m.StringKeys[k] = v
+		}
+	}
+	if len(src.BoolKeys) > 0 {
+		if m.BoolKeys == nil {
+			m.BoolKeys = make(map[bool]string, len(src.BoolKeys))
+		}
+		for k, v := range src.BoolKeys {
+			m.BoolKeys[k] = v
+		}
+	}
+	if len(src.Int32Keys) > 0 {
+		if m.Int32Keys == nil {
+			m.Int32Keys = make(map[int32][]byte, len(src.Int32Keys))
+		}
+		for k, v := range src.Int32Keys {
+			m.Int32Keys[k] = append([]byte{}, v...)
+		}
+	}
+	if len(src.Int64Keys) > 0 {
+		if m.Int64Keys == nil {
+			m.Int64Keys = make(map[int64]*Nested, len(src.Int64Keys))
+		}
+		for k, v := range src.Int64Keys {
+			tmpMsg := &Nested{}
+			tmpMsg.MergeVT(v)
+			m.Int64Keys[k] = tmpMsg
+		}
+	}
+	if len(src.Uint32Keys) > 0 {
+		if m.Uint32Keys == nil {
+			m.Uint32Keys = make(map[uint32]bool, len(src.Uint32Keys))
+		}
+		for k, v := range src.Uint32Keys {
+			m.Uint32Keys[k] = v
+		}
+	}
+	if len(src.Uint64Keys) > 0 {
+		if m.Uint64Keys == nil {
+			m.Uint64Keys = make(map[uint64]float64, len(src.Uint64Keys))
+		}
+		for k, v := range src.Uint64Keys {
+			m.Uint64Keys[k] = v
+		}
+	}
+	if len(src.Sint32Keys) > 0 {
+		if m.Sint32Keys == nil {
+			m.Sint32Keys = make(map[int32]float32, len(src.Sint32Keys))
+		}
+		for k, v := range src.Sint32Keys {
+			m.Sint32Keys[k] = v
+		}
+	}
+	if len(src.Sint64Keys) > 0 {
+		if m.Sint64Keys == nil {
+			m.Sint64Keys = make(map[int64]string, len(src.Sint64Keys))
+		}
+		for k, v := range src.Sint64Keys {
+			m.Sint64Keys[k] = v
+		}
+	}
+	if len(src.Fixed32Keys) > 0 {
+		if m.Fixed32Keys == nil {
+			m.Fixed32Keys = make(map[uint32]int32, len(src.Fixed32Keys))
+		}
+		for k, v := range src.Fixed32Keys {
+			m.Fixed32Keys[k] = v
+		}
+	}
+	if len(src.Fixed64Keys) > 0 {
+		if m.Fixed64Keys == nil {
+			m.Fixed64Keys = make(map[uint64]int32, len(src.Fixed64Keys))
+		}
+		for k, v := range src.Fixed64Keys {
+			m.Fixed64Keys[k] = v
+		}
+	}
+	if len(src.Sfixed32Keys) > 0 {
+		if m.Sfixed32Keys == nil {
+			m.Sfixed32Keys = make(map[int32]int32, len(src.Sfixed32Keys))
+		}
+		for k, v := range src.Sfixed32Keys {
+			m.Sfixed32Keys[k] = v
+		}
+	}
+	if len(src.Sfixed64Keys) > 0 {
+		if m.Sfixed64Keys == nil {
+			m.Sfixed64Keys = make(map[int64]int32, len(src.Sfixed64Keys))
+		}
+		for k, v := range src.Sfixed64Keys {
+			m.Sfixed64Keys[k] = v
+		}
+	}
+	for _, v := range src.Nested {
+		tmpMsg := &Nested{}
+		tmpMsg.MergeVT(v)
+		m.Nested = append(m.Nested, tmpMsg)
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Maps) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Maps); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *Nested) MergeVT(src *Nested) {
+	if m == nil || src == nil {
+		return
+	}
+	if len(src.Labels) > 0 {
+		if m.Labels == nil {
+			m.Labels = make(map[string]string, len(src.Labels))
+		}
+		for k, v := range src.Labels {
+			m.Labels[k] = v
+		}
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Nested) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Nested); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *Maps) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StringKeys) > 0 {
+		for k, v := range m.StringKeys {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + sov(uint64(v))
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.BoolKeys) > 0 {
+		for k, v := range m.BoolKeys {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 1 + 1 + len(v) + sov(uint64(len(v)))
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Int32Keys) > 0 {
+		for k, v := range m.Int32Keys {
+			_ = k
+			_ = v
+			l = 1 + len(v) + sov(uint64(len(v)))
+			mapEntrySize := 1 + sov(uint64(k)) + l
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Int64Keys) > 0 {
+		for k, v := range m.Int64Keys {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.SizeVT()
+			}
+			l += 1 + sov(uint64(l))
+			mapEntrySize := 1 + sov(uint64(k)) + l
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Uint32Keys) > 0 {
+		for k, v := range m.Uint32Keys {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sov(uint64(k)) + 1 + 1
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Uint64Keys) > 0 {
+		for k, v := range m.Uint64Keys {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sov(uint64(k)) + 1 + 8
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Sint32Keys) > 0 {
+		for k, v := range m.Sint32Keys {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + soz(uint64(k)) + 1 + 4
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Sint64Keys) > 0 {
+		for k, v := range m.Sint64Keys {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + soz(uint64(k)) + 1 + len(v) + sov(uint64(len(v)))
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Fixed32Keys) > 0 {
+		for k, v := range m.Fixed32Keys {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + sov(uint64(v))
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Fixed64Keys) > 0 {
+		for k, v := range m.Fixed64Keys {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + sov(uint64(v))
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Sfixed32Keys) > 0 {
+		for k, v := range m.Sfixed32Keys {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + sov(uint64(v))
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Sfixed64Keys) > 0 {
+		for k, v := range m.Sfixed64Keys {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + sov(uint64(v))
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Nested) > 0 {
+		for _, e := range m.Nested {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Nested) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + len(v) + sov(uint64(len(v)))
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func sov(x uint64) (n int) {
+	return (bits.Len64(x|1) + 6) / 7
+}
+func soz(x uint64) (n int) {
+	return sov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Maps) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Maps) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *Maps) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *Maps) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Maps)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Maps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Maps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StringKeys == nil {
+				m.StringKeys = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.StringKeys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BoolKeys == nil {
+				m.BoolKeys = make(map[bool]string)
+			}
+			var mapkey bool
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkey = bool(mapkeytemp != 0)
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapvalue]) {
+						return ErrInvalidUTF8
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.BoolKeys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Int32Keys == nil {
+				m.Int32Keys = make(map[int32][]byte)
+			}
+			var mapkey int32
+			var mapvalue []byte
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLength
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Int32Keys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Int64Keys == nil {
+				m.Int64Keys = make(map[int64]*Nested)
+			}
+			var mapkey int64
+			var mapvalue *Nested
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Nested{}
+					if err := mapvalue.unmarshalVT(dAtA[iNdEx:postmsgIndex], opts, depth); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Int64Keys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint32Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Uint32Keys == nil {
+				m.Uint32Keys = make(map[uint32]bool)
+			}
+			var mapkey uint32
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Uint32Keys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint64Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Uint64Keys == nil {
+				m.Uint64Keys = make(map[uint64]float64)
+			}
+			var mapkey uint64
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Uint64Keys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sint32Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sint32Keys == nil {
+				m.Sint32Keys = make(map[int32]float32)
+			}
+			var mapkey int32
+			var mapvalue float32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					mapvalue = math.Float32frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Sint32Keys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sint64Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sint64Keys == nil {
+				m.Sint64Keys = make(map[int64]string)
+			}
+			var mapkey int64
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapvalue]) {
+						return ErrInvalidUTF8
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Sint64Keys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fixed32Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fixed32Keys == nil {
+				m.Fixed32Keys = make(map[uint32]int32)
+			}
+			var mapkey uint32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Fixed32Keys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fixed64Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fixed64Keys == nil {
+				m.Fixed64Keys = make(map[uint64]int32)
+			}
+			var mapkey uint64
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Fixed64Keys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sfixed32Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sfixed32Keys == nil {
+				m.Sfixed32Keys = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Sfixed32Keys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sfixed64Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sfixed64Keys == nil {
+				m.Sfixed64Keys = make(map[int64]int32)
+			}
+			var mapkey int64
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Sfixed64Keys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nested = append(m.Nested, &Nested{})
+			if err := m.Nested[len(m.Nested)-1].unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Nested) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Nested) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *Nested) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *Nested) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Nested)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Nested: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Nested: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapvalue]) {
+						return ErrInvalidUTF8
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skip(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLength
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroup
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLength
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLength          = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflow            = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroup   = fmt.Errorf("proto: unexpected end of group")
+	ErrInvalidUTF8            = fmt.Errorf("proto: string field contains invalid UTF-8")
+	ErrRecursionLimitExceeded = fmt.Errorf("proto: exceeded maximum recursion depth")
+)
+
+func (m *Maps) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Maps) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *Maps) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Maps)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Maps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Maps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StringKeys == nil {
+				m.StringKeys = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					if intStringLenmapkey > 0 {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.StringKeys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BoolKeys == nil {
+				m.BoolKeys = make(map[bool]string)
+			}
+			var mapkey bool
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkey = bool(mapkeytemp != 0)
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapvalue]) {
+						return ErrInvalidUTF8
+					}
+					if intStringLenmapvalue > 0 {
+						mapvalue = unsafe.String(&dAtA[iNdEx], intStringLenmapvalue)
+					}
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.BoolKeys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Int32Keys == nil {
+				m.Int32Keys = make(map[int32][]byte)
+			}
+			var mapkey int32
+			var mapvalue []byte
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLength
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = dAtA[iNdEx:postbytesIndex:postbytesIndex]
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Int32Keys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int64Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Int64Keys == nil {
+				m.Int64Keys = make(map[int64]*Nested)
+			}
+			var mapkey int64
+			var mapvalue *Nested
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Nested{}
+					if err := mapvalue.unmarshalVTUnsafe(dAtA[iNdEx:postmsgIndex], opts, depth); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Int64Keys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint32Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Uint32Keys == nil {
+				m.Uint32Keys = make(map[uint32]bool)
+			}
+			var mapkey uint32
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Uint32Keys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint64Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Uint64Keys == nil {
+				m.Uint64Keys = make(map[uint64]float64)
+			}
+			var mapkey uint64
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Uint64Keys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sint32Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sint32Keys == nil {
+				m.Sint32Keys = make(map[int32]float32)
+			}
+			var mapkey int32
+			var mapvalue float32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					mapvalue = math.Float32frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Sint32Keys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sint64Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sint64Keys == nil {
+				m.Sint64Keys = make(map[int64]string)
+			}
+			var mapkey int64
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapvalue]) {
+						return ErrInvalidUTF8
+					}
+					if intStringLenmapvalue > 0 {
+						mapvalue = unsafe.String(&dAtA[iNdEx], intStringLenmapvalue)
+					}
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Sint64Keys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fixed32Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fixed32Keys == nil {
+				m.Fixed32Keys = make(map[uint32]int32)
+			}
+			var mapkey uint32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Fixed32Keys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fixed64Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fixed64Keys == nil {
+				m.Fixed64Keys = make(map[uint64]int32)
+			}
+			var mapkey uint64
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Fixed64Keys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sfixed32Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sfixed32Keys == nil {
+				m.Sfixed32Keys = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Sfixed32Keys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sfixed64Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sfixed64Keys == nil {
+				m.Sfixed64Keys = make(map[int64]int32)
+			}
+			var mapkey int64
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Sfixed64Keys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nested = append(m.Nested, &Nested{})
+			if err := m.Nested[len(m.Nested)-1].unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Nested) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Nested) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *Nested) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Nested)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Nested: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Nested: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					if intStringLenmapkey > 0 {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapvalue]) {
+						return ErrInvalidUTF8
+					}
+					if intStringLenmapvalue > 0 {
+						mapvalue = unsafe.String(&dAtA[iNdEx], intStringLenmapvalue)
+					}
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
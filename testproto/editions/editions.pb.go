@@ -0,0 +1,1017 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.27.1
+// source: editions/editions.proto
+
+package editions
+
+import (
+	_ "github.com/planetscale/vtprotobuf/vtproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OpenEnum int32
+
+const (
+	OpenEnum_OPEN_ZERO OpenEnum = 0
+	OpenEnum_OPEN_ONE  OpenEnum = 1
+	OpenEnum_OPEN_TWO  OpenEnum = 2
+)
+
+// Enum value maps for OpenEnum.
+var (
+	OpenEnum_name = map[int32]string{
+		0: "OPEN_ZERO",
+		1: "OPEN_ONE",
+		2: "OPEN_TWO",
+	}
+	OpenEnum_value = map[string]int32{
+		"OPEN_ZERO": 0,
+		"OPEN_ONE":  1,
+		"OPEN_TWO":  2,
+	}
+)
+
+func (x OpenEnum) Enum() *OpenEnum {
+	p := new(OpenEnum)
+	*p = x
+	return p
+}
+
+func (x OpenEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OpenEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_editions_editions_proto_enumTypes[0].Descriptor()
+}
+
+func (OpenEnum) Type() protoreflect.EnumType {
+	return &file_editions_editions_proto_enumTypes[0]
+}
+
+func (x OpenEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OpenEnum.Descriptor instead.
+func (OpenEnum) EnumDescriptor() ([]byte, []int) {
+	return file_editions_editions_proto_rawDescGZIP(), []int{0}
+}
+
+type ClosedEnum int32
+
+const (
+	ClosedEnum_CLOSED_ONE ClosedEnum = 1
+	ClosedEnum_CLOSED_TWO ClosedEnum = 2
+)
+
+// Enum value maps for ClosedEnum.
+var (
+	ClosedEnum_name = map[int32]string{
+		1: "CLOSED_ONE",
+		2: "CLOSED_TWO",
+	}
+	ClosedEnum_value = map[string]int32{
+		"CLOSED_ONE": 1,
+		"CLOSED_TWO": 2,
+	}
+)
+
+func (x ClosedEnum) Enum() *ClosedEnum {
+	p := new(ClosedEnum)
+	*p = x
+	return p
+}
+
+func (x ClosedEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClosedEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_editions_editions_proto_enumTypes[1].Descriptor()
+}
+
+func (ClosedEnum) Type() protoreflect.EnumType {
+	return &file_editions_editions_proto_enumTypes[1]
+}
+
+func (x ClosedEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClosedEnum.Descriptor instead.
+func (ClosedEnum) EnumDescriptor() ([]byte, []int) {
+	return file_editions_editions_proto_rawDescGZIP(), []int{1}
+}
+
+type Child struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *int32  `protobuf:"varint,1,opt,name=value" json:"value,omitempty"`
+	Name  *string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+}
+
+func (x *Child) Reset() {
+	*x = Child{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editions_editions_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Child) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Child) ProtoMessage() {}
+
+func (x *Child) ProtoReflect() protoreflect.Message {
+	mi := &file_editions_editions_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Child.ProtoReflect.Descriptor instead.
+func (*Child) Descriptor() ([]byte, []int) {
+	return file_editions_editions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Child) GetValue() int32 {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return 0
+}
+
+func (x *Child) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type Editions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExplicitInt32     *int32       `protobuf:"varint,1,opt,name=explicit_int32,json=explicitInt32" json:"explicit_int32,omitempty"`
+	ExplicitInt64     *int64       `protobuf:"varint,2,opt,name=explicit_int64,json=explicitInt64" json:"explicit_int64,omitempty"`
+	ExplicitSint64    *int64       `protobuf:"zigzag64,3,opt,name=explicit_sint64,json=explicitSint64" json:"explicit_sint64,omitempty"`
+	ExplicitFixed32   *uint32      `protobuf:"fixed32,4,opt,name=explicit_fixed32,json=explicitFixed32" json:"explicit_fixed32,omitempty"`
+	ExplicitDouble    *float64     `protobuf:"fixed64,5,opt,name=explicit_double,json=explicitDouble" json:"explicit_double,omitempty"`
+	ExplicitBool      *bool        `protobuf:"varint,6,opt,name=explicit_bool,json=explicitBool" json:"explicit_bool,omitempty"`
+	ExplicitString    *string      `protobuf:"bytes,7,opt,name=explicit_string,json=explicitString" json:"explicit_string,omitempty"`
+	ExplicitBytes     []byte       `protobuf:"bytes,8,opt,name=explicit_bytes,json=explicitBytes" json:"explicit_bytes,omitempty"`
+	ExplicitEnum      *OpenEnum    `protobuf:"varint,9,opt,name=explicit_enum,json=explicitEnum,enum=OpenEnum" json:"explicit_enum,omitempty"`
+	ImplicitInt32     int32        `protobuf:"varint,11,opt,name=implicit_int32,json=implicitInt32" json:"implicit_int32,omitempty"`
+	ImplicitInt64     int64        `protobuf:"varint,12,opt,name=implicit_int64,json=implicitInt64" json:"implicit_int64,omitempty"`
+	ImplicitSint64    int64        `protobuf:"zigzag64,13,opt,name=implicit_sint64,json=implicitSint64" json:"implicit_sint64,omitempty"`
+	ImplicitFixed32   uint32       `protobuf:"fixed32,14,opt,name=implicit_fixed32,json=implicitFixed32" json:"implicit_fixed32,omitempty"`
+	ImplicitDouble    float64      `protobuf:"fixed64,15,opt,name=implicit_double,json=implicitDouble" json:"implicit_double,omitempty"`
+	ImplicitBool      bool         `protobuf:"varint,16,opt,name=implicit_bool,json=implicitBool" json:"implicit_bool,omitempty"`
+	ImplicitString    string       `protobuf:"bytes,17,opt,name=implicit_string,json=implicitString" json:"implicit_string,omitempty"`
+	ImplicitBytes     []byte       `protobuf:"bytes,18,opt,name=implicit_bytes,json=implicitBytes" json:"implicit_bytes,omitempty"`
+	ImplicitEnum      OpenEnum     `protobuf:"varint,19,opt,name=implicit_enum,json=implicitEnum,enum=OpenEnum" json:"implicit_enum,omitempty"`
+	PackedInt32       []int32      `protobuf:"varint,21,rep,packed,name=packed_int32,json=packedInt32" json:"packed_int32,omitempty"`
+	PackedDouble      []float64    `protobuf:"fixed64,22,rep,packed,name=packed_double,json=packedDouble" json:"packed_double,omitempty"`
+	PackedEnum        []OpenEnum   `protobuf:"varint,23,rep,packed,name=packed_enum,json=packedEnum,enum=OpenEnum" json:"packed_enum,omitempty"`
+	ExpandedInt32     []int32      `protobuf:"varint,24,rep,name=expanded_int32,json=expandedInt32" json:"expanded_int32,omitempty"`
+	ExpandedDouble    []float64    `protobuf:"fixed64,25,rep,name=expanded_double,json=expandedDouble" json:"expanded_double,omitempty"`
+	ExpandedEnum      []OpenEnum   `protobuf:"varint,26,rep,name=expanded_enum,json=expandedEnum,enum=OpenEnum" json:"expanded_enum,omitempty"`
+	Child             *Child       `protobuf:"bytes,31,opt,name=child" json:"child,omitempty"`
+	DelimitedChild    *Child       `protobuf:"group,32,opt,name=Child,json=delimitedChild" json:"delimited_child,omitempty"`
+	DelimitedChildren []*Child     `protobuf:"group,33,rep,name=Child,json=delimitedChildren" json:"delimited_children,omitempty"`
+	ClosedEnum        *ClosedEnum  `protobuf:"varint,41,opt,name=closed_enum,json=closedEnum,enum=ClosedEnum" json:"closed_enum,omitempty"`
+	ClosedEnums       []ClosedEnum `protobuf:"varint,42,rep,packed,name=closed_enums,json=closedEnums,enum=ClosedEnum" json:"closed_enums,omitempty"`
+	UnverifiedString  *string      `protobuf:"bytes,51,opt,name=unverified_string,json=unverifiedString" json:"unverified_string,omitempty"`
+	VerifiedStrings   []string     `protobuf:"bytes,52,rep,name=verified_strings,json=verifiedStrings" json:"verified_strings,omitempty"`
+	// Types that are assignable to Choice:
+	//	*Editions_OneofInt32
+	//	*Editions_OneofString
+	//	*Editions_OneofChild
+	//	*Editions_OneofDelimitedChild
+	Choice isEditions_Choice `protobuf_oneof:"choice"`
+}
+
+func (x *Editions) Reset() {
+	*x = Editions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editions_editions_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Editions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Editions) ProtoMessage() {}
+
+func (x *Editions) ProtoReflect() protoreflect.Message {
+	mi := &file_editions_editions_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Editions.ProtoReflect.Descriptor instead.
+func (*Editions) Descriptor() ([]byte, []int) {
+	return file_editions_editions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Editions) GetExplicitInt32() int32 {
+	if x != nil && x.ExplicitInt32 != nil {
+		return *x.ExplicitInt32
+	}
+	return 0
+}
+
+func (x *Editions) GetExplicitInt64() int64 {
+	if x != nil && x.ExplicitInt64 != nil {
+		return *x.ExplicitInt64
+	}
+	return 0
+}
+
+func (x *Editions) GetExplicitSint64() int64 {
+	if x != nil && x.ExplicitSint64 != nil {
+		return *x.ExplicitSint64
+	}
+	return 0
+}
+
+func (x *Editions) GetExplicitFixed32() uint32 {
+	if x != nil && x.ExplicitFixed32 != nil {
+		return *x.ExplicitFixed32
+	}
+	return 0
+}
+
+func (x *Editions) GetExplicitDouble() float64 {
+	if x != nil && x.ExplicitDouble != nil {
+		return *x.ExplicitDouble
+	}
+	return 0
+}
+
+func (x *Editions) GetExplicitBool() bool {
+	if x != nil && x.ExplicitBool != nil {
+		return *x.ExplicitBool
+	}
+	return false
+}
+
+func (x *Editions) GetExplicitString() string {
+	if x != nil && x.ExplicitString != nil {
+		return *x.ExplicitString
+	}
+	return ""
+}
+
+func (x *Editions) GetExplicitBytes() []byte {
+	if x != nil {
+		return x.ExplicitBytes
+	}
+	return nil
+}
+
+func (x *Editions) GetExplicitEnum() OpenEnum {
+	if x != nil && x.ExplicitEnum != nil {
+		return *x.ExplicitEnum
+	}
+	return OpenEnum_OPEN_ZERO
+}
+
+func (x *Editions) GetImplicitInt32() int32 {
+	if x != nil {
+		return x.ImplicitInt32
+	}
+	return 0
+}
+
+func (x *Editions) GetImplicitInt64() int64 {
+	if x != nil {
+		return x.ImplicitInt64
+	}
+	return 0
+}
+
+func (x *Editions) GetImplicitSint64() int64 {
+	if x != nil {
+		return x.ImplicitSint64
+	}
+	return 0
+}
+
+func (x *Editions) GetImplicitFixed32() uint32 {
+	if x != nil {
+		return x.ImplicitFixed32
+	}
+	return 0
+}
+
+func (x *Editions) GetImplicitDouble() float64 {
+	if x != nil {
+		return x.ImplicitDouble
+	}
+	return 0
+}
+
+func (x *Editions) GetImplicitBool() bool {
+	if x != nil {
+		return x.ImplicitBool
+	}
+	return false
+}
+
+func (x *Editions) GetImplicitString() string {
+	if x != nil {
+		return x.ImplicitString
+	}
+	return ""
+}
+
+func (x *Editions) GetImplicitBytes() []byte {
+	if x != nil {
+		return x.ImplicitBytes
+	}
+	return nil
+}
+
+func (x *Editions) GetImplicitEnum() OpenEnum {
+	if x != nil {
+		return x.ImplicitEnum
+	}
+	return OpenEnum_OPEN_ZERO
+}
+
+func (x *Editions) GetPackedInt32() []int32 {
+	if x != nil {
+		return x.PackedInt32
+	}
+	return nil
+}
+
+func (x *Editions) GetPackedDouble() []float64 {
+	if x != nil {
+		return x.PackedDouble
+	}
+	return nil
+}
+
+func (x *Editions) GetPackedEnum() []OpenEnum {
+	if x != nil {
+		return x.PackedEnum
+	}
+	return nil
+}
+
+func (x *Editions) GetExpandedInt32() []int32 {
+	if x != nil {
+		return x.ExpandedInt32
+	}
+	return nil
+}
+
+func (x *Editions) GetExpandedDouble() []float64 {
+	if x != nil {
+		return x.ExpandedDouble
+	}
+	return nil
+}
+
+func (x *Editions) GetExpandedEnum() []OpenEnum {
+	if x != nil {
+		return x.ExpandedEnum
+	}
+	return nil
+}
+
+func (x *Editions) GetChild() *Child {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+func (x *Editions) GetDelimitedChild() *Child {
+	if x != nil {
+		return x.DelimitedChild
+	}
+	return nil
+}
+
+func (x *Editions) GetDelimitedChildren() []*Child {
+	if x != nil {
+		return x.DelimitedChildren
+	}
+	return nil
+}
+
+func (x *Editions) GetClosedEnum() ClosedEnum {
+	if x != nil && x.ClosedEnum != nil {
+		return *x.ClosedEnum
+	}
+	return ClosedEnum_CLOSED_ONE
+}
+
+func (x *Editions) GetClosedEnums() []ClosedEnum {
+	if x != nil {
+		return x.ClosedEnums
+	}
+	return nil
+}
+
+func (x *Editions) GetUnverifiedString() string {
+	if x != nil && x.UnverifiedString != nil {
+		return *x.UnverifiedString
+	}
+	return ""
+}
+
+func (x *Editions) GetVerifiedStrings() []string {
+	if x != nil {
+		return x.VerifiedStrings
+	}
+	return nil
+}
+
+func (m *Editions) GetChoice() isEditions_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (x *Editions) GetOneofInt32() int32 {
+	if x, ok := x.GetChoice().(*Editions_OneofInt32); ok {
+		return x.OneofInt32
+	}
+	return 0
+}
+
+func (x *Editions) GetOneofString() string {
+	if x, ok := x.GetChoice().(*Editions_OneofString); ok {
+		return x.OneofString
+	}
+	return ""
+}
+
+func (x *Editions) GetOneofChild() *Child {
+	if x, ok := x.GetChoice().(*Editions_OneofChild); ok {
+		return x.OneofChild
+	}
+	return nil
+}
+
+func (x *Editions) GetOneofDelimitedChild() *Child {
+	if x, ok := x.GetChoice().(*Editions_OneofDelimitedChild); ok {
+		return x.OneofDelimitedChild
+	}
+	return nil
+}
+
+type isEditions_Choice interface {
+	isEditions_Choice()
+}
+
+type Editions_OneofInt32 struct {
+	OneofInt32 int32 `protobuf:"varint,61,opt,name=oneof_int32,json=oneofInt32,oneof"`
+}
+
+type Editions_OneofString struct {
+	OneofString string `protobuf:"bytes,62,opt,name=oneof_string,json=oneofString,oneof"`
+}
+
+type Editions_OneofChild struct {
+	OneofChild *Child `protobuf:"bytes,63,opt,name=oneof_child,json=oneofChild,oneof"`
+}
+
+type Editions_OneofDelimitedChild struct {
+	OneofDelimitedChild *Child `protobuf:"group,64,opt,name=Child,json=oneofDelimitedChild,oneof"`
+}
+
+func (*Editions_OneofInt32) isEditions_Choice() {}
+
+func (*Editions_OneofString) isEditions_Choice() {}
+
+func (*Editions_OneofChild) isEditions_Choice() {}
+
+func (*Editions_OneofDelimitedChild) isEditions_Choice() {}
+
+type EditionsMaps struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Children map[string]*Child  `protobuf:"bytes,1,rep,name=children" json:"children,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Strings  map[string]string  `protobuf:"bytes,2,rep,name=strings" json:"strings,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Enums    map[int32]OpenEnum `protobuf:"bytes,3,rep,name=enums" json:"enums,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=OpenEnum"`
+}
+
+func (x *EditionsMaps) Reset() {
+	*x = EditionsMaps{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editions_editions_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditionsMaps) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditionsMaps) ProtoMessage() {}
+
+func (x *EditionsMaps) ProtoReflect() protoreflect.Message {
+	mi := &file_editions_editions_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditionsMaps.ProtoReflect.Descriptor instead.
+func (*EditionsMaps) Descriptor() ([]byte, []int) {
+	return file_editions_editions_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EditionsMaps) GetChildren() map[string]*Child {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *EditionsMaps) GetStrings() map[string]string {
+	if x != nil {
+		return x.Strings
+	}
+	return nil
+}
+
+func (x *EditionsMaps) GetEnums() map[int32]OpenEnum {
+	if x != nil {
+		return x.Enums
+	}
+	return nil
+}
+
+type EditionsRequired struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *int32 `protobuf:"varint,1,req,name=value" json:"value,omitempty"`
+	Child *Child `protobuf:"bytes,2,req,name=child" json:"child,omitempty"`
+}
+
+func (x *EditionsRequired) Reset() {
+	*x = EditionsRequired{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editions_editions_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditionsRequired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditionsRequired) ProtoMessage() {}
+
+func (x *EditionsRequired) ProtoReflect() protoreflect.Message {
+	mi := &file_editions_editions_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditionsRequired.ProtoReflect.Descriptor instead.
+func (*EditionsRequired) Descriptor() ([]byte, []int) {
+	return file_editions_editions_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EditionsRequired) GetValue() int32 {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return 0
+}
+
+func (x *EditionsRequired) GetChild() *Child {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+type EditionsPooled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExplicitBytes     []byte   `protobuf:"bytes,1,opt,name=explicit_bytes,json=explicitBytes" json:"explicit_bytes,omitempty"`
+	ImplicitBytes     []byte   `protobuf:"bytes,2,opt,name=implicit_bytes,json=implicitBytes" json:"implicit_bytes,omitempty"`
+	DelimitedChildren []*Child `protobuf:"group,3,rep,name=Child,json=delimitedChildren" json:"delimited_children,omitempty"`
+}
+
+func (x *EditionsPooled) Reset() {
+	*x = EditionsPooled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_editions_editions_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditionsPooled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditionsPooled) ProtoMessage() {}
+
+func (x *EditionsPooled) ProtoReflect() protoreflect.Message {
+	mi := &file_editions_editions_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditionsPooled.ProtoReflect.Descriptor instead.
+func (*EditionsPooled) Descriptor() ([]byte, []int) {
+	return file_editions_editions_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EditionsPooled) GetExplicitBytes() []byte {
+	if x != nil {
+		return x.ExplicitBytes
+	}
+	return nil
+}
+
+func (x *EditionsPooled) GetImplicitBytes() []byte {
+	if x != nil {
+		return x.ImplicitBytes
+	}
+	return nil
+}
+
+func (x *EditionsPooled) GetDelimitedChildren() []*Child {
+	if x != nil {
+		return x.DelimitedChildren
+	}
+	return nil
+}
+
+var File_editions_editions_proto protoreflect.FileDescriptor
+
+var file_editions_editions_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x65, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x2f, 0x76, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x31,
+	0x0a, 0x05, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0xc5, 0x0c, 0x0a, 0x08, 0x45, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69,
+	0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65,
+	0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x27, 0x0a, 0x0f,
+	0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x12, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x53,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69,
+	0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x07, 0x52,
+	0x0f, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x64, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x6c, 0x69,
+	0x63, 0x69, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70,
+	0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x27,
+	0x0a, 0x0f, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69,
+	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x6c, 0x69,
+	0x63, 0x69, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0d, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2e,
+	0x0a, 0x0d, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x45, 0x6e, 0x75, 0x6d,
+	0x52, 0x0c, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x2c,
+	0x0a, 0x0e, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x0d, 0x69,
+	0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x2c, 0x0a, 0x0e,
+	0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x0d, 0x69, 0x6d, 0x70,
+	0x6c, 0x69, 0x63, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x2e, 0x0a, 0x0f, 0x69, 0x6d,
+	0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x12, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x0e, 0x69, 0x6d, 0x70, 0x6c,
+	0x69, 0x63, 0x69, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x30, 0x0a, 0x10, 0x69, 0x6d,
+	0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x07, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x0f, 0x69, 0x6d, 0x70,
+	0x6c, 0x69, 0x63, 0x69, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x2e, 0x0a, 0x0f,
+	0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x01, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x0e, 0x69, 0x6d,
+	0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x0d,
+	0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x08, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x0c, 0x69, 0x6d, 0x70, 0x6c,
+	0x69, 0x63, 0x69, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x2e, 0x0a, 0x0f, 0x69, 0x6d, 0x70, 0x6c,
+	0x69, 0x63, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x0e, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63,
+	0x69, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x0e, 0x69, 0x6d, 0x70, 0x6c,
+	0x69, 0x63, 0x69, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x0d, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69,
+	0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0d, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63,
+	0x69, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52,
+	0x0c, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x21, 0x0a,
+	0x0c, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x15, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x18, 0x16, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f,
+	0x65, 0x6e, 0x75, 0x6d, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x45, 0x6e, 0x75,
+	0x6d, 0x12, 0x2c, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x18, 0x18, 0x20, 0x03, 0x28, 0x05, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x18, 0x02,
+	0x52, 0x0d, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12,
+	0x2e, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x18, 0x19, 0x20, 0x03, 0x28, 0x01, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x18, 0x02, 0x52,
+	0x0e, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12,
+	0x35, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x75, 0x6d,
+	0x18, 0x1a, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x45, 0x6e, 0x75,
+	0x6d, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x18, 0x02, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x61, 0x6e, 0x64,
+	0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x1c, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18,
+	0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x12, 0x36, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65,
+	0x64, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
+	0x43, 0x68, 0x69, 0x6c, 0x64, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x28, 0x02, 0x52, 0x0e, 0x64, 0x65,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x3c, 0x0a, 0x12,
+	0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72,
+	0x65, 0x6e, 0x18, 0x21, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64,
+	0x42, 0x05, 0xaa, 0x01, 0x02, 0x28, 0x02, 0x52, 0x11, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x65, 0x64, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x2c, 0x0a, 0x0b, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0b, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x0a, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x64, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x18, 0x2a, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0b,
+	0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x0b, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x73, 0x12, 0x32, 0x0a, 0x11, 0x75, 0x6e, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x33, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x20, 0x03, 0x52, 0x10, 0x75, 0x6e, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x10,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x34, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0a,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x23, 0x0a, 0x0c, 0x6f, 0x6e,
+	0x65, 0x6f, 0x66, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12,
+	0x29, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x3f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x0a,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x43, 0x0a, 0x15, 0x6f, 0x6e,
+	0x65, 0x6f, 0x66, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x18, 0x40, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x43, 0x68, 0x69, 0x6c,
+	0x64, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x28, 0x02, 0x48, 0x00, 0x52, 0x13, 0x6f, 0x6e, 0x65, 0x6f,
+	0x66, 0x44, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x42,
+	0x08, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x22, 0xf3, 0x02, 0x0a, 0x0c, 0x45, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x61, 0x70, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x45,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x61, 0x70, 0x73, 0x2e, 0x43, 0x68, 0x69, 0x6c,
+	0x64, 0x72, 0x65, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x72, 0x65, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d,
+	0x61, 0x70, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x65, 0x6e, 0x75,
+	0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x4d, 0x61, 0x70, 0x73, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x1a, 0x43, 0x0a, 0x0d, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x72, 0x65, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x43, 0x68,
+	0x69, 0x6c, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a,
+	0x0a, 0x0c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x43, 0x0a, 0x0a, 0x45, 0x6e,
+	0x75, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x09, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x45, 0x6e, 0x75, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x54, 0x0a, 0x10, 0x45, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x23, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x03, 0x52, 0x05,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x22, 0xa9, 0x01, 0x0a, 0x0e, 0x45, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x6c,
+	0x69, 0x63, 0x69, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0d, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x2c, 0x0a, 0x0e, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x0d,
+	0x69, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3c, 0x0a,
+	0x12, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x72, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x43, 0x68, 0x69, 0x6c,
+	0x64, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x28, 0x02, 0x52, 0x11, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x65, 0x64, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x3a, 0x04, 0xa8, 0xa6, 0x1f,
+	0x01, 0x2a, 0x35, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0d, 0x0a,
+	0x09, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x5a, 0x45, 0x52, 0x4f, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x4f, 0x50,
+	0x45, 0x4e, 0x5f, 0x54, 0x57, 0x4f, 0x10, 0x02, 0x2a, 0x32, 0x0a, 0x0a, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44,
+	0x5f, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44,
+	0x5f, 0x54, 0x57, 0x4f, 0x10, 0x02, 0x1a, 0x04, 0x3a, 0x02, 0x10, 0x02, 0x42, 0x14, 0x5a, 0x12,
+	0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x62, 0x08, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x70, 0xe8, 0x07,
+}
+
+var (
+	file_editions_editions_proto_rawDescOnce sync.Once
+	file_editions_editions_proto_rawDescData = file_editions_editions_proto_rawDesc
+)
+
+func file_editions_editions_proto_rawDescGZIP() []byte {
+	file_editions_editions_proto_rawDescOnce.Do(func() {
+		file_editions_editions_proto_rawDescData = protoimpl.X.CompressGZIP(file_editions_editions_proto_rawDescData)
+	})
+	return file_editions_editions_proto_rawDescData
+}
+
+var file_editions_editions_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_editions_editions_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_editions_editions_proto_goTypes = []any{
+	(OpenEnum)(0),            // 0: OpenEnum
+	(ClosedEnum)(0),          // 1: ClosedEnum
+	(*Child)(nil),            // 2: Child
+	(*Editions)(nil),         // 3: Editions
+	(*EditionsMaps)(nil),     // 4: EditionsMaps
+	(*EditionsRequired)(nil), // 5: EditionsRequired
+	(*EditionsPooled)(nil),   // 6: EditionsPooled
+	nil,                      // 7: EditionsMaps.ChildrenEntry
+	nil,                      // 8: EditionsMaps.StringsEntry
+	nil,                      // 9: EditionsMaps.EnumsEntry
+}
+var file_editions_editions_proto_depIdxs = []int32{
+	0,  // 0: Editions.explicit_enum:type_name -> OpenEnum
+	0,  // 1: Editions.implicit_enum:type_name -> OpenEnum
+	0,  // 2: Editions.packed_enum:type_name -> OpenEnum
+	0,  // 3: Editions.expanded_enum:type_name -> OpenEnum
+	2,  // 4: Editions.child:type_name -> Child
+	2,  // 5: Editions.delimited_child:type_name -> Child
+	2,  // 6: Editions.delimited_children:type_name -> Child
+	1,  // 7: Editions.closed_enum:type_name -> ClosedEnum
+	1,  // 8: Editions.closed_enums:type_name -> ClosedEnum
+	2,  // 9: Editions.oneof_child:type_name -> Child
+	2,  // 10: Editions.oneof_delimited_child:type_name -> Child
+	7,  // 11: EditionsMaps.children:type_name -> EditionsMaps.ChildrenEntry
+	8,  // 12: EditionsMaps.strings:type_name -> EditionsMaps.StringsEntry
+	9,  // 13: EditionsMaps.enums:type_name -> EditionsMaps.EnumsEntry
+	2,  // 14: EditionsRequired.child:type_name -> Child
+	2,  // 15: EditionsPooled.delimited_children:type_name -> Child
+	2,  // 16: EditionsMaps.ChildrenEntry.value:type_name -> Child
+	0,  // 17: EditionsMaps.EnumsEntry.value:type_name -> OpenEnum
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_editions_editions_proto_init() }
+func file_editions_editions_proto_init() {
+	if File_editions_editions_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_editions_editions_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Child); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editions_editions_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Editions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editions_editions_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*EditionsMaps); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editions_editions_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*EditionsRequired); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_editions_editions_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*EditionsPooled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_editions_editions_proto_msgTypes[1].OneofWrappers = []any{
+		(*Editions_OneofInt32)(nil),
+		(*Editions_OneofString)(nil),
+		(*Editions_OneofChild)(nil),
+		(*Editions_OneofDelimitedChild)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_editions_editions_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_editions_editions_proto_goTypes,
+		DependencyIndexes: file_editions_editions_proto_depIdxs,
+		EnumInfos:         file_editions_editions_proto_enumTypes,
+		MessageInfos:      file_editions_editions_proto_msgTypes,
+	}.Build()
+	File_editions_editions_proto = out.File
+	file_editions_editions_proto_rawDesc = nil
+	file_editions_editions_proto_goTypes = nil
+	file_editions_editions_proto_depIdxs = nil
+}
@@ -0,0 +1,278 @@
+package editions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+func testEditions() *Editions {
+	return &Editions{
+		ExplicitInt32:     proto.Int32(-42),
+		ExplicitInt64:     proto.Int64(1 << 40),
+		ExplicitSint64:    proto.Int64(-1 << 40),
+		ExplicitFixed32:   proto.Uint32(1234),
+		ExplicitDouble:    proto.Float64(1.5),
+		ExplicitBool:      proto.Bool(true),
+		ExplicitString:    proto.String("explicit"),
+		ExplicitBytes:     []byte{1, 2, 3},
+		ExplicitEnum:      OpenEnum_OPEN_TWO.Enum(),
+		ImplicitInt32:     -7,
+		ImplicitInt64:     7,
+		ImplicitSint64:    -8,
+		ImplicitFixed32:   9,
+		ImplicitDouble:    2.5,
+		ImplicitBool:      true,
+		ImplicitString:    "implicit",
+		ImplicitBytes:     []byte{4, 5},
+		ImplicitEnum:      OpenEnum_OPEN_ONE,
+		PackedInt32:       []int32{1, -1, 1 << 20},
+		PackedDouble:      []float64{0.5, -0.5},
+		PackedEnum:        []OpenEnum{OpenEnum_OPEN_ONE, OpenEnum_OPEN_ZERO},
+		ExpandedInt32:     []int32{2, -2},
+		ExpandedDouble:    []float64{1.25},
+		ExpandedEnum:      []OpenEnum{OpenEnum_OPEN_TWO},
+		Child:             &Child{Value: proto.Int32(1), Name: proto.String("child")},
+		DelimitedChild:    &Child{Value: proto.Int32(2)},
+		DelimitedChildren: []*Child{{Value: proto.Int32(3)}, {Name: proto.String("four")}, {}},
+		ClosedEnum:        ClosedEnum_CLOSED_TWO.Enum(),
+		ClosedEnums:       []ClosedEnum{ClosedEnum_CLOSED_ONE, ClosedEnum_CLOSED_TWO},
+		UnverifiedString:  proto.String("unverified"),
+		VerifiedStrings:   []string{"a", "b"},
+		Choice:            &Editions_OneofDelimitedChild{OneofDelimitedChild: &Child{Value: proto.Int32(5)}},
+	}
+}
+
+func TestEditionsMarshal(t *testing.T) {
+	for _, m := range []*Editions{
+		testEditions(),
+		{Choice: &Editions_OneofInt32{OneofInt32: 0}},
+		{Choice: &Editions_OneofString{OneofString: "oneof"}},
+		{Choice: &Editions_OneofChild{OneofChild: &Child{}}},
+		{},
+	} {
+		expected, err := proto.Marshal(m)
+		require.NoError(t, err)
+		require.Equal(t, len(expected), m.SizeVT())
+
+		got, err := m.MarshalVT()
+		require.NoError(t, err)
+		require.Equal(t, expected, got)
+	}
+}
+
+func TestEditionsUnmarshal(t *testing.T) {
+	m := testEditions()
+	data, err := proto.Marshal(m)
+	require.NoError(t, err)
+
+	unmarshaled := &Editions{}
+	require.NoError(t, unmarshaled.UnmarshalVT(data))
+	require.True(t, proto.Equal(m, unmarshaled), "expected %v, got %v", m, unmarshaled)
+	require.Empty(t, unmarshaled.unknownFields)
+
+	// Delimited fields merge like any other message field
+	more := &Editions{DelimitedChild: &Child{Name: proto.String("merged")}, DelimitedChildren: []*Child{{}}}
+	data, err = proto.Marshal(more)
+	require.NoError(t, err)
+	require.NoError(t, unmarshaled.UnmarshalVT(data))
+	require.Equal(t, int32(2), unmarshaled.DelimitedChild.GetValue())
+	require.Equal(t, "merged", unmarshaled.DelimitedChild.GetName())
+	require.Len(t, unmarshaled.DelimitedChildren, 4)
+}
+
+func TestEditionsMaps(t *testing.T) {
+	m := &EditionsMaps{
+		Children: map[string]*Child{"a": {Value: proto.Int32(1)}, "b": {}},
+		Strings:  map[string]string{"c": "d"},
+		Enums:    map[int32]OpenEnum{1: OpenEnum_OPEN_ONE, 2: OpenEnum_OPEN_ZERO},
+	}
+	require.Equal(t, proto.Size(m), m.SizeVT())
+
+	data, err := m.MarshalVT()
+	require.NoError(t, err)
+	unmarshaled := &EditionsMaps{}
+	require.NoError(t, proto.Unmarshal(data, unmarshaled))
+	require.True(t, proto.Equal(m, unmarshaled))
+
+	unmarshaled = &EditionsMaps{}
+	require.NoError(t, unmarshaled.UnmarshalVT(data))
+	require.True(t, proto.Equal(m, unmarshaled))
+}
+
+func TestEditionsPresence(t *testing.T) {
+	m := &Editions{
+		ExplicitInt32:  proto.Int32(0),
+		ExplicitDouble: proto.Float64(0),
+		ExplicitBool:   proto.Bool(false),
+		ExplicitString: proto.String(""),
+		ExplicitBytes:  []byte{},
+		ExplicitEnum:   OpenEnum_OPEN_ZERO.Enum(),
+	}
+	data, err := m.MarshalVT()
+	require.NoError(t, err)
+	require.NotEmpty(t, data)
+
+	unmarshaled := &Editions{}
+	require.NoError(t, unmarshaled.UnmarshalVT(data))
+	require.True(t, proto.Equal(m, unmarshaled))
+	require.NotNil(t, unmarshaled.ExplicitInt32)
+	require.NotNil(t, unmarshaled.ExplicitBytes)
+	require.False(t, unmarshaled.EqualVT(&Editions{}))
+
+	// Zero values of fields with implicit presence are not serialized
+	m = &Editions{ImplicitString: "", ImplicitBytes: []byte{}, ImplicitEnum: OpenEnum_OPEN_ZERO}
+	require.Zero(t, m.SizeVT())
+	require.True(t, m.EqualVT(&Editions{}))
+}
+
+func TestEditionsRepeatedFieldEncoding(t *testing.T) {
+	m := &Editions{PackedInt32: []int32{1, 2}, ExpandedInt32: []int32{3, 4}}
+	data, err := m.MarshalVT()
+	require.NoError(t, err)
+
+	num, typ, n := protowire.ConsumeTag(data)
+	require.Equal(t, protowire.Number(21), num)
+	require.Equal(t, protowire.BytesType, typ)
+	_, l := protowire.ConsumeBytes(data[n:])
+	data = data[n+l:]
+	for range m.ExpandedInt32 {
+		num, typ, n = protowire.ConsumeTag(data)
+		require.Equal(t, protowire.Number(24), num)
+		require.Equal(t, protowire.VarintType, typ)
+		_, l = protowire.ConsumeVarint(data[n:])
+		data = data[n+l:]
+	}
+	require.Empty(t, data)
+
+	// Both encodings are accepted for all repeated scalar fields
+	var b []byte
+	b = protowire.AppendTag(b, 21, protowire.VarintType)
+	b = protowire.AppendVarint(b, 5)
+	b = protowire.AppendTag(b, 24, protowire.BytesType)
+	b = protowire.AppendBytes(b, protowire.AppendVarint(protowire.AppendVarint(nil, 6), 7))
+	unmarshaled := &Editions{}
+	require.NoError(t, unmarshaled.UnmarshalVT(b))
+	require.Equal(t, []int32{5}, unmarshaled.PackedInt32)
+	require.Equal(t, []int32{6, 7}, unmarshaled.ExpandedInt32)
+}
+
+func TestEditionsDelimitedEncoding(t *testing.T) {
+	child := &Child{Value: proto.Int32(2)}
+	childData, err := child.MarshalVT()
+	require.NoError(t, err)
+
+	var expected []byte
+	expected = protowire.AppendTag(expected, 32, protowire.StartGroupType)
+	expected = append(expected, childData...)
+	expected = protowire.AppendTag(expected, 32, protowire.EndGroupType)
+
+	m := &Editions{DelimitedChild: child}
+	data, err := m.MarshalVT()
+	require.NoError(t, err)
+	require.Equal(t, expected, data)
+
+	// The end of the group must match its start
+	var b []byte
+	b = protowire.AppendTag(b, 32, protowire.StartGroupType)
+	b = append(b, childData...)
+	b = protowire.AppendTag(b, 33, protowire.EndGroupType)
+	require.Error(t, proto.Unmarshal(b, &Editions{}))
+	require.Error(t, (&Editions{}).UnmarshalVT(b))
+
+	// Unterminated groups are rejected
+	b = protowire.AppendTag(nil, 32, protowire.StartGroupType)
+	b = append(b, childData...)
+	require.Error(t, proto.Unmarshal(b, &Editions{}))
+	require.Error(t, (&Editions{}).UnmarshalVT(b))
+}
+
+func TestEditionsClosedEnum(t *testing.T) {
+	// Like proto.Unmarshal, unknown values of closed enums are stored in the field
+	var b []byte
+	b = protowire.AppendTag(b, 41, protowire.VarintType)
+	b = protowire.AppendVarint(b, 42)
+	b = protowire.AppendTag(b, 42, protowire.VarintType)
+	b = protowire.AppendVarint(b, 43)
+
+	expected := &Editions{}
+	require.NoError(t, proto.Unmarshal(b, expected))
+	unmarshaled := &Editions{}
+	require.NoError(t, unmarshaled.UnmarshalVT(b))
+	require.True(t, proto.Equal(expected, unmarshaled), "expected %v, got %v", expected, unmarshaled)
+}
+
+func TestEditionsUTF8Validation(t *testing.T) {
+	invalid := string([]byte{0xff, 0xfe})
+
+	for _, num := range []protowire.Number{7, 17, 52, 62} {
+		var b []byte
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		b = protowire.AppendString(b, invalid)
+		require.Error(t, proto.Unmarshal(b, &Editions{}))
+		require.Error(t, (&Editions{}).UnmarshalVT(b), "field %d", num)
+	}
+
+	var b []byte
+	b = protowire.AppendTag(b, 51, protowire.BytesType)
+	b = protowire.AppendString(b, invalid)
+	unmarshaled := &Editions{}
+	require.NoError(t, unmarshaled.UnmarshalVT(b))
+	require.Equal(t, invalid, unmarshaled.GetUnverifiedString())
+
+	var entry []byte
+	entry = protowire.AppendTag(entry, 1, protowire.BytesType)
+	entry = protowire.AppendString(entry, invalid)
+	b = protowire.AppendTag(nil, 2, protowire.BytesType)
+	b = protowire.AppendBytes(b, entry)
+	require.Error(t, proto.Unmarshal(b, &EditionsMaps{}))
+	require.Error(t, (&EditionsMaps{}).UnmarshalVT(b))
+}
+
+func TestEditionsRequired(t *testing.T) {
+	m := &EditionsRequired{Value: proto.Int32(1)}
+	_, err := m.MarshalVT()
+	require.Error(t, err)
+
+	m.Child = &Child{}
+	data, err := m.MarshalVT()
+	require.NoError(t, err)
+	require.NoError(t, (&EditionsRequired{}).UnmarshalVT(data))
+
+	data, err = proto.MarshalOptions{AllowPartial: true}.Marshal(&EditionsRequired{Child: &Child{}})
+	require.NoError(t, err)
+	require.Error(t, (&EditionsRequired{}).UnmarshalVT(data))
+}
+
+func TestEditionsEqualAndClone(t *testing.T) {
+	m := testEditions()
+	clone := m.CloneVT()
+	require.True(t, proto.Equal(m, clone))
+	require.True(t, m.EqualVT(clone))
+	require.NotSame(t, m.ExplicitInt32, clone.ExplicitInt32)
+	require.NotSame(t, m.DelimitedChild, clone.DelimitedChild)
+
+	clone.ExplicitInt32 = nil
+	require.False(t, m.EqualVT(clone))
+	clone.ExplicitInt32 = proto.Int32(0)
+	require.False(t, m.EqualVT(clone))
+	clone.ExplicitInt32 = proto.Int32(m.GetExplicitInt32())
+	require.True(t, m.EqualVT(clone))
+
+	clone.DelimitedChildren[2].Value = proto.Int32(0)
+	require.False(t, m.EqualVT(clone))
+}
+
+func TestEditionsPool(t *testing.T) {
+	m := EditionsPooledFromVTPool()
+	m.ExplicitBytes = []byte{1}
+	m.ImplicitBytes = []byte{2}
+	m.DelimitedChildren = []*Child{{Value: proto.Int32(1)}}
+	m.ResetVT()
+
+	require.Nil(t, m.ExplicitBytes)
+	require.Zero(t, m.SizeVT())
+	require.True(t, proto.Equal(&EditionsPooled{}, m))
+}
@@ -0,0 +1,5344 @@
+// Code generated by protoc-gen-go-vtproto. DO NOT EDIT.
+// protoc-gen-go-vtproto version: (devel)
+// source: editions/editions.proto
+
+package editions
+
+import (
+	binary "encoding/binary"
+	fmt "fmt"
+	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
+	vtproto "github.com/planetscale/vtprotobuf/vtproto"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	proto "google.golang.org/protobuf/proto"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	math "math"
+	bits "math/bits"
+	sync "sync"
+	utf8 "unicode/utf8"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+func (m *Child) CloneVT() *Child {
+	if m == nil {
+		return (*Child)(nil)
+	}
+	r := &Child{}
+	if rhs := m.Value; rhs != nil {
+		tmpVal := *rhs
+		r.Value = &tmpVal
+	}
+	if rhs := m.Name; rhs != nil {
+		tmpVal := *rhs
+		r.Name = &tmpVal
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *Child) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *Child) CopyToVT(dst *Child) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.Value == nil {
+		dst.Value = nil
+	} else {
+		if dst.Value == nil {
+			dst.Value = new(int32)
+		}
+		*dst.Value = *m.Value
+	}
+	if m.Name == nil {
+		dst.Name = nil
+	} else {
+		if dst.Name == nil {
+			dst.Name = new(string)
+		}
+		*dst.Name = *m.Name
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *Editions) CloneVT() *Editions {
+	if m == nil {
+		return (*Editions)(nil)
+	}
+	r := &Editions{
+		ImplicitInt32:   m.ImplicitInt32,
+		ImplicitInt64:   m.ImplicitInt64,
+		ImplicitSint64:  m.ImplicitSint64,
+		ImplicitFixed32: m.ImplicitFixed32,
+		ImplicitDouble:  m.ImplicitDouble,
+		ImplicitBool:    m.ImplicitBool,
+		ImplicitString:  m.ImplicitString,
+		ImplicitEnum:    m.ImplicitEnum,
+		Child:           m.Child.CloneVT(),
+		DelimitedChild:  m.DelimitedChild.CloneVT(),
+	}
+	if rhs := m.ExplicitInt32; rhs != nil {
+		tmpVal := *rhs
+		r.ExplicitInt32 = &tmpVal
+	}
+	if rhs := m.ExplicitInt64; rhs != nil {
+		tmpVal := *rhs
+		r.ExplicitInt64 = &tmpVal
+	}
+	if rhs := m.ExplicitSint64; rhs != nil {
+		tmpVal := *rhs
+		r.ExplicitSint64 = &tmpVal
+	}
+	if rhs := m.ExplicitFixed32; rhs != nil {
+		tmpVal := *rhs
+		r.ExplicitFixed32 = &tmpVal
+	}
+	if rhs := m.ExplicitDouble; rhs != nil {
+		tmpVal := *rhs
+		r.ExplicitDouble = &tmpVal
+	}
+	if rhs := m.ExplicitBool; rhs != nil {
+		tmpVal := *rhs
+		r.ExplicitBool = &tmpVal
+	}
+	if rhs := m.ExplicitString; rhs != nil {
+		tmpVal := *rhs
+		r.ExplicitString = &tmpVal
+	}
+	if rhs := m.ExplicitBytes; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.ExplicitBytes = tmpBytes
+	}
+	if rhs := m.ExplicitEnum; rhs != nil {
+		tmpVal := *rhs
+		r.ExplicitEnum = &tmpVal
+	}
+	if rhs := m.ImplicitBytes; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.ImplicitBytes = tmpBytes
+	}
+	if rhs := m.PackedInt32; rhs != nil {
+		tmpContainer := make([]int32, len(rhs))
+		copy(tmpContainer, rhs)
+		r.PackedInt32 = tmpContainer
+	}
+	if rhs := m.PackedDouble; rhs != nil {
+		tmpContainer := make([]float64, len(rhs))
+		copy(tmpContainer, rhs)
+		r.PackedDouble = tmpContainer
+	}
+	if rhs := m.PackedEnum; rhs != nil {
+		tmpContainer := make([]OpenEnum, len(rhs))
+		copy(tmpContainer, rhs)
+		r.PackedEnum = tmpContainer
+	}
+	if rhs := m.ExpandedInt32; rhs != nil {
+		tmpContainer := make([]int32, len(rhs))
+		copy(tmpContainer, rhs)
+		r.ExpandedInt32 = tmpContainer
+	}
+	if rhs := m.ExpandedDouble; rhs != nil {
+		tmpContainer := make([]float64, len(rhs))
+		copy(tmpContainer, rhs)
+		r.ExpandedDouble = tmpContainer
+	}
+	if rhs := m.ExpandedEnum; rhs != nil {
+		tmpContainer := make([]OpenEnum, len(rhs))
+		copy(tmpContainer, rhs)
+		r.ExpandedEnum = tmpContainer
+	}
+	if rhs := m.DelimitedChildren; rhs != nil {
+		tmpContainer := make([]*Child, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.DelimitedChildren = tmpContainer
+	}
+	if rhs := m.ClosedEnum; rhs != nil {
+		tmpVal := *rhs
+		r.ClosedEnum = &tmpVal
+	}
+	if rhs := m.ClosedEnums; rhs != nil {
+		tmpContainer := make([]ClosedEnum, len(rhs))
+		copy(tmpContainer, rhs)
+		r.ClosedEnums = tmpContainer
+	}
+	if rhs := m.UnverifiedString; rhs != nil {
+		tmpVal := *rhs
+		r.UnverifiedString = &tmpVal
+	}
+	if rhs := m.VerifiedStrings; rhs != nil {
+		tmpContainer := make([]string, len(rhs))
+		copy(tmpContainer, rhs)
+		r.VerifiedStrings = tmpContainer
+	}
+	if m.Choice != nil {
+		r.Choice = m.Choice.(interface{ CloneVT() isEditions_Choice }).CloneVT()
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *Editions) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *Editions) CopyToVT(dst *Editions) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.ExplicitInt32 == nil {
+		dst.ExplicitInt32 = nil
+	} else {
+		if dst.ExplicitInt32 == nil {
+			dst.ExplicitInt32 = new(int32)
+		}
+		*dst.ExplicitInt32 = *m.ExplicitInt32
+	}
+	if m.ExplicitInt64 == nil {
+		dst.ExplicitInt64 = nil
+	} else {
+		if dst.ExplicitInt64 == nil {
+			dst.ExplicitInt64 = new(int64)
+		}
+		*dst.ExplicitInt64 = *m.ExplicitInt64
+	}
+	if m.ExplicitSint64 == nil {
+		dst.ExplicitSint64 = nil
+	} else {
+		if dst.ExplicitSint64 == nil {
+			dst.ExplicitSint64 = new(int64)
+		}
+		*dst.ExplicitSint64 = *m.ExplicitSint64
+	}
+	if m.ExplicitFixed32 == nil {
+		dst.ExplicitFixed32 = nil
+	} else {
+		if dst.ExplicitFixed32 == nil {
+			dst.ExplicitFixed32 = new(uint32)
+		}
+		*dst.ExplicitFixed32 = *m.ExplicitFixed32
+	}
+	if m.ExplicitDouble == nil {
+		dst.ExplicitDouble = nil
+	} else {
+		if dst.ExplicitDouble == nil {
+			dst.ExplicitDouble = new(float64)
+		}
+		*dst.ExplicitDouble = *m.ExplicitDouble
+	}
+	if m.ExplicitBool == nil {
+		dst.ExplicitBool = nil
+	} else {
+		if dst.ExplicitBool == nil {
+			dst.ExplicitBool = new(bool)
+		}
+		*dst.ExplicitBool = *m.ExplicitBool
+	}
+	if m.ExplicitString == nil {
+		dst.ExplicitString = nil
+	} else {
+		if dst.ExplicitString == nil {
+			dst.ExplicitString = new(string)
+		}
+		*dst.ExplicitString = *m.ExplicitString
+	}
+	if m.ExplicitBytes == nil {
+		dst.ExplicitBytes = nil
+	} else if dst.ExplicitBytes == nil {
+		dst.ExplicitBytes = append([]byte{}, m.ExplicitBytes...)
+	} else {
+		dst.ExplicitBytes = append(dst.ExplicitBytes[:0], m.ExplicitBytes...)
+	}
+	if m.ExplicitEnum == nil {
+		dst.ExplicitEnum = nil
+	} else {
+		if dst.ExplicitEnum == nil {
+			dst.ExplicitEnum = new(OpenEnum)
+		}
+		*dst.ExplicitEnum = *m.ExplicitEnum
+	}
+	dst.ImplicitInt32 = m.ImplicitInt32
+	dst.ImplicitInt64 = m.ImplicitInt64
+	dst.ImplicitSint64 = m.ImplicitSint64
+	dst.ImplicitFixed32 = m.ImplicitFixed32
+	dst.ImplicitDouble = m.ImplicitDouble
+	dst.ImplicitBool = m.ImplicitBool
+	dst.ImplicitString = m.ImplicitString
+	dst.ImplicitBytes = append(dst.ImplicitBytes[:0], m.ImplicitBytes...)
+	dst.ImplicitEnum = m.ImplicitEnum
+	dst.PackedInt32 = append(dst.PackedInt32[:0], m.PackedInt32...)
+	dst.PackedDouble = append(dst.PackedDouble[:0], m.PackedDouble...)
+	dst.PackedEnum = append(dst.PackedEnum[:0], m.PackedEnum...)
+	dst.ExpandedInt32 = append(dst.ExpandedInt32[:0], m.ExpandedInt32...)
+	dst.ExpandedDouble = append(dst.ExpandedDouble[:0], m.ExpandedDouble...)
+	dst.ExpandedEnum = append(dst.ExpandedEnum[:0], m.ExpandedEnum...)
+	if m.Child == nil {
+		dst.Child = nil
+	} else {
+		if dst.Child == nil {
+			dst.Child = m.Child.CloneVT()
+		} else {
+			m.Child.CopyToVT(dst.Child)
+		}
+	}
+	if m.DelimitedChild == nil {
+		dst.DelimitedChild = nil
+	} else {
+		if dst.DelimitedChild == nil {
+			dst.DelimitedChild = m.DelimitedChild.CloneVT()
+		} else {
+			m.DelimitedChild.CopyToVT(dst.DelimitedChild)
+		}
+	}
+	if len(m.DelimitedChildren) > cap(dst.DelimitedChildren) {
+		tmpContainer := make([]*Child, len(m.DelimitedChildren))
+		copy(tmpContainer, dst.DelimitedChildren[:cap(dst.DelimitedChildren)])
+		dst.DelimitedChildren = tmpContainer
+	} else {
+		dst.DelimitedChildren = dst.DelimitedChildren[:len(m.DelimitedChildren)]
+	}
+	for k, v := range m.DelimitedChildren {
+		if dst.DelimitedChildren[k] == nil {
+			dst.DelimitedChildren[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.DelimitedChildren[k])
+		}
+	}
+	if m.ClosedEnum == nil {
+		dst.ClosedEnum = nil
+	} else {
+		if dst.ClosedEnum == nil {
+			dst.ClosedEnum = new(ClosedEnum)
+		}
+		*dst.ClosedEnum = *m.ClosedEnum
+	}
+	dst.ClosedEnums = append(dst.ClosedEnums[:0], m.ClosedEnums...)
+	if m.UnverifiedString == nil {
+		dst.UnverifiedString = nil
+	} else {
+		if dst.UnverifiedString == nil {
+			dst.UnverifiedString = new(string)
+		}
+		*dst.UnverifiedString = *m.UnverifiedString
+	}
+	dst.VerifiedStrings = append(dst.VerifiedStrings[:0], m.VerifiedStrings...)
+	switch c := m.Choice.(type) {
+	case *Editions_OneofInt32:
+		if d, ok := dst.Choice.(*Editions_OneofInt32); ok {
+			d.OneofInt32 = c.OneofInt32
+		} else {
+			dst.Choice = c.CloneVT()
+		}
+	case *Editions_OneofString:
+		if d, ok := dst.Choice.(*Editions_OneofString); ok {
+			d.OneofString = c.OneofString
+		} else {
+			dst.Choice = c.CloneVT()
+		}
+	case *Editions_OneofChild:
+		if d, ok := dst.Choice.(*Editions_OneofChild); ok {
+			if d.OneofChild == nil {
+				d.OneofChild = c.OneofChild.CloneVT()
+			} else {
+				c.OneofChild.CopyToVT(d.OneofChild)
+			}
+		} else {
+			dst.Choice = c.CloneVT()
+		}
+	case *Editions_OneofDelimitedChild:
+		if d, ok := dst.Choice.(*Editions_OneofDelimitedChild); ok {
+			if d.OneofDelimitedChild == nil {
+				d.OneofDelimitedChild = c.OneofDelimitedChild.CloneVT()
+			} else {
+				c.OneofDelimitedChild.CopyToVT(d.OneofDelimitedChild)
+			}
+		} else {
+			dst.Choice = c.CloneVT()
+		}
+	default:
+		dst.Choice = nil
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *Editions_OneofInt32) CloneVT() isEditions_Choice {
+	if m == nil {
+		return (*Editions_OneofInt32)(nil)
+	}
+	r := &Editions_OneofInt32{
+		OneofInt32: m.OneofInt32,
+	}
+	return r
+}
+
+func (m *Editions_OneofString) CloneVT() isEditions_Choice {
+	if m == nil {
+		return (*Editions_OneofString)(nil)
+	}
+	r := &Editions_OneofString{
+		OneofString: m.OneofString,
+	}
+	return r
+}
+
+func (m *Editions_OneofChild) CloneVT() isEditions_Choice {
+	if m == nil {
+		return (*Editions_OneofChild)(nil)
+	}
+	r := &Editions_OneofChild{
+		OneofChild: m.OneofChild.CloneVT(),
+	}
+	return r
+}
+
+func (m *Editions_OneofDelimitedChild) CloneVT() isEditions_Choice {
+	if m == nil {
+		return (*Editions_OneofDelimitedChild)(nil)
+	}
+	r := &Editions_OneofDelimitedChild{
+		OneofDelimitedChild: m.OneofDelimitedChild.CloneVT(),
+	}
+	return r
+}
+
+func (m *EditionsMaps) CloneVT() *EditionsMaps {
+	if m == nil {
+		return (*EditionsMaps)(nil)
+	}
+	r := &EditionsMaps{}
+	if rhs := m.Children; rhs != nil {
+		tmpContainer := make(map[string]*Child, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.Children = tmpContainer
+	}
+	if rhs := m.Strings; rhs != nil {
+		tmpContainer := make(map[string]string, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v
+		}
+		r.Strings = tmpContainer
+	}
+	if rhs := m.Enums; rhs != nil {
+		tmpContainer := make(map[int32]OpenEnum, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v
+		}
+		r.Enums = tmpContainer
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *EditionsMaps) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *EditionsMaps) CopyToVT(dst *EditionsMaps) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if dst.Children == nil && len(m.Children) > 0 {
+		dst.Children = make(map[string]*Child, len(m.Children))
+	}
+	for k := range dst.Children {
+		if _, ok := m.Children[k]; !ok {
+			delete(dst.Children, k)
+		}
+	}
+	for k, v := range m.Children {
+		if dst.Children[k] == nil {
+			dst.Children[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.Children[k])
+		}
+	}
+	if dst.Strings == nil && len(m.Strings) > 0 {
+		dst.Strings = make(map[string]string, len(m.Strings))
+	}
+	for k := range dst.Strings {
+		if _, ok := m.Strings[k]; !ok {
+			delete(dst.Strings, k)
+		}
+	}
+	for k, v := range m.Strings {
+		dst.Strings[k] = v
+	}
+	if dst.Enums == nil && len(m.Enums) > 0 {
+		dst.Enums = make(map[int32]OpenEnum, len(m.Enums))
+	}
+	for k := range dst.Enums {
+		if _, ok := m.Enums[k]; !ok {
+			delete(dst.Enums, k)
+		}
+	}
+	for k, v := range m.Enums {
+		dst.Enums[k] = v
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *EditionsRequired) CloneVT() *EditionsRequired {
+	if m == nil {
+		return (*EditionsRequired)(nil)
+	}
+	r := &EditionsRequired{
+		Child: m.Child.CloneVT(),
+	}
+	if rhs := m.Value; rhs != nil {
+		tmpVal := *rhs
+		r.Value = &tmpVal
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *EditionsRequired) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *EditionsRequired) CopyToVT(dst *EditionsRequired) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.Value == nil {
+		dst.Value = nil
+	} else {
+		if dst.Value == nil {
+			dst.Value = new(int32)
+		}
+		*dst.Value = *m.Value
+	}
+	if m.Child == nil {
+		dst.Child = nil
+	} else {
+		if dst.Child == nil {
+			dst.Child = m.Child.CloneVT()
+		} else {
+			m.Child.CopyToVT(dst.Child)
+		}
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *EditionsPooled) CloneVT() *EditionsPooled {
+	if m == nil {
+		return (*EditionsPooled)(nil)
+	}
+	r := EditionsPooledFromVTPool()
+	m.CopyToVT(r)
+	return r
+}
+
+func (m *EditionsPooled) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *EditionsPooled) CopyToVT(dst *EditionsPooled) {
+	if m == nil {
+		dst.ResetVT()
+		return
+	}
+	if m.ExplicitBytes == nil {
+		dst.ExplicitBytes = nil
+	} else if dst.ExplicitBytes == nil {
+		dst.ExplicitBytes = append([]byte{}, m.ExplicitBytes...)
+	} else {
+		dst.ExplicitBytes = append(dst.ExplicitBytes[:0], m.ExplicitBytes...)
+	}
+	dst.ImplicitBytes = append(dst.ImplicitBytes[:0], m.ImplicitBytes...)
+	if len(m.DelimitedChildren) > cap(dst.DelimitedChildren) {
+		tmpContainer := make([]*Child, len(m.DelimitedChildren))
+		copy(tmpContainer, dst.DelimitedChildren[:cap(dst.DelimitedChildren)])
+		dst.DelimitedChildren = tmpContainer
+	} else {
+		dst.DelimitedChildren = dst.DelimitedChildren[:len(m.DelimitedChildren)]
+	}
+	for k, v := range m.DelimitedChildren {
+		if dst.DelimitedChildren[k] == nil {
+			dst.DelimitedChildren[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.DelimitedChildren[k])
+		}
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (this *Child) EqualVT(that *Child) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Child) EqualVTWithOptions(that *Child, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	if p, q := this.Value, that.Value; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := this.Name, that.Name; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Child) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Child)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *Editions) EqualVT(that *Editions) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Editions) EqualVTWithOptions(that *Editions, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	if this.Choice == nil && that.Choice != nil {
+		return false
+	} else if this.Choice != nil {
+		if that.Choice == nil {
+			return false
+		}
+		if !this.Choice.(interface {
+			EqualVTWithOptions(isEditions_Choice, vtproto.EqualOptions) bool
+		}).EqualVTWithOptions(that.Choice, opts) {
+			return false
+		}
+	}
+	if p, q := this.ExplicitInt32, that.ExplicitInt32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := this.ExplicitInt64, that.ExplicitInt64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := this.ExplicitSint64, that.ExplicitSint64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := this.ExplicitFixed32, that.ExplicitFixed32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := this.ExplicitDouble, that.ExplicitDouble; (p == nil && q != nil) || (p != nil && (q == nil || !opts.EqualFloat64(*p, *q))) {
+		return false
+	}
+	if p, q := this.ExplicitBool, that.ExplicitBool; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := this.ExplicitString, that.ExplicitString; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := this.ExplicitBytes, that.ExplicitBytes; (p == nil && q != nil) || (p != nil && q == nil) || string(p) != string(q) {
+		return false
+	}
+	if p, q := this.ExplicitEnum, that.ExplicitEnum; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if this.ImplicitInt32 != that.ImplicitInt32 {
+		return false
+	}
+	if this.ImplicitInt64 != that.ImplicitInt64 {
+		return false
+	}
+	if this.ImplicitSint64 != that.ImplicitSint64 {
+		return false
+	}
+	if this.ImplicitFixed32 != that.ImplicitFixed32 {
+		return false
+	}
+	if !opts.EqualFloat64(this.ImplicitDouble, that.ImplicitDouble) {
+		return false
+	}
+	if this.ImplicitBool != that.ImplicitBool {
+		return false
+	}
+	if this.ImplicitString != that.ImplicitString {
+		return false
+	}
+	if string(this.ImplicitBytes) != string(that.ImplicitBytes) {
+		return false
+	}
+	if this.ImplicitEnum != that.ImplicitEnum {
+		return false
+	}
+	if len(this.PackedInt32) != len(that.PackedInt32) {
+		return false
+	}
+	for i, vx := range this.PackedInt32 {
+		vy := that.PackedInt32[i]
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.PackedDouble) != len(that.PackedDouble) {
+		return false
+	}
+	for i, vx := range this.PackedDouble {
+		vy := that.PackedDouble[i]
+		if !opts.EqualFloat64(vx, vy) {
+			return false
+		}
+	}
+	if len(this.PackedEnum) != len(that.PackedEnum) {
+		return false
+	}
+	for i, vx := range this.PackedEnum {
+		vy := that.PackedEnum[i]
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.ExpandedInt32) != len(that.ExpandedInt32) {
+		return false
+	}
+	for i, vx := range this.ExpandedInt32 {
+		vy := that.ExpandedInt32[i]
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.ExpandedDouble) != len(that.ExpandedDouble) {
+		return false
+	}
+	for i, vx := range this.ExpandedDouble {
+		vy := that.ExpandedDouble[i]
+		if !opts.EqualFloat64(vx, vy) {
+			return false
+		}
+	}
+	if len(this.ExpandedEnum) != len(that.ExpandedEnum) {
+		return false
+	}
+	for i, vx := range this.ExpandedEnum {
+		vy := that.ExpandedEnum[i]
+		if vx != vy {
+			return false
+		}
+	}
+	if !this.Child.EqualVTWithOptions(that.Child, opts) {
+		return false
+	}
+	if !this.DelimitedChild.EqualVTWithOptions(that.DelimitedChild, opts) {
+		return false
+	}
+	if len(this.DelimitedChildren) != len(that.DelimitedChildren) {
+		return false
+	}
+	for i, vx := range this.DelimitedChildren {
+		vy := that.DelimitedChildren[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Child{}
+			}
+			if q == nil {
+				q = &Child{}
+			}
+			if !p.EqualVTWithOptions(q, opts) {
+				return false
+			}
+		}
+	}
+	if p, q := this.ClosedEnum, that.ClosedEnum; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if len(this.ClosedEnums) != len(that.ClosedEnums) {
+		return false
+	}
+	for i, vx := range this.ClosedEnums {
+		vy := that.ClosedEnums[i]
+		if vx != vy {
+			return false
+		}
+	}
+	if p, q := this.UnverifiedString, that.UnverifiedString; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if len(this.VerifiedStrings) != len(that.VerifiedStrings) {
+		return false
+	}
+	for i, vx := range this.VerifiedStrings {
+		vy := that.VerifiedStrings[i]
+		if vx != vy {
+			return false
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Editions) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Editions)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *Editions_OneofInt32) EqualVT(thatIface isEditions_Choice) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *Editions_OneofInt32) EqualVTWithOptions(thatIface isEditions_Choice, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*Editions_OneofInt32)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.OneofInt32 != that.OneofInt32 {
+		return false
+	}
+	return true
+}
+
+func (this *Editions_OneofString) EqualVT(thatIface isEditions_Choice) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *Editions_OneofString) EqualVTWithOptions(thatIface isEditions_Choice, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*Editions_OneofString)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.OneofString != that.OneofString {
+		return false
+	}
+	return true
+}
+
+func (this *Editions_OneofChild) EqualVT(thatIface isEditions_Choice) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *Editions_OneofChild) EqualVTWithOptions(thatIface isEditions_Choice, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*Editions_OneofChild)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if p, q := this.OneofChild, that.OneofChild; p != q {
+		if p == nil {
+			p = &Child{}
+		}
+		if q == nil {
+			q = &Child{}
+		}
+		if !p.EqualVTWithOptions(q, opts) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *Editions_OneofDelimitedChild) EqualVT(thatIface isEditions_Choice) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *Editions_OneofDelimitedChild) EqualVTWithOptions(thatIface isEditions_Choice, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*Editions_OneofDelimitedChild)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if p, q := this.OneofDelimitedChild, that.OneofDelimitedChild; p != q {
+		if p == nil {
+			p = &Child{}
+		}
+		if q == nil {
+			q = &Child{}
+		}
+		if !p.EqualVTWithOptions(q, opts) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *EditionsMaps) EqualVT(that *EditionsMaps) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *EditionsMaps) EqualVTWithOptions(that *EditionsMaps, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	if len(this.Children) != len(that.Children) {
+		return false
+	}
+	for i, vx := range this.Children {
+		vy, ok := that.Children[i]
+		if !ok {
+			return false
+		}
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Child{}
+			}
+			if q == nil {
+				q = &Child{}
+			}
+			if !p.EqualVTWithOptions(q, opts) {
+				return false
+			}
+		}
+	}
+	if len(this.Strings) != len(that.Strings) {
+		return false
+	}
+	for i, vx := range this.Strings {
+		vy, ok := that.Strings[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.Enums) != len(that.Enums) {
+		return false
+	}
+	for i, vx := range this.Enums {
+		vy, ok := that.Enums[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *EditionsMaps) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*EditionsMaps)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *EditionsRequired) EqualVT(that *EditionsRequired) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *EditionsRequired) EqualVTWithOptions(that *EditionsRequired, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	if p, q := this.Value, that.Value; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if !this.Child.EqualVTWithOptions(that.Child, opts) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *EditionsRequired) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*EditionsRequired)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *EditionsPooled) EqualVT(that *EditionsPooled) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *EditionsPooled) EqualVTWithOptions(that *EditionsPooled, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	if p, q := this.ExplicitBytes, that.ExplicitBytes; (p == nil && q != nil) || (p != nil && q == nil) || string(p) != string(q) {
+		return false
+	}
+	if string(this.ImplicitBytes) != string(that.ImplicitBytes) {
+		return false
+	}
+	if len(this.DelimitedChildren) != len(that.DelimitedChildren) {
+		return false
+	}
+	for i, vx := range this.DelimitedChildren {
+		vy := that.DelimitedChildren[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Child{}
+			}
+			if q == nil {
+				q = &Child{}
+			}
+			if !p.EqualVTWithOptions(q, opts) {
+				return false
+			}
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *EditionsPooled) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*EditionsPooled)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (m *Child) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Child) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Child) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Child) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Child) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Name != nil {
+		i -= len(*m.Name)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarint(dAtA, i, uint64(len(*m.Name)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x12
+	}
+	if m.Value != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.Value))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Editions) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Editions) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Editions) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Editions) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Editions) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if vtmsg, ok := m.Choice.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if len(m.VerifiedStrings) > 0 {
+		for iNdEx := len(m.VerifiedStrings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VerifiedStrings[iNdEx])
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], m.VerifiedStrings[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.VerifiedStrings[iNdEx])))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa2
+			dAtA[i+1] = 0x3
+		}
+	}
+	if m.UnverifiedString != nil {
+		i -= len(*m.UnverifiedString)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], *m.UnverifiedString)
+		i = encodeVarint(dAtA, i, uint64(len(*m.UnverifiedString)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x9a
+		dAtA[i+1] = 0x3
+	}
+	if len(m.ClosedEnums) > 0 {
+		var pksize2 int
+		for _, num := range m.ClosedEnums {
+			pksize2 += sov(uint64(num))
+		}
+		i -= pksize2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j1 := i
+		for _, num1 := range m.ClosedEnums {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA[j1] = uint8(num)
+			j1++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize2))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xd2
+		dAtA[i+1] = 0x2
+	}
+	if m.ClosedEnum != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.ClosedEnum))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xc8
+		dAtA[i+1] = 0x2
+	}
+	if len(m.DelimitedChildren) > 0 {
+		for iNdEx := len(m.DelimitedChildren) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8c
+			dAtA[i+1] = 0x2
+			size, err := m.DelimitedChildren[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8b
+			dAtA[i+1] = 0x2
+		}
+	}
+	if m.DelimitedChild != nil {
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x84
+		dAtA[i+1] = 0x2
+		size, err := m.DelimitedChild.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x83
+		dAtA[i+1] = 0x2
+	}
+	if m.Child != nil {
+		size, err := m.Child.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xfa
+		dAtA[i+1] = 0x1
+	}
+	if len(m.ExpandedEnum) > 0 {
+		for iNdEx := len(m.ExpandedEnum) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.ExpandedEnum[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xd0
+			dAtA[i+1] = 0x1
+		}
+	}
+	if len(m.ExpandedDouble) > 0 {
+		for iNdEx := len(m.ExpandedDouble) - 1; iNdEx >= 0; iNdEx-- {
+			f3 := math.Float64bits(float64(m.ExpandedDouble[iNdEx]))
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f3))
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xc9
+			dAtA[i+1] = 0x1
+		}
+	}
+	if len(m.ExpandedInt32) > 0 {
+		for iNdEx := len(m.ExpandedInt32) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.ExpandedInt32[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xc0
+			dAtA[i+1] = 0x1
+		}
+	}
+	if len(m.PackedEnum) > 0 {
+		var pksize5 int
+		for _, num := range m.PackedEnum {
+			pksize5 += sov(uint64(num))
+		}
+		i -= pksize5
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j4 := i
+		for _, num1 := range m.PackedEnum {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA[j4] = uint8(num)
+			j4++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize5))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xba
+		dAtA[i+1] = 0x1
+	}
+	if len(m.PackedDouble) > 0 {
+		for iNdEx := len(m.PackedDouble) - 1; iNdEx >= 0; iNdEx-- {
+			f6 := math.Float64bits(float64(m.PackedDouble[iNdEx]))
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f6))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedDouble)*8))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xb2
+		dAtA[i+1] = 0x1
+	}
+	if len(m.PackedInt32) > 0 {
+		var pksize8 int
+		for _, num := range m.PackedInt32 {
+			pksize8 += sov(uint64(num))
+		}
+		i -= pksize8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j7 := i
+		for _, num1 := range m.PackedInt32 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA[j7] = uint8(num)
+			j7++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize8))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xaa
+		dAtA[i+1] = 0x1
+	}
+	if m.ImplicitEnum != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ImplicitEnum))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x98
+		dAtA[i+1] = 0x1
+	}
+	if len(m.ImplicitBytes) > 0 {
+		i -= len(m.ImplicitBytes)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.ImplicitBytes)
+		i = encodeVarint(dAtA, i, uint64(len(m.ImplicitBytes)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x92
+		dAtA[i+1] = 0x1
+	}
+	if len(m.ImplicitString) > 0 {
+		i -= len(m.ImplicitString)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.ImplicitString)
+		i = encodeVarint(dAtA, i, uint64(len(m.ImplicitString)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8a
+		dAtA[i+1] = 0x1
+	}
+	if m.ImplicitBool {
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		if m.ImplicitBool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x80
+		dAtA[i+1] = 0x1
+	}
+	if m.ImplicitDouble != 0 {
+		i -= 8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ImplicitDouble))))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x79
+	}
+	if m.ImplicitFixed32 != 0 {
+		i -= 4
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.ImplicitFixed32))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x75
+	}
+	if m.ImplicitSint64 != 0 {
+		i = encodeVarint(dAtA, i, uint64((uint64(m.ImplicitSint64)<<1)^uint64((m.ImplicitSint64>>63))))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x68
+	}
+	if m.ImplicitInt64 != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ImplicitInt64))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x60
+	}
+	if m.ImplicitInt32 != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ImplicitInt32))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x58
+	}
+	if m.ExplicitEnum != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.ExplicitEnum))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x48
+	}
+	if m.ExplicitBytes != nil {
+		i -= len(m.ExplicitBytes)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.ExplicitBytes)
+		i = encodeVarint(dAtA, i, uint64(len(m.ExplicitBytes)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x42
+	}
+	if m.ExplicitString != nil {
+		i -= len(*m.ExplicitString)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], *m.ExplicitString)
+		i = encodeVarint(dAtA, i, uint64(len(*m.ExplicitString)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x3a
+	}
+	if m.ExplicitBool != nil {
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		if *m.ExplicitBool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x30
+	}
+	if m.ExplicitDouble != nil {
+		i -= 8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.ExplicitDouble))))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x29
+	}
+	if m.ExplicitFixed32 != nil {
+		i -= 4
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.ExplicitFixed32))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x25
+	}
+	if m.ExplicitSint64 != nil {
+		i = encodeVarint(dAtA, i, uint64((uint64(*m.ExplicitSint64)<<1)^uint64((*m.ExplicitSint64>>63))))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x18
+	}
+	if m.ExplicitInt64 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.ExplicitInt64))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x10
+	}
+	if m.ExplicitInt32 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.ExplicitInt32))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Editions_OneofInt32) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Editions_OneofInt32) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Editions_OneofInt32) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarint(dAtA, i, uint64(m.OneofInt32))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i -= 2
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0xe8
+	dAtA[i+1] = 0x3
+	return len(dAtA) - i, nil
+}
+func (m *Editions_OneofString) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Editions_OneofString) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Editions_OneofString) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.OneofString)
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	copy(dAtA[i:], m.OneofString)
+	i = encodeVarint(dAtA, i, uint64(len(m.OneofString)))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i -= 2
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0xf2
+	dAtA[i+1] = 0x3
+	return len(dAtA) - i, nil
+}
+func (m *Editions_OneofChild) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Editions_OneofChild) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Editions_OneofChild) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OneofChild != nil {
+		size, err := m.OneofChild.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xfa
+		dAtA[i+1] = 0x3
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Editions_OneofDelimitedChild) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Editions_OneofDelimitedChild) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Editions_OneofDelimitedChild) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OneofDelimitedChild != nil {
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x84
+		dAtA[i+1] = 0x4
+		size, err := m.OneofDelimitedChild.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x83
+		dAtA[i+1] = 0x4
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EditionsMaps) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *EditionsMaps) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *EditionsMaps) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *EditionsMaps) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *EditionsMaps) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Enums) > 0 {
+		for k := range m.Enums {
+			v := m.Enums[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i = encodeVarint(dAtA, i, uint64(k))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Strings) > 0 {
+		for k := range m.Strings {
+			v := m.Strings[k]
+			baseI := i
+			i -= len(v)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+			i -= len(k)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Children) > 0 {
+		for k := range m.Children {
+			v := m.Children[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+			i -= len(k)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EditionsRequired) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *EditionsRequired) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *EditionsRequired) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *EditionsRequired) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *EditionsRequired) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Child == nil {
+		return 0, fmt.Errorf("proto: required field child not set")
+	} else {
+		size, err := m.Child.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x12
+	}
+	if m.Value == nil {
+		return 0, fmt.Errorf("proto: required field value not set")
+	} else {
+		i = encodeVarint(dAtA, i, uint64(*m.Value))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EditionsPooled) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *EditionsPooled) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *EditionsPooled) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *EditionsPooled) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *EditionsPooled) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.DelimitedChildren) > 0 {
+		for iNdEx := len(m.DelimitedChildren) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x1c
+			size, err := m.DelimitedChildren[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x1b
+		}
+	}
+	if len(m.ImplicitBytes) > 0 {
+		i -= len(m.ImplicitBytes)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.ImplicitBytes)
+		i = encodeVarint(dAtA, i, uint64(len(m.ImplicitBytes)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x12
+	}
+	if m.ExplicitBytes != nil {
+		i -= len(m.ExplicitBytes)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.ExplicitBytes)
+		i = encodeVarint(dAtA, i, uint64(len(m.ExplicitBytes)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+var (
+	ErrBufferTooSmall = fmt.Errorf("proto: buffer too small to marshal the message")
+	ErrSizeMismatch   = fmt.Errorf("proto: message size changed while marshaling")
+)
+
+func encodeVarint(dAtA []byte, offset int, v uint64) int {
+	offset -= sov(v)
+	if offset < 0 {
+		return offset
+	}
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Child) MergeVT(src *Child) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Value != nil {
+		tmpVal := *src.Value
+		m.Value = &tmpVal
+	}
+	if src.Name != nil {
+		tmpVal := *src.Name
+		m.Name = &tmpVal
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Child) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Child); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *Editions) MergeVT(src *Editions) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.ExplicitInt32 != nil {
+		tmpVal := *src.ExplicitInt32
+		m.ExplicitInt32 = &tmpVal
+	}
+	if src.ExplicitInt64 != nil {
+		tmpVal := *src.ExplicitInt64
+		m.ExplicitInt64 = &tmpVal
+	}
+	if src.ExplicitSint64 != nil {
+		tmpVal := *src.ExplicitSint64
+		m.ExplicitSint64 = &tmpVal
+	}
+	if src.ExplicitFixed32 != nil {
+		tmpVal := *src.ExplicitFixed32
+		m.ExplicitFixed32 = &tmpVal
+	}
+	if src.ExplicitDouble != nil {
+		tmpVal := *src.ExplicitDouble
+		m.ExplicitDouble = &tmpVal
+	}
+	if src.ExplicitBool != nil {
+		tmpVal := *src.ExplicitBool
+		m.ExplicitBool = &tmpVal
+	}
+	if src.ExplicitString != nil {
+		tmpVal := *src.ExplicitString
+		m.ExplicitString = &tmpVal
+	}
+	if src.ExplicitBytes != nil {
+		m.ExplicitBytes = append([]byte{}, src.ExplicitBytes...)
+	}
+	if src.ExplicitEnum != nil {
+		tmpVal := *src.ExplicitEnum
+		m.ExplicitEnum = &tmpVal
+	}
+	if src.ImplicitInt32 != 0 {
+		m.ImplicitInt32 = src.ImplicitInt32
+	}
+	if src.ImplicitInt64 != 0 {
+		m.ImplicitInt64 = src.ImplicitInt64
+	}
+	if src.ImplicitSint64 != 0 {
+		m.ImplicitSint64 = src.ImplicitSint64
+	}
+	if src.ImplicitFixed32 != 0 {
+		m.ImplicitFixed32 = src.ImplicitFixed32
+	}
+	if src.ImplicitDouble != 0 {
+		m.ImplicitDouble = src.ImplicitDouble
+	}
+	if src.ImplicitBool {
+		m.ImplicitBool = true
+	}
+	if src.ImplicitString != "" {
+		m.ImplicitString = src.ImplicitString
+	}
+	if len(src.ImplicitBytes) > 0 {
+		m.ImplicitBytes = append([]byte{}, src.ImplicitBytes...)
+	}
+	if src.ImplicitEnum != 0 {
+		m.ImplicitEnum = src.ImplicitEnum
+	}
+	m.PackedInt32 = append(m.PackedInt32, src.PackedInt32...)
+	m.PackedDouble = append(m.PackedDouble, src.PackedDouble...)
+	m.PackedEnum = append(m.PackedEnum, src.PackedEnum...)
+	m.ExpandedInt32 = append(m.ExpandedInt32, src.ExpandedInt32...)
+	m.ExpandedDouble = append(m.ExpandedDouble, src.ExpandedDouble...)
+	m.ExpandedEnum = append(m.ExpandedEnum, src.ExpandedEnum...)
+	if src.Child != nil {
+		if m.Child == nil {
+			m.Child = &Child{}
+		}
+		m.Child.MergeVT(src.Child)
+	}
+	if src.DelimitedChild != nil {
+		if m.DelimitedChild == nil {
+			m.DelimitedChild = &Child{}
+		}
+		m.DelimitedChild.MergeVT(src.DelimitedChild)
+	}
+	for _, v := range src.DelimitedChildren {
+		tmpMsg := &Child{}
+		tmpMsg.MergeVT(v)
+		m.DelimitedChildren = append(m.DelimitedChildren, tmpMsg)
+	}
+	if src.ClosedEnum != nil {
+		tmpVal := *src.ClosedEnum
+		m.ClosedEnum = &tmpVal
+	}
+	m.ClosedEnums = append(m.ClosedEnums, src.ClosedEnums...)
+	if src.UnverifiedString != nil {
+		tmpVal := *src.UnverifiedString
+		m.UnverifiedString = &tmpVal
+	}
+	m.VerifiedStrings = append(m.VerifiedStrings, src.VerifiedStrings...)
+	switch srcOneof := src.Choice.(type) {
+	case *Editions_OneofInt32:
+		m.Choice = &Editions_OneofInt32{OneofInt32: srcOneof.OneofInt32}
+	case *Editions_OneofString:
+		m.Choice = &Editions_OneofString{OneofString: srcOneof.OneofString}
+	case *Editions_OneofChild:
+		if dstOneof, ok := m.Choice.(*Editions_OneofChild); ok && dstOneof.OneofChild != nil {
+			if srcOneof.OneofChild != nil {
+				dstOneof.OneofChild.MergeVT(srcOneof.OneofChild)
+			}
+		} else {
+			tmpMsg := &Child{}
+			tmpMsg.MergeVT(srcOneof.OneofChild)
+			m.Choice = &Editions_OneofChild{OneofChild: tmpMsg}
+		}
+	case *Editions_OneofDelimitedChild:
+		if dstOneof, ok := m.Choice.(*Editions_OneofDelimitedChild); ok && dstOneof.OneofDelimitedChild != nil {
+			if srcOneof.OneofDelimitedChild != nil {
+				dstOneof.OneofDelimitedChild.MergeVT(srcOneof.OneofDelimitedChild)
+			}
+		} else {
+			tmpMsg := &Child{}
+			tmpMsg.MergeVT(srcOneof.OneofDelimitedChild)
+			m.Choice = &Editions_OneofDelimitedChild{OneofDelimitedChild: tmpMsg}
+		}
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Editions) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Editions); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *EditionsMaps) MergeVT(src *EditionsMaps) {
+	if m == nil || src == nil {
+		return
+	}
+	if len(src.Children) > 0 {
+		if m.Children == nil {
+			m.Children = make(map[string]*Child, len(src.Children))
+		}
+		for k, v := range src.Children {
+			tmpMsg := &Child{}
+			tmpMsg.MergeVT(v)
+			m.Children[k] = tmpMsg
+		}
+	}
+	if len(src.Strings) > 0 {
+		if m.Strings == nil {
+			m.Strings = make(map[string]string, len(src.Strings))
+		}
+		for k, v := range src.Strings {
+			m.Strings[k] = v
+		}
+	}
+	if len(src.Enums) > 0 {
+		if m.Enums == nil {
+			m.Enums = make(map[int32]OpenEnum, len(src.Enums))
+		}
+		for k, v := range src.Enums {
+			m.Enums[k] = v
+		}
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *EditionsMaps) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*EditionsMaps); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *EditionsRequired) MergeVT(src *EditionsRequired) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Value != nil {
+		tmpVal := *src.Value
+		m.Value = &tmpVal
+	}
+	if src.Child != nil {
+		if m.Child == nil {
+			m.Child = &Child{}
+		}
+		m.Child.MergeVT(src.Child)
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *EditionsRequired) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*EditionsRequired); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *EditionsPooled) MergeVT(src *EditionsPooled) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.ExplicitBytes != nil {
+		m.ExplicitBytes = append([]byte{}, src.ExplicitBytes...)
+	}
+	if len(src.ImplicitBytes) > 0 {
+		m.ImplicitBytes = append([]byte{}, src.ImplicitBytes...)
+	}
+	for _, v := range src.DelimitedChildren {
+		tmpMsg := &Child{}
+		tmpMsg.MergeVT(v)
+		m.DelimitedChildren = append(m.DelimitedChildren, tmpMsg)
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *EditionsPooled) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*EditionsPooled); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+var vtprotoPool_EditionsPooled = sync.Pool{
+	New: func() interface{} {
+		return &EditionsPooled{}
+	},
+}
+
+func (m *EditionsPooled) ResetVT() {
+	f0 := m.ImplicitBytes[:0]
+	m.Reset()
+	m.ImplicitBytes = f0
+}
+func (m *EditionsPooled) ReturnToVTPool() {
+	if m != nil {
+		m.ResetVT()
+		vtprotoPool_EditionsPooled.Put(m)
+	}
+}
+func EditionsPooledFromVTPool() *EditionsPooled {
+	return vtprotoPool_EditionsPooled.Get().(*EditionsPooled)
+}
+func (m *Child) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		n += 1 + sov(uint64(*m.Value))
+	}
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Editions) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExplicitInt32 != nil {
+		n += 1 + sov(uint64(*m.ExplicitInt32))
+	}
+	if m.ExplicitInt64 != nil {
+		n += 1 + sov(uint64(*m.ExplicitInt64))
+	}
+	if m.ExplicitSint64 != nil {
+		n += 1 + soz(uint64(*m.ExplicitSint64))
+	}
+	if m.ExplicitFixed32 != nil {
+		n += 5
+	}
+	if m.ExplicitDouble != nil {
+		n += 9
+	}
+	if m.ExplicitBool != nil {
+		n += 2
+	}
+	if m.ExplicitString != nil {
+		l = len(*m.ExplicitString)
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.ExplicitBytes != nil {
+		l = len(m.ExplicitBytes)
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.ExplicitEnum != nil {
+		n += 1 + sov(uint64(*m.ExplicitEnum))
+	}
+	if m.ImplicitInt32 != 0 {
+		n += 1 + sov(uint64(m.ImplicitInt32))
+	}
+	if m.ImplicitInt64 != 0 {
+		n += 1 + sov(uint64(m.ImplicitInt64))
+	}
+	if m.ImplicitSint64 != 0 {
+		n += 1 + soz(uint64(m.ImplicitSint64))
+	}
+	if m.ImplicitFixed32 != 0 {
+		n += 5
+	}
+	if m.ImplicitDouble != 0 {
+		n += 9
+	}
+	if m.ImplicitBool {
+		n += 3
+	}
+	l = len(m.ImplicitString)
+	if l > 0 {
+		n += 2 + l + sov(uint64(l))
+	}
+	l = len(m.ImplicitBytes)
+	if l > 0 {
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.ImplicitEnum != 0 {
+		n += 2 + sov(uint64(m.ImplicitEnum))
+	}
+	if len(m.PackedInt32) > 0 {
+		l = 0
+		for _, e := range m.PackedInt32 {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.PackedDouble) > 0 {
+		n += 2 + sov(uint64(len(m.PackedDouble)*8)) + len(m.PackedDouble)*8
+	}
+	if len(m.PackedEnum) > 0 {
+		l = 0
+		for _, e := range m.PackedEnum {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.ExpandedInt32) > 0 {
+		for _, e := range m.ExpandedInt32 {
+			n += 2 + sov(uint64(e))
+		}
+	}
+	if len(m.ExpandedDouble) > 0 {
+		n += 10 * len(m.ExpandedDouble)
+	}
+	if len(m.ExpandedEnum) > 0 {
+		for _, e := range m.ExpandedEnum {
+			n += 2 + sov(uint64(e))
+		}
+	}
+	if m.Child != nil {
+		l = m.Child.SizeVT()
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.DelimitedChild != nil {
+		l = m.DelimitedChild.SizeVT()
+		n += l + 4
+	}
+	if len(m.DelimitedChildren) > 0 {
+		for _, e := range m.DelimitedChildren {
+			l = e.SizeVT()
+			n += l + 4
+		}
+	}
+	if m.ClosedEnum != nil {
+		n += 2 + sov(uint64(*m.ClosedEnum))
+	}
+	if len(m.ClosedEnums) > 0 {
+		l = 0
+		for _, e := range m.ClosedEnums {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if m.UnverifiedString != nil {
+		l = len(*m.UnverifiedString)
+		n += 2 + l + sov(uint64(l))
+	}
+	if len(m.VerifiedStrings) > 0 {
+		for _, s := range m.VerifiedStrings {
+			l = len(s)
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if vtmsg, ok := m.Choice.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Editions_OneofInt32) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2 + sov(uint64(m.OneofInt32))
+	return n
+}
+func (m *Editions_OneofString) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OneofString)
+	n += 2 + l + sov(uint64(l))
+	return n
+}
+func (m *Editions_OneofChild) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OneofChild != nil {
+		l = m.OneofChild.SizeVT()
+		n += 2 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *Editions_OneofDelimitedChild) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OneofDelimitedChild != nil {
+		l = m.OneofDelimitedChild.SizeVT()
+		n += l + 4
+	}
+	return n
+}
+func (m *EditionsMaps) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Children) > 0 {
+		for k, v := range m.Children {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.SizeVT()
+			}
+			l += 1 + sov(uint64(l))
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Strings) > 0 {
+		for k, v := range m.Strings {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + len(v) + sov(uint64(len(v)))
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Enums) > 0 {
+		for k, v := range m.Enums {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sov(uint64(k)) + 1 + sov(uint64(v))
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *EditionsRequired) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		n += 1 + sov(uint64(*m.Value))
+	}
+	if m.Child != nil {
+		l = m.Child.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *EditionsPooled) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExplicitBytes != nil {
+		l = len(m.ExplicitBytes)
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.ImplicitBytes)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.DelimitedChildren) > 0 {
+		for _, e := range m.DelimitedChildren {
+			l = e.SizeVT()
+			n += l + 2
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func sov(x uint64) (n int) {
+	return (bits.Len64(x|1) + 6) / 7
+}
+func soz(x uint64) (n int) {
+	return sov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Child) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Child) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *Child) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *Child) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Child)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Child: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Child: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Value = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			s := string(string(dAtA[iNdEx:postIndex]))
+			m.Name = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Editions) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Editions) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *Editions) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *Editions) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Editions)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Editions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Editions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitInt32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExplicitInt32 = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitInt64", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExplicitInt64 = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitSint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			v2 := int64(v)
+			m.ExplicitSint64 = &v2
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitFixed32", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.ExplicitFixed32 = &v
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitDouble", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.ExplicitDouble = &v2
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitBool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ExplicitBool = &b
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			s := string(string(dAtA[iNdEx:postIndex]))
+			m.ExplicitString = &s
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExplicitBytes = append(m.ExplicitBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExplicitBytes == nil {
+				m.ExplicitBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitEnum", wireType)
+			}
+			var v OpenEnum
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= OpenEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExplicitEnum = &v
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitInt32", wireType)
+			}
+			m.ImplicitInt32 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ImplicitInt32 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitInt64", wireType)
+			}
+			m.ImplicitInt64 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ImplicitInt64 |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitSint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			m.ImplicitSint64 = int64(v)
+		case 14:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitFixed32", wireType)
+			}
+			m.ImplicitFixed32 = 0
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImplicitFixed32 = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+		case 15:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitDouble", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ImplicitDouble = float64(math.Float64frombits(v))
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitBool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ImplicitBool = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.ImplicitString = string(string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImplicitBytes = append(m.ImplicitBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.ImplicitBytes == nil {
+				m.ImplicitBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitEnum", wireType)
+			}
+			m.ImplicitEnum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ImplicitEnum |= OpenEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedInt32 = append(m.PackedInt32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedInt32) == 0 {
+					m.PackedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PackedInt32 = append(m.PackedInt32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedInt32", wireType)
+			}
+		case 22:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.PackedDouble = append(m.PackedDouble, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.PackedDouble) == 0 {
+					m.PackedDouble = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.PackedDouble = append(m.PackedDouble, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedDouble", wireType)
+			}
+		case 23:
+			if wireType == 0 {
+				var v OpenEnum
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= OpenEnum(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedEnum = append(m.PackedEnum, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.PackedEnum) == 0 {
+					m.PackedEnum = make([]OpenEnum, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v OpenEnum
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= OpenEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PackedEnum = append(m.PackedEnum, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedEnum", wireType)
+			}
+		case 24:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExpandedInt32 = append(m.ExpandedInt32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExpandedInt32) == 0 {
+					m.ExpandedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExpandedInt32 = append(m.ExpandedInt32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpandedInt32", wireType)
+			}
+		case 25:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.ExpandedDouble = append(m.ExpandedDouble, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.ExpandedDouble) == 0 {
+					m.ExpandedDouble = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.ExpandedDouble = append(m.ExpandedDouble, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpandedDouble", wireType)
+			}
+		case 26:
+			if wireType == 0 {
+				var v OpenEnum
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= OpenEnum(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExpandedEnum = append(m.ExpandedEnum, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.ExpandedEnum) == 0 {
+					m.ExpandedEnum = make([]OpenEnum, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v OpenEnum
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= OpenEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExpandedEnum = append(m.ExpandedEnum, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpandedEnum", wireType)
+			}
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Child", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Child == nil {
+				m.Child = &Child{}
+			}
+			if err := m.Child.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 3 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelimitedChild", wireType)
+			}
+			groupStart := iNdEx
+			var groupEnd int
+			for {
+				groupEnd = iNdEx
+				var groupFieldWire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					groupFieldWire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if groupFieldWire&0x7 == 4 {
+					if int32(groupFieldWire>>3) != 32 {
+						return fmt.Errorf("proto: mismatched end group for field DelimitedChild")
+					}
+					break
+				}
+				iNdEx = groupEnd
+				skippy, err := skip(dAtA[iNdEx:])
+				if err != nil {
+					return err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx += skippy
+			}
+			if m.DelimitedChild == nil {
+				m.DelimitedChild = &Child{}
+			}
+			if err := m.DelimitedChild.unmarshalVT(dAtA[groupStart:groupEnd], opts, depth); err != nil {
+				return err
+			}
+		case 33:
+			if wireType != 3 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelimitedChildren", wireType)
+			}
+			groupStart := iNdEx
+			var groupEnd int
+			for {
+				groupEnd = iNdEx
+				var groupFieldWire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					groupFieldWire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if groupFieldWire&0x7 == 4 {
+					if int32(groupFieldWire>>3) != 33 {
+						return fmt.Errorf("proto: mismatched end group for field DelimitedChildren")
+					}
+					break
+				}
+				iNdEx = groupEnd
+				skippy, err := skip(dAtA[iNdEx:])
+				if err != nil {
+					return err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx += skippy
+			}
+			m.DelimitedChildren = append(m.DelimitedChildren, &Child{})
+			if err := m.DelimitedChildren[len(m.DelimitedChildren)-1].unmarshalVT(dAtA[groupStart:groupEnd], opts, depth); err != nil {
+				return err
+			}
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedEnum", wireType)
+			}
+			var v ClosedEnum
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= ClosedEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClosedEnum = &v
+		case 42:
+			if wireType == 0 {
+				var v ClosedEnum
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ClosedEnum(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ClosedEnums = append(m.ClosedEnums, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.ClosedEnums) == 0 {
+					m.ClosedEnums = make([]ClosedEnum, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ClosedEnum
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ClosedEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ClosedEnums = append(m.ClosedEnums, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedEnums", wireType)
+			}
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnverifiedString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(string(dAtA[iNdEx:postIndex]))
+			m.UnverifiedString = &s
+			iNdEx = postIndex
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedStrings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.VerifiedStrings = append(m.VerifiedStrings, string(string(dAtA[iNdEx:postIndex])))
+			iNdEx = postIndex
+		case 61:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofInt32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Choice = &Editions_OneofInt32{OneofInt32: v}
+		case 62:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.Choice = &Editions_OneofString{OneofString: string(string(dAtA[iNdEx:postIndex]))}
+			iNdEx = postIndex
+		case 63:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofChild", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.Choice.(*Editions_OneofChild); ok {
+				if err := oneof.OneofChild.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+					return err
+				}
+			} else {
+				v := &Child{}
+				if err := v.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+					return err
+				}
+				m.Choice = &Editions_OneofChild{OneofChild: v}
+			}
+			iNdEx = postIndex
+		case 64:
+			if wireType != 3 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofDelimitedChild", wireType)
+			}
+			groupStart := iNdEx
+			var groupEnd int
+			for {
+				groupEnd = iNdEx
+				var groupFieldWire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					groupFieldWire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if groupFieldWire&0x7 == 4 {
+					if int32(groupFieldWire>>3) != 64 {
+						return fmt.Errorf("proto: mismatched end group for field OneofDelimitedChild")
+					}
+					break
+				}
+				iNdEx = groupEnd
+				skippy, err := skip(dAtA[iNdEx:])
+				if err != nil {
+					return err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx += skippy
+			}
+			if oneof, ok := m.Choice.(*Editions_OneofDelimitedChild); ok {
+				if err := oneof.OneofDelimitedChild.unmarshalVT(dAtA[groupStart:groupEnd], opts, depth); err != nil {
+					return err
+				}
+			} else {
+				v := &Child{}
+				if err := v.unmarshalVT(dAtA[groupStart:groupEnd], opts, depth); err != nil {
+					return err
+				}
+				m.Choice = &Editions_OneofDelimitedChild{OneofDelimitedChild: v}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EditionsMaps) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *EditionsMaps) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *EditionsMaps) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *EditionsMaps) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*EditionsMaps)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EditionsMaps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EditionsMaps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Children == nil {
+				m.Children = make(map[string]*Child)
+			}
+			var mapkey string
+			var mapvalue *Child
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Child{}
+					if err := mapvalue.unmarshalVT(dAtA[iNdEx:postmsgIndex], opts, depth); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Children[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Strings == nil {
+				m.Strings = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapvalue]) {
+						return ErrInvalidUTF8
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Strings[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Enums == nil {
+				m.Enums = make(map[int32]OpenEnum)
+			}
+			var mapkey int32
+			var mapvalue OpenEnum
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= OpenEnum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Enums[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EditionsRequired) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *EditionsRequired) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *EditionsRequired) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *EditionsRequired) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*EditionsRequired)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EditionsRequired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EditionsRequired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Value = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Child", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Child == nil {
+				m.Child = &Child{}
+			}
+			if err := m.Child.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field value not set")
+		}
+		if hasFields[0]&uint64(0x00000002) == 0 {
+			return fmt.Errorf("proto: required field child not set")
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EditionsPooled) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *EditionsPooled) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.ResetVT()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *EditionsPooled) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *EditionsPooled) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*EditionsPooled)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EditionsPooled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EditionsPooled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExplicitBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExplicitBytes = append(m.ExplicitBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExplicitBytes == nil {
+				m.ExplicitBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImplicitBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImplicitBytes = append(m.ImplicitBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.ImplicitBytes == nil {
+				m.ImplicitBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 3 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelimitedChildren", wireType)
+			}
+			groupStart := iNdEx
+			var groupEnd int
+			for {
+				groupEnd = iNdEx
+				var groupFieldWire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					groupFieldWire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if groupFieldWire&0x7 == 4 {
+					if int32(groupFieldWire>>3) != 3 {
+						return fmt.Errorf("proto: mismatched end group for field DelimitedChildren")
+					}
+					break
+				}
+				iNdEx = groupEnd
+				skippy, err := skip(dAtA[iNdEx:])
+				if err != nil {
+					return err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx += skippy
+			}
+			if len(m.DelimitedChildren) == cap(m.DelimitedChildren) {
+				m.DelimitedChildren = append(m.DelimitedChildren, &Child{})
+			} else {
+				m.DelimitedChildren = m.DelimitedChildren[:len(m.DelimitedChildren)+1]
+				if m.DelimitedChildren[len(m.DelimitedChildren)-1] == nil {
+					m.DelimitedChildren[len(m.DelimitedChildren)-1] = &Child{}
+				}
+			}
+			if err := m.DelimitedChildren[len(m.DelimitedChildren)-1].unmarshalVT(dAtA[groupStart:groupEnd], opts, depth); err != nil {
+				return err
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skip(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLength
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroup
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLength
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLength          = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflow            = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroup   = fmt.Errorf("proto: unexpected end of group")
+	ErrInvalidUTF8            = fmt.Errorf("proto: string field contains invalid UTF-8")
+	ErrRecursionLimitExceeded = fmt.Errorf("proto: exceeded maximum recursion depth")
+)
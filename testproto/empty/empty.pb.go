@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.26.0
+// 	protoc-gen-go v1.34.2
 // 	protoc        v3.20.0
 // source: empty/empty.proto
 
@@ -27,7 +27,7 @@ var file_empty_empty_proto_rawDesc = []byte{
 	0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
-var file_empty_empty_proto_goTypes = []interface{}{}
+var file_empty_empty_proto_goTypes = []any{}
 var file_empty_empty_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
 	0, // [0:0] is the sub-list for method input_type
@@ -0,0 +1,620 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.20.0
+// source: extension/extension.proto
+
+package extension
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Color int32
+
+const (
+	Color_RED   Color = 0
+	Color_GREEN Color = 1
+	Color_BLUE  Color = 2
+)
+
+// Enum value maps for Color.
+var (
+	Color_name = map[int32]string{
+		0: "RED",
+		1: "GREEN",
+		2: "BLUE",
+	}
+	Color_value = map[string]int32{
+		"RED":   0,
+		"GREEN": 1,
+		"BLUE":  2,
+	}
+)
+
+func (x Color) Enum() *Color {
+	p := new(Color)
+	*p = x
+	return p
+}
+
+func (x Color) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Color) Descriptor() protoreflect.EnumDescriptor {
+	return file_extension_extension_proto_enumTypes[0].Descriptor()
+}
+
+func (Color) Type() protoreflect.EnumType {
+	return &file_extension_extension_proto_enumTypes[0]
+}
+
+func (x Color) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Color) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Color(num)
+	return nil
+}
+
+// Deprecated: Use Color.Descriptor instead.
+func (Color) EnumDescriptor() ([]byte, []int) {
+	return file_extension_extension_proto_rawDescGZIP(), []int{0}
+}
+
+type Extendable struct {
+	state           protoimpl.MessageState
+	sizeCache       protoimpl.SizeCache
+	unknownFields   protoimpl.UnknownFields
+	extensionFields protoimpl.ExtensionFields
+
+	Id   *int32   `protobuf:"varint,1,opt,name=id" json:"id,omitempty"`
+	Tags []string `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty"`
+}
+
+func (x *Extendable) Reset() {
+	*x = Extendable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extension_extension_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Extendable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Extendable) ProtoMessage() {}
+
+func (x *Extendable) ProtoReflect() protoreflect.Message {
+	mi := &file_extension_extension_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Extendable.ProtoReflect.Descriptor instead.
+func (*Extendable) Descriptor() ([]byte, []int) {
+	return file_extension_extension_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Extendable) GetId() int32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *Extendable) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type Payload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Values []int32 `protobuf:"varint,2,rep,name=values" json:"values,omitempty"`
+}
+
+func (x *Payload) Reset() {
+	*x = Payload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extension_extension_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Payload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payload) ProtoMessage() {}
+
+func (x *Payload) ProtoReflect() protoreflect.Message {
+	mi := &file_extension_extension_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payload.ProtoReflect.Descriptor instead.
+func (*Payload) Descriptor() ([]byte, []int) {
+	return file_extension_extension_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Payload) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Payload) GetValues() []int32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// Groups are not generated inline and exercise the runtime fallback
+type ExtGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	A *int32   `protobuf:"varint,1,opt,name=a" json:"a,omitempty"`
+	B []string `protobuf:"bytes,2,rep,name=b" json:"b,omitempty"`
+}
+
+func (x *ExtGroup) Reset() {
+	*x = ExtGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extension_extension_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtGroup) ProtoMessage() {}
+
+func (x *ExtGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_extension_extension_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtGroup.ProtoReflect.Descriptor instead.
+func (*ExtGroup) Descriptor() ([]byte, []int) {
+	return file_extension_extension_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExtGroup) GetA() int32 {
+	if x != nil && x.A != nil {
+		return *x.A
+	}
+	return 0
+}
+
+func (x *ExtGroup) GetB() []string {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+type Scope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Scope) Reset() {
+	*x = Scope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extension_extension_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Scope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scope) ProtoMessage() {}
+
+func (x *Scope) ProtoReflect() protoreflect.Message {
+	mi := &file_extension_extension_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scope.ProtoReflect.Descriptor instead.
+func (*Scope) Descriptor() ([]byte, []int) {
+	return file_extension_extension_proto_rawDescGZIP(), []int{3}
+}
+
+var file_extension_extension_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*int32)(nil),
+		Field:         100,
+		Name:          "ext_int32",
+		Tag:           "varint,100,opt,name=ext_int32",
+		Filename:      "extension/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*int64)(nil),
+		Field:         101,
+		Name:          "ext_sint64",
+		Tag:           "zigzag64,101,opt,name=ext_sint64",
+		Filename:      "extension/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         102,
+		Name:          "ext_string",
+		Tag:           "bytes,102,opt,name=ext_string",
+		Filename:      "extension/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: ([]byte)(nil),
+		Field:         103,
+		Name:          "ext_bytes",
+		Tag:           "bytes,103,opt,name=ext_bytes",
+		Filename:      "extension/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*Payload)(nil),
+		Field:         104,
+		Name:          "ext_payload",
+		Tag:           "bytes,104,opt,name=ext_payload",
+		Filename:      "extension/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: ([]int32)(nil),
+		Field:         105,
+		Name:          "ext_packed",
+		Tag:           "varint,105,rep,packed,name=ext_packed",
+		Filename:      "extension/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: ([]string)(nil),
+		Field:         106,
+		Name:          "ext_strings",
+		Tag:           "bytes,106,rep,name=ext_strings",
+		Filename:      "extension/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: ([]*Payload)(nil),
+		Field:         107,
+		Name:          "ext_payloads",
+		Tag:           "bytes,107,rep,name=ext_payloads",
+		Filename:      "extension/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*Color)(nil),
+		Field:         108,
+		Name:          "ext_color",
+		Tag:           "varint,108,opt,name=ext_color,enum=Color",
+		Filename:      "extension/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*float64)(nil),
+		Field:         109,
+		Name:          "ext_double",
+		Tag:           "fixed64,109,opt,name=ext_double",
+		Filename:      "extension/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*uint32)(nil),
+		Field:         110,
+		Name:          "ext_fixed32",
+		Tag:           "fixed32,110,opt,name=ext_fixed32",
+		Filename:      "extension/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         111,
+		Name:          "ext_bool",
+		Tag:           "varint,111,opt,name=ext_bool",
+		Filename:      "extension/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*ExtGroup)(nil),
+		Field:         150,
+		Name:          "extgroup",
+		Tag:           "group,150,opt,name=ExtGroup",
+		Filename:      "extension/extension.proto",
+	},
+	{
+		ExtendedType:  (*Extendable)(nil),
+		ExtensionType: (*float32)(nil),
+		Field:         120,
+		Name:          "Scope.nested_float",
+		Tag:           "fixed32,120,opt,name=nested_float",
+		Filename:      "extension/extension.proto",
+	},
+}
+
+// Extension fields to Extendable.
+var (
+	// optional int32 ext_int32 = 100;
+	E_ExtInt32 = &file_extension_extension_proto_extTypes[0]
+	// optional sint64 ext_sint64 = 101;
+	E_ExtSint64 = &file_extension_extension_proto_extTypes[1]
+	// optional string ext_string = 102;
+	E_ExtString = &file_extension_extension_proto_extTypes[2]
+	// optional bytes ext_bytes = 103;
+	E_ExtBytes = &file_extension_extension_proto_extTypes[3]
+	// optional Payload ext_payload = 104;
+	E_ExtPayload = &file_extension_extension_proto_extTypes[4]
+	// repeated int32 ext_packed = 105;
+	E_ExtPacked = &file_extension_extension_proto_extTypes[5]
+	// repeated string ext_strings = 106;
+	E_ExtStrings = &file_extension_extension_proto_extTypes[6]
+	// repeated Payload ext_payloads = 107;
+	E_ExtPayloads = &file_extension_extension_proto_extTypes[7]
+	// optional Color ext_color = 108;
+	E_ExtColor = &file_extension_extension_proto_extTypes[8]
+	// optional double ext_double = 109;
+	E_ExtDouble = &file_extension_extension_proto_extTypes[9]
+	// optional fixed32 ext_fixed32 = 110;
+	E_ExtFixed32 = &file_extension_extension_proto_extTypes[10]
+	// optional bool ext_bool = 111;
+	E_ExtBool = &file_extension_extension_proto_extTypes[11]
+	// optional ExtGroup extgroup = 150;
+	E_Extgroup = &file_extension_extension_proto_extTypes[12]
+	// optional float nested_float = 120;
+	E_Scope_NestedFloat = &file_extension_extension_proto_extTypes[13]
+)
+
+var File_extension_extension_proto protoreflect.FileDescriptor
+
+var file_extension_extension_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x37, 0x0a, 0x0a, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x2a, 0x05, 0x08,
+	0x64, 0x10, 0xc8, 0x01, 0x22, 0x35, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x26, 0x0a, 0x08, 0x45,
+	0x78, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x01, 0x61, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x01, 0x62, 0x22, 0x37, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x32, 0x2e, 0x0a, 0x0c,
+	0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x0b, 0x2e, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x78, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0b, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x2a, 0x25, 0x0a, 0x05,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09,
+	0x0a, 0x05, 0x47, 0x52, 0x45, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4c, 0x55,
+	0x45, 0x10, 0x02, 0x3a, 0x28, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x12, 0x0b, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x64, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x3a, 0x2a, 0x0a,
+	0x0a, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x0b, 0x2e, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x12, 0x52, 0x09,
+	0x65, 0x78, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x3a, 0x2a, 0x0a, 0x0a, 0x65, 0x78, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x0b, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x74, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x3a, 0x28, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x0b, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x67, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x65, 0x78, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x3a,
+	0x36, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x0b,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x68, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x0a, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x3a, 0x2e, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x0b, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x69, 0x20, 0x03, 0x28, 0x05, 0x42, 0x02, 0x10, 0x01, 0x52, 0x09, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x3a, 0x2c, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x0b, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x6a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x38, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x0b, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x6b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x3a,
+	0x30, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x0b, 0x2e, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x06, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x08, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x3a, 0x2a, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12,
+	0x0b, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x6d, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x65, 0x78, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x3a, 0x2c, 0x0a,
+	0x0b, 0x65, 0x78, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x0b, 0x2e, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x07, 0x52,
+	0x0a, 0x65, 0x78, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x3a, 0x26, 0x0a, 0x08, 0x65,
+	0x78, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x12, 0x0b, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x74, 0x42,
+	0x6f, 0x6f, 0x6c, 0x3a, 0x33, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x0b, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x96, 0x01, 0x20,
+	0x01, 0x28, 0x0a, 0x32, 0x09, 0x2e, 0x45, 0x78, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x08,
+	0x65, 0x78, 0x74, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x15, 0x5a, 0x13, 0x74, 0x65, 0x73, 0x74,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+}
+
+var (
+	file_extension_extension_proto_rawDescOnce sync.Once
+	file_extension_extension_proto_rawDescData = file_extension_extension_proto_rawDesc
+)
+
+func file_extension_extension_proto_rawDescGZIP() []byte {
+	file_extension_extension_proto_rawDescOnce.Do(func() {
+		file_extension_extension_proto_rawDescData = protoimpl.X.CompressGZIP(file_extension_extension_proto_rawDescData)
+	})
+	return file_extension_extension_proto_rawDescData
+}
+
+var file_extension_extension_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_extension_extension_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_extension_extension_proto_goTypes = []any{
+	(Color)(0),         // 0: Color
+	(*Extendable)(nil), // 1: Extendable
+	(*Payload)(nil),    // 2: Payload
+	(*ExtGroup)(nil),   // 3: ExtGroup
+	(*Scope)(nil),      // 4: Scope
+}
+var file_extension_extension_proto_depIdxs = []int32{
+	1,  // 0: ext_int32:extendee -> Extendable
+	1,  // 1: ext_sint64:extendee -> Extendable
+	1,  // 2: ext_string:extendee -> Extendable
+	1,  // 3: ext_bytes:extendee -> Extendable
+	1,  // 4: ext_payload:extendee -> Extendable
+	1,  // 5: ext_packed:extendee -> Extendable
+	1,  // 6: ext_strings:extendee -> Extendable
+	1,  // 7: ext_payloads:extendee -> Extendable
+	1,  // 8: ext_color:extendee -> Extendable
+	1,  // 9: ext_double:extendee -> Extendable
+	1,  // 10: ext_fixed32:extendee -> Extendable
+	1,  // 11: ext_bool:extendee -> Extendable
+	1,  // 12: extgroup:extendee -> Extendable
+	1,  // 13: Scope.nested_float:extendee -> Extendable
+	2,  // 14: ext_payload:type_name -> Payload
+	2,  // 15: ext_payloads:type_name -> Payload
+	0,  // 16: ext_color:type_name -> Color
+	3,  // 17: extgroup:type_name -> ExtGroup
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	14, // [14:18] is the sub-list for extension type_name
+	0,  // [0:14] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_extension_extension_proto_init() }
+func file_extension_extension_proto_init() {
+	if File_extension_extension_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_extension_extension_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Extendable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			case 3:
+				return &v.extensionFields
+			default:
+				return nil
+			}
+		}
+		file_extension_extension_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Payload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_extension_extension_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ExtGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_extension_extension_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Scope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_extension_extension_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 14,
+			NumServices:   0,
+		},
+		GoTypes:           file_extension_extension_proto_goTypes,
+		DependencyIndexes: file_extension_extension_proto_depIdxs,
+		EnumInfos:         file_extension_extension_proto_enumTypes,
+		MessageInfos:      file_extension_extension_proto_msgTypes,
+		ExtensionInfos:    file_extension_extension_proto_extTypes,
+	}.Build()
+	File_extension_extension_proto = out.File
+	file_extension_extension_proto_rawDesc = nil
+	file_extension_extension_proto_goTypes = nil
+	file_extension_extension_proto_depIdxs = nil
+}
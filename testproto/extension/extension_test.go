@@ -0,0 +1,217 @@
+package extension
+
+import (
+	"hash/fnv"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+func testExtendable() *Extendable {
+	m := &Extendable{Id: proto.Int32(7), Tags: []string{"a", "b"}}
+	proto.SetExtension(m, E_ExtInt32, int32(-42))
+	proto.SetExtension(m, E_ExtSint64, int64(-1<<40))
+	proto.SetExtension(m, E_ExtString, "hello")
+	proto.SetExtension(m, E_ExtBytes, []byte{0, 1, 2})
+	proto.SetExtension(m, E_ExtPayload, &Payload{Name: proto.String("payload"), Values: []int32{1, 2, 3}})
+	proto.SetExtension(m, E_ExtPacked, []int32{1, -1, 1 << 20})
+	proto.SetExtension(m, E_ExtStrings, []string{"x", "y"})
+	proto.SetExtension(m, E_ExtPayloads, []*Payload{{Name: proto.String("first")}, {Values: []int32{4}}})
+	proto.SetExtension(m, E_ExtColor, Color_BLUE)
+	proto.SetExtension(m, E_ExtDouble, math.Pi)
+	proto.SetExtension(m, E_ExtFixed32, uint32(1234))
+	proto.SetExtension(m, E_ExtBool, true)
+	proto.SetExtension(m, E_Scope_NestedFloat, float32(1.5))
+	// groups are handled by the runtime fallback in protohelpers
+	proto.SetExtension(m, E_Extgroup, &ExtGroup{A: proto.Int32(1), B: []string{"group"}})
+	return m
+}
+
+func TestExtensionsMarshal(t *testing.T) {
+	m := testExtendable()
+
+	expected, err := proto.Marshal(m)
+	require.NoError(t, err)
+	require.Equal(t, len(expected), m.SizeVT())
+
+	got, err := m.MarshalVT()
+	require.NoError(t, err)
+	require.Equal(t, expected, got)
+}
+
+func TestExtensionsUnmarshal(t *testing.T) {
+	m := testExtendable()
+	data, err := proto.Marshal(m)
+	require.NoError(t, err)
+
+	unmarshaled := &Extendable{}
+	require.NoError(t, unmarshaled.UnmarshalVT(data))
+	require.True(t, proto.Equal(m, unmarshaled), "expected %v, got %v", m, unmarshaled)
+	require.Empty(t, unmarshaled.unknownFields)
+
+	require.Equal(t, int32(-42), proto.GetExtension(unmarshaled, E_ExtInt32))
+	require.Equal(t, "payload", proto.GetExtension(unmarshaled, E_ExtPayload).(*Payload).GetName())
+	require.Equal(t, "group", proto.GetExtension(unmarshaled, E_Extgroup).(*ExtGroup).GetB()[0])
+}
+
+func TestExtensionsUnmarshalMerge(t *testing.T) {
+	first := &Extendable{Id: proto.Int32(1)}
+	proto.SetExtension(first, E_ExtPacked, []int32{1, 2})
+	proto.SetExtension(first, E_ExtPayload, &Payload{Name: proto.String("first")})
+
+	second := &Extendable{}
+	proto.SetExtension(second, E_ExtPacked, []int32{3})
+	proto.SetExtension(second, E_ExtPayload, &Payload{Values: []int32{4}})
+	proto.SetExtension(second, E_Extgroup, &ExtGroup{A: proto.Int32(5)})
+
+	data1, err := first.MarshalVT()
+	require.NoError(t, err)
+	data2, err := second.MarshalVT()
+	require.NoError(t, err)
+
+	expected := &Extendable{}
+	require.NoError(t, proto.Unmarshal(append(data1, data2...), expected))
+
+	got := &Extendable{}
+	require.NoError(t, got.UnmarshalVT(append(data1, data2...)))
+	require.True(t, proto.Equal(expected, got), "expected %v, got %v", expected, got)
+	require.Equal(t, int32(1), got.GetId())
+}
+
+func TestExtensionsUnknown(t *testing.T) {
+	const unregistered = 199
+
+	data := protowire.AppendTag(nil, 1, protowire.VarintType)
+	data = protowire.AppendVarint(data, 3)
+	data = protowire.AppendTag(data, unregistered, protowire.BytesType)
+	data = protowire.AppendString(data, "unknown")
+
+	m := &Extendable{}
+	require.NoError(t, m.UnmarshalVT(data))
+	require.Equal(t, int32(3), m.GetId())
+	require.NotEmpty(t, m.unknownFields)
+
+	got, err := m.MarshalVT()
+	require.NoError(t, err)
+	require.Equal(t, data, got)
+}
+
+func TestExtensionsEqual(t *testing.T) {
+	a, b := testExtendable(), testExtendable()
+	require.True(t, a.EqualVT(b))
+
+	proto.SetExtension(b, E_ExtInt32, int32(42))
+	require.False(t, a.EqualVT(b))
+
+	b = testExtendable()
+	proto.GetExtension(b, E_ExtPayloads).([]*Payload)[1].Values[0] = 5
+	require.False(t, a.EqualVT(b))
+
+	b = testExtendable()
+	proto.GetExtension(b, E_Extgroup).(*ExtGroup).A = proto.Int32(2)
+	require.False(t, a.EqualVT(b))
+
+	b = testExtendable()
+	proto.ClearExtension(b, E_ExtBool)
+	require.False(t, a.EqualVT(b))
+	require.False(t, b.EqualVT(a))
+}
+
+func TestExtensionsEqualWithOptions(t *testing.T) {
+	a, b := testExtendable(), testExtendable()
+	proto.SetExtension(a, E_ExtDouble, math.NaN())
+	proto.SetExtension(b, E_ExtDouble, math.NaN())
+	proto.SetExtension(b, E_Scope_NestedFloat, float32(1.5001))
+	require.False(t, a.EqualVT(b))
+	require.False(t, a.EqualVTWithOptions(b, vtproto.EqualOptions{NaNEqual: true}))
+	require.True(t, a.EqualVTWithOptions(b, vtproto.EqualOptions{NaNEqual: true, FloatTolerance: 0.001}))
+}
+
+func TestExtensionsClone(t *testing.T) {
+	m := testExtendable()
+	clone := m.CloneVT()
+	require.True(t, proto.Equal(m, clone))
+	require.True(t, m.EqualVT(clone))
+
+	proto.GetExtension(clone, E_ExtPayload).(*Payload).Name = proto.String("changed")
+	proto.GetExtension(clone, E_ExtBytes).([]byte)[0] = 42
+	proto.GetExtension(clone, E_ExtPayloads).([]*Payload)[0].Name = proto.String("changed")
+	proto.GetExtension(clone, E_Extgroup).(*ExtGroup).B[0] = "changed"
+	require.True(t, proto.Equal(testExtendable(), m), "mutating the clone modified the original message")
+}
+
+func TestExtensionsCopyTo(t *testing.T) {
+	m := testExtendable()
+	proto.ClearExtension(m, E_ExtBool)
+	expected := proto.Clone(m)
+
+	dst := &Extendable{Id: proto.Int32(1)}
+	proto.SetExtension(dst, E_ExtString, "replaced")
+	proto.SetExtension(dst, E_ExtBool, true)
+
+	m.CopyToVT(dst)
+	require.True(t, proto.Equal(expected, dst), "expected %v, got %v", expected, dst)
+
+	proto.GetExtension(dst, E_ExtPayload).(*Payload).Name = proto.String("changed")
+	proto.GetExtension(dst, E_Extgroup).(*ExtGroup).B[0] = "changed"
+	require.True(t, proto.Equal(expected, m), "mutating the copy modified the original message")
+}
+
+func TestExtensionsHash(t *testing.T) {
+	hash := func(m *Extendable) uint64 {
+		h := fnv.New64a()
+		m.HashVT(h)
+		return h.Sum64()
+	}
+
+	m := testExtendable()
+	for i := 0; i < 10; i++ {
+		// the extensions are ranged over in a different order every time
+		require.Equal(t, hash(m), hash(testExtendable()))
+	}
+
+	proto.SetExtension(m, E_ExtPayloads, []*Payload{{Name: proto.String("first")}, {Values: []int32{5}}})
+	require.NotEqual(t, hash(m), hash(testExtendable()))
+
+	m = testExtendable()
+	proto.SetExtension(m, E_Extgroup, &ExtGroup{A: proto.Int32(2), B: []string{"group"}})
+	require.NotEqual(t, hash(m), hash(testExtendable()))
+}
+
+func TestExtensionsDiff(t *testing.T) {
+	old, new := testExtendable(), testExtendable()
+	require.Empty(t, old.DiffVT(new))
+
+	proto.SetExtension(new, E_ExtInt32, int32(1))
+	proto.ClearExtension(new, E_ExtString)
+	proto.ClearExtension(old, E_ExtBool)
+	require.Equal(t, []vtproto.FieldDiff{
+		{Path: "[ext_int32]", Kind: vtproto.FieldChanged, Old: int32(-42), New: int32(1)},
+		{Path: "[ext_string]", Kind: vtproto.FieldRemoved, Old: "hello"},
+		{Path: "[ext_bool]", Kind: vtproto.FieldAdded, New: true},
+	}, old.DiffVT(new))
+}
+
+func TestExtensionsMerge(t *testing.T) {
+	dst := testExtendable()
+	src := testExtendable()
+	proto.SetExtension(src, E_ExtInt32, int32(1))
+	proto.SetExtension(src, E_ExtPayload, &Payload{Values: []int32{4}})
+	proto.SetExtension(src, E_Extgroup, &ExtGroup{B: []string{"merged"}})
+
+	expected := proto.Clone(dst)
+	proto.Merge(expected, src)
+
+	dst.MergeVT(src)
+	require.True(t, proto.Equal(expected, dst), "expected %v, got %v", expected, dst)
+
+	proto.GetExtension(src, E_ExtBytes).([]byte)[0] = 42
+	proto.GetExtension(src, E_ExtPayloads).([]*Payload)[0].Name = proto.String("changed")
+	proto.GetExtension(src, E_Extgroup).(*ExtGroup).B[0] = "changed"
+	require.True(t, proto.Equal(expected, dst), "mutating the source modified the merged message")
+}
@@ -0,0 +1,4111 @@
+// Code generated by protoc-gen-go-vtproto. DO NOT EDIT.
+// protoc-gen-go-vtproto version: (devel)
+// source: extension/extension.proto
+
+package extension
+
+import (
+	binary "encoding/binary"
+	errors "errors"
+	fmt "fmt"
+	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
+	vtproto "github.com/planetscale/vtprotobuf/vtproto"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	proto "google.golang.org/protobuf/proto"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	hash "hash"
+	fnv "hash/fnv"
+	io "io"
+	math "math"
+	bits "math/bits"
+	sort "sort"
+	strconv "strconv"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+func (m *Extendable) CloneVT() *Extendable {
+	if m == nil {
+		return (*Extendable)(nil)
+	}
+	r := &Extendable{}
+	if rhs := m.Id; rhs != nil {
+		tmpVal := *rhs
+		r.Id = &tmpVal
+	}
+	if rhs := m.Tags; rhs != nil {
+		tmpContainer := make([]string, len(rhs))
+		copy(tmpContainer, rhs)
+		r.Tags = tmpContainer
+	}
+	for num, x := range m.extensionFields {
+		switch num {
+		case 100:
+			rhs := proto.GetExtension(m, E_ExtInt32).(int32)
+			proto.SetExtension(r, E_ExtInt32, rhs)
+		case 101:
+			rhs := proto.GetExtension(m, E_ExtSint64).(int64)
+			proto.SetExtension(r, E_ExtSint64, rhs)
+		case 102:
+			rhs := proto.GetExtension(m, E_ExtString).(string)
+			proto.SetExtension(r, E_ExtString, rhs)
+		case 103:
+			rhs := proto.GetExtension(m, E_ExtBytes).([]byte)
+			var tmpVal []byte
+			tmpBytes := make([]byte, len(rhs))
+			copy(tmpBytes, rhs)
+			tmpVal = tmpBytes
+			proto.SetExtension(r, E_ExtBytes, tmpVal)
+		case 104:
+			rhs := proto.GetExtension(m, E_ExtPayload).(*Payload)
+			var tmpVal *Payload
+			tmpVal = rhs.CloneVT()
+			proto.SetExtension(r, E_ExtPayload, tmpVal)
+		case 105:
+			rhs := proto.GetExtension(m, E_ExtPacked).([]int32)
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			proto.SetExtension(r, E_ExtPacked, tmpContainer)
+		case 106:
+			rhs := proto.GetExtension(m, E_ExtStrings).([]string)
+			tmpContainer := make([]string, len(rhs))
+			copy(tmpContainer, rhs)
+			proto.SetExtension(r, E_ExtStrings, tmpContainer)
+		case 107:
+			rhs := proto.GetExtension(m, E_ExtPayloads).([]*Payload)
+			tmpContainer := make([]*Payload, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = v.CloneVT()
+			}
+			proto.SetExtension(r, E_ExtPayloads, tmpContainer)
+		case 108:
+			rhs := proto.GetExtension(m, E_ExtColor).(Color)
+			proto.SetExtension(r, E_ExtColor, rhs)
+		case 109:
+			rhs := proto.GetExtension(m, E_ExtDouble).(float64)
+			proto.SetExtension(r, E_ExtDouble, rhs)
+		case 110:
+			rhs := proto.GetExtension(m, E_ExtFixed32).(uint32)
+			proto.SetExtension(r, E_ExtFixed32, rhs)
+		case 111:
+			rhs := proto.GetExtension(m, E_ExtBool).(bool)
+			proto.SetExtension(r, E_ExtBool, rhs)
+		case 120:
+			rhs := proto.GetExtension(m, E_Scope_NestedFloat).(float32)
+			proto.SetExtension(r, E_Scope_NestedFloat, rhs)
+		default:
+			r.ProtoReflect().Set(x.Type().TypeDescriptor(), protohelpers.CloneExtension(x.Type(), x.Value()))
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *Extendable) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *Extendable) CopyToVT(dst *Extendable) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.Id == nil {
+		dst.Id = nil
+	} else {
+		if dst.Id == nil {
+			dst.Id = new(int32)
+		}
+		*dst.Id = *m.Id
+	}
+	dst.Tags = append(dst.Tags[:0], m.Tags...)
+	for num := range dst.extensionFields {
+		delete(dst.extensionFields, num)
+	}
+	for num, x := range m.extensionFields {
+		switch num {
+		case 100:
+			rhs := proto.GetExtension(m, E_ExtInt32).(int32)
+			proto.SetExtension(dst, E_ExtInt32, rhs)
+		case 101:
+			rhs := proto.GetExtension(m, E_ExtSint64).(int64)
+			proto.SetExtension(dst, E_ExtSint64, rhs)
+		case 102:
+			rhs := proto.GetExtension(m, E_ExtString).(string)
+			proto.SetExtension(dst, E_ExtString, rhs)
+		case 103:
+			rhs := proto.GetExtension(m, E_ExtBytes).([]byte)
+			var tmpVal []byte
+			tmpBytes := make([]byte, len(rhs))
+			copy(tmpBytes, rhs)
+			tmpVal = tmpBytes
+			proto.SetExtension(dst, E_ExtBytes, tmpVal)
+		case 104:
+			rhs := proto.GetExtension(m, E_ExtPayload).(*Payload)
+			var tmpVal *Payload
+			tmpVal = rhs.CloneVT()
+			proto.SetExtension(dst, E_ExtPayload, tmpVal)
+		case 105:
+			rhs := proto.GetExtension(m, E_ExtPacked).([]int32)
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			proto.SetExtension(dst, E_ExtPacked, tmpContainer)
+		case 106:
+			rhs := proto.GetExtension(m, E_ExtStrings).([]string)
+			tmpContainer := make([]string, len(rhs))
+			copy(tmpContainer, rhs)
+			proto.SetExtension(dst, E_ExtStrings, tmpContainer)
+		case 107:
+			rhs := proto.GetExtension(m, E_ExtPayloads).([]*Payload)
+			tmpContainer := make([]*Payload, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = v.CloneVT()
+			}
+			proto.SetExtension(dst, E_ExtPayloads, tmpContainer)
+		case 108:
+			rhs := proto.GetExtension(m, E_ExtColor).(Color)
+			proto.SetExtension(dst, E_ExtColor, rhs)
+		case 109:
+			rhs := proto.GetExtension(m, E_ExtDouble).(float64)
+			proto.SetExtension(dst, E_ExtDouble, rhs)
+		case 110:
+			rhs := proto.GetExtension(m, E_ExtFixed32).(uint32)
+			proto.SetExtension(dst, E_ExtFixed32, rhs)
+		case 111:
+			rhs := proto.GetExtension(m, E_ExtBool).(bool)
+			proto.SetExtension(dst, E_ExtBool, rhs)
+		case 120:
+			rhs := proto.GetExtension(m, E_Scope_NestedFloat).(float32)
+			proto.SetExtension(dst, E_Scope_NestedFloat, rhs)
+		default:
+			dst.ProtoReflect().Set(x.Type().TypeDescriptor(), protohelpers.CloneExtension(x.Type(), x.Value()))
+		}
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *Payload) CloneVT() *Payload {
+	if m == nil {
+		return (*Payload)(nil)
+	}
+	r := &Payload{}
+	if rhs := m.Name; rhs != nil {
+		tmpVal := *rhs
+		r.Name = &tmpVal
+	}
+	if rhs := m.Values; rhs != nil {
+		tmpContainer := make([]int32, len(rhs))
+		copy(tmpContainer, rhs)
+		r.Values = tmpContainer
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *Payload) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *Payload) CopyToVT(dst *Payload) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.Name == nil {
+		dst.Name = nil
+	} else {
+		if dst.Name == nil {
+			dst.Name = new(string)
+		}
+		*dst.Name = *m.Name
+	}
+	dst.Values = append(dst.Values[:0], m.Values...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *ExtGroup) CloneVT() *ExtGroup {
+	if m == nil {
+		return (*ExtGroup)(nil)
+	}
+	r := &ExtGroup{}
+	if rhs := m.A; rhs != nil {
+		tmpVal := *rhs
+		r.A = &tmpVal
+	}
+	if rhs := m.B; rhs != nil {
+		tmpContainer := make([]string, len(rhs))
+		copy(tmpContainer, rhs)
+		r.B = tmpContainer
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *ExtGroup) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *ExtGroup) CopyToVT(dst *ExtGroup) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.A == nil {
+		dst.A = nil
+	} else {
+		if dst.A == nil {
+			dst.A = new(int32)
+		}
+		*dst.A = *m.A
+	}
+	dst.B = append(dst.B[:0], m.B...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *Scope) CloneVT() *Scope {
+	if m == nil {
+		return (*Scope)(nil)
+	}
+	r := &Scope{}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *Scope) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *Scope) CopyToVT(dst *Scope) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (this *Extendable) DiffVT(that *Extendable) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.Id, that.Id; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "id", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "id", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "id", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	for i := 0; i < len(this.Tags) && i < len(that.Tags); i++ {
+		if this.Tags[i] != that.Tags[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "tags[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.Tags[i], New: that.Tags[i]})
+		}
+	}
+	for i := len(that.Tags); i < len(this.Tags); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "tags[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.Tags[i]})
+	}
+	for i := len(this.Tags); i < len(that.Tags); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "tags[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.Tags[i]})
+	}
+	diffs = protohelpers.DiffExtensions(diffs, this.extensionFields, that.extensionFields)
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *Payload) DiffVT(that *Payload) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.Name, that.Name; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "name", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "name", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "name", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	for i := 0; i < len(this.Values) && i < len(that.Values); i++ {
+		if this.Values[i] != that.Values[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "values[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.Values[i], New: that.Values[i]})
+		}
+	}
+	for i := len(that.Values); i < len(this.Values); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "values[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.Values[i]})
+	}
+	for i := len(this.Values); i < len(that.Values); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "values[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.Values[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *ExtGroup) DiffVT(that *ExtGroup) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.A, that.A; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "a", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "a", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "a", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	for i := 0; i < len(this.B) && i < len(that.B); i++ {
+		if this.B[i] != that.B[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "b[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.B[i], New: that.B[i]})
+		}
+	}
+	for i := len(that.B); i < len(this.B); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "b[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.B[i]})
+	}
+	for i := len(this.B); i < len(that.B); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "b[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.B[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *Scope) DiffVT(that *Scope) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *Extendable) EqualVT(that *Extendable) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Extendable) EqualVTWithOptions(that *Extendable, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	if p, q := this.Id, that.Id; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if len(this.Tags) != len(that.Tags) {
+		return false
+	}
+	for i, vx := range this.Tags {
+		vy := that.Tags[i]
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.extensionFields) != len(that.extensionFields) {
+		return false
+	}
+	for num, x := range this.extensionFields {
+		y, ok := that.extensionFields[num]
+		if !ok {
+			return false
+		}
+		switch num {
+		case 100:
+			ex, ey := proto.GetExtension(this, E_ExtInt32).(int32), proto.GetExtension(that, E_ExtInt32).(int32)
+			if ex != ey {
+				return false
+			}
+		case 101:
+			ex, ey := proto.GetExtension(this, E_ExtSint64).(int64), proto.GetExtension(that, E_ExtSint64).(int64)
+			if ex != ey {
+				return false
+			}
+		case 102:
+			ex, ey := proto.GetExtension(this, E_ExtString).(string), proto.GetExtension(that, E_ExtString).(string)
+			if ex != ey {
+				return false
+			}
+		case 103:
+			ex, ey := proto.GetExtension(this, E_ExtBytes).([]byte), proto.GetExtension(that, E_ExtBytes).([]byte)
+			if string(ex) != string(ey) {
+				return false
+			}
+		case 104:
+			ex, ey := proto.GetExtension(this, E_ExtPayload).(*Payload), proto.GetExtension(that, E_ExtPayload).(*Payload)
+			if p, q := ex, ey; p != q {
+				if p == nil {
+					p = &Payload{}
+				}
+				if q == nil {
+					q = &Payload{}
+				}
+				if !p.EqualVTWithOptions(q, opts) {
+					return false
+				}
+			}
+		case 105:
+			ex, ey := proto.GetExtension(this, E_ExtPacked).([]int32), proto.GetExtension(that, E_ExtPacked).([]int32)
+			if len(ex) != len(ey) {
+				return false
+			}
+			for i, vx := range ex {
+				vy := ey[i]
+				if vx != vy {
+					return false
+				}
+			}
+		case 106:
+			ex, ey := proto.GetExtension(this, E_ExtStrings).([]string), proto.GetExtension(that, E_ExtStrings).([]string)
+			if len(ex) != len(ey) {
+				return false
+			}
+			for i, vx := range ex {
+				vy := ey[i]
+				if vx != vy {
+					return false
+				}
+			}
+		case 107:
+			ex, ey := proto.GetExtension(this, E_ExtPayloads).([]*Payload), proto.GetExtension(that, E_ExtPayloads).([]*Payload)
+			if len(ex) != len(ey) {
+				return false
+			}
+			for i, vx := range ex {
+				vy := ey[i]
+				if p, q := vx, vy; p != q {
+					if p == nil {
+						p = &Payload{}
+					}
+					if q == nil {
+						q = &Payload{}
+					}
+					if !p.EqualVTWithOptions(q, opts) {
+						return false
+					}
+				}
+			}
+		case 108:
+			ex, ey := proto.GetExtension(this, E_ExtColor).(Color), proto.GetExtension(that, E_ExtColor).(Color)
+			if ex != ey {
+				return false
+			}
+		case 109:
+			ex, ey := proto.GetExtension(this, E_ExtDouble).(float64), proto.GetExtension(that, E_ExtDouble).(float64)
+			if !opts.EqualFloat64(ex, ey) {
+				return false
+			}
+		case 110:
+			ex, ey := proto.GetExtension(this, E_ExtFixed32).(uint32), proto.GetExtension(that, E_ExtFixed32).(uint32)
+			if ex != ey {
+				return false
+			}
+		case 111:
+			ex, ey := proto.GetExtension(this, E_ExtBool).(bool), proto.GetExtension(that, E_ExtBool).(bool)
+			if ex != ey {
+				return false
+			}
+		case 120:
+			ex, ey := proto.GetExtension(this, E_Scope_NestedFloat).(float32), proto.GetExtension(that, E_Scope_NestedFloat).(float32)
+			if !opts.EqualFloat32(ex, ey) {
+				return false
+			}
+		default:
+			if x.Type() != y.Type() || !protohelpers.EqualExtensionWithOptions(x.Type(), x.Value(), y.Value(), opts) {
+				return false
+			}
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Extendable) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Extendable)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *Payload) EqualVT(that *Payload) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Payload) EqualVTWithOptions(that *Payload, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	if p, q := this.Name, that.Name; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if len(this.Values) != len(that.Values) {
+		return false
+	}
+	for i, vx := range this.Values {
+		vy := that.Values[i]
+		if vx != vy {
+			return false
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Payload) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Payload)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *ExtGroup) EqualVT(that *ExtGroup) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *ExtGroup) EqualVTWithOptions(that *ExtGroup, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	if p, q := this.A, that.A; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if len(this.B) != len(that.B) {
+		return false
+	}
+	for i, vx := range this.B {
+		vy := that.B[i]
+		if vx != vy {
+			return false
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *ExtGroup) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*ExtGroup)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *Scope) EqualVT(that *Scope) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Scope) EqualVTWithOptions(that *Scope, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Scope) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Scope)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (m *Extendable) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *Extendable) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	var entry hash.Hash64
+	if m.Id != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(*m.Id))
+	}
+	if len(m.Tags) > 0 {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashUint64(h, s, uint64(len(m.Tags)))
+		for _, v := range m.Tags {
+			protohelpers.HashString(h, s, v)
+		}
+	}
+	if len(m.extensionFields) > 0 {
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for num, x := range m.extensionFields {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(num))
+			switch num {
+			case 100:
+				v := proto.GetExtension(m, E_ExtInt32).(int32)
+				protohelpers.HashUint64(entry, s, uint64(v))
+			case 101:
+				v := proto.GetExtension(m, E_ExtSint64).(int64)
+				protohelpers.HashUint64(entry, s, uint64(v))
+			case 102:
+				v := proto.GetExtension(m, E_ExtString).(string)
+				protohelpers.HashString(entry, s, v)
+			case 103:
+				v := proto.GetExtension(m, E_ExtBytes).([]byte)
+				protohelpers.HashBytes(entry, s, v)
+			case 104:
+				v := proto.GetExtension(m, E_ExtPayload).(*Payload)
+				v.hashVT(entry, s)
+				protohelpers.HashUint64(entry, s, 0)
+			case 105:
+				v := proto.GetExtension(m, E_ExtPacked).([]int32)
+				protohelpers.HashUint64(entry, s, uint64(len(v)))
+				for _, v := range v {
+					protohelpers.HashUint64(entry, s, uint64(v))
+				}
+			case 106:
+				v := proto.GetExtension(m, E_ExtStrings).([]string)
+				protohelpers.HashUint64(entry, s, uint64(len(v)))
+				for _, v := range v {
+					protohelpers.HashString(entry, s, v)
+				}
+			case 107:
+				v := proto.GetExtension(m, E_ExtPayloads).([]*Payload)
+				protohelpers.HashUint64(entry, s, uint64(len(v)))
+				for _, v := range v {
+					v.hashVT(entry, s)
+					protohelpers.HashUint64(entry, s, 0)
+				}
+			case 108:
+				v := proto.GetExtension(m, E_ExtColor).(Color)
+				protohelpers.HashUint64(entry, s, uint64(v))
+			case 109:
+				v := proto.GetExtension(m, E_ExtDouble).(float64)
+				protohelpers.HashFloat64(entry, s, v)
+			case 110:
+				v := proto.GetExtension(m, E_ExtFixed32).(uint32)
+				protohelpers.HashUint64(entry, s, uint64(v))
+			case 111:
+				v := proto.GetExtension(m, E_ExtBool).(bool)
+				protohelpers.HashBool(entry, s, v)
+			case 120:
+				v := proto.GetExtension(m, E_Scope_NestedFloat).(float32)
+				protohelpers.HashFloat32(entry, s, v)
+			default:
+				protohelpers.HashExtension(entry, x.Type(), x.Value())
+			}
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *Payload) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *Payload) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	if m.Name != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashString(h, s, *m.Name)
+	}
+	if len(m.Values) > 0 {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashUint64(h, s, uint64(len(m.Values)))
+		for _, v := range m.Values {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *ExtGroup) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *ExtGroup) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	if m.A != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(*m.A))
+	}
+	if len(m.B) > 0 {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashUint64(h, s, uint64(len(m.B)))
+		for _, v := range m.B {
+			protohelpers.HashString(h, s, v)
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *Scope) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *Scope) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *Extendable) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *Extendable) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &Extendable{}
+	}
+	w.ObjectStart()
+	if m.Id != nil {
+		w.Field("id", "id")
+		w.Int32(*m.Id)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("id", "id")
+		w.Null()
+	}
+	if len(m.Tags) > 0 {
+		w.Field("tags", "tags")
+		w.ArrayStart()
+		for _, v := range m.Tags {
+			if err := w.String(v); err != nil {
+				return errors.New("proto: field Extendable.tags contains invalid UTF-8")
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("tags", "tags")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if err := protohelpers.MarshalJSONExtensions(w, m.extensionFields); err != nil {
+		return err
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *Extendable) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *Extendable) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [2]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "id":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Id = &v
+		case "tags":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadString()
+				if err != nil {
+					return err
+				}
+				m.Tags = append(m.Tags, v)
+			}
+		default:
+			if err := protohelpers.UnmarshalJSONExtension(r, m, name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *Payload) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *Payload) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &Payload{}
+	}
+	w.ObjectStart()
+	if m.Name != nil {
+		w.Field("name", "name")
+		if err := w.String(*m.Name); err != nil {
+			return errors.New("proto: field Payload.name contains invalid UTF-8")
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("name", "name")
+		w.Null()
+	}
+	if len(m.Values) > 0 {
+		w.Field("values", "values")
+		w.ArrayStart()
+		for _, v := range m.Values {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("values", "values")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *Payload) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *Payload) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [2]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "name":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.Name = &v
+		case "values":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.Values = append(m.Values, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *ExtGroup) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *ExtGroup) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &ExtGroup{}
+	}
+	w.ObjectStart()
+	if m.A != nil {
+		w.Field("a", "a")
+		w.Int32(*m.A)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("a", "a")
+		w.Null()
+	}
+	if len(m.B) > 0 {
+		w.Field("b", "b")
+		w.ArrayStart()
+		for _, v := range m.B {
+			if err := w.String(v); err != nil {
+				return errors.New("proto: field ExtGroup.b contains invalid UTF-8")
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("b", "b")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *ExtGroup) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *ExtGroup) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [2]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "a":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.A = &v
+		case "b":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadString()
+				if err != nil {
+					return err
+				}
+				m.B = append(m.B, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *Scope) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *Scope) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &Scope{}
+	}
+	w.ObjectStart()
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *Scope) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *Scope) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *Extendable) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Extendable) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Extendable) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Extendable) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Extendable) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.Id))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	if len(m.extensionFields) > 0 {
+		extensionNums := make([]int32, 0, len(m.extensionFields))
+		for num := range m.extensionFields {
+			extensionNums = append(extensionNums, num)
+		}
+		sort.Slice(extensionNums, func(i, j int) bool {
+			return extensionNums[i] > extensionNums[j]
+		})
+		for _, num := range extensionNums {
+			switch num {
+			case 100:
+				v := proto.GetExtension(m, E_ExtInt32).(int32)
+				i = encodeVarint(dAtA, i, uint64(v))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				i -= 2
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				dAtA[i] = 0xa0
+				dAtA[i+1] = 0x6
+			case 101:
+				v := proto.GetExtension(m, E_ExtSint64).(int64)
+				i = encodeVarint(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				i -= 2
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				dAtA[i] = 0xa8
+				dAtA[i+1] = 0x6
+			case 102:
+				v := proto.GetExtension(m, E_ExtString).(string)
+				i -= len(v)
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				copy(dAtA[i:], v)
+				i = encodeVarint(dAtA, i, uint64(len(v)))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				i -= 2
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				dAtA[i] = 0xb2
+				dAtA[i+1] = 0x6
+			case 103:
+				v := proto.GetExtension(m, E_ExtBytes).([]byte)
+				i -= len(v)
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				copy(dAtA[i:], v)
+				i = encodeVarint(dAtA, i, uint64(len(v)))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				i -= 2
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				dAtA[i] = 0xba
+				dAtA[i+1] = 0x6
+			case 104:
+				v := proto.GetExtension(m, E_ExtPayload).(*Payload)
+				if v != nil {
+					size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarint(dAtA, i, uint64(size))
+					if i < 0 {
+						return 0, ErrBufferTooSmall
+					}
+					i -= 2
+					if i < 0 {
+						return 0, ErrBufferTooSmall
+					}
+					dAtA[i] = 0xc2
+					dAtA[i+1] = 0x6
+				}
+			case 105:
+				v := proto.GetExtension(m, E_ExtPacked).([]int32)
+				if len(v) > 0 {
+					var pksize2 int
+					for _, num := range v {
+						pksize2 += sov(uint64(num))
+					}
+					i -= pksize2
+					if i < 0 {
+						return 0, ErrBufferTooSmall
+					}
+					j1 := i
+					for _, num1 := range v {
+						num := uint64(num1)
+						for num >= 1<<7 {
+							dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+							num >>= 7
+							j1++
+						}
+						dAtA[j1] = uint8(num)
+						j1++
+					}
+					i = encodeVarint(dAtA, i, uint64(pksize2))
+					if i < 0 {
+						return 0, ErrBufferTooSmall
+					}
+					i -= 2
+					if i < 0 {
+						return 0, ErrBufferTooSmall
+					}
+					dAtA[i] = 0xca
+					dAtA[i+1] = 0x6
+				}
+			case 106:
+				v := proto.GetExtension(m, E_ExtStrings).([]string)
+				if len(v) > 0 {
+					for iNdEx := len(v) - 1; iNdEx >= 0; iNdEx-- {
+						i -= len(v[iNdEx])
+						if i < 0 {
+							return 0, ErrBufferTooSmall
+						}
+						copy(dAtA[i:], v[iNdEx])
+						i = encodeVarint(dAtA, i, uint64(len(v[iNdEx])))
+						if i < 0 {
+							return 0, ErrBufferTooSmall
+						}
+						i -= 2
+						if i < 0 {
+							return 0, ErrBufferTooSmall
+						}
+						dAtA[i] = 0xd2
+						dAtA[i+1] = 0x6
+					}
+				}
+			case 107:
+				v := proto.GetExtension(m, E_ExtPayloads).([]*Payload)
+				if len(v) > 0 {
+					for iNdEx := len(v) - 1; iNdEx >= 0; iNdEx-- {
+						size, err := v[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+						if err != nil {
+							return 0, err
+						}
+						i -= size
+						i = encodeVarint(dAtA, i, uint64(size))
+						if i < 0 {
+							return 0, ErrBufferTooSmall
+						}
+						i -= 2
+						if i < 0 {
+							return 0, ErrBufferTooSmall
+						}
+						dAtA[i] = 0xda
+						dAtA[i+1] = 0x6
+					}
+				}
+			case 108:
+				v := proto.GetExtension(m, E_ExtColor).(Color)
+				i = encodeVarint(dAtA, i, uint64(v))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				i -= 2
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				dAtA[i] = 0xe0
+				dAtA[i+1] = 0x6
+			case 109:
+				v := proto.GetExtension(m, E_ExtDouble).(float64)
+				i -= 8
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+				i -= 2
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				dAtA[i] = 0xe9
+				dAtA[i+1] = 0x6
+			case 110:
+				v := proto.GetExtension(m, E_ExtFixed32).(uint32)
+				i -= 4
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+				i -= 2
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				dAtA[i] = 0xf5
+				dAtA[i+1] = 0x6
+			case 111:
+				v := proto.GetExtension(m, E_ExtBool).(bool)
+				i--
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				if v {
+					dAtA[i] = 1
+				} else {
+					dAtA[i] = 0
+				}
+				i -= 2
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				dAtA[i] = 0xf8
+				dAtA[i+1] = 0x6
+			case 120:
+				v := proto.GetExtension(m, E_Scope_NestedFloat).(float32)
+				i -= 4
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
+				i -= 2
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				dAtA[i] = 0xc5
+				dAtA[i+1] = 0x7
+			default:
+				x := m.extensionFields[num]
+				i -= protohelpers.SizeOfExtension(x.Type(), x.Value())
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				if _, err := protohelpers.AppendExtension(dAtA[:i], x.Type(), x.Value()); err != nil {
+					return 0, err
+				}
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Payload) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Payload) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Payload) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Payload) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Payload) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.Values[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+		}
+	}
+	if m.Name != nil {
+		i -= len(*m.Name)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarint(dAtA, i, uint64(len(*m.Name)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtGroup) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ExtGroup) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *ExtGroup) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ExtGroup) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *ExtGroup) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.B) > 0 {
+		for iNdEx := len(m.B) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.B[iNdEx])
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], m.B[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.B[iNdEx])))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+		}
+	}
+	if m.A != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.A))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Scope) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Scope) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Scope) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Scope) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Scope) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	return len(dAtA) - i, nil
+}
+
+var (
+	ErrBufferTooSmall = fmt.Errorf("proto: buffer too small to marshal the message")
+	ErrSizeMismatch   = fmt.Errorf("proto: message size changed while marshaling")
+)
+
+func encodeVarint(dAtA []byte, offset int, v uint64) int {
+	offset -= sov(v)
+	if offset < 0 {
+		return offset
+	}
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Extendable) MergeVT(src *Extendable) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Id != nil {
+		tmpVal := *src.Id
+		m.Id = &tmpVal
+	}
+	m.Tags = append(m.Tags, src.Tags...)
+	for num, x := range src.extensionFields {
+		switch num {
+		case 100:
+			rhs := proto.GetExtension(src, E_ExtInt32).(int32)
+			proto.SetExtension(m, E_ExtInt32, rhs)
+		case 101:
+			rhs := proto.GetExtension(src, E_ExtSint64).(int64)
+			proto.SetExtension(m, E_ExtSint64, rhs)
+		case 102:
+			rhs := proto.GetExtension(src, E_ExtString).(string)
+			proto.SetExtension(m, E_ExtString, rhs)
+		case 103:
+			rhs := proto.GetExtension(src, E_ExtBytes).([]byte)
+			proto.SetExtension(m, E_ExtBytes, append([]byte{}, rhs...))
+		case 104:
+			rhs := proto.GetExtension(src, E_ExtPayload).(*Payload)
+			lhs := proto.GetExtension(m, E_ExtPayload).(*Payload)
+			if lhs == nil {
+				lhs = &Payload{}
+			}
+			lhs.MergeVT(rhs)
+			proto.SetExtension(m, E_ExtPayload, lhs)
+		case 105:
+			rhs := proto.GetExtension(src, E_ExtPacked).([]int32)
+			lhs := proto.GetExtension(m, E_ExtPacked).([]int32)
+			lhs = append(lhs, rhs...)
+			proto.SetExtension(m, E_ExtPacked, lhs)
+		case 106:
+			rhs := proto.GetExtension(src, E_ExtStrings).([]string)
+			lhs := proto.GetExtension(m, E_ExtStrings).([]string)
+			lhs = append(lhs, rhs...)
+			proto.SetExtension(m, E_ExtStrings, lhs)
+		case 107:
+			rhs := proto.GetExtension(src, E_ExtPayloads).([]*Payload)
+			lhs := proto.GetExtension(m, E_ExtPayloads).([]*Payload)
+			for _, v := range rhs {
+				tmpMsg := &Payload{}
+				tmpMsg.MergeVT(v)
+				lhs = append(lhs, tmpMsg)
+			}
+			proto.SetExtension(m, E_ExtPayloads, lhs)
+		case 108:
+			rhs := proto.GetExtension(src, E_ExtColor).(Color)
+			proto.SetExtension(m, E_ExtColor, rhs)
+		case 109:
+			rhs := proto.GetExtension(src, E_ExtDouble).(float64)
+			proto.SetExtension(m, E_ExtDouble, rhs)
+		case 110:
+			rhs := proto.GetExtension(src, E_ExtFixed32).(uint32)
+			proto.SetExtension(m, E_ExtFixed32, rhs)
+		case 111:
+			rhs := proto.GetExtension(src, E_ExtBool).(bool)
+			proto.SetExtension(m, E_ExtBool, rhs)
+		case 120:
+			rhs := proto.GetExtension(src, E_Scope_NestedFloat).(float32)
+			proto.SetExtension(m, E_Scope_NestedFloat, rhs)
+		default:
+			protohelpers.MergeExtension(m.ProtoReflect(), x.Type(), x.Value())
+		}
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Extendable) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Extendable); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *Payload) MergeVT(src *Payload) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Name != nil {
+		tmpVal := *src.Name
+		m.Name = &tmpVal
+	}
+	m.Values = append(m.Values, src.Values...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Payload) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Payload); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *ExtGroup) MergeVT(src *ExtGroup) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.A != nil {
+		tmpVal := *src.A
+		m.A = &tmpVal
+	}
+	m.B = append(m.B, src.B...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *ExtGroup) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*ExtGroup); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *Scope) MergeVT(src *Scope) {
+	if m == nil || src == nil {
+		return
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Scope) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Scope); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *Extendable) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		n += 1 + sov(uint64(*m.Id))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	for num, x := range m.extensionFields {
+		switch num {
+		case 100:
+			v := proto.GetExtension(m, E_ExtInt32).(int32)
+			n += 2 + sov(uint64(v))
+		case 101:
+			v := proto.GetExtension(m, E_ExtSint64).(int64)
+			n += 2 + soz(uint64(v))
+		case 102:
+			v := proto.GetExtension(m, E_ExtString).(string)
+			l = len(v)
+			n += 2 + l + sov(uint64(l))
+		case 103:
+			v := proto.GetExtension(m, E_ExtBytes).([]byte)
+			l = len(v)
+			n += 2 + l + sov(uint64(l))
+		case 104:
+			v := proto.GetExtension(m, E_ExtPayload).(*Payload)
+			if v != nil {
+				l = v.SizeVT()
+				n += 2 + l + sov(uint64(l))
+			}
+		case 105:
+			v := proto.GetExtension(m, E_ExtPacked).([]int32)
+			if len(v) > 0 {
+				l = 0
+				for _, e := range v {
+					l += sov(uint64(e))
+				}
+				n += 2 + sov(uint64(l)) + l
+			}
+		case 106:
+			v := proto.GetExtension(m, E_ExtStrings).([]string)
+			if len(v) > 0 {
+				for _, s := range v {
+					l = len(s)
+					n += 2 + l + sov(uint64(l))
+				}
+			}
+		case 107:
+			v := proto.GetExtension(m, E_ExtPayloads).([]*Payload)
+			if len(v) > 0 {
+				for _, e := range v {
+					l = e.SizeVT()
+					n += 2 + l + sov(uint64(l))
+				}
+			}
+		case 108:
+			v := proto.GetExtension(m, E_ExtColor).(Color)
+			n += 2 + sov(uint64(v))
+		case 109:
+			n += 10
+		case 110:
+			n += 6
+		case 111:
+			n += 3
+		case 120:
+			n += 6
+		default:
+			n += protohelpers.SizeOfExtension(x.Type(), x.Value())
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Payload) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			n += 1 + sov(uint64(e))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *ExtGroup) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.A != nil {
+		n += 1 + sov(uint64(*m.A))
+	}
+	if len(m.B) > 0 {
+		for _, s := range m.B {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Scope) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += len(m.unknownFields)
+	return n
+}
+
+func sov(x uint64) (n int) {
+	return (bits.Len64(x|1) + 6) / 7
+}
+func soz(x uint64) (n int) {
+	return sov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Extendable) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Extendable) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *Extendable) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *Extendable) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Extendable)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Extendable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Extendable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Id = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(string(dAtA[iNdEx:postIndex])))
+			iNdEx = postIndex
+		case 100:
+			var ext int32
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtInt32", wireType)
+			}
+			ext = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				ext |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			proto.SetExtension(m, E_ExtInt32, ext)
+		case 101:
+			var ext int64
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtSint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			ext = int64(v)
+			proto.SetExtension(m, E_ExtSint64, ext)
+		case 102:
+			var ext string
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			ext = string(string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+			proto.SetExtension(m, E_ExtString, ext)
+		case 103:
+			var ext []byte
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			ext = append(ext[:0], dAtA[iNdEx:postIndex]...)
+			if ext == nil {
+				ext = []byte{}
+			}
+			iNdEx = postIndex
+			proto.SetExtension(m, E_ExtBytes, ext)
+		case 104:
+			ext := proto.GetExtension(m, E_ExtPayload).(*Payload)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if ext == nil {
+				ext = &Payload{}
+			}
+			if err := ext.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			proto.SetExtension(m, E_ExtPayload, ext)
+		case 105:
+			ext := proto.GetExtension(m, E_ExtPacked).([]int32)
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				ext = append(ext, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(ext) == 0 {
+					ext = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					ext = append(ext, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtPacked", wireType)
+			}
+			proto.SetExtension(m, E_ExtPacked, ext)
+		case 106:
+			ext := proto.GetExtension(m, E_ExtStrings).([]string)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtStrings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			ext = append(ext, string(string(dAtA[iNdEx:postIndex])))
+			iNdEx = postIndex
+			proto.SetExtension(m, E_ExtStrings, ext)
+		case 107:
+			ext := proto.GetExtension(m, E_ExtPayloads).([]*Payload)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtPayloads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			ext = append(ext, &Payload{})
+			if err := ext[len(ext)-1].unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			proto.SetExtension(m, E_ExtPayloads, ext)
+		case 108:
+			var ext Color
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtColor", wireType)
+			}
+			ext = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				ext |= Color(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			proto.SetExtension(m, E_ExtColor, ext)
+		case 109:
+			var ext float64
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtDouble", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			ext = float64(math.Float64frombits(v))
+			proto.SetExtension(m, E_ExtDouble, ext)
+		case 110:
+			var ext uint32
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtFixed32", wireType)
+			}
+			ext = 0
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			ext = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			proto.SetExtension(m, E_ExtFixed32, ext)
+		case 111:
+			var ext bool
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtBool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			ext = bool(v != 0)
+			proto.SetExtension(m, E_ExtBool, ext)
+		case 120:
+			var ext float32
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedFloat", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			ext = float32(math.Float32frombits(v))
+			proto.SetExtension(m, E_Scope_NestedFloat, ext)
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if (fieldNum >= 100) && (fieldNum < 200) {
+				found, err := protohelpers.UnmarshalExtension(m.ProtoReflect(), dAtA[iNdEx:iNdEx+skippy], depth)
+				if err != nil {
+					return err
+				}
+				if !found && !opts.DiscardUnknown {
+					m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			} else {
+				if !opts.DiscardUnknown {
+					m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Payload) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Payload) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *Payload) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *Payload) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Payload)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Payload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Payload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(string(dAtA[iNdEx:postIndex]))
+			m.Name = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Values = append(m.Values, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Values) == 0 {
+					m.Values = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Values = append(m.Values, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtGroup) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *ExtGroup) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *ExtGroup) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *ExtGroup) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*ExtGroup)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.A = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.B = append(m.B, string(string(dAtA[iNdEx:postIndex])))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Scope) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Scope) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *Scope) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *Scope) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Scope)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Scope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Scope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skip(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLength
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroup
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLength
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLength          = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflow            = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroup   = fmt.Errorf("proto: unexpected end of group")
+	ErrInvalidUTF8            = fmt.Errorf("proto: string field contains invalid UTF-8")
+	ErrRecursionLimitExceeded = fmt.Errorf("proto: exceeded maximum recursion depth")
+)
+
+func (m *Extendable) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Extendable) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *Extendable) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Extendable)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Extendable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Extendable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Id = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.Tags = append(m.Tags, string(stringValue))
+			iNdEx = postIndex
+		case 100:
+			var ext int32
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtInt32", wireType)
+			}
+			ext = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				ext |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			proto.SetExtension(m, E_ExtInt32, ext)
+		case 101:
+			var ext int64
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtSint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			ext = int64(v)
+			proto.SetExtension(m, E_ExtSint64, ext)
+		case 102:
+			var ext string
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			ext = string(stringValue)
+			iNdEx = postIndex
+			proto.SetExtension(m, E_ExtString, ext)
+		case 103:
+			var ext []byte
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			ext = dAtA[iNdEx:postIndex:postIndex]
+			iNdEx = postIndex
+			proto.SetExtension(m, E_ExtBytes, ext)
+		case 104:
+			ext := proto.GetExtension(m, E_ExtPayload).(*Payload)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if ext == nil {
+				ext = &Payload{}
+			}
+			if err := ext.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			proto.SetExtension(m, E_ExtPayload, ext)
+		case 105:
+			ext := proto.GetExtension(m, E_ExtPacked).([]int32)
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				ext = append(ext, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(ext) == 0 {
+					ext = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					ext = append(ext, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtPacked", wireType)
+			}
+			proto.SetExtension(m, E_ExtPacked, ext)
+		case 106:
+			ext := proto.GetExtension(m, E_ExtStrings).([]string)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtStrings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			ext = append(ext, string(stringValue))
+			iNdEx = postIndex
+			proto.SetExtension(m, E_ExtStrings, ext)
+		case 107:
+			ext := proto.GetExtension(m, E_ExtPayloads).([]*Payload)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtPayloads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			ext = append(ext, &Payload{})
+			if err := ext[len(ext)-1].unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			proto.SetExtension(m, E_ExtPayloads, ext)
+		case 108:
+			var ext Color
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtColor", wireType)
+			}
+			ext = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				ext |= Color(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			proto.SetExtension(m, E_ExtColor, ext)
+		case 109:
+			var ext float64
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtDouble", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			ext = float64(math.Float64frombits(v))
+			proto.SetExtension(m, E_ExtDouble, ext)
+		case 110:
+			var ext uint32
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtFixed32", wireType)
+			}
+			ext = 0
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			ext = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			proto.SetExtension(m, E_ExtFixed32, ext)
+		case 111:
+			var ext bool
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtBool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			ext = bool(v != 0)
+			proto.SetExtension(m, E_ExtBool, ext)
+		case 120:
+			var ext float32
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NestedFloat", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			ext = float32(math.Float32frombits(v))
+			proto.SetExtension(m, E_Scope_NestedFloat, ext)
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if (fieldNum >= 100) && (fieldNum < 200) {
+				found, err := protohelpers.UnmarshalExtension(m.ProtoReflect(), dAtA[iNdEx:iNdEx+skippy], depth)
+				if err != nil {
+					return err
+				}
+				if !found && !opts.DiscardUnknown {
+					m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			} else {
+				if !opts.DiscardUnknown {
+					m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Payload) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Payload) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *Payload) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Payload)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Payload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Payload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			s := string(stringValue)
+			m.Name = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Values = append(m.Values, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Values) == 0 {
+					m.Values = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Values = append(m.Values, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtGroup) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *ExtGroup) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *ExtGroup) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*ExtGroup)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.A = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.B = append(m.B, string(stringValue))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Scope) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Scope) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *Scope) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Scope)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Scope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Scope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
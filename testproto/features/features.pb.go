@@ -0,0 +1,381 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.20.0
+// source: features/features.proto
+
+package features
+
+import (
+	_ "github.com/planetscale/vtprotobuf/vtproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Default struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	A           string       `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	Skipped     *Skipped     `protobuf:"bytes,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	MarshalOnly *MarshalOnly `protobuf:"bytes,3,opt,name=marshal_only,json=marshalOnly,proto3" json:"marshal_only,omitempty"`
+}
+
+func (x *Default) Reset() {
+	*x = Default{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_features_features_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Default) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Default) ProtoMessage() {}
+
+func (x *Default) ProtoReflect() protoreflect.Message {
+	mi := &file_features_features_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Default.ProtoReflect.Descriptor instead.
+func (*Default) Descriptor() ([]byte, []int) {
+	return file_features_features_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Default) GetA() string {
+	if x != nil {
+		return x.A
+	}
+	return ""
+}
+
+func (x *Default) GetSkipped() *Skipped {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+func (x *Default) GetMarshalOnly() *MarshalOnly {
+	if x != nil {
+		return x.MarshalOnly
+	}
+	return nil
+}
+
+type Skipped struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	A string `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+}
+
+func (x *Skipped) Reset() {
+	*x = Skipped{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_features_features_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Skipped) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Skipped) ProtoMessage() {}
+
+func (x *Skipped) ProtoReflect() protoreflect.Message {
+	mi := &file_features_features_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Skipped.ProtoReflect.Descriptor instead.
+func (*Skipped) Descriptor() ([]byte, []int) {
+	return file_features_features_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Skipped) GetA() string {
+	if x != nil {
+		return x.A
+	}
+	return ""
+}
+
+type MarshalOnly struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Skipped []*Skipped `protobuf:"bytes,1,rep,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (x *MarshalOnly) Reset() {
+	*x = MarshalOnly{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_features_features_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarshalOnly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarshalOnly) ProtoMessage() {}
+
+func (x *MarshalOnly) ProtoReflect() protoreflect.Message {
+	mi := &file_features_features_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarshalOnly.ProtoReflect.Descriptor instead.
+func (*MarshalOnly) Descriptor() ([]byte, []int) {
+	return file_features_features_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MarshalOnly) GetSkipped() []*Skipped {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+type Everything struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Default *Default            `protobuf:"bytes,1,opt,name=default,proto3" json:"default,omitempty"`
+	Skipped map[string]*Skipped `protobuf:"bytes,2,rep,name=skipped,proto3" json:"skipped,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Everything) Reset() {
+	*x = Everything{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_features_features_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Everything) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Everything) ProtoMessage() {}
+
+func (x *Everything) ProtoReflect() protoreflect.Message {
+	mi := &file_features_features_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Everything.ProtoReflect.Descriptor instead.
+func (*Everything) Descriptor() ([]byte, []int) {
+	return file_features_features_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Everything) GetDefault() *Default {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *Everything) GetSkipped() map[string]*Skipped {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+var File_features_features_proto protoreflect.FileDescriptor
+
+var file_features_features_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x2f, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x2f, 0x76, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6c,
+	0x0a, 0x07, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x61, 0x12, 0x22, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x53, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x0c, 0x6d,
+	0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x4f, 0x6e, 0x6c, 0x79, 0x52,
+	0x0b, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x1d, 0x0a, 0x07,
+	0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x01, 0x61, 0x3a, 0x04, 0xc0, 0xa6, 0x1f, 0x01, 0x22, 0x43, 0x0a, 0x0b, 0x4d,
+	0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x22, 0x0a, 0x07, 0x73, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x53, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x3a, 0x10,
+	0xba, 0xa6, 0x1f, 0x0c, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x2b, 0x73, 0x69, 0x7a, 0x65,
+	0x22, 0xb3, 0x01, 0x0a, 0x0a, 0x45, 0x76, 0x65, 0x72, 0x79, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12,
+	0x22, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x08, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x45, 0x76, 0x65, 0x72, 0x79, 0x74, 0x68, 0x69, 0x6e,
+	0x67, 0x2e, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x1a, 0x44, 0x0a, 0x0c, 0x53, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x53, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x07, 0xba,
+	0xa6, 0x1f, 0x03, 0x61, 0x6c, 0x6c, 0x42, 0x21, 0xf2, 0xe7, 0x1e, 0x09, 0x61, 0x6c, 0x6c, 0x2d,
+	0x65, 0x71, 0x75, 0x61, 0x6c, 0x5a, 0x12, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_features_features_proto_rawDescOnce sync.Once
+	file_features_features_proto_rawDescData = file_features_features_proto_rawDesc
+)
+
+func file_features_features_proto_rawDescGZIP() []byte {
+	file_features_features_proto_rawDescOnce.Do(func() {
+		file_features_features_proto_rawDescData = protoimpl.X.CompressGZIP(file_features_features_proto_rawDescData)
+	})
+	return file_features_features_proto_rawDescData
+}
+
+var file_features_features_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_features_features_proto_goTypes = []any{
+	(*Default)(nil),     // 0: Default
+	(*Skipped)(nil),     // 1: Skipped
+	(*MarshalOnly)(nil), // 2: MarshalOnly
+	(*Everything)(nil),  // 3: Everything
+	nil,                 // 4: Everything.SkippedEntry
+}
+var file_features_features_proto_depIdxs = []int32{
+	1, // 0: Default.skipped:type_name -> Skipped
+	2, // 1: Default.marshal_only:type_name -> MarshalOnly
+	1, // 2: MarshalOnly.skipped:type_name -> Skipped
+	0, // 3: Everything.default:type_name -> Default
+	4, // 4: Everything.skipped:type_name -> Everything.SkippedEntry
+	1, // 5: Everything.SkippedEntry.value:type_name -> Skipped
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_features_features_proto_init() }
+func file_features_features_proto_init() {
+	if File_features_features_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_features_features_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Default); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_features_features_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Skipped); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_features_features_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*MarshalOnly); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_features_features_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Everything); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_features_features_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_features_features_proto_goTypes,
+		DependencyIndexes: file_features_features_proto_depIdxs,
+		MessageInfos:      file_features_features_proto_msgTypes,
+	}.Build()
+	File_features_features_proto = out.File
+	file_features_features_proto_rawDesc = nil
+	file_features_features_proto_goTypes = nil
+	file_features_features_proto_depIdxs = nil
+}
@@ -0,0 +1,81 @@
+package features
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+type marshalVT interface {
+	MarshalVT() ([]byte, error)
+}
+
+type unmarshalVT interface {
+	UnmarshalVT([]byte) error
+}
+
+func TestFeatureSelection(t *testing.T) {
+	var skipped interface{} = &Skipped{}
+	_, ok := skipped.(marshalVT)
+	require.False(t, ok, "Skipped should not implement MarshalVT")
+	_, ok = skipped.(unmarshalVT)
+	require.False(t, ok, "Skipped should not implement UnmarshalVT")
+
+	var marshalOnly interface{} = &MarshalOnly{}
+	_, ok = marshalOnly.(marshalVT)
+	require.True(t, ok, "MarshalOnly should implement MarshalVT")
+	_, ok = marshalOnly.(unmarshalVT)
+	require.False(t, ok, "MarshalOnly should not implement UnmarshalVT")
+
+	var def interface{} = &Default{}
+	_, ok = def.(interface{ EqualVT(*Default) bool })
+	require.False(t, ok, "Default should not implement EqualVT")
+	_, ok = def.(interface{ CloneVT() *Default })
+	require.True(t, ok, "Default should implement CloneVT")
+
+	var everything interface{} = &Everything{}
+	_, ok = everything.(interface{ EqualVT(*Everything) bool })
+	require.True(t, ok, "Everything should implement EqualVT")
+}
+
+func TestFeatureSelectionFallback(t *testing.T) {
+	msg := &Everything{
+		Default: &Default{
+			A:       "a",
+			Skipped: &Skipped{A: "skipped"},
+			MarshalOnly: &MarshalOnly{
+				Skipped: []*Skipped{{A: "one"}, {A: "two"}},
+			},
+		},
+		Skipped: map[string]*Skipped{"key": {A: "value"}},
+	}
+
+	expected, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	require.Equal(t, len(expected), msg.SizeVT())
+
+	got, err := msg.MarshalVT()
+	require.NoError(t, err)
+	require.Equal(t, expected, got)
+
+	unmarshaled := &Everything{}
+	require.NoError(t, unmarshaled.UnmarshalVT(got))
+	require.True(t, proto.Equal(msg, unmarshaled))
+	require.True(t, msg.EqualVT(unmarshaled))
+
+	cloned := msg.CloneVT()
+	require.True(t, msg.EqualVT(cloned))
+	cloned.Default.Skipped.A = "changed"
+	require.False(t, msg.EqualVT(cloned))
+}
+
+func TestFeatureSelectionFallbackShortBuffer(t *testing.T) {
+	// Skipped has no MarshalVT, so it is encoded with proto.Marshal and copied
+	msg := &Default{Skipped: &Skipped{A: "skipped"}}
+
+	_, err := msg.MarshalToSizedBufferVT(make([]byte, 3))
+	require.ErrorIs(t, err, ErrBufferTooSmall)
+	_, err = msg.MarshalToSizedBufferVT(make([]byte, msg.SizeVT()-1))
+	require.ErrorIs(t, err, ErrBufferTooSmall)
+}
@@ -0,0 +1,526 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.20.0
+// source: merge/merge.proto
+
+package merge
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MergeEnum int32
+
+const (
+	MergeEnum_MERGE_ZERO MergeEnum = 0
+	MergeEnum_MERGE_ONE  MergeEnum = 1
+)
+
+// Enum value maps for MergeEnum.
+var (
+	MergeEnum_name = map[int32]string{
+		0: "MERGE_ZERO",
+		1: "MERGE_ONE",
+	}
+	MergeEnum_value = map[string]int32{
+		"MERGE_ZERO": 0,
+		"MERGE_ONE":  1,
+	}
+)
+
+func (x MergeEnum) Enum() *MergeEnum {
+	p := new(MergeEnum)
+	*p = x
+	return p
+}
+
+func (x MergeEnum) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MergeEnum) Descriptor() protoreflect.EnumDescriptor {
+	return file_merge_merge_proto_enumTypes[0].Descriptor()
+}
+
+func (MergeEnum) Type() protoreflect.EnumType {
+	return &file_merge_merge_proto_enumTypes[0]
+}
+
+func (x MergeEnum) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MergeEnum.Descriptor instead.
+func (MergeEnum) EnumDescriptor() ([]byte, []int) {
+	return file_merge_merge_proto_rawDescGZIP(), []int{0}
+}
+
+type MergeChild struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tags []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *MergeChild) Reset() {
+	*x = MergeChild{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_merge_merge_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeChild) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeChild) ProtoMessage() {}
+
+func (x *MergeChild) ProtoReflect() protoreflect.Message {
+	mi := &file_merge_merge_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeChild.ProtoReflect.Descriptor instead.
+func (*MergeChild) Descriptor() ([]byte, []int) {
+	return file_merge_merge_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MergeChild) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MergeChild) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type MergeMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Int32Value    int32                  `protobuf:"varint,1,opt,name=int32_value,json=int32Value,proto3" json:"int32_value,omitempty"`
+	StringValue   string                 `protobuf:"bytes,2,opt,name=string_value,json=stringValue,proto3" json:"string_value,omitempty"`
+	BytesValue    []byte                 `protobuf:"bytes,3,opt,name=bytes_value,json=bytesValue,proto3" json:"bytes_value,omitempty"`
+	BoolValue     bool                   `protobuf:"varint,4,opt,name=bool_value,json=boolValue,proto3" json:"bool_value,omitempty"`
+	DoubleValue   float64                `protobuf:"fixed64,5,opt,name=double_value,json=doubleValue,proto3" json:"double_value,omitempty"`
+	EnumValue     MergeEnum              `protobuf:"varint,6,opt,name=enum_value,json=enumValue,proto3,enum=MergeEnum" json:"enum_value,omitempty"`
+	OptionalInt32 *int32                 `protobuf:"varint,11,opt,name=optional_int32,json=optionalInt32,proto3,oneof" json:"optional_int32,omitempty"`
+	OptionalBytes []byte                 `protobuf:"bytes,12,opt,name=optional_bytes,json=optionalBytes,proto3,oneof" json:"optional_bytes,omitempty"`
+	RepeatedInt32 []int32                `protobuf:"varint,21,rep,packed,name=repeated_int32,json=repeatedInt32,proto3" json:"repeated_int32,omitempty"`
+	RepeatedBytes [][]byte               `protobuf:"bytes,22,rep,name=repeated_bytes,json=repeatedBytes,proto3" json:"repeated_bytes,omitempty"`
+	RepeatedChild []*MergeChild          `protobuf:"bytes,23,rep,name=repeated_child,json=repeatedChild,proto3" json:"repeated_child,omitempty"`
+	Int32Map      map[string]int32       `protobuf:"bytes,31,rep,name=int32_map,json=int32Map,proto3" json:"int32_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	BytesMap      map[string][]byte      `protobuf:"bytes,32,rep,name=bytes_map,json=bytesMap,proto3" json:"bytes_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ChildMap      map[string]*MergeChild `protobuf:"bytes,33,rep,name=child_map,json=childMap,proto3" json:"child_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Child         *MergeChild            `protobuf:"bytes,41,opt,name=child,proto3" json:"child,omitempty"`
+	// Merged with the proto package, as it is declared in another Go package.
+	Wrapped *wrapperspb.StringValue `protobuf:"bytes,42,opt,name=wrapped,proto3" json:"wrapped,omitempty"`
+	// Types that are assignable to Choice:
+	//	*MergeMessage_OneofInt32
+	//	*MergeMessage_OneofBytes
+	//	*MergeMessage_OneofChild
+	Choice isMergeMessage_Choice `protobuf_oneof:"choice"`
+}
+
+func (x *MergeMessage) Reset() {
+	*x = MergeMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_merge_merge_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeMessage) ProtoMessage() {}
+
+func (x *MergeMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_merge_merge_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeMessage.ProtoReflect.Descriptor instead.
+func (*MergeMessage) Descriptor() ([]byte, []int) {
+	return file_merge_merge_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MergeMessage) GetInt32Value() int32 {
+	if x != nil {
+		return x.Int32Value
+	}
+	return 0
+}
+
+func (x *MergeMessage) GetStringValue() string {
+	if x != nil {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (x *MergeMessage) GetBytesValue() []byte {
+	if x != nil {
+		return x.BytesValue
+	}
+	return nil
+}
+
+func (x *MergeMessage) GetBoolValue() bool {
+	if x != nil {
+		return x.BoolValue
+	}
+	return false
+}
+
+func (x *MergeMessage) GetDoubleValue() float64 {
+	if x != nil {
+		return x.DoubleValue
+	}
+	return 0
+}
+
+func (x *MergeMessage) GetEnumValue() MergeEnum {
+	if x != nil {
+		return x.EnumValue
+	}
+	return MergeEnum_MERGE_ZERO
+}
+
+func (x *MergeMessage) GetOptionalInt32() int32 {
+	if x != nil && x.OptionalInt32 != nil {
+		return *x.OptionalInt32
+	}
+	return 0
+}
+
+func (x *MergeMessage) GetOptionalBytes() []byte {
+	if x != nil {
+		return x.OptionalBytes
+	}
+	return nil
+}
+
+func (x *MergeMessage) GetRepeatedInt32() []int32 {
+	if x != nil {
+		return x.RepeatedInt32
+	}
+	return nil
+}
+
+func (x *MergeMessage) GetRepeatedBytes() [][]byte {
+	if x != nil {
+		return x.RepeatedBytes
+	}
+	return nil
+}
+
+func (x *MergeMessage) GetRepeatedChild() []*MergeChild {
+	if x != nil {
+		return x.RepeatedChild
+	}
+	return nil
+}
+
+func (x *MergeMessage) GetInt32Map() map[string]int32 {
+	if x != nil {
+		return x.Int32Map
+	}
+	return nil
+}
+
+func (x *MergeMessage) GetBytesMap() map[string][]byte {
+	if x != nil {
+		return x.BytesMap
+	}
+	return nil
+}
+
+func (x *MergeMessage) GetChildMap() map[string]*MergeChild {
+	if x != nil {
+		return x.ChildMap
+	}
+	return nil
+}
+
+func (x *MergeMessage) GetChild() *MergeChild {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+func (x *MergeMessage) GetWrapped() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Wrapped
+	}
+	return nil
+}
+
+func (m *MergeMessage) GetChoice() isMergeMessage_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (x *MergeMessage) GetOneofInt32() int32 {
+	if x, ok := x.GetChoice().(*MergeMessage_OneofInt32); ok {
+		return x.OneofInt32
+	}
+	return 0
+}
+
+func (x *MergeMessage) GetOneofBytes() []byte {
+	if x, ok := x.GetChoice().(*MergeMessage_OneofBytes); ok {
+		return x.OneofBytes
+	}
+	return nil
+}
+
+func (x *MergeMessage) GetOneofChild() *MergeChild {
+	if x, ok := x.GetChoice().(*MergeMessage_OneofChild); ok {
+		return x.OneofChild
+	}
+	return nil
+}
+
+type isMergeMessage_Choice interface {
+	isMergeMessage_Choice()
+}
+
+type MergeMessage_OneofInt32 struct {
+	OneofInt32 int32 `protobuf:"varint,51,opt,name=oneof_int32,json=oneofInt32,proto3,oneof"`
+}
+
+type MergeMessage_OneofBytes struct {
+	OneofBytes []byte `protobuf:"bytes,52,opt,name=oneof_bytes,json=oneofBytes,proto3,oneof"`
+}
+
+type MergeMessage_OneofChild struct {
+	OneofChild *MergeChild `protobuf:"bytes,53,opt,name=oneof_child,json=oneofChild,proto3,oneof"`
+}
+
+func (*MergeMessage_OneofInt32) isMergeMessage_Choice() {}
+
+func (*MergeMessage_OneofBytes) isMergeMessage_Choice() {}
+
+func (*MergeMessage_OneofChild) isMergeMessage_Choice() {}
+
+var File_merge_merge_proto protoreflect.FileDescriptor
+
+var file_merge_merge_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x2f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x30, 0x0a, 0x0a, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x68, 0x69, 0x6c,
+	0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0xad, 0x08, 0x0a, 0x0c, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x29, 0x0a,
+	0x0a, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0a, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x09, 0x65,
+	0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2a, 0x0a, 0x0e, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x01, 0x52, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x02, 0x52, 0x0d,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x18, 0x15, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x0d, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x32,
+	0x0a, 0x0e, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x68,
+	0x69, 0x6c, 0x64, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6d, 0x61, 0x70, 0x18,
+	0x1f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x61, 0x70, 0x12, 0x38, 0x0a, 0x09,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x20, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f,
+	0x6d, 0x61, 0x70, 0x18, 0x21, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4d, 0x61,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x4d, 0x61, 0x70,
+	0x12, 0x21, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x12, 0x36, 0x0a, 0x07, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x18, 0x2a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x07, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0b, 0x6f,
+	0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x33, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x00, 0x52, 0x0a, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x21,
+	0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x34, 0x20,
+	0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0a, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x2e, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x18, 0x35, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x68,
+	0x69, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x43, 0x68, 0x69, 0x6c,
+	0x64, 0x1a, 0x3b, 0x0a, 0x0d, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3b,
+	0x0a, 0x0d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x48, 0x0a, 0x0d, 0x43,
+	0x68, 0x69, 0x6c, 0x64, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x42,
+	0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x2a, 0x2a, 0x0a, 0x09, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x45, 0x6e,
+	0x75, 0x6d, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x5a, 0x45, 0x52, 0x4f,
+	0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x4f, 0x4e, 0x45, 0x10,
+	0x01, 0x42, 0x11, 0x5a, 0x0f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d,
+	0x65, 0x72, 0x67, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_merge_merge_proto_rawDescOnce sync.Once
+	file_merge_merge_proto_rawDescData = file_merge_merge_proto_rawDesc
+)
+
+func file_merge_merge_proto_rawDescGZIP() []byte {
+	file_merge_merge_proto_rawDescOnce.Do(func() {
+		file_merge_merge_proto_rawDescData = protoimpl.X.CompressGZIP(file_merge_merge_proto_rawDescData)
+	})
+	return file_merge_merge_proto_rawDescData
+}
+
+var file_merge_merge_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_merge_merge_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_merge_merge_proto_goTypes = []any{
+	(MergeEnum)(0),                 // 0: MergeEnum
+	(*MergeChild)(nil),             // 1: MergeChild
+	(*MergeMessage)(nil),           // 2: MergeMessage
+	nil,                            // 3: MergeMessage.Int32MapEntry
+	nil,                            // 4: MergeMessage.BytesMapEntry
+	nil,                            // 5: MergeMessage.ChildMapEntry
+	(*wrapperspb.StringValue)(nil), // 6: google.protobuf.StringValue
+}
+var file_merge_merge_proto_depIdxs = []int32{
+	0, // 0: MergeMessage.enum_value:type_name -> MergeEnum
+	1, // 1: MergeMessage.repeated_child:type_name -> MergeChild
+	3, // 2: MergeMessage.int32_map:type_name -> MergeMessage.Int32MapEntry
+	4, // 3: MergeMessage.bytes_map:type_name -> MergeMessage.BytesMapEntry
+	5, // 4: MergeMessage.child_map:type_name -> MergeMessage.ChildMapEntry
+	1, // 5: MergeMessage.child:type_name -> MergeChild
+	6, // 6: MergeMessage.wrapped:type_name -> google.protobuf.StringValue
+	1, // 7: MergeMessage.oneof_child:type_name -> MergeChild
+	1, // 8: MergeMessage.ChildMapEntry.value:type_name -> MergeChild
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_merge_merge_proto_init() }
+func file_merge_merge_proto_init() {
+	if File_merge_merge_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_merge_merge_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*MergeChild); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_merge_merge_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*MergeMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_merge_merge_proto_msgTypes[1].OneofWrappers = []any{
+		(*MergeMessage_OneofInt32)(nil),
+		(*MergeMessage_OneofBytes)(nil),
+		(*MergeMessage_OneofChild)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_merge_merge_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_merge_merge_proto_goTypes,
+		DependencyIndexes: file_merge_merge_proto_depIdxs,
+		EnumInfos:         file_merge_merge_proto_enumTypes,
+		MessageInfos:      file_merge_merge_proto_msgTypes,
+	}.Build()
+	File_merge_merge_proto = out.File
+	file_merge_merge_proto_rawDesc = nil
+	file_merge_merge_proto_goTypes = nil
+	file_merge_merge_proto_depIdxs = nil
+}
@@ -0,0 +1,255 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.20.0
+// source: merge/merge2.proto
+
+package merge
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MergeExtendable struct {
+	state           protoimpl.MessageState
+	sizeCache       protoimpl.SizeCache
+	unknownFields   protoimpl.UnknownFields
+	extensionFields protoimpl.ExtensionFields
+
+	Value *int32      `protobuf:"varint,1,opt,name=value" json:"value,omitempty"`
+	Data  []byte      `protobuf:"bytes,2,opt,name=data" json:"data,omitempty"`
+	Child *MergeChild `protobuf:"bytes,3,opt,name=child" json:"child,omitempty"`
+}
+
+func (x *MergeExtendable) Reset() {
+	*x = MergeExtendable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_merge_merge2_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeExtendable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeExtendable) ProtoMessage() {}
+
+func (x *MergeExtendable) ProtoReflect() protoreflect.Message {
+	mi := &file_merge_merge2_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeExtendable.ProtoReflect.Descriptor instead.
+func (*MergeExtendable) Descriptor() ([]byte, []int) {
+	return file_merge_merge2_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MergeExtendable) GetValue() int32 {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return 0
+}
+
+func (x *MergeExtendable) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *MergeExtendable) GetChild() *MergeChild {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+var file_merge_merge2_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*MergeExtendable)(nil),
+		ExtensionType: (*int32)(nil),
+		Field:         100,
+		Name:          "int32_extension",
+		Tag:           "varint,100,opt,name=int32_extension",
+		Filename:      "merge/merge2.proto",
+	},
+	{
+		ExtendedType:  (*MergeExtendable)(nil),
+		ExtensionType: ([]byte)(nil),
+		Field:         101,
+		Name:          "bytes_extension",
+		Tag:           "bytes,101,opt,name=bytes_extension",
+		Filename:      "merge/merge2.proto",
+	},
+	{
+		ExtendedType:  (*MergeExtendable)(nil),
+		ExtensionType: ([]int32)(nil),
+		Field:         102,
+		Name:          "repeated_extension",
+		Tag:           "varint,102,rep,name=repeated_extension",
+		Filename:      "merge/merge2.proto",
+	},
+	{
+		ExtendedType:  (*MergeExtendable)(nil),
+		ExtensionType: (*MergeChild)(nil),
+		Field:         103,
+		Name:          "child_extension",
+		Tag:           "bytes,103,opt,name=child_extension",
+		Filename:      "merge/merge2.proto",
+	},
+	{
+		ExtendedType:  (*MergeExtendable)(nil),
+		ExtensionType: ([]*MergeChild)(nil),
+		Field:         104,
+		Name:          "repeated_child_extension",
+		Tag:           "bytes,104,rep,name=repeated_child_extension",
+		Filename:      "merge/merge2.proto",
+	},
+}
+
+// Extension fields to MergeExtendable.
+var (
+	// optional int32 int32_extension = 100;
+	E_Int32Extension = &file_merge_merge2_proto_extTypes[0]
+	// optional bytes bytes_extension = 101;
+	E_BytesExtension = &file_merge_merge2_proto_extTypes[1]
+	// repeated int32 repeated_extension = 102;
+	E_RepeatedExtension = &file_merge_merge2_proto_extTypes[2]
+	// optional MergeChild child_extension = 103;
+	E_ChildExtension = &file_merge_merge2_proto_extTypes[3]
+	// repeated MergeChild repeated_child_extension = 104;
+	E_RepeatedChildExtension = &file_merge_merge2_proto_extTypes[4]
+)
+
+var File_merge_merge2_proto protoreflect.FileDescriptor
+
+var file_merge_merge2_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x2f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x32, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x2f, 0x6d, 0x65, 0x72, 0x67,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x65, 0x0a, 0x0f, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64,
+	0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2a, 0x05, 0x08, 0x64, 0x10, 0xc8, 0x01, 0x3a, 0x39,
+	0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x10, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x39, 0x0a, 0x0f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x2e, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x65,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73, 0x45, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x3f, 0x0a, 0x12, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x2e, 0x4d, 0x65, 0x72,
+	0x67, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x66, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x11, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x46, 0x0a, 0x0f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x0e, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x57, 0x0a,
+	0x18, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f,
+	0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x2e, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x68, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x16,
+	0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x11, 0x5a, 0x0f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x65, 0x72, 0x67, 0x65,
+}
+
+var (
+	file_merge_merge2_proto_rawDescOnce sync.Once
+	file_merge_merge2_proto_rawDescData = file_merge_merge2_proto_rawDesc
+)
+
+func file_merge_merge2_proto_rawDescGZIP() []byte {
+	file_merge_merge2_proto_rawDescOnce.Do(func() {
+		file_merge_merge2_proto_rawDescData = protoimpl.X.CompressGZIP(file_merge_merge2_proto_rawDescData)
+	})
+	return file_merge_merge2_proto_rawDescData
+}
+
+var file_merge_merge2_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_merge_merge2_proto_goTypes = []any{
+	(*MergeExtendable)(nil), // 0: MergeExtendable
+	(*MergeChild)(nil),      // 1: MergeChild
+}
+var file_merge_merge2_proto_depIdxs = []int32{
+	1, // 0: MergeExtendable.child:type_name -> MergeChild
+	0, // 1: int32_extension:extendee -> MergeExtendable
+	0, // 2: bytes_extension:extendee -> MergeExtendable
+	0, // 3: repeated_extension:extendee -> MergeExtendable
+	0, // 4: child_extension:extendee -> MergeExtendable
+	0, // 5: repeated_child_extension:extendee -> MergeExtendable
+	1, // 6: child_extension:type_name -> MergeChild
+	1, // 7: repeated_child_extension:type_name -> MergeChild
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	6, // [6:8] is the sub-list for extension type_name
+	1, // [1:6] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_merge_merge2_proto_init() }
+func file_merge_merge2_proto_init() {
+	if File_merge_merge2_proto != nil {
+		return
+	}
+	file_merge_merge_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_merge_merge2_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*MergeExtendable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			case 3:
+				return &v.extensionFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_merge_merge2_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 5,
+			NumServices:   0,
+		},
+		GoTypes:           file_merge_merge2_proto_goTypes,
+		DependencyIndexes: file_merge_merge2_proto_depIdxs,
+		MessageInfos:      file_merge_merge2_proto_msgTypes,
+		ExtensionInfos:    file_merge_merge2_proto_extTypes,
+	}.Build()
+	File_merge_merge2_proto = out.File
+	file_merge_merge2_proto_rawDesc = nil
+	file_merge_merge2_proto_goTypes = nil
+	file_merge_merge2_proto_depIdxs = nil
+}
@@ -0,0 +1,1602 @@
+// Code generated by protoc-gen-go-vtproto. DO NOT EDIT.
+// protoc-gen-go-vtproto version: (devel)
+// source: merge/merge2.proto
+
+package merge
+
+import (
+	fmt "fmt"
+	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
+	vtproto "github.com/planetscale/vtprotobuf/vtproto"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	proto "google.golang.org/protobuf/proto"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	hash "hash"
+	fnv "hash/fnv"
+	io "io"
+	sort "sort"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+func (m *MergeExtendable) CloneVT() *MergeExtendable {
+	if m == nil {
+		return (*MergeExtendable)(nil)
+	}
+	r := &MergeExtendable{
+		Child: m.Child.CloneVT(),
+	}
+	if rhs := m.Value; rhs != nil {
+		tmpVal := *rhs
+		r.Value = &tmpVal
+	}
+	if rhs := m.Data; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.Data = tmpBytes
+	}
+	for num, x := range m.extensionFields {
+		switch num {
+		case 100:
+			rhs := proto.GetExtension(m, E_Int32Extension).(int32)
+			proto.SetExtension(r, E_Int32Extension, rhs)
+		case 101:
+			rhs := proto.GetExtension(m, E_BytesExtension).([]byte)
+			var tmpVal []byte
+			tmpBytes := make([]byte, len(rhs))
+			copy(tmpBytes, rhs)
+			tmpVal = tmpBytes
+			proto.SetExtension(r, E_BytesExtension, tmpVal)
+		case 102:
+			rhs := proto.GetExtension(m, E_RepeatedExtension).([]int32)
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			proto.SetExtension(r, E_RepeatedExtension, tmpContainer)
+		case 103:
+			rhs := proto.GetExtension(m, E_ChildExtension).(*MergeChild)
+			var tmpVal *MergeChild
+			tmpVal = rhs.CloneVT()
+			proto.SetExtension(r, E_ChildExtension, tmpVal)
+		case 104:
+			rhs := proto.GetExtension(m, E_RepeatedChildExtension).([]*MergeChild)
+			tmpContainer := make([]*MergeChild, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = v.CloneVT()
+			}
+			proto.SetExtension(r, E_RepeatedChildExtension, tmpContainer)
+		default:
+			r.ProtoReflect().Set(x.Type().TypeDescriptor(), protohelpers.CloneExtension(x.Type(), x.Value()))
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *MergeExtendable) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *MergeExtendable) CopyToVT(dst *MergeExtendable) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.Value == nil {
+		dst.Value = nil
+	} else {
+		if dst.Value == nil {
+			dst.Value = new(int32)
+		}
+		*dst.Value = *m.Value
+	}
+	if m.Data == nil {
+		dst.Data = nil
+	} else if dst.Data == nil {
+		dst.Data = append([]byte{}, m.Data...)
+	} else {
+		dst.Data = append(dst.Data[:0], m.Data...)
+	}
+	if m.Child == nil {
+		dst.Child = nil
+	} else {
+		if dst.Child == nil {
+			dst.Child = m.Child.CloneVT()
+		} else {
+			m.Child.CopyToVT(dst.Child)
+		}
+	}
+	for num := range dst.extensionFields {
+		delete(dst.extensionFields, num)
+	}
+	for num, x := range m.extensionFields {
+		switch num {
+		case 100:
+			rhs := proto.GetExtension(m, E_Int32Extension).(int32)
+			proto.SetExtension(dst, E_Int32Extension, rhs)
+		case 101:
+			rhs := proto.GetExtension(m, E_BytesExtension).([]byte)
+			var tmpVal []byte
+			tmpBytes := make([]byte, len(rhs))
+			copy(tmpBytes, rhs)
+			tmpVal = tmpBytes
+			proto.SetExtension(dst, E_BytesExtension, tmpVal)
+		case 102:
+			rhs := proto.GetExtension(m, E_RepeatedExtension).([]int32)
+			tmpContainer := make([]int32, len(rhs))
+			copy(tmpContainer, rhs)
+			proto.SetExtension(dst, E_RepeatedExtension, tmpContainer)
+		case 103:
+			rhs := proto.GetExtension(m, E_ChildExtension).(*MergeChild)
+			var tmpVal *MergeChild
+			tmpVal = rhs.CloneVT()
+			proto.SetExtension(dst, E_ChildExtension, tmpVal)
+		case 104:
+			rhs := proto.GetExtension(m, E_RepeatedChildExtension).([]*MergeChild)
+			tmpContainer := make([]*MergeChild, len(rhs))
+			for k, v := range rhs {
+				tmpContainer[k] = v.CloneVT()
+			}
+			proto.SetExtension(dst, E_RepeatedChildExtension, tmpContainer)
+		default:
+			dst.ProtoReflect().Set(x.Type().TypeDescriptor(), protohelpers.CloneExtension(x.Type(), x.Value()))
+		}
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (this *MergeExtendable) DiffVT(that *MergeExtendable) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.Value, that.Value; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "value", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "value", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "value", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.Data, that.Data; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "data", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "data", Kind: vtproto.FieldRemoved, Old: p})
+	} else if string(p) != string(q) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "data", Kind: vtproto.FieldChanged, Old: p, New: q})
+	}
+	if p, q := this.Child, that.Child; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "child", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "child", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if nested := p.DiffVT(q); len(nested) > 0 {
+			diffs = protohelpers.AppendDiffs(diffs, "child", nested)
+		}
+	}
+	diffs = protohelpers.DiffExtensions(diffs, this.extensionFields, that.extensionFields)
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *MergeExtendable) EqualVT(that *MergeExtendable) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *MergeExtendable) EqualVTWithOptions(that *MergeExtendable, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	if p, q := this.Value, that.Value; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := this.Data, that.Data; (p == nil && q != nil) || (p != nil && q == nil) || string(p) != string(q) {
+		return false
+	}
+	if !this.Child.EqualVTWithOptions(that.Child, opts) {
+		return false
+	}
+	if len(this.extensionFields) != len(that.extensionFields) {
+		return false
+	}
+	for num, x := range this.extensionFields {
+		y, ok := that.extensionFields[num]
+		if !ok {
+			return false
+		}
+		switch num {
+		case 100:
+			ex, ey := proto.GetExtension(this, E_Int32Extension).(int32), proto.GetExtension(that, E_Int32Extension).(int32)
+			if ex != ey {
+				return false
+			}
+		case 101:
+			ex, ey := proto.GetExtension(this, E_BytesExtension).([]byte), proto.GetExtension(that, E_BytesExtension).([]byte)
+			if string(ex) != string(ey) {
+				return false
+			}
+		case 102:
+			ex, ey := proto.GetExtension(this, E_RepeatedExtension).([]int32), proto.GetExtension(that, E_RepeatedExtension).([]int32)
+			if len(ex) != len(ey) {
+				return false
+			}
+			for i, vx := range ex {
+				vy := ey[i]
+				if vx != vy {
+					return false
+				}
+			}
+		case 103:
+			ex, ey := proto.GetExtension(this, E_ChildExtension).(*MergeChild), proto.GetExtension(that, E_ChildExtension).(*MergeChild)
+			if p, q := ex, ey; p != q {
+				if p == nil {
+					p = &MergeChild{}
+				}
+				if q == nil {
+					q = &MergeChild{}
+				}
+				if !p.EqualVTWithOptions(q, opts) {
+					return false
+				}
+			}
+		case 104:
+			ex, ey := proto.GetExtension(this, E_RepeatedChildExtension).([]*MergeChild), proto.GetExtension(that, E_RepeatedChildExtension).([]*MergeChild)
+			if len(ex) != len(ey) {
+				return false
+			}
+			for i, vx := range ex {
+				vy := ey[i]
+				if p, q := vx, vy; p != q {
+					if p == nil {
+						p = &MergeChild{}
+					}
+					if q == nil {
+						q = &MergeChild{}
+					}
+					if !p.EqualVTWithOptions(q, opts) {
+						return false
+					}
+				}
+			}
+		default:
+			if x.Type() != y.Type() || !protohelpers.EqualExtensionWithOptions(x.Type(), x.Value(), y.Value(), opts) {
+				return false
+			}
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *MergeExtendable) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*MergeExtendable)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (m *MergeExtendable) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *MergeExtendable) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	var entry hash.Hash64
+	if m.Value != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(*m.Value))
+	}
+	if m.Data != nil {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashBytes(h, s, m.Data)
+	}
+	if m.Child != nil {
+		protohelpers.HashUint64(h, s, 3)
+		m.Child.hashVT(h, s)
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if len(m.extensionFields) > 0 {
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for num, x := range m.extensionFields {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(num))
+			switch num {
+			case 100:
+				v := proto.GetExtension(m, E_Int32Extension).(int32)
+				protohelpers.HashUint64(entry, s, uint64(v))
+			case 101:
+				v := proto.GetExtension(m, E_BytesExtension).([]byte)
+				protohelpers.HashBytes(entry, s, v)
+			case 102:
+				v := proto.GetExtension(m, E_RepeatedExtension).([]int32)
+				protohelpers.HashUint64(entry, s, uint64(len(v)))
+				for _, v := range v {
+					protohelpers.HashUint64(entry, s, uint64(v))
+				}
+			case 103:
+				v := proto.GetExtension(m, E_ChildExtension).(*MergeChild)
+				v.hashVT(entry, s)
+				protohelpers.HashUint64(entry, s, 0)
+			case 104:
+				v := proto.GetExtension(m, E_RepeatedChildExtension).([]*MergeChild)
+				protohelpers.HashUint64(entry, s, uint64(len(v)))
+				for _, v := range v {
+					v.hashVT(entry, s)
+					protohelpers.HashUint64(entry, s, 0)
+				}
+			default:
+				protohelpers.HashExtension(entry, x.Type(), x.Value())
+			}
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *MergeExtendable) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *MergeExtendable) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &MergeExtendable{}
+	}
+	w.ObjectStart()
+	if m.Value != nil {
+		w.Field("value", "value")
+		w.Int32(*m.Value)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("value", "value")
+		w.Null()
+	}
+	if m.Data != nil {
+		w.Field("data", "data")
+		w.Base64(m.Data)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("data", "data")
+		w.Null()
+	}
+	if m.Child != nil {
+		w.Field("child", "child")
+		if err := m.Child.MarshalJSONToVT(w); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("child", "child")
+		w.Null()
+	}
+	if err := protohelpers.MarshalJSONExtensions(w, m.extensionFields); err != nil {
+		return err
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *MergeExtendable) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *MergeExtendable) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [3]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "value":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Value = &v
+		case "data":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadBytes()
+			if err != nil {
+				return err
+			}
+			m.Data = v
+		case "child":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(MergeChild)
+			if err := v.UnmarshalJSONFromVT(r); err != nil {
+				return err
+			}
+			m.Child = v
+		default:
+			if err := protohelpers.UnmarshalJSONExtension(r, m, name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *MergeExtendable) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *MergeExtendable) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *MergeExtendable) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *MergeExtendable) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *MergeExtendable) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Child != nil {
+		size, err := m.Child.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x1a
+	}
+	if m.Data != nil {
+		i -= len(m.Data)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.Data)
+		i = encodeVarint(dAtA, i, uint64(len(m.Data)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x12
+	}
+	if m.Value != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.Value))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	if len(m.extensionFields) > 0 {
+		extensionNums := make([]int32, 0, len(m.extensionFields))
+		for num := range m.extensionFields {
+			extensionNums = append(extensionNums, num)
+		}
+		sort.Slice(extensionNums, func(i, j int) bool {
+			return extensionNums[i] > extensionNums[j]
+		})
+		for _, num := range extensionNums {
+			switch num {
+			case 100:
+				v := proto.GetExtension(m, E_Int32Extension).(int32)
+				i = encodeVarint(dAtA, i, uint64(v))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				i -= 2
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				dAtA[i] = 0xa0
+				dAtA[i+1] = 0x6
+			case 101:
+				v := proto.GetExtension(m, E_BytesExtension).([]byte)
+				i -= len(v)
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				copy(dAtA[i:], v)
+				i = encodeVarint(dAtA, i, uint64(len(v)))
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				i -= 2
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				dAtA[i] = 0xaa
+				dAtA[i+1] = 0x6
+			case 102:
+				v := proto.GetExtension(m, E_RepeatedExtension).([]int32)
+				if len(v) > 0 {
+					for iNdEx := len(v) - 1; iNdEx >= 0; iNdEx-- {
+						i = encodeVarint(dAtA, i, uint64(v[iNdEx]))
+						if i < 0 {
+							return 0, ErrBufferTooSmall
+						}
+						i -= 2
+						if i < 0 {
+							return 0, ErrBufferTooSmall
+						}
+						dAtA[i] = 0xb0
+						dAtA[i+1] = 0x6
+					}
+				}
+			case 103:
+				v := proto.GetExtension(m, E_ChildExtension).(*MergeChild)
+				if v != nil {
+					size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarint(dAtA, i, uint64(size))
+					if i < 0 {
+						return 0, ErrBufferTooSmall
+					}
+					i -= 2
+					if i < 0 {
+						return 0, ErrBufferTooSmall
+					}
+					dAtA[i] = 0xba
+					dAtA[i+1] = 0x6
+				}
+			case 104:
+				v := proto.GetExtension(m, E_RepeatedChildExtension).([]*MergeChild)
+				if len(v) > 0 {
+					for iNdEx := len(v) - 1; iNdEx >= 0; iNdEx-- {
+						size, err := v[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+						if err != nil {
+							return 0, err
+						}
+						i -= size
+						i = encodeVarint(dAtA, i, uint64(size))
+						if i < 0 {
+							return 0, ErrBufferTooSmall
+						}
+						i -= 2
+						if i < 0 {
+							return 0, ErrBufferTooSmall
+						}
+						dAtA[i] = 0xc2
+						dAtA[i+1] = 0x6
+					}
+				}
+			default:
+				x := m.extensionFields[num]
+				i -= protohelpers.SizeOfExtension(x.Type(), x.Value())
+				if i < 0 {
+					return 0, ErrBufferTooSmall
+				}
+				if _, err := protohelpers.AppendExtension(dAtA[:i], x.Type(), x.Value()); err != nil {
+					return 0, err
+				}
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeExtendable) MergeVT(src *MergeExtendable) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Value != nil {
+		tmpVal := *src.Value
+		m.Value = &tmpVal
+	}
+	if src.Data != nil {
+		m.Data = append([]byte{}, src.Data...)
+	}
+	if src.Child != nil {
+		if m.Child == nil {
+			m.Child = &MergeChild{}
+		}
+		m.Child.MergeVT(src.Child)
+	}
+	for num, x := range src.extensionFields {
+		switch num {
+		case 100:
+			rhs := proto.GetExtension(src, E_Int32Extension).(int32)
+			proto.SetExtension(m, E_Int32Extension, rhs)
+		case 101:
+			rhs := proto.GetExtension(src, E_BytesExtension).([]byte)
+			proto.SetExtension(m, E_BytesExtension, append([]byte{}, rhs...))
+		case 102:
+			rhs := proto.GetExtension(src, E_RepeatedExtension).([]int32)
+			lhs := proto.GetExtension(m, E_RepeatedExtension).([]int32)
+			lhs = append(lhs, rhs...)
+			proto.SetExtension(m, E_RepeatedExtension, lhs)
+		case 103:
+			rhs := proto.GetExtension(src, E_ChildExtension).(*MergeChild)
+			lhs := proto.GetExtension(m, E_ChildExtension).(*MergeChild)
+			if lhs == nil {
+				lhs = &MergeChild{}
+			}
+			lhs.MergeVT(rhs)
+			proto.SetExtension(m, E_ChildExtension, lhs)
+		case 104:
+			rhs := proto.GetExtension(src, E_RepeatedChildExtension).([]*MergeChild)
+			lhs := proto.GetExtension(m, E_RepeatedChildExtension).([]*MergeChild)
+			for _, v := range rhs {
+				tmpMsg := &MergeChild{}
+				tmpMsg.MergeVT(v)
+				lhs = append(lhs, tmpMsg)
+			}
+			proto.SetExtension(m, E_RepeatedChildExtension, lhs)
+		default:
+			protohelpers.MergeExtension(m.ProtoReflect(), x.Type(), x.Value())
+		}
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *MergeExtendable) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*MergeExtendable); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *MergeExtendable) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		n += 1 + sov(uint64(*m.Value))
+	}
+	if m.Data != nil {
+		l = len(m.Data)
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.Child != nil {
+		l = m.Child.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	for num, x := range m.extensionFields {
+		switch num {
+		case 100:
+			v := proto.GetExtension(m, E_Int32Extension).(int32)
+			n += 2 + sov(uint64(v))
+		case 101:
+			v := proto.GetExtension(m, E_BytesExtension).([]byte)
+			l = len(v)
+			n += 2 + l + sov(uint64(l))
+		case 102:
+			v := proto.GetExtension(m, E_RepeatedExtension).([]int32)
+			if len(v) > 0 {
+				for _, e := range v {
+					n += 2 + sov(uint64(e))
+				}
+			}
+		case 103:
+			v := proto.GetExtension(m, E_ChildExtension).(*MergeChild)
+			if v != nil {
+				l = v.SizeVT()
+				n += 2 + l + sov(uint64(l))
+			}
+		case 104:
+			v := proto.GetExtension(m, E_RepeatedChildExtension).([]*MergeChild)
+			if len(v) > 0 {
+				for _, e := range v {
+					l = e.SizeVT()
+					n += 2 + l + sov(uint64(l))
+				}
+			}
+		default:
+			n += protohelpers.SizeOfExtension(x.Type(), x.Value())
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *MergeExtendable) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *MergeExtendable) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *MergeExtendable) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *MergeExtendable) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*MergeExtendable)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeExtendable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeExtendable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Value = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Child", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Child == nil {
+				m.Child = &MergeChild{}
+			}
+			if err := m.Child.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 100:
+			var ext int32
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Extension", wireType)
+			}
+			ext = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				ext |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			proto.SetExtension(m, E_Int32Extension, ext)
+		case 101:
+			var ext []byte
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesExtension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			ext = append(ext[:0], dAtA[iNdEx:postIndex]...)
+			if ext == nil {
+				ext = []byte{}
+			}
+			iNdEx = postIndex
+			proto.SetExtension(m, E_BytesExtension, ext)
+		case 102:
+			ext := proto.GetExtension(m, E_RepeatedExtension).([]int32)
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				ext = append(ext, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(ext) == 0 {
+					ext = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					ext = append(ext, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedExtension", wireType)
+			}
+			proto.SetExtension(m, E_RepeatedExtension, ext)
+		case 103:
+			ext := proto.GetExtension(m, E_ChildExtension).(*MergeChild)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildExtension", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if ext == nil {
+				ext = &MergeChild{}
+			}
+			if err := ext.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			proto.SetExtension(m, E_ChildExtension, ext)
+		case 104:
+			ext := proto.GetExtension(m, E_RepeatedChildExtension).([]*MergeChild)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedChildExtension", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			ext = append(ext, &MergeChild{})
+			if err := ext[len(ext)-1].unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			proto.SetExtension(m, E_RepeatedChildExtension, ext)
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if (fieldNum >= 100) && (fieldNum < 200) {
+				found, err := protohelpers.UnmarshalExtension(m.ProtoReflect(), dAtA[iNdEx:iNdEx+skippy], depth)
+				if err != nil {
+					return err
+				}
+				if !found && !opts.DiscardUnknown {
+					m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			} else {
+				if !opts.DiscardUnknown {
+					m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeExtendable) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *MergeExtendable) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *MergeExtendable) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*MergeExtendable)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeExtendable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeExtendable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Value = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = dAtA[iNdEx:postIndex:postIndex]
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Child", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Child == nil {
+				m.Child = &MergeChild{}
+			}
+			if err := m.Child.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 100:
+			var ext int32
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Extension", wireType)
+			}
+			ext = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				ext |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			proto.SetExtension(m, E_Int32Extension, ext)
+		case 101:
+			var ext []byte
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesExtension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			ext = dAtA[iNdEx:postIndex:postIndex]
+			iNdEx = postIndex
+			proto.SetExtension(m, E_BytesExtension, ext)
+		case 102:
+			ext := proto.GetExtension(m, E_RepeatedExtension).([]int32)
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				ext = append(ext, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(ext) == 0 {
+					ext = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					ext = append(ext, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedExtension", wireType)
+			}
+			proto.SetExtension(m, E_RepeatedExtension, ext)
+		case 103:
+			ext := proto.GetExtension(m, E_ChildExtension).(*MergeChild)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildExtension", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if ext == nil {
+				ext = &MergeChild{}
+			}
+			if err := ext.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			proto.SetExtension(m, E_ChildExtension, ext)
+		case 104:
+			ext := proto.GetExtension(m, E_RepeatedChildExtension).([]*MergeChild)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedChildExtension", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			ext = append(ext, &MergeChild{})
+			if err := ext[len(ext)-1].unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			proto.SetExtension(m, E_RepeatedChildExtension, ext)
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if (fieldNum >= 100) && (fieldNum < 200) {
+				found, err := protohelpers.UnmarshalExtension(m.ProtoReflect(), dAtA[iNdEx:iNdEx+skippy], depth)
+				if err != nil {
+					return err
+				}
+				if !found && !opts.DiscardUnknown {
+					m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			} else {
+				if !opts.DiscardUnknown {
+					m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package merge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func newMessage(id int32) *MergeMessage {
+	return &MergeMessage{
+		Int32Value:    id,
+		StringValue:   "string",
+		BytesValue:    []byte("bytes"),
+		BoolValue:     true,
+		DoubleValue:   1.5,
+		EnumValue:     MergeEnum_MERGE_ONE,
+		OptionalInt32: proto.Int32(0),
+		OptionalBytes: []byte{},
+		RepeatedInt32: []int32{id, id + 1},
+		RepeatedBytes: [][]byte{[]byte("repeated")},
+		RepeatedChild: []*MergeChild{{Id: id}},
+		Int32Map:      map[string]int32{"a": id},
+		BytesMap:      map[string][]byte{"a": []byte("a")},
+		ChildMap:      map[string]*MergeChild{"a": {Id: id, Tags: []string{"a"}}},
+		Child:         &MergeChild{Id: id, Tags: []string{"child"}},
+		Wrapped:       wrapperspb.String("wrapped"),
+		Choice:        &MergeMessage_OneofChild{OneofChild: &MergeChild{Tags: []string{"oneof"}}},
+	}
+}
+
+func TestMergeVT(t *testing.T) {
+	for name, tc := range map[string]struct{ dst, src *MergeMessage }{
+		"empty src":   {dst: newMessage(1), src: &MergeMessage{}},
+		"empty dst":   {dst: &MergeMessage{}, src: newMessage(1)},
+		"both":        {dst: newMessage(1), src: newMessage(2)},
+		"zero values": {dst: newMessage(1), src: &MergeMessage{Child: &MergeChild{}, OptionalInt32: proto.Int32(0)}},
+		"maps": {
+			dst: &MergeMessage{ChildMap: map[string]*MergeChild{"a": {Id: 1}, "b": {Id: 2}}},
+			src: &MergeMessage{ChildMap: map[string]*MergeChild{"a": {Tags: []string{"a"}}, "c": {Id: 3}}},
+		},
+		"oneof switch": {
+			dst: &MergeMessage{Choice: &MergeMessage_OneofInt32{OneofInt32: 1}},
+			src: &MergeMessage{Choice: &MergeMessage_OneofBytes{OneofBytes: []byte("bytes")}},
+		},
+		"oneof merge": {
+			dst: &MergeMessage{Choice: &MergeMessage_OneofChild{OneofChild: &MergeChild{Id: 1}}},
+			src: &MergeMessage{Choice: &MergeMessage_OneofChild{OneofChild: &MergeChild{Tags: []string{"a"}}}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			expected := proto.Clone(tc.dst)
+			proto.Merge(expected, tc.src)
+
+			got := proto.Clone(tc.dst).(*MergeMessage)
+			got.MergeVT(tc.src)
+			require.True(t, proto.Equal(expected, got), "expected %v, got %v", expected, got)
+
+			got = proto.Clone(tc.dst).(*MergeMessage)
+			got.MergeGenericVT(tc.src)
+			require.True(t, proto.Equal(expected, got), "expected %v, got %v", expected, got)
+		})
+	}
+}
+
+func TestMergeVTUnknownFields(t *testing.T) {
+	src := &MergeMessage{}
+	src.ProtoReflect().SetUnknown([]byte{0xf8, 0x07, 0x01})
+	dst := &MergeMessage{}
+	dst.ProtoReflect().SetUnknown([]byte{0xf0, 0x07, 0x02})
+
+	dst.MergeVT(src)
+	require.Equal(t, []byte{0xf0, 0x07, 0x02, 0xf8, 0x07, 0x01}, []byte(dst.ProtoReflect().GetUnknown()))
+}
+
+func TestMergeVTDoesNotAlias(t *testing.T) {
+	src := newMessage(1)
+	dst := &MergeMessage{}
+	dst.MergeVT(src)
+
+	src.BytesValue[0] = 'X'
+	src.RepeatedBytes[0][0] = 'X'
+	src.RepeatedChild[0].Id = 100
+	src.BytesMap["a"][0] = 'X'
+	src.ChildMap["a"].Id = 100
+	src.Child.Tags[0] = "X"
+	src.Wrapped.Value = "X"
+	src.GetOneofChild().Tags[0] = "X"
+
+	require.True(t, proto.Equal(newMessage(1), dst))
+}
+
+func TestMergeVTExtensions(t *testing.T) {
+	dst := &MergeExtendable{Value: proto.Int32(1), Child: &MergeChild{Id: 1}}
+	proto.SetExtension(dst, E_Int32Extension, int32(1))
+	proto.SetExtension(dst, E_RepeatedExtension, []int32{1})
+	proto.SetExtension(dst, E_ChildExtension, &MergeChild{Id: 1})
+	proto.SetExtension(dst, E_RepeatedChildExtension, []*MergeChild{{Id: 1}})
+
+	src := &MergeExtendable{Data: []byte("data"), Child: &MergeChild{Tags: []string{"a"}}}
+	proto.SetExtension(src, E_Int32Extension, int32(2))
+	proto.SetExtension(src, E_BytesExtension, []byte("bytes"))
+	proto.SetExtension(src, E_RepeatedExtension, []int32{2, 3})
+	proto.SetExtension(src, E_ChildExtension, &MergeChild{Tags: []string{"b"}})
+	proto.SetExtension(src, E_RepeatedChildExtension, []*MergeChild{{Id: 2}})
+
+	expected := proto.Clone(dst)
+	proto.Merge(expected, src)
+
+	dst.MergeVT(src)
+	require.True(t, proto.Equal(expected, dst), "expected %v, got %v", expected, dst)
+
+	proto.GetExtension(src, E_ChildExtension).(*MergeChild).Tags[0] = "X"
+	require.True(t, proto.Equal(expected, dst), "expected %v, got %v", expected, dst)
+}
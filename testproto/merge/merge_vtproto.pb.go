@@ -0,0 +1,5085 @@
+// Code generated by protoc-gen-go-vtproto. DO NOT EDIT.
+// protoc-gen-go-vtproto version: (devel)
+// source: merge/merge.proto
+
+package merge
+
+import (
+	binary "encoding/binary"
+	errors "errors"
+	fmt "fmt"
+	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
+	vtproto "github.com/planetscale/vtprotobuf/vtproto"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	proto "google.golang.org/protobuf/proto"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	hash "hash"
+	fnv "hash/fnv"
+	io "io"
+	math "math"
+	bits "math/bits"
+	sort "sort"
+	strconv "strconv"
+	utf8 "unicode/utf8"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+func (m *MergeChild) CloneVT() *MergeChild {
+	if m == nil {
+		return (*MergeChild)(nil)
+	}
+	r := &MergeChild{
+		Id: m.Id,
+	}
+	if rhs := m.Tags; rhs != nil {
+		tmpContainer := make([]string, len(rhs))
+		copy(tmpContainer, rhs)
+		r.Tags = tmpContainer
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *MergeChild) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *MergeChild) CopyToVT(dst *MergeChild) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Id = m.Id
+	dst.Tags = append(dst.Tags[:0], m.Tags...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *MergeMessage) CloneVT() *MergeMessage {
+	if m == nil {
+		return (*MergeMessage)(nil)
+	}
+	r := &MergeMessage{
+		Int32Value:  m.Int32Value,
+		StringValue: m.StringValue,
+		BoolValue:   m.BoolValue,
+		DoubleValue: m.DoubleValue,
+		EnumValue:   m.EnumValue,
+		Child:       m.Child.CloneVT(),
+	}
+	if rhs := m.BytesValue; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.BytesValue = tmpBytes
+	}
+	if rhs := m.OptionalInt32; rhs != nil {
+		tmpVal := *rhs
+		r.OptionalInt32 = &tmpVal
+	}
+	if rhs := m.OptionalBytes; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.OptionalBytes = tmpBytes
+	}
+	if rhs := m.RepeatedInt32; rhs != nil {
+		tmpContainer := make([]int32, len(rhs))
+		copy(tmpContainer, rhs)
+		r.RepeatedInt32 = tmpContainer
+	}
+	if rhs := m.RepeatedBytes; rhs != nil {
+		tmpContainer := make([][]byte, len(rhs))
+		for k, v := range rhs {
+			tmpBytes := make([]byte, len(v))
+			copy(tmpBytes, v)
+			tmpContainer[k] = tmpBytes
+		}
+		r.RepeatedBytes = tmpContainer
+	}
+	if rhs := m.RepeatedChild; rhs != nil {
+		tmpContainer := make([]*MergeChild, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.RepeatedChild = tmpContainer
+	}
+	if rhs := m.Int32Map; rhs != nil {
+		tmpContainer := make(map[string]int32, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v
+		}
+		r.Int32Map = tmpContainer
+	}
+	if rhs := m.BytesMap; rhs != nil {
+		tmpContainer := make(map[string][]byte, len(rhs))
+		for k, v := range rhs {
+			tmpBytes := make([]byte, len(v))
+			copy(tmpBytes, v)
+			tmpContainer[k] = tmpBytes
+		}
+		r.BytesMap = tmpContainer
+	}
+	if rhs := m.ChildMap; rhs != nil {
+		tmpContainer := make(map[string]*MergeChild, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.ChildMap = tmpContainer
+	}
+	if rhs := m.Wrapped; rhs != nil {
+		if vtpb, ok := interface{}(rhs).(interface {
+			CloneVT() *wrapperspb.StringValue
+		}); ok {
+			r.Wrapped = vtpb.CloneVT()
+		} else {
+			r.Wrapped = proto.Clone(rhs).(*wrapperspb.StringValue)
+		}
+	}
+	if m.Choice != nil {
+		r.Choice = m.Choice.(interface{ CloneVT() isMergeMessage_Choice }).CloneVT()
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *MergeMessage) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *MergeMessage) CopyToVT(dst *MergeMessage) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Int32Value = m.Int32Value
+	dst.StringValue = m.StringValue
+	dst.BytesValue = append(dst.BytesValue[:0], m.BytesValue...)
+	dst.BoolValue = m.BoolValue
+	dst.DoubleValue = m.DoubleValue
+	dst.EnumValue = m.EnumValue
+	if m.OptionalInt32 == nil {
+		dst.OptionalInt32 = nil
+	} else {
+		if dst.OptionalInt32 == nil {
+			dst.OptionalInt32 = new(int32)
+		}
+		*dst.OptionalInt32 = *m.OptionalInt32
+	}
+	if m.OptionalBytes == nil {
+		dst.OptionalBytes = nil
+	} else if dst.OptionalBytes == nil {
+		dst.OptionalBytes = append([]byte{}, m.OptionalBytes...)
+	} else {
+		dst.OptionalBytes = append(dst.OptionalBytes[:0], m.OptionalBytes...)
+	}
+	dst.RepeatedInt32 = append(dst.RepeatedInt32[:0], m.RepeatedInt32...)
+	if len(m.RepeatedBytes) > cap(dst.RepeatedBytes) {
+		tmpContainer := make([][]byte, len(m.RepeatedBytes))
+		copy(tmpContainer, dst.RepeatedBytes[:cap(dst.RepeatedBytes)])
+		dst.RepeatedBytes = tmpContainer
+	} else {
+		dst.RepeatedBytes = dst.RepeatedBytes[:len(m.RepeatedBytes)]
+	}
+	for k, v := range m.RepeatedBytes {
+		dst.RepeatedBytes[k] = append(dst.RepeatedBytes[k][:0], v...)
+	}
+	if len(m.RepeatedChild) > cap(dst.RepeatedChild) {
+		tmpContainer := make([]*MergeChild, len(m.RepeatedChild))
+		copy(tmpContainer, dst.RepeatedChild[:cap(dst.RepeatedChild)])
+		dst.RepeatedChild = tmpContainer
+	} else {
+		dst.RepeatedChild = dst.RepeatedChild[:len(m.RepeatedChild)]
+	}
+	for k, v := range m.RepeatedChild {
+		if dst.RepeatedChild[k] == nil {
+			dst.RepeatedChild[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.RepeatedChild[k])
+		}
+	}
+	if dst.Int32Map == nil && len(m.Int32Map) > 0 {
+		dst.Int32Map = make(map[string]int32, len(m.Int32Map))
+	}
+	for k := range dst.Int32Map {
+		if _, ok := m.Int32Map[k]; !ok {
+			delete(dst.Int32Map, k)
+		}
+	}
+	for k, v := range m.Int32Map {
+		dst.Int32Map[k] = v
+	}
+	if dst.BytesMap == nil && len(m.BytesMap) > 0 {
+		dst.BytesMap = make(map[string][]byte, len(m.BytesMap))
+	}
+	for k := range dst.BytesMap {
+		if _, ok := m.BytesMap[k]; !ok {
+			delete(dst.BytesMap, k)
+		}
+	}
+	for k, v := range m.BytesMap {
+		dst.BytesMap[k] = append(dst.BytesMap[k][:0], v...)
+	}
+	if dst.ChildMap == nil && len(m.ChildMap) > 0 {
+		dst.ChildMap = make(map[string]*MergeChild, len(m.ChildMap))
+	}
+	for k := range dst.ChildMap {
+		if _, ok := m.ChildMap[k]; !ok {
+			delete(dst.ChildMap, k)
+		}
+	}
+	for k, v := range m.ChildMap {
+		if dst.ChildMap[k] == nil {
+			dst.ChildMap[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.ChildMap[k])
+		}
+	}
+	if m.Child == nil {
+		dst.Child = nil
+	} else {
+		if dst.Child == nil {
+			dst.Child = m.Child.CloneVT()
+		} else {
+			m.Child.CopyToVT(dst.Child)
+		}
+	}
+	if m.Wrapped == nil {
+		dst.Wrapped = nil
+	} else {
+		if dst.Wrapped == nil {
+			if vtpb, ok := interface{}(m.Wrapped).(interface {
+				CloneVT() *wrapperspb.StringValue
+			}); ok {
+				dst.Wrapped = vtpb.CloneVT()
+			} else {
+				dst.Wrapped = proto.Clone(m.Wrapped).(*wrapperspb.StringValue)
+			}
+		} else {
+			if vtpb, ok := interface{}(m.Wrapped).(interface{ CopyToVT(*wrapperspb.StringValue) }); ok {
+				vtpb.CopyToVT(dst.Wrapped)
+			} else {
+				proto.Reset(dst.Wrapped)
+				proto.Merge(dst.Wrapped, m.Wrapped)
+			}
+		}
+	}
+	switch c := m.Choice.(type) {
+	case *MergeMessage_OneofInt32:
+		if d, ok := dst.Choice.(*MergeMessage_OneofInt32); ok {
+			d.OneofInt32 = c.OneofInt32
+		} else {
+			dst.Choice = c.CloneVT()
+		}
+	case *MergeMessage_OneofBytes:
+		if d, ok := dst.Choice.(*MergeMessage_OneofBytes); ok {
+			d.OneofBytes = append(d.OneofBytes[:0], c.OneofBytes...)
+		} else {
+			dst.Choice = c.CloneVT()
+		}
+	case *MergeMessage_OneofChild:
+		if d, ok := dst.Choice.(*MergeMessage_OneofChild); ok {
+			if d.OneofChild == nil {
+				d.OneofChild = c.OneofChild.CloneVT()
+			} else {
+				c.OneofChild.CopyToVT(d.OneofChild)
+			}
+		} else {
+			dst.Choice = c.CloneVT()
+		}
+	default:
+		dst.Choice = nil
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *MergeMessage_OneofInt32) CloneVT() isMergeMessage_Choice {
+	if m == nil {
+		return (*MergeMessage_OneofInt32)(nil)
+	}
+	r := &MergeMessage_OneofInt32{
+		OneofInt32: m.OneofInt32,
+	}
+	return r
+}
+
+func (m *MergeMessage_OneofBytes) CloneVT() isMergeMessage_Choice {
+	if m == nil {
+		return (*MergeMessage_OneofBytes)(nil)
+	}
+	r := &MergeMessage_OneofBytes{}
+	if rhs := m.OneofBytes; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.OneofBytes = tmpBytes
+	}
+	return r
+}
+
+func (m *MergeMessage_OneofChild) CloneVT() isMergeMessage_Choice {
+	if m == nil {
+		return (*MergeMessage_OneofChild)(nil)
+	}
+	r := &MergeMessage_OneofChild{
+		OneofChild: m.OneofChild.CloneVT(),
+	}
+	return r
+}
+
+func (this *MergeChild) DiffVT(that *MergeChild) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if this.Id != that.Id {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "id", Kind: vtproto.FieldChanged, Old: this.Id, New: that.Id})
+	}
+	for i := 0; i < len(this.Tags) && i < len(that.Tags); i++ {
+		if this.Tags[i] != that.Tags[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "tags[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.Tags[i], New: that.Tags[i]})
+		}
+	}
+	for i := len(that.Tags); i < len(this.Tags); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "tags[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.Tags[i]})
+	}
+	for i := len(this.Tags); i < len(that.Tags); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "tags[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.Tags[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *MergeMessage) DiffVT(that *MergeMessage) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if this.Int32Value != that.Int32Value {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "int32_value", Kind: vtproto.FieldChanged, Old: this.Int32Value, New: that.Int32Value})
+	}
+	if this.StringValue != that.StringValue {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "string_value", Kind: vtproto.FieldChanged, Old: this.StringValue, New: that.StringValue})
+	}
+	if string(this.BytesValue) != string(that.BytesValue) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "bytes_value", Kind: vtproto.FieldChanged, Old: this.BytesValue, New: that.BytesValue})
+	}
+	if this.BoolValue != that.BoolValue {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "bool_value", Kind: vtproto.FieldChanged, Old: this.BoolValue, New: that.BoolValue})
+	}
+	if this.DoubleValue != that.DoubleValue {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "double_value", Kind: vtproto.FieldChanged, Old: this.DoubleValue, New: that.DoubleValue})
+	}
+	if this.EnumValue != that.EnumValue {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "enum_value", Kind: vtproto.FieldChanged, Old: this.EnumValue, New: that.EnumValue})
+	}
+	if p, q := this.OptionalInt32, that.OptionalInt32; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int32", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int32", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int32", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalBytes, that.OptionalBytes; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bytes", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bytes", Kind: vtproto.FieldRemoved, Old: p})
+	} else if string(p) != string(q) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bytes", Kind: vtproto.FieldChanged, Old: p, New: q})
+	}
+	for i := 0; i < len(this.RepeatedInt32) && i < len(that.RepeatedInt32); i++ {
+		if this.RepeatedInt32[i] != that.RepeatedInt32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedInt32[i], New: that.RepeatedInt32[i]})
+		}
+	}
+	for i := len(that.RepeatedInt32); i < len(this.RepeatedInt32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedInt32[i]})
+	}
+	for i := len(this.RepeatedInt32); i < len(that.RepeatedInt32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_int32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedInt32[i]})
+	}
+	for i := 0; i < len(this.RepeatedBytes) && i < len(that.RepeatedBytes); i++ {
+		if string(this.RepeatedBytes[i]) != string(that.RepeatedBytes[i]) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_bytes[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedBytes[i], New: that.RepeatedBytes[i]})
+		}
+	}
+	for i := len(that.RepeatedBytes); i < len(this.RepeatedBytes); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_bytes[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedBytes[i]})
+	}
+	for i := len(this.RepeatedBytes); i < len(that.RepeatedBytes); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_bytes[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedBytes[i]})
+	}
+	for i := 0; i < len(this.RepeatedChild) && i < len(that.RepeatedChild); i++ {
+		if p, q := this.RepeatedChild[i], that.RepeatedChild[i]; p != q {
+			if p == nil {
+				p = &MergeChild{}
+			}
+			if q == nil {
+				q = &MergeChild{}
+			}
+			if nested := p.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "repeated_child["+strconv.Itoa(i)+"]", nested)
+			}
+		}
+	}
+	for i := len(that.RepeatedChild); i < len(this.RepeatedChild); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_child[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedChild[i]})
+	}
+	for i := len(this.RepeatedChild); i < len(that.RepeatedChild); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_child[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedChild[i]})
+	}
+	if len(this.Int32Map) > 0 || len(that.Int32Map) > 0 {
+		keys := make([]string, 0, len(this.Int32Map)+len(that.Int32Map))
+		for k := range this.Int32Map {
+			keys = append(keys, k)
+		}
+		for k := range that.Int32Map {
+			if _, ok := this.Int32Map[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.Int32Map[k]
+			vy, yok := that.Int32Map[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "int32_map[" + strconv.Quote(k) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "int32_map[" + strconv.Quote(k) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "int32_map[" + strconv.Quote(k) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
+	}
+	if len(this.BytesMap) > 0 || len(that.BytesMap) > 0 {
+		keys := make([]string, 0, len(this.BytesMap)+len(that.BytesMap))
+		for k := range this.BytesMap {
+			keys = append(keys, k)
+		}
+		for k := range that.BytesMap {
+			if _, ok := this.BytesMap[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.BytesMap[k]
+			vy, yok := that.BytesMap[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "bytes_map[" + strconv.Quote(k) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "bytes_map[" + strconv.Quote(k) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if string(vx) != string(vy) {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "bytes_map[" + strconv.Quote(k) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
+	}
+	if len(this.ChildMap) > 0 || len(that.ChildMap) > 0 {
+		keys := make([]string, 0, len(this.ChildMap)+len(that.ChildMap))
+		for k := range this.ChildMap {
+			keys = append(keys, k)
+		}
+		for k := range that.ChildMap {
+			if _, ok := this.ChildMap[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.ChildMap[k]
+			vy, yok := that.ChildMap[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "child_map[" + strconv.Quote(k) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "child_map[" + strconv.Quote(k) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if p, q := vx, vy; p != q {
+					if p == nil {
+						p = &MergeChild{}
+					}
+					if q == nil {
+						q = &MergeChild{}
+					}
+					if nested := p.DiffVT(q); len(nested) > 0 {
+						diffs = protohelpers.AppendDiffs(diffs, "child_map["+strconv.Quote(k)+"]", nested)
+					}
+				}
+			}
+		}
+	}
+	if p, q := this.Child, that.Child; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "child", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "child", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if nested := p.DiffVT(q); len(nested) > 0 {
+			diffs = protohelpers.AppendDiffs(diffs, "child", nested)
+		}
+	}
+	if p, q := this.Wrapped, that.Wrapped; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "wrapped", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "wrapped", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if vtpb, ok := interface{}(p).(interface {
+			DiffVT(*wrapperspb.StringValue) []vtproto.FieldDiff
+		}); ok {
+			if nested := vtpb.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "wrapped", nested)
+			}
+		} else if !proto.Equal(p, q) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "wrapped", Kind: vtproto.FieldChanged, Old: p, New: q})
+		}
+	}
+	if x, ok := this.Choice.(*MergeMessage_OneofInt32); ok {
+		if y, ok := that.Choice.(*MergeMessage_OneofInt32); ok {
+			if x.OneofInt32 != y.OneofInt32 {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_int32", Kind: vtproto.FieldChanged, Old: x.OneofInt32, New: y.OneofInt32})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_int32", Kind: vtproto.FieldRemoved, Old: x.OneofInt32})
+		}
+	} else if y, ok := that.Choice.(*MergeMessage_OneofInt32); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_int32", Kind: vtproto.FieldAdded, New: y.OneofInt32})
+	}
+	if x, ok := this.Choice.(*MergeMessage_OneofBytes); ok {
+		if y, ok := that.Choice.(*MergeMessage_OneofBytes); ok {
+			if string(x.OneofBytes) != string(y.OneofBytes) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_bytes", Kind: vtproto.FieldChanged, Old: x.OneofBytes, New: y.OneofBytes})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_bytes", Kind: vtproto.FieldRemoved, Old: x.OneofBytes})
+		}
+	} else if y, ok := that.Choice.(*MergeMessage_OneofBytes); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_bytes", Kind: vtproto.FieldAdded, New: y.OneofBytes})
+	}
+	if x, ok := this.Choice.(*MergeMessage_OneofChild); ok {
+		if y, ok := that.Choice.(*MergeMessage_OneofChild); ok {
+			if p, q := x.OneofChild, y.OneofChild; p != q {
+				if p == nil {
+					p = &MergeChild{}
+				}
+				if q == nil {
+					q = &MergeChild{}
+				}
+				if nested := p.DiffVT(q); len(nested) > 0 {
+					diffs = protohelpers.AppendDiffs(diffs, "oneof_child", nested)
+				}
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_child", Kind: vtproto.FieldRemoved, Old: x.OneofChild})
+		}
+	} else if y, ok := that.Choice.(*MergeMessage_OneofChild); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_child", Kind: vtproto.FieldAdded, New: y.OneofChild})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *MergeChild) EqualVT(that *MergeChild) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *MergeChild) EqualVTWithOptions(that *MergeChild, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	if this.Id != that.Id {
+		return false
+	}
+	if len(this.Tags) != len(that.Tags) {
+		return false
+	}
+	for i, vx := range this.Tags {
+		vy := that.Tags[i]
+		if vx != vy {
+			return false
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *MergeChild) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*MergeChild)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *MergeMessage) EqualVT(that *MergeMessage) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *MergeMessage) EqualVTWithOptions(that *MergeMessage, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	if this.Choice == nil && that.Choice != nil {
+		return false
+	} else if this.Choice != nil {
+		if that.Choice == nil {
+			return false
+		}
+		if !this.Choice.(interface {
+			EqualVTWithOptions(isMergeMessage_Choice, vtproto.EqualOptions) bool
+		}).EqualVTWithOptions(that.Choice, opts) {
+			return false
+		}
+	}
+	if this.Int32Value != that.Int32Value {
+		return false
+	}
+	if this.StringValue != that.StringValue {
+		return false
+	}
+	if string(this.BytesValue) != string(that.BytesValue) {
+		return false
+	}
+	if this.BoolValue != that.BoolValue {
+		return false
+	}
+	if !opts.EqualFloat64(this.DoubleValue, that.DoubleValue) {
+		return false
+	}
+	if this.EnumValue != that.EnumValue {
+		return false
+	}
+	if p, q := this.OptionalInt32, that.OptionalInt32; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if p, q := this.OptionalBytes, that.OptionalBytes; (p == nil && q != nil) || (p != nil && q == nil) || string(p) != string(q) {
+		return false
+	}
+	if len(this.RepeatedInt32) != len(that.RepeatedInt32) {
+		return false
+	}
+	for i, vx := range this.RepeatedInt32 {
+		vy := that.RepeatedInt32[i]
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.RepeatedBytes) != len(that.RepeatedBytes) {
+		return false
+	}
+	for i, vx := range this.RepeatedBytes {
+		vy := that.RepeatedBytes[i]
+		if string(vx) != string(vy) {
+			return false
+		}
+	}
+	if len(this.RepeatedChild) != len(that.RepeatedChild) {
+		return false
+	}
+	for i, vx := range this.RepeatedChild {
+		vy := that.RepeatedChild[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &MergeChild{}
+			}
+			if q == nil {
+				q = &MergeChild{}
+			}
+			if !p.EqualVTWithOptions(q, opts) {
+				return false
+			}
+		}
+	}
+	if len(this.Int32Map) != len(that.Int32Map) {
+		return false
+	}
+	for i, vx := range this.Int32Map {
+		vy, ok := that.Int32Map[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.BytesMap) != len(that.BytesMap) {
+		return false
+	}
+	for i, vx := range this.BytesMap {
+		vy, ok := that.BytesMap[i]
+		if !ok {
+			return false
+		}
+		if string(vx) != string(vy) {
+			return false
+		}
+	}
+	if len(this.ChildMap) != len(that.ChildMap) {
+		return false
+	}
+	for i, vx := range this.ChildMap {
+		vy, ok := that.ChildMap[i]
+		if !ok {
+			return false
+		}
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &MergeChild{}
+			}
+			if q == nil {
+				q = &MergeChild{}
+			}
+			if !p.EqualVTWithOptions(q, opts) {
+				return false
+			}
+		}
+	}
+	if !this.Child.EqualVTWithOptions(that.Child, opts) {
+		return false
+	}
+	if equal, ok := interface{}(this.Wrapped).(interface {
+		EqualVTWithOptions(*wrapperspb.StringValue, vtproto.EqualOptions) bool
+	}); ok {
+		if !equal.EqualVTWithOptions(that.Wrapped, opts) {
+			return false
+		}
+	} else if equal, ok := interface{}(this.Wrapped).(interface {
+		EqualVT(*wrapperspb.StringValue) bool
+	}); ok {
+		if !equal.EqualVT(that.Wrapped) {
+			return false
+		}
+	} else if !proto.Equal(this.Wrapped, that.Wrapped) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *MergeMessage) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*MergeMessage)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *MergeMessage_OneofInt32) EqualVT(thatIface isMergeMessage_Choice) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *MergeMessage_OneofInt32) EqualVTWithOptions(thatIface isMergeMessage_Choice, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*MergeMessage_OneofInt32)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.OneofInt32 != that.OneofInt32 {
+		return false
+	}
+	return true
+}
+
+func (this *MergeMessage_OneofBytes) EqualVT(thatIface isMergeMessage_Choice) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *MergeMessage_OneofBytes) EqualVTWithOptions(thatIface isMergeMessage_Choice, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*MergeMessage_OneofBytes)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if string(this.OneofBytes) != string(that.OneofBytes) {
+		return false
+	}
+	return true
+}
+
+func (this *MergeMessage_OneofChild) EqualVT(thatIface isMergeMessage_Choice) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *MergeMessage_OneofChild) EqualVTWithOptions(thatIface isMergeMessage_Choice, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*MergeMessage_OneofChild)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if p, q := this.OneofChild, that.OneofChild; p != q {
+		if p == nil {
+			p = &MergeChild{}
+		}
+		if q == nil {
+			q = &MergeChild{}
+		}
+		if !p.EqualVTWithOptions(q, opts) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *MergeChild) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *MergeChild) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	if m.Id != 0 {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(m.Id))
+	}
+	if len(m.Tags) > 0 {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashUint64(h, s, uint64(len(m.Tags)))
+		for _, v := range m.Tags {
+			protohelpers.HashString(h, s, v)
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *MergeMessage) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *MergeMessage) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	var entry hash.Hash64
+	if m.Int32Value != 0 {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(m.Int32Value))
+	}
+	if m.StringValue != "" {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashString(h, s, m.StringValue)
+	}
+	if len(m.BytesValue) > 0 {
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashBytes(h, s, m.BytesValue)
+	}
+	if m.BoolValue {
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashBool(h, s, m.BoolValue)
+	}
+	if m.DoubleValue != 0 {
+		protohelpers.HashUint64(h, s, 5)
+		protohelpers.HashFloat64(h, s, m.DoubleValue)
+	}
+	if m.EnumValue != 0 {
+		protohelpers.HashUint64(h, s, 6)
+		protohelpers.HashUint64(h, s, uint64(m.EnumValue))
+	}
+	if m.OptionalInt32 != nil {
+		protohelpers.HashUint64(h, s, 11)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalInt32))
+	}
+	if m.OptionalBytes != nil {
+		protohelpers.HashUint64(h, s, 12)
+		protohelpers.HashBytes(h, s, m.OptionalBytes)
+	}
+	if len(m.RepeatedInt32) > 0 {
+		protohelpers.HashUint64(h, s, 21)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedInt32)))
+		for _, v := range m.RepeatedInt32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.RepeatedBytes) > 0 {
+		protohelpers.HashUint64(h, s, 22)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedBytes)))
+		for _, v := range m.RepeatedBytes {
+			protohelpers.HashBytes(h, s, v)
+		}
+	}
+	if len(m.RepeatedChild) > 0 {
+		protohelpers.HashUint64(h, s, 23)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedChild)))
+		for _, v := range m.RepeatedChild {
+			v.hashVT(h, s)
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if len(m.Int32Map) > 0 {
+		protohelpers.HashUint64(h, s, 31)
+		protohelpers.HashUint64(h, s, uint64(len(m.Int32Map)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.Int32Map {
+			entry.Reset()
+			protohelpers.HashString(entry, s, k)
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.BytesMap) > 0 {
+		protohelpers.HashUint64(h, s, 32)
+		protohelpers.HashUint64(h, s, uint64(len(m.BytesMap)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.BytesMap {
+			entry.Reset()
+			protohelpers.HashString(entry, s, k)
+			protohelpers.HashBytes(entry, s, v)
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if len(m.ChildMap) > 0 {
+		protohelpers.HashUint64(h, s, 33)
+		protohelpers.HashUint64(h, s, uint64(len(m.ChildMap)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.ChildMap {
+			entry.Reset()
+			protohelpers.HashString(entry, s, k)
+			v.hashVT(entry, s)
+			protohelpers.HashUint64(entry, s, 0)
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if m.Child != nil {
+		protohelpers.HashUint64(h, s, 41)
+		m.Child.hashVT(h, s)
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.Wrapped != nil {
+		protohelpers.HashUint64(h, s, 42)
+		if vtpb, ok := interface{}(m.Wrapped).(interface{ HashVT(hash.Hash64) }); ok {
+			vtpb.HashVT(h)
+		} else {
+			protohelpers.HashMessage(h, m.Wrapped)
+		}
+		protohelpers.HashUint64(h, s, 0)
+	}
+	switch c := m.Choice.(type) {
+	case *MergeMessage_OneofInt32:
+		protohelpers.HashUint64(h, s, 51)
+		protohelpers.HashUint64(h, s, uint64(c.OneofInt32))
+	case *MergeMessage_OneofBytes:
+		protohelpers.HashUint64(h, s, 52)
+		protohelpers.HashBytes(h, s, c.OneofBytes)
+	case *MergeMessage_OneofChild:
+		protohelpers.HashUint64(h, s, 53)
+		c.OneofChild.hashVT(h, s)
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *MergeChild) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *MergeChild) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &MergeChild{}
+	}
+	w.ObjectStart()
+	if m.Id != 0 {
+		w.Field("id", "id")
+		w.Int32(m.Id)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("id", "id")
+		w.Int32(m.GetId())
+	}
+	if len(m.Tags) > 0 {
+		w.Field("tags", "tags")
+		w.ArrayStart()
+		for _, v := range m.Tags {
+			if err := w.String(v); err != nil {
+				return errors.New("proto: field MergeChild.tags contains invalid UTF-8")
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("tags", "tags")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *MergeChild) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *MergeChild) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [2]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "id":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Id = v
+		case "tags":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadString()
+				if err != nil {
+					return err
+				}
+				m.Tags = append(m.Tags, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *MergeMessage) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *MergeMessage) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &MergeMessage{}
+	}
+	w.ObjectStart()
+	if m.Int32Value != 0 {
+		w.Field("int32Value", "int32_value")
+		w.Int32(m.Int32Value)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("int32Value", "int32_value")
+		w.Int32(m.GetInt32Value())
+	}
+	if m.StringValue != "" {
+		w.Field("stringValue", "string_value")
+		if err := w.String(m.StringValue); err != nil {
+			return errors.New("proto: field MergeMessage.string_value contains invalid UTF-8")
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("stringValue", "string_value")
+		if err := w.String(m.GetStringValue()); err != nil {
+			return errors.New("proto: field MergeMessage.string_value contains invalid UTF-8")
+		}
+	}
+	if len(m.BytesValue) > 0 {
+		w.Field("bytesValue", "bytes_value")
+		w.Base64(m.BytesValue)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("bytesValue", "bytes_value")
+		w.Base64(m.GetBytesValue())
+	}
+	if m.BoolValue {
+		w.Field("boolValue", "bool_value")
+		w.Bool(m.BoolValue)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("boolValue", "bool_value")
+		w.Bool(m.GetBoolValue())
+	}
+	if m.DoubleValue != 0 || math.Signbit(float64(m.DoubleValue)) {
+		w.Field("doubleValue", "double_value")
+		w.Float64(m.DoubleValue)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("doubleValue", "double_value")
+		w.Float64(m.GetDoubleValue())
+	}
+	if m.EnumValue != 0 {
+		w.Field("enumValue", "enum_value")
+		w.Enum(int32(m.EnumValue), MergeEnum_name)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("enumValue", "enum_value")
+		w.Enum(int32(m.GetEnumValue()), MergeEnum_name)
+	}
+	if m.OptionalInt32 != nil {
+		w.Field("optionalInt32", "optional_int32")
+		w.Int32(*m.OptionalInt32)
+	}
+	if m.OptionalBytes != nil {
+		w.Field("optionalBytes", "optional_bytes")
+		w.Base64(m.OptionalBytes)
+	}
+	if len(m.RepeatedInt32) > 0 {
+		w.Field("repeatedInt32", "repeated_int32")
+		w.ArrayStart()
+		for _, v := range m.RepeatedInt32 {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedInt32", "repeated_int32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedBytes) > 0 {
+		w.Field("repeatedBytes", "repeated_bytes")
+		w.ArrayStart()
+		for _, v := range m.RepeatedBytes {
+			w.Base64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedBytes", "repeated_bytes")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.RepeatedChild) > 0 {
+		w.Field("repeatedChild", "repeated_child")
+		w.ArrayStart()
+		for _, v := range m.RepeatedChild {
+			if err := v.MarshalJSONToVT(w); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedChild", "repeated_child")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.Int32Map) > 0 {
+		w.Field("int32Map", "int32_map")
+		keys := make([]string, 0, len(m.Int32Map))
+		for k := range m.Int32Map {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		w.ObjectStart()
+		for _, k := range keys {
+			if err := w.Name(k); err != nil {
+				return errors.New("proto: field MergeMessage.Int32MapEntry.key contains invalid UTF-8")
+			}
+			w.Int32(m.Int32Map[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("int32Map", "int32_map")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.BytesMap) > 0 {
+		w.Field("bytesMap", "bytes_map")
+		keys := make([]string, 0, len(m.BytesMap))
+		for k := range m.BytesMap {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		w.ObjectStart()
+		for _, k := range keys {
+			if err := w.Name(k); err != nil {
+				return errors.New("proto: field MergeMessage.BytesMapEntry.key contains invalid UTF-8")
+			}
+			w.Base64(m.BytesMap[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("bytesMap", "bytes_map")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if len(m.ChildMap) > 0 {
+		w.Field("childMap", "child_map")
+		keys := make([]string, 0, len(m.ChildMap))
+		for k := range m.ChildMap {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		w.ObjectStart()
+		for _, k := range keys {
+			if err := w.Name(k); err != nil {
+				return errors.New("proto: field MergeMessage.ChildMapEntry.key contains invalid UTF-8")
+			}
+			if err := m.ChildMap[k].MarshalJSONToVT(w); err != nil {
+				return err
+			}
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("childMap", "child_map")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if m.Child != nil {
+		w.Field("child", "child")
+		if err := m.Child.MarshalJSONToVT(w); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("child", "child")
+		w.Null()
+	}
+	if m.Wrapped != nil {
+		w.Field("wrapped", "wrapped")
+		if err := w.Message(m.Wrapped); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("wrapped", "wrapped")
+		w.Null()
+	}
+	if c, ok := m.Choice.(*MergeMessage_OneofInt32); ok {
+		w.Field("oneofInt32", "oneof_int32")
+		w.Int32(c.OneofInt32)
+	}
+	if c, ok := m.Choice.(*MergeMessage_OneofBytes); ok {
+		w.Field("oneofBytes", "oneof_bytes")
+		w.Base64(c.OneofBytes)
+	}
+	if c, ok := m.Choice.(*MergeMessage_OneofChild); ok {
+		w.Field("oneofChild", "oneof_child")
+		if err := c.OneofChild.MarshalJSONToVT(w); err != nil {
+			return err
+		}
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *MergeMessage) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *MergeMessage) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [22]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "int32Value", "int32_value":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Int32Value = v
+		case "stringValue", "string_value":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.StringValue = v
+		case "bytesValue", "bytes_value":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadBytes()
+			if err != nil {
+				return err
+			}
+			m.BytesValue = v
+		case "boolValue", "bool_value":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadBool()
+			if err != nil {
+				return err
+			}
+			m.BoolValue = v
+		case "doubleValue", "double_value":
+			if seen[4] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[4] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadFloat64()
+			if err != nil {
+				return err
+			}
+			m.DoubleValue = v
+		case "enumValue", "enum_value":
+			if seen[5] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[5] = true
+			if r.SkipNull() {
+				continue
+			}
+			e, ok, err := r.ReadEnum(MergeEnum_value)
+			if err != nil {
+				return err
+			}
+			if ok {
+				v := MergeEnum(e)
+				m.EnumValue = v
+			}
+		case "optionalInt32", "optional_int32":
+			if seen[6] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[6] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.OptionalInt32 = &v
+		case "optionalBytes", "optional_bytes":
+			if seen[7] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[7] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadBytes()
+			if err != nil {
+				return err
+			}
+			m.OptionalBytes = v
+		case "repeatedInt32", "repeated_int32":
+			if seen[8] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[8] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.RepeatedInt32 = append(m.RepeatedInt32, v)
+			}
+		case "repeatedBytes", "repeated_bytes":
+			if seen[9] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[9] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadBytes()
+				if err != nil {
+					return err
+				}
+				m.RepeatedBytes = append(m.RepeatedBytes, v)
+			}
+		case "repeatedChild", "repeated_child":
+			if seen[10] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[10] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(MergeChild)
+				if err := v.UnmarshalJSONFromVT(r); err != nil {
+					return err
+				}
+				m.RepeatedChild = append(m.RepeatedChild, v)
+			}
+		case "int32Map", "int32_map":
+			if seen[11] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[11] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.Int32Map == nil {
+				m.Int32Map = make(map[string]int32)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k := name
+				if _, ok := m.Int32Map[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.Int32Map[k] = v
+			}
+		case "bytesMap", "bytes_map":
+			if seen[12] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[12] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.BytesMap == nil {
+				m.BytesMap = make(map[string][]byte)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k := name
+				if _, ok := m.BytesMap[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadBytes()
+				if err != nil {
+					return err
+				}
+				m.BytesMap[k] = v
+			}
+		case "childMap", "child_map":
+			if seen[13] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[13] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.ChildMap == nil {
+				m.ChildMap = make(map[string]*MergeChild)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k := name
+				if _, ok := m.ChildMap[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v := new(MergeChild)
+				if err := v.UnmarshalJSONFromVT(r); err != nil {
+					return err
+				}
+				m.ChildMap[k] = v
+			}
+		case "child":
+			if seen[14] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[14] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(MergeChild)
+			if err := v.UnmarshalJSONFromVT(r); err != nil {
+				return err
+			}
+			m.Child = v
+		case "wrapped":
+			if seen[15] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[15] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(wrapperspb.StringValue)
+			if err := r.ReadMessage(v); err != nil {
+				return err
+			}
+			m.Wrapped = v
+		case "oneofInt32", "oneof_int32":
+			if seen[16] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[16] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[19] {
+				return r.Errorf("oneof MergeMessage.choice is already set")
+			}
+			seen[19] = true
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Choice = &MergeMessage_OneofInt32{OneofInt32: v}
+		case "oneofBytes", "oneof_bytes":
+			if seen[17] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[17] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[19] {
+				return r.Errorf("oneof MergeMessage.choice is already set")
+			}
+			seen[19] = true
+			v, err := r.ReadBytes()
+			if err != nil {
+				return err
+			}
+			m.Choice = &MergeMessage_OneofBytes{OneofBytes: v}
+		case "oneofChild", "oneof_child":
+			if seen[18] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[18] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[19] {
+				return r.Errorf("oneof MergeMessage.choice is already set")
+			}
+			seen[19] = true
+			v := new(MergeChild)
+			if err := v.UnmarshalJSONFromVT(r); err != nil {
+				return err
+			}
+			m.Choice = &MergeMessage_OneofChild{OneofChild: v}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *MergeChild) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *MergeChild) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *MergeChild) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *MergeChild) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *MergeChild) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Id))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeMessage) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *MergeMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *MergeMessage) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *MergeMessage) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *MergeMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if vtmsg, ok := m.Choice.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if m.Wrapped != nil {
+		if vtmsg, ok := interface{}(m.Wrapped).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		} else {
+			encoded, err := proto.Marshal(m.Wrapped)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xd2
+		dAtA[i+1] = 0x2
+	}
+	if m.Child != nil {
+		size, err := m.Child.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xca
+		dAtA[i+1] = 0x2
+	}
+	if len(m.ChildMap) > 0 {
+		for k := range m.ChildMap {
+			v := m.ChildMap[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+			i -= len(k)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8a
+			dAtA[i+1] = 0x2
+		}
+	}
+	if len(m.BytesMap) > 0 {
+		for k := range m.BytesMap {
+			v := m.BytesMap[k]
+			baseI := i
+			i -= len(v)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+			i -= len(k)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x82
+			dAtA[i+1] = 0x2
+		}
+	}
+	if len(m.Int32Map) > 0 {
+		for k := range m.Int32Map {
+			v := m.Int32Map[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i -= len(k)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xfa
+			dAtA[i+1] = 0x1
+		}
+	}
+	if len(m.RepeatedChild) > 0 {
+		for iNdEx := len(m.RepeatedChild) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.RepeatedChild[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xba
+			dAtA[i+1] = 0x1
+		}
+	}
+	if len(m.RepeatedBytes) > 0 {
+		for iNdEx := len(m.RepeatedBytes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RepeatedBytes[iNdEx])
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], m.RepeatedBytes[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.RepeatedBytes[iNdEx])))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i -= 2
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xb2
+			dAtA[i+1] = 0x1
+		}
+	}
+	if len(m.RepeatedInt32) > 0 {
+		var pksize2 int
+		for _, num := range m.RepeatedInt32 {
+			pksize2 += sov(uint64(num))
+		}
+		i -= pksize2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j1 := i
+		for _, num1 := range m.RepeatedInt32 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA[j1] = uint8(num)
+			j1++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize2))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xaa
+		dAtA[i+1] = 0x1
+	}
+	if m.OptionalBytes != nil {
+		i -= len(m.OptionalBytes)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.OptionalBytes)
+		i = encodeVarint(dAtA, i, uint64(len(m.OptionalBytes)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x62
+	}
+	if m.OptionalInt32 != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.OptionalInt32))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x58
+	}
+	if m.EnumValue != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.EnumValue))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x30
+	}
+	if m.DoubleValue != 0 {
+		i -= 8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.DoubleValue))))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x29
+	}
+	if m.BoolValue {
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		if m.BoolValue {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x20
+	}
+	if len(m.BytesValue) > 0 {
+		i -= len(m.BytesValue)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.BytesValue)
+		i = encodeVarint(dAtA, i, uint64(len(m.BytesValue)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x1a
+	}
+	if len(m.StringValue) > 0 {
+		i -= len(m.StringValue)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.StringValue)
+		i = encodeVarint(dAtA, i, uint64(len(m.StringValue)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x12
+	}
+	if m.Int32Value != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Int32Value))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MergeMessage_OneofInt32) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *MergeMessage_OneofInt32) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *MergeMessage_OneofInt32) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarint(dAtA, i, uint64(m.OneofInt32))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i -= 2
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0x98
+	dAtA[i+1] = 0x3
+	return len(dAtA) - i, nil
+}
+func (m *MergeMessage_OneofBytes) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *MergeMessage_OneofBytes) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *MergeMessage_OneofBytes) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.OneofBytes)
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	copy(dAtA[i:], m.OneofBytes)
+	i = encodeVarint(dAtA, i, uint64(len(m.OneofBytes)))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i -= 2
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0xa2
+	dAtA[i+1] = 0x3
+	return len(dAtA) - i, nil
+}
+func (m *MergeMessage_OneofChild) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *MergeMessage_OneofChild) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *MergeMessage_OneofChild) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OneofChild != nil {
+		size, err := m.OneofChild.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xaa
+		dAtA[i+1] = 0x3
+	}
+	return len(dAtA) - i, nil
+}
+
+var (
+	ErrBufferTooSmall = fmt.Errorf("proto: buffer too small to marshal the message")
+	ErrSizeMismatch   = fmt.Errorf("proto: message size changed while marshaling")
+)
+
+func encodeVarint(dAtA []byte, offset int, v uint64) int {
+	offset -= sov(v)
+	if offset < 0 {
+		return offset
+	}
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MergeChild) MergeVT(src *MergeChild) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Id != 0 {
+		m.Id = src.Id
+	}
+	m.Tags = append(m.Tags, src.Tags...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *MergeChild) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*MergeChild); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *MergeMessage) MergeVT(src *MergeMessage) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Int32Value != 0 {
+		m.Int32Value = src.Int32Value
+	}
+	if src.StringValue != "" {
+		m.StringValue = src.StringValue
+	}
+	if len(src.BytesValue) > 0 {
+		m.BytesValue = append([]byte{}, src.BytesValue...)
+	}
+	if src.BoolValue {
+		m.BoolValue = true
+	}
+	if src.DoubleValue != 0 {
+		m.DoubleValue = src.DoubleValue
+	}
+	if src.EnumValue != 0 {
+		m.EnumValue = src.EnumValue
+	}
+	if src.OptionalInt32 != nil {
+		tmpVal := *src.OptionalInt32
+		m.OptionalInt32 = &tmpVal
+	}
+	if src.OptionalBytes != nil {
+		m.OptionalBytes = append([]byte{}, src.OptionalBytes...)
+	}
+	m.RepeatedInt32 = append(m.RepeatedInt32, src.RepeatedInt32...)
+	for _, v := range src.RepeatedBytes {
+		m.RepeatedBytes = append(m.RepeatedBytes, append([]byte{}, v...))
+	}
+	for _, v := range src.RepeatedChild {
+		tmpMsg := &MergeChild{}
+		tmpMsg.MergeVT(v)
+		m.RepeatedChild = append(m.RepeatedChild, tmpMsg)
+	}
+	if len(src.Int32Map) > 0 {
+		if m.Int32Map == nil {
+			m.Int32Map = make(map[string]int32, len(src.Int32Map))
+		}
+		for k, v := range src.Int32Map {
+			m.Int32Map[k] = v
+		}
+	}
+	if len(src.BytesMap) > 0 {
+		if m.BytesMap == nil {
+			m.BytesMap = make(map[string][]byte, len(src.BytesMap))
+		}
+		for k, v := range src.BytesMap {
+			m.BytesMap[k] = append([]byte{}, v...)
+		}
+	}
+	if len(src.ChildMap) > 0 {
+		if m.ChildMap == nil {
+			m.ChildMap = make(map[string]*MergeChild, len(src.ChildMap))
+		}
+		for k, v := range src.ChildMap {
+			tmpMsg := &MergeChild{}
+			tmpMsg.MergeVT(v)
+			m.ChildMap[k] = tmpMsg
+		}
+	}
+	if src.Child != nil {
+		if m.Child == nil {
+			m.Child = &MergeChild{}
+		}
+		m.Child.MergeVT(src.Child)
+	}
+	if src.Wrapped != nil {
+		if m.Wrapped == nil {
+			m.Wrapped = &wrapperspb.StringValue{}
+		}
+		if vtpb, ok := interface{}(m.Wrapped).(interface{ MergeVT(*wrapperspb.StringValue) }); ok {
+			vtpb.MergeVT(src.Wrapped)
+		} else {
+			proto.Merge(m.Wrapped, src.Wrapped)
+		}
+	}
+	switch srcOneof := src.Choice.(type) {
+	case *MergeMessage_OneofInt32:
+		m.Choice = &MergeMessage_OneofInt32{OneofInt32: srcOneof.OneofInt32}
+	case *MergeMessage_OneofBytes:
+		m.Choice = &MergeMessage_OneofBytes{OneofBytes: append([]byte{}, srcOneof.OneofBytes...)}
+	case *MergeMessage_OneofChild:
+		if dstOneof, ok := m.Choice.(*MergeMessage_OneofChild); ok && dstOneof.OneofChild != nil {
+			if srcOneof.OneofChild != nil {
+				dstOneof.OneofChild.MergeVT(srcOneof.OneofChild)
+			}
+		} else {
+			tmpMsg := &MergeChild{}
+			tmpMsg.MergeVT(srcOneof.OneofChild)
+			m.Choice = &MergeMessage_OneofChild{OneofChild: tmpMsg}
+		}
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *MergeMessage) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*MergeMessage); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *MergeChild) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sov(uint64(m.Id))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *MergeMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Int32Value != 0 {
+		n += 1 + sov(uint64(m.Int32Value))
+	}
+	l = len(m.StringValue)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.BytesValue)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.BoolValue {
+		n += 2
+	}
+	if m.DoubleValue != 0 {
+		n += 9
+	}
+	if m.EnumValue != 0 {
+		n += 1 + sov(uint64(m.EnumValue))
+	}
+	if m.OptionalInt32 != nil {
+		n += 1 + sov(uint64(*m.OptionalInt32))
+	}
+	if m.OptionalBytes != nil {
+		l = len(m.OptionalBytes)
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.RepeatedInt32) > 0 {
+		l = 0
+		for _, e := range m.RepeatedInt32 {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.RepeatedBytes) > 0 {
+		for _, b := range m.RepeatedBytes {
+			l = len(b)
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.RepeatedChild) > 0 {
+		for _, e := range m.RepeatedChild {
+			l = e.SizeVT()
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if len(m.Int32Map) > 0 {
+		for k, v := range m.Int32Map {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + sov(uint64(v))
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.BytesMap) > 0 {
+		for k, v := range m.BytesMap {
+			_ = k
+			_ = v
+			l = 1 + len(v) + sov(uint64(len(v)))
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ChildMap) > 0 {
+		for k, v := range m.ChildMap {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.SizeVT()
+			}
+			l += 1 + sov(uint64(l))
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if m.Child != nil {
+		l = m.Child.SizeVT()
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.Wrapped != nil {
+		if size, ok := interface{}(m.Wrapped).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.Wrapped)
+		}
+		n += 2 + l + sov(uint64(l))
+	}
+	if vtmsg, ok := m.Choice.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *MergeMessage_OneofInt32) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2 + sov(uint64(m.OneofInt32))
+	return n
+}
+func (m *MergeMessage_OneofBytes) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OneofBytes)
+	n += 2 + l + sov(uint64(l))
+	return n
+}
+func (m *MergeMessage_OneofChild) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OneofChild != nil {
+		l = m.OneofChild.SizeVT()
+		n += 2 + l + sov(uint64(l))
+	}
+	return n
+}
+
+func sov(x uint64) (n int) {
+	return (bits.Len64(x|1) + 6) / 7
+}
+func soz(x uint64) (n int) {
+	return sov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MergeChild) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *MergeChild) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *MergeChild) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *MergeChild) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*MergeChild)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeChild: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeChild: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.Tags = append(m.Tags, string(string(dAtA[iNdEx:postIndex])))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeMessage) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *MergeMessage) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *MergeMessage) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *MergeMessage) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*MergeMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Value", wireType)
+			}
+			m.Int32Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Int32Value |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.StringValue = string(string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BytesValue = append(m.BytesValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.BytesValue == nil {
+				m.BytesValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BoolValue = bool(v != 0)
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleValue", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DoubleValue = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnumValue", wireType)
+			}
+			m.EnumValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnumValue |= MergeEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalInt32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalInt32 = &v
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionalBytes = append(m.OptionalBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.OptionalBytes == nil {
+				m.OptionalBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedInt32 = append(m.RepeatedInt32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedInt32) == 0 {
+					m.RepeatedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedInt32 = append(m.RepeatedInt32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt32", wireType)
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedBytes = append(m.RepeatedBytes, make([]byte, postIndex-iNdEx))
+			copy(m.RepeatedBytes[len(m.RepeatedBytes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedChild", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedChild = append(m.RepeatedChild, &MergeChild{})
+			if err := m.RepeatedChild[len(m.RepeatedChild)-1].unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Map", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Int32Map == nil {
+				m.Int32Map = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Int32Map[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BytesMap == nil {
+				m.BytesMap = make(map[string][]byte)
+			}
+			var mapkey string
+			var mapvalue []byte
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLength
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.BytesMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChildMap == nil {
+				m.ChildMap = make(map[string]*MergeChild)
+			}
+			var mapkey string
+			var mapvalue *MergeChild
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MergeChild{}
+					if err := mapvalue.unmarshalVT(dAtA[iNdEx:postmsgIndex], opts, depth); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ChildMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Child", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Child == nil {
+				m.Child = &MergeChild{}
+			}
+			if err := m.Child.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wrapped", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Wrapped == nil {
+				m.Wrapped = &wrapperspb.StringValue{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.Wrapped).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.Wrapped); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 51:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofInt32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Choice = &MergeMessage_OneofInt32{OneofInt32: v}
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Choice = &MergeMessage_OneofBytes{OneofBytes: v}
+			iNdEx = postIndex
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofChild", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.Choice.(*MergeMessage_OneofChild); ok {
+				if err := oneof.OneofChild.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+					return err
+				}
+			} else {
+				v := &MergeChild{}
+				if err := v.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+					return err
+				}
+				m.Choice = &MergeMessage_OneofChild{OneofChild: v}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skip(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLength
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroup
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLength
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLength          = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflow            = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroup   = fmt.Errorf("proto: unexpected end of group")
+	ErrInvalidUTF8            = fmt.Errorf("proto: string field contains invalid UTF-8")
+	ErrRecursionLimitExceeded = fmt.Errorf("proto: exceeded maximum recursion depth")
+)
+
+func (m *MergeChild) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *MergeChild) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *MergeChild) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*MergeChild)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeChild: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeChild: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.Tags = append(m.Tags, string(stringValue))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeMessage) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *MergeMessage) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *MergeMessage) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*MergeMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Value", wireType)
+			}
+			m.Int32Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Int32Value |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.StringValue = string(stringValue)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BytesValue = dAtA[iNdEx:postIndex:postIndex]
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoolValue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BoolValue = bool(v != 0)
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleValue", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.DoubleValue = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnumValue", wireType)
+			}
+			m.EnumValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnumValue |= MergeEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalInt32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalInt32 = &v
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionalBytes = dAtA[iNdEx:postIndex:postIndex]
+			iNdEx = postIndex
+		case 21:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedInt32 = append(m.RepeatedInt32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedInt32) == 0 {
+					m.RepeatedInt32 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedInt32 = append(m.RepeatedInt32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedInt32", wireType)
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedBytes = append(m.RepeatedBytes, dAtA[iNdEx:postIndex:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedChild", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedChild = append(m.RepeatedChild, &MergeChild{})
+			if err := m.RepeatedChild[len(m.RepeatedChild)-1].unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Map", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Int32Map == nil {
+				m.Int32Map = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					if intStringLenmapkey > 0 {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Int32Map[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BytesMap == nil {
+				m.BytesMap = make(map[string][]byte)
+			}
+			var mapkey string
+			var mapvalue []byte
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					if intStringLenmapkey > 0 {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLength
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = dAtA[iNdEx:postbytesIndex:postbytesIndex]
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.BytesMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChildMap == nil {
+				m.ChildMap = make(map[string]*MergeChild)
+			}
+			var mapkey string
+			var mapvalue *MergeChild
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					if intStringLenmapkey > 0 {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MergeChild{}
+					if err := mapvalue.unmarshalVTUnsafe(dAtA[iNdEx:postmsgIndex], opts, depth); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ChildMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Child", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Child == nil {
+				m.Child = &MergeChild{}
+			}
+			if err := m.Child.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wrapped", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Wrapped == nil {
+				m.Wrapped = &wrapperspb.StringValue{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.Wrapped).(interface {
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.Wrapped).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.Wrapped); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 51:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofInt32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Choice = &MergeMessage_OneofInt32{OneofInt32: v}
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Choice = &MergeMessage_OneofBytes{OneofBytes: dAtA[iNdEx:postIndex:postIndex]}
+			iNdEx = postIndex
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofChild", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.Choice.(*MergeMessage_OneofChild); ok {
+				if err := oneof.OneofChild.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+					return err
+				}
+			} else {
+				v := &MergeChild{}
+				if err := v.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+					return err
+				}
+				m.Choice = &MergeMessage_OneofChild{OneofChild: v}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
@@ -0,0 +1,590 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.20.0
+// source: methods/methods.proto
+
+package methods
+
+import (
+	_ "github.com/planetscale/vtprotobuf/vtproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MethodsChild struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tags []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *MethodsChild) Reset() {
+	*x = MethodsChild{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_methods_methods_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MethodsChild) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MethodsChild) ProtoMessage() {}
+
+func (x *MethodsChild) ProtoReflect() protoreflect.Message {
+	mi := &file_methods_methods_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MethodsChild.ProtoReflect.Descriptor instead.
+func (*MethodsChild) Descriptor() ([]byte, []int) {
+	return file_methods_methods_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MethodsChild) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MethodsChild) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type MethodsMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Int32Value      int32                    `protobuf:"varint,1,opt,name=int32_value,json=int32Value,proto3" json:"int32_value,omitempty"`
+	StringValue     string                   `protobuf:"bytes,2,opt,name=string_value,json=stringValue,proto3" json:"string_value,omitempty"`
+	BytesValue      []byte                   `protobuf:"bytes,3,opt,name=bytes_value,json=bytesValue,proto3" json:"bytes_value,omitempty"`
+	OptionalSint64  *int64                   `protobuf:"zigzag64,4,opt,name=optional_sint64,json=optionalSint64,proto3,oneof" json:"optional_sint64,omitempty"`
+	RepeatedFixed32 []uint32                 `protobuf:"fixed32,5,rep,packed,name=repeated_fixed32,json=repeatedFixed32,proto3" json:"repeated_fixed32,omitempty"`
+	Children        []*MethodsChild          `protobuf:"bytes,6,rep,name=children,proto3" json:"children,omitempty"`
+	ChildMap        map[string]*MethodsChild `protobuf:"bytes,7,rep,name=child_map,json=childMap,proto3" json:"child_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Child           *MethodsChild            `protobuf:"bytes,8,opt,name=child,proto3" json:"child,omitempty"`
+	Wrapped         *wrapperspb.StringValue  `protobuf:"bytes,9,opt,name=wrapped,proto3" json:"wrapped,omitempty"`
+	// Types that are assignable to Choice:
+	//	*MethodsMessage_OneofString
+	//	*MethodsMessage_OneofChild
+	Choice isMethodsMessage_Choice `protobuf_oneof:"choice"`
+}
+
+func (x *MethodsMessage) Reset() {
+	*x = MethodsMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_methods_methods_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MethodsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MethodsMessage) ProtoMessage() {}
+
+func (x *MethodsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_methods_methods_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MethodsMessage.ProtoReflect.Descriptor instead.
+func (*MethodsMessage) Descriptor() ([]byte, []int) {
+	return file_methods_methods_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MethodsMessage) GetInt32Value() int32 {
+	if x != nil {
+		return x.Int32Value
+	}
+	return 0
+}
+
+func (x *MethodsMessage) GetStringValue() string {
+	if x != nil {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (x *MethodsMessage) GetBytesValue() []byte {
+	if x != nil {
+		return x.BytesValue
+	}
+	return nil
+}
+
+func (x *MethodsMessage) GetOptionalSint64() int64 {
+	if x != nil && x.OptionalSint64 != nil {
+		return *x.OptionalSint64
+	}
+	return 0
+}
+
+func (x *MethodsMessage) GetRepeatedFixed32() []uint32 {
+	if x != nil {
+		return x.RepeatedFixed32
+	}
+	return nil
+}
+
+func (x *MethodsMessage) GetChildren() []*MethodsChild {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *MethodsMessage) GetChildMap() map[string]*MethodsChild {
+	if x != nil {
+		return x.ChildMap
+	}
+	return nil
+}
+
+func (x *MethodsMessage) GetChild() *MethodsChild {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+func (x *MethodsMessage) GetWrapped() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Wrapped
+	}
+	return nil
+}
+
+func (m *MethodsMessage) GetChoice() isMethodsMessage_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (x *MethodsMessage) GetOneofString() string {
+	if x, ok := x.GetChoice().(*MethodsMessage_OneofString); ok {
+		return x.OneofString
+	}
+	return ""
+}
+
+func (x *MethodsMessage) GetOneofChild() *MethodsChild {
+	if x, ok := x.GetChoice().(*MethodsMessage_OneofChild); ok {
+		return x.OneofChild
+	}
+	return nil
+}
+
+type isMethodsMessage_Choice interface {
+	isMethodsMessage_Choice()
+}
+
+type MethodsMessage_OneofString struct {
+	OneofString string `protobuf:"bytes,10,opt,name=oneof_string,json=oneofString,proto3,oneof"`
+}
+
+type MethodsMessage_OneofChild struct {
+	OneofChild *MethodsChild `protobuf:"bytes,11,opt,name=oneof_child,json=oneofChild,proto3,oneof"`
+}
+
+func (*MethodsMessage_OneofString) isMethodsMessage_Choice() {}
+
+func (*MethodsMessage_OneofChild) isMethodsMessage_Choice() {}
+
+// PlainMessage has the same fields as MethodsMessage, but doesn't register its
+// generated methods: the proto package handles it with reflection.
+type PlainMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Int32Value      int32                    `protobuf:"varint,1,opt,name=int32_value,json=int32Value,proto3" json:"int32_value,omitempty"`
+	StringValue     string                   `protobuf:"bytes,2,opt,name=string_value,json=stringValue,proto3" json:"string_value,omitempty"`
+	BytesValue      []byte                   `protobuf:"bytes,3,opt,name=bytes_value,json=bytesValue,proto3" json:"bytes_value,omitempty"`
+	OptionalSint64  *int64                   `protobuf:"zigzag64,4,opt,name=optional_sint64,json=optionalSint64,proto3,oneof" json:"optional_sint64,omitempty"`
+	RepeatedFixed32 []uint32                 `protobuf:"fixed32,5,rep,packed,name=repeated_fixed32,json=repeatedFixed32,proto3" json:"repeated_fixed32,omitempty"`
+	Children        []*MethodsChild          `protobuf:"bytes,6,rep,name=children,proto3" json:"children,omitempty"`
+	ChildMap        map[string]*MethodsChild `protobuf:"bytes,7,rep,name=child_map,json=childMap,proto3" json:"child_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Child           *MethodsChild            `protobuf:"bytes,8,opt,name=child,proto3" json:"child,omitempty"`
+	Wrapped         *wrapperspb.StringValue  `protobuf:"bytes,9,opt,name=wrapped,proto3" json:"wrapped,omitempty"`
+	// Types that are assignable to Choice:
+	//	*PlainMessage_OneofString
+	//	*PlainMessage_OneofChild
+	Choice isPlainMessage_Choice `protobuf_oneof:"choice"`
+}
+
+func (x *PlainMessage) Reset() {
+	*x = PlainMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_methods_methods_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlainMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlainMessage) ProtoMessage() {}
+
+func (x *PlainMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_methods_methods_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlainMessage.ProtoReflect.Descriptor instead.
+func (*PlainMessage) Descriptor() ([]byte, []int) {
+	return file_methods_methods_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlainMessage) GetInt32Value() int32 {
+	if x != nil {
+		return x.Int32Value
+	}
+	return 0
+}
+
+func (x *PlainMessage) GetStringValue() string {
+	if x != nil {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (x *PlainMessage) GetBytesValue() []byte {
+	if x != nil {
+		return x.BytesValue
+	}
+	return nil
+}
+
+func (x *PlainMessage) GetOptionalSint64() int64 {
+	if x != nil && x.OptionalSint64 != nil {
+		return *x.OptionalSint64
+	}
+	return 0
+}
+
+func (x *PlainMessage) GetRepeatedFixed32() []uint32 {
+	if x != nil {
+		return x.RepeatedFixed32
+	}
+	return nil
+}
+
+func (x *PlainMessage) GetChildren() []*MethodsChild {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *PlainMessage) GetChildMap() map[string]*MethodsChild {
+	if x != nil {
+		return x.ChildMap
+	}
+	return nil
+}
+
+func (x *PlainMessage) GetChild() *MethodsChild {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+func (x *PlainMessage) GetWrapped() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Wrapped
+	}
+	return nil
+}
+
+func (m *PlainMessage) GetChoice() isPlainMessage_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (x *PlainMessage) GetOneofString() string {
+	if x, ok := x.GetChoice().(*PlainMessage_OneofString); ok {
+		return x.OneofString
+	}
+	return ""
+}
+
+func (x *PlainMessage) GetOneofChild() *MethodsChild {
+	if x, ok := x.GetChoice().(*PlainMessage_OneofChild); ok {
+		return x.OneofChild
+	}
+	return nil
+}
+
+type isPlainMessage_Choice interface {
+	isPlainMessage_Choice()
+}
+
+type PlainMessage_OneofString struct {
+	OneofString string `protobuf:"bytes,10,opt,name=oneof_string,json=oneofString,proto3,oneof"`
+}
+
+type PlainMessage_OneofChild struct {
+	OneofChild *MethodsChild `protobuf:"bytes,11,opt,name=oneof_child,json=oneofChild,proto3,oneof"`
+}
+
+func (*PlainMessage_OneofString) isPlainMessage_Choice() {}
+
+func (*PlainMessage_OneofChild) isPlainMessage_Choice() {}
+
+var File_methods_methods_proto protoreflect.FileDescriptor
+
+var file_methods_methods_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x2f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x2f,
+	0x76, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x74, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x43, 0x0a, 0x0c,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x3a, 0x0f, 0xba, 0xa6, 0x1f, 0x0b, 0x61, 0x6c, 0x6c, 0x2b, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x73, 0x22, 0xe4, 0x04, 0x0a, 0x0e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2c, 0x0a, 0x0f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x12, 0x48, 0x01, 0x52, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x07, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x69, 0x78, 0x65, 0x64,
+	0x33, 0x32, 0x12, 0x29, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x43, 0x68,
+	0x69, 0x6c, 0x64, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x3a, 0x0a,
+	0x09, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x4d, 0x61, 0x70, 0x12, 0x23, 0x0a, 0x05, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x73, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x36,
+	0x0a, 0x07, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0c, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x30, 0x0a, 0x0b, 0x6f,
+	0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x48,
+	0x00, 0x52, 0x0a, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x1a, 0x4a, 0x0a,
+	0x0d, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x23, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x0f, 0xba, 0xa6, 0x1f, 0x0b, 0x61,
+	0x6c, 0x6c, 0x2b, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x22, 0xcf, 0x04, 0x0a, 0x0c, 0x50, 0x6c, 0x61,
+	0x69, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2c,
+	0x0a, 0x0f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x12, 0x48, 0x01, 0x52, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x10,
+	0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x07, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x29, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x72, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x73, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72,
+	0x65, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x70, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x4d, 0x61, 0x70, 0x12, 0x23, 0x0a, 0x05,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x73, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x12, 0x36, 0x0a, 0x07, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x07, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0c, 0x6f, 0x6e, 0x65,
+	0x6f, 0x66, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x30,
+	0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x43, 0x68, 0x69, 0x6c, 0x64,
+	0x1a, 0x4a, 0x0a, 0x0d, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x43, 0x68, 0x69, 0x6c,
+	0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x08, 0x0a, 0x06,
+	0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x42, 0x13, 0x5a, 0x11, 0x74, 0x65,
+	0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_methods_methods_proto_rawDescOnce sync.Once
+	file_methods_methods_proto_rawDescData = file_methods_methods_proto_rawDesc
+)
+
+func file_methods_methods_proto_rawDescGZIP() []byte {
+	file_methods_methods_proto_rawDescOnce.Do(func() {
+		file_methods_methods_proto_rawDescData = protoimpl.X.CompressGZIP(file_methods_methods_proto_rawDescData)
+	})
+	return file_methods_methods_proto_rawDescData
+}
+
+var file_methods_methods_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_methods_methods_proto_goTypes = []any{
+	(*MethodsChild)(nil),           // 0: MethodsChild
+	(*MethodsMessage)(nil),         // 1: MethodsMessage
+	(*PlainMessage)(nil),           // 2: PlainMessage
+	nil,                            // 3: MethodsMessage.ChildMapEntry
+	nil,                            // 4: PlainMessage.ChildMapEntry
+	(*wrapperspb.StringValue)(nil), // 5: google.protobuf.StringValue
+}
+var file_methods_methods_proto_depIdxs = []int32{
+	0,  // 0: MethodsMessage.children:type_name -> MethodsChild
+	3,  // 1: MethodsMessage.child_map:type_name -> MethodsMessage.ChildMapEntry
+	0,  // 2: MethodsMessage.child:type_name -> MethodsChild
+	5,  // 3: MethodsMessage.wrapped:type_name -> google.protobuf.StringValue
+	0,  // 4: MethodsMessage.oneof_child:type_name -> MethodsChild
+	0,  // 5: PlainMessage.children:type_name -> MethodsChild
+	4,  // 6: PlainMessage.child_map:type_name -> PlainMessage.ChildMapEntry
+	0,  // 7: PlainMessage.child:type_name -> MethodsChild
+	5,  // 8: PlainMessage.wrapped:type_name -> google.protobuf.StringValue
+	0,  // 9: PlainMessage.oneof_child:type_name -> MethodsChild
+	0,  // 10: MethodsMessage.ChildMapEntry.value:type_name -> MethodsChild
+	0,  // 11: PlainMessage.ChildMapEntry.value:type_name -> MethodsChild
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_methods_methods_proto_init() }
+func file_methods_methods_proto_init() {
+	if File_methods_methods_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_methods_methods_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*MethodsChild); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_methods_methods_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*MethodsMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_methods_methods_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*PlainMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_methods_methods_proto_msgTypes[1].OneofWrappers = []any{
+		(*MethodsMessage_OneofString)(nil),
+		(*MethodsMessage_OneofChild)(nil),
+	}
+	file_methods_methods_proto_msgTypes[2].OneofWrappers = []any{
+		(*PlainMessage_OneofString)(nil),
+		(*PlainMessage_OneofChild)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_methods_methods_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_methods_methods_proto_goTypes,
+		DependencyIndexes: file_methods_methods_proto_depIdxs,
+		MessageInfos:      file_methods_methods_proto_msgTypes,
+	}.Build()
+	File_methods_methods_proto = out.File
+	file_methods_methods_proto_rawDesc = nil
+	file_methods_methods_proto_goTypes = nil
+	file_methods_methods_proto_depIdxs = nil
+}
@@ -0,0 +1,147 @@
+package methods
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func newMessage() *MethodsMessage {
+	return &MethodsMessage{
+		Int32Value:      -1,
+		StringValue:     "string",
+		BytesValue:      []byte("bytes"),
+		OptionalSint64:  proto.Int64(0),
+		RepeatedFixed32: []uint32{1, 2},
+		Children:        []*MethodsChild{{Id: 1}, {Tags: []string{"a", "b"}}},
+		ChildMap:        map[string]*MethodsChild{"a": {Id: 2}},
+		Child:           &MethodsChild{},
+		Wrapped:         wrapperspb.String("wrapped"),
+		Choice:          &MethodsMessage_OneofChild{OneofChild: &MethodsChild{Id: 3}},
+	}
+}
+
+// toPlain returns the PlainMessage with the same contents as msg, converted with
+// reflection.
+func toPlain(t *testing.T, msg *MethodsMessage) *PlainMessage {
+	b, err := msg.MarshalVT()
+	require.NoError(t, err)
+	plain := &PlainMessage{}
+	require.NoError(t, proto.Unmarshal(b, plain))
+	return plain
+}
+
+func TestMethodsRegistered(t *testing.T) {
+	methods := (&MethodsMessage{}).ProtoReflect().ProtoMethods()
+	require.NotNil(t, methods)
+
+	msg := newMessage()
+	require.Equal(t, msg.SizeVT(), methods.Size(protoiface.SizeInput{Message: msg.ProtoReflect()}).Size)
+	require.Zero(t, methods.Flags&protoiface.SupportMarshalDeterministic)
+}
+
+// TestMethodsAliased fails if ProtoMethods stops returning the methods the protobuf
+// runtime uses for the type, which RegisterMethods patches in place.
+func TestMethodsAliased(t *testing.T) {
+	first := (&MethodsMessage{}).ProtoReflect().ProtoMethods()
+	second := newMessage().ProtoReflect().ProtoMethods()
+	require.Same(t, first, second)
+
+	for name, fn := range map[string]interface{}{
+		"Size":      second.Size,
+		"Marshal":   second.Marshal,
+		"Unmarshal": second.Unmarshal,
+		"Merge":     second.Merge,
+	} {
+		registered := runtime.FuncForPC(reflect.ValueOf(fn).Pointer()).Name()
+		require.True(t, strings.HasPrefix(registered, "github.com/planetscale/vtprotobuf/vtproto.RegisterMethods."),
+			"%s is %s, not the method registered by vtproto.RegisterMethods", name, registered)
+	}
+}
+
+func TestMethodsMarshal(t *testing.T) {
+	for _, msg := range []*MethodsMessage{
+		{},
+		newMessage(),
+		{Choice: &MethodsMessage_OneofString{OneofString: ""}},
+	} {
+		expected, err := proto.Marshal(toPlain(t, msg))
+		require.NoError(t, err)
+
+		got, err := proto.Marshal(msg)
+		require.NoError(t, err)
+		require.Equal(t, expected, got)
+		require.Equal(t, len(expected), proto.Size(msg))
+
+		vt, err := msg.MarshalVT()
+		require.NoError(t, err)
+		require.Equal(t, vt, got)
+
+		// the output is appended to the given buffer
+		prefix := []byte{0xff}
+		got, err = proto.MarshalOptions{}.MarshalAppend(prefix, msg)
+		require.NoError(t, err)
+		require.Equal(t, append([]byte{0xff}, expected...), got)
+
+		// the generated methods are not deterministic, so reflection is used
+		got, err = proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+		require.NoError(t, err)
+		require.Equal(t, expected, got)
+	}
+}
+
+func TestMethodsUnmarshal(t *testing.T) {
+	b, err := newMessage().MarshalVT()
+	require.NoError(t, err)
+
+	msg := &MethodsMessage{StringValue: "reset"}
+	require.NoError(t, proto.Unmarshal(b, msg))
+	require.True(t, newMessage().EqualVT(msg))
+
+	// unknown fields are kept or discarded
+	b = append(b, 0xa0, 0x06, 0x01) // field 100, varint 1
+	msg = &MethodsMessage{}
+	require.NoError(t, proto.Unmarshal(b, msg))
+	require.Equal(t, []byte{0xa0, 0x06, 0x01}, []byte(msg.ProtoReflect().GetUnknown()))
+	msg = &MethodsMessage{}
+	require.NoError(t, proto.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(b, msg))
+	require.Empty(t, msg.ProtoReflect().GetUnknown())
+
+	// a custom resolver is handled by the runtime
+	msg = &MethodsMessage{}
+	require.NoError(t, proto.UnmarshalOptions{Resolver: new(protoregistry.Types)}.Unmarshal(b, msg))
+	require.Equal(t, []byte{0xa0, 0x06, 0x01}, []byte(msg.ProtoReflect().GetUnknown()))
+
+	msg = &MethodsMessage{}
+	require.Error(t, proto.Unmarshal([]byte{0x0a}, msg))
+	require.Error(t, proto.UnmarshalOptions{RecursionLimit: 1}.Unmarshal(b, msg))
+}
+
+func TestMethodsMerge(t *testing.T) {
+	dst := &MethodsMessage{Int32Value: 5, RepeatedFixed32: []uint32{0}}
+	proto.Merge(dst, newMessage())
+	expected := &MethodsMessage{Int32Value: 5, RepeatedFixed32: []uint32{0}}
+	expected.MergeVT(newMessage())
+	require.True(t, expected.EqualVT(dst))
+
+	clone := proto.Clone(newMessage()).(*MethodsMessage)
+	require.True(t, newMessage().EqualVT(clone))
+	require.True(t, proto.Equal(toPlain(t, newMessage()), toPlain(t, clone)))
+
+	// dynamic messages are merged by the runtime
+	dynamic := dynamicpb.NewMessage(dst.ProtoReflect().Descriptor())
+	b, err := newMessage().MarshalVT()
+	require.NoError(t, err)
+	require.NoError(t, proto.Unmarshal(b, dynamic))
+	dst = &MethodsMessage{}
+	proto.Merge(dst, dynamic)
+	require.True(t, newMessage().EqualVT(dst))
+}
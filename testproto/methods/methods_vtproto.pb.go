@@ -0,0 +1,4812 @@
+// Code generated by protoc-gen-go-vtproto. DO NOT EDIT.
+// protoc-gen-go-vtproto version: (devel)
+// source: methods/methods.proto
+
+package methods
+
+import (
+	binary "encoding/binary"
+	errors "errors"
+	fmt "fmt"
+	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
+	vtproto "github.com/planetscale/vtprotobuf/vtproto"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	proto "google.golang.org/protobuf/proto"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	hash "hash"
+	fnv "hash/fnv"
+	io "io"
+	bits "math/bits"
+	sort "sort"
+	strconv "strconv"
+	utf8 "unicode/utf8"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+func (m *MethodsChild) CloneVT() *MethodsChild {
+	if m == nil {
+		return (*MethodsChild)(nil)
+	}
+	r := &MethodsChild{
+		Id: m.Id,
+	}
+	if rhs := m.Tags; rhs != nil {
+		tmpContainer := make([]string, len(rhs))
+		copy(tmpContainer, rhs)
+		r.Tags = tmpContainer
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *MethodsChild) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *MethodsChild) CopyToVT(dst *MethodsChild) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Id = m.Id
+	dst.Tags = append(dst.Tags[:0], m.Tags...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *MethodsMessage) CloneVT() *MethodsMessage {
+	if m == nil {
+		return (*MethodsMessage)(nil)
+	}
+	r := &MethodsMessage{
+		Int32Value:  m.Int32Value,
+		StringValue: m.StringValue,
+		Child:       m.Child.CloneVT(),
+	}
+	if rhs := m.BytesValue; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.BytesValue = tmpBytes
+	}
+	if rhs := m.OptionalSint64; rhs != nil {
+		tmpVal := *rhs
+		r.OptionalSint64 = &tmpVal
+	}
+	if rhs := m.RepeatedFixed32; rhs != nil {
+		tmpContainer := make([]uint32, len(rhs))
+		copy(tmpContainer, rhs)
+		r.RepeatedFixed32 = tmpContainer
+	}
+	if rhs := m.Children; rhs != nil {
+		tmpContainer := make([]*MethodsChild, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.Children = tmpContainer
+	}
+	if rhs := m.ChildMap; rhs != nil {
+		tmpContainer := make(map[string]*MethodsChild, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.ChildMap = tmpContainer
+	}
+	if rhs := m.Wrapped; rhs != nil {
+		if vtpb, ok := interface{}(rhs).(interface {
+			CloneVT() *wrapperspb.StringValue
+		}); ok {
+			r.Wrapped = vtpb.CloneVT()
+		} else {
+			r.Wrapped = proto.Clone(rhs).(*wrapperspb.StringValue)
+		}
+	}
+	if m.Choice != nil {
+		r.Choice = m.Choice.(interface {
+			CloneVT() isMethodsMessage_Choice
+		}).CloneVT()
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *MethodsMessage) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *MethodsMessage) CopyToVT(dst *MethodsMessage) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Int32Value = m.Int32Value
+	dst.StringValue = m.StringValue
+	dst.BytesValue = append(dst.BytesValue[:0], m.BytesValue...)
+	if m.OptionalSint64 == nil {
+		dst.OptionalSint64 = nil
+	} else {
+		if dst.OptionalSint64 == nil {
+			dst.OptionalSint64 = new(int64)
+		}
+		*dst.OptionalSint64 = *m.OptionalSint64
+	}
+	dst.RepeatedFixed32 = append(dst.RepeatedFixed32[:0], m.RepeatedFixed32...)
+	if len(m.Children) > cap(dst.Children) {
+		tmpContainer := make([]*MethodsChild, len(m.Children))
+		copy(tmpContainer, dst.Children[:cap(dst.Children)])
+		dst.Children = tmpContainer
+	} else {
+		dst.Children = dst.Children[:len(m.Children)]
+	}
+	for k, v := range m.Children {
+		if dst.Children[k] == nil {
+			dst.Children[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.Children[k])
+		}
+	}
+	if dst.ChildMap == nil && len(m.ChildMap) > 0 {
+		dst.ChildMap = make(map[string]*MethodsChild, len(m.ChildMap))
+	}
+	for k := range dst.ChildMap {
+		if _, ok := m.ChildMap[k]; !ok {
+			delete(dst.ChildMap, k)
+		}
+	}
+	for k, v := range m.ChildMap {
+		if dst.ChildMap[k] == nil {
+			dst.ChildMap[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.ChildMap[k])
+		}
+	}
+	if m.Child == nil {
+		dst.Child = nil
+	} else {
+		if dst.Child == nil {
+			dst.Child = m.Child.CloneVT()
+		} else {
+			m.Child.CopyToVT(dst.Child)
+		}
+	}
+	if m.Wrapped == nil {
+		dst.Wrapped = nil
+	} else {
+		if dst.Wrapped == nil {
+			if vtpb, ok := interface{}(m.Wrapped).(interface {
+				CloneVT() *wrapperspb.StringValue
+			}); ok {
+				dst.Wrapped = vtpb.CloneVT()
+			} else {
+				dst.Wrapped = proto.Clone(m.Wrapped).(*wrapperspb.StringValue)
+			}
+		} else {
+			if vtpb, ok := interface{}(m.Wrapped).(interface{ CopyToVT(*wrapperspb.StringValue) }); ok {
+				vtpb.CopyToVT(dst.Wrapped)
+			} else {
+				proto.Reset(dst.Wrapped)
+				proto.Merge(dst.Wrapped, m.Wrapped)
+			}
+		}
+	}
+	switch c := m.Choice.(type) {
+	case *MethodsMessage_OneofString:
+		if d, ok := dst.Choice.(*MethodsMessage_OneofString); ok {
+			d.OneofString = c.OneofString
+		} else {
+			dst.Choice = c.CloneVT()
+		}
+	case *MethodsMessage_OneofChild:
+		if d, ok := dst.Choice.(*MethodsMessage_OneofChild); ok {
+			if d.OneofChild == nil {
+				d.OneofChild = c.OneofChild.CloneVT()
+			} else {
+				c.OneofChild.CopyToVT(d.OneofChild)
+			}
+		} else {
+			dst.Choice = c.CloneVT()
+		}
+	default:
+		dst.Choice = nil
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *MethodsMessage_OneofString) CloneVT() isMethodsMessage_Choice {
+	if m == nil {
+		return (*MethodsMessage_OneofString)(nil)
+	}
+	r := &MethodsMessage_OneofString{
+		OneofString: m.OneofString,
+	}
+	return r
+}
+
+func (m *MethodsMessage_OneofChild) CloneVT() isMethodsMessage_Choice {
+	if m == nil {
+		return (*MethodsMessage_OneofChild)(nil)
+	}
+	r := &MethodsMessage_OneofChild{
+		OneofChild: m.OneofChild.CloneVT(),
+	}
+	return r
+}
+
+func (m *PlainMessage) CloneVT() *PlainMessage {
+	if m == nil {
+		return (*PlainMessage)(nil)
+	}
+	r := &PlainMessage{
+		Int32Value:  m.Int32Value,
+		StringValue: m.StringValue,
+		Child:       m.Child.CloneVT(),
+	}
+	if rhs := m.BytesValue; rhs != nil {
+		tmpBytes := make([]byte, len(rhs))
+		copy(tmpBytes, rhs)
+		r.BytesValue = tmpBytes
+	}
+	if rhs := m.OptionalSint64; rhs != nil {
+		tmpVal := *rhs
+		r.OptionalSint64 = &tmpVal
+	}
+	if rhs := m.RepeatedFixed32; rhs != nil {
+		tmpContainer := make([]uint32, len(rhs))
+		copy(tmpContainer, rhs)
+		r.RepeatedFixed32 = tmpContainer
+	}
+	if rhs := m.Children; rhs != nil {
+		tmpContainer := make([]*MethodsChild, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.Children = tmpContainer
+	}
+	if rhs := m.ChildMap; rhs != nil {
+		tmpContainer := make(map[string]*MethodsChild, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.ChildMap = tmpContainer
+	}
+	if rhs := m.Wrapped; rhs != nil {
+		if vtpb, ok := interface{}(rhs).(interface {
+			CloneVT() *wrapperspb.StringValue
+		}); ok {
+			r.Wrapped = vtpb.CloneVT()
+		} else {
+			r.Wrapped = proto.Clone(rhs).(*wrapperspb.StringValue)
+		}
+	}
+	if m.Choice != nil {
+		r.Choice = m.Choice.(interface{ CloneVT() isPlainMessage_Choice }).CloneVT()
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *PlainMessage) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *PlainMessage) CopyToVT(dst *PlainMessage) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Int32Value = m.Int32Value
+	dst.StringValue = m.StringValue
+	dst.BytesValue = append(dst.BytesValue[:0], m.BytesValue...)
+	if m.OptionalSint64 == nil {
+		dst.OptionalSint64 = nil
+	} else {
+		if dst.OptionalSint64 == nil {
+			dst.OptionalSint64 = new(int64)
+		}
+		*dst.OptionalSint64 = *m.OptionalSint64
+	}
+	dst.RepeatedFixed32 = append(dst.RepeatedFixed32[:0], m.RepeatedFixed32...)
+	if len(m.Children) > cap(dst.Children) {
+		tmpContainer := make([]*MethodsChild, len(m.Children))
+		copy(tmpContainer, dst.Children[:cap(dst.Children)])
+		dst.Children = tmpContainer
+	} else {
+		dst.Children = dst.Children[:len(m.Children)]
+	}
+	for k, v := range m.Children {
+		if dst.Children[k] == nil {
+			dst.Children[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.Children[k])
+		}
+	}
+	if dst.ChildMap == nil && len(m.ChildMap) > 0 {
+		dst.ChildMap = make(map[string]*MethodsChild, len(m.ChildMap))
+	}
+	for k := range dst.ChildMap {
+		if _, ok := m.ChildMap[k]; !ok {
+			delete(dst.ChildMap, k)
+		}
+	}
+	for k, v := range m.ChildMap {
+		if dst.ChildMap[k] == nil {
+			dst.ChildMap[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.ChildMap[k])
+		}
+	}
+	if m.Child == nil {
+		dst.Child = nil
+	} else {
+		if dst.Child == nil {
+			dst.Child = m.Child.CloneVT()
+		} else {
+			m.Child.CopyToVT(dst.Child)
+		}
+	}
+	if m.Wrapped == nil {
+		dst.Wrapped = nil
+	} else {
+		if dst.Wrapped == nil {
+			if vtpb, ok := interface{}(m.Wrapped).(interface {
+				CloneVT() *wrapperspb.StringValue
+			}); ok {
+				dst.Wrapped = vtpb.CloneVT()
+			} else {
+				dst.Wrapped = proto.Clone(m.Wrapped).(*wrapperspb.StringValue)
+			}
+		} else {
+			if vtpb, ok := interface{}(m.Wrapped).(interface{ CopyToVT(*wrapperspb.StringValue) }); ok {
+				vtpb.CopyToVT(dst.Wrapped)
+			} else {
+				proto.Reset(dst.Wrapped)
+				proto.Merge(dst.Wrapped, m.Wrapped)
+			}
+		}
+	}
+	switch c := m.Choice.(type) {
+	case *PlainMessage_OneofString:
+		if d, ok := dst.Choice.(*PlainMessage_OneofString); ok {
+			d.OneofString = c.OneofString
+		} else {
+			dst.Choice = c.CloneVT()
+		}
+	case *PlainMessage_OneofChild:
+		if d, ok := dst.Choice.(*PlainMessage_OneofChild); ok {
+			if d.OneofChild == nil {
+				d.OneofChild = c.OneofChild.CloneVT()
+			} else {
+				c.OneofChild.CopyToVT(d.OneofChild)
+			}
+		} else {
+			dst.Choice = c.CloneVT()
+		}
+	default:
+		dst.Choice = nil
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *PlainMessage_OneofString) CloneVT() isPlainMessage_Choice {
+	if m == nil {
+		return (*PlainMessage_OneofString)(nil)
+	}
+	r := &PlainMessage_OneofString{
+		OneofString: m.OneofString,
+	}
+	return r
+}
+
+func (m *PlainMessage_OneofChild) CloneVT() isPlainMessage_Choice {
+	if m == nil {
+		return (*PlainMessage_OneofChild)(nil)
+	}
+	r := &PlainMessage_OneofChild{
+		OneofChild: m.OneofChild.CloneVT(),
+	}
+	return r
+}
+
+func (this *PlainMessage) DiffVT(that *PlainMessage) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if this.Int32Value != that.Int32Value {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "int32_value", Kind: vtproto.FieldChanged, Old: this.Int32Value, New: that.Int32Value})
+	}
+	if this.StringValue != that.StringValue {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "string_value", Kind: vtproto.FieldChanged, Old: this.StringValue, New: that.StringValue})
+	}
+	if string(this.BytesValue) != string(that.BytesValue) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "bytes_value", Kind: vtproto.FieldChanged, Old: this.BytesValue, New: that.BytesValue})
+	}
+	if p, q := this.OptionalSint64, that.OptionalSint64; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sint64", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sint64", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sint64", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	for i := 0; i < len(this.RepeatedFixed32) && i < len(that.RepeatedFixed32); i++ {
+		if this.RepeatedFixed32[i] != that.RepeatedFixed32[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_fixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedFixed32[i], New: that.RepeatedFixed32[i]})
+		}
+	}
+	for i := len(that.RepeatedFixed32); i < len(this.RepeatedFixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_fixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedFixed32[i]})
+	}
+	for i := len(this.RepeatedFixed32); i < len(that.RepeatedFixed32); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_fixed32[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedFixed32[i]})
+	}
+	for i := 0; i < len(this.Children) && i < len(that.Children); i++ {
+		if p, q := this.Children[i], that.Children[i]; p != q {
+			if p == nil {
+				p = &MethodsChild{}
+			}
+			if q == nil {
+				q = &MethodsChild{}
+			}
+			if vtpb, ok := interface{}(p).(interface {
+				DiffVT(*MethodsChild) []vtproto.FieldDiff
+			}); ok {
+				if nested := vtpb.DiffVT(q); len(nested) > 0 {
+					diffs = protohelpers.AppendDiffs(diffs, "children["+strconv.Itoa(i)+"]", nested)
+				}
+			} else if !proto.Equal(p, q) {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "children[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: p, New: q})
+			}
+		}
+	}
+	for i := len(that.Children); i < len(this.Children); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "children[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.Children[i]})
+	}
+	for i := len(this.Children); i < len(that.Children); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "children[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.Children[i]})
+	}
+	if len(this.ChildMap) > 0 || len(that.ChildMap) > 0 {
+		keys := make([]string, 0, len(this.ChildMap)+len(that.ChildMap))
+		for k := range this.ChildMap {
+			keys = append(keys, k)
+		}
+		for k := range that.ChildMap {
+			if _, ok := this.ChildMap[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.ChildMap[k]
+			vy, yok := that.ChildMap[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "child_map[" + strconv.Quote(k) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "child_map[" + strconv.Quote(k) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if p, q := vx, vy; p != q {
+					if p == nil {
+						p = &MethodsChild{}
+					}
+					if q == nil {
+						q = &MethodsChild{}
+					}
+					if vtpb, ok := interface{}(p).(interface {
+						DiffVT(*MethodsChild) []vtproto.FieldDiff
+					}); ok {
+						if nested := vtpb.DiffVT(q); len(nested) > 0 {
+							diffs = protohelpers.AppendDiffs(diffs, "child_map["+strconv.Quote(k)+"]", nested)
+						}
+					} else if !proto.Equal(p, q) {
+						diffs = append(diffs, vtproto.FieldDiff{Path: "child_map[" + strconv.Quote(k) + "]", Kind: vtproto.FieldChanged, Old: p, New: q})
+					}
+				}
+			}
+		}
+	}
+	if p, q := this.Child, that.Child; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "child", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "child", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if vtpb, ok := interface{}(p).(interface {
+			DiffVT(*MethodsChild) []vtproto.FieldDiff
+		}); ok {
+			if nested := vtpb.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "child", nested)
+			}
+		} else if !proto.Equal(p, q) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "child", Kind: vtproto.FieldChanged, Old: p, New: q})
+		}
+	}
+	if p, q := this.Wrapped, that.Wrapped; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "wrapped", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "wrapped", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if vtpb, ok := interface{}(p).(interface {
+			DiffVT(*wrapperspb.StringValue) []vtproto.FieldDiff
+		}); ok {
+			if nested := vtpb.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "wrapped", nested)
+			}
+		} else if !proto.Equal(p, q) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "wrapped", Kind: vtproto.FieldChanged, Old: p, New: q})
+		}
+	}
+	if x, ok := this.Choice.(*PlainMessage_OneofString); ok {
+		if y, ok := that.Choice.(*PlainMessage_OneofString); ok {
+			if x.OneofString != y.OneofString {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_string", Kind: vtproto.FieldChanged, Old: x.OneofString, New: y.OneofString})
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_string", Kind: vtproto.FieldRemoved, Old: x.OneofString})
+		}
+	} else if y, ok := that.Choice.(*PlainMessage_OneofString); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_string", Kind: vtproto.FieldAdded, New: y.OneofString})
+	}
+	if x, ok := this.Choice.(*PlainMessage_OneofChild); ok {
+		if y, ok := that.Choice.(*PlainMessage_OneofChild); ok {
+			if p, q := x.OneofChild, y.OneofChild; p != q {
+				if p == nil {
+					p = &MethodsChild{}
+				}
+				if q == nil {
+					q = &MethodsChild{}
+				}
+				if vtpb, ok := interface{}(p).(interface {
+					DiffVT(*MethodsChild) []vtproto.FieldDiff
+				}); ok {
+					if nested := vtpb.DiffVT(q); len(nested) > 0 {
+						diffs = protohelpers.AppendDiffs(diffs, "oneof_child", nested)
+					}
+				} else if !proto.Equal(p, q) {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_child", Kind: vtproto.FieldChanged, Old: p, New: q})
+				}
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_child", Kind: vtproto.FieldRemoved, Old: x.OneofChild})
+		}
+	} else if y, ok := that.Choice.(*PlainMessage_OneofChild); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_child", Kind: vtproto.FieldAdded, New: y.OneofChild})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *MethodsChild) EqualVT(that *MethodsChild) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *MethodsChild) EqualVTWithOptions(that *MethodsChild, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	if this.Id != that.Id {
+		return false
+	}
+	if len(this.Tags) != len(that.Tags) {
+		return false
+	}
+	for i, vx := range this.Tags {
+		vy := that.Tags[i]
+		if vx != vy {
+			return false
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *MethodsChild) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*MethodsChild)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *MethodsMessage) EqualVT(that *MethodsMessage) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *MethodsMessage) EqualVTWithOptions(that *MethodsMessage, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	if this.Choice == nil && that.Choice != nil {
+		return false
+	} else if this.Choice != nil {
+		if that.Choice == nil {
+			return false
+		}
+		if !this.Choice.(interface {
+			EqualVTWithOptions(isMethodsMessage_Choice, vtproto.EqualOptions) bool
+		}).EqualVTWithOptions(that.Choice, opts) {
+			return false
+		}
+	}
+	if this.Int32Value != that.Int32Value {
+		return false
+	}
+	if this.StringValue != that.StringValue {
+		return false
+	}
+	if string(this.BytesValue) != string(that.BytesValue) {
+		return false
+	}
+	if p, q := this.OptionalSint64, that.OptionalSint64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if len(this.RepeatedFixed32) != len(that.RepeatedFixed32) {
+		return false
+	}
+	for i, vx := range this.RepeatedFixed32 {
+		vy := that.RepeatedFixed32[i]
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.Children) != len(that.Children) {
+		return false
+	}
+	for i, vx := range this.Children {
+		vy := that.Children[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &MethodsChild{}
+			}
+			if q == nil {
+				q = &MethodsChild{}
+			}
+			if !p.EqualVTWithOptions(q, opts) {
+				return false
+			}
+		}
+	}
+	if len(this.ChildMap) != len(that.ChildMap) {
+		return false
+	}
+	for i, vx := range this.ChildMap {
+		vy, ok := that.ChildMap[i]
+		if !ok {
+			return false
+		}
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &MethodsChild{}
+			}
+			if q == nil {
+				q = &MethodsChild{}
+			}
+			if !p.EqualVTWithOptions(q, opts) {
+				return false
+			}
+		}
+	}
+	if !this.Child.EqualVTWithOptions(that.Child, opts) {
+		return false
+	}
+	if equal, ok := interface{}(this.Wrapped).(interface {
+		EqualVTWithOptions(*wrapperspb.StringValue, vtproto.EqualOptions) bool
+	}); ok {
+		if !equal.EqualVTWithOptions(that.Wrapped, opts) {
+			return false
+		}
+	} else if equal, ok := interface{}(this.Wrapped).(interface {
+		EqualVT(*wrapperspb.StringValue) bool
+	}); ok {
+		if !equal.EqualVT(that.Wrapped) {
+			return false
+		}
+	} else if !proto.Equal(this.Wrapped, that.Wrapped) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *MethodsMessage) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*MethodsMessage)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *MethodsMessage_OneofString) EqualVT(thatIface isMethodsMessage_Choice) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *MethodsMessage_OneofString) EqualVTWithOptions(thatIface isMethodsMessage_Choice, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*MethodsMessage_OneofString)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.OneofString != that.OneofString {
+		return false
+	}
+	return true
+}
+
+func (this *MethodsMessage_OneofChild) EqualVT(thatIface isMethodsMessage_Choice) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *MethodsMessage_OneofChild) EqualVTWithOptions(thatIface isMethodsMessage_Choice, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*MethodsMessage_OneofChild)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if p, q := this.OneofChild, that.OneofChild; p != q {
+		if p == nil {
+			p = &MethodsChild{}
+		}
+		if q == nil {
+			q = &MethodsChild{}
+		}
+		if !p.EqualVTWithOptions(q, opts) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *PlainMessage) EqualVT(that *PlainMessage) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *PlainMessage) EqualVTWithOptions(that *PlainMessage, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	if this.Choice == nil && that.Choice != nil {
+		return false
+	} else if this.Choice != nil {
+		if that.Choice == nil {
+			return false
+		}
+		if !this.Choice.(interface {
+			EqualVTWithOptions(isPlainMessage_Choice, vtproto.EqualOptions) bool
+		}).EqualVTWithOptions(that.Choice, opts) {
+			return false
+		}
+	}
+	if this.Int32Value != that.Int32Value {
+		return false
+	}
+	if this.StringValue != that.StringValue {
+		return false
+	}
+	if string(this.BytesValue) != string(that.BytesValue) {
+		return false
+	}
+	if p, q := this.OptionalSint64, that.OptionalSint64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if len(this.RepeatedFixed32) != len(that.RepeatedFixed32) {
+		return false
+	}
+	for i, vx := range this.RepeatedFixed32 {
+		vy := that.RepeatedFixed32[i]
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.Children) != len(that.Children) {
+		return false
+	}
+	for i, vx := range this.Children {
+		vy := that.Children[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &MethodsChild{}
+			}
+			if q == nil {
+				q = &MethodsChild{}
+			}
+			if !p.EqualVTWithOptions(q, opts) {
+				return false
+			}
+		}
+	}
+	if len(this.ChildMap) != len(that.ChildMap) {
+		return false
+	}
+	for i, vx := range this.ChildMap {
+		vy, ok := that.ChildMap[i]
+		if !ok {
+			return false
+		}
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &MethodsChild{}
+			}
+			if q == nil {
+				q = &MethodsChild{}
+			}
+			if !p.EqualVTWithOptions(q, opts) {
+				return false
+			}
+		}
+	}
+	if !this.Child.EqualVTWithOptions(that.Child, opts) {
+		return false
+	}
+	if equal, ok := interface{}(this.Wrapped).(interface {
+		EqualVTWithOptions(*wrapperspb.StringValue, vtproto.EqualOptions) bool
+	}); ok {
+		if !equal.EqualVTWithOptions(that.Wrapped, opts) {
+			return false
+		}
+	} else if equal, ok := interface{}(this.Wrapped).(interface {
+		EqualVT(*wrapperspb.StringValue) bool
+	}); ok {
+		if !equal.EqualVT(that.Wrapped) {
+			return false
+		}
+	} else if !proto.Equal(this.Wrapped, that.Wrapped) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *PlainMessage) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*PlainMessage)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *PlainMessage_OneofString) EqualVT(thatIface isPlainMessage_Choice) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *PlainMessage_OneofString) EqualVTWithOptions(thatIface isPlainMessage_Choice, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*PlainMessage_OneofString)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.OneofString != that.OneofString {
+		return false
+	}
+	return true
+}
+
+func (this *PlainMessage_OneofChild) EqualVT(thatIface isPlainMessage_Choice) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *PlainMessage_OneofChild) EqualVTWithOptions(thatIface isPlainMessage_Choice, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*PlainMessage_OneofChild)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if p, q := this.OneofChild, that.OneofChild; p != q {
+		if p == nil {
+			p = &MethodsChild{}
+		}
+		if q == nil {
+			q = &MethodsChild{}
+		}
+		if !p.EqualVTWithOptions(q, opts) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *PlainMessage) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *PlainMessage) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	var entry hash.Hash64
+	if m.Int32Value != 0 {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(m.Int32Value))
+	}
+	if m.StringValue != "" {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashString(h, s, m.StringValue)
+	}
+	if len(m.BytesValue) > 0 {
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashBytes(h, s, m.BytesValue)
+	}
+	if m.OptionalSint64 != nil {
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalSint64))
+	}
+	if len(m.RepeatedFixed32) > 0 {
+		protohelpers.HashUint64(h, s, 5)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedFixed32)))
+		for _, v := range m.RepeatedFixed32 {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.Children) > 0 {
+		protohelpers.HashUint64(h, s, 6)
+		protohelpers.HashUint64(h, s, uint64(len(m.Children)))
+		for _, v := range m.Children {
+			if vtpb, ok := interface{}(v).(interface{ HashVT(hash.Hash64) }); ok {
+				vtpb.HashVT(h)
+			} else {
+				protohelpers.HashMessage(h, v)
+			}
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if len(m.ChildMap) > 0 {
+		protohelpers.HashUint64(h, s, 7)
+		protohelpers.HashUint64(h, s, uint64(len(m.ChildMap)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.ChildMap {
+			entry.Reset()
+			protohelpers.HashString(entry, s, k)
+			if vtpb, ok := interface{}(v).(interface{ HashVT(hash.Hash64) }); ok {
+				vtpb.HashVT(entry)
+			} else {
+				protohelpers.HashMessage(entry, v)
+			}
+			protohelpers.HashUint64(entry, s, 0)
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	if m.Child != nil {
+		protohelpers.HashUint64(h, s, 8)
+		if vtpb, ok := interface{}(m.Child).(interface{ HashVT(hash.Hash64) }); ok {
+			vtpb.HashVT(h)
+		} else {
+			protohelpers.HashMessage(h, m.Child)
+		}
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.Wrapped != nil {
+		protohelpers.HashUint64(h, s, 9)
+		if vtpb, ok := interface{}(m.Wrapped).(interface{ HashVT(hash.Hash64) }); ok {
+			vtpb.HashVT(h)
+		} else {
+			protohelpers.HashMessage(h, m.Wrapped)
+		}
+		protohelpers.HashUint64(h, s, 0)
+	}
+	switch c := m.Choice.(type) {
+	case *PlainMessage_OneofString:
+		protohelpers.HashUint64(h, s, 10)
+		protohelpers.HashString(h, s, c.OneofString)
+	case *PlainMessage_OneofChild:
+		protohelpers.HashUint64(h, s, 11)
+		if vtpb, ok := interface{}(c.OneofChild).(interface{ HashVT(hash.Hash64) }); ok {
+			vtpb.HashVT(h)
+		} else {
+			protohelpers.HashMessage(h, c.OneofChild)
+		}
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *PlainMessage) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *PlainMessage) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &PlainMessage{}
+	}
+	w.ObjectStart()
+	if m.Int32Value != 0 {
+		w.Field("int32Value", "int32_value")
+		w.Int32(m.Int32Value)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("int32Value", "int32_value")
+		w.Int32(m.GetInt32Value())
+	}
+	if m.StringValue != "" {
+		w.Field("stringValue", "string_value")
+		if err := w.String(m.StringValue); err != nil {
+			return errors.New("proto: field PlainMessage.string_value contains invalid UTF-8")
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("stringValue", "string_value")
+		if err := w.String(m.GetStringValue()); err != nil {
+			return errors.New("proto: field PlainMessage.string_value contains invalid UTF-8")
+		}
+	}
+	if len(m.BytesValue) > 0 {
+		w.Field("bytesValue", "bytes_value")
+		w.Base64(m.BytesValue)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("bytesValue", "bytes_value")
+		w.Base64(m.GetBytesValue())
+	}
+	if m.OptionalSint64 != nil {
+		w.Field("optionalSint64", "optional_sint64")
+		w.Int64(*m.OptionalSint64)
+	}
+	if len(m.RepeatedFixed32) > 0 {
+		w.Field("repeatedFixed32", "repeated_fixed32")
+		w.ArrayStart()
+		for _, v := range m.RepeatedFixed32 {
+			w.Uint32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedFixed32", "repeated_fixed32")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.Children) > 0 {
+		w.Field("children", "children")
+		w.ArrayStart()
+		for _, v := range m.Children {
+			if err := w.Message(v); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("children", "children")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.ChildMap) > 0 {
+		w.Field("childMap", "child_map")
+		keys := make([]string, 0, len(m.ChildMap))
+		for k := range m.ChildMap {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		w.ObjectStart()
+		for _, k := range keys {
+			if err := w.Name(k); err != nil {
+				return errors.New("proto: field PlainMessage.ChildMapEntry.key contains invalid UTF-8")
+			}
+			if err := w.Message(m.ChildMap[k]); err != nil {
+				return err
+			}
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("childMap", "child_map")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if m.Child != nil {
+		w.Field("child", "child")
+		if err := w.Message(m.Child); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("child", "child")
+		w.Null()
+	}
+	if m.Wrapped != nil {
+		w.Field("wrapped", "wrapped")
+		if err := w.Message(m.Wrapped); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("wrapped", "wrapped")
+		w.Null()
+	}
+	if c, ok := m.Choice.(*PlainMessage_OneofString); ok {
+		w.Field("oneofString", "oneof_string")
+		if err := w.String(c.OneofString); err != nil {
+			return errors.New("proto: field PlainMessage.oneof_string contains invalid UTF-8")
+		}
+	}
+	if c, ok := m.Choice.(*PlainMessage_OneofChild); ok {
+		w.Field("oneofChild", "oneof_child")
+		if err := w.Message(c.OneofChild); err != nil {
+			return err
+		}
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *PlainMessage) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *PlainMessage) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [13]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "int32Value", "int32_value":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Int32Value = v
+		case "stringValue", "string_value":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.StringValue = v
+		case "bytesValue", "bytes_value":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadBytes()
+			if err != nil {
+				return err
+			}
+			m.BytesValue = v
+		case "optionalSint64", "optional_sint64":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt64()
+			if err != nil {
+				return err
+			}
+			m.OptionalSint64 = &v
+		case "repeatedFixed32", "repeated_fixed32":
+			if seen[4] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[4] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint32()
+				if err != nil {
+					return err
+				}
+				m.RepeatedFixed32 = append(m.RepeatedFixed32, v)
+			}
+		case "children":
+			if seen[5] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[5] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(MethodsChild)
+				if err := r.ReadMessage(v); err != nil {
+					return err
+				}
+				m.Children = append(m.Children, v)
+			}
+		case "childMap", "child_map":
+			if seen[6] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[6] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.ChildMap == nil {
+				m.ChildMap = make(map[string]*MethodsChild)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k := name
+				if _, ok := m.ChildMap[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v := new(MethodsChild)
+				if err := r.ReadMessage(v); err != nil {
+					return err
+				}
+				m.ChildMap[k] = v
+			}
+		case "child":
+			if seen[7] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[7] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(MethodsChild)
+			if err := r.ReadMessage(v); err != nil {
+				return err
+			}
+			m.Child = v
+		case "wrapped":
+			if seen[8] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[8] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(wrapperspb.StringValue)
+			if err := r.ReadMessage(v); err != nil {
+				return err
+			}
+			m.Wrapped = v
+		case "oneofString", "oneof_string":
+			if seen[9] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[9] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[11] {
+				return r.Errorf("oneof PlainMessage.choice is already set")
+			}
+			seen[11] = true
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.Choice = &PlainMessage_OneofString{OneofString: v}
+		case "oneofChild", "oneof_child":
+			if seen[10] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[10] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[11] {
+				return r.Errorf("oneof PlainMessage.choice is already set")
+			}
+			seen[11] = true
+			v := new(MethodsChild)
+			if err := r.ReadMessage(v); err != nil {
+				return err
+			}
+			m.Choice = &PlainMessage_OneofChild{OneofChild: v}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *MethodsChild) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *MethodsChild) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *MethodsChild) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *MethodsChild) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *MethodsChild) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Id))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MethodsMessage) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *MethodsMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *MethodsMessage) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *MethodsMessage) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *MethodsMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if vtmsg, ok := m.Choice.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if m.Wrapped != nil {
+		if vtmsg, ok := interface{}(m.Wrapped).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		} else {
+			encoded, err := proto.Marshal(m.Wrapped)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x4a
+	}
+	if m.Child != nil {
+		size, err := m.Child.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x42
+	}
+	if len(m.ChildMap) > 0 {
+		for k := range m.ChildMap {
+			v := m.ChildMap[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+			i -= len(k)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Children) > 0 {
+		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Children[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RepeatedFixed32) > 0 {
+		for iNdEx := len(m.RepeatedFixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.RepeatedFixed32[iNdEx]))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.RepeatedFixed32)*4))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x2a
+	}
+	if m.OptionalSint64 != nil {
+		i = encodeVarint(dAtA, i, uint64((uint64(*m.OptionalSint64)<<1)^uint64((*m.OptionalSint64>>63))))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x20
+	}
+	if len(m.BytesValue) > 0 {
+		i -= len(m.BytesValue)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.BytesValue)
+		i = encodeVarint(dAtA, i, uint64(len(m.BytesValue)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x1a
+	}
+	if len(m.StringValue) > 0 {
+		i -= len(m.StringValue)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.StringValue)
+		i = encodeVarint(dAtA, i, uint64(len(m.StringValue)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x12
+	}
+	if m.Int32Value != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Int32Value))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MethodsMessage_OneofString) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *MethodsMessage_OneofString) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *MethodsMessage_OneofString) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.OneofString)
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	copy(dAtA[i:], m.OneofString)
+	i = encodeVarint(dAtA, i, uint64(len(m.OneofString)))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i--
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0x52
+	return len(dAtA) - i, nil
+}
+func (m *MethodsMessage_OneofChild) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *MethodsMessage_OneofChild) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *MethodsMessage_OneofChild) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OneofChild != nil {
+		size, err := m.OneofChild.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PlainMessage) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *PlainMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *PlainMessage) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *PlainMessage) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *PlainMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if vtmsg, ok := m.Choice.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if m.Wrapped != nil {
+		if vtmsg, ok := interface{}(m.Wrapped).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		} else {
+			encoded, err := proto.Marshal(m.Wrapped)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x4a
+	}
+	if m.Child != nil {
+		size, err := m.Child.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x42
+	}
+	if len(m.ChildMap) > 0 {
+		for k := range m.ChildMap {
+			v := m.ChildMap[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+			i -= len(k)
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Children) > 0 {
+		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Children[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RepeatedFixed32) > 0 {
+		for iNdEx := len(m.RepeatedFixed32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.RepeatedFixed32[iNdEx]))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.RepeatedFixed32)*4))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x2a
+	}
+	if m.OptionalSint64 != nil {
+		i = encodeVarint(dAtA, i, uint64((uint64(*m.OptionalSint64)<<1)^uint64((*m.OptionalSint64>>63))))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x20
+	}
+	if len(m.BytesValue) > 0 {
+		i -= len(m.BytesValue)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.BytesValue)
+		i = encodeVarint(dAtA, i, uint64(len(m.BytesValue)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x1a
+	}
+	if len(m.StringValue) > 0 {
+		i -= len(m.StringValue)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.StringValue)
+		i = encodeVarint(dAtA, i, uint64(len(m.StringValue)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x12
+	}
+	if m.Int32Value != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Int32Value))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlainMessage_OneofString) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *PlainMessage_OneofString) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *PlainMessage_OneofString) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.OneofString)
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	copy(dAtA[i:], m.OneofString)
+	i = encodeVarint(dAtA, i, uint64(len(m.OneofString)))
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	i--
+	if i < 0 {
+		return 0, ErrBufferTooSmall
+	}
+	dAtA[i] = 0x52
+	return len(dAtA) - i, nil
+}
+func (m *PlainMessage_OneofChild) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *PlainMessage_OneofChild) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *PlainMessage_OneofChild) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OneofChild != nil {
+		size, err := m.OneofChild.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+
+var (
+	ErrBufferTooSmall = fmt.Errorf("proto: buffer too small to marshal the message")
+	ErrSizeMismatch   = fmt.Errorf("proto: message size changed while marshaling")
+)
+
+func encodeVarint(dAtA []byte, offset int, v uint64) int {
+	offset -= sov(v)
+	if offset < 0 {
+		return offset
+	}
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MethodsChild) MergeVT(src *MethodsChild) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Id != 0 {
+		m.Id = src.Id
+	}
+	m.Tags = append(m.Tags, src.Tags...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *MethodsChild) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*MethodsChild); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *MethodsMessage) MergeVT(src *MethodsMessage) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Int32Value != 0 {
+		m.Int32Value = src.Int32Value
+	}
+	if src.StringValue != "" {
+		m.StringValue = src.StringValue
+	}
+	if len(src.BytesValue) > 0 {
+		m.BytesValue = append([]byte{}, src.BytesValue...)
+	}
+	if src.OptionalSint64 != nil {
+		tmpVal := *src.OptionalSint64
+		m.OptionalSint64 = &tmpVal
+	}
+	m.RepeatedFixed32 = append(m.RepeatedFixed32, src.RepeatedFixed32...)
+	for _, v := range src.Children {
+		tmpMsg := &MethodsChild{}
+		tmpMsg.MergeVT(v)
+		m.Children = append(m.Children, tmpMsg)
+	}
+	if len(src.ChildMap) > 0 {
+		if m.ChildMap == nil {
+			m.ChildMap = make(map[string]*MethodsChild, len(src.ChildMap))
+		}
+		for k, v := range src.ChildMap {
+			tmpMsg := &MethodsChild{}
+			tmpMsg.MergeVT(v)
+			m.ChildMap[k] = tmpMsg
+		}
+	}
+	if src.Child != nil {
+		if m.Child == nil {
+			m.Child = &MethodsChild{}
+		}
+		m.Child.MergeVT(src.Child)
+	}
+	if src.Wrapped != nil {
+		if m.Wrapped == nil {
+			m.Wrapped = &wrapperspb.StringValue{}
+		}
+		if vtpb, ok := interface{}(m.Wrapped).(interface{ MergeVT(*wrapperspb.StringValue) }); ok {
+			vtpb.MergeVT(src.Wrapped)
+		} else {
+			proto.Merge(m.Wrapped, src.Wrapped)
+		}
+	}
+	switch srcOneof := src.Choice.(type) {
+	case *MethodsMessage_OneofString:
+		m.Choice = &MethodsMessage_OneofString{OneofString: srcOneof.OneofString}
+	case *MethodsMessage_OneofChild:
+		if dstOneof, ok := m.Choice.(*MethodsMessage_OneofChild); ok && dstOneof.OneofChild != nil {
+			if srcOneof.OneofChild != nil {
+				dstOneof.OneofChild.MergeVT(srcOneof.OneofChild)
+			}
+		} else {
+			tmpMsg := &MethodsChild{}
+			tmpMsg.MergeVT(srcOneof.OneofChild)
+			m.Choice = &MethodsMessage_OneofChild{OneofChild: tmpMsg}
+		}
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *MethodsMessage) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*MethodsMessage); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *PlainMessage) MergeVT(src *PlainMessage) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Int32Value != 0 {
+		m.Int32Value = src.Int32Value
+	}
+	if src.StringValue != "" {
+		m.StringValue = src.StringValue
+	}
+	if len(src.BytesValue) > 0 {
+		m.BytesValue = append([]byte{}, src.BytesValue...)
+	}
+	if src.OptionalSint64 != nil {
+		tmpVal := *src.OptionalSint64
+		m.OptionalSint64 = &tmpVal
+	}
+	m.RepeatedFixed32 = append(m.RepeatedFixed32, src.RepeatedFixed32...)
+	for _, v := range src.Children {
+		tmpMsg := &MethodsChild{}
+		tmpMsg.MergeVT(v)
+		m.Children = append(m.Children, tmpMsg)
+	}
+	if len(src.ChildMap) > 0 {
+		if m.ChildMap == nil {
+			m.ChildMap = make(map[string]*MethodsChild, len(src.ChildMap))
+		}
+		for k, v := range src.ChildMap {
+			tmpMsg := &MethodsChild{}
+			tmpMsg.MergeVT(v)
+			m.ChildMap[k] = tmpMsg
+		}
+	}
+	if src.Child != nil {
+		if m.Child == nil {
+			m.Child = &MethodsChild{}
+		}
+		m.Child.MergeVT(src.Child)
+	}
+	if src.Wrapped != nil {
+		if m.Wrapped == nil {
+			m.Wrapped = &wrapperspb.StringValue{}
+		}
+		if vtpb, ok := interface{}(m.Wrapped).(interface{ MergeVT(*wrapperspb.StringValue) }); ok {
+			vtpb.MergeVT(src.Wrapped)
+		} else {
+			proto.Merge(m.Wrapped, src.Wrapped)
+		}
+	}
+	switch srcOneof := src.Choice.(type) {
+	case *PlainMessage_OneofString:
+		m.Choice = &PlainMessage_OneofString{OneofString: srcOneof.OneofString}
+	case *PlainMessage_OneofChild:
+		if dstOneof, ok := m.Choice.(*PlainMessage_OneofChild); ok && dstOneof.OneofChild != nil {
+			if srcOneof.OneofChild != nil {
+				dstOneof.OneofChild.MergeVT(srcOneof.OneofChild)
+			}
+		} else {
+			tmpMsg := &MethodsChild{}
+			tmpMsg.MergeVT(srcOneof.OneofChild)
+			m.Choice = &PlainMessage_OneofChild{OneofChild: tmpMsg}
+		}
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *PlainMessage) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*PlainMessage); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func init() {
+	vtproto.RegisterMethods((*MethodsChild)(nil), false)
+	vtproto.RegisterMethods((*MethodsMessage)(nil), false)
+}
+
+func (m *MethodsChild) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sov(uint64(m.Id))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *MethodsMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Int32Value != 0 {
+		n += 1 + sov(uint64(m.Int32Value))
+	}
+	l = len(m.StringValue)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.BytesValue)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.OptionalSint64 != nil {
+		n += 1 + soz(uint64(*m.OptionalSint64))
+	}
+	if len(m.RepeatedFixed32) > 0 {
+		n += 1 + sov(uint64(len(m.RepeatedFixed32)*4)) + len(m.RepeatedFixed32)*4
+	}
+	if len(m.Children) > 0 {
+		for _, e := range m.Children {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.ChildMap) > 0 {
+		for k, v := range m.ChildMap {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.SizeVT()
+			}
+			l += 1 + sov(uint64(l))
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if m.Child != nil {
+		l = m.Child.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.Wrapped != nil {
+		if size, ok := interface{}(m.Wrapped).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.Wrapped)
+		}
+		n += 1 + l + sov(uint64(l))
+	}
+	if vtmsg, ok := m.Choice.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *MethodsMessage_OneofString) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OneofString)
+	n += 1 + l + sov(uint64(l))
+	return n
+}
+func (m *MethodsMessage_OneofChild) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OneofChild != nil {
+		l = m.OneofChild.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *PlainMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Int32Value != 0 {
+		n += 1 + sov(uint64(m.Int32Value))
+	}
+	l = len(m.StringValue)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.BytesValue)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.OptionalSint64 != nil {
+		n += 1 + soz(uint64(*m.OptionalSint64))
+	}
+	if len(m.RepeatedFixed32) > 0 {
+		n += 1 + sov(uint64(len(m.RepeatedFixed32)*4)) + len(m.RepeatedFixed32)*4
+	}
+	if len(m.Children) > 0 {
+		for _, e := range m.Children {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.ChildMap) > 0 {
+		for k, v := range m.ChildMap {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.SizeVT()
+			}
+			l += 1 + sov(uint64(l))
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if m.Child != nil {
+		l = m.Child.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.Wrapped != nil {
+		if size, ok := interface{}(m.Wrapped).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.Wrapped)
+		}
+		n += 1 + l + sov(uint64(l))
+	}
+	if vtmsg, ok := m.Choice.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *PlainMessage_OneofString) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OneofString)
+	n += 1 + l + sov(uint64(l))
+	return n
+}
+func (m *PlainMessage_OneofChild) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OneofChild != nil {
+		l = m.OneofChild.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+
+func sov(x uint64) (n int) {
+	return (bits.Len64(x|1) + 6) / 7
+}
+func soz(x uint64) (n int) {
+	return sov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MethodsChild) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *MethodsChild) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *MethodsChild) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *MethodsChild) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*MethodsChild)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MethodsChild: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MethodsChild: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.Tags = append(m.Tags, string(string(dAtA[iNdEx:postIndex])))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MethodsMessage) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *MethodsMessage) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *MethodsMessage) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *MethodsMessage) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*MethodsMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MethodsMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MethodsMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Value", wireType)
+			}
+			m.Int32Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Int32Value |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.StringValue = string(string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BytesValue = append(m.BytesValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.BytesValue == nil {
+				m.BytesValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			v2 := int64(v)
+			m.OptionalSint64 = &v2
+		case 5:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.RepeatedFixed32 = append(m.RepeatedFixed32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.RepeatedFixed32) == 0 {
+					m.RepeatedFixed32 = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.RepeatedFixed32 = append(m.RepeatedFixed32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedFixed32", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, &MethodsChild{})
+			if err := m.Children[len(m.Children)-1].unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChildMap == nil {
+				m.ChildMap = make(map[string]*MethodsChild)
+			}
+			var mapkey string
+			var mapvalue *MethodsChild
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MethodsChild{}
+					if err := mapvalue.unmarshalVT(dAtA[iNdEx:postmsgIndex], opts, depth); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ChildMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Child", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Child == nil {
+				m.Child = &MethodsChild{}
+			}
+			if err := m.Child.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wrapped", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Wrapped == nil {
+				m.Wrapped = &wrapperspb.StringValue{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.Wrapped).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.Wrapped); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.Choice = &MethodsMessage_OneofString{OneofString: string(string(dAtA[iNdEx:postIndex]))}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofChild", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.Choice.(*MethodsMessage_OneofChild); ok {
+				if err := oneof.OneofChild.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+					return err
+				}
+			} else {
+				v := &MethodsChild{}
+				if err := v.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+					return err
+				}
+				m.Choice = &MethodsMessage_OneofChild{OneofChild: v}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlainMessage) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *PlainMessage) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *PlainMessage) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *PlainMessage) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*PlainMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlainMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlainMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Value", wireType)
+			}
+			m.Int32Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Int32Value |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.StringValue = string(string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BytesValue = append(m.BytesValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.BytesValue == nil {
+				m.BytesValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			v2 := int64(v)
+			m.OptionalSint64 = &v2
+		case 5:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.RepeatedFixed32 = append(m.RepeatedFixed32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.RepeatedFixed32) == 0 {
+					m.RepeatedFixed32 = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.RepeatedFixed32 = append(m.RepeatedFixed32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedFixed32", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, &MethodsChild{})
+			if err := m.Children[len(m.Children)-1].unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChildMap == nil {
+				m.ChildMap = make(map[string]*MethodsChild)
+			}
+			var mapkey string
+			var mapvalue *MethodsChild
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MethodsChild{}
+					if err := mapvalue.unmarshalVT(dAtA[iNdEx:postmsgIndex], opts, depth); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ChildMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Child", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Child == nil {
+				m.Child = &MethodsChild{}
+			}
+			if err := m.Child.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wrapped", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Wrapped == nil {
+				m.Wrapped = &wrapperspb.StringValue{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.Wrapped).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.Wrapped); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.Choice = &PlainMessage_OneofString{OneofString: string(string(dAtA[iNdEx:postIndex]))}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofChild", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.Choice.(*PlainMessage_OneofChild); ok {
+				if err := oneof.OneofChild.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+					return err
+				}
+			} else {
+				v := &MethodsChild{}
+				if err := v.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+					return err
+				}
+				m.Choice = &PlainMessage_OneofChild{OneofChild: v}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skip(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLength
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroup
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLength
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLength          = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflow            = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroup   = fmt.Errorf("proto: unexpected end of group")
+	ErrInvalidUTF8            = fmt.Errorf("proto: string field contains invalid UTF-8")
+	ErrRecursionLimitExceeded = fmt.Errorf("proto: exceeded maximum recursion depth")
+)
+
+func (m *PlainMessage) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *PlainMessage) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *PlainMessage) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*PlainMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlainMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlainMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Int32Value", wireType)
+			}
+			m.Int32Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Int32Value |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StringValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.StringValue = string(stringValue)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BytesValue = dAtA[iNdEx:postIndex:postIndex]
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			v2 := int64(v)
+			m.OptionalSint64 = &v2
+		case 5:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.RepeatedFixed32 = append(m.RepeatedFixed32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.RepeatedFixed32) == 0 {
+					m.RepeatedFixed32 = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.RepeatedFixed32 = append(m.RepeatedFixed32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedFixed32", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, &MethodsChild{})
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.Children[len(m.Children)-1]).(interface {
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.Children[len(m.Children)-1]).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.Children[len(m.Children)-1]); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChildMap == nil {
+				m.ChildMap = make(map[string]*MethodsChild)
+			}
+			var mapkey string
+			var mapvalue *MethodsChild
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					if !utf8.Valid(dAtA[iNdEx:postStringIndexmapkey]) {
+						return ErrInvalidUTF8
+					}
+					if intStringLenmapkey > 0 {
+						mapkey = unsafe.String(&dAtA[iNdEx], intStringLenmapkey)
+					}
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MethodsChild{}
+					if depth == 0 {
+						return ErrRecursionLimitExceeded
+					}
+					if unmarshal, ok := interface{}(mapvalue).(interface {
+						UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+					}); ok {
+						if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postmsgIndex], vtproto.UnmarshalOptions{
+							Merge:          true,
+							AllowPartial:   opts.AllowPartial,
+							DiscardUnknown: opts.DiscardUnknown,
+							RecursionLimit: depth,
+						}); err != nil {
+							return err
+						}
+					} else if unmarshal, ok := interface{}(mapvalue).(interface {
+						UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+					}); ok {
+						if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postmsgIndex], vtproto.UnmarshalOptions{
+							Merge:          true,
+							AllowPartial:   opts.AllowPartial,
+							DiscardUnknown: opts.DiscardUnknown,
+							RecursionLimit: depth,
+						}); err != nil {
+							return err
+						}
+					} else {
+						if err := (proto.UnmarshalOptions{
+							Merge:          true,
+							AllowPartial:   opts.AllowPartial,
+							DiscardUnknown: opts.DiscardUnknown,
+							RecursionLimit: depth,
+						}).Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+							return err
+						}
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ChildMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Child", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Child == nil {
+				m.Child = &MethodsChild{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.Child).(interface {
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.Child).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.Child); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wrapped", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Wrapped == nil {
+				m.Wrapped = &wrapperspb.StringValue{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.Wrapped).(interface {
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.Wrapped).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.Wrapped); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.Choice = &PlainMessage_OneofString{OneofString: string(stringValue)}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofChild", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.Choice.(*PlainMessage_OneofChild); ok {
+				if depth == 0 {
+					return ErrRecursionLimitExceeded
+				}
+				if unmarshal, ok := interface{}(oneof.OneofChild).(interface {
+					UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+				}); ok {
+					if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+						Merge:          true,
+						AllowPartial:   opts.AllowPartial,
+						DiscardUnknown: opts.DiscardUnknown,
+						RecursionLimit: depth,
+					}); err != nil {
+						return err
+					}
+				} else if unmarshal, ok := interface{}(oneof.OneofChild).(interface {
+					UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+				}); ok {
+					if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+						Merge:          true,
+						AllowPartial:   opts.AllowPartial,
+						DiscardUnknown: opts.DiscardUnknown,
+						RecursionLimit: depth,
+					}); err != nil {
+						return err
+					}
+				} else {
+					if err := (proto.UnmarshalOptions{
+						Merge:          true,
+						AllowPartial:   opts.AllowPartial,
+						DiscardUnknown: opts.DiscardUnknown,
+						RecursionLimit: depth,
+					}).Unmarshal(dAtA[iNdEx:postIndex], oneof.OneofChild); err != nil {
+						return err
+					}
+				}
+			} else {
+				v := &MethodsChild{}
+				if depth == 0 {
+					return ErrRecursionLimitExceeded
+				}
+				if unmarshal, ok := interface{}(v).(interface {
+					UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+				}); ok {
+					if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+						Merge:          true,
+						AllowPartial:   opts.AllowPartial,
+						DiscardUnknown: opts.DiscardUnknown,
+						RecursionLimit: depth,
+					}); err != nil {
+						return err
+					}
+				} else if unmarshal, ok := interface{}(v).(interface {
+					UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+				}); ok {
+					if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+						Merge:          true,
+						AllowPartial:   opts.AllowPartial,
+						DiscardUnknown: opts.DiscardUnknown,
+						RecursionLimit: depth,
+					}); err != nil {
+						return err
+					}
+				} else {
+					if err := (proto.UnmarshalOptions{
+						Merge:          true,
+						AllowPartial:   opts.AllowPartial,
+						DiscardUnknown: opts.DiscardUnknown,
+						RecursionLimit: depth,
+					}).Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+						return err
+					}
+				}
+				m.Choice = &PlainMessage_OneofChild{OneofChild: v}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package pool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func newSlice2() *Slice2 {
+	return &Slice2{
+		A: map[int64]int64{1: 2, 3: 4},
+		B: proto.Int32(5),
+		C: []string{"one", "two"},
+		D: &Element2{A: 6},
+		E: "something",
+		F: 7,
+	}
+}
+
+func TestCloneVTFromPool(t *testing.T) {
+	pooled := Test1FromVTPool()
+	pooled.Sl = append(pooled.Sl, "x", "y", "z")
+	pooled.ReturnToVTPool()
+
+	src := &Test1{Sl: []string{"a", "b"}}
+	clone := src.CloneVT()
+	require.Same(t, pooled, clone)
+	require.True(t, proto.Equal(src, clone))
+
+	clone.Sl[0] = "changed"
+	require.Equal(t, "a", src.Sl[0])
+
+	require.Nil(t, (*Test1)(nil).CloneVT())
+}
+
+func TestCopyToVT(t *testing.T) {
+	src := &Test2{Sl: []*Slice2{newSlice2(), {E: "second"}}}
+
+	dst := &Test2{Sl: []*Slice2{{
+		A: map[int64]int64{1: 0, 8: 9},
+		C: make([]string, 0, 4),
+		D: &Element2{A: 10},
+		F: 11,
+	}}}
+	first, element, list := dst.Sl[0], dst.Sl[0].D, dst.Sl[0].C[:1]
+
+	src.CopyToVT(dst)
+	require.True(t, proto.Equal(src, dst))
+	require.Same(t, first, dst.Sl[0])
+	require.Same(t, element, dst.Sl[0].D)
+	require.Same(t, &list[0], &dst.Sl[0].C[0])
+
+	src.Sl[0].A[1] = 100
+	*src.Sl[0].B = 100
+	src.Sl[0].C[0] = "changed"
+	src.Sl[0].D.A = 100
+	require.True(t, proto.Equal(newSlice2(), dst.Sl[0]))
+}
+
+func TestCopyToVTClearsFields(t *testing.T) {
+	dst := newSlice2()
+	(&Slice2{E: "only"}).CopyToVT(dst)
+	require.True(t, proto.Equal(&Slice2{E: "only"}, dst))
+	require.Nil(t, dst.B)
+	require.Nil(t, dst.D)
+	require.Empty(t, dst.A)
+	require.Empty(t, dst.C)
+
+	(*Slice2)(nil).CopyToVT(dst)
+	require.True(t, proto.Equal(&Slice2{}, dst))
+}
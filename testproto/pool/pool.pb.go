@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.26.0
+// 	protoc-gen-go v1.34.2
 // 	protoc        v3.20.0
 // source: pool/pool.proto
 
@@ -105,7 +105,7 @@ func file_pool_pool_proto_rawDescGZIP() []byte {
 }
 
 var file_pool_pool_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_pool_pool_proto_goTypes = []interface{}{
+var file_pool_pool_proto_goTypes = []any{
 	(*MemoryPoolExtension)(nil), // 0: MemoryPoolExtension
 }
 var file_pool_pool_proto_depIdxs = []int32{
@@ -122,7 +122,7 @@ func file_pool_pool_proto_init() {
 		return
 	}
 	if !protoimpl.UnsafeEnabled {
-		file_pool_pool_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+		file_pool_pool_proto_msgTypes[0].Exporter = func(v any, i int) any {
 			switch v := v.(*MemoryPoolExtension); i {
 			case 0:
 				return &v.state
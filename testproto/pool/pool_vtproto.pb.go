@@ -5,12 +5,19 @@
 package pool
 
 import (
+	errors "errors"
 	fmt "fmt"
+	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
+	vtproto "github.com/planetscale/vtprotobuf/vtproto"
+	protowire "google.golang.org/protobuf/encoding/protowire"
 	proto "google.golang.org/protobuf/proto"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	hash "hash"
 	io "io"
 	bits "math/bits"
 	sync "sync"
+	utf8 "unicode/utf8"
+	unsafe "unsafe"
 )
 
 const (
@@ -24,14 +31,8 @@ func (m *MemoryPoolExtension) CloneVT() *MemoryPoolExtension {
 	if m == nil {
 		return (*MemoryPoolExtension)(nil)
 	}
-	r := &MemoryPoolExtension{
-		Foo1: m.Foo1,
-		Foo2: m.Foo2,
-	}
-	if len(m.unknownFields) > 0 {
-		r.unknownFields = make([]byte, len(m.unknownFields))
-		copy(r.unknownFields, m.unknownFields)
-	}
+	r := MemoryPoolExtensionFromVTPool()
+	m.CopyToVT(r)
 	return r
 }
 
@@ -39,7 +40,42 @@ func (m *MemoryPoolExtension) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *MemoryPoolExtension) CopyToVT(dst *MemoryPoolExtension) {
+	if m == nil {
+		dst.ResetVT()
+		return
+	}
+	dst.Foo1 = m.Foo1
+	dst.Foo2 = m.Foo2
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (this *MemoryPoolExtension) DiffVT(that *MemoryPoolExtension) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if this.Foo1 != that.Foo1 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "foo1", Kind: vtproto.FieldChanged, Old: this.Foo1, New: that.Foo1})
+	}
+	if this.Foo2 != that.Foo2 {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "foo2", Kind: vtproto.FieldChanged, Old: this.Foo2, New: that.Foo2})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
 func (this *MemoryPoolExtension) EqualVT(that *MemoryPoolExtension) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *MemoryPoolExtension) EqualVTWithOptions(that *MemoryPoolExtension, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
@@ -51,7 +87,125 @@ func (this *MemoryPoolExtension) EqualVT(that *MemoryPoolExtension) bool {
 	if this.Foo2 != that.Foo2 {
 		return false
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *MemoryPoolExtension) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*MemoryPoolExtension)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (m *MemoryPoolExtension) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *MemoryPoolExtension) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	if m.Foo1 != "" {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashString(h, s, m.Foo1)
+	}
+	if m.Foo2 != 0 {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashUint64(h, s, uint64(m.Foo2))
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *MemoryPoolExtension) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *MemoryPoolExtension) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &MemoryPoolExtension{}
+	}
+	w.ObjectStart()
+	if m.Foo1 != "" {
+		w.Field("foo1", "foo1")
+		if err := w.String(m.Foo1); err != nil {
+			return errors.New("proto: field MemoryPoolExtension.foo1 contains invalid UTF-8")
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("foo1", "foo1")
+		if err := w.String(m.GetFoo1()); err != nil {
+			return errors.New("proto: field MemoryPoolExtension.foo1 contains invalid UTF-8")
+		}
+	}
+	if m.Foo2 != 0 {
+		w.Field("foo2", "foo2")
+		w.Uint64(m.Foo2)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("foo2", "foo2")
+		w.Uint64(m.GetFoo2())
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *MemoryPoolExtension) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *MemoryPoolExtension) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [2]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "foo1":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.Foo1 = v
+		case "foo2":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint64()
+			if err != nil {
+				return err
+			}
+			m.Foo2 = v
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
 }
 
 func (m *MemoryPoolExtension) MarshalVT() (dAtA []byte, err error) {
@@ -60,16 +214,61 @@ func (m *MemoryPoolExtension) MarshalVT() (dAtA []byte, err error) {
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
 }
 
 func (m *MemoryPoolExtension) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *MemoryPoolExtension) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *MemoryPoolExtension) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
 }
 
 func (m *MemoryPoolExtension) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
@@ -82,25 +281,51 @@ func (m *MemoryPoolExtension) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	_ = l
 	if m.unknownFields != nil {
 		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		copy(dAtA[i:], m.unknownFields)
 	}
 	if m.Foo2 != 0 {
 		i = encodeVarint(dAtA, i, uint64(m.Foo2))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		dAtA[i] = 0x10
 	}
 	if len(m.Foo1) > 0 {
 		i -= len(m.Foo1)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		copy(dAtA[i:], m.Foo1)
 		i = encodeVarint(dAtA, i, uint64(len(m.Foo1)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
+var (
+	ErrBufferTooSmall = fmt.Errorf("proto: buffer too small to marshal the message")
+	ErrSizeMismatch   = fmt.Errorf("proto: message size changed while marshaling")
+)
+
 func encodeVarint(dAtA []byte, offset int, v uint64) int {
 	offset -= sov(v)
+	if offset < 0 {
+		return offset
+	}
 	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -110,6 +335,26 @@ func encodeVarint(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *MemoryPoolExtension) MergeVT(src *MemoryPoolExtension) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Foo1 != "" {
+		m.Foo1 = src.Foo1
+	}
+	if src.Foo2 != 0 {
+		m.Foo2 = src.Foo2
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *MemoryPoolExtension) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*MemoryPoolExtension); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
 
 var vtprotoPool_MemoryPoolExtension = sync.Pool{
 	New: func() interface{} {
@@ -153,10 +398,50 @@ func soz(x uint64) (n int) {
 	return sov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *MemoryPoolExtension) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *MemoryPoolExtension) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.ResetVT()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *MemoryPoolExtension) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *MemoryPoolExtension) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*MemoryPoolExtension)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -211,7 +496,10 @@ func (m *MemoryPoolExtension) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Foo1 = string(dAtA[iNdEx:postIndex])
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.Foo1 = string(string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -244,10 +532,13 @@ func (m *MemoryPoolExtension) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -334,7 +625,147 @@ func skip(dAtA []byte) (n int, err error) {
 }
 
 var (
-	ErrInvalidLength        = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflow          = fmt.Errorf("proto: integer overflow")
-	ErrUnexpectedEndOfGroup = fmt.Errorf("proto: unexpected end of group")
+	ErrInvalidLength          = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflow            = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroup   = fmt.Errorf("proto: unexpected end of group")
+	ErrInvalidUTF8            = fmt.Errorf("proto: string field contains invalid UTF-8")
+	ErrRecursionLimitExceeded = fmt.Errorf("proto: exceeded maximum recursion depth")
 )
+
+func (m *MemoryPoolExtension) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *MemoryPoolExtension) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.ResetVT()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *MemoryPoolExtension) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*MemoryPoolExtension)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoryPoolExtension: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemoryPoolExtension: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Foo1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.Foo1 = string(stringValue)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Foo2", wireType)
+			}
+			m.Foo2 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Foo2 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.26.0
+// 	protoc-gen-go v1.34.2
 // 	protoc        v3.20.0
 // source: pool/pool_with_slice_reuse.proto
 
@@ -292,7 +292,7 @@ func file_pool_pool_with_slice_reuse_proto_rawDescGZIP() []byte {
 }
 
 var file_pool_pool_with_slice_reuse_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
-var file_pool_pool_with_slice_reuse_proto_goTypes = []interface{}{
+var file_pool_pool_with_slice_reuse_proto_goTypes = []any{
 	(*Test1)(nil),    // 0: Test1
 	(*Test2)(nil),    // 1: Test2
 	(*Slice2)(nil),   // 2: Slice2
@@ -316,7 +316,7 @@ func file_pool_pool_with_slice_reuse_proto_init() {
 		return
 	}
 	if !protoimpl.UnsafeEnabled {
-		file_pool_pool_with_slice_reuse_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+		file_pool_pool_with_slice_reuse_proto_msgTypes[0].Exporter = func(v any, i int) any {
 			switch v := v.(*Test1); i {
 			case 0:
 				return &v.state
@@ -328,7 +328,7 @@ func file_pool_pool_with_slice_reuse_proto_init() {
 				return nil
 			}
 		}
-		file_pool_pool_with_slice_reuse_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+		file_pool_pool_with_slice_reuse_proto_msgTypes[1].Exporter = func(v any, i int) any {
 			switch v := v.(*Test2); i {
 			case 0:
 				return &v.state
@@ -340,7 +340,7 @@ func file_pool_pool_with_slice_reuse_proto_init() {
 				return nil
 			}
 		}
-		file_pool_pool_with_slice_reuse_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_pool_pool_with_slice_reuse_proto_msgTypes[2].Exporter = func(v any, i int) any {
 			switch v := v.(*Slice2); i {
 			case 0:
 				return &v.state
@@ -352,7 +352,7 @@ func file_pool_pool_with_slice_reuse_proto_init() {
 				return nil
 			}
 		}
-		file_pool_pool_with_slice_reuse_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_pool_pool_with_slice_reuse_proto_msgTypes[3].Exporter = func(v any, i int) any {
 			switch v := v.(*Element2); i {
 			case 0:
 				return &v.state
@@ -365,7 +365,7 @@ func file_pool_pool_with_slice_reuse_proto_init() {
 			}
 		}
 	}
-	file_pool_pool_with_slice_reuse_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_pool_pool_with_slice_reuse_proto_msgTypes[2].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -5,11 +5,21 @@
 package pool
 
 import (
+	errors "errors"
 	fmt "fmt"
+	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
+	vtproto "github.com/planetscale/vtprotobuf/vtproto"
+	protowire "google.golang.org/protobuf/encoding/protowire"
 	proto "google.golang.org/protobuf/proto"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	hash "hash"
+	fnv "hash/fnv"
 	io "io"
+	sort "sort"
+	strconv "strconv"
 	sync "sync"
+	utf8 "unicode/utf8"
+	unsafe "unsafe"
 )
 
 const (
@@ -23,16 +33,8 @@ func (m *Test1) CloneVT() *Test1 {
 	if m == nil {
 		return (*Test1)(nil)
 	}
-	r := &Test1{}
-	if rhs := m.Sl; rhs != nil {
-		tmpContainer := make([]string, len(rhs))
-		copy(tmpContainer, rhs)
-		r.Sl = tmpContainer
-	}
-	if len(m.unknownFields) > 0 {
-		r.unknownFields = make([]byte, len(m.unknownFields))
-		copy(r.unknownFields, m.unknownFields)
-	}
+	r := Test1FromVTPool()
+	m.CopyToVT(r)
 	return r
 }
 
@@ -40,22 +42,21 @@ func (m *Test1) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *Test1) CopyToVT(dst *Test1) {
+	if m == nil {
+		dst.ResetVT()
+		return
+	}
+	dst.Sl = append(dst.Sl[:0], m.Sl...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *Test2) CloneVT() *Test2 {
 	if m == nil {
 		return (*Test2)(nil)
 	}
-	r := &Test2{}
-	if rhs := m.Sl; rhs != nil {
-		tmpContainer := make([]*Slice2, len(rhs))
-		for k, v := range rhs {
-			tmpContainer[k] = v.CloneVT()
-		}
-		r.Sl = tmpContainer
-	}
-	if len(m.unknownFields) > 0 {
-		r.unknownFields = make([]byte, len(m.unknownFields))
-		copy(r.unknownFields, m.unknownFields)
-	}
+	r := Test2FromVTPool()
+	m.CopyToVT(r)
 	return r
 }
 
@@ -63,6 +64,28 @@ func (m *Test2) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *Test2) CopyToVT(dst *Test2) {
+	if m == nil {
+		dst.ResetVT()
+		return
+	}
+	if len(m.Sl) > cap(dst.Sl) {
+		tmpContainer := make([]*Slice2, len(m.Sl))
+		copy(tmpContainer, dst.Sl[:cap(dst.Sl)])
+		dst.Sl = tmpContainer
+	} else {
+		dst.Sl = dst.Sl[:len(m.Sl)]
+	}
+	for k, v := range m.Sl {
+		if dst.Sl[k] == nil {
+			dst.Sl[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.Sl[k])
+		}
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *Slice2) CloneVT() *Slice2 {
 	if m == nil {
 		return (*Slice2)(nil)
@@ -99,6 +122,45 @@ func (m *Slice2) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *Slice2) CopyToVT(dst *Slice2) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if dst.A == nil && len(m.A) > 0 {
+		dst.A = make(map[int64]int64, len(m.A))
+	}
+	for k := range dst.A {
+		if _, ok := m.A[k]; !ok {
+			delete(dst.A, k)
+		}
+	}
+	for k, v := range m.A {
+		dst.A[k] = v
+	}
+	if m.B == nil {
+		dst.B = nil
+	} else {
+		if dst.B == nil {
+			dst.B = new(int32)
+		}
+		*dst.B = *m.B
+	}
+	dst.C = append(dst.C[:0], m.C...)
+	if m.D == nil {
+		dst.D = nil
+	} else {
+		if dst.D == nil {
+			dst.D = m.D.CloneVT()
+		} else {
+			m.D.CopyToVT(dst.D)
+		}
+	}
+	dst.E = m.E
+	dst.F = m.F
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *Element2) CloneVT() *Element2 {
 	if m == nil {
 		return (*Element2)(nil)
@@ -117,7 +179,171 @@ func (m *Element2) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *Element2) CopyToVT(dst *Element2) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.A = m.A
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (this *Test1) DiffVT(that *Test1) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	for i := 0; i < len(this.Sl) && i < len(that.Sl); i++ {
+		if this.Sl[i] != that.Sl[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "Sl[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.Sl[i], New: that.Sl[i]})
+		}
+	}
+	for i := len(that.Sl); i < len(this.Sl); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "Sl[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.Sl[i]})
+	}
+	for i := len(this.Sl); i < len(that.Sl); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "Sl[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.Sl[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *Test2) DiffVT(that *Test2) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	for i := 0; i < len(this.Sl) && i < len(that.Sl); i++ {
+		if p, q := this.Sl[i], that.Sl[i]; p != q {
+			if p == nil {
+				p = &Slice2{}
+			}
+			if q == nil {
+				q = &Slice2{}
+			}
+			if nested := p.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "Sl["+strconv.Itoa(i)+"]", nested)
+			}
+		}
+	}
+	for i := len(that.Sl); i < len(this.Sl); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "Sl[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.Sl[i]})
+	}
+	for i := len(this.Sl); i < len(that.Sl); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "Sl[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.Sl[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *Slice2) DiffVT(that *Slice2) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if len(this.A) > 0 || len(that.A) > 0 {
+		keys := make([]int64, 0, len(this.A)+len(that.A))
+		for k := range this.A {
+			keys = append(keys, k)
+		}
+		for k := range that.A {
+			if _, ok := this.A[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.A[k]
+			vy, yok := that.A[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "a[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "a[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if vx != vy {
+					diffs = append(diffs, vtproto.FieldDiff{Path: "a[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldChanged, Old: vx, New: vy})
+				}
+			}
+		}
+	}
+	if p, q := this.B, that.B; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "b", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "b", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "b", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	for i := 0; i < len(this.C) && i < len(that.C); i++ {
+		if this.C[i] != that.C[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "c[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.C[i], New: that.C[i]})
+		}
+	}
+	for i := len(that.C); i < len(this.C); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "c[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.C[i]})
+	}
+	for i := len(this.C); i < len(that.C); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "c[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.C[i]})
+	}
+	if p, q := this.D, that.D; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "d", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "d", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if nested := p.DiffVT(q); len(nested) > 0 {
+			diffs = protohelpers.AppendDiffs(diffs, "d", nested)
+		}
+	}
+	if this.E != that.E {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "e", Kind: vtproto.FieldChanged, Old: this.E, New: that.E})
+	}
+	if this.F != that.F {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "f", Kind: vtproto.FieldChanged, Old: this.F, New: that.F})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *Element2) DiffVT(that *Element2) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if this.A != that.A {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "a", Kind: vtproto.FieldChanged, Old: this.A, New: that.A})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
 func (this *Test1) EqualVT(that *Test1) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Test1) EqualVTWithOptions(that *Test1, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
@@ -132,10 +358,22 @@ func (this *Test1) EqualVT(that *Test1) bool {
 			return false
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Test1) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Test1)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
 }
 
 func (this *Test2) EqualVT(that *Test2) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Test2) EqualVTWithOptions(that *Test2, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
@@ -153,15 +391,27 @@ func (this *Test2) EqualVT(that *Test2) bool {
 			if q == nil {
 				q = &Slice2{}
 			}
-			if !p.EqualVT(q) {
+			if !p.EqualVTWithOptions(q, opts) {
 				return false
 			}
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Test2) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Test2)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
 }
 
 func (this *Slice2) EqualVT(that *Slice2) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Slice2) EqualVTWithOptions(that *Slice2, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
@@ -191,7 +441,7 @@ func (this *Slice2) EqualVT(that *Slice2) bool {
 			return false
 		}
 	}
-	if !this.D.EqualVT(that.D) {
+	if !this.D.EqualVTWithOptions(that.D, opts) {
 		return false
 	}
 	if this.E != that.E {
@@ -200,10 +450,22 @@ func (this *Slice2) EqualVT(that *Slice2) bool {
 	if this.F != that.F {
 		return false
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Slice2) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Slice2)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
 }
 
 func (this *Element2) EqualVT(that *Element2) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Element2) EqualVTWithOptions(that *Element2, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
@@ -212,345 +474,2018 @@ func (this *Element2) EqualVT(that *Element2) bool {
 	if this.A != that.A {
 		return false
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Test1) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Element2) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Element2)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
+	return this.EqualVT(that)
 }
 
-func (m *Test1) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *Test1) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
 }
 
-func (m *Test1) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Test1) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+		return
 	}
 	if len(m.Sl) > 0 {
-		for iNdEx := len(m.Sl) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Sl[iNdEx])
-			copy(dAtA[i:], m.Sl[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.Sl[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(len(m.Sl)))
+		for _, v := range m.Sl {
+			protohelpers.HashString(h, s, v)
 		}
 	}
-	return len(dAtA) - i, nil
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
 }
 
-func (m *Test2) MarshalVT() (dAtA []byte, err error) {
+func (m *Test2) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *Test2) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return nil, nil
+		return
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Sl) > 0 {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(len(m.Sl)))
+		for _, v := range m.Sl {
+			v.hashVT(h, s)
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	return dAtA[:n], nil
 }
 
-func (m *Test2) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *Slice2) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
 }
 
-func (m *Test2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Slice2) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return 0, nil
+		return
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	var entry hash.Hash64
+	if len(m.A) > 0 {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(len(m.A)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.A {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			protohelpers.HashUint64(entry, s, uint64(v))
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
 	}
-	if len(m.Sl) > 0 {
-		for iNdEx := len(m.Sl) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Sl[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0xa
+	if m.B != nil {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashUint64(h, s, uint64(*m.B))
+	}
+	if len(m.C) > 0 {
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(len(m.C)))
+		for _, v := range m.C {
+			protohelpers.HashString(h, s, v)
 		}
 	}
-	return len(dAtA) - i, nil
+	if m.D != nil {
+		protohelpers.HashUint64(h, s, 4)
+		m.D.hashVT(h, s)
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.E != "" {
+		protohelpers.HashUint64(h, s, 5)
+		protohelpers.HashString(h, s, m.E)
+	}
+	if m.F != 0 {
+		protohelpers.HashUint64(h, s, 6)
+		protohelpers.HashUint64(h, s, uint64(m.F))
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
 }
 
-func (m *Slice2) MarshalVT() (dAtA []byte, err error) {
+func (m *Element2) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *Element2) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return nil, nil
+		return
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.A != 0 {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(m.A))
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	return dAtA[:n], nil
 }
 
-func (m *Slice2) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *Test1) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
 }
 
-func (m *Slice2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Test1) MarshalJSONToVT(w *vtproto.JSONWriter) error {
 	if m == nil {
-		return 0, nil
+		// a nil message is written like an empty one
+		m = &Test1{}
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	w.ObjectStart()
+	if len(m.Sl) > 0 {
+		w.Field("Sl", "Sl")
+		w.ArrayStart()
+		for _, v := range m.Sl {
+			if err := w.String(v); err != nil {
+				return errors.New("proto: field Test1.Sl contains invalid UTF-8")
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("Sl", "Sl")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
-	if m.F != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.F))
-		i--
-		dAtA[i] = 0x30
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *Test1) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *Test1) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
 	}
-	if len(m.E) > 0 {
-		i -= len(m.E)
-		copy(dAtA[i:], m.E)
-		i = encodeVarint(dAtA, i, uint64(len(m.E)))
-		i--
-		dAtA[i] = 0x2a
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
 	}
-	if m.D != nil {
-		size, err := m.D.MarshalToSizedBufferVT(dAtA[:i])
+	var seen [1]bool
+	for {
+		name, ok, err := r.ReadName()
 		if err != nil {
-			return 0, err
+			return err
 		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.C) > 0 {
-		for iNdEx := len(m.C) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.C[iNdEx])
-			copy(dAtA[i:], m.C[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.C[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+		if !ok {
+			return nil
 		}
+		switch name {
+		case "Sl":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadString()
+				if err != nil {
+					return err
+				}
+				m.Sl = append(m.Sl, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *Test2) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *Test2) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &Test2{}
+	}
+	w.ObjectStart()
+	if len(m.Sl) > 0 {
+		w.Field("Sl", "Sl")
+		w.ArrayStart()
+		for _, v := range m.Sl {
+			if err := v.MarshalJSONToVT(w); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("Sl", "Sl")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *Test2) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *Test2) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [1]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "Sl":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(Slice2)
+				if err := v.UnmarshalJSONFromVT(r); err != nil {
+					return err
+				}
+				m.Sl = append(m.Sl, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *Slice2) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *Slice2) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &Slice2{}
 	}
-	if m.B != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.B))
-		i--
-		dAtA[i] = 0x10
-	}
+	w.ObjectStart()
 	if len(m.A) > 0 {
+		w.Field("a", "a")
+		keys := make([]int64, 0, len(m.A))
 		for k := range m.A {
-			v := m.A[k]
-			baseI := i
-			i = encodeVarint(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			w.Int64(m.A[k])
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("a", "a")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if m.B != nil {
+		w.Field("b", "b")
+		w.Int32(*m.B)
+	}
+	if len(m.C) > 0 {
+		w.Field("c", "c")
+		w.ArrayStart()
+		for _, v := range m.C {
+			if err := w.String(v); err != nil {
+				return errors.New("proto: field Slice2.c contains invalid UTF-8")
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("c", "c")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if m.D != nil {
+		w.Field("d", "d")
+		if err := m.D.MarshalJSONToVT(w); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("d", "d")
+		w.Null()
+	}
+	if m.E != "" {
+		w.Field("e", "e")
+		if err := w.String(m.E); err != nil {
+			return errors.New("proto: field Slice2.e contains invalid UTF-8")
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("e", "e")
+		if err := w.String(m.GetE()); err != nil {
+			return errors.New("proto: field Slice2.e contains invalid UTF-8")
+		}
+	}
+	if m.F != 0 {
+		w.Field("f", "f")
+		w.Int64(m.F)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("f", "f")
+		w.Int64(m.GetF())
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *Slice2) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *Slice2) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [7]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "a":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.A == nil {
+				m.A = make(map[int64]int64)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int64Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.A[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.A[k] = v
+			}
+		case "b":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.B = &v
+		case "c":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadString()
+				if err != nil {
+					return err
+				}
+				m.C = append(m.C, v)
+			}
+		case "d":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(Element2)
+			if err := v.UnmarshalJSONFromVT(r); err != nil {
+				return err
+			}
+			m.D = v
+		case "e":
+			if seen[4] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[4] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.E = v
+		case "f":
+			if seen[5] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[5] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt64()
+			if err != nil {
+				return err
+			}
+			m.F = v
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *Element2) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *Element2) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &Element2{}
+	}
+	w.ObjectStart()
+	if m.A != 0 {
+		w.Field("a", "a")
+		w.Int32(m.A)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("a", "a")
+		w.Int32(m.GetA())
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *Element2) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *Element2) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [1]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "a":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.A = v
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *Test1) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Test1) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Test1) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Test1) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Test1) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Sl) > 0 {
+		for iNdEx := len(m.Sl) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Sl[iNdEx])
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], m.Sl[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.Sl[iNdEx])))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
 			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
 			dAtA[i] = 0xa
 		}
 	}
-	return len(dAtA) - i, nil
-}
+	return len(dAtA) - i, nil
+}
+
+func (m *Test2) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Test2) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Test2) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Test2) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Test2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.Sl) > 0 {
+		for iNdEx := len(m.Sl) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Sl[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Slice2) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Slice2) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Slice2) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Slice2) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Slice2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.F != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.F))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x30
+	}
+	if len(m.E) > 0 {
+		i -= len(m.E)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.E)
+		i = encodeVarint(dAtA, i, uint64(len(m.E)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x2a
+	}
+	if m.D != nil {
+		size, err := m.D.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x22
+	}
+	if len(m.C) > 0 {
+		for iNdEx := len(m.C) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.C[iNdEx])
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], m.C[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.C[iNdEx])))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.B != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.B))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x10
+	}
+	if len(m.A) > 0 {
+		for k := range m.A {
+			v := m.A[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x10
+			i = encodeVarint(dAtA, i, uint64(k))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Element2) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Element2) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Element2) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Element2) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Element2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.A != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.A))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Test1) MergeVT(src *Test1) {
+	if m == nil || src == nil {
+		return
+	}
+	m.Sl = append(m.Sl, src.Sl...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Test1) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Test1); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *Test2) MergeVT(src *Test2) {
+	if m == nil || src == nil {
+		return
+	}
+	for _, v := range src.Sl {
+		tmpMsg := &Slice2{}
+		tmpMsg.MergeVT(v)
+		m.Sl = append(m.Sl, tmpMsg)
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Test2) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Test2); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *Slice2) MergeVT(src *Slice2) {
+	if m == nil || src == nil {
+		return
+	}
+	if len(src.A) > 0 {
+		if m.A == nil {
+			m.A = make(map[int64]int64, len(src.A))
+		}
+		for k, v := range src.A {
+			m.A[k] = v
+		}
+	}
+	if src.B != nil {
+		tmpVal := *src.B
+		m.B = &tmpVal
+	}
+	m.C = append(m.C, src.C...)
+	if src.D != nil {
+		if m.D == nil {
+			m.D = &Element2{}
+		}
+		m.D.MergeVT(src.D)
+	}
+	if src.E != "" {
+		m.E = src.E
+	}
+	if src.F != 0 {
+		m.F = src.F
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Slice2) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Slice2); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *Element2) MergeVT(src *Element2) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.A != 0 {
+		m.A = src.A
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Element2) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Element2); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+var vtprotoPool_Test1 = sync.Pool{
+	New: func() interface{} {
+		return &Test1{}
+	},
+}
+
+func (m *Test1) ResetVT() {
+	f0 := m.Sl[:0]
+	m.Reset()
+	m.Sl = f0
+}
+func (m *Test1) ReturnToVTPool() {
+	if m != nil {
+		m.ResetVT()
+		vtprotoPool_Test1.Put(m)
+	}
+}
+func Test1FromVTPool() *Test1 {
+	return vtprotoPool_Test1.Get().(*Test1)
+}
+
+var vtprotoPool_Test2 = sync.Pool{
+	New: func() interface{} {
+		return &Test2{}
+	},
+}
+
+func (m *Test2) ResetVT() {
+	m.Reset()
+}
+func (m *Test2) ReturnToVTPool() {
+	if m != nil {
+		m.ResetVT()
+		vtprotoPool_Test2.Put(m)
+	}
+}
+func Test2FromVTPool() *Test2 {
+	return vtprotoPool_Test2.Get().(*Test2)
+}
+func (m *Test1) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sl) > 0 {
+		for _, s := range m.Sl {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Test2) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sl) > 0 {
+		for _, e := range m.Sl {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Slice2) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.A) > 0 {
+		for k, v := range m.A {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sov(uint64(k)) + 1 + sov(uint64(v))
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if m.B != nil {
+		n += 1 + sov(uint64(*m.B))
+	}
+	if len(m.C) > 0 {
+		for _, s := range m.C {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if m.D != nil {
+		l = m.D.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.E)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.F != 0 {
+		n += 1 + sov(uint64(m.F))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Element2) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.A != 0 {
+		n += 1 + sov(uint64(m.A))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Test1) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Test1) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.ResetVT()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *Test1) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *Test1) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Test1)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Test1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Test1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.Sl = append(m.Sl, string(string(dAtA[iNdEx:postIndex])))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Test2) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Test2) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.ResetVT()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *Test2) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *Test2) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Test2)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Test2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Test2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if len(m.Sl) == cap(m.Sl) {
+				m.Sl = append(m.Sl, &Slice2{})
+			} else {
+				m.Sl = m.Sl[:len(m.Sl)+1]
+				if m.Sl[len(m.Sl)-1] == nil {
+					m.Sl[len(m.Sl)-1] = &Slice2{}
+				}
+			}
+			if err := m.Sl[len(m.Sl)-1].unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Slice2) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Slice2) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *Slice2) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *Slice2) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Slice2)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Slice2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Slice2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.A == nil {
+				m.A = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.A[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.B = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.C = append(m.C, string(string(dAtA[iNdEx:postIndex])))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field D", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.D == nil {
+				m.D = &Element2{}
+			}
+			if err := m.D.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field E", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			m.E = string(string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field F", wireType)
+			}
+			m.F = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.F |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
 
-func (m *Element2) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return dAtA[:n], nil
+	return nil
 }
-
-func (m *Element2) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *Element2) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
 }
 
-func (m *Element2) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
-	}
-	if m.A != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.A))
-		i--
-		dAtA[i] = 0x8
+func (m *Element2) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
 	}
-	return len(dAtA) - i, nil
-}
-
-var vtprotoPool_Test1 = sync.Pool{
-	New: func() interface{} {
-		return &Test1{}
-	},
-}
-
-func (m *Test1) ResetVT() {
-	f0 := m.Sl[:0]
-	m.Reset()
-	m.Sl = f0
-}
-func (m *Test1) ReturnToVTPool() {
-	if m != nil {
-		m.ResetVT()
-		vtprotoPool_Test1.Put(m)
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
 	}
-}
-func Test1FromVTPool() *Test1 {
-	return vtprotoPool_Test1.Get().(*Test1)
-}
-
-var vtprotoPool_Test2 = sync.Pool{
-	New: func() interface{} {
-		return &Test2{}
-	},
+	return m.unmarshalVT(dAtA, opts, depth)
 }
 
-func (m *Test2) ResetVT() {
-	m.Reset()
-}
-func (m *Test2) ReturnToVTPool() {
-	if m != nil {
-		m.ResetVT()
-		vtprotoPool_Test2.Put(m)
+func (m *Element2) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
 	}
-}
-func Test2FromVTPool() *Test2 {
-	return vtprotoPool_Test2.Get().(*Test2)
-}
-func (m *Test1) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Sl) > 0 {
-		for _, s := range m.Sl {
-			l = len(s)
-			n += 1 + l + sov(uint64(l))
-		}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return end, nil
 }
 
-func (m *Test2) SizeVT() (n int) {
-	if m == nil {
-		return 0
+func (m *Element2) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
 	}
-	var l int
-	_ = l
-	if len(m.Sl) > 0 {
-		for _, e := range m.Sl {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Element2)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
 		}
-	}
-	n += len(m.unknownFields)
-	return n
-}
-
-func (m *Slice2) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.A) > 0 {
-		for k, v := range m.A {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sov(uint64(k)) + 1 + sov(uint64(v))
-			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if m.B != nil {
-		n += 1 + sov(uint64(*m.B))
-	}
-	if len(m.C) > 0 {
-		for _, s := range m.C {
-			l = len(s)
-			n += 1 + l + sov(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Element2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Element2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			m.A = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.A |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.D != nil {
-		l = m.D.SizeVT()
-		n += 1 + l + sov(uint64(l))
-	}
-	l = len(m.E)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
-	}
-	if m.F != 0 {
-		n += 1 + sov(uint64(m.F))
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	n += len(m.unknownFields)
-	return n
+	return nil
+}
+func (m *Test1) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
 }
 
-func (m *Element2) SizeVT() (n int) {
-	if m == nil {
-		return 0
+func (m *Test1) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.ResetVT()
 	}
-	var l int
-	_ = l
-	if m.A != 0 {
-		n += 1 + sov(uint64(m.A))
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
 	}
-	n += len(m.unknownFields)
-	return n
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
 }
 
-func (m *Test1) UnmarshalVT(dAtA []byte) error {
+func (m *Test1) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Test1)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -605,7 +2540,14 @@ func (m *Test1) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sl = append(m.Sl, string(dAtA[iNdEx:postIndex]))
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.Sl = append(m.Sl, string(stringValue))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -619,21 +2561,49 @@ func (m *Test1) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *Test2) UnmarshalVT(dAtA []byte) error {
+func (m *Test2) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Test2) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.ResetVT()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *Test2) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Test2)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -695,7 +2665,7 @@ func (m *Test2) UnmarshalVT(dAtA []byte) error {
 					m.Sl[len(m.Sl)-1] = &Slice2{}
 				}
 			}
-			if err := m.Sl[len(m.Sl)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Sl[len(m.Sl)-1].unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -711,21 +2681,49 @@ func (m *Test2) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *Slice2) UnmarshalVT(dAtA []byte) error {
+func (m *Slice2) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Slice2) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *Slice2) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Slice2)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -899,7 +2897,14 @@ func (m *Slice2) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.C = append(m.C, string(dAtA[iNdEx:postIndex]))
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.C = append(m.C, string(stringValue))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -933,7 +2938,7 @@ func (m *Slice2) UnmarshalVT(dAtA []byte) error {
 			if m.D == nil {
 				m.D = &Element2{}
 			}
-			if err := m.D.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.D.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -967,7 +2972,14 @@ func (m *Slice2) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.E = string(dAtA[iNdEx:postIndex])
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.E = string(stringValue)
 			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
@@ -1000,21 +3012,49 @@ func (m *Slice2) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *Element2) UnmarshalVT(dAtA []byte) error {
+func (m *Element2) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Element2) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *Element2) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Element2)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -1070,10 +3110,13 @@ func (m *Element2) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
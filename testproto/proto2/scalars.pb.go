@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.26.0
+// 	protoc-gen-go v1.34.2
 // 	protoc        v3.20.0
 // source: proto2/scalars.proto
 
@@ -1501,7 +1501,7 @@ func file_proto2_scalars_proto_rawDescGZIP() []byte {
 
 var file_proto2_scalars_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_proto2_scalars_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
-var file_proto2_scalars_proto_goTypes = []interface{}{
+var file_proto2_scalars_proto_goTypes = []any{
 	(EnumMessage_Num)(0),    // 0: EnumMessage.Num
 	(*DoubleMessage)(nil),   // 1: DoubleMessage
 	(*FloatMessage)(nil),    // 2: FloatMessage
@@ -1538,7 +1538,7 @@ func file_proto2_scalars_proto_init() {
 		return
 	}
 	if !protoimpl.UnsafeEnabled {
-		file_proto2_scalars_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+		file_proto2_scalars_proto_msgTypes[0].Exporter = func(v any, i int) any {
 			switch v := v.(*DoubleMessage); i {
 			case 0:
 				return &v.state
@@ -1550,7 +1550,7 @@ func file_proto2_scalars_proto_init() {
 				return nil
 			}
 		}
-		file_proto2_scalars_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+		file_proto2_scalars_proto_msgTypes[1].Exporter = func(v any, i int) any {
 			switch v := v.(*FloatMessage); i {
 			case 0:
 				return &v.state
@@ -1562,7 +1562,7 @@ func file_proto2_scalars_proto_init() {
 				return nil
 			}
 		}
-		file_proto2_scalars_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_proto2_scalars_proto_msgTypes[2].Exporter = func(v any, i int) any {
 			switch v := v.(*Int32Message); i {
 			case 0:
 				return &v.state
@@ -1574,7 +1574,7 @@ func file_proto2_scalars_proto_init() {
 				return nil
 			}
 		}
-		file_proto2_scalars_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_proto2_scalars_proto_msgTypes[3].Exporter = func(v any, i int) any {
 			switch v := v.(*Int64Message); i {
 			case 0:
 				return &v.state
@@ -1586,7 +1586,7 @@ func file_proto2_scalars_proto_init() {
 				return nil
 			}
 		}
-		file_proto2_scalars_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_proto2_scalars_proto_msgTypes[4].Exporter = func(v any, i int) any {
 			switch v := v.(*Uint32Message); i {
 			case 0:
 				return &v.state
@@ -1598,7 +1598,7 @@ func file_proto2_scalars_proto_init() {
 				return nil
 			}
 		}
-		file_proto2_scalars_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_proto2_scalars_proto_msgTypes[5].Exporter = func(v any, i int) any {
 			switch v := v.(*Uint64Message); i {
 			case 0:
 				return &v.state
@@ -1610,7 +1610,7 @@ func file_proto2_scalars_proto_init() {
 				return nil
 			}
 		}
-		file_proto2_scalars_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_proto2_scalars_proto_msgTypes[6].Exporter = func(v any, i int) any {
 			switch v := v.(*Sint32Message); i {
 			case 0:
 				return &v.state
@@ -1622,7 +1622,7 @@ func file_proto2_scalars_proto_init() {
 				return nil
 			}
 		}
-		file_proto2_scalars_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_proto2_scalars_proto_msgTypes[7].Exporter = func(v any, i int) any {
 			switch v := v.(*Sint64Message); i {
 			case 0:
 				return &v.state
@@ -1634,7 +1634,7 @@ func file_proto2_scalars_proto_init() {
 				return nil
 			}
 		}
-		file_proto2_scalars_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_proto2_scalars_proto_msgTypes[8].Exporter = func(v any, i int) any {
 			switch v := v.(*Fixed32Message); i {
 			case 0:
 				return &v.state
@@ -1646,7 +1646,7 @@ func file_proto2_scalars_proto_init() {
 				return nil
 			}
 		}
-		file_proto2_scalars_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_proto2_scalars_proto_msgTypes[9].Exporter = func(v any, i int) any {
 			switch v := v.(*Fixed64Message); i {
 			case 0:
 				return &v.state
@@ -1658,7 +1658,7 @@ func file_proto2_scalars_proto_init() {
 				return nil
 			}
 		}
-		file_proto2_scalars_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_proto2_scalars_proto_msgTypes[10].Exporter = func(v any, i int) any {
 			switch v := v.(*Sfixed32Message); i {
 			case 0:
 				return &v.state
@@ -1670,7 +1670,7 @@ func file_proto2_scalars_proto_init() {
 				return nil
 			}
 		}
-		file_proto2_scalars_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_proto2_scalars_proto_msgTypes[11].Exporter = func(v any, i int) any {
 			switch v := v.(*Sfixed64Message); i {
 			case 0:
 				return &v.state
@@ -1682,7 +1682,7 @@ func file_proto2_scalars_proto_init() {
 				return nil
 			}
 		}
-		file_proto2_scalars_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_proto2_scalars_proto_msgTypes[12].Exporter = func(v any, i int) any {
 			switch v := v.(*BoolMessage); i {
 			case 0:
 				return &v.state
@@ -1694,7 +1694,7 @@ func file_proto2_scalars_proto_init() {
 				return nil
 			}
 		}
-		file_proto2_scalars_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_proto2_scalars_proto_msgTypes[13].Exporter = func(v any, i int) any {
 			switch v := v.(*StringMessage); i {
 			case 0:
 				return &v.state
@@ -1706,7 +1706,7 @@ func file_proto2_scalars_proto_init() {
 				return nil
 			}
 		}
-		file_proto2_scalars_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_proto2_scalars_proto_msgTypes[14].Exporter = func(v any, i int) any {
 			switch v := v.(*BytesMessage); i {
 			case 0:
 				return &v.state
@@ -1718,7 +1718,7 @@ func file_proto2_scalars_proto_init() {
 				return nil
 			}
 		}
-		file_proto2_scalars_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_proto2_scalars_proto_msgTypes[15].Exporter = func(v any, i int) any {
 			switch v := v.(*EnumMessage); i {
 			case 0:
 				return &v.state
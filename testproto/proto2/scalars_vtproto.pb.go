@@ -6,12 +6,19 @@ package proto2
 
 import (
 	binary "encoding/binary"
+	errors "errors"
 	fmt "fmt"
+	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
+	vtproto "github.com/planetscale/vtprotobuf/vtproto"
+	protowire "google.golang.org/protobuf/encoding/protowire"
 	proto "google.golang.org/protobuf/proto"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	hash "hash"
 	io "io"
 	math "math"
 	bits "math/bits"
+	strconv "strconv"
+	unsafe "unsafe"
 )
 
 const (
@@ -55,6 +62,32 @@ func (m *DoubleMessage) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *DoubleMessage) CopyToVT(dst *DoubleMessage) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.RequiredField == nil {
+		dst.RequiredField = nil
+	} else {
+		if dst.RequiredField == nil {
+			dst.RequiredField = new(float64)
+		}
+		*dst.RequiredField = *m.RequiredField
+	}
+	if m.OptionalField == nil {
+		dst.OptionalField = nil
+	} else {
+		if dst.OptionalField == nil {
+			dst.OptionalField = new(float64)
+		}
+		*dst.OptionalField = *m.OptionalField
+	}
+	dst.RepeatedField = append(dst.RepeatedField[:0], m.RepeatedField...)
+	dst.PackedField = append(dst.PackedField[:0], m.PackedField...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *FloatMessage) CloneVT() *FloatMessage {
 	if m == nil {
 		return (*FloatMessage)(nil)
@@ -89,6 +122,32 @@ func (m *FloatMessage) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *FloatMessage) CopyToVT(dst *FloatMessage) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.RequiredField == nil {
+		dst.RequiredField = nil
+	} else {
+		if dst.RequiredField == nil {
+			dst.RequiredField = new(float32)
+		}
+		*dst.RequiredField = *m.RequiredField
+	}
+	if m.OptionalField == nil {
+		dst.OptionalField = nil
+	} else {
+		if dst.OptionalField == nil {
+			dst.OptionalField = new(float32)
+		}
+		*dst.OptionalField = *m.OptionalField
+	}
+	dst.RepeatedField = append(dst.RepeatedField[:0], m.RepeatedField...)
+	dst.PackedField = append(dst.PackedField[:0], m.PackedField...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *Int32Message) CloneVT() *Int32Message {
 	if m == nil {
 		return (*Int32Message)(nil)
@@ -123,6 +182,32 @@ func (m *Int32Message) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *Int32Message) CopyToVT(dst *Int32Message) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.RequiredField == nil {
+		dst.RequiredField = nil
+	} else {
+		if dst.RequiredField == nil {
+			dst.RequiredField = new(int32)
+		}
+		*dst.RequiredField = *m.RequiredField
+	}
+	if m.OptionalField == nil {
+		dst.OptionalField = nil
+	} else {
+		if dst.OptionalField == nil {
+			dst.OptionalField = new(int32)
+		}
+		*dst.OptionalField = *m.OptionalField
+	}
+	dst.RepeatedField = append(dst.RepeatedField[:0], m.RepeatedField...)
+	dst.PackedField = append(dst.PackedField[:0], m.PackedField...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *Int64Message) CloneVT() *Int64Message {
 	if m == nil {
 		return (*Int64Message)(nil)
@@ -157,6 +242,32 @@ func (m *Int64Message) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *Int64Message) CopyToVT(dst *Int64Message) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.RequiredField == nil {
+		dst.RequiredField = nil
+	} else {
+		if dst.RequiredField == nil {
+			dst.RequiredField = new(int64)
+		}
+		*dst.RequiredField = *m.RequiredField
+	}
+	if m.OptionalField == nil {
+		dst.OptionalField = nil
+	} else {
+		if dst.OptionalField == nil {
+			dst.OptionalField = new(int64)
+		}
+		*dst.OptionalField = *m.OptionalField
+	}
+	dst.RepeatedField = append(dst.RepeatedField[:0], m.RepeatedField...)
+	dst.PackedField = append(dst.PackedField[:0], m.PackedField...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *Uint32Message) CloneVT() *Uint32Message {
 	if m == nil {
 		return (*Uint32Message)(nil)
@@ -191,6 +302,32 @@ func (m *Uint32Message) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *Uint32Message) CopyToVT(dst *Uint32Message) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.RequiredField == nil {
+		dst.RequiredField = nil
+	} else {
+		if dst.RequiredField == nil {
+			dst.RequiredField = new(uint32)
+		}
+		*dst.RequiredField = *m.RequiredField
+	}
+	if m.OptionalField == nil {
+		dst.OptionalField = nil
+	} else {
+		if dst.OptionalField == nil {
+			dst.OptionalField = new(uint32)
+		}
+		*dst.OptionalField = *m.OptionalField
+	}
+	dst.RepeatedField = append(dst.RepeatedField[:0], m.RepeatedField...)
+	dst.PackedField = append(dst.PackedField[:0], m.PackedField...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *Uint64Message) CloneVT() *Uint64Message {
 	if m == nil {
 		return (*Uint64Message)(nil)
@@ -225,6 +362,32 @@ func (m *Uint64Message) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *Uint64Message) CopyToVT(dst *Uint64Message) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.RequiredField == nil {
+		dst.RequiredField = nil
+	} else {
+		if dst.RequiredField == nil {
+			dst.RequiredField = new(uint64)
+		}
+		*dst.RequiredField = *m.RequiredField
+	}
+	if m.OptionalField == nil {
+		dst.OptionalField = nil
+	} else {
+		if dst.OptionalField == nil {
+			dst.OptionalField = new(uint64)
+		}
+		*dst.OptionalField = *m.OptionalField
+	}
+	dst.RepeatedField = append(dst.RepeatedField[:0], m.RepeatedField...)
+	dst.PackedField = append(dst.PackedField[:0], m.PackedField...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *Sint32Message) CloneVT() *Sint32Message {
 	if m == nil {
 		return (*Sint32Message)(nil)
@@ -259,6 +422,32 @@ func (m *Sint32Message) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *Sint32Message) CopyToVT(dst *Sint32Message) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.RequiredField == nil {
+		dst.RequiredField = nil
+	} else {
+		if dst.RequiredField == nil {
+			dst.RequiredField = new(int32)
+		}
+		*dst.RequiredField = *m.RequiredField
+	}
+	if m.OptionalField == nil {
+		dst.OptionalField = nil
+	} else {
+		if dst.OptionalField == nil {
+			dst.OptionalField = new(int32)
+		}
+		*dst.OptionalField = *m.OptionalField
+	}
+	dst.RepeatedField = append(dst.RepeatedField[:0], m.RepeatedField...)
+	dst.PackedField = append(dst.PackedField[:0], m.PackedField...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *Sint64Message) CloneVT() *Sint64Message {
 	if m == nil {
 		return (*Sint64Message)(nil)
@@ -293,6 +482,32 @@ func (m *Sint64Message) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *Sint64Message) CopyToVT(dst *Sint64Message) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.RequiredField == nil {
+		dst.RequiredField = nil
+	} else {
+		if dst.RequiredField == nil {
+			dst.RequiredField = new(int64)
+		}
+		*dst.RequiredField = *m.RequiredField
+	}
+	if m.OptionalField == nil {
+		dst.OptionalField = nil
+	} else {
+		if dst.OptionalField == nil {
+			dst.OptionalField = new(int64)
+		}
+		*dst.OptionalField = *m.OptionalField
+	}
+	dst.RepeatedField = append(dst.RepeatedField[:0], m.RepeatedField...)
+	dst.PackedField = append(dst.PackedField[:0], m.PackedField...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *Fixed32Message) CloneVT() *Fixed32Message {
 	if m == nil {
 		return (*Fixed32Message)(nil)
@@ -327,6 +542,32 @@ func (m *Fixed32Message) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *Fixed32Message) CopyToVT(dst *Fixed32Message) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.RequiredField == nil {
+		dst.RequiredField = nil
+	} else {
+		if dst.RequiredField == nil {
+			dst.RequiredField = new(uint32)
+		}
+		*dst.RequiredField = *m.RequiredField
+	}
+	if m.OptionalField == nil {
+		dst.OptionalField = nil
+	} else {
+		if dst.OptionalField == nil {
+			dst.OptionalField = new(uint32)
+		}
+		*dst.OptionalField = *m.OptionalField
+	}
+	dst.RepeatedField = append(dst.RepeatedField[:0], m.RepeatedField...)
+	dst.PackedField = append(dst.PackedField[:0], m.PackedField...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *Fixed64Message) CloneVT() *Fixed64Message {
 	if m == nil {
 		return (*Fixed64Message)(nil)
@@ -361,6 +602,32 @@ func (m *Fixed64Message) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *Fixed64Message) CopyToVT(dst *Fixed64Message) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.RequiredField == nil {
+		dst.RequiredField = nil
+	} else {
+		if dst.RequiredField == nil {
+			dst.RequiredField = new(uint64)
+		}
+		*dst.RequiredField = *m.RequiredField
+	}
+	if m.OptionalField == nil {
+		dst.OptionalField = nil
+	} else {
+		if dst.OptionalField == nil {
+			dst.OptionalField = new(uint64)
+		}
+		*dst.OptionalField = *m.OptionalField
+	}
+	dst.RepeatedField = append(dst.RepeatedField[:0], m.RepeatedField...)
+	dst.PackedField = append(dst.PackedField[:0], m.PackedField...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *Sfixed32Message) CloneVT() *Sfixed32Message {
 	if m == nil {
 		return (*Sfixed32Message)(nil)
@@ -395,6 +662,32 @@ func (m *Sfixed32Message) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *Sfixed32Message) CopyToVT(dst *Sfixed32Message) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.RequiredField == nil {
+		dst.RequiredField = nil
+	} else {
+		if dst.RequiredField == nil {
+			dst.RequiredField = new(int32)
+		}
+		*dst.RequiredField = *m.RequiredField
+	}
+	if m.OptionalField == nil {
+		dst.OptionalField = nil
+	} else {
+		if dst.OptionalField == nil {
+			dst.OptionalField = new(int32)
+		}
+		*dst.OptionalField = *m.OptionalField
+	}
+	dst.RepeatedField = append(dst.RepeatedField[:0], m.RepeatedField...)
+	dst.PackedField = append(dst.PackedField[:0], m.PackedField...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *Sfixed64Message) CloneVT() *Sfixed64Message {
 	if m == nil {
 		return (*Sfixed64Message)(nil)
@@ -429,6 +722,32 @@ func (m *Sfixed64Message) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *Sfixed64Message) CopyToVT(dst *Sfixed64Message) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.RequiredField == nil {
+		dst.RequiredField = nil
+	} else {
+		if dst.RequiredField == nil {
+			dst.RequiredField = new(int64)
+		}
+		*dst.RequiredField = *m.RequiredField
+	}
+	if m.OptionalField == nil {
+		dst.OptionalField = nil
+	} else {
+		if dst.OptionalField == nil {
+			dst.OptionalField = new(int64)
+		}
+		*dst.OptionalField = *m.OptionalField
+	}
+	dst.RepeatedField = append(dst.RepeatedField[:0], m.RepeatedField...)
+	dst.PackedField = append(dst.PackedField[:0], m.PackedField...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *BoolMessage) CloneVT() *BoolMessage {
 	if m == nil {
 		return (*BoolMessage)(nil)
@@ -463,6 +782,32 @@ func (m *BoolMessage) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *BoolMessage) CopyToVT(dst *BoolMessage) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.RequiredField == nil {
+		dst.RequiredField = nil
+	} else {
+		if dst.RequiredField == nil {
+			dst.RequiredField = new(bool)
+		}
+		*dst.RequiredField = *m.RequiredField
+	}
+	if m.OptionalField == nil {
+		dst.OptionalField = nil
+	} else {
+		if dst.OptionalField == nil {
+			dst.OptionalField = new(bool)
+		}
+		*dst.OptionalField = *m.OptionalField
+	}
+	dst.RepeatedField = append(dst.RepeatedField[:0], m.RepeatedField...)
+	dst.PackedField = append(dst.PackedField[:0], m.PackedField...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *StringMessage) CloneVT() *StringMessage {
 	if m == nil {
 		return (*StringMessage)(nil)
@@ -492,6 +837,31 @@ func (m *StringMessage) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *StringMessage) CopyToVT(dst *StringMessage) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.RequiredField == nil {
+		dst.RequiredField = nil
+	} else {
+		if dst.RequiredField == nil {
+			dst.RequiredField = new(string)
+		}
+		*dst.RequiredField = *m.RequiredField
+	}
+	if m.OptionalField == nil {
+		dst.OptionalField = nil
+	} else {
+		if dst.OptionalField == nil {
+			dst.OptionalField = new(string)
+		}
+		*dst.OptionalField = *m.OptionalField
+	}
+	dst.RepeatedField = append(dst.RepeatedField[:0], m.RepeatedField...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *BytesMessage) CloneVT() *BytesMessage {
 	if m == nil {
 		return (*BytesMessage)(nil)
@@ -527,6 +897,38 @@ func (m *BytesMessage) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *BytesMessage) CopyToVT(dst *BytesMessage) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.RequiredField == nil {
+		dst.RequiredField = nil
+	} else if dst.RequiredField == nil {
+		dst.RequiredField = append([]byte{}, m.RequiredField...)
+	} else {
+		dst.RequiredField = append(dst.RequiredField[:0], m.RequiredField...)
+	}
+	if m.OptionalField == nil {
+		dst.OptionalField = nil
+	} else if dst.OptionalField == nil {
+		dst.OptionalField = append([]byte{}, m.OptionalField...)
+	} else {
+		dst.OptionalField = append(dst.OptionalField[:0], m.OptionalField...)
+	}
+	if len(m.RepeatedField) > cap(dst.RepeatedField) {
+		tmpContainer := make([][]byte, len(m.RepeatedField))
+		copy(tmpContainer, dst.RepeatedField[:cap(dst.RepeatedField)])
+		dst.RepeatedField = tmpContainer
+	} else {
+		dst.RepeatedField = dst.RepeatedField[:len(m.RepeatedField)]
+	}
+	for k, v := range m.RepeatedField {
+		dst.RepeatedField[k] = append(dst.RepeatedField[k][:0], v...)
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
 func (m *EnumMessage) CloneVT() *EnumMessage {
 	if m == nil {
 		return (*EnumMessage)(nil)
@@ -561,148 +963,840 @@ func (m *EnumMessage) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
-func (this *DoubleMessage) EqualVT(that *DoubleMessage) bool {
-	if this == nil {
-		return that == nil
-	} else if that == nil {
-		return false
-	}
-	if p, q := this.RequiredField, that.RequiredField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+func (m *EnumMessage) CopyToVT(dst *EnumMessage) {
+	if m == nil {
+		dst.Reset()
+		return
 	}
-	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	if m.RequiredField == nil {
+		dst.RequiredField = nil
+	} else {
+		if dst.RequiredField == nil {
+			dst.RequiredField = new(EnumMessage_Num)
+		}
+		*dst.RequiredField = *m.RequiredField
 	}
-	if len(this.RepeatedField) != len(that.RepeatedField) {
-		return false
+	if m.OptionalField == nil {
+		dst.OptionalField = nil
+	} else {
+		if dst.OptionalField == nil {
+			dst.OptionalField = new(EnumMessage_Num)
+		}
+		*dst.OptionalField = *m.OptionalField
 	}
-	for i, vx := range this.RepeatedField {
-		vy := that.RepeatedField[i]
-		if vx != vy {
-			return false
+	dst.RepeatedField = append(dst.RepeatedField[:0], m.RepeatedField...)
+	dst.PackedField = append(dst.PackedField[:0], m.PackedField...)
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (this *DoubleMessage) DiffVT(that *DoubleMessage) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.RequiredField, that.RequiredField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalField, that.OptionalField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	for i := 0; i < len(this.RepeatedField) && i < len(that.RepeatedField); i++ {
+		if this.RepeatedField[i] != that.RepeatedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedField[i], New: that.RepeatedField[i]})
 		}
 	}
-	if len(this.PackedField) != len(that.PackedField) {
-		return false
+	for i := len(that.RepeatedField); i < len(this.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedField[i]})
 	}
-	for i, vx := range this.PackedField {
-		vy := that.PackedField[i]
-		if vx != vy {
-			return false
+	for i := len(this.RepeatedField); i < len(that.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedField[i]})
+	}
+	for i := 0; i < len(this.PackedField) && i < len(that.PackedField); i++ {
+		if this.PackedField[i] != that.PackedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedField[i], New: that.PackedField[i]})
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	for i := len(that.PackedField); i < len(this.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedField[i]})
+	}
+	for i := len(this.PackedField); i < len(that.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedField[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
 }
 
-func (this *FloatMessage) EqualVT(that *FloatMessage) bool {
-	if this == nil {
-		return that == nil
+func (this *FloatMessage) DiffVT(that *FloatMessage) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
 	} else if that == nil {
-		return false
-	}
-	if p, q := this.RequiredField, that.RequiredField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.RequiredField, that.RequiredField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalField, that.OptionalField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	for i := 0; i < len(this.RepeatedField) && i < len(that.RepeatedField); i++ {
+		if this.RepeatedField[i] != that.RepeatedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedField[i], New: that.RepeatedField[i]})
+		}
 	}
-	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(that.RepeatedField); i < len(this.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedField[i]})
 	}
-	if len(this.RepeatedField) != len(that.RepeatedField) {
-		return false
+	for i := len(this.RepeatedField); i < len(that.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedField[i]})
 	}
-	for i, vx := range this.RepeatedField {
-		vy := that.RepeatedField[i]
-		if vx != vy {
-			return false
+	for i := 0; i < len(this.PackedField) && i < len(that.PackedField); i++ {
+		if this.PackedField[i] != that.PackedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedField[i], New: that.PackedField[i]})
 		}
 	}
-	if len(this.PackedField) != len(that.PackedField) {
-		return false
+	for i := len(that.PackedField); i < len(this.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedField[i]})
 	}
-	for i, vx := range this.PackedField {
-		vy := that.PackedField[i]
-		if vx != vy {
-			return false
-		}
+	for i := len(this.PackedField); i < len(that.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedField[i]})
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
 }
 
-func (this *Int32Message) EqualVT(that *Int32Message) bool {
-	if this == nil {
-		return that == nil
+func (this *Int32Message) DiffVT(that *Int32Message) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
 	} else if that == nil {
-		return false
-	}
-	if p, q := this.RequiredField, that.RequiredField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.RequiredField, that.RequiredField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalField, that.OptionalField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	for i := 0; i < len(this.RepeatedField) && i < len(that.RepeatedField); i++ {
+		if this.RepeatedField[i] != that.RepeatedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedField[i], New: that.RepeatedField[i]})
+		}
 	}
-	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(that.RepeatedField); i < len(this.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedField[i]})
 	}
-	if len(this.RepeatedField) != len(that.RepeatedField) {
-		return false
+	for i := len(this.RepeatedField); i < len(that.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedField[i]})
 	}
-	for i, vx := range this.RepeatedField {
-		vy := that.RepeatedField[i]
-		if vx != vy {
-			return false
+	for i := 0; i < len(this.PackedField) && i < len(that.PackedField); i++ {
+		if this.PackedField[i] != that.PackedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedField[i], New: that.PackedField[i]})
 		}
 	}
-	if len(this.PackedField) != len(that.PackedField) {
-		return false
+	for i := len(that.PackedField); i < len(this.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedField[i]})
 	}
-	for i, vx := range this.PackedField {
-		vy := that.PackedField[i]
-		if vx != vy {
-			return false
-		}
+	for i := len(this.PackedField); i < len(that.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedField[i]})
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
 }
 
-func (this *Int64Message) EqualVT(that *Int64Message) bool {
-	if this == nil {
-		return that == nil
+func (this *Int64Message) DiffVT(that *Int64Message) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
 	} else if that == nil {
-		return false
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.RequiredField, that.RequiredField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalField, that.OptionalField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	for i := 0; i < len(this.RepeatedField) && i < len(that.RepeatedField); i++ {
+		if this.RepeatedField[i] != that.RepeatedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedField[i], New: that.RepeatedField[i]})
+		}
 	}
-	if p, q := this.RequiredField, that.RequiredField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(that.RepeatedField); i < len(this.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedField[i]})
 	}
-	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
-		return false
+	for i := len(this.RepeatedField); i < len(that.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedField[i]})
 	}
-	if len(this.RepeatedField) != len(that.RepeatedField) {
-		return false
+	for i := 0; i < len(this.PackedField) && i < len(that.PackedField); i++ {
+		if this.PackedField[i] != that.PackedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedField[i], New: that.PackedField[i]})
+		}
 	}
-	for i, vx := range this.RepeatedField {
-		vy := that.RepeatedField[i]
-		if vx != vy {
-			return false
+	for i := len(that.PackedField); i < len(this.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedField[i]})
+	}
+	for i := len(this.PackedField); i < len(that.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedField[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *Uint32Message) DiffVT(that *Uint32Message) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.RequiredField, that.RequiredField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalField, that.OptionalField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	for i := 0; i < len(this.RepeatedField) && i < len(that.RepeatedField); i++ {
+		if this.RepeatedField[i] != that.RepeatedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedField[i], New: that.RepeatedField[i]})
 		}
 	}
-	if len(this.PackedField) != len(that.PackedField) {
-		return false
+	for i := len(that.RepeatedField); i < len(this.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedField[i]})
 	}
-	for i, vx := range this.PackedField {
-		vy := that.PackedField[i]
-		if vx != vy {
-			return false
+	for i := len(this.RepeatedField); i < len(that.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedField[i]})
+	}
+	for i := 0; i < len(this.PackedField) && i < len(that.PackedField); i++ {
+		if this.PackedField[i] != that.PackedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedField[i], New: that.PackedField[i]})
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	for i := len(that.PackedField); i < len(this.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedField[i]})
+	}
+	for i := len(this.PackedField); i < len(that.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedField[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
 }
 
-func (this *Uint32Message) EqualVT(that *Uint32Message) bool {
+func (this *Uint64Message) DiffVT(that *Uint64Message) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.RequiredField, that.RequiredField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalField, that.OptionalField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	for i := 0; i < len(this.RepeatedField) && i < len(that.RepeatedField); i++ {
+		if this.RepeatedField[i] != that.RepeatedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedField[i], New: that.RepeatedField[i]})
+		}
+	}
+	for i := len(that.RepeatedField); i < len(this.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedField[i]})
+	}
+	for i := len(this.RepeatedField); i < len(that.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedField[i]})
+	}
+	for i := 0; i < len(this.PackedField) && i < len(that.PackedField); i++ {
+		if this.PackedField[i] != that.PackedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedField[i], New: that.PackedField[i]})
+		}
+	}
+	for i := len(that.PackedField); i < len(this.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedField[i]})
+	}
+	for i := len(this.PackedField); i < len(that.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedField[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *Sint32Message) DiffVT(that *Sint32Message) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.RequiredField, that.RequiredField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalField, that.OptionalField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	for i := 0; i < len(this.RepeatedField) && i < len(that.RepeatedField); i++ {
+		if this.RepeatedField[i] != that.RepeatedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedField[i], New: that.RepeatedField[i]})
+		}
+	}
+	for i := len(that.RepeatedField); i < len(this.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedField[i]})
+	}
+	for i := len(this.RepeatedField); i < len(that.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedField[i]})
+	}
+	for i := 0; i < len(this.PackedField) && i < len(that.PackedField); i++ {
+		if this.PackedField[i] != that.PackedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedField[i], New: that.PackedField[i]})
+		}
+	}
+	for i := len(that.PackedField); i < len(this.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedField[i]})
+	}
+	for i := len(this.PackedField); i < len(that.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedField[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *Sint64Message) DiffVT(that *Sint64Message) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.RequiredField, that.RequiredField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalField, that.OptionalField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	for i := 0; i < len(this.RepeatedField) && i < len(that.RepeatedField); i++ {
+		if this.RepeatedField[i] != that.RepeatedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedField[i], New: that.RepeatedField[i]})
+		}
+	}
+	for i := len(that.RepeatedField); i < len(this.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedField[i]})
+	}
+	for i := len(this.RepeatedField); i < len(that.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedField[i]})
+	}
+	for i := 0; i < len(this.PackedField) && i < len(that.PackedField); i++ {
+		if this.PackedField[i] != that.PackedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedField[i], New: that.PackedField[i]})
+		}
+	}
+	for i := len(that.PackedField); i < len(this.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedField[i]})
+	}
+	for i := len(this.PackedField); i < len(that.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedField[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *Fixed32Message) DiffVT(that *Fixed32Message) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.RequiredField, that.RequiredField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalField, that.OptionalField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	for i := 0; i < len(this.RepeatedField) && i < len(that.RepeatedField); i++ {
+		if this.RepeatedField[i] != that.RepeatedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedField[i], New: that.RepeatedField[i]})
+		}
+	}
+	for i := len(that.RepeatedField); i < len(this.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedField[i]})
+	}
+	for i := len(this.RepeatedField); i < len(that.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedField[i]})
+	}
+	for i := 0; i < len(this.PackedField) && i < len(that.PackedField); i++ {
+		if this.PackedField[i] != that.PackedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedField[i], New: that.PackedField[i]})
+		}
+	}
+	for i := len(that.PackedField); i < len(this.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedField[i]})
+	}
+	for i := len(this.PackedField); i < len(that.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedField[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *Fixed64Message) DiffVT(that *Fixed64Message) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.RequiredField, that.RequiredField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalField, that.OptionalField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	for i := 0; i < len(this.RepeatedField) && i < len(that.RepeatedField); i++ {
+		if this.RepeatedField[i] != that.RepeatedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedField[i], New: that.RepeatedField[i]})
+		}
+	}
+	for i := len(that.RepeatedField); i < len(this.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedField[i]})
+	}
+	for i := len(this.RepeatedField); i < len(that.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedField[i]})
+	}
+	for i := 0; i < len(this.PackedField) && i < len(that.PackedField); i++ {
+		if this.PackedField[i] != that.PackedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedField[i], New: that.PackedField[i]})
+		}
+	}
+	for i := len(that.PackedField); i < len(this.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedField[i]})
+	}
+	for i := len(this.PackedField); i < len(that.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedField[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *Sfixed32Message) DiffVT(that *Sfixed32Message) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.RequiredField, that.RequiredField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalField, that.OptionalField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	for i := 0; i < len(this.RepeatedField) && i < len(that.RepeatedField); i++ {
+		if this.RepeatedField[i] != that.RepeatedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedField[i], New: that.RepeatedField[i]})
+		}
+	}
+	for i := len(that.RepeatedField); i < len(this.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedField[i]})
+	}
+	for i := len(this.RepeatedField); i < len(that.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedField[i]})
+	}
+	for i := 0; i < len(this.PackedField) && i < len(that.PackedField); i++ {
+		if this.PackedField[i] != that.PackedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedField[i], New: that.PackedField[i]})
+		}
+	}
+	for i := len(that.PackedField); i < len(this.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedField[i]})
+	}
+	for i := len(this.PackedField); i < len(that.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedField[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *Sfixed64Message) DiffVT(that *Sfixed64Message) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.RequiredField, that.RequiredField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalField, that.OptionalField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	for i := 0; i < len(this.RepeatedField) && i < len(that.RepeatedField); i++ {
+		if this.RepeatedField[i] != that.RepeatedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedField[i], New: that.RepeatedField[i]})
+		}
+	}
+	for i := len(that.RepeatedField); i < len(this.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedField[i]})
+	}
+	for i := len(this.RepeatedField); i < len(that.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedField[i]})
+	}
+	for i := 0; i < len(this.PackedField) && i < len(that.PackedField); i++ {
+		if this.PackedField[i] != that.PackedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedField[i], New: that.PackedField[i]})
+		}
+	}
+	for i := len(that.PackedField); i < len(this.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedField[i]})
+	}
+	for i := len(this.PackedField); i < len(that.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedField[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *BoolMessage) DiffVT(that *BoolMessage) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.RequiredField, that.RequiredField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalField, that.OptionalField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	for i := 0; i < len(this.RepeatedField) && i < len(that.RepeatedField); i++ {
+		if this.RepeatedField[i] != that.RepeatedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedField[i], New: that.RepeatedField[i]})
+		}
+	}
+	for i := len(that.RepeatedField); i < len(this.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedField[i]})
+	}
+	for i := len(this.RepeatedField); i < len(that.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedField[i]})
+	}
+	for i := 0; i < len(this.PackedField) && i < len(that.PackedField); i++ {
+		if this.PackedField[i] != that.PackedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedField[i], New: that.PackedField[i]})
+		}
+	}
+	for i := len(that.PackedField); i < len(this.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedField[i]})
+	}
+	for i := len(this.PackedField); i < len(that.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedField[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *StringMessage) DiffVT(that *StringMessage) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.RequiredField, that.RequiredField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalField, that.OptionalField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	for i := 0; i < len(this.RepeatedField) && i < len(that.RepeatedField); i++ {
+		if this.RepeatedField[i] != that.RepeatedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedField[i], New: that.RepeatedField[i]})
+		}
+	}
+	for i := len(that.RepeatedField); i < len(this.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedField[i]})
+	}
+	for i := len(this.RepeatedField); i < len(that.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedField[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *BytesMessage) DiffVT(that *BytesMessage) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.RequiredField, that.RequiredField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldRemoved, Old: p})
+	} else if string(p) != string(q) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldChanged, Old: p, New: q})
+	}
+	if p, q := this.OptionalField, that.OptionalField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldRemoved, Old: p})
+	} else if string(p) != string(q) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldChanged, Old: p, New: q})
+	}
+	for i := 0; i < len(this.RepeatedField) && i < len(that.RepeatedField); i++ {
+		if string(this.RepeatedField[i]) != string(that.RepeatedField[i]) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedField[i], New: that.RepeatedField[i]})
+		}
+	}
+	for i := len(that.RepeatedField); i < len(this.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedField[i]})
+	}
+	for i := len(this.RepeatedField); i < len(that.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedField[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *EnumMessage) DiffVT(that *EnumMessage) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.RequiredField, that.RequiredField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "required_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalField, that.OptionalField; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_field", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	for i := 0; i < len(this.RepeatedField) && i < len(that.RepeatedField); i++ {
+		if this.RepeatedField[i] != that.RepeatedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.RepeatedField[i], New: that.RepeatedField[i]})
+		}
+	}
+	for i := len(that.RepeatedField); i < len(this.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.RepeatedField[i]})
+	}
+	for i := len(this.RepeatedField); i < len(that.RepeatedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "repeated_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.RepeatedField[i]})
+	}
+	for i := 0; i < len(this.PackedField) && i < len(that.PackedField); i++ {
+		if this.PackedField[i] != that.PackedField[i] {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldChanged, Old: this.PackedField[i], New: that.PackedField[i]})
+		}
+	}
+	for i := len(that.PackedField); i < len(this.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.PackedField[i]})
+	}
+	for i := len(this.PackedField); i < len(that.PackedField); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "packed_field[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.PackedField[i]})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *DoubleMessage) EqualVT(that *DoubleMessage) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *DoubleMessage) EqualVTWithOptions(that *DoubleMessage, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
 		return false
 	}
-	if p, q := this.RequiredField, that.RequiredField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if p, q := this.RequiredField, that.RequiredField; (p == nil && q != nil) || (p != nil && (q == nil || !opts.EqualFloat64(*p, *q))) {
 		return false
 	}
-	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || !opts.EqualFloat64(*p, *q))) {
 		return false
 	}
 	if len(this.RepeatedField) != len(that.RepeatedField) {
@@ -710,7 +1804,7 @@ func (this *Uint32Message) EqualVT(that *Uint32Message) bool {
 	}
 	for i, vx := range this.RepeatedField {
 		vy := that.RepeatedField[i]
-		if vx != vy {
+		if !opts.EqualFloat64(vx, vy) {
 			return false
 		}
 	}
@@ -719,23 +1813,35 @@ func (this *Uint32Message) EqualVT(that *Uint32Message) bool {
 	}
 	for i, vx := range this.PackedField {
 		vy := that.PackedField[i]
-		if vx != vy {
+		if !opts.EqualFloat64(vx, vy) {
 			return false
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Uint64Message) EqualVT(that *Uint64Message) bool {
+func (this *DoubleMessage) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*DoubleMessage)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *FloatMessage) EqualVT(that *FloatMessage) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *FloatMessage) EqualVTWithOptions(that *FloatMessage, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
 		return false
 	}
-	if p, q := this.RequiredField, that.RequiredField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if p, q := this.RequiredField, that.RequiredField; (p == nil && q != nil) || (p != nil && (q == nil || !opts.EqualFloat32(*p, *q))) {
 		return false
 	}
-	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || !opts.EqualFloat32(*p, *q))) {
 		return false
 	}
 	if len(this.RepeatedField) != len(that.RepeatedField) {
@@ -743,7 +1849,7 @@ func (this *Uint64Message) EqualVT(that *Uint64Message) bool {
 	}
 	for i, vx := range this.RepeatedField {
 		vy := that.RepeatedField[i]
-		if vx != vy {
+		if !opts.EqualFloat32(vx, vy) {
 			return false
 		}
 	}
@@ -752,14 +1858,26 @@ func (this *Uint64Message) EqualVT(that *Uint64Message) bool {
 	}
 	for i, vx := range this.PackedField {
 		vy := that.PackedField[i]
-		if vx != vy {
+		if !opts.EqualFloat32(vx, vy) {
 			return false
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Sint32Message) EqualVT(that *Sint32Message) bool {
+func (this *FloatMessage) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*FloatMessage)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *Int32Message) EqualVT(that *Int32Message) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Int32Message) EqualVTWithOptions(that *Int32Message, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
@@ -789,10 +1907,22 @@ func (this *Sint32Message) EqualVT(that *Sint32Message) bool {
 			return false
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Sint64Message) EqualVT(that *Sint64Message) bool {
+func (this *Int32Message) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Int32Message)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *Int64Message) EqualVT(that *Int64Message) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Int64Message) EqualVTWithOptions(that *Int64Message, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
@@ -822,10 +1952,22 @@ func (this *Sint64Message) EqualVT(that *Sint64Message) bool {
 			return false
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Fixed32Message) EqualVT(that *Fixed32Message) bool {
+func (this *Int64Message) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Int64Message)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *Uint32Message) EqualVT(that *Uint32Message) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Uint32Message) EqualVTWithOptions(that *Uint32Message, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
@@ -855,10 +1997,22 @@ func (this *Fixed32Message) EqualVT(that *Fixed32Message) bool {
 			return false
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Fixed64Message) EqualVT(that *Fixed64Message) bool {
+func (this *Uint32Message) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Uint32Message)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *Uint64Message) EqualVT(that *Uint64Message) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Uint64Message) EqualVTWithOptions(that *Uint64Message, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
@@ -888,10 +2042,22 @@ func (this *Fixed64Message) EqualVT(that *Fixed64Message) bool {
 			return false
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Sfixed32Message) EqualVT(that *Sfixed32Message) bool {
+func (this *Uint64Message) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Uint64Message)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *Sint32Message) EqualVT(that *Sint32Message) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Sint32Message) EqualVTWithOptions(that *Sint32Message, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
@@ -921,10 +2087,22 @@ func (this *Sfixed32Message) EqualVT(that *Sfixed32Message) bool {
 			return false
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Sfixed64Message) EqualVT(that *Sfixed64Message) bool {
+func (this *Sint32Message) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Sint32Message)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *Sint64Message) EqualVT(that *Sint64Message) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Sint64Message) EqualVTWithOptions(that *Sint64Message, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
@@ -954,10 +2132,22 @@ func (this *Sfixed64Message) EqualVT(that *Sfixed64Message) bool {
 			return false
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *BoolMessage) EqualVT(that *BoolMessage) bool {
+func (this *Sint64Message) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Sint64Message)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *Fixed32Message) EqualVT(that *Fixed32Message) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Fixed32Message) EqualVTWithOptions(that *Fixed32Message, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
@@ -987,10 +2177,22 @@ func (this *BoolMessage) EqualVT(that *BoolMessage) bool {
 			return false
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *StringMessage) EqualVT(that *StringMessage) bool {
+func (this *Fixed32Message) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Fixed32Message)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *Fixed64Message) EqualVT(that *Fixed64Message) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Fixed64Message) EqualVTWithOptions(that *Fixed64Message, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
@@ -1011,19 +2213,40 @@ func (this *StringMessage) EqualVT(that *StringMessage) bool {
 			return false
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if len(this.PackedField) != len(that.PackedField) {
+		return false
+	}
+	for i, vx := range this.PackedField {
+		vy := that.PackedField[i]
+		if vx != vy {
+			return false
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *BytesMessage) EqualVT(that *BytesMessage) bool {
+func (this *Fixed64Message) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Fixed64Message)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *Sfixed32Message) EqualVT(that *Sfixed32Message) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Sfixed32Message) EqualVTWithOptions(that *Sfixed32Message, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
 		return false
 	}
-	if p, q := this.RequiredField, that.RequiredField; (p == nil && q != nil) || (p != nil && q == nil) || string(p) != string(q) {
+	if p, q := this.RequiredField, that.RequiredField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && q == nil) || string(p) != string(q) {
+	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
 	if len(this.RepeatedField) != len(that.RepeatedField) {
@@ -1031,14 +2254,35 @@ func (this *BytesMessage) EqualVT(that *BytesMessage) bool {
 	}
 	for i, vx := range this.RepeatedField {
 		vy := that.RepeatedField[i]
-		if string(vx) != string(vy) {
+		if vx != vy {
 			return false
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	if len(this.PackedField) != len(that.PackedField) {
+		return false
+	}
+	for i, vx := range this.PackedField {
+		vy := that.PackedField[i]
+		if vx != vy {
+			return false
+		}
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *EnumMessage) EqualVT(that *EnumMessage) bool {
+func (this *Sfixed32Message) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Sfixed32Message)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *Sfixed64Message) EqualVT(that *Sfixed64Message) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Sfixed64Message) EqualVTWithOptions(that *Sfixed64Message, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
@@ -1068,1525 +2312,10555 @@ func (this *EnumMessage) EqualVT(that *EnumMessage) bool {
 			return false
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *DoubleMessage) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Sfixed64Message) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Sfixed64Message)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
+	return this.EqualVT(that)
 }
 
-func (m *DoubleMessage) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *BoolMessage) EqualVT(that *BoolMessage) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
 }
 
-func (m *DoubleMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *BoolMessage) EqualVTWithOptions(that *BoolMessage, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if p, q := this.RequiredField, that.RequiredField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if len(m.PackedField) > 0 {
-		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
-			f1 := math.Float64bits(float64(m.PackedField[iNdEx]))
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f1))
-		}
-		i = encodeVarint(dAtA, i, uint64(len(m.PackedField)*8))
-		i--
-		dAtA[i] = 0x22
+	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			f2 := math.Float64bits(float64(m.RepeatedField[iNdEx]))
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f2))
-			i--
-			dAtA[i] = 0x19
+	if len(this.RepeatedField) != len(that.RepeatedField) {
+		return false
+	}
+	for i, vx := range this.RepeatedField {
+		vy := that.RepeatedField[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if m.OptionalField != nil {
-		i -= 8
-		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.OptionalField))))
-		i--
-		dAtA[i] = 0x11
+	if len(this.PackedField) != len(that.PackedField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i -= 8
-		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.RequiredField))))
-		i--
-		dAtA[i] = 0x9
+	for i, vx := range this.PackedField {
+		vy := that.PackedField[i]
+		if vx != vy {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *FloatMessage) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *BoolMessage) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*BoolMessage)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
+	return this.EqualVT(that)
 }
 
-func (m *FloatMessage) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *StringMessage) EqualVT(that *StringMessage) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
 }
 
-func (m *FloatMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+func (this *StringMessage) EqualVTWithOptions(that *StringMessage, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
 	}
-	if len(m.PackedField) > 0 {
-		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
-			f1 := math.Float32bits(float32(m.PackedField[iNdEx]))
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f1))
-		}
-		i = encodeVarint(dAtA, i, uint64(len(m.PackedField)*4))
-		i--
-		dAtA[i] = 0x22
+	if p, q := this.RequiredField, that.RequiredField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			f2 := math.Float32bits(float32(m.RepeatedField[iNdEx]))
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f2))
-			i--
-			dAtA[i] = 0x1d
-		}
+	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if m.OptionalField != nil {
-		i -= 4
-		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(*m.OptionalField))))
-		i--
-		dAtA[i] = 0x15
+	if len(this.RepeatedField) != len(that.RepeatedField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i -= 4
-		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(*m.RequiredField))))
-		i--
-		dAtA[i] = 0xd
+	for i, vx := range this.RepeatedField {
+		vy := that.RepeatedField[i]
+		if vx != vy {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Int32Message) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *StringMessage) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*StringMessage)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
+	return this.EqualVT(that)
 }
 
-func (m *Int32Message) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *BytesMessage) EqualVT(that *BytesMessage) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
 }
 
-func (m *Int32Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+func (this *BytesMessage) EqualVTWithOptions(that *BytesMessage, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
 	}
-	if len(m.PackedField) > 0 {
-		var pksize2 int
-		for _, num := range m.PackedField {
-			pksize2 += sov(uint64(num))
-		}
-		i -= pksize2
-		j1 := i
-		for _, num1 := range m.PackedField {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA[j1] = uint8(num)
-			j1++
-		}
-		i = encodeVarint(dAtA, i, uint64(pksize2))
-		i--
-		dAtA[i] = 0x22
+	if p, q := this.RequiredField, that.RequiredField; (p == nil && q != nil) || (p != nil && q == nil) || string(p) != string(q) {
+		return false
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x18
-		}
+	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && q == nil) || string(p) != string(q) {
+		return false
 	}
-	if m.OptionalField != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x10
+	if len(this.RepeatedField) != len(that.RepeatedField) {
+		return false
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = encodeVarint(dAtA, i, uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x8
+	for i, vx := range this.RepeatedField {
+		vy := that.RepeatedField[i]
+		if string(vx) != string(vy) {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Int64Message) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *BytesMessage) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*BytesMessage)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
+	return this.EqualVT(that)
 }
 
-func (m *Int64Message) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *EnumMessage) EqualVT(that *EnumMessage) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
 }
 
-func (m *Int64Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *EnumMessage) EqualVTWithOptions(that *EnumMessage, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if p, q := this.RequiredField, that.RequiredField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
 	}
-	if len(m.PackedField) > 0 {
-		var pksize2 int
-		for _, num := range m.PackedField {
-			pksize2 += sov(uint64(num))
+	if p, q := this.OptionalField, that.OptionalField; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+		return false
+	}
+	if len(this.RepeatedField) != len(that.RepeatedField) {
+		return false
+	}
+	for i, vx := range this.RepeatedField {
+		vy := that.RepeatedField[i]
+		if vx != vy {
+			return false
 		}
-		i -= pksize2
-		j1 := i
-		for _, num1 := range m.PackedField {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA[j1] = uint8(num)
-			j1++
+	}
+	if len(this.PackedField) != len(that.PackedField) {
+		return false
+	}
+	for i, vx := range this.PackedField {
+		vy := that.PackedField[i]
+		if vx != vy {
+			return false
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize2))
-		i--
-		dAtA[i] = 0x22
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *EnumMessage) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*EnumMessage)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (m *DoubleMessage) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *DoubleMessage) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	if m.RequiredField != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashFloat64(h, s, *m.RequiredField)
+	}
+	if m.OptionalField != nil {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashFloat64(h, s, *m.OptionalField)
 	}
 	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x18
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedField)))
+		for _, v := range m.RepeatedField {
+			protohelpers.HashFloat64(h, s, v)
 		}
 	}
-	if m.OptionalField != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x10
+	if len(m.PackedField) > 0 {
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedField)))
+		for _, v := range m.PackedField {
+			protohelpers.HashFloat64(h, s, v)
+		}
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = encodeVarint(dAtA, i, uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x8
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *Uint32Message) MarshalVT() (dAtA []byte, err error) {
+func (m *FloatMessage) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *FloatMessage) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return nil, nil
+		return
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.RequiredField != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashFloat32(h, s, *m.RequiredField)
+	}
+	if m.OptionalField != nil {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashFloat32(h, s, *m.OptionalField)
+	}
+	if len(m.RepeatedField) > 0 {
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedField)))
+		for _, v := range m.RepeatedField {
+			protohelpers.HashFloat32(h, s, v)
+		}
+	}
+	if len(m.PackedField) > 0 {
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedField)))
+		for _, v := range m.PackedField {
+			protohelpers.HashFloat32(h, s, v)
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	return dAtA[:n], nil
 }
 
-func (m *Uint32Message) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *Int32Message) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
 }
 
-func (m *Uint32Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Int32Message) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return 0, nil
+		return
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if m.RequiredField != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(*m.RequiredField))
 	}
-	if len(m.PackedField) > 0 {
-		var pksize2 int
-		for _, num := range m.PackedField {
-			pksize2 += sov(uint64(num))
-		}
-		i -= pksize2
-		j1 := i
-		for _, num := range m.PackedField {
-			for num >= 1<<7 {
-				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA[j1] = uint8(num)
-			j1++
-		}
-		i = encodeVarint(dAtA, i, uint64(pksize2))
-		i--
-		dAtA[i] = 0x22
+	if m.OptionalField != nil {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalField))
 	}
 	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x18
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedField)))
+		for _, v := range m.RepeatedField {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
 	}
-	if m.OptionalField != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x10
+	if len(m.PackedField) > 0 {
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedField)))
+		for _, v := range m.PackedField {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = encodeVarint(dAtA, i, uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x8
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *Uint64Message) MarshalVT() (dAtA []byte, err error) {
+func (m *Int64Message) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *Int64Message) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return nil, nil
+		return
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.RequiredField != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(*m.RequiredField))
+	}
+	if m.OptionalField != nil {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalField))
+	}
+	if len(m.RepeatedField) > 0 {
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedField)))
+		for _, v := range m.RepeatedField {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.PackedField) > 0 {
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedField)))
+		for _, v := range m.PackedField {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	return dAtA[:n], nil
 }
 
-func (m *Uint64Message) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *Uint32Message) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
 }
 
-func (m *Uint64Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Uint32Message) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return 0, nil
+		return
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if m.RequiredField != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(*m.RequiredField))
 	}
-	if len(m.PackedField) > 0 {
-		var pksize2 int
-		for _, num := range m.PackedField {
-			pksize2 += sov(uint64(num))
-		}
-		i -= pksize2
-		j1 := i
-		for _, num := range m.PackedField {
-			for num >= 1<<7 {
-				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA[j1] = uint8(num)
-			j1++
-		}
-		i = encodeVarint(dAtA, i, uint64(pksize2))
-		i--
-		dAtA[i] = 0x22
+	if m.OptionalField != nil {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalField))
 	}
 	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x18
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedField)))
+		for _, v := range m.RepeatedField {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
 	}
-	if m.OptionalField != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x10
+	if len(m.PackedField) > 0 {
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedField)))
+		for _, v := range m.PackedField {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = encodeVarint(dAtA, i, uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x8
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *Sint32Message) MarshalVT() (dAtA []byte, err error) {
+func (m *Uint64Message) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *Uint64Message) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return nil, nil
+		return
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.RequiredField != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(*m.RequiredField))
+	}
+	if m.OptionalField != nil {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalField))
+	}
+	if len(m.RepeatedField) > 0 {
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedField)))
+		for _, v := range m.RepeatedField {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.PackedField) > 0 {
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedField)))
+		for _, v := range m.PackedField {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	return dAtA[:n], nil
 }
 
-func (m *Sint32Message) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *Sint32Message) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
 }
 
-func (m *Sint32Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Sint32Message) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return 0, nil
+		return
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if m.RequiredField != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(*m.RequiredField))
 	}
-	if len(m.PackedField) > 0 {
-		var pksize2 int
-		for _, num := range m.PackedField {
-			pksize2 += soz(uint64(num))
+	if m.OptionalField != nil {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalField))
+	}
+	if len(m.RepeatedField) > 0 {
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedField)))
+		for _, v := range m.RepeatedField {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
-		i -= pksize2
-		j1 := i
-		for _, num := range m.PackedField {
-			x3 := (uint32(num) << 1) ^ uint32((num >> 31))
-			for x3 >= 1<<7 {
-				dAtA[j1] = uint8(uint64(x3)&0x7f | 0x80)
-				j1++
-				x3 >>= 7
-			}
-			dAtA[j1] = uint8(x3)
-			j1++
-		}
-		i = encodeVarint(dAtA, i, uint64(pksize2))
-		i--
-		dAtA[i] = 0x22
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			x4 := (uint32(m.RepeatedField[iNdEx]) << 1) ^ uint32((m.RepeatedField[iNdEx] >> 31))
-			i = encodeVarint(dAtA, i, uint64(x4))
-			i--
-			dAtA[i] = 0x18
+	if len(m.PackedField) > 0 {
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedField)))
+		for _, v := range m.PackedField {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
 	}
-	if m.OptionalField != nil {
-		i = encodeVarint(dAtA, i, uint64((uint32(*m.OptionalField)<<1)^uint32((*m.OptionalField>>31))))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = encodeVarint(dAtA, i, uint64((uint32(*m.RequiredField)<<1)^uint32((*m.RequiredField>>31))))
-		i--
-		dAtA[i] = 0x8
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *Sint64Message) MarshalVT() (dAtA []byte, err error) {
+func (m *Sint64Message) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *Sint64Message) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return nil, nil
+		return
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.RequiredField != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(*m.RequiredField))
+	}
+	if m.OptionalField != nil {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalField))
+	}
+	if len(m.RepeatedField) > 0 {
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedField)))
+		for _, v := range m.RepeatedField {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.PackedField) > 0 {
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedField)))
+		for _, v := range m.PackedField {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	return dAtA[:n], nil
 }
 
-func (m *Sint64Message) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *Fixed32Message) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
 }
 
-func (m *Sint64Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Fixed32Message) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return 0, nil
+		return
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if m.RequiredField != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(*m.RequiredField))
 	}
-	if len(m.PackedField) > 0 {
-		var pksize2 int
-		for _, num := range m.PackedField {
-			pksize2 += soz(uint64(num))
-		}
-		i -= pksize2
-		j1 := i
-		for _, num := range m.PackedField {
-			x3 := (uint64(num) << 1) ^ uint64((num >> 63))
-			for x3 >= 1<<7 {
-				dAtA[j1] = uint8(uint64(x3)&0x7f | 0x80)
-				j1++
-				x3 >>= 7
-			}
-			dAtA[j1] = uint8(x3)
-			j1++
-		}
-		i = encodeVarint(dAtA, i, uint64(pksize2))
-		i--
-		dAtA[i] = 0x22
+	if m.OptionalField != nil {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalField))
 	}
 	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			x4 := (uint64(m.RepeatedField[iNdEx]) << 1) ^ uint64((m.RepeatedField[iNdEx] >> 63))
-			i = encodeVarint(dAtA, i, uint64(x4))
-			i--
-			dAtA[i] = 0x18
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedField)))
+		for _, v := range m.RepeatedField {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
 	}
-	if m.OptionalField != nil {
-		i = encodeVarint(dAtA, i, uint64((uint64(*m.OptionalField)<<1)^uint64((*m.OptionalField>>63))))
-		i--
-		dAtA[i] = 0x10
+	if len(m.PackedField) > 0 {
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedField)))
+		for _, v := range m.PackedField {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = encodeVarint(dAtA, i, uint64((uint64(*m.RequiredField)<<1)^uint64((*m.RequiredField>>63))))
-		i--
-		dAtA[i] = 0x8
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *Fixed32Message) MarshalVT() (dAtA []byte, err error) {
+func (m *Fixed64Message) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *Fixed64Message) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return nil, nil
+		return
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.RequiredField != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(*m.RequiredField))
+	}
+	if m.OptionalField != nil {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalField))
+	}
+	if len(m.RepeatedField) > 0 {
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedField)))
+		for _, v := range m.RepeatedField {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.PackedField) > 0 {
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedField)))
+		for _, v := range m.PackedField {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	return dAtA[:n], nil
 }
 
-func (m *Fixed32Message) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *Sfixed32Message) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
 }
 
-func (m *Fixed32Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Sfixed32Message) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return 0, nil
+		return
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if m.RequiredField != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(*m.RequiredField))
 	}
-	if len(m.PackedField) > 0 {
-		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.PackedField[iNdEx]))
-		}
-		i = encodeVarint(dAtA, i, uint64(len(m.PackedField)*4))
-		i--
-		dAtA[i] = 0x22
+	if m.OptionalField != nil {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalField))
 	}
 	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x1d
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedField)))
+		for _, v := range m.RepeatedField {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
 	}
-	if m.OptionalField != nil {
-		i -= 4
-		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.OptionalField))
-		i--
-		dAtA[i] = 0x15
+	if len(m.PackedField) > 0 {
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedField)))
+		for _, v := range m.PackedField {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i -= 4
-		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.RequiredField))
-		i--
-		dAtA[i] = 0xd
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *Fixed64Message) MarshalVT() (dAtA []byte, err error) {
+func (m *Sfixed64Message) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *Sfixed64Message) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return nil, nil
+		return
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.RequiredField != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(*m.RequiredField))
+	}
+	if m.OptionalField != nil {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalField))
+	}
+	if len(m.RepeatedField) > 0 {
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedField)))
+		for _, v := range m.RepeatedField {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.PackedField) > 0 {
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedField)))
+		for _, v := range m.PackedField {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	return dAtA[:n], nil
 }
 
-func (m *Fixed64Message) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *BoolMessage) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
 }
 
-func (m *Fixed64Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *BoolMessage) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return 0, nil
+		return
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if m.RequiredField != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashBool(h, s, *m.RequiredField)
 	}
-	if len(m.PackedField) > 0 {
-		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.PackedField[iNdEx]))
-		}
-		i = encodeVarint(dAtA, i, uint64(len(m.PackedField)*8))
-		i--
-		dAtA[i] = 0x22
+	if m.OptionalField != nil {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashBool(h, s, *m.OptionalField)
 	}
 	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x19
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedField)))
+		for _, v := range m.RepeatedField {
+			protohelpers.HashBool(h, s, v)
 		}
 	}
-	if m.OptionalField != nil {
-		i -= 8
-		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x11
+	if len(m.PackedField) > 0 {
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedField)))
+		for _, v := range m.PackedField {
+			protohelpers.HashBool(h, s, v)
+		}
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i -= 8
-		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x9
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *Sfixed32Message) MarshalVT() (dAtA []byte, err error) {
+func (m *StringMessage) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *StringMessage) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return nil, nil
+		return
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.RequiredField != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashString(h, s, *m.RequiredField)
+	}
+	if m.OptionalField != nil {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashString(h, s, *m.OptionalField)
+	}
+	if len(m.RepeatedField) > 0 {
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedField)))
+		for _, v := range m.RepeatedField {
+			protohelpers.HashString(h, s, v)
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	return dAtA[:n], nil
 }
 
-func (m *Sfixed32Message) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *BytesMessage) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
 }
 
-func (m *Sfixed32Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *BytesMessage) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return 0, nil
+		return
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if m.RequiredField != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashBytes(h, s, m.RequiredField)
 	}
-	if len(m.PackedField) > 0 {
-		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.PackedField[iNdEx]))
-		}
-		i = encodeVarint(dAtA, i, uint64(len(m.PackedField)*4))
-		i--
-		dAtA[i] = 0x22
+	if m.OptionalField != nil {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashBytes(h, s, m.OptionalField)
 	}
 	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 4
-			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x1d
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedField)))
+		for _, v := range m.RepeatedField {
+			protohelpers.HashBytes(h, s, v)
 		}
 	}
-	if m.OptionalField != nil {
-		i -= 4
-		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.OptionalField))
-		i--
-		dAtA[i] = 0x15
-	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i -= 4
-		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.RequiredField))
-		i--
-		dAtA[i] = 0xd
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Sfixed64Message) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	return dAtA[:n], nil
 }
 
-func (m *Sfixed64Message) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *EnumMessage) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
 }
 
-func (m *Sfixed64Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *EnumMessage) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
 	if m == nil {
-		return 0, nil
+		return
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if m.RequiredField != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(*m.RequiredField))
 	}
-	if len(m.PackedField) > 0 {
-		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.PackedField[iNdEx]))
-		}
-		i = encodeVarint(dAtA, i, uint64(len(m.PackedField)*8))
-		i--
-		dAtA[i] = 0x22
+	if m.OptionalField != nil {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalField))
 	}
 	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i -= 8
-			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x19
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(len(m.RepeatedField)))
+		for _, v := range m.RepeatedField {
+			protohelpers.HashUint64(h, s, uint64(v))
 		}
 	}
-	if m.OptionalField != nil {
-		i -= 8
-		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x11
-	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i -= 8
-		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x9
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *BoolMessage) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if len(m.PackedField) > 0 {
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashUint64(h, s, uint64(len(m.PackedField)))
+		for _, v := range m.PackedField {
+			protohelpers.HashUint64(h, s, uint64(v))
+		}
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
 	}
-	return dAtA[:n], nil
 }
 
-func (m *BoolMessage) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *DoubleMessage) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
 }
 
-func (m *BoolMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *DoubleMessage) MarshalJSONToVT(w *vtproto.JSONWriter) error {
 	if m == nil {
-		return 0, nil
+		// a nil message is written like an empty one
+		m = &DoubleMessage{}
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	w.ObjectStart()
+	if m.RequiredField != nil {
+		w.Field("requiredField", "required_field")
+		w.Float64(*m.RequiredField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("requiredField", "required_field")
+		w.Null()
 	}
-	if len(m.PackedField) > 0 {
-		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.PackedField[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-		}
-		i = encodeVarint(dAtA, i, uint64(len(m.PackedField)))
-		i--
-		dAtA[i] = 0x22
+	if m.OptionalField != nil {
+		w.Field("optionalField", "optional_field")
+		w.Float64(*m.OptionalField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalField", "optional_field")
+		w.Null()
 	}
 	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i--
-			if m.RepeatedField[iNdEx] {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x18
-		}
-	}
-	if m.OptionalField != nil {
-		i--
-		if *m.OptionalField {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		for _, v := range m.RepeatedField {
+			w.Float64(v)
 		}
-		i--
-		dAtA[i] = 0x10
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i--
-		if *m.RequiredField {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.PackedField) > 0 {
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		for _, v := range m.PackedField {
+			w.Float64(v)
 		}
-		i--
-		dAtA[i] = 0x8
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
-	return len(dAtA) - i, nil
+	w.ObjectEnd()
+	return nil
 }
 
-func (m *StringMessage) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DoubleMessage) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *DoubleMessage) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [4]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "requiredField", "required_field":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadFloat64()
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+		case "optionalField", "optional_field":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadFloat64()
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case "repeatedField", "repeated_field":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadFloat64()
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			}
+		case "packedField", "packed_field":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadFloat64()
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
 	}
-	return dAtA[:n], nil
 }
 
-func (m *StringMessage) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *FloatMessage) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
 }
 
-func (m *StringMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *FloatMessage) MarshalJSONToVT(w *vtproto.JSONWriter) error {
 	if m == nil {
-		return 0, nil
+		// a nil message is written like an empty one
+		m = &FloatMessage{}
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	w.ObjectStart()
+	if m.RequiredField != nil {
+		w.Field("requiredField", "required_field")
+		w.Float32(*m.RequiredField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("requiredField", "required_field")
+		w.Null()
+	}
+	if m.OptionalField != nil {
+		w.Field("optionalField", "optional_field")
+		w.Float32(*m.OptionalField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalField", "optional_field")
+		w.Null()
 	}
 	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RepeatedField[iNdEx])
-			copy(dAtA[i:], m.RepeatedField[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.RepeatedField[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		for _, v := range m.RepeatedField {
+			w.Float32(v)
 		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
-	if m.OptionalField != nil {
-		i -= len(*m.OptionalField)
-		copy(dAtA[i:], *m.OptionalField)
-		i = encodeVarint(dAtA, i, uint64(len(*m.OptionalField)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i -= len(*m.RequiredField)
-		copy(dAtA[i:], *m.RequiredField)
-		i = encodeVarint(dAtA, i, uint64(len(*m.RequiredField)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.PackedField) > 0 {
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		for _, v := range m.PackedField {
+			w.Float32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
-	return len(dAtA) - i, nil
+	w.ObjectEnd()
+	return nil
 }
 
-func (m *BytesMessage) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *FloatMessage) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
 }
 
-func (m *BytesMessage) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *FloatMessage) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [4]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "requiredField", "required_field":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadFloat32()
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+		case "optionalField", "optional_field":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadFloat32()
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case "repeatedField", "repeated_field":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadFloat32()
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			}
+		case "packedField", "packed_field":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadFloat32()
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
 }
 
-func (m *BytesMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Int32Message) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *Int32Message) MarshalJSONToVT(w *vtproto.JSONWriter) error {
 	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+		// a nil message is written like an empty one
+		m = &Int32Message{}
 	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RepeatedField[iNdEx])
-			copy(dAtA[i:], m.RepeatedField[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.RepeatedField[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	w.ObjectStart()
+	if m.RequiredField != nil {
+		w.Field("requiredField", "required_field")
+		w.Int32(*m.RequiredField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("requiredField", "required_field")
+		w.Null()
 	}
 	if m.OptionalField != nil {
-		i -= len(m.OptionalField)
-		copy(dAtA[i:], m.OptionalField)
-		i = encodeVarint(dAtA, i, uint64(len(m.OptionalField)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i -= len(m.RequiredField)
-		copy(dAtA[i:], m.RequiredField)
-		i = encodeVarint(dAtA, i, uint64(len(m.RequiredField)))
-		i--
-		dAtA[i] = 0xa
+		w.Field("optionalField", "optional_field")
+		w.Int32(*m.OptionalField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalField", "optional_field")
+		w.Null()
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *EnumMessage) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if len(m.RepeatedField) > 0 {
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		for _, v := range m.RepeatedField {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.PackedField) > 0 {
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		for _, v := range m.PackedField {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
-	return dAtA[:n], nil
+	w.ObjectEnd()
+	return nil
 }
 
-func (m *EnumMessage) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (m *Int32Message) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
 }
 
-func (m *EnumMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
-	}
-	if len(m.PackedField) > 0 {
-		var pksize2 int
-		for _, num := range m.PackedField {
-			pksize2 += sov(uint64(num))
+func (m *Int32Message) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [4]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
 		}
-		i -= pksize2
-		j1 := i
-		for _, num1 := range m.PackedField {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA[j1] = uint8(num)
-			j1++
+		if !ok {
+			return nil
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize2))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.RepeatedField) > 0 {
-		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
-			i--
-			dAtA[i] = 0x18
+		switch name {
+		case "requiredField", "required_field":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+		case "optionalField", "optional_field":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case "repeatedField", "repeated_field":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			}
+		case "packedField", "packed_field":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
 		}
 	}
-	if m.OptionalField != nil {
-		i = encodeVarint(dAtA, i, uint64(*m.OptionalField))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.RequiredField == nil {
-		return 0, fmt.Errorf("proto: required field required_field not set")
-	} else {
-		i = encodeVarint(dAtA, i, uint64(*m.RequiredField))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
 }
 
-func encodeVarint(dAtA []byte, offset int, v uint64) int {
-	offset -= sov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *Int64Message) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
 }
-func (m *DoubleMessage) SizeVT() (n int) {
+
+func (m *Int64Message) MarshalJSONToVT(w *vtproto.JSONWriter) error {
 	if m == nil {
-		return 0
+		// a nil message is written like an empty one
+		m = &Int64Message{}
 	}
-	var l int
-	_ = l
+	w.ObjectStart()
 	if m.RequiredField != nil {
-		n += 9
+		w.Field("requiredField", "required_field")
+		w.Int64(*m.RequiredField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("requiredField", "required_field")
+		w.Null()
 	}
 	if m.OptionalField != nil {
-		n += 9
+		w.Field("optionalField", "optional_field")
+		w.Int64(*m.OptionalField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalField", "optional_field")
+		w.Null()
 	}
 	if len(m.RepeatedField) > 0 {
-		n += 9 * len(m.RepeatedField)
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		for _, v := range m.RepeatedField {
+			w.Int64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
 	if len(m.PackedField) > 0 {
-		n += 1 + sov(uint64(len(m.PackedField)*8)) + len(m.PackedField)*8
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		for _, v := range m.PackedField {
+			w.Int64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
-	n += len(m.unknownFields)
-	return n
+	w.ObjectEnd()
+	return nil
 }
 
-func (m *FloatMessage) SizeVT() (n int) {
+func (m *Int64Message) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *Int64Message) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [4]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "requiredField", "required_field":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt64()
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+		case "optionalField", "optional_field":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt64()
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case "repeatedField", "repeated_field":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			}
+		case "packedField", "packed_field":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *Uint32Message) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *Uint32Message) MarshalJSONToVT(w *vtproto.JSONWriter) error {
 	if m == nil {
-		return 0
+		// a nil message is written like an empty one
+		m = &Uint32Message{}
 	}
-	var l int
-	_ = l
+	w.ObjectStart()
 	if m.RequiredField != nil {
-		n += 5
+		w.Field("requiredField", "required_field")
+		w.Uint32(*m.RequiredField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("requiredField", "required_field")
+		w.Null()
 	}
 	if m.OptionalField != nil {
-		n += 5
+		w.Field("optionalField", "optional_field")
+		w.Uint32(*m.OptionalField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalField", "optional_field")
+		w.Null()
 	}
 	if len(m.RepeatedField) > 0 {
-		n += 5 * len(m.RepeatedField)
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		for _, v := range m.RepeatedField {
+			w.Uint32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
 	if len(m.PackedField) > 0 {
-		n += 1 + sov(uint64(len(m.PackedField)*4)) + len(m.PackedField)*4
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		for _, v := range m.PackedField {
+			w.Uint32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
-	n += len(m.unknownFields)
-	return n
+	w.ObjectEnd()
+	return nil
 }
 
-func (m *Int32Message) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RequiredField != nil {
-		n += 1 + sov(uint64(*m.RequiredField))
-	}
-	if m.OptionalField != nil {
-		n += 1 + sov(uint64(*m.OptionalField))
-	}
-	if len(m.RepeatedField) > 0 {
-		for _, e := range m.RepeatedField {
-			n += 1 + sov(uint64(e))
+func (m *Uint32Message) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *Uint32Message) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [4]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
 		}
-	}
-	if len(m.PackedField) > 0 {
-		l = 0
-		for _, e := range m.PackedField {
-			l += sov(uint64(e))
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "requiredField", "required_field":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint32()
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+		case "optionalField", "optional_field":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint32()
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case "repeatedField", "repeated_field":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint32()
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			}
+		case "packedField", "packed_field":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint32()
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
 		}
-		n += 1 + sov(uint64(l)) + l
 	}
-	n += len(m.unknownFields)
-	return n
 }
 
-func (m *Int64Message) SizeVT() (n int) {
+func (m *Uint64Message) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *Uint64Message) MarshalJSONToVT(w *vtproto.JSONWriter) error {
 	if m == nil {
-		return 0
+		// a nil message is written like an empty one
+		m = &Uint64Message{}
 	}
-	var l int
-	_ = l
+	w.ObjectStart()
 	if m.RequiredField != nil {
-		n += 1 + sov(uint64(*m.RequiredField))
+		w.Field("requiredField", "required_field")
+		w.Uint64(*m.RequiredField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("requiredField", "required_field")
+		w.Null()
 	}
 	if m.OptionalField != nil {
-		n += 1 + sov(uint64(*m.OptionalField))
+		w.Field("optionalField", "optional_field")
+		w.Uint64(*m.OptionalField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalField", "optional_field")
+		w.Null()
 	}
 	if len(m.RepeatedField) > 0 {
-		for _, e := range m.RepeatedField {
-			n += 1 + sov(uint64(e))
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		for _, v := range m.RepeatedField {
+			w.Uint64(v)
 		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
 	if len(m.PackedField) > 0 {
-		l = 0
-		for _, e := range m.PackedField {
-			l += sov(uint64(e))
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		for _, v := range m.PackedField {
+			w.Uint64(v)
 		}
-		n += 1 + sov(uint64(l)) + l
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
-	n += len(m.unknownFields)
-	return n
+	w.ObjectEnd()
+	return nil
 }
 
-func (m *Uint32Message) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RequiredField != nil {
-		n += 1 + sov(uint64(*m.RequiredField))
-	}
-	if m.OptionalField != nil {
-		n += 1 + sov(uint64(*m.OptionalField))
-	}
-	if len(m.RepeatedField) > 0 {
-		for _, e := range m.RepeatedField {
-			n += 1 + sov(uint64(e))
+func (m *Uint64Message) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *Uint64Message) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [4]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
 		}
-	}
-	if len(m.PackedField) > 0 {
-		l = 0
-		for _, e := range m.PackedField {
-			l += sov(uint64(e))
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "requiredField", "required_field":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint64()
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+		case "optionalField", "optional_field":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint64()
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case "repeatedField", "repeated_field":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint64()
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			}
+		case "packedField", "packed_field":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint64()
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
 		}
-		n += 1 + sov(uint64(l)) + l
 	}
-	n += len(m.unknownFields)
-	return n
 }
 
-func (m *Uint64Message) SizeVT() (n int) {
+func (m *Sint32Message) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *Sint32Message) MarshalJSONToVT(w *vtproto.JSONWriter) error {
 	if m == nil {
-		return 0
+		// a nil message is written like an empty one
+		m = &Sint32Message{}
 	}
-	var l int
-	_ = l
+	w.ObjectStart()
 	if m.RequiredField != nil {
-		n += 1 + sov(uint64(*m.RequiredField))
+		w.Field("requiredField", "required_field")
+		w.Int32(*m.RequiredField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("requiredField", "required_field")
+		w.Null()
 	}
 	if m.OptionalField != nil {
-		n += 1 + sov(uint64(*m.OptionalField))
+		w.Field("optionalField", "optional_field")
+		w.Int32(*m.OptionalField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalField", "optional_field")
+		w.Null()
 	}
 	if len(m.RepeatedField) > 0 {
-		for _, e := range m.RepeatedField {
-			n += 1 + sov(uint64(e))
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		for _, v := range m.RepeatedField {
+			w.Int32(v)
 		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
 	if len(m.PackedField) > 0 {
-		l = 0
-		for _, e := range m.PackedField {
-			l += sov(uint64(e))
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		for _, v := range m.PackedField {
+			w.Int32(v)
 		}
-		n += 1 + sov(uint64(l)) + l
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
-	n += len(m.unknownFields)
-	return n
+	w.ObjectEnd()
+	return nil
 }
 
-func (m *Sint32Message) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RequiredField != nil {
-		n += 1 + soz(uint64(*m.RequiredField))
-	}
-	if m.OptionalField != nil {
-		n += 1 + soz(uint64(*m.OptionalField))
-	}
-	if len(m.RepeatedField) > 0 {
-		for _, e := range m.RepeatedField {
-			n += 1 + soz(uint64(e))
+func (m *Sint32Message) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *Sint32Message) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [4]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
 		}
-	}
-	if len(m.PackedField) > 0 {
-		l = 0
-		for _, e := range m.PackedField {
-			l += soz(uint64(e))
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "requiredField", "required_field":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+		case "optionalField", "optional_field":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case "repeatedField", "repeated_field":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			}
+		case "packedField", "packed_field":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
 		}
-		n += 1 + sov(uint64(l)) + l
 	}
-	n += len(m.unknownFields)
-	return n
 }
 
-func (m *Sint64Message) SizeVT() (n int) {
+func (m *Sint64Message) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *Sint64Message) MarshalJSONToVT(w *vtproto.JSONWriter) error {
 	if m == nil {
-		return 0
+		// a nil message is written like an empty one
+		m = &Sint64Message{}
 	}
-	var l int
-	_ = l
+	w.ObjectStart()
 	if m.RequiredField != nil {
-		n += 1 + soz(uint64(*m.RequiredField))
+		w.Field("requiredField", "required_field")
+		w.Int64(*m.RequiredField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("requiredField", "required_field")
+		w.Null()
 	}
 	if m.OptionalField != nil {
-		n += 1 + soz(uint64(*m.OptionalField))
+		w.Field("optionalField", "optional_field")
+		w.Int64(*m.OptionalField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalField", "optional_field")
+		w.Null()
 	}
 	if len(m.RepeatedField) > 0 {
-		for _, e := range m.RepeatedField {
-			n += 1 + soz(uint64(e))
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		for _, v := range m.RepeatedField {
+			w.Int64(v)
 		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
 	if len(m.PackedField) > 0 {
-		l = 0
-		for _, e := range m.PackedField {
-			l += soz(uint64(e))
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		for _, v := range m.PackedField {
+			w.Int64(v)
 		}
-		n += 1 + sov(uint64(l)) + l
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
-	n += len(m.unknownFields)
-	return n
+	w.ObjectEnd()
+	return nil
 }
 
-func (m *Fixed32Message) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RequiredField != nil {
-		n += 5
-	}
-	if m.OptionalField != nil {
-		n += 5
-	}
-	if len(m.RepeatedField) > 0 {
-		n += 5 * len(m.RepeatedField)
-	}
-	if len(m.PackedField) > 0 {
-		n += 1 + sov(uint64(len(m.PackedField)*4)) + len(m.PackedField)*4
-	}
-	n += len(m.unknownFields)
-	return n
+func (m *Sint64Message) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
 }
 
-func (m *Fixed64Message) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RequiredField != nil {
-		n += 9
-	}
-	if m.OptionalField != nil {
-		n += 9
-	}
-	if len(m.RepeatedField) > 0 {
-		n += 9 * len(m.RepeatedField)
-	}
-	if len(m.PackedField) > 0 {
-		n += 1 + sov(uint64(len(m.PackedField)*8)) + len(m.PackedField)*8
+func (m *Sint64Message) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [4]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "requiredField", "required_field":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt64()
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+		case "optionalField", "optional_field":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt64()
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case "repeatedField", "repeated_field":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			}
+		case "packedField", "packed_field":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
 	}
-	n += len(m.unknownFields)
-	return n
 }
 
-func (m *Sfixed32Message) SizeVT() (n int) {
+func (m *Fixed32Message) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *Fixed32Message) MarshalJSONToVT(w *vtproto.JSONWriter) error {
 	if m == nil {
-		return 0
+		// a nil message is written like an empty one
+		m = &Fixed32Message{}
 	}
-	var l int
-	_ = l
+	w.ObjectStart()
 	if m.RequiredField != nil {
-		n += 5
+		w.Field("requiredField", "required_field")
+		w.Uint32(*m.RequiredField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("requiredField", "required_field")
+		w.Null()
 	}
 	if m.OptionalField != nil {
-		n += 5
+		w.Field("optionalField", "optional_field")
+		w.Uint32(*m.OptionalField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalField", "optional_field")
+		w.Null()
 	}
 	if len(m.RepeatedField) > 0 {
-		n += 5 * len(m.RepeatedField)
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		for _, v := range m.RepeatedField {
+			w.Uint32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
 	if len(m.PackedField) > 0 {
-		n += 1 + sov(uint64(len(m.PackedField)*4)) + len(m.PackedField)*4
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		for _, v := range m.PackedField {
+			w.Uint32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
-	n += len(m.unknownFields)
-	return n
+	w.ObjectEnd()
+	return nil
 }
 
-func (m *Sfixed64Message) SizeVT() (n int) {
+func (m *Fixed32Message) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *Fixed32Message) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [4]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "requiredField", "required_field":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint32()
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+		case "optionalField", "optional_field":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint32()
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case "repeatedField", "repeated_field":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint32()
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			}
+		case "packedField", "packed_field":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint32()
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *Fixed64Message) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *Fixed64Message) MarshalJSONToVT(w *vtproto.JSONWriter) error {
 	if m == nil {
-		return 0
+		// a nil message is written like an empty one
+		m = &Fixed64Message{}
 	}
-	var l int
-	_ = l
+	w.ObjectStart()
 	if m.RequiredField != nil {
-		n += 9
+		w.Field("requiredField", "required_field")
+		w.Uint64(*m.RequiredField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("requiredField", "required_field")
+		w.Null()
 	}
 	if m.OptionalField != nil {
-		n += 9
+		w.Field("optionalField", "optional_field")
+		w.Uint64(*m.OptionalField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalField", "optional_field")
+		w.Null()
 	}
 	if len(m.RepeatedField) > 0 {
-		n += 9 * len(m.RepeatedField)
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		for _, v := range m.RepeatedField {
+			w.Uint64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
 	if len(m.PackedField) > 0 {
-		n += 1 + sov(uint64(len(m.PackedField)*8)) + len(m.PackedField)*8
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		for _, v := range m.PackedField {
+			w.Uint64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
-	n += len(m.unknownFields)
-	return n
+	w.ObjectEnd()
+	return nil
 }
 
-func (m *BoolMessage) SizeVT() (n int) {
+func (m *Fixed64Message) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *Fixed64Message) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [4]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "requiredField", "required_field":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint64()
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+		case "optionalField", "optional_field":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint64()
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case "repeatedField", "repeated_field":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint64()
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			}
+		case "packedField", "packed_field":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadUint64()
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *Sfixed32Message) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *Sfixed32Message) MarshalJSONToVT(w *vtproto.JSONWriter) error {
 	if m == nil {
-		return 0
+		// a nil message is written like an empty one
+		m = &Sfixed32Message{}
 	}
-	var l int
-	_ = l
+	w.ObjectStart()
 	if m.RequiredField != nil {
-		n += 2
+		w.Field("requiredField", "required_field")
+		w.Int32(*m.RequiredField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("requiredField", "required_field")
+		w.Null()
 	}
 	if m.OptionalField != nil {
-		n += 2
+		w.Field("optionalField", "optional_field")
+		w.Int32(*m.OptionalField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalField", "optional_field")
+		w.Null()
 	}
 	if len(m.RepeatedField) > 0 {
-		n += 2 * len(m.RepeatedField)
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		for _, v := range m.RepeatedField {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
 	if len(m.PackedField) > 0 {
-		n += 1 + sov(uint64(len(m.PackedField))) + len(m.PackedField)*1
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		for _, v := range m.PackedField {
+			w.Int32(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
-	n += len(m.unknownFields)
-	return n
+	w.ObjectEnd()
+	return nil
 }
 
-func (m *StringMessage) SizeVT() (n int) {
+func (m *Sfixed32Message) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *Sfixed32Message) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [4]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "requiredField", "required_field":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+		case "optionalField", "optional_field":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case "repeatedField", "repeated_field":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			}
+		case "packedField", "packed_field":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt32()
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *Sfixed64Message) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *Sfixed64Message) MarshalJSONToVT(w *vtproto.JSONWriter) error {
 	if m == nil {
-		return 0
+		// a nil message is written like an empty one
+		m = &Sfixed64Message{}
 	}
-	var l int
-	_ = l
+	w.ObjectStart()
 	if m.RequiredField != nil {
-		l = len(*m.RequiredField)
-		n += 1 + l + sov(uint64(l))
+		w.Field("requiredField", "required_field")
+		w.Int64(*m.RequiredField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("requiredField", "required_field")
+		w.Null()
 	}
 	if m.OptionalField != nil {
-		l = len(*m.OptionalField)
-		n += 1 + l + sov(uint64(l))
+		w.Field("optionalField", "optional_field")
+		w.Int64(*m.OptionalField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalField", "optional_field")
+		w.Null()
 	}
 	if len(m.RepeatedField) > 0 {
-		for _, s := range m.RepeatedField {
-			l = len(s)
-			n += 1 + l + sov(uint64(l))
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		for _, v := range m.RepeatedField {
+			w.Int64(v)
 		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
-	n += len(m.unknownFields)
-	return n
+	if len(m.PackedField) > 0 {
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		for _, v := range m.PackedField {
+			w.Int64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+	return nil
 }
 
-func (m *BytesMessage) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *Sfixed64Message) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *Sfixed64Message) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [4]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "requiredField", "required_field":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt64()
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+		case "optionalField", "optional_field":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt64()
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case "repeatedField", "repeated_field":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			}
+		case "packedField", "packed_field":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadInt64()
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *BoolMessage) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *BoolMessage) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &BoolMessage{}
+	}
+	w.ObjectStart()
 	if m.RequiredField != nil {
-		l = len(m.RequiredField)
-		n += 1 + l + sov(uint64(l))
+		w.Field("requiredField", "required_field")
+		w.Bool(*m.RequiredField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("requiredField", "required_field")
+		w.Null()
 	}
 	if m.OptionalField != nil {
-		l = len(m.OptionalField)
-		n += 1 + l + sov(uint64(l))
+		w.Field("optionalField", "optional_field")
+		w.Bool(*m.OptionalField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalField", "optional_field")
+		w.Null()
 	}
 	if len(m.RepeatedField) > 0 {
-		for _, b := range m.RepeatedField {
-			l = len(b)
-			n += 1 + l + sov(uint64(l))
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		for _, v := range m.RepeatedField {
+			w.Bool(v)
 		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
-	n += len(m.unknownFields)
-	return n
+	if len(m.PackedField) > 0 {
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		for _, v := range m.PackedField {
+			w.Bool(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+	return nil
 }
 
-func (m *EnumMessage) SizeVT() (n int) {
+func (m *BoolMessage) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *BoolMessage) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [4]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "requiredField", "required_field":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadBool()
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+		case "optionalField", "optional_field":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadBool()
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case "repeatedField", "repeated_field":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadBool()
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			}
+		case "packedField", "packed_field":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadBool()
+				if err != nil {
+					return err
+				}
+				m.PackedField = append(m.PackedField, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *StringMessage) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *StringMessage) MarshalJSONToVT(w *vtproto.JSONWriter) error {
 	if m == nil {
-		return 0
+		// a nil message is written like an empty one
+		m = &StringMessage{}
 	}
-	var l int
-	_ = l
+	w.ObjectStart()
 	if m.RequiredField != nil {
-		n += 1 + sov(uint64(*m.RequiredField))
+		w.Field("requiredField", "required_field")
+		if err := w.String(*m.RequiredField); err != nil {
+			return errors.New("proto: field StringMessage.required_field contains invalid UTF-8")
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("requiredField", "required_field")
+		w.Null()
 	}
 	if m.OptionalField != nil {
-		n += 1 + sov(uint64(*m.OptionalField))
+		w.Field("optionalField", "optional_field")
+		if err := w.String(*m.OptionalField); err != nil {
+			return errors.New("proto: field StringMessage.optional_field contains invalid UTF-8")
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalField", "optional_field")
+		w.Null()
 	}
 	if len(m.RepeatedField) > 0 {
-		for _, e := range m.RepeatedField {
-			n += 1 + sov(uint64(e))
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		for _, v := range m.RepeatedField {
+			if err := w.String(v); err != nil {
+				return errors.New("proto: field StringMessage.repeated_field contains invalid UTF-8")
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *StringMessage) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *StringMessage) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [3]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "requiredField", "required_field":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.RequiredField = &v
+		case "optionalField", "optional_field":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.OptionalField = &v
+		case "repeatedField", "repeated_field":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadString()
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *BytesMessage) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *BytesMessage) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &BytesMessage{}
+	}
+	w.ObjectStart()
+	if m.RequiredField != nil {
+		w.Field("requiredField", "required_field")
+		w.Base64(m.RequiredField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("requiredField", "required_field")
+		w.Null()
+	}
+	if m.OptionalField != nil {
+		w.Field("optionalField", "optional_field")
+		w.Base64(m.OptionalField)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalField", "optional_field")
+		w.Null()
+	}
+	if len(m.RepeatedField) > 0 {
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		for _, v := range m.RepeatedField {
+			w.Base64(v)
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *BytesMessage) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *BytesMessage) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [3]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "requiredField", "required_field":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadBytes()
+			if err != nil {
+				return err
+			}
+			m.RequiredField = v
+		case "optionalField", "optional_field":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadBytes()
+			if err != nil {
+				return err
+			}
+			m.OptionalField = v
+		case "repeatedField", "repeated_field":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v, err := r.ReadBytes()
+				if err != nil {
+					return err
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *EnumMessage) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *EnumMessage) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &EnumMessage{}
+	}
+	w.ObjectStart()
+	if m.RequiredField != nil {
+		w.Field("requiredField", "required_field")
+		w.Enum(int32(*m.RequiredField), EnumMessage_Num_name)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("requiredField", "required_field")
+		w.Null()
+	}
+	if m.OptionalField != nil {
+		w.Field("optionalField", "optional_field")
+		w.Enum(int32(*m.OptionalField), EnumMessage_Num_name)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("optionalField", "optional_field")
+		w.Null()
+	}
+	if len(m.RepeatedField) > 0 {
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		for _, v := range m.RepeatedField {
+			w.Enum(int32(v), EnumMessage_Num_name)
 		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("repeatedField", "repeated_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
 	if len(m.PackedField) > 0 {
-		l = 0
-		for _, e := range m.PackedField {
-			l += sov(uint64(e))
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		for _, v := range m.PackedField {
+			w.Enum(int32(v), EnumMessage_Num_name)
 		}
-		n += 1 + sov(uint64(l)) + l
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("packedField", "packed_field")
+		w.ArrayStart()
+		w.ArrayEnd()
 	}
-	n += len(m.unknownFields)
-	return n
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *EnumMessage) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
 }
 
-func sov(x uint64) (n int) {
-	return (bits.Len64(x|1) + 6) / 7
+func (m *EnumMessage) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [4]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "requiredField", "required_field":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			e, ok, err := r.ReadEnum(EnumMessage_Num_value)
+			if err != nil {
+				return err
+			}
+			if ok {
+				v := EnumMessage_Num(e)
+				m.RequiredField = &v
+			}
+		case "optionalField", "optional_field":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			e, ok, err := r.ReadEnum(EnumMessage_Num_value)
+			if err != nil {
+				return err
+			}
+			if ok {
+				v := EnumMessage_Num(e)
+				m.OptionalField = &v
+			}
+		case "repeatedField", "repeated_field":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				e, ok, err := r.ReadEnum(EnumMessage_Num_value)
+				if err != nil {
+					return err
+				}
+				if ok {
+					v := EnumMessage_Num(e)
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			}
+		case "packedField", "packed_field":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				e, ok, err := r.ReadEnum(EnumMessage_Num_value)
+				if err != nil {
+					return err
+				}
+				if ok {
+					v := EnumMessage_Num(e)
+					m.PackedField = append(m.PackedField, v)
+				}
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *DoubleMessage) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *DoubleMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *DoubleMessage) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *DoubleMessage) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *DoubleMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
+			f1 := math.Float64bits(float64(m.PackedField[iNdEx]))
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f1))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedField)*8))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			f2 := math.Float64bits(float64(m.RepeatedField[iNdEx]))
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(f2))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x19
+		}
+	}
+	if m.OptionalField != nil {
+		i -= 8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.OptionalField))))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x11
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i -= 8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.RequiredField))))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FloatMessage) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *FloatMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *FloatMessage) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *FloatMessage) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *FloatMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
+			f1 := math.Float32bits(float32(m.PackedField[iNdEx]))
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f1))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedField)*4))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			f2 := math.Float32bits(float32(m.RepeatedField[iNdEx]))
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(f2))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x1d
+		}
+	}
+	if m.OptionalField != nil {
+		i -= 4
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(*m.OptionalField))))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x15
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i -= 4
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(*m.RequiredField))))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xd
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Int32Message) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Int32Message) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Int32Message) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Int32Message) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Int32Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		var pksize2 int
+		for _, num := range m.PackedField {
+			pksize2 += sov(uint64(num))
+		}
+		i -= pksize2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j1 := i
+		for _, num1 := range m.PackedField {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA[j1] = uint8(num)
+			j1++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize2))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.OptionalField))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = encodeVarint(dAtA, i, uint64(*m.RequiredField))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Int64Message) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Int64Message) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Int64Message) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Int64Message) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Int64Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		var pksize2 int
+		for _, num := range m.PackedField {
+			pksize2 += sov(uint64(num))
+		}
+		i -= pksize2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j1 := i
+		for _, num1 := range m.PackedField {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA[j1] = uint8(num)
+			j1++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize2))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.OptionalField))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = encodeVarint(dAtA, i, uint64(*m.RequiredField))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Uint32Message) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Uint32Message) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Uint32Message) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Uint32Message) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Uint32Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		var pksize2 int
+		for _, num := range m.PackedField {
+			pksize2 += sov(uint64(num))
+		}
+		i -= pksize2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j1 := i
+		for _, num := range m.PackedField {
+			for num >= 1<<7 {
+				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA[j1] = uint8(num)
+			j1++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize2))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.OptionalField))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = encodeVarint(dAtA, i, uint64(*m.RequiredField))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Uint64Message) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Uint64Message) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Uint64Message) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Uint64Message) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Uint64Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		var pksize2 int
+		for _, num := range m.PackedField {
+			pksize2 += sov(uint64(num))
+		}
+		i -= pksize2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j1 := i
+		for _, num := range m.PackedField {
+			for num >= 1<<7 {
+				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA[j1] = uint8(num)
+			j1++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize2))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.OptionalField))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = encodeVarint(dAtA, i, uint64(*m.RequiredField))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Sint32Message) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Sint32Message) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Sint32Message) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Sint32Message) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Sint32Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		var pksize2 int
+		for _, num := range m.PackedField {
+			pksize2 += soz(uint64(num))
+		}
+		i -= pksize2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j1 := i
+		for _, num := range m.PackedField {
+			x3 := (uint32(num) << 1) ^ uint32((num >> 31))
+			for x3 >= 1<<7 {
+				dAtA[j1] = uint8(uint64(x3)&0x7f | 0x80)
+				j1++
+				x3 >>= 7
+			}
+			dAtA[j1] = uint8(x3)
+			j1++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize2))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			x4 := (uint32(m.RepeatedField[iNdEx]) << 1) ^ uint32((m.RepeatedField[iNdEx] >> 31))
+			i = encodeVarint(dAtA, i, uint64(x4))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = encodeVarint(dAtA, i, uint64((uint32(*m.OptionalField)<<1)^uint32((*m.OptionalField>>31))))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = encodeVarint(dAtA, i, uint64((uint32(*m.RequiredField)<<1)^uint32((*m.RequiredField>>31))))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Sint64Message) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Sint64Message) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Sint64Message) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Sint64Message) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Sint64Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		var pksize2 int
+		for _, num := range m.PackedField {
+			pksize2 += soz(uint64(num))
+		}
+		i -= pksize2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j1 := i
+		for _, num := range m.PackedField {
+			x3 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x3 >= 1<<7 {
+				dAtA[j1] = uint8(uint64(x3)&0x7f | 0x80)
+				j1++
+				x3 >>= 7
+			}
+			dAtA[j1] = uint8(x3)
+			j1++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize2))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			x4 := (uint64(m.RepeatedField[iNdEx]) << 1) ^ uint64((m.RepeatedField[iNdEx] >> 63))
+			i = encodeVarint(dAtA, i, uint64(x4))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = encodeVarint(dAtA, i, uint64((uint64(*m.OptionalField)<<1)^uint64((*m.OptionalField>>63))))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = encodeVarint(dAtA, i, uint64((uint64(*m.RequiredField)<<1)^uint64((*m.RequiredField>>63))))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Fixed32Message) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Fixed32Message) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Fixed32Message) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Fixed32Message) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Fixed32Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.PackedField[iNdEx]))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedField)*4))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.RepeatedField[iNdEx]))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x1d
+		}
+	}
+	if m.OptionalField != nil {
+		i -= 4
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.OptionalField))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x15
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i -= 4
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.RequiredField))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xd
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Fixed64Message) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Fixed64Message) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Fixed64Message) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Fixed64Message) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Fixed64Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.PackedField[iNdEx]))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedField)*8))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.RepeatedField[iNdEx]))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x19
+		}
+	}
+	if m.OptionalField != nil {
+		i -= 8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.OptionalField))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x11
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i -= 8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.RequiredField))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Sfixed32Message) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Sfixed32Message) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Sfixed32Message) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Sfixed32Message) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Sfixed32Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.PackedField[iNdEx]))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedField)*4))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.RepeatedField[iNdEx]))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x1d
+		}
+	}
+	if m.OptionalField != nil {
+		i -= 4
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.OptionalField))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x15
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i -= 4
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.RequiredField))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xd
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Sfixed64Message) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Sfixed64Message) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Sfixed64Message) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Sfixed64Message) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Sfixed64Message) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.PackedField[iNdEx]))
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedField)*8))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.RepeatedField[iNdEx]))
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x19
+		}
+	}
+	if m.OptionalField != nil {
+		i -= 8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.OptionalField))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x11
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i -= 8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.RequiredField))
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BoolMessage) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *BoolMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *BoolMessage) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *BoolMessage) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *BoolMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		for iNdEx := len(m.PackedField) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			if m.PackedField[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarint(dAtA, i, uint64(len(m.PackedField)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			if m.RepeatedField[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		if *m.OptionalField {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		if *m.RequiredField {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StringMessage) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *StringMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *StringMessage) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *StringMessage) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *StringMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RepeatedField[iNdEx])
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], m.RepeatedField[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.RepeatedField[iNdEx])))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.OptionalField != nil {
+		i -= len(*m.OptionalField)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], *m.OptionalField)
+		i = encodeVarint(dAtA, i, uint64(len(*m.OptionalField)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x12
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i -= len(*m.RequiredField)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], *m.RequiredField)
+		i = encodeVarint(dAtA, i, uint64(len(*m.RequiredField)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BytesMessage) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *BytesMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *BytesMessage) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *BytesMessage) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *BytesMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RepeatedField[iNdEx])
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			copy(dAtA[i:], m.RepeatedField[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.RepeatedField[iNdEx])))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.OptionalField != nil {
+		i -= len(m.OptionalField)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.OptionalField)
+		i = encodeVarint(dAtA, i, uint64(len(m.OptionalField)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x12
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i -= len(m.RequiredField)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.RequiredField)
+		i = encodeVarint(dAtA, i, uint64(len(m.RequiredField)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnumMessage) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *EnumMessage) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *EnumMessage) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *EnumMessage) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *EnumMessage) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if len(m.PackedField) > 0 {
+		var pksize2 int
+		for _, num := range m.PackedField {
+			pksize2 += sov(uint64(num))
+		}
+		i -= pksize2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		j1 := i
+		for _, num1 := range m.PackedField {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA[j1] = uint8(num)
+			j1++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize2))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x22
+	}
+	if len(m.RepeatedField) > 0 {
+		for iNdEx := len(m.RepeatedField) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarint(dAtA, i, uint64(m.RepeatedField[iNdEx]))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x18
+		}
+	}
+	if m.OptionalField != nil {
+		i = encodeVarint(dAtA, i, uint64(*m.OptionalField))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x10
+	}
+	if m.RequiredField == nil {
+		return 0, fmt.Errorf("proto: required field required_field not set")
+	} else {
+		i = encodeVarint(dAtA, i, uint64(*m.RequiredField))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+var (
+	ErrBufferTooSmall = fmt.Errorf("proto: buffer too small to marshal the message")
+	ErrSizeMismatch   = fmt.Errorf("proto: message size changed while marshaling")
+)
+
+func encodeVarint(dAtA []byte, offset int, v uint64) int {
+	offset -= sov(v)
+	if offset < 0 {
+		return offset
+	}
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DoubleMessage) MergeVT(src *DoubleMessage) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		tmpVal := *src.RequiredField
+		m.RequiredField = &tmpVal
+	}
+	if src.OptionalField != nil {
+		tmpVal := *src.OptionalField
+		m.OptionalField = &tmpVal
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *DoubleMessage) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*DoubleMessage); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *FloatMessage) MergeVT(src *FloatMessage) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		tmpVal := *src.RequiredField
+		m.RequiredField = &tmpVal
+	}
+	if src.OptionalField != nil {
+		tmpVal := *src.OptionalField
+		m.OptionalField = &tmpVal
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *FloatMessage) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*FloatMessage); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *Int32Message) MergeVT(src *Int32Message) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		tmpVal := *src.RequiredField
+		m.RequiredField = &tmpVal
+	}
+	if src.OptionalField != nil {
+		tmpVal := *src.OptionalField
+		m.OptionalField = &tmpVal
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Int32Message) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Int32Message); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *Int64Message) MergeVT(src *Int64Message) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		tmpVal := *src.RequiredField
+		m.RequiredField = &tmpVal
+	}
+	if src.OptionalField != nil {
+		tmpVal := *src.OptionalField
+		m.OptionalField = &tmpVal
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Int64Message) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Int64Message); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *Uint32Message) MergeVT(src *Uint32Message) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		tmpVal := *src.RequiredField
+		m.RequiredField = &tmpVal
+	}
+	if src.OptionalField != nil {
+		tmpVal := *src.OptionalField
+		m.OptionalField = &tmpVal
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Uint32Message) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Uint32Message); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *Uint64Message) MergeVT(src *Uint64Message) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		tmpVal := *src.RequiredField
+		m.RequiredField = &tmpVal
+	}
+	if src.OptionalField != nil {
+		tmpVal := *src.OptionalField
+		m.OptionalField = &tmpVal
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Uint64Message) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Uint64Message); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *Sint32Message) MergeVT(src *Sint32Message) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		tmpVal := *src.RequiredField
+		m.RequiredField = &tmpVal
+	}
+	if src.OptionalField != nil {
+		tmpVal := *src.OptionalField
+		m.OptionalField = &tmpVal
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Sint32Message) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Sint32Message); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *Sint64Message) MergeVT(src *Sint64Message) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		tmpVal := *src.RequiredField
+		m.RequiredField = &tmpVal
+	}
+	if src.OptionalField != nil {
+		tmpVal := *src.OptionalField
+		m.OptionalField = &tmpVal
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Sint64Message) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Sint64Message); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *Fixed32Message) MergeVT(src *Fixed32Message) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		tmpVal := *src.RequiredField
+		m.RequiredField = &tmpVal
+	}
+	if src.OptionalField != nil {
+		tmpVal := *src.OptionalField
+		m.OptionalField = &tmpVal
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Fixed32Message) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Fixed32Message); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *Fixed64Message) MergeVT(src *Fixed64Message) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		tmpVal := *src.RequiredField
+		m.RequiredField = &tmpVal
+	}
+	if src.OptionalField != nil {
+		tmpVal := *src.OptionalField
+		m.OptionalField = &tmpVal
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Fixed64Message) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Fixed64Message); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *Sfixed32Message) MergeVT(src *Sfixed32Message) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		tmpVal := *src.RequiredField
+		m.RequiredField = &tmpVal
+	}
+	if src.OptionalField != nil {
+		tmpVal := *src.OptionalField
+		m.OptionalField = &tmpVal
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Sfixed32Message) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Sfixed32Message); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *Sfixed64Message) MergeVT(src *Sfixed64Message) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		tmpVal := *src.RequiredField
+		m.RequiredField = &tmpVal
+	}
+	if src.OptionalField != nil {
+		tmpVal := *src.OptionalField
+		m.OptionalField = &tmpVal
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Sfixed64Message) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Sfixed64Message); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *BoolMessage) MergeVT(src *BoolMessage) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		tmpVal := *src.RequiredField
+		m.RequiredField = &tmpVal
+	}
+	if src.OptionalField != nil {
+		tmpVal := *src.OptionalField
+		m.OptionalField = &tmpVal
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *BoolMessage) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*BoolMessage); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *StringMessage) MergeVT(src *StringMessage) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		tmpVal := *src.RequiredField
+		m.RequiredField = &tmpVal
+	}
+	if src.OptionalField != nil {
+		tmpVal := *src.OptionalField
+		m.OptionalField = &tmpVal
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *StringMessage) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*StringMessage); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *BytesMessage) MergeVT(src *BytesMessage) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		m.RequiredField = append([]byte{}, src.RequiredField...)
+	}
+	if src.OptionalField != nil {
+		m.OptionalField = append([]byte{}, src.OptionalField...)
+	}
+	for _, v := range src.RepeatedField {
+		m.RepeatedField = append(m.RepeatedField, append([]byte{}, v...))
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *BytesMessage) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*BytesMessage); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *EnumMessage) MergeVT(src *EnumMessage) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.RequiredField != nil {
+		tmpVal := *src.RequiredField
+		m.RequiredField = &tmpVal
+	}
+	if src.OptionalField != nil {
+		tmpVal := *src.OptionalField
+		m.OptionalField = &tmpVal
+	}
+	m.RepeatedField = append(m.RepeatedField, src.RepeatedField...)
+	m.PackedField = append(m.PackedField, src.PackedField...)
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *EnumMessage) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*EnumMessage); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *DoubleMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequiredField != nil {
+		n += 9
+	}
+	if m.OptionalField != nil {
+		n += 9
+	}
+	if len(m.RepeatedField) > 0 {
+		n += 9 * len(m.RepeatedField)
+	}
+	if len(m.PackedField) > 0 {
+		n += 1 + sov(uint64(len(m.PackedField)*8)) + len(m.PackedField)*8
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *FloatMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequiredField != nil {
+		n += 5
+	}
+	if m.OptionalField != nil {
+		n += 5
+	}
+	if len(m.RepeatedField) > 0 {
+		n += 5 * len(m.RepeatedField)
+	}
+	if len(m.PackedField) > 0 {
+		n += 1 + sov(uint64(len(m.PackedField)*4)) + len(m.PackedField)*4
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Int32Message) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequiredField != nil {
+		n += 1 + sov(uint64(*m.RequiredField))
+	}
+	if m.OptionalField != nil {
+		n += 1 + sov(uint64(*m.OptionalField))
+	}
+	if len(m.RepeatedField) > 0 {
+		for _, e := range m.RepeatedField {
+			n += 1 + sov(uint64(e))
+		}
+	}
+	if len(m.PackedField) > 0 {
+		l = 0
+		for _, e := range m.PackedField {
+			l += sov(uint64(e))
+		}
+		n += 1 + sov(uint64(l)) + l
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Int64Message) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequiredField != nil {
+		n += 1 + sov(uint64(*m.RequiredField))
+	}
+	if m.OptionalField != nil {
+		n += 1 + sov(uint64(*m.OptionalField))
+	}
+	if len(m.RepeatedField) > 0 {
+		for _, e := range m.RepeatedField {
+			n += 1 + sov(uint64(e))
+		}
+	}
+	if len(m.PackedField) > 0 {
+		l = 0
+		for _, e := range m.PackedField {
+			l += sov(uint64(e))
+		}
+		n += 1 + sov(uint64(l)) + l
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Uint32Message) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequiredField != nil {
+		n += 1 + sov(uint64(*m.RequiredField))
+	}
+	if m.OptionalField != nil {
+		n += 1 + sov(uint64(*m.OptionalField))
+	}
+	if len(m.RepeatedField) > 0 {
+		for _, e := range m.RepeatedField {
+			n += 1 + sov(uint64(e))
+		}
+	}
+	if len(m.PackedField) > 0 {
+		l = 0
+		for _, e := range m.PackedField {
+			l += sov(uint64(e))
+		}
+		n += 1 + sov(uint64(l)) + l
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Uint64Message) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequiredField != nil {
+		n += 1 + sov(uint64(*m.RequiredField))
+	}
+	if m.OptionalField != nil {
+		n += 1 + sov(uint64(*m.OptionalField))
+	}
+	if len(m.RepeatedField) > 0 {
+		for _, e := range m.RepeatedField {
+			n += 1 + sov(uint64(e))
+		}
+	}
+	if len(m.PackedField) > 0 {
+		l = 0
+		for _, e := range m.PackedField {
+			l += sov(uint64(e))
+		}
+		n += 1 + sov(uint64(l)) + l
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Sint32Message) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequiredField != nil {
+		n += 1 + soz(uint64(*m.RequiredField))
+	}
+	if m.OptionalField != nil {
+		n += 1 + soz(uint64(*m.OptionalField))
+	}
+	if len(m.RepeatedField) > 0 {
+		for _, e := range m.RepeatedField {
+			n += 1 + soz(uint64(e))
+		}
+	}
+	if len(m.PackedField) > 0 {
+		l = 0
+		for _, e := range m.PackedField {
+			l += soz(uint64(e))
+		}
+		n += 1 + sov(uint64(l)) + l
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Sint64Message) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequiredField != nil {
+		n += 1 + soz(uint64(*m.RequiredField))
+	}
+	if m.OptionalField != nil {
+		n += 1 + soz(uint64(*m.OptionalField))
+	}
+	if len(m.RepeatedField) > 0 {
+		for _, e := range m.RepeatedField {
+			n += 1 + soz(uint64(e))
+		}
+	}
+	if len(m.PackedField) > 0 {
+		l = 0
+		for _, e := range m.PackedField {
+			l += soz(uint64(e))
+		}
+		n += 1 + sov(uint64(l)) + l
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Fixed32Message) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequiredField != nil {
+		n += 5
+	}
+	if m.OptionalField != nil {
+		n += 5
+	}
+	if len(m.RepeatedField) > 0 {
+		n += 5 * len(m.RepeatedField)
+	}
+	if len(m.PackedField) > 0 {
+		n += 1 + sov(uint64(len(m.PackedField)*4)) + len(m.PackedField)*4
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Fixed64Message) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequiredField != nil {
+		n += 9
+	}
+	if m.OptionalField != nil {
+		n += 9
+	}
+	if len(m.RepeatedField) > 0 {
+		n += 9 * len(m.RepeatedField)
+	}
+	if len(m.PackedField) > 0 {
+		n += 1 + sov(uint64(len(m.PackedField)*8)) + len(m.PackedField)*8
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Sfixed32Message) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequiredField != nil {
+		n += 5
+	}
+	if m.OptionalField != nil {
+		n += 5
+	}
+	if len(m.RepeatedField) > 0 {
+		n += 5 * len(m.RepeatedField)
+	}
+	if len(m.PackedField) > 0 {
+		n += 1 + sov(uint64(len(m.PackedField)*4)) + len(m.PackedField)*4
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Sfixed64Message) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequiredField != nil {
+		n += 9
+	}
+	if m.OptionalField != nil {
+		n += 9
+	}
+	if len(m.RepeatedField) > 0 {
+		n += 9 * len(m.RepeatedField)
+	}
+	if len(m.PackedField) > 0 {
+		n += 1 + sov(uint64(len(m.PackedField)*8)) + len(m.PackedField)*8
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *BoolMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequiredField != nil {
+		n += 2
+	}
+	if m.OptionalField != nil {
+		n += 2
+	}
+	if len(m.RepeatedField) > 0 {
+		n += 2 * len(m.RepeatedField)
+	}
+	if len(m.PackedField) > 0 {
+		n += 1 + sov(uint64(len(m.PackedField))) + len(m.PackedField)*1
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *StringMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequiredField != nil {
+		l = len(*m.RequiredField)
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.OptionalField != nil {
+		l = len(*m.OptionalField)
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.RepeatedField) > 0 {
+		for _, s := range m.RepeatedField {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *BytesMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequiredField != nil {
+		l = len(m.RequiredField)
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.OptionalField != nil {
+		l = len(m.OptionalField)
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.RepeatedField) > 0 {
+		for _, b := range m.RepeatedField {
+			l = len(b)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *EnumMessage) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequiredField != nil {
+		n += 1 + sov(uint64(*m.RequiredField))
+	}
+	if m.OptionalField != nil {
+		n += 1 + sov(uint64(*m.OptionalField))
+	}
+	if len(m.RepeatedField) > 0 {
+		for _, e := range m.RepeatedField {
+			n += 1 + sov(uint64(e))
+		}
+	}
+	if len(m.PackedField) > 0 {
+		l = 0
+		for _, e := range m.PackedField {
+			l += sov(uint64(e))
+		}
+		n += 1 + sov(uint64(l)) + l
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func sov(x uint64) (n int) {
+	return (bits.Len64(x|1) + 6) / 7
+}
+func soz(x uint64) (n int) {
+	return sov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DoubleMessage) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *DoubleMessage) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *DoubleMessage) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *DoubleMessage) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*DoubleMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DoubleMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DoubleMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.RequiredField = &v2
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.OptionalField = &v2
+		case 3:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.RepeatedField = append(m.RepeatedField, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.RepeatedField = append(m.RepeatedField, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.PackedField = append(m.PackedField, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.PackedField = append(m.PackedField, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FloatMessage) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *FloatMessage) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *FloatMessage) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *FloatMessage) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*FloatMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FloatMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FloatMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			v2 := float32(math.Float32frombits(v))
+			m.RequiredField = &v2
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			v2 := float32(math.Float32frombits(v))
+			m.OptionalField = &v2
+		case 3:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				m.RepeatedField = append(m.RepeatedField, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					v2 := float32(math.Float32frombits(v))
+					m.RepeatedField = append(m.RepeatedField, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				m.PackedField = append(m.PackedField, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					v2 := float32(math.Float32frombits(v))
+					m.PackedField = append(m.PackedField, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Int32Message) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Int32Message) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *Int32Message) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *Int32Message) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Int32Message)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Int32Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Int32Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Int64Message) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Int64Message) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *Int64Message) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *Int64Message) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Int64Message)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Int64Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Int64Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Uint32Message) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Uint32Message) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *Uint32Message) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *Uint32Message) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Uint32Message)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Uint32Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Uint32Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Uint64Message) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Uint64Message) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *Uint64Message) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *Uint64Message) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Uint64Message)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Uint64Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Uint64Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sint32Message) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Sint32Message) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *Sint32Message) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *Sint32Message) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Sint32Message)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sint32Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sint32Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sint64Message) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Sint64Message) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *Sint64Message) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *Sint64Message) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Sint64Message)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sint64Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sint64Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			v2 := int64(v)
+			m.RequiredField = &v2
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			v2 := int64(v)
+			m.OptionalField = &v2
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				m.RepeatedField = append(m.RepeatedField, int64(v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					m.RepeatedField = append(m.RepeatedField, int64(v))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				m.PackedField = append(m.PackedField, int64(v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					m.PackedField = append(m.PackedField, int64(v))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Fixed32Message) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Fixed32Message) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *Fixed32Message) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *Fixed32Message) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Fixed32Message)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fixed32Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fixed32Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.OptionalField = &v
+		case 3:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Fixed64Message) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Fixed64Message) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *Fixed64Message) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *Fixed64Message) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Fixed64Message)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fixed64Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fixed64Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.OptionalField = &v
+		case 3:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sfixed32Message) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Sfixed32Message) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *Sfixed32Message) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *Sfixed32Message) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Sfixed32Message)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sfixed32Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sfixed32Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.OptionalField = &v
+		case 3:
+			if wireType == 5 {
+				var v int32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 5 {
+				var v int32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sfixed64Message) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Sfixed64Message) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *Sfixed64Message) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *Sfixed64Message) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Sfixed64Message)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sfixed64Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sfixed64Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.OptionalField = &v
+		case 3:
+			if wireType == 1 {
+				var v int64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 1 {
+				var v int64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BoolMessage) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *BoolMessage) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *BoolMessage) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *BoolMessage) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*BoolMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BoolMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BoolMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.RequiredField = &b
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.OptionalField = &b
+		case 3:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedField = append(m.RepeatedField, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedField = append(m.RepeatedField, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedField = append(m.PackedField, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PackedField = append(m.PackedField, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StringMessage) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *StringMessage) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *StringMessage) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *StringMessage) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*StringMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StringMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StringMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(string(dAtA[iNdEx:postIndex]))
+			m.RequiredField = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(string(dAtA[iNdEx:postIndex]))
+			m.OptionalField = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedField = append(m.RepeatedField, string(string(dAtA[iNdEx:postIndex])))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BytesMessage) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *BytesMessage) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *BytesMessage) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *BytesMessage) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*BytesMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BytesMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BytesMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredField = append(m.RequiredField[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequiredField == nil {
+				m.RequiredField = []byte{}
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionalField = append(m.OptionalField[:0], dAtA[iNdEx:postIndex]...)
+			if m.OptionalField == nil {
+				m.OptionalField = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepeatedField = append(m.RepeatedField, make([]byte, postIndex-iNdEx))
+			copy(m.RepeatedField[len(m.RepeatedField)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnumMessage) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *EnumMessage) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *EnumMessage) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *EnumMessage) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*EnumMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnumMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnumMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredField", wireType)
+			}
+			var v EnumMessage_Num
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= EnumMessage_Num(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiredField = &v
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalField", wireType)
+			}
+			var v EnumMessage_Num
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= EnumMessage_Num(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalField = &v
+		case 3:
+			if wireType == 0 {
+				var v EnumMessage_Num
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= EnumMessage_Num(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RepeatedField = append(m.RepeatedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.RepeatedField) == 0 {
+					m.RepeatedField = make([]EnumMessage_Num, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v EnumMessage_Num
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= EnumMessage_Num(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RepeatedField = append(m.RepeatedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepeatedField", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v EnumMessage_Num
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= EnumMessage_Num(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PackedField = append(m.PackedField, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.PackedField) == 0 {
+					m.PackedField = make([]EnumMessage_Num, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v EnumMessage_Num
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= EnumMessage_Num(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PackedField = append(m.PackedField, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackedField", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skip(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLength
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroup
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLength
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLength          = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflow            = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroup   = fmt.Errorf("proto: unexpected end of group")
+	ErrInvalidUTF8            = fmt.Errorf("proto: string field contains invalid UTF-8")
+	ErrRecursionLimitExceeded = fmt.Errorf("proto: exceeded maximum recursion depth")
+)
+
+func (m *DoubleMessage) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
 }
-func soz(x uint64) (n int) {
-	return sov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *DoubleMessage) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
 }
-func (m *DoubleMessage) UnmarshalVT(dAtA []byte) error {
+
+func (m *DoubleMessage) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*DoubleMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -2756,12 +13030,17 @@ func (m *DoubleMessage) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return fmt.Errorf("proto: required field required_field not set")
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
 	}
 
 	if iNdEx > l {
@@ -2769,12 +13048,37 @@ func (m *DoubleMessage) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FloatMessage) UnmarshalVT(dAtA []byte) error {
+func (m *FloatMessage) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *FloatMessage) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *FloatMessage) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*FloatMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -2944,12 +13248,17 @@ func (m *FloatMessage) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return fmt.Errorf("proto: required field required_field not set")
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
 	}
 
 	if iNdEx > l {
@@ -2957,12 +13266,37 @@ func (m *FloatMessage) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Int32Message) UnmarshalVT(dAtA []byte) error {
+func (m *Int32Message) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Int32Message) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *Int32Message) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Int32Message)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -3192,12 +13526,17 @@ func (m *Int32Message) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return fmt.Errorf("proto: required field required_field not set")
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
 	}
 
 	if iNdEx > l {
@@ -3205,12 +13544,37 @@ func (m *Int32Message) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Int64Message) UnmarshalVT(dAtA []byte) error {
+func (m *Int64Message) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Int64Message) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *Int64Message) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Int64Message)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -3440,12 +13804,17 @@ func (m *Int64Message) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return fmt.Errorf("proto: required field required_field not set")
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
 	}
 
 	if iNdEx > l {
@@ -3453,12 +13822,37 @@ func (m *Int64Message) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Uint32Message) UnmarshalVT(dAtA []byte) error {
+func (m *Uint32Message) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Uint32Message) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *Uint32Message) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Uint32Message)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -3688,12 +14082,17 @@ func (m *Uint32Message) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return fmt.Errorf("proto: required field required_field not set")
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
 	}
 
 	if iNdEx > l {
@@ -3701,12 +14100,37 @@ func (m *Uint32Message) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Uint64Message) UnmarshalVT(dAtA []byte) error {
+func (m *Uint64Message) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Uint64Message) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *Uint64Message) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Uint64Message)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -3936,12 +14360,17 @@ func (m *Uint64Message) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return fmt.Errorf("proto: required field required_field not set")
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
 	}
 
 	if iNdEx > l {
@@ -3949,12 +14378,37 @@ func (m *Uint64Message) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Sint32Message) UnmarshalVT(dAtA []byte) error {
+func (m *Sint32Message) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Sint32Message) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *Sint32Message) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Sint32Message)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -4190,12 +14644,17 @@ func (m *Sint32Message) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return fmt.Errorf("proto: required field required_field not set")
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
 	}
 
 	if iNdEx > l {
@@ -4203,12 +14662,37 @@ func (m *Sint32Message) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Sint64Message) UnmarshalVT(dAtA []byte) error {
+func (m *Sint64Message) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Sint64Message) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *Sint64Message) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Sint64Message)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -4446,12 +14930,17 @@ func (m *Sint64Message) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return fmt.Errorf("proto: required field required_field not set")
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
 	}
 
 	if iNdEx > l {
@@ -4459,12 +14948,37 @@ func (m *Sint64Message) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Fixed32Message) UnmarshalVT(dAtA []byte) error {
+func (m *Fixed32Message) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Fixed32Message) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *Fixed32Message) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Fixed32Message)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -4628,12 +15142,17 @@ func (m *Fixed32Message) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return fmt.Errorf("proto: required field required_field not set")
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
 	}
 
 	if iNdEx > l {
@@ -4641,12 +15160,37 @@ func (m *Fixed32Message) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Fixed64Message) UnmarshalVT(dAtA []byte) error {
+func (m *Fixed64Message) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Fixed64Message) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *Fixed64Message) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Fixed64Message)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -4810,12 +15354,17 @@ func (m *Fixed64Message) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return fmt.Errorf("proto: required field required_field not set")
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
 	}
 
 	if iNdEx > l {
@@ -4823,12 +15372,37 @@ func (m *Fixed64Message) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Sfixed32Message) UnmarshalVT(dAtA []byte) error {
+func (m *Sfixed32Message) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Sfixed32Message) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *Sfixed32Message) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Sfixed32Message)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -4992,12 +15566,17 @@ func (m *Sfixed32Message) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return fmt.Errorf("proto: required field required_field not set")
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
 	}
 
 	if iNdEx > l {
@@ -5005,12 +15584,37 @@ func (m *Sfixed32Message) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Sfixed64Message) UnmarshalVT(dAtA []byte) error {
+func (m *Sfixed64Message) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Sfixed64Message) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *Sfixed64Message) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Sfixed64Message)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -5174,12 +15778,17 @@ func (m *Sfixed64Message) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return fmt.Errorf("proto: required field required_field not set")
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
 	}
 
 	if iNdEx > l {
@@ -5187,12 +15796,37 @@ func (m *Sfixed64Message) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BoolMessage) UnmarshalVT(dAtA []byte) error {
+func (m *BoolMessage) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *BoolMessage) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *BoolMessage) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*BoolMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -5412,12 +16046,17 @@ func (m *BoolMessage) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return fmt.Errorf("proto: required field required_field not set")
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
 	}
 
 	if iNdEx > l {
@@ -5425,12 +16064,37 @@ func (m *BoolMessage) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StringMessage) UnmarshalVT(dAtA []byte) error {
+func (m *StringMessage) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *StringMessage) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *StringMessage) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*StringMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -5485,7 +16149,11 @@ func (m *StringMessage) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			s := string(stringValue)
 			m.RequiredField = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
@@ -5519,7 +16187,11 @@ func (m *StringMessage) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			s := string(stringValue)
 			m.OptionalField = &s
 			iNdEx = postIndex
 		case 3:
@@ -5552,7 +16224,11 @@ func (m *StringMessage) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepeatedField = append(m.RepeatedField, string(dAtA[iNdEx:postIndex]))
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			m.RepeatedField = append(m.RepeatedField, string(stringValue))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5566,12 +16242,17 @@ func (m *StringMessage) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return fmt.Errorf("proto: required field required_field not set")
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
 	}
 
 	if iNdEx > l {
@@ -5579,12 +16260,37 @@ func (m *StringMessage) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BytesMessage) UnmarshalVT(dAtA []byte) error {
+func (m *BytesMessage) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *BytesMessage) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *BytesMessage) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*BytesMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -5638,10 +16344,7 @@ func (m *BytesMessage) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequiredField = append(m.RequiredField[:0], dAtA[iNdEx:postIndex]...)
-			if m.RequiredField == nil {
-				m.RequiredField = []byte{}
-			}
+			m.RequiredField = dAtA[iNdEx:postIndex:postIndex]
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
@@ -5673,10 +16376,7 @@ func (m *BytesMessage) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptionalField = append(m.OptionalField[:0], dAtA[iNdEx:postIndex]...)
-			if m.OptionalField == nil {
-				m.OptionalField = []byte{}
-			}
+			m.OptionalField = dAtA[iNdEx:postIndex:postIndex]
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -5707,8 +16407,7 @@ func (m *BytesMessage) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepeatedField = append(m.RepeatedField, make([]byte, postIndex-iNdEx))
-			copy(m.RepeatedField[len(m.RepeatedField)-1], dAtA[iNdEx:postIndex])
+			m.RepeatedField = append(m.RepeatedField, dAtA[iNdEx:postIndex:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5722,12 +16421,17 @@ func (m *BytesMessage) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return fmt.Errorf("proto: required field required_field not set")
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
 	}
 
 	if iNdEx > l {
@@ -5735,12 +16439,37 @@ func (m *BytesMessage) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EnumMessage) UnmarshalVT(dAtA []byte) error {
+func (m *EnumMessage) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *EnumMessage) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *EnumMessage) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*EnumMessage)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -5956,12 +16685,17 @@ func (m *EnumMessage) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return fmt.Errorf("proto: required field required_field not set")
+	preIndex = iNdEx
+	if !opts.AllowPartial {
+		if hasFields[0]&uint64(0x00000001) == 0 {
+			return fmt.Errorf("proto: required field required_field not set")
+		}
 	}
 
 	if iNdEx > l {
@@ -5969,87 +16703,3 @@ func (m *EnumMessage) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func skip(dAtA []byte) (n int, err error) {
-	l := len(dAtA)
-	iNdEx := 0
-	depth := 0
-	for iNdEx < l {
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return 0, ErrIntOverflow
-			}
-			if iNdEx >= l {
-				return 0, io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		wireType := int(wire & 0x7)
-		switch wireType {
-		case 0:
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return 0, ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
-				}
-				iNdEx++
-				if dAtA[iNdEx-1] < 0x80 {
-					break
-				}
-			}
-		case 1:
-			iNdEx += 8
-		case 2:
-			var length int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return 0, ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				length |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if length < 0 {
-				return 0, ErrInvalidLength
-			}
-			iNdEx += length
-		case 3:
-			depth++
-		case 4:
-			if depth == 0 {
-				return 0, ErrUnexpectedEndOfGroup
-			}
-			depth--
-		case 5:
-			iNdEx += 4
-		default:
-			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
-		}
-		if iNdEx < 0 {
-			return 0, ErrInvalidLength
-		}
-		if depth == 0 {
-			return iNdEx, nil
-		}
-	}
-	return 0, io.ErrUnexpectedEOF
-}
-
-var (
-	ErrInvalidLength        = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflow          = fmt.Errorf("proto: integer overflow")
-	ErrUnexpectedEndOfGroup = fmt.Errorf("proto: unexpected end of group")
-)
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.26.0
+// 	protoc-gen-go v1.34.2
 // 	protoc        v3.20.0
 // source: proto3opt/opt.proto
 
@@ -330,7 +330,7 @@ func file_proto3opt_opt_proto_rawDescGZIP() []byte {
 
 var file_proto3opt_opt_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_proto3opt_opt_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_proto3opt_opt_proto_goTypes = []interface{}{
+var file_proto3opt_opt_proto_goTypes = []any{
 	(SimpleEnum)(0),               // 0: SimpleEnum
 	(*OptionalFieldInProto3)(nil), // 1: OptionalFieldInProto3
 }
@@ -349,7 +349,7 @@ func file_proto3opt_opt_proto_init() {
 		return
 	}
 	if !protoimpl.UnsafeEnabled {
-		file_proto3opt_opt_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+		file_proto3opt_opt_proto_msgTypes[0].Exporter = func(v any, i int) any {
 			switch v := v.(*OptionalFieldInProto3); i {
 			case 0:
 				return &v.state
@@ -362,7 +362,7 @@ func file_proto3opt_opt_proto_init() {
 			}
 		}
 	}
-	file_proto3opt_opt_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_proto3opt_opt_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
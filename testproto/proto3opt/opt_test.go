@@ -0,0 +1,23 @@
+package proto3opt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMarshalShortBuffer(t *testing.T) {
+	msg := &OptionalFieldInProto3{OptionalString: proto.String("optional string")}
+
+	_, err := msg.MarshalToSizedBufferVT(make([]byte, 3))
+	require.ErrorIs(t, err, ErrBufferTooSmall)
+	_, err = msg.MarshalToSizedBufferVT(make([]byte, msg.SizeVT()-1))
+	require.ErrorIs(t, err, ErrBufferTooSmall)
+
+	got, err := msg.MarshalVT()
+	require.NoError(t, err)
+	expected, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	require.Equal(t, expected, got)
+}
@@ -6,12 +6,19 @@ package proto3opt
 
 import (
 	binary "encoding/binary"
+	errors "errors"
 	fmt "fmt"
+	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
+	vtproto "github.com/planetscale/vtprotobuf/vtproto"
+	protowire "google.golang.org/protobuf/encoding/protowire"
 	proto "google.golang.org/protobuf/proto"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	hash "hash"
 	io "io"
 	math "math"
 	bits "math/bits"
+	utf8 "unicode/utf8"
+	unsafe "unsafe"
 )
 
 const (
@@ -102,7 +109,273 @@ func (m *OptionalFieldInProto3) CloneGenericVT() proto.Message {
 	return m.CloneVT()
 }
 
+func (m *OptionalFieldInProto3) CopyToVT(dst *OptionalFieldInProto3) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	if m.OptionalInt32 == nil {
+		dst.OptionalInt32 = nil
+	} else {
+		if dst.OptionalInt32 == nil {
+			dst.OptionalInt32 = new(int32)
+		}
+		*dst.OptionalInt32 = *m.OptionalInt32
+	}
+	if m.OptionalInt64 == nil {
+		dst.OptionalInt64 = nil
+	} else {
+		if dst.OptionalInt64 == nil {
+			dst.OptionalInt64 = new(int64)
+		}
+		*dst.OptionalInt64 = *m.OptionalInt64
+	}
+	if m.OptionalUint32 == nil {
+		dst.OptionalUint32 = nil
+	} else {
+		if dst.OptionalUint32 == nil {
+			dst.OptionalUint32 = new(uint32)
+		}
+		*dst.OptionalUint32 = *m.OptionalUint32
+	}
+	if m.OptionalUint64 == nil {
+		dst.OptionalUint64 = nil
+	} else {
+		if dst.OptionalUint64 == nil {
+			dst.OptionalUint64 = new(uint64)
+		}
+		*dst.OptionalUint64 = *m.OptionalUint64
+	}
+	if m.OptionalSint32 == nil {
+		dst.OptionalSint32 = nil
+	} else {
+		if dst.OptionalSint32 == nil {
+			dst.OptionalSint32 = new(int32)
+		}
+		*dst.OptionalSint32 = *m.OptionalSint32
+	}
+	if m.OptionalSint64 == nil {
+		dst.OptionalSint64 = nil
+	} else {
+		if dst.OptionalSint64 == nil {
+			dst.OptionalSint64 = new(int64)
+		}
+		*dst.OptionalSint64 = *m.OptionalSint64
+	}
+	if m.OptionalFixed32 == nil {
+		dst.OptionalFixed32 = nil
+	} else {
+		if dst.OptionalFixed32 == nil {
+			dst.OptionalFixed32 = new(uint32)
+		}
+		*dst.OptionalFixed32 = *m.OptionalFixed32
+	}
+	if m.OptionalFixed64 == nil {
+		dst.OptionalFixed64 = nil
+	} else {
+		if dst.OptionalFixed64 == nil {
+			dst.OptionalFixed64 = new(uint64)
+		}
+		*dst.OptionalFixed64 = *m.OptionalFixed64
+	}
+	if m.OptionalSfixed32 == nil {
+		dst.OptionalSfixed32 = nil
+	} else {
+		if dst.OptionalSfixed32 == nil {
+			dst.OptionalSfixed32 = new(int32)
+		}
+		*dst.OptionalSfixed32 = *m.OptionalSfixed32
+	}
+	if m.OptionalSfixed64 == nil {
+		dst.OptionalSfixed64 = nil
+	} else {
+		if dst.OptionalSfixed64 == nil {
+			dst.OptionalSfixed64 = new(int64)
+		}
+		*dst.OptionalSfixed64 = *m.OptionalSfixed64
+	}
+	if m.OptionalFloat == nil {
+		dst.OptionalFloat = nil
+	} else {
+		if dst.OptionalFloat == nil {
+			dst.OptionalFloat = new(float32)
+		}
+		*dst.OptionalFloat = *m.OptionalFloat
+	}
+	if m.OptionalDouble == nil {
+		dst.OptionalDouble = nil
+	} else {
+		if dst.OptionalDouble == nil {
+			dst.OptionalDouble = new(float64)
+		}
+		*dst.OptionalDouble = *m.OptionalDouble
+	}
+	if m.OptionalBool == nil {
+		dst.OptionalBool = nil
+	} else {
+		if dst.OptionalBool == nil {
+			dst.OptionalBool = new(bool)
+		}
+		*dst.OptionalBool = *m.OptionalBool
+	}
+	if m.OptionalString == nil {
+		dst.OptionalString = nil
+	} else {
+		if dst.OptionalString == nil {
+			dst.OptionalString = new(string)
+		}
+		*dst.OptionalString = *m.OptionalString
+	}
+	if m.OptionalBytes == nil {
+		dst.OptionalBytes = nil
+	} else if dst.OptionalBytes == nil {
+		dst.OptionalBytes = append([]byte{}, m.OptionalBytes...)
+	} else {
+		dst.OptionalBytes = append(dst.OptionalBytes[:0], m.OptionalBytes...)
+	}
+	if m.OptionalEnum == nil {
+		dst.OptionalEnum = nil
+	} else {
+		if dst.OptionalEnum == nil {
+			dst.OptionalEnum = new(SimpleEnum)
+		}
+		*dst.OptionalEnum = *m.OptionalEnum
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (this *OptionalFieldInProto3) DiffVT(that *OptionalFieldInProto3) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if p, q := this.OptionalInt32, that.OptionalInt32; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int32", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int32", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int32", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalInt64, that.OptionalInt64; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int64", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int64", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_int64", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalUint32, that.OptionalUint32; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_uint32", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_uint32", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_uint32", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalUint64, that.OptionalUint64; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_uint64", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_uint64", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_uint64", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalSint32, that.OptionalSint32; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sint32", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sint32", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sint32", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalSint64, that.OptionalSint64; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sint64", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sint64", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sint64", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalFixed32, that.OptionalFixed32; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_fixed32", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_fixed32", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_fixed32", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalFixed64, that.OptionalFixed64; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_fixed64", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_fixed64", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_fixed64", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalSfixed32, that.OptionalSfixed32; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sfixed32", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sfixed32", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sfixed32", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalSfixed64, that.OptionalSfixed64; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sfixed64", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sfixed64", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_sfixed64", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalFloat, that.OptionalFloat; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_float", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_float", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_float", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalDouble, that.OptionalDouble; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_double", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_double", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_double", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalBool, that.OptionalBool; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bool", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bool", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bool", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalString, that.OptionalString; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_string", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_string", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_string", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if p, q := this.OptionalBytes, that.OptionalBytes; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bytes", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bytes", Kind: vtproto.FieldRemoved, Old: p})
+	} else if string(p) != string(q) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_bytes", Kind: vtproto.FieldChanged, Old: p, New: q})
+	}
+	if p, q := this.OptionalEnum, that.OptionalEnum; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_enum", Kind: vtproto.FieldAdded, New: *q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_enum", Kind: vtproto.FieldRemoved, Old: *p})
+	} else if p != nil && *p != *q {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "optional_enum", Kind: vtproto.FieldChanged, Old: *p, New: *q})
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
 func (this *OptionalFieldInProto3) EqualVT(that *OptionalFieldInProto3) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *OptionalFieldInProto3) EqualVTWithOptions(that *OptionalFieldInProto3, opts vtproto.EqualOptions) bool {
 	if this == nil {
 		return that == nil
 	} else if that == nil {
@@ -138,10 +411,10 @@ func (this *OptionalFieldInProto3) EqualVT(that *OptionalFieldInProto3) bool {
 	if p, q := this.OptionalSfixed64, that.OptionalSfixed64; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	if p, q := this.OptionalFloat, that.OptionalFloat; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if p, q := this.OptionalFloat, that.OptionalFloat; (p == nil && q != nil) || (p != nil && (q == nil || !opts.EqualFloat32(*p, *q))) {
 		return false
 	}
-	if p, q := this.OptionalDouble, that.OptionalDouble; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
+	if p, q := this.OptionalDouble, that.OptionalDouble; (p == nil && q != nil) || (p != nil && (q == nil || !opts.EqualFloat64(*p, *q))) {
 		return false
 	}
 	if p, q := this.OptionalBool, that.OptionalBool; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
@@ -156,7 +429,414 @@ func (this *OptionalFieldInProto3) EqualVT(that *OptionalFieldInProto3) bool {
 	if p, q := this.OptionalEnum, that.OptionalEnum; (p == nil && q != nil) || (p != nil && (q == nil || *p != *q)) {
 		return false
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *OptionalFieldInProto3) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*OptionalFieldInProto3)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (m *OptionalFieldInProto3) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *OptionalFieldInProto3) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	if m.OptionalInt32 != nil {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalInt32))
+	}
+	if m.OptionalInt64 != nil {
+		protohelpers.HashUint64(h, s, 2)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalInt64))
+	}
+	if m.OptionalUint32 != nil {
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalUint32))
+	}
+	if m.OptionalUint64 != nil {
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalUint64))
+	}
+	if m.OptionalSint32 != nil {
+		protohelpers.HashUint64(h, s, 5)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalSint32))
+	}
+	if m.OptionalSint64 != nil {
+		protohelpers.HashUint64(h, s, 6)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalSint64))
+	}
+	if m.OptionalFixed32 != nil {
+		protohelpers.HashUint64(h, s, 7)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalFixed32))
+	}
+	if m.OptionalFixed64 != nil {
+		protohelpers.HashUint64(h, s, 8)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalFixed64))
+	}
+	if m.OptionalSfixed32 != nil {
+		protohelpers.HashUint64(h, s, 9)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalSfixed32))
+	}
+	if m.OptionalSfixed64 != nil {
+		protohelpers.HashUint64(h, s, 10)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalSfixed64))
+	}
+	if m.OptionalFloat != nil {
+		protohelpers.HashUint64(h, s, 11)
+		protohelpers.HashFloat32(h, s, *m.OptionalFloat)
+	}
+	if m.OptionalDouble != nil {
+		protohelpers.HashUint64(h, s, 12)
+		protohelpers.HashFloat64(h, s, *m.OptionalDouble)
+	}
+	if m.OptionalBool != nil {
+		protohelpers.HashUint64(h, s, 13)
+		protohelpers.HashBool(h, s, *m.OptionalBool)
+	}
+	if m.OptionalString != nil {
+		protohelpers.HashUint64(h, s, 14)
+		protohelpers.HashString(h, s, *m.OptionalString)
+	}
+	if m.OptionalBytes != nil {
+		protohelpers.HashUint64(h, s, 15)
+		protohelpers.HashBytes(h, s, m.OptionalBytes)
+	}
+	if m.OptionalEnum != nil {
+		protohelpers.HashUint64(h, s, 16)
+		protohelpers.HashUint64(h, s, uint64(*m.OptionalEnum))
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *OptionalFieldInProto3) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *OptionalFieldInProto3) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &OptionalFieldInProto3{}
+	}
+	w.ObjectStart()
+	if m.OptionalInt32 != nil {
+		w.Field("optionalInt32", "optional_int32")
+		w.Int32(*m.OptionalInt32)
+	}
+	if m.OptionalInt64 != nil {
+		w.Field("optionalInt64", "optional_int64")
+		w.Int64(*m.OptionalInt64)
+	}
+	if m.OptionalUint32 != nil {
+		w.Field("optionalUint32", "optional_uint32")
+		w.Uint32(*m.OptionalUint32)
+	}
+	if m.OptionalUint64 != nil {
+		w.Field("optionalUint64", "optional_uint64")
+		w.Uint64(*m.OptionalUint64)
+	}
+	if m.OptionalSint32 != nil {
+		w.Field("optionalSint32", "optional_sint32")
+		w.Int32(*m.OptionalSint32)
+	}
+	if m.OptionalSint64 != nil {
+		w.Field("optionalSint64", "optional_sint64")
+		w.Int64(*m.OptionalSint64)
+	}
+	if m.OptionalFixed32 != nil {
+		w.Field("optionalFixed32", "optional_fixed32")
+		w.Uint32(*m.OptionalFixed32)
+	}
+	if m.OptionalFixed64 != nil {
+		w.Field("optionalFixed64", "optional_fixed64")
+		w.Uint64(*m.OptionalFixed64)
+	}
+	if m.OptionalSfixed32 != nil {
+		w.Field("optionalSfixed32", "optional_sfixed32")
+		w.Int32(*m.OptionalSfixed32)
+	}
+	if m.OptionalSfixed64 != nil {
+		w.Field("optionalSfixed64", "optional_sfixed64")
+		w.Int64(*m.OptionalSfixed64)
+	}
+	if m.OptionalFloat != nil {
+		w.Field("optionalFloat", "optional_float")
+		w.Float32(*m.OptionalFloat)
+	}
+	if m.OptionalDouble != nil {
+		w.Field("optionalDouble", "optional_double")
+		w.Float64(*m.OptionalDouble)
+	}
+	if m.OptionalBool != nil {
+		w.Field("optionalBool", "optional_bool")
+		w.Bool(*m.OptionalBool)
+	}
+	if m.OptionalString != nil {
+		w.Field("optionalString", "optional_string")
+		if err := w.String(*m.OptionalString); err != nil {
+			return errors.New("proto: field OptionalFieldInProto3.optional_string contains invalid UTF-8")
+		}
+	}
+	if m.OptionalBytes != nil {
+		w.Field("optionalBytes", "optional_bytes")
+		w.Base64(m.OptionalBytes)
+	}
+	if m.OptionalEnum != nil {
+		w.Field("optionalEnum", "optional_enum")
+		w.Enum(int32(*m.OptionalEnum), SimpleEnum_name)
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *OptionalFieldInProto3) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *OptionalFieldInProto3) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [32]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "optionalInt32", "optional_int32":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.OptionalInt32 = &v
+		case "optionalInt64", "optional_int64":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt64()
+			if err != nil {
+				return err
+			}
+			m.OptionalInt64 = &v
+		case "optionalUint32", "optional_uint32":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint32()
+			if err != nil {
+				return err
+			}
+			m.OptionalUint32 = &v
+		case "optionalUint64", "optional_uint64":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint64()
+			if err != nil {
+				return err
+			}
+			m.OptionalUint64 = &v
+		case "optionalSint32", "optional_sint32":
+			if seen[4] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[4] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.OptionalSint32 = &v
+		case "optionalSint64", "optional_sint64":
+			if seen[5] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[5] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt64()
+			if err != nil {
+				return err
+			}
+			m.OptionalSint64 = &v
+		case "optionalFixed32", "optional_fixed32":
+			if seen[6] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[6] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint32()
+			if err != nil {
+				return err
+			}
+			m.OptionalFixed32 = &v
+		case "optionalFixed64", "optional_fixed64":
+			if seen[7] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[7] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadUint64()
+			if err != nil {
+				return err
+			}
+			m.OptionalFixed64 = &v
+		case "optionalSfixed32", "optional_sfixed32":
+			if seen[8] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[8] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.OptionalSfixed32 = &v
+		case "optionalSfixed64", "optional_sfixed64":
+			if seen[9] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[9] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt64()
+			if err != nil {
+				return err
+			}
+			m.OptionalSfixed64 = &v
+		case "optionalFloat", "optional_float":
+			if seen[10] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[10] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadFloat32()
+			if err != nil {
+				return err
+			}
+			m.OptionalFloat = &v
+		case "optionalDouble", "optional_double":
+			if seen[11] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[11] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadFloat64()
+			if err != nil {
+				return err
+			}
+			m.OptionalDouble = &v
+		case "optionalBool", "optional_bool":
+			if seen[12] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[12] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadBool()
+			if err != nil {
+				return err
+			}
+			m.OptionalBool = &v
+		case "optionalString", "optional_string":
+			if seen[13] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[13] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadString()
+			if err != nil {
+				return err
+			}
+			m.OptionalString = &v
+		case "optionalBytes", "optional_bytes":
+			if seen[14] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[14] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadBytes()
+			if err != nil {
+				return err
+			}
+			m.OptionalBytes = v
+		case "optionalEnum", "optional_enum":
+			if seen[15] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[15] = true
+			if r.SkipNull() {
+				continue
+			}
+			e, ok, err := r.ReadEnum(SimpleEnum_value)
+			if err != nil {
+				return err
+			}
+			if ok {
+				v := SimpleEnum(e)
+				m.OptionalEnum = &v
+			}
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
 }
 
 func (m *OptionalFieldInProto3) MarshalVT() (dAtA []byte, err error) {
@@ -165,16 +845,61 @@ func (m *OptionalFieldInProto3) MarshalVT() (dAtA []byte, err error) {
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	n, err := m.MarshalToSizedBufferVT(dAtA)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
 }
 
 func (m *OptionalFieldInProto3) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *OptionalFieldInProto3) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *OptionalFieldInProto3) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
 }
 
 func (m *OptionalFieldInProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
@@ -187,194 +912,888 @@ func (m *OptionalFieldInProto3) MarshalToSizedBufferVT(dAtA []byte) (int, error)
 	_ = l
 	if m.unknownFields != nil {
 		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		copy(dAtA[i:], m.unknownFields)
 	}
 	if m.OptionalEnum != nil {
 		i = encodeVarint(dAtA, i, uint64(*m.OptionalEnum))
-		i--
-		dAtA[i] = 0x1
-		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i -= 2
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		dAtA[i] = 0x80
+		dAtA[i+1] = 0x1
 	}
 	if m.OptionalBytes != nil {
-		if len(m.OptionalBytes) > 0 {
-			i -= len(m.OptionalBytes)
-			copy(dAtA[i:], m.OptionalBytes)
-			i = encodeVarint(dAtA, i, uint64(len(m.OptionalBytes)))
-			i--
-			dAtA[i] = 0x7a
+		i -= len(m.OptionalBytes)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.OptionalBytes)
+		i = encodeVarint(dAtA, i, uint64(len(m.OptionalBytes)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
 		}
+		dAtA[i] = 0x7a
 	}
 	if m.OptionalString != nil {
 		i -= len(*m.OptionalString)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		copy(dAtA[i:], *m.OptionalString)
 		i = encodeVarint(dAtA, i, uint64(len(*m.OptionalString)))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		dAtA[i] = 0x72
 	}
 	if m.OptionalBool != nil {
 		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		if *m.OptionalBool {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		dAtA[i] = 0x68
 	}
 	if m.OptionalDouble != nil {
 		i -= 8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.OptionalDouble))))
 		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		dAtA[i] = 0x61
 	}
 	if m.OptionalFloat != nil {
 		i -= 4
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(*m.OptionalFloat))))
 		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		dAtA[i] = 0x5d
 	}
 	if m.OptionalSfixed64 != nil {
 		i -= 8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.OptionalSfixed64))
 		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		dAtA[i] = 0x51
 	}
 	if m.OptionalSfixed32 != nil {
 		i -= 4
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.OptionalSfixed32))
 		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		dAtA[i] = 0x4d
 	}
 	if m.OptionalFixed64 != nil {
 		i -= 8
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		binary.LittleEndian.PutUint64(dAtA[i:], uint64(*m.OptionalFixed64))
 		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		dAtA[i] = 0x41
 	}
 	if m.OptionalFixed32 != nil {
 		i -= 4
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		binary.LittleEndian.PutUint32(dAtA[i:], uint32(*m.OptionalFixed32))
 		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		dAtA[i] = 0x3d
 	}
 	if m.OptionalSint64 != nil {
 		i = encodeVarint(dAtA, i, uint64((uint64(*m.OptionalSint64)<<1)^uint64((*m.OptionalSint64>>63))))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		dAtA[i] = 0x30
 	}
 	if m.OptionalSint32 != nil {
 		i = encodeVarint(dAtA, i, uint64((uint32(*m.OptionalSint32)<<1)^uint32((*m.OptionalSint32>>31))))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		dAtA[i] = 0x28
 	}
 	if m.OptionalUint64 != nil {
 		i = encodeVarint(dAtA, i, uint64(*m.OptionalUint64))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		dAtA[i] = 0x20
 	}
 	if m.OptionalUint32 != nil {
 		i = encodeVarint(dAtA, i, uint64(*m.OptionalUint32))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		dAtA[i] = 0x18
 	}
 	if m.OptionalInt64 != nil {
 		i = encodeVarint(dAtA, i, uint64(*m.OptionalInt64))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		dAtA[i] = 0x10
 	}
 	if m.OptionalInt32 != nil {
 		i = encodeVarint(dAtA, i, uint64(*m.OptionalInt32))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
 		dAtA[i] = 0x8
 	}
-	return len(dAtA) - i, nil
-}
+	return len(dAtA) - i, nil
+}
+
+var (
+	ErrBufferTooSmall = fmt.Errorf("proto: buffer too small to marshal the message")
+	ErrSizeMismatch   = fmt.Errorf("proto: message size changed while marshaling")
+)
+
+func encodeVarint(dAtA []byte, offset int, v uint64) int {
+	offset -= sov(v)
+	if offset < 0 {
+		return offset
+	}
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OptionalFieldInProto3) MergeVT(src *OptionalFieldInProto3) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.OptionalInt32 != nil {
+		tmpVal := *src.OptionalInt32
+		m.OptionalInt32 = &tmpVal
+	}
+	if src.OptionalInt64 != nil {
+		tmpVal := *src.OptionalInt64
+		m.OptionalInt64 = &tmpVal
+	}
+	if src.OptionalUint32 != nil {
+		tmpVal := *src.OptionalUint32
+		m.OptionalUint32 = &tmpVal
+	}
+	if src.OptionalUint64 != nil {
+		tmpVal := *src.OptionalUint64
+		m.OptionalUint64 = &tmpVal
+	}
+	if src.OptionalSint32 != nil {
+		tmpVal := *src.OptionalSint32
+		m.OptionalSint32 = &tmpVal
+	}
+	if src.OptionalSint64 != nil {
+		tmpVal := *src.OptionalSint64
+		m.OptionalSint64 = &tmpVal
+	}
+	if src.OptionalFixed32 != nil {
+		tmpVal := *src.OptionalFixed32
+		m.OptionalFixed32 = &tmpVal
+	}
+	if src.OptionalFixed64 != nil {
+		tmpVal := *src.OptionalFixed64
+		m.OptionalFixed64 = &tmpVal
+	}
+	if src.OptionalSfixed32 != nil {
+		tmpVal := *src.OptionalSfixed32
+		m.OptionalSfixed32 = &tmpVal
+	}
+	if src.OptionalSfixed64 != nil {
+		tmpVal := *src.OptionalSfixed64
+		m.OptionalSfixed64 = &tmpVal
+	}
+	if src.OptionalFloat != nil {
+		tmpVal := *src.OptionalFloat
+		m.OptionalFloat = &tmpVal
+	}
+	if src.OptionalDouble != nil {
+		tmpVal := *src.OptionalDouble
+		m.OptionalDouble = &tmpVal
+	}
+	if src.OptionalBool != nil {
+		tmpVal := *src.OptionalBool
+		m.OptionalBool = &tmpVal
+	}
+	if src.OptionalString != nil {
+		tmpVal := *src.OptionalString
+		m.OptionalString = &tmpVal
+	}
+	if src.OptionalBytes != nil {
+		m.OptionalBytes = append([]byte{}, src.OptionalBytes...)
+	}
+	if src.OptionalEnum != nil {
+		tmpVal := *src.OptionalEnum
+		m.OptionalEnum = &tmpVal
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *OptionalFieldInProto3) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*OptionalFieldInProto3); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *OptionalFieldInProto3) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OptionalInt32 != nil {
+		n += 1 + sov(uint64(*m.OptionalInt32))
+	}
+	if m.OptionalInt64 != nil {
+		n += 1 + sov(uint64(*m.OptionalInt64))
+	}
+	if m.OptionalUint32 != nil {
+		n += 1 + sov(uint64(*m.OptionalUint32))
+	}
+	if m.OptionalUint64 != nil {
+		n += 1 + sov(uint64(*m.OptionalUint64))
+	}
+	if m.OptionalSint32 != nil {
+		n += 1 + soz(uint64(*m.OptionalSint32))
+	}
+	if m.OptionalSint64 != nil {
+		n += 1 + soz(uint64(*m.OptionalSint64))
+	}
+	if m.OptionalFixed32 != nil {
+		n += 5
+	}
+	if m.OptionalFixed64 != nil {
+		n += 9
+	}
+	if m.OptionalSfixed32 != nil {
+		n += 5
+	}
+	if m.OptionalSfixed64 != nil {
+		n += 9
+	}
+	if m.OptionalFloat != nil {
+		n += 5
+	}
+	if m.OptionalDouble != nil {
+		n += 9
+	}
+	if m.OptionalBool != nil {
+		n += 2
+	}
+	if m.OptionalString != nil {
+		l = len(*m.OptionalString)
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.OptionalBytes != nil {
+		l = len(m.OptionalBytes)
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.OptionalEnum != nil {
+		n += 2 + sov(uint64(*m.OptionalEnum))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func sov(x uint64) (n int) {
+	return (bits.Len64(x|1) + 6) / 7
+}
+func soz(x uint64) (n int) {
+	return sov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OptionalFieldInProto3) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *OptionalFieldInProto3) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *OptionalFieldInProto3) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *OptionalFieldInProto3) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*OptionalFieldInProto3)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OptionalFieldInProto3: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OptionalFieldInProto3: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalInt32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalInt32 = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalInt64", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalInt64 = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalUint32", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalUint32 = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalUint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalUint64 = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSint32", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.OptionalSint32 = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSint64", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			v2 := int64(v)
+			m.OptionalSint64 = &v2
+		case 7:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalFixed32", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.OptionalFixed32 = &v
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalFixed64", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.OptionalFixed64 = &v
+		case 9:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSfixed32", wireType)
+			}
+			var v int32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = int32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.OptionalSfixed32 = &v
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalSfixed64", wireType)
+			}
+			var v int64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = int64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.OptionalSfixed64 = &v
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalFloat", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			v2 := float32(math.Float32frombits(v))
+			m.OptionalFloat = &v2
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalDouble", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.OptionalDouble = &v2
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalBool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.OptionalBool = &b
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			s := string(string(dAtA[iNdEx:postIndex]))
+			m.OptionalString = &s
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionalBytes = append(m.OptionalBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.OptionalBytes == nil {
+				m.OptionalBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalEnum", wireType)
+			}
+			var v SimpleEnum
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= SimpleEnum(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalEnum = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
 
-func encodeVarint(dAtA []byte, offset int, v uint64) int {
-	offset -= sov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *OptionalFieldInProto3) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OptionalInt32 != nil {
-		n += 1 + sov(uint64(*m.OptionalInt32))
-	}
-	if m.OptionalInt64 != nil {
-		n += 1 + sov(uint64(*m.OptionalInt64))
-	}
-	if m.OptionalUint32 != nil {
-		n += 1 + sov(uint64(*m.OptionalUint32))
-	}
-	if m.OptionalUint64 != nil {
-		n += 1 + sov(uint64(*m.OptionalUint64))
-	}
-	if m.OptionalSint32 != nil {
-		n += 1 + soz(uint64(*m.OptionalSint32))
-	}
-	if m.OptionalSint64 != nil {
-		n += 1 + soz(uint64(*m.OptionalSint64))
-	}
-	if m.OptionalFixed32 != nil {
-		n += 5
-	}
-	if m.OptionalFixed64 != nil {
-		n += 9
-	}
-	if m.OptionalSfixed32 != nil {
-		n += 5
-	}
-	if m.OptionalSfixed64 != nil {
-		n += 9
-	}
-	if m.OptionalFloat != nil {
-		n += 5
-	}
-	if m.OptionalDouble != nil {
-		n += 9
-	}
-	if m.OptionalBool != nil {
-		n += 2
-	}
-	if m.OptionalString != nil {
-		l = len(*m.OptionalString)
-		n += 1 + l + sov(uint64(l))
-	}
-	if m.OptionalBytes != nil {
-		l = len(m.OptionalBytes)
-		if l > 0 {
-			n += 1 + l + sov(uint64(l))
+func skip(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLength
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroup
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLength
+		}
+		if depth == 0 {
+			return iNdEx, nil
 		}
 	}
-	if m.OptionalEnum != nil {
-		n += 2 + sov(uint64(*m.OptionalEnum))
-	}
-	n += len(m.unknownFields)
-	return n
+	return 0, io.ErrUnexpectedEOF
 }
 
-func sov(x uint64) (n int) {
-	return (bits.Len64(x|1) + 6) / 7
+var (
+	ErrInvalidLength          = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflow            = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroup   = fmt.Errorf("proto: unexpected end of group")
+	ErrInvalidUTF8            = fmt.Errorf("proto: string field contains invalid UTF-8")
+	ErrRecursionLimitExceeded = fmt.Errorf("proto: exceeded maximum recursion depth")
+)
+
+func (m *OptionalFieldInProto3) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
 }
-func soz(x uint64) (n int) {
-	return sov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *OptionalFieldInProto3) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
 }
-func (m *OptionalFieldInProto3) UnmarshalVT(dAtA []byte) error {
+
+func (m *OptionalFieldInProto3) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*OptionalFieldInProto3)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
-		preIndex := iNdEx
+		preIndex = iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -641,7 +2060,14 @@ func (m *OptionalFieldInProto3) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
+			if !utf8.Valid(dAtA[iNdEx:postIndex]) {
+				return ErrInvalidUTF8
+			}
+			var stringValue string
+			if intStringLen > 0 {
+				stringValue = unsafe.String(&dAtA[iNdEx], intStringLen)
+			}
+			s := string(stringValue)
 			m.OptionalString = &s
 			iNdEx = postIndex
 		case 15:
@@ -673,10 +2099,7 @@ func (m *OptionalFieldInProto3) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OptionalBytes = append(m.OptionalBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.OptionalBytes == nil {
-				m.OptionalBytes = []byte{}
-			}
+			m.OptionalBytes = dAtA[iNdEx:postIndex:postIndex]
 			iNdEx = postIndex
 		case 16:
 			if wireType != 0 {
@@ -710,97 +2133,16 @@ func (m *OptionalFieldInProto3) UnmarshalVT(dAtA []byte) error {
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
 			iNdEx += skippy
 		}
 	}
+	preIndex = iNdEx
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func skip(dAtA []byte) (n int, err error) {
-	l := len(dAtA)
-	iNdEx := 0
-	depth := 0
-	for iNdEx < l {
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return 0, ErrIntOverflow
-			}
-			if iNdEx >= l {
-				return 0, io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		wireType := int(wire & 0x7)
-		switch wireType {
-		case 0:
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return 0, ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
-				}
-				iNdEx++
-				if dAtA[iNdEx-1] < 0x80 {
-					break
-				}
-			}
-		case 1:
-			iNdEx += 8
-		case 2:
-			var length int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return 0, ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return 0, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				length |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if length < 0 {
-				return 0, ErrInvalidLength
-			}
-			iNdEx += length
-		case 3:
-			depth++
-		case 4:
-			if depth == 0 {
-				return 0, ErrUnexpectedEndOfGroup
-			}
-			depth--
-		case 5:
-			iNdEx += 4
-		default:
-			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
-		}
-		if iNdEx < 0 {
-			return 0, ErrInvalidLength
-		}
-		if depth == 0 {
-			return iNdEx, nil
-		}
-	}
-	return 0, io.ErrUnexpectedEOF
-}
-
-var (
-	ErrInvalidLength        = fmt.Errorf("proto: negative length found during unmarshaling")
-	ErrIntOverflow          = fmt.Errorf("proto: integer overflow")
-	ErrUnexpectedEndOfGroup = fmt.Errorf("proto: unexpected end of group")
-)
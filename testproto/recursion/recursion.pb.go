@@ -0,0 +1,301 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.20.0
+// source: recursion/recursion.proto
+
+package recursion
+
+import (
+	_ "github.com/planetscale/vtprotobuf/vtproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Recursive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value       int32                `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Child       *Recursive           `protobuf:"bytes,2,opt,name=child,proto3" json:"child,omitempty"`
+	Children    []*Recursive         `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"`
+	ChildrenMap map[int32]*Recursive `protobuf:"bytes,4,rep,name=children_map,json=childrenMap,proto3" json:"children_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Types that are assignable to Choice:
+	//	*Recursive_OneofChild
+	Choice isRecursive_Choice `protobuf_oneof:"choice"`
+	// Decoded with the proto package, as UnmarshalVT is not generated for it.
+	Reflected *Reflected `protobuf:"bytes,6,opt,name=reflected,proto3" json:"reflected,omitempty"`
+}
+
+func (x *Recursive) Reset() {
+	*x = Recursive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_recursion_recursion_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Recursive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recursive) ProtoMessage() {}
+
+func (x *Recursive) ProtoReflect() protoreflect.Message {
+	mi := &file_recursion_recursion_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recursive.ProtoReflect.Descriptor instead.
+func (*Recursive) Descriptor() ([]byte, []int) {
+	return file_recursion_recursion_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Recursive) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Recursive) GetChild() *Recursive {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+func (x *Recursive) GetChildren() []*Recursive {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *Recursive) GetChildrenMap() map[int32]*Recursive {
+	if x != nil {
+		return x.ChildrenMap
+	}
+	return nil
+}
+
+func (m *Recursive) GetChoice() isRecursive_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (x *Recursive) GetOneofChild() *Recursive {
+	if x, ok := x.GetChoice().(*Recursive_OneofChild); ok {
+		return x.OneofChild
+	}
+	return nil
+}
+
+func (x *Recursive) GetReflected() *Reflected {
+	if x != nil {
+		return x.Reflected
+	}
+	return nil
+}
+
+type isRecursive_Choice interface {
+	isRecursive_Choice()
+}
+
+type Recursive_OneofChild struct {
+	OneofChild *Recursive `protobuf:"bytes,5,opt,name=oneof_child,json=oneofChild,proto3,oneof"`
+}
+
+func (*Recursive_OneofChild) isRecursive_Choice() {}
+
+type Reflected struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Child *Recursive `protobuf:"bytes,1,opt,name=child,proto3" json:"child,omitempty"`
+}
+
+func (x *Reflected) Reset() {
+	*x = Reflected{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_recursion_recursion_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reflected) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reflected) ProtoMessage() {}
+
+func (x *Reflected) ProtoReflect() protoreflect.Message {
+	mi := &file_recursion_recursion_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reflected.ProtoReflect.Descriptor instead.
+func (*Reflected) Descriptor() ([]byte, []int) {
+	return file_recursion_recursion_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Reflected) GetChild() *Recursive {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+var File_recursion_recursion_proto protoreflect.FileDescriptor
+
+var file_recursion_recursion_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x63, 0x75,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x33, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x74, 0x73, 0x63,
+	0x61, 0x6c, 0x65, 0x2f, 0x76, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x76,
+	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xda, 0x02, 0x0a, 0x09, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x52,
+	0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x26, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72,
+	0x73, 0x69, 0x76, 0x65, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x3e,
+	0x0a, 0x0c, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65,
+	0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0b, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x12, 0x2d,
+	0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x48,
+	0x00, 0x52, 0x0a, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x28, 0x0a,
+	0x09, 0x72, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0a, 0x2e, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x09, 0x72, 0x65,
+	0x66, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x1a, 0x4a, 0x0a, 0x10, 0x43, 0x68, 0x69, 0x6c, 0x64,
+	0x72, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52,
+	0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x22, 0x3f, 0x0a,
+	0x09, 0x52, 0x65, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x05, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x52, 0x65, 0x63, 0x75,
+	0x72, 0x73, 0x69, 0x76, 0x65, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x3a, 0x10, 0xba, 0xa6,
+	0x1f, 0x0c, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x2b, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x15,
+	0x5a, 0x13, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x65, 0x63, 0x75,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_recursion_recursion_proto_rawDescOnce sync.Once
+	file_recursion_recursion_proto_rawDescData = file_recursion_recursion_proto_rawDesc
+)
+
+func file_recursion_recursion_proto_rawDescGZIP() []byte {
+	file_recursion_recursion_proto_rawDescOnce.Do(func() {
+		file_recursion_recursion_proto_rawDescData = protoimpl.X.CompressGZIP(file_recursion_recursion_proto_rawDescData)
+	})
+	return file_recursion_recursion_proto_rawDescData
+}
+
+var file_recursion_recursion_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_recursion_recursion_proto_goTypes = []any{
+	(*Recursive)(nil), // 0: Recursive
+	(*Reflected)(nil), // 1: Reflected
+	nil,               // 2: Recursive.ChildrenMapEntry
+}
+var file_recursion_recursion_proto_depIdxs = []int32{
+	0, // 0: Recursive.child:type_name -> Recursive
+	0, // 1: Recursive.children:type_name -> Recursive
+	2, // 2: Recursive.children_map:type_name -> Recursive.ChildrenMapEntry
+	0, // 3: Recursive.oneof_child:type_name -> Recursive
+	1, // 4: Recursive.reflected:type_name -> Reflected
+	0, // 5: Reflected.child:type_name -> Recursive
+	0, // 6: Recursive.ChildrenMapEntry.value:type_name -> Recursive
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_recursion_recursion_proto_init() }
+func file_recursion_recursion_proto_init() {
+	if File_recursion_recursion_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_recursion_recursion_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Recursive); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_recursion_recursion_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Reflected); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_recursion_recursion_proto_msgTypes[0].OneofWrappers = []any{
+		(*Recursive_OneofChild)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_recursion_recursion_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_recursion_recursion_proto_goTypes,
+		DependencyIndexes: file_recursion_recursion_proto_depIdxs,
+		MessageInfos:      file_recursion_recursion_proto_msgTypes,
+	}.Build()
+	File_recursion_recursion_proto = out.File
+	file_recursion_recursion_proto_rawDesc = nil
+	file_recursion_recursion_proto_goTypes = nil
+	file_recursion_recursion_proto_depIdxs = nil
+}
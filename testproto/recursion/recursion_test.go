@@ -0,0 +1,106 @@
+package recursion
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+// nested returns a message with depth levels of nesting, counting itself, where
+// each level is linked to the next one by link.
+func nested(depth int, link func(parent, child *Recursive)) *Recursive {
+	m := &Recursive{Value: int32(depth)}
+	for i := depth - 1; i > 0; i-- {
+		parent := &Recursive{Value: int32(i)}
+		link(parent, m)
+		m = parent
+	}
+	return m
+}
+
+func TestRecursionLimit(t *testing.T) {
+	for name, link := range map[string]func(parent, child *Recursive){
+		"message":  func(parent, child *Recursive) { parent.Child = child },
+		"repeated": func(parent, child *Recursive) { parent.Children = []*Recursive{child} },
+		"map":      func(parent, child *Recursive) { parent.ChildrenMap = map[int32]*Recursive{1: child} },
+		"oneof":    func(parent, child *Recursive) { parent.Choice = &Recursive_OneofChild{OneofChild: child} },
+	} {
+		t.Run(name, func(t *testing.T) {
+			data, err := nested(protowire.DefaultRecursionLimit, link).MarshalVT()
+			require.NoError(t, err)
+			require.NoError(t, proto.Unmarshal(data, &Recursive{}))
+			require.NoError(t, (&Recursive{}).UnmarshalVT(data))
+			require.NoError(t, (&Recursive{}).UnmarshalVTUnsafe(data))
+
+			data, err = nested(protowire.DefaultRecursionLimit+1, link).MarshalVT()
+			require.NoError(t, err)
+			require.Error(t, proto.Unmarshal(data, &Recursive{}))
+			require.ErrorIs(t, (&Recursive{}).UnmarshalVT(data), ErrRecursionLimitExceeded)
+			require.ErrorIs(t, (&Recursive{}).UnmarshalVTUnsafe(data), ErrRecursionLimitExceeded)
+		})
+	}
+}
+
+func TestRecursionLimitOption(t *testing.T) {
+	data, err := nested(10, func(parent, child *Recursive) { parent.Child = child }).MarshalVT()
+	require.NoError(t, err)
+
+	for depth := 1; depth < 10; depth++ {
+		opts := vtproto.UnmarshalOptions{RecursionLimit: depth}
+		require.ErrorIs(t, (&Recursive{}).UnmarshalVTWithOptions(data, opts), ErrRecursionLimitExceeded)
+		require.ErrorIs(t, (&Recursive{}).UnmarshalVTUnsafeWithOptions(data, opts), ErrRecursionLimitExceeded)
+	}
+	opts := vtproto.UnmarshalOptions{RecursionLimit: 10}
+	require.NoError(t, (&Recursive{}).UnmarshalVTWithOptions(data, opts))
+	require.NoError(t, (&Recursive{}).UnmarshalVTUnsafeWithOptions(data, opts))
+}
+
+func TestRecursionLimitReflected(t *testing.T) {
+	// Every other level is decoded by the proto package, which must be given
+	// the remaining depth.
+	m := nested(10, func(parent, child *Recursive) {
+		parent.Reflected = &Reflected{Child: child}
+	})
+	data, err := m.MarshalVT()
+	require.NoError(t, err)
+
+	for depth := 1; depth < 19; depth++ {
+		require.Error(t, proto.UnmarshalOptions{RecursionLimit: depth}.Unmarshal(data, &Recursive{}))
+		require.Error(t, (&Recursive{}).UnmarshalVTWithOptions(data, vtproto.UnmarshalOptions{RecursionLimit: depth}))
+	}
+
+	unmarshaled := &Recursive{}
+	require.NoError(t, unmarshaled.UnmarshalVTWithOptions(data, vtproto.UnmarshalOptions{RecursionLimit: 19}))
+	require.True(t, proto.Equal(m, unmarshaled))
+}
+
+func TestRecursionLimitDecodeError(t *testing.T) {
+	data, err := nested(protowire.DefaultRecursionLimit+1, func(parent, child *Recursive) { parent.Child = child }).MarshalVT()
+	require.NoError(t, err)
+
+	err = (&Recursive{}).UnmarshalVT(data)
+	var decodeErr *vtproto.DecodeError
+	require.True(t, errors.As(err, &decodeErr), "unexpected error: %v", err)
+	require.Equal(t, strings.TrimSuffix(strings.Repeat("child.", protowire.DefaultRecursionLimit), "."), decodeErr.Path())
+	require.Equal(t, protowire.Number(2), decodeErr.FieldNumber)
+	require.Equal(t, protowire.BytesType, decodeErr.WireType)
+}
+
+func BenchmarkRecursionLimitDecodeError(b *testing.B) {
+	data, err := nested(protowire.DefaultRecursionLimit+1, func(parent, child *Recursive) { parent.Child = child }).MarshalVT()
+	require.NoError(b, err)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := (&Recursive{}).UnmarshalVT(data); err == nil {
+			b.Fatal("expected an error")
+		}
+	}
+}
@@ -0,0 +1,2047 @@
+// Code generated by protoc-gen-go-vtproto. DO NOT EDIT.
+// protoc-gen-go-vtproto version: (devel)
+// source: recursion/recursion.proto
+
+package recursion
+
+import (
+	fmt "fmt"
+	protohelpers "github.com/planetscale/vtprotobuf/protohelpers"
+	vtproto "github.com/planetscale/vtprotobuf/vtproto"
+	protowire "google.golang.org/protobuf/encoding/protowire"
+	proto "google.golang.org/protobuf/proto"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	hash "hash"
+	fnv "hash/fnv"
+	io "io"
+	bits "math/bits"
+	sort "sort"
+	strconv "strconv"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+func (m *Recursive) CloneVT() *Recursive {
+	if m == nil {
+		return (*Recursive)(nil)
+	}
+	r := &Recursive{
+		Value: m.Value,
+		Child: m.Child.CloneVT(),
+	}
+	if rhs := m.Children; rhs != nil {
+		tmpContainer := make([]*Recursive, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.Children = tmpContainer
+	}
+	if rhs := m.ChildrenMap; rhs != nil {
+		tmpContainer := make(map[int32]*Recursive, len(rhs))
+		for k, v := range rhs {
+			tmpContainer[k] = v.CloneVT()
+		}
+		r.ChildrenMap = tmpContainer
+	}
+	if m.Choice != nil {
+		r.Choice = m.Choice.(interface{ CloneVT() isRecursive_Choice }).CloneVT()
+	}
+	if rhs := m.Reflected; rhs != nil {
+		if vtpb, ok := interface{}(rhs).(interface{ CloneVT() *Reflected }); ok {
+			r.Reflected = vtpb.CloneVT()
+		} else {
+			r.Reflected = proto.Clone(rhs).(*Reflected)
+		}
+	}
+	if len(m.unknownFields) > 0 {
+		r.unknownFields = make([]byte, len(m.unknownFields))
+		copy(r.unknownFields, m.unknownFields)
+	}
+	return r
+}
+
+func (m *Recursive) CloneGenericVT() proto.Message {
+	return m.CloneVT()
+}
+
+func (m *Recursive) CopyToVT(dst *Recursive) {
+	if m == nil {
+		dst.Reset()
+		return
+	}
+	dst.Value = m.Value
+	if m.Child == nil {
+		dst.Child = nil
+	} else {
+		if dst.Child == nil {
+			dst.Child = m.Child.CloneVT()
+		} else {
+			m.Child.CopyToVT(dst.Child)
+		}
+	}
+	if len(m.Children) > cap(dst.Children) {
+		tmpContainer := make([]*Recursive, len(m.Children))
+		copy(tmpContainer, dst.Children[:cap(dst.Children)])
+		dst.Children = tmpContainer
+	} else {
+		dst.Children = dst.Children[:len(m.Children)]
+	}
+	for k, v := range m.Children {
+		if dst.Children[k] == nil {
+			dst.Children[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.Children[k])
+		}
+	}
+	if dst.ChildrenMap == nil && len(m.ChildrenMap) > 0 {
+		dst.ChildrenMap = make(map[int32]*Recursive, len(m.ChildrenMap))
+	}
+	for k := range dst.ChildrenMap {
+		if _, ok := m.ChildrenMap[k]; !ok {
+			delete(dst.ChildrenMap, k)
+		}
+	}
+	for k, v := range m.ChildrenMap {
+		if dst.ChildrenMap[k] == nil {
+			dst.ChildrenMap[k] = v.CloneVT()
+		} else {
+			v.CopyToVT(dst.ChildrenMap[k])
+		}
+	}
+	switch c := m.Choice.(type) {
+	case *Recursive_OneofChild:
+		if d, ok := dst.Choice.(*Recursive_OneofChild); ok {
+			if d.OneofChild == nil {
+				d.OneofChild = c.OneofChild.CloneVT()
+			} else {
+				c.OneofChild.CopyToVT(d.OneofChild)
+			}
+		} else {
+			dst.Choice = c.CloneVT()
+		}
+	default:
+		dst.Choice = nil
+	}
+	if m.Reflected == nil {
+		dst.Reflected = nil
+	} else {
+		if dst.Reflected == nil {
+			if vtpb, ok := interface{}(m.Reflected).(interface{ CloneVT() *Reflected }); ok {
+				dst.Reflected = vtpb.CloneVT()
+			} else {
+				dst.Reflected = proto.Clone(m.Reflected).(*Reflected)
+			}
+		} else {
+			if vtpb, ok := interface{}(m.Reflected).(interface{ CopyToVT(*Reflected) }); ok {
+				vtpb.CopyToVT(dst.Reflected)
+			} else {
+				proto.Reset(dst.Reflected)
+				proto.Merge(dst.Reflected, m.Reflected)
+			}
+		}
+	}
+	dst.unknownFields = append(dst.unknownFields[:0], m.unknownFields...)
+}
+
+func (m *Recursive_OneofChild) CloneVT() isRecursive_Choice {
+	if m == nil {
+		return (*Recursive_OneofChild)(nil)
+	}
+	r := &Recursive_OneofChild{
+		OneofChild: m.OneofChild.CloneVT(),
+	}
+	return r
+}
+
+func (this *Recursive) DiffVT(that *Recursive) []vtproto.FieldDiff {
+	if this == nil && that == nil {
+		return nil
+	} else if this == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldAdded, New: that}}
+	} else if that == nil {
+		return []vtproto.FieldDiff{{Kind: vtproto.FieldRemoved, Old: this}}
+	}
+	var diffs []vtproto.FieldDiff
+	if this.Value != that.Value {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "value", Kind: vtproto.FieldChanged, Old: this.Value, New: that.Value})
+	}
+	if p, q := this.Child, that.Child; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "child", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "child", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if nested := p.DiffVT(q); len(nested) > 0 {
+			diffs = protohelpers.AppendDiffs(diffs, "child", nested)
+		}
+	}
+	for i := 0; i < len(this.Children) && i < len(that.Children); i++ {
+		if p, q := this.Children[i], that.Children[i]; p != q {
+			if p == nil {
+				p = &Recursive{}
+			}
+			if q == nil {
+				q = &Recursive{}
+			}
+			if nested := p.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "children["+strconv.Itoa(i)+"]", nested)
+			}
+		}
+	}
+	for i := len(that.Children); i < len(this.Children); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "children[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldRemoved, Old: this.Children[i]})
+	}
+	for i := len(this.Children); i < len(that.Children); i++ {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "children[" + strconv.Itoa(i) + "]", Kind: vtproto.FieldAdded, New: that.Children[i]})
+	}
+	if len(this.ChildrenMap) > 0 || len(that.ChildrenMap) > 0 {
+		keys := make([]int32, 0, len(this.ChildrenMap)+len(that.ChildrenMap))
+		for k := range this.ChildrenMap {
+			keys = append(keys, k)
+		}
+		for k := range that.ChildrenMap {
+			if _, ok := this.ChildrenMap[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			vx, xok := this.ChildrenMap[k]
+			vy, yok := that.ChildrenMap[k]
+			if !yok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "children_map[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldRemoved, Old: vx})
+			} else if !xok {
+				diffs = append(diffs, vtproto.FieldDiff{Path: "children_map[" + strconv.FormatInt(int64(k), 10) + "]", Kind: vtproto.FieldAdded, New: vy})
+			} else {
+				if p, q := vx, vy; p != q {
+					if p == nil {
+						p = &Recursive{}
+					}
+					if q == nil {
+						q = &Recursive{}
+					}
+					if nested := p.DiffVT(q); len(nested) > 0 {
+						diffs = protohelpers.AppendDiffs(diffs, "children_map["+strconv.FormatInt(int64(k), 10)+"]", nested)
+					}
+				}
+			}
+		}
+	}
+	if x, ok := this.Choice.(*Recursive_OneofChild); ok {
+		if y, ok := that.Choice.(*Recursive_OneofChild); ok {
+			if p, q := x.OneofChild, y.OneofChild; p != q {
+				if p == nil {
+					p = &Recursive{}
+				}
+				if q == nil {
+					q = &Recursive{}
+				}
+				if nested := p.DiffVT(q); len(nested) > 0 {
+					diffs = protohelpers.AppendDiffs(diffs, "oneof_child", nested)
+				}
+			}
+		} else {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_child", Kind: vtproto.FieldRemoved, Old: x.OneofChild})
+		}
+	} else if y, ok := that.Choice.(*Recursive_OneofChild); ok {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "oneof_child", Kind: vtproto.FieldAdded, New: y.OneofChild})
+	}
+	if p, q := this.Reflected, that.Reflected; p == nil && q != nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "reflected", Kind: vtproto.FieldAdded, New: q})
+	} else if p != nil && q == nil {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "reflected", Kind: vtproto.FieldRemoved, Old: p})
+	} else if p != q {
+		if vtpb, ok := interface{}(p).(interface {
+			DiffVT(*Reflected) []vtproto.FieldDiff
+		}); ok {
+			if nested := vtpb.DiffVT(q); len(nested) > 0 {
+				diffs = protohelpers.AppendDiffs(diffs, "reflected", nested)
+			}
+		} else if !proto.Equal(p, q) {
+			diffs = append(diffs, vtproto.FieldDiff{Path: "reflected", Kind: vtproto.FieldChanged, Old: p, New: q})
+		}
+	}
+	if string(this.unknownFields) != string(that.unknownFields) {
+		diffs = append(diffs, vtproto.FieldDiff{Path: "<unknown>", Kind: vtproto.FieldChanged, Old: this.unknownFields, New: that.unknownFields})
+	}
+	return diffs
+}
+
+func (this *Recursive) EqualVT(that *Recursive) bool {
+	return this.EqualVTWithOptions(that, vtproto.EqualOptions{})
+}
+
+func (this *Recursive) EqualVTWithOptions(that *Recursive, opts vtproto.EqualOptions) bool {
+	if this == nil {
+		return that == nil
+	} else if that == nil {
+		return false
+	}
+	if this.Choice == nil && that.Choice != nil {
+		return false
+	} else if this.Choice != nil {
+		if that.Choice == nil {
+			return false
+		}
+		if !this.Choice.(interface {
+			EqualVTWithOptions(isRecursive_Choice, vtproto.EqualOptions) bool
+		}).EqualVTWithOptions(that.Choice, opts) {
+			return false
+		}
+	}
+	if this.Value != that.Value {
+		return false
+	}
+	if !this.Child.EqualVTWithOptions(that.Child, opts) {
+		return false
+	}
+	if len(this.Children) != len(that.Children) {
+		return false
+	}
+	for i, vx := range this.Children {
+		vy := that.Children[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Recursive{}
+			}
+			if q == nil {
+				q = &Recursive{}
+			}
+			if !p.EqualVTWithOptions(q, opts) {
+				return false
+			}
+		}
+	}
+	if len(this.ChildrenMap) != len(that.ChildrenMap) {
+		return false
+	}
+	for i, vx := range this.ChildrenMap {
+		vy, ok := that.ChildrenMap[i]
+		if !ok {
+			return false
+		}
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Recursive{}
+			}
+			if q == nil {
+				q = &Recursive{}
+			}
+			if !p.EqualVTWithOptions(q, opts) {
+				return false
+			}
+		}
+	}
+	if equal, ok := interface{}(this.Reflected).(interface {
+		EqualVTWithOptions(*Reflected, vtproto.EqualOptions) bool
+	}); ok {
+		if !equal.EqualVTWithOptions(that.Reflected, opts) {
+			return false
+		}
+	} else if equal, ok := interface{}(this.Reflected).(interface{ EqualVT(*Reflected) bool }); ok {
+		if !equal.EqualVT(that.Reflected) {
+			return false
+		}
+	} else if !proto.Equal(this.Reflected, that.Reflected) {
+		return false
+	}
+	return opts.IgnoreUnknown || string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Recursive) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Recursive)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+
+func (this *Recursive_OneofChild) EqualVT(thatIface isRecursive_Choice) bool {
+	return this.EqualVTWithOptions(thatIface, vtproto.EqualOptions{})
+}
+
+func (this *Recursive_OneofChild) EqualVTWithOptions(thatIface isRecursive_Choice, opts vtproto.EqualOptions) bool {
+	that, ok := thatIface.(*Recursive_OneofChild)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if p, q := this.OneofChild, that.OneofChild; p != q {
+		if p == nil {
+			p = &Recursive{}
+		}
+		if q == nil {
+			q = &Recursive{}
+		}
+		if !p.EqualVTWithOptions(q, opts) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Recursive) HashVT(h hash.Hash64) {
+	var s protohelpers.HashScratch
+	m.hashVT(h, &s)
+}
+
+func (m *Recursive) hashVT(h hash.Hash64, s *protohelpers.HashScratch) {
+	if m == nil {
+		return
+	}
+	var entry hash.Hash64
+	if m.Value != 0 {
+		protohelpers.HashUint64(h, s, 1)
+		protohelpers.HashUint64(h, s, uint64(m.Value))
+	}
+	if m.Child != nil {
+		protohelpers.HashUint64(h, s, 2)
+		m.Child.hashVT(h, s)
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if len(m.Children) > 0 {
+		protohelpers.HashUint64(h, s, 3)
+		protohelpers.HashUint64(h, s, uint64(len(m.Children)))
+		for _, v := range m.Children {
+			v.hashVT(h, s)
+			protohelpers.HashUint64(h, s, 0)
+		}
+	}
+	if len(m.ChildrenMap) > 0 {
+		protohelpers.HashUint64(h, s, 4)
+		protohelpers.HashUint64(h, s, uint64(len(m.ChildrenMap)))
+		var sum uint64
+		if entry == nil {
+			entry = fnv.New64a()
+		}
+		for k, v := range m.ChildrenMap {
+			entry.Reset()
+			protohelpers.HashUint64(entry, s, uint64(k))
+			v.hashVT(entry, s)
+			protohelpers.HashUint64(entry, s, 0)
+			sum += entry.Sum64()
+		}
+		protohelpers.HashUint64(h, s, sum)
+	}
+	switch c := m.Choice.(type) {
+	case *Recursive_OneofChild:
+		protohelpers.HashUint64(h, s, 5)
+		c.OneofChild.hashVT(h, s)
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if m.Reflected != nil {
+		protohelpers.HashUint64(h, s, 6)
+		if vtpb, ok := interface{}(m.Reflected).(interface{ HashVT(hash.Hash64) }); ok {
+			vtpb.HashVT(h)
+		} else {
+			protohelpers.HashMessage(h, m.Reflected)
+		}
+		protohelpers.HashUint64(h, s, 0)
+	}
+	if len(m.unknownFields) > 0 {
+		protohelpers.HashBytes(h, s, m.unknownFields)
+	}
+}
+
+func (m *Recursive) MarshalJSONVT() ([]byte, error) {
+	return vtproto.JSONMarshalOptions{}.Marshal(m)
+}
+
+func (m *Recursive) MarshalJSONToVT(w *vtproto.JSONWriter) error {
+	if m == nil {
+		// a nil message is written like an empty one
+		m = &Recursive{}
+	}
+	w.ObjectStart()
+	if m.Value != 0 {
+		w.Field("value", "value")
+		w.Int32(m.Value)
+	} else if w.Options().EmitUnpopulated {
+		w.Field("value", "value")
+		w.Int32(m.GetValue())
+	}
+	if m.Child != nil {
+		w.Field("child", "child")
+		if err := m.Child.MarshalJSONToVT(w); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("child", "child")
+		w.Null()
+	}
+	if len(m.Children) > 0 {
+		w.Field("children", "children")
+		w.ArrayStart()
+		for _, v := range m.Children {
+			if err := v.MarshalJSONToVT(w); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("children", "children")
+		w.ArrayStart()
+		w.ArrayEnd()
+	}
+	if len(m.ChildrenMap) > 0 {
+		w.Field("childrenMap", "children_map")
+		keys := make([]int32, 0, len(m.ChildrenMap))
+		for k := range m.ChildrenMap {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		w.ObjectStart()
+		for _, k := range keys {
+			w.Name(strconv.FormatInt(int64(k), 10))
+			if err := m.ChildrenMap[k].MarshalJSONToVT(w); err != nil {
+				return err
+			}
+		}
+		w.ObjectEnd()
+	} else if w.Options().EmitUnpopulated {
+		w.Field("childrenMap", "children_map")
+		w.ObjectStart()
+		w.ObjectEnd()
+	}
+	if c, ok := m.Choice.(*Recursive_OneofChild); ok {
+		w.Field("oneofChild", "oneof_child")
+		if err := c.OneofChild.MarshalJSONToVT(w); err != nil {
+			return err
+		}
+	}
+	if m.Reflected != nil {
+		w.Field("reflected", "reflected")
+		if err := w.Message(m.Reflected); err != nil {
+			return err
+		}
+	} else if w.Options().EmitUnpopulated {
+		w.Field("reflected", "reflected")
+		w.Null()
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (m *Recursive) UnmarshalJSONVT(b []byte) error {
+	return vtproto.JSONUnmarshalOptions{}.Unmarshal(b, m)
+}
+
+func (m *Recursive) UnmarshalJSONFromVT(r *vtproto.JSONReader) error {
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	var seen [7]bool
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		switch name {
+		case "value":
+			if seen[0] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[0] = true
+			if r.SkipNull() {
+				continue
+			}
+			v, err := r.ReadInt32()
+			if err != nil {
+				return err
+			}
+			m.Value = v
+		case "child":
+			if seen[1] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[1] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(Recursive)
+			if err := v.UnmarshalJSONFromVT(r); err != nil {
+				return err
+			}
+			m.Child = v
+		case "children":
+			if seen[2] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[2] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadArrayStart(); err != nil {
+				return err
+			}
+			for {
+				ok, err := r.ReadArrayNext()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				v := new(Recursive)
+				if err := v.UnmarshalJSONFromVT(r); err != nil {
+					return err
+				}
+				m.Children = append(m.Children, v)
+			}
+		case "childrenMap", "children_map":
+			if seen[3] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[3] = true
+			if r.SkipNull() {
+				continue
+			}
+			if err := r.ReadObjectStart(); err != nil {
+				return err
+			}
+			if m.ChildrenMap == nil {
+				m.ChildrenMap = make(map[int32]*Recursive)
+			}
+			for {
+				name, ok, err := r.ReadName()
+				if err != nil {
+					return err
+				}
+				if !ok {
+					break
+				}
+				k, err := r.Int32Key(name)
+				if err != nil {
+					return err
+				}
+				if _, ok := m.ChildrenMap[k]; ok {
+					return r.Errorf("duplicate map key %q", name)
+				}
+				v := new(Recursive)
+				if err := v.UnmarshalJSONFromVT(r); err != nil {
+					return err
+				}
+				m.ChildrenMap[k] = v
+			}
+		case "oneofChild", "oneof_child":
+			if seen[4] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[4] = true
+			if r.SkipNull() {
+				continue
+			}
+			if seen[6] {
+				return r.Errorf("oneof Recursive.choice is already set")
+			}
+			seen[6] = true
+			v := new(Recursive)
+			if err := v.UnmarshalJSONFromVT(r); err != nil {
+				return err
+			}
+			m.Choice = &Recursive_OneofChild{OneofChild: v}
+		case "reflected":
+			if seen[5] {
+				return r.Errorf("duplicate field %q", name)
+			}
+			seen[5] = true
+			if r.SkipNull() {
+				continue
+			}
+			v := new(Reflected)
+			if err := r.ReadMessage(v); err != nil {
+				return err
+			}
+			m.Reflected = v
+		default:
+			if err := r.UnknownField(name); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (m *Recursive) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Recursive) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Recursive) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Recursive) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Recursive) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if vtmsg, ok := m.Choice.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
+	if m.Reflected != nil {
+		size, err := m.Reflected.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x32
+	}
+	if len(m.ChildrenMap) > 0 {
+		for k := range m.ChildrenMap {
+			v := m.ChildrenMap[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x12
+			i = encodeVarint(dAtA, i, uint64(k))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Children) > 0 {
+		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Children[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			i--
+			if i < 0 {
+				return 0, ErrBufferTooSmall
+			}
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Child != nil {
+		size, err := m.Child.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x12
+	}
+	if m.Value != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Value))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Recursive_OneofChild) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Recursive_OneofChild) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Recursive_OneofChild) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.OneofChild != nil {
+		size, err := m.OneofChild.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Reflected) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Reflected) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	if len(dAtA) < size {
+		return 0, ErrBufferTooSmall
+	}
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return 0, err
+	}
+	if n != size {
+		return 0, ErrSizeMismatch
+	}
+	return n, nil
+}
+
+func (m *Reflected) MarshalAppendVT(dAtA []byte) ([]byte, error) {
+	if m == nil {
+		return dAtA, nil
+	}
+	l := len(dAtA)
+	size := m.SizeVT()
+	dAtA = append(dAtA, make([]byte, size)...)
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return dAtA[:l], err
+	}
+	if n != size {
+		return dAtA[:l], ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Reflected) MarshalDelimitedVT() (dAtA []byte, err error) {
+	size := m.SizeVT()
+	l := sov(uint64(size))
+	dAtA = make([]byte, l+size)
+	encodeVarint(dAtA, l, uint64(size))
+	n, err := m.MarshalToSizedBufferVT(dAtA[l:])
+	if err != nil {
+		return nil, err
+	}
+	if n != size {
+		return nil, ErrSizeMismatch
+	}
+	return dAtA, nil
+}
+
+func (m *Reflected) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Child != nil {
+		size, err := m.Child.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		i--
+		if i < 0 {
+			return 0, ErrBufferTooSmall
+		}
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+var (
+	ErrBufferTooSmall = fmt.Errorf("proto: buffer too small to marshal the message")
+	ErrSizeMismatch   = fmt.Errorf("proto: message size changed while marshaling")
+)
+
+func encodeVarint(dAtA []byte, offset int, v uint64) int {
+	offset -= sov(v)
+	if offset < 0 {
+		return offset
+	}
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Recursive) MergeVT(src *Recursive) {
+	if m == nil || src == nil {
+		return
+	}
+	if src.Value != 0 {
+		m.Value = src.Value
+	}
+	if src.Child != nil {
+		if m.Child == nil {
+			m.Child = &Recursive{}
+		}
+		m.Child.MergeVT(src.Child)
+	}
+	for _, v := range src.Children {
+		tmpMsg := &Recursive{}
+		tmpMsg.MergeVT(v)
+		m.Children = append(m.Children, tmpMsg)
+	}
+	if len(src.ChildrenMap) > 0 {
+		if m.ChildrenMap == nil {
+			m.ChildrenMap = make(map[int32]*Recursive, len(src.ChildrenMap))
+		}
+		for k, v := range src.ChildrenMap {
+			tmpMsg := &Recursive{}
+			tmpMsg.MergeVT(v)
+			m.ChildrenMap[k] = tmpMsg
+		}
+	}
+	switch srcOneof := src.Choice.(type) {
+	case *Recursive_OneofChild:
+		if dstOneof, ok := m.Choice.(*Recursive_OneofChild); ok && dstOneof.OneofChild != nil {
+			if srcOneof.OneofChild != nil {
+				dstOneof.OneofChild.MergeVT(srcOneof.OneofChild)
+			}
+		} else {
+			tmpMsg := &Recursive{}
+			tmpMsg.MergeVT(srcOneof.OneofChild)
+			m.Choice = &Recursive_OneofChild{OneofChild: tmpMsg}
+		}
+	}
+	if src.Reflected != nil {
+		if m.Reflected == nil {
+			m.Reflected = &Reflected{}
+		}
+		if vtpb, ok := interface{}(m.Reflected).(interface{ MergeVT(*Reflected) }); ok {
+			vtpb.MergeVT(src.Reflected)
+		} else {
+			proto.Merge(m.Reflected, src.Reflected)
+		}
+	}
+	m.unknownFields = append(m.unknownFields, src.unknownFields...)
+}
+
+func (m *Recursive) MergeGenericVT(src proto.Message) {
+	if src, ok := src.(*Recursive); ok {
+		m.MergeVT(src)
+	} else {
+		proto.Merge(m, src)
+	}
+}
+
+func (m *Recursive) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sov(uint64(m.Value))
+	}
+	if m.Child != nil {
+		l = m.Child.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.Children) > 0 {
+		for _, e := range m.Children {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.ChildrenMap) > 0 {
+		for k, v := range m.ChildrenMap {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.SizeVT()
+			}
+			l += 1 + sov(uint64(l))
+			mapEntrySize := 1 + sov(uint64(k)) + l
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if vtmsg, ok := m.Choice.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	if m.Reflected != nil {
+		l = m.Reflected.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Recursive_OneofChild) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OneofChild != nil {
+		l = m.OneofChild.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *Reflected) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Child != nil {
+		l = m.Child.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func sov(x uint64) (n int) {
+	return (bits.Len64(x|1) + 6) / 7
+}
+func soz(x uint64) (n int) {
+	return sov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Recursive) UnmarshalVT(dAtA []byte) error {
+	return m.unmarshalVT(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Recursive) UnmarshalVTWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVT(dAtA, opts, depth)
+}
+
+func (m *Recursive) UnmarshalDelimitedVT(dAtA []byte) (int, error) {
+	size, n := protowire.ConsumeVarint(dAtA)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if size > uint64(len(dAtA)-n) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	end := n + int(size)
+	if err := m.UnmarshalVT(dAtA[n:end]); err != nil {
+		return 0, err
+	}
+	return end, nil
+}
+
+func (m *Recursive) unmarshalVT(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Recursive)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Recursive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Recursive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Child", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Child == nil {
+				m.Child = &Recursive{}
+			}
+			if err := m.Child.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, &Recursive{})
+			if err := m.Children[len(m.Children)-1].unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildrenMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChildrenMap == nil {
+				m.ChildrenMap = make(map[int32]*Recursive)
+			}
+			var mapkey int32
+			var mapvalue *Recursive
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Recursive{}
+					if err := mapvalue.unmarshalVT(dAtA[iNdEx:postmsgIndex], opts, depth); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ChildrenMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofChild", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.Choice.(*Recursive_OneofChild); ok {
+				if err := oneof.OneofChild.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+					return err
+				}
+			} else {
+				v := &Recursive{}
+				if err := v.unmarshalVT(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+					return err
+				}
+				m.Choice = &Recursive_OneofChild{OneofChild: v}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reflected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reflected == nil {
+				m.Reflected = &Reflected{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.Reflected).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.Reflected); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skip(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLength
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroup
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLength
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLength          = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflow            = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroup   = fmt.Errorf("proto: unexpected end of group")
+	ErrInvalidUTF8            = fmt.Errorf("proto: string field contains invalid UTF-8")
+	ErrRecursionLimitExceeded = fmt.Errorf("proto: exceeded maximum recursion depth")
+)
+
+func (m *Recursive) UnmarshalVTUnsafe(dAtA []byte) error {
+	return m.unmarshalVTUnsafe(dAtA, vtproto.UnmarshalOptions{}, protowire.DefaultRecursionLimit)
+}
+
+func (m *Recursive) UnmarshalVTUnsafeWithOptions(dAtA []byte, opts vtproto.UnmarshalOptions) error {
+	if !opts.Merge {
+		m.Reset()
+	}
+	depth := opts.RecursionLimit
+	if depth == 0 {
+		depth = protowire.DefaultRecursionLimit
+	}
+	return m.unmarshalVTUnsafe(dAtA, opts, depth)
+}
+
+func (m *Recursive) unmarshalVTUnsafe(dAtA []byte, opts vtproto.UnmarshalOptions, depth int) (err error) {
+	depth--
+	if depth < 0 {
+		return ErrRecursionLimitExceeded
+	}
+	var preIndex int
+	defer func() {
+		if err != nil {
+			err = protohelpers.WrapDecodeError(err, (*Recursive)(nil).ProtoReflect().Descriptor(), dAtA, preIndex)
+		}
+	}()
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex = iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Recursive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Recursive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Child", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Child == nil {
+				m.Child = &Recursive{}
+			}
+			if err := m.Child.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Children = append(m.Children, &Recursive{})
+			if err := m.Children[len(m.Children)-1].unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildrenMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChildrenMap == nil {
+				m.ChildrenMap = make(map[int32]*Recursive)
+			}
+			var mapkey int32
+			var mapvalue *Recursive
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Recursive{}
+					if err := mapvalue.unmarshalVTUnsafe(dAtA[iNdEx:postmsgIndex], opts, depth); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ChildrenMap[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OneofChild", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.Choice.(*Recursive_OneofChild); ok {
+				if err := oneof.OneofChild.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+					return err
+				}
+			} else {
+				v := &Recursive{}
+				if err := v.unmarshalVTUnsafe(dAtA[iNdEx:postIndex], opts, depth); err != nil {
+					return err
+				}
+				m.Choice = &Recursive_OneofChild{OneofChild: v}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reflected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reflected == nil {
+				m.Reflected = &Reflected{}
+			}
+			if depth == 0 {
+				return ErrRecursionLimitExceeded
+			}
+			if unmarshal, ok := interface{}(m.Reflected).(interface {
+				UnmarshalVTUnsafeWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTUnsafeWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else if unmarshal, ok := interface{}(m.Reflected).(interface {
+				UnmarshalVTWithOptions([]byte, vtproto.UnmarshalOptions) error
+			}); ok {
+				if err := unmarshal.UnmarshalVTWithOptions(dAtA[iNdEx:postIndex], vtproto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}); err != nil {
+					return err
+				}
+			} else {
+				if err := (proto.UnmarshalOptions{
+					Merge:          true,
+					AllowPartial:   opts.AllowPartial,
+					DiscardUnknown: opts.DiscardUnknown,
+					RecursionLimit: depth,
+				}).Unmarshal(dAtA[iNdEx:postIndex], m.Reflected); err != nil {
+					return err
+				}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			if !opts.DiscardUnknown {
+				m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			}
+			iNdEx += skippy
+		}
+	}
+	preIndex = iNdEx
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
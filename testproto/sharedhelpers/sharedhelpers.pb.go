@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.20.0
+// source: sharedhelpers/sharedhelpers.proto
+
+package sharedhelpers
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Shared struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values   []int64            `protobuf:"zigzag64,2,rep,packed,name=values,proto3" json:"values,omitempty"`
+	Children map[string]*Shared `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Shared) Reset() {
+	*x = Shared{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sharedhelpers_sharedhelpers_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Shared) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shared) ProtoMessage() {}
+
+func (x *Shared) ProtoReflect() protoreflect.Message {
+	mi := &file_sharedhelpers_sharedhelpers_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shared.ProtoReflect.Descriptor instead.
+func (*Shared) Descriptor() ([]byte, []int) {
+	return file_sharedhelpers_sharedhelpers_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Shared) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Shared) GetValues() []int64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Shared) GetChildren() map[string]*Shared {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+var File_sharedhelpers_sharedhelpers_proto protoreflect.FileDescriptor
+
+var file_sharedhelpers_sharedhelpers_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x2f,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xad, 0x01, 0x0a, 0x06, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x12, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x1a, 0x44, 0x0a,
+	0x0d, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x1d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x07, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x42, 0x19, 0x5a, 0x17, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sharedhelpers_sharedhelpers_proto_rawDescOnce sync.Once
+	file_sharedhelpers_sharedhelpers_proto_rawDescData = file_sharedhelpers_sharedhelpers_proto_rawDesc
+)
+
+func file_sharedhelpers_sharedhelpers_proto_rawDescGZIP() []byte {
+	file_sharedhelpers_sharedhelpers_proto_rawDescOnce.Do(func() {
+		file_sharedhelpers_sharedhelpers_proto_rawDescData = protoimpl.X.CompressGZIP(file_sharedhelpers_sharedhelpers_proto_rawDescData)
+	})
+	return file_sharedhelpers_sharedhelpers_proto_rawDescData
+}
+
+var file_sharedhelpers_sharedhelpers_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_sharedhelpers_sharedhelpers_proto_goTypes = []any{
+	(*Shared)(nil), // 0: Shared
+	nil,            // 1: Shared.ChildrenEntry
+}
+var file_sharedhelpers_sharedhelpers_proto_depIdxs = []int32{
+	1, // 0: Shared.children:type_name -> Shared.ChildrenEntry
+	0, // 1: Shared.ChildrenEntry.value:type_name -> Shared
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_sharedhelpers_sharedhelpers_proto_init() }
+func file_sharedhelpers_sharedhelpers_proto_init() {
+	if File_sharedhelpers_sharedhelpers_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_sharedhelpers_sharedhelpers_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Shared); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_sharedhelpers_sharedhelpers_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_sharedhelpers_sharedhelpers_proto_goTypes,
+		DependencyIndexes: file_sharedhelpers_sharedhelpers_proto_depIdxs,
+		MessageInfos:      file_sharedhelpers_sharedhelpers_proto_msgTypes,
+	}.Build()
+	File_sharedhelpers_sharedhelpers_proto = out.File
+	file_sharedhelpers_sharedhelpers_proto_rawDesc = nil
+	file_sharedhelpers_sharedhelpers_proto_goTypes = nil
+	file_sharedhelpers_sharedhelpers_proto_depIdxs = nil
+}
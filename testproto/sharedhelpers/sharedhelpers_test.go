@@ -0,0 +1,52 @@
+package sharedhelpers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/planetscale/vtprotobuf/protohelpers"
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+func TestSharedHelpersRoundTrip(t *testing.T) {
+	msg := &Shared{
+		Name:   "root",
+		Values: []int64{-1, 0, 1, 1 << 40, -(1 << 40)},
+		Children: map[string]*Shared{
+			"child": {Name: "child", Values: []int64{42}},
+		},
+	}
+
+	expected, err := proto.Marshal(msg)
+	require.NoError(t, err)
+	require.Equal(t, len(expected), msg.SizeVT())
+
+	got, err := msg.MarshalVT()
+	require.NoError(t, err)
+
+	unmarshaled := &Shared{}
+	require.NoError(t, unmarshaled.UnmarshalVT(got))
+	require.True(t, proto.Equal(msg, unmarshaled))
+}
+
+func TestSharedHelpersErrors(t *testing.T) {
+	require.Equal(t, protohelpers.ErrInvalidLength, ErrInvalidLength)
+	require.Equal(t, protohelpers.ErrInvalidUTF8, ErrInvalidUTF8)
+	require.Equal(t, protohelpers.ErrRecursionLimitExceeded, ErrRecursionLimitExceeded)
+	require.Equal(t, vtproto.ErrBufferTooSmall, ErrBufferTooSmall)
+	require.Equal(t, vtproto.ErrSizeMismatch, ErrSizeMismatch)
+
+	// field 1, wire type 2, with a length that overflows int
+	data := []byte{0x0a, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}
+	err := (&Shared{}).UnmarshalVT(data)
+	require.True(t, errors.Is(err, protohelpers.ErrInvalidLength), "unexpected error: %v", err)
+}
+
+func TestSharedHelpersShortBuffer(t *testing.T) {
+	msg := &Shared{Name: "root", Values: []int64{1, 2, 3}}
+	_, err := msg.MarshalToVT(make([]byte, msg.SizeVT()-1))
+	require.ErrorIs(t, err, vtproto.ErrBufferTooSmall)
+}
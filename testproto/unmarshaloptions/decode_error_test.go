@@ -0,0 +1,140 @@
+package unmarshaloptions
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+// truncated returns the encoding of a Required message with a truncated id, and
+// the offset of the tag of the id in it.
+func truncated(prefix []byte) ([]byte, int) {
+	b := protowire.AppendTag(prefix, 2, protowire.BytesType)
+	b = protowire.AppendString(b, "name")
+	offset := len(b)
+	b = protowire.AppendTag(b, 1, protowire.VarintType)
+	return append(b, 0x80), offset
+}
+
+func appendMessage(b []byte, num protowire.Number, m []byte) ([]byte, int) {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	b = protowire.AppendVarint(b, uint64(len(m)))
+	return append(b, m...), len(b)
+}
+
+func requireDecodeError(t *testing.T, data []byte, target error, path string, expected vtproto.DecodeError) {
+	for _, unmarshal := range []func([]byte) error{
+		(&Parent{}).UnmarshalVT,
+		(&Parent{}).UnmarshalVTUnsafe,
+	} {
+		err := unmarshal(data)
+		require.ErrorIs(t, err, target)
+
+		var decodeErr *vtproto.DecodeError
+		require.True(t, errors.As(err, &decodeErr), "unexpected error: %v", err)
+		require.Equal(t, path, decodeErr.Path())
+		require.Equal(t, expected.Offset, decodeErr.Offset)
+		require.Equal(t, expected.FieldNumber, decodeErr.FieldNumber)
+		require.Equal(t, expected.WireType, decodeErr.WireType)
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	var name []byte
+	name = protowire.AppendTag(name, 1, protowire.BytesType)
+	name = protowire.AppendString(name, "parent")
+
+	t.Run("field", func(t *testing.T) {
+		data := protowire.AppendTag(append([]byte(nil), name...), 2, protowire.VarintType)
+		data = append(data, 0x80)
+		requireDecodeError(t, data, io.ErrUnexpectedEOF, "values", vtproto.DecodeError{
+			Offset: len(name), FieldNumber: 2, WireType: protowire.VarintType,
+		})
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		data := protowire.AppendTag(append([]byte(nil), name...), 50, protowire.BytesType)
+		data = protowire.AppendVarint(data, 10)
+		requireDecodeError(t, data, io.ErrUnexpectedEOF, "50", vtproto.DecodeError{
+			Offset: len(name), FieldNumber: 50, WireType: protowire.BytesType,
+		})
+	})
+
+	t.Run("message", func(t *testing.T) {
+		child, offset := truncated(nil)
+		data, start := appendMessage(append([]byte(nil), name...), 3, child)
+		requireDecodeError(t, data, io.ErrUnexpectedEOF, "child.id", vtproto.DecodeError{
+			Offset: start + offset, FieldNumber: 1, WireType: protowire.VarintType,
+		})
+	})
+
+	t.Run("repeated", func(t *testing.T) {
+		valid := protowire.AppendTag(nil, 1, protowire.VarintType)
+		valid = protowire.AppendVarint(valid, 1)
+		data, _ := appendMessage(append([]byte(nil), name...), 4, valid)
+		child, offset := truncated(nil)
+		data, start := appendMessage(data, 4, child)
+		requireDecodeError(t, data, io.ErrUnexpectedEOF, "children.id", vtproto.DecodeError{
+			Offset: start + offset, FieldNumber: 1, WireType: protowire.VarintType,
+		})
+	})
+
+	t.Run("map", func(t *testing.T) {
+		entry := protowire.AppendTag(nil, 1, protowire.BytesType)
+		entry = protowire.AppendString(entry, "key")
+		child, offset := truncated(nil)
+		entry, value := appendMessage(entry, 2, child)
+		data, start := appendMessage(append([]byte(nil), name...), 5, entry)
+		requireDecodeError(t, data, io.ErrUnexpectedEOF, "children_map.id", vtproto.DecodeError{
+			Offset: start + value + offset, FieldNumber: 1, WireType: protowire.VarintType,
+		})
+	})
+
+	t.Run("extension", func(t *testing.T) {
+		child, offset := truncated(nil)
+		data, start := appendMessage(append([]byte(nil), name...), 100, child)
+		requireDecodeError(t, data, io.ErrUnexpectedEOF, "[required_extension].id", vtproto.DecodeError{
+			Offset: start + offset, FieldNumber: 1, WireType: protowire.VarintType,
+		})
+	})
+
+	t.Run("required", func(t *testing.T) {
+		child := protowire.AppendTag(nil, 2, protowire.BytesType)
+		child = protowire.AppendString(child, "name")
+		data, start := appendMessage(append([]byte(nil), name...), 3, child)
+
+		err := (&Parent{}).UnmarshalVT(data)
+		var decodeErr *vtproto.DecodeError
+		require.True(t, errors.As(err, &decodeErr), "unexpected error: %v", err)
+		require.Equal(t, "child", decodeErr.Path())
+		require.Equal(t, start+len(child), decodeErr.Offset)
+		require.Equal(t, protowire.Number(3), decodeErr.FieldNumber)
+		require.Equal(t, protowire.BytesType, decodeErr.WireType)
+	})
+
+	t.Run("required extension", func(t *testing.T) {
+		child := protowire.AppendTag(nil, 2, protowire.BytesType)
+		child = protowire.AppendString(child, "name")
+		data, start := appendMessage(append([]byte(nil), name...), 100, child)
+
+		err := (&Parent{}).UnmarshalVT(data)
+		var decodeErr *vtproto.DecodeError
+		require.True(t, errors.As(err, &decodeErr), "unexpected error: %v", err)
+		require.Equal(t, "[required_extension]", decodeErr.Path())
+		require.Equal(t, start+len(child), decodeErr.Offset)
+		require.Equal(t, protowire.Number(100), decodeErr.FieldNumber)
+		require.Equal(t, protowire.BytesType, decodeErr.WireType)
+	})
+}
+
+func TestDecodeErrorMessage(t *testing.T) {
+	child, _ := truncated(nil)
+	data, _ := appendMessage(nil, 3, child)
+	err := (&Parent{}).UnmarshalVT(data)
+	require.EqualError(t, err, "unexpected EOF (field child.id, number 1, wire type 0, offset 8)")
+}
@@ -0,0 +1,310 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.20.0
+// source: unmarshaloptions/options.proto
+
+package unmarshaloptions
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Required struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   *int32  `protobuf:"varint,1,req,name=id" json:"id,omitempty"`
+	Name *string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+}
+
+func (x *Required) Reset() {
+	*x = Required{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_unmarshaloptions_options_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Required) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Required) ProtoMessage() {}
+
+func (x *Required) ProtoReflect() protoreflect.Message {
+	mi := &file_unmarshaloptions_options_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Required.ProtoReflect.Descriptor instead.
+func (*Required) Descriptor() ([]byte, []int) {
+	return file_unmarshaloptions_options_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Required) GetId() int32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *Required) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type Parent struct {
+	state           protoimpl.MessageState
+	sizeCache       protoimpl.SizeCache
+	unknownFields   protoimpl.UnknownFields
+	extensionFields protoimpl.ExtensionFields
+
+	Name        *string              `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Values      []int32              `protobuf:"varint,2,rep,name=values" json:"values,omitempty"`
+	Child       *Required            `protobuf:"bytes,3,opt,name=child" json:"child,omitempty"`
+	Children    []*Required          `protobuf:"bytes,4,rep,name=children" json:"children,omitempty"`
+	ChildrenMap map[string]*Required `protobuf:"bytes,5,rep,name=children_map,json=childrenMap" json:"children_map,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Decoded with the proto package, as it is declared in another Go package.
+	Wrapped *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=wrapped" json:"wrapped,omitempty"`
+}
+
+func (x *Parent) Reset() {
+	*x = Parent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_unmarshaloptions_options_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Parent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Parent) ProtoMessage() {}
+
+func (x *Parent) ProtoReflect() protoreflect.Message {
+	mi := &file_unmarshaloptions_options_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Parent.ProtoReflect.Descriptor instead.
+func (*Parent) Descriptor() ([]byte, []int) {
+	return file_unmarshaloptions_options_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Parent) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *Parent) GetValues() []int32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Parent) GetChild() *Required {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+func (x *Parent) GetChildren() []*Required {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *Parent) GetChildrenMap() map[string]*Required {
+	if x != nil {
+		return x.ChildrenMap
+	}
+	return nil
+}
+
+func (x *Parent) GetWrapped() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Wrapped
+	}
+	return nil
+}
+
+var file_unmarshaloptions_options_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*Parent)(nil),
+		ExtensionType: (*Required)(nil),
+		Field:         100,
+		Name:          "required_extension",
+		Tag:           "bytes,100,opt,name=required_extension",
+		Filename:      "unmarshaloptions/options.proto",
+	},
+}
+
+// Extension fields to Parent.
+var (
+	// optional Required required_extension = 100;
+	E_RequiredExtension = &file_unmarshaloptions_options_proto_extTypes[0]
+)
+
+var File_unmarshaloptions_options_proto protoreflect.FileDescriptor
+
+var file_unmarshaloptions_options_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x75, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x02, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0xc3, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x72, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12,
+	0x3b, 0x0a, 0x0c, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x5f, 0x6d, 0x61, 0x70, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x2e, 0x43,
+	0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0b, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x12, 0x36, 0x0a, 0x07,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x77, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x64, 0x1a, 0x49, 0x0a, 0x10, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
+	0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a,
+	0x05, 0x08, 0x64, 0x10, 0xc8, 0x01, 0x3a, 0x41, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x07, 0x2e, 0x50,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x1c, 0x5a, 0x1a, 0x74, 0x65, 0x73,
+	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x75, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+}
+
+var (
+	file_unmarshaloptions_options_proto_rawDescOnce sync.Once
+	file_unmarshaloptions_options_proto_rawDescData = file_unmarshaloptions_options_proto_rawDesc
+)
+
+func file_unmarshaloptions_options_proto_rawDescGZIP() []byte {
+	file_unmarshaloptions_options_proto_rawDescOnce.Do(func() {
+		file_unmarshaloptions_options_proto_rawDescData = protoimpl.X.CompressGZIP(file_unmarshaloptions_options_proto_rawDescData)
+	})
+	return file_unmarshaloptions_options_proto_rawDescData
+}
+
+var file_unmarshaloptions_options_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_unmarshaloptions_options_proto_goTypes = []any{
+	(*Required)(nil),               // 0: Required
+	(*Parent)(nil),                 // 1: Parent
+	nil,                            // 2: Parent.ChildrenMapEntry
+	(*wrapperspb.StringValue)(nil), // 3: google.protobuf.StringValue
+}
+var file_unmarshaloptions_options_proto_depIdxs = []int32{
+	0, // 0: Parent.child:type_name -> Required
+	0, // 1: Parent.children:type_name -> Required
+	2, // 2: Parent.children_map:type_name -> Parent.ChildrenMapEntry
+	3, // 3: Parent.wrapped:type_name -> google.protobuf.StringValue
+	0, // 4: Parent.ChildrenMapEntry.value:type_name -> Required
+	1, // 5: required_extension:extendee -> Parent
+	0, // 6: required_extension:type_name -> Required
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	6, // [6:7] is the sub-list for extension type_name
+	5, // [5:6] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_unmarshaloptions_options_proto_init() }
+func file_unmarshaloptions_options_proto_init() {
+	if File_unmarshaloptions_options_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_unmarshaloptions_options_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Required); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_unmarshaloptions_options_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Parent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			case 3:
+				return &v.extensionFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_unmarshaloptions_options_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_unmarshaloptions_options_proto_goTypes,
+		DependencyIndexes: file_unmarshaloptions_options_proto_depIdxs,
+		MessageInfos:      file_unmarshaloptions_options_proto_msgTypes,
+		ExtensionInfos:    file_unmarshaloptions_options_proto_extTypes,
+	}.Build()
+	File_unmarshaloptions_options_proto = out.File
+	file_unmarshaloptions_options_proto_rawDesc = nil
+	file_unmarshaloptions_options_proto_goTypes = nil
+	file_unmarshaloptions_options_proto_depIdxs = nil
+}
@@ -0,0 +1,120 @@
+package unmarshaloptions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/planetscale/vtprotobuf/vtproto"
+)
+
+// withUnknown appends an unknown field to the encoding of m.
+func withUnknown(t *testing.T, m proto.Message) []byte {
+	data, err := proto.Marshal(m)
+	require.NoError(t, err)
+	data = protowire.AppendTag(data, 50, protowire.VarintType)
+	return protowire.AppendVarint(data, 42)
+}
+
+func newParent(t *testing.T) *Parent {
+	var child, element, value, extension Required
+	require.NoError(t, proto.Unmarshal(withUnknown(t, &Required{Id: proto.Int32(1)}), &child))
+	require.NoError(t, proto.Unmarshal(withUnknown(t, &Required{Id: proto.Int32(2)}), &element))
+	require.NoError(t, proto.Unmarshal(withUnknown(t, &Required{Id: proto.Int32(3)}), &value))
+	require.NoError(t, proto.Unmarshal(withUnknown(t, &Required{Id: proto.Int32(4)}), &extension))
+	var wrapped wrapperspb.StringValue
+	require.NoError(t, proto.Unmarshal(withUnknown(t, wrapperspb.String("wrapped")), &wrapped))
+
+	m := &Parent{
+		Name:        proto.String("parent"),
+		Values:      []int32{1, 2, 3},
+		Child:       &child,
+		Children:    []*Required{&element},
+		ChildrenMap: map[string]*Required{"value": &value},
+		Wrapped:     &wrapped,
+	}
+	proto.SetExtension(m, E_RequiredExtension, &extension)
+	return m
+}
+
+func TestUnmarshalOptions(t *testing.T) {
+	data := withUnknown(t, newParent(t))
+	for _, opts := range []vtproto.UnmarshalOptions{
+		{},
+		{Merge: true},
+		{DiscardUnknown: true},
+		{Merge: true, DiscardUnknown: true},
+		{AllowPartial: true},
+	} {
+		expected := newParent(t)
+		err := proto.UnmarshalOptions{
+			Merge:          opts.Merge,
+			AllowPartial:   opts.AllowPartial,
+			DiscardUnknown: opts.DiscardUnknown,
+		}.Unmarshal(data, expected)
+		require.NoError(t, err)
+
+		m := newParent(t)
+		require.NoError(t, m.UnmarshalVTWithOptions(data, opts))
+		require.True(t, proto.Equal(expected, m), "%+v: expected %v, got %v", opts, expected, m)
+
+		m = newParent(t)
+		require.NoError(t, m.UnmarshalVTUnsafeWithOptions(data, opts))
+		require.True(t, proto.Equal(expected, m), "%+v: expected %v, got %v", opts, expected, m)
+	}
+}
+
+func TestUnmarshalOptionsDiscardUnknown(t *testing.T) {
+	m := &Parent{}
+	require.NoError(t, m.UnmarshalVTWithOptions(withUnknown(t, newParent(t)), vtproto.UnmarshalOptions{DiscardUnknown: true}))
+	require.Empty(t, m.ProtoReflect().GetUnknown())
+	require.Empty(t, m.Child.ProtoReflect().GetUnknown())
+	require.Empty(t, m.Children[0].ProtoReflect().GetUnknown())
+	require.Empty(t, m.ChildrenMap["value"].ProtoReflect().GetUnknown())
+	require.Empty(t, m.Wrapped.ProtoReflect().GetUnknown())
+
+	m = &Parent{}
+	require.NoError(t, m.UnmarshalVT(withUnknown(t, newParent(t))))
+	require.NotEmpty(t, m.ProtoReflect().GetUnknown())
+	require.NotEmpty(t, m.Child.ProtoReflect().GetUnknown())
+	require.NotEmpty(t, m.Wrapped.ProtoReflect().GetUnknown())
+}
+
+func TestUnmarshalOptionsAllowPartial(t *testing.T) {
+	for name, m := range map[string]*Parent{
+		"message":  {Child: &Required{}},
+		"repeated": {Children: []*Required{{}}},
+		"map":      {ChildrenMap: map[string]*Required{"value": {}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			data, err := proto.MarshalOptions{AllowPartial: true}.Marshal(m)
+			require.NoError(t, err)
+
+			require.Error(t, proto.Unmarshal(data, &Parent{}))
+			require.Error(t, (&Parent{}).UnmarshalVT(data))
+			require.Error(t, (&Parent{}).UnmarshalVTWithOptions(data, vtproto.UnmarshalOptions{}))
+
+			unmarshaled := &Parent{}
+			require.NoError(t, unmarshaled.UnmarshalVTWithOptions(data, vtproto.UnmarshalOptions{AllowPartial: true}))
+			require.True(t, proto.Equal(m, unmarshaled))
+		})
+	}
+}
+
+func TestUnmarshalOptionsMerge(t *testing.T) {
+	data, err := (&Parent{Values: []int32{4}, Child: &Required{Id: proto.Int32(1)}}).MarshalVT()
+	require.NoError(t, err)
+
+	m := &Parent{Name: proto.String("parent"), Values: []int32{1, 2, 3}}
+	require.NoError(t, m.UnmarshalVTWithOptions(data, vtproto.UnmarshalOptions{Merge: true}))
+	require.Equal(t, "parent", m.GetName())
+	require.Equal(t, []int32{1, 2, 3, 4}, m.Values)
+
+	require.NoError(t, m.UnmarshalVTWithOptions(data, vtproto.UnmarshalOptions{}))
+	require.Nil(t, m.Name)
+	require.Equal(t, []int32{4}, m.Values)
+	require.Equal(t, int32(1), m.Child.GetId())
+}
@@ -0,0 +1,83 @@
+package unsafe
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func newAliased() *Aliased {
+	return &Aliased{
+		StringValue:    "string",
+		BytesValue:     []byte("bytes"),
+		OptionalString: proto.String("optional string"),
+		OptionalBytes:  []byte("optional bytes"),
+		RepeatedString: []string{"repeated", ""},
+		RepeatedBytes:  [][]byte{[]byte("repeated"), {}},
+		StringMap:      map[string]string{"key": "value"},
+		BytesMap:       map[string][]byte{"key": []byte("value")},
+		Choice:         &Aliased_OneofString{OneofString: "oneof"},
+		Child:          &Child{Name: "child", Data: []byte("child data")},
+		Children:       []*Child{{Name: "first"}, {Data: []byte("second")}},
+	}
+}
+
+func TestUnmarshalVTUnsafe(t *testing.T) {
+	for _, m := range []*Aliased{
+		{},
+		newAliased(),
+		{Choice: &Aliased_OneofBytes{OneofBytes: []byte("oneof")}},
+		{OptionalString: proto.String(""), OptionalBytes: []byte{}},
+	} {
+		data, err := m.MarshalVT()
+		require.NoError(t, err)
+
+		safe := &Aliased{}
+		require.NoError(t, safe.UnmarshalVT(data))
+
+		unsafe := &Aliased{}
+		require.NoError(t, unsafe.UnmarshalVTUnsafe(data))
+		require.True(t, safe.EqualVT(unsafe))
+		require.True(t, proto.Equal(m, unsafe))
+	}
+}
+
+func TestUnmarshalVTUnsafeAliasesInput(t *testing.T) {
+	data, err := newAliased().MarshalVT()
+	require.NoError(t, err)
+
+	safe := &Aliased{}
+	require.NoError(t, safe.UnmarshalVT(data))
+	unsafe := &Aliased{}
+	require.NoError(t, unsafe.UnmarshalVTUnsafe(data))
+
+	for _, b := range [][]byte{[]byte("string"), []byte("bytes"), []byte("value"), []byte("oneof"), []byte("child")} {
+		i := bytes.Index(data, b)
+		require.GreaterOrEqual(t, i, 0)
+		data[i] = 'X'
+	}
+
+	require.Equal(t, "Xtring", unsafe.StringValue)
+	require.Equal(t, []byte("Xytes"), unsafe.BytesValue)
+	require.Equal(t, map[string]string{"key": "Xalue"}, unsafe.StringMap)
+	require.Equal(t, "Xneof", unsafe.GetOneofString())
+	require.Equal(t, "Xhild", unsafe.Child.Name)
+
+	require.True(t, proto.Equal(newAliased(), safe))
+}
+
+func TestUnmarshalVTUnsafeAppend(t *testing.T) {
+	data, err := newAliased().MarshalVT()
+	require.NoError(t, err)
+	original := append([]byte(nil), data...)
+
+	m := &Aliased{}
+	require.NoError(t, m.UnmarshalVTUnsafe(data))
+	m.BytesValue = append(m.BytesValue, "appended"...)
+	m.RepeatedBytes[0] = append(m.RepeatedBytes[0], "appended"...)
+	m.Child.Data = append(m.Child.Data, "appended"...)
+
+	require.Equal(t, original, data)
+}
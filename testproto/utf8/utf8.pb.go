@@ -0,0 +1,260 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.20.0
+// source: utf8/utf8.proto
+
+package utf8
+
+import (
+	_ "github.com/planetscale/vtprotobuf/vtproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Strings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Verified         string            `protobuf:"bytes,1,opt,name=verified,proto3" json:"verified,omitempty"`
+	VerifiedOptional *string           `protobuf:"bytes,2,opt,name=verified_optional,json=verifiedOptional,proto3,oneof" json:"verified_optional,omitempty"`
+	VerifiedList     []string          `protobuf:"bytes,3,rep,name=verified_list,json=verifiedList,proto3" json:"verified_list,omitempty"`
+	VerifiedMap      map[string]string `protobuf:"bytes,4,rep,name=verified_map,json=verifiedMap,proto3" json:"verified_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Types that are assignable to Choice:
+	//	*Strings_VerifiedOneof
+	Choice         isStrings_Choice  `protobuf_oneof:"choice"`
+	Unverified     string            `protobuf:"bytes,11,opt,name=unverified,proto3" json:"unverified,omitempty"`
+	UnverifiedList []string          `protobuf:"bytes,12,rep,name=unverified_list,json=unverifiedList,proto3" json:"unverified_list,omitempty"`
+	UnverifiedMap  map[string]string `protobuf:"bytes,13,rep,name=unverified_map,json=unverifiedMap,proto3" json:"unverified_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Strings) Reset() {
+	*x = Strings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_utf8_utf8_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Strings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Strings) ProtoMessage() {}
+
+func (x *Strings) ProtoReflect() protoreflect.Message {
+	mi := &file_utf8_utf8_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Strings.ProtoReflect.Descriptor instead.
+func (*Strings) Descriptor() ([]byte, []int) {
+	return file_utf8_utf8_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Strings) GetVerified() string {
+	if x != nil {
+		return x.Verified
+	}
+	return ""
+}
+
+func (x *Strings) GetVerifiedOptional() string {
+	if x != nil && x.VerifiedOptional != nil {
+		return *x.VerifiedOptional
+	}
+	return ""
+}
+
+func (x *Strings) GetVerifiedList() []string {
+	if x != nil {
+		return x.VerifiedList
+	}
+	return nil
+}
+
+func (x *Strings) GetVerifiedMap() map[string]string {
+	if x != nil {
+		return x.VerifiedMap
+	}
+	return nil
+}
+
+func (m *Strings) GetChoice() isStrings_Choice {
+	if m != nil {
+		return m.Choice
+	}
+	return nil
+}
+
+func (x *Strings) GetVerifiedOneof() string {
+	if x, ok := x.GetChoice().(*Strings_VerifiedOneof); ok {
+		return x.VerifiedOneof
+	}
+	return ""
+}
+
+func (x *Strings) GetUnverified() string {
+	if x != nil {
+		return x.Unverified
+	}
+	return ""
+}
+
+func (x *Strings) GetUnverifiedList() []string {
+	if x != nil {
+		return x.UnverifiedList
+	}
+	return nil
+}
+
+func (x *Strings) GetUnverifiedMap() map[string]string {
+	if x != nil {
+		return x.UnverifiedMap
+	}
+	return nil
+}
+
+type isStrings_Choice interface {
+	isStrings_Choice()
+}
+
+type Strings_VerifiedOneof struct {
+	VerifiedOneof string `protobuf:"bytes,5,opt,name=verified_oneof,json=verifiedOneof,proto3,oneof"`
+}
+
+func (*Strings_VerifiedOneof) isStrings_Choice() {}
+
+var File_utf8_utf8_proto protoreflect.FileDescriptor
+
+var file_utf8_utf8_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x75, 0x74, 0x66, 0x38, 0x2f, 0x75, 0x74, 0x66, 0x38, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x6c,
+	0x61, 0x6e, 0x65, 0x74, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x2f, 0x76, 0x74, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x04, 0x0a, 0x07, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x30,
+	0x0a, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x10, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x88, 0x01, 0x01,
+	0x12, 0x23, 0x0a, 0x0d, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x5f, 0x6d, 0x61, 0x70, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4d, 0x61,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x4d, 0x61, 0x70, 0x12, 0x27, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x24, 0x0a, 0x0a,
+	0x75, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0xe8, 0xe4, 0x1f, 0x01, 0x52, 0x0a, 0x75, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x12, 0x2d, 0x0a, 0x0f, 0x75, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x42, 0x04, 0xe8, 0xe4, 0x1f,
+	0x01, 0x52, 0x0e, 0x75, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x48, 0x0a, 0x0e, 0x75, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f,
+	0x6d, 0x61, 0x70, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x73, 0x2e, 0x55, 0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4d, 0x61,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xe8, 0xe4, 0x1f, 0x01, 0x52, 0x0d, 0x75, 0x6e,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4d, 0x61, 0x70, 0x1a, 0x3e, 0x0a, 0x10, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x12, 0x55,
+	0x6e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x08, 0x0a,
+	0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x10, 0x5a,
+	0x0e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x75, 0x74, 0x66, 0x38, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_utf8_utf8_proto_rawDescOnce sync.Once
+	file_utf8_utf8_proto_rawDescData = file_utf8_utf8_proto_rawDesc
+)
+
+func file_utf8_utf8_proto_rawDescGZIP() []byte {
+	file_utf8_utf8_proto_rawDescOnce.Do(func() {
+		file_utf8_utf8_proto_rawDescData = protoimpl.X.CompressGZIP(file_utf8_utf8_proto_rawDescData)
+	})
+	return file_utf8_utf8_proto_rawDescData
+}
+
+var file_utf8_utf8_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_utf8_utf8_proto_goTypes = []any{
+	(*Strings)(nil), // 0: Strings
+	nil,             // 1: Strings.VerifiedMapEntry
+	nil,             // 2: Strings.UnverifiedMapEntry
+}
+var file_utf8_utf8_proto_depIdxs = []int32{
+	1, // 0: Strings.verified_map:type_name -> Strings.VerifiedMapEntry
+	2, // 1: Strings.unverified_map:type_name -> Strings.UnverifiedMapEntry
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_utf8_utf8_proto_init() }
+func file_utf8_utf8_proto_init() {
+	if File_utf8_utf8_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_utf8_utf8_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Strings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_utf8_utf8_proto_msgTypes[0].OneofWrappers = []any{
+		(*Strings_VerifiedOneof)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_utf8_utf8_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_utf8_utf8_proto_goTypes,
+		DependencyIndexes: file_utf8_utf8_proto_depIdxs,
+		MessageInfos:      file_utf8_utf8_proto_msgTypes,
+	}.Build()
+	File_utf8_utf8_proto = out.File
+	file_utf8_utf8_proto_rawDesc = nil
+	file_utf8_utf8_proto_goTypes = nil
+	file_utf8_utf8_proto_depIdxs = nil
+}
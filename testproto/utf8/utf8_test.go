@@ -0,0 +1,71 @@
+package utf8
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+const invalid = "\xff\xfe"
+
+func appendString(b []byte, num protowire.Number, s string) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, s)
+}
+
+func appendMapEntry(b []byte, num protowire.Number, key, value string) []byte {
+	var entry []byte
+	entry = appendString(entry, 1, key)
+	entry = appendString(entry, 2, value)
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, entry)
+}
+
+func TestUTF8Validation(t *testing.T) {
+	for name, data := range map[string][]byte{
+		"singular":  appendString(nil, 1, invalid),
+		"optional":  appendString(nil, 2, invalid),
+		"repeated":  appendString(appendString(nil, 3, "valid"), 3, invalid),
+		"map key":   appendMapEntry(nil, 4, invalid, "valid"),
+		"map value": appendMapEntry(nil, 4, "valid", invalid),
+		"oneof":     appendString(nil, 5, invalid),
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.Error(t, proto.Unmarshal(data, &Strings{}))
+
+			err := (&Strings{}).UnmarshalVT(data)
+			require.ErrorIs(t, err, ErrInvalidUTF8)
+		})
+	}
+}
+
+func TestUTF8ValidationValid(t *testing.T) {
+	m := &Strings{
+		Verified:         "héllo",
+		VerifiedOptional: proto.String("wörld"),
+		VerifiedList:     []string{"ü", ""},
+		VerifiedMap:      map[string]string{"ключ": "значение"},
+		Choice:           &Strings_VerifiedOneof{VerifiedOneof: "☃"},
+	}
+	data, err := m.MarshalVT()
+	require.NoError(t, err)
+
+	unmarshaled := &Strings{}
+	require.NoError(t, unmarshaled.UnmarshalVT(data))
+	require.True(t, m.EqualVT(unmarshaled))
+}
+
+func TestSkipUTF8Validation(t *testing.T) {
+	var data []byte
+	data = appendString(data, 11, invalid)
+	data = appendString(data, 12, invalid)
+	data = appendMapEntry(data, 13, invalid, invalid)
+
+	m := &Strings{}
+	require.NoError(t, m.UnmarshalVT(data))
+	require.Equal(t, invalid, m.Unverified)
+	require.Equal(t, []string{invalid}, m.UnverifiedList)
+	require.Equal(t, map[string]string{invalid: invalid}, m.UnverifiedMap)
+}
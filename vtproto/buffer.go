@@ -0,0 +1,105 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vtproto
+
+import (
+	"math/bits"
+	"sync"
+)
+
+const (
+	// minBufferShift and maxBufferShift bound the size classes of the buffer pool:
+	// the buffers have a capacity of 1<<shift bytes, and longer ones are not pooled.
+	minBufferShift = 6
+	maxBufferShift = 24
+)
+
+var bufferPools [maxBufferShift - minBufferShift + 1]sync.Pool
+
+func init() {
+	for i := range bufferPools {
+		size := 1 << (i + minBufferShift)
+		bufferPools[i].New = func() interface{} {
+			buf := make([]byte, size)
+			return &buf
+		}
+	}
+}
+
+// bufferClass returns the index in bufferPools of the smallest size class holding
+// length bytes, or -1 if length is too large to be pooled.
+func bufferClass(length int) int {
+	if length <= 1<<minBufferShift {
+		return 0
+	}
+	shift := bits.Len(uint(length - 1))
+	if shift > maxBufferShift {
+		return -1
+	}
+	return shift - minBufferShift
+}
+
+// GetBuffer returns a byte slice of the given length from a pool of buffers sorted
+// by size class. Its contents are undefined. It must be returned to the pool with
+// PutBuffer once it is no longer used.
+func GetBuffer(length int) *[]byte {
+	class := bufferClass(length)
+	if class < 0 {
+		buf := make([]byte, length)
+		return &buf
+	}
+	buf := bufferPools[class].Get().(*[]byte)
+	*buf = (*buf)[:length]
+	return buf
+}
+
+// PutBuffer returns a buffer obtained from GetBuffer to the pool. The buffer must
+// not be used afterwards.
+func PutBuffer(buf *[]byte) {
+	c := cap(*buf)
+	class := bufferClass(c)
+	if class < 0 || c != 1<<(class+minBufferShift) {
+		// the buffer was too large to be pooled
+		return
+	}
+	bufferPools[class].Put(buf)
+}
+
+// Buffer holds a message marshalled by the generated MarshalVTPooled methods into a
+// buffer taken from the pool of GetBuffer. It must be released with Free once the
+// encoded message is no longer used. A Buffer is used through a pointer, so that
+// freeing it a second time does nothing.
+type Buffer struct {
+	buf *[]byte
+}
+
+// NewBuffer returns a Buffer of the given length from the pool of GetBuffer.
+func NewBuffer(length int) *Buffer {
+	return &Buffer{buf: GetBuffer(length)}
+}
+
+// Bytes returns the contents of the buffer, or nil once it has been freed. They
+// must not be used after Free.
+func (b *Buffer) Bytes() []byte {
+	if b == nil || b.buf == nil {
+		return nil
+	}
+	return *b.buf
+}
+
+// Len returns the length of the buffer.
+func (b *Buffer) Len() int {
+	return len(b.Bytes())
+}
+
+// Free returns the buffer to the pool, and Bytes must not be used afterwards.
+// Calling Free on a nil or already freed Buffer does nothing.
+func (b *Buffer) Free() {
+	if b == nil || b.buf == nil {
+		return
+	}
+	PutBuffer(b.buf)
+	b.buf = nil
+}
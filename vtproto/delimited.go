@@ -0,0 +1,150 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vtproto
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultMaxDelimitedSize is the default DelimitedReader.MaxSize, like in protodelim.
+const defaultMaxDelimitedSize = 4 << 20
+
+// DelimitedWriter writes messages to an io.Writer as varint size-delimited records,
+// in the format of the protodelim package. The buffer holding a record is reused
+// for the following ones.
+type DelimitedWriter struct {
+	w   io.Writer
+	buf []byte
+}
+
+// NewDelimitedWriter returns a DelimitedWriter writing to w.
+func NewDelimitedWriter(w io.Writer) *DelimitedWriter {
+	return &DelimitedWriter{w: w}
+}
+
+// WriteMsg writes m as a single record, with a single call to the Write method of
+// the underlying io.Writer. Messages with the generated SizeVT and
+// MarshalToSizedBufferVT methods are marshalled with them, any other message
+// with proto.Marshal.
+func (w *DelimitedWriter) WriteMsg(m proto.Message) error {
+	buf := w.buf[:0]
+	if vt, ok := m.(interface {
+		SizeVT() int
+		MarshalToSizedBufferVT(dAtA []byte) (int, error)
+	}); ok {
+		size := vt.SizeVT()
+		buf = protowire.AppendVarint(buf, uint64(size))
+		l := len(buf)
+		if cap(buf) < l+size {
+			buf = append(buf, make([]byte, size)...)
+		} else {
+			buf = buf[:l+size]
+		}
+		n, err := vt.MarshalToSizedBufferVT(buf[l:])
+		if err != nil {
+			return err
+		}
+		if n != size {
+			return ErrSizeMismatch
+		}
+	} else {
+		opts := proto.MarshalOptions{UseCachedSize: true}
+		buf = protowire.AppendVarint(buf, uint64(opts.Size(m)))
+		var err error
+		buf, err = opts.MarshalAppend(buf, m)
+		if err != nil {
+			return err
+		}
+	}
+	w.buf = buf
+	_, err := w.w.Write(buf)
+	return err
+}
+
+// DelimitedReader reads varint size-delimited records, in the format of the
+// protodelim package, into messages. The buffer holding a record is reused for
+// the following ones.
+type DelimitedReader struct {
+	r   protodelim.Reader
+	buf []byte
+
+	// MaxSize is the maximum size of a record, not including its size prefix.
+	// Reading a larger record returns a *protodelim.SizeTooLargeError. A zero
+	// MaxSize defaults to 4 MiB, and -1 disables the limit.
+	MaxSize int
+}
+
+// NewDelimitedReader returns a DelimitedReader reading from r, which is wrapped
+// in a bufio.Reader unless it is an io.ByteReader already.
+func NewDelimitedReader(r io.Reader) *DelimitedReader {
+	br, ok := r.(protodelim.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	return &DelimitedReader{r: br}
+}
+
+// ReadMsg resets m and reads the next record into it. Messages with the generated
+// UnmarshalVT method are unmarshalled with it, any other message with
+// proto.Unmarshal. As the buffer holding the record is reused, ReadMsg must not be
+// used with methods aliasing their input, such as UnmarshalVTUnsafe.
+//
+// Like protodelim.UnmarshalFrom, ReadMsg returns io.EOF only if the reader is at
+// the end of its input, and io.ErrUnexpectedEOF if it ends within a record.
+func (r *DelimitedReader) ReadMsg(m proto.Message) error {
+	var prefix [binary.MaxVarintLen64]byte
+	n := 0
+	for n < len(prefix) {
+		b, err := r.r.ReadByte()
+		if err != nil {
+			if err == io.EOF && n > 0 {
+				return io.ErrUnexpectedEOF
+			}
+			return err
+		}
+		prefix[n] = b
+		n++
+		if b < 0x80 {
+			break
+		}
+	}
+	size, n := protowire.ConsumeVarint(prefix[:n])
+	if n < 0 {
+		return protowire.ParseError(n)
+	}
+
+	maxSize := r.MaxSize
+	if maxSize == 0 {
+		maxSize = defaultMaxDelimitedSize
+	}
+	if maxSize != -1 && size > uint64(maxSize) {
+		return &protodelim.SizeTooLargeError{Size: size, MaxSize: uint64(maxSize)}
+	}
+
+	if uint64(cap(r.buf)) < size {
+		r.buf = make([]byte, size)
+	}
+	buf := r.buf[:size]
+	if _, err := io.ReadFull(r.r, buf); err != nil {
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+
+	proto.Reset(m)
+	if vt, ok := m.(interface {
+		UnmarshalVT(dAtA []byte) error
+	}); ok {
+		return vt.UnmarshalVT(buf)
+	}
+	return proto.Unmarshal(buf, m)
+}
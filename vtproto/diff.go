@@ -0,0 +1,61 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vtproto
+
+import "fmt"
+
+// DiffKind is the kind of a FieldDiff.
+type DiffKind int
+
+const (
+	// FieldChanged means that the field has a different value in both messages.
+	FieldChanged DiffKind = iota
+	// FieldAdded means that the field is only set in the new message, or that a
+	// list element or a map entry only exists in it.
+	FieldAdded
+	// FieldRemoved means that the field is only set in the old message, or that
+	// a list element or a map entry only exists in it.
+	FieldRemoved
+)
+
+func (k DiffKind) String() string {
+	switch k {
+	case FieldChanged:
+		return "changed"
+	case FieldAdded:
+		return "added"
+	case FieldRemoved:
+		return "removed"
+	default:
+		return fmt.Sprintf("DiffKind(%d)", int(k))
+	}
+}
+
+// FieldDiff is a difference between two messages, as returned by the generated
+// DiffVT methods.
+type FieldDiff struct {
+	// Path is the path of the field from the root message, e.g.
+	// "children[2].values[\"key\"].name". Extensions are named by their full
+	// name in brackets, and the unknown fields of a message by "<unknown>".
+	// The path is empty when one of the root messages is nil.
+	Path string
+	// Kind is the kind of the difference.
+	Kind DiffKind
+	// Old is the value in the old message, or nil when the field was added.
+	Old interface{}
+	// New is the value in the new message, or nil when the field was removed.
+	New interface{}
+}
+
+func (d FieldDiff) String() string {
+	switch d.Kind {
+	case FieldAdded:
+		return fmt.Sprintf("%s %s: %v", d.Kind, d.Path, d.New)
+	case FieldRemoved:
+		return fmt.Sprintf("%s %s: %v", d.Kind, d.Path, d.Old)
+	default:
+		return fmt.Sprintf("%s %s: %v -> %v", d.Kind, d.Path, d.Old, d.New)
+	}
+}
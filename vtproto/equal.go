@@ -0,0 +1,56 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vtproto
+
+import (
+	"math"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// EqualOptions configures the generated EqualVTWithOptions methods. The zero value
+// compares messages like EqualVT does, and the options apply to all the messages
+// nested in the ones being compared that implement EqualVTWithOptions.
+type EqualOptions struct {
+	// IgnoreUnknown ignores the unknown fields of the messages. Otherwise, the
+	// unknown fields must be the same for the messages to be equal.
+	IgnoreUnknown bool
+
+	// NaNEqual makes NaN values equal to each other. Otherwise, like with ==,
+	// NaN is not equal to any value.
+	NaNEqual bool
+
+	// FloatTolerance is the maximum absolute difference between floating point
+	// values that are considered equal. If zero, they must be exactly equal.
+	FloatTolerance float64
+}
+
+// EqualFloat64 reports whether x and y are equal according to the options.
+func (o EqualOptions) EqualFloat64(x, y float64) bool {
+	if x == y {
+		return true
+	}
+	if math.IsNaN(x) || math.IsNaN(y) {
+		return o.NaNEqual && math.IsNaN(x) && math.IsNaN(y)
+	}
+	return o.FloatTolerance > 0 && math.Abs(x-y) <= o.FloatTolerance
+}
+
+// EqualFloat32 reports whether x and y are equal according to the options.
+func (o EqualOptions) EqualFloat32(x, y float32) bool {
+	if x == y {
+		return true
+	}
+	return o.EqualFloat64(float64(x), float64(y))
+}
+
+// Equal reports whether the messages x and y are equal. It calls the EqualMessageVT
+// method generated for x if there is one, and falls back to proto.Equal otherwise.
+func Equal(x, y proto.Message) bool {
+	if x, ok := x.(interface{ EqualMessageVT(proto.Message) bool }); ok {
+		return x.EqualMessageVT(y)
+	}
+	return proto.Equal(x, y)
+}
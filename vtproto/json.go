@@ -0,0 +1,576 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vtproto
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// JSONMarshalOptions configures the generated MarshalJSONToVT methods. Its fields
+// have the same meaning as in protojson.MarshalOptions, and they apply to all the
+// messages nested in the one being marshalled.
+type JSONMarshalOptions struct {
+	// AllowPartial allows messages that are missing required fields to be
+	// marshalled. Otherwise, an error is returned if a required field is not set.
+	AllowPartial bool
+
+	// UseProtoNames uses the field names as declared in the .proto file instead
+	// of their lowerCamelCase JSON names.
+	UseProtoNames bool
+
+	// EmitUnpopulated emits the fields that are not set with their default
+	// value, or null for the singular messages and the proto2 scalars. The
+	// members of oneofs, including proto3 optional fields, are never emitted
+	// when they are not set.
+	EmitUnpopulated bool
+}
+
+// Marshal returns the JSON encoding of m, which is the same as the one returned
+// by protojson.MarshalOptions with the same options, except that the output is
+// never randomized. It calls the MarshalJSONToVT method generated for m if there
+// is one, and falls back to protojson otherwise.
+func (o JSONMarshalOptions) Marshal(m proto.Message) ([]byte, error) {
+	if !o.AllowPartial {
+		if err := proto.CheckInitialized(m); err != nil {
+			return nil, err
+		}
+	}
+	w := NewJSONWriter(o)
+	if err := w.Message(m); err != nil {
+		return nil, err
+	}
+	return w.Bytes(), nil
+}
+
+// JSONWriter writes the JSON encoding of messages for the generated MarshalJSONToVT
+// methods. Its methods take care of the separators between values, so that the
+// generated code only has to write the names and values.
+type JSONWriter struct {
+	opts  JSONMarshalOptions
+	buf   []byte
+	comma bool
+}
+
+// NewJSONWriter returns a JSONWriter with an empty buffer.
+func NewJSONWriter(opts JSONMarshalOptions) *JSONWriter {
+	return &JSONWriter{opts: opts}
+}
+
+// Options returns the options of the writer.
+func (w *JSONWriter) Options() JSONMarshalOptions {
+	return w.opts
+}
+
+// Bytes returns the JSON written so far.
+func (w *JSONWriter) Bytes() []byte {
+	return w.buf
+}
+
+func (w *JSONWriter) next() {
+	if w.comma {
+		w.buf = append(w.buf, ',')
+	}
+	w.comma = true
+}
+
+// ObjectStart starts a JSON object.
+func (w *JSONWriter) ObjectStart() {
+	w.next()
+	w.buf = append(w.buf, '{')
+	w.comma = false
+}
+
+// ObjectEnd ends the current JSON object.
+func (w *JSONWriter) ObjectEnd() {
+	w.buf = append(w.buf, '}')
+	w.comma = true
+}
+
+// ArrayStart starts a JSON array.
+func (w *JSONWriter) ArrayStart() {
+	w.next()
+	w.buf = append(w.buf, '[')
+	w.comma = false
+}
+
+// ArrayEnd ends the current JSON array.
+func (w *JSONWriter) ArrayEnd() {
+	w.buf = append(w.buf, ']')
+	w.comma = true
+}
+
+// Name writes the name of the next member of the current object.
+func (w *JSONWriter) Name(name string) error {
+	w.next()
+	var err error
+	w.buf, err = appendJSONString(w.buf, name)
+	w.buf = append(w.buf, ':')
+	w.comma = false
+	return err
+}
+
+// Field writes the name of the next member of the current object, which holds
+// a field with the given JSON and proto names, depending on UseProtoNames.
+func (w *JSONWriter) Field(jsonName, protoName string) {
+	if w.opts.UseProtoNames {
+		w.Name(protoName)
+	} else {
+		w.Name(jsonName)
+	}
+}
+
+// Null writes a null value.
+func (w *JSONWriter) Null() {
+	w.next()
+	w.buf = append(w.buf, "null"...)
+}
+
+// Bool writes a bool value.
+func (w *JSONWriter) Bool(v bool) {
+	w.next()
+	w.buf = strconv.AppendBool(w.buf, v)
+}
+
+// Int32 writes a 32-bit signed integer value as a number.
+func (w *JSONWriter) Int32(v int32) {
+	w.next()
+	w.buf = strconv.AppendInt(w.buf, int64(v), 10)
+}
+
+// Uint32 writes a 32-bit unsigned integer value as a number.
+func (w *JSONWriter) Uint32(v uint32) {
+	w.next()
+	w.buf = strconv.AppendUint(w.buf, uint64(v), 10)
+}
+
+// Int64 writes a 64-bit signed integer value as a string.
+func (w *JSONWriter) Int64(v int64) {
+	w.next()
+	w.buf = append(w.buf, '"')
+	w.buf = strconv.AppendInt(w.buf, v, 10)
+	w.buf = append(w.buf, '"')
+}
+
+// Uint64 writes a 64-bit unsigned integer value as a string.
+func (w *JSONWriter) Uint64(v uint64) {
+	w.next()
+	w.buf = append(w.buf, '"')
+	w.buf = strconv.AppendUint(w.buf, v, 10)
+	w.buf = append(w.buf, '"')
+}
+
+// Float32 writes a float value.
+func (w *JSONWriter) Float32(v float32) {
+	w.next()
+	w.buf = appendJSONFloat(w.buf, float64(v), 32)
+}
+
+// Float64 writes a double value.
+func (w *JSONWriter) Float64(v float64) {
+	w.next()
+	w.buf = appendJSONFloat(w.buf, v, 64)
+}
+
+// String writes a string value. It fails if v is not valid UTF-8.
+func (w *JSONWriter) String(v string) error {
+	w.next()
+	var err error
+	w.buf, err = appendJSONString(w.buf, v)
+	return err
+}
+
+// Base64 writes a bytes value as a base64 string.
+func (w *JSONWriter) Base64(v []byte) {
+	w.next()
+	w.buf = append(w.buf, '"')
+	n := len(w.buf)
+	w.buf = append(w.buf, make([]byte, base64.StdEncoding.EncodedLen(len(v)))...)
+	base64.StdEncoding.Encode(w.buf[n:], v)
+	w.buf = append(w.buf, '"')
+}
+
+// Enum writes an enum value by its name in names, which is the _name map generated
+// for the enum, or as a number if it is not a known value.
+func (w *JSONWriter) Enum(v int32, names map[int32]string) {
+	if name, ok := names[v]; ok {
+		w.String(name)
+		return
+	}
+	w.Int32(v)
+}
+
+// Raw writes a value that is already encoded as JSON.
+func (w *JSONWriter) Raw(v []byte) {
+	w.next()
+	w.buf = append(w.buf, v...)
+}
+
+// Message writes the message m. The well-known types are written in their special
+// JSON form, the messages with a MarshalJSONToVT method with it, and all others
+// with protojson.
+func (w *JSONWriter) Message(m proto.Message) error {
+	switch m := m.(type) {
+	case interface{ MarshalJSONToVT(*JSONWriter) error }:
+		return m.MarshalJSONToVT(w)
+	case *timestamppb.Timestamp:
+		return w.timestamp(m)
+	case *durationpb.Duration:
+		return w.duration(m)
+	case *structpb.Struct:
+		return w.structValue(m)
+	case *structpb.ListValue:
+		return w.listValue(m)
+	case *structpb.Value:
+		return w.value(m)
+	case *emptypb.Empty:
+		w.ObjectStart()
+		w.ObjectEnd()
+	case *wrapperspb.DoubleValue:
+		w.Float64(m.GetValue())
+	case *wrapperspb.FloatValue:
+		w.Float32(m.GetValue())
+	case *wrapperspb.Int64Value:
+		w.Int64(m.GetValue())
+	case *wrapperspb.UInt64Value:
+		w.Uint64(m.GetValue())
+	case *wrapperspb.Int32Value:
+		w.Int32(m.GetValue())
+	case *wrapperspb.UInt32Value:
+		w.Uint32(m.GetValue())
+	case *wrapperspb.BoolValue:
+		w.Bool(m.GetValue())
+	case *wrapperspb.StringValue:
+		return w.String(m.GetValue())
+	case *wrapperspb.BytesValue:
+		w.Base64(m.GetValue())
+	default:
+		b, err := protojson.MarshalOptions{
+			AllowPartial:    true, // required fields are checked by Marshal
+			UseProtoNames:   w.opts.UseProtoNames,
+			EmitUnpopulated: w.opts.EmitUnpopulated,
+		}.Marshal(m)
+		if err != nil {
+			return err
+		}
+		// protojson randomly adds whitespace to its output
+		w.next()
+		buf := bytes.NewBuffer(w.buf)
+		if err := json.Compact(buf, b); err != nil {
+			return err
+		}
+		w.buf = buf.Bytes()
+	}
+	return nil
+}
+
+// Value writes the value v of the field fd with reflection. It is used for the
+// fields whose type is not known at generation time, such as extensions.
+func (w *JSONWriter) Value(fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	switch {
+	case fd.IsList():
+		list := v.List()
+		w.ArrayStart()
+		for i := 0; i < list.Len(); i++ {
+			if err := w.singular(fd, list.Get(i)); err != nil {
+				return err
+			}
+		}
+		w.ArrayEnd()
+		return nil
+	case fd.IsMap():
+		return w.mapValue(fd, v.Map())
+	default:
+		return w.singular(fd, v)
+	}
+}
+
+func (w *JSONWriter) singular(fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		w.Bool(v.Bool())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		w.Int32(int32(v.Int()))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		w.Uint32(uint32(v.Uint()))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		w.Int64(v.Int())
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		w.Uint64(v.Uint())
+	case protoreflect.FloatKind:
+		w.Float32(float32(v.Float()))
+	case protoreflect.DoubleKind:
+		w.Float64(v.Float())
+	case protoreflect.StringKind:
+		if err := w.String(v.String()); err != nil {
+			return fmt.Errorf("proto: field %v contains invalid UTF-8", fd.FullName())
+		}
+	case protoreflect.BytesKind:
+		w.Base64(v.Bytes())
+	case protoreflect.EnumKind:
+		if fd.Enum().FullName() == "google.protobuf.NullValue" {
+			w.Null()
+		} else if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			w.String(string(ev.Name()))
+		} else {
+			w.Int32(int32(v.Enum()))
+		}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return w.Message(v.Message().Interface())
+	default:
+		return fmt.Errorf("proto: %v has unknown kind %v", fd.FullName(), fd.Kind())
+	}
+	return nil
+}
+
+func (w *JSONWriter) mapValue(fd protoreflect.FieldDescriptor, m protoreflect.Map) error {
+	keys := make([]protoreflect.MapKey, 0, m.Len())
+	m.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	sort.Slice(keys, func(i, j int) bool {
+		switch x, y := keys[i].Interface(), keys[j].Interface(); x := x.(type) {
+		case bool:
+			return !x && y.(bool)
+		case int32, int64:
+			return keys[i].Int() < keys[j].Int()
+		case uint32, uint64:
+			return keys[i].Uint() < keys[j].Uint()
+		default:
+			return keys[i].String() < keys[j].String()
+		}
+	})
+	w.ObjectStart()
+	for _, k := range keys {
+		if err := w.Name(k.String()); err != nil {
+			return fmt.Errorf("proto: field %v contains invalid UTF-8", fd.FullName())
+		}
+		if err := w.singular(fd.MapValue(), m.Get(k)); err != nil {
+			return err
+		}
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+const (
+	minTimestampSeconds  = -62135596800 // 0001-01-01T00:00:00Z
+	maxTimestampSeconds  = 253402300799 // 9999-12-31T23:59:59Z
+	maxDurationSeconds   = 315576000000 // 10000 years
+	nanosecondsPerSecond = 1e9
+)
+
+func (w *JSONWriter) timestamp(m *timestamppb.Timestamp) error {
+	secs, nanos := m.GetSeconds(), m.GetNanos()
+	if secs < minTimestampSeconds || secs > maxTimestampSeconds {
+		return fmt.Errorf("proto: google.protobuf.Timestamp: seconds out of range %v", secs)
+	}
+	if nanos < 0 || nanos >= nanosecondsPerSecond {
+		return fmt.Errorf("proto: google.protobuf.Timestamp: nanos out of range %v", nanos)
+	}
+	w.next()
+	w.buf = append(w.buf, '"')
+	w.buf = time.Unix(secs, int64(nanos)).UTC().AppendFormat(w.buf, "2006-01-02T15:04:05")
+	w.buf = appendNanos(w.buf, nanos)
+	w.buf = append(w.buf, `Z"`...)
+	return nil
+}
+
+func (w *JSONWriter) duration(m *durationpb.Duration) error {
+	secs, nanos := m.GetSeconds(), m.GetNanos()
+	if secs < -maxDurationSeconds || secs > maxDurationSeconds {
+		return fmt.Errorf("proto: google.protobuf.Duration: seconds out of range %v", secs)
+	}
+	if nanos <= -nanosecondsPerSecond || nanos >= nanosecondsPerSecond {
+		return fmt.Errorf("proto: google.protobuf.Duration: nanos out of range %v", nanos)
+	}
+	if (secs > 0 && nanos < 0) || (secs < 0 && nanos > 0) {
+		return errors.New("proto: google.protobuf.Duration: signs of seconds and nanos do not match")
+	}
+	w.next()
+	w.buf = append(w.buf, '"')
+	if secs < 0 || nanos < 0 {
+		w.buf = append(w.buf, '-')
+		secs, nanos = -secs, -nanos
+	}
+	w.buf = strconv.AppendInt(w.buf, secs, 10)
+	w.buf = appendNanos(w.buf, nanos)
+	w.buf = append(w.buf, `s"`...)
+	return nil
+}
+
+// appendNanos appends the fractional part of a Timestamp or a Duration, with 0, 3,
+// 6 or 9 digits like protojson.
+func appendNanos(b []byte, nanos int32) []byte {
+	if nanos == 0 {
+		return b
+	}
+	digits := 9
+	for digits > 3 && nanos%1000 == 0 {
+		nanos /= 1000
+		digits -= 3
+	}
+	s := strconv.Itoa(int(nanos))
+	b = append(b, '.')
+	b = append(b, strings.Repeat("0", digits-len(s))...)
+	return append(b, s...)
+}
+
+func (w *JSONWriter) structValue(m *structpb.Struct) error {
+	fields := m.GetFields()
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	w.ObjectStart()
+	for _, k := range keys {
+		if err := w.Name(k); err != nil {
+			return errors.New("proto: field google.protobuf.Struct.fields contains invalid UTF-8")
+		}
+		if err := w.value(fields[k]); err != nil {
+			return err
+		}
+	}
+	w.ObjectEnd()
+	return nil
+}
+
+func (w *JSONWriter) listValue(m *structpb.ListValue) error {
+	w.ArrayStart()
+	for _, v := range m.GetValues() {
+		if err := w.value(v); err != nil {
+			return err
+		}
+	}
+	w.ArrayEnd()
+	return nil
+}
+
+func (w *JSONWriter) value(m *structpb.Value) error {
+	switch k := m.GetKind().(type) {
+	case *structpb.Value_NullValue:
+		w.Null()
+	case *structpb.Value_NumberValue:
+		if math.IsNaN(k.NumberValue) || math.IsInf(k.NumberValue, 0) {
+			return fmt.Errorf("proto: google.protobuf.Value.number_value: invalid %v value", k.NumberValue)
+		}
+		w.Float64(k.NumberValue)
+	case *structpb.Value_StringValue:
+		if err := w.String(k.StringValue); err != nil {
+			return errors.New("proto: field google.protobuf.Value.string_value contains invalid UTF-8")
+		}
+	case *structpb.Value_BoolValue:
+		w.Bool(k.BoolValue)
+	case *structpb.Value_StructValue:
+		return w.structValue(k.StructValue)
+	case *structpb.Value_ListValue:
+		return w.listValue(k.ListValue)
+	default:
+		return errors.New("proto: google.protobuf.Value: none of the oneof fields is set")
+	}
+	return nil
+}
+
+var errInvalidUTF8 = errors.New("proto: string contains invalid UTF-8")
+
+// appendJSONString appends the JSON string s to b, escaping the same characters
+// as protojson does.
+func appendJSONString(b []byte, s string) ([]byte, error) {
+	b = append(b, '"')
+	for len(s) > 0 {
+		i := 0
+		for i < len(s) {
+			if c := s[i]; c < utf8.RuneSelf {
+				if c < ' ' || c == '"' || c == '\\' {
+					break
+				}
+				i++
+				continue
+			}
+			r, n := utf8.DecodeRuneInString(s[i:])
+			if r == utf8.RuneError && n == 1 {
+				return b, errInvalidUTF8
+			}
+			i += n
+		}
+		b, s = append(b, s[:i]...), s[i:]
+		if len(s) == 0 {
+			break
+		}
+
+		b = append(b, '\\')
+		switch c := s[0]; c {
+		case '"', '\\':
+			b = append(b, c)
+		case '\b':
+			b = append(b, 'b')
+		case '\f':
+			b = append(b, 'f')
+		case '\n':
+			b = append(b, 'n')
+		case '\r':
+			b = append(b, 'r')
+		case '\t':
+			b = append(b, 't')
+		default:
+			b = append(b, 'u')
+			b = append(b, "0000"[1+(bits.Len32(uint32(c))-1)/4:]...)
+			b = strconv.AppendUint(b, uint64(c), 16)
+		}
+		s = s[1:]
+	}
+	return append(b, '"'), nil
+}
+
+// appendJSONFloat appends the JSON number n to b, formatted like protojson does.
+func appendJSONFloat(b []byte, n float64, bitSize int) []byte {
+	switch {
+	case math.IsNaN(n):
+		return append(b, `"NaN"`...)
+	case math.IsInf(n, +1):
+		return append(b, `"Infinity"`...)
+	case math.IsInf(n, -1):
+		return append(b, `"-Infinity"`...)
+	}
+
+	format := byte('f')
+	if abs := math.Abs(n); abs != 0 {
+		if bitSize == 64 && (abs < 1e-6 || abs >= 1e21) ||
+			bitSize == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			format = 'e'
+		}
+	}
+	b = strconv.AppendFloat(b, n, format, -1, bitSize)
+	if format == 'e' {
+		// clean up e-09 to e-9
+		l := len(b)
+		if l >= 4 && b[l-4] == 'e' && b[l-3] == '-' && b[l-2] == '0' {
+			b[l-2] = b[l-1]
+			b = b[:l-1]
+		}
+	}
+	return b
+}
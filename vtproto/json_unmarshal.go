@@ -0,0 +1,1129 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vtproto
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// JSONUnmarshalOptions configures the generated UnmarshalJSONFromVT methods. Its
+// fields have the same meaning as in protojson.UnmarshalOptions, and they apply to
+// all the messages nested in the one being unmarshalled.
+type JSONUnmarshalOptions struct {
+	// AllowPartial accepts messages that are missing required fields.
+	// Otherwise, an error is returned if a required field is not set.
+	AllowPartial bool
+
+	// DiscardUnknown ignores unknown fields and enum names instead of failing.
+	DiscardUnknown bool
+
+	// RecursionLimit limits how deeply messages may be nested. If zero, the
+	// default limit of protowire.DefaultRecursionLimit is applied.
+	RecursionLimit int
+}
+
+// Unmarshal parses the JSON encoding of a message in b and stores it in m, which
+// is reset first. It accepts the same inputs as protojson.UnmarshalOptions with the
+// same options. It calls the UnmarshalJSONFromVT method generated for m if there is
+// one, and falls back to protojson otherwise.
+func (o JSONUnmarshalOptions) Unmarshal(b []byte, m proto.Message) error {
+	proto.Reset(m)
+	r := NewJSONReader(b, o)
+	if err := r.ReadMessage(m); err != nil {
+		return err
+	}
+	if err := r.ReadEOF(); err != nil {
+		return err
+	}
+	if !o.AllowPartial {
+		return proto.CheckInitialized(m)
+	}
+	return nil
+}
+
+// JSONReader reads the JSON encoding of messages for the generated
+// UnmarshalJSONFromVT methods. It is a streaming tokenizer: the generated code
+// drives it by asking for the names and values it expects, and it fails when the
+// input doesn't match.
+type JSONReader struct {
+	opts  JSONUnmarshalOptions
+	buf   []byte
+	pos   int
+	start int // start of the last token, for error messages
+	depth int
+	comma bool
+}
+
+// NewJSONReader returns a JSONReader that reads b.
+func NewJSONReader(b []byte, opts JSONUnmarshalOptions) *JSONReader {
+	if opts.RecursionLimit == 0 {
+		opts.RecursionLimit = protowire.DefaultRecursionLimit
+	}
+	return &JSONReader{opts: opts, buf: b}
+}
+
+// Options returns the options of the reader.
+func (r *JSONReader) Options() JSONUnmarshalOptions {
+	return r.opts
+}
+
+// Errorf returns an error at the position of the last token read.
+func (r *JSONReader) Errorf(format string, args ...interface{}) error {
+	b := r.buf[:r.start]
+	line := bytes.Count(b, []byte("\n")) + 1
+	if i := bytes.LastIndexByte(b, '\n'); i >= 0 {
+		b = b[i+1:]
+	}
+	column := utf8.RuneCount(b) + 1
+	return fmt.Errorf("proto: (line %d:%d): %s", line, column, fmt.Sprintf(format, args...))
+}
+
+func (r *JSONReader) skipWS() {
+	for r.pos < len(r.buf) {
+		switch r.buf[r.pos] {
+		case ' ', '\n', '\r', '\t':
+			r.pos++
+		default:
+			return
+		}
+	}
+}
+
+// peek returns the first character of the next token, or 0 at the end of the input.
+func (r *JSONReader) peek() byte {
+	r.skipWS()
+	r.start = r.pos
+	if r.pos == len(r.buf) {
+		return 0
+	}
+	return r.buf[r.pos]
+}
+
+func (r *JSONReader) unexpected() error {
+	if r.pos >= len(r.buf) {
+		return fmt.Errorf("proto: %w", errUnexpectedEOF)
+	}
+	n := 1
+	for r.pos+n < len(r.buf) && n < 32 && isNotDelim(r.buf[r.pos+n-1]) && isNotDelim(r.buf[r.pos+n]) {
+		n++
+	}
+	return r.Errorf("unexpected token %s", r.buf[r.pos:r.pos+n])
+}
+
+var errUnexpectedEOF = errors.New("unexpected EOF")
+
+// ReadEOF checks that there is nothing left to read after the top-level value.
+func (r *JSONReader) ReadEOF() error {
+	if r.peek() != 0 {
+		return r.unexpected()
+	}
+	return nil
+}
+
+// EnterMessage must be called before reading a message, and LeaveMessage after
+// it. EnterMessage fails if the recursion limit is exceeded.
+func (r *JSONReader) EnterMessage() error {
+	if r.depth >= r.opts.RecursionLimit {
+		return errors.New("proto: exceeded max recursion depth")
+	}
+	r.depth++
+	return nil
+}
+
+// LeaveMessage must be called after reading a message entered with EnterMessage.
+func (r *JSONReader) LeaveMessage() {
+	r.depth--
+}
+
+// ReadObjectStart reads the start of an object, which holds a message or a map.
+func (r *JSONReader) ReadObjectStart() error {
+	if r.peek() != '{' {
+		return r.unexpected()
+	}
+	r.pos++
+	r.comma = false
+	return nil
+}
+
+// ReadName reads the name of the next member of the current object. It returns
+// false when the end of the object is read instead.
+func (r *JSONReader) ReadName() (string, bool, error) {
+	c := r.peek()
+	if c == '}' {
+		r.pos++
+		r.comma = true
+		return "", false, nil
+	}
+	if r.comma {
+		if c != ',' {
+			return "", false, r.unexpected()
+		}
+		r.pos++
+		c = r.peek()
+	}
+	if c != '"' {
+		return "", false, r.unexpected()
+	}
+	name, err := r.readString()
+	if err != nil {
+		return "", false, err
+	}
+	r.skipWS()
+	if r.pos == len(r.buf) {
+		return "", false, fmt.Errorf("proto: %w", errUnexpectedEOF)
+	}
+	if r.buf[r.pos] != ':' {
+		r.start = r.pos
+		return "", false, r.Errorf(`unexpected character %s, missing ":" after field name`, string(r.buf[r.pos]))
+	}
+	r.pos++
+	r.comma = false
+	return name, true, nil
+}
+
+// ReadArrayStart reads the start of an array.
+func (r *JSONReader) ReadArrayStart() error {
+	if r.peek() != '[' {
+		return r.unexpected()
+	}
+	r.pos++
+	r.comma = false
+	return nil
+}
+
+// ReadArrayNext reports whether the current array has another element, and reads
+// the end of the array if it doesn't.
+func (r *JSONReader) ReadArrayNext() (bool, error) {
+	c := r.peek()
+	if c == ']' {
+		r.pos++
+		r.comma = true
+		return false, nil
+	}
+	if r.comma {
+		if c != ',' {
+			return false, r.unexpected()
+		}
+		r.pos++
+		r.comma = false
+	}
+	return true, nil
+}
+
+// SkipNull reads the next value if it is null, and reports whether it was.
+func (r *JSONReader) SkipNull() bool {
+	if r.peek() == 'n' {
+		if n := matchWithDelim("null", r.buf[r.pos:]); n > 0 {
+			r.pos += n
+			r.comma = true
+			return true
+		}
+	}
+	return false
+}
+
+// Skip reads the next value, whatever it is.
+func (r *JSONReader) Skip() error {
+	return r.skip(1)
+}
+
+func (r *JSONReader) skip(open int) error {
+	c := r.peek()
+	if c != '{' && c != '[' {
+		_, err := r.readScalar()
+		return err
+	}
+	if open > r.opts.RecursionLimit-r.depth {
+		return errors.New("proto: exceeded max recursion depth")
+	}
+	if c == '{' {
+		if err := r.ReadObjectStart(); err != nil {
+			return err
+		}
+		for {
+			_, ok, err := r.ReadName()
+			if err != nil || !ok {
+				return err
+			}
+			if err := r.skip(open + 1); err != nil {
+				return err
+			}
+		}
+	}
+	if err := r.ReadArrayStart(); err != nil {
+		return err
+	}
+	for {
+		ok, err := r.ReadArrayNext()
+		if err != nil || !ok {
+			return err
+		}
+		if err := r.skip(open + 1); err != nil {
+			return err
+		}
+	}
+}
+
+// ReadRaw reads the next value, whatever it is, and returns its JSON encoding.
+func (r *JSONReader) ReadRaw() ([]byte, error) {
+	r.peek()
+	start := r.start
+	if err := r.Skip(); err != nil {
+		return nil, err
+	}
+	return r.buf[start:r.pos], nil
+}
+
+// ProtoJSON returns the protojson options to read a message returned by ReadRaw
+// with the options of the reader, at the current depth.
+func (r *JSONReader) ProtoJSON() protojson.UnmarshalOptions {
+	return protojson.UnmarshalOptions{
+		AllowPartial:   true, // required fields are checked by Unmarshal
+		DiscardUnknown: r.opts.DiscardUnknown,
+		RecursionLimit: r.opts.RecursionLimit - r.depth,
+	}
+}
+
+// UnknownField handles the value of the member name, which is not a field of the
+// message being read: it is skipped if DiscardUnknown is set, and it is an error
+// otherwise. Like in protojson, naming an extension of another message is always an
+// error.
+func (r *JSONReader) UnknownField(name string) error {
+	if len(name) > 2 && name[0] == '[' && name[len(name)-1] == ']' {
+		if xt, err := protoregistry.GlobalTypes.FindExtensionByName(protoreflect.FullName(name[1 : len(name)-1])); err == nil {
+			return r.Errorf("message cannot be extended by %v", xt.TypeDescriptor().FullName())
+		}
+	}
+	if r.opts.DiscardUnknown {
+		return r.Skip()
+	}
+	return r.Errorf("unknown field %q", name)
+}
+
+// scalar is a literal, number or string token.
+type scalar struct {
+	kind byte // 'n'ull, 'b'ool, '0' for numbers, '"' for strings
+	raw  []byte
+	str  string
+	b    bool
+}
+
+func (r *JSONReader) readScalar() (scalar, error) {
+	c := r.peek()
+	in := r.buf[r.pos:]
+	switch {
+	case c == 'n':
+		if n := matchWithDelim("null", in); n > 0 {
+			r.pos += n
+			r.comma = true
+			return scalar{kind: 'n', raw: in[:n]}, nil
+		}
+	case c == 't':
+		if n := matchWithDelim("true", in); n > 0 {
+			r.pos += n
+			r.comma = true
+			return scalar{kind: 'b', raw: in[:n], b: true}, nil
+		}
+	case c == 'f':
+		if n := matchWithDelim("false", in); n > 0 {
+			r.pos += n
+			r.comma = true
+			return scalar{kind: 'b', raw: in[:n]}, nil
+		}
+	case c == '-' || ('0' <= c && c <= '9'):
+		if n, ok := parseNumber(in); ok {
+			r.pos += n
+			r.comma = true
+			return scalar{kind: '0', raw: in[:n]}, nil
+		}
+	case c == '"':
+		s, err := r.readString()
+		if err != nil {
+			return scalar{}, err
+		}
+		r.comma = true
+		return scalar{kind: '"', raw: r.buf[r.start:r.pos], str: s}, nil
+	case c == 0:
+		return scalar{}, fmt.Errorf("proto: %w", errUnexpectedEOF)
+	}
+	return scalar{}, r.unexpected()
+}
+
+func (r *JSONReader) invalid(kind string, s scalar) error {
+	return r.Errorf("invalid value for %s type: %s", kind, s.raw)
+}
+
+// ReadBool reads a bool value.
+func (r *JSONReader) ReadBool() (bool, error) {
+	s, err := r.readScalar()
+	if err != nil {
+		return false, err
+	}
+	if s.kind != 'b' {
+		return false, r.invalid("bool", s)
+	}
+	return s.b, nil
+}
+
+// numberToken returns the number in s, which may be quoted.
+func numberToken(s scalar) ([]byte, bool) {
+	switch s.kind {
+	case '0':
+		return s.raw, true
+	case '"':
+		if len(strings.TrimSpace(s.str)) != len(s.str) {
+			return nil, false
+		}
+		in := []byte(s.str)
+		n, ok := parseNumber(in)
+		return in[:n], ok
+	}
+	return nil, false
+}
+
+func (r *JSONReader) readInt(kind string, bitSize int) (int64, error) {
+	s, err := r.readScalar()
+	if err != nil {
+		return 0, err
+	}
+	if num, ok := numberToken(s); ok {
+		if str, ok := normalizeToIntString(num); ok {
+			if n, err := strconv.ParseInt(str, 10, bitSize); err == nil {
+				return n, nil
+			}
+		}
+	}
+	return 0, r.invalid(kind, s)
+}
+
+func (r *JSONReader) readUint(kind string, bitSize int) (uint64, error) {
+	s, err := r.readScalar()
+	if err != nil {
+		return 0, err
+	}
+	if num, ok := numberToken(s); ok {
+		if str, ok := normalizeToIntString(num); ok {
+			if n, err := strconv.ParseUint(str, 10, bitSize); err == nil {
+				return n, nil
+			}
+		}
+	}
+	return 0, r.invalid(kind, s)
+}
+
+func (r *JSONReader) readFloat(kind string, bitSize int) (float64, error) {
+	s, err := r.readScalar()
+	if err != nil {
+		return 0, err
+	}
+	if s.kind == '"' {
+		switch s.str {
+		case "NaN":
+			return math.NaN(), nil
+		case "Infinity":
+			return math.Inf(+1), nil
+		case "-Infinity":
+			return math.Inf(-1), nil
+		}
+	}
+	if num, ok := numberToken(s); ok {
+		if f, err := strconv.ParseFloat(string(num), bitSize); err == nil {
+			return f, nil
+		}
+	}
+	return 0, r.invalid(kind, s)
+}
+
+// ReadInt32 reads a 32-bit signed integer value, which may be quoted.
+func (r *JSONReader) ReadInt32() (int32, error) {
+	n, err := r.readInt("int32", 32)
+	return int32(n), err
+}
+
+// ReadInt64 reads a 64-bit signed integer value, which may be quoted.
+func (r *JSONReader) ReadInt64() (int64, error) {
+	return r.readInt("int64", 64)
+}
+
+// ReadUint32 reads a 32-bit unsigned integer value, which may be quoted.
+func (r *JSONReader) ReadUint32() (uint32, error) {
+	n, err := r.readUint("uint32", 32)
+	return uint32(n), err
+}
+
+// ReadUint64 reads a 64-bit unsigned integer value, which may be quoted.
+func (r *JSONReader) ReadUint64() (uint64, error) {
+	return r.readUint("uint64", 64)
+}
+
+// ReadFloat32 reads a float value, which may be quoted, "NaN", "Infinity" or
+// "-Infinity".
+func (r *JSONReader) ReadFloat32() (float32, error) {
+	f, err := r.readFloat("float", 32)
+	return float32(f), err
+}
+
+// ReadFloat64 reads a double value, which may be quoted, "NaN", "Infinity" or
+// "-Infinity".
+func (r *JSONReader) ReadFloat64() (float64, error) {
+	return r.readFloat("double", 64)
+}
+
+// ReadString reads a string value.
+func (r *JSONReader) ReadString() (string, error) {
+	s, err := r.readScalar()
+	if err != nil {
+		return "", err
+	}
+	if s.kind != '"' {
+		return "", r.invalid("string", s)
+	}
+	return s.str, nil
+}
+
+// ReadBytes reads a bytes value, encoded in standard or URL base64 with or without
+// padding.
+func (r *JSONReader) ReadBytes() ([]byte, error) {
+	s, err := r.readScalar()
+	if err != nil {
+		return nil, err
+	}
+	if s.kind != '"' {
+		return nil, r.invalid("bytes", s)
+	}
+	enc := base64.StdEncoding
+	if strings.ContainsAny(s.str, "-_") {
+		enc = base64.URLEncoding
+	}
+	if len(s.str)%4 != 0 {
+		enc = enc.WithPadding(base64.NoPadding)
+	}
+	b, err := enc.DecodeString(s.str)
+	if err != nil {
+		return nil, r.invalid("bytes", s)
+	}
+	return b, nil
+}
+
+// ReadEnum reads an enum value, given by its name in values, which is the _value
+// map generated for the enum, or by its number. It returns false if the name is
+// unknown and DiscardUnknown is set, in which case the value must be ignored.
+func (r *JSONReader) ReadEnum(values map[string]int32) (int32, bool, error) {
+	s, err := r.readScalar()
+	if err != nil {
+		return 0, false, err
+	}
+	switch s.kind {
+	case '"':
+		if v, ok := values[s.str]; ok {
+			return v, true, nil
+		}
+		if r.opts.DiscardUnknown {
+			return 0, false, nil
+		}
+	case '0':
+		if str, ok := normalizeToIntString(s.raw); ok {
+			if n, err := strconv.ParseInt(str, 10, 32); err == nil {
+				return int32(n), true, nil
+			}
+		}
+	}
+	return 0, false, r.invalid("enum", s)
+}
+
+// ReadNullValue reads a google.protobuf.NullValue enum value, which may be null.
+func (r *JSONReader) ReadNullValue() (int32, bool, error) {
+	if r.SkipNull() {
+		return 0, true, nil
+	}
+	return r.ReadEnum(structpb.NullValue_value)
+}
+
+func (r *JSONReader) mapKeyError(kind, name string) error {
+	return r.Errorf("invalid value for %s key: %q", kind, name)
+}
+
+// BoolKey parses the name of a member of an object holding a map with bool keys.
+func (r *JSONReader) BoolKey(name string) (bool, error) {
+	switch name {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	return false, r.mapKeyError("bool", name)
+}
+
+// Int32Key parses the name of a member of an object holding a map with int32 keys.
+func (r *JSONReader) Int32Key(name string) (int32, error) {
+	n, err := strconv.ParseInt(name, 10, 32)
+	if err != nil {
+		return 0, r.mapKeyError("int32", name)
+	}
+	return int32(n), nil
+}
+
+// Int64Key parses the name of a member of an object holding a map with int64 keys.
+func (r *JSONReader) Int64Key(name string) (int64, error) {
+	n, err := strconv.ParseInt(name, 10, 64)
+	if err != nil {
+		return 0, r.mapKeyError("int64", name)
+	}
+	return n, nil
+}
+
+// Uint32Key parses the name of a member of an object holding a map with uint32
+// keys.
+func (r *JSONReader) Uint32Key(name string) (uint32, error) {
+	n, err := strconv.ParseUint(name, 10, 32)
+	if err != nil {
+		return 0, r.mapKeyError("uint32", name)
+	}
+	return uint32(n), nil
+}
+
+// Uint64Key parses the name of a member of an object holding a map with uint64
+// keys.
+func (r *JSONReader) Uint64Key(name string) (uint64, error) {
+	n, err := strconv.ParseUint(name, 10, 64)
+	if err != nil {
+		return 0, r.mapKeyError("uint64", name)
+	}
+	return n, nil
+}
+
+// ReadMessage reads the message m. The well-known types are read from their
+// special JSON form, the messages with an UnmarshalJSONFromVT method with it, and
+// all others with protojson.
+func (r *JSONReader) ReadMessage(m proto.Message) error {
+	if m, ok := m.(interface{ UnmarshalJSONFromVT(*JSONReader) error }); ok {
+		return m.UnmarshalJSONFromVT(r)
+	}
+
+	switch m.(type) {
+	case *timestamppb.Timestamp, *durationpb.Duration, *structpb.Struct, *structpb.ListValue, *structpb.Value, *emptypb.Empty:
+	case *wrapperspb.DoubleValue, *wrapperspb.FloatValue, *wrapperspb.Int64Value, *wrapperspb.UInt64Value,
+		*wrapperspb.Int32Value, *wrapperspb.UInt32Value, *wrapperspb.BoolValue, *wrapperspb.StringValue, *wrapperspb.BytesValue:
+	default:
+		raw, err := r.ReadRaw()
+		if err != nil {
+			return err
+		}
+		return r.ProtoJSON().Unmarshal(raw, m)
+	}
+
+	// the well-known types count as nested messages, like in protojson
+	if err := r.EnterMessage(); err != nil {
+		return err
+	}
+	defer r.LeaveMessage()
+
+	var err error
+	switch m := m.(type) {
+	case *timestamppb.Timestamp:
+		return r.timestamp(m)
+	case *durationpb.Duration:
+		return r.duration(m)
+	case *structpb.Struct:
+		return r.structValue(m)
+	case *structpb.ListValue:
+		return r.listValue(m)
+	case *structpb.Value:
+		return r.value(m)
+	case *emptypb.Empty:
+		return r.empty()
+	case *wrapperspb.DoubleValue:
+		m.Value, err = r.ReadFloat64()
+	case *wrapperspb.FloatValue:
+		m.Value, err = r.ReadFloat32()
+	case *wrapperspb.Int64Value:
+		m.Value, err = r.ReadInt64()
+	case *wrapperspb.UInt64Value:
+		m.Value, err = r.ReadUint64()
+	case *wrapperspb.Int32Value:
+		m.Value, err = r.ReadInt32()
+	case *wrapperspb.UInt32Value:
+		m.Value, err = r.ReadUint32()
+	case *wrapperspb.BoolValue:
+		m.Value, err = r.ReadBool()
+	case *wrapperspb.StringValue:
+		m.Value, err = r.ReadString()
+	case *wrapperspb.BytesValue:
+		m.Value, err = r.ReadBytes()
+	}
+	return err
+}
+
+func (r *JSONReader) timestamp(m *timestamppb.Timestamp) error {
+	s, err := r.readScalar()
+	if err != nil {
+		return err
+	}
+	if s.kind != '"' {
+		return r.Errorf("unexpected token %s", s.raw)
+	}
+	t, err := time.Parse(time.RFC3339Nano, s.str)
+	if err != nil {
+		return r.Errorf("invalid google.protobuf.Timestamp value %s", s.raw)
+	}
+	secs := t.Unix()
+	if secs < minTimestampSeconds || secs > maxTimestampSeconds {
+		return r.Errorf("google.protobuf.Timestamp value out of range: %s", s.raw)
+	}
+	// time.Parse accepts more than 9 fractional digits
+	i := strings.LastIndexByte(s.str, '.')
+	j := strings.LastIndexAny(s.str, "Z-+")
+	if i >= 0 && j >= i && j-i > len(".999999999") {
+		return r.Errorf("invalid google.protobuf.Timestamp value %s", s.raw)
+	}
+	m.Seconds = secs
+	m.Nanos = int32(t.Nanosecond())
+	return nil
+}
+
+func (r *JSONReader) duration(m *durationpb.Duration) error {
+	s, err := r.readScalar()
+	if err != nil {
+		return err
+	}
+	if s.kind != '"' {
+		return r.Errorf("unexpected token %s", s.raw)
+	}
+	secs, nanos, ok := parseDuration(s.str)
+	if !ok {
+		return r.Errorf("invalid google.protobuf.Duration value %s", s.raw)
+	}
+	if secs < -maxDurationSeconds || secs > maxDurationSeconds {
+		return r.Errorf("google.protobuf.Duration value out of range: %s", s.raw)
+	}
+	m.Seconds = secs
+	m.Nanos = nanos
+	return nil
+}
+
+// parseDuration parses a Duration in the "[-]seconds[.fraction]s" format, with up
+// to 9 fractional digits.
+func parseDuration(s string) (int64, int32, bool) {
+	if len(s) < 2 || s[len(s)-1] != 's' {
+		return 0, 0, false
+	}
+	s = s[:len(s)-1]
+
+	var neg bool
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+	if s == "" {
+		return 0, 0, false
+	}
+
+	// the integer part has no leading zeros, and may be omitted before a fraction
+	var intp string
+	switch {
+	case s[0] == '0':
+		s = s[1:]
+	case '1' <= s[0] && s[0] <= '9':
+		n := 1
+		for n < len(s) && '0' <= s[n] && s[n] <= '9' {
+			n++
+		}
+		intp, s = s[:n], s[n:]
+	case s[0] == '.':
+	default:
+		return 0, 0, false
+	}
+
+	var frac string
+	hasFrac := false
+	if s != "" {
+		if s[0] != '.' {
+			return 0, 0, false
+		}
+		s = s[1:]
+		n := 0
+		for n < len(s) && n < 9 && '0' <= s[n] && s[n] <= '9' {
+			n++
+		}
+		if n != len(s) {
+			return 0, 0, false
+		}
+		frac = s + strings.Repeat("0", 9-n)
+		hasFrac = true
+	}
+
+	var secs int64
+	if intp != "" {
+		var err error
+		if secs, err = strconv.ParseInt(intp, 10, 64); err != nil {
+			return 0, 0, false
+		}
+	}
+	var nanos int64
+	if hasFrac {
+		if frac = strings.TrimLeft(frac, "0"); frac != "" {
+			var err error
+			if nanos, err = strconv.ParseInt(frac, 10, 32); err != nil {
+				return 0, 0, false
+			}
+		}
+	}
+	if neg {
+		secs, nanos = -secs, -nanos
+	}
+	return secs, int32(nanos), true
+}
+
+func (r *JSONReader) empty() error {
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil || !ok {
+			return err
+		}
+		if err := r.UnknownField(name); err != nil {
+			return err
+		}
+	}
+}
+
+func (r *JSONReader) structValue(m *structpb.Struct) error {
+	if err := r.ReadObjectStart(); err != nil {
+		return err
+	}
+	for {
+		name, ok, err := r.ReadName()
+		if err != nil || !ok {
+			return err
+		}
+		if _, ok := m.Fields[name]; ok {
+			return r.Errorf("duplicate map key %q", name)
+		}
+		v := &structpb.Value{}
+		if err := r.ReadMessage(v); err != nil {
+			return err
+		}
+		if m.Fields == nil {
+			m.Fields = make(map[string]*structpb.Value)
+		}
+		m.Fields[name] = v
+	}
+}
+
+func (r *JSONReader) listValue(m *structpb.ListValue) error {
+	if err := r.ReadArrayStart(); err != nil {
+		return err
+	}
+	for {
+		ok, err := r.ReadArrayNext()
+		if err != nil || !ok {
+			return err
+		}
+		v := &structpb.Value{}
+		if err := r.ReadMessage(v); err != nil {
+			return err
+		}
+		m.Values = append(m.Values, v)
+	}
+}
+
+func (r *JSONReader) value(m *structpb.Value) error {
+	switch c := r.peek(); {
+	case c == '{':
+		v := &structpb.Struct{}
+		if err := r.ReadMessage(v); err != nil {
+			return err
+		}
+		m.Kind = &structpb.Value_StructValue{StructValue: v}
+	case c == '[':
+		v := &structpb.ListValue{}
+		if err := r.ReadMessage(v); err != nil {
+			return err
+		}
+		m.Kind = &structpb.Value_ListValue{ListValue: v}
+	default:
+		s, err := r.readScalar()
+		if err != nil {
+			return err
+		}
+		switch s.kind {
+		case 'n':
+			m.Kind = &structpb.Value_NullValue{}
+		case 'b':
+			m.Kind = &structpb.Value_BoolValue{BoolValue: s.b}
+		case '"':
+			m.Kind = &structpb.Value_StringValue{StringValue: s.str}
+		case '0':
+			f, err := strconv.ParseFloat(string(s.raw), 64)
+			if err != nil {
+				return r.Errorf("invalid google.protobuf.Value: %s", s.raw)
+			}
+			m.Kind = &structpb.Value_NumberValue{NumberValue: f}
+		}
+	}
+	return nil
+}
+
+// readString reads a string token, unescaping it.
+func (r *JSONReader) readString() (string, error) {
+	in := r.buf[r.pos+1:]
+	var out []byte
+	for i := 0; i < len(in); {
+		switch c := in[i]; {
+		case c == '"':
+			if out == nil {
+				r.pos += i + 2
+				return string(in[:i]), nil
+			}
+			r.pos += i + 2
+			return string(out), nil
+		case c == '\\':
+			if out == nil {
+				out = append(make([]byte, 0, len(in)), in[:i]...)
+			}
+			if i+1 >= len(in) {
+				return "", fmt.Errorf("proto: %w", errUnexpectedEOF)
+			}
+			switch e := in[i+1]; e {
+			case '"', '\\', '/':
+				out = append(out, e)
+			case 'b':
+				out = append(out, '\b')
+			case 'f':
+				out = append(out, '\f')
+			case 'n':
+				out = append(out, '\n')
+			case 'r':
+				out = append(out, '\r')
+			case 't':
+				out = append(out, '\t')
+			case 'u':
+				rn, n, err := r.readEscapedRune(in[i:])
+				if err != nil {
+					return "", err
+				}
+				out = utf8.AppendRune(out, rn)
+				i += n
+				continue
+			default:
+				r.start = r.pos + 1 + i
+				return "", r.Errorf("invalid escape code %q in string", in[i:i+2])
+			}
+			i += 2
+		case c < ' ':
+			r.start = r.pos + 1 + i
+			return "", r.Errorf("invalid character %q in string", rune(c))
+		case c < utf8.RuneSelf:
+			if out != nil {
+				out = append(out, c)
+			}
+			i++
+		default:
+			rn, n := utf8.DecodeRune(in[i:])
+			if rn == utf8.RuneError && n == 1 {
+				r.start = r.pos + 1 + i
+				return "", r.Errorf("invalid UTF-8 in string")
+			}
+			if out != nil {
+				out = append(out, in[i:i+n]...)
+			}
+			i += n
+		}
+	}
+	return "", fmt.Errorf("proto: %w", errUnexpectedEOF)
+}
+
+// readEscapedRune decodes the \uXXXX escape sequence at the start of in, which is
+// followed by a second one if it is a surrogate.
+func (r *JSONReader) readEscapedRune(in []byte) (rune, int, error) {
+	if len(in) < 6 {
+		return 0, 0, fmt.Errorf("proto: %w", errUnexpectedEOF)
+	}
+	v, err := strconv.ParseUint(string(in[2:6]), 16, 16)
+	if err != nil {
+		return 0, 0, r.Errorf("invalid escape code %q in string", in[:6])
+	}
+	rn := rune(v)
+	if !utf16.IsSurrogate(rn) {
+		return rn, 6, nil
+	}
+	if len(in) < 12 {
+		return 0, 0, fmt.Errorf("proto: %w", errUnexpectedEOF)
+	}
+	v, err = strconv.ParseUint(string(in[8:12]), 16, 16)
+	rn = utf16.DecodeRune(rn, rune(v))
+	if in[6] != '\\' || in[7] != 'u' || rn == unicode.ReplacementChar || err != nil {
+		return 0, 0, r.Errorf("invalid escape code %q in string", in[6:12])
+	}
+	return rn, 12, nil
+}
+
+func matchWithDelim(s string, b []byte) int {
+	if !bytes.HasPrefix(b, []byte(s)) {
+		return 0
+	}
+	n := len(s)
+	if n < len(b) && isNotDelim(b[n]) {
+		return 0
+	}
+	return n
+}
+
+// isNotDelim reports whether c can be part of a literal or a number, so that
+// e.g. "nullx" and "1a" are not valid tokens.
+func isNotDelim(c byte) bool {
+	return c == '-' || c == '+' || c == '.' || c == '_' ||
+		('a' <= c && c <= 'z') ||
+		('A' <= c && c <= 'Z') ||
+		('0' <= c && c <= '9')
+}
+
+// parseNumber returns the length of the JSON number at the start of in.
+func parseNumber(in []byte) (int, bool) {
+	s := in
+	n := 0
+	if len(s) > 0 && s[0] == '-' {
+		s, n = s[1:], n+1
+	}
+	switch {
+	case len(s) == 0:
+		return 0, false
+	case s[0] == '0':
+		s, n = s[1:], n+1
+	case '1' <= s[0] && s[0] <= '9':
+		s, n = s[1:], n+1
+		for len(s) > 0 && '0' <= s[0] && s[0] <= '9' {
+			s, n = s[1:], n+1
+		}
+	default:
+		return 0, false
+	}
+
+	if len(s) >= 2 && s[0] == '.' && '0' <= s[1] && s[1] <= '9' {
+		s, n = s[2:], n+2
+		for len(s) > 0 && '0' <= s[0] && s[0] <= '9' {
+			s, n = s[1:], n+1
+		}
+	}
+
+	if len(s) >= 2 && (s[0] == 'e' || s[0] == 'E') {
+		s, n = s[1:], n+1
+		if s[0] == '+' || s[0] == '-' {
+			s, n = s[1:], n+1
+			if len(s) == 0 {
+				return 0, false
+			}
+		}
+		for len(s) > 0 && '0' <= s[0] && s[0] <= '9' {
+			s, n = s[1:], n+1
+		}
+	}
+
+	if n < len(in) && isNotDelim(in[n]) {
+		return 0, false
+	}
+	return n, true
+}
+
+// normalizeToIntString returns the JSON number num as a plain integer, so that
+// e.g. 1.0 and 1e2 are accepted as integers like protojson does.
+func normalizeToIntString(num []byte) (string, bool) {
+	var neg bool
+	if len(num) > 0 && num[0] == '-' {
+		neg, num = true, num[1:]
+	}
+	var intp, frac, exp []byte
+	i := 0
+	for i < len(num) && '0' <= num[i] && num[i] <= '9' {
+		i++
+	}
+	if i > 1 || (i == 1 && num[0] != '0') {
+		intp = num[:i]
+	}
+	num = num[i:]
+	if len(num) > 0 && num[0] == '.' {
+		i = 1
+		for i < len(num) && '0' <= num[i] && num[i] <= '9' {
+			i++
+		}
+		frac, num = bytes.TrimRight(num[1:i], "0"), num[i:]
+	}
+	if len(num) > 0 && (num[0] == 'e' || num[0] == 'E') {
+		exp = num[1:]
+	}
+
+	if len(intp) == 0 && len(frac) == 0 {
+		return "0", true
+	}
+	var e int
+	if len(exp) > 0 {
+		n, err := strconv.ParseInt(string(exp), 10, 32)
+		if err != nil {
+			return "", false
+		}
+		e = int(n)
+	}
+
+	var out []byte
+	if e >= 0 {
+		// the fraction must be shifted into the integer part
+		if len(frac) > e {
+			return "", false
+		}
+		// max uint64 value has 20 decimal digits
+		if len(intp)+e > 20 {
+			return "", false
+		}
+		out = append(append(out, intp...), frac...)
+		for i := 0; i < e-len(frac); i++ {
+			out = append(out, '0')
+		}
+	} else {
+		// the digits shifted out of the integer part must be zeros
+		if len(frac) > 0 {
+			return "", false
+		}
+		index := len(intp) + e
+		if index < 0 {
+			return "", false
+		}
+		for _, c := range intp[index:] {
+			if c != '0' {
+				return "", false
+			}
+		}
+		out = intp[:index]
+	}
+	if neg {
+		return "-" + string(out), true
+	}
+	return string(out), true
+}
@@ -0,0 +1,23 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vtproto
+
+import "errors"
+
+var (
+	// ErrBufferTooSmall is returned when a buffer cannot hold the encoded
+	// message. This happens when the buffer passed to MarshalToVT is shorter
+	// than SizeVT, or when the message grows while it is being marshalled, e.g.
+	// because it is modified concurrently. The generated marshal methods return
+	// the ErrBufferTooSmall of their package, which is this error when they are
+	// generated with the shared-helpers option.
+	ErrBufferTooSmall = errors.New("proto: buffer too small to marshal the message")
+
+	// ErrSizeMismatch is returned when the encoded message is not of the size
+	// reported by SizeVT, i.e. when the message has been modified while it was
+	// being marshalled. Like ErrBufferTooSmall, the generated marshal methods
+	// return the ErrSizeMismatch of their package.
+	ErrSizeMismatch = errors.New("proto: message size changed while marshaling")
+)
@@ -0,0 +1,94 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vtproto
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/runtime/protoiface"
+)
+
+// MethodsMessage is implemented by the messages generated with the marshal, size,
+// unmarshal and merge features.
+type MethodsMessage interface {
+	proto.Message
+	SizeVT() int
+	MarshalToSizedBufferVT(dAtA []byte) (int, error)
+	UnmarshalVTWithOptions(dAtA []byte, opts UnmarshalOptions) error
+	MergeGenericVT(src proto.Message)
+}
+
+// RegisterMethods makes the proto package use the generated methods of the type of
+// m to marshal, size, unmarshal and merge its messages, instead of the ones the
+// protobuf runtime derives from the message descriptor. This makes proto.Marshal,
+// proto.Size, proto.Unmarshal, proto.Merge and proto.Clone, and any library using
+// them, call the unrolled code.
+//
+// deterministic reports whether the generated marshal methods sort map keys: if they
+// don't, proto.MarshalOptions{Deterministic: true} keeps using reflection. The
+// runtime methods are also used to unmarshal with a custom Resolver, which the
+// generated methods don't support.
+//
+// The methods are replaced in the *protoiface.Methods returned by ProtoMethods,
+// which the protobuf runtime shares between all the messages of a type. This is an
+// implementation detail of google.golang.org/protobuf, not part of its API.
+//
+// RegisterMethods is called by the init functions generated with the methods
+// feature. It must not be called concurrently with the use of messages of the type.
+func RegisterMethods(m MethodsMessage, deterministic bool) {
+	methods := m.ProtoReflect().ProtoMethods()
+	if methods == nil {
+		return
+	}
+	runtime := *methods
+
+	methods.Flags = protoiface.SupportUnmarshalDiscardUnknown
+	if deterministic {
+		methods.Flags |= protoiface.SupportMarshalDeterministic
+	}
+	methods.Size = func(in protoiface.SizeInput) protoiface.SizeOutput {
+		return protoiface.SizeOutput{Size: in.Message.Interface().(MethodsMessage).SizeVT()}
+	}
+	methods.Marshal = func(in protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		m := in.Message.Interface().(MethodsMessage)
+		size := m.SizeVT()
+		buf := in.Buf
+		if cap(buf)-len(buf) < size {
+			buf = make([]byte, len(in.Buf), len(in.Buf)+size)
+			copy(buf, in.Buf)
+		}
+		buf = buf[:len(buf)+size]
+		n, err := m.MarshalToSizedBufferVT(buf[len(in.Buf):])
+		if err != nil {
+			return protoiface.MarshalOutput{}, err
+		}
+		if n != size {
+			return protoiface.MarshalOutput{}, ErrSizeMismatch
+		}
+		return protoiface.MarshalOutput{Buf: buf}, nil
+	}
+	methods.Unmarshal = func(in protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		if in.Resolver != nil && in.Resolver != protoregistry.GlobalTypes {
+			return runtime.Unmarshal(in)
+		}
+		// proto.Unmarshal resets the message first if needed, and checks the required
+		// fields afterwards
+		err := in.Message.Interface().(MethodsMessage).UnmarshalVTWithOptions(in.Buf, UnmarshalOptions{
+			Merge:          true,
+			AllowPartial:   true,
+			DiscardUnknown: in.Flags&protoiface.UnmarshalDiscardUnknown != 0,
+			RecursionLimit: in.Depth,
+		})
+		return protoiface.UnmarshalOutput{}, err
+	}
+	methods.Merge = func(in protoiface.MergeInput) protoiface.MergeOutput {
+		// the source may be a dynamic message with the same descriptor
+		if in.Source.Type() != in.Destination.Type() {
+			return runtime.Merge(in)
+		}
+		in.Destination.Interface().(MethodsMessage).MergeGenericVT(in.Source.Interface())
+		return protoiface.MergeOutput{Flags: protoiface.MergeComplete}
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2021 PlanetScale Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package vtproto
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// UnmarshalOptions configures the generated UnmarshalVTWithOptions methods. Its
+// fields have the same meaning as in proto.UnmarshalOptions, and they apply to
+// all the messages nested in the one being unmarshalled.
+type UnmarshalOptions struct {
+	// Merge merges the input into the existing message. Otherwise, the message
+	// is reset before unmarshalling.
+	Merge bool
+
+	// AllowPartial accepts messages that are missing required fields.
+	// Otherwise, an error is returned if a required field is not set.
+	AllowPartial bool
+
+	// DiscardUnknown ignores unknown fields instead of storing them in the
+	// message.
+	DiscardUnknown bool
+
+	// RecursionLimit limits how deeply messages may be nested. If zero, the
+	// default limit of protowire.DefaultRecursionLimit is applied.
+	RecursionLimit int
+}
+
+// DecodeError is returned by the generated unmarshal methods when their input
+// cannot be decoded. It wraps the underlying error, so that errors.Is keeps
+// matching the errors declared in the generated packages and in the io package.
+type DecodeError struct {
+	// Offset is the position in the input of the tag of the field that could not
+	// be decoded, or of the end of the message containing it if the error is not
+	// specific to a field.
+	Offset int
+
+	// FieldNumber and WireType are the tag of the field that could not be decoded,
+	// the last one in the path. They are zero if the path is empty.
+	FieldNumber protowire.Number
+	WireType    protowire.Type
+
+	// Err is the underlying error.
+	Err error
+
+	// fields holds the names of the fields in the path, starting from the innermost
+	// one, so that each enclosing message only appends the name of its own field.
+	fields []string
+}
+
+// Path returns the dot-separated list of the names of the fields leading from the
+// message being unmarshalled to the field that could not be decoded, e.g.
+// "child.values". Extensions are named "[full.name]", and unknown fields by their
+// number. Path is empty if the error is not specific to a field, e.g. when a tag
+// cannot be decoded or a required field is not set. Such errors in a nested
+// message are reported at the field holding it.
+func (e *DecodeError) Path() string {
+	var b strings.Builder
+	for i := len(e.fields) - 1; i >= 0; i-- {
+		b.WriteString(e.fields[i])
+		if i > 0 {
+			b.WriteByte('.')
+		}
+	}
+	return b.String()
+}
+
+// PrependField adds the field name, with the given tag, to the start of the path
+// of e. It is called by the generated code when e is returned by the message held
+// by that field. If the path of e is empty, the field becomes the one reported by
+// FieldNumber and WireType.
+func (e *DecodeError) PrependField(name string, num protowire.Number, typ protowire.Type) {
+	if len(e.fields) == 0 {
+		e.FieldNumber = num
+		e.WireType = typ
+	}
+	e.fields = append(e.fields, name)
+}
+
+func (e *DecodeError) Error() string {
+	if len(e.fields) == 0 {
+		return fmt.Sprintf("%v (offset %d)", e.Err, e.Offset)
+	}
+	return fmt.Sprintf("%v (field %s, number %d, wire type %d, offset %d)", e.Err, e.Path(), e.FieldNumber, e.WireType, e.Offset)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}